@@ -0,0 +1,91 @@
+package access
+
+import (
+	"context"
+	"time"
+
+	"github.com/goto/salt/log"
+	"github.com/spf13/cobra"
+
+	"github.com/goto/optimus/client/cmd/internal"
+	"github.com/goto/optimus/client/cmd/internal/connection"
+	"github.com/goto/optimus/config"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+const accessTimeout = time.Minute
+
+// NewAccessCommand initializes command to manage the roles of the principals on a project
+func NewAccessCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "access",
+		Short: "Manage the roles granted to the users on a project or its namespaces",
+		Annotations: map[string]string{
+			"group:core": "false",
+		},
+	}
+
+	cmd.AddCommand(
+		NewGrantCommand(),
+		NewRevokeCommand(),
+		NewListCommand(),
+	)
+	return cmd
+}
+
+// accessCommand holds the target of the access commands
+type accessCommand struct {
+	logger     log.Logger
+	connection connection.Connection
+
+	configFilePath string
+
+	host          string
+	projectName   string
+	namespaceName string
+}
+
+func (a *accessCommand) injectFlags(cmd *cobra.Command) {
+	// Config filepath flag
+	cmd.Flags().StringVarP(&a.configFilePath, "config", "c", config.EmptyPath, "File path for client configuration")
+
+	// Mandatory flags if config is not set
+	cmd.Flags().StringVar(&a.host, "host", a.host, "Targeted server host, by default taking from client config")
+	cmd.Flags().StringVarP(&a.projectName, "project-name", "p", a.projectName, "Targeted project name, by default taking from client config")
+}
+
+func (a *accessCommand) PreRunE(cmd *cobra.Command, _ []string) error {
+	// Load config
+	conf, err := internal.LoadOptionalConfig(a.configFilePath)
+	if err != nil {
+		return err
+	}
+
+	if conf == nil {
+		internal.MarkFlagsRequired(cmd, []string{"project-name", "host"})
+		a.connection = connection.NewInsecure(a.logger)
+		return nil
+	}
+
+	if a.projectName == "" {
+		a.projectName = conf.Project.Name
+	}
+	if a.host == "" {
+		a.host = conf.Host
+	}
+	a.connection = connection.New(a.logger, conf)
+	return nil
+}
+
+func (a *accessCommand) call(fn func(context.Context, pb.RoleBindingServiceClient) error) error {
+	conn, err := a.connection.Create(a.host)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ctx, cancelFunc := context.WithTimeout(context.Background(), accessTimeout)
+	defer cancelFunc()
+
+	return fn(ctx, pb.NewRoleBindingServiceClient(conn))
+}
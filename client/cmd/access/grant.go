@@ -0,0 +1,70 @@
+package access
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/goto/optimus/client/cmd/internal/logger"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+type grantCommand struct {
+	accessCommand
+
+	role string
+}
+
+// NewGrantCommand initializes command to grant a role to a principal
+func NewGrantCommand() *cobra.Command {
+	grant := &grantCommand{
+		accessCommand: accessCommand{logger: logger.NewClientLogger()},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "grant <principal>",
+		Short: "Grant a role to a user on the project, or only on one of its namespaces",
+		Long: "Grant the viewer, editor or admin role to a user on the project, replacing the role the user had there.\n" +
+			"Viewers read the jobs, resources and replays, editors also deploy them and run replays, admins also manage the secrets and the access.",
+		Example: "optimus access grant john.doe@example.com --role editor\n" +
+			"optimus access grant john.doe@example.com --role viewer --namespace-name sample-namespace",
+		Args:    cobra.ExactArgs(1),
+		PreRunE: grant.PreRunE,
+		RunE:    grant.RunE,
+	}
+	grant.injectFlags(cmd)
+	cmd.Flags().StringVarP(&grant.namespaceName, "namespace-name", "n", "", "Grant the role only on the namespace")
+	cmd.Flags().StringVar(&grant.role, "role", "", "Role to grant, one of viewer, editor or admin")
+	cmd.MarkFlagRequired("role")
+	return cmd
+}
+
+func (g *grantCommand) RunE(_ *cobra.Command, args []string) error {
+	if args[0] == "" {
+		return errors.New("principal is required")
+	}
+
+	var granted *pb.RoleBinding
+	err := g.call(func(ctx context.Context, client pb.RoleBindingServiceClient) error {
+		response, err := client.GrantRole(ctx, &pb.GrantRoleRequest{
+			ProjectName:   g.projectName,
+			NamespaceName: g.namespaceName,
+			Principal:     args[0],
+			Role:          g.role,
+		})
+		granted = response.GetRoleBinding()
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("granting role to %s failed: %w", args[0], err)
+	}
+
+	target := "project " + granted.GetProjectName()
+	if granted.GetNamespaceName() != "" {
+		target = "namespace " + granted.GetNamespaceName() + " of " + target
+	}
+	g.logger.Info("Granted role %s to %s on %s", granted.GetRole(), granted.GetPrincipal(), target)
+	return nil
+}
@@ -0,0 +1,83 @@
+package access
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+
+	"github.com/goto/optimus/client/cmd/internal/logger"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+type listCommand struct {
+	accessCommand
+}
+
+// NewListCommand initializes command to list the roles granted on a project
+func NewListCommand() *cobra.Command {
+	list := &listCommand{
+		accessCommand: accessCommand{logger: logger.NewClientLogger()},
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List the roles granted on the project and its namespaces",
+		Example: "optimus access list",
+		PreRunE: list.PreRunE,
+		RunE:    list.RunE,
+	}
+	list.injectFlags(cmd)
+	return cmd
+}
+
+func (l *listCommand) RunE(_ *cobra.Command, _ []string) error {
+	var bindings []*pb.RoleBinding
+	err := l.call(func(ctx context.Context, client pb.RoleBindingServiceClient) error {
+		response, err := client.ListRoleBindings(ctx, &pb.ListRoleBindingsRequest{ProjectName: l.projectName})
+		bindings = response.GetRoleBindings()
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("listing roles of project %s failed: %w", l.projectName, err)
+	}
+
+	if len(bindings) == 0 {
+		l.logger.Info("No role granted in %s project.", l.projectName)
+		return nil
+	}
+	l.logger.Info(stringifyRoleBindings(bindings))
+	return nil
+}
+
+func stringifyRoleBindings(bindings []*pb.RoleBinding) string {
+	buff := &bytes.Buffer{}
+	table := tablewriter.NewWriter(buff)
+	table.SetBorder(false)
+	table.SetHeader([]string{
+		"Principal",
+		"Namespace",
+		"Role",
+		"Granted By",
+		"Granted At",
+	})
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	for _, binding := range bindings {
+		namespaceName := binding.GetNamespaceName()
+		if namespaceName == "" {
+			namespaceName = "*"
+		}
+		table.Append([]string{
+			binding.GetPrincipal(),
+			namespaceName,
+			binding.GetRole(),
+			binding.GetGrantedBy(),
+			binding.GetCreatedAt().AsTime().Format(time.RFC3339),
+		})
+	}
+	table.Render()
+	return buff.String()
+}
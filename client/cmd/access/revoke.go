@@ -0,0 +1,56 @@
+package access
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/goto/optimus/client/cmd/internal/logger"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+type revokeCommand struct {
+	accessCommand
+}
+
+// NewRevokeCommand initializes command to revoke the role of a principal
+func NewRevokeCommand() *cobra.Command {
+	revoke := &revokeCommand{
+		accessCommand: accessCommand{logger: logger.NewClientLogger()},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "revoke <principal>",
+		Short: "Revoke the role of a user on the project, or on one of its namespaces",
+		Example: "optimus access revoke john.doe@example.com\n" +
+			"optimus access revoke john.doe@example.com --namespace-name sample-namespace",
+		Args:    cobra.ExactArgs(1),
+		PreRunE: revoke.PreRunE,
+		RunE:    revoke.RunE,
+	}
+	revoke.injectFlags(cmd)
+	cmd.Flags().StringVarP(&revoke.namespaceName, "namespace-name", "n", "", "Revoke the role granted on the namespace, instead of the one on the project")
+	return cmd
+}
+
+func (r *revokeCommand) RunE(_ *cobra.Command, args []string) error {
+	if args[0] == "" {
+		return errors.New("principal is required")
+	}
+
+	err := r.call(func(ctx context.Context, client pb.RoleBindingServiceClient) error {
+		_, err := client.RevokeRole(ctx, &pb.RevokeRoleRequest{
+			ProjectName:   r.projectName,
+			NamespaceName: r.namespaceName,
+			Principal:     args[0],
+		})
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("revoking role of %s failed: %w", args[0], err)
+	}
+	r.logger.Info("Revoked role of %s on project %s", args[0], r.projectName)
+	return nil
+}
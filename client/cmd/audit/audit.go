@@ -0,0 +1,192 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/goto/salt/log"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/goto/optimus/client/cmd/internal"
+	"github.com/goto/optimus/client/cmd/internal/connection"
+	"github.com/goto/optimus/client/cmd/internal/logger"
+	"github.com/goto/optimus/config"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+const auditTimeout = time.Minute
+
+type auditCommand struct {
+	logger     log.Logger
+	connection connection.Connection
+
+	configFilePath string
+
+	dirPath     string
+	host        string
+	projectName string
+
+	namespaceName string
+	actor         string
+	action        string
+	since         string
+	until         string
+	limit         int
+}
+
+// NewAuditCommand initializes command to query the audit logs of a project
+func NewAuditCommand() *cobra.Command {
+	audit := &auditCommand{
+		logger: logger.NewClientLogger(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Lists the mutating calls made on a project, like job deployments, secret updates and replays",
+		Example: "optimus audit --actor john.doe --action secret.set --since 24h\n" +
+			"optimus audit --namespace-name sample-namespace --since 2023-05-01T00:00:00Z --until 2023-05-02T00:00:00Z",
+		Annotations: map[string]string{
+			"group:core": "false",
+		},
+		PreRunE: audit.PreRunE,
+		RunE:    audit.RunE,
+	}
+
+	// Config filepath flag
+	cmd.Flags().StringVarP(&audit.configFilePath, "config", "c", config.EmptyPath, "File path for client configuration")
+	cmd.Flags().StringVar(&audit.dirPath, "dir", audit.dirPath, "Directory where the Optimus client config resides")
+
+	// Mandatory flags if config is not set
+	cmd.Flags().StringVar(&audit.host, "host", audit.host, "Targeted server host, by default taking from client config")
+	cmd.Flags().StringVar(&audit.projectName, "project-name", audit.projectName, "Targeted project name, by default taking from client config")
+
+	cmd.Flags().StringVarP(&audit.namespaceName, "namespace-name", "n", "", "Only the calls made on the namespace")
+	cmd.Flags().StringVar(&audit.actor, "actor", "", "Only the calls made by the actor, api tokens are named as api_token:<project>/<name>")
+	cmd.Flags().StringVar(&audit.action, "action", "", "Only the calls of the action, like job.deploy, secret.set or replay.create")
+	cmd.Flags().StringVar(&audit.since, "since", "", "Only the calls made since, as RFC3339 timestamp or duration before now, e.g. 24h")
+	cmd.Flags().StringVar(&audit.until, "until", "", "Only the calls made until, as RFC3339 timestamp or duration before now, e.g. 1h")
+	cmd.Flags().IntVar(&audit.limit, "limit", 0, "Maximum number of calls listed, the latest first")
+	return cmd
+}
+
+func (a *auditCommand) PreRunE(cmd *cobra.Command, _ []string) error {
+	if a.dirPath != "" {
+		a.configFilePath = path.Join(a.dirPath, config.DefaultFilename)
+	}
+	// Load config
+	conf, err := internal.LoadOptionalConfig(a.configFilePath)
+	if err != nil {
+		return err
+	}
+
+	if conf == nil {
+		internal.MarkFlagsRequired(cmd, []string{"project-name", "host"})
+		return nil
+	}
+
+	if a.projectName == "" {
+		a.projectName = conf.Project.Name
+	}
+	if a.host == "" {
+		a.host = conf.Host
+	}
+	a.connection = connection.New(a.logger, conf)
+	return nil
+}
+
+func (a *auditCommand) RunE(_ *cobra.Command, _ []string) error {
+	now := time.Now().UTC()
+	req := &pb.QueryAuditLogsRequest{
+		ProjectName:   a.projectName,
+		NamespaceName: a.namespaceName,
+		Actor:         a.actor,
+		Action:        a.action,
+		Limit:         int32(a.limit),
+	}
+	var err error
+	if req.Since, err = parseTime(a.since, now); err != nil {
+		return fmt.Errorf("invalid since: %w", err)
+	}
+	if req.Until, err = parseTime(a.until, now); err != nil {
+		return fmt.Errorf("invalid until: %w", err)
+	}
+
+	auditLogs, err := a.query(req)
+	if err != nil {
+		return fmt.Errorf("audit log request failed for project %s: %w", a.projectName, err)
+	}
+
+	if len(auditLogs) == 0 {
+		a.logger.Info("No audit log found in %s project.", a.projectName)
+		return nil
+	}
+	a.logger.Info(stringifyAuditLogs(auditLogs))
+	return nil
+}
+
+// parseTime accepts a RFC3339 timestamp or a duration before now, nil is returned for the empty value
+func parseTime(value string, now time.Time) (*timestamppb.Timestamp, error) {
+	if value == "" {
+		return nil, nil //nolint:nilnil
+	}
+	if duration, err := time.ParseDuration(value); err == nil {
+		return timestamppb.New(now.Add(-duration)), nil
+	}
+	at, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("%q is neither a RFC3339 timestamp nor a duration", value)
+	}
+	return timestamppb.New(at), nil
+}
+
+func (a *auditCommand) query(req *pb.QueryAuditLogsRequest) ([]*pb.AuditLog, error) {
+	conn, err := a.connection.Create(a.host)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	ctx, cancelFunc := context.WithTimeout(context.Background(), auditTimeout)
+	defer cancelFunc()
+
+	auditLogServiceClient := pb.NewAuditLogServiceClient(conn)
+	resp, err := auditLogServiceClient.Query(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetAuditLogs(), nil
+}
+
+func stringifyAuditLogs(auditLogs []*pb.AuditLog) string {
+	buff := &bytes.Buffer{}
+	table := tablewriter.NewWriter(buff)
+	table.SetBorder(false)
+	table.SetHeader([]string{
+		"Time",
+		"Actor",
+		"Namespace",
+		"Action",
+		"Result",
+		"Payload Digest",
+		"Message",
+	})
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	for _, auditLog := range auditLogs {
+		table.Append([]string{
+			auditLog.GetCreatedAt().AsTime().Format(time.RFC3339),
+			auditLog.GetActor(),
+			auditLog.GetNamespaceName(),
+			auditLog.GetAction(),
+			auditLog.GetResult(),
+			auditLog.GetPayloadDigest(),
+			auditLog.GetMessage(),
+		})
+	}
+	table.Render()
+	return buff.String()
+}
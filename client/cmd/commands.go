@@ -5,7 +5,10 @@ import (
 	"github.com/goto/salt/cmdx"
 	cli "github.com/spf13/cobra"
 
+	"github.com/goto/optimus/client/cmd/access"
+	"github.com/goto/optimus/client/cmd/audit"
 	"github.com/goto/optimus/client/cmd/backup"
+	"github.com/goto/optimus/client/cmd/context"
 	"github.com/goto/optimus/client/cmd/extension"
 	"github.com/goto/optimus/client/cmd/initialize"
 	"github.com/goto/optimus/client/cmd/job"
@@ -57,7 +60,10 @@ func New() *cli.Command {
 
 	// Client related commands
 	cmd.AddCommand(
+		access.NewAccessCommand(),
+		audit.NewAuditCommand(),
 		backup.NewBackupCommand(),
+		context.NewContextCommand(),
 		initialize.NewInitializeCommand(),
 		job.NewJobCommand(),
 		namespace.NewNamespaceCommand(),
@@ -0,0 +1,20 @@
+package context
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewContextCommand initializes command for client context
+func NewContextCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "context",
+		Short:   "Commands that will let the user to switch between server, project and namespace contexts",
+		Example: "optimus context [sub-command]",
+	}
+	cmd.AddCommand(
+		NewListCommand(),
+		NewCurrentCommand(),
+		NewUseCommand(),
+	)
+	return cmd
+}
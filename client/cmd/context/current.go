@@ -0,0 +1,47 @@
+package context
+
+import (
+	"github.com/goto/salt/log"
+	"github.com/spf13/cobra"
+
+	"github.com/goto/optimus/client/cmd/internal/logger"
+	"github.com/goto/optimus/config"
+)
+
+type currentCommand struct {
+	logger log.Logger
+
+	configFilePath string
+}
+
+// NewCurrentCommand initializes command for showing current context
+func NewCurrentCommand() *cobra.Command {
+	current := &currentCommand{
+		logger: logger.NewClientLogger(),
+	}
+	cmd := &cobra.Command{
+		Use:     "current",
+		Short:   "Shows the context currently used by client commands",
+		Example: "optimus context current [--config]",
+		RunE:    current.RunE,
+	}
+	cmd.Flags().StringVarP(&current.configFilePath, "config", "c", config.EmptyPath, "File path for client configuration")
+	return cmd
+}
+
+func (c *currentCommand) RunE(_ *cobra.Command, _ []string) error {
+	clientConfig, err := config.LoadClientConfig(c.configFilePath)
+	if err != nil {
+		return err
+	}
+	if clientConfig.CurrentContext == "" {
+		c.logger.Info("No context is in use, host [%s] and project [%s] are used", clientConfig.Host, clientConfig.Project.Name)
+		return nil
+	}
+
+	c.logger.Info("Current context: %s", clientConfig.CurrentContext)
+	c.logger.Info("host: %s", clientConfig.Host)
+	c.logger.Info("project: %s", clientConfig.Project.Name)
+	c.logger.Info("namespace: %s", clientConfig.DefaultNamespaceName())
+	return nil
+}
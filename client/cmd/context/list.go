@@ -0,0 +1,61 @@
+package context
+
+import (
+	"bytes"
+
+	"github.com/goto/salt/log"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+
+	"github.com/goto/optimus/client/cmd/internal/logger"
+	"github.com/goto/optimus/config"
+)
+
+type listCommand struct {
+	logger log.Logger
+
+	configFilePath string
+}
+
+// NewListCommand initializes command for listing contexts
+func NewListCommand() *cobra.Command {
+	list := &listCommand{
+		logger: logger.NewClientLogger(),
+	}
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "Lists contexts defined in client config",
+		Example: "optimus context list [--config]",
+		RunE:    list.RunE,
+	}
+	cmd.Flags().StringVarP(&list.configFilePath, "config", "c", config.EmptyPath, "File path for client configuration")
+	return cmd
+}
+
+func (l *listCommand) RunE(_ *cobra.Command, _ []string) error {
+	clientConfig, err := config.LoadClientConfigWithoutContext(l.configFilePath)
+	if err != nil {
+		return err
+	}
+	if len(clientConfig.Contexts) == 0 {
+		l.logger.Info("No context is defined in client config")
+		return nil
+	}
+
+	buff := &bytes.Buffer{}
+	table := tablewriter.NewWriter(buff)
+	table.SetHeader([]string{"current", "name", "host", "project", "namespace"})
+	for _, ctx := range clientConfig.Contexts {
+		if ctx == nil {
+			continue
+		}
+		current := ""
+		if ctx.Name == clientConfig.CurrentContext {
+			current = "*"
+		}
+		table.Append([]string{current, ctx.Name, ctx.Host, ctx.Project, ctx.Namespace})
+	}
+	table.Render()
+	l.logger.Info(buff.String())
+	return nil
+}
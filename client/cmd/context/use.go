@@ -0,0 +1,107 @@
+package context
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+
+	"github.com/goto/salt/log"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/goto/optimus/client/cmd/internal/logger"
+	"github.com/goto/optimus/config"
+)
+
+const currentContextKey = "current_context"
+
+type useCommand struct {
+	logger log.Logger
+
+	configFilePath string
+}
+
+// NewUseCommand initializes command for switching current context
+func NewUseCommand() *cobra.Command {
+	use := &useCommand{
+		logger: logger.NewClientLogger(),
+	}
+	cmd := &cobra.Command{
+		Use:     "use",
+		Short:   "Switches host, project, namespace and auth used by client commands to the ones of the context",
+		Example: "optimus context use <context_name> [--config]",
+		Args:    cobra.ExactArgs(1),
+		RunE:    use.RunE,
+	}
+	cmd.Flags().StringVarP(&use.configFilePath, "config", "c", config.EmptyPath, "File path for client configuration")
+	return cmd
+}
+
+func (u *useCommand) RunE(_ *cobra.Command, args []string) error {
+	contextName := args[0]
+
+	clientConfig, err := config.LoadClientConfigWithoutContext(u.configFilePath)
+	if err != nil {
+		return err
+	}
+	if _, err := clientConfig.GetContextByName(contextName); err != nil {
+		return err
+	}
+
+	filePath := u.configFilePath
+	if filePath == config.EmptyPath {
+		currPath, err := os.Getwd()
+		if err != nil {
+			return err
+		}
+		filePath = path.Join(currPath, config.DefaultFilename)
+	}
+	if err := setCurrentContext(filePath, contextName); err != nil {
+		return err
+	}
+
+	u.logger.Info("Switched to context [%s]", contextName)
+	return nil
+}
+
+// setCurrentContext updates current context in client config file, keeping the rest of the file as is
+func setCurrentContext(filePath, contextName string) error {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return err
+	}
+
+	var root yaml.Node
+	if err := yaml.Unmarshal(content, &root); err != nil {
+		return fmt.Errorf("error decoding client config [%s]: %w", filePath, err)
+	}
+	if len(root.Content) == 0 || root.Content[0].Kind != yaml.MappingNode {
+		return errors.New("client config is not a valid yaml mapping")
+	}
+
+	mapping := root.Content[0]
+	updated := false
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == currentContextKey {
+			mapping.Content[i+1].SetString(contextName)
+			updated = true
+			break
+		}
+	}
+	if !updated {
+		keyNode := &yaml.Node{}
+		keyNode.SetString(currentContextKey)
+		valueNode := &yaml.Node{}
+		valueNode.SetString(contextName)
+		mapping.Content = append(mapping.Content, keyNode, valueNode)
+	}
+
+	marshalled, err := yaml.Marshal(&root)
+	if err != nil {
+		return err
+	}
+	filePermission := 0o600
+	return os.WriteFile(filePath, marshalled, fs.FileMode(filePermission))
+}
@@ -0,0 +1,28 @@
+package connection
+
+import (
+	"context"
+	"os/user"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/goto/optimus/core/audit"
+)
+
+// actorUnaryInterceptor sends the user running the client as the actor of the call, which is recorded in the audit log
+func actorUnaryInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return invoker(withActor(ctx), method, req, reply, cc, opts...)
+}
+
+func actorStreamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return streamer(withActor(ctx), desc, cc, method, opts...)
+}
+
+func withActor(ctx context.Context) context.Context {
+	currentUser, err := user.Current()
+	if err != nil {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, audit.ActorMetadataKey, currentUser.Username)
+}
@@ -68,10 +68,12 @@ func defaultDialOptions() []grpc.DialOption {
 			grpc_retry.UnaryClientInterceptor(retryOpts...),
 			otelgrpc.UnaryClientInterceptor(),
 			grpc_prometheus.UnaryClientInterceptor,
+			actorUnaryInterceptor,
 		)),
 		grpc.WithStreamInterceptor(grpc_middleware.ChainStreamClient(
 			otelgrpc.StreamClientInterceptor(),
 			grpc_prometheus.StreamClientInterceptor,
+			actorStreamInterceptor,
 		)),
 		grpc.WithKeepaliveParams(keepalive.ClientParameters{
 			Time:                time.Minute,     // send pings every 1 Minute if there is no activity
@@ -48,8 +48,8 @@ func (s *Secure) Create(host string) (*grpc.ClientConn, error) {
 }
 
 func (s *Secure) getOptionsWithAuth() ([]grpc.DialOption, error) {
-	if s.authConfig.ClientID == "" || s.authConfig.ClientSecret == "" {
-		return nil, errors.New("invalid auth configuration, clientID or clientSecret is empty")
+	if s.authConfig.Token == "" && (s.authConfig.ClientID == "" || s.authConfig.ClientSecret == "") {
+		return nil, errors.New("invalid auth configuration, token or clientID and clientSecret should be provided")
 	}
 
 	// setup https connection
@@ -60,6 +60,14 @@ func (s *Secure) getOptionsWithAuth() ([]grpc.DialOption, error) {
 
 	opts := append(defaultDialOptions(), grpc.WithTransportCredentials(tlsCredentials))
 
+	// api token is used as it is, without exchanging the client credentials
+	if s.authConfig.Token != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(&bearerAuthentication{
+			Token: s.authConfig.Token,
+		}))
+		return opts, nil
+	}
+
 	// add the token for authentication
 	a := auth.NewAuth(s.l, s.authConfig)
 
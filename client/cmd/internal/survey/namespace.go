@@ -34,6 +34,10 @@ func (n *NamespaceSurvey) AskToSelectNamespace(clientConfig *config.ClientConfig
 		Message: "Please choose the namespace:",
 		Options: options,
 	}
+	// preselect the namespace of current context, if it is one of the options
+	if defaultNamespace, err := clientConfig.GetNamespaceByName(clientConfig.DefaultNamespaceName()); err == nil {
+		prompt.Default = defaultNamespace.Name
+	}
 	for {
 		var response string
 		if err := survey.AskOne(prompt, &response); err != nil {
@@ -0,0 +1,44 @@
+package job
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/goto/salt/log"
+
+	"github.com/goto/optimus/config"
+)
+
+const allProjectsFlagUsage = "Run on all namespaces of every project listed in client configuration"
+
+// runForAllProjects runs the operation of every project concurrently and reports a summary once all of them finished
+func runForAllProjects(l log.Logger, projectConfs []*config.ClientConfig, operation func(*config.ClientConfig) error) error {
+	errs := make([]error, len(projectConfs))
+
+	var wg sync.WaitGroup
+	for i, projectConf := range projectConfs {
+		wg.Add(1)
+		go func(i int, projectConf *config.ClientConfig) {
+			defer wg.Done()
+			errs[i] = operation(projectConf)
+		}(i, projectConf)
+	}
+	wg.Wait()
+
+	var failedProjects []string
+	l.Info("Summary for %d projects:", len(projectConfs))
+	for i, projectConf := range projectConfs {
+		if errs[i] != nil {
+			l.Error("[failed] %s: %s", projectConf.Project.Name, errs[i])
+			failedProjects = append(failedProjects, projectConf.Project.Name)
+			continue
+		}
+		l.Info("[OK] %s", projectConf.Project.Name)
+	}
+
+	if len(failedProjects) > 0 {
+		return fmt.Errorf("failed for projects [%s]", strings.Join(failedProjects, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/goto/salt/log"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/goto/optimus/client/cmd/internal"
+	"github.com/goto/optimus/client/cmd/internal/connection"
+	"github.com/goto/optimus/client/cmd/internal/logger"
+	"github.com/goto/optimus/config"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+const criticalPathTimeout = time.Minute
+
+type criticalPathCommand struct {
+	logger         log.Logger
+	connection     connection.Connection
+	configFilePath string
+
+	projectName string
+	host        string
+	scheduledAt string
+}
+
+// NewCriticalPathCommand initializes command to show the upstream runs which gated the completion of a job run
+func NewCriticalPathCommand() *cobra.Command {
+	pathCommand := &criticalPathCommand{
+		logger: logger.NewClientLogger(),
+	}
+
+	cmd := &cobra.Command{
+		Use:     "critical-path",
+		Short:   "Show the chain of upstream runs which gated the completion of a job run",
+		Long:    "Walk up from a job run through the upstream run which finished last, for as long as it kept the run waiting past its schedule.",
+		Example: "optimus job critical-path <job_name> --scheduled-at 2023-10-10T10:00:00Z",
+		Args:    cobra.ExactArgs(1),
+		RunE:    pathCommand.RunE,
+		PreRunE: pathCommand.PreRunE,
+	}
+	pathCommand.injectFlags(cmd)
+	return cmd
+}
+
+func (r *criticalPathCommand) injectFlags(cmd *cobra.Command) {
+	// Config filepath flag
+	cmd.Flags().StringVarP(&r.configFilePath, "config", "c", config.EmptyPath, "File path for client configuration")
+
+	cmd.Flags().StringVar(&r.scheduledAt, "scheduled-at", "", "Scheduled time of the run, in RFC3339 format")
+	cmd.MarkFlagRequired("scheduled-at")
+
+	// Mandatory flags if config is not set
+	cmd.Flags().StringVarP(&r.projectName, "project-name", "p", "", "Name of the optimus project")
+	cmd.Flags().StringVar(&r.host, "host", "", "Optimus service endpoint url")
+}
+
+func (r *criticalPathCommand) PreRunE(cmd *cobra.Command, _ []string) error {
+	// Load config
+	conf, err := internal.LoadOptionalConfig(r.configFilePath)
+	if err != nil {
+		return err
+	}
+
+	if conf == nil {
+		internal.MarkFlagsRequired(cmd, []string{"project-name", "host"})
+		r.connection = connection.NewInsecure(r.logger)
+		return nil
+	}
+
+	if r.projectName == "" {
+		r.projectName = conf.Project.Name
+	}
+	if r.host == "" {
+		r.host = conf.Host
+	}
+	r.connection = connection.New(r.logger, conf)
+	return nil
+}
+
+func (r *criticalPathCommand) RunE(_ *cobra.Command, args []string) error {
+	scheduledAt, err := time.Parse(time.RFC3339, r.scheduledAt)
+	if err != nil {
+		return fmt.Errorf("invalid scheduled-at %s, expected RFC3339 format", r.scheduledAt)
+	}
+
+	path, err := r.getCriticalPath(args[0], scheduledAt)
+	if err != nil {
+		return err
+	}
+
+	if len(path.GetRuns()) == 1 {
+		r.logger.Info("Run of %s was not kept waiting by its upstreams", args[0])
+		return nil
+	}
+	r.logger.Info("Critical path of %s, taking %s:", args[0], path.GetDuration().AsDuration())
+	for _, run := range path.GetRuns() {
+		if run.GetDuration() == nil {
+			r.logger.Info("  %s/%s scheduled at %s, waited %s, is %s", run.GetProjectName(), run.GetJobName(),
+				formatTimestamp(run.GetScheduledAt()), run.GetWait().AsDuration(), run.GetState())
+			continue
+		}
+		r.logger.Info("  %s/%s scheduled at %s, waited %s, took %s", run.GetProjectName(), run.GetJobName(),
+			formatTimestamp(run.GetScheduledAt()), run.GetWait().AsDuration(), run.GetDuration().AsDuration())
+	}
+	return nil
+}
+
+func (r *criticalPathCommand) getCriticalPath(jobName string, scheduledAt time.Time) (*pb.GetJobRunCriticalPathResponse, error) {
+	conn, err := r.connection.Create(r.host)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	ctx, cancelFunc := context.WithTimeout(context.Background(), criticalPathTimeout)
+	defer cancelFunc()
+
+	jobRunServiceClient := pb.NewJobRunServiceClient(conn)
+	return jobRunServiceClient.GetJobRunCriticalPath(ctx, &pb.GetJobRunCriticalPathRequest{
+		ProjectName: r.projectName,
+		JobName:     jobName,
+		ScheduledAt: timestamppb.New(scheduledAt),
+	})
+}
@@ -0,0 +1,131 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/goto/salt/log"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/goto/optimus/client/cmd/internal"
+	"github.com/goto/optimus/client/cmd/internal/connection"
+	"github.com/goto/optimus/client/cmd/internal/logger"
+	"github.com/goto/optimus/config"
+	"github.com/goto/optimus/core/job"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+const deleteTimeout = time.Minute * 5
+
+type deleteCommand struct {
+	logger         log.Logger
+	connection     connection.Connection
+	configFilePath string
+
+	projectName   string
+	namespaceName string
+	host          string
+	force         bool
+	cleanHistory  bool
+	requestedBy   string
+	reason        string
+}
+
+// NewDeleteCommand initializes command to delete jobs of a namespace at once
+func NewDeleteCommand() *cobra.Command {
+	deleteCmd := &deleteCommand{
+		logger: logger.NewClientLogger(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete jobs of a namespace at once, refusing when other jobs depend on them unless forced",
+		Example: "optimus job delete <job_name> [<job_name>...] --namespace sample " +
+			"--requested-by user@example.com --reason \"tables are deprecated\"",
+		Args:    cobra.MinimumNArgs(1),
+		RunE:    deleteCmd.RunE,
+		PreRunE: deleteCmd.PreRunE,
+	}
+	deleteCmd.injectFlags(cmd)
+	return cmd
+}
+
+func (d *deleteCommand) injectFlags(cmd *cobra.Command) {
+	// Config filepath flag
+	cmd.Flags().StringVarP(&d.configFilePath, "config", "c", config.EmptyPath, "File path for client configuration")
+
+	cmd.Flags().StringVarP(&d.namespaceName, "namespace", "n", "", "Namespace of the jobs, the one of current context by default")
+	cmd.Flags().BoolVar(&d.force, "force", false, "Delete the jobs even if other jobs depend on them")
+	cmd.Flags().BoolVar(&d.cleanHistory, "clean-history", false, "Delete the stored history of the jobs as well")
+	cmd.Flags().StringVar(&d.requestedBy, "requested-by", "", "Who requested the deletion, recorded in the deletion audit")
+	cmd.Flags().StringVar(&d.reason, "reason", "", "Reason of the deletion, recorded in the deletion audit")
+
+	// Mandatory flags if config is not set
+	cmd.Flags().StringVarP(&d.projectName, "project-name", "p", "", "Name of the optimus project")
+	cmd.Flags().StringVar(&d.host, "host", "", "Optimus service endpoint url")
+}
+
+func (d *deleteCommand) PreRunE(cmd *cobra.Command, _ []string) error {
+	// Load config
+	conf, err := internal.LoadOptionalConfig(d.configFilePath)
+	if err != nil {
+		return err
+	}
+
+	if conf == nil {
+		internal.MarkFlagsRequired(cmd, []string{"project-name", "host", "namespace"})
+		return nil
+	}
+
+	if d.projectName == "" {
+		d.projectName = conf.Project.Name
+	}
+	if d.host == "" {
+		d.host = conf.Host
+	}
+	if d.namespaceName == "" {
+		d.namespaceName = conf.DefaultNamespaceName()
+	}
+	if d.namespaceName == "" {
+		internal.MarkFlagsRequired(cmd, []string{"namespace"})
+	}
+	d.connection = connection.New(d.logger, conf)
+	return nil
+}
+
+func (d *deleteCommand) RunE(_ *cobra.Command, args []string) error {
+	conn, err := d.connection.Create(d.host)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ctx, cancelFunc := context.WithTimeout(context.Background(), deleteTimeout)
+	defer cancelFunc()
+
+	// the requester and the reason are recorded in the deletion audit of the jobs with downstream
+	ctx = metadata.AppendToOutgoingContext(ctx,
+		job.DeleteRequestedByMetadataKey, d.requestedBy,
+		job.DeleteReasonMetadataKey, d.reason,
+	)
+
+	jobSpecificationServiceClient := pb.NewJobSpecificationServiceClient(conn)
+	deleted, err := jobSpecificationServiceClient.DeleteJobs(ctx, &pb.DeleteJobsRequest{
+		ProjectName:   d.projectName,
+		NamespaceName: d.namespaceName,
+		JobNames:      args,
+		CleanHistory:  d.cleanHistory,
+		Force:         d.force,
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(deleted.GetAffectedDownstream()) > 0 {
+		d.logger.Warn(deleted.GetMessage())
+		return nil
+	}
+	d.logger.Info(deleted.GetMessage())
+	return nil
+}
@@ -0,0 +1,133 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/goto/salt/log"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+
+	"github.com/goto/optimus/client/cmd/internal/connection"
+	"github.com/goto/optimus/client/cmd/internal/logger"
+	"github.com/goto/optimus/client/local/model"
+	"github.com/goto/optimus/client/local/specio"
+	"github.com/goto/optimus/config"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+const formatTimeout = time.Minute * 2
+
+type fmtCommand struct {
+	logger     log.Logger
+	connection *connection.Insecure
+
+	configFilePath string
+	clientConfig   *config.ClientConfig
+
+	namespaceName string
+	check         bool
+}
+
+// NewFmtCommand initializes command for formatting job specification
+func NewFmtCommand() *cobra.Command {
+	format := &fmtCommand{
+		logger: logger.NewClientLogger(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "fmt",
+		Short: "Format job specifications into canonical form",
+		Long:  "Rewrite job specifications with the field order and format used by the optimus server, so diffs on specs stay stable",
+		Example: `optimus job fmt
+optimus job fmt --namespace sample_namespace --check`,
+		RunE:    format.RunE,
+		PreRunE: format.PreRunE,
+	}
+	cmd.Flags().StringVarP(&format.configFilePath, "config", "c", config.EmptyPath, "File path for client configuration")
+
+	cmd.Flags().StringVarP(&format.namespaceName, "namespace", "n", "", "Namespace of the jobs to be formatted, all namespaces when empty")
+	cmd.Flags().BoolVar(&format.check, "check", false, "Only list unformatted specs and fail if any, without rewriting them")
+	return cmd
+}
+
+func (f *fmtCommand) PreRunE(_ *cobra.Command, _ []string) error {
+	conf, err := config.LoadClientConfig(f.configFilePath)
+	if err != nil {
+		return err
+	}
+	f.clientConfig = conf
+
+	f.connection = connection.NewInsecure(f.logger)
+	return nil
+}
+
+func (f *fmtCommand) RunE(_ *cobra.Command, _ []string) error {
+	namespaces := f.clientConfig.Namespaces
+	if f.namespaceName != "" {
+		namespace, err := f.clientConfig.GetNamespaceByName(f.namespaceName)
+		if err != nil {
+			return err
+		}
+		namespaces = []*config.Namespace{namespace}
+	}
+
+	var unformattedCount int
+	for _, namespace := range namespaces {
+		if namespace.Job.Path == "" {
+			continue
+		}
+		filePaths, err := specio.FormatJobSpecs(afero.NewOsFs(), namespace.Job.Path, f.normalizer(namespace.Name), !f.check)
+		if err != nil {
+			return fmt.Errorf("directory '%s': %w", namespace.Job.Path, err)
+		}
+		for _, filePath := range filePaths {
+			f.logger.Info(filePath)
+		}
+		unformattedCount += len(filePaths)
+	}
+
+	if f.check && unformattedCount > 0 {
+		return fmt.Errorf("found %d job specs which are not formatted", unformattedCount)
+	}
+	if !f.check {
+		f.logger.Info("Formatted %d job specs", unformattedCount)
+	}
+	return nil
+}
+
+// normalizer returns the normalizer of the specs of the namespace, which has the specs read by the server
+func (f *fmtCommand) normalizer(namespaceName string) specio.JobSpecNormalizer {
+	return func(specs []*model.JobSpec) ([]*model.JobSpec, error) {
+		conn, err := f.connection.Create(f.clientConfig.Host)
+		if err != nil {
+			return nil, err
+		}
+		defer conn.Close()
+
+		jobSpecsProto := make([]*pb.JobSpecification, len(specs))
+		for i, spec := range specs {
+			jobSpecsProto[i] = spec.ToProto()
+		}
+
+		ctx, cancelFunc := context.WithTimeout(context.Background(), formatTimeout)
+		defer cancelFunc()
+
+		jobSpecService := pb.NewJobSpecificationServiceClient(conn)
+		response, err := jobSpecService.FormatJobSpecifications(ctx, &pb.FormatJobSpecificationsRequest{
+			ProjectName:   f.clientConfig.Project.Name,
+			NamespaceName: namespaceName,
+			Jobs:          jobSpecsProto,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("format request failed: %w", err)
+		}
+
+		formattedSpecs := make([]*model.JobSpec, len(response.GetJobs()))
+		for i, jobProto := range response.GetJobs() {
+			formattedSpecs[i] = model.ToJobSpec(jobProto)
+		}
+		return formattedSpecs, nil
+	}
+}
@@ -0,0 +1,136 @@
+package job
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/goto/salt/log"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+
+	"github.com/goto/optimus/client/cmd/internal"
+	"github.com/goto/optimus/client/cmd/internal/connection"
+	"github.com/goto/optimus/client/cmd/internal/logger"
+	"github.com/goto/optimus/config"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+const historyTimeout = time.Minute
+
+type historyCommand struct {
+	logger         log.Logger
+	connection     connection.Connection
+	configFilePath string
+
+	projectName string
+	host        string
+}
+
+// NewHistoryCommand initializes command to list the deployed versions of a job spec
+func NewHistoryCommand() *cobra.Command {
+	history := &historyCommand{
+		logger: logger.NewClientLogger(),
+	}
+
+	cmd := &cobra.Command{
+		Use:     "history",
+		Short:   "List the deployed versions of a job specification, the latest first",
+		Example: "optimus job history <job_name>",
+		Args:    cobra.ExactArgs(1),
+		RunE:    history.RunE,
+		PreRunE: history.PreRunE,
+	}
+	// Config filepath flag
+	cmd.Flags().StringVarP(&history.configFilePath, "config", "c", config.EmptyPath, "File path for client configuration")
+
+	// Mandatory flags if config is not set
+	cmd.Flags().StringVarP(&history.projectName, "project-name", "p", "", "Name of the optimus project")
+	cmd.Flags().StringVar(&history.host, "host", "", "Optimus service endpoint url")
+	return cmd
+}
+
+func (h *historyCommand) PreRunE(cmd *cobra.Command, _ []string) error {
+	// Load config
+	conf, err := internal.LoadOptionalConfig(h.configFilePath)
+	if err != nil {
+		return err
+	}
+
+	if conf == nil {
+		internal.MarkFlagsRequired(cmd, []string{"project-name", "host"})
+		return nil
+	}
+
+	if h.projectName == "" {
+		h.projectName = conf.Project.Name
+	}
+	if h.host == "" {
+		h.host = conf.Host
+	}
+	h.connection = connection.New(h.logger, conf)
+	return nil
+}
+
+func (h *historyCommand) RunE(_ *cobra.Command, args []string) error {
+	jobName := args[0]
+	versions, err := h.getSpecVersions(jobName)
+	if err != nil {
+		return fmt.Errorf("history request failed for job %s: %w", jobName, err)
+	}
+
+	if len(versions) == 0 {
+		h.logger.Info("No deployed version found for job %s", jobName)
+		return nil
+	}
+	h.logger.Info(stringifySpecVersions(versions))
+	return nil
+}
+
+func (h *historyCommand) getSpecVersions(jobName string) ([]*pb.JobSpecVersion, error) {
+	conn, err := h.connection.Create(h.host)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	ctx, cancelFunc := context.WithTimeout(context.Background(), historyTimeout)
+	defer cancelFunc()
+
+	jobSpecificationServiceClient := pb.NewJobSpecificationServiceClient(conn)
+	resp, err := jobSpecificationServiceClient.GetJobSpecVersions(ctx, &pb.GetJobSpecVersionsRequest{
+		ProjectName: h.projectName,
+		JobName:     jobName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetVersions(), nil
+}
+
+func stringifySpecVersions(versions []*pb.JobSpecVersion) string {
+	buff := &bytes.Buffer{}
+	table := tablewriter.NewWriter(buff)
+	table.SetBorder(false)
+	table.SetHeader([]string{
+		"Version",
+		"Owner",
+		"Task",
+		"Interval",
+		"Deployed At",
+	})
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	for _, version := range versions {
+		table.Append([]string{
+			strconv.Itoa(int(version.GetVersion())),
+			version.GetOwner(),
+			version.GetTaskName(),
+			version.GetInterval(),
+			formatTimestamp(version.GetCreatedAt()),
+		})
+	}
+	table.Render()
+	return buff.String()
+}
@@ -25,6 +25,18 @@ func NewJobCommand() *cobra.Command {
 		NewExportCommand(),
 		NewJobRunInputCommand(),
 		NewChangeNamespaceCommand(),
+		NewFmtCommand(),
+		NewRecommendScheduleCommand(),
+		NewRunDetailCommand(),
+		NewCriticalPathCommand(),
+		NewRunNowCommand(),
+		NewPlanCommand(),
+		NewLineageCommand(),
+		NewDeleteCommand(),
+		NewRenameCommand(),
+		NewMoveCommand(),
+		NewHistoryCommand(),
+		NewRollbackCommand(),
 	)
 	return cmd
 }
@@ -0,0 +1,151 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/goto/salt/log"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/goto/optimus/client/cmd/internal"
+	"github.com/goto/optimus/client/cmd/internal/connection"
+	"github.com/goto/optimus/client/cmd/internal/logger"
+	"github.com/goto/optimus/config"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+const (
+	lineageTimeout = time.Minute
+
+	lineageFormatDOT  = "dot"
+	lineageFormatJSON = "json"
+)
+
+type lineageCommand struct {
+	logger         log.Logger
+	connection     connection.Connection
+	configFilePath string
+
+	projectName string
+	host        string
+	depth       int
+	format      string
+}
+
+// NewLineageCommand initializes command to render the lineage of a job
+func NewLineageCommand() *cobra.Command {
+	lineage := &lineageCommand{
+		logger: logger.NewClientLogger(),
+	}
+
+	cmd := &cobra.Command{
+		Use:     "lineage",
+		Short:   "Render the lineage of jobs and resources around the destination of the job",
+		Long:    "Walk the sources and destinations of the deployed jobs around the destination of the job, and render them as DOT or JSON.",
+		Example: "optimus job lineage <job_name> [--depth 3] [--format dot|json] > lineage.dot",
+		Args:    cobra.ExactArgs(1),
+		RunE:    lineage.RunE,
+		PreRunE: lineage.PreRunE,
+	}
+	lineage.injectFlags(cmd)
+	return cmd
+}
+
+func (l *lineageCommand) injectFlags(cmd *cobra.Command) {
+	// Config filepath flag
+	cmd.Flags().StringVarP(&l.configFilePath, "config", "c", config.EmptyPath, "File path for client configuration")
+
+	cmd.Flags().IntVar(&l.depth, "depth", 3, "Number of jobs to walk upstream and downstream")
+	cmd.Flags().StringVar(&l.format, "format", lineageFormatDOT, "Output format, either dot or json")
+
+	// Mandatory flags if config is not set
+	cmd.Flags().StringVarP(&l.projectName, "project-name", "p", "", "Name of the optimus project")
+	cmd.Flags().StringVar(&l.host, "host", "", "Optimus service endpoint url")
+}
+
+func (l *lineageCommand) PreRunE(cmd *cobra.Command, _ []string) error {
+	if l.format != lineageFormatDOT && l.format != lineageFormatJSON {
+		return fmt.Errorf("format should be either %s or %s", lineageFormatDOT, lineageFormatJSON)
+	}
+
+	// Load config
+	conf, err := internal.LoadOptionalConfig(l.configFilePath)
+	if err != nil {
+		return err
+	}
+
+	if conf == nil {
+		internal.MarkFlagsRequired(cmd, []string{"project-name", "host"})
+		return nil
+	}
+
+	if l.projectName == "" {
+		l.projectName = conf.Project.Name
+	}
+	if l.host == "" {
+		l.host = conf.Host
+	}
+	l.connection = connection.New(l.logger, conf)
+	return nil
+}
+
+func (l *lineageCommand) RunE(cmd *cobra.Command, args []string) error {
+	graph, err := l.getLineage(args[0])
+	if err != nil {
+		return err
+	}
+
+	if l.format == lineageFormatJSON {
+		body, err := protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(graph)
+		if err != nil {
+			return err
+		}
+		_, err = cmd.OutOrStdout().Write(append(body, '\n'))
+		return err
+	}
+
+	_, err = io.WriteString(cmd.OutOrStdout(), toDOT(graph))
+	return err
+}
+
+// toDOT renders the jobs as boxes and the resources as ellipses, with the edges following the data
+func toDOT(graph *pb.GetLineageResponse) string {
+	var sb strings.Builder
+	sb.WriteString("digraph lineage {\n")
+	sb.WriteString("  rankdir=LR;\n")
+	for _, node := range graph.GetNodes() {
+		shape := "ellipse"
+		if node.GetType() == "job" {
+			shape = "box"
+		}
+		fmt.Fprintf(&sb, "  %s [label=%s, shape=%s];\n", strconv.Quote(node.GetId()), strconv.Quote(node.GetName()), shape)
+	}
+	for _, edge := range graph.GetEdges() {
+		fmt.Fprintf(&sb, "  %s -> %s;\n", strconv.Quote(edge.GetFrom()), strconv.Quote(edge.GetTo()))
+	}
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+func (l *lineageCommand) getLineage(jobName string) (*pb.GetLineageResponse, error) {
+	conn, err := l.connection.Create(l.host)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	ctx, cancelFunc := context.WithTimeout(context.Background(), lineageTimeout)
+	defer cancelFunc()
+
+	lineageServiceClient := pb.NewLineageServiceClient(conn)
+	return lineageServiceClient.GetLineage(ctx, &pb.GetLineageRequest{
+		ProjectName: l.projectName,
+		JobName:     jobName,
+		Depth:       int32(l.depth),
+	})
+}
@@ -0,0 +1,100 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/goto/optimus/client/cmd/internal"
+	"github.com/goto/optimus/client/cmd/internal/logger"
+	"github.com/goto/optimus/config"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+const moveTimeout = time.Minute * 5
+
+// moveCommand moves the job through the server, which checks the target namespace has what the job refers to,
+// and moves the local job specification the way change-namespace does
+type moveCommand struct {
+	*changeNamespaceCommand
+}
+
+// NewMoveCommand initializes command to move a job to another namespace of the project
+func NewMoveCommand() *cobra.Command {
+	move := &moveCommand{
+		changeNamespaceCommand: &changeNamespaceCommand{
+			logger: logger.NewClientLogger(),
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:      "move",
+		Short:    "Move a job to another namespace of the project, checking the secrets and configs it refers to are available there",
+		Example:  "optimus job move <job_name> --namespace <namespace> --to-namespace <target-namespace>",
+		Args:     cobra.ExactArgs(1),
+		PreRunE:  move.PreRunE,
+		RunE:     move.RunE,
+		PostRunE: move.PostRunE,
+	}
+	// Config filepath flag
+	cmd.Flags().StringVarP(&move.configFilePath, "config", "c", config.EmptyPath, "File path for client configuration")
+
+	cmd.Flags().StringVarP(&move.oldNamespaceName, "namespace", "n", "", "Current namespace of the job, the one of current context by default")
+	cmd.Flags().StringVar(&move.newNamespaceName, "to-namespace", "", "Namespace to move the job to")
+	cmd.MarkFlagRequired("to-namespace")
+
+	cmd.Flags().StringVarP(&move.project, "project-name", "p", "", "Name of the optimus project")
+	cmd.Flags().StringVar(&move.host, "host", "", "Optimus service endpoint url")
+	return cmd
+}
+
+func (m *moveCommand) PreRunE(cmd *cobra.Command, args []string) error {
+	if err := m.changeNamespaceCommand.PreRunE(cmd, args); err != nil {
+		return err
+	}
+	if m.project == "" {
+		m.project = m.clientConfig.Project.Name
+	}
+	if m.host == "" {
+		m.host = m.clientConfig.Host
+	}
+	if m.oldNamespaceName == "" {
+		m.oldNamespaceName = m.clientConfig.DefaultNamespaceName()
+	}
+	if m.oldNamespaceName == "" {
+		internal.MarkFlagsRequired(cmd, []string{"namespace"})
+	}
+	return nil
+}
+
+func (m *moveCommand) RunE(_ *cobra.Command, args []string) error {
+	jobName := args[0]
+	if err := m.sendMoveRequest(jobName); err != nil {
+		return fmt.Errorf("move request failed for job %s: %w", jobName, err)
+	}
+	m.logger.Info("[OK] Successfully moved job %s to namespace %s and deployed it on Scheduler", jobName, m.newNamespaceName)
+	return nil
+}
+
+func (m *moveCommand) sendMoveRequest(jobName string) error {
+	conn, err := m.connection.Create(m.host)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	jobSpecificationServiceClient := pb.NewJobSpecificationServiceClient(conn)
+
+	ctx, cancelFunc := context.WithTimeout(context.Background(), moveTimeout)
+	defer cancelFunc()
+
+	_, err = jobSpecificationServiceClient.MoveJob(ctx, &pb.MoveJobRequest{
+		ProjectName:      m.project,
+		NamespaceName:    m.oldNamespaceName,
+		NewNamespaceName: m.newNamespaceName,
+		JobName:          jobName,
+	})
+	return err
+}
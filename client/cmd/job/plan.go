@@ -0,0 +1,175 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/goto/salt/log"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+
+	"github.com/goto/optimus/client/cmd/internal/connection"
+	"github.com/goto/optimus/client/cmd/internal/logger"
+	"github.com/goto/optimus/client/local/model"
+	"github.com/goto/optimus/client/local/specio"
+	"github.com/goto/optimus/config"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+const planTimeout = time.Minute * 15
+
+var planSymbols = map[model.JobPlanAction]string{
+	model.JobPlanCreate: "+",
+	model.JobPlanUpdate: "~",
+	model.JobPlanDelete: "-",
+	model.JobPlanNoOp:   " ",
+}
+
+type planCommand struct {
+	logger     log.Logger
+	connection *connection.Insecure
+
+	configFilePath string
+	clientConfig   *config.ClientConfig
+
+	namespaceName string
+	verbose       bool
+	allProjects   bool
+}
+
+// NewPlanCommand initializes command to preview the changes a deployment makes to the jobs of a namespace
+func NewPlanCommand() *cobra.Command {
+	plan := &planCommand{
+		logger: logger.NewClientLogger(),
+	}
+
+	cmd := &cobra.Command{
+		Use:     "plan",
+		Short:   "Compare the local job specifications with the server, printing the jobs deploying them creates, updates and deletes",
+		Example: "optimus job plan --namespace sample\noptimus job plan --all-projects",
+		RunE:    plan.RunE,
+		PreRunE: plan.PreRunE,
+	}
+	// Config filepath flag
+	cmd.Flags().StringVarP(&plan.configFilePath, "config", "c", config.EmptyPath, "File path for client configuration")
+
+	cmd.Flags().StringVarP(&plan.namespaceName, "namespace", "n", plan.namespaceName, "Namespace of the jobs within project")
+	cmd.Flags().BoolVarP(&plan.verbose, "verbose", "v", false, "Print the unchanged jobs as well")
+	cmd.Flags().BoolVar(&plan.allProjects, "all-projects", false, allProjectsFlagUsage)
+	return cmd
+}
+
+func (p *planCommand) PreRunE(_ *cobra.Command, _ []string) error {
+	conf, err := config.LoadClientConfig(p.configFilePath)
+	if err != nil {
+		return err
+	}
+	p.clientConfig = conf
+
+	if !p.allProjects && p.namespaceName == "" {
+		p.namespaceName = conf.DefaultNamespaceName()
+	}
+	if !p.allProjects && p.namespaceName == "" {
+		return errors.New(`required flag(s) "namespace" not set`)
+	}
+
+	p.connection = connection.NewInsecure(p.logger)
+	return nil
+}
+
+func (p *planCommand) RunE(_ *cobra.Command, _ []string) error {
+	if p.allProjects {
+		return p.planAllProjects()
+	}
+	return p.planNamespace()
+}
+
+func (p *planCommand) planAllProjects() error {
+	projectConfs, err := config.LoadProjectClientConfigs(p.clientConfig)
+	if err != nil {
+		return err
+	}
+
+	return runForAllProjects(p.logger, projectConfs, func(projectConf *config.ClientConfig) error {
+		for _, namespace := range projectConf.Namespaces {
+			projectPlan := *p
+			projectPlan.clientConfig = projectConf
+			projectPlan.namespaceName = namespace.Name
+			if err := projectPlan.planNamespace(); err != nil {
+				return fmt.Errorf("namespace [%s]: %w", namespace.Name, err)
+			}
+		}
+		return nil
+	})
+}
+
+func (p *planCommand) planNamespace() error {
+	namespace, err := p.clientConfig.GetNamespaceByName(p.namespaceName)
+	if err != nil {
+		return err
+	}
+
+	jobSpecReadWriter, err := specio.NewJobSpecReadWriter(afero.NewOsFs(), specio.WithJobSpecParentReading())
+	if err != nil {
+		return err
+	}
+	localSpecs, err := jobSpecReadWriter.ReadAll(namespace.Job.Path)
+	if err != nil {
+		return fmt.Errorf("directory '%s': %w", namespace.Job.Path, err)
+	}
+
+	serverSpecs, err := p.fetchServerSpecs(namespace.Name)
+	if err != nil {
+		return err
+	}
+
+	plans := model.PlanJobs(localSpecs, serverSpecs)
+	p.printPlans(namespace.Name, plans)
+	return nil
+}
+
+func (p *planCommand) fetchServerSpecs(namespaceName string) ([]*model.JobSpec, error) {
+	conn, err := p.connection.Create(p.clientConfig.Host)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	jobSpecificationServiceClient := pb.NewJobSpecificationServiceClient(conn)
+
+	ctx, cancelFunc := context.WithTimeout(context.Background(), planTimeout)
+	defer cancelFunc()
+
+	response, err := jobSpecificationServiceClient.GetJobSpecifications(ctx, &pb.GetJobSpecificationsRequest{
+		ProjectName:   p.clientConfig.Project.Name,
+		NamespaceName: namespaceName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	specs := make([]*model.JobSpec, len(response.JobSpecificationResponses))
+	for i, jobProto := range response.JobSpecificationResponses {
+		specs[i] = model.ToJobSpec(jobProto.Job)
+	}
+	return specs, nil
+}
+
+func (p *planCommand) printPlans(namespaceName string, plans []*model.JobPlan) {
+	counts := map[model.JobPlanAction]int{}
+	p.logger.Info("Plan for project [%s] namespace [%s]:", p.clientConfig.Project.Name, namespaceName)
+	for _, plan := range plans {
+		counts[plan.Action]++
+		if plan.Action == model.JobPlanNoOp && !p.verbose {
+			continue
+		}
+		p.logger.Info("  %s %s (%s)", planSymbols[plan.Action], plan.Name, plan.Action)
+		for _, change := range plan.Changes {
+			p.logger.Info("      %s", change)
+		}
+	}
+	p.logger.Info("Plan: %d to create, %d to update, %d to delete, %d unchanged",
+		counts[model.JobPlanCreate], counts[model.JobPlanUpdate], counts[model.JobPlanDelete], counts[model.JobPlanNoOp])
+}
@@ -0,0 +1,157 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/goto/salt/log"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+
+	"github.com/goto/optimus/client/cmd/internal"
+	"github.com/goto/optimus/client/cmd/internal/connection"
+	"github.com/goto/optimus/client/cmd/internal/logger"
+	"github.com/goto/optimus/client/local/specio"
+	"github.com/goto/optimus/config"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+const recommendScheduleTimeout = time.Minute
+
+type recommendScheduleCommand struct {
+	logger         log.Logger
+	connection     connection.Connection
+	configFilePath string
+	clientConfig   *config.ClientConfig
+
+	projectName   string
+	host          string
+	namespaceName string
+	apply         bool
+}
+
+// NewRecommendScheduleCommand initializes command to recommend a schedule from the completion times of upstreams
+func NewRecommendScheduleCommand() *cobra.Command {
+	recommend := &recommendScheduleCommand{
+		logger: logger.NewClientLogger(),
+	}
+
+	cmd := &cobra.Command{
+		Use:     "recommend-schedule",
+		Short:   "Recommend a schedule for the job based on when its upstreams usually complete",
+		Long:    "Inspect the completion times of the upstreams of the job, and recommend a schedule reducing the time spent waiting on sensors.",
+		Example: "optimus job recommend-schedule <job_name> [--apply --namespace <namespace_name>]",
+		Args:    cobra.ExactArgs(1),
+		RunE:    recommend.RunE,
+		PreRunE: recommend.PreRunE,
+	}
+	recommend.injectFlags(cmd)
+	return cmd
+}
+
+func (r *recommendScheduleCommand) injectFlags(cmd *cobra.Command) {
+	// Config filepath flag
+	cmd.Flags().StringVarP(&r.configFilePath, "config", "c", config.EmptyPath, "File path for client configuration")
+
+	cmd.Flags().BoolVar(&r.apply, "apply", false, "Write the recommended schedule to the job specification")
+	cmd.Flags().StringVarP(&r.namespaceName, "namespace", "n", "", "Namespace of the job, required to apply the recommendation")
+
+	// Mandatory flags if config is not set
+	cmd.Flags().StringVarP(&r.projectName, "project-name", "p", "", "Name of the optimus project")
+	cmd.Flags().StringVar(&r.host, "host", "", "Optimus service endpoint url")
+}
+
+func (r *recommendScheduleCommand) PreRunE(cmd *cobra.Command, _ []string) error {
+	// Load config
+	conf, err := internal.LoadOptionalConfig(r.configFilePath)
+	if err != nil {
+		return err
+	}
+
+	if conf == nil {
+		if r.apply {
+			return errors.New("client config is required to apply the recommendation")
+		}
+		internal.MarkFlagsRequired(cmd, []string{"project-name", "host"})
+		r.connection = connection.NewInsecure(r.logger)
+		return nil
+	}
+	r.clientConfig = conf
+
+	if r.projectName == "" {
+		r.projectName = conf.Project.Name
+	}
+	if r.host == "" {
+		r.host = conf.Host
+	}
+	if r.namespaceName == "" {
+		r.namespaceName = conf.DefaultNamespaceName()
+	}
+	if r.apply && r.namespaceName == "" {
+		internal.MarkFlagsRequired(cmd, []string{"namespace"})
+	}
+	r.connection = connection.New(r.logger, conf)
+	return nil
+}
+
+func (r *recommendScheduleCommand) RunE(_ *cobra.Command, args []string) error {
+	jobName := args[0]
+	recommendation, err := r.getRecommendation(jobName)
+	if err != nil {
+		return err
+	}
+
+	r.logger.Info("Schedule recommendation for job: %s", jobName)
+	r.logger.Info("  current schedule     : %s", recommendation.GetCurrentSchedule())
+	r.logger.Info("  upstreams ready after: %s (p90 over %d schedules)", recommendation.GetUpstreamsReadyAfter().AsDuration(), recommendation.GetSampleSize())
+	r.logger.Info("  reason               : %s", recommendation.GetReason())
+	if recommendation.GetRecommendedSchedule() == "" {
+		return nil
+	}
+	r.logger.Info("  recommended schedule : %s (shifted by %s)", recommendation.GetRecommendedSchedule(), recommendation.GetShift().AsDuration())
+
+	if !r.apply {
+		return nil
+	}
+	return r.applyRecommendation(jobName, recommendation.GetRecommendedSchedule())
+}
+
+func (r *recommendScheduleCommand) applyRecommendation(jobName, schedule string) error {
+	namespace, err := r.clientConfig.GetNamespaceByName(r.namespaceName)
+	if err != nil {
+		return err
+	}
+
+	jobSpecReadWriter, err := specio.NewJobSpecReadWriter(afero.NewOsFs())
+	if err != nil {
+		return err
+	}
+	jobSpec, err := jobSpecReadWriter.ReadByName(namespace.Job.Path, jobName)
+	if err != nil {
+		return err
+	}
+	jobSpec.Schedule.Interval = schedule
+	if err := jobSpecReadWriter.Write(jobSpec.Path, jobSpec); err != nil {
+		return err
+	}
+	r.logger.Info("Schedule of %s is updated, review and deploy the job to apply it", jobName)
+	return nil
+}
+
+func (r *recommendScheduleCommand) getRecommendation(jobName string) (*pb.GetScheduleRecommendationResponse, error) {
+	conn, err := r.connection.Create(r.host)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	ctx, cancelFunc := context.WithTimeout(context.Background(), recommendScheduleTimeout)
+	defer cancelFunc()
+
+	jobRunServiceClient := pb.NewJobRunServiceClient(conn)
+	return jobRunServiceClient.GetScheduleRecommendation(ctx, &pb.GetScheduleRecommendationRequest{
+		ProjectName: r.projectName,
+		JobName:     jobName,
+	})
+}
@@ -0,0 +1,122 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/goto/salt/log"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+
+	"github.com/goto/optimus/client/cmd/internal"
+	"github.com/goto/optimus/client/cmd/internal/connection"
+	"github.com/goto/optimus/client/cmd/internal/logger"
+	"github.com/goto/optimus/client/local/specio"
+	"github.com/goto/optimus/config"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+const renameTimeout = time.Minute * 5
+
+type renameCommand struct {
+	logger     log.Logger
+	connection connection.Connection
+
+	configFilePath string
+	clientConfig   *config.ClientConfig
+
+	namespaceName string
+}
+
+// NewRenameCommand initializes command to rename a job keeping its run history
+func NewRenameCommand() *cobra.Command {
+	rename := &renameCommand{
+		logger: logger.NewClientLogger(),
+	}
+
+	cmd := &cobra.Command{
+		Use:     "rename",
+		Short:   "Rename a job on the server and in its local specification, keeping its run and replay history",
+		Example: "optimus job rename <job_name> <new_job_name> --namespace sample",
+		Args:    cobra.ExactArgs(2),
+		RunE:    rename.RunE,
+		PreRunE: rename.PreRunE,
+	}
+	// Config filepath flag
+	cmd.Flags().StringVarP(&rename.configFilePath, "config", "c", config.EmptyPath, "File path for client configuration")
+
+	cmd.Flags().StringVarP(&rename.namespaceName, "namespace", "n", "", "Namespace of the job, the one of current context by default")
+	return cmd
+}
+
+func (r *renameCommand) PreRunE(cmd *cobra.Command, _ []string) error {
+	conf, err := config.LoadClientConfig(r.configFilePath)
+	if err != nil {
+		return err
+	}
+	r.clientConfig = conf
+
+	if r.namespaceName == "" {
+		r.namespaceName = conf.DefaultNamespaceName()
+	}
+	if r.namespaceName == "" {
+		internal.MarkFlagsRequired(cmd, []string{"namespace"})
+	}
+	r.connection = connection.New(r.logger, conf)
+	return nil
+}
+
+func (r *renameCommand) RunE(_ *cobra.Command, args []string) error {
+	jobName, newJobName := args[0], args[1]
+	namespace, err := r.clientConfig.GetNamespaceByName(r.namespaceName)
+	if err != nil {
+		return err
+	}
+
+	renamed, err := r.changeJobName(namespace.Name, jobName, newJobName)
+	if err != nil {
+		return err
+	}
+	r.logger.Info("[OK] Renamed job %s to %s", jobName, renamed.GetJobName())
+
+	if err := r.renameLocalSpec(namespace.Job.Path, jobName, newJobName); err != nil {
+		r.logger.Error("[error] unable to rename the local job specification: %s", err)
+		r.logger.Warn("[info] manually run \n\t`optimus job export -p %s -n %s -r %s `, to fetch the renamed job.",
+			r.clientConfig.Project.Name, namespace.Name, newJobName)
+	}
+	r.logger.Warn("[info] the local specifications of the downstream jobs depending on %s by name need to refer to %s before their next deployment",
+		jobName, newJobName)
+	return nil
+}
+
+func (r *renameCommand) changeJobName(namespaceName, jobName, newJobName string) (*pb.ChangeJobNameResponse, error) {
+	conn, err := r.connection.Create(r.clientConfig.Host)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	ctx, cancelFunc := context.WithTimeout(context.Background(), renameTimeout)
+	defer cancelFunc()
+
+	jobSpecificationServiceClient := pb.NewJobSpecificationServiceClient(conn)
+	return jobSpecificationServiceClient.ChangeJobName(ctx, &pb.ChangeJobNameRequest{
+		ProjectName:   r.clientConfig.Project.Name,
+		NamespaceName: namespaceName,
+		JobName:       jobName,
+		NewJobName:    newJobName,
+	})
+}
+
+func (*renameCommand) renameLocalSpec(jobsPath, jobName, newJobName string) error {
+	readWriter, err := specio.NewJobSpecReadWriter(afero.NewOsFs())
+	if err != nil {
+		return err
+	}
+	jobSpec, err := readWriter.ReadByName(jobsPath, jobName)
+	if err != nil {
+		return err
+	}
+	jobSpec.Name = newJobName
+	return readWriter.Write(jobSpec.Path, jobSpec)
+}
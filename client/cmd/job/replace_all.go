@@ -34,6 +34,7 @@ type replaceAllCommand struct {
 
 	selectedNamespaceNames []string
 	verbose                bool
+	allProjects            bool
 	configFilePath         string
 }
 
@@ -48,7 +49,7 @@ func NewReplaceAllCommand() *cobra.Command {
 		Short: "Replace all current optimus project to server",
 		Long: heredoc.Doc(`Apply local changes to destination server which includes creating/updating/deleting
 				jobs`),
-		Example: "optimus job replace-all [--verbose]",
+		Example: "optimus job replace-all [--verbose]\noptimus job replace-all --all-projects",
 		Annotations: map[string]string{
 			"group:core": "true",
 		},
@@ -58,10 +59,15 @@ func NewReplaceAllCommand() *cobra.Command {
 	cmd.Flags().StringVarP(&replaceAll.configFilePath, "config", "c", replaceAll.configFilePath, "File path for client configuration")
 	cmd.Flags().StringSliceVarP(&replaceAll.selectedNamespaceNames, "namespace-names", "N", nil, "Selected namespaces of optimus project")
 	cmd.Flags().BoolVarP(&replaceAll.verbose, "verbose", "v", false, "Print details related to replace-all stages")
+	cmd.Flags().BoolVar(&replaceAll.allProjects, "all-projects", false, allProjectsFlagUsage)
 	return cmd
 }
 
 func (r *replaceAllCommand) PreRunE(_ *cobra.Command, _ []string) error {
+	if r.allProjects && len(r.selectedNamespaceNames) > 0 {
+		return errors.New("namespace names can not be given together with --all-projects")
+	}
+
 	var err error
 	r.clientConfig, err = config.LoadClientConfig(r.configFilePath)
 	if err != nil {
@@ -73,6 +79,10 @@ func (r *replaceAllCommand) PreRunE(_ *cobra.Command, _ []string) error {
 }
 
 func (r *replaceAllCommand) RunE(_ *cobra.Command, _ []string) error {
+	if r.allProjects {
+		return r.replaceAllProjects()
+	}
+
 	r.logger.Info("> Validating namespaces")
 	selectedNamespaces, err := r.clientConfig.GetSelectedNamespaces(r.selectedNamespaceNames...)
 	if err != nil {
@@ -86,6 +96,20 @@ func (r *replaceAllCommand) RunE(_ *cobra.Command, _ []string) error {
 	return r.replaceAll(selectedNamespaces)
 }
 
+func (r *replaceAllCommand) replaceAllProjects() error {
+	projectConfs, err := config.LoadProjectClientConfigs(r.clientConfig)
+	if err != nil {
+		return err
+	}
+
+	return runForAllProjects(r.logger, projectConfs, func(projectConf *config.ClientConfig) error {
+		projectReplaceAll := *r
+		projectReplaceAll.clientConfig = projectConf
+		projectReplaceAll.connection = connection.New(r.logger, projectConf)
+		return projectReplaceAll.replaceAll(projectConf.Namespaces)
+	})
+}
+
 func (r *replaceAllCommand) replaceAll(selectedNamespaces []*config.Namespace) error {
 	conn, err := r.connection.Create(r.clientConfig.Host)
 	if err != nil {
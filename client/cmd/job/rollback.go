@@ -0,0 +1,114 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/goto/salt/log"
+	"github.com/spf13/cobra"
+
+	"github.com/goto/optimus/client/cmd/internal"
+	"github.com/goto/optimus/client/cmd/internal/connection"
+	"github.com/goto/optimus/client/cmd/internal/logger"
+	"github.com/goto/optimus/config"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+const rollbackTimeout = time.Minute * 5
+
+type rollbackCommand struct {
+	logger         log.Logger
+	connection     connection.Connection
+	configFilePath string
+
+	projectName   string
+	namespaceName string
+	host          string
+	version       int
+}
+
+// NewRollbackCommand initializes command to redeploy a previously deployed version of a job spec
+func NewRollbackCommand() *cobra.Command {
+	rollback := &rollbackCommand{
+		logger: logger.NewClientLogger(),
+	}
+
+	cmd := &cobra.Command{
+		Use:     "rollback",
+		Short:   "Redeploy a previously deployed version of a job specification, listed by the history command",
+		Example: "optimus job rollback <job_name> --version 3 --namespace sample",
+		Args:    cobra.ExactArgs(1),
+		RunE:    rollback.RunE,
+		PreRunE: rollback.PreRunE,
+	}
+	// Config filepath flag
+	cmd.Flags().StringVarP(&rollback.configFilePath, "config", "c", config.EmptyPath, "File path for client configuration")
+
+	cmd.Flags().StringVarP(&rollback.namespaceName, "namespace", "n", "", "Namespace of the job, the one of current context by default")
+	cmd.Flags().IntVar(&rollback.version, "version", 0, "Version of the job specification to redeploy")
+	cmd.MarkFlagRequired("version")
+
+	// Mandatory flags if config is not set
+	cmd.Flags().StringVarP(&rollback.projectName, "project-name", "p", "", "Name of the optimus project")
+	cmd.Flags().StringVar(&rollback.host, "host", "", "Optimus service endpoint url")
+	return cmd
+}
+
+func (r *rollbackCommand) PreRunE(cmd *cobra.Command, _ []string) error {
+	// Load config
+	conf, err := internal.LoadOptionalConfig(r.configFilePath)
+	if err != nil {
+		return err
+	}
+
+	if conf == nil {
+		internal.MarkFlagsRequired(cmd, []string{"project-name", "host", "namespace"})
+		return nil
+	}
+
+	if r.projectName == "" {
+		r.projectName = conf.Project.Name
+	}
+	if r.host == "" {
+		r.host = conf.Host
+	}
+	if r.namespaceName == "" {
+		r.namespaceName = conf.DefaultNamespaceName()
+	}
+	if r.namespaceName == "" {
+		internal.MarkFlagsRequired(cmd, []string{"namespace"})
+	}
+	r.connection = connection.New(r.logger, conf)
+	return nil
+}
+
+func (r *rollbackCommand) RunE(_ *cobra.Command, args []string) error {
+	jobName := args[0]
+	rolledBack, err := r.rollbackJob(jobName)
+	if err != nil {
+		return fmt.Errorf("rollback request failed for job %s: %w", jobName, err)
+	}
+	r.logger.Info("[OK] Successfully rolled back job %s to version %d and deployed it on Scheduler", jobName, rolledBack.GetVersion())
+	r.logger.Warn("[info] the local specification of %s is unchanged, update it before the next deployment to keep the rollback", jobName)
+	return nil
+}
+
+func (r *rollbackCommand) rollbackJob(jobName string) (*pb.RollbackJobResponse, error) {
+	conn, err := r.connection.Create(r.host)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	ctx, cancelFunc := context.WithTimeout(context.Background(), rollbackTimeout)
+	defer cancelFunc()
+
+	jobSpecificationServiceClient := pb.NewJobSpecificationServiceClient(conn)
+	return jobSpecificationServiceClient.RollbackJob(ctx, &pb.RollbackJobRequest{
+		ProjectName:   r.projectName,
+		NamespaceName: r.namespaceName,
+		JobName:       jobName,
+		Version:       int32(r.version),
+	})
+}
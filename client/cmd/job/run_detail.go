@@ -0,0 +1,127 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/goto/salt/log"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/goto/optimus/client/cmd/internal"
+	"github.com/goto/optimus/client/cmd/internal/connection"
+	"github.com/goto/optimus/client/cmd/internal/logger"
+	"github.com/goto/optimus/config"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+const runDetailTimeout = time.Minute
+
+type runDetailCommand struct {
+	logger         log.Logger
+	connection     connection.Connection
+	configFilePath string
+
+	projectName string
+	host        string
+	scheduledAt string
+}
+
+// NewRunDetailCommand initializes command to show the timeline of a job run
+func NewRunDetailCommand() *cobra.Command {
+	detail := &runDetailCommand{
+		logger: logger.NewClientLogger(),
+	}
+
+	cmd := &cobra.Command{
+		Use:     "run-detail",
+		Short:   "Show the timeline of a job run with the state and duration of its sensors, task and hooks",
+		Example: "optimus job run-detail <job_name> --scheduled-at 2023-10-10T10:00:00Z",
+		Args:    cobra.ExactArgs(1),
+		RunE:    detail.RunE,
+		PreRunE: detail.PreRunE,
+	}
+	detail.injectFlags(cmd)
+	return cmd
+}
+
+func (r *runDetailCommand) injectFlags(cmd *cobra.Command) {
+	// Config filepath flag
+	cmd.Flags().StringVarP(&r.configFilePath, "config", "c", config.EmptyPath, "File path for client configuration")
+
+	cmd.Flags().StringVar(&r.scheduledAt, "scheduled-at", "", "Scheduled time of the run, in RFC3339 format")
+	cmd.MarkFlagRequired("scheduled-at")
+
+	// Mandatory flags if config is not set
+	cmd.Flags().StringVarP(&r.projectName, "project-name", "p", "", "Name of the optimus project")
+	cmd.Flags().StringVar(&r.host, "host", "", "Optimus service endpoint url")
+}
+
+func (r *runDetailCommand) PreRunE(cmd *cobra.Command, _ []string) error {
+	// Load config
+	conf, err := internal.LoadOptionalConfig(r.configFilePath)
+	if err != nil {
+		return err
+	}
+
+	if conf == nil {
+		internal.MarkFlagsRequired(cmd, []string{"project-name", "host"})
+		return nil
+	}
+
+	if r.projectName == "" {
+		r.projectName = conf.Project.Name
+	}
+	if r.host == "" {
+		r.host = conf.Host
+	}
+	r.connection = connection.New(r.logger, conf)
+	return nil
+}
+
+func (r *runDetailCommand) RunE(_ *cobra.Command, args []string) error {
+	scheduledAt, err := time.Parse(time.RFC3339, r.scheduledAt)
+	if err != nil {
+		return fmt.Errorf("invalid scheduled-at %s, expected RFC3339 format", r.scheduledAt)
+	}
+
+	detail, err := r.getRunDetail(args[0], scheduledAt)
+	if err != nil {
+		return err
+	}
+
+	r.logger.Info("Run of %s scheduled at %s: %s", detail.GetJobName(), formatTimestamp(detail.GetScheduledAt()), detail.GetState())
+	r.logger.Info("  started at %s, took %s", formatTimestamp(detail.GetStartTime()), detail.GetDuration().AsDuration())
+	if len(detail.GetOperators()) == 0 {
+		r.logger.Info("no sensor, task or hook of the run is recorded yet")
+		return nil
+	}
+	for _, operator := range detail.GetOperators() {
+		r.logger.Info("  [%s] %s: %s, started at %s, took %s", operator.GetType(), operator.GetName(), operator.GetState(),
+			formatTimestamp(operator.GetStartTime()), operator.GetDuration().AsDuration())
+	}
+	return nil
+}
+
+func (r *runDetailCommand) getRunDetail(jobName string, scheduledAt time.Time) (*pb.GetJobRunDetailResponse, error) {
+	conn, err := r.connection.Create(r.host)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	ctx, cancelFunc := context.WithTimeout(context.Background(), runDetailTimeout)
+	defer cancelFunc()
+
+	jobRunServiceClient := pb.NewJobRunServiceClient(conn)
+	return jobRunServiceClient.GetJobRunDetail(ctx, &pb.GetJobRunDetailRequest{
+		ProjectName: r.projectName,
+		JobName:     jobName,
+		ScheduledAt: timestamppb.New(scheduledAt),
+	})
+}
+
+func formatTimestamp(ts *timestamppb.Timestamp) string {
+	return ts.AsTime().Format(time.RFC3339)
+}
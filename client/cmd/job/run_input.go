@@ -11,12 +11,14 @@ import (
 
 	"github.com/goto/salt/log"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/goto/optimus/client/cmd/internal"
 	"github.com/goto/optimus/client/cmd/internal/connection"
 	"github.com/goto/optimus/client/cmd/internal/logger"
 	"github.com/goto/optimus/config"
+	"github.com/goto/optimus/core/scheduler"
 	"github.com/goto/optimus/internal/utils"
 	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
 )
@@ -46,6 +48,9 @@ type jobRunInputCommand struct {
 	projectName    string
 	host           string
 
+	attempt        string
+	schedulerRunID string
+
 	keysWithUnsubstitutedValue []string
 }
 
@@ -86,6 +91,10 @@ func (j *jobRunInputCommand) injectFlags(cmd *cobra.Command) {
 	// Mandatory flags if config is not set
 	cmd.Flags().StringVarP(&j.projectName, "project-name", "p", "", "Name of the optimus project")
 	cmd.Flags().StringVar(&j.host, "host", "", "Optimus service endpoint url")
+
+	// Optional flags identifying the run in the scheduler
+	cmd.Flags().StringVar(&j.attempt, "attempt", "", "Attempt of the job run, starting from 1")
+	cmd.Flags().StringVar(&j.schedulerRunID, "scheduler-run-id", "", "Id of the run in the scheduler, e.g., scheduled__2021-01-14T02:00:00+00:00")
 }
 
 func (j *jobRunInputCommand) PreRunE(cmd *cobra.Command, _ []string) error {
@@ -234,6 +243,12 @@ func (j *jobRunInputCommand) sendJobRunInputRequest(jobName string, jobScheduled
 	ctx, reqCancel := context.WithTimeout(context.Background(), jobRunInputCompileAssetsTimeout)
 	defer reqCancel()
 
+	if j.attempt != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, scheduler.JobRunAttemptMetadataKey, j.attempt)
+	}
+	if j.schedulerRunID != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, scheduler.SchedulerRunIDMetadataKey, j.schedulerRunID)
+	}
 	return jobRunServiceClient.JobRunInput(ctx, request)
 }
 
@@ -18,7 +18,10 @@ import (
 	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
 )
 
-const jobStatusTimeout = time.Second * 30
+const (
+	jobStatusTimeout = time.Second * 30
+	runWatchInterval = time.Second * 30
+)
 
 type runListCommand struct {
 	logger         log.Logger
@@ -29,6 +32,7 @@ type runListCommand struct {
 	endDate     string
 	projectName string
 	host        string
+	watch       bool
 }
 
 // NewRunListCommand initializes run list command
@@ -40,7 +44,7 @@ func NewRunListCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "list-runs",
 		Short:   "Get Job run details",
-		Example: `optimus job runs <sample_job_goes_here> [--project \"project-id\"] [--start_date \"2006-01-02T15:04:05Z07:00\" --end_date \"2006-01-02T15:04:05Z07:00\"]`,
+		Example: `optimus job runs <sample_job_goes_here> [--project \"project-id\"] [--start_date \"2006-01-02T15:04:05Z07:00\" --end_date \"2006-01-02T15:04:05Z07:00\"] [--watch]`,
 		Args:    cobra.MinimumNArgs(1),
 		RunE:    run.RunE,
 		PreRunE: run.PreRunE,
@@ -55,6 +59,7 @@ func (r *runListCommand) injectFlags(cmd *cobra.Command) {
 
 	cmd.Flags().StringVar(&r.startDate, "start_date", "", "start date of job run")
 	cmd.Flags().StringVar(&r.endDate, "end_date", "", "end date of job run")
+	cmd.Flags().BoolVar(&r.watch, "watch", false, "Keep listing the runs with the estimated start of the ones not started, until all have started")
 
 	// Mandatory flags if config is not set
 	cmd.Flags().StringVarP(&r.projectName, "project-name", "p", "", "Name of the optimus project")
@@ -105,9 +110,25 @@ func (r *runListCommand) callJobRun(jobRunRequest *pb.JobRunRequest) error {
 	}
 	defer conn.Close()
 
+	run := pb.NewJobRunServiceClient(conn)
+	for {
+		waiting, err := r.listJobRuns(run, jobRunRequest)
+		if err != nil {
+			return err
+		}
+		if !r.watch || waiting == 0 {
+			return nil
+		}
+		r.logger.Info("\n%d jobRun instances have not started, refreshing in %s", waiting, runWatchInterval)
+		time.Sleep(runWatchInterval)
+	}
+}
+
+// listJobRuns prints the runs of the job, with the estimated start of the ones not started when watching,
+// and returns the number of runs not started
+func (r *runListCommand) listJobRuns(run pb.JobRunServiceClient, jobRunRequest *pb.JobRunRequest) (int, error) {
 	spinner := progressbar.NewProgressBar()
 	spinner.Start("please wait...")
-	run := pb.NewJobRunServiceClient(conn)
 
 	ctx, dialCancel := context.WithTimeout(context.Background(), jobStatusTimeout)
 	defer dialCancel()
@@ -115,15 +136,33 @@ func (r *runListCommand) callJobRun(jobRunRequest *pb.JobRunRequest) error {
 	jobRunResponse, err := run.JobRun(ctx, jobRunRequest)
 	spinner.Stop()
 	if err != nil {
-		return fmt.Errorf("request failed for job %s: %w", jobRunRequest.JobName, err)
+		return 0, fmt.Errorf("request failed for job %s: %w", jobRunRequest.JobName, err)
 	}
 
 	jobRuns := jobRunResponse.GetJobRuns()
+	waiting := 0
 	for _, jobRun := range jobRuns {
 		r.logger.Info("%s - %s", jobRun.GetScheduledAt().AsTime(), jobRun.GetState())
+		if !r.watch || hasJobRunStarted(jobRun.GetState()) {
+			continue
+		}
+		waiting++
+		estimate, err := run.EstimateJobRunStart(ctx, &pb.EstimateJobRunStartRequest{
+			ProjectName: jobRunRequest.ProjectName,
+			JobName:     jobRunRequest.JobName,
+			ScheduledAt: jobRun.GetScheduledAt(),
+		})
+		if err != nil {
+			r.logger.Warn("\tunable to estimate the start: %s", err)
+			continue
+		}
+		r.logger.Info("\texpected to start at %s, %s", estimate.GetEstimatedStartTime().AsTime(), estimate.GetReason())
+		if pool := estimate.GetPool(); pool != nil {
+			r.logger.Info("\tpool %s: %d of %d slots occupied, %d queued", pool.GetName(), pool.GetOccupiedSlots(), pool.GetSlots(), pool.GetQueuedSlots())
+		}
 	}
 	r.logger.Info("\nFound %d jobRun instances.", len(jobRuns))
-	return nil
+	return waiting, nil
 }
 
 func (r *runListCommand) createJobRunRequest(jobName, startDate, endDate string) (*pb.JobRunRequest, error) {
@@ -163,3 +202,12 @@ func (*runListCommand) validateDateArgs(startDate, endDate string) error {
 	}
 	return nil
 }
+
+func hasJobRunStarted(state string) bool {
+	switch state {
+	case "running", "success", "failed":
+		return true
+	default:
+		return false
+	}
+}
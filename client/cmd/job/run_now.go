@@ -0,0 +1,133 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/goto/salt/log"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/goto/optimus/client/cmd/internal"
+	"github.com/goto/optimus/client/cmd/internal/connection"
+	"github.com/goto/optimus/client/cmd/internal/logger"
+	"github.com/goto/optimus/config"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+const runNowTimeout = time.Minute
+
+type runNowCommand struct {
+	logger         log.Logger
+	connection     connection.Connection
+	configFilePath string
+
+	projectName   string
+	namespaceName string
+	host          string
+	scheduledAt   string
+	overrides     map[string]string
+}
+
+// NewRunNowCommand initializes command to trigger a manual run of a job
+func NewRunNowCommand() *cobra.Command {
+	runNow := &runNowCommand{
+		logger: logger.NewClientLogger(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "run-now",
+		Short: "Trigger a run of the job for a scheduled time, optionally overriding its task configs for that run only",
+		Example: "optimus job run-now <job_name> --namespace sample --scheduled-at 2023-10-10T10:00:00Z " +
+			"--override LOAD_METHOD=REPLACE",
+		Args:    cobra.ExactArgs(1),
+		RunE:    runNow.RunE,
+		PreRunE: runNow.PreRunE,
+	}
+	runNow.injectFlags(cmd)
+	return cmd
+}
+
+func (r *runNowCommand) injectFlags(cmd *cobra.Command) {
+	// Config filepath flag
+	cmd.Flags().StringVarP(&r.configFilePath, "config", "c", config.EmptyPath, "File path for client configuration")
+
+	cmd.Flags().StringVarP(&r.namespaceName, "namespace", "n", "", "Namespace of the job, the one of current context by default")
+	cmd.Flags().StringVar(&r.scheduledAt, "scheduled-at", "", "Scheduled time of the run in RFC3339 format, the current time by default")
+	cmd.Flags().StringToStringVar(&r.overrides, "override", nil, "Task config to override for the run, e.g. LOAD_METHOD=REPLACE")
+
+	// Mandatory flags if config is not set
+	cmd.Flags().StringVarP(&r.projectName, "project-name", "p", "", "Name of the optimus project")
+	cmd.Flags().StringVar(&r.host, "host", "", "Optimus service endpoint url")
+}
+
+func (r *runNowCommand) PreRunE(cmd *cobra.Command, _ []string) error {
+	// Load config
+	conf, err := internal.LoadOptionalConfig(r.configFilePath)
+	if err != nil {
+		return err
+	}
+
+	if conf == nil {
+		internal.MarkFlagsRequired(cmd, []string{"project-name", "host", "namespace"})
+		return nil
+	}
+
+	if r.projectName == "" {
+		r.projectName = conf.Project.Name
+	}
+	if r.host == "" {
+		r.host = conf.Host
+	}
+	if r.namespaceName == "" {
+		r.namespaceName = conf.DefaultNamespaceName()
+	}
+	if r.namespaceName == "" {
+		internal.MarkFlagsRequired(cmd, []string{"namespace"})
+	}
+	r.connection = connection.New(r.logger, conf)
+	return nil
+}
+
+func (r *runNowCommand) RunE(_ *cobra.Command, args []string) error {
+	scheduledAt := time.Now().UTC().Truncate(time.Second)
+	if r.scheduledAt != "" {
+		parsed, err := time.Parse(time.RFC3339, r.scheduledAt)
+		if err != nil {
+			return fmt.Errorf("invalid scheduled-at %s, expected RFC3339 format", r.scheduledAt)
+		}
+		scheduledAt = parsed
+	}
+
+	triggered, err := r.trigger(args[0], scheduledAt)
+	if err != nil {
+		return err
+	}
+
+	r.logger.Info("Triggered run of %s scheduled at %s", triggered.GetJobName(), formatTimestamp(triggered.GetScheduledAt()))
+	for key, value := range r.overrides {
+		r.logger.Info("  with %s=%s", key, value)
+	}
+	return nil
+}
+
+func (r *runNowCommand) trigger(jobName string, scheduledAt time.Time) (*pb.TriggerJobRunResponse, error) {
+	conn, err := r.connection.Create(r.host)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	ctx, cancelFunc := context.WithTimeout(context.Background(), runNowTimeout)
+	defer cancelFunc()
+
+	jobRunServiceClient := pb.NewJobRunServiceClient(conn)
+	return jobRunServiceClient.TriggerJobRun(ctx, &pb.TriggerJobRunRequest{
+		ProjectName:     r.projectName,
+		NamespaceName:   r.namespaceName,
+		JobName:         jobName,
+		ScheduledAt:     timestamppb.New(scheduledAt),
+		ConfigOverrides: r.overrides,
+	})
+}
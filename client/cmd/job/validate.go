@@ -13,13 +13,16 @@ import (
 
 	"github.com/goto/optimus/client/cmd/internal/connection"
 	"github.com/goto/optimus/client/cmd/internal/logger"
+	"github.com/goto/optimus/client/local"
 	"github.com/goto/optimus/client/local/model"
 	"github.com/goto/optimus/client/local/specio"
 	"github.com/goto/optimus/config"
 	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
 )
 
-const validateTimeout = time.Minute * 15
+const (
+	validateTimeout = time.Minute * 15
+)
 
 type validateCommand struct {
 	logger     log.Logger
@@ -30,6 +33,7 @@ type validateCommand struct {
 
 	verbose       bool
 	namespaceName string
+	allProjects   bool
 }
 
 // NewValidateCommand initializes command for validating job specification
@@ -39,10 +43,11 @@ func NewValidateCommand() *cobra.Command {
 	}
 
 	cmd := &cobra.Command{
-		Use:     "validate",
-		Short:   "Run basic checks on all jobs",
-		Long:    "Check if specifications are valid for deployment",
-		Example: "optimus job validate",
+		Use:   "validate",
+		Short: "Run basic checks on all jobs",
+		Long: "Check if specifications are valid for deployment. When job names are given, only those specifications are " +
+			"checked with the full server validation and a report per job, without treating the missing ones as deleted",
+		Example: "optimus job validate [<job_name>...]\noptimus job validate --all-projects",
 		RunE:    validate.RunE,
 		PreRunE: validate.PreRunE,
 	}
@@ -51,22 +56,58 @@ func NewValidateCommand() *cobra.Command {
 
 	cmd.Flags().BoolVarP(&validate.verbose, "verbose", "v", false, "Print details related to operation")
 	cmd.Flags().StringVarP(&validate.namespaceName, "namespace", "n", validate.namespaceName, "Namespace of the resource within project")
-	cmd.MarkFlagRequired("namespace")
+	cmd.Flags().BoolVar(&validate.allProjects, "all-projects", false, allProjectsFlagUsage)
 	return cmd
 }
 
-func (v *validateCommand) PreRunE(_ *cobra.Command, _ []string) error { // Load mandatory config
+func (v *validateCommand) PreRunE(_ *cobra.Command, args []string) error { // Load mandatory config
+	if v.allProjects && len(args) > 0 {
+		return errors.New("job names can not be given together with --all-projects")
+	}
 	conf, err := config.LoadClientConfig(v.configFilePath)
 	if err != nil {
 		return err
 	}
 	v.clientConfig = conf
 
+	if !v.allProjects && v.namespaceName == "" {
+		v.namespaceName = conf.DefaultNamespaceName()
+	}
+	if !v.allProjects && v.namespaceName == "" {
+		return errors.New(`required flag(s) "namespace" not set`)
+	}
+
 	v.connection = connection.NewInsecure(v.logger)
 	return nil
 }
 
-func (v *validateCommand) RunE(_ *cobra.Command, _ []string) error {
+func (v *validateCommand) RunE(_ *cobra.Command, args []string) error {
+	if v.allProjects {
+		return v.validateAllProjects()
+	}
+	return v.validateNamespace(args)
+}
+
+func (v *validateCommand) validateAllProjects() error {
+	projectConfs, err := config.LoadProjectClientConfigs(v.clientConfig)
+	if err != nil {
+		return err
+	}
+
+	return runForAllProjects(v.logger, projectConfs, func(projectConf *config.ClientConfig) error {
+		for _, namespace := range projectConf.Namespaces {
+			projectValidate := *v
+			projectValidate.clientConfig = projectConf
+			projectValidate.namespaceName = namespace.Name
+			if err := projectValidate.validateNamespace(nil); err != nil {
+				return fmt.Errorf("namespace [%s]: %w", namespace.Name, err)
+			}
+		}
+		return nil
+	})
+}
+
+func (v *validateCommand) validateNamespace(args []string) error {
 	namespace, err := v.clientConfig.GetNamespaceByName(v.namespaceName)
 	if err != nil {
 		return err
@@ -77,6 +118,10 @@ func (v *validateCommand) RunE(_ *cobra.Command, _ []string) error {
 		return err
 	}
 
+	if len(args) > 0 {
+		return v.validateJobSpecs(jobSpecReadWriter, namespace.Job.Path, args)
+	}
+
 	start := time.Now()
 	projectName := v.clientConfig.Project.Name
 	v.logger.Info("Validating job specifications for project: %s, namespace: %s", projectName, namespace.Name)
@@ -145,3 +190,46 @@ func (v *validateCommand) getCheckJobSpecificationsResponse(stream pb.JobSpecifi
 
 	return nil
 }
+
+func (v *validateCommand) validateJobSpecs(jobSpecReader local.SpecReadWriter[*model.JobSpec], jobsPath string, jobNames []string) error {
+	request := &pb.ValidateJobSpecsRequest{
+		ProjectName:   v.clientConfig.Project.Name,
+		NamespaceName: v.namespaceName,
+	}
+	for _, jobName := range jobNames {
+		jobSpec, err := jobSpecReader.ReadByName(jobsPath, jobName)
+		if err != nil {
+			return fmt.Errorf("job %s: %w", jobName, err)
+		}
+		request.Jobs = append(request.Jobs, jobSpec.ToProto())
+	}
+
+	conn, err := v.connection.Create(v.clientConfig.Host)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ctx, cancelFunc := context.WithTimeout(context.Background(), validateTimeout)
+	defer cancelFunc()
+
+	jobSpecificationServiceClient := pb.NewJobSpecificationServiceClient(conn)
+	validated, err := jobSpecificationServiceClient.ValidateJobSpecs(ctx, request)
+	if err != nil {
+		return fmt.Errorf("validate request failed: %w", err)
+	}
+
+	for _, result := range validated.GetResults() {
+		if result.GetValid() {
+			v.logger.Info("[OK] %s", result.GetJobName())
+			continue
+		}
+		for _, validationErr := range result.GetErrors() {
+			v.logger.Error("[error] %s: %s", result.GetJobName(), validationErr)
+		}
+	}
+	if !validated.GetValid() {
+		return errors.New("validation failed for some of the jobs")
+	}
+	return nil
+}
@@ -15,6 +15,7 @@ func NewNamespaceCommand() *cobra.Command {
 		NewRegisterCommand(),
 		NewDescribeCommand(),
 		NewListCommand(),
+		NewWebhookCommand(),
 	)
 	return cmd
 }
@@ -0,0 +1,365 @@
+package namespace
+
+import (
+	"bytes"
+	"context"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/goto/salt/log"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+
+	"github.com/goto/optimus/client/cmd/internal"
+	"github.com/goto/optimus/client/cmd/internal/connection"
+	"github.com/goto/optimus/client/cmd/internal/logger"
+	"github.com/goto/optimus/config"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+const webhookTimeout = time.Minute
+
+type webhookCommand struct {
+	logger     log.Logger
+	connection connection.Connection
+
+	configFilePath string
+
+	dirPath       string
+	host          string
+	projectName   string
+	namespaceName string
+}
+
+// webhookFlags are the url, secret and event types of a subscription
+type webhookFlags struct {
+	url        string
+	secretName string
+	eventTypes []string
+}
+
+// NewWebhookCommand initializes command to manage the webhook subscriptions of a namespace
+func NewWebhookCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "webhook",
+		Short:   "Commands to post the job run events of the namespace to webhooks",
+		Example: "optimus namespace webhook [sub-command]",
+	}
+	cmd.AddCommand(
+		newWebhookCreateCommand(),
+		newWebhookUpdateCommand(),
+		newWebhookListCommand(),
+		newWebhookDeleteCommand(),
+		newWebhookDeliveriesCommand(),
+	)
+	return cmd
+}
+
+func newWebhookCreateCommand() *cobra.Command {
+	webhook := &webhookCommand{
+		logger: logger.NewClientLogger(),
+	}
+	var flags webhookFlags
+
+	cmd := &cobra.Command{
+		Use:     "create",
+		Short:   "Subscribes a webhook to the job run events of the namespace, the payloads are signed with the value of the secret",
+		Example: "optimus namespace webhook create --namespace sample --url https://example.com/hook --secret-name WEBHOOK_KEY --event-types failure,sla_miss",
+		PreRunE: webhook.PreRunE,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			var id string
+			err := webhook.call(func(ctx context.Context, client pb.WebhookSubscriptionServiceClient) error {
+				response, err := client.CreateWebhookSubscription(ctx, &pb.CreateWebhookSubscriptionRequest{
+					ProjectName:   webhook.projectName,
+					NamespaceName: webhook.namespaceName,
+					Url:           flags.url,
+					SecretName:    flags.secretName,
+					EventTypes:    flags.eventTypes,
+				})
+				id = response.GetId()
+				return err
+			})
+			if err != nil {
+				return err
+			}
+			webhook.logger.Info("Webhook subscription [%s] is created", id)
+			return nil
+		},
+	}
+
+	webhook.injectFlags(cmd)
+	injectSubscriptionFlags(cmd, &flags)
+	return cmd
+}
+
+func newWebhookUpdateCommand() *cobra.Command {
+	webhook := &webhookCommand{
+		logger: logger.NewClientLogger(),
+	}
+	var flags webhookFlags
+
+	cmd := &cobra.Command{
+		Use:     "update",
+		Short:   "Replaces the url, secret and event types of a webhook subscription",
+		Example: "optimus namespace webhook update <subscription-id> --namespace sample --url https://example.com/hook --secret-name WEBHOOK_KEY --event-types failure",
+		Args:    cobra.ExactArgs(1),
+		PreRunE: webhook.PreRunE,
+		RunE: func(_ *cobra.Command, args []string) error {
+			err := webhook.call(func(ctx context.Context, client pb.WebhookSubscriptionServiceClient) error {
+				_, err := client.UpdateWebhookSubscription(ctx, &pb.UpdateWebhookSubscriptionRequest{
+					ProjectName:   webhook.projectName,
+					NamespaceName: webhook.namespaceName,
+					Id:            args[0],
+					Url:           flags.url,
+					SecretName:    flags.secretName,
+					EventTypes:    flags.eventTypes,
+				})
+				return err
+			})
+			if err != nil {
+				return err
+			}
+			webhook.logger.Info("Webhook subscription [%s] is updated", args[0])
+			return nil
+		},
+	}
+
+	webhook.injectFlags(cmd)
+	injectSubscriptionFlags(cmd, &flags)
+	return cmd
+}
+
+func newWebhookListCommand() *cobra.Command {
+	webhook := &webhookCommand{
+		logger: logger.NewClientLogger(),
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "Lists the webhook subscriptions of the namespace",
+		Example: "optimus namespace webhook list --namespace sample",
+		PreRunE: webhook.PreRunE,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			var subscriptions []*pb.WebhookSubscription
+			err := webhook.call(func(ctx context.Context, client pb.WebhookSubscriptionServiceClient) error {
+				response, err := client.ListWebhookSubscriptions(ctx, &pb.ListWebhookSubscriptionsRequest{
+					ProjectName:   webhook.projectName,
+					NamespaceName: webhook.namespaceName,
+				})
+				subscriptions = response.GetSubscriptions()
+				return err
+			})
+			if err != nil {
+				return err
+			}
+
+			if len(subscriptions) == 0 {
+				webhook.logger.Info("No webhook subscriptions were found in %s namespace.", webhook.namespaceName)
+				return nil
+			}
+			webhook.logger.Info("Webhook subscriptions for namespace: %s", webhook.namespaceName)
+			webhook.logger.Info(stringifyWebhooks(subscriptions))
+			return nil
+		},
+	}
+
+	webhook.injectFlags(cmd)
+	return cmd
+}
+
+func newWebhookDeleteCommand() *cobra.Command {
+	webhook := &webhookCommand{
+		logger: logger.NewClientLogger(),
+	}
+
+	cmd := &cobra.Command{
+		Use:     "delete",
+		Short:   "Deletes a webhook subscription along with its deliveries",
+		Example: "optimus namespace webhook delete <subscription-id> --namespace sample",
+		Args:    cobra.ExactArgs(1),
+		PreRunE: webhook.PreRunE,
+		RunE: func(_ *cobra.Command, args []string) error {
+			err := webhook.call(func(ctx context.Context, client pb.WebhookSubscriptionServiceClient) error {
+				_, err := client.DeleteWebhookSubscription(ctx, &pb.DeleteWebhookSubscriptionRequest{
+					ProjectName:   webhook.projectName,
+					NamespaceName: webhook.namespaceName,
+					Id:            args[0],
+				})
+				return err
+			})
+			if err != nil {
+				return err
+			}
+			webhook.logger.Info("Webhook subscription [%s] is deleted", args[0])
+			return nil
+		},
+	}
+
+	webhook.injectFlags(cmd)
+	return cmd
+}
+
+func newWebhookDeliveriesCommand() *cobra.Command {
+	webhook := &webhookCommand{
+		logger: logger.NewClientLogger(),
+	}
+	var limit int32
+
+	cmd := &cobra.Command{
+		Use:     "deliveries",
+		Short:   "Lists the latest deliveries of a webhook subscription",
+		Example: "optimus namespace webhook deliveries <subscription-id> --namespace sample --limit 50",
+		Args:    cobra.ExactArgs(1),
+		PreRunE: webhook.PreRunE,
+		RunE: func(_ *cobra.Command, args []string) error {
+			var deliveries []*pb.WebhookDelivery
+			err := webhook.call(func(ctx context.Context, client pb.WebhookSubscriptionServiceClient) error {
+				response, err := client.ListWebhookDeliveries(ctx, &pb.ListWebhookDeliveriesRequest{
+					ProjectName:   webhook.projectName,
+					NamespaceName: webhook.namespaceName,
+					Id:            args[0],
+					Limit:         limit,
+				})
+				deliveries = response.GetDeliveries()
+				return err
+			})
+			if err != nil {
+				return err
+			}
+
+			if len(deliveries) == 0 {
+				webhook.logger.Info("No deliveries were found for webhook subscription [%s].", args[0])
+				return nil
+			}
+			webhook.logger.Info(stringifyWebhookDeliveries(deliveries))
+			return nil
+		},
+	}
+
+	webhook.injectFlags(cmd)
+	cmd.Flags().Int32Var(&limit, "limit", 20, "Number of the latest deliveries to list")
+	return cmd
+}
+
+func injectSubscriptionFlags(cmd *cobra.Command, flags *webhookFlags) {
+	cmd.Flags().StringVar(&flags.url, "url", "", "Url the events are posted to")
+	cmd.Flags().StringVar(&flags.secretName, "secret-name", "", "Namespace secret whose value signs the payloads")
+	cmd.Flags().StringSliceVar(&flags.eventTypes, "event-types", nil, "Events to post, any of job_success, failure and sla_miss")
+	cmd.MarkFlagRequired("url")
+	cmd.MarkFlagRequired("secret-name")
+	cmd.MarkFlagRequired("event-types")
+}
+
+func (w *webhookCommand) injectFlags(cmd *cobra.Command) {
+	// Config filepath flag
+	cmd.Flags().StringVarP(&w.configFilePath, "config", "c", config.EmptyPath, "File path for client configuration")
+	cmd.Flags().StringVar(&w.dirPath, "dir", w.dirPath, "Directory where the Optimus client config resides")
+
+	cmd.Flags().StringVarP(&w.namespaceName, "namespace", "n", w.namespaceName, "Namespace of the webhook subscriptions")
+	cmd.MarkFlagRequired("namespace")
+
+	// Mandatory flags if config is not set
+	cmd.Flags().StringVar(&w.host, "host", w.host, "Targeted server host, by default taking from client config")
+	cmd.Flags().StringVar(&w.projectName, "project-name", w.projectName, "Targeted project name, by default taking from client config")
+}
+
+func (w *webhookCommand) PreRunE(cmd *cobra.Command, _ []string) error {
+	if w.dirPath != "" {
+		w.configFilePath = path.Join(w.dirPath, config.DefaultFilename)
+	}
+	// Load config
+	conf, err := internal.LoadOptionalConfig(w.configFilePath)
+	if err != nil {
+		return err
+	}
+
+	if conf == nil {
+		internal.MarkFlagsRequired(cmd, []string{"project-name", "host"})
+		w.connection = connection.NewInsecure(w.logger)
+		return nil
+	}
+
+	if w.projectName == "" {
+		w.projectName = conf.Project.Name
+	}
+	if w.host == "" {
+		w.host = conf.Host
+	}
+	w.connection = connection.New(w.logger, conf)
+	return nil
+}
+
+func (w *webhookCommand) call(fn func(context.Context, pb.WebhookSubscriptionServiceClient) error) error {
+	conn, err := w.connection.Create(w.host)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ctx, cancelFunc := context.WithTimeout(context.Background(), webhookTimeout)
+	defer cancelFunc()
+
+	return fn(ctx, pb.NewWebhookSubscriptionServiceClient(conn))
+}
+
+func stringifyWebhooks(subscriptions []*pb.WebhookSubscription) string {
+	buff := &bytes.Buffer{}
+	table := tablewriter.NewWriter(buff)
+	table.SetBorder(false)
+	table.SetHeader([]string{
+		"ID",
+		"URL",
+		"Secret Name",
+		"Event Types",
+		"Created At",
+	})
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	for _, subscription := range subscriptions {
+		table.Append([]string{
+			subscription.GetId(),
+			subscription.GetUrl(),
+			subscription.GetSecretName(),
+			strings.Join(subscription.GetEventTypes(), ","),
+			subscription.GetCreatedAt().AsTime().Format(time.RFC3339),
+		})
+	}
+	table.Render()
+	return buff.String()
+}
+
+func stringifyWebhookDeliveries(deliveries []*pb.WebhookDelivery) string {
+	buff := &bytes.Buffer{}
+	table := tablewriter.NewWriter(buff)
+	table.SetBorder(false)
+	table.SetHeader([]string{
+		"Created At",
+		"Job",
+		"Event",
+		"Scheduled At",
+		"Delivered",
+		"Attempts",
+		"Status",
+		"Error",
+	})
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	for _, delivery := range deliveries {
+		var scheduledAt string
+		if delivery.GetScheduledAt() != nil {
+			scheduledAt = delivery.GetScheduledAt().AsTime().Format(time.RFC3339)
+		}
+		table.Append([]string{
+			delivery.GetCreatedAt().AsTime().Format(time.RFC3339),
+			delivery.GetJobName(),
+			delivery.GetEventType(),
+			scheduledAt,
+			strconv.FormatBool(delivery.GetDelivered()),
+			strconv.Itoa(int(delivery.GetAttempts())),
+			strconv.Itoa(int(delivery.GetStatusCode())),
+			delivery.GetError(),
+		})
+	}
+	table.Render()
+	return buff.String()
+}
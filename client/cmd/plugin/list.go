@@ -0,0 +1,129 @@
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/goto/salt/log"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+
+	"github.com/goto/optimus/client/cmd/internal"
+	"github.com/goto/optimus/client/cmd/internal/connection"
+	"github.com/goto/optimus/client/cmd/internal/logger"
+	"github.com/goto/optimus/config"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+const listTimeout = time.Minute
+
+type listCommand struct {
+	logger     log.Logger
+	connection connection.Connection
+
+	configFilePath string
+	hosts          []string
+}
+
+// NewListCommand initializes command to list the plugins registered in the servers
+func NewListCommand() *cobra.Command {
+	list := &listCommand{
+		logger: logger.NewClientLogger(),
+	}
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List the plugins registered in the servers along with their capabilities and health",
+		Example: "optimus plugin list --host optimus-1:9100 --host optimus-2:9100",
+		PreRunE: list.PreRunE,
+		RunE:    list.RunE,
+	}
+	cmd.Flags().StringVarP(&list.configFilePath, "config", "c", config.EmptyPath, "File path for client configuration")
+	cmd.Flags().StringSliceVar(&list.hosts, "host", nil, "Targeted server hosts, by default taking from client config")
+	return cmd
+}
+
+func (l *listCommand) PreRunE(cmd *cobra.Command, _ []string) error {
+	conf, err := internal.LoadOptionalConfig(l.configFilePath)
+	if err != nil {
+		return err
+	}
+
+	if conf == nil {
+		internal.MarkFlagsRequired(cmd, []string{"host"})
+		return nil
+	}
+	if len(l.hosts) == 0 {
+		l.hosts = []string{conf.Host}
+	}
+	l.connection = connection.New(l.logger, conf)
+	return nil
+}
+
+func (l *listCommand) RunE(_ *cobra.Command, _ []string) error {
+	var failedHosts []string
+	for _, host := range l.hosts {
+		plugins, err := l.getPlugins(host)
+		if err != nil {
+			l.logger.Error("Unable to list plugins of %s: %s", host, err)
+			failedHosts = append(failedHosts, host)
+			continue
+		}
+		l.logger.Info("Plugins registered in %s", host)
+		l.logger.Info(stringifyPlugins(plugins))
+	}
+	if len(failedHosts) > 0 {
+		return fmt.Errorf("unable to list plugins of %s", strings.Join(failedHosts, ", "))
+	}
+	return nil
+}
+
+func (l *listCommand) getPlugins(host string) ([]*pb.ListPluginsResponse_Plugin, error) {
+	conn, err := l.connection.Create(host)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	ctx, cancelFunc := context.WithTimeout(context.Background(), listTimeout)
+	defer cancelFunc()
+
+	runtimeServiceClient := pb.NewRuntimeServiceClient(conn)
+	response, err := runtimeServiceClient.ListPlugins(ctx, &pb.ListPluginsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return response.GetPlugins(), nil
+}
+
+func stringifyPlugins(plugins []*pb.ListPluginsResponse_Plugin) string {
+	buff := &bytes.Buffer{}
+	table := tablewriter.NewWriter(buff)
+	table.SetBorder(false)
+	table.SetHeader([]string{
+		"Name",
+		"Type",
+		"Version",
+		"Yaml Mod",
+		"Dependency Mod",
+		"Asset Types",
+		"Health",
+	})
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	for _, p := range plugins {
+		table.Append([]string{
+			p.GetName(),
+			p.GetType(),
+			p.GetVersion(),
+			strconv.FormatBool(p.GetHasYamlMod()),
+			strconv.FormatBool(p.GetHasDependencyMod()),
+			strings.Join(p.GetAssetTypes(), ","),
+			p.GetHealth(),
+		})
+	}
+	table.Render()
+	return buff.String()
+}
@@ -16,6 +16,7 @@ func NewPluginCommand() *cobra.Command {
 		NewInstallCommand(),
 		NewValidateCommand(),
 		NewSyncCommand(),
+		NewListCommand(),
 	)
 	return cmd
 }
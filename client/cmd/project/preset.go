@@ -0,0 +1,232 @@
+package project
+
+import (
+	"bytes"
+	"context"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/goto/salt/log"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+
+	"github.com/goto/optimus/client/cmd/internal"
+	"github.com/goto/optimus/client/cmd/internal/connection"
+	"github.com/goto/optimus/client/cmd/internal/logger"
+	"github.com/goto/optimus/config"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+const presetTimeout = time.Minute
+
+type presetCommand struct {
+	logger     log.Logger
+	connection connection.Connection
+
+	configFilePath string
+
+	dirPath     string
+	host        string
+	projectName string
+}
+
+// NewPresetCommand initializes command to manage window presets of a project
+func NewPresetCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "preset",
+		Short:   "Commands to manage window presets of a project in the selected server",
+		Example: "optimus project preset [sub-command]",
+	}
+	cmd.AddCommand(
+		newPresetCreateCommand(),
+		newPresetListCommand(),
+		newPresetDeleteCommand(),
+	)
+	return cmd
+}
+
+func newPresetCreateCommand() *cobra.Command {
+	preset := &presetCommand{
+		logger: logger.NewClientLogger(),
+	}
+	var description, truncateTo, offset, size string
+
+	cmd := &cobra.Command{
+		Use:     "create",
+		Short:   "Creates or updates a window preset of the project",
+		Example: "optimus project preset create last_7_days --description \"last seven days\" --truncate-to d --size 168h",
+		Args:    cobra.ExactArgs(1),
+		PreRunE: preset.PreRunE,
+		RunE: func(_ *cobra.Command, args []string) error {
+			request := &pb.SavePresetRequest{
+				ProjectName: preset.projectName,
+				Name:        args[0],
+				Description: description,
+				TruncateTo:  truncateTo,
+				Offset:      offset,
+				Size:        size,
+			}
+
+			var response *pb.SavePresetResponse
+			err := preset.call(func(ctx context.Context, client pb.ProjectServiceClient) (err error) {
+				response, err = client.SavePreset(ctx, request)
+				return err
+			})
+			if err != nil {
+				return err
+			}
+			preset.logger.Info("Preset [%s] is saved with version %d", response.GetPreset().GetName(), response.GetPreset().GetVersion())
+			return nil
+		},
+	}
+
+	preset.injectFlags(cmd)
+	cmd.Flags().StringVar(&description, "description", "", "Description of the preset")
+	cmd.Flags().StringVar(&truncateTo, "truncate-to", "", "Truncate the window to the given unit, e.g. h, d, w, M")
+	cmd.Flags().StringVar(&offset, "offset", "", "Offset to shift the window, e.g. -1h")
+	cmd.Flags().StringVar(&size, "size", "", "Size of the window, e.g. 24h")
+	cmd.MarkFlagRequired("description")
+	return cmd
+}
+
+func newPresetListCommand() *cobra.Command {
+	preset := &presetCommand{
+		logger: logger.NewClientLogger(),
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "Lists the window presets of the project",
+		Example: "optimus project preset list [--flag]",
+		PreRunE: preset.PreRunE,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			var response *pb.ListPresetsResponse
+			err := preset.call(func(ctx context.Context, client pb.ProjectServiceClient) (err error) {
+				response, err = client.ListPresets(ctx, &pb.ListPresetsRequest{ProjectName: preset.projectName})
+				return err
+			})
+			if err != nil {
+				return err
+			}
+
+			if len(response.GetPresets()) == 0 {
+				preset.logger.Info("No presets were found in %s project.", preset.projectName)
+				return nil
+			}
+			preset.logger.Info("Presets for project: %s", preset.projectName)
+			preset.logger.Info(stringifyPresets(response.GetPresets()))
+			return nil
+		},
+	}
+
+	preset.injectFlags(cmd)
+	return cmd
+}
+
+func newPresetDeleteCommand() *cobra.Command {
+	preset := &presetCommand{
+		logger: logger.NewClientLogger(),
+	}
+
+	cmd := &cobra.Command{
+		Use:     "delete",
+		Short:   "Deletes a window preset of the project",
+		Example: "optimus project preset delete last_7_days [--flag]",
+		Args:    cobra.ExactArgs(1),
+		PreRunE: preset.PreRunE,
+		RunE: func(_ *cobra.Command, args []string) error {
+			request := &pb.DeletePresetRequest{
+				ProjectName: preset.projectName,
+				Name:        args[0],
+			}
+			err := preset.call(func(ctx context.Context, client pb.ProjectServiceClient) error {
+				_, err := client.DeletePreset(ctx, request)
+				return err
+			})
+			if err != nil {
+				return err
+			}
+			preset.logger.Info("Preset [%s] is deleted", args[0])
+			return nil
+		},
+	}
+
+	preset.injectFlags(cmd)
+	return cmd
+}
+
+func (p *presetCommand) injectFlags(cmd *cobra.Command) {
+	// Config filepath flag
+	cmd.Flags().StringVarP(&p.configFilePath, "config", "c", config.EmptyPath, "File path for client configuration")
+	cmd.Flags().StringVar(&p.dirPath, "dir", p.dirPath, "Directory where the Optimus client config resides")
+
+	// Mandatory flags if config is not set
+	cmd.Flags().StringVar(&p.host, "host", p.host, "Targeted server host, by default taking from client config")
+	cmd.Flags().StringVar(&p.projectName, "project-name", p.projectName, "Targeted project name, by default taking from client config")
+}
+
+func (p *presetCommand) PreRunE(cmd *cobra.Command, _ []string) error {
+	if p.dirPath != "" {
+		p.configFilePath = path.Join(p.dirPath, config.DefaultFilename)
+	}
+	// Load config
+	conf, err := internal.LoadOptionalConfig(p.configFilePath)
+	if err != nil {
+		return err
+	}
+
+	if conf == nil {
+		internal.MarkFlagsRequired(cmd, []string{"project-name", "host"})
+		return nil
+	}
+
+	if p.projectName == "" {
+		p.projectName = conf.Project.Name
+	}
+	if p.host == "" {
+		p.host = conf.Host
+	}
+	p.connection = connection.New(p.logger, conf)
+	return nil
+}
+
+func (p *presetCommand) call(fn func(ctx context.Context, client pb.ProjectServiceClient) error) error {
+	conn, err := p.connection.Create(p.host)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ctx, cancelFunc := context.WithTimeout(context.Background(), presetTimeout)
+	defer cancelFunc()
+
+	return fn(ctx, pb.NewProjectServiceClient(conn))
+}
+
+func stringifyPresets(presets []*pb.WindowPreset) string {
+	buff := &bytes.Buffer{}
+	table := tablewriter.NewWriter(buff)
+	table.SetBorder(false)
+	table.SetHeader([]string{
+		"Name",
+		"Description",
+		"Truncate To",
+		"Offset",
+		"Size",
+		"Version",
+	})
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	for _, preset := range presets {
+		table.Append([]string{
+			preset.GetName(),
+			preset.GetDescription(),
+			preset.GetTruncateTo(),
+			preset.GetOffset(),
+			preset.GetSize(),
+			strconv.Itoa(int(preset.GetVersion())),
+		})
+	}
+	table.Render()
+	return buff.String()
+}
@@ -14,6 +14,7 @@ func NewProjectCommand() *cobra.Command {
 	cmd.AddCommand(
 		NewRegisterCommand(),
 		NewDescribeCommand(),
+		NewPresetCommand(),
 	)
 	return cmd
 }
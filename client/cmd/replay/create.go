@@ -4,11 +4,15 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"io"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/goto/salt/log"
 	"github.com/spf13/cobra"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/goto/optimus/client/cmd/internal"
@@ -16,18 +20,23 @@ import (
 	"github.com/goto/optimus/client/cmd/internal/logger"
 	"github.com/goto/optimus/client/cmd/internal/progressbar"
 	"github.com/goto/optimus/config"
+	"github.com/goto/optimus/core/scheduler"
 	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
 )
 
 const (
-	replayTimeout        = time.Minute * 1
-	ISOTimeLayout        = time.RFC3339
-	pollIntervalInSecond = 30
+	replayTimeout = time.Minute * 1
+	ISOTimeLayout = time.RFC3339
 )
 
 var (
 	supportedISOTimeLayouts = [...]string{time.RFC3339, "2006-01-02"}
 	terminalStatuses        = map[string]bool{"success": true, "failed": true, "invalid": true}
+	finishedRunStatuses     = map[string]bool{
+		scheduler.StateSuccess.String(): true,
+		scheduler.StateFailed.String():  true,
+		scheduler.StateSkipped.String(): true,
+	}
 )
 
 type createCommand struct {
@@ -39,7 +48,13 @@ type createCommand struct {
 	parallel    bool
 	dryRun      bool
 	description string
+	reason      string
 	jobConfig   string
+	excludes    []string
+
+	fromFailed bool
+	jobName    string
+	since      string
 
 	projectName   string
 	namespaceName string
@@ -57,17 +72,11 @@ func CreateCommand() *cobra.Command {
 		Short: "Run replay operation on a dag based on provided start and end time range",
 		Long: "This operation takes three arguments, first is DAG name[required]\nused in optimus specification, " +
 			"second is start time[required] of\nreplay, third is end time[optional] of replay. \nDate ranges are inclusive. " +
-			"Supported date formats are RFC3339 and \nsimple date YYYY-MM-DD",
-		Example: "optimus replay create <job_name> <2023-01-01T02:30:00Z00:00> [2023-01-02T02:30:00Z00:00]\noptimus replay create <job_name> <2023-01-01> [2023-01-02]",
-		Args: func(cmd *cobra.Command, args []string) error {
-			if len(args) < 1 {
-				return errors.New("job name is required")
-			}
-			if len(args) < 2 { //nolint: gomnd
-				return errors.New("replay start time is required")
-			}
-			return nil
-		},
+			"Supported date formats are RFC3339 and \n" +
+			"simple date YYYY-MM-DD.\nWith --from-failed, only the failed and missing runs of the job since the given duration are replayed",
+		Example: "optimus replay create <job_name> <2023-01-01T02:30:00Z00:00> [2023-01-02T02:30:00Z00:00]\noptimus replay create <job_name> <2023-01-01> [2023-01-02]\n" +
+			"optimus replay create --from-failed --job <job_name> --since 7d",
+		Args:    refresh.validateArgs,
 		RunE:    refresh.RunE,
 		PreRunE: refresh.PreRunE,
 	}
@@ -83,8 +92,13 @@ func (r *createCommand) injectFlags(cmd *cobra.Command) {
 
 	cmd.Flags().BoolVarP(&r.parallel, "parallel", "", false, "Backfill job runs in parallel")
 	cmd.Flags().StringVarP(&r.description, "description", "d", "", "Description of why backfill is needed")
+	cmd.Flags().StringVarP(&r.reason, "reason", "", "", "Reason of the backfill, recorded for auditing")
 	cmd.Flags().StringVarP(&r.jobConfig, "job-config", "", "", "additional job configurations")
+	cmd.Flags().StringSliceVarP(&r.excludes, "exclude", "", nil, "Scheduled time of runs to be skipped within the range, can be repeated")
 	cmd.Flags().BoolVarP(&r.dryRun, "dry-run", "", false, "inspect replayed runs without taking effect on scheduler")
+	cmd.Flags().BoolVarP(&r.fromFailed, "from-failed", "", false, "Replay only the failed and missing runs found in the run history")
+	cmd.Flags().StringVarP(&r.jobName, "job", "", "", "Name of the job to replay, used along with --from-failed")
+	cmd.Flags().StringVarP(&r.since, "since", "", "", "How far back to look for failed runs, e.g. 7d or 12h, used along with --from-failed")
 
 	// Mandatory flags if config is not set
 	cmd.Flags().StringVarP(&r.projectName, "project-name", "p", "", "Name of the optimus project")
@@ -108,17 +122,37 @@ func (r *createCommand) PreRunE(cmd *cobra.Command, _ []string) error {
 	if r.host == "" {
 		r.host = conf.Host
 	}
+	if r.namespaceName == "" {
+		r.namespaceName = conf.DefaultNamespaceName()
+	}
 
 	r.connection = connection.New(r.logger, conf)
 	return nil
 }
 
+func (r *createCommand) validateArgs(_ *cobra.Command, args []string) error {
+	if r.fromFailed {
+		if r.jobName == "" && len(args) < 1 {
+			return errors.New("job name is required")
+		}
+		if r.since == "" {
+			return errors.New("since duration is required to replay from failed runs")
+		}
+		return nil
+	}
+	if len(args) < 1 {
+		return errors.New("job name is required")
+	}
+	if len(args) < 2 { //nolint: gomnd
+		return errors.New("replay start time is required")
+	}
+	return nil
+}
+
 func (r *createCommand) RunE(_ *cobra.Command, args []string) error {
-	jobName := args[0]
-	startTime := args[1]
-	endTime := args[1]
-	if len(args) >= 3 { //nolint: gomnd
-		endTime = args[2]
+	jobName, startTime, endTime, err := r.getReplayRange(args, time.Now().UTC())
+	if err != nil {
+		return err
 	}
 
 	replayReq, err := r.createReplayRequest(jobName, startTime, endTime, r.jobConfig)
@@ -126,16 +160,43 @@ func (r *createCommand) RunE(_ *cobra.Command, args []string) error {
 		return err
 	}
 
+	excludedScheduledAt, err := getExcludedScheduledAt(r.excludes)
+	if err != nil {
+		return err
+	}
+
 	if r.dryRun {
 		replayDryRunReq := convertReplayToReplayDryRunRequest(replayReq)
-		err := r.replayDryRun(replayDryRunReq)
+		err := r.replayDryRun(replayDryRunReq, excludedScheduledAt)
 		if err != nil {
 			return err
 		}
 		return nil
 	}
 
-	return r.replay(replayReq)
+	return r.replay(replayReq, excludedScheduledAt)
+}
+
+// getReplayRange returns the job name along with the start and end time of the replay, when replaying from failed runs
+// the range covers the given since duration up to now
+func (r *createCommand) getReplayRange(args []string, now time.Time) (jobName, startTime, endTime string, err error) {
+	if !r.fromFailed {
+		endTime = args[1]
+		if len(args) >= 3 { //nolint: gomnd
+			endTime = args[2]
+		}
+		return args[0], args[1], endTime, nil
+	}
+
+	jobName = r.jobName
+	if jobName == "" {
+		jobName = args[0]
+	}
+	since, err := getSinceDuration(r.since)
+	if err != nil {
+		return "", "", "", err
+	}
+	return jobName, now.Add(-since).Format(ISOTimeLayout), now.Format(ISOTimeLayout), nil
 }
 
 func convertReplayToReplayDryRunRequest(replayReq *pb.ReplayRequest) *pb.ReplayDryRunRequest {
@@ -151,7 +212,7 @@ func convertReplayToReplayDryRunRequest(replayReq *pb.ReplayRequest) *pb.ReplayD
 	}
 }
 
-func (r *createCommand) replayDryRun(replayDryRunReq *pb.ReplayDryRunRequest) error {
+func (r *createCommand) replayDryRun(replayDryRunReq *pb.ReplayDryRunRequest, excludedScheduledAt string) error {
 	conn, err := r.connection.Create(r.host)
 	if err != nil {
 		return err
@@ -163,6 +224,10 @@ func (r *createCommand) replayDryRun(replayDryRunReq *pb.ReplayDryRunRequest) er
 	ctx, cancelFunc := context.WithTimeout(context.Background(), replayTimeout)
 	defer cancelFunc()
 
+	ctx = metadata.AppendToOutgoingContext(ctx,
+		scheduler.ReplayExcludedScheduledAtMetadataKey, excludedScheduledAt,
+		scheduler.ReplayFromFailedMetadataKey, strconv.FormatBool(r.fromFailed),
+	)
 	resp, err := replayService.ReplayDryRun(ctx, replayDryRunReq)
 	if err != nil {
 		if errors.Is(err, context.DeadlineExceeded) {
@@ -182,7 +247,7 @@ func (r *createCommand) replayDryRun(replayDryRunReq *pb.ReplayDryRunRequest) er
 	return nil
 }
 
-func (r *createCommand) replay(replayReq *pb.ReplayRequest) error {
+func (r *createCommand) replay(replayReq *pb.ReplayRequest, excludedScheduledAt string) error {
 	conn, err := r.connection.Create(r.host)
 	if err != nil {
 		return err
@@ -194,6 +259,11 @@ func (r *createCommand) replay(replayReq *pb.ReplayRequest) error {
 	ctx, cancelFunc := context.WithTimeout(context.Background(), replayTimeout)
 	defer cancelFunc()
 
+	ctx = metadata.AppendToOutgoingContext(ctx,
+		scheduler.ReplayReasonMetadataKey, r.reason,
+		scheduler.ReplayExcludedScheduledAtMetadataKey, excludedScheduledAt,
+		scheduler.ReplayFromFailedMetadataKey, strconv.FormatBool(r.fromFailed),
+	)
 	resp, err := replayService.Replay(ctx, replayReq)
 	if err != nil {
 		if errors.Is(err, context.DeadlineExceeded) {
@@ -205,36 +275,61 @@ func (r *createCommand) replay(replayReq *pb.ReplayRequest) error {
 	r.logger.Info("Replay request is accepted and it is in progress")
 	r.logger.Info("Either you could wait or you could close (ctrl+c) and check the status with `optimus replay status %s` command later", resp.Id)
 
-	return r.waitForReplayState(resp.Id)
+	return r.waitForReplayState(replayService, resp.Id)
 }
 
-func (r *createCommand) waitForReplayState(replayID string) error {
-	spinner := progressbar.NewProgressBarWithWriter(r.logger.Writer())
-	status := "created"
-	spinner.Start(fmt.Sprintf("%s...", status))
+// waitForReplayState follows the status streamed for the replay, showing the progress of its runs until the replay is done
+func (r *createCommand) waitForReplayState(replayService pb.ReplayServiceClient, replayID string) error {
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	defer cancelFunc()
+
+	stream, err := replayService.StreamReplayStatus(ctx, &pb.StreamReplayStatusRequest{
+		ProjectName: r.projectName,
+		ReplayId:    replayID,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to stream status of replay %s: %w", replayID, err)
+	}
+
+	bar := progressbar.NewProgressBarWithWriter(r.logger.Writer())
+	defer bar.Stop()
+
+	status := ""
 	for {
-		resp, err := r.getReplay(replayID)
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			r.logger.Warn("Replay status is no longer streamed, check it with `optimus replay status %s` command", replayID)
+			return nil
+		}
 		if err != nil {
-			return err
+			return fmt.Errorf("unable to stream status of replay %s: %w", replayID, err)
+		}
+
+		if status != resp.GetStatus() {
+			status = resp.GetStatus()
+			bar.Stop()
+			bar.StartProgress(len(resp.GetReplayRuns()), status)
 		}
-		if status != resp.Status {
-			status = resp.Status
-			spinner.StartNewLine(fmt.Sprintf("%s...", status))
+		if err := bar.SetProgress(countFinishedRuns(resp.GetReplayRuns())); err != nil {
+			return err
 		}
+
 		if _, ok := terminalStatuses[status]; ok {
-			spinner.StartNewLine("\n")
-			spinner.Stop()
+			bar.Stop()
 			r.logger.Info("\n" + stringifyReplayStatus(resp))
-			break
+			return nil
 		}
-		time.Sleep(time.Duration(pollIntervalInSecond) * time.Second)
 	}
-	spinner.Stop()
-	return nil
 }
 
-func (r *createCommand) getReplay(replayID string) (*pb.GetReplayResponse, error) {
-	return getReplay(r.host, replayID, r.connection)
+func countFinishedRuns(runs []*pb.ReplayRun) int {
+	finished := 0
+	for _, run := range runs {
+		if _, ok := finishedRunStatuses[run.GetStatus()]; ok {
+			finished++
+		}
+	}
+	return finished
 }
 
 func (r *createCommand) createReplayRequest(jobName, startTimeStr, endTimeStr, jobConfig string) (*pb.ReplayRequest, error) {
@@ -260,6 +355,36 @@ func (r *createCommand) createReplayRequest(jobName, startTimeStr, endTimeStr, j
 	return replayReq, nil
 }
 
+// getExcludedScheduledAt converts the excluded times into comma separated RFC3339 values to be sent along with the request
+func getExcludedScheduledAt(excludes []string) (string, error) {
+	excludedScheduledAt := make([]string, len(excludes))
+	for i, exclude := range excludes {
+		excludedTime, err := getTimeProto(exclude)
+		if err != nil {
+			return "", fmt.Errorf("invalid excluded time %s: %w", exclude, err)
+		}
+		excludedScheduledAt[i] = excludedTime.AsTime().Format(ISOTimeLayout)
+	}
+	return strings.Join(excludedScheduledAt, ","), nil
+}
+
+// getSinceDuration parses the since duration, on top of the units supported by time.ParseDuration it accepts days, e.g. 7d
+func getSinceDuration(since string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(since, "d"); ok {
+		numOfDays, err := strconv.Atoi(days)
+		if err != nil || numOfDays <= 0 {
+			return 0, fmt.Errorf("invalid since duration %s", since)
+		}
+		return time.Duration(numOfDays) * 24 * time.Hour, nil //nolint: gomnd
+	}
+
+	duration, err := time.ParseDuration(since)
+	if err != nil || duration <= 0 {
+		return 0, fmt.Errorf("invalid since duration %s", since)
+	}
+	return duration, nil
+}
+
 func getTimeProto(timeStr string) (*timestamppb.Timestamp, error) {
 	var parsedTime time.Time
 	var err error
@@ -96,17 +96,17 @@ func (r *statusCommand) RunE(_ *cobra.Command, args []string) error {
 }
 
 func (r *statusCommand) getReplay(replayID string) (*pb.GetReplayResponse, error) {
-	return getReplay(r.host, replayID, r.connection)
+	return getReplay(r.host, r.projectName, replayID, r.connection)
 }
 
-func getReplay(host, replayID string, connection connection.Connection) (*pb.GetReplayResponse, error) {
+func getReplay(host, projectName, replayID string, connection connection.Connection) (*pb.GetReplayResponse, error) {
 	conn, err := connection.Create(host)
 	if err != nil {
 		return nil, err
 	}
 	defer conn.Close()
 
-	req := &pb.GetReplayRequest{ReplayId: replayID}
+	req := &pb.GetReplayRequest{ProjectName: projectName, ReplayId: replayID}
 
 	replayService := pb.NewReplayServiceClient(conn)
 
@@ -88,8 +88,12 @@ func (a *applyCommand) RunE(_ *cobra.Command, _ []string) error {
 		a.projectName = a.clientConfig.Project.Name
 	}
 
+	if a.namespaceName == "" {
+		a.namespaceName = a.clientConfig.DefaultNamespaceName()
+	}
+
 	var namespace *config.Namespace
-	// use flag or ask namespace name
+	// use flag, current context or ask namespace name
 	if a.namespaceName == "" {
 		var err error
 		namespace, err = a.namespaceSurvey.AskToSelectNamespace(a.clientConfig)
@@ -0,0 +1,241 @@
+package scheduler
+
+import (
+	"bytes"
+	"context"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/goto/salt/log"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+
+	"github.com/goto/optimus/client/cmd/internal"
+	"github.com/goto/optimus/client/cmd/internal/connection"
+	"github.com/goto/optimus/client/cmd/internal/logger"
+	"github.com/goto/optimus/config"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+const silenceTimeout = time.Minute
+
+type silenceCommand struct {
+	logger     log.Logger
+	connection connection.Connection
+
+	configFilePath string
+
+	dirPath     string
+	host        string
+	projectName string
+}
+
+// NewSilenceCommand initializes command to manage alert silences of a project
+func NewSilenceCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "silence",
+		Short:   "Commands to silence job alerts during a planned maintenance",
+		Example: "optimus scheduler silence [sub-command]",
+	}
+	cmd.AddCommand(
+		newSilenceCreateCommand(),
+		newSilenceListCommand(),
+		newSilenceExpireCommand(),
+	)
+	return cmd
+}
+
+func newSilenceCreateCommand() *cobra.Command {
+	silence := &silenceCommand{
+		logger: logger.NewClientLogger(),
+	}
+	var namespaceName, jobName, reason, expiresAt string
+	var labels map[string]string
+
+	cmd := &cobra.Command{
+		Use:     "create",
+		Short:   "Silences the alerts of the jobs selected by namespace, job name or labels until the silence expires",
+		Example: "optimus scheduler silence create --namespace-name sample --labels team=data --expires-at 2h --reason \"warehouse maintenance\"",
+		PreRunE: silence.PreRunE,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			err := silence.call(func(ctx context.Context, client pb.AlertSilenceServiceClient) error {
+				_, err := client.CreateAlertSilence(ctx, &pb.CreateAlertSilenceRequest{
+					ProjectName:   silence.projectName,
+					NamespaceName: namespaceName,
+					JobName:       jobName,
+					Labels:        labels,
+					Reason:        reason,
+					ExpiresAt:     expiresAt,
+				})
+				return err
+			})
+			if err != nil {
+				return err
+			}
+			silence.logger.Info("Alerts are silenced until %s", expiresAt)
+			return nil
+		},
+	}
+
+	silence.injectFlags(cmd)
+	cmd.Flags().StringVar(&namespaceName, "namespace-name", "", "Silence the alerts of the jobs in the namespace")
+	cmd.Flags().StringVar(&jobName, "job-name", "", "Silence the alerts of the job")
+	cmd.Flags().StringToStringVar(&labels, "labels", nil, "Silence the alerts of the jobs having all the labels, e.g. team=data")
+	cmd.Flags().StringVar(&reason, "reason", "", "Reason of the silence")
+	cmd.Flags().StringVar(&expiresAt, "expires-at", "", "Expiry of the silence as RFC3339 timestamp or duration from now, e.g. 2h")
+	cmd.MarkFlagRequired("reason")
+	cmd.MarkFlagRequired("expires-at")
+	return cmd
+}
+
+func newSilenceListCommand() *cobra.Command {
+	silence := &silenceCommand{
+		logger: logger.NewClientLogger(),
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "Lists the active alert silences of the project",
+		Example: "optimus scheduler silence list [--flag]",
+		PreRunE: silence.PreRunE,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			var silences []*pb.AlertSilence
+			err := silence.call(func(ctx context.Context, client pb.AlertSilenceServiceClient) error {
+				response, err := client.ListAlertSilences(ctx, &pb.ListAlertSilencesRequest{ProjectName: silence.projectName})
+				silences = response.GetSilences()
+				return err
+			})
+			if err != nil {
+				return err
+			}
+
+			if len(silences) == 0 {
+				silence.logger.Info("No active silences were found in %s project.", silence.projectName)
+				return nil
+			}
+			silence.logger.Info("Active silences for project: %s", silence.projectName)
+			silence.logger.Info(stringifySilences(silences))
+			return nil
+		},
+	}
+
+	silence.injectFlags(cmd)
+	return cmd
+}
+
+func newSilenceExpireCommand() *cobra.Command {
+	silence := &silenceCommand{
+		logger: logger.NewClientLogger(),
+	}
+
+	cmd := &cobra.Command{
+		Use:     "expire",
+		Short:   "Expires an alert silence so the alerts are sent again",
+		Example: "optimus scheduler silence expire <silence-id> [--flag]",
+		Args:    cobra.ExactArgs(1),
+		PreRunE: silence.PreRunE,
+		RunE: func(_ *cobra.Command, args []string) error {
+			err := silence.call(func(ctx context.Context, client pb.AlertSilenceServiceClient) error {
+				_, err := client.ExpireAlertSilence(ctx, &pb.ExpireAlertSilenceRequest{
+					ProjectName: silence.projectName,
+					Id:          args[0],
+				})
+				return err
+			})
+			if err != nil {
+				return err
+			}
+			silence.logger.Info("Silence [%s] is expired", args[0])
+			return nil
+		},
+	}
+
+	silence.injectFlags(cmd)
+	return cmd
+}
+
+func (s *silenceCommand) injectFlags(cmd *cobra.Command) {
+	// Config filepath flag
+	cmd.Flags().StringVarP(&s.configFilePath, "config", "c", config.EmptyPath, "File path for client configuration")
+	cmd.Flags().StringVar(&s.dirPath, "dir", s.dirPath, "Directory where the Optimus client config resides")
+
+	// Mandatory flags if config is not set
+	cmd.Flags().StringVar(&s.host, "host", s.host, "Targeted server host, by default taking from client config")
+	cmd.Flags().StringVar(&s.projectName, "project-name", s.projectName, "Targeted project name, by default taking from client config")
+}
+
+func (s *silenceCommand) PreRunE(cmd *cobra.Command, _ []string) error {
+	if s.dirPath != "" {
+		s.configFilePath = path.Join(s.dirPath, config.DefaultFilename)
+	}
+	// Load config
+	conf, err := internal.LoadOptionalConfig(s.configFilePath)
+	if err != nil {
+		return err
+	}
+
+	if conf == nil {
+		internal.MarkFlagsRequired(cmd, []string{"project-name", "host"})
+		s.connection = connection.NewInsecure(s.logger)
+		return nil
+	}
+
+	if s.projectName == "" {
+		s.projectName = conf.Project.Name
+	}
+	if s.host == "" {
+		s.host = conf.Host
+	}
+	s.connection = connection.New(s.logger, conf)
+	return nil
+}
+
+func (s *silenceCommand) call(fn func(context.Context, pb.AlertSilenceServiceClient) error) error {
+	conn, err := s.connection.Create(s.host)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ctx, cancelFunc := context.WithTimeout(context.Background(), silenceTimeout)
+	defer cancelFunc()
+
+	return fn(ctx, pb.NewAlertSilenceServiceClient(conn))
+}
+
+func stringifySilences(silences []*pb.AlertSilence) string {
+	buff := &bytes.Buffer{}
+	table := tablewriter.NewWriter(buff)
+	table.SetBorder(false)
+	table.SetHeader([]string{
+		"ID",
+		"Namespace",
+		"Job",
+		"Labels",
+		"Reason",
+		"Created By",
+		"Expires At",
+	})
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	for _, silence := range silences {
+		labels := make([]string, 0, len(silence.GetLabels()))
+		for key, value := range silence.GetLabels() {
+			labels = append(labels, key+"="+value)
+		}
+		sort.Strings(labels)
+
+		table.Append([]string{
+			silence.GetId(),
+			silence.GetNamespaceName(),
+			silence.GetJobName(),
+			strings.Join(labels, ","),
+			silence.GetReason(),
+			silence.GetCreatedBy(),
+			silence.GetExpiresAt().AsTime().Format(time.RFC3339),
+		})
+	}
+	table.Render()
+	return buff.String()
+}
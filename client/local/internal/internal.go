@@ -2,6 +2,7 @@ package internal
 
 import (
 	"fmt"
+	"io"
 	"io/fs"
 	"os"
 	"path/filepath"
@@ -56,8 +57,15 @@ func WriteSpec[S local.ValidSpec](specFS afero.Fs, filePath string, spec S) erro
 	if err != nil {
 		return fmt.Errorf("error creating spec under [%s]: %w", filePath, err)
 	}
+	defer fileSpec.Close()
+
+	return EncodeSpec(fileSpec, spec)
+}
+
+// EncodeSpec writes the spec in the canonical yaml format used when specs are written by optimus
+func EncodeSpec[S local.ValidSpec](w io.Writer, spec S) error {
 	indent := 2
-	encoder := yaml.NewEncoder(fileSpec)
+	encoder := yaml.NewEncoder(w)
 	encoder.SetIndent(indent)
 	return encoder.Encode(spec)
 }
@@ -0,0 +1,235 @@
+package model
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+type JobPlanAction string
+
+const (
+	JobPlanCreate JobPlanAction = "create"
+	JobPlanUpdate JobPlanAction = "update"
+	JobPlanDelete JobPlanAction = "delete"
+	JobPlanNoOp   JobPlanAction = "no-op"
+
+	catchUpLastOnly = "last_only"
+)
+
+// JobPlan is what a deployment does to a job, with the changes it makes to the spec deployed on the server
+type JobPlan struct {
+	Name    string
+	Action  JobPlanAction
+	Changes []string
+}
+
+// PlanJobs compares the local job specs against the ones deployed on the server, the plans are sorted by job name
+func PlanJobs(localSpecs, serverSpecs []*JobSpec) []*JobPlan {
+	serverByName := make(map[string]*JobSpec, len(serverSpecs))
+	for _, spec := range serverSpecs {
+		serverByName[spec.Name] = spec
+	}
+
+	plans := make([]*JobPlan, 0, len(localSpecs))
+	localNames := make(map[string]bool, len(localSpecs))
+	for _, local := range localSpecs {
+		localNames[local.Name] = true
+		server, ok := serverByName[local.Name]
+		if !ok {
+			plans = append(plans, &JobPlan{Name: local.Name, Action: JobPlanCreate})
+			continue
+		}
+
+		// both specs go through the same proto mapping, so only the changes sent to the server are compared
+		changes := diffJobSpec(ToJobSpec(server.ToProto()), ToJobSpec(local.ToProto()))
+		action := JobPlanUpdate
+		if len(changes) == 0 {
+			action = JobPlanNoOp
+		}
+		plans = append(plans, &JobPlan{Name: local.Name, Action: action, Changes: changes})
+	}
+	for _, server := range serverSpecs {
+		if !localNames[server.Name] {
+			plans = append(plans, &JobPlan{Name: server.Name, Action: JobPlanDelete})
+		}
+	}
+
+	sort.Slice(plans, func(i, j int) bool {
+		return plans[i].Name < plans[j].Name
+	})
+	return plans
+}
+
+func diffJobSpec(server, local *JobSpec) []string {
+	var changes []string
+	changes = append(changes, diffValue("owner", server.Owner, local.Owner)...)
+	changes = append(changes, diffValue("description", server.Description, local.Description)...)
+
+	changes = append(changes, diffValue("schedule.start_date", server.Schedule.StartDate, local.Schedule.StartDate)...)
+	changes = append(changes, diffValue("schedule.end_date", server.Schedule.EndDate, local.Schedule.EndDate)...)
+	changes = append(changes, diffValue("schedule.interval", server.Schedule.Interval, local.Schedule.Interval)...)
+	changes = append(changes, diffValue("schedule.catch_up", normalizeCatchUp(server.Schedule.CatchUp), normalizeCatchUp(local.Schedule.CatchUp))...)
+
+	changes = append(changes, diffValue("behavior.depends_on_past", server.Behavior.DependsOnPast, local.Behavior.DependsOnPast)...)
+	changes = append(changes, diffChanged("behavior.retry", server.Behavior.Retry, local.Behavior.Retry)...)
+	changes = append(changes, diffChanged("behavior.notify", server.Behavior.Notify, local.Behavior.Notify)...)
+
+	changes = append(changes, diffValue("task.name", server.Task.Name, local.Task.Name)...)
+	changes = append(changes, diffValue("task.version", server.Task.Version, local.Task.Version)...)
+	changes = append(changes, diffMap("task.config", server.Task.Config, local.Task.Config)...)
+	changes = append(changes, diffChanged("task.window", server.Task.Window, local.Task.Window)...)
+	changes = append(changes, diffMapKeys("asset", server.Asset, local.Asset)...)
+	changes = append(changes, diffMap("labels", server.Labels, local.Labels)...)
+
+	changes = append(changes, diffHooks(server.Hooks, local.Hooks)...)
+
+	changes = append(changes, diffList("dependencies", dependencyNames(server.Dependencies), dependencyNames(local.Dependencies))...)
+	var serverUpstreams, localUpstreams JobSpecUpstreams
+	if server.Upstreams != nil {
+		serverUpstreams = *server.Upstreams
+	}
+	if local.Upstreams != nil {
+		localUpstreams = *local.Upstreams
+	}
+	changes = append(changes, diffList("upstreams.ignore", serverUpstreams.Ignore, localUpstreams.Ignore)...)
+	changes = append(changes, diffList("upstreams.extra", serverUpstreams.Extra, localUpstreams.Extra)...)
+	changes = append(changes, diffChanged("metadata", server.Metadata, local.Metadata)...)
+	return changes
+}
+
+func diffHooks(serverHooks, localHooks []JobSpecHook) []string {
+	serverByName := map[string]JobSpecHook{}
+	for _, hook := range serverHooks {
+		serverByName[hook.Name] = hook
+	}
+
+	var changes []string
+	localNames := map[string]bool{}
+	for _, hook := range localHooks {
+		localNames[hook.Name] = true
+		serverHook, ok := serverByName[hook.Name]
+		if !ok {
+			changes = append(changes, fmt.Sprintf("hooks.%s: added", hook.Name))
+			continue
+		}
+		prefix := "hooks." + hook.Name
+		changes = append(changes, diffMap(prefix+".config", serverHook.Config, hook.Config)...)
+		changes = append(changes, diffValue(prefix+".enabled_when", serverHook.EnabledWhen, hook.EnabledWhen)...)
+		changes = append(changes, diffValue(prefix+".phase", serverHook.Phase, hook.Phase)...)
+		changes = append(changes, diffList(prefix+".depends_on", serverHook.DependsOn, hook.DependsOn)...)
+		changes = append(changes, diffValue(prefix+".when", serverHook.When, hook.When)...)
+	}
+	for _, hook := range serverHooks {
+		if !localNames[hook.Name] {
+			changes = append(changes, fmt.Sprintf("hooks.%s: removed", hook.Name))
+		}
+	}
+	return changes
+}
+
+func diffValue[V string | bool](field string, server, local V) []string {
+	if server == local {
+		return nil
+	}
+	return []string{fmt.Sprintf("%s: %q -> %q", field, fmt.Sprint(server), fmt.Sprint(local))}
+}
+
+// diffChanged reports the field as changed without its values, for the fields too nested to print
+func diffChanged(field string, server, local interface{}) []string {
+	if reflect.DeepEqual(server, local) {
+		return nil
+	}
+	return []string{field + ": changed"}
+}
+
+func diffMap(field string, server, local map[string]string) []string {
+	var changes []string
+	for _, key := range sortedKeys(local) {
+		serverValue, ok := server[key]
+		if !ok {
+			changes = append(changes, fmt.Sprintf("%s.%s: added %q", field, key, local[key]))
+			continue
+		}
+		changes = append(changes, diffValue(field+"."+key, serverValue, local[key])...)
+	}
+	for _, key := range sortedKeys(server) {
+		if _, ok := local[key]; !ok {
+			changes = append(changes, fmt.Sprintf("%s.%s: removed", field, key))
+		}
+	}
+	return changes
+}
+
+// diffMapKeys reports the changed keys of the map without their values, for the values too long to print
+func diffMapKeys(field string, server, local map[string]string) []string {
+	var changes []string
+	for _, key := range sortedKeys(local) {
+		serverValue, ok := server[key]
+		if !ok {
+			changes = append(changes, fmt.Sprintf("%s.%s: added", field, key))
+		} else if serverValue != local[key] {
+			changes = append(changes, fmt.Sprintf("%s.%s: changed", field, key))
+		}
+	}
+	for _, key := range sortedKeys(server) {
+		if _, ok := local[key]; !ok {
+			changes = append(changes, fmt.Sprintf("%s.%s: removed", field, key))
+		}
+	}
+	return changes
+}
+
+func diffList(field string, server, local []string) []string {
+	serverItems := map[string]bool{}
+	for _, item := range server {
+		serverItems[item] = true
+	}
+	localItems := map[string]bool{}
+	for _, item := range local {
+		localItems[item] = true
+	}
+
+	var changes []string
+	for _, item := range local {
+		if !serverItems[item] {
+			changes = append(changes, fmt.Sprintf("%s: added %s", field, item))
+		}
+	}
+	for _, item := range server {
+		if !localItems[item] {
+			changes = append(changes, fmt.Sprintf("%s: removed %s", field, item))
+		}
+	}
+	return changes
+}
+
+func dependencyNames(dependencies []JobSpecDependency) []string {
+	names := make([]string, 0, len(dependencies))
+	for _, dependency := range dependencies {
+		switch {
+		case dependency.JobName != "":
+			names = append(names, dependency.JobName)
+		case dependency.HTTP != nil:
+			names = append(names, "http:"+dependency.HTTP.Name)
+		}
+	}
+	return names
+}
+
+func normalizeCatchUp(catchUp string) string {
+	if catchUp == "" {
+		return catchUpLastOnly
+	}
+	return strings.ToLower(catchUp)
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,70 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/client/local/model"
+)
+
+func TestPlanJobs(t *testing.T) {
+	newSpec := func(name string) *model.JobSpec {
+		return &model.JobSpec{
+			Version: 1,
+			Name:    name,
+			Owner:   "data-team",
+			Schedule: model.JobSpecSchedule{
+				StartDate: "2023-10-01",
+				Interval:  "0 2 * * *",
+			},
+			Task: model.JobSpecTask{
+				Name:   "bq2bq",
+				Config: map[string]string{"LOAD_METHOD": "APPEND", "PROJECT": "proj"},
+			},
+			Hooks:        []model.JobSpecHook{{Name: "predator", Config: map[string]string{"FILTER": "a"}}},
+			Dependencies: []model.JobSpecDependency{{JobName: "upstream_job"}},
+		}
+	}
+
+	t.Run("plans the creation of the jobs not on the server and the deletion of the ones not local", func(t *testing.T) {
+		plans := model.PlanJobs([]*model.JobSpec{newSpec("job_b")}, []*model.JobSpec{newSpec("job_a")})
+
+		assert.Equal(t, []*model.JobPlan{
+			{Name: "job_a", Action: model.JobPlanDelete},
+			{Name: "job_b", Action: model.JobPlanCreate},
+		}, plans)
+	})
+	t.Run("plans no change for the jobs matching the server", func(t *testing.T) {
+		local := newSpec("job_a")
+		local.Schedule.CatchUp = "last_only"
+
+		plans := model.PlanJobs([]*model.JobSpec{local}, []*model.JobSpec{newSpec("job_a")})
+
+		assert.Len(t, plans, 1)
+		assert.Equal(t, model.JobPlanNoOp, plans[0].Action)
+		assert.Empty(t, plans[0].Changes)
+	})
+	t.Run("plans the update of the jobs with the changed parts of the spec", func(t *testing.T) {
+		local := newSpec("job_a")
+		local.Schedule.Interval = "0 3 * * *"
+		local.Task.Config = map[string]string{"LOAD_METHOD": "REPLACE", "DATASET": "playground"}
+		local.Hooks = []model.JobSpecHook{{Name: "transporter"}}
+		local.Dependencies = []model.JobSpecDependency{{JobName: "other_upstream_job"}}
+
+		plans := model.PlanJobs([]*model.JobSpec{local}, []*model.JobSpec{newSpec("job_a")})
+
+		assert.Len(t, plans, 1)
+		assert.Equal(t, model.JobPlanUpdate, plans[0].Action)
+		assert.Equal(t, []string{
+			`schedule.interval: "0 2 * * *" -> "0 3 * * *"`,
+			`task.config.DATASET: added "playground"`,
+			`task.config.LOAD_METHOD: "APPEND" -> "REPLACE"`,
+			`task.config.PROJECT: removed`,
+			`hooks.transporter: added`,
+			`hooks.predator: removed`,
+			`dependencies: added other_upstream_job`,
+			`dependencies: removed upstream_job`,
+		}, plans[0].Changes)
+	})
+}
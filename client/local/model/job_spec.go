@@ -1,6 +1,7 @@
 package model
 
 import (
+	"strings"
 	"time"
 
 	"google.golang.org/protobuf/types/known/durationpb"
@@ -9,6 +10,37 @@ import (
 	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
 )
 
+const (
+	// hookConfigEnabledWhen carries the enabled_when condition of a hook in the hook config of the proto
+	hookConfigEnabledWhen = "ENABLED_WHEN"
+	// hookConfigPhase and hookConfigDependsOn carry the phase and the comma separated depends_on of a hook
+	hookConfigPhase     = "HOOK_PHASE"
+	hookConfigDependsOn = "HOOK_DEPENDS_ON"
+	// hookConfigWhen carries the when condition of a hook, compiled for every run
+	hookConfigWhen = "HOOK_WHEN"
+)
+
+const (
+	// dependencyTypeSensor marks a dependency in the proto carrying the sensor config of an upstream instead of
+	// an upstream, the poke interval and timeout are passed as the params of its http dependency
+	dependencyTypeSensor = "sensor"
+	// dependencyTypeIgnore and dependencyTypeExtra mark a dependency in the proto carrying, as its name, a resource
+	// to be removed from or added to the upstreams generated by the plugin
+	dependencyTypeIgnore = "upstream_ignore"
+	dependencyTypeExtra  = "upstream_extra"
+
+	sensorParamPokeInterval             = "poke_interval"
+	sensorParamTimeout                  = "timeout"
+	sensorParamFreshnessPartitionExists = "freshness_partition_exists"
+	sensorParamFreshnessMaxAge          = "freshness_max_age"
+
+	// taskVersionSeparator separates the name of the task and the version of the plugin pinned by the job
+	taskVersionSeparator = "@"
+	// taskConfigCatchUp carries the catch up policy of the job in the task config of the proto
+	taskConfigCatchUp = "CATCH_UP"
+	catchUpFull       = "full"
+)
+
 type JobSpec struct {
 	Version      int                 `yaml:"version,omitempty"`
 	Name         string              `yaml:"name"`
@@ -21,6 +53,7 @@ type JobSpec struct {
 	Labels       map[string]string   `yaml:"labels,omitempty"`
 	Hooks        []JobSpecHook       `yaml:"hooks"`
 	Dependencies []JobSpecDependency `yaml:"dependencies"`
+	Upstreams    *JobSpecUpstreams   `yaml:"upstreams,omitempty"`
 	Metadata     *JobSpecMetadata    `yaml:"metadata,omitempty"`
 	Path         string              `yaml:"-"`
 }
@@ -29,6 +62,8 @@ type JobSpecSchedule struct {
 	StartDate string `yaml:"start_date"`
 	EndDate   string `yaml:"end_date,omitempty"`
 	Interval  string `yaml:"interval"`
+	// CatchUp is one of none, last_only or full, runs missed before the deployment are not backfilled by default
+	CatchUp string `yaml:"catch_up,omitempty"`
 }
 
 type JobSpecBehavior struct {
@@ -50,9 +85,11 @@ type JobSpecBehaviorNotifier struct {
 }
 
 type JobSpecTask struct {
-	Name   string            `yaml:"name"`
-	Config map[string]string `yaml:"config,omitempty"`
-	Window JobSpecTaskWindow `yaml:"window,omitempty"`
+	Name string `yaml:"name"`
+	// Version pins the version of the plugin running the task, the default version of the server is used when empty
+	Version string            `yaml:"version,omitempty"`
+	Config  map[string]string `yaml:"config,omitempty"`
+	Window  JobSpecTaskWindow `yaml:"window,omitempty"`
 }
 
 type JobSpecTaskWindow struct {
@@ -65,6 +102,16 @@ type JobSpecTaskWindow struct {
 type JobSpecHook struct {
 	Name   string            `yaml:"name"`
 	Config map[string]string `yaml:"config,omitempty"`
+	// EnabledWhen is a template compiled against the project and namespace configs on deployment,
+	// the hook runs only when it compiles to true
+	EnabledWhen string `yaml:"enabled_when,omitempty"`
+	// Phase runs the hook before (pre) or after (post) the task regardless of the hook type of the plugin
+	Phase string `yaml:"phase,omitempty"`
+	// DependsOn lists the hooks of the job which should finish before the hook runs
+	DependsOn []string `yaml:"depends_on,omitempty"`
+	// When is a template compiled against the task context of every run, the hook is skipped
+	// in the runs it does not compile to true
+	When string `yaml:"when,omitempty"`
 }
 
 type JobSpecDependency struct {
@@ -73,6 +120,14 @@ type JobSpecDependency struct {
 	HTTP    *JobSpecDependencyHTTP `yaml:"http,omitempty"`
 }
 
+// JobSpecUpstreams overrides the upstream resources detected by the plugin, referred by their urn
+type JobSpecUpstreams struct {
+	// Ignore lists the detected resources which are not actual upstreams of the job
+	Ignore []string `yaml:"ignore,omitempty"`
+	// Extra lists the resources the job reads from which are missed by the plugin
+	Extra []string `yaml:"extra,omitempty"`
+}
+
 type JobSpecDependencyHTTP struct {
 	Name          string            `yaml:"name"`
 	RequestParams map[string]string `yaml:"params,omitempty"`
@@ -96,8 +151,34 @@ type JobSpecMetadataResourceConfig struct {
 }
 
 type JobSpecMetadataAirflow struct {
-	Pool  string `yaml:"pool" json:"pool"`
-	Queue string `yaml:"queue" json:"queue"`
+	Pool   string                 `yaml:"pool" json:"pool"`
+	Queue  string                 `yaml:"queue" json:"queue"`
+	Sensor *JobSpecMetadataSensor `yaml:"sensor,omitempty" json:"sensor,omitempty"`
+}
+
+// JobSpecMetadataSensor configures the sensors waiting for the upstreams of the job, the poke interval and
+// timeout apply to every upstream unless overridden for an upstream
+type JobSpecMetadataSensor struct {
+	PokeInterval string                          `yaml:"poke_interval,omitempty" json:"poke_interval,omitempty"`
+	Timeout      string                          `yaml:"timeout,omitempty" json:"timeout,omitempty"`
+	Freshness    *JobSpecMetadataSensorFreshness `yaml:"freshness,omitempty" json:"freshness,omitempty"`
+	Upstreams    []JobSpecMetadataUpstreamSensor `yaml:"upstreams,omitempty" json:"upstreams,omitempty"`
+}
+
+// JobSpecMetadataUpstreamSensor overrides the sensor of an upstream, referred by its job name,
+// project/job name or resource urn
+type JobSpecMetadataUpstreamSensor struct {
+	Upstream     string                          `yaml:"upstream" json:"upstream"`
+	PokeInterval string                          `yaml:"poke_interval,omitempty" json:"poke_interval,omitempty"`
+	Timeout      string                          `yaml:"timeout,omitempty" json:"timeout,omitempty"`
+	Freshness    *JobSpecMetadataSensorFreshness `yaml:"freshness,omitempty" json:"freshness,omitempty"`
+}
+
+// JobSpecMetadataSensorFreshness makes the sensor wait for the data of the upstream to be fresh as well, the
+// partition of the window start existing and the data modified within max age, like 6h
+type JobSpecMetadataSensorFreshness struct {
+	PartitionExists bool   `yaml:"partition_exists,omitempty" json:"partition_exists,omitempty"`
+	MaxAge          string `yaml:"max_age,omitempty" json:"max_age,omitempty"`
 }
 
 func (j *JobSpec) ToProto() *pb.JobSpecification {
@@ -109,13 +190,14 @@ func (j *JobSpec) ToProto() *pb.JobSpecification {
 		EndDate:          j.Schedule.EndDate,
 		Interval:         j.Schedule.Interval,
 		DependsOnPast:    j.Behavior.DependsOnPast,
-		TaskName:         j.Task.Name,
+		CatchUp:          strings.EqualFold(j.Schedule.CatchUp, catchUpFull),
+		TaskName:         j.getProtoTaskName(),
 		Config:           j.getProtoJobConfigItems(),
 		WindowSize:       j.Task.Window.Size,
 		WindowOffset:     j.Task.Window.Offset,
 		WindowTruncateTo: j.Task.Window.TruncateTo,
 		WindowPreset:     j.Task.Window.Preset,
-		Dependencies:     j.getProtoJobDependencies(),
+		Dependencies:     j.getProtoDependencies(),
 		Assets:           j.Asset,
 		Hooks:            j.getProtoJobSpecHooks(),
 		Description:      j.Description,
@@ -202,6 +284,30 @@ func (j *JobSpec) getProtoJobSpecHooks() []*pb.JobSpecHook {
 				Value: value,
 			})
 		}
+		if hook.EnabledWhen != "" {
+			protoJobConfigItems = append(protoJobConfigItems, &pb.JobConfigItem{
+				Name:  hookConfigEnabledWhen,
+				Value: hook.EnabledWhen,
+			})
+		}
+		if hook.Phase != "" {
+			protoJobConfigItems = append(protoJobConfigItems, &pb.JobConfigItem{
+				Name:  hookConfigPhase,
+				Value: hook.Phase,
+			})
+		}
+		if len(hook.DependsOn) > 0 {
+			protoJobConfigItems = append(protoJobConfigItems, &pb.JobConfigItem{
+				Name:  hookConfigDependsOn,
+				Value: strings.Join(hook.DependsOn, ","),
+			})
+		}
+		if hook.When != "" {
+			protoJobConfigItems = append(protoJobConfigItems, &pb.JobConfigItem{
+				Name:  hookConfigWhen,
+				Value: hook.When,
+			})
+		}
 		protoJobSpecHooks[i] = &pb.JobSpecHook{
 			Name:   hook.Name,
 			Config: protoJobConfigItems,
@@ -210,6 +316,32 @@ func (j *JobSpec) getProtoJobSpecHooks() []*pb.JobSpecHook {
 	return protoJobSpecHooks
 }
 
+func (j *JobSpec) getProtoTaskName() string {
+	if j.Task.Version == "" {
+		return j.Task.Name
+	}
+	return j.Task.Name + taskVersionSeparator + j.Task.Version
+}
+
+func (j *JobSpec) getProtoDependencies() []*pb.JobDependency {
+	dependencies := append(j.getProtoJobDependencies(), j.getProtoSensorDependencies()...)
+	return append(dependencies, j.getProtoUpstreamOverrides()...)
+}
+
+func (j *JobSpec) getProtoUpstreamOverrides() []*pb.JobDependency {
+	if j.Upstreams == nil {
+		return nil
+	}
+	var overrides []*pb.JobDependency
+	for _, urn := range j.Upstreams.Ignore {
+		overrides = append(overrides, &pb.JobDependency{Name: urn, Type: dependencyTypeIgnore})
+	}
+	for _, urn := range j.Upstreams.Extra {
+		overrides = append(overrides, &pb.JobDependency{Name: urn, Type: dependencyTypeExtra})
+	}
+	return overrides
+}
+
 func (j *JobSpec) getProtoJobDependencies() []*pb.JobDependency {
 	protoJobDependencies := make([]*pb.JobDependency, len(j.Dependencies))
 	for i, dependency := range j.Dependencies {
@@ -230,6 +362,40 @@ func (j *JobSpec) getProtoJobDependencies() []*pb.JobDependency {
 	return protoJobDependencies
 }
 
+func (j *JobSpec) getProtoSensorDependencies() []*pb.JobDependency {
+	if j.Metadata == nil || j.Metadata.Airflow == nil || j.Metadata.Airflow.Sensor == nil {
+		return nil
+	}
+	sensor := j.Metadata.Airflow.Sensor
+	protoSensorDependencies := []*pb.JobDependency{toProtoSensorDependency("", sensor.PokeInterval, sensor.Timeout, sensor.Freshness)}
+	for _, upstreamSensor := range sensor.Upstreams {
+		protoSensorDependencies = append(protoSensorDependencies,
+			toProtoSensorDependency(upstreamSensor.Upstream, upstreamSensor.PokeInterval, upstreamSensor.Timeout, upstreamSensor.Freshness))
+	}
+	return protoSensorDependencies
+}
+
+func toProtoSensorDependency(upstream, pokeInterval, timeout string, freshness *JobSpecMetadataSensorFreshness) *pb.JobDependency {
+	params := map[string]string{}
+	if pokeInterval != "" {
+		params[sensorParamPokeInterval] = pokeInterval
+	}
+	if timeout != "" {
+		params[sensorParamTimeout] = timeout
+	}
+	if freshness != nil && freshness.PartitionExists {
+		params[sensorParamFreshnessPartitionExists] = "true"
+	}
+	if freshness != nil && freshness.MaxAge != "" {
+		params[sensorParamFreshnessMaxAge] = freshness.MaxAge
+	}
+	return &pb.JobDependency{
+		Name:           upstream,
+		Type:           dependencyTypeSensor,
+		HttpDependency: &pb.HttpDependency{Params: params},
+	}
+}
+
 func (j *JobSpec) getProtoJobConfigItems() []*pb.JobConfigItem {
 	var protoJobConfigItems []*pb.JobConfigItem
 	for name, value := range j.Task.Config {
@@ -238,6 +404,12 @@ func (j *JobSpec) getProtoJobConfigItems() []*pb.JobConfigItem {
 			Value: value,
 		})
 	}
+	if j.Schedule.CatchUp != "" {
+		protoJobConfigItems = append(protoJobConfigItems, &pb.JobConfigItem{
+			Name:  taskConfigCatchUp,
+			Value: j.Schedule.CatchUp,
+		})
+	}
 	return protoJobConfigItems
 }
 
@@ -249,6 +421,7 @@ func (j *JobSpec) MergeFrom(anotherJobSpec *JobSpec) {
 	j.Schedule.Interval = getValue(j.Schedule.Interval, anotherJobSpec.Schedule.Interval)
 	j.Schedule.StartDate = getValue(j.Schedule.StartDate, anotherJobSpec.Schedule.StartDate)
 	j.Schedule.EndDate = getValue(j.Schedule.EndDate, anotherJobSpec.Schedule.EndDate)
+	j.Schedule.CatchUp = getValue(j.Schedule.CatchUp, anotherJobSpec.Schedule.CatchUp)
 
 	if anotherJobSpec.Behavior.Retry == nil {
 		anotherJobSpec.Behavior.Retry = &JobSpecBehaviorRetry{}
@@ -332,7 +505,17 @@ func (j *JobSpec) MergeFrom(anotherJobSpec *JobSpec) {
 		}
 	}
 
+	if anotherJobSpec.Upstreams != nil {
+		if j.Upstreams == nil {
+			j.Upstreams = &JobSpecUpstreams{}
+		}
+		// the overrides of the job take precedence over the inherited ones of the opposite list
+		j.Upstreams.Ignore = appendMissing(j.Upstreams.Ignore, anotherJobSpec.Upstreams.Ignore, j.Upstreams.Extra)
+		j.Upstreams.Extra = appendMissing(j.Upstreams.Extra, anotherJobSpec.Upstreams.Extra, j.Upstreams.Ignore)
+	}
+
 	j.Task.Name = getValue(j.Task.Name, anotherJobSpec.Task.Name)
+	j.Task.Version = getValue(j.Task.Version, anotherJobSpec.Task.Version)
 	j.Task.Window.TruncateTo = getValue(j.Task.Window.TruncateTo, anotherJobSpec.Task.Window.TruncateTo)
 	j.Task.Window.Offset = getValue(j.Task.Window.Offset, anotherJobSpec.Task.Window.Offset)
 	j.Task.Window.Size = getValue(j.Task.Window.Size, anotherJobSpec.Task.Window.Size)
@@ -365,6 +548,18 @@ func (j *JobSpec) MergeFrom(anotherJobSpec *JobSpec) {
 			existingHooks[j.Hooks[chi].Name] = true
 			// check if hook already present in child
 			if ph.Name == j.Hooks[chi].Name {
+				if j.Hooks[chi].EnabledWhen == "" {
+					j.Hooks[chi].EnabledWhen = ph.EnabledWhen
+				}
+				if j.Hooks[chi].Phase == "" {
+					j.Hooks[chi].Phase = ph.Phase
+				}
+				if j.Hooks[chi].DependsOn == nil {
+					j.Hooks[chi].DependsOn = ph.DependsOn
+				}
+				if j.Hooks[chi].When == "" {
+					j.Hooks[chi].When = ph.When
+				}
 				// try to copy configs
 				for phcKey, phc := range ph.Config {
 					alreadyExists := false
@@ -385,8 +580,12 @@ func (j *JobSpec) MergeFrom(anotherJobSpec *JobSpec) {
 		// copy non existing hooks
 		if _, ok := existingHooks[ph.Name]; !ok {
 			j.Hooks = append(j.Hooks, JobSpecHook{
-				Name:   ph.Name,
-				Config: ph.Config,
+				Name:        ph.Name,
+				Config:      ph.Config,
+				EnabledWhen: ph.EnabledWhen,
+				Phase:       ph.Phase,
+				DependsOn:   ph.DependsOn,
+				When:        ph.When,
 			})
 		}
 	}
@@ -405,6 +604,9 @@ func (j *JobSpec) MergeFrom(anotherJobSpec *JobSpec) {
 		if airflow := metadata.Airflow; airflow != nil {
 			j.Metadata.Airflow.Pool = getValue(j.Metadata.Airflow.Pool, airflow.Pool)
 			j.Metadata.Airflow.Queue = getValue(j.Metadata.Airflow.Queue, airflow.Queue)
+			if j.Metadata.Airflow.Sensor == nil {
+				j.Metadata.Airflow.Sensor = airflow.Sensor
+			}
 		}
 	}
 }
@@ -419,7 +621,33 @@ func getValue[V int | string | bool | time.Duration](reference, other V) V {
 	return reference
 }
 
+// appendMissing appends the values of other which are neither in reference nor in excluded
+func appendMissing(reference, other, excluded []string) []string {
+	skip := make(map[string]bool, len(reference)+len(excluded))
+	for _, value := range reference {
+		skip[value] = true
+	}
+	for _, value := range excluded {
+		skip[value] = true
+	}
+	for _, value := range other {
+		if skip[value] {
+			continue
+		}
+		skip[value] = true
+		reference = append(reference, value)
+	}
+	return reference
+}
+
 func ToJobSpec(protoSpec *pb.JobSpecification) *JobSpec {
+	taskName, taskVersion, _ := strings.Cut(protoSpec.TaskName, taskVersionSeparator)
+	taskConfig := configProtoToMap(protoSpec.Config)
+	catchUp := taskConfig[taskConfigCatchUp]
+	delete(taskConfig, taskConfigCatchUp)
+	if catchUp == "" && protoSpec.CatchUp {
+		catchUp = catchUpFull
+	}
 	return &JobSpec{
 		Version:     int(protoSpec.Version),
 		Name:        protoSpec.Name,
@@ -429,11 +657,13 @@ func ToJobSpec(protoSpec *pb.JobSpecification) *JobSpec {
 			StartDate: protoSpec.StartDate,
 			EndDate:   protoSpec.EndDate,
 			Interval:  protoSpec.Interval,
+			CatchUp:   catchUp,
 		},
 		Behavior: toJobSpecBehavior(protoSpec.Behavior, protoSpec.DependsOnPast),
 		Task: JobSpecTask{
-			Name:   protoSpec.TaskName,
-			Config: configProtoToMap(protoSpec.Config),
+			Name:    taskName,
+			Version: taskVersion,
+			Config:  taskConfig,
 			Window: JobSpecTaskWindow{
 				Size:       protoSpec.WindowSize,
 				Offset:     protoSpec.WindowOffset,
@@ -445,11 +675,53 @@ func ToJobSpec(protoSpec *pb.JobSpecification) *JobSpec {
 		Labels:       protoSpec.Labels,
 		Hooks:        toJobSpecHooks(protoSpec.Hooks),
 		Dependencies: toJobSpecDependencies(protoSpec.Dependencies),
-		Metadata:     toJobSpecMetadata(protoSpec.Metadata),
+		Upstreams:    toJobSpecUpstreams(protoSpec.Dependencies),
+		Metadata:     toJobSpecMetadata(protoSpec.Metadata, toJobSpecMetadataSensor(protoSpec.Dependencies)),
 	}
 }
 
-func toJobSpecMetadata(protoMetadata *pb.JobMetadata) *JobSpecMetadata {
+func toJobSpecMetadataSensor(protoDependencies []*pb.JobDependency) *JobSpecMetadataSensor {
+	var sensor *JobSpecMetadataSensor
+	for _, dependency := range protoDependencies {
+		if dependency.Type != dependencyTypeSensor {
+			continue
+		}
+		if sensor == nil {
+			sensor = &JobSpecMetadataSensor{}
+		}
+		var params map[string]string
+		if dependency.HttpDependency != nil {
+			params = dependency.HttpDependency.Params
+		}
+		if dependency.Name == "" {
+			sensor.PokeInterval = params[sensorParamPokeInterval]
+			sensor.Timeout = params[sensorParamTimeout]
+			sensor.Freshness = toJobSpecMetadataSensorFreshness(params)
+			continue
+		}
+		sensor.Upstreams = append(sensor.Upstreams, JobSpecMetadataUpstreamSensor{
+			Upstream:     dependency.Name,
+			PokeInterval: params[sensorParamPokeInterval],
+			Timeout:      params[sensorParamTimeout],
+			Freshness:    toJobSpecMetadataSensorFreshness(params),
+		})
+	}
+	return sensor
+}
+
+func toJobSpecMetadataSensorFreshness(params map[string]string) *JobSpecMetadataSensorFreshness {
+	partitionExists := params[sensorParamFreshnessPartitionExists] == "true"
+	maxAge := params[sensorParamFreshnessMaxAge]
+	if !partitionExists && maxAge == "" {
+		return nil
+	}
+	return &JobSpecMetadataSensorFreshness{
+		PartitionExists: partitionExists,
+		MaxAge:          maxAge,
+	}
+}
+
+func toJobSpecMetadata(protoMetadata *pb.JobMetadata, sensor *JobSpecMetadataSensor) *JobSpecMetadata {
 	var metadataSpec *JobSpecMetadata
 	if protoMetadata != nil {
 		var metadataResourceSpec *JobSpecMetadataResource
@@ -475,10 +747,11 @@ func toJobSpecMetadata(protoMetadata *pb.JobMetadata) *JobSpecMetadata {
 		}
 
 		var metadataAirflowSpec *JobSpecMetadataAirflow
-		if protoMetadata.Airflow != nil {
+		if protoMetadata.Airflow != nil || sensor != nil {
 			metadataAirflowSpec = &JobSpecMetadataAirflow{
-				Pool:  protoMetadata.Airflow.Pool,
-				Queue: protoMetadata.Airflow.Queue,
+				Pool:   protoMetadata.Airflow.GetPool(),
+				Queue:  protoMetadata.Airflow.GetQueue(),
+				Sensor: sensor,
 			}
 		}
 		metadataSpec = &JobSpecMetadata{
@@ -489,9 +762,31 @@ func toJobSpecMetadata(protoMetadata *pb.JobMetadata) *JobSpecMetadata {
 	return metadataSpec
 }
 
+func toJobSpecUpstreams(protoDependencies []*pb.JobDependency) *JobSpecUpstreams {
+	var upstreams *JobSpecUpstreams
+	for _, dependency := range protoDependencies {
+		if dependency.Type != dependencyTypeIgnore && dependency.Type != dependencyTypeExtra {
+			continue
+		}
+		if upstreams == nil {
+			upstreams = &JobSpecUpstreams{}
+		}
+		if dependency.Type == dependencyTypeIgnore {
+			upstreams.Ignore = append(upstreams.Ignore, dependency.Name)
+		} else {
+			upstreams.Extra = append(upstreams.Extra, dependency.Name)
+		}
+	}
+	return upstreams
+}
+
 func toJobSpecDependencies(protoDependencies []*pb.JobDependency) []JobSpecDependency {
 	var dependencySpecs []JobSpecDependency
 	for _, dependency := range protoDependencies {
+		switch dependency.Type {
+		case dependencyTypeSensor, dependencyTypeIgnore, dependencyTypeExtra:
+			continue
+		}
 		var httpDependency *JobSpecDependencyHTTP
 		if dependency.HttpDependency != nil {
 			httpDependency = &JobSpecDependencyHTTP{
@@ -514,9 +809,26 @@ func toJobSpecDependencies(protoDependencies []*pb.JobDependency) []JobSpecDepen
 func toJobSpecHooks(protoHooks []*pb.JobSpecHook) []JobSpecHook {
 	var hookSpecs []JobSpecHook
 	for _, protoHook := range protoHooks {
+		hookConfig := configProtoToMap(protoHook.Config)
+		enabledWhen := hookConfig[hookConfigEnabledWhen]
+		phase := hookConfig[hookConfigPhase]
+		when := hookConfig[hookConfigWhen]
+		var dependsOn []string
+		if rawDependsOn := hookConfig[hookConfigDependsOn]; rawDependsOn != "" {
+			dependsOn = strings.Split(rawDependsOn, ",")
+		}
+		delete(hookConfig, hookConfigEnabledWhen)
+		delete(hookConfig, hookConfigPhase)
+		delete(hookConfig, hookConfigDependsOn)
+		delete(hookConfig, hookConfigWhen)
+
 		hookSpec := JobSpecHook{
-			Name:   protoHook.Name,
-			Config: configProtoToMap(protoHook.Config),
+			Name:        protoHook.Name,
+			Config:      hookConfig,
+			EnabledWhen: enabledWhen,
+			Phase:       phase,
+			DependsOn:   dependsOn,
+			When:        when,
 		}
 		hookSpecs = append(hookSpecs, hookSpec)
 	}
@@ -21,6 +21,134 @@ func TestJobSpecTestSuite(t *testing.T) {
 }
 
 func (s *JobSpecTestSuite) TestToProto() {
+	s.Run("should return job spec proto with enabled_when of hook in hook config", func() {
+		jobSpec := s.getCompleteJobSpec()
+		jobSpec.Hooks[0].EnabledWhen = `{{ eq .proj.ENVIRONMENT "production" }}`
+
+		expectedProto := s.getCompleteJobSpecProto()
+		expectedProto.Hooks[0].Config = append(expectedProto.Hooks[0].Config, &pb.JobConfigItem{
+			Name:  "ENABLED_WHEN",
+			Value: `{{ eq .proj.ENVIRONMENT "production" }}`,
+		})
+
+		actualProto := jobSpec.ToProto()
+
+		s.Assert().EqualValues(expectedProto, actualProto)
+	})
+
+	s.Run("should return job spec proto with phase and depends_on of hook in hook config", func() {
+		jobSpec := s.getCompleteJobSpec()
+		jobSpec.Hooks[0].Phase = "post"
+		jobSpec.Hooks[0].DependsOn = []string{"predator", "transporter"}
+
+		expectedProto := s.getCompleteJobSpecProto()
+		expectedProto.Hooks[0].Config = append(expectedProto.Hooks[0].Config,
+			&pb.JobConfigItem{Name: "HOOK_PHASE", Value: "post"},
+			&pb.JobConfigItem{Name: "HOOK_DEPENDS_ON", Value: "predator,transporter"},
+		)
+
+		actualProto := jobSpec.ToProto()
+
+		s.Assert().EqualValues(expectedProto, actualProto)
+	})
+
+	s.Run("should return job spec proto with when of hook in hook config", func() {
+		jobSpec := s.getCompleteJobSpec()
+		jobSpec.Hooks[0].When = `{{ eq .JOB_DESTINATION "project:dataset.table" }}`
+
+		expectedProto := s.getCompleteJobSpecProto()
+		expectedProto.Hooks[0].Config = append(expectedProto.Hooks[0].Config, &pb.JobConfigItem{
+			Name:  "HOOK_WHEN",
+			Value: `{{ eq .JOB_DESTINATION "project:dataset.table" }}`,
+		})
+
+		actualProto := jobSpec.ToProto()
+
+		s.Assert().EqualValues(expectedProto, actualProto)
+	})
+
+	s.Run("should return job spec proto with catch up policy in task config", func() {
+		jobSpec := s.getCompleteJobSpec()
+		jobSpec.Schedule.CatchUp = "full"
+
+		expectedProto := s.getCompleteJobSpecProto()
+		expectedProto.CatchUp = true
+		expectedProto.Config = append(expectedProto.Config, &pb.JobConfigItem{Name: "CATCH_UP", Value: "full"})
+
+		actualProto := jobSpec.ToProto()
+
+		s.Assert().EqualValues(expectedProto, actualProto)
+	})
+
+	s.Run("should return job spec proto with sensor config of airflow metadata as sensor dependencies", func() {
+		jobSpec := s.getCompleteJobSpec()
+		jobSpec.Metadata.Airflow.Sensor = &model.JobSpecMetadataSensor{
+			Timeout: "6h",
+			Upstreams: []model.JobSpecMetadataUpstreamSensor{
+				{Upstream: "project/job_name_2", PokeInterval: "5m", Timeout: "1h"},
+				{
+					Upstream:  "bigquery://project:dataset.table",
+					Freshness: &model.JobSpecMetadataSensorFreshness{PartitionExists: true, MaxAge: "6h"},
+				},
+			},
+		}
+
+		expectedProto := s.getCompleteJobSpecProto()
+		expectedProto.Dependencies = append(expectedProto.Dependencies,
+			&pb.JobDependency{
+				Type:           "sensor",
+				HttpDependency: &pb.HttpDependency{Params: map[string]string{"timeout": "6h"}},
+			},
+			&pb.JobDependency{
+				Name:           "project/job_name_2",
+				Type:           "sensor",
+				HttpDependency: &pb.HttpDependency{Params: map[string]string{"poke_interval": "5m", "timeout": "1h"}},
+			},
+			&pb.JobDependency{
+				Name: "bigquery://project:dataset.table",
+				Type: "sensor",
+				HttpDependency: &pb.HttpDependency{Params: map[string]string{
+					"freshness_partition_exists": "true",
+					"freshness_max_age":          "6h",
+				}},
+			},
+		)
+
+		actualProto := jobSpec.ToProto()
+
+		s.Assert().EqualValues(expectedProto, actualProto)
+	})
+
+	s.Run("should return job spec proto with upstream overrides as typed dependencies", func() {
+		jobSpec := s.getCompleteJobSpec()
+		jobSpec.Upstreams = &model.JobSpecUpstreams{
+			Ignore: []string{"bigquery://project:dataset.table_a"},
+			Extra:  []string{"bigquery://project:dataset.table_b"},
+		}
+
+		expectedProto := s.getCompleteJobSpecProto()
+		expectedProto.Dependencies = append(expectedProto.Dependencies,
+			&pb.JobDependency{Name: "bigquery://project:dataset.table_a", Type: "upstream_ignore"},
+			&pb.JobDependency{Name: "bigquery://project:dataset.table_b", Type: "upstream_extra"},
+		)
+
+		actualProto := jobSpec.ToProto()
+
+		s.Assert().EqualValues(expectedProto, actualProto)
+	})
+
+	s.Run("should return job spec proto with the pinned plugin version in the task name", func() {
+		jobSpec := s.getCompleteJobSpec()
+		jobSpec.Task.Version = "1.2.0"
+
+		expectedProto := s.getCompleteJobSpecProto()
+		expectedProto.TaskName += "@1.2.0"
+
+		actualProto := jobSpec.ToProto()
+
+		s.Assert().EqualValues(expectedProto, actualProto)
+	})
+
 	s.Run("should return job spec proto with behavior proto nil when behavior.retry is nil and behavior.notify is empty", func() {
 		jobSpec := s.getCompleteJobSpec()
 		jobSpec.Behavior.Retry = nil
@@ -80,6 +208,35 @@ func (s *JobSpecTestSuite) TestMergeFrom() {
 
 		s.Assert().EqualValues(jobSpec2, jobSpec1)
 	})
+	s.Run("should inherit enabled_when of hook unless set in the current job spec", func() {
+		jobSpec1 := s.getCompleteJobSpec()
+		jobSpec2 := s.getCompleteJobSpec()
+		jobSpec2.Hooks[0].EnabledWhen = `{{ eq .proj.ENVIRONMENT "production" }}`
+
+		jobSpec1.MergeFrom(&jobSpec2)
+		s.Assert().Equal(`{{ eq .proj.ENVIRONMENT "production" }}`, jobSpec1.Hooks[0].EnabledWhen)
+
+		jobSpec3 := s.getCompleteJobSpec()
+		jobSpec3.Hooks[0].EnabledWhen = `{{ eq .proj.ENVIRONMENT "staging" }}`
+
+		jobSpec3.MergeFrom(&jobSpec2)
+		s.Assert().Equal(`{{ eq .proj.ENVIRONMENT "staging" }}`, jobSpec3.Hooks[0].EnabledWhen)
+	})
+	s.Run("should inherit upstream overrides unless overridden the other way in the current job spec", func() {
+		jobSpec1 := s.getCompleteJobSpec()
+		jobSpec1.Upstreams = &model.JobSpecUpstreams{Extra: []string{"bigquery://project:dataset.table_a"}}
+		jobSpec2 := s.getCompleteJobSpec()
+		jobSpec2.Upstreams = &model.JobSpecUpstreams{
+			Ignore: []string{"bigquery://project:dataset.table_a", "bigquery://project:dataset.table_b"},
+			Extra:  []string{"bigquery://project:dataset.table_a"},
+		}
+
+		jobSpec1.MergeFrom(&jobSpec2)
+		s.Assert().Equal(&model.JobSpecUpstreams{
+			Ignore: []string{"bigquery://project:dataset.table_b"},
+			Extra:  []string{"bigquery://project:dataset.table_a"},
+		}, jobSpec1.Upstreams)
+	})
 }
 
 func (*JobSpecTestSuite) getCompleteJobSpec() model.JobSpec {
@@ -267,6 +424,110 @@ func (*JobSpecTestSuite) getCompleteJobSpecProto() *pb.JobSpecification {
 }
 
 func (s *JobSpecTestSuite) TestToJobSpec() {
+	s.Run("should return job spec with enabled_when of hook taken out of hook config", func() {
+		jobProto := s.getCompleteJobSpecProto()
+		jobProto.Hooks[0].Config = append(jobProto.Hooks[0].Config, &pb.JobConfigItem{
+			Name:  "ENABLED_WHEN",
+			Value: `{{ eq .proj.ENVIRONMENT "production" }}`,
+		})
+
+		expectedJobSpec := s.getCompleteJobSpec()
+		expectedJobSpec.Hooks[0].EnabledWhen = `{{ eq .proj.ENVIRONMENT "production" }}`
+
+		actualJobSpec := model.ToJobSpec(jobProto)
+
+		s.Assert().EqualValues(&expectedJobSpec, actualJobSpec)
+	})
+
+	s.Run("should return job spec with phase and depends_on of hook taken out of hook config", func() {
+		jobProto := s.getCompleteJobSpecProto()
+		jobProto.Hooks[0].Config = append(jobProto.Hooks[0].Config,
+			&pb.JobConfigItem{Name: "HOOK_PHASE", Value: "post"},
+			&pb.JobConfigItem{Name: "HOOK_DEPENDS_ON", Value: "predator,transporter"},
+		)
+
+		expectedJobSpec := s.getCompleteJobSpec()
+		expectedJobSpec.Hooks[0].Phase = "post"
+		expectedJobSpec.Hooks[0].DependsOn = []string{"predator", "transporter"}
+
+		actualJobSpec := model.ToJobSpec(jobProto)
+
+		s.Assert().EqualValues(&expectedJobSpec, actualJobSpec)
+	})
+
+	s.Run("should return job spec with catch up policy taken out of task config", func() {
+		jobProto := s.getCompleteJobSpecProto()
+		jobProto.Config = append(jobProto.Config, &pb.JobConfigItem{Name: "CATCH_UP", Value: "none"})
+
+		expectedJobSpec := s.getCompleteJobSpec()
+		expectedJobSpec.Schedule.CatchUp = "none"
+
+		actualJobSpec := model.ToJobSpec(jobProto)
+
+		s.Assert().EqualValues(&expectedJobSpec, actualJobSpec)
+	})
+
+	s.Run("should return job spec with sensor dependencies taken out as sensor config of airflow metadata", func() {
+		jobProto := s.getCompleteJobSpecProto()
+		jobProto.Dependencies = append(jobProto.Dependencies,
+			&pb.JobDependency{
+				Type:           "sensor",
+				HttpDependency: &pb.HttpDependency{Params: map[string]string{"poke_interval": "10m"}},
+			},
+			&pb.JobDependency{
+				Name:           "bigquery://project:dataset.table",
+				Type:           "sensor",
+				HttpDependency: &pb.HttpDependency{Params: map[string]string{"timeout": "2h", "freshness_max_age": "3h"}},
+			},
+		)
+
+		expectedJobSpec := s.getCompleteJobSpec()
+		expectedJobSpec.Metadata.Airflow.Sensor = &model.JobSpecMetadataSensor{
+			PokeInterval: "10m",
+			Upstreams: []model.JobSpecMetadataUpstreamSensor{
+				{
+					Upstream:  "bigquery://project:dataset.table",
+					Timeout:   "2h",
+					Freshness: &model.JobSpecMetadataSensorFreshness{MaxAge: "3h"},
+				},
+			},
+		}
+
+		actualJobSpec := model.ToJobSpec(jobProto)
+
+		s.Assert().EqualValues(&expectedJobSpec, actualJobSpec)
+	})
+
+	s.Run("should return job spec with upstream overrides from the typed dependencies", func() {
+		jobProto := s.getCompleteJobSpecProto()
+		jobProto.Dependencies = append(jobProto.Dependencies,
+			&pb.JobDependency{Name: "bigquery://project:dataset.table_a", Type: "upstream_ignore"},
+			&pb.JobDependency{Name: "bigquery://project:dataset.table_b", Type: "upstream_extra"},
+		)
+
+		expectedJobSpec := s.getCompleteJobSpec()
+		expectedJobSpec.Upstreams = &model.JobSpecUpstreams{
+			Ignore: []string{"bigquery://project:dataset.table_a"},
+			Extra:  []string{"bigquery://project:dataset.table_b"},
+		}
+
+		actualJobSpec := model.ToJobSpec(jobProto)
+
+		s.Assert().EqualValues(&expectedJobSpec, actualJobSpec)
+	})
+
+	s.Run("should return job spec with the pinned plugin version from the task name", func() {
+		jobProto := s.getCompleteJobSpecProto()
+		jobProto.TaskName += "@1.2.0"
+
+		expectedJobSpec := s.getCompleteJobSpec()
+		expectedJobSpec.Task.Version = "1.2.0"
+
+		actualJobSpec := model.ToJobSpec(jobProto)
+
+		s.Assert().EqualValues(&expectedJobSpec, actualJobSpec)
+	})
+
 	s.Run("should return job spec with behavior.retry nil and behavior.notify nil when behavior proto is nil", func() {
 		jobProto := s.getCompleteJobSpecProto()
 		jobProto.Behavior = nil
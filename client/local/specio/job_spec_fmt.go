@@ -0,0 +1,156 @@
+package specio
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/afero"
+	"gopkg.in/yaml.v3"
+
+	"github.com/goto/optimus/client/local/internal"
+	"github.com/goto/optimus/client/local/model"
+)
+
+const jobSpecFileName = "job.yaml"
+
+// JobSpecNormalizer returns the specs normalized into the canonical form of the server, in the order they are given
+type JobSpecNormalizer func(specs []*model.JobSpec) ([]*model.JobSpec, error)
+
+// FormatJobSpecs normalizes the job specs under rootDirPath into the canonical field order and format, using normalize
+// for the values. It returns the file paths of specs which are not in canonical form, those are only rewritten when
+// write is set.
+func FormatJobSpecs(specFS afero.Fs, rootDirPath string, normalize JobSpecNormalizer, write bool) ([]string, error) {
+	if specFS == nil {
+		return nil, errors.New("specFS is nil")
+	}
+	if rootDirPath == "" {
+		return nil, errors.New("root dir path is empty")
+	}
+	if normalize == nil {
+		return nil, errors.New("normalize is nil")
+	}
+
+	j := jobSpecReadWriter{
+		referenceParentFileName: "this.yaml",
+		referenceSpecFileName:   jobSpecFileName,
+		specFS:                  specFS,
+	}
+	jobSpecParentsMappedByDirPath, err := j.readJobSpecParentsMappedByDirPath(rootDirPath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading parent specs under [%s]: %w", rootDirPath, err)
+	}
+
+	dirPaths, err := internal.DiscoverSpecDirPaths(specFS, rootDirPath, jobSpecFileName)
+	if err != nil {
+		return nil, fmt.Errorf("error discovering spec dir paths under [%s]: %w", rootDirPath, err)
+	}
+	if len(dirPaths) == 0 {
+		return nil, nil
+	}
+
+	filePaths := make([]string, len(dirPaths))
+	originals := make([][]byte, len(dirPaths))
+	specs := make([]*model.JobSpec, len(dirPaths))
+	inheritedFields := make([]inheritedJobSpecFields, len(dirPaths))
+	for i, dirPath := range dirPaths {
+		filePaths[i] = filepath.Join(dirPath, jobSpecFileName)
+		originals[i], err = afero.ReadFile(specFS, filePaths[i])
+		if err != nil {
+			return nil, fmt.Errorf("error reading spec under [%s]: %w", filePaths[i], err)
+		}
+		specs[i], err = decodeJobSpec(originals[i], filePaths[i])
+		if err != nil {
+			return nil, err
+		}
+
+		mergedSpec, err := decodeJobSpec(originals[i], filePaths[i])
+		if err != nil {
+			return nil, err
+		}
+		j.mergeJobSpecWithParents(mergedSpec, dirPath, jobSpecParentsMappedByDirPath)
+		inheritedFields[i] = borrowInheritedJobSpecFields(specs[i], mergedSpec)
+	}
+
+	normalizedSpecs, err := normalize(specs)
+	if err != nil {
+		return nil, fmt.Errorf("error normalizing specs under [%s]: %w", rootDirPath, err)
+	}
+	if len(normalizedSpecs) != len(specs) {
+		return nil, fmt.Errorf("error normalizing specs under [%s]: expected %d specs, got %d", rootDirPath, len(specs), len(normalizedSpecs))
+	}
+
+	var unformattedFilePaths []string
+	for i, filePath := range filePaths {
+		inheritedFields[i].clear(normalizedSpecs[i])
+
+		var buf bytes.Buffer
+		if err := internal.EncodeSpec(&buf, normalizedSpecs[i]); err != nil {
+			return nil, fmt.Errorf("error encoding spec under [%s]: %w", filePath, err)
+		}
+		formatted := buf.Bytes()
+		if bytes.Equal(originals[i], formatted) {
+			continue
+		}
+
+		unformattedFilePaths = append(unformattedFilePaths, filePath)
+		if !write {
+			continue
+		}
+		filePermission := 0o644
+		if err := afero.WriteFile(specFS, filePath, formatted, os.FileMode(filePermission)); err != nil {
+			return nil, fmt.Errorf("error writing formatted spec into [%s]: %w", filePath, err)
+		}
+	}
+	return unformattedFilePaths, nil
+}
+
+func decodeJobSpec(content []byte, filePath string) (*model.JobSpec, error) {
+	var spec *model.JobSpec
+	if err := yaml.Unmarshal(content, &spec); err != nil {
+		return nil, fmt.Errorf("error decoding spec under [%s]: %w", filePath, err)
+	}
+	if spec == nil {
+		return nil, fmt.Errorf("error decoding spec under [%s]: spec is empty", filePath)
+	}
+	return spec, nil
+}
+
+// inheritedJobSpecFields marks the fields required by the server which the spec inherits from the specs of its parent
+// directories, those are borrowed from the parents to normalize the spec and left out of the formatted spec again
+type inheritedJobSpecFields struct {
+	version   bool
+	owner     bool
+	startDate bool
+}
+
+func borrowInheritedJobSpecFields(spec, mergedSpec *model.JobSpec) inheritedJobSpecFields {
+	var inherited inheritedJobSpecFields
+	if spec.Version == 0 && mergedSpec.Version != 0 {
+		spec.Version = mergedSpec.Version
+		inherited.version = true
+	}
+	if spec.Owner == "" && mergedSpec.Owner != "" {
+		spec.Owner = mergedSpec.Owner
+		inherited.owner = true
+	}
+	if spec.Schedule.StartDate == "" && mergedSpec.Schedule.StartDate != "" {
+		spec.Schedule.StartDate = mergedSpec.Schedule.StartDate
+		inherited.startDate = true
+	}
+	return inherited
+}
+
+func (i inheritedJobSpecFields) clear(spec *model.JobSpec) {
+	if i.version {
+		spec.Version = 0
+	}
+	if i.owner {
+		spec.Owner = ""
+	}
+	if i.startDate {
+		spec.Schedule.StartDate = ""
+	}
+}
@@ -0,0 +1,153 @@
+package specio_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/client/local/model"
+	"github.com/goto/optimus/client/local/specio"
+)
+
+func TestFormatJobSpecs(t *testing.T) {
+	unformattedSpec := `name: job_1
+version: 1
+owner: optimus@example.com
+task:
+  name: bq2bq
+  config:
+    PROJECT: sample
+schedule:
+    interval: "0 2 * * *"
+    start_date: "2023-01-01"
+behavior:
+  depends_on_past: false
+hooks: []
+dependencies: []
+`
+	formattedSpec := `version: 1
+name: job_1
+owner: optimus@example.com
+schedule:
+  start_date: "2023-01-01"
+  interval: 0 2 * * *
+behavior:
+  depends_on_past: false
+task:
+  name: bq2bq
+  config:
+    PROJECT: sample
+hooks: []
+dependencies: []
+`
+	sameSpecs := func(specs []*model.JobSpec) ([]*model.JobSpec, error) { return specs, nil }
+
+	t.Run("return error if root dir path is empty", func(t *testing.T) {
+		specFS := afero.NewMemMapFs()
+
+		filePaths, err := specio.FormatJobSpecs(specFS, "", sameSpecs, true)
+		assert.Error(t, err)
+		assert.Nil(t, filePaths)
+	})
+	t.Run("return error if spec is not a valid yaml", func(t *testing.T) {
+		specFS := afero.NewMemMapFs()
+		assert.NoError(t, afero.WriteFile(specFS, "root/ns1/jobs/job_1/job.yaml", []byte("invalid yaml"), 0o644))
+
+		filePaths, err := specio.FormatJobSpecs(specFS, "root", sameSpecs, true)
+		assert.ErrorContains(t, err, "error decoding spec")
+		assert.Nil(t, filePaths)
+	})
+	t.Run("only list unformatted specs when write is not set", func(t *testing.T) {
+		specFS := afero.NewMemMapFs()
+		assert.NoError(t, afero.WriteFile(specFS, "root/ns1/jobs/job_1/job.yaml", []byte(unformattedSpec), 0o644))
+		assert.NoError(t, afero.WriteFile(specFS, "root/ns1/jobs/job_2/job.yaml", []byte(formattedSpec), 0o644))
+
+		filePaths, err := specio.FormatJobSpecs(specFS, "root", sameSpecs, false)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"root/ns1/jobs/job_1/job.yaml"}, filePaths)
+
+		content, err := afero.ReadFile(specFS, "root/ns1/jobs/job_1/job.yaml")
+		assert.NoError(t, err)
+		assert.Equal(t, unformattedSpec, string(content))
+	})
+	t.Run("rewrite unformatted specs into canonical form", func(t *testing.T) {
+		specFS := afero.NewMemMapFs()
+		assert.NoError(t, afero.WriteFile(specFS, "root/ns1/jobs/job_1/job.yaml", []byte(unformattedSpec), 0o644))
+
+		filePaths, err := specio.FormatJobSpecs(specFS, "root", sameSpecs, true)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"root/ns1/jobs/job_1/job.yaml"}, filePaths)
+
+		content, err := afero.ReadFile(specFS, "root/ns1/jobs/job_1/job.yaml")
+		assert.NoError(t, err)
+		assert.Equal(t, formattedSpec, string(content))
+
+		filePaths, err = specio.FormatJobSpecs(specFS, "root", sameSpecs, true)
+		assert.NoError(t, err)
+		assert.Empty(t, filePaths)
+	})
+	t.Run("return error if unable to normalize the specs", func(t *testing.T) {
+		specFS := afero.NewMemMapFs()
+		assert.NoError(t, afero.WriteFile(specFS, "root/ns1/jobs/job_1/job.yaml", []byte(unformattedSpec), 0o644))
+
+		failingNormalizer := func([]*model.JobSpec) ([]*model.JobSpec, error) { return nil, errors.New("unavailable") }
+		filePaths, err := specio.FormatJobSpecs(specFS, "root", failingNormalizer, true)
+		assert.ErrorContains(t, err, "unavailable")
+		assert.Nil(t, filePaths)
+
+		content, err := afero.ReadFile(specFS, "root/ns1/jobs/job_1/job.yaml")
+		assert.NoError(t, err)
+		assert.Equal(t, unformattedSpec, string(content))
+	})
+	t.Run("normalize the fields inherited from the parents without writing them into the spec", func(t *testing.T) {
+		inheritingSpec := `name: job_1
+task:
+  name: bq2bq
+schedule:
+  interval: "0 2 * * *"
+`
+		formattedInheritingSpec := `name: job_1
+owner: ""
+schedule:
+  start_date: ""
+  interval: 0 2 * * *
+behavior:
+  depends_on_past: false
+task:
+  name: bq2bq
+hooks: []
+dependencies: []
+`
+		parentSpec := `version: 1
+owner: optimus@example.com
+schedule:
+  start_date: "2023-01-01"
+`
+		specFS := afero.NewMemMapFs()
+		assert.NoError(t, afero.WriteFile(specFS, "root/ns1/this.yaml", []byte(parentSpec), 0o644))
+		assert.NoError(t, afero.WriteFile(specFS, "root/ns1/jobs/job_1/job.yaml", []byte(inheritingSpec), 0o644))
+
+		var normalizedSpecs []model.JobSpec
+		normalizer := func(specs []*model.JobSpec) ([]*model.JobSpec, error) {
+			for _, spec := range specs {
+				normalizedSpecs = append(normalizedSpecs, *spec)
+				spec.Hooks = []model.JobSpecHook{}
+				spec.Dependencies = []model.JobSpecDependency{}
+			}
+			return specs, nil
+		}
+		filePaths, err := specio.FormatJobSpecs(specFS, "root", normalizer, true)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"root/ns1/jobs/job_1/job.yaml"}, filePaths)
+		assert.Len(t, normalizedSpecs, 1)
+		assert.Equal(t, 1, normalizedSpecs[0].Version)
+		assert.Equal(t, "optimus@example.com", normalizedSpecs[0].Owner)
+		assert.Equal(t, "2023-01-01", normalizedSpecs[0].Schedule.StartDate)
+
+		content, err := afero.ReadFile(specFS, "root/ns1/jobs/job_1/job.yaml")
+		assert.NoError(t, err)
+		assert.Equal(t, formattedInheritingSpec, string(content))
+	})
+}
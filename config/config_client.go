@@ -13,9 +13,30 @@ type ClientConfig struct {
 	Namespaces []*Namespace `mapstructure:"namespaces"`
 	Auth       Auth         `mapstructure:"auth"`
 
+	CurrentContext string     `mapstructure:"current_context"`
+	Contexts       []*Context `mapstructure:"contexts"`
+
+	// Projects lists the client configs of the projects in a monorepo, used by the job commands run with --all-projects
+	Projects []*ProjectRef `mapstructure:"projects"`
+
 	namespaceNameToNamespace map[string]*Namespace
 }
 
+// Context groups server host, project, namespace and auth, switching the
+// current context switches all of them for every client command at once
+type Context struct {
+	Name      string `mapstructure:"name"`
+	Host      string `mapstructure:"host"`
+	Project   string `mapstructure:"project"`
+	Namespace string `mapstructure:"namespace"`
+	Auth      *Auth  `mapstructure:"auth"`
+}
+
+// ProjectRef points to the client config of a project, its path is relative to the current directory
+type ProjectRef struct {
+	Path string `mapstructure:"path"`
+}
+
 type Datastore struct {
 	Type   string            `mapstructure:"type"`   // type could be bigquery/postgres/gcs
 	Path   string            `mapstructure:"path"`   // directory to find specifications
@@ -35,6 +56,9 @@ type Project struct {
 type Auth struct {
 	ClientID     string `mapstructure:"client_id"`
 	ClientSecret string `mapstructure:"client_secret"`
+
+	// Token is an api token of the project, used instead of the client credentials when it is set
+	Token string `mapstructure:"token"`
 }
 
 type Namespace struct {
@@ -93,6 +117,51 @@ func (c *ClientConfig) GetAllNamespaceNames() []string {
 	return output
 }
 
+func (c *ClientConfig) GetContextByName(name string) (*Context, error) {
+	for _, ctx := range c.Contexts {
+		if ctx != nil && ctx.Name == name {
+			return ctx, nil
+		}
+	}
+	return nil, fmt.Errorf("context [%s] is not found", name)
+}
+
+// ApplyCurrentContext overrides host, project and auth with the ones set on current context
+func (c *ClientConfig) ApplyCurrentContext() error {
+	if c.CurrentContext == "" {
+		return nil
+	}
+
+	ctx, err := c.GetContextByName(c.CurrentContext)
+	if err != nil {
+		return err
+	}
+
+	if ctx.Host != "" {
+		c.Host = ctx.Host
+	}
+	if ctx.Project != "" {
+		c.Project.Name = ctx.Project
+	}
+	if ctx.Auth != nil {
+		c.Auth = *ctx.Auth
+	}
+	return nil
+}
+
+// DefaultNamespaceName returns the namespace of current context, if any
+func (c *ClientConfig) DefaultNamespaceName() string {
+	if c.CurrentContext == "" {
+		return ""
+	}
+
+	ctx, err := c.GetContextByName(c.CurrentContext)
+	if err != nil {
+		return ""
+	}
+	return ctx.Namespace
+}
+
 func (c *ClientConfig) buildDictionary() {
 	c.namespaceNameToNamespace = map[string]*Namespace{}
 	for _, namespace := range c.Namespaces {
@@ -111,6 +111,51 @@ func (c *ClientConfigTestSuite) TestGetAllNamespaceNames() {
 	})
 }
 
+func (c *ClientConfigTestSuite) TestApplyCurrentContext() {
+	c.Run("should not change config if current context is not set", func() {
+		clientConfig := &config.ClientConfig{Host: "localhost:9100"}
+
+		actualErr := clientConfig.ApplyCurrentContext()
+
+		c.NoError(actualErr)
+		c.Equal("localhost:9100", clientConfig.Host)
+		c.Empty(clientConfig.DefaultNamespaceName())
+	})
+
+	c.Run("should return error if current context is not found", func() {
+		clientConfig := &config.ClientConfig{CurrentContext: "staging"}
+
+		actualErr := clientConfig.ApplyCurrentContext()
+
+		c.ErrorContains(actualErr, "context [staging] is not found")
+	})
+
+	c.Run("should override host, project and auth with current context", func() {
+		clientConfig := &config.ClientConfig{
+			Host:           "localhost:9100",
+			Project:        config.Project{Name: "local-project"},
+			CurrentContext: "staging",
+			Contexts: []*config.Context{
+				{
+					Name:      "staging",
+					Host:      "staging.optimus.io:80",
+					Project:   "staging-project",
+					Namespace: "staging-namespace",
+					Auth:      &config.Auth{ClientID: "staging-client"},
+				},
+			},
+		}
+
+		actualErr := clientConfig.ApplyCurrentContext()
+
+		c.NoError(actualErr)
+		c.Equal("staging.optimus.io:80", clientConfig.Host)
+		c.Equal("staging-project", clientConfig.Project.Name)
+		c.Equal("staging-client", clientConfig.Auth.ClientID)
+		c.Equal("staging-namespace", clientConfig.DefaultNamespaceName())
+	})
+}
+
 func TestClientConfigSuite(t *testing.T) {
 	suite.Run(t, new(ClientConfigTestSuite))
 }
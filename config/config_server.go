@@ -3,21 +3,76 @@ package config
 import "time"
 
 type ServerConfig struct {
-	Version          Version           `mapstructure:"version"`
-	Log              LogConfig         `mapstructure:"log"`
-	Serve            Serve             `mapstructure:"serve"`
-	Telemetry        TelemetryConfig   `mapstructure:"telemetry"`
-	ResourceManagers []ResourceManager `mapstructure:"resource_managers"`
-	Plugin           PluginConfig      `mapstructure:"plugin"`
-	Replay           ReplayConfig      `mapstructure:"replay"`
-	Publisher        *Publisher        `mapstructure:"publisher"`
+	Version             Version                   `mapstructure:"version"`
+	Log                 LogConfig                 `mapstructure:"log"`
+	Serve               Serve                     `mapstructure:"serve"`
+	Telemetry           TelemetryConfig           `mapstructure:"telemetry"`
+	ResourceManagers    []ResourceManager         `mapstructure:"resource_managers"`
+	Plugin              PluginConfig              `mapstructure:"plugin"`
+	Scheduler           SchedulerConfig           `mapstructure:"scheduler"`
+	Replay              ReplayConfig              `mapstructure:"replay"`
+	SLAMonitor          SLAMonitorConfig          `mapstructure:"sla_monitor"`
+	WebhookSubscription WebhookSubscriptionConfig `mapstructure:"webhook_subscription"`
+	DurationAnomaly     DurationAnomalyConfig     `mapstructure:"duration_anomaly"`
+	ExecutorInput       ExecutorInputConfig       `mapstructure:"executor_input"`
+	RunSnapshot         RunSnapshotConfig         `mapstructure:"run_snapshot"`
+	FailureRules        []FailureRuleConfig       `mapstructure:"failure_rules"`
+	LegacyClient        LegacyClientConfig        `mapstructure:"legacy_client"`
+	Publisher           *Publisher                `mapstructure:"publisher"`
+	EventConsumer       *EventConsumer            `mapstructure:"event_consumer"`
 }
 
 type Serve struct {
-	Port        int      `mapstructure:"port" default:"9100"` // port to listen on
-	IngressHost string   `mapstructure:"ingress_host"`        // service ingress host for jobs to communicate back to optimus
-	AppKey      string   `mapstructure:"app_key"`             // random 32 character hash used for encrypting secrets
-	DB          DBConfig `mapstructure:"db"`
+	Port        int             `mapstructure:"port" default:"9100"` // port to listen on
+	IngressHost string          `mapstructure:"ingress_host"`        // service ingress host for jobs to communicate back to optimus
+	AppKey      string          `mapstructure:"app_key"`             // random 32 character hash used for encrypting secrets
+	DB          DBConfig        `mapstructure:"db"`
+	Auth        AuthConfig      `mapstructure:"auth"`
+	RateLimit   RateLimitConfig `mapstructure:"rate_limit"`
+	Shutdown    ShutdownConfig  `mapstructure:"shutdown"`
+}
+
+// ShutdownConfig is how long the server waits for the calls and the replays being processed when it is stopped,
+// the server reports not ready for the readiness delay first, for the load balancers to stop sending new calls.
+type ShutdownConfig struct {
+	ReadinessDelay time.Duration `mapstructure:"readiness_delay"`             // time between reporting not ready and refusing new calls
+	DrainTimeout   time.Duration `mapstructure:"drain_timeout" default:"30s"` // time to finish the calls and checkpoint the replays being processed
+}
+
+// RateLimitConfig limits the expensive calls of every client, the calls are grouped as deploy, replay or compile.
+// A group without a rate or a quota is not limited. The limits are kept by every server on its own.
+type RateLimitConfig struct {
+	PrincipalRates  map[string]float64 `mapstructure:"principal_rates"`           // calls per second of an authenticated principal to a group
+	IPRates         map[string]float64 `mapstructure:"ip_rates"`                  // calls per second from an ip address to a group, regardless of the principal
+	Burst           int                `mapstructure:"burst" default:"1"`         // calls of a client to a group allowed at once before they are limited
+	PrincipalQuotas map[string]int     `mapstructure:"principal_quotas"`          // calls of an authenticated principal to a group within a quota window
+	IPQuotas        map[string]int     `mapstructure:"ip_quotas"`                 // calls from an ip address to a group within a quota window, regardless of the principal
+	QuotaWindow     time.Duration      `mapstructure:"quota_window" default:"1h"` // window the quotas are counted in, the counts start over with every window
+	TrustedProxies  []string           `mapstructure:"trusted_proxies"`           // ip addresses or CIDR ranges of the proxies whose x-forwarded-for is honoured
+}
+
+// AuthConfig authenticates the requests with the api tokens of the projects, and with the id tokens of the OIDC
+// provider when one is set. The requests without credentials are left to be authenticated in front of the server,
+// unless Required is set, then they are rejected.
+type AuthConfig struct {
+	Required bool       `mapstructure:"required"`
+	OIDC     OIDCConfig `mapstructure:"oidc"`
+	RBAC     RBACConfig `mapstructure:"rbac"`
+}
+
+// OIDCConfig is the provider issuing the id tokens accepted by the server, the tokens are named after their
+// UsernameClaim, which is email by default
+type OIDCConfig struct {
+	IssuerURL     string `mapstructure:"issuer_url"`
+	Audience      string `mapstructure:"audience"`
+	UsernameClaim string `mapstructure:"username_claim"`
+}
+
+// RBACConfig authorizes the OIDC principals with the roles bound to them on the projects and namespaces when enabled,
+// the Admins are the principals with the admin role on every project, who grant the first roles
+type RBACConfig struct {
+	Enabled bool     `mapstructure:"enabled"`
+	Admins  []string `mapstructure:"admins"`
 }
 
 type DBConfig struct {
@@ -28,7 +83,32 @@ type DBConfig struct {
 
 type TelemetryConfig struct {
 	ProfileAddr string `mapstructure:"profile_addr"`
-	JaegerAddr  string `mapstructure:"jaeger_addr"`
+	// JaegerAddr is the jaeger collector the traces are exported to, deprecated in favour of Tracing
+	JaegerAddr   string             `mapstructure:"jaeger_addr"`
+	Tracing      TracingConfig      `mapstructure:"tracing"`
+	MetricLabels MetricLabelsConfig `mapstructure:"metric_labels"`
+}
+
+const (
+	TracingExporterJaegerCollector = "jaeger_collector"
+	TracingExporterJaegerAgent     = "jaeger_agent"
+)
+
+// TracingConfig sets up the tracer provider, the traces are exported to the endpoint with the exporter, which is
+// jaeger_collector by default. SampleRatio is the ratio of the traces started by optimus which are sampled, all of
+// them when not set, and Attributes are added to the resource of every span.
+type TracingConfig struct {
+	Exporter    string            `mapstructure:"exporter"`
+	Endpoint    string            `mapstructure:"endpoint"`
+	SampleRatio float64           `mapstructure:"sample_ratio"`
+	Attributes  map[string]string `mapstructure:"attributes"`
+}
+
+// MetricLabelsConfig bounds the number of series exposed on /metrics, Drop lists the labels left out of every metric,
+// like job, and MaxValuesPerProject is the number of distinct values kept per label within a project, zero is unlimited
+type MetricLabelsConfig struct {
+	Drop                []string `mapstructure:"drop"`
+	MaxValuesPerProject int      `mapstructure:"max_values_per_project"`
 }
 
 type ResourceManager struct {
@@ -39,23 +119,193 @@ type ResourceManager struct {
 }
 
 type ResourceManagerConfigOptimus struct {
-	Host    string            `mapstructure:"host"`
-	Headers map[string]string `mapstructure:"headers"`
+	Host    string                    `mapstructure:"host"`
+	Headers map[string]string         `mapstructure:"headers"`
+	Auth    ResourceManagerAuthConfig `mapstructure:"auth"`
+	TLS     ResourceManagerTLSConfig  `mapstructure:"tls"`
+
+	// HealthCheckInterval is how long the reachability of the host is trusted before checking it again, 0 disables the check
+	HealthCheckInterval time.Duration `mapstructure:"health_check_interval"`
+}
+
+// ResourceManagerConfigBigQuery resolves the upstreams on bigquery tables not managed by any optimus
+type ResourceManagerConfigBigQuery struct {
+	CredentialsFile string        `mapstructure:"credentials_file"` // service account reading the table metadata, application default credentials when empty
+	Projects        []string      `mapstructure:"projects"`         // gcp projects whose tables are resolved, every project when empty
+	MaxStaleness    time.Duration `mapstructure:"max_staleness"`    // tables not modified within it are not resolved, 0 disables the check
+}
+
+// ResourceManagerAuthConfig authenticates the requests with a static token, or with a token of
+// the OAuth client credentials flow when token url is set
+type ResourceManagerAuthConfig struct {
+	Token string `mapstructure:"token"`
+
+	TokenURL     string   `mapstructure:"token_url"`
+	ClientID     string   `mapstructure:"client_id"`
+	ClientSecret string   `mapstructure:"client_secret"`
+	Scopes       []string `mapstructure:"scopes"`
+}
+
+// ResourceManagerTLSConfig holds the paths of the client certificate used for mTLS, and of the CA verifying the host
+type ResourceManagerTLSConfig struct {
+	CertFile   string `mapstructure:"cert_file"`
+	KeyFile    string `mapstructure:"key_file"`
+	CAFile     string `mapstructure:"ca_file"`
+	ServerName string `mapstructure:"server_name"`
 }
 
 type PluginConfig struct {
-	Artifacts []string `mapstructure:"artifacts"`
+	Artifacts []string             `mapstructure:"artifacts"`
+	Remotes   []RemotePluginConfig `mapstructure:"remotes"`
+	Sandbox   PluginSandboxConfig  `mapstructure:"sandbox"`
+}
+
+// PluginSandboxConfig limits the dependency resolver mod calls, so one slow or crashing plugin
+// does not stall the deployments and asset compilations of every job
+type PluginSandboxConfig struct {
+	Timeout        time.Duration                 `mapstructure:"timeout" default:"1m"`        // duration after which a plugin call is abandoned
+	MaxConcurrency int                           `mapstructure:"max_concurrency" default:"0"` // maximum concurrent calls to a plugin, 0 is unlimited
+	Plugins        map[string]PluginLimitsConfig `mapstructure:"plugins"`                     // overrides of the limits for a plugin
+}
+
+type PluginLimitsConfig struct {
+	Timeout        time.Duration `mapstructure:"timeout"`
+	MaxConcurrency int           `mapstructure:"max_concurrency"`
+}
+
+// RemotePluginConfig is a dependency resolver mod served by a sidecar over grpc instead of a plugin binary
+type RemotePluginConfig struct {
+	Name        string                `mapstructure:"name"`         // name of the plugin, the yaml version of it is still required
+	Address     string                `mapstructure:"address"`      // host:port the sidecar listens on
+	DialTimeout time.Duration         `mapstructure:"dial_timeout"` // timeout of an attempt to reach the sidecar, defaults to 10s
+	TLS         RemotePluginTLSConfig `mapstructure:"tls"`
+}
+
+// RemotePluginTLSConfig secures the connection to the sidecar, the system CAs verify the sidecar unless a CA file is
+// set, and the client certificate is presented for mTLS when set. Insecure transport is used when nothing is set.
+type RemotePluginTLSConfig struct {
+	Enabled    bool   `mapstructure:"enabled"`
+	CertFile   string `mapstructure:"cert_file"`
+	KeyFile    string `mapstructure:"key_file"`
+	CAFile     string `mapstructure:"ca_file"`
+	ServerName string `mapstructure:"server_name"`
+}
+
+// SchedulerConfig selects the scheduler the jobs are deployed to and run on
+type SchedulerConfig struct {
+	Type     string                  `mapstructure:"type" default:"airflow"` // airflow or temporal
+	Airflow  AirflowSchedulerConfig  `mapstructure:"airflow"`
+	Temporal TemporalSchedulerConfig `mapstructure:"temporal"`
+}
+
+// AirflowSchedulerConfig limits the calls to the airflow api shared by the deployments, replays and run queries,
+// the limits apply to every airflow host on its own
+type AirflowSchedulerConfig struct {
+	Timeout             time.Duration        `mapstructure:"timeout" default:"1m"`                 // timeout of a single call
+	MaxIdleConnsPerHost int                  `mapstructure:"max_idle_conns_per_host" default:"20"` // idle connections kept open to an airflow
+	MaxConnsPerHost     int                  `mapstructure:"max_conns_per_host" default:"0"`       // maximum open connections to an airflow, 0 is unlimited
+	IdleConnTimeout     time.Duration        `mapstructure:"idle_conn_timeout" default:"90s"`      // duration after which an idle connection is closed
+	RateLimits          map[string]float64   `mapstructure:"rate_limits"`                          // calls per second to an endpoint, one of dag_runs, dag, clear, create_run, health, import_errors or pools
+	RateLimitBurst      int                  `mapstructure:"rate_limit_burst" default:"1"`         // calls to an endpoint allowed at once before they are throttled
+	CircuitBreaker      CircuitBreakerConfig `mapstructure:"circuit_breaker"`
+	Canary              CanaryConfig         `mapstructure:"canary"`
+}
+
+// CanaryConfig deploys the jobs of a namespace only when airflow imports all of them, the jobs uploaded for the
+// namespace are rolled back when one of them fails to import
+type CanaryConfig struct {
+	Enabled       bool          `mapstructure:"enabled"`
+	CheckInterval time.Duration `mapstructure:"check_interval" default:"10s"` // interval on which airflow is checked for the import of the jobs
+	CheckTimeout  time.Duration `mapstructure:"check_timeout" default:"5m"`   // duration after which the jobs not imported yet fail the check
+}
+
+// CircuitBreakerConfig fails the calls to an endpoint fast after it keeps failing, until a trial call succeeds
+type CircuitBreakerConfig struct {
+	FailureThreshold int           `mapstructure:"failure_threshold" default:"0"` // consecutive failed calls opening the circuit, 0 disables the breaker
+	OpenDuration     time.Duration `mapstructure:"open_duration" default:"30s"`   // duration calls fail fast before a trial call is let through
+}
+
+// TemporalSchedulerConfig is the workflow started for every run of a job, the workers polling the task queue run it
+type TemporalSchedulerConfig struct {
+	TaskQueue    string `mapstructure:"task_queue" default:"optimus"`
+	WorkflowType string `mapstructure:"workflow_type" default:"OptimusJob"`
 }
 
-// TODO: add worker interval
 type ReplayConfig struct {
-	ReplayTimeout time.Duration `mapstructure:"replay_timeout" default:"3h"`
+	ReplayTimeout  time.Duration `mapstructure:"replay_timeout" default:"3h"`
+	WorkerInterval time.Duration `mapstructure:"worker_interval" default:"1m"` // interval on which replay states are reconciled
+	WorkerCount    int           `mapstructure:"worker_count" default:"1"`     // maximum replays processed concurrently
+	LeaseTimeout   time.Duration `mapstructure:"lease_timeout" default:"5m"`   // replays in progress whose lease is not renewed within it are resumed by any server
+
+	StatusPollInterval time.Duration `mapstructure:"status_poll_interval" default:"30s"` // interval on which replay statuses streamed to subscribers are re-read
+
+	TenantConcurrencyLimit int `mapstructure:"tenant_concurrency_limit"` // maximum active replays of a namespace, further ones wait in queue; 0 means no limit
+
+	RetryMaxAttempts int           `mapstructure:"retry_max_attempts" default:"3"` // attempts on transient scheduler errors before marking replay failed
+	RetryBackoff     time.Duration `mapstructure:"retry_backoff" default:"2s"`     // initial backoff between attempts, doubled on every retry
+}
+
+type SLAMonitorConfig struct {
+	Enabled  bool          `mapstructure:"enabled"`
+	Interval time.Duration `mapstructure:"interval" default:"5m"` // interval on which running and pending job runs are projected against their sla, and passed deadlines are checked
+}
+
+// WebhookSubscriptionConfig controls the posts of the run events to the webhook subscriptions of the namespaces
+type WebhookSubscriptionConfig struct {
+	MaxAttempts  int           `mapstructure:"max_attempts" default:"3"`    // attempts after which a delivery is logged as failed
+	RetryBackoff time.Duration `mapstructure:"retry_backoff" default:"10s"` // wait before the first retry, doubled on every attempt
+	Timeout      time.Duration `mapstructure:"timeout" default:"10s"`       // timeout of a single post
+}
+
+// DurationAnomalyConfig flags the successful runs taking much longer than the latest runs of the job, a run is
+// flagged when it exceeds either threshold, a zero threshold is not checked
+type DurationAnomalyConfig struct {
+	Enabled         bool    `mapstructure:"enabled"`
+	WindowSize      int     `mapstructure:"window_size" default:"30"`     // latest run durations kept per job to compare against
+	MinSamples      int     `mapstructure:"min_samples" default:"10"`     // durations needed before the runs of a job are checked
+	ZScoreThreshold float64 `mapstructure:"zscore_threshold" default:"3"` // standard deviations above the mean after which a run is flagged
+	Percentile      float64 `mapstructure:"percentile" default:"0"`       // percentile of the durations, like 99, above which a run is flagged
+}
+
+type RunSnapshotConfig struct {
+	Enabled   bool          `mapstructure:"enabled"`
+	Retention time.Duration `mapstructure:"retention" default:"720h"` // duration after which the snapshot of a run input expires
+}
+
+// FailureRuleConfig tags the run failures matching it with the category, rules are evaluated in order
+type FailureRuleConfig struct {
+	Plugin   string   `mapstructure:"plugin"`   // plugin the rule applies to, empty applies to every plugin
+	Category string   `mapstructure:"category"` // one of infra, data, quota or code
+	Pattern  string   `mapstructure:"pattern"`  // regex matched against the exception and message of the failure
+	Codes    []string `mapstructure:"codes"`    // error codes reported by the run
+}
+
+// LegacyClientConfig serves the clients still speaking the legacy protocol, to upgrade the server ahead of them
+type LegacyClientConfig struct {
+	Enabled bool   `mapstructure:"enabled"`
+	Sunset  string `mapstructure:"sunset"` // HTTP-date after which legacy clients are no longer served, sent in the Sunset header
+}
+
+type ExecutorInputConfig struct {
+	CacheSize int           `mapstructure:"cache_size"`              // compiled executor inputs kept in memory; 0 disables the cache
+	CacheTTL  time.Duration `mapstructure:"cache_ttl" default:"10m"` // duration for which a compiled input is served from the cache
 }
 
 type Publisher struct {
-	Type   string      `mapstructure:"type" default:"kafka"`
-	Buffer int         `mapstructure:"buffer"`
-	Config interface{} `mapstructure:"config"`
+	Type              string                `mapstructure:"type" default:"kafka"`
+	Buffer            int                   `mapstructure:"buffer"`
+	Encoding          string                `mapstructure:"encoding" default:"proto"` // proto or json encoding of the events
+	TopicPerEventType bool                  `mapstructure:"topic_per_event_type"`     // publish every event type to its own topic
+	Config            interface{}           `mapstructure:"config"`
+	Outbox            PublisherOutboxConfig `mapstructure:"outbox"`
+}
+
+// PublisherOutboxConfig keeps the events in a database outbox until they are published, retrying the failed ones
+type PublisherOutboxConfig struct {
+	Enabled      bool          `mapstructure:"enabled"`
+	BatchSize    int           `mapstructure:"batch_size" default:"100"`    // max events published at once
+	MaxAttempts  int           `mapstructure:"max_attempts" default:"10"`   // attempts after which an event is dead lettered
+	RetryBackoff time.Duration `mapstructure:"retry_backoff" default:"30s"` // wait before the first retry, doubled on every attempt
 }
 
 type PublisherKafkaConfig struct {
@@ -63,3 +313,28 @@ type PublisherKafkaConfig struct {
 	BatchIntervalSecond int      `mapstructure:"batch_interval_second"`
 	BrokerURLs          []string `mapstructure:"broker_urls"`
 }
+
+type PublisherNATSConfig struct {
+	URL                 string `mapstructure:"url"`     // url of the nats server, e.g. nats://localhost:4222
+	Subject             string `mapstructure:"subject"` // subject captured by a jetstream stream
+	BatchIntervalSecond int    `mapstructure:"batch_interval_second"`
+}
+
+type PublisherPubSubConfig struct {
+	ProjectID           string `mapstructure:"project_id"`
+	Topic               string `mapstructure:"topic"`
+	BatchIntervalSecond int    `mapstructure:"batch_interval_second"`
+	MaxBatchSize        int    `mapstructure:"max_batch_size"` // max messages sent in a single publish request
+}
+
+// EventConsumer consumes the job events published by the scheduler, as an alternative to the event endpoint
+type EventConsumer struct {
+	Type   string      `mapstructure:"type" default:"kafka"`
+	Config interface{} `mapstructure:"config"`
+}
+
+type EventConsumerKafkaConfig struct {
+	Topic      string   `mapstructure:"topic"`
+	GroupID    string   `mapstructure:"group_id"`
+	BrokerURLs []string `mapstructure:"broker_urls"`
+}
@@ -25,7 +25,23 @@ var FS = afero.NewReadOnlyFs(afero.NewOsFs())
 // LoadClientConfig load the project specific config from these locations:
 // 1. filepath. ./optimus <client_command> -c "path/to/config/optimus.yaml"
 // 2. current dir. Optimus will look at current directory if there's optimus.yaml there, use it
+// The current context, if any, is applied on the loaded config.
 func LoadClientConfig(filePath string) (*ClientConfig, error) {
+	cfg, err := LoadClientConfigWithoutContext(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cfg.ApplyCurrentContext(); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// LoadClientConfigWithoutContext loads the client config the way LoadClientConfig does, without applying the
+// current context, so the config can still be loaded when its current context is not valid
+func LoadClientConfigWithoutContext(filePath string) (*ClientConfig, error) {
 	cfg := &ClientConfig{}
 
 	// getViperWithDefault + SetFs
@@ -64,6 +80,34 @@ func LoadClientConfig(filePath string) (*ClientConfig, error) {
 	return cfg, nil
 }
 
+// LoadProjectClientConfigs loads the client configs of the projects listed in the given config, the given config is
+// the only project when none is listed. Host and auth not set on a project are taken from the given config.
+func LoadProjectClientConfigs(conf *ClientConfig) ([]*ClientConfig, error) {
+	if len(conf.Projects) == 0 {
+		return []*ClientConfig{conf}, nil
+	}
+
+	projectConfs := make([]*ClientConfig, len(conf.Projects))
+	for i, projectRef := range conf.Projects {
+		if projectRef == nil || projectRef.Path == EmptyPath {
+			return nil, errors.New("path of the project config is empty")
+		}
+
+		projectConf, err := LoadClientConfig(projectRef.Path)
+		if err != nil {
+			return nil, fmt.Errorf("project config %s: %w", projectRef.Path, err)
+		}
+		if projectConf.Host == "" {
+			projectConf.Host = conf.Host
+		}
+		if projectConf.Auth == (Auth{}) {
+			projectConf.Auth = conf.Auth
+		}
+		projectConfs[i] = projectConf
+	}
+	return projectConfs, nil
+}
+
 // LoadServerConfig load the server specific config from these locations:
 // 1. filepath. ./optimus <server_command> -c "path/to/config.yaml"
 // 2. env var. eg. OPTIMUS_SERVE_PORT, etc
@@ -0,0 +1,142 @@
+package audit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+)
+
+const (
+	EntityAuditLog = "audit_log"
+
+	// ActorMetadataKey is sent by the clients with the user making the call, the name of the api token is
+	// recorded as the actor of the calls authenticated with one
+	ActorMetadataKey = "x-optimus-actor"
+
+	DefaultQueryLimit = 100
+	MaxQueryLimit     = 1000
+)
+
+type Action string
+
+const (
+	ActionJobDeploy       Action = "job.deploy"
+	ActionJobDelete       Action = "job.delete"
+	ActionJobUpdateState  Action = "job.update_state"
+	ActionJobUpload       Action = "job.upload"
+	ActionResourceDeploy  Action = "resource.deploy"
+	ActionSecretSet       Action = "secret.set"
+	ActionSecretDelete    Action = "secret.delete"
+	ActionReplayCreate    Action = "replay.create"
+	ActionProjectUpdate   Action = "project.update"
+	ActionNamespaceUpdate Action = "namespace.update"
+	ActionBackupCreate    Action = "backup.create"
+)
+
+func (a Action) String() string {
+	return string(a)
+}
+
+type Result string
+
+const (
+	ResultSuccess Result = "success"
+	ResultFailure Result = "failure"
+)
+
+func (r Result) String() string {
+	return string(r)
+}
+
+// Entry records a mutating call made to the server. Only the digest of the payload is kept, the payloads may hold
+// secret values and the digest is enough to tell whether two calls carried the same payload.
+type Entry struct {
+	ID uuid.UUID
+
+	Actor         string
+	ProjectName   tenant.ProjectName
+	NamespaceName tenant.NamespaceName
+
+	Action        Action
+	Method        string
+	PayloadDigest string
+
+	Result Result
+	// Message is the error of the failed calls
+	Message string
+
+	CreatedAt time.Time
+}
+
+func NewEntry(actor string, projectName tenant.ProjectName, namespaceName tenant.NamespaceName, action Action, method,
+	payloadDigest string, callErr error, at time.Time,
+) (*Entry, error) {
+	if action == "" {
+		return nil, errors.InvalidArgument(EntityAuditLog, "action is empty")
+	}
+	if method == "" {
+		return nil, errors.InvalidArgument(EntityAuditLog, "method is empty")
+	}
+
+	entry := &Entry{
+		Actor:         actor,
+		ProjectName:   projectName,
+		NamespaceName: namespaceName,
+		Action:        action,
+		Method:        method,
+		PayloadDigest: payloadDigest,
+		Result:        ResultSuccess,
+		CreatedAt:     at,
+	}
+	if callErr != nil {
+		entry.Result = ResultFailure
+		entry.Message = callErr.Error()
+	}
+	return entry, nil
+}
+
+// PayloadDigest returns the hex encoded sha256 of the payload, the payloads of the streaming calls are written to
+// the hash as they are received
+func PayloadDigest(payload hash.Hash) string {
+	return hex.EncodeToString(payload.Sum(nil))
+}
+
+func NewPayloadHash() hash.Hash {
+	return sha256.New()
+}
+
+// Filter selects the entries of a project, optionally narrowed down by namespace, actor, action and the time range
+type Filter struct {
+	ProjectName   tenant.ProjectName
+	NamespaceName tenant.NamespaceName
+	Actor         string
+	Action        Action
+
+	Since time.Time
+	Until time.Time
+	Limit int
+}
+
+// Validate checks the filter selects a project and defaults the limit when it is not set
+func (f *Filter) Validate() error {
+	if f.ProjectName == "" {
+		return errors.InvalidArgument(EntityAuditLog, "project name is empty")
+	}
+	if !f.Since.IsZero() && !f.Until.IsZero() && f.Until.Before(f.Since) {
+		return errors.InvalidArgument(EntityAuditLog, "until should not be before since")
+	}
+	if f.Limit < 0 || f.Limit > MaxQueryLimit {
+		return errors.InvalidArgument(EntityAuditLog, fmt.Sprintf("limit should be between 0 and %d", MaxQueryLimit))
+	}
+	if f.Limit == 0 {
+		f.Limit = DefaultQueryLimit
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package audit_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/core/audit"
+)
+
+func TestEntry(t *testing.T) {
+	at := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+	method := "/gotocompany.optimus.core.v1beta1.SecretService/RegisterSecret"
+	payload := audit.NewPayloadHash()
+	payload.Write([]byte("secret-value"))
+	digest := audit.PayloadDigest(payload)
+
+	t.Run("NewEntry", func(t *testing.T) {
+		t.Run("should return error if action is empty", func(t *testing.T) {
+			entry, err := audit.NewEntry("user", "proj", "ns1", "", method, digest, nil, at)
+			assert.ErrorContains(t, err, "action is empty")
+			assert.Nil(t, entry)
+		})
+		t.Run("should return error if method is empty", func(t *testing.T) {
+			entry, err := audit.NewEntry("user", "proj", "ns1", audit.ActionSecretSet, "", digest, nil, at)
+			assert.ErrorContains(t, err, "method is empty")
+			assert.Nil(t, entry)
+		})
+		t.Run("should record the successful calls", func(t *testing.T) {
+			entry, err := audit.NewEntry("user", "proj", "ns1", audit.ActionSecretSet, method, digest, nil, at)
+			assert.NoError(t, err)
+			assert.Equal(t, "31160254d1297393d2ad00e1c01851aec834361e02c524b89fe06aff2879ce6a", entry.PayloadDigest)
+			assert.Equal(t, audit.ResultSuccess, entry.Result)
+			assert.Empty(t, entry.Message)
+		})
+		t.Run("should record the error of failed calls", func(t *testing.T) {
+			entry, err := audit.NewEntry("user", "proj", "ns1", audit.ActionSecretSet, method, digest, errors.New("already exists"), at)
+			assert.NoError(t, err)
+			assert.Equal(t, audit.ResultFailure, entry.Result)
+			assert.Equal(t, "already exists", entry.Message)
+		})
+	})
+	t.Run("Filter", func(t *testing.T) {
+		t.Run("should return error if project is not selected", func(t *testing.T) {
+			filter := audit.Filter{Actor: "user"}
+			assert.ErrorContains(t, filter.Validate(), "project name is empty")
+		})
+		t.Run("should return error if time range is inverted", func(t *testing.T) {
+			filter := audit.Filter{ProjectName: "proj", Since: at, Until: at.Add(-time.Hour)}
+			assert.ErrorContains(t, filter.Validate(), "until should not be before since")
+		})
+		t.Run("should return error if limit is above the max", func(t *testing.T) {
+			filter := audit.Filter{ProjectName: "proj", Limit: audit.MaxQueryLimit + 1}
+			assert.ErrorContains(t, filter.Validate(), "limit should be between 0 and 1000")
+		})
+		t.Run("should default the limit", func(t *testing.T) {
+			filter := audit.Filter{ProjectName: "proj"}
+			assert.NoError(t, filter.Validate())
+			assert.Equal(t, audit.DefaultQueryLimit, filter.Limit)
+		})
+	})
+}
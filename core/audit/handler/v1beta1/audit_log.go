@@ -0,0 +1,91 @@
+package v1beta1
+
+import (
+	"context"
+
+	"github.com/goto/salt/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/goto/optimus/core/audit"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+type AuditLogService interface {
+	Query(ctx context.Context, filter audit.Filter) ([]*audit.Entry, error)
+}
+
+type AuditLogHandler struct {
+	l       log.Logger
+	service AuditLogService
+
+	pb.UnimplementedAuditLogServiceServer
+}
+
+// Query lists the audit logs of the project matching the request, the latest first
+func (h *AuditLogHandler) Query(ctx context.Context, req *pb.QueryAuditLogsRequest) (*pb.QueryAuditLogsResponse, error) {
+	filter, err := fromQueryAuditLogsRequest(req)
+	if err != nil {
+		h.l.Error("error adapting audit log filter: %s", err)
+		return nil, errors.GRPCErr(err, "unable to query audit logs of "+req.GetProjectName())
+	}
+
+	entries, err := h.service.Query(ctx, filter)
+	if err != nil {
+		h.l.Error("error querying audit logs of project [%s]: %s", filter.ProjectName, err)
+		return nil, errors.GRPCErr(err, "unable to query audit logs of "+req.GetProjectName())
+	}
+
+	auditLogs := make([]*pb.AuditLog, len(entries))
+	for i, entry := range entries {
+		auditLogs[i] = &pb.AuditLog{
+			Id:            entry.ID.String(),
+			Actor:         entry.Actor,
+			ProjectName:   entry.ProjectName.String(),
+			NamespaceName: entry.NamespaceName.String(),
+			Action:        entry.Action.String(),
+			Method:        entry.Method,
+			PayloadDigest: entry.PayloadDigest,
+			Result:        entry.Result.String(),
+			Message:       entry.Message,
+			CreatedAt:     timestamppb.New(entry.CreatedAt),
+		}
+	}
+	return &pb.QueryAuditLogsResponse{AuditLogs: auditLogs}, nil
+}
+
+func fromQueryAuditLogsRequest(req *pb.QueryAuditLogsRequest) (audit.Filter, error) {
+	projectName, err := tenant.ProjectNameFrom(req.GetProjectName())
+	if err != nil {
+		return audit.Filter{}, err
+	}
+
+	filter := audit.Filter{
+		ProjectName:   projectName,
+		NamespaceName: tenant.NamespaceName(req.GetNamespaceName()),
+		Actor:         req.GetActor(),
+		Action:        audit.Action(req.GetAction()),
+		Limit:         int(req.GetLimit()),
+	}
+	if req.GetSince() != nil {
+		if err := req.GetSince().CheckValid(); err != nil {
+			return audit.Filter{}, errors.InvalidArgument(audit.EntityAuditLog, "invalid since")
+		}
+		filter.Since = req.GetSince().AsTime()
+	}
+	if req.GetUntil() != nil {
+		if err := req.GetUntil().CheckValid(); err != nil {
+			return audit.Filter{}, errors.InvalidArgument(audit.EntityAuditLog, "invalid until")
+		}
+		filter.Until = req.GetUntil().AsTime()
+	}
+	return filter, nil
+}
+
+func NewAuditLogHandler(l log.Logger, service AuditLogService) *AuditLogHandler {
+	return &AuditLogHandler{
+		l:       l,
+		service: service,
+	}
+}
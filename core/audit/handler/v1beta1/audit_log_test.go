@@ -0,0 +1,102 @@
+package v1beta1_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/goto/optimus/core/audit"
+	"github.com/goto/optimus/core/audit/handler/v1beta1"
+	"github.com/goto/optimus/core/tenant"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+func TestAuditLogHandler(t *testing.T) {
+	ctx := context.Background()
+	logger := log.NewNoop()
+	createdAt := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("Query", func(t *testing.T) {
+		t.Run("returns error when project name is empty", func(t *testing.T) {
+			service := new(auditLogService)
+			handler := v1beta1.NewAuditLogHandler(logger, service)
+
+			_, err := handler.Query(ctx, &pb.QueryAuditLogsRequest{})
+			assert.ErrorContains(t, err, "code = InvalidArgument")
+		})
+		t.Run("returns error when since is invalid", func(t *testing.T) {
+			service := new(auditLogService)
+			handler := v1beta1.NewAuditLogHandler(logger, service)
+
+			_, err := handler.Query(ctx, &pb.QueryAuditLogsRequest{
+				ProjectName: "proj",
+				Since:       &timestamppb.Timestamp{Nanos: -1},
+			})
+			assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid argument for entity "+
+				audit.EntityAuditLog+": invalid since: unable to query audit logs of proj")
+		})
+		t.Run("returns error when unable to query the audit logs", func(t *testing.T) {
+			service := new(auditLogService)
+			service.On("Query", ctx, audit.Filter{ProjectName: "proj"}).Return(nil, errors.New("unknown error"))
+			defer service.AssertExpectations(t)
+
+			handler := v1beta1.NewAuditLogHandler(logger, service)
+
+			_, err := handler.Query(ctx, &pb.QueryAuditLogsRequest{ProjectName: "proj"})
+			assert.EqualError(t, err, "rpc error: code = Internal desc = unknown error: unable to query audit logs of proj")
+		})
+		t.Run("returns the audit logs matching the request", func(t *testing.T) {
+			entry, err := audit.NewEntry("john.doe", "proj", "sales", audit.ActionSecretSet,
+				"/gotocompany.optimus.core.v1beta1.SecretService/RegisterSecret", "digest", nil, createdAt)
+			assert.NoError(t, err)
+
+			since := createdAt.Add(-time.Hour)
+			service := new(auditLogService)
+			service.On("Query", ctx, audit.Filter{
+				ProjectName:   "proj",
+				NamespaceName: tenant.NamespaceName("sales"),
+				Actor:         "john.doe",
+				Action:        audit.ActionSecretSet,
+				Since:         since,
+				Limit:         10,
+			}).Return([]*audit.Entry{entry}, nil)
+			defer service.AssertExpectations(t)
+
+			handler := v1beta1.NewAuditLogHandler(logger, service)
+
+			resp, err := handler.Query(ctx, &pb.QueryAuditLogsRequest{
+				ProjectName:   "proj",
+				NamespaceName: "sales",
+				Actor:         "john.doe",
+				Action:        audit.ActionSecretSet.String(),
+				Since:         timestamppb.New(since),
+				Limit:         10,
+			})
+			assert.NoError(t, err)
+			assert.Len(t, resp.GetAuditLogs(), 1)
+			assert.Equal(t, "john.doe", resp.GetAuditLogs()[0].GetActor())
+			assert.Equal(t, "sales", resp.GetAuditLogs()[0].GetNamespaceName())
+			assert.Equal(t, audit.ActionSecretSet.String(), resp.GetAuditLogs()[0].GetAction())
+			assert.Equal(t, "digest", resp.GetAuditLogs()[0].GetPayloadDigest())
+			assert.Equal(t, createdAt, resp.GetAuditLogs()[0].GetCreatedAt().AsTime())
+		})
+	})
+}
+
+type auditLogService struct {
+	mock.Mock
+}
+
+func (a *auditLogService) Query(ctx context.Context, filter audit.Filter) ([]*audit.Entry, error) {
+	args := a.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*audit.Entry), args.Error(1)
+}
@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+
+	"github.com/goto/salt/log"
+
+	"github.com/goto/optimus/core/audit"
+)
+
+type AuditLogRepository interface {
+	Create(ctx context.Context, entry *audit.Entry) error
+	Query(ctx context.Context, filter audit.Filter) ([]*audit.Entry, error)
+}
+
+// AuditLogService records the mutating calls made to the server, like job deployments, secret updates and replays,
+// and lets them be queried per project
+type AuditLogService struct {
+	repo   AuditLogRepository
+	logger log.Logger
+}
+
+func (s AuditLogService) Record(ctx context.Context, entry *audit.Entry) error {
+	if err := s.repo.Create(ctx, entry); err != nil {
+		s.logger.Error("error recording audit log of [%s] by [%s]: %s", entry.Method, entry.Actor, err)
+		return err
+	}
+	return nil
+}
+
+func (s AuditLogService) Query(ctx context.Context, filter audit.Filter) ([]*audit.Entry, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+	return s.repo.Query(ctx, filter)
+}
+
+func NewAuditLogService(repo AuditLogRepository, logger log.Logger) *AuditLogService {
+	return &AuditLogService{
+		repo:   repo,
+		logger: logger,
+	}
+}
@@ -0,0 +1,81 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/goto/optimus/core/audit"
+	"github.com/goto/optimus/core/audit/service"
+)
+
+func TestAuditLogService(t *testing.T) {
+	ctx := context.Background()
+	logger := log.NewNoop()
+	at := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+	entry, _ := audit.NewEntry("user", "proj", "ns1", audit.ActionReplayCreate,
+		"/gotocompany.optimus.core.v1beta1.ReplayService/Replay", "digest", nil, at)
+
+	t.Run("Record", func(t *testing.T) {
+		t.Run("returns error when unable to store the entry", func(t *testing.T) {
+			repo := new(auditLogRepository)
+			repo.On("Create", ctx, entry).Return(errors.New("unable to store"))
+			defer repo.AssertExpectations(t)
+
+			auditService := service.NewAuditLogService(repo, logger)
+			err := auditService.Record(ctx, entry)
+			assert.EqualError(t, err, "unable to store")
+		})
+		t.Run("stores the entry", func(t *testing.T) {
+			repo := new(auditLogRepository)
+			repo.On("Create", ctx, entry).Return(nil)
+			defer repo.AssertExpectations(t)
+
+			auditService := service.NewAuditLogService(repo, logger)
+			assert.NoError(t, auditService.Record(ctx, entry))
+		})
+	})
+	t.Run("Query", func(t *testing.T) {
+		t.Run("returns error when the filter does not select a project", func(t *testing.T) {
+			repo := new(auditLogRepository)
+			defer repo.AssertExpectations(t)
+
+			auditService := service.NewAuditLogService(repo, logger)
+			_, err := auditService.Query(ctx, audit.Filter{Actor: "user"})
+			assert.ErrorContains(t, err, "project name is empty")
+		})
+		t.Run("queries the entries with the default limit", func(t *testing.T) {
+			repo := new(auditLogRepository)
+			repo.On("Query", ctx, audit.Filter{ProjectName: "proj", Action: audit.ActionReplayCreate, Limit: audit.DefaultQueryLimit}).
+				Return([]*audit.Entry{entry}, nil)
+			defer repo.AssertExpectations(t)
+
+			auditService := service.NewAuditLogService(repo, logger)
+			entries, err := auditService.Query(ctx, audit.Filter{ProjectName: "proj", Action: audit.ActionReplayCreate})
+			assert.NoError(t, err)
+			assert.Equal(t, []*audit.Entry{entry}, entries)
+		})
+	})
+}
+
+type auditLogRepository struct {
+	mock.Mock
+}
+
+func (r *auditLogRepository) Create(ctx context.Context, entry *audit.Entry) error {
+	args := r.Called(ctx, entry)
+	return args.Error(0)
+}
+
+func (r *auditLogRepository) Query(ctx context.Context, filter audit.Filter) ([]*audit.Entry, error) {
+	args := r.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*audit.Entry), args.Error(1)
+}
@@ -80,6 +80,7 @@ func (j *JobDeleted) Bytes() ([]byte, error) {
 		ProjectName:   j.JobTenant.ProjectName().String(),
 		NamespaceName: j.JobTenant.NamespaceName().String(),
 		EventType:     pbInt.OptimusChangeEvent_EVENT_TYPE_JOB_DELETE,
+		SchemaVersion: SchemaVersion,
 		Payload: &pbInt.OptimusChangeEvent_JobChange{
 			JobChange: &pbInt.JobChangePayload{
 				JobName: j.JobName.String(),
@@ -125,6 +126,7 @@ func (j *JobStateChange) Bytes() ([]byte, error) {
 		ProjectName:   j.JobTenant.ProjectName().String(),
 		NamespaceName: j.JobTenant.NamespaceName().String(),
 		EventType:     pbInt.OptimusChangeEvent_EVENT_TYPE_JOB_STATE_CHANGE,
+		SchemaVersion: SchemaVersion,
 		Payload: &pbInt.OptimusChangeEvent_JobStateChange{
 			JobStateChange: &pbInt.JobStateChangePayload{
 				JobName: j.JobName.String(),
@@ -144,6 +146,7 @@ func jobEventToBytes(event Event, job *job.Job, eventType pbInt.OptimusChangeEve
 		ProjectName:   job.Tenant().ProjectName().String(),
 		NamespaceName: job.Tenant().NamespaceName().String(),
 		EventType:     eventType,
+		SchemaVersion: SchemaVersion,
 		Payload: &pbInt.OptimusChangeEvent_JobChange{
 			JobChange: &pbInt.JobChangePayload{
 				JobName: job.GetName(),
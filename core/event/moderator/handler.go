@@ -45,3 +45,33 @@ func (e EventHandler) HandleEvent(event Event) {
 	go func() { e.messageChan <- bytes }()
 	eventQueueCounter.Inc()
 }
+
+// EncodedHandler encodes the events with the encoder before handing them over to the handler
+type EncodedHandler struct {
+	handler Handler
+	encode  func([]byte) ([]byte, error)
+}
+
+func NewEncodedHandler(handler Handler, encode func([]byte) ([]byte, error)) *EncodedHandler {
+	return &EncodedHandler{
+		handler: handler,
+		encode:  encode,
+	}
+}
+
+func (h EncodedHandler) HandleEvent(event Event) {
+	h.handler.HandleEvent(encodedEvent{event: event, encode: h.encode})
+}
+
+type encodedEvent struct {
+	event  Event
+	encode func([]byte) ([]byte, error)
+}
+
+func (e encodedEvent) Bytes() ([]byte, error) {
+	bytes, err := e.event.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	return e.encode(bytes)
+}
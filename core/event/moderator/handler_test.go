@@ -71,6 +71,36 @@ func TestHandleEvent(t *testing.T) {
 	})
 }
 
+func TestEncodedHandler(t *testing.T) {
+	logger := log.NewNoop()
+
+	t.Run("hands over the encoded event to the handler", func(t *testing.T) {
+		repo := new(mockOutboxRepository)
+		defer repo.AssertExpectations(t)
+
+		event := NewEvent(t)
+		event.On("Bytes").Return([]byte("message"), nil)
+		repo.On("Save", mock.Anything, []byte("encoded message")).Return(nil)
+
+		handler := moderator.NewEncodedHandler(moderator.NewOutboxHandler(repo, logger), func(payload []byte) ([]byte, error) {
+			return append([]byte("encoded "), payload...), nil
+		})
+		handler.HandleEvent(event)
+	})
+	t.Run("does not hand over the event which cannot be encoded", func(t *testing.T) {
+		repo := new(mockOutboxRepository)
+		defer repo.AssertExpectations(t)
+
+		event := NewEvent(t)
+		event.On("Bytes").Return([]byte("message"), nil)
+
+		handler := moderator.NewEncodedHandler(moderator.NewOutboxHandler(repo, logger), func([]byte) ([]byte, error) {
+			return nil, errors.New("cannot encode")
+		})
+		handler.HandleEvent(event)
+	})
+}
+
 type Event struct {
 	mock.Mock
 }
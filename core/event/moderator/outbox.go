@@ -0,0 +1,265 @@
+package moderator
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/goto/salt/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/goto/optimus/internal/errors"
+)
+
+const (
+	EntityOutbox = "event_outbox"
+
+	OutboxStatusPending    OutboxStatus = "pending"
+	OutboxStatusDeadLetter OutboxStatus = "dead_letter"
+
+	defaultOutboxBatchSize    = 100
+	defaultOutboxMaxAttempts  = 10
+	defaultOutboxRetryBackoff = 30 * time.Second
+	maxOutboxRetryBackoff     = time.Hour
+)
+
+var (
+	outboxSaveFailureCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "publisher_outbox_save_failures_total",
+		Help: "Events which could not be stored in the outbox, and are lost",
+	})
+	outboxPublishedCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "publisher_outbox_events_published_total",
+		Help: "Events of the outbox published through the writer",
+	})
+	outboxRetryCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "publisher_outbox_events_retried_total",
+		Help: "Events of the outbox failed to be published and scheduled for a retry",
+	})
+	outboxDeadLetterCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "publisher_outbox_events_dead_lettered_total",
+		Help: "Events of the outbox moved to the dead letter after failing on every attempt",
+	})
+)
+
+type OutboxStatus string
+
+// OutboxEvent is an event kept in the outbox until the writer publishes it, pending events are retried
+// until the max attempts, after which they stay in the dead letter until re-driven
+type OutboxEvent struct {
+	ID      uuid.UUID
+	Payload []byte
+
+	Status        OutboxStatus
+	Attempts      int
+	LastError     string
+	NextAttemptAt time.Time
+}
+
+type OutboxRepository interface {
+	Save(ctx context.Context, payload []byte) error
+	GetPending(ctx context.Context, at time.Time, limit int) ([]*OutboxEvent, error)
+	Delete(ctx context.Context, ids []uuid.UUID) error
+	UpdateAttempts(ctx context.Context, events []*OutboxEvent) error
+	// Redrive moves the dead lettered events with the ids, or all of them when no id is given, back to pending
+	Redrive(ctx context.Context, ids []uuid.UUID, at time.Time) (int64, error)
+}
+
+// OutboxHandler stores the events in the outbox instead of keeping them in memory, so that the events
+// are not lost when the writer fails or the server stops before they are published
+type OutboxHandler struct {
+	repo   OutboxRepository
+	logger log.Logger
+}
+
+func NewOutboxHandler(repo OutboxRepository, logger log.Logger) *OutboxHandler {
+	return &OutboxHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+func (h OutboxHandler) HandleEvent(event Event) {
+	bytes, err := event.Bytes()
+	if err != nil {
+		h.logger.Error("error converting event to bytes: %v", err)
+		return
+	}
+
+	if err := h.repo.Save(context.Background(), bytes); err != nil {
+		h.logger.Error("error storing event in the outbox: %v", err)
+		outboxSaveFailureCounter.Inc()
+		return
+	}
+	eventQueueCounter.Inc()
+}
+
+type OutboxConfig struct {
+	BatchSize    int
+	MaxAttempts  int
+	RetryBackoff time.Duration
+}
+
+// OutboxRelay publishes the pending events of the outbox through the writer on every interval, an event is
+// removed from the outbox once published, giving at least once delivery
+type OutboxRelay struct {
+	mu sync.Mutex
+	wg sync.WaitGroup
+
+	repo     OutboxRepository
+	writer   Writer
+	interval time.Duration
+	config   OutboxConfig
+	now      func() time.Time
+
+	logger log.Logger
+}
+
+func NewOutboxRelay(repo OutboxRepository, writer Writer, interval time.Duration, config OutboxConfig, now func() time.Time, logger log.Logger) *OutboxRelay {
+	if config.BatchSize <= 0 {
+		config.BatchSize = defaultOutboxBatchSize
+	}
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = defaultOutboxMaxAttempts
+	}
+	if config.RetryBackoff <= 0 {
+		config.RetryBackoff = defaultOutboxRetryBackoff
+	}
+	return &OutboxRelay{
+		repo:     repo,
+		writer:   writer,
+		interval: interval,
+		config:   config,
+		now:      now,
+		logger:   logger,
+	}
+}
+
+func (r *OutboxRelay) Run(ctx context.Context) {
+	r.wg.Add(1)
+	defer r.wg.Done()
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			r.Flush(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Flush publishes the pending events batch by batch, and stops on the first batch failing to be published
+func (r *OutboxRelay) Flush(ctx context.Context) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for {
+		now := r.now()
+		events, err := r.repo.GetPending(ctx, now, r.config.BatchSize)
+		if err != nil {
+			r.logger.Error("error getting pending events of the outbox: %v", err)
+			return
+		}
+		if len(events) == 0 {
+			return
+		}
+
+		payloads := make([][]byte, len(events))
+		for i, event := range events {
+			payloads[i] = event.Payload
+		}
+
+		published, failed := events, []*OutboxEvent(nil)
+		writeErr := r.writer.Write(payloads)
+		if writeErr != nil {
+			r.logger.Error("error publishing %d events of the outbox: %v", len(events), writeErr)
+			published, failed = splitDelivered(events, writeErr)
+		}
+
+		if len(published) > 0 {
+			ids := make([]uuid.UUID, len(published))
+			for i, event := range published {
+				ids[i] = event.ID
+			}
+			if err := r.repo.Delete(ctx, ids); err != nil {
+				// the events are published again on the next flush, which is fine for at least once delivery
+				r.logger.Error("error removing published events from the outbox: %v", err)
+				return
+			}
+			outboxPublishedCounter.Add(float64(len(published)))
+		}
+
+		if len(failed) > 0 {
+			r.scheduleRetry(ctx, failed, writeErr, now)
+			return
+		}
+		if len(events) < r.config.BatchSize {
+			return
+		}
+	}
+}
+
+// splitDelivered returns the events delivered by the write which failed, when the writer tells which of them are
+// delivered, otherwise none of them is taken as delivered
+func splitDelivered(events []*OutboxEvent, writeErr error) ([]*OutboxEvent, []*OutboxEvent) {
+	var partialErr *WriteError
+	if !errors.As(writeErr, &partialErr) {
+		return nil, events
+	}
+
+	var delivered, failed []*OutboxEvent
+	for i, event := range events {
+		if partialErr.Delivered(i) {
+			delivered = append(delivered, event)
+			continue
+		}
+		failed = append(failed, event)
+	}
+	return delivered, failed
+}
+
+func (r *OutboxRelay) scheduleRetry(ctx context.Context, events []*OutboxEvent, publishErr error, now time.Time) {
+	for _, event := range events {
+		event.Attempts++
+		event.LastError = publishErr.Error()
+		if event.Attempts >= r.config.MaxAttempts {
+			event.Status = OutboxStatusDeadLetter
+			outboxDeadLetterCounter.Inc()
+			continue
+		}
+		event.NextAttemptAt = now.Add(r.backoff(event.Attempts))
+		outboxRetryCounter.Inc()
+	}
+
+	if err := r.repo.UpdateAttempts(ctx, events); err != nil {
+		r.logger.Error("error updating attempts of the outbox events: %v", err)
+	}
+}
+
+// backoff doubles the wait on every attempt, up to an hour
+func (r *OutboxRelay) backoff(attempts int) time.Duration {
+	wait := r.config.RetryBackoff
+	for i := 1; i < attempts && wait < maxOutboxRetryBackoff; i++ {
+		wait *= 2
+	}
+	if wait > maxOutboxRetryBackoff {
+		wait = maxOutboxRetryBackoff
+	}
+	return wait
+}
+
+// Redrive moves the dead lettered events back to pending, to be published on the next flush
+func (r *OutboxRelay) Redrive(ctx context.Context, ids []uuid.UUID) (int64, error) {
+	return r.repo.Redrive(ctx, ids, r.now())
+}
+
+func (r *OutboxRelay) Close() error {
+	r.wg.Wait()
+	r.Flush(context.Background())
+	return r.writer.Close()
+}
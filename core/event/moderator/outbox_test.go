@@ -0,0 +1,164 @@
+package moderator_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/goto/optimus/core/event/moderator"
+)
+
+func TestOutbox(t *testing.T) {
+	ctx := context.Background()
+	logger := log.NewNoop()
+	now := time.Date(2023, 10, 10, 10, 0, 0, 0, time.UTC)
+	nowFn := func() time.Time { return now }
+	config := moderator.OutboxConfig{BatchSize: 2, MaxAttempts: 3, RetryBackoff: time.Minute}
+
+	t.Run("OutboxHandler", func(t *testing.T) {
+		t.Run("stores the event in the outbox", func(t *testing.T) {
+			repo := new(mockOutboxRepository)
+			defer repo.AssertExpectations(t)
+
+			event := NewEvent(t)
+			event.On("Bytes").Return([]byte("message"), nil)
+			repo.On("Save", mock.Anything, []byte("message")).Return(nil)
+
+			moderator.NewOutboxHandler(repo, logger).HandleEvent(event)
+		})
+		t.Run("does not store the event if its bytes cannot be extracted", func(t *testing.T) {
+			repo := new(mockOutboxRepository)
+			defer repo.AssertExpectations(t)
+
+			event := NewEvent(t)
+			event.On("Bytes").Return(nil, errors.New("cannot get bytes representation"))
+
+			moderator.NewOutboxHandler(repo, logger).HandleEvent(event)
+		})
+	})
+	t.Run("OutboxRelay", func(t *testing.T) {
+		t.Run("publishes pending events batch by batch and removes them from the outbox", func(t *testing.T) {
+			repo := new(mockOutboxRepository)
+			defer repo.AssertExpectations(t)
+			writer := new(mockWriter)
+			defer writer.AssertExpectations(t)
+
+			first := &moderator.OutboxEvent{ID: uuid.New(), Payload: []byte("first")}
+			second := &moderator.OutboxEvent{ID: uuid.New(), Payload: []byte("second")}
+			third := &moderator.OutboxEvent{ID: uuid.New(), Payload: []byte("third")}
+			repo.On("GetPending", ctx, now, 2).Return([]*moderator.OutboxEvent{first, second}, nil).Once()
+			repo.On("GetPending", ctx, now, 2).Return([]*moderator.OutboxEvent{third}, nil).Once()
+			writer.On("Write", [][]byte{[]byte("first"), []byte("second")}).Return(nil)
+			writer.On("Write", [][]byte{[]byte("third")}).Return(nil)
+			repo.On("Delete", ctx, []uuid.UUID{first.ID, second.ID}).Return(nil)
+			repo.On("Delete", ctx, []uuid.UUID{third.ID}).Return(nil)
+
+			relay := moderator.NewOutboxRelay(repo, writer, time.Second, config, nowFn, logger)
+			relay.Flush(ctx)
+		})
+		t.Run("schedules a retry with backoff and dead letters the events out of attempts", func(t *testing.T) {
+			repo := new(mockOutboxRepository)
+			defer repo.AssertExpectations(t)
+			writer := new(mockWriter)
+			defer writer.AssertExpectations(t)
+
+			fresh := &moderator.OutboxEvent{ID: uuid.New(), Payload: []byte("fresh"), Status: moderator.OutboxStatusPending}
+			retried := &moderator.OutboxEvent{ID: uuid.New(), Payload: []byte("retried"), Status: moderator.OutboxStatusPending, Attempts: 2}
+			repo.On("GetPending", ctx, now, 2).Return([]*moderator.OutboxEvent{fresh, retried}, nil).Once()
+			writer.On("Write", mock.Anything).Return(errors.New("broker is not available"))
+			repo.On("UpdateAttempts", ctx, mock.MatchedBy(func(events []*moderator.OutboxEvent) bool {
+				return events[0].Attempts == 1 && events[0].Status == moderator.OutboxStatusPending &&
+					events[0].NextAttemptAt.Equal(now.Add(time.Minute)) &&
+					events[0].LastError == "broker is not available" &&
+					events[1].Attempts == 3 && events[1].Status == moderator.OutboxStatusDeadLetter
+			})).Return(nil)
+
+			relay := moderator.NewOutboxRelay(repo, writer, time.Second, config, nowFn, logger)
+			relay.Flush(ctx)
+		})
+		t.Run("removes only the delivered events of a partly failed batch and retries the others", func(t *testing.T) {
+			repo := new(mockOutboxRepository)
+			defer repo.AssertExpectations(t)
+			writer := new(mockWriter)
+			defer writer.AssertExpectations(t)
+
+			delivered := &moderator.OutboxEvent{ID: uuid.New(), Payload: []byte("delivered"), Status: moderator.OutboxStatusPending}
+			failed := &moderator.OutboxEvent{ID: uuid.New(), Payload: []byte("failed"), Status: moderator.OutboxStatusPending}
+			repo.On("GetPending", ctx, now, 2).Return([]*moderator.OutboxEvent{delivered, failed}, nil).Once()
+			writer.On("Write", [][]byte{[]byte("delivered"), []byte("failed")}).
+				Return(&moderator.WriteError{Failed: map[int]error{1: errors.New("topic is not found")}})
+			repo.On("Delete", ctx, []uuid.UUID{delivered.ID}).Return(nil)
+			repo.On("UpdateAttempts", ctx, mock.MatchedBy(func(events []*moderator.OutboxEvent) bool {
+				return len(events) == 1 && events[0].ID == failed.ID && events[0].Attempts == 1 &&
+					events[0].NextAttemptAt.Equal(now.Add(time.Minute))
+			})).Return(nil)
+
+			relay := moderator.NewOutboxRelay(repo, writer, time.Second, config, nowFn, logger)
+			relay.Flush(ctx)
+		})
+		t.Run("re-drives the dead lettered events", func(t *testing.T) {
+			repo := new(mockOutboxRepository)
+			defer repo.AssertExpectations(t)
+
+			ids := []uuid.UUID{uuid.New()}
+			repo.On("Redrive", ctx, ids, now).Return(int64(1), nil)
+
+			relay := moderator.NewOutboxRelay(repo, nil, time.Second, config, nowFn, logger)
+			count, err := relay.Redrive(ctx, ids)
+			assert.NoError(t, err)
+			assert.Equal(t, int64(1), count)
+		})
+	})
+}
+
+type mockOutboxRepository struct {
+	mock.Mock
+}
+
+func (m *mockOutboxRepository) Save(ctx context.Context, payload []byte) error {
+	args := m.Called(ctx, payload)
+	return args.Error(0)
+}
+
+func (m *mockOutboxRepository) GetPending(ctx context.Context, at time.Time, limit int) ([]*moderator.OutboxEvent, error) {
+	args := m.Called(ctx, at, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*moderator.OutboxEvent), args.Error(1)
+}
+
+func (m *mockOutboxRepository) Delete(ctx context.Context, ids []uuid.UUID) error {
+	args := m.Called(ctx, ids)
+	return args.Error(0)
+}
+
+func (m *mockOutboxRepository) UpdateAttempts(ctx context.Context, events []*moderator.OutboxEvent) error {
+	args := m.Called(ctx, events)
+	return args.Error(0)
+}
+
+func (m *mockOutboxRepository) Redrive(ctx context.Context, ids []uuid.UUID, at time.Time) (int64, error) {
+	args := m.Called(ctx, ids, at)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+type mockWriter struct {
+	mock.Mock
+}
+
+func (m *mockWriter) Write(messages [][]byte) error {
+	args := m.Called(messages)
+	return args.Error(0)
+}
+
+func (m *mockWriter) Close() error {
+	args := m.Called()
+	return args.Error(0)
+}
@@ -2,10 +2,14 @@ package moderator
 
 import (
 	"context"
+	"fmt"
+	"sort"
 	"sync"
 	"time"
 
 	"github.com/goto/salt/log"
+
+	"github.com/goto/optimus/internal/errors"
 )
 
 type Writer interface {
@@ -13,6 +17,30 @@ type Writer interface {
 	Close() error
 }
 
+// WriteError is returned by the writers when some of the messages of a write are not delivered, the messages not
+// in it are delivered
+type WriteError struct {
+	Failed map[int]error // errors of the messages not delivered, by their index in the write
+}
+
+func (e *WriteError) Error() string {
+	indexes := make([]int, 0, len(e.Failed))
+	for i := range e.Failed {
+		indexes = append(indexes, i)
+	}
+	sort.Ints(indexes)
+	if len(indexes) == 0 {
+		return "messages are not delivered"
+	}
+	return fmt.Sprintf("%d messages are not delivered, first at index %d: %s", len(indexes), indexes[0], e.Failed[indexes[0]])
+}
+
+// Delivered tells whether the message at the index of the write is delivered
+func (e *WriteError) Delivered(index int) bool {
+	_, failed := e.Failed[index]
+	return !failed
+}
+
 type Worker struct {
 	mu          sync.Mutex
 	wg          sync.WaitGroup
@@ -68,10 +96,23 @@ func (w *Worker) Flush() {
 		return
 	}
 
-	if err := w.writer.Write(w.messages); err != nil {
-		w.logger.Error("error writing message: %v", err)
-	} else {
+	err := w.writer.Write(w.messages)
+	if err == nil {
 		w.messages = nil
+		return
+	}
+	w.logger.Error("error writing message: %v", err)
+
+	// only the messages not delivered are written again
+	var writeErr *WriteError
+	if errors.As(err, &writeErr) {
+		var failed [][]byte
+		for i, message := range w.messages {
+			if !writeErr.Delivered(i) {
+				failed = append(failed, message)
+			}
+		}
+		w.messages = failed
 	}
 }
 
@@ -0,0 +1,37 @@
+package moderator_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/goto/salt/log"
+
+	"github.com/goto/optimus/core/event/moderator"
+)
+
+func TestWorker(t *testing.T) {
+	logger := log.NewNoop()
+
+	t.Run("keeps only the messages not delivered to be written again", func(t *testing.T) {
+		writer := new(mockWriter)
+		defer writer.AssertExpectations(t)
+
+		messageChan := make(chan []byte)
+		worker := moderator.NewWorker(messageChan, writer, time.Hour, logger)
+		go worker.Run(context.Background())
+
+		messageChan <- []byte("first")
+		messageChan <- []byte("second")
+		messageChan <- []byte("third")
+
+		writer.On("Write", [][]byte{[]byte("first"), []byte("second"), []byte("third")}).
+			Return(&moderator.WriteError{Failed: map[int]error{1: errors.New("topic is not found")}}).Once()
+		writer.On("Close").Return(nil)
+		_ = worker.Close()
+
+		writer.On("Write", [][]byte{[]byte("second")}).Return(nil).Once()
+		worker.Flush()
+	})
+}
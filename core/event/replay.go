@@ -0,0 +1,58 @@
+package event
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/goto/optimus/core/scheduler"
+	pbInt "github.com/goto/optimus/protos/gotocompany/optimus/integration/v1beta1"
+)
+
+type ReplayStateChange struct {
+	Event
+
+	Replay *scheduler.ReplayWithRun
+}
+
+func NewReplayStateChangeEvent(replay *scheduler.ReplayWithRun) (*ReplayStateChange, error) {
+	baseEvent, err := NewBaseEvent()
+	if err != nil {
+		return nil, err
+	}
+	return &ReplayStateChange{
+		Event:  baseEvent,
+		Replay: replay,
+	}, nil
+}
+
+func (r *ReplayStateChange) Bytes() ([]byte, error) {
+	replay := r.Replay.Replay
+	runs := make([]*pbInt.ReplayRunPayload, len(r.Replay.Runs))
+	for i, run := range r.Replay.Runs {
+		runs[i] = &pbInt.ReplayRunPayload{
+			ScheduledAt: timestamppb.New(run.ScheduledAt),
+			State:       run.State.String(),
+		}
+	}
+
+	optEvent := &pbInt.OptimusChangeEvent{
+		EventId:       r.ID.String(),
+		OccurredAt:    timestamppb.New(r.OccurredAt),
+		ProjectName:   replay.Tenant().ProjectName().String(),
+		NamespaceName: replay.Tenant().NamespaceName().String(),
+		EventType:     pbInt.OptimusChangeEvent_EVENT_TYPE_REPLAY_STATE_CHANGE,
+		SchemaVersion: SchemaVersion,
+		Payload: &pbInt.OptimusChangeEvent_ReplayStateChange{
+			ReplayStateChange: &pbInt.ReplayStateChangePayload{
+				ReplayId:  replay.ID().String(),
+				JobName:   replay.JobName().String(),
+				State:     replay.State().String(),
+				StartTime: timestamppb.New(replay.Config().StartTime),
+				EndTime:   timestamppb.New(replay.Config().EndTime),
+				Runs:      runs,
+				Message:   replay.Message(),
+			},
+		},
+	}
+	return proto.Marshal(optEvent)
+}
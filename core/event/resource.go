@@ -79,6 +79,7 @@ func resourceEventToBytes(event Event, rsc *resource.Resource, eventType pbInt.O
 		ProjectName:   rsc.Tenant().ProjectName().String(),
 		NamespaceName: rsc.Tenant().NamespaceName().String(),
 		EventType:     eventType,
+		SchemaVersion: SchemaVersion,
 		Payload: &pbInt.OptimusChangeEvent_ResourceChange{
 			ResourceChange: &pbInt.ResourceChangePayload{
 				DatastoreName: rsc.Store().String(),
@@ -48,6 +48,26 @@ func (j *JobRunFailed) Bytes() ([]byte, error) {
 	return proto.Marshal(toOptimusChangeEvent(j.JobRun, j.Event, pbInt.OptimusChangeEvent_EVENT_TYPE_JOB_FAILURE))
 }
 
+type JobRunSLABreach struct {
+	Event
+
+	JobRun *scheduler.JobRun
+}
+
+func (j *JobRunSLABreach) Bytes() ([]byte, error) {
+	return proto.Marshal(toOptimusChangeEvent(j.JobRun, j.Event, pbInt.OptimusChangeEvent_EVENT_TYPE_JOB_SLA_BREACH))
+}
+
+type JobRunDurationAnomaly struct {
+	Event
+
+	JobRun *scheduler.JobRun
+}
+
+func (j *JobRunDurationAnomaly) Bytes() ([]byte, error) {
+	return proto.Marshal(toOptimusChangeEvent(j.JobRun, j.Event, pbInt.OptimusChangeEvent_EVENT_TYPE_JOB_DURATION_ANOMALY))
+}
+
 func NewJobRunWaitUpstreamEvent(jobRun *scheduler.JobRun) (*JobRunWaitUpstream, error) {
 	baseEvent, err := NewBaseEvent()
 	if err != nil {
@@ -92,6 +112,28 @@ func NewJobRunFailedEvent(jobRun *scheduler.JobRun) (*JobRunFailed, error) {
 	}, nil
 }
 
+func NewJobRunSLABreachEvent(jobRun *scheduler.JobRun) (*JobRunSLABreach, error) {
+	baseEvent, err := NewBaseEvent()
+	if err != nil {
+		return nil, err
+	}
+	return &JobRunSLABreach{
+		Event:  baseEvent,
+		JobRun: jobRun,
+	}, nil
+}
+
+func NewJobRunDurationAnomalyEvent(jobRun *scheduler.JobRun) (*JobRunDurationAnomaly, error) {
+	baseEvent, err := NewBaseEvent()
+	if err != nil {
+		return nil, err
+	}
+	return &JobRunDurationAnomaly{
+		Event:  baseEvent,
+		JobRun: jobRun,
+	}, nil
+}
+
 func toOptimusChangeEvent(j *scheduler.JobRun, e Event, eventType pbInt.OptimusChangeEvent_EventType) *pbInt.OptimusChangeEvent {
 	return &pbInt.OptimusChangeEvent{
 		EventId:       e.ID.String(),
@@ -99,6 +141,7 @@ func toOptimusChangeEvent(j *scheduler.JobRun, e Event, eventType pbInt.OptimusC
 		ProjectName:   j.Tenant.ProjectName().String(),
 		NamespaceName: j.Tenant.NamespaceName().String(),
 		EventType:     eventType,
+		SchemaVersion: SchemaVersion,
 		Payload: &pbInt.OptimusChangeEvent_JobRun{
 			JobRun: &pbInt.JobRunPayload{
 				JobName:     j.JobName.String(),
@@ -0,0 +1,65 @@
+package event
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/goto/optimus/internal/errors"
+	pbInt "github.com/goto/optimus/protos/gotocompany/optimus/integration/v1beta1"
+)
+
+const (
+	// Schema is the versioned protobuf message every published event follows, the proto encoded events
+	// are its wire format and the json encoded ones its json mapping
+	Schema = "gotocompany.optimus.integration.v1beta1.OptimusChangeEvent"
+	// SchemaVersion is the revision of the schema the events are published with, it is bumped whenever event types
+	// or payloads are added, the events published before it was introduced carry none
+	SchemaVersion = 1
+
+	EncodingProto = "proto"
+	EncodingJSON  = "json"
+)
+
+// Encoder returns the function encoding the proto encoded events with the encoding
+func Encoder(encoding string) (func([]byte) ([]byte, error), error) {
+	switch encoding {
+	case "", EncodingProto:
+		return func(payload []byte) ([]byte, error) { return payload, nil }, nil
+	case EncodingJSON:
+		return toJSON, nil
+	default:
+		return nil, errors.InvalidArgument(eventsEntity, "unknown event encoding "+encoding)
+	}
+}
+
+// TypeResolver returns the function resolving the type name of the events encoded with the encoding
+func TypeResolver(encoding string) func([]byte) (string, error) {
+	return func(payload []byte) (string, error) {
+		var changeEvent pbInt.OptimusChangeEvent
+		var err error
+		if encoding == EncodingJSON {
+			err = protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(payload, &changeEvent)
+		} else {
+			err = proto.Unmarshal(payload, &changeEvent)
+		}
+		if err != nil {
+			return "", errors.InvalidArgument(eventsEntity, "unable to decode event: "+err.Error())
+		}
+		return TypeName(changeEvent.EventType), nil
+	}
+}
+
+// TypeName returns the name of the event type used to route the events, like job_success
+func TypeName(eventType pbInt.OptimusChangeEvent_EventType) string {
+	return strings.ToLower(strings.TrimPrefix(eventType.String(), "EVENT_TYPE_"))
+}
+
+func toJSON(payload []byte) ([]byte, error) {
+	var changeEvent pbInt.OptimusChangeEvent
+	if err := proto.Unmarshal(payload, &changeEvent); err != nil {
+		return nil, err
+	}
+	return protojson.MarshalOptions{UseProtoNames: true}.Marshal(&changeEvent)
+}
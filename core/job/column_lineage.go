@@ -0,0 +1,71 @@
+package job
+
+import (
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+)
+
+// ColumnLineage tells which column of a source resource a column of the job destination is derived from
+type ColumnLineage struct {
+	source       ResourceURN
+	sourceColumn string
+	targetColumn string
+}
+
+func NewColumnLineage(source ResourceURN, sourceColumn, targetColumn string) (*ColumnLineage, error) {
+	if source == "" {
+		return nil, errors.InvalidArgument(EntityJob, "source resource of column lineage is empty")
+	}
+	if sourceColumn == "" {
+		return nil, errors.InvalidArgument(EntityJob, "source column of column lineage is empty")
+	}
+	if targetColumn == "" {
+		return nil, errors.InvalidArgument(EntityJob, "target column of column lineage is empty")
+	}
+	return &ColumnLineage{source: source, sourceColumn: sourceColumn, targetColumn: targetColumn}, nil
+}
+
+func (c ColumnLineage) Source() ResourceURN {
+	return c.source
+}
+
+func (c ColumnLineage) SourceColumn() string {
+	return c.sourceColumn
+}
+
+func (c ColumnLineage) TargetColumn() string {
+	return c.targetColumn
+}
+
+// ColumnDownstream is a job reading a column of a resource, along with the column of its destination derived from it
+type ColumnDownstream struct {
+	name        Name
+	projectName tenant.ProjectName
+
+	destination  ResourceURN
+	targetColumn string
+}
+
+func NewColumnDownstream(name Name, projectName tenant.ProjectName, destination ResourceURN, targetColumn string) *ColumnDownstream {
+	return &ColumnDownstream{name: name, projectName: projectName, destination: destination, targetColumn: targetColumn}
+}
+
+func (c ColumnDownstream) Name() Name {
+	return c.name
+}
+
+func (c ColumnDownstream) ProjectName() tenant.ProjectName {
+	return c.projectName
+}
+
+func (c ColumnDownstream) Destination() ResourceURN {
+	return c.destination
+}
+
+func (c ColumnDownstream) TargetColumn() string {
+	return c.targetColumn
+}
+
+func (c ColumnDownstream) FullName() FullName {
+	return FullNameFrom(c.projectName, c.name)
+}
@@ -0,0 +1,35 @@
+package job_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/core/job"
+)
+
+func TestColumnLineage(t *testing.T) {
+	source := job.ResourceURN("bigquery://project:dataset.source")
+
+	t.Run("NewColumnLineage", func(t *testing.T) {
+		t.Run("returns error when source resource is empty", func(t *testing.T) {
+			_, err := job.NewColumnLineage("", "id", "id")
+			assert.EqualError(t, err, "invalid argument for entity job: source resource of column lineage is empty")
+		})
+		t.Run("returns error when source column is empty", func(t *testing.T) {
+			_, err := job.NewColumnLineage(source, "", "id")
+			assert.EqualError(t, err, "invalid argument for entity job: source column of column lineage is empty")
+		})
+		t.Run("returns error when target column is empty", func(t *testing.T) {
+			_, err := job.NewColumnLineage(source, "id", "")
+			assert.EqualError(t, err, "invalid argument for entity job: target column of column lineage is empty")
+		})
+		t.Run("returns column lineage", func(t *testing.T) {
+			columnLineage, err := job.NewColumnLineage(source, "customer_id", "id")
+			assert.NoError(t, err)
+			assert.Equal(t, source, columnLineage.Source())
+			assert.Equal(t, "customer_id", columnLineage.SourceColumn())
+			assert.Equal(t, "id", columnLineage.TargetColumn())
+		})
+	})
+}
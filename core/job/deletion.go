@@ -0,0 +1,81 @@
+package job
+
+import (
+	"time"
+
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+)
+
+const (
+	EntityJobDeletion = "job_deletion"
+
+	// metadata keys used by clients to pass the actor and the reason along with the job deletion request
+	DeleteRequestedByMetadataKey = "x-job-delete-requested-by"
+	DeleteReasonMetadataKey      = "x-job-delete-reason"
+)
+
+// DeletionConsent is given by the owner of a downstream job to allow deleting the job it depends on
+type DeletionConsent struct {
+	ProjectName tenant.ProjectName
+	JobName     Name
+
+	DownstreamProjectName tenant.ProjectName
+	DownstreamJobName     Name
+
+	GivenBy string
+	Reason  string
+
+	CreatedAt time.Time
+}
+
+func NewDeletionConsent(projectName tenant.ProjectName, jobName Name, downstreamProjectName tenant.ProjectName, downstreamJobName Name, givenBy, reason string) (*DeletionConsent, error) {
+	if givenBy == "" {
+		return nil, errors.InvalidArgument(EntityJobDeletion, "consent of deleting job "+jobName.String()+" requires the consent giver")
+	}
+	return &DeletionConsent{
+		ProjectName:           projectName,
+		JobName:               jobName,
+		DownstreamProjectName: downstreamProjectName,
+		DownstreamJobName:     downstreamJobName,
+		GivenBy:               givenBy,
+		Reason:                reason,
+	}, nil
+}
+
+func (c DeletionConsent) DownstreamFullName() FullName {
+	return FullNameFrom(c.DownstreamProjectName, c.DownstreamJobName)
+}
+
+// DeletionAudit records the deletion of a job having downstream jobs, along with the downstream
+// jobs which consented and the ones which were overridden by a force delete
+type DeletionAudit struct {
+	ProjectName tenant.ProjectName
+	JobName     Name
+
+	RequestedBy string
+	Reason      string
+	Forced      bool
+
+	ConsentedDownstream  FullNames
+	OverriddenDownstream FullNames
+
+	CreatedAt time.Time
+}
+
+// SplitByConsent separates the downstream jobs which consented to the deletion from the ones blocking it
+func (d DownstreamList) SplitByConsent(consents []*DeletionConsent) (consented, blocking FullNames) {
+	consentMap := make(map[FullName]bool, len(consents))
+	for _, consent := range consents {
+		consentMap[consent.DownstreamFullName()] = true
+	}
+
+	for _, downstream := range d {
+		if consentMap[downstream.FullName()] {
+			consented = append(consented, downstream.FullName())
+			continue
+		}
+		blocking = append(blocking, downstream.FullName())
+	}
+	return consented, blocking
+}
@@ -0,0 +1,35 @@
+package v1beta1
+
+import (
+	"context"
+
+	"github.com/goto/optimus/core/job"
+	"github.com/goto/optimus/internal/errors"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+type ColumnLineageService interface {
+	GetColumnDownstreams(ctx context.Context, source job.ResourceURN, column string) ([]*job.ColumnDownstream, error)
+}
+
+// GetColumnLineage serves the jobs reading the column of the resource, along with the columns of their destinations
+// derived from it
+func (h *LineageHandler) GetColumnLineage(ctx context.Context, req *pb.GetColumnLineageRequest) (*pb.GetColumnLineageResponse, error) {
+	source := job.ResourceURN(req.GetResourceUrn())
+	columnDownstreams, err := h.columnService.GetColumnDownstreams(ctx, source, req.GetColumn())
+	if err != nil {
+		h.l.Error("error getting downstreams of column [%s] of [%s]: %s", req.GetColumn(), source, err)
+		return nil, errors.GRPCErr(err, "unable to get lineage of column "+req.GetColumn())
+	}
+
+	downstreams := make([]*pb.GetColumnLineageResponse_Downstream, len(columnDownstreams))
+	for i, downstream := range columnDownstreams {
+		downstreams[i] = &pb.GetColumnLineageResponse_Downstream{
+			ProjectName:  downstream.ProjectName().String(),
+			JobName:      downstream.Name().String(),
+			Destination:  downstream.Destination().String(),
+			TargetColumn: downstream.TargetColumn(),
+		}
+	}
+	return &pb.GetColumnLineageResponse{Downstreams: downstreams}, nil
+}
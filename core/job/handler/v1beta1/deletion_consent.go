@@ -0,0 +1,108 @@
+package v1beta1
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/goto/optimus/core/job"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/auth"
+	"github.com/goto/optimus/internal/errors"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+// AddJobDeletionConsent records the consent of the downstream job of the request to delete its upstream job. The
+// caller is recorded as giving the consent, so the consents can not be given without credentials.
+func (jh *JobHandler) AddJobDeletionConsent(ctx context.Context, req *pb.AddJobDeletionConsentRequest) (*pb.AddJobDeletionConsentResponse, error) {
+	principal := auth.PrincipalFrom(ctx)
+	if principal == nil {
+		return nil, status.Error(codes.Unauthenticated, "deletion consent of job "+req.GetJobName()+" can only be given with credentials")
+	}
+
+	downstreamProjectName, downstreamJobName, err := projectAndJobNameFrom(req.GetProjectName(), req.GetJobName())
+	if err != nil {
+		jh.l.Error("error adapting downstream job of deletion consent: %s", err)
+		return nil, errors.GRPCErr(err, "unable to add deletion consent of "+req.GetJobName())
+	}
+
+	projectName, jobName, err := projectAndJobNameFrom(req.GetUpstreamProjectName(), req.GetUpstreamJobName())
+	if err != nil {
+		jh.l.Error("error adapting job of deletion consent: %s", err)
+		return nil, errors.GRPCErr(err, "unable to add deletion consent of "+req.GetJobName())
+	}
+
+	err = jh.jobService.AddDeletionConsent(ctx, projectName, jobName, downstreamProjectName, downstreamJobName, principal.String(), req.GetReason())
+	if err != nil {
+		jh.l.Error("error adding deletion consent of job [%s]: %s", jobName, err)
+		return nil, errors.GRPCErr(err, "unable to add deletion consent of "+req.GetJobName())
+	}
+	return &pb.AddJobDeletionConsentResponse{}, nil
+}
+
+// GetJobDeletionConsents lists the consents given to delete the job, along with the audit trail of its deletions
+func (jh *JobHandler) GetJobDeletionConsents(ctx context.Context, req *pb.GetJobDeletionConsentsRequest) (*pb.GetJobDeletionConsentsResponse, error) {
+	projectName, jobName, err := projectAndJobNameFrom(req.GetProjectName(), req.GetJobName())
+	if err != nil {
+		jh.l.Error("error adapting job of deletion consents: %s", err)
+		return nil, errors.GRPCErr(err, "unable to get deletion consents of "+req.GetJobName())
+	}
+
+	consents, err := jh.jobService.GetDeletionConsents(ctx, projectName, jobName)
+	if err != nil {
+		jh.l.Error("error getting deletion consents of job [%s]: %s", jobName, err)
+		return nil, errors.GRPCErr(err, "unable to get deletion consents of "+req.GetJobName())
+	}
+
+	audits, err := jh.jobService.GetDeletionAudits(ctx, projectName, jobName)
+	if err != nil {
+		jh.l.Error("error getting deletion audits of job [%s]: %s", jobName, err)
+		return nil, errors.GRPCErr(err, "unable to get deletion consents of "+req.GetJobName())
+	}
+
+	consentsProto := make([]*pb.GetJobDeletionConsentsResponse_Consent, len(consents))
+	for i, consent := range consents {
+		consentsProto[i] = &pb.GetJobDeletionConsentsResponse_Consent{
+			DownstreamProjectName: consent.DownstreamProjectName.String(),
+			DownstreamJobName:     consent.DownstreamJobName.String(),
+			GivenBy:               consent.GivenBy,
+			Reason:                consent.Reason,
+			CreatedAt:             timestamppb.New(consent.CreatedAt),
+		}
+	}
+
+	auditsProto := make([]*pb.GetJobDeletionConsentsResponse_Audit, len(audits))
+	for i, audit := range audits {
+		auditsProto[i] = &pb.GetJobDeletionConsentsResponse_Audit{
+			RequestedBy:          audit.RequestedBy,
+			Reason:               audit.Reason,
+			Forced:               audit.Forced,
+			ConsentedDownstream:  fullNamesToStrings(audit.ConsentedDownstream),
+			OverriddenDownstream: fullNamesToStrings(audit.OverriddenDownstream),
+			CreatedAt:            timestamppb.New(audit.CreatedAt),
+		}
+	}
+	return &pb.GetJobDeletionConsentsResponse{Consents: consentsProto, Audits: auditsProto}, nil
+}
+
+func projectAndJobNameFrom(rawProjectName, rawJobName string) (tenant.ProjectName, job.Name, error) {
+	projectName, err := tenant.ProjectNameFrom(rawProjectName)
+	if err != nil {
+		return "", "", err
+	}
+	jobName, err := job.NameFrom(rawJobName)
+	if err != nil {
+		return "", "", err
+	}
+	return projectName, jobName, nil
+}
+
+func fullNamesToStrings(fullNames job.FullNames) []string {
+	names := make([]string, len(fullNames))
+	for i, fullName := range fullNames {
+		names[i] = fullName.String()
+	}
+	return names
+}
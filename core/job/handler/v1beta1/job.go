@@ -8,12 +8,15 @@ import (
 	"time"
 
 	"github.com/goto/salt/log"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/goto/optimus/core/job"
 	"github.com/goto/optimus/core/job/service/filter"
 	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/auth"
 	"github.com/goto/optimus/internal/errors"
+	"github.com/goto/optimus/internal/logging"
 	"github.com/goto/optimus/internal/models"
 	"github.com/goto/optimus/internal/telemetry"
 	"github.com/goto/optimus/internal/writer"
@@ -34,6 +37,11 @@ type JobHandler struct {
 	pb.UnimplementedJobSpecificationServiceServer
 }
 
+// tenantLogger attaches the tenant fields to the lines logged for the request
+func (jh *JobHandler) tenantLogger(tnnt tenant.Tenant) log.Logger {
+	return logging.ForTenant(jh.l, tnnt.ProjectName().String(), tnnt.NamespaceName().String(), "")
+}
+
 func NewJobHandler(jobService JobService, logger log.Logger) *JobHandler {
 	return &JobHandler{
 		jobService: jobService,
@@ -47,7 +55,17 @@ type JobService interface {
 	SyncState(ctx context.Context, jobTenant tenant.Tenant, disabledJobNames, enabledJobNames []job.Name) error
 	UpdateState(ctx context.Context, jobTenant tenant.Tenant, jobNames []job.Name, jobState job.State, remark string) error
 	ChangeNamespace(ctx context.Context, jobSourceTenant, jobNewTenant tenant.Tenant, jobName job.Name) error
-	Delete(ctx context.Context, jobTenant tenant.Tenant, jobName job.Name, cleanFlag, forceFlag bool) (affectedDownstream []job.FullName, err error)
+	MoveJob(ctx context.Context, jobTenant, jobNewTenant tenant.Tenant, jobName job.Name) error
+	ChangeJobName(ctx context.Context, jobTenant tenant.Tenant, jobName, newJobName job.Name) error
+	GetSpecVersions(ctx context.Context, projectName tenant.ProjectName, jobName job.Name) ([]*job.SpecVersion, error)
+	RollbackJob(ctx context.Context, jobTenant tenant.Tenant, jobName job.Name, version int) error
+	ValidateJobSpecs(ctx context.Context, jobTenant tenant.Tenant, specs []*job.Spec) ([]*job.SpecValidation, error)
+	Delete(ctx context.Context, jobTenant tenant.Tenant, jobName job.Name, cleanFlag, forceFlag bool, requestedBy, reason string) (affectedDownstream []job.FullName, err error)
+	AddDeletionConsent(ctx context.Context, projectName tenant.ProjectName, jobName job.Name,
+		downstreamProjectName tenant.ProjectName, downstreamJobName job.Name, givenBy, reason string) error
+	GetDeletionConsents(ctx context.Context, projectName tenant.ProjectName, jobName job.Name) ([]*job.DeletionConsent, error)
+	GetDeletionAudits(ctx context.Context, projectName tenant.ProjectName, jobName job.Name) ([]*job.DeletionAudit, error)
+	DeleteJobs(ctx context.Context, jobTenant tenant.Tenant, jobNames []job.Name, cleanFlag, forceFlag bool, requestedBy, reason string) (affectedDownstream []job.FullName, err error)
 	Get(ctx context.Context, jobTenant tenant.Tenant, jobName job.Name) (jobSpec *job.Job, err error)
 	GetTaskInfo(ctx context.Context, task job.Task) (*plugin.Info, error)
 	GetByFilter(ctx context.Context, filters ...filter.FilterOpt) (jobSpecs []*job.Job, err error)
@@ -67,24 +85,26 @@ func (jh *JobHandler) AddJobSpecifications(ctx context.Context, jobSpecRequest *
 		return nil, errors.GRPCErr(err, "failed to add job specifications")
 	}
 
+	l := jh.tenantLogger(jobTenant)
+
 	me := errors.NewMultiError("add specs errors")
 
 	jobSpecs, invalidSpecs, err := fromJobProtos(jobSpecRequest.Specs)
 	if err != nil {
 		errorMsg := fmt.Sprintf("failure when adapting job specifications: %s", err.Error())
-		jh.l.Error(errorMsg)
+		l.Error(errorMsg)
 		me.Append(err)
 	}
 	raiseJobEventMetric(jobTenant, job.MetricJobEventStateValidationFailed, len(invalidSpecs))
 
 	if len(jobSpecs) == 0 {
-		jh.l.Error("no jobs to be processed")
+		l.Error("no jobs to be processed")
 		me.Append(errors.NewError(errors.ErrFailedPrecond, job.EntityJob, "no jobs to be processed"))
 		return nil, me.ToErr()
 	}
 
 	if err = jh.jobService.Add(ctx, jobTenant, jobSpecs); err != nil {
-		jh.l.Error("failure found when adding job specifications: %s", err)
+		l.Error("failure found when adding job specifications: %s", err)
 		me.Append(err)
 	}
 
@@ -108,24 +128,27 @@ func (jh *JobHandler) DeleteJobSpecification(ctx context.Context, deleteRequest
 		return nil, errors.GRPCErr(err, errorMsg)
 	}
 
+	l := jh.tenantLogger(jobTenant)
+
 	jobName, err := job.NameFrom(deleteRequest.JobName)
 	if err != nil {
 		errorMsg := "failed to adapt job name when deleting job specification"
-		jh.l.Error(fmt.Sprintf("%s: %s", errorMsg, err.Error()))
+		l.Error(fmt.Sprintf("%s: %s", errorMsg, err.Error()))
 		return nil, errors.GRPCErr(err, errorMsg)
 	}
 
-	affectedDownstream, err := jh.jobService.Delete(ctx, jobTenant, jobName, deleteRequest.CleanHistory, deleteRequest.Force)
+	requestedBy, reason := deleteAuditFromContext(ctx)
+	affectedDownstream, err := jh.jobService.Delete(ctx, jobTenant, jobName, deleteRequest.CleanHistory, deleteRequest.Force, requestedBy, reason)
 	if err != nil {
 		errorMsg := "failed to delete job specification"
-		jh.l.Error(fmt.Sprintf("%s: %s", errorMsg, err.Error()))
+		l.Error(fmt.Sprintf("%s: %s", errorMsg, err.Error()))
 		return nil, errors.GRPCErr(err, errorMsg)
 	}
 
 	msg := fmt.Sprintf("job %s has been deleted", jobName)
 	if deleteRequest.Force && len(affectedDownstream) > 0 {
 		msg = fmt.Sprintf("job %s has been forced deleted. these downstream will be affected: %s", jobName, job.FullNames(affectedDownstream).String())
-		jh.l.Warn(msg)
+		l.Warn(msg)
 	}
 
 	return &pb.DeleteJobSpecificationResponse{
@@ -134,6 +157,68 @@ func (jh *JobHandler) DeleteJobSpecification(ctx context.Context, deleteRequest
 	}, nil
 }
 
+// DeleteJobs deletes several job specifications of a namespace at once, refusing the deletion when jobs outside of
+// the request depend on them unless it is forced
+func (jh *JobHandler) DeleteJobs(ctx context.Context, deleteRequest *pb.DeleteJobsRequest) (*pb.DeleteJobsResponse, error) {
+	jobTenant, err := tenant.NewTenant(deleteRequest.ProjectName, deleteRequest.NamespaceName)
+	if err != nil {
+		errorMsg := "failed to adapt tenant when deleting job specifications"
+		jh.l.Error(fmt.Sprintf("%s: %s", errorMsg, err.Error()))
+		return nil, errors.GRPCErr(err, errorMsg)
+	}
+
+	l := jh.tenantLogger(jobTenant)
+
+	jobNames := make([]job.Name, len(deleteRequest.JobNames))
+	for i, rawJobName := range deleteRequest.JobNames {
+		jobName, err := job.NameFrom(rawJobName)
+		if err != nil {
+			errorMsg := "failed to adapt job name when deleting job specifications"
+			l.Error(fmt.Sprintf("%s: %s", errorMsg, err.Error()))
+			return nil, errors.GRPCErr(err, errorMsg)
+		}
+		jobNames[i] = jobName
+	}
+
+	requestedBy, reason := deleteAuditFromContext(ctx)
+	affectedDownstream, err := jh.jobService.DeleteJobs(ctx, jobTenant, jobNames, deleteRequest.CleanHistory, deleteRequest.Force, requestedBy, reason)
+	if err != nil {
+		errorMsg := "failed to delete job specifications"
+		l.Error(fmt.Sprintf("%s: %s", errorMsg, err.Error()))
+		return nil, errors.GRPCErr(err, errorMsg)
+	}
+
+	msg := fmt.Sprintf("%d jobs have been deleted", len(jobNames))
+	if deleteRequest.Force && len(affectedDownstream) > 0 {
+		msg = fmt.Sprintf("%d jobs have been forced deleted. these downstream will be affected: %s", len(jobNames), job.FullNames(affectedDownstream).String())
+		l.Warn(msg)
+	}
+
+	return &pb.DeleteJobsResponse{
+		Message:            msg,
+		AffectedDownstream: fullNamesToStrings(affectedDownstream),
+	}, nil
+}
+
+// deleteAuditFromContext reads the reason of the deletion from the incoming request metadata. The deletion is requested
+// by the principal the call is authenticated as, otherwise by the requester sent by the client.
+func deleteAuditFromContext(ctx context.Context) (requestedBy, reason string) {
+	if principal := auth.PrincipalFrom(ctx); principal != nil {
+		requestedBy = principal.String()
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return requestedBy, ""
+	}
+	if values := md.Get(job.DeleteRequestedByMetadataKey); len(values) > 0 && requestedBy == "" {
+		requestedBy = values[0]
+	}
+	if values := md.Get(job.DeleteReasonMetadataKey); len(values) > 0 {
+		reason = values[0]
+	}
+	return requestedBy, reason
+}
+
 func (jh *JobHandler) ChangeJobNamespace(ctx context.Context, changeRequest *pb.ChangeJobNamespaceRequest) (*pb.ChangeJobNamespaceResponse, error) {
 	jobSourceTenant, err := tenant.NewTenant(changeRequest.ProjectName, changeRequest.NamespaceName)
 	if err != nil {
@@ -141,24 +226,27 @@ func (jh *JobHandler) ChangeJobNamespace(ctx context.Context, changeRequest *pb.
 		jh.l.Error(fmt.Sprintf("%s: %s", errorMsg, err.Error()))
 		return nil, errors.GRPCErr(err, errorMsg)
 	}
+
+	l := jh.tenantLogger(jobSourceTenant)
+
 	jobNewTenant, err := tenant.NewTenant(changeRequest.ProjectName, changeRequest.NewNamespaceName)
 	if err != nil {
 		errorMsg := "failed to adapt new tenant when changing job namespace"
-		jh.l.Error(fmt.Sprintf("%s: %s", errorMsg, err.Error()))
+		l.Error(fmt.Sprintf("%s: %s", errorMsg, err.Error()))
 		return nil, errors.GRPCErr(err, errorMsg)
 	}
 
 	jobName, err := job.NameFrom(changeRequest.JobName)
 	if err != nil {
 		errorMsg := "failed to adapt job name when changing job specification"
-		jh.l.Error(fmt.Sprintf("%s: %s", errorMsg, err.Error()))
+		l.Error(fmt.Sprintf("%s: %s", errorMsg, err.Error()))
 		return nil, errors.GRPCErr(err, errorMsg)
 	}
 
 	err = jh.jobService.ChangeNamespace(ctx, jobSourceTenant, jobNewTenant, jobName)
 	if err != nil {
 		errorMsg := "failed to change job namespace"
-		jh.l.Error(fmt.Sprintf("%s: %s", errorMsg, err.Error()))
+		l.Error(fmt.Sprintf("%s: %s", errorMsg, err.Error()))
 		return nil, errors.GRPCErr(err, errorMsg)
 	}
 
@@ -171,6 +259,213 @@ func (jh *JobHandler) ChangeJobNamespace(ctx context.Context, changeRequest *pb.
 	return &pb.ChangeJobNamespaceResponse{}, nil
 }
 
+// MoveJob changes the namespace of a job after checking the secrets and configs the job refers to are available in the new namespace
+func (jh *JobHandler) MoveJob(ctx context.Context, moveRequest *pb.MoveJobRequest) (*pb.MoveJobResponse, error) {
+	jobTenant, err := tenant.NewTenant(moveRequest.ProjectName, moveRequest.NamespaceName)
+	if err != nil {
+		errorMsg := "failed to adapt source tenant when moving job"
+		jh.l.Error(fmt.Sprintf("%s: %s", errorMsg, err.Error()))
+		return nil, errors.GRPCErr(err, errorMsg)
+	}
+
+	l := jh.tenantLogger(jobTenant)
+
+	jobNewTenant, err := tenant.NewTenant(moveRequest.ProjectName, moveRequest.NewNamespaceName)
+	if err != nil {
+		errorMsg := "failed to adapt new tenant when moving job"
+		l.Error(fmt.Sprintf("%s: %s", errorMsg, err.Error()))
+		return nil, errors.GRPCErr(err, errorMsg)
+	}
+
+	jobName, err := job.NameFrom(moveRequest.JobName)
+	if err != nil {
+		errorMsg := "failed to adapt job name when moving job"
+		l.Error(fmt.Sprintf("%s: %s", errorMsg, err.Error()))
+		return nil, errors.GRPCErr(err, errorMsg)
+	}
+
+	if err := jh.jobService.MoveJob(ctx, jobTenant, jobNewTenant, jobName); err != nil {
+		errorMsg := "failed to move job"
+		l.Error(fmt.Sprintf("%s: %s", errorMsg, err.Error()))
+		return nil, errors.GRPCErr(err, errorMsg)
+	}
+	return &pb.MoveJobResponse{}, nil
+}
+
+// ChangeJobName renames a job specification, keeping its run and replay history linked to the new name
+func (jh *JobHandler) ChangeJobName(ctx context.Context, changeRequest *pb.ChangeJobNameRequest) (*pb.ChangeJobNameResponse, error) {
+	jobTenant, err := tenant.NewTenant(changeRequest.ProjectName, changeRequest.NamespaceName)
+	if err != nil {
+		errorMsg := "failed to adapt tenant when changing job name"
+		jh.l.Error(fmt.Sprintf("%s: %s", errorMsg, err.Error()))
+		return nil, errors.GRPCErr(err, errorMsg)
+	}
+
+	l := jh.tenantLogger(jobTenant)
+
+	jobName, err := job.NameFrom(changeRequest.JobName)
+	if err != nil {
+		errorMsg := "failed to adapt job name when changing job name"
+		l.Error(fmt.Sprintf("%s: %s", errorMsg, err.Error()))
+		return nil, errors.GRPCErr(err, errorMsg)
+	}
+	newJobName, err := job.NameFrom(changeRequest.NewJobName)
+	if err != nil {
+		errorMsg := "failed to adapt new job name when changing job name"
+		l.Error(fmt.Sprintf("%s: %s", errorMsg, err.Error()))
+		return nil, errors.GRPCErr(err, errorMsg)
+	}
+
+	if err := jh.jobService.ChangeJobName(ctx, jobTenant, jobName, newJobName); err != nil {
+		errorMsg := "failed to change job name"
+		l.Error(fmt.Sprintf("%s: %s", errorMsg, err.Error()))
+		return nil, errors.GRPCErr(err, errorMsg)
+	}
+	return &pb.ChangeJobNameResponse{JobName: newJobName.String()}, nil
+}
+
+// GetJobSpecVersions lists the deployed versions of a job specification, the latest first
+func (jh *JobHandler) GetJobSpecVersions(ctx context.Context, req *pb.GetJobSpecVersionsRequest) (*pb.GetJobSpecVersionsResponse, error) {
+	projectName, err := tenant.ProjectNameFrom(req.ProjectName)
+	if err != nil {
+		errorMsg := "failed to adapt project name when getting job spec versions"
+		jh.l.Error(fmt.Sprintf("%s: %s", errorMsg, err.Error()))
+		return nil, errors.GRPCErr(err, errorMsg)
+	}
+	jobName, err := job.NameFrom(req.JobName)
+	if err != nil {
+		errorMsg := "failed to adapt job name when getting job spec versions"
+		jh.l.Error(fmt.Sprintf("%s: %s", errorMsg, err.Error()))
+		return nil, errors.GRPCErr(err, errorMsg)
+	}
+
+	versions, err := jh.jobService.GetSpecVersions(ctx, projectName, jobName)
+	if err != nil {
+		errorMsg := "failed to get job spec versions"
+		jh.l.Error(fmt.Sprintf("%s: %s", errorMsg, err.Error()))
+		return nil, errors.GRPCErr(err, errorMsg)
+	}
+
+	versionsProto := make([]*pb.JobSpecVersion, len(versions))
+	for i, version := range versions {
+		versionsProto[i] = &pb.JobSpecVersion{
+			Version:   int32(version.Version),
+			Owner:     version.Spec.Owner(),
+			TaskName:  version.Spec.Task().Name().String(),
+			Interval:  version.Spec.Schedule().Interval(),
+			CreatedAt: timestamppb.New(version.CreatedAt),
+		}
+	}
+	return &pb.GetJobSpecVersionsResponse{Versions: versionsProto}, nil
+}
+
+// RollbackJob redeploys a previously deployed version of a job specification
+func (jh *JobHandler) RollbackJob(ctx context.Context, req *pb.RollbackJobRequest) (*pb.RollbackJobResponse, error) {
+	if req.Version <= 0 {
+		return nil, errors.GRPCErr(errors.InvalidArgument(job.EntityJobSpecVersion, "version should be a positive number"), "failed to rollback job")
+	}
+
+	jobTenant, err := tenant.NewTenant(req.ProjectName, req.NamespaceName)
+	if err != nil {
+		errorMsg := "failed to adapt tenant when rolling back job"
+		jh.l.Error(fmt.Sprintf("%s: %s", errorMsg, err.Error()))
+		return nil, errors.GRPCErr(err, errorMsg)
+	}
+
+	l := jh.tenantLogger(jobTenant)
+
+	jobName, err := job.NameFrom(req.JobName)
+	if err != nil {
+		errorMsg := "failed to adapt job name when rolling back job"
+		l.Error(fmt.Sprintf("%s: %s", errorMsg, err.Error()))
+		return nil, errors.GRPCErr(err, errorMsg)
+	}
+
+	if err := jh.jobService.RollbackJob(ctx, jobTenant, jobName, int(req.Version)); err != nil {
+		errorMsg := fmt.Sprintf("failed to rollback job to version %d", req.Version)
+		l.Error(fmt.Sprintf("%s: %s", errorMsg, err.Error()))
+		return nil, errors.GRPCErr(err, errorMsg)
+	}
+	return &pb.RollbackJobResponse{
+		JobName: jobName.String(),
+		Version: req.Version,
+	}, nil
+}
+
+// ValidateJobSpecs runs the checks done on deployment against the job specifications of the request, without
+// persisting them. Every job gets its own result, the response is valid only when all of them are.
+func (jh *JobHandler) ValidateJobSpecs(ctx context.Context, req *pb.ValidateJobSpecsRequest) (*pb.ValidateJobSpecsResponse, error) {
+	jobTenant, err := tenant.NewTenant(req.ProjectName, req.NamespaceName)
+	if err != nil {
+		errorMsg := "failed to adapt tenant when validating job specifications"
+		jh.l.Error(fmt.Sprintf("%s: %s", errorMsg, err.Error()))
+		return nil, errors.GRPCErr(err, errorMsg)
+	}
+
+	l := jh.tenantLogger(jobTenant)
+
+	// specs failing to be adapted are reported in place, the rest are checked by the service
+	results := make([]*pb.ValidateJobSpecsResponse_Result, len(req.Jobs))
+	var specs []*job.Spec
+	var specResultIndexes []int
+	for i, jobProto := range req.Jobs {
+		results[i] = &pb.ValidateJobSpecsResponse_Result{JobName: jobProto.GetName()}
+
+		spec, err := fromJobProto(jobProto)
+		if err != nil {
+			results[i].Errors = []string{err.Error()}
+			continue
+		}
+		specs = append(specs, spec)
+		specResultIndexes = append(specResultIndexes, i)
+	}
+
+	specValidations, err := jh.jobService.ValidateJobSpecs(ctx, jobTenant, specs)
+	if err != nil {
+		errorMsg := "failed to validate job specifications"
+		l.Error(fmt.Sprintf("%s: %s", errorMsg, err.Error()))
+		return nil, errors.GRPCErr(err, errorMsg)
+	}
+	// the service reports the specs in the order they are given
+	for i, specValidation := range specValidations {
+		result := results[specResultIndexes[i]]
+		result.Errors = append(result.Errors, specValidation.Errors...)
+	}
+
+	valid := true
+	for _, result := range results {
+		result.Valid = len(result.Errors) == 0
+		valid = valid && result.Valid
+	}
+	return &pb.ValidateJobSpecsResponse{
+		Valid:   valid,
+		Results: results,
+	}, nil
+}
+
+// FormatJobSpecifications returns the job specifications as the server reads them, so the clients can keep the specs
+// in the canonical form of the server
+func (jh *JobHandler) FormatJobSpecifications(_ context.Context, req *pb.FormatJobSpecificationsRequest) (*pb.FormatJobSpecificationsResponse, error) {
+	if _, err := tenant.NewTenant(req.ProjectName, req.NamespaceName); err != nil {
+		errorMsg := "failed to adapt tenant when formatting job specifications"
+		jh.l.Error(fmt.Sprintf("%s: %s", errorMsg, err.Error()))
+		return nil, errors.GRPCErr(err, errorMsg)
+	}
+
+	specs, _, err := fromJobProtos(req.Jobs)
+	if err != nil {
+		errorMsg := "failed to format job specifications"
+		jh.l.Error(fmt.Sprintf("%s: %s", errorMsg, err.Error()))
+		return nil, errors.GRPCErr(errors.InvalidArgument(job.EntityJob, err.Error()), errorMsg)
+	}
+
+	formatted := make([]*pb.JobSpecification, len(specs))
+	for i, spec := range specs {
+		formatted[i] = fromJobSpec(spec)
+	}
+	return &pb.FormatJobSpecificationsResponse{Jobs: formatted}, nil
+}
+
 func (jh *JobHandler) UpdateJobSpecifications(ctx context.Context, jobSpecRequest *pb.UpdateJobSpecificationsRequest) (*pb.UpdateJobSpecificationsResponse, error) {
 	jobTenant, err := tenant.NewTenant(jobSpecRequest.ProjectName, jobSpecRequest.NamespaceName)
 	if err != nil {
@@ -179,11 +474,13 @@ func (jh *JobHandler) UpdateJobSpecifications(ctx context.Context, jobSpecReques
 		return nil, errors.GRPCErr(err, errorMsg)
 	}
 
+	l := jh.tenantLogger(jobTenant)
+
 	me := errors.NewMultiError("update specs errors")
 	jobSpecs, invalidSpecs, err := fromJobProtos(jobSpecRequest.Specs)
 	if err != nil {
 		errorMsg := fmt.Sprintf("failure when adapting job specifications: %s", err.Error())
-		jh.l.Error(errorMsg)
+		l.Error(errorMsg)
 		me.Append(err)
 	}
 	raiseJobEventMetric(jobTenant, job.MetricJobEventStateValidationFailed, len(invalidSpecs))
@@ -194,7 +491,7 @@ func (jh *JobHandler) UpdateJobSpecifications(ctx context.Context, jobSpecReques
 	}
 
 	if err = jh.jobService.Update(ctx, jobTenant, jobSpecs); err != nil {
-		jh.l.Error(fmt.Sprintf("%s: %s", "failed to update job specifications", err.Error()))
+		l.Error(fmt.Sprintf("%s: %s", "failed to update job specifications", err.Error()))
 		me.Append(err)
 	}
 
@@ -216,16 +513,19 @@ func (jh *JobHandler) GetJobSpecification(ctx context.Context, req *pb.GetJobSpe
 		jh.l.Error("invalid tenant information request project [%s] namespace [%s]: %s", req.GetProjectName(), req.GetNamespaceName(), err)
 		return nil, err
 	}
+
+	l := jh.tenantLogger(jobTenant)
+
 	jobName, err := job.NameFrom(req.GetJobName())
 	if err != nil {
-		jh.l.Error("error adapating job name [%s]: %s", req.GetJobName(), err)
+		l.Error("error adapating job name [%s]: %s", req.GetJobName(), err)
 		return nil, err
 	}
 
 	jobSpec, err := jh.jobService.Get(ctx, jobTenant, jobName)
 	if err != nil && !errors.IsErrorType(err, errors.ErrNotFound) {
 		errorMsg := "failed to get job specification"
-		jh.l.Error(fmt.Sprintf("%s: %s", err.Error(), errorMsg))
+		l.Error(fmt.Sprintf("%s: %s", err.Error(), errorMsg))
 		return nil, errors.GRPCErr(err, errorMsg)
 	}
 
@@ -420,15 +720,17 @@ func (jh *JobHandler) CheckJobSpecifications(req *pb.CheckJobSpecificationsReque
 		return err
 	}
 
+	l := jh.tenantLogger(jobTenant)
+
 	me := errors.NewMultiError("check / validate job spec errors")
 	jobSpecs, jobNamesWithInvalidSpec, err := fromJobProtos(req.Jobs)
 	if err != nil {
-		jh.l.Error("error when adapting job specifications: %s", err)
+		l.Error("error when adapting job specifications: %s", err)
 		me.Append(err)
 	}
 
 	if err := jh.jobService.Validate(stream.Context(), jobTenant, jobSpecs, jobNamesWithInvalidSpec, responseWriter); err != nil {
-		jh.l.Error("error validating job: %s", err)
+		l.Error("error validating job: %s", err)
 		me.Append(err)
 	}
 
@@ -448,21 +750,23 @@ func (jh *JobHandler) GetJobTask(ctx context.Context, req *pb.GetJobTaskRequest)
 		return nil, err
 	}
 
+	l := jh.tenantLogger(jobTenant)
+
 	jobName, err := job.NameFrom(req.GetJobName())
 	if err != nil {
-		jh.l.Error("error adapting job name [%s]: %s", req.GetJobName(), err)
+		l.Error("error adapting job name [%s]: %s", req.GetJobName(), err)
 		return nil, err
 	}
 
 	jobResult, err := jh.jobService.Get(ctx, jobTenant, jobName)
 	if err != nil {
-		jh.l.Error("error getting job: %s", err)
+		l.Error("error getting job: %s", err)
 		return nil, err
 	}
 
 	taskInfo, err := jh.jobService.GetTaskInfo(ctx, jobResult.Spec().Task())
 	if err != nil {
-		jh.l.Error("error getting task info: %s", err)
+		l.Error("error getting task info: %s", err)
 		return nil, err
 	}
 
@@ -495,22 +799,25 @@ func (jh *JobHandler) UpdateJobsState(ctx context.Context, req *pb.UpdateJobsSta
 		jh.l.Error("invalid tenant information request project [%s] namespace [%s]: %s", req.GetProjectName(), req.GetNamespaceName(), err)
 		return nil, err
 	}
+
+	l := jh.tenantLogger(jobTenant)
+
 	jobState, err := job.StateFrom(req.GetState().String())
 	if err != nil {
-		jh.l.Error("error adapting job state %s: %s", req.GetState().String(), err)
+		l.Error("error adapting job state %s: %s", req.GetState().String(), err)
 		return nil, err
 	}
 
 	remark := req.Remark
 	if len(remark) < 1 {
-		jh.l.Error("empty remark for changing %d jobs state of %s:%s to %s", len(req.GetJobNames()), jobState, jobTenant.ProjectName(), jobTenant.NamespaceName())
+		l.Error("empty remark for changing %d jobs state of %s:%s to %s", len(req.GetJobNames()), jobState, jobTenant.ProjectName(), jobTenant.NamespaceName())
 		return nil, errors.InvalidArgument(job.EntityJob, "can not update job state without a valid remark")
 	}
 	var jobNames []job.Name
 	for _, name := range req.GetJobNames() {
 		jobName, err := job.NameFrom(name)
 		if err != nil {
-			jh.l.Error("error adapting job name: '%s', err: %s", name, err.Error())
+			l.Error("error adapting job name: '%s', err: %s", name, err.Error())
 			return nil, err
 		}
 		jobNames = append(jobNames, jobName)
@@ -518,7 +825,7 @@ func (jh *JobHandler) UpdateJobsState(ctx context.Context, req *pb.UpdateJobsSta
 
 	err = jh.jobService.UpdateState(ctx, jobTenant, jobNames, jobState, remark)
 	if err != nil {
-		jh.l.Error("error updating job state", err.Error())
+		l.Error("error updating job state", err.Error())
 		return nil, err
 	}
 
@@ -532,16 +839,18 @@ func (jh *JobHandler) SyncJobsState(ctx context.Context, req *pb.SyncJobsStateRe
 		return nil, err
 	}
 
+	l := jh.tenantLogger(jobTenant)
+
 	var enabledJobNames, disabledJobNames []job.Name
 	for _, jobState := range req.GetJobStates() {
 		state, err := job.StateFrom(jobState.State.String())
 		if err != nil {
-			jh.l.Error("error adapting job state %s: %s", jobState.State.String(), err)
+			l.Error("error adapting job state %s: %s", jobState.State.String(), err)
 			return nil, err
 		}
 		jobName, err := job.NameFrom(jobState.JobName)
 		if err != nil {
-			jh.l.Error("error adapting job name: '%s', err: %s", jobState.JobName, err.Error())
+			l.Error("error adapting job name: '%s', err: %s", jobState.JobName, err.Error())
 			return nil, err
 		}
 		if state == job.DISABLED {
@@ -553,7 +862,7 @@ func (jh *JobHandler) SyncJobsState(ctx context.Context, req *pb.SyncJobsStateRe
 
 	err = jh.jobService.SyncState(ctx, jobTenant, disabledJobNames, enabledJobNames)
 	if err != nil {
-		jh.l.Error("error syncing job state for project: %s, namespace: %s, err: %s", jobTenant.ProjectName, jobTenant.NamespaceName(), err.Error())
+		l.Error("error syncing job state for project: %s, namespace: %s, err: %s", jobTenant.ProjectName, jobTenant.NamespaceName(), err.Error())
 		return nil, err
 	}
 
@@ -567,20 +876,22 @@ func (jh *JobHandler) JobInspect(ctx context.Context, req *pb.JobInspectRequest)
 		return nil, err
 	}
 
+	l := jh.tenantLogger(jobTenant)
+
 	localJob := false
 	var jobName job.Name
 	var jobSpec *job.Spec
 	if req.GetSpec() != nil {
 		jobSpec, err = fromJobProto(req.GetSpec())
 		if err != nil {
-			jh.l.Error("cannot adapt job specification %s: %s", req.Spec.Name, err)
+			l.Error("cannot adapt job specification %s: %s", req.Spec.Name, err)
 			return nil, err
 		}
 		localJob = true
 	} else {
 		jobName, err = job.NameFrom(req.JobName)
 		if err != nil {
-			jh.l.Error("error adapting job name %s: %s", req.JobName, err)
+			l.Error("error adapting job name %s: %s", req.JobName, err)
 			return nil, err
 		}
 	}
@@ -598,14 +909,14 @@ func (jh *JobHandler) JobInspect(ctx context.Context, req *pb.JobInspectRequest)
 	upstreamLogs := &writer.BufferedLogger{}
 	upstreams, err := jh.jobService.GetUpstreamsToInspect(ctx, subjectJob, localJob)
 	if err != nil {
-		jh.l.Error("error getting upstreams to inspect: %s", err)
+		l.Error("error getting upstreams to inspect: %s", err)
 		upstreamLogs.Write(writer.LogLevelError, fmt.Sprintf("unable to get upstream jobs: %v", err.Error()))
 	}
 
 	downstreamLogs := &writer.BufferedLogger{}
 	downstreams, err := jh.jobService.GetDownstream(ctx, subjectJob, localJob)
 	if err != nil {
-		jh.l.Error("error getting downstream: %s", err)
+		l.Error("error getting downstream: %s", err)
 		downstreamLogs.Write(writer.LogLevelError, fmt.Sprintf("unable to get downstream jobs: %v", err.Error()))
 	}
 
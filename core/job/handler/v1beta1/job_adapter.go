@@ -2,6 +2,8 @@ package v1beta1
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"google.golang.org/protobuf/types/known/durationpb"
 
@@ -13,30 +15,68 @@ import (
 	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
 )
 
+const (
+	// hookConfigEnabledWhen carries the enabled_when condition of a hook in the hook config of the proto
+	hookConfigEnabledWhen = "ENABLED_WHEN"
+	// hookConfigPhase and hookConfigDependsOn carry the phase and the comma separated depends_on of a hook
+	hookConfigPhase     = "HOOK_PHASE"
+	hookConfigDependsOn = "HOOK_DEPENDS_ON"
+	// hookConfigWhen carries the when condition of a hook, compiled for every run
+	hookConfigWhen = "HOOK_WHEN"
+)
+
+const (
+	// dependencyTypeSensor marks a dependency in the proto carrying the sensor config of an upstream instead of
+	// an upstream, the poke interval and timeout are passed as the params of its http dependency
+	dependencyTypeSensor = "sensor"
+	// dependencyTypeIgnore and dependencyTypeExtra mark a dependency in the proto carrying, as its name, a resource
+	// to be removed from or added to the upstreams generated by the plugin
+	dependencyTypeIgnore = "upstream_ignore"
+	dependencyTypeExtra  = "upstream_extra"
+
+	sensorParamPokeInterval             = "poke_interval"
+	sensorParamTimeout                  = "timeout"
+	sensorParamFreshnessPartitionExists = "freshness_partition_exists"
+	sensorParamFreshnessMaxAge          = "freshness_max_age"
+
+	// taskVersionSeparator separates the name of the task and the version of the plugin pinned by the job
+	taskVersionSeparator = "@"
+	// taskConfigCatchUp carries the catch up policy of the job in the task config of the proto, the catch_up
+	// flag of the proto alone can not tell none from last_only
+	taskConfigCatchUp = "CATCH_UP"
+)
+
 func ToJobProto(jobEntity *job.Job) *pb.JobSpecification {
+	jobProto := fromJobSpec(jobEntity.Spec())
+	jobProto.Destination = jobEntity.Destination().String()
+	jobProto.Sources = fromResourceURNs(jobEntity.Sources())
+	return jobProto
+}
+
+// fromJobSpec returns the proto of the spec alone, without the destination and sources resolved for the job
+func fromJobSpec(spec *job.Spec) *pb.JobSpecification {
 	return &pb.JobSpecification{
-		Version:          int32(jobEntity.Spec().Version()),
-		Name:             jobEntity.Spec().Name().String(),
-		Owner:            jobEntity.Spec().Owner(),
-		StartDate:        jobEntity.Spec().Schedule().StartDate().String(),
-		EndDate:          jobEntity.Spec().Schedule().EndDate().String(),
-		Interval:         jobEntity.Spec().Schedule().Interval(),
-		DependsOnPast:    jobEntity.Spec().Schedule().DependsOnPast(),
-		TaskName:         jobEntity.Spec().Task().Name().String(),
-		Config:           fromConfig(jobEntity.Spec().Task().Config()),
-		WindowPreset:     jobEntity.Spec().WindowConfig().Preset,
-		WindowSize:       jobEntity.Spec().WindowConfig().GetSize(),
-		WindowOffset:     jobEntity.Spec().WindowConfig().GetOffset(),
-		WindowTruncateTo: jobEntity.Spec().WindowConfig().GetTruncateTo(),
-		Dependencies:     fromSpecUpstreams(jobEntity.Spec().UpstreamSpec()),
-		Assets:           fromAsset(jobEntity.Spec().Asset()),
-		Hooks:            fromHooks(jobEntity.Spec().Hooks()),
-		Description:      jobEntity.Spec().Description(),
-		Labels:           jobEntity.Spec().Labels(),
-		Behavior:         fromRetryAndAlerts(jobEntity.Spec().Schedule().Retry(), jobEntity.Spec().AlertSpecs()),
-		Metadata:         fromMetadata(jobEntity.Spec().Metadata()),
-		Destination:      jobEntity.Destination().String(),
-		Sources:          fromResourceURNs(jobEntity.Sources()),
+		Version:          int32(spec.Version()),
+		Name:             spec.Name().String(),
+		Owner:            spec.Owner(),
+		StartDate:        spec.Schedule().StartDate().String(),
+		EndDate:          spec.Schedule().EndDate().String(),
+		Interval:         spec.Schedule().Interval(),
+		DependsOnPast:    spec.Schedule().DependsOnPast(),
+		CatchUp:          spec.Schedule().CatchUp() == job.CatchUpFull,
+		TaskName:         fromTask(spec.Task()),
+		Config:           fromTaskConfig(spec.Task().Config(), spec.Schedule().CatchUp()),
+		WindowPreset:     spec.WindowConfig().Preset,
+		WindowSize:       spec.WindowConfig().GetSize(),
+		WindowOffset:     spec.WindowConfig().GetOffset(),
+		WindowTruncateTo: spec.WindowConfig().GetTruncateTo(),
+		Dependencies:     append(fromSpecUpstreams(spec.UpstreamSpec()), fromSensors(spec.Metadata())...),
+		Assets:           fromAsset(spec.Asset()),
+		Hooks:            fromHooks(spec.Hooks()),
+		Description:      spec.Description(),
+		Labels:           spec.Labels(),
+		Behavior:         fromRetryAndAlerts(spec.Schedule().Retry(), spec.AlertSpecs()),
+		Metadata:         fromMetadata(spec.Metadata()),
 	}
 }
 
@@ -76,9 +116,15 @@ func fromJobProto(js *pb.JobSpecification) (*job.Spec, error) {
 		return nil, err
 	}
 
+	catchUp, err := toCatchUp(js)
+	if err != nil {
+		return nil, err
+	}
+
 	scheduleBuilder := job.NewScheduleBuilder(startDate).
 		WithDependsOnPast(js.DependsOnPast).
-		WithInterval(js.Interval)
+		WithInterval(js.Interval).
+		WithCatchUp(catchUp)
 
 	if js.EndDate != "" {
 		endDate, err := job.ScheduleDateFrom(js.EndDate)
@@ -118,12 +164,14 @@ func fromJobProto(js *pb.JobSpecification) (*job.Spec, error) {
 		if err != nil {
 			return nil, err
 		}
+		delete(taskConfig, taskConfigCatchUp)
 	}
-	taskName, err := job.TaskNameFrom(js.TaskName)
+	taskNameWithoutVersion, taskVersion, _ := strings.Cut(js.TaskName, taskVersionSeparator)
+	taskName, err := job.TaskNameFrom(taskNameWithoutVersion)
 	if err != nil {
 		return nil, err
 	}
-	task := job.NewTask(taskName, taskConfig)
+	task := job.NewTask(taskName, taskConfig).WithVersion(taskVersion)
 
 	jobSpecBuilder := job.NewSpecBuilder(version, name, owner, schedule, window, task).WithDescription(js.Description)
 
@@ -155,8 +203,13 @@ func fromJobProto(js *pb.JobSpecification) (*job.Spec, error) {
 		jobSpecBuilder = jobSpecBuilder.WithSpecUpstream(upstream)
 	}
 
-	if js.Metadata != nil {
-		metadata, err := toMetadata(js.Metadata)
+	sensors, err := toSensors(js.Dependencies)
+	if err != nil {
+		return nil, err
+	}
+
+	if js.Metadata != nil || len(sensors) > 0 {
+		metadata, err := toMetadata(js.Metadata, sensors)
 		if err != nil {
 			return nil, err
 		}
@@ -216,7 +269,8 @@ func toRetry(protoRetry *pb.JobSpecification_Behavior_Retry) *job.Retry {
 	if protoRetry == nil {
 		return nil
 	}
-	return job.NewRetry(int(protoRetry.Count), protoRetry.Delay.GetNanos(), protoRetry.ExponentialBackoff)
+	// the delay of the retry is kept in seconds
+	return job.NewRetry(int(protoRetry.Count), int32(protoRetry.Delay.GetSeconds()), protoRetry.ExponentialBackoff)
 }
 
 func fromRetry(jobRetry *job.Retry) *pb.JobSpecification_Behavior_Retry {
@@ -225,7 +279,7 @@ func fromRetry(jobRetry *job.Retry) *pb.JobSpecification_Behavior_Retry {
 	}
 	return &pb.JobSpecification_Behavior_Retry{
 		Count:              int32(jobRetry.Count()),
-		Delay:              &durationpb.Duration{Nanos: jobRetry.Delay()},
+		Delay:              &durationpb.Duration{Seconds: int64(jobRetry.Delay())},
 		ExponentialBackoff: jobRetry.ExponentialBackoff(),
 	}
 }
@@ -237,10 +291,31 @@ func toHooks(hooksProto []*pb.JobSpecHook) ([]*job.Hook, error) {
 		if err != nil {
 			return nil, err
 		}
+		enabledWhen := hookConfig[hookConfigEnabledWhen]
+		phase := hookConfig[hookConfigPhase]
+		dependsOn := hookConfig[hookConfigDependsOn]
+		when := hookConfig[hookConfigWhen]
+		delete(hookConfig, hookConfigEnabledWhen)
+		delete(hookConfig, hookConfigPhase)
+		delete(hookConfig, hookConfigDependsOn)
+		delete(hookConfig, hookConfigWhen)
+
 		hookSpec, err := job.NewHook(hookProto.Name, hookConfig)
 		if err != nil {
 			return nil, err
 		}
+		if enabledWhen != "" {
+			hookSpec = hookSpec.WithEnabledWhen(enabledWhen)
+		}
+		if phase != "" {
+			hookSpec = hookSpec.WithPhase(phase)
+		}
+		if dependsOn != "" {
+			hookSpec = hookSpec.WithDependsOn(strings.Split(dependsOn, ","))
+		}
+		if when != "" {
+			hookSpec = hookSpec.WithWhen(when)
+		}
 		hooks[i] = hookSpec
 	}
 	return hooks, nil
@@ -249,9 +324,22 @@ func toHooks(hooksProto []*pb.JobSpecHook) ([]*job.Hook, error) {
 func fromHooks(hooks []*job.Hook) []*pb.JobSpecHook {
 	var hooksProto []*pb.JobSpecHook
 	for _, hook := range hooks {
+		hookConfig := fromConfig(hook.Config())
+		if hook.EnabledWhen() != "" {
+			hookConfig = append(hookConfig, &pb.JobConfigItem{Name: hookConfigEnabledWhen, Value: hook.EnabledWhen()})
+		}
+		if hook.Phase() != "" {
+			hookConfig = append(hookConfig, &pb.JobConfigItem{Name: hookConfigPhase, Value: hook.Phase()})
+		}
+		if len(hook.DependsOn()) > 0 {
+			hookConfig = append(hookConfig, &pb.JobConfigItem{Name: hookConfigDependsOn, Value: strings.Join(hook.DependsOn(), ",")})
+		}
+		if hook.When() != "" {
+			hookConfig = append(hookConfig, &pb.JobConfigItem{Name: hookConfigWhen, Value: hook.When()})
+		}
 		hooksProto = append(hooksProto, &pb.JobSpecHook{
 			Name:   hook.Name(),
-			Config: fromConfig(hook.Config()),
+			Config: hookConfig,
 		})
 	}
 	return hooksProto
@@ -297,7 +385,18 @@ func fromAlerts(jobAlerts []*job.AlertSpec) []*pb.JobSpecification_Behavior_Noti
 func toSpecUpstreams(upstreamProtos []*pb.JobDependency) (*job.UpstreamSpec, error) {
 	var upstreamNames []job.SpecUpstreamName
 	var httpUpstreams []*job.SpecHTTPUpstream
+	var ignoredResources, extraResources []job.ResourceURN
 	for _, upstream := range upstreamProtos {
+		switch upstream.Type {
+		case dependencyTypeSensor:
+			continue
+		case dependencyTypeIgnore:
+			ignoredResources = append(ignoredResources, job.ResourceURN(upstream.Name))
+			continue
+		case dependencyTypeExtra:
+			extraResources = append(extraResources, job.ResourceURN(upstream.Name))
+			continue
+		}
 		upstreamName := job.SpecUpstreamNameFrom(upstream.Name)
 		if upstream.HttpDependency == nil {
 			upstreamNames = append(upstreamNames, upstreamName)
@@ -313,7 +412,12 @@ func toSpecUpstreams(upstreamProtos []*pb.JobDependency) (*job.UpstreamSpec, err
 		}
 		httpUpstreams = append(httpUpstreams, httpUpstream)
 	}
-	upstream, err := job.NewSpecUpstreamBuilder().WithUpstreamNames(upstreamNames).WithSpecHTTPUpstream(httpUpstreams).Build()
+	upstream, err := job.NewSpecUpstreamBuilder().
+		WithUpstreamNames(upstreamNames).
+		WithSpecHTTPUpstream(httpUpstreams).
+		WithIgnoredResources(ignoredResources).
+		WithExtraResources(extraResources).
+		Build()
 	if err != nil {
 		return nil, err
 	}
@@ -338,11 +442,97 @@ func fromSpecUpstreams(upstreams *job.UpstreamSpec) []*pb.JobDependency {
 			},
 		})
 	}
+	for _, urn := range upstreams.IgnoredResources() {
+		dependencies = append(dependencies, &pb.JobDependency{Name: urn.String(), Type: dependencyTypeIgnore})
+	}
+	for _, urn := range upstreams.ExtraResources() {
+		dependencies = append(dependencies, &pb.JobDependency{Name: urn.String(), Type: dependencyTypeExtra})
+	}
+	return dependencies
+}
+
+func toSensors(upstreamProtos []*pb.JobDependency) ([]*job.MetadataSensor, error) {
+	var sensors []*job.MetadataSensor
+	for _, upstream := range upstreamProtos {
+		if upstream.Type != dependencyTypeSensor {
+			continue
+		}
+		var params map[string]string
+		if upstream.HttpDependency != nil {
+			params = upstream.HttpDependency.Params
+		}
+		pokeInterval, err := parseSensorDuration(params[sensorParamPokeInterval])
+		if err != nil {
+			return nil, err
+		}
+		timeout, err := parseSensorDuration(params[sensorParamTimeout])
+		if err != nil {
+			return nil, err
+		}
+		maxAge, err := parseSensorDuration(params[sensorParamFreshnessMaxAge])
+		if err != nil {
+			return nil, err
+		}
+		sensor, err := job.NewMetadataSensor(upstream.Name, pokeInterval, timeout)
+		if err != nil {
+			return nil, err
+		}
+		sensor, err = sensor.WithFreshness(job.SensorFreshness{
+			PartitionExists: params[sensorParamFreshnessPartitionExists] == "true",
+			MaxAge:          maxAge,
+		})
+		if err != nil {
+			return nil, err
+		}
+		sensors = append(sensors, sensor)
+	}
+	return sensors, nil
+}
+
+func parseSensorDuration(value string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, errors.InvalidArgument(job.EntityJob, "invalid sensor duration "+value)
+	}
+	return duration, nil
+}
+
+func fromSensors(metadata *job.Metadata) []*pb.JobDependency {
+	if metadata == nil {
+		return nil
+	}
+	var dependencies []*pb.JobDependency
+	for _, sensor := range metadata.Sensors() {
+		params := map[string]string{}
+		if sensor.PokeInterval() > 0 {
+			params[sensorParamPokeInterval] = sensor.PokeInterval().String()
+		}
+		if sensor.Timeout() > 0 {
+			params[sensorParamTimeout] = sensor.Timeout().String()
+		}
+		if sensor.Freshness().PartitionExists {
+			params[sensorParamFreshnessPartitionExists] = "true"
+		}
+		if sensor.Freshness().MaxAge > 0 {
+			params[sensorParamFreshnessMaxAge] = sensor.Freshness().MaxAge.String()
+		}
+		dependencies = append(dependencies, &pb.JobDependency{
+			Name:           sensor.Upstream(),
+			Type:           dependencyTypeSensor,
+			HttpDependency: &pb.HttpDependency{Params: params},
+		})
+	}
 	return dependencies
 }
 
-func toMetadata(jobMetadata *pb.JobMetadata) (*job.Metadata, error) {
-	metadataBuilder := job.NewMetadataBuilder()
+func toMetadata(jobMetadata *pb.JobMetadata, sensors []*job.MetadataSensor) (*job.Metadata, error) {
+	metadataBuilder := job.NewMetadataBuilder().WithSensors(sensors)
+	if jobMetadata == nil {
+		return metadataBuilder.Build()
+	}
 
 	if jobMetadata.Resource != nil {
 		metadataResourceProto := jobMetadata.Resource
@@ -412,6 +602,25 @@ func toConfig(configs []*pb.JobConfigItem) (job.Config, error) {
 	return job.ConfigFrom(configMap)
 }
 
+func toCatchUp(js *pb.JobSpecification) (job.CatchUpPolicy, error) {
+	for _, config := range js.Config {
+		if config.Name == taskConfigCatchUp {
+			return job.CatchUpPolicyFrom(config.Value)
+		}
+	}
+	if js.CatchUp {
+		return job.CatchUpFull, nil
+	}
+	return job.CatchUpLastOnly, nil
+}
+
+func fromTask(task job.Task) string {
+	if task.Version() == "" {
+		return task.Name().String()
+	}
+	return task.Name().String() + taskVersionSeparator + task.Version()
+}
+
 func fromConfig(jobConfig job.Config) []*pb.JobConfigItem {
 	configs := []*pb.JobConfigItem{}
 	for configName, configValue := range jobConfig {
@@ -420,6 +629,14 @@ func fromConfig(jobConfig job.Config) []*pb.JobConfigItem {
 	return configs
 }
 
+func fromTaskConfig(jobConfig job.Config, catchUp job.CatchUpPolicy) []*pb.JobConfigItem {
+	configs := fromConfig(jobConfig)
+	if catchUp != "" && catchUp != job.CatchUpLastOnly {
+		configs = append(configs, &pb.JobConfigItem{Name: taskConfigCatchUp, Value: catchUp.String()})
+	}
+	return configs
+}
+
 func toBasicInfoSectionProto(jobDetail *job.Job, logMessages []*pb.Log) *pb.JobInspectResponse_BasicInfoSection {
 	var sources []string
 	for _, source := range jobDetail.Sources() {
@@ -11,12 +11,14 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/goto/optimus/core/job"
 	"github.com/goto/optimus/core/job/handler/v1beta1"
 	"github.com/goto/optimus/core/job/service/filter"
 	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/auth"
 	"github.com/goto/optimus/internal/lib/window"
 	"github.com/goto/optimus/internal/models"
 	"github.com/goto/optimus/internal/writer"
@@ -689,6 +691,71 @@ func TestNewJobHandler(t *testing.T) {
 			assert.ErrorContains(t, err, "error in changing namespace: failed to change job namespace")
 		})
 	})
+	t.Run("MoveJob", func(t *testing.T) {
+		newNamespaceName := "newNamespace"
+		jobAName, _ := job.NameFrom("job-A")
+		newTenant, _ := tenant.NewTenant(project.Name().String(), newNamespaceName)
+
+		t.Run("fail if new namespace is invalid", func(t *testing.T) {
+			jobService := new(JobService)
+			defer jobService.AssertExpectations(t)
+
+			request := &pb.MoveJobRequest{
+				ProjectName:   project.Name().String(),
+				NamespaceName: namespace.Name().String(),
+				JobName:       jobAName.String(),
+			}
+			jobHandler := v1beta1.NewJobHandler(jobService, log)
+			_, err := jobHandler.MoveJob(ctx, request)
+			assert.ErrorContains(t, err, "failed to adapt new tenant when moving job")
+		})
+		t.Run("fail if job name is invalid", func(t *testing.T) {
+			jobService := new(JobService)
+			defer jobService.AssertExpectations(t)
+
+			request := &pb.MoveJobRequest{
+				ProjectName:      project.Name().String(),
+				NamespaceName:    namespace.Name().String(),
+				NewNamespaceName: newNamespaceName,
+			}
+			jobHandler := v1beta1.NewJobHandler(jobService, log)
+			_, err := jobHandler.MoveJob(ctx, request)
+			assert.ErrorContains(t, err, "failed to adapt job name when moving job")
+		})
+		t.Run("fail if the job refers to what is unavailable in the new namespace", func(t *testing.T) {
+			jobService := new(JobService)
+			defer jobService.AssertExpectations(t)
+
+			request := &pb.MoveJobRequest{
+				ProjectName:      project.Name().String(),
+				NamespaceName:    namespace.Name().String(),
+				JobName:          jobAName.String(),
+				NewNamespaceName: newNamespaceName,
+			}
+			jobService.On("MoveJob", ctx, sampleTenant, newTenant, jobAName).
+				Return(errors.New("job job-A can not be moved to namespace newNamespace"))
+
+			jobHandler := v1beta1.NewJobHandler(jobService, log)
+			_, err := jobHandler.MoveJob(ctx, request)
+			assert.ErrorContains(t, err, "job job-A can not be moved to namespace newNamespace: failed to move job")
+		})
+		t.Run("move job successfully", func(t *testing.T) {
+			jobService := new(JobService)
+			defer jobService.AssertExpectations(t)
+
+			request := &pb.MoveJobRequest{
+				ProjectName:      project.Name().String(),
+				NamespaceName:    namespace.Name().String(),
+				JobName:          jobAName.String(),
+				NewNamespaceName: newNamespaceName,
+			}
+			jobService.On("MoveJob", ctx, sampleTenant, newTenant, jobAName).Return(nil)
+
+			jobHandler := v1beta1.NewJobHandler(jobService, log)
+			_, err := jobHandler.MoveJob(ctx, request)
+			assert.NoError(t, err)
+		})
+	})
 	t.Run("UpdateJobState", func(t *testing.T) {
 		updateRemark := "job state update remark"
 		jobAName, _ := job.NameFrom("job-A")
@@ -757,7 +824,7 @@ func TestNewJobHandler(t *testing.T) {
 				Force:         false,
 			}
 
-			jobService.On("Delete", ctx, sampleTenant, jobAName, false, false).Return(nil, nil)
+			jobService.On("Delete", ctx, sampleTenant, jobAName, false, false, "", "").Return(nil, nil)
 
 			jobHandler := v1beta1.NewJobHandler(jobService, log)
 			resp, err := jobHandler.DeleteJobSpecification(ctx, request)
@@ -777,13 +844,57 @@ func TestNewJobHandler(t *testing.T) {
 			}
 
 			downstreamNames := []job.FullName{"job-B"}
-			jobService.On("Delete", ctx, sampleTenant, jobAName, false, true).Return(downstreamNames, nil)
+			jobService.On("Delete", ctx, sampleTenant, jobAName, false, true, "", "").Return(downstreamNames, nil)
 
 			jobHandler := v1beta1.NewJobHandler(jobService, log)
 			resp, err := jobHandler.DeleteJobSpecification(ctx, request)
 			assert.NoError(t, err)
 			assert.Contains(t, resp.Message, "these downstream will be affected")
 		})
+		t.Run("passes the requester and reason of the deletion to the service", func(t *testing.T) {
+			jobService := new(JobService)
+
+			jobAName, _ := job.NameFrom("job-A")
+			request := &pb.DeleteJobSpecificationRequest{
+				ProjectName:   project.Name().String(),
+				NamespaceName: namespace.Name().String(),
+				JobName:       jobAName.String(),
+				Force:         true,
+			}
+			auditCtx := metadata.NewIncomingContext(ctx, metadata.Pairs(
+				job.DeleteRequestedByMetadataKey, "user@example.com",
+				job.DeleteReasonMetadataKey, "table is deprecated",
+			))
+
+			jobService.On("Delete", auditCtx, sampleTenant, jobAName, false, true, "user@example.com", "table is deprecated").Return(nil, nil)
+			defer jobService.AssertExpectations(t)
+
+			jobHandler := v1beta1.NewJobHandler(jobService, log)
+			_, err := jobHandler.DeleteJobSpecification(auditCtx, request)
+			assert.NoError(t, err)
+		})
+		t.Run("passes the principal of the call as the requester of the deletion", func(t *testing.T) {
+			jobService := new(JobService)
+
+			jobAName, _ := job.NameFrom("job-A")
+			request := &pb.DeleteJobSpecificationRequest{
+				ProjectName:   project.Name().String(),
+				NamespaceName: namespace.Name().String(),
+				JobName:       jobAName.String(),
+				Force:         true,
+			}
+			auditCtx := auth.WithPrincipal(metadata.NewIncomingContext(ctx, metadata.Pairs(
+				job.DeleteRequestedByMetadataKey, "someone-else@example.com",
+				job.DeleteReasonMetadataKey, "table is deprecated",
+			)), &auth.Principal{Type: auth.PrincipalOIDC, Name: "user@example.com"})
+
+			jobService.On("Delete", auditCtx, sampleTenant, jobAName, false, true, "user@example.com", "table is deprecated").Return(nil, nil)
+			defer jobService.AssertExpectations(t)
+
+			jobHandler := v1beta1.NewJobHandler(jobService, log)
+			_, err := jobHandler.DeleteJobSpecification(auditCtx, request)
+			assert.NoError(t, err)
+		})
 		t.Run("returns error if unable to construct tenant", func(t *testing.T) {
 			jobService := new(JobService)
 
@@ -827,7 +938,7 @@ func TestNewJobHandler(t *testing.T) {
 				Force:         true,
 			}
 
-			jobService.On("Delete", ctx, sampleTenant, jobAName, false, true).Return(nil, errors.New("internal error"))
+			jobService.On("Delete", ctx, sampleTenant, jobAName, false, true, "", "").Return(nil, errors.New("internal error"))
 
 			jobHandler := v1beta1.NewJobHandler(jobService, log)
 			resp, err := jobHandler.DeleteJobSpecification(ctx, request)
@@ -835,6 +946,469 @@ func TestNewJobHandler(t *testing.T) {
 			assert.Nil(t, resp)
 		})
 	})
+	t.Run("AddJobDeletionConsent", func(t *testing.T) {
+		jobAName, _ := job.NameFrom("job-A")
+		downstreamName, _ := job.NameFrom("job-B")
+		request := &pb.AddJobDeletionConsentRequest{
+			ProjectName:         "other-proj",
+			JobName:             downstreamName.String(),
+			UpstreamProjectName: project.Name().String(),
+			UpstreamJobName:     jobAName.String(),
+			Reason:              "migrated to another source",
+		}
+		principalCtx := auth.WithPrincipal(ctx, &auth.Principal{Type: auth.PrincipalOIDC, Name: "owner@example.com"})
+
+		t.Run("returns error if the caller is not authenticated", func(t *testing.T) {
+			jobHandler := v1beta1.NewJobHandler(new(JobService), log)
+
+			resp, err := jobHandler.AddJobDeletionConsent(ctx, request)
+			assert.ErrorContains(t, err, "code = Unauthenticated")
+			assert.Nil(t, resp)
+		})
+		t.Run("returns error if the upstream job name is invalid", func(t *testing.T) {
+			jobHandler := v1beta1.NewJobHandler(new(JobService), log)
+
+			resp, err := jobHandler.AddJobDeletionConsent(principalCtx, &pb.AddJobDeletionConsentRequest{
+				ProjectName:         "other-proj",
+				JobName:             downstreamName.String(),
+				UpstreamProjectName: project.Name().String(),
+			})
+			assert.ErrorContains(t, err, "code = InvalidArgument")
+			assert.Nil(t, resp)
+		})
+		t.Run("records the caller as giving the consent", func(t *testing.T) {
+			jobService := new(JobService)
+			jobService.On("AddDeletionConsent", principalCtx, project.Name(), jobAName, tenant.ProjectName("other-proj"), downstreamName,
+				"owner@example.com", "migrated to another source").Return(nil)
+			defer jobService.AssertExpectations(t)
+
+			jobHandler := v1beta1.NewJobHandler(jobService, log)
+			_, err := jobHandler.AddJobDeletionConsent(principalCtx, request)
+			assert.NoError(t, err)
+		})
+	})
+	t.Run("GetJobDeletionConsents", func(t *testing.T) {
+		jobAName, _ := job.NameFrom("job-A")
+		request := &pb.GetJobDeletionConsentsRequest{ProjectName: project.Name().String(), JobName: jobAName.String()}
+
+		t.Run("returns error if unable to get the deletion audits", func(t *testing.T) {
+			jobService := new(JobService)
+			jobService.On("GetDeletionConsents", ctx, project.Name(), jobAName).Return(nil, nil)
+			jobService.On("GetDeletionAudits", ctx, project.Name(), jobAName).Return(nil, errors.New("internal error"))
+			defer jobService.AssertExpectations(t)
+
+			jobHandler := v1beta1.NewJobHandler(jobService, log)
+			resp, err := jobHandler.GetJobDeletionConsents(ctx, request)
+			assert.ErrorContains(t, err, "unable to get deletion consents of job-A")
+			assert.Nil(t, resp)
+		})
+		t.Run("returns the consents and the deletion audits of the job", func(t *testing.T) {
+			createdAt := time.Date(2023, 10, 10, 10, 0, 0, 0, time.UTC)
+			consent, err := job.NewDeletionConsent(project.Name(), jobAName, "other-proj", "job-B", "owner@example.com", "migrated")
+			assert.NoError(t, err)
+			consent.CreatedAt = createdAt
+			audit := &job.DeletionAudit{
+				RequestedBy:          "user@example.com",
+				Forced:               true,
+				OverriddenDownstream: job.FullNames{"other-proj/job-C"},
+				CreatedAt:            createdAt,
+			}
+
+			jobService := new(JobService)
+			jobService.On("GetDeletionConsents", ctx, project.Name(), jobAName).Return([]*job.DeletionConsent{consent}, nil)
+			jobService.On("GetDeletionAudits", ctx, project.Name(), jobAName).Return([]*job.DeletionAudit{audit}, nil)
+			defer jobService.AssertExpectations(t)
+
+			jobHandler := v1beta1.NewJobHandler(jobService, log)
+			resp, err := jobHandler.GetJobDeletionConsents(ctx, request)
+			assert.NoError(t, err)
+			assert.Len(t, resp.GetConsents(), 1)
+			assert.Equal(t, "job-B", resp.GetConsents()[0].GetDownstreamJobName())
+			assert.Equal(t, "owner@example.com", resp.GetConsents()[0].GetGivenBy())
+			assert.Equal(t, createdAt, resp.GetConsents()[0].GetCreatedAt().AsTime())
+			assert.Len(t, resp.GetAudits(), 1)
+			assert.True(t, resp.GetAudits()[0].GetForced())
+			assert.Equal(t, []string{"other-proj/job-C"}, resp.GetAudits()[0].GetOverriddenDownstream())
+		})
+	})
+	t.Run("FormatJobSpecifications", func(t *testing.T) {
+		jobSpecProto := &pb.JobSpecification{
+			Version:          int32(jobVersion),
+			Name:             "job-A",
+			Owner:            sampleOwner,
+			StartDate:        jobSchedule.StartDate().String(),
+			Interval:         jobSchedule.Interval(),
+			TaskName:         jobTask.Name().String(),
+			WindowSize:       jobWindow.GetSize(),
+			WindowOffset:     jobWindow.GetOffset(),
+			WindowTruncateTo: jobWindow.GetTruncateTo(),
+			Behavior: &pb.JobSpecification_Behavior{
+				Retry: &pb.JobSpecification_Behavior_Retry{Count: 2, Delay: durationpb.New(time.Minute * 5)},
+			},
+		}
+
+		t.Run("returns the specs as read by the server", func(t *testing.T) {
+			jobHandler := v1beta1.NewJobHandler(nil, log)
+			resp, err := jobHandler.FormatJobSpecifications(ctx, &pb.FormatJobSpecificationsRequest{
+				ProjectName:   project.Name().String(),
+				NamespaceName: namespace.Name().String(),
+				Jobs:          []*pb.JobSpecification{jobSpecProto},
+			})
+			assert.NoError(t, err)
+			assert.Len(t, resp.Jobs, 1)
+			assert.Equal(t, "job-A", resp.Jobs[0].Name)
+			assert.Equal(t, sampleOwner, resp.Jobs[0].Owner)
+			assert.Equal(t, jobSchedule.StartDate().String(), resp.Jobs[0].StartDate)
+			assert.Equal(t, time.Minute*5, resp.Jobs[0].Behavior.Retry.Delay.AsDuration())
+			assert.Empty(t, resp.Jobs[0].Destination)
+		})
+		t.Run("returns error if a spec is not valid", func(t *testing.T) {
+			jobHandler := v1beta1.NewJobHandler(nil, log)
+			resp, err := jobHandler.FormatJobSpecifications(ctx, &pb.FormatJobSpecificationsRequest{
+				ProjectName:   project.Name().String(),
+				NamespaceName: namespace.Name().String(),
+				Jobs:          []*pb.JobSpecification{jobSpecProto, {Name: "job-B"}},
+			})
+			assert.ErrorContains(t, err, "code = InvalidArgument")
+			assert.ErrorContains(t, err, "job-B")
+			assert.Nil(t, resp)
+		})
+		t.Run("returns error if unable to construct tenant", func(t *testing.T) {
+			jobHandler := v1beta1.NewJobHandler(nil, log)
+			resp, err := jobHandler.FormatJobSpecifications(ctx, &pb.FormatJobSpecificationsRequest{
+				NamespaceName: namespace.Name().String(),
+				Jobs:          []*pb.JobSpecification{jobSpecProto},
+			})
+			assert.Error(t, err)
+			assert.Nil(t, resp)
+		})
+	})
+	t.Run("DeleteJobs", func(t *testing.T) {
+		jobAName, _ := job.NameFrom("job-A")
+		jobBName, _ := job.NameFrom("job-B")
+
+		t.Run("deletes jobs successfully", func(t *testing.T) {
+			jobService := new(JobService)
+
+			request := &pb.DeleteJobsRequest{
+				ProjectName:   project.Name().String(),
+				NamespaceName: namespace.Name().String(),
+				JobNames:      []string{jobAName.String(), jobBName.String()},
+			}
+
+			jobService.On("DeleteJobs", ctx, sampleTenant, []job.Name{jobAName, jobBName}, false, false, "", "").Return(nil, nil)
+			defer jobService.AssertExpectations(t)
+
+			jobHandler := v1beta1.NewJobHandler(jobService, log)
+			resp, err := jobHandler.DeleteJobs(ctx, request)
+			assert.NoError(t, err)
+			assert.Equal(t, "2 jobs have been deleted", resp.Message)
+			assert.Empty(t, resp.AffectedDownstream)
+		})
+		t.Run("force deletes jobs with downstream and the requester and reason of the deletion", func(t *testing.T) {
+			jobService := new(JobService)
+
+			request := &pb.DeleteJobsRequest{
+				ProjectName:   project.Name().String(),
+				NamespaceName: namespace.Name().String(),
+				JobNames:      []string{jobAName.String()},
+				Force:         true,
+			}
+			auditCtx := metadata.NewIncomingContext(ctx, metadata.Pairs(
+				job.DeleteRequestedByMetadataKey, "user@example.com",
+				job.DeleteReasonMetadataKey, "table is deprecated",
+			))
+
+			downstreamNames := []job.FullName{"proj/job-C"}
+			jobService.On("DeleteJobs", auditCtx, sampleTenant, []job.Name{jobAName}, false, true, "user@example.com", "table is deprecated").
+				Return(downstreamNames, nil)
+			defer jobService.AssertExpectations(t)
+
+			jobHandler := v1beta1.NewJobHandler(jobService, log)
+			resp, err := jobHandler.DeleteJobs(auditCtx, request)
+			assert.NoError(t, err)
+			assert.Contains(t, resp.Message, "these downstream will be affected")
+			assert.Equal(t, []string{"proj/job-C"}, resp.AffectedDownstream)
+		})
+		t.Run("returns error if unable to construct tenant", func(t *testing.T) {
+			jobService := new(JobService)
+
+			request := &pb.DeleteJobsRequest{
+				NamespaceName: namespace.Name().String(),
+				JobNames:      []string{jobAName.String()},
+			}
+
+			jobHandler := v1beta1.NewJobHandler(jobService, log)
+			resp, err := jobHandler.DeleteJobs(ctx, request)
+			assert.Error(t, err)
+			assert.Nil(t, resp)
+		})
+		t.Run("returns error if a job name is invalid", func(t *testing.T) {
+			jobService := new(JobService)
+
+			request := &pb.DeleteJobsRequest{
+				ProjectName:   project.Name().String(),
+				NamespaceName: namespace.Name().String(),
+				JobNames:      []string{jobAName.String(), ""},
+			}
+
+			jobHandler := v1beta1.NewJobHandler(jobService, log)
+			resp, err := jobHandler.DeleteJobs(ctx, request)
+			assert.Error(t, err)
+			assert.Nil(t, resp)
+		})
+		t.Run("returns error if unable to delete jobs", func(t *testing.T) {
+			jobService := new(JobService)
+
+			request := &pb.DeleteJobsRequest{
+				ProjectName:   project.Name().String(),
+				NamespaceName: namespace.Name().String(),
+				JobNames:      []string{jobAName.String()},
+			}
+
+			jobService.On("DeleteJobs", ctx, sampleTenant, []job.Name{jobAName}, false, false, "", "").
+				Return(nil, errors.New("downstream jobs exist"))
+			defer jobService.AssertExpectations(t)
+
+			jobHandler := v1beta1.NewJobHandler(jobService, log)
+			resp, err := jobHandler.DeleteJobs(ctx, request)
+			assert.ErrorContains(t, err, "failed to delete job specifications")
+			assert.Nil(t, resp)
+		})
+	})
+	t.Run("ChangeJobName", func(t *testing.T) {
+		jobAName, _ := job.NameFrom("job-A")
+		newJobAName, _ := job.NameFrom("job-A-v2")
+
+		t.Run("renames the job successfully", func(t *testing.T) {
+			jobService := new(JobService)
+			jobService.On("ChangeJobName", ctx, sampleTenant, jobAName, newJobAName).Return(nil)
+			defer jobService.AssertExpectations(t)
+
+			jobHandler := v1beta1.NewJobHandler(jobService, log)
+			resp, err := jobHandler.ChangeJobName(ctx, &pb.ChangeJobNameRequest{
+				ProjectName:   project.Name().String(),
+				NamespaceName: namespace.Name().String(),
+				JobName:       jobAName.String(),
+				NewJobName:    newJobAName.String(),
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, newJobAName.String(), resp.JobName)
+		})
+		t.Run("returns error if unable to construct tenant", func(t *testing.T) {
+			jobService := new(JobService)
+
+			jobHandler := v1beta1.NewJobHandler(jobService, log)
+			resp, err := jobHandler.ChangeJobName(ctx, &pb.ChangeJobNameRequest{
+				NamespaceName: namespace.Name().String(),
+				JobName:       jobAName.String(),
+				NewJobName:    newJobAName.String(),
+			})
+			assert.Error(t, err)
+			assert.Nil(t, resp)
+		})
+		t.Run("returns error if new job name is empty", func(t *testing.T) {
+			jobService := new(JobService)
+
+			jobHandler := v1beta1.NewJobHandler(jobService, log)
+			resp, err := jobHandler.ChangeJobName(ctx, &pb.ChangeJobNameRequest{
+				ProjectName:   project.Name().String(),
+				NamespaceName: namespace.Name().String(),
+				JobName:       jobAName.String(),
+			})
+			assert.ErrorContains(t, err, "failed to adapt new job name when changing job name")
+			assert.Nil(t, resp)
+		})
+		t.Run("returns error if unable to rename the job", func(t *testing.T) {
+			jobService := new(JobService)
+			jobService.On("ChangeJobName", ctx, sampleTenant, jobAName, newJobAName).Return(errors.New("job already exists"))
+			defer jobService.AssertExpectations(t)
+
+			jobHandler := v1beta1.NewJobHandler(jobService, log)
+			resp, err := jobHandler.ChangeJobName(ctx, &pb.ChangeJobNameRequest{
+				ProjectName:   project.Name().String(),
+				NamespaceName: namespace.Name().String(),
+				JobName:       jobAName.String(),
+				NewJobName:    newJobAName.String(),
+			})
+			assert.ErrorContains(t, err, "failed to change job name")
+			assert.Nil(t, resp)
+		})
+	})
+	t.Run("GetJobSpecVersions", func(t *testing.T) {
+		jobAName, _ := job.NameFrom("job-A")
+
+		t.Run("returns the deployed versions of the job", func(t *testing.T) {
+			jobService := new(JobService)
+
+			specA, _ := job.NewSpecBuilder(jobVersion, jobAName, sampleOwner, jobSchedule, jobWindow, jobTask).Build()
+			deployedAt := time.Date(2023, 10, 10, 10, 0, 0, 0, time.UTC)
+			versions := []*job.SpecVersion{
+				{ProjectName: project.Name(), JobName: jobAName, Version: 2, Spec: specA, CreatedAt: deployedAt},
+			}
+			jobService.On("GetSpecVersions", ctx, project.Name(), jobAName).Return(versions, nil)
+			defer jobService.AssertExpectations(t)
+
+			jobHandler := v1beta1.NewJobHandler(jobService, log)
+			resp, err := jobHandler.GetJobSpecVersions(ctx, &pb.GetJobSpecVersionsRequest{
+				ProjectName: project.Name().String(),
+				JobName:     jobAName.String(),
+			})
+			assert.NoError(t, err)
+			assert.Len(t, resp.Versions, 1)
+			assert.EqualValues(t, 2, resp.Versions[0].Version)
+			assert.Equal(t, sampleOwner, resp.Versions[0].Owner)
+			assert.Equal(t, "bq2bq", resp.Versions[0].TaskName)
+			assert.Equal(t, deployedAt, resp.Versions[0].CreatedAt.AsTime())
+		})
+		t.Run("returns error if job name is empty", func(t *testing.T) {
+			jobService := new(JobService)
+
+			jobHandler := v1beta1.NewJobHandler(jobService, log)
+			resp, err := jobHandler.GetJobSpecVersions(ctx, &pb.GetJobSpecVersionsRequest{ProjectName: project.Name().String()})
+			assert.Error(t, err)
+			assert.Nil(t, resp)
+		})
+		t.Run("returns error if unable to get the versions", func(t *testing.T) {
+			jobService := new(JobService)
+			jobService.On("GetSpecVersions", ctx, project.Name(), jobAName).Return(nil, errors.New("internal error"))
+			defer jobService.AssertExpectations(t)
+
+			jobHandler := v1beta1.NewJobHandler(jobService, log)
+			resp, err := jobHandler.GetJobSpecVersions(ctx, &pb.GetJobSpecVersionsRequest{
+				ProjectName: project.Name().String(),
+				JobName:     jobAName.String(),
+			})
+			assert.ErrorContains(t, err, "failed to get job spec versions")
+			assert.Nil(t, resp)
+		})
+	})
+	t.Run("RollbackJob", func(t *testing.T) {
+		jobAName, _ := job.NameFrom("job-A")
+
+		t.Run("rolls back the job to the version", func(t *testing.T) {
+			jobService := new(JobService)
+			jobService.On("RollbackJob", ctx, sampleTenant, jobAName, 3).Return(nil)
+			defer jobService.AssertExpectations(t)
+
+			jobHandler := v1beta1.NewJobHandler(jobService, log)
+			resp, err := jobHandler.RollbackJob(ctx, &pb.RollbackJobRequest{
+				ProjectName:   project.Name().String(),
+				NamespaceName: namespace.Name().String(),
+				JobName:       jobAName.String(),
+				Version:       3,
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, jobAName.String(), resp.JobName)
+			assert.EqualValues(t, 3, resp.Version)
+		})
+		t.Run("returns error if version is not positive", func(t *testing.T) {
+			jobService := new(JobService)
+
+			jobHandler := v1beta1.NewJobHandler(jobService, log)
+			resp, err := jobHandler.RollbackJob(ctx, &pb.RollbackJobRequest{
+				ProjectName:   project.Name().String(),
+				NamespaceName: namespace.Name().String(),
+				JobName:       jobAName.String(),
+			})
+			assert.ErrorContains(t, err, "version should be a positive number")
+			assert.Nil(t, resp)
+		})
+		t.Run("returns error if unable to rollback the job", func(t *testing.T) {
+			jobService := new(JobService)
+			jobService.On("RollbackJob", ctx, sampleTenant, jobAName, 3).Return(errors.New("version not found"))
+			defer jobService.AssertExpectations(t)
+
+			jobHandler := v1beta1.NewJobHandler(jobService, log)
+			resp, err := jobHandler.RollbackJob(ctx, &pb.RollbackJobRequest{
+				ProjectName:   project.Name().String(),
+				NamespaceName: namespace.Name().String(),
+				JobName:       jobAName.String(),
+				Version:       3,
+			})
+			assert.ErrorContains(t, err, "failed to rollback job to version 3")
+			assert.Nil(t, resp)
+		})
+	})
+	t.Run("ValidateJobSpecs", func(t *testing.T) {
+		jobSpecProto := &pb.JobSpecification{
+			Version:          int32(jobVersion),
+			Name:             "job-A",
+			Owner:            sampleOwner,
+			StartDate:        jobSchedule.StartDate().String(),
+			EndDate:          jobSchedule.EndDate().String(),
+			Interval:         jobSchedule.Interval(),
+			TaskName:         jobTask.Name().String(),
+			WindowSize:       jobWindow.GetSize(),
+			WindowOffset:     jobWindow.GetOffset(),
+			WindowTruncateTo: jobWindow.GetTruncateTo(),
+		}
+
+		t.Run("reports the result of every job, including the ones failing to be adapted", func(t *testing.T) {
+			jobService := new(JobService)
+			jobService.On("ValidateJobSpecs", ctx, sampleTenant, mock.Anything).Return([]*job.SpecValidation{
+				{JobName: "job-A", Errors: []string{"cyclic dependency"}},
+			}, nil)
+			defer jobService.AssertExpectations(t)
+
+			jobHandler := v1beta1.NewJobHandler(jobService, log)
+			resp, err := jobHandler.ValidateJobSpecs(ctx, &pb.ValidateJobSpecsRequest{
+				ProjectName:   project.Name().String(),
+				NamespaceName: namespace.Name().String(),
+				Jobs:          []*pb.JobSpecification{{Name: "job-B"}, jobSpecProto},
+			})
+			assert.NoError(t, err)
+			assert.False(t, resp.Valid)
+			assert.Len(t, resp.Results, 2)
+			assert.Equal(t, "job-B", resp.Results[0].JobName)
+			assert.False(t, resp.Results[0].Valid)
+			assert.NotEmpty(t, resp.Results[0].Errors)
+			assert.Equal(t, "job-A", resp.Results[1].JobName)
+			assert.Equal(t, []string{"cyclic dependency"}, resp.Results[1].Errors)
+		})
+		t.Run("returns valid when every job is valid", func(t *testing.T) {
+			jobService := new(JobService)
+			jobService.On("ValidateJobSpecs", ctx, sampleTenant, mock.Anything).Return([]*job.SpecValidation{
+				{JobName: "job-A"},
+			}, nil)
+			defer jobService.AssertExpectations(t)
+
+			jobHandler := v1beta1.NewJobHandler(jobService, log)
+			resp, err := jobHandler.ValidateJobSpecs(ctx, &pb.ValidateJobSpecsRequest{
+				ProjectName:   project.Name().String(),
+				NamespaceName: namespace.Name().String(),
+				Jobs:          []*pb.JobSpecification{jobSpecProto},
+			})
+			assert.NoError(t, err)
+			assert.True(t, resp.Valid)
+			assert.True(t, resp.Results[0].Valid)
+		})
+		t.Run("returns error if unable to construct tenant", func(t *testing.T) {
+			jobService := new(JobService)
+
+			jobHandler := v1beta1.NewJobHandler(jobService, log)
+			resp, err := jobHandler.ValidateJobSpecs(ctx, &pb.ValidateJobSpecsRequest{
+				NamespaceName: namespace.Name().String(),
+				Jobs:          []*pb.JobSpecification{jobSpecProto},
+			})
+			assert.Error(t, err)
+			assert.Nil(t, resp)
+		})
+		t.Run("returns error if unable to validate the jobs", func(t *testing.T) {
+			jobService := new(JobService)
+			jobService.On("ValidateJobSpecs", ctx, sampleTenant, mock.Anything).Return(nil, errors.New("internal error"))
+			defer jobService.AssertExpectations(t)
+
+			jobHandler := v1beta1.NewJobHandler(jobService, log)
+			resp, err := jobHandler.ValidateJobSpecs(ctx, &pb.ValidateJobSpecsRequest{
+				ProjectName:   project.Name().String(),
+				NamespaceName: namespace.Name().String(),
+				Jobs:          []*pb.JobSpecification{jobSpecProto},
+			})
+			assert.ErrorContains(t, err, "failed to validate job specifications")
+			assert.Nil(t, resp)
+		})
+	})
 	t.Run("GetWindow", func(t *testing.T) {
 		t.Run("returns error if scheduledAt is not valid", func(t *testing.T) {
 			req := &pb.GetWindowRequest{
@@ -1967,13 +2541,13 @@ func (_m *JobService) Add(ctx context.Context, jobTenant tenant.Tenant, jobs []*
 	return r0
 }
 
-// Delete provides a mock function with given fields: ctx, jobTenant, jobName, cleanFlag, forceFlag
-func (_m *JobService) Delete(ctx context.Context, jobTenant tenant.Tenant, jobName job.Name, cleanFlag, forceFlag bool) ([]job.FullName, error) {
-	ret := _m.Called(ctx, jobTenant, jobName, cleanFlag, forceFlag)
+// Delete provides a mock function with given fields: ctx, jobTenant, jobName, cleanFlag, forceFlag, requestedBy, reason
+func (_m *JobService) Delete(ctx context.Context, jobTenant tenant.Tenant, jobName job.Name, cleanFlag, forceFlag bool, requestedBy, reason string) ([]job.FullName, error) {
+	ret := _m.Called(ctx, jobTenant, jobName, cleanFlag, forceFlag, requestedBy, reason)
 
 	var r0 []job.FullName
-	if rf, ok := ret.Get(0).(func(context.Context, tenant.Tenant, job.Name, bool, bool) []job.FullName); ok {
-		r0 = rf(ctx, jobTenant, jobName, cleanFlag, forceFlag)
+	if rf, ok := ret.Get(0).(func(context.Context, tenant.Tenant, job.Name, bool, bool, string, string) []job.FullName); ok {
+		r0 = rf(ctx, jobTenant, jobName, cleanFlag, forceFlag, requestedBy, reason)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]job.FullName)
@@ -1981,8 +2555,8 @@ func (_m *JobService) Delete(ctx context.Context, jobTenant tenant.Tenant, jobNa
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, tenant.Tenant, job.Name, bool, bool) error); ok {
-		r1 = rf(ctx, jobTenant, jobName, cleanFlag, forceFlag)
+	if rf, ok := ret.Get(1).(func(context.Context, tenant.Tenant, job.Name, bool, bool, string, string) error); ok {
+		r1 = rf(ctx, jobTenant, jobName, cleanFlag, forceFlag, requestedBy, reason)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1990,12 +2564,93 @@ func (_m *JobService) Delete(ctx context.Context, jobTenant tenant.Tenant, jobNa
 	return r0, r1
 }
 
+// AddDeletionConsent provides a mock function with given fields: ctx, projectName, jobName, downstreamProjectName, downstreamJobName, givenBy, reason
+func (_m *JobService) AddDeletionConsent(ctx context.Context, projectName tenant.ProjectName, jobName job.Name,
+	downstreamProjectName tenant.ProjectName, downstreamJobName job.Name, givenBy, reason string,
+) error {
+	ret := _m.Called(ctx, projectName, jobName, downstreamProjectName, downstreamJobName, givenBy, reason)
+	return ret.Error(0)
+}
+
+// GetDeletionConsents provides a mock function with given fields: ctx, projectName, jobName
+func (_m *JobService) GetDeletionConsents(ctx context.Context, projectName tenant.ProjectName, jobName job.Name) ([]*job.DeletionConsent, error) {
+	ret := _m.Called(ctx, projectName, jobName)
+
+	var r0 []*job.DeletionConsent
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]*job.DeletionConsent)
+	}
+	return r0, ret.Error(1)
+}
+
+// GetDeletionAudits provides a mock function with given fields: ctx, projectName, jobName
+func (_m *JobService) GetDeletionAudits(ctx context.Context, projectName tenant.ProjectName, jobName job.Name) ([]*job.DeletionAudit, error) {
+	ret := _m.Called(ctx, projectName, jobName)
+
+	var r0 []*job.DeletionAudit
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]*job.DeletionAudit)
+	}
+	return r0, ret.Error(1)
+}
+
+// DeleteJobs provides a mock function with given fields: ctx, jobTenant, jobNames, cleanFlag, forceFlag, requestedBy, reason
+func (_m *JobService) DeleteJobs(ctx context.Context, jobTenant tenant.Tenant, jobNames []job.Name, cleanFlag, forceFlag bool, requestedBy, reason string) ([]job.FullName, error) {
+	ret := _m.Called(ctx, jobTenant, jobNames, cleanFlag, forceFlag, requestedBy, reason)
+
+	var r0 []job.FullName
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]job.FullName)
+	}
+	return r0, ret.Error(1)
+}
+
 // ChangeNamespace provides a mock function with given fields: ctx, jobName, jobTenant, jobNewTenant
 func (_m *JobService) ChangeNamespace(ctx context.Context, jobTenant, jobNewTenant tenant.Tenant, jobName job.Name) error {
 	ret := _m.Called(ctx, jobTenant, jobNewTenant, jobName)
 	return ret.Error(0)
 }
 
+// MoveJob provides a mock function with given fields: ctx, jobTenant, jobNewTenant, jobName
+func (_m *JobService) MoveJob(ctx context.Context, jobTenant, jobNewTenant tenant.Tenant, jobName job.Name) error {
+	ret := _m.Called(ctx, jobTenant, jobNewTenant, jobName)
+	return ret.Error(0)
+}
+
+// ChangeJobName provides a mock function with given fields: ctx, jobTenant, jobName, newJobName
+func (_m *JobService) ChangeJobName(ctx context.Context, jobTenant tenant.Tenant, jobName, newJobName job.Name) error {
+	ret := _m.Called(ctx, jobTenant, jobName, newJobName)
+	return ret.Error(0)
+}
+
+// GetSpecVersions provides a mock function with given fields: ctx, projectName, jobName
+func (_m *JobService) GetSpecVersions(ctx context.Context, projectName tenant.ProjectName, jobName job.Name) ([]*job.SpecVersion, error) {
+	ret := _m.Called(ctx, projectName, jobName)
+
+	var r0 []*job.SpecVersion
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]*job.SpecVersion)
+	}
+	return r0, ret.Error(1)
+}
+
+// RollbackJob provides a mock function with given fields: ctx, jobTenant, jobName, version
+func (_m *JobService) RollbackJob(ctx context.Context, jobTenant tenant.Tenant, jobName job.Name, version int) error {
+	ret := _m.Called(ctx, jobTenant, jobName, version)
+	return ret.Error(0)
+}
+
+// ValidateJobSpecs provides a mock function with given fields: ctx, jobTenant, specs
+func (_m *JobService) ValidateJobSpecs(ctx context.Context, jobTenant tenant.Tenant, specs []*job.Spec) ([]*job.SpecValidation, error) {
+	ret := _m.Called(ctx, jobTenant, specs)
+
+	var r0 []*job.SpecValidation
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]*job.SpecValidation)
+	}
+	return r0, ret.Error(1)
+}
+
 // UpdateState provides a mock function with given fields: ctx, jobTenant, jobNames, jobState, remark
 func (_m *JobService) UpdateState(ctx context.Context, jobTenant tenant.Tenant, jobNames []job.Name, jobState job.State, remark string) error {
 	ret := _m.Called(ctx, jobTenant, jobNames, jobState, remark)
@@ -0,0 +1,79 @@
+package v1beta1
+
+import (
+	"context"
+
+	"github.com/goto/salt/log"
+
+	"github.com/goto/optimus/core/job"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+const defaultLineageDepth = 3
+
+type LineageService interface {
+	GetLineage(ctx context.Context, urn job.ResourceURN, depth int) (*job.LineageGraph, error)
+	GetJobLineage(ctx context.Context, projectName tenant.ProjectName, jobName job.Name, depth int) (*job.LineageGraph, error)
+}
+
+type LineageHandler struct {
+	l             log.Logger
+	service       LineageService
+	columnService ColumnLineageService
+
+	pb.UnimplementedLineageServiceServer
+}
+
+// GetLineage serves the graph of jobs and resources around the resource of the request, or around the destination
+// of its job. The graph is walked up to depth jobs both ways.
+func (h *LineageHandler) GetLineage(ctx context.Context, req *pb.GetLineageRequest) (*pb.GetLineageResponse, error) {
+	depth := defaultLineageDepth
+	if req.GetDepth() > 0 {
+		depth = int(req.GetDepth())
+	}
+
+	graph, err := h.getLineage(ctx, req, depth)
+	if err != nil {
+		h.l.Error("error getting lineage: %s", err)
+		return nil, errors.GRPCErr(err, "unable to get lineage")
+	}
+
+	nodes := make([]*pb.GetLineageResponse_Node, len(graph.Nodes))
+	for i, node := range graph.Nodes {
+		nodes[i] = &pb.GetLineageResponse_Node{Id: node.ID, Type: node.Type, Name: node.Name}
+	}
+	edges := make([]*pb.GetLineageResponse_Edge, len(graph.Edges))
+	for i, edge := range graph.Edges {
+		edges[i] = &pb.GetLineageResponse_Edge{From: edge.From, To: edge.To}
+	}
+	return &pb.GetLineageResponse{
+		Nodes: nodes,
+		Edges: edges,
+	}, nil
+}
+
+func (h *LineageHandler) getLineage(ctx context.Context, req *pb.GetLineageRequest, depth int) (*job.LineageGraph, error) {
+	if urn := req.GetResourceUrn(); urn != "" {
+		return h.service.GetLineage(ctx, job.ResourceURN(urn), depth)
+	}
+
+	projectName, err := tenant.ProjectNameFrom(req.GetProjectName())
+	if err != nil {
+		return nil, err
+	}
+	jobName, err := job.NameFrom(req.GetJobName())
+	if err != nil {
+		return nil, err
+	}
+	return h.service.GetJobLineage(ctx, projectName, jobName, depth)
+}
+
+func NewLineageHandler(l log.Logger, service LineageService, columnService ColumnLineageService) *LineageHandler {
+	return &LineageHandler{
+		l:             l,
+		service:       service,
+		columnService: columnService,
+	}
+}
@@ -0,0 +1,133 @@
+package v1beta1_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/goto/optimus/core/job"
+	"github.com/goto/optimus/core/job/handler/v1beta1"
+	"github.com/goto/optimus/core/tenant"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+func TestLineageHandler(t *testing.T) {
+	ctx := context.Background()
+	logger := log.NewNoop()
+
+	graph := job.NewLineageGraph()
+	graph.Nodes = []*job.LineageNode{
+		{ID: "job:proj/job1", Type: "job", Name: "job1"},
+		{ID: "resource:store://table", Type: "resource", Name: "store://table"},
+	}
+	graph.Edges = []*job.LineageEdge{{From: "job:proj/job1", To: "resource:store://table"}}
+
+	t.Run("GetLineage", func(t *testing.T) {
+		t.Run("returns the lineage around the resource with the default depth", func(t *testing.T) {
+			service := new(lineageService)
+			service.On("GetLineage", ctx, job.ResourceURN("store://table"), 3).Return(graph, nil)
+			defer service.AssertExpectations(t)
+
+			handler := v1beta1.NewLineageHandler(logger, service, nil)
+
+			resp, err := handler.GetLineage(ctx, &pb.GetLineageRequest{ResourceUrn: "store://table"})
+			assert.NoError(t, err)
+			assert.Len(t, resp.GetNodes(), 2)
+			assert.Equal(t, "job1", resp.GetNodes()[0].GetName())
+			assert.Equal(t, "resource:store://table", resp.GetEdges()[0].GetTo())
+		})
+		t.Run("returns the lineage around the destination of the job", func(t *testing.T) {
+			service := new(lineageService)
+			service.On("GetJobLineage", ctx, tenant.ProjectName("proj"), job.Name("job1"), 1).Return(graph, nil)
+			defer service.AssertExpectations(t)
+
+			handler := v1beta1.NewLineageHandler(logger, service, nil)
+
+			resp, err := handler.GetLineage(ctx, &pb.GetLineageRequest{ProjectName: "proj", JobName: "job1", Depth: 1})
+			assert.NoError(t, err)
+			assert.Len(t, resp.GetEdges(), 1)
+		})
+		t.Run("returns error when job name is invalid", func(t *testing.T) {
+			service := new(lineageService)
+			handler := v1beta1.NewLineageHandler(logger, service, nil)
+
+			_, err := handler.GetLineage(ctx, &pb.GetLineageRequest{ProjectName: "proj"})
+			assert.ErrorContains(t, err, "code = InvalidArgument")
+		})
+		t.Run("returns error when unable to get lineage", func(t *testing.T) {
+			service := new(lineageService)
+			service.On("GetJobLineage", ctx, tenant.ProjectName("proj"), job.Name("job1"), 3).Return(nil, errors.New("unknown error"))
+			defer service.AssertExpectations(t)
+
+			handler := v1beta1.NewLineageHandler(logger, service, nil)
+
+			_, err := handler.GetLineage(ctx, &pb.GetLineageRequest{ProjectName: "proj", JobName: "job1"})
+			assert.EqualError(t, err, "rpc error: code = Internal desc = unknown error: unable to get lineage")
+		})
+	})
+	t.Run("GetColumnLineage", func(t *testing.T) {
+		t.Run("returns the jobs reading the column with the columns derived from it", func(t *testing.T) {
+			downstream := job.NewColumnDownstream("job2", "proj", "store://table2", "customer")
+			service := new(lineageService)
+			service.On("GetColumnDownstreams", ctx, job.ResourceURN("store://table"), "customer_id").
+				Return([]*job.ColumnDownstream{downstream}, nil)
+			defer service.AssertExpectations(t)
+
+			handler := v1beta1.NewLineageHandler(logger, nil, service)
+
+			resp, err := handler.GetColumnLineage(ctx, &pb.GetColumnLineageRequest{
+				ProjectName: "proj",
+				ResourceUrn: "store://table",
+				Column:      "customer_id",
+			})
+			assert.NoError(t, err)
+			assert.Len(t, resp.GetDownstreams(), 1)
+			assert.Equal(t, "job2", resp.GetDownstreams()[0].GetJobName())
+			assert.Equal(t, "store://table2", resp.GetDownstreams()[0].GetDestination())
+			assert.Equal(t, "customer", resp.GetDownstreams()[0].GetTargetColumn())
+		})
+		t.Run("returns error when unable to get the downstreams of the column", func(t *testing.T) {
+			service := new(lineageService)
+			service.On("GetColumnDownstreams", ctx, job.ResourceURN("store://table"), "customer_id").
+				Return(nil, errors.New("unknown error"))
+			defer service.AssertExpectations(t)
+
+			handler := v1beta1.NewLineageHandler(logger, nil, service)
+
+			_, err := handler.GetColumnLineage(ctx, &pb.GetColumnLineageRequest{ResourceUrn: "store://table", Column: "customer_id"})
+			assert.EqualError(t, err, "rpc error: code = Internal desc = unknown error: unable to get lineage of column customer_id")
+		})
+	})
+}
+
+type lineageService struct {
+	mock.Mock
+}
+
+func (l *lineageService) GetLineage(ctx context.Context, urn job.ResourceURN, depth int) (*job.LineageGraph, error) {
+	args := l.Called(ctx, urn, depth)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*job.LineageGraph), args.Error(1)
+}
+
+func (l *lineageService) GetJobLineage(ctx context.Context, projectName tenant.ProjectName, jobName job.Name, depth int) (*job.LineageGraph, error) {
+	args := l.Called(ctx, projectName, jobName, depth)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*job.LineageGraph), args.Error(1)
+}
+
+func (l *lineageService) GetColumnDownstreams(ctx context.Context, source job.ResourceURN, column string) ([]*job.ColumnDownstream, error) {
+	args := l.Called(ctx, source, column)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*job.ColumnDownstream), args.Error(1)
+}
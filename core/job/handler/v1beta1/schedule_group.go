@@ -0,0 +1,160 @@
+package v1beta1
+
+import (
+	"context"
+	"time"
+
+	"github.com/goto/salt/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/goto/optimus/core/job"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+	"github.com/goto/optimus/internal/logging"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+type ScheduleGroupService interface {
+	Register(ctx context.Context, group *job.ScheduleGroup) error
+	GetAll(ctx context.Context, projectName tenant.ProjectName) ([]*job.ScheduleGroup, error)
+	Plan(ctx context.Context, projectName tenant.ProjectName, name string) (*job.SchedulePlan, error)
+	Apply(ctx context.Context, projectName tenant.ProjectName, name string) (*job.SchedulePlan, error)
+}
+
+type ScheduleGroupHandler struct {
+	l       log.Logger
+	service ScheduleGroupService
+
+	pb.UnimplementedScheduleGroupServiceServer
+}
+
+func (h *ScheduleGroupHandler) RegisterScheduleGroup(ctx context.Context, req *pb.RegisterScheduleGroupRequest) (*pb.RegisterScheduleGroupResponse, error) {
+	group, err := scheduleGroupFrom(req.GetProjectName(), req.GetGroup())
+	if err != nil {
+		h.l.Error("error adapting schedule group [%s]: %s", req.GetGroup().GetName(), err)
+		return nil, errors.GRPCErr(err, "unable to register schedule group "+req.GetGroup().GetName())
+	}
+
+	if err := h.service.Register(ctx, group); err != nil {
+		h.l.Error("error registering schedule group [%s]: %s", group.Name, err)
+		return nil, errors.GRPCErr(err, "unable to register schedule group "+group.Name)
+	}
+	return &pb.RegisterScheduleGroupResponse{}, nil
+}
+
+func (h *ScheduleGroupHandler) ListScheduleGroups(ctx context.Context, req *pb.ListScheduleGroupsRequest) (*pb.ListScheduleGroupsResponse, error) {
+	l := logging.ForTenant(h.l, req.GetProjectName(), "", "")
+	projectName, err := tenant.ProjectNameFrom(req.GetProjectName())
+	if err != nil {
+		l.Error("error adapting project name of schedule groups: %s", err)
+		return nil, errors.GRPCErr(err, "unable to list schedule groups")
+	}
+
+	groups, err := h.service.GetAll(ctx, projectName)
+	if err != nil {
+		l.Error("error getting schedule groups of project [%s]: %s", projectName, err)
+		return nil, errors.GRPCErr(err, "unable to list schedule groups of "+projectName.String())
+	}
+
+	groupsProto := make([]*pb.ScheduleGroup, len(groups))
+	for i, group := range groups {
+		jobNames := make([]string, len(group.JobNames))
+		for j, jobName := range group.JobNames {
+			jobNames[j] = jobName.String()
+		}
+		groupsProto[i] = &pb.ScheduleGroup{
+			Name:      group.Name,
+			Kind:      string(group.Kind),
+			JobNames:  jobNames,
+			MaxOffset: group.MaxOffset.String(),
+			Spacing:   group.Spacing.String(),
+			CreatedAt: timestamppb.New(group.CreatedAt),
+			UpdatedAt: timestamppb.New(group.UpdatedAt),
+		}
+	}
+	return &pb.ListScheduleGroupsResponse{Groups: groupsProto}, nil
+}
+
+// PlanScheduleGroup reports the schedule changes suggested for the jobs of the group, without changing the jobs
+func (h *ScheduleGroupHandler) PlanScheduleGroup(ctx context.Context, req *pb.ScheduleGroupPlanRequest) (*pb.ScheduleGroupPlanResponse, error) {
+	return h.plan(ctx, req, h.service.Plan)
+}
+
+// ApplyScheduleGroup updates the intervals of the jobs of the group as per its plan, and uploads them to the scheduler
+func (h *ScheduleGroupHandler) ApplyScheduleGroup(ctx context.Context, req *pb.ScheduleGroupPlanRequest) (*pb.ScheduleGroupPlanResponse, error) {
+	return h.plan(ctx, req, h.service.Apply)
+}
+
+func (h *ScheduleGroupHandler) plan(ctx context.Context, req *pb.ScheduleGroupPlanRequest,
+	planFn func(context.Context, tenant.ProjectName, string) (*job.SchedulePlan, error),
+) (*pb.ScheduleGroupPlanResponse, error) {
+	l := logging.ForTenant(h.l, req.GetProjectName(), "", "")
+	projectName, err := tenant.ProjectNameFrom(req.GetProjectName())
+	if err != nil {
+		l.Error("error adapting project name of schedule group: %s", err)
+		return nil, errors.GRPCErr(err, "unable to plan schedule group "+req.GetName())
+	}
+	if req.GetName() == "" {
+		return nil, errors.GRPCErr(errors.InvalidArgument(job.EntityScheduleGroup, "schedule group name is empty"),
+			"unable to plan schedule group")
+	}
+
+	plan, err := planFn(ctx, projectName, req.GetName())
+	if err != nil {
+		l.Error("error planning schedule group [%s]: %s", req.GetName(), err)
+		return nil, errors.GRPCErr(err, "unable to plan schedule group "+req.GetName())
+	}
+
+	changes := make([]*pb.SchedulePlan_Change, len(plan.Changes))
+	for i, change := range plan.Changes {
+		changes[i] = &pb.SchedulePlan_Change{
+			JobName:           change.JobName.String(),
+			Interval:          change.Interval,
+			SuggestedInterval: change.SuggestedInterval,
+			Offset:            change.Offset.String(),
+		}
+	}
+	return &pb.ScheduleGroupPlanResponse{Plan: &pb.SchedulePlan{
+		Group:      plan.GroupName,
+		Changes:    changes,
+		Unresolved: plan.Unresolved,
+	}}, nil
+}
+
+func scheduleGroupFrom(rawProjectName string, groupProto *pb.ScheduleGroup) (*job.ScheduleGroup, error) {
+	projectName, err := tenant.ProjectNameFrom(rawProjectName)
+	if err != nil {
+		return nil, err
+	}
+	kind, err := job.ScheduleGroupKindFrom(groupProto.GetKind())
+	if err != nil {
+		return nil, err
+	}
+	jobNames := make([]job.Name, len(groupProto.GetJobNames()))
+	for i, rawJobName := range groupProto.GetJobNames() {
+		jobName, err := job.NameFrom(rawJobName)
+		if err != nil {
+			return nil, err
+		}
+		jobNames[i] = jobName
+	}
+	maxOffset, err := time.ParseDuration(groupProto.GetMaxOffset())
+	if err != nil {
+		return nil, errors.InvalidArgument(job.EntityScheduleGroup, "invalid max_offset "+groupProto.GetMaxOffset())
+	}
+	var spacing time.Duration
+	if groupProto.GetSpacing() != "" {
+		spacing, err = time.ParseDuration(groupProto.GetSpacing())
+		if err != nil {
+			return nil, errors.InvalidArgument(job.EntityScheduleGroup, "invalid spacing "+groupProto.GetSpacing())
+		}
+	}
+	return job.NewScheduleGroup(projectName, groupProto.GetName(), kind, jobNames, maxOffset, spacing)
+}
+
+func NewScheduleGroupHandler(l log.Logger, service ScheduleGroupService) *ScheduleGroupHandler {
+	return &ScheduleGroupHandler{
+		l:       l,
+		service: service,
+	}
+}
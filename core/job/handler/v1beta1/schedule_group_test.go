@@ -0,0 +1,166 @@
+package v1beta1_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/goto/optimus/core/job"
+	"github.com/goto/optimus/core/job/handler/v1beta1"
+	"github.com/goto/optimus/core/tenant"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+func TestScheduleGroupHandler(t *testing.T) {
+	ctx := context.Background()
+	logger := log.NewNoop()
+	projectName := tenant.ProjectName("proj")
+
+	t.Run("RegisterScheduleGroup", func(t *testing.T) {
+		groupProto := &pb.ScheduleGroup{
+			Name:      "source-limit",
+			Kind:      "anti_affinity",
+			JobNames:  []string{"job-a", "job-b"},
+			MaxOffset: "1h",
+			Spacing:   "15m",
+		}
+
+		t.Run("returns error when max offset is invalid", func(t *testing.T) {
+			handler := v1beta1.NewScheduleGroupHandler(logger, new(scheduleGroupService))
+
+			_, err := handler.RegisterScheduleGroup(ctx, &pb.RegisterScheduleGroupRequest{
+				ProjectName: "proj",
+				Group:       &pb.ScheduleGroup{Name: "source-limit", Kind: "affinity", JobNames: []string{"job-a", "job-b"}, MaxOffset: "an hour"},
+			})
+			assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid argument for entity "+
+				job.EntityScheduleGroup+": invalid max_offset an hour: unable to register schedule group source-limit")
+		})
+		t.Run("returns error when unable to register the group", func(t *testing.T) {
+			service := new(scheduleGroupService)
+			service.On("Register", ctx, mock.Anything).Return(errors.New("unknown error"))
+			defer service.AssertExpectations(t)
+			handler := v1beta1.NewScheduleGroupHandler(logger, service)
+
+			_, err := handler.RegisterScheduleGroup(ctx, &pb.RegisterScheduleGroupRequest{ProjectName: "proj", Group: groupProto})
+			assert.EqualError(t, err, "rpc error: code = Internal desc = unknown error: unable to register schedule group source-limit")
+		})
+		t.Run("registers the group of the project", func(t *testing.T) {
+			service := new(scheduleGroupService)
+			service.On("Register", ctx, mock.MatchedBy(func(group *job.ScheduleGroup) bool {
+				return group.ProjectName == projectName && group.Name == "source-limit" &&
+					group.Kind == job.ScheduleGroupAntiAffinity && group.MaxOffset == time.Hour && group.Spacing == 15*time.Minute
+			})).Return(nil)
+			defer service.AssertExpectations(t)
+			handler := v1beta1.NewScheduleGroupHandler(logger, service)
+
+			_, err := handler.RegisterScheduleGroup(ctx, &pb.RegisterScheduleGroupRequest{ProjectName: "proj", Group: groupProto})
+			assert.NoError(t, err)
+		})
+	})
+	t.Run("ListScheduleGroups", func(t *testing.T) {
+		t.Run("returns the groups of the project", func(t *testing.T) {
+			group, err := job.NewScheduleGroup(projectName, "source-limit", job.ScheduleGroupAffinity,
+				[]job.Name{"job-a", "job-b"}, time.Hour, 0)
+			assert.NoError(t, err)
+
+			service := new(scheduleGroupService)
+			service.On("GetAll", ctx, projectName).Return([]*job.ScheduleGroup{group}, nil)
+			defer service.AssertExpectations(t)
+			handler := v1beta1.NewScheduleGroupHandler(logger, service)
+
+			resp, err := handler.ListScheduleGroups(ctx, &pb.ListScheduleGroupsRequest{ProjectName: "proj"})
+			assert.NoError(t, err)
+			assert.Len(t, resp.GetGroups(), 1)
+			assert.Equal(t, "affinity", resp.GetGroups()[0].GetKind())
+			assert.Equal(t, []string{"job-a", "job-b"}, resp.GetGroups()[0].GetJobNames())
+			assert.Equal(t, "1h0m0s", resp.GetGroups()[0].GetMaxOffset())
+		})
+	})
+	t.Run("PlanScheduleGroup", func(t *testing.T) {
+		t.Run("returns error when the group name is empty", func(t *testing.T) {
+			handler := v1beta1.NewScheduleGroupHandler(logger, new(scheduleGroupService))
+
+			_, err := handler.PlanScheduleGroup(ctx, &pb.ScheduleGroupPlanRequest{ProjectName: "proj"})
+			assert.ErrorContains(t, err, "code = InvalidArgument")
+			assert.ErrorContains(t, err, "schedule group name is empty")
+		})
+		t.Run("returns the plan of the group", func(t *testing.T) {
+			service := new(scheduleGroupService)
+			service.On("Plan", ctx, projectName, "source-limit").Return(&job.SchedulePlan{
+				GroupName: "source-limit",
+				Changes: []*job.ScheduleChange{
+					{JobName: "job-b", Interval: "0 1 * * *", SuggestedInterval: "15 1 * * *", Offset: 15 * time.Minute},
+				},
+				Unresolved: []string{"job-c runs every 5 minutes"},
+			}, nil)
+			defer service.AssertExpectations(t)
+			handler := v1beta1.NewScheduleGroupHandler(logger, service)
+
+			resp, err := handler.PlanScheduleGroup(ctx, &pb.ScheduleGroupPlanRequest{ProjectName: "proj", Name: "source-limit"})
+			assert.NoError(t, err)
+			assert.Equal(t, "source-limit", resp.GetPlan().GetGroup())
+			assert.Len(t, resp.GetPlan().GetChanges(), 1)
+			assert.Equal(t, "15 1 * * *", resp.GetPlan().GetChanges()[0].GetSuggestedInterval())
+			assert.Equal(t, "15m0s", resp.GetPlan().GetChanges()[0].GetOffset())
+			assert.Equal(t, []string{"job-c runs every 5 minutes"}, resp.GetPlan().GetUnresolved())
+		})
+	})
+	t.Run("ApplyScheduleGroup", func(t *testing.T) {
+		t.Run("returns error when unable to apply the plan", func(t *testing.T) {
+			service := new(scheduleGroupService)
+			service.On("Apply", ctx, projectName, "source-limit").Return(nil, errors.New("unknown error"))
+			defer service.AssertExpectations(t)
+			handler := v1beta1.NewScheduleGroupHandler(logger, service)
+
+			_, err := handler.ApplyScheduleGroup(ctx, &pb.ScheduleGroupPlanRequest{ProjectName: "proj", Name: "source-limit"})
+			assert.EqualError(t, err, "rpc error: code = Internal desc = unknown error: unable to plan schedule group source-limit")
+		})
+		t.Run("applies the plan of the group", func(t *testing.T) {
+			service := new(scheduleGroupService)
+			service.On("Apply", ctx, projectName, "source-limit").Return(&job.SchedulePlan{GroupName: "source-limit"}, nil)
+			defer service.AssertExpectations(t)
+			handler := v1beta1.NewScheduleGroupHandler(logger, service)
+
+			resp, err := handler.ApplyScheduleGroup(ctx, &pb.ScheduleGroupPlanRequest{ProjectName: "proj", Name: "source-limit"})
+			assert.NoError(t, err)
+			assert.Equal(t, "source-limit", resp.GetPlan().GetGroup())
+		})
+	})
+}
+
+type scheduleGroupService struct {
+	mock.Mock
+}
+
+func (s *scheduleGroupService) Register(ctx context.Context, group *job.ScheduleGroup) error {
+	return s.Called(ctx, group).Error(0)
+}
+
+func (s *scheduleGroupService) GetAll(ctx context.Context, projectName tenant.ProjectName) ([]*job.ScheduleGroup, error) {
+	args := s.Called(ctx, projectName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*job.ScheduleGroup), args.Error(1)
+}
+
+func (s *scheduleGroupService) Plan(ctx context.Context, projectName tenant.ProjectName, name string) (*job.SchedulePlan, error) {
+	args := s.Called(ctx, projectName, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*job.SchedulePlan), args.Error(1)
+}
+
+func (s *scheduleGroupService) Apply(ctx context.Context, projectName tenant.ProjectName, name string) (*job.SchedulePlan, error) {
+	args := s.Called(ctx, projectName, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*job.SchedulePlan), args.Error(1)
+}
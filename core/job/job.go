@@ -37,6 +37,8 @@ type Job struct {
 
 	destination ResourceURN
 	sources     []ResourceURN
+
+	columnLineages []*ColumnLineage
 }
 
 func (j *Job) Tenant() tenant.Tenant {
@@ -116,6 +118,17 @@ func (j *Job) Sources() []ResourceURN {
 	return j.sources
 }
 
+func (j *Job) ColumnLineages() []*ColumnLineage {
+	return j.columnLineages
+}
+
+// WithColumnLineages returns a copy of the job carrying the column lineages of its destination
+func (j *Job) WithColumnLineages(columnLineages []*ColumnLineage) *Job {
+	jobWithColumnLineages := *j
+	jobWithColumnLineages.columnLineages = columnLineages
+	return &jobWithColumnLineages
+}
+
 func (j *Job) StaticUpstreamNames() []SpecUpstreamName {
 	if j.spec.upstreamSpec == nil {
 		return nil
@@ -266,6 +279,9 @@ func (w WithUpstreams) MergeWithResolvedUpstreams(resolvedUpstreamsBySubjectJobM
 	return jobsWithMergedUpstream
 }
 
+// UpstreamHostBigQuery is the host of the external upstreams on bigquery tables not managed by any optimus
+const UpstreamHostBigQuery = "bigquery"
+
 type Upstream struct {
 	name     Name
 	host     string
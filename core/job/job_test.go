@@ -404,6 +404,27 @@ func TestEntityJob(t *testing.T) {
 		})
 	})
 
+	t.Run("SplitByConsent", func(t *testing.T) {
+		t.Run("should separate consented downstream from the blocking ones", func(t *testing.T) {
+			downstreamA := job.NewDownstream(specA.Name(), project.Name(), namespace.Name(), jobTask.Name())
+			downstreamB := job.NewDownstream(specB.Name(), project.Name(), namespace.Name(), jobTask.Name())
+			consent, err := job.NewDeletionConsent(project.Name(), "job-C", project.Name(), specB.Name(), "owner@example.com", "")
+			assert.NoError(t, err)
+
+			consented, blocking := job.DownstreamList([]*job.Downstream{downstreamA, downstreamB}).SplitByConsent([]*job.DeletionConsent{consent})
+			assert.EqualValues(t, job.FullNames{"test-proj/job-B"}, consented)
+			assert.EqualValues(t, job.FullNames{"test-proj/job-A"}, blocking)
+		})
+	})
+
+	t.Run("NewDeletionConsent", func(t *testing.T) {
+		t.Run("should return error if consent giver is empty", func(t *testing.T) {
+			consent, err := job.NewDeletionConsent(project.Name(), "job-A", project.Name(), "job-B", "", "not used anymore")
+			assert.ErrorContains(t, err, "consent of deleting job job-A requires the consent giver")
+			assert.Nil(t, consent)
+		})
+	})
+
 	t.Run("GetJobWithUnresolvedUpstream", func(t *testing.T) {
 		t.Run("should contains error when get static upstream failed because of job name empty", func(t *testing.T) {
 			jobTaskPython := job.NewTask("python", jobTaskConfig)
@@ -0,0 +1,64 @@
+package job
+
+const (
+	LineageNodeJob      = "job"
+	LineageNodeResource = "resource"
+)
+
+// LineageNode is either a job, named by its full name, or a resource, named by its urn
+type LineageNode struct {
+	ID   string
+	Type string
+	Name string
+}
+
+// LineageEdge points from where the data is read to where it is written: from a resource to the job reading it,
+// and from a job to its destination
+type LineageEdge struct {
+	From string
+	To   string
+}
+
+// LineageGraph is the graph of jobs and resources inferred from the sources and destinations of the jobs
+type LineageGraph struct {
+	Nodes []*LineageNode
+	Edges []*LineageEdge
+
+	nodeIDs map[string]bool
+	edges   map[LineageEdge]bool
+}
+
+func NewLineageGraph() *LineageGraph {
+	return &LineageGraph{
+		nodeIDs: map[string]bool{},
+		edges:   map[LineageEdge]bool{},
+	}
+}
+
+// AddJob adds the job to the graph once, and returns the id of its node
+func (g *LineageGraph) AddJob(j *Job) string {
+	return g.addNode(LineageNodeJob, j.FullName())
+}
+
+// AddResource adds the resource to the graph once, and returns the id of its node
+func (g *LineageGraph) AddResource(urn ResourceURN) string {
+	return g.addNode(LineageNodeResource, urn.String())
+}
+
+func (g *LineageGraph) AddEdge(from, to string) {
+	edge := LineageEdge{From: from, To: to}
+	if g.edges[edge] {
+		return
+	}
+	g.edges[edge] = true
+	g.Edges = append(g.Edges, &edge)
+}
+
+func (g *LineageGraph) addNode(nodeType, name string) string {
+	id := nodeType + ":" + name
+	if !g.nodeIDs[id] {
+		g.nodeIDs[id] = true
+		g.Nodes = append(g.Nodes, &LineageNode{ID: id, Type: nodeType, Name: name})
+	}
+	return id
+}
@@ -0,0 +1,9 @@
+package job
+
+// ResolvedUpstreams are the upstream resources and column lineages generated by the task plugin of a job, along with
+// the hash of the inputs they are generated from, so they are generated again only when the inputs change
+type ResolvedUpstreams struct {
+	Hash           string
+	Sources        []ResourceURN
+	ColumnLineages []*ColumnLineage
+}
@@ -28,6 +28,12 @@ func NewExternalUpstreamResolver(resourceManagerConfigs []config.ResourceManager
 				return nil, err
 			}
 			optimusResourceManagers = append(optimusResourceManagers, getter)
+		case "bigquery":
+			getter, err := resourcemanager.NewBigQueryResourceManager(conf)
+			if err != nil {
+				return nil, err
+			}
+			optimusResourceManagers = append(optimusResourceManagers, getter)
 		default:
 			return nil, fmt.Errorf("resource manager %s is not recognized", conf.Type)
 		}
@@ -0,0 +1,246 @@
+package job
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+)
+
+const (
+	EntityScheduleGroup = "schedule_group"
+
+	// ScheduleGroupAntiAffinity groups jobs which must not run concurrently, like jobs sharing the rate limits of a source
+	ScheduleGroupAntiAffinity ScheduleGroupKind = "anti_affinity"
+	// ScheduleGroupAffinity groups jobs which should run together
+	ScheduleGroupAffinity ScheduleGroupKind = "affinity"
+
+	minJobsInScheduleGroup = 2
+	cronFields             = 5
+	minutesInHour          = 60
+	minutesInDay           = 24 * minutesInHour
+)
+
+type ScheduleGroupKind string
+
+func ScheduleGroupKindFrom(kind string) (ScheduleGroupKind, error) {
+	switch ScheduleGroupKind(kind) {
+	case ScheduleGroupAntiAffinity, ScheduleGroupAffinity:
+		return ScheduleGroupKind(kind), nil
+	default:
+		return "", errors.InvalidArgument(EntityScheduleGroup, "unknown schedule group kind "+kind)
+	}
+}
+
+// ScheduleGroup is a scheduling hint on a set of jobs of a project. The schedules of the jobs are only ever delayed,
+// by at most MaxOffset, to space the runs of anti affinity groups by Spacing, or to align the runs of affinity groups
+type ScheduleGroup struct {
+	ProjectName tenant.ProjectName
+	Name        string
+	Kind        ScheduleGroupKind
+	JobNames    []Name
+
+	MaxOffset time.Duration
+	Spacing   time.Duration
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func NewScheduleGroup(projectName tenant.ProjectName, name string, kind ScheduleGroupKind, jobNames []Name, maxOffset, spacing time.Duration) (*ScheduleGroup, error) {
+	if name == "" {
+		return nil, errors.InvalidArgument(EntityScheduleGroup, "schedule group name is empty")
+	}
+	if len(jobNames) < minJobsInScheduleGroup {
+		return nil, errors.InvalidArgument(EntityScheduleGroup, "schedule group "+name+" requires at least two jobs")
+	}
+	if maxOffset < time.Minute || maxOffset >= 24*time.Hour {
+		return nil, errors.InvalidArgument(EntityScheduleGroup, "max offset of schedule group "+name+" should be from a minute to less than a day")
+	}
+	if kind == ScheduleGroupAntiAffinity && spacing < time.Minute {
+		return nil, errors.InvalidArgument(EntityScheduleGroup, "anti affinity schedule group "+name+" requires a spacing of at least a minute")
+	}
+	return &ScheduleGroup{
+		ProjectName: projectName,
+		Name:        name,
+		Kind:        kind,
+		JobNames:    jobNames,
+		MaxOffset:   maxOffset,
+		Spacing:     spacing,
+	}, nil
+}
+
+// ScheduleChange is a delay suggested for the schedule of a job
+type ScheduleChange struct {
+	JobName           Name
+	Interval          string
+	SuggestedInterval string
+	Offset            time.Duration
+}
+
+// SchedulePlan holds the changes suggested for the jobs of a schedule group, along with the reasons for
+// the jobs whose schedule could not be fit within the bounds of the group
+type SchedulePlan struct {
+	GroupName  string
+	Changes    []*ScheduleChange
+	Unresolved []string
+}
+
+// Plan suggests the schedule changes for the jobs of the group, given the current schedule interval of every job.
+// Only schedules running at a fixed minute of every hour, or at a fixed minute and hour, are supported.
+func (g *ScheduleGroup) Plan(intervals map[Name]string) *SchedulePlan {
+	plan := &SchedulePlan{GroupName: g.Name}
+
+	var slots []*scheduleSlot
+	for _, jobName := range g.JobNames {
+		interval, ok := intervals[jobName]
+		if !ok {
+			plan.Unresolved = append(plan.Unresolved, fmt.Sprintf("job %s is not found", jobName))
+			continue
+		}
+		slot, err := scheduleSlotFrom(jobName, interval)
+		if err != nil {
+			plan.Unresolved = append(plan.Unresolved, fmt.Sprintf("job %s: %s", jobName, err))
+			continue
+		}
+		slots = append(slots, slot)
+	}
+	if len(slots) < minJobsInScheduleGroup {
+		return plan
+	}
+	for _, slot := range slots[1:] {
+		if slot.hourly != slots[0].hourly {
+			plan.Unresolved = append(plan.Unresolved, "jobs of the group do not run at the same frequency")
+			return plan
+		}
+	}
+
+	sort.SliceStable(slots, func(i, j int) bool {
+		if slots[i].start == slots[j].start {
+			return slots[i].jobName < slots[j].jobName
+		}
+		return slots[i].start < slots[j].start
+	})
+
+	maxOffset := int(g.MaxOffset / time.Minute)
+	if g.Kind == ScheduleGroupAffinity {
+		target := slots[len(slots)-1].start
+		for _, slot := range slots {
+			g.addChange(plan, slot, target-slot.start, maxOffset)
+		}
+		return plan
+	}
+
+	spacing := int(g.Spacing / time.Minute)
+	previous := slots[0]
+	previousStart := previous.start
+	for _, slot := range slots[1:] {
+		desired := slot.start
+		if desired < previousStart+spacing {
+			desired = previousStart + spacing
+		}
+		offset := desired - slot.start
+		if offset > maxOffset {
+			plan.Unresolved = append(plan.Unresolved, fmt.Sprintf("job %s needs a delay of %s to run %s after %s, more than the max offset of %s",
+				slot.jobName, time.Duration(offset)*time.Minute, g.Spacing, previous.jobName, g.MaxOffset))
+			desired = slot.start
+		} else if !g.addChange(plan, slot, offset, maxOffset) {
+			desired = slot.start
+		}
+		if desired >= previousStart {
+			previous, previousStart = slot, desired
+		}
+	}
+	return plan
+}
+
+// addChange adds the change delaying the job by offset minutes to the plan, and tells whether the delay is possible
+func (g *ScheduleGroup) addChange(plan *SchedulePlan, slot *scheduleSlot, offset, maxOffset int) bool {
+	if offset == 0 {
+		return true
+	}
+	if offset > maxOffset {
+		plan.Unresolved = append(plan.Unresolved, fmt.Sprintf("job %s needs a delay of %s to run with the group, more than the max offset of %s",
+			slot.jobName, time.Duration(offset)*time.Minute, g.MaxOffset))
+		return false
+	}
+	suggested, err := slot.delayedBy(offset)
+	if err != nil {
+		plan.Unresolved = append(plan.Unresolved, fmt.Sprintf("job %s: %s", slot.jobName, err))
+		return false
+	}
+	plan.Changes = append(plan.Changes, &ScheduleChange{
+		JobName:           slot.jobName,
+		Interval:          slot.interval,
+		SuggestedInterval: suggested,
+		Offset:            time.Duration(offset) * time.Minute,
+	})
+	return true
+}
+
+// scheduleSlot is the start of the runs of a job, in minutes from the start of the hour for hourly
+// schedules and from the start of the day otherwise
+type scheduleSlot struct {
+	jobName  Name
+	interval string
+
+	hourly    bool
+	start     int
+	dayFields []string
+}
+
+func scheduleSlotFrom(jobName Name, interval string) (*scheduleSlot, error) {
+	expression := interval
+	switch interval {
+	case "@hourly":
+		expression = "0 * * * *"
+	case "@daily", "@midnight":
+		expression = "0 0 * * *"
+	}
+
+	fields := strings.Fields(expression)
+	if len(fields) != cronFields {
+		return nil, fmt.Errorf("schedule %s is not supported, only cron expressions with a fixed minute and hour are", interval)
+	}
+	minute, err := strconv.Atoi(fields[0])
+	if err != nil || minute < 0 || minute >= minutesInHour {
+		return nil, fmt.Errorf("schedule %s is not supported, only cron expressions with a fixed minute are", interval)
+	}
+
+	slot := &scheduleSlot{jobName: jobName, interval: interval, dayFields: fields[2:]}
+	if fields[1] == "*" {
+		slot.hourly = true
+		slot.start = minute
+		return slot, nil
+	}
+	hour, err := strconv.Atoi(fields[1])
+	if err != nil || hour < 0 || hour >= 24 {
+		return nil, fmt.Errorf("schedule %s is not supported, only cron expressions with a fixed or every hour are", interval)
+	}
+	slot.start = hour*minutesInHour + minute
+	return slot, nil
+}
+
+func (s *scheduleSlot) delayedBy(offset int) (string, error) {
+	start := s.start + offset
+	if s.hourly {
+		if start >= minutesInHour {
+			return "", fmt.Errorf("a delay of %s moves the hourly schedule %s to the next hour", time.Duration(offset)*time.Minute, s.interval)
+		}
+		return strings.Join(append([]string{strconv.Itoa(start), "*"}, s.dayFields...), " "), nil
+	}
+
+	if start >= minutesInDay {
+		for _, field := range s.dayFields {
+			if field != "*" {
+				return "", fmt.Errorf("a delay of %s moves the schedule %s to the next day", time.Duration(offset)*time.Minute, s.interval)
+			}
+		}
+		start -= minutesInDay
+	}
+	return strings.Join(append([]string{strconv.Itoa(start % minutesInHour), strconv.Itoa(start / minutesInHour)}, s.dayFields...), " "), nil
+}
@@ -0,0 +1,120 @@
+package job_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/core/job"
+	"github.com/goto/optimus/core/tenant"
+)
+
+func TestScheduleGroup(t *testing.T) {
+	projectName := tenant.ProjectName("sample-project")
+	jobNames := []job.Name{"job-a", "job-b", "job-c"}
+
+	t.Run("NewScheduleGroup", func(t *testing.T) {
+		t.Run("returns error when group has less than two jobs", func(t *testing.T) {
+			_, err := job.NewScheduleGroup(projectName, "source-limit", job.ScheduleGroupAntiAffinity, []job.Name{"job-a"}, time.Hour, 10*time.Minute)
+			assert.EqualError(t, err, "invalid argument for entity schedule_group: schedule group source-limit requires at least two jobs")
+		})
+		t.Run("returns error when max offset is out of bounds", func(t *testing.T) {
+			_, err := job.NewScheduleGroup(projectName, "source-limit", job.ScheduleGroupAntiAffinity, jobNames, 24*time.Hour, 10*time.Minute)
+			assert.ErrorContains(t, err, "max offset of schedule group source-limit should be from a minute to less than a day")
+		})
+		t.Run("returns error when anti affinity group has no spacing", func(t *testing.T) {
+			_, err := job.NewScheduleGroup(projectName, "source-limit", job.ScheduleGroupAntiAffinity, jobNames, time.Hour, 0)
+			assert.ErrorContains(t, err, "anti affinity schedule group source-limit requires a spacing of at least a minute")
+		})
+		t.Run("returns affinity group without spacing", func(t *testing.T) {
+			group, err := job.NewScheduleGroup(projectName, "co-run", job.ScheduleGroupAffinity, jobNames, time.Hour, 0)
+			assert.NoError(t, err)
+			assert.Equal(t, job.ScheduleGroupAffinity, group.Kind)
+		})
+	})
+	t.Run("ScheduleGroupKindFrom", func(t *testing.T) {
+		_, err := job.ScheduleGroupKindFrom("together")
+		assert.EqualError(t, err, "invalid argument for entity schedule_group: unknown schedule group kind together")
+	})
+	t.Run("Plan", func(t *testing.T) {
+		t.Run("spaces the runs of anti affinity group", func(t *testing.T) {
+			group, err := job.NewScheduleGroup(projectName, "source-limit", job.ScheduleGroupAntiAffinity, jobNames, time.Hour, 15*time.Minute)
+			assert.NoError(t, err)
+
+			plan := group.Plan(map[job.Name]string{
+				"job-a": "0 2 * * *",
+				"job-b": "@daily",
+				"job-c": "5 2 * * *",
+			})
+			assert.Empty(t, plan.Unresolved)
+			assert.Equal(t, []*job.ScheduleChange{
+				{JobName: "job-c", Interval: "5 2 * * *", SuggestedInterval: "15 2 * * *", Offset: 10 * time.Minute},
+			}, plan.Changes)
+		})
+		t.Run("reports the jobs which cannot be spaced within the max offset", func(t *testing.T) {
+			group, err := job.NewScheduleGroup(projectName, "source-limit", job.ScheduleGroupAntiAffinity, jobNames, 20*time.Minute, 15*time.Minute)
+			assert.NoError(t, err)
+
+			plan := group.Plan(map[job.Name]string{
+				"job-a": "0 2 * * *",
+				"job-b": "0 2 * * *",
+				"job-c": "0 2 * * *",
+			})
+			assert.Equal(t, []*job.ScheduleChange{
+				{JobName: "job-b", Interval: "0 2 * * *", SuggestedInterval: "15 2 * * *", Offset: 15 * time.Minute},
+			}, plan.Changes)
+			assert.Equal(t, []string{"job job-c needs a delay of 30m0s to run 15m0s after job-b, more than the max offset of 20m0s"}, plan.Unresolved)
+		})
+		t.Run("aligns the runs of affinity group to the latest run", func(t *testing.T) {
+			group, err := job.NewScheduleGroup(projectName, "co-run", job.ScheduleGroupAffinity, jobNames, time.Hour, 0)
+			assert.NoError(t, err)
+
+			plan := group.Plan(map[job.Name]string{
+				"job-a": "50 23 * * *",
+				"job-b": "40 23 * * *",
+				"job-c": "30 22 * * *",
+			})
+			assert.Equal(t, []*job.ScheduleChange{
+				{JobName: "job-b", Interval: "40 23 * * *", SuggestedInterval: "50 23 * * *", Offset: 10 * time.Minute},
+			}, plan.Changes)
+			assert.Equal(t, []string{"job job-c needs a delay of 1h20m0s to run with the group, more than the max offset of 1h0m0s"}, plan.Unresolved)
+		})
+		t.Run("moves daily runs to the next day only when every day is scheduled", func(t *testing.T) {
+			group, err := job.NewScheduleGroup(projectName, "source-limit", job.ScheduleGroupAntiAffinity, jobNames[:2], time.Hour, 10*time.Minute)
+			assert.NoError(t, err)
+
+			plan := group.Plan(map[job.Name]string{"job-a": "55 23 * * *", "job-b": "55 23 * * *"})
+			assert.Equal(t, []*job.ScheduleChange{
+				{JobName: "job-b", Interval: "55 23 * * *", SuggestedInterval: "5 0 * * *", Offset: 10 * time.Minute},
+			}, plan.Changes)
+
+			plan = group.Plan(map[job.Name]string{"job-a": "55 23 * * 1", "job-b": "55 23 * * 1"})
+			assert.Empty(t, plan.Changes)
+			assert.Equal(t, []string{"job job-b: a delay of 10m0s moves the schedule 55 23 * * 1 to the next day"}, plan.Unresolved)
+		})
+		t.Run("reports jobs not found or with unsupported schedules", func(t *testing.T) {
+			group, err := job.NewScheduleGroup(projectName, "source-limit", job.ScheduleGroupAntiAffinity, jobNames, time.Hour, 10*time.Minute)
+			assert.NoError(t, err)
+
+			plan := group.Plan(map[job.Name]string{"job-a": "0 2 * * *", "job-b": "*/5 * * * *"})
+			assert.Empty(t, plan.Changes)
+			assert.Equal(t, []string{
+				"job job-b: schedule */5 * * * * is not supported, only cron expressions with a fixed minute are",
+				"job job-c is not found",
+			}, plan.Unresolved)
+		})
+		t.Run("reports groups mixing hourly and daily jobs", func(t *testing.T) {
+			group, err := job.NewScheduleGroup(projectName, "co-run", job.ScheduleGroupAffinity, jobNames, time.Hour, 0)
+			assert.NoError(t, err)
+
+			plan := group.Plan(map[job.Name]string{
+				"job-a": "0 2 * * *",
+				"job-b": "10 * * * *",
+				"job-c": "0 3 * * *",
+			})
+			assert.Empty(t, plan.Changes)
+			assert.Equal(t, []string{"jobs of the group do not run at the same frequency"}, plan.Unresolved)
+		})
+	})
+}
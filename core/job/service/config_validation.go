@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/goto/optimus/core/job"
+	"github.com/goto/optimus/internal/errors"
+	"github.com/goto/optimus/sdk/plugin"
+)
+
+// ValidateConfigs checks the task config and the hook configs of the spec against the config schemas provided by
+// their plugins. Plugins without a config schema accept any config.
+func (p JobPluginService) ValidateConfigs(_ context.Context, spec *job.Spec) error {
+	taskPlugin, err := p.pluginRepo.GetByNameAndVersion(spec.Task().Name().String(), spec.Task().Version())
+	if err != nil {
+		p.logger.Error("error getting plugin [%s]: %s", spec.Task().Name().String(), err)
+		return err
+	}
+
+	me := errors.NewMultiError("config validation errors")
+	if schema := configSchemaOf(taskPlugin); schema != nil {
+		if err := schema.ValidateConfig(spec.Task().Config().Map()); err != nil {
+			me.Append(fmt.Errorf("task config: %w", err))
+		}
+	}
+
+	for _, hook := range spec.Hooks() {
+		hookPlugin, err := p.pluginRepo.GetByNameAndVersion(hook.Name(), "")
+		if err != nil {
+			p.logger.Error("error getting plugin [%s]: %s", hook.Name(), err)
+			me.Append(fmt.Errorf("hook %s: %w", hook.Name(), err))
+			continue
+		}
+		if schema := configSchemaOf(hookPlugin); schema != nil {
+			if err := schema.ValidateConfig(hook.Config().Map()); err != nil {
+				me.Append(fmt.Errorf("hook %s config: %w", hook.Name(), err))
+			}
+		}
+	}
+	return me.ToErr()
+}
+
+func configSchemaOf(p *plugin.Plugin) *plugin.ConfigSchema {
+	if info := p.Info(); info != nil {
+		return info.ConfigSchema
+	}
+	return nil
+}
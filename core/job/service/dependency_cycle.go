@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/goto/optimus/core/job"
+	"github.com/goto/optimus/internal/errors"
+	"github.com/goto/optimus/internal/writer"
+)
+
+const cyclePathSeparator = " → "
+
+type dependencyVisitState int
+
+const (
+	dependencyUnvisited dependencyVisitState = iota
+	dependencyVisiting
+	dependencyVisited
+)
+
+// dependencyCycleFinder walks the upstream graph starting from the jobs being deployed. Jobs which are not part of
+// the deployment are expanded using their persisted upstreams, so cycles passing through existing jobs are found too.
+type dependencyCycleFinder struct {
+	upstreamRepo UpstreamRepository
+
+	upstreamsByJob map[string][]*job.Upstream
+	states         map[string]dependencyVisitState
+	path           []string
+	cycles         [][]string
+}
+
+func newDependencyCycleFinder(upstreamRepo UpstreamRepository, jobsWithUpstreams []*job.WithUpstream) *dependencyCycleFinder {
+	upstreamsByJob := make(map[string][]*job.Upstream, len(jobsWithUpstreams))
+	for _, jobWithUpstreams := range jobsWithUpstreams {
+		upstreamsByJob[jobWithUpstreams.Job().FullName()] = jobWithUpstreams.GetResolvedUpstreams()
+	}
+	return &dependencyCycleFinder{
+		upstreamRepo:   upstreamRepo,
+		upstreamsByJob: upstreamsByJob,
+		states:         make(map[string]dependencyVisitState),
+	}
+}
+
+func (d *dependencyCycleFinder) find(ctx context.Context, jobsWithUpstreams []*job.WithUpstream) ([][]string, error) {
+	for _, jobWithUpstreams := range jobsWithUpstreams {
+		if err := d.visit(ctx, jobWithUpstreams.Job().FullName(), jobWithUpstreams.GetResolvedUpstreams()); err != nil {
+			return nil, err
+		}
+	}
+	return d.cycles, nil
+}
+
+func (d *dependencyCycleFinder) visit(ctx context.Context, fullName string, upstreams []*job.Upstream) error {
+	switch d.states[fullName] {
+	case dependencyVisited:
+		return nil
+	case dependencyVisiting:
+		d.recordCycle(fullName)
+		return nil
+	}
+
+	d.states[fullName] = dependencyVisiting
+	d.path = append(d.path, fullName)
+	for _, upstream := range upstreams {
+		if upstream.External() || upstream.State() != job.UpstreamStateResolved {
+			continue
+		}
+		upstreamsOfUpstream, err := d.getUpstreams(ctx, upstream)
+		if err != nil {
+			return err
+		}
+		if err := d.visit(ctx, upstream.FullName(), upstreamsOfUpstream); err != nil {
+			return err
+		}
+	}
+	d.path = d.path[:len(d.path)-1]
+	d.states[fullName] = dependencyVisited
+	return nil
+}
+
+func (d *dependencyCycleFinder) getUpstreams(ctx context.Context, upstream *job.Upstream) ([]*job.Upstream, error) {
+	if upstreams, ok := d.upstreamsByJob[upstream.FullName()]; ok {
+		return upstreams, nil
+	}
+	if d.states[upstream.FullName()] != dependencyUnvisited {
+		return nil, nil
+	}
+
+	upstreams, err := d.upstreamRepo.GetUpstreams(ctx, upstream.ProjectName(), upstream.Name())
+	if err != nil {
+		return nil, err
+	}
+	d.upstreamsByJob[upstream.FullName()] = upstreams
+	return upstreams, nil
+}
+
+func (d *dependencyCycleFinder) recordCycle(fullName string) {
+	for i := len(d.path) - 1; i >= 0; i-- {
+		if d.path[i] != fullName {
+			continue
+		}
+		cycle := make([]string, 0, len(d.path)-i+1)
+		cycle = append(cycle, d.path[i:]...)
+		cycle = append(cycle, fullName)
+		d.cycles = append(d.cycles, cycle)
+		return
+	}
+}
+
+// findCyclicJobs reports every circular dependency among the resolved upstreams along with the full path of the
+// cycle, and returns the jobs taking part in one as the scheduler can never satisfy their dependencies
+func (j *JobService) findCyclicJobs(ctx context.Context, jobsWithUpstreams []*job.WithUpstream, logWriter writer.LogWriter) (map[string]bool, error) {
+	cycles, err := newDependencyCycleFinder(j.upstreamRepo, jobsWithUpstreams).find(ctx, jobsWithUpstreams)
+	if err != nil {
+		j.logger.Error("error checking circular dependencies: %s", err)
+		return nil, err
+	}
+
+	me := errors.NewMultiError("circular dependency errors")
+	cyclicJobs := make(map[string]bool)
+	for _, cycle := range cycles {
+		for _, fullName := range cycle {
+			cyclicJobs[fullName] = true
+		}
+		cycleErr := errors.NewError(errors.ErrFailedPrecond, job.EntityJob, "circular dependency detected: "+strings.Join(cycle, cyclePathSeparator))
+		logWriter.Write(writer.LogLevelError, fmt.Sprintf("[%s] %s", cycle[0], cycleErr.Error()))
+		me.Append(cycleErr)
+	}
+	return cyclicJobs, me.ToErr()
+}
+
+func withoutCyclicJobs(jobs []*job.Job, cyclicJobs map[string]bool) []*job.Job {
+	if len(cyclicJobs) == 0 {
+		return jobs
+	}
+	var acyclicJobs []*job.Job
+	for _, subjectJob := range jobs {
+		if !cyclicJobs[subjectJob.FullName()] {
+			acyclicJobs = append(acyclicJobs, subjectJob)
+		}
+	}
+	return acyclicJobs
+}
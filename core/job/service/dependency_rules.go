@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/goto/optimus/sdk/plugin"
+)
+
+// ruleDependencyMod resolves the dependencies of the jobs through the dependency rules declared by a yaml plugin
+type ruleDependencyMod struct {
+	name   string
+	rules  *plugin.DependencyRules
+	engine Engine
+}
+
+func (r ruleDependencyMod) GetName(context.Context) (string, error) {
+	return r.name, nil
+}
+
+func (r ruleDependencyMod) GenerateDestination(_ context.Context, req plugin.GenerateDestinationRequest) (*plugin.GenerateDestinationResponse, error) {
+	configs := make(map[string]any, len(req.Config))
+	for _, config := range req.Config {
+		configs[config.Name] = config.Value
+	}
+
+	destination, err := r.engine.CompileString(r.rules.Destination.Name, configs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile destination rule of plugin %s: %w", r.name, err)
+	}
+	return &plugin.GenerateDestinationResponse{
+		Destination: destination,
+		Type:        r.rules.Destination.Type,
+	}, nil
+}
+
+func (r ruleDependencyMod) GenerateDependencies(_ context.Context, req plugin.GenerateDependenciesRequest) (*plugin.GenerateDependenciesResponse, error) {
+	// assets are applied in the order of their names, for the dependencies to be stable across deployments
+	assets := append(plugin.Assets{}, req.Assets...)
+	sort.Slice(assets, func(i, j int) bool { return assets[i].Name < assets[j].Name })
+
+	var dependencies []string
+	seen := map[string]bool{}
+	for _, rule := range r.rules.Upstreams {
+		pattern, err := regexp.Compile(rule.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid upstream rule pattern of plugin %s: %w", r.name, err)
+		}
+		for _, asset := range assets {
+			if !rule.AppliesTo(asset.Name) {
+				continue
+			}
+			for _, submatches := range pattern.FindAllStringSubmatchIndex(asset.Value, -1) {
+				urn := string(pattern.ExpandString(nil, rule.URN, asset.Value, submatches))
+				if seen[urn] {
+					continue
+				}
+				seen[urn] = true
+				dependencies = append(dependencies, urn)
+			}
+		}
+	}
+	return &plugin.GenerateDependenciesResponse{Dependencies: dependencies}, nil
+}
+
+// CompileAssets leaves the assets as is, the rules have no say in the compilation
+func (ruleDependencyMod) CompileAssets(_ context.Context, req plugin.CompileAssetsRequest) (*plugin.CompileAssetsResponse, error) { //nolint: gocritic
+	return &plugin.CompileAssetsResponse{Assets: req.Assets}, nil
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/goto/optimus/internal/errors"
 	"github.com/goto/optimus/internal/lib/tree"
 	"github.com/goto/optimus/internal/lib/window"
+	"github.com/goto/optimus/internal/logging"
 	"github.com/goto/optimus/internal/telemetry"
 	"github.com/goto/optimus/internal/writer"
 	"github.com/goto/optimus/sdk/plugin"
@@ -31,6 +32,7 @@ type JobService struct {
 	jobRepo        JobRepository
 	upstreamRepo   UpstreamRepository
 	downstreamRepo DownstreamRepository
+	deletionRepo   DeletionRepository
 
 	pluginService    PluginService
 	upstreamResolver UpstreamResolver
@@ -47,12 +49,13 @@ func NewJobService(
 	jobRepo JobRepository, upstreamRepo UpstreamRepository, downstreamRepo DownstreamRepository,
 	pluginService PluginService, upstreamResolver UpstreamResolver,
 	tenantDetailsGetter TenantDetailsGetter, eventHandler EventHandler, logger log.Logger,
-	jobDeploymentService JobDeploymentService,
+	jobDeploymentService JobDeploymentService, deletionRepo DeletionRepository,
 ) *JobService {
 	return &JobService{
 		jobRepo:              jobRepo,
 		upstreamRepo:         upstreamRepo,
 		downstreamRepo:       downstreamRepo,
+		deletionRepo:         deletionRepo,
 		pluginService:        pluginService,
 		upstreamResolver:     upstreamResolver,
 		eventHandler:         eventHandler,
@@ -63,9 +66,11 @@ func NewJobService(
 }
 
 type PluginService interface {
-	Info(context.Context, job.TaskName) (*plugin.Info, error)
+	Info(context.Context, job.Task) (*plugin.Info, error)
 	GenerateDestination(context.Context, *tenant.WithDetails, job.Task) (job.ResourceURN, error)
-	GenerateUpstreams(ctx context.Context, jobTenant *tenant.WithDetails, spec *job.Spec, dryRun bool) ([]job.ResourceURN, error)
+	GenerateUpstreams(ctx context.Context, jobTenant *tenant.WithDetails, spec *job.Spec, dryRun bool) ([]job.ResourceURN, []*job.ColumnLineage, error)
+	ValidateTemplates(ctx context.Context, jobTenant *tenant.WithDetails, spec *job.Spec) error
+	ValidateConfigs(ctx context.Context, spec *job.Spec) error
 }
 
 type TenantDetailsGetter interface {
@@ -82,8 +87,10 @@ type JobRepository interface {
 	Add(context.Context, []*job.Job) (addedJobs []*job.Job, err error)
 	Update(context.Context, []*job.Job) (updatedJobs []*job.Job, err error)
 	Delete(ctx context.Context, projectName tenant.ProjectName, jobName job.Name, cleanHistory bool) error
+	BulkDelete(ctx context.Context, projectName tenant.ProjectName, jobNames []job.Name, cleanHistory bool) error
 
 	ChangeJobNamespace(ctx context.Context, jobName job.Name, tenant, newTenant tenant.Tenant) error
+	ChangeJobName(ctx context.Context, jobTenant tenant.Tenant, jobName, newJobName job.Name) error
 
 	GetByJobName(ctx context.Context, projectName tenant.ProjectName, jobName job.Name) (*job.Job, error)
 	GetAllByResourceDestination(ctx context.Context, resourceDestination job.ResourceURN) ([]*job.Job, error)
@@ -91,6 +98,10 @@ type JobRepository interface {
 	GetAllByProjectName(ctx context.Context, projectName tenant.ProjectName) ([]*job.Job, error)
 	SyncState(ctx context.Context, jobTenant tenant.Tenant, disabledJobNames, enabledJobNames []job.Name) error
 	UpdateState(ctx context.Context, jobTenant tenant.Tenant, jobNames []job.Name, jobState job.State, remark string) error
+	GetJobNamesByState(ctx context.Context, jobTenant tenant.Tenant, jobState job.State) ([]job.Name, error)
+
+	GetSpecVersions(ctx context.Context, projectName tenant.ProjectName, jobName job.Name) ([]*job.SpecVersion, error)
+	GetSpecVersion(ctx context.Context, projectName tenant.ProjectName, jobName job.Name, version int) (*job.SpecVersion, error)
 }
 
 type UpstreamRepository interface {
@@ -103,6 +114,14 @@ type DownstreamRepository interface {
 	GetDownstreamByDestination(ctx context.Context, projectName tenant.ProjectName, destination job.ResourceURN) ([]*job.Downstream, error)
 	GetDownstreamByJobName(ctx context.Context, projectName tenant.ProjectName, jobName job.Name) ([]*job.Downstream, error)
 	GetDownstreamBySources(ctx context.Context, sources []job.ResourceURN) ([]*job.Downstream, error)
+	GetColumnDownstreams(ctx context.Context, source job.ResourceURN, column string) ([]*job.ColumnDownstream, error)
+}
+
+type DeletionRepository interface {
+	AddDeletionConsent(ctx context.Context, consent *job.DeletionConsent) error
+	GetDeletionConsents(ctx context.Context, projectName tenant.ProjectName, jobName job.Name) ([]*job.DeletionConsent, error)
+	AddDeletionAudit(ctx context.Context, audit *job.DeletionAudit) error
+	GetDeletionAudits(ctx context.Context, projectName tenant.ProjectName, jobName job.Name) ([]*job.DeletionAudit, error)
 }
 
 type EventHandler interface {
@@ -114,15 +133,24 @@ type UpstreamResolver interface {
 	Resolve(ctx context.Context, subjectJob *job.Job, logWriter writer.LogWriter) ([]*job.Upstream, error)
 }
 
+// tenantLogger attaches the tenant fields, along with the job when given, to the lines logged for the tenant
+func (j *JobService) tenantLogger(jobTenant tenant.Tenant, jobName string) log.Logger {
+	return logging.ForTenant(j.logger, jobTenant.ProjectName().String(), jobTenant.NamespaceName().String(), jobName)
+}
+
 func (j *JobService) Add(ctx context.Context, jobTenant tenant.Tenant, specs []*job.Spec) error {
+	l := j.tenantLogger(jobTenant, "")
 	logWriter := writer.NewLogWriter(j.logger)
 	me := errors.NewMultiError("add specs errors")
 
 	tenantWithDetails, err := j.tenantDetailsGetter.GetDetails(ctx, jobTenant)
 	if err != nil {
-		j.logger.Error("error getting tenant details: %s", err)
+		l.Error("error getting tenant details: %s", err)
 		return err
 	}
+	if tenantWithDetails.IsArchived() {
+		return archivedTenantError(jobTenant)
+	}
 
 	jobs, err := j.generateJobs(ctx, tenantWithDetails, specs, logWriter)
 	me.Append(err)
@@ -136,7 +164,10 @@ func (j *JobService) Add(ctx context.Context, jobTenant tenant.Tenant, specs []*
 	err = j.upstreamRepo.ReplaceUpstreams(ctx, jobsWithUpstreams)
 	me.Append(err)
 
-	err = j.uploadJobs(ctx, jobTenant, addedJobs, nil, nil)
+	cyclicJobs, err := j.findCyclicJobs(ctx, jobsWithUpstreams, logWriter)
+	me.Append(err)
+
+	err = j.uploadJobs(ctx, jobTenant, withoutCyclicJobs(addedJobs, cyclicJobs), nil, nil)
 	me.Append(err)
 
 	for _, job := range addedJobs {
@@ -153,14 +184,18 @@ func (j *JobService) Add(ctx context.Context, jobTenant tenant.Tenant, specs []*
 }
 
 func (j *JobService) Update(ctx context.Context, jobTenant tenant.Tenant, specs []*job.Spec) error {
+	l := j.tenantLogger(jobTenant, "")
 	logWriter := writer.NewLogWriter(j.logger)
 	me := errors.NewMultiError("update specs errors")
 
 	tenantWithDetails, err := j.tenantDetailsGetter.GetDetails(ctx, jobTenant)
 	if err != nil {
-		j.logger.Error("error getting tenant details: %s", err)
+		l.Error("error getting tenant details: %s", err)
 		return err
 	}
+	if tenantWithDetails.IsArchived() {
+		return archivedTenantError(jobTenant)
+	}
 
 	jobs, err := j.generateJobs(ctx, tenantWithDetails, specs, logWriter)
 	me.Append(err)
@@ -174,7 +209,10 @@ func (j *JobService) Update(ctx context.Context, jobTenant tenant.Tenant, specs
 	err = j.upstreamRepo.ReplaceUpstreams(ctx, jobsWithUpstreams)
 	me.Append(err)
 
-	err = j.uploadJobs(ctx, jobTenant, nil, updatedJobs, nil)
+	cyclicJobs, err := j.findCyclicJobs(ctx, jobsWithUpstreams, logWriter)
+	me.Append(err)
+
+	err = j.uploadJobs(ctx, jobTenant, nil, withoutCyclicJobs(updatedJobs, cyclicJobs), nil)
 	me.Append(err)
 
 	for _, job := range updatedJobs {
@@ -215,37 +253,90 @@ func (j *JobService) UpdateState(ctx context.Context, jobTenant tenant.Tenant, j
 	return nil
 }
 
+// PauseTenantJobs pauses the enabled jobs of the tenant on the scheduler while keeping their state, so they can be
+// resumed once the tenant is unarchived
+func (j *JobService) PauseTenantJobs(ctx context.Context, jobTenant tenant.Tenant) error {
+	return j.updateTenantScheduleState(ctx, jobTenant, job.DISABLED)
+}
+
+// ResumeTenantJobs resumes the enabled jobs of the tenant on the scheduler
+func (j *JobService) ResumeTenantJobs(ctx context.Context, jobTenant tenant.Tenant) error {
+	return j.updateTenantScheduleState(ctx, jobTenant, job.ENABLED)
+}
+
+func (j *JobService) updateTenantScheduleState(ctx context.Context, jobTenant tenant.Tenant, scheduleState job.State) error {
+	enabledJobNames, err := j.jobRepo.GetJobNamesByState(ctx, jobTenant, job.ENABLED)
+	if err != nil {
+		j.tenantLogger(jobTenant, "").Error("error getting enabled jobs: %s", err)
+		return err
+	}
+	if len(enabledJobNames) == 0 {
+		return nil
+	}
+	return j.jobDeploymentService.UpdateJobScheduleState(ctx, jobTenant, enabledJobNames, scheduleState.String())
+}
+
 func (j *JobService) SyncState(ctx context.Context, jobTenant tenant.Tenant, disabledJobNames, enabledJobNames []job.Name) error {
 	return j.jobRepo.SyncState(ctx, jobTenant, disabledJobNames, enabledJobNames)
 }
 
-func (j *JobService) Delete(ctx context.Context, jobTenant tenant.Tenant, jobName job.Name, cleanFlag, forceFlag bool) (affectedDownstream []job.FullName, err error) {
+func (j *JobService) Delete(ctx context.Context, jobTenant tenant.Tenant, jobName job.Name, cleanFlag, forceFlag bool, requestedBy, reason string) (affectedDownstream []job.FullName, err error) {
+	l := j.tenantLogger(jobTenant, jobName.String())
 	downstreamList, err := j.downstreamRepo.GetDownstreamByJobName(ctx, jobTenant.ProjectName(), jobName)
 	if err != nil {
 		raiseJobEventMetric(jobTenant, job.MetricJobEventStateDeleteFailed, 1)
-		j.logger.Error("error getting downstream jobs for [%s]: %s", jobName, err)
+		l.Error("error getting downstream jobs for [%s]: %s", jobName, err)
 		return nil, err
 	}
 
 	downstreamFullNames := job.DownstreamList(downstreamList).GetDownstreamFullNames()
 
-	if len(downstreamList) > 0 && !forceFlag {
-		raiseJobEventMetric(jobTenant, job.MetricJobEventStateDeleteFailed, 1)
-		errorMsg := fmt.Sprintf("%s depends on this job. consider do force delete to proceed.", downstreamFullNames)
-		j.logger.Error(errorMsg)
-		return nil, errors.NewError(errors.ErrFailedPrecond, job.EntityJob, errorMsg)
+	if len(downstreamList) > 0 {
+		consents, err := j.deletionRepo.GetDeletionConsents(ctx, jobTenant.ProjectName(), jobName)
+		if err != nil {
+			raiseJobEventMetric(jobTenant, job.MetricJobEventStateDeleteFailed, 1)
+			l.Error("error getting deletion consents of job [%s]: %s", jobName, err)
+			return nil, err
+		}
+
+		consented, blocking := job.DownstreamList(downstreamList).SplitByConsent(consents)
+		if len(blocking) > 0 && !forceFlag {
+			raiseJobEventMetric(jobTenant, job.MetricJobEventStateDeleteFailed, 1)
+			errorMsg := fmt.Sprintf("%s depends on this job without consenting to its deletion. "+
+				"get the consent of the downstream owners or consider do force delete to proceed.", blocking)
+			l.Error(errorMsg)
+			return nil, errors.NewError(errors.ErrFailedPrecond, job.EntityJob, errorMsg)
+		}
+
+		audit := &job.DeletionAudit{
+			ProjectName:          jobTenant.ProjectName(),
+			JobName:              jobName,
+			RequestedBy:          requestedBy,
+			Reason:               reason,
+			Forced:               len(blocking) > 0,
+			ConsentedDownstream:  consented,
+			OverriddenDownstream: blocking,
+		}
+		if err := j.deletionRepo.AddDeletionAudit(ctx, audit); err != nil {
+			raiseJobEventMetric(jobTenant, job.MetricJobEventStateDeleteFailed, 1)
+			l.Error("error recording deletion audit of job [%s]: %s", jobName, err)
+			return nil, err
+		}
+		if len(blocking) > 0 {
+			l.Warn("job [%s] is force deleted by [%s] overriding downstream %s: %s", jobName, requestedBy, blocking, reason)
+		}
 	}
 
 	if err := j.jobRepo.Delete(ctx, jobTenant.ProjectName(), jobName, cleanFlag); err != nil {
 		raiseJobEventMetric(jobTenant, job.MetricJobEventStateDeleteFailed, 1)
-		j.logger.Error("error deleting job [%s]: %s", jobName, err)
+		l.Error("error deleting job [%s]: %s", jobName, err)
 		return downstreamFullNames, err
 	}
 
 	raiseJobEventMetric(jobTenant, job.MetricJobEventStateDeleted, 1)
 
 	if err := j.uploadJobs(ctx, jobTenant, nil, nil, []job.Name{jobName}); err != nil {
-		j.logger.Error("error uploading job [%s]: %s", jobName, err)
+		l.Error("error uploading job [%s]: %s", jobName, err)
 		return downstreamFullNames, err
 	}
 
@@ -254,6 +345,151 @@ func (j *JobService) Delete(ctx context.Context, jobTenant tenant.Tenant, jobNam
 	return downstreamFullNames, nil
 }
 
+// DeleteJobs deletes the jobs of a tenant all at once. Downstream jobs not part of the deletion block it unless their
+// owners consented or the deletion is forced, in which case none of the jobs is deleted.
+func (j *JobService) DeleteJobs(ctx context.Context, jobTenant tenant.Tenant, jobNames []job.Name, cleanFlag, forceFlag bool, requestedBy, reason string) (affectedDownstream []job.FullName, err error) {
+	l := j.tenantLogger(jobTenant, "")
+	if len(jobNames) == 0 {
+		return nil, errors.InvalidArgument(job.EntityJob, "no job to delete")
+	}
+
+	toDelete := map[job.FullName]bool{}
+	var uniqueJobNames []job.Name
+	for _, jobName := range jobNames {
+		fullName := job.FullNameFrom(jobTenant.ProjectName(), jobName)
+		if toDelete[fullName] {
+			continue
+		}
+		toDelete[fullName] = true
+		uniqueJobNames = append(uniqueJobNames, jobName)
+	}
+
+	var audits []*job.DeletionAudit
+	var blockingMessages []string
+	affected := map[job.FullName]bool{}
+	for _, jobName := range uniqueJobNames {
+		downstreamList, err := j.downstreamRepo.GetDownstreamByJobName(ctx, jobTenant.ProjectName(), jobName)
+		if err != nil {
+			raiseJobEventMetric(jobTenant, job.MetricJobEventStateDeleteFailed, len(uniqueJobNames))
+			l.Error("error getting downstream jobs for [%s]: %s", jobName, err)
+			return nil, err
+		}
+
+		var outsideDownstream []*job.Downstream
+		for _, downstream := range downstreamList {
+			if !toDelete[downstream.FullName()] {
+				outsideDownstream = append(outsideDownstream, downstream)
+			}
+		}
+		if len(outsideDownstream) == 0 {
+			continue
+		}
+
+		consents, err := j.deletionRepo.GetDeletionConsents(ctx, jobTenant.ProjectName(), jobName)
+		if err != nil {
+			raiseJobEventMetric(jobTenant, job.MetricJobEventStateDeleteFailed, len(uniqueJobNames))
+			l.Error("error getting deletion consents of job [%s]: %s", jobName, err)
+			return nil, err
+		}
+
+		consented, blocking := job.DownstreamList(outsideDownstream).SplitByConsent(consents)
+		if len(blocking) > 0 && !forceFlag {
+			blockingMessages = append(blockingMessages, fmt.Sprintf("%s depends on job %s", blocking, jobName))
+			continue
+		}
+
+		for _, fullName := range job.DownstreamList(outsideDownstream).GetDownstreamFullNames() {
+			if !affected[fullName] {
+				affected[fullName] = true
+				affectedDownstream = append(affectedDownstream, fullName)
+			}
+		}
+		audits = append(audits, &job.DeletionAudit{
+			ProjectName:          jobTenant.ProjectName(),
+			JobName:              jobName,
+			RequestedBy:          requestedBy,
+			Reason:               reason,
+			Forced:               len(blocking) > 0,
+			ConsentedDownstream:  consented,
+			OverriddenDownstream: blocking,
+		})
+	}
+
+	if len(blockingMessages) > 0 {
+		raiseJobEventMetric(jobTenant, job.MetricJobEventStateDeleteFailed, len(uniqueJobNames))
+		errorMsg := fmt.Sprintf("%s without consenting to the deletion. "+
+			"get the consent of the downstream owners or consider do force delete to proceed.", strings.Join(blockingMessages, "; "))
+		l.Error(errorMsg)
+		return nil, errors.NewError(errors.ErrFailedPrecond, job.EntityJob, errorMsg)
+	}
+
+	for _, audit := range audits {
+		if err := j.deletionRepo.AddDeletionAudit(ctx, audit); err != nil {
+			raiseJobEventMetric(jobTenant, job.MetricJobEventStateDeleteFailed, len(uniqueJobNames))
+			l.Error("error recording deletion audit of job [%s]: %s", audit.JobName, err)
+			return nil, err
+		}
+		if audit.Forced {
+			l.Warn("job [%s] is force deleted by [%s] overriding downstream %s: %s", audit.JobName, requestedBy, audit.OverriddenDownstream, reason)
+		}
+	}
+
+	if err := j.jobRepo.BulkDelete(ctx, jobTenant.ProjectName(), uniqueJobNames, cleanFlag); err != nil {
+		raiseJobEventMetric(jobTenant, job.MetricJobEventStateDeleteFailed, len(uniqueJobNames))
+		l.Error("error deleting jobs %s: %s", uniqueJobNames, err)
+		return affectedDownstream, err
+	}
+
+	raiseJobEventMetric(jobTenant, job.MetricJobEventStateDeleted, len(uniqueJobNames))
+
+	if err := j.uploadJobs(ctx, jobTenant, nil, nil, uniqueJobNames); err != nil {
+		l.Error("error removing the deleted jobs from scheduler: %s", err)
+		return affectedDownstream, err
+	}
+
+	for _, jobName := range uniqueJobNames {
+		j.raiseDeleteEvent(jobTenant, jobName)
+	}
+	return affectedDownstream, nil
+}
+
+// AddDeletionConsent records the consent of the owner of a downstream job to delete the job it depends on
+func (j *JobService) AddDeletionConsent(ctx context.Context, projectName tenant.ProjectName, jobName job.Name,
+	downstreamProjectName tenant.ProjectName, downstreamJobName job.Name, givenBy, reason string,
+) error {
+	consent, err := job.NewDeletionConsent(projectName, jobName, downstreamProjectName, downstreamJobName, givenBy, reason)
+	if err != nil {
+		return err
+	}
+
+	downstreamList, err := j.downstreamRepo.GetDownstreamByJobName(ctx, projectName, jobName)
+	if err != nil {
+		j.logger.Error("error getting downstream jobs for [%s]: %s", jobName, err)
+		return err
+	}
+
+	isDownstream := false
+	for _, downstreamJob := range downstreamList {
+		if downstreamJob.FullName() == consent.DownstreamFullName() {
+			isDownstream = true
+			break
+		}
+	}
+	if !isDownstream {
+		return errors.InvalidArgument(job.EntityJobDeletion, fmt.Sprintf("%s is not a downstream of job %s", consent.DownstreamFullName(), jobName))
+	}
+
+	return j.deletionRepo.AddDeletionConsent(ctx, consent)
+}
+
+func (j *JobService) GetDeletionConsents(ctx context.Context, projectName tenant.ProjectName, jobName job.Name) ([]*job.DeletionConsent, error) {
+	return j.deletionRepo.GetDeletionConsents(ctx, projectName, jobName)
+}
+
+func (j *JobService) GetDeletionAudits(ctx context.Context, projectName tenant.ProjectName, jobName job.Name) ([]*job.DeletionAudit, error) {
+	return j.deletionRepo.GetDeletionAudits(ctx, projectName, jobName)
+}
+
 func (j *JobService) ChangeNamespace(ctx context.Context, jobTenant, jobNewTenant tenant.Tenant, jobName job.Name) error {
 	err := j.jobRepo.ChangeJobNamespace(ctx, jobName, jobTenant, jobNewTenant)
 	if err != nil {
@@ -282,24 +518,157 @@ func (j *JobService) ChangeNamespace(ctx context.Context, jobTenant, jobNewTenan
 	return nil
 }
 
+// MoveJob changes the namespace of the job after checking the secrets and configs its templates refer to are
+// available in the target namespace
+func (j *JobService) MoveJob(ctx context.Context, jobTenant, jobNewTenant tenant.Tenant, jobName job.Name) error {
+	l := j.tenantLogger(jobTenant, jobName.String())
+	if jobTenant.ProjectName() != jobNewTenant.ProjectName() {
+		return errors.InvalidArgument(job.EntityJob, "job can only be moved between namespaces of the same project")
+	}
+	if jobTenant.NamespaceName() == jobNewTenant.NamespaceName() {
+		return errors.InvalidArgument(job.EntityJob, "job is already in namespace "+jobNewTenant.NamespaceName().String())
+	}
+
+	existingJob, err := j.jobRepo.GetByJobName(ctx, jobTenant.ProjectName(), jobName)
+	if err != nil {
+		l.Error("error getting job [%s]: %s", jobName, err)
+		return err
+	}
+	if existingJob.Tenant().NamespaceName() != jobTenant.NamespaceName() {
+		return errors.NotFound(job.EntityJob, fmt.Sprintf("job %s not found in namespace %s", jobName, jobTenant.NamespaceName()))
+	}
+
+	newTenantWithDetails, err := j.tenantDetailsGetter.GetDetails(ctx, jobNewTenant)
+	if err != nil {
+		l.Error("error getting details of namespace [%s]: %s", jobNewTenant.NamespaceName(), err)
+		return err
+	}
+	if newTenantWithDetails.IsArchived() {
+		return archivedTenantError(jobNewTenant)
+	}
+	if err := j.pluginService.ValidateTemplates(ctx, newTenantWithDetails, existingJob.Spec()); err != nil {
+		l.Error("error validating templates of [%s] in namespace [%s]: %s", jobName, jobNewTenant.NamespaceName(), err)
+		errorMsg := fmt.Sprintf("job %s can not be moved to namespace %s: %s", jobName, jobNewTenant.NamespaceName(), err.Error())
+		return errors.InvalidArgument(job.EntityJob, errorMsg)
+	}
+
+	return j.ChangeNamespace(ctx, jobTenant, jobNewTenant, jobName)
+}
+
+// ChangeJobName renames the job keeping its run and replay history. The job is deployed to the scheduler under the
+// new name, and its downstream jobs are redeployed to wait for the job under the new name.
+func (j *JobService) ChangeJobName(ctx context.Context, jobTenant tenant.Tenant, jobName, newJobName job.Name) error {
+	l := j.tenantLogger(jobTenant, jobName.String())
+	if jobName == newJobName {
+		return errors.InvalidArgument(job.EntityJob, "new job name is the same as the current one")
+	}
+
+	if err := j.jobRepo.ChangeJobName(ctx, jobTenant, jobName, newJobName); err != nil {
+		l.Error("error changing name of job [%s] to [%s]: %s", jobName, newJobName, err)
+		return err
+	}
+
+	renamedJob, err := j.jobRepo.GetByJobName(ctx, jobTenant.ProjectName(), newJobName)
+	if err != nil {
+		errorsMsg := fmt.Sprintf("unable to fetch renamed job %s: %s", newJobName, err.Error())
+		return errors.NewError(errors.ErrInternalError, job.EntityJob, errorsMsg)
+	}
+
+	me := errors.NewMultiError("change job name errors")
+	if err := j.uploadJobs(ctx, jobTenant, nil, []*job.Job{renamedJob}, []job.Name{jobName}); err != nil {
+		l.Error("error moving job [%s] to [%s] on scheduler: %s", jobName, newJobName, err)
+		me.Append(errors.NewError(errors.ErrInternalError, job.EntityJob, "unable to move job on scheduler: "+err.Error()))
+	}
+
+	downstreams, err := j.downstreamRepo.GetDownstreamByJobName(ctx, jobTenant.ProjectName(), newJobName)
+	if err != nil {
+		l.Error("error getting downstream jobs of [%s]: %s", newJobName, err)
+		me.Append(err)
+		return me.ToErr()
+	}
+	for _, downstreamTenant := range groupDownstreamPerTenant(downstreams) {
+		if err := j.jobDeploymentService.UploadJobs(ctx, downstreamTenant.tenant, downstreamTenant.jobNames, nil); err != nil {
+			l.Error("error redeploying downstream jobs of [%s] under namespace [%s]: %s", newJobName, downstreamTenant.tenant.NamespaceName(), err)
+			me.Append(err)
+		}
+	}
+
+	j.raiseDeleteEvent(jobTenant, jobName)
+	j.raiseCreateEvent(renamedJob)
+	return me.ToErr()
+}
+
+// GetSpecVersions returns the deployed versions of the job spec, the latest first
+func (j *JobService) GetSpecVersions(ctx context.Context, projectName tenant.ProjectName, jobName job.Name) ([]*job.SpecVersion, error) {
+	return j.jobRepo.GetSpecVersions(ctx, projectName, jobName)
+}
+
+// RollbackJob redeploys a previously deployed version of the job spec. The rolled back spec goes through the same
+// validation as any update, and is stored as the latest version of the job.
+func (j *JobService) RollbackJob(ctx context.Context, jobTenant tenant.Tenant, jobName job.Name, version int) error {
+	l := j.tenantLogger(jobTenant, jobName.String())
+
+	existingJob, err := j.jobRepo.GetByJobName(ctx, jobTenant.ProjectName(), jobName)
+	if err != nil {
+		l.Error("error getting job [%s]: %s", jobName, err)
+		return err
+	}
+	if existingJob.Tenant().NamespaceName() != jobTenant.NamespaceName() {
+		return errors.NotFound(job.EntityJob, fmt.Sprintf("job %s not found in namespace %s", jobName, jobTenant.NamespaceName()))
+	}
+
+	specVersion, err := j.jobRepo.GetSpecVersion(ctx, jobTenant.ProjectName(), jobName, version)
+	if err != nil {
+		l.Error("error getting version [%d] of job [%s]: %s", version, jobName, err)
+		return err
+	}
+
+	return j.Update(ctx, jobTenant, []*job.Spec{specVersion.Spec})
+}
+
+type downstreamTenantJobs struct {
+	tenant   tenant.Tenant
+	jobNames []string
+}
+
+func groupDownstreamPerTenant(downstreams []*job.Downstream) []*downstreamTenantJobs {
+	var grouped []*downstreamTenantJobs
+	byTenant := map[tenant.Tenant]*downstreamTenantJobs{}
+	for _, downstream := range downstreams {
+		downstreamTenant, err := tenant.NewTenant(downstream.ProjectName().String(), downstream.NamespaceName().String())
+		if err != nil {
+			continue
+		}
+		group, ok := byTenant[downstreamTenant]
+		if !ok {
+			group = &downstreamTenantJobs{tenant: downstreamTenant}
+			byTenant[downstreamTenant] = group
+			grouped = append(grouped, group)
+		}
+		group.jobNames = append(group.jobNames, downstream.Name().String())
+	}
+	return grouped
+}
+
 func (j *JobService) Get(ctx context.Context, jobTenant tenant.Tenant, jobName job.Name) (*job.Job, error) {
+	l := j.tenantLogger(jobTenant, jobName.String())
 	jobs, err := j.GetByFilter(ctx,
 		filter.WithString(filter.ProjectName, jobTenant.ProjectName().String()),
 		filter.WithString(filter.JobName, jobName.String()),
 	)
 	if err != nil {
-		j.logger.Error("error getting job specified by the filter: %s", err)
+		l.Error("error getting job specified by the filter: %s", err)
 		return nil, err
 	}
 	if len(jobs) == 0 {
-		j.logger.Error("job [%s] is not found", jobName)
+		l.Error("job [%s] is not found", jobName)
 		return nil, errors.NotFound(job.EntityJob, fmt.Sprintf("job %s is not found", jobName))
 	}
 	return jobs[0], nil
 }
 
 func (j *JobService) GetTaskInfo(ctx context.Context, task job.Task) (*plugin.Info, error) {
-	return j.pluginService.Info(ctx, task.Name())
+	return j.pluginService.Info(ctx, task)
 }
 
 func (j *JobService) GetByFilter(ctx context.Context, filters ...filter.FilterOpt) ([]*job.Job, error) {
@@ -402,6 +771,7 @@ func (j *JobService) GetByFilter(ctx context.Context, filters ...filter.FilterOp
 }
 
 func (j *JobService) ReplaceAll(ctx context.Context, jobTenant tenant.Tenant, specs []*job.Spec, jobNamesWithInvalidSpec []job.Name, logWriter writer.LogWriter) error {
+	l := j.tenantLogger(jobTenant, "")
 	me := errors.NewMultiError("replace all specs errors")
 
 	existingJobs, err := j.jobRepo.GetAllByTenant(ctx, jobTenant)
@@ -413,10 +783,14 @@ func (j *JobService) ReplaceAll(ctx context.Context, jobTenant tenant.Tenant, sp
 
 	tenantWithDetails, err := j.tenantDetailsGetter.GetDetails(ctx, jobTenant)
 	if err != nil {
-		j.logger.Error("error getting tenant details: %s", err)
+		l.Error("error getting tenant details: %s", err)
 		me.Append(err)
 		return me.ToErr()
 	}
+	if tenantWithDetails.IsArchived() {
+		me.Append(archivedTenantError(jobTenant))
+		return me.ToErr()
+	}
 
 	addedJobs, err := j.bulkAdd(ctx, tenantWithDetails, toAdd, logWriter)
 	me.Append(err)
@@ -429,10 +803,13 @@ func (j *JobService) ReplaceAll(ctx context.Context, jobTenant tenant.Tenant, sp
 	deletedJobNames, err := j.bulkDelete(ctx, jobTenant, toDelete, logWriter)
 	me.Append(err)
 
-	err = j.resolveAndSaveUpstreams(ctx, jobTenant, logWriter, addedJobs, updatedJobs)
+	jobsWithUpstreams, err := j.resolveAndSaveUpstreams(ctx, jobTenant, logWriter, addedJobs, updatedJobs)
 	me.Append(err)
 
-	err = j.uploadJobs(ctx, jobTenant, addedJobs, updatedJobs, deletedJobNames)
+	cyclicJobs, err := j.findCyclicJobs(ctx, jobsWithUpstreams, logWriter)
+	me.Append(err)
+
+	err = j.uploadJobs(ctx, jobTenant, withoutCyclicJobs(addedJobs, cyclicJobs), withoutCyclicJobs(updatedJobs, cyclicJobs), deletedJobNames)
 	me.Append(err)
 
 	raiseJobEventMetric(tenantWithDetails.ToTenant(), job.MetricJobEventStateUpsertFailed, failedToAdd+failedToUpdate)
@@ -441,8 +818,9 @@ func (j *JobService) ReplaceAll(ctx context.Context, jobTenant tenant.Tenant, sp
 }
 
 func (j *JobService) uploadJobs(ctx context.Context, jobTenant tenant.Tenant, addedJobs, updatedJobs []*job.Job, deletedJobNames []job.Name) error {
+	l := j.tenantLogger(jobTenant, "")
 	if len(addedJobs) == 0 && len(updatedJobs) == 0 && len(deletedJobNames) == 0 {
-		j.logger.Warn("no jobs to be uploaded")
+		l.Warn("no jobs to be uploaded")
 		return nil
 	}
 
@@ -497,8 +875,12 @@ func (j *JobService) Refresh(ctx context.Context, projectName tenant.ProjectName
 		err = j.upstreamRepo.ReplaceUpstreams(ctx, jobsWithUpstreams)
 		me.Append(err)
 
-		j.logger.Debug("uploading [%d] jobs of project [%s] namespace [%s] to scheduler", len(jobs), projectName, namespaceName)
-		err = j.uploadJobs(ctx, jobTenant, jobs, nil, nil)
+		cyclicJobs, err := j.findCyclicJobs(ctx, jobsWithUpstreams, logWriter)
+		me.Append(err)
+
+		jobsToUpload := withoutCyclicJobs(jobs, cyclicJobs)
+		j.logger.Debug("uploading [%d] jobs of project [%s] namespace [%s] to scheduler", len(jobsToUpload), projectName, namespaceName)
+		err = j.uploadJobs(ctx, jobTenant, jobsToUpload, nil, nil)
 		me.Append(err)
 	}
 
@@ -540,11 +922,12 @@ func (j *JobService) RefreshResourceDownstream(ctx context.Context, resourceURNs
 }
 
 func (j *JobService) Validate(ctx context.Context, jobTenant tenant.Tenant, jobSpecs []*job.Spec, jobNamesWithInvalidSpec []job.Name, logWriter writer.LogWriter) error {
+	l := j.tenantLogger(jobTenant, "")
 	me := errors.NewMultiError("validate specs errors")
 
 	tenantWithDetails, err := j.tenantDetailsGetter.GetDetails(ctx, jobTenant)
 	if err != nil {
-		j.logger.Error("error getting tenant details: %s", err)
+		l.Error("error getting tenant details: %s", err)
 		return err
 	}
 
@@ -571,7 +954,7 @@ func (j *JobService) Validate(ctx context.Context, jobTenant tenant.Tenant, jobS
 	identifierToJobsMap := getIdentifierToJobsMap(jobsToValidateMap)
 	for _, jobEntity := range jobsToValidateMap {
 		if _, err := j.validateCyclic(jobEntity.Job().Spec().Name(), jobsToValidateMap, identifierToJobsMap); err != nil {
-			j.logger.Error("error when executing cyclic validation on [%s]: %s", jobEntity.Job().Spec().Name(), err)
+			l.Error("error when executing cyclic validation on [%s]: %s", jobEntity.Job().Spec().Name(), err)
 			me.Append(err)
 			break
 		}
@@ -581,13 +964,14 @@ func (j *JobService) Validate(ctx context.Context, jobTenant tenant.Tenant, jobS
 }
 
 func (j *JobService) validateDeleteJobs(ctx context.Context, jobTenant tenant.Tenant, toDelete []*job.Spec, logWriter writer.LogWriter) error {
+	l := j.tenantLogger(jobTenant, "")
 	me := errors.NewMultiError("delete job specs check errors")
 	toDeleteMap := job.Specs(toDelete).ToFullNameAndSpecMap(jobTenant.ProjectName())
 
 	for _, jobToDelete := range toDelete {
 		downstreams, err := j.getAllDownstreams(ctx, jobTenant.ProjectName(), jobToDelete.Name(), map[job.FullName]bool{})
 		if err != nil {
-			j.logger.Error("error getting all downstreams for job [%s]: %s", jobToDelete.Name().String(), err)
+			l.Error("error getting all downstreams for job [%s]: %s", jobToDelete.Name().String(), err)
 			logWriter.Write(writer.LogLevelError, fmt.Sprintf("[%s] pre-delete check for job %s failed: %s", jobTenant.NamespaceName().String(), jobToDelete.Name().String(), err.Error()))
 			me.Append(err)
 			continue
@@ -690,27 +1074,28 @@ func getIdentifierToJobsMap(jobsToValidateMap map[job.Name]*job.WithUpstream) ma
 	return identifierToJobsMap
 }
 
-func (j *JobService) resolveAndSaveUpstreams(ctx context.Context, jobTenant tenant.Tenant, logWriter writer.LogWriter, jobsToResolve ...[]*job.Job) error {
+func (j *JobService) resolveAndSaveUpstreams(ctx context.Context, jobTenant tenant.Tenant, logWriter writer.LogWriter, jobsToResolve ...[]*job.Job) ([]*job.WithUpstream, error) {
+	l := j.tenantLogger(jobTenant, "")
 	var allJobsToResolve []*job.Job
 	for _, group := range jobsToResolve {
 		allJobsToResolve = append(allJobsToResolve, group...)
 	}
 	if len(allJobsToResolve) == 0 {
-		j.logger.Warn("no jobs to be resolved")
-		return nil
+		l.Warn("no jobs to be resolved")
+		return nil, nil
 	}
 
 	me := errors.NewMultiError("resolve and save upstream errors")
 
-	j.logger.Debug("resolving upstreams for %d jobs of project [%s] namespace [%s]", len(allJobsToResolve), jobTenant.ProjectName(), jobTenant.NamespaceName())
+	l.Debug("resolving upstreams for %d jobs of project [%s] namespace [%s]", len(allJobsToResolve), jobTenant.ProjectName(), jobTenant.NamespaceName())
 	jobsWithUpstreams, err := j.upstreamResolver.BulkResolve(ctx, jobTenant.ProjectName(), allJobsToResolve, logWriter)
 	me.Append(err)
 
-	j.logger.Debug("replacing upstreams for %d jobs of project [%s] namespace [%s]", len(jobsWithUpstreams), jobTenant.ProjectName(), jobTenant.NamespaceName())
+	l.Debug("replacing upstreams for %d jobs of project [%s] namespace [%s]", len(jobsWithUpstreams), jobTenant.ProjectName(), jobTenant.NamespaceName())
 	err = j.upstreamRepo.ReplaceUpstreams(ctx, jobsWithUpstreams)
 	me.Append(err)
 
-	return me.ToErr()
+	return jobsWithUpstreams, me.ToErr()
 }
 
 func (j *JobService) bulkAdd(ctx context.Context, tenantWithDetails *tenant.WithDetails, specsToAdd []*job.Spec, logWriter writer.LogWriter) ([]*job.Job, error) {
@@ -773,6 +1158,7 @@ func (j *JobService) bulkUpdate(ctx context.Context, tenantWithDetails *tenant.W
 }
 
 func (j *JobService) bulkDelete(ctx context.Context, jobTenant tenant.Tenant, toDelete []*job.Spec, logWriter writer.LogWriter) ([]job.Name, error) {
+	l := j.tenantLogger(jobTenant, "")
 	me := errors.NewMultiError("bulk delete specs errors")
 	var deletedJobNames []job.Name
 	toDeleteMap := job.Specs(toDelete).ToFullNameAndSpecMap(jobTenant.ProjectName())
@@ -783,7 +1169,7 @@ func (j *JobService) bulkDelete(ctx context.Context, jobTenant tenant.Tenant, to
 		fullName := job.FullNameFrom(jobTenant.ProjectName(), spec.Name())
 		downstreams, err := j.getAllDownstreams(ctx, jobTenant.ProjectName(), spec.Name(), map[job.FullName]bool{})
 		if err != nil {
-			j.logger.Error("error getting downstreams for job [%s]: %s", spec.Name(), err)
+			l.Error("error getting downstreams for job [%s]: %s", spec.Name(), err)
 			logWriter.Write(writer.LogLevelError, fmt.Sprintf("[%s] pre-delete check for job %s failed: %s", jobTenant.NamespaceName().String(), spec.Name().String(), err.Error()))
 			me.Append(err)
 			continue
@@ -791,7 +1177,7 @@ func (j *JobService) bulkDelete(ctx context.Context, jobTenant tenant.Tenant, to
 
 		isSafeToDelete := validateDeleteJob(jobTenant, downstreams, toDeleteMap, spec, logWriter, me)
 		if !isSafeToDelete {
-			j.logger.Warn("job [%s] is not safe to be deleted", spec.Name())
+			l.Warn("job [%s] is not safe to be deleted", spec.Name())
 			continue
 		}
 
@@ -803,7 +1189,7 @@ func (j *JobService) bulkDelete(ctx context.Context, jobTenant tenant.Tenant, to
 				continue
 			}
 			if err = j.jobRepo.Delete(ctx, downstreams[i].ProjectName(), downstreams[i].Name(), false); err != nil {
-				j.logger.Error("error deleting [%s] as downstream of [%s]", downstreams[i].Name(), spec.Name())
+				l.Error("error deleting [%s] as downstream of [%s]", downstreams[i].Name(), spec.Name())
 				logWriter.Write(writer.LogLevelError, fmt.Sprintf("[%s] deleting job %s failed: %s", downstreams[i].NamespaceName().String(), downstreams[i].Name().String(), err.Error()))
 				me.Append(err)
 				isDeletionFail = true
@@ -816,11 +1202,11 @@ func (j *JobService) bulkDelete(ctx context.Context, jobTenant tenant.Tenant, to
 		}
 
 		if alreadyDeleted[fullName] || isDeletionFail {
-			j.logger.Warn("job [%s] deletion is skipped [already deleted or failure in deleting downstreams]", spec.Name())
+			l.Warn("job [%s] deletion is skipped [already deleted or failure in deleting downstreams]", spec.Name())
 			continue
 		}
 		if err = j.jobRepo.Delete(ctx, jobTenant.ProjectName(), spec.Name(), false); err != nil {
-			j.logger.Error("error deleting job [%s]", spec.Name())
+			l.Error("error deleting job [%s]", spec.Name())
 			logWriter.Write(writer.LogLevelError, fmt.Sprintf("[%s] deleting job %s failed: %s", jobTenant.NamespaceName().String(), spec.Name().String(), err.Error()))
 			me.Append(err)
 		} else {
@@ -911,6 +1297,18 @@ func (j *JobService) generateJob(ctx context.Context, tenantWithDetails *tenant.
 		}
 	}
 
+	if err := j.pluginService.ValidateTemplates(ctx, tenantWithDetails, spec); err != nil {
+		j.logger.Error("error validating templates of [%s]: %s", spec.Name(), err)
+		errorMsg := fmt.Sprintf("invalid templates in %s: %s", spec.Name().String(), err.Error())
+		return nil, errors.NewError(errors.ErrInvalidArgument, job.EntityJob, errorMsg)
+	}
+
+	if err := j.pluginService.ValidateConfigs(ctx, spec); err != nil {
+		j.logger.Error("error validating configs of [%s]: %s", spec.Name(), err)
+		errorMsg := fmt.Sprintf("invalid configs in %s: %s", spec.Name().String(), err.Error())
+		return nil, errors.NewError(errors.ErrInvalidArgument, job.EntityJob, errorMsg)
+	}
+
 	destination, err := j.pluginService.GenerateDestination(ctx, tenantWithDetails, spec.Task())
 	if err != nil && !errors.Is(err, ErrUpstreamModNotFound) {
 		j.logger.Error("error generating destination for [%s]: %s", spec.Name(), err)
@@ -918,14 +1316,14 @@ func (j *JobService) generateJob(ctx context.Context, tenantWithDetails *tenant.
 		return nil, errors.NewError(errors.ErrInternalError, job.EntityJob, errorMsg)
 	}
 
-	sources, err := j.pluginService.GenerateUpstreams(ctx, tenantWithDetails, spec, true)
+	sources, columnLineages, err := j.pluginService.GenerateUpstreams(ctx, tenantWithDetails, spec, true)
 	if err != nil && !errors.Is(err, ErrUpstreamModNotFound) {
 		j.logger.Error("error generating upstream for [%s]: %s", spec.Name(), err)
 		errorMsg := fmt.Sprintf("unable to add %s: %s", spec.Name().String(), err.Error())
 		return nil, errors.NewError(errors.ErrInternalError, job.EntityJob, errorMsg)
 	}
 
-	return job.NewJob(tenantWithDetails.ToTenant(), spec, destination, sources), nil
+	return job.NewJob(tenantWithDetails.ToTenant(), spec, destination, sources).WithColumnLineages(columnLineages), nil
 }
 
 func (j *JobService) validateCyclic(rootName job.Name, jobMap map[job.Name]*job.WithUpstream, identifierToJobMap map[string][]*job.WithUpstream) ([]string, error) {
@@ -979,33 +1377,34 @@ func findOrCreateDAGNode(dagTree *tree.MultiRootTree, dag tree.TreeData) *tree.T
 }
 
 func (j *JobService) GetJobBasicInfo(ctx context.Context, jobTenant tenant.Tenant, jobName job.Name, spec *job.Spec) (*job.Job, writer.BufferedLogger) {
+	l := j.tenantLogger(jobTenant, jobName.String())
 	var subjectJob *job.Job
 	var logger writer.BufferedLogger
 	var err error
 	if spec != nil {
 		tenantWithDetails, err := j.tenantDetailsGetter.GetDetails(ctx, jobTenant)
 		if err != nil {
-			j.logger.Info("error getting tenant details: %s", err)
+			l.Info("error getting tenant details: %s", err)
 			logger.Write(writer.LogLevelError, fmt.Sprintf("unable to get tenant detail, err: %v", err))
 			return nil, logger
 		}
 		subjectJob, err = j.generateJob(ctx, tenantWithDetails, spec)
 		if err != nil {
-			j.logger.Info("error generating job for [%s]: %s", spec.Name(), err)
+			l.Info("error generating job for [%s]: %s", spec.Name(), err)
 			logger.Write(writer.LogLevelError, fmt.Sprintf("unable to generate job, err: %v", err))
 			return nil, logger
 		}
 	} else {
 		subjectJob, err = j.Get(ctx, jobTenant, jobName)
 		if err != nil {
-			j.logger.Info("error getting job [%s]: %s", jobName, err)
+			l.Info("error getting job [%s]: %s", jobName, err)
 			logger.Write(writer.LogLevelError, fmt.Sprintf("unable to get job, err: %v", err))
 			return nil, logger
 		}
 	}
 
 	if len(subjectJob.Sources()) == 0 {
-		j.logger.Warn("no job sources detected")
+		l.Warn("no job sources detected")
 		logger.Write(writer.LogLevelInfo, "no job sources detected")
 	}
 
@@ -1049,6 +1448,17 @@ func (j *JobService) GetDownstream(ctx context.Context, subjectJob *job.Job, loc
 	return j.downstreamRepo.GetDownstreamByJobName(ctx, subjectJob.ProjectName(), subjectJob.Spec().Name())
 }
 
+// GetColumnDownstreams returns the jobs reading the column of the source resource, as captured from the column lineages of their destinations
+func (j *JobService) GetColumnDownstreams(ctx context.Context, source job.ResourceURN, column string) ([]*job.ColumnDownstream, error) {
+	if source == "" {
+		return nil, errors.InvalidArgument(job.EntityJob, "resource urn is empty")
+	}
+	if column == "" {
+		return nil, errors.InvalidArgument(job.EntityJob, "column is empty")
+	}
+	return j.downstreamRepo.GetColumnDownstreams(ctx, source, column)
+}
+
 func (j *JobService) raiseCreateEvent(job *job.Job) {
 	jobEvent, err := event.NewJobCreatedEvent(job)
 	if err != nil {
@@ -1068,18 +1478,20 @@ func (j *JobService) raiseUpdateEvent(job *job.Job) {
 }
 
 func (j *JobService) raiseStateChangeEvent(tnnt tenant.Tenant, jobName job.Name, state job.State) {
+	l := j.tenantLogger(tnnt, jobName.String())
 	jobEvent, err := event.NewJobStateChangeEvent(tnnt, jobName, state)
 	if err != nil {
-		j.logger.Error("error creating event for job state change: %s", err)
+		l.Error("error creating event for job state change: %s", err)
 		return
 	}
 	j.eventHandler.HandleEvent(jobEvent)
 }
 
 func (j *JobService) raiseDeleteEvent(tnnt tenant.Tenant, jobName job.Name) {
+	l := j.tenantLogger(tnnt, jobName.String())
 	jobEvent, err := event.NewJobDeleteEvent(tnnt, jobName)
 	if err != nil {
-		j.logger.Error("error creating event for job delete: %s", err)
+		l.Error("error creating event for job delete: %s", err)
 		return
 	}
 	j.eventHandler.HandleEvent(jobEvent)
@@ -1093,6 +1505,12 @@ func (*JobService) groupDownstreamPerProject(downstreams []*job.Downstream) map[
 	return output
 }
 
+// archivedTenantError rejects the deployments to archived projects and namespaces, their specs are kept as is
+func archivedTenantError(jobTenant tenant.Tenant) error {
+	return errors.NewError(errors.ErrFailedPrecond, job.EntityJob,
+		fmt.Sprintf("tenant is archived: project %s, namespace %s", jobTenant.ProjectName(), jobTenant.NamespaceName()))
+}
+
 func raiseJobEventMetric(jobTenant tenant.Tenant, state string, metricValue int) {
 	telemetry.NewCounter(job.MetricJobEvent, map[string]string{
 		"project":   jobTenant.ProjectName().String(),
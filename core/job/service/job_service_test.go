@@ -97,10 +97,12 @@ func TestJobService(t *testing.T) {
 			tenantDetailsGetter.On("GetDetails", ctx, sampleTenant).Return(detailedTenant, nil)
 
 			jobADestination := job.ResourceURN("resource-A")
+			pluginService.On("ValidateTemplates", ctx, detailedTenant, mock.Anything).Return(nil)
+			pluginService.On("ValidateConfigs", ctx, mock.Anything).Return(nil)
 			pluginService.On("GenerateDestination", ctx, detailedTenant, specA.Task()).Return(jobADestination, nil)
 
 			jobAUpstreamName := []job.ResourceURN{"job-B"}
-			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return(jobAUpstreamName, nil)
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return(jobAUpstreamName, nil, nil)
 
 			jobA := job.NewJob(sampleTenant, specA, jobADestination, jobAUpstreamName)
 			jobs := []*job.Job{jobA}
@@ -111,16 +113,98 @@ func TestJobService(t *testing.T) {
 			upstreamResolver.On("BulkResolve", ctx, project.Name(), jobs, mock.Anything).Return([]*job.WithUpstream{jobWithUpstream}, nil, nil)
 
 			upstreamRepo.On("ReplaceUpstreams", ctx, []*job.WithUpstream{jobWithUpstream}).Return(nil)
+			upstreamRepo.On("GetUpstreams", ctx, project.Name(), job.Name("job-B")).Return(nil, nil)
 
 			jobNamesToUpload := []string{jobA.GetName()}
 			jobDeploymentService.On("UploadJobs", ctx, sampleTenant, jobNamesToUpload, emptyJobNames).Return(nil)
 
 			eventHandler.On("HandleEvent", mock.Anything).Times(1)
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
 			err := jobService.Add(ctx, sampleTenant, specs)
 			assert.NoError(t, err)
 		})
+		t.Run("returns error when the tenant is archived", func(t *testing.T) {
+			tenantDetailsGetter := new(TenantDetailsGetter)
+			defer tenantDetailsGetter.AssertExpectations(t)
+
+			archivedNamespace, _ := tenant.NewNamespace(namespace.Name().String(), project.Name(), namespace.GetConfigs())
+			archivedNamespace.SetArchived(true)
+			archivedTenant, _ := tenant.NewTenantDetails(project, archivedNamespace, nil)
+			tenantDetailsGetter.On("GetDetails", ctx, sampleTenant).Return(archivedTenant, nil)
+
+			specA, _ := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).Build()
+
+			jobService := service.NewJobService(nil, nil, nil, nil, nil, tenantDetailsGetter, nil, log, nil, nil)
+			err := jobService.Add(ctx, sampleTenant, []*job.Spec{specA})
+			assert.EqualError(t, err, "failed precondition for entity job: tenant is archived: project test-proj, namespace test-ns")
+		})
+		t.Run("skip uploading jobs having circular dependency and return error with the cycle path", func(t *testing.T) {
+			jobRepo := new(JobRepository)
+			defer jobRepo.AssertExpectations(t)
+
+			upstreamRepo := new(UpstreamRepository)
+			defer upstreamRepo.AssertExpectations(t)
+
+			downstreamRepo := new(DownstreamRepository)
+			defer downstreamRepo.AssertExpectations(t)
+
+			pluginService := new(PluginService)
+			defer pluginService.AssertExpectations(t)
+
+			upstreamResolver := new(UpstreamResolver)
+			defer upstreamResolver.AssertExpectations(t)
+
+			tenantDetailsGetter := new(TenantDetailsGetter)
+			defer tenantDetailsGetter.AssertExpectations(t)
+
+			jobDeploymentService := new(JobDeploymentService)
+			defer jobDeploymentService.AssertExpectations(t)
+
+			eventHandler := newEventHandler(t)
+
+			specA, _ := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).Build()
+			specC, _ := job.NewSpecBuilder(jobVersion, "job-C", "sample-owner", jobSchedule, jobWindow, jobTask).Build()
+			specs := []*job.Spec{specA, specC}
+
+			tenantDetailsGetter.On("GetDetails", ctx, sampleTenant).Return(detailedTenant, nil)
+
+			jobADestination := job.ResourceURN("resource-A")
+			jobCDestination := job.ResourceURN("resource-C")
+			pluginService.On("ValidateTemplates", ctx, detailedTenant, mock.Anything).Return(nil)
+			pluginService.On("ValidateConfigs", ctx, mock.Anything).Return(nil)
+			pluginService.On("GenerateDestination", ctx, detailedTenant, specA.Task()).Return(jobADestination, nil).Once()
+			pluginService.On("GenerateDestination", ctx, detailedTenant, specC.Task()).Return(jobCDestination, nil).Once()
+
+			jobAUpstreamName := []job.ResourceURN{"resource-B"}
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return(jobAUpstreamName, nil, nil)
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specC, true).Return(nil, nil, nil)
+
+			jobA := job.NewJob(sampleTenant, specA, jobADestination, jobAUpstreamName)
+			jobC := job.NewJob(sampleTenant, specC, jobCDestination, nil)
+			jobs := []*job.Job{jobA, jobC}
+			jobRepo.On("Add", ctx, mock.Anything).Return(jobs, nil, nil)
+
+			upstreamB := job.NewUpstreamResolved("job-B", "", "resource-B", sampleTenant, "inferred", taskName, false)
+			jobAWithUpstream := job.NewWithUpstream(jobA, []*job.Upstream{upstreamB})
+			jobCWithUpstream := job.NewWithUpstream(jobC, nil)
+			jobsWithUpstream := []*job.WithUpstream{jobAWithUpstream, jobCWithUpstream}
+			upstreamResolver.On("BulkResolve", ctx, project.Name(), jobs, mock.Anything).Return(jobsWithUpstream, nil, nil)
+
+			upstreamRepo.On("ReplaceUpstreams", ctx, jobsWithUpstream).Return(nil)
+
+			upstreamA := job.NewUpstreamResolved("job-A", "", "resource-A", sampleTenant, "inferred", taskName, false)
+			upstreamRepo.On("GetUpstreams", ctx, project.Name(), job.Name("job-B")).Return([]*job.Upstream{upstreamA}, nil)
+
+			jobNamesToUpload := []string{jobC.GetName()}
+			jobDeploymentService.On("UploadJobs", ctx, sampleTenant, jobNamesToUpload, emptyJobNames).Return(nil)
+
+			eventHandler.On("HandleEvent", mock.Anything).Times(2)
+
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
+			err := jobService.Add(ctx, sampleTenant, specs)
+			assert.ErrorContains(t, err, "circular dependency detected: test-proj/job-A → test-proj/job-B → test-proj/job-A")
+		})
 		t.Run("return error if unable to get detailed tenant", func(t *testing.T) {
 			jobRepo := new(JobRepository)
 			defer jobRepo.AssertExpectations(t)
@@ -145,7 +229,7 @@ func TestJobService(t *testing.T) {
 
 			tenantDetailsGetter.On("GetDetails", ctx, sampleTenant).Return(&tenant.WithDetails{}, errors.New("internal error"))
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil, nil)
 			err := jobService.Add(ctx, sampleTenant, specs)
 			assert.ErrorContains(t, err, "internal error")
 		})
@@ -183,13 +267,15 @@ func TestJobService(t *testing.T) {
 			jobADestination := job.ResourceURN("resource-A")
 			jobBDestination := job.ResourceURN("resource-B")
 			var jobDestination job.ResourceURN
+			pluginService.On("ValidateTemplates", ctx, detailedTenant, mock.Anything).Return(nil)
+			pluginService.On("ValidateConfigs", ctx, mock.Anything).Return(nil)
 			pluginService.On("GenerateDestination", ctx, detailedTenant, specB.Task()).Return(jobBDestination, nil).Once()
 			pluginService.On("GenerateDestination", ctx, detailedTenant, specA.Task()).Return(jobADestination, nil).Once()
 			pluginService.On("GenerateDestination", ctx, detailedTenant, specC.Task()).Return(jobDestination, errors.New("generate destination error")).Once()
 
 			jobAUpstreamName := []job.ResourceURN{"job-B"}
-			pluginService.On("GenerateUpstreams", ctx, detailedTenant, mock.Anything, true).Return([]job.ResourceURN{}, errors.New("generate upstream error"))
-			pluginService.On("GenerateUpstreams", ctx, detailedTenant, mock.Anything, true).Return(jobAUpstreamName, nil)
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, mock.Anything, true).Return([]job.ResourceURN{}, nil, errors.New("generate upstream error"))
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, mock.Anything, true).Return(jobAUpstreamName, nil, nil)
 
 			jobA := job.NewJob(sampleTenant, specA, jobADestination, jobAUpstreamName)
 			jobs := []*job.Job{jobA}
@@ -200,13 +286,14 @@ func TestJobService(t *testing.T) {
 			upstreamResolver.On("BulkResolve", ctx, project.Name(), jobs, mock.Anything).Return([]*job.WithUpstream{jobWithUpstream}, nil, nil)
 
 			upstreamRepo.On("ReplaceUpstreams", ctx, []*job.WithUpstream{jobWithUpstream}).Return(nil)
+			upstreamRepo.On("GetUpstreams", ctx, project.Name(), job.Name("job-B")).Return(nil, nil)
 
 			jobNamesToUpload := []string{jobA.GetName()}
 			jobDeploymentService.On("UploadJobs", ctx, sampleTenant, jobNamesToUpload, emptyJobNames).Return(nil)
 
 			eventHandler.On("HandleEvent", mock.Anything).Times(1)
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
 			err := jobService.Add(ctx, sampleTenant, specs)
 			assert.ErrorContains(t, err, "generate upstream error")
 		})
@@ -243,15 +330,80 @@ func TestJobService(t *testing.T) {
 
 			var jobADestination job.ResourceURN
 			jobBDestination := job.ResourceURN("resource-B")
+			pluginService.On("ValidateTemplates", ctx, detailedTenant, mock.Anything).Return(nil)
+			pluginService.On("ValidateConfigs", ctx, mock.Anything).Return(nil)
 			pluginService.On("GenerateDestination", ctx, detailedTenant, mock.Anything).Return(jobBDestination, nil).Once()
 			pluginService.On("GenerateDestination", ctx, detailedTenant, mock.Anything).Return(jobADestination, errors.New("generate destination error")).Once()
 
-			pluginService.On("GenerateUpstreams", ctx, detailedTenant, mock.Anything, true).Return(nil, errors.New("generate upstream error"))
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, mock.Anything, true).Return(nil, nil, errors.New("generate upstream error"))
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil, nil)
 			err := jobService.Add(ctx, sampleTenant, specs)
 			assert.ErrorContains(t, err, "generate upstream error")
 		})
+		t.Run("return error when templates of the job reference undefined variables", func(t *testing.T) {
+			jobRepo := new(JobRepository)
+			defer jobRepo.AssertExpectations(t)
+
+			upstreamRepo := new(UpstreamRepository)
+			defer upstreamRepo.AssertExpectations(t)
+
+			pluginService := new(PluginService)
+			defer pluginService.AssertExpectations(t)
+
+			upstreamResolver := new(UpstreamResolver)
+			defer upstreamResolver.AssertExpectations(t)
+
+			tenantDetailsGetter := new(TenantDetailsGetter)
+			defer tenantDetailsGetter.AssertExpectations(t)
+
+			specA, _ := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).Build()
+			specs := []*job.Spec{specA}
+
+			tenantDetailsGetter.On("GetDetails", ctx, sampleTenant).Return(detailedTenant, nil)
+
+			pluginService.On("ValidateTemplates", ctx, detailedTenant, specA).Return(errors.New(`asset: map has no entry for key "DATASET"`))
+
+			jobRepo.On("Add", ctx, mock.Anything).Return(nil, nil)
+			upstreamResolver.On("BulkResolve", ctx, mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
+			upstreamRepo.On("ReplaceUpstreams", ctx, mock.Anything).Return(nil)
+
+			jobService := service.NewJobService(jobRepo, upstreamRepo, nil, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil, nil)
+			err := jobService.Add(ctx, sampleTenant, specs)
+			assert.ErrorContains(t, err, `invalid templates in job-A: asset: map has no entry for key "DATASET"`)
+		})
+		t.Run("return error when configs of the job do not follow the config schema of the plugin", func(t *testing.T) {
+			jobRepo := new(JobRepository)
+			defer jobRepo.AssertExpectations(t)
+
+			upstreamRepo := new(UpstreamRepository)
+			defer upstreamRepo.AssertExpectations(t)
+
+			pluginService := new(PluginService)
+			defer pluginService.AssertExpectations(t)
+
+			upstreamResolver := new(UpstreamResolver)
+			defer upstreamResolver.AssertExpectations(t)
+
+			tenantDetailsGetter := new(TenantDetailsGetter)
+			defer tenantDetailsGetter.AssertExpectations(t)
+
+			specA, _ := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).Build()
+			specs := []*job.Spec{specA}
+
+			tenantDetailsGetter.On("GetDetails", ctx, sampleTenant).Return(detailedTenant, nil)
+
+			pluginService.On("ValidateTemplates", ctx, detailedTenant, specA).Return(nil)
+			pluginService.On("ValidateConfigs", ctx, specA).Return(errors.New("task config: unknown config LOAD_METHD, did you mean LOAD_METHOD?"))
+
+			jobRepo.On("Add", ctx, mock.Anything).Return(nil, nil)
+			upstreamResolver.On("BulkResolve", ctx, mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
+			upstreamRepo.On("ReplaceUpstreams", ctx, mock.Anything).Return(nil)
+
+			jobService := service.NewJobService(jobRepo, upstreamRepo, nil, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil, nil)
+			err := jobService.Add(ctx, sampleTenant, specs)
+			assert.ErrorContains(t, err, "invalid configs in job-A: task config: unknown config LOAD_METHD, did you mean LOAD_METHOD?")
+		})
 		t.Run("should not skip nor return error if jobs does not have upstream mod and encounter issue on generate destination/upstream", func(t *testing.T) {
 			jobRepo := new(JobRepository)
 			defer jobRepo.AssertExpectations(t)
@@ -282,8 +434,10 @@ func TestJobService(t *testing.T) {
 			tenantDetailsGetter.On("GetDetails", ctx, sampleTenant).Return(detailedTenant, nil)
 
 			var jobADestination job.ResourceURN
+			pluginService.On("ValidateTemplates", ctx, detailedTenant, mock.Anything).Return(nil)
+			pluginService.On("ValidateConfigs", ctx, mock.Anything).Return(nil)
 			pluginService.On("GenerateDestination", ctx, detailedTenant, specA.Task()).Return(jobADestination, service.ErrUpstreamModNotFound).Once()
-			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return(nil, service.ErrUpstreamModNotFound)
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return(nil, nil, service.ErrUpstreamModNotFound)
 
 			jobA := job.NewJob(sampleTenant, specA, "", nil)
 			jobs := []*job.Job{jobA}
@@ -299,7 +453,7 @@ func TestJobService(t *testing.T) {
 
 			eventHandler.On("HandleEvent", mock.Anything).Times(1)
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
 			err := jobService.Add(ctx, sampleTenant, specs)
 			assert.NoError(t, err)
 		})
@@ -335,12 +489,14 @@ func TestJobService(t *testing.T) {
 
 			resourceA := job.ResourceURN("resource-A")
 			var resourceB job.ResourceURN
+			pluginService.On("ValidateTemplates", ctx, detailedTenant, mock.Anything).Return(nil)
+			pluginService.On("ValidateConfigs", ctx, mock.Anything).Return(nil)
 			pluginService.On("GenerateDestination", ctx, detailedTenant, specA.Task()).Return(resourceA, nil).Once()
 			pluginService.On("GenerateDestination", ctx, detailedTenant, specB.Task()).Return(resourceB, service.ErrUpstreamModNotFound).Once()
 
 			jobSourcesA := []job.ResourceURN{"resource-B"}
-			pluginService.On("GenerateUpstreams", ctx, detailedTenant, mock.Anything, true).Return(jobSourcesA, nil)
-			pluginService.On("GenerateUpstreams", ctx, detailedTenant, mock.Anything, true).Return(nil, service.ErrUpstreamModNotFound)
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, mock.Anything, true).Return(jobSourcesA, nil, nil)
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, mock.Anything, true).Return(nil, nil, service.ErrUpstreamModNotFound)
 
 			jobB := job.NewJob(sampleTenant, specB, "", nil)
 			savedJobs := []*job.Job{jobB}
@@ -356,7 +512,7 @@ func TestJobService(t *testing.T) {
 
 			eventHandler.On("HandleEvent", mock.Anything).Times(1)
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
 			err := jobService.Add(ctx, sampleTenant, specs)
 			assert.ErrorContains(t, err, "unable to save job A")
 		})
@@ -389,14 +545,16 @@ func TestJobService(t *testing.T) {
 			upstreamRepo.On("ReplaceUpstreams", ctx, mock.Anything).Return(nil)
 
 			resourceA := job.ResourceURN("resource-A")
+			pluginService.On("ValidateTemplates", ctx, detailedTenant, mock.Anything).Return(nil)
+			pluginService.On("ValidateConfigs", ctx, mock.Anything).Return(nil)
 			pluginService.On("GenerateDestination", ctx, detailedTenant, specA.Task()).Return(resourceA, nil).Once()
 
 			jobSourcesA := []job.ResourceURN{"resource-B"}
-			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return(jobSourcesA, nil)
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return(jobSourcesA, nil, nil)
 
 			jobRepo.On("Add", ctx, mock.Anything).Return([]*job.Job{}, errors.New("unable to save job A"), errors.New("all jobs failed"))
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil, nil)
 			err := jobService.Add(ctx, sampleTenant, specs)
 			assert.ErrorContains(t, err, "unable to save job A")
 		})
@@ -430,10 +588,12 @@ func TestJobService(t *testing.T) {
 			tenantDetailsGetter.On("GetDetails", ctx, sampleTenant).Return(detailedTenant, nil)
 
 			resourceA := job.ResourceURN("resource-A")
+			pluginService.On("ValidateTemplates", ctx, detailedTenant, mock.Anything).Return(nil)
+			pluginService.On("ValidateConfigs", ctx, mock.Anything).Return(nil)
 			pluginService.On("GenerateDestination", ctx, detailedTenant, specA.Task()).Return(resourceA, nil).Once()
 
 			jobSourcesA := []job.ResourceURN{"resource-B"}
-			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return(jobSourcesA, nil)
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return(jobSourcesA, nil, nil)
 
 			jobA := job.NewJob(sampleTenant, specA, resourceA, jobSourcesA)
 			jobs := []*job.Job{jobA}
@@ -450,7 +610,7 @@ func TestJobService(t *testing.T) {
 
 			eventHandler.On("HandleEvent", mock.Anything).Times(1)
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
 			err := jobService.Add(ctx, sampleTenant, specs)
 			assert.Error(t, err)
 		})
@@ -484,10 +644,12 @@ func TestJobService(t *testing.T) {
 			tenantDetailsGetter.On("GetDetails", ctx, sampleTenant).Return(detailedTenant, nil)
 
 			jobADestination := job.ResourceURN("resource-A")
+			pluginService.On("ValidateTemplates", ctx, detailedTenant, mock.Anything).Return(nil)
+			pluginService.On("ValidateConfigs", ctx, mock.Anything).Return(nil)
 			pluginService.On("GenerateDestination", ctx, detailedTenant, specA.Task()).Return(jobADestination, nil)
 
 			jobAUpstreamName := []job.ResourceURN{"job-B"}
-			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return(jobAUpstreamName, nil)
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return(jobAUpstreamName, nil, nil)
 
 			jobA := job.NewJob(sampleTenant, specA, jobADestination, jobAUpstreamName)
 			jobs := []*job.Job{jobA}
@@ -498,13 +660,14 @@ func TestJobService(t *testing.T) {
 			upstreamResolver.On("BulkResolve", ctx, project.Name(), jobs, mock.Anything).Return([]*job.WithUpstream{jobWithUpstream}, nil, nil)
 
 			upstreamRepo.On("ReplaceUpstreams", ctx, []*job.WithUpstream{jobWithUpstream}).Return(nil)
+			upstreamRepo.On("GetUpstreams", ctx, project.Name(), job.Name("job-B")).Return(nil, nil)
 
 			errorMsg := "internal error"
 			jobDeploymentService.On("UploadJobs", ctx, sampleTenant, mock.Anything, emptyJobNames).Return(errors.New(errorMsg))
 
 			eventHandler.On("HandleEvent", mock.Anything).Times(1)
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
 			err := jobService.Add(ctx, sampleTenant, specs)
 			assert.ErrorContains(t, err, errorMsg)
 		})
@@ -540,10 +703,12 @@ func TestJobService(t *testing.T) {
 			tenantDetailsGetter.On("GetDetails", ctx, sampleTenant).Return(detailedTenant, nil)
 
 			jobADestination := job.ResourceURN("resource-A")
+			pluginService.On("ValidateTemplates", ctx, detailedTenant, mock.Anything).Return(nil)
+			pluginService.On("ValidateConfigs", ctx, mock.Anything).Return(nil)
 			pluginService.On("GenerateDestination", ctx, detailedTenant, specA.Task()).Return(jobADestination, nil)
 
 			jobAUpstreamName := []job.ResourceURN{"job-B"}
-			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return(jobAUpstreamName, nil)
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return(jobAUpstreamName, nil, nil)
 
 			jobA := job.NewJob(sampleTenant, specA, jobADestination, jobAUpstreamName)
 			jobs := []*job.Job{jobA}
@@ -554,13 +719,14 @@ func TestJobService(t *testing.T) {
 			upstreamResolver.On("BulkResolve", ctx, project.Name(), jobs, mock.Anything).Return([]*job.WithUpstream{jobWithUpstream}, nil, nil)
 
 			upstreamRepo.On("ReplaceUpstreams", ctx, []*job.WithUpstream{jobWithUpstream}).Return(nil)
+			upstreamRepo.On("GetUpstreams", ctx, project.Name(), job.Name("job-B")).Return(nil, nil)
 
 			jobNamesToUpload := []string{jobA.GetName()}
 			jobDeploymentService.On("UploadJobs", ctx, sampleTenant, jobNamesToUpload, emptyJobNames).Return(nil)
 
 			eventHandler.On("HandleEvent", mock.Anything).Times(1)
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
 			err := jobService.Update(ctx, sampleTenant, specs)
 			assert.NoError(t, err)
 		})
@@ -588,7 +754,7 @@ func TestJobService(t *testing.T) {
 
 			tenantDetailsGetter.On("GetDetails", ctx, sampleTenant).Return(&tenant.WithDetails{}, errors.New("internal error"))
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil, nil)
 			err := jobService.Update(ctx, sampleTenant, specs)
 			assert.ErrorContains(t, err, "internal error")
 		})
@@ -626,13 +792,15 @@ func TestJobService(t *testing.T) {
 			jobADestination := job.ResourceURN("resource-A")
 			jobBDestination := job.ResourceURN("resource-B")
 			var jobDestination job.ResourceURN
+			pluginService.On("ValidateTemplates", ctx, detailedTenant, mock.Anything).Return(nil)
+			pluginService.On("ValidateConfigs", ctx, mock.Anything).Return(nil)
 			pluginService.On("GenerateDestination", ctx, detailedTenant, specB.Task()).Return(jobBDestination, nil).Once()
 			pluginService.On("GenerateDestination", ctx, detailedTenant, specA.Task()).Return(jobADestination, nil).Once()
 			pluginService.On("GenerateDestination", ctx, detailedTenant, specC.Task()).Return(jobDestination, errors.New("generate destination error")).Once()
 
 			jobAUpstreamName := []job.ResourceURN{"job-B"}
-			pluginService.On("GenerateUpstreams", ctx, detailedTenant, mock.Anything, true).Return([]job.ResourceURN{}, errors.New("generate upstream error"))
-			pluginService.On("GenerateUpstreams", ctx, detailedTenant, mock.Anything, true).Return(jobAUpstreamName, nil)
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, mock.Anything, true).Return([]job.ResourceURN{}, nil, errors.New("generate upstream error"))
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, mock.Anything, true).Return(jobAUpstreamName, nil, nil)
 
 			jobA := job.NewJob(sampleTenant, specA, jobADestination, jobAUpstreamName)
 			jobs := []*job.Job{jobA}
@@ -643,13 +811,14 @@ func TestJobService(t *testing.T) {
 			upstreamResolver.On("BulkResolve", ctx, project.Name(), jobs, mock.Anything).Return([]*job.WithUpstream{jobWithUpstream}, nil, nil)
 
 			upstreamRepo.On("ReplaceUpstreams", ctx, []*job.WithUpstream{jobWithUpstream}).Return(nil)
+			upstreamRepo.On("GetUpstreams", ctx, project.Name(), job.Name("job-B")).Return(nil, nil)
 
 			jobNamesToUpload := []string{jobA.GetName()}
 			jobDeploymentService.On("UploadJobs", ctx, sampleTenant, jobNamesToUpload, emptyJobNames).Return(nil)
 
 			eventHandler.On("HandleEvent", mock.Anything).Times(1)
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
 			err := jobService.Update(ctx, sampleTenant, specs)
 			assert.ErrorContains(t, err, "generate upstream error")
 		})
@@ -686,12 +855,14 @@ func TestJobService(t *testing.T) {
 
 			var jobADestination job.ResourceURN
 			jobBDestination := job.ResourceURN("resource-B")
+			pluginService.On("ValidateTemplates", ctx, detailedTenant, mock.Anything).Return(nil)
+			pluginService.On("ValidateConfigs", ctx, mock.Anything).Return(nil)
 			pluginService.On("GenerateDestination", ctx, detailedTenant, specB.Task()).Return(jobBDestination, nil).Once()
 			pluginService.On("GenerateDestination", ctx, detailedTenant, specA.Task()).Return(jobADestination, errors.New("generate destination error")).Once()
 
-			pluginService.On("GenerateUpstreams", ctx, detailedTenant, mock.Anything, true).Return(nil, errors.New("generate upstream error"))
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, mock.Anything, true).Return(nil, nil, errors.New("generate upstream error"))
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil, nil)
 			err := jobService.Update(ctx, sampleTenant, specs)
 			assert.ErrorContains(t, err, "generate upstream error")
 		})
@@ -725,8 +896,10 @@ func TestJobService(t *testing.T) {
 			tenantDetailsGetter.On("GetDetails", ctx, sampleTenant).Return(detailedTenant, nil)
 
 			var jobADestination job.ResourceURN
+			pluginService.On("ValidateTemplates", ctx, detailedTenant, mock.Anything).Return(nil)
+			pluginService.On("ValidateConfigs", ctx, mock.Anything).Return(nil)
 			pluginService.On("GenerateDestination", ctx, detailedTenant, specA.Task()).Return(jobADestination, service.ErrUpstreamModNotFound).Once()
-			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return(nil, service.ErrUpstreamModNotFound)
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return(nil, nil, service.ErrUpstreamModNotFound)
 
 			jobA := job.NewJob(sampleTenant, specA, "", nil)
 			jobs := []*job.Job{jobA}
@@ -742,7 +915,7 @@ func TestJobService(t *testing.T) {
 
 			eventHandler.On("HandleEvent", mock.Anything).Times(1)
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
 			err := jobService.Update(ctx, sampleTenant, specs)
 			assert.NoError(t, err)
 		})
@@ -778,12 +951,14 @@ func TestJobService(t *testing.T) {
 
 			resourceA := job.ResourceURN("resource-A")
 			var resourceB job.ResourceURN
+			pluginService.On("ValidateTemplates", ctx, detailedTenant, mock.Anything).Return(nil)
+			pluginService.On("ValidateConfigs", ctx, mock.Anything).Return(nil)
 			pluginService.On("GenerateDestination", ctx, detailedTenant, specA.Task()).Return(resourceA, nil).Once()
 			pluginService.On("GenerateDestination", ctx, detailedTenant, specB.Task()).Return(resourceB, service.ErrUpstreamModNotFound).Once()
 
 			jobSourcesA := []job.ResourceURN{"resource-B"}
-			pluginService.On("GenerateUpstreams", ctx, detailedTenant, mock.Anything, true).Return(jobSourcesA, nil)
-			pluginService.On("GenerateUpstreams", ctx, detailedTenant, mock.Anything, true).Return(nil, service.ErrUpstreamModNotFound)
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, mock.Anything, true).Return(jobSourcesA, nil, nil)
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, mock.Anything, true).Return(nil, nil, service.ErrUpstreamModNotFound)
 
 			jobB := job.NewJob(sampleTenant, specB, "", nil)
 			savedJobs := []*job.Job{jobB}
@@ -799,7 +974,7 @@ func TestJobService(t *testing.T) {
 
 			eventHandler.On("HandleEvent", mock.Anything).Times(1)
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
 			err := jobService.Update(ctx, sampleTenant, specs)
 			assert.ErrorContains(t, err, "unable to save job A")
 		})
@@ -832,14 +1007,16 @@ func TestJobService(t *testing.T) {
 			upstreamRepo.On("ReplaceUpstreams", ctx, mock.Anything).Return(nil)
 
 			resourceA := job.ResourceURN("resource-A")
+			pluginService.On("ValidateTemplates", ctx, detailedTenant, mock.Anything).Return(nil)
+			pluginService.On("ValidateConfigs", ctx, mock.Anything).Return(nil)
 			pluginService.On("GenerateDestination", ctx, detailedTenant, specA.Task()).Return(resourceA, nil).Once()
 
 			jobSourcesA := []job.ResourceURN{"resource-B"}
-			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return(jobSourcesA, nil)
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return(jobSourcesA, nil, nil)
 
 			jobRepo.On("Update", ctx, mock.Anything).Return([]*job.Job{}, errors.New("unable to update job A"), errors.New("all jobs failed"))
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil, nil)
 			err := jobService.Update(ctx, sampleTenant, specs)
 			assert.ErrorContains(t, err, "unable to update job A")
 		})
@@ -873,10 +1050,12 @@ func TestJobService(t *testing.T) {
 			tenantDetailsGetter.On("GetDetails", ctx, sampleTenant).Return(detailedTenant, nil)
 
 			resourceA := job.ResourceURN("resource-A")
+			pluginService.On("ValidateTemplates", ctx, detailedTenant, mock.Anything).Return(nil)
+			pluginService.On("ValidateConfigs", ctx, mock.Anything).Return(nil)
 			pluginService.On("GenerateDestination", ctx, detailedTenant, specA.Task()).Return(resourceA, nil).Once()
 
 			jobSourcesA := []job.ResourceURN{"resource-B"}
-			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return(jobSourcesA, nil)
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return(jobSourcesA, nil, nil)
 
 			jobA := job.NewJob(sampleTenant, specA, resourceA, jobSourcesA)
 			jobs := []*job.Job{jobA}
@@ -892,7 +1071,7 @@ func TestJobService(t *testing.T) {
 
 			eventHandler.On("HandleEvent", mock.Anything).Times(1)
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
 			err := jobService.Update(ctx, sampleTenant, specs)
 			assert.Error(t, err)
 		})
@@ -926,10 +1105,12 @@ func TestJobService(t *testing.T) {
 			tenantDetailsGetter.On("GetDetails", ctx, sampleTenant).Return(detailedTenant, nil)
 
 			jobADestination := job.ResourceURN("resource-A")
+			pluginService.On("ValidateTemplates", ctx, detailedTenant, mock.Anything).Return(nil)
+			pluginService.On("ValidateConfigs", ctx, mock.Anything).Return(nil)
 			pluginService.On("GenerateDestination", ctx, detailedTenant, specA.Task()).Return(jobADestination, nil)
 
 			jobAUpstreamName := []job.ResourceURN{"job-B"}
-			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return(jobAUpstreamName, nil)
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return(jobAUpstreamName, nil, nil)
 
 			jobA := job.NewJob(sampleTenant, specA, jobADestination, jobAUpstreamName)
 			jobs := []*job.Job{jobA}
@@ -940,13 +1121,14 @@ func TestJobService(t *testing.T) {
 			upstreamResolver.On("BulkResolve", ctx, project.Name(), jobs, mock.Anything).Return([]*job.WithUpstream{jobWithUpstream}, nil, nil)
 
 			upstreamRepo.On("ReplaceUpstreams", ctx, []*job.WithUpstream{jobWithUpstream}).Return(nil)
+			upstreamRepo.On("GetUpstreams", ctx, project.Name(), job.Name("job-B")).Return(nil, nil)
 
 			errorMsg := "internal error"
 			jobDeploymentService.On("UploadJobs", ctx, sampleTenant, mock.Anything, emptyJobNames).Return(errors.New(errorMsg))
 
 			eventHandler.On("HandleEvent", mock.Anything).Times(1)
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
 			err := jobService.Update(ctx, sampleTenant, specs)
 			assert.ErrorContains(t, err, errorMsg)
 		})
@@ -962,7 +1144,7 @@ func TestJobService(t *testing.T) {
 			jobRepo.On("ChangeJobNamespace", ctx, specA.Name(), sampleTenant, newTenant).Return(errors.New("error in transaction"))
 			defer jobRepo.AssertExpectations(t)
 
-			jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, nil, nil)
+			jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 			err := jobService.ChangeNamespace(ctx, sampleTenant, newTenant, specA.Name())
 			assert.ErrorContains(t, err, "error in transaction")
 		})
@@ -973,7 +1155,7 @@ func TestJobService(t *testing.T) {
 			jobRepo.On("GetByJobName", ctx, project.Name(), specA.Name()).Return(nil, errors.New("error in fetching job from DB"))
 			defer jobRepo.AssertExpectations(t)
 
-			jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, nil, nil)
+			jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 			err := jobService.ChangeNamespace(ctx, sampleTenant, newTenant, specA.Name())
 			assert.ErrorContains(t, err, "error in fetching job from DB")
 		})
@@ -989,7 +1171,7 @@ func TestJobService(t *testing.T) {
 			jobDeploymentService.On("UploadJobs", ctx, sampleTenant, emptyJobNames, jobModified).Return(errors.New("error in upload jobs"))
 			defer jobDeploymentService.AssertExpectations(t)
 
-			jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, nil, jobDeploymentService)
+			jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, nil, jobDeploymentService, nil)
 			err := jobService.ChangeNamespace(ctx, sampleTenant, newTenant, specA.Name())
 			assert.ErrorContains(t, err, "error in upload jobs")
 		})
@@ -1006,7 +1188,7 @@ func TestJobService(t *testing.T) {
 			jobDeploymentService.On("UploadJobs", ctx, newTenant, jobModified, emptyJobNames).Return(errors.New("error in upload new job"))
 			defer jobDeploymentService.AssertExpectations(t)
 
-			jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, nil, jobDeploymentService)
+			jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, nil, jobDeploymentService, nil)
 
 			err := jobService.ChangeNamespace(ctx, sampleTenant, newTenant, specA.Name())
 			assert.ErrorContains(t, err, "error in upload new job")
@@ -1028,12 +1210,189 @@ func TestJobService(t *testing.T) {
 			eventHandler.On("HandleEvent", mock.Anything).Times(1)
 			defer eventHandler.AssertExpectations(t)
 
-			jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, eventHandler, nil, jobDeploymentService)
+			jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, eventHandler, nil, jobDeploymentService, nil)
 			err := jobService.ChangeNamespace(ctx, sampleTenant, newTenant, specA.Name())
 			assert.NoError(t, err)
 		})
 	})
 
+	t.Run("ChangeJobName", func(t *testing.T) {
+		specRenamed, _ := job.NewSpecBuilder(jobVersion, "job-A-renamed", "sample-owner", jobSchedule, jobWindow, jobTask).Build()
+		jobRenamed := job.NewJob(sampleTenant, specRenamed, "table-A", []job.ResourceURN{"table-B"})
+
+		t.Run("returns error if the new name is the same as the current one", func(t *testing.T) {
+			jobService := service.NewJobService(nil, nil, nil, nil, nil, nil, nil, log, nil, nil)
+			err := jobService.ChangeJobName(ctx, sampleTenant, "job-A", "job-A")
+			assert.ErrorContains(t, err, "new job name is the same as the current one")
+		})
+		t.Run("returns error if the job fails to be renamed", func(t *testing.T) {
+			jobRepo := new(JobRepository)
+			jobRepo.On("ChangeJobName", ctx, sampleTenant, job.Name("job-A"), specRenamed.Name()).Return(errors.New("job already exists"))
+			defer jobRepo.AssertExpectations(t)
+
+			jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, log, nil, nil)
+			err := jobService.ChangeJobName(ctx, sampleTenant, "job-A", specRenamed.Name())
+			assert.ErrorContains(t, err, "job already exists")
+		})
+		t.Run("moves the job on scheduler and redeploys its downstream jobs", func(t *testing.T) {
+			jobRepo := new(JobRepository)
+			jobRepo.On("ChangeJobName", ctx, sampleTenant, job.Name("job-A"), specRenamed.Name()).Return(nil)
+			jobRepo.On("GetByJobName", ctx, project.Name(), specRenamed.Name()).Return(jobRenamed, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			downstreamRepo := new(DownstreamRepository)
+			downstreamRepo.On("GetDownstreamByJobName", ctx, project.Name(), specRenamed.Name()).Return([]*job.Downstream{
+				job.NewDownstream("job-B", project.Name(), namespace.Name(), taskName),
+				job.NewDownstream("job-C", project.Name(), otherTenant.NamespaceName(), taskName),
+			}, nil)
+			defer downstreamRepo.AssertExpectations(t)
+
+			jobDeploymentService := new(JobDeploymentService)
+			jobDeploymentService.On("UploadJobs", ctx, sampleTenant, []string{"job-A-renamed"}, []string{"job-A"}).Return(nil)
+			jobDeploymentService.On("UploadJobs", ctx, sampleTenant, []string{"job-B"}, emptyJobNames).Return(nil)
+			jobDeploymentService.On("UploadJobs", ctx, otherTenant, []string{"job-C"}, emptyJobNames).Return(nil)
+			defer jobDeploymentService.AssertExpectations(t)
+
+			eventHandler := newEventHandler(t)
+			eventHandler.On("HandleEvent", mock.Anything).Times(2)
+			defer eventHandler.AssertExpectations(t)
+
+			jobService := service.NewJobService(jobRepo, nil, downstreamRepo, nil, nil, nil, eventHandler, log, jobDeploymentService, nil)
+			err := jobService.ChangeJobName(ctx, sampleTenant, "job-A", specRenamed.Name())
+			assert.NoError(t, err)
+		})
+	})
+
+	t.Run("MoveJob", func(t *testing.T) {
+		specA, _ := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).Build()
+		jobA := job.NewJob(sampleTenant, specA, "table-A", []job.ResourceURN{"table-B"})
+		movedJobA := job.NewJob(otherTenant, specA, "table-A", []job.ResourceURN{"table-B"})
+
+		t.Run("returns error if the job is already in the target namespace", func(t *testing.T) {
+			jobService := service.NewJobService(nil, nil, nil, nil, nil, nil, nil, log, nil, nil)
+			err := jobService.MoveJob(ctx, sampleTenant, sampleTenant, specA.Name())
+			assert.ErrorContains(t, err, "job is already in namespace test-ns")
+		})
+		t.Run("returns error if the job templates refer to secrets not available in the target namespace", func(t *testing.T) {
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetByJobName", ctx, project.Name(), specA.Name()).Return(jobA, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			tenantDetailsGetter := new(TenantDetailsGetter)
+			tenantDetailsGetter.On("GetDetails", ctx, otherTenant).Return(detailedOtherTenant, nil)
+			defer tenantDetailsGetter.AssertExpectations(t)
+
+			pluginService := new(PluginService)
+			pluginService.On("ValidateTemplates", ctx, detailedOtherTenant, specA).Return(errors.New("secret.table_key is not defined"))
+			defer pluginService.AssertExpectations(t)
+
+			jobService := service.NewJobService(jobRepo, nil, nil, pluginService, nil, tenantDetailsGetter, nil, log, nil, nil)
+			err := jobService.MoveJob(ctx, sampleTenant, otherTenant, specA.Name())
+			assert.ErrorContains(t, err, "job job-A can not be moved to namespace other-ns: secret.table_key is not defined")
+		})
+		t.Run("changes the namespace of the job", func(t *testing.T) {
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetByJobName", ctx, project.Name(), specA.Name()).Return(jobA, nil).Once()
+			jobRepo.On("ChangeJobNamespace", ctx, specA.Name(), sampleTenant, otherTenant).Return(nil)
+			jobRepo.On("GetByJobName", ctx, project.Name(), specA.Name()).Return(movedJobA, nil).Once()
+			defer jobRepo.AssertExpectations(t)
+
+			tenantDetailsGetter := new(TenantDetailsGetter)
+			tenantDetailsGetter.On("GetDetails", ctx, otherTenant).Return(detailedOtherTenant, nil)
+			defer tenantDetailsGetter.AssertExpectations(t)
+
+			pluginService := new(PluginService)
+			pluginService.On("ValidateTemplates", ctx, detailedOtherTenant, specA).Return(nil)
+			defer pluginService.AssertExpectations(t)
+
+			jobDeploymentService := new(JobDeploymentService)
+			jobDeploymentService.On("UploadJobs", ctx, sampleTenant, emptyJobNames, []string{"job-A"}).Return(nil)
+			jobDeploymentService.On("UploadJobs", ctx, otherTenant, []string{"job-A"}, emptyJobNames).Return(nil)
+			defer jobDeploymentService.AssertExpectations(t)
+
+			eventHandler := newEventHandler(t)
+			eventHandler.On("HandleEvent", mock.Anything).Times(1)
+			defer eventHandler.AssertExpectations(t)
+
+			jobService := service.NewJobService(jobRepo, nil, nil, pluginService, nil, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
+			err := jobService.MoveJob(ctx, sampleTenant, otherTenant, specA.Name())
+			assert.NoError(t, err)
+		})
+	})
+
+	t.Run("RollbackJob", func(t *testing.T) {
+		specA, _ := job.NewSpecBuilder(jobVersion, "job-A", "new-owner", jobSchedule, jobWindow, jobTask).Build()
+		jobA := job.NewJob(sampleTenant, specA, "resource-A", nil)
+		previousSpecA, _ := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).Build()
+		previousVersion := &job.SpecVersion{ProjectName: project.Name(), JobName: specA.Name(), Version: 1, Spec: previousSpecA}
+
+		t.Run("returns error if the job is not in the namespace", func(t *testing.T) {
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetByJobName", ctx, project.Name(), specA.Name()).Return(jobA, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, log, nil, nil)
+			err := jobService.RollbackJob(ctx, otherTenant, specA.Name(), 1)
+			assert.ErrorContains(t, err, "job job-A not found in namespace other-ns")
+		})
+		t.Run("returns error if the version is not found", func(t *testing.T) {
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetByJobName", ctx, project.Name(), specA.Name()).Return(jobA, nil)
+			jobRepo.On("GetSpecVersion", ctx, project.Name(), specA.Name(), 3).Return(nil, optErrors.NotFound(job.EntityJobSpecVersion, "version 3 of job job-A is not found"))
+			defer jobRepo.AssertExpectations(t)
+
+			jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, log, nil, nil)
+			err := jobService.RollbackJob(ctx, sampleTenant, specA.Name(), 3)
+			assert.ErrorContains(t, err, "version 3 of job job-A is not found")
+		})
+		t.Run("redeploys the stored version of the job spec", func(t *testing.T) {
+			jobRepo := new(JobRepository)
+			defer jobRepo.AssertExpectations(t)
+
+			upstreamRepo := new(UpstreamRepository)
+			defer upstreamRepo.AssertExpectations(t)
+
+			pluginService := new(PluginService)
+			defer pluginService.AssertExpectations(t)
+
+			upstreamResolver := new(UpstreamResolver)
+			defer upstreamResolver.AssertExpectations(t)
+
+			tenantDetailsGetter := new(TenantDetailsGetter)
+			defer tenantDetailsGetter.AssertExpectations(t)
+
+			jobDeploymentService := new(JobDeploymentService)
+			defer jobDeploymentService.AssertExpectations(t)
+
+			eventHandler := newEventHandler(t)
+
+			jobRepo.On("GetByJobName", ctx, project.Name(), specA.Name()).Return(jobA, nil)
+			jobRepo.On("GetSpecVersion", ctx, project.Name(), specA.Name(), 1).Return(previousVersion, nil)
+
+			tenantDetailsGetter.On("GetDetails", ctx, sampleTenant).Return(detailedTenant, nil)
+			pluginService.On("ValidateTemplates", ctx, detailedTenant, previousSpecA).Return(nil)
+			pluginService.On("ValidateConfigs", ctx, previousSpecA).Return(nil)
+			pluginService.On("GenerateDestination", ctx, detailedTenant, previousSpecA.Task()).Return(job.ResourceURN("resource-A"), nil)
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, previousSpecA, true).Return(nil, nil, nil)
+
+			rolledBackJobA := job.NewJob(sampleTenant, previousSpecA, "resource-A", nil)
+			jobs := []*job.Job{rolledBackJobA}
+			jobRepo.On("Update", ctx, mock.Anything).Return(jobs, nil)
+
+			jobWithUpstream := job.NewWithUpstream(rolledBackJobA, nil)
+			upstreamResolver.On("BulkResolve", ctx, project.Name(), jobs, mock.Anything).Return([]*job.WithUpstream{jobWithUpstream}, nil)
+			upstreamRepo.On("ReplaceUpstreams", ctx, []*job.WithUpstream{jobWithUpstream}).Return(nil)
+
+			jobDeploymentService.On("UploadJobs", ctx, sampleTenant, []string{"job-A"}, emptyJobNames).Return(nil)
+
+			eventHandler.On("HandleEvent", mock.Anything).Times(1)
+
+			jobService := service.NewJobService(jobRepo, upstreamRepo, nil, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
+			err := jobService.RollbackJob(ctx, sampleTenant, specA.Name(), 1)
+			assert.NoError(t, err)
+		})
+	})
+
 	t.Run("Delete", func(t *testing.T) {
 		t.Run("deletes job without downstream", func(t *testing.T) {
 			jobRepo := new(JobRepository)
@@ -1060,8 +1419,8 @@ func TestJobService(t *testing.T) {
 
 			eventHandler.On("HandleEvent", mock.Anything).Times(1)
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, nil, nil, nil, eventHandler, log, jobDeploymentService)
-			affectedDownstream, err := jobService.Delete(ctx, sampleTenant, specA.Name(), false, false)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, nil, nil, nil, eventHandler, log, jobDeploymentService, nil)
+			affectedDownstream, err := jobService.Delete(ctx, sampleTenant, specA.Name(), false, false, "", "")
 			assert.NoError(t, err)
 			assert.Empty(t, affectedDownstream)
 		})
@@ -1089,16 +1448,28 @@ func TestJobService(t *testing.T) {
 				job.NewDownstream("job-C", project.Name(), namespace.Name(), taskName),
 			}
 
+			deletionRepo := new(DeletionRepository)
+			defer deletionRepo.AssertExpectations(t)
+
 			downstreamRepo.On("GetDownstreamByJobName", ctx, project.Name(), specA.Name()).Return(downstreamList, nil)
+			deletionRepo.On("GetDeletionConsents", ctx, project.Name(), specA.Name()).Return(nil, nil)
+			deletionRepo.On("AddDeletionAudit", ctx, &job.DeletionAudit{
+				ProjectName:          project.Name(),
+				JobName:              specA.Name(),
+				RequestedBy:          "user@example.com",
+				Reason:               "table is deprecated",
+				Forced:               true,
+				OverriddenDownstream: downstreamFullNames,
+			}).Return(nil)
 			jobRepo.On("Delete", ctx, project.Name(), specA.Name(), false).Return(nil)
 
 			jobNamesToRemove := []string{specA.Name().String()}
 			jobDeploymentService.On("UploadJobs", ctx, sampleTenant, emptyJobNames, jobNamesToRemove).Return(nil)
 
 			eventHandler.On("HandleEvent", mock.Anything).Times(1)
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, nil, nil, nil, eventHandler, log, jobDeploymentService)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, nil, nil, nil, eventHandler, log, jobDeploymentService, deletionRepo)
 
-			affectedDownstream, err := jobService.Delete(ctx, sampleTenant, specA.Name(), false, true)
+			affectedDownstream, err := jobService.Delete(ctx, sampleTenant, specA.Name(), false, true, "user@example.com", "table is deprecated")
 			assert.NoError(t, err)
 			assert.EqualValues(t, downstreamFullNames, affectedDownstream)
 		})
@@ -1106,92 +1477,290 @@ func TestJobService(t *testing.T) {
 			jobRepo := new(JobRepository)
 			defer jobRepo.AssertExpectations(t)
 
-			upstreamRepo := new(UpstreamRepository)
-			defer upstreamRepo.AssertExpectations(t)
+			upstreamRepo := new(UpstreamRepository)
+			defer upstreamRepo.AssertExpectations(t)
+
+			downstreamRepo := new(DownstreamRepository)
+			defer downstreamRepo.AssertExpectations(t)
+
+			specA, _ := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).Build()
+
+			downstreamList := []*job.Downstream{
+				job.NewDownstream("job-B", project.Name(), namespace.Name(), taskName),
+				job.NewDownstream("job-C", project.Name(), namespace.Name(), taskName),
+			}
+			downstreamRepo.On("GetDownstreamByJobName", ctx, project.Name(), specA.Name()).Return(downstreamList, nil)
+
+			deletionRepo := new(DeletionRepository)
+			defer deletionRepo.AssertExpectations(t)
+			consent, _ := job.NewDeletionConsent(project.Name(), specA.Name(), project.Name(), "job-B", "owner@example.com", "")
+			deletionRepo.On("GetDeletionConsents", ctx, project.Name(), specA.Name()).Return([]*job.DeletionConsent{consent}, nil)
+
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, nil, nil, nil, nil, log, nil, deletionRepo)
+			affectedDownstream, err := jobService.Delete(ctx, sampleTenant, specA.Name(), false, false, "", "")
+			assert.ErrorContains(t, err, "test-proj/job-C depends on this job without consenting to its deletion")
+			assert.Empty(t, affectedDownstream)
+		})
+		t.Run("deletes the job if all of its downstream consented", func(t *testing.T) {
+			jobRepo := new(JobRepository)
+			defer jobRepo.AssertExpectations(t)
+
+			downstreamRepo := new(DownstreamRepository)
+			defer downstreamRepo.AssertExpectations(t)
+
+			deletionRepo := new(DeletionRepository)
+			defer deletionRepo.AssertExpectations(t)
+
+			jobDeploymentService := new(JobDeploymentService)
+			defer jobDeploymentService.AssertExpectations(t)
+
+			eventHandler := newEventHandler(t)
+
+			specA, _ := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).Build()
+
+			downstreamList := []*job.Downstream{
+				job.NewDownstream("job-B", project.Name(), namespace.Name(), taskName),
+			}
+			downstreamRepo.On("GetDownstreamByJobName", ctx, project.Name(), specA.Name()).Return(downstreamList, nil)
+
+			consent, _ := job.NewDeletionConsent(project.Name(), specA.Name(), project.Name(), "job-B", "owner@example.com", "")
+			deletionRepo.On("GetDeletionConsents", ctx, project.Name(), specA.Name()).Return([]*job.DeletionConsent{consent}, nil)
+			deletionRepo.On("AddDeletionAudit", ctx, &job.DeletionAudit{
+				ProjectName:         project.Name(),
+				JobName:             specA.Name(),
+				ConsentedDownstream: job.FullNames{"test-proj/job-B"},
+			}).Return(nil)
+			jobRepo.On("Delete", ctx, project.Name(), specA.Name(), false).Return(nil)
+
+			jobNamesToRemove := []string{specA.Name().String()}
+			jobDeploymentService.On("UploadJobs", ctx, sampleTenant, emptyJobNames, jobNamesToRemove).Return(nil)
+
+			eventHandler.On("HandleEvent", mock.Anything).Times(1)
+
+			jobService := service.NewJobService(jobRepo, nil, downstreamRepo, nil, nil, nil, eventHandler, log, jobDeploymentService, deletionRepo)
+			affectedDownstream, err := jobService.Delete(ctx, sampleTenant, specA.Name(), false, false, "", "")
+			assert.NoError(t, err)
+			assert.EqualValues(t, []job.FullName{"test-proj/job-B"}, affectedDownstream)
+		})
+		t.Run("returns error if unable to record the deletion audit", func(t *testing.T) {
+			downstreamRepo := new(DownstreamRepository)
+			defer downstreamRepo.AssertExpectations(t)
+
+			deletionRepo := new(DeletionRepository)
+			defer deletionRepo.AssertExpectations(t)
+
+			specA, _ := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).Build()
+
+			downstreamList := []*job.Downstream{
+				job.NewDownstream("job-B", project.Name(), namespace.Name(), taskName),
+			}
+			downstreamRepo.On("GetDownstreamByJobName", ctx, project.Name(), specA.Name()).Return(downstreamList, nil)
+			deletionRepo.On("GetDeletionConsents", ctx, project.Name(), specA.Name()).Return(nil, nil)
+			deletionRepo.On("AddDeletionAudit", ctx, mock.Anything).Return(errors.New("internal error"))
+
+			jobService := service.NewJobService(nil, nil, downstreamRepo, nil, nil, nil, nil, log, nil, deletionRepo)
+			affectedDownstream, err := jobService.Delete(ctx, sampleTenant, specA.Name(), false, true, "", "")
+			assert.ErrorContains(t, err, "internal error")
+			assert.Empty(t, affectedDownstream)
+		})
+		t.Run("returns error if unable to get downstream", func(t *testing.T) {
+			jobRepo := new(JobRepository)
+			defer jobRepo.AssertExpectations(t)
+
+			upstreamRepo := new(UpstreamRepository)
+			defer upstreamRepo.AssertExpectations(t)
+
+			downstreamRepo := new(DownstreamRepository)
+			defer downstreamRepo.AssertExpectations(t)
+
+			specA, _ := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).Build()
+
+			downstreamRepo.On("GetDownstreamByJobName", ctx, project.Name(), specA.Name()).Return(nil, errors.New("internal error"))
+
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, nil, nil, nil, nil, log, nil, nil)
+
+			affectedDownstream, err := jobService.Delete(ctx, sampleTenant, specA.Name(), false, false, "", "")
+			assert.Error(t, err)
+			assert.Empty(t, affectedDownstream)
+		})
+		t.Run("returns error if unable to delete job", func(t *testing.T) {
+			jobRepo := new(JobRepository)
+			defer jobRepo.AssertExpectations(t)
+
+			upstreamRepo := new(UpstreamRepository)
+			defer upstreamRepo.AssertExpectations(t)
+
+			downstreamRepo := new(DownstreamRepository)
+			defer downstreamRepo.AssertExpectations(t)
+
+			specA, _ := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).Build()
+
+			downstreamRepo.On("GetDownstreamByJobName", ctx, project.Name(), specA.Name()).Return(nil, nil)
+			jobRepo.On("Delete", ctx, project.Name(), specA.Name(), false).Return(errors.New("internal error"))
+
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, nil, nil, nil, nil, log, nil, nil)
+			affectedDownstream, err := jobService.Delete(ctx, sampleTenant, specA.Name(), false, false, "", "")
+			assert.Error(t, err)
+			assert.Empty(t, affectedDownstream)
+		})
+		t.Run("return error if encounter issue when removing jobs from scheduler", func(t *testing.T) {
+			jobRepo := new(JobRepository)
+			defer jobRepo.AssertExpectations(t)
+
+			upstreamRepo := new(UpstreamRepository)
+			defer upstreamRepo.AssertExpectations(t)
+
+			downstreamRepo := new(DownstreamRepository)
+			defer downstreamRepo.AssertExpectations(t)
+
+			jobDeploymentService := new(JobDeploymentService)
+			defer jobDeploymentService.AssertExpectations(t)
+
+			eventHandler := newEventHandler(t)
+
+			specA, _ := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).Build()
+
+			downstreamRepo.On("GetDownstreamByJobName", ctx, project.Name(), specA.Name()).Return(nil, nil)
+			jobRepo.On("Delete", ctx, project.Name(), specA.Name(), false).Return(nil)
+
+			errorMsg := "internal error"
+			jobDeploymentService.On("UploadJobs", ctx, sampleTenant, emptyJobNames, mock.Anything).Return(errors.New(errorMsg))
+
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, nil, nil, nil, eventHandler, log, jobDeploymentService, nil)
+			affectedDownstream, err := jobService.Delete(ctx, sampleTenant, specA.Name(), false, false, "", "")
+			assert.ErrorContains(t, err, errorMsg)
+			assert.Empty(t, affectedDownstream)
+		})
+	})
+	t.Run("DeleteJobs", func(t *testing.T) {
+		downstreamB := job.NewDownstream("job-B", project.Name(), namespace.Name(), taskName)
+		downstreamC := job.NewDownstream("job-C", project.Name(), namespace.Name(), taskName)
+
+		t.Run("deletes the jobs depending only on each other", func(t *testing.T) {
+			jobRepo := new(JobRepository)
+			defer jobRepo.AssertExpectations(t)
+
+			downstreamRepo := new(DownstreamRepository)
+			defer downstreamRepo.AssertExpectations(t)
+
+			jobDeploymentService := new(JobDeploymentService)
+			defer jobDeploymentService.AssertExpectations(t)
+
+			eventHandler := newEventHandler(t)
+
+			downstreamRepo.On("GetDownstreamByJobName", ctx, project.Name(), job.Name("job-A")).Return([]*job.Downstream{downstreamB}, nil)
+			downstreamRepo.On("GetDownstreamByJobName", ctx, project.Name(), job.Name("job-B")).Return(nil, nil)
+			jobRepo.On("BulkDelete", ctx, project.Name(), []job.Name{"job-A", "job-B"}, true).Return(nil)
+			jobDeploymentService.On("UploadJobs", ctx, sampleTenant, emptyJobNames, []string{"job-A", "job-B"}).Return(nil)
+			eventHandler.On("HandleEvent", mock.Anything).Times(2)
 
+			jobService := service.NewJobService(jobRepo, nil, downstreamRepo, nil, nil, nil, eventHandler, log, jobDeploymentService, nil)
+			affectedDownstream, err := jobService.DeleteJobs(ctx, sampleTenant, []job.Name{"job-A", "job-B", "job-A"}, true, false, "", "")
+			assert.NoError(t, err)
+			assert.Empty(t, affectedDownstream)
+		})
+		t.Run("does not delete any of the jobs if one has downstream outside of the deletion and not a force delete", func(t *testing.T) {
 			downstreamRepo := new(DownstreamRepository)
 			defer downstreamRepo.AssertExpectations(t)
 
-			specA, _ := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).Build()
+			deletionRepo := new(DeletionRepository)
+			defer deletionRepo.AssertExpectations(t)
 
-			downstreamList := []*job.Downstream{
-				job.NewDownstream("job-B", project.Name(), namespace.Name(), taskName),
-				job.NewDownstream("job-C", project.Name(), namespace.Name(), taskName),
-			}
-			downstreamRepo.On("GetDownstreamByJobName", ctx, project.Name(), specA.Name()).Return(downstreamList, nil)
+			downstreamRepo.On("GetDownstreamByJobName", ctx, project.Name(), job.Name("job-A")).Return([]*job.Downstream{downstreamB, downstreamC}, nil)
+			downstreamRepo.On("GetDownstreamByJobName", ctx, project.Name(), job.Name("job-B")).Return(nil, nil)
+			deletionRepo.On("GetDeletionConsents", ctx, project.Name(), job.Name("job-A")).Return(nil, nil)
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, nil, nil, nil, nil, log, nil)
-			affectedDownstream, err := jobService.Delete(ctx, sampleTenant, specA.Name(), false, false)
-			assert.Error(t, err)
+			jobService := service.NewJobService(nil, nil, downstreamRepo, nil, nil, nil, nil, log, nil, deletionRepo)
+			affectedDownstream, err := jobService.DeleteJobs(ctx, sampleTenant, []job.Name{"job-A", "job-B"}, false, false, "", "")
+			assert.ErrorContains(t, err, "test-proj/job-C depends on job job-A without consenting to the deletion")
 			assert.Empty(t, affectedDownstream)
 		})
-		t.Run("returns error if unable to get downstream", func(t *testing.T) {
+		t.Run("deletes the jobs with downstream outside of the deletion if it is a force delete", func(t *testing.T) {
 			jobRepo := new(JobRepository)
 			defer jobRepo.AssertExpectations(t)
 
-			upstreamRepo := new(UpstreamRepository)
-			defer upstreamRepo.AssertExpectations(t)
-
 			downstreamRepo := new(DownstreamRepository)
 			defer downstreamRepo.AssertExpectations(t)
 
-			specA, _ := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).Build()
+			deletionRepo := new(DeletionRepository)
+			defer deletionRepo.AssertExpectations(t)
 
-			downstreamRepo.On("GetDownstreamByJobName", ctx, project.Name(), specA.Name()).Return(nil, errors.New("internal error"))
+			jobDeploymentService := new(JobDeploymentService)
+			defer jobDeploymentService.AssertExpectations(t)
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, nil, nil, nil, nil, log, nil)
+			eventHandler := newEventHandler(t)
 
-			affectedDownstream, err := jobService.Delete(ctx, sampleTenant, specA.Name(), false, false)
-			assert.Error(t, err)
-			assert.Empty(t, affectedDownstream)
+			downstreamRepo.On("GetDownstreamByJobName", ctx, project.Name(), job.Name("job-A")).Return([]*job.Downstream{downstreamC}, nil)
+			deletionRepo.On("GetDeletionConsents", ctx, project.Name(), job.Name("job-A")).Return(nil, nil)
+			deletionRepo.On("AddDeletionAudit", ctx, &job.DeletionAudit{
+				ProjectName:          project.Name(),
+				JobName:              "job-A",
+				RequestedBy:          "user@example.com",
+				Reason:               "table is deprecated",
+				Forced:               true,
+				OverriddenDownstream: job.FullNames{"test-proj/job-C"},
+			}).Return(nil)
+			jobRepo.On("BulkDelete", ctx, project.Name(), []job.Name{"job-A"}, false).Return(nil)
+			jobDeploymentService.On("UploadJobs", ctx, sampleTenant, emptyJobNames, []string{"job-A"}).Return(nil)
+			eventHandler.On("HandleEvent", mock.Anything).Times(1)
+
+			jobService := service.NewJobService(jobRepo, nil, downstreamRepo, nil, nil, nil, eventHandler, log, jobDeploymentService, deletionRepo)
+			affectedDownstream, err := jobService.DeleteJobs(ctx, sampleTenant, []job.Name{"job-A"}, false, true, "user@example.com", "table is deprecated")
+			assert.NoError(t, err)
+			assert.EqualValues(t, []job.FullName{"test-proj/job-C"}, affectedDownstream)
 		})
-		t.Run("returns error if unable to delete job", func(t *testing.T) {
+		t.Run("returns error and does not remove the jobs from scheduler if the deletion failed", func(t *testing.T) {
 			jobRepo := new(JobRepository)
 			defer jobRepo.AssertExpectations(t)
 
-			upstreamRepo := new(UpstreamRepository)
-			defer upstreamRepo.AssertExpectations(t)
-
 			downstreamRepo := new(DownstreamRepository)
 			defer downstreamRepo.AssertExpectations(t)
 
-			specA, _ := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).Build()
-
-			downstreamRepo.On("GetDownstreamByJobName", ctx, project.Name(), specA.Name()).Return(nil, nil)
-			jobRepo.On("Delete", ctx, project.Name(), specA.Name(), false).Return(errors.New("internal error"))
+			downstreamRepo.On("GetDownstreamByJobName", ctx, project.Name(), job.Name("job-A")).Return(nil, nil)
+			jobRepo.On("BulkDelete", ctx, project.Name(), []job.Name{"job-A"}, false).Return(errors.New("internal error"))
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, nil, nil, nil, nil, log, nil)
-			affectedDownstream, err := jobService.Delete(ctx, sampleTenant, specA.Name(), false, false)
-			assert.Error(t, err)
-			assert.Empty(t, affectedDownstream)
+			jobService := service.NewJobService(jobRepo, nil, downstreamRepo, nil, nil, nil, nil, log, nil, nil)
+			_, err := jobService.DeleteJobs(ctx, sampleTenant, []job.Name{"job-A"}, false, false, "", "")
+			assert.ErrorContains(t, err, "internal error")
 		})
-		t.Run("return error if encounter issue when removing jobs from scheduler", func(t *testing.T) {
-			jobRepo := new(JobRepository)
-			defer jobRepo.AssertExpectations(t)
-
-			upstreamRepo := new(UpstreamRepository)
-			defer upstreamRepo.AssertExpectations(t)
+	})
+	t.Run("AddDeletionConsent", func(t *testing.T) {
+		t.Run("returns error if consent giver is empty", func(t *testing.T) {
+			jobService := service.NewJobService(nil, nil, nil, nil, nil, nil, nil, log, nil, nil)
 
+			err := jobService.AddDeletionConsent(ctx, project.Name(), "job-A", project.Name(), "job-B", "", "")
+			assert.ErrorContains(t, err, "requires the consent giver")
+		})
+		t.Run("returns error if consenting job is not a downstream of the job", func(t *testing.T) {
 			downstreamRepo := new(DownstreamRepository)
 			defer downstreamRepo.AssertExpectations(t)
 
-			jobDeploymentService := new(JobDeploymentService)
-			defer jobDeploymentService.AssertExpectations(t)
+			downstreamRepo.On("GetDownstreamByJobName", ctx, project.Name(), job.Name("job-A")).Return([]*job.Downstream{
+				job.NewDownstream("job-C", project.Name(), namespace.Name(), taskName),
+			}, nil)
 
-			eventHandler := newEventHandler(t)
+			jobService := service.NewJobService(nil, nil, downstreamRepo, nil, nil, nil, nil, log, nil, nil)
 
-			specA, _ := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).Build()
+			err := jobService.AddDeletionConsent(ctx, project.Name(), "job-A", project.Name(), "job-B", "owner@example.com", "")
+			assert.ErrorContains(t, err, "test-proj/job-B is not a downstream of job job-A")
+		})
+		t.Run("stores the consent of the downstream", func(t *testing.T) {
+			downstreamRepo := new(DownstreamRepository)
+			defer downstreamRepo.AssertExpectations(t)
 
-			downstreamRepo.On("GetDownstreamByJobName", ctx, project.Name(), specA.Name()).Return(nil, nil)
-			jobRepo.On("Delete", ctx, project.Name(), specA.Name(), false).Return(nil)
+			deletionRepo := new(DeletionRepository)
+			defer deletionRepo.AssertExpectations(t)
 
-			errorMsg := "internal error"
-			jobDeploymentService.On("UploadJobs", ctx, sampleTenant, emptyJobNames, mock.Anything).Return(errors.New(errorMsg))
+			downstreamRepo.On("GetDownstreamByJobName", ctx, project.Name(), job.Name("job-A")).Return([]*job.Downstream{
+				job.NewDownstream("job-B", project.Name(), namespace.Name(), taskName),
+			}, nil)
+			consent, _ := job.NewDeletionConsent(project.Name(), "job-A", project.Name(), "job-B", "owner@example.com", "moved to job-D")
+			deletionRepo.On("AddDeletionConsent", ctx, consent).Return(nil)
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, nil, nil, nil, eventHandler, log, jobDeploymentService)
-			affectedDownstream, err := jobService.Delete(ctx, sampleTenant, specA.Name(), false, false)
-			assert.ErrorContains(t, err, errorMsg)
-			assert.Empty(t, affectedDownstream)
+			jobService := service.NewJobService(nil, nil, downstreamRepo, nil, nil, nil, nil, log, nil, deletionRepo)
+
+			err := jobService.AddDeletionConsent(ctx, project.Name(), "job-A", project.Name(), "job-B", "owner@example.com", "moved to job-D")
+			assert.NoError(t, err)
 		})
 	})
 	t.Run("ReplaceAll", func(t *testing.T) {
@@ -1238,8 +1807,10 @@ func TestJobService(t *testing.T) {
 
 			jobRepo.On("GetAllByTenant", ctx, sampleTenant).Return(existingJobs, nil)
 
+			pluginService.On("ValidateTemplates", ctx, detailedTenant, mock.Anything).Return(nil)
+			pluginService.On("ValidateConfigs", ctx, mock.Anything).Return(nil)
 			pluginService.On("GenerateDestination", ctx, detailedTenant, specA.Task()).Return(jobADestination, nil).Once()
-			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return(jobAUpstreamName, nil)
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return(jobAUpstreamName, nil, nil)
 
 			jobRepo.On("Add", ctx, mock.Anything).Return([]*job.Job{jobA}, nil)
 
@@ -1249,6 +1820,7 @@ func TestJobService(t *testing.T) {
 			upstreamResolver.On("BulkResolve", ctx, project.Name(), []*job.Job{jobA}, mock.Anything).Return([]*job.WithUpstream{jobWithUpstream}, nil, nil)
 
 			upstreamRepo.On("ReplaceUpstreams", ctx, []*job.WithUpstream{jobWithUpstream}).Return(nil)
+			upstreamRepo.On("GetUpstreams", ctx, project.Name(), job.Name("job-B")).Return(nil, nil)
 
 			logWriter.On("Write", mock.Anything, mock.Anything).Return(nil)
 			eventHandler.On("HandleEvent", mock.Anything).Times(1)
@@ -1257,7 +1829,7 @@ func TestJobService(t *testing.T) {
 			var jobNamesToRemove []string
 			jobDeploymentService.On("UploadJobs", ctx, sampleTenant, jobNamesToUpload, jobNamesToRemove).Return(nil)
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
 			err := jobService.ReplaceAll(ctx, sampleTenant, incomingSpecs, jobNamesWithInvalidSpec, logWriter)
 			assert.NoError(t, err)
 		})
@@ -1305,8 +1877,10 @@ func TestJobService(t *testing.T) {
 
 			jobRepo.On("GetAllByTenant", ctx, sampleTenant).Return(existingSpecs, nil)
 
+			pluginService.On("ValidateTemplates", ctx, detailedTenant, mock.Anything).Return(nil)
+			pluginService.On("ValidateConfigs", ctx, mock.Anything).Return(nil)
 			pluginService.On("GenerateDestination", ctx, detailedTenant, specA.Task()).Return(jobADestination, nil).Once()
-			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return(jobAUpstreamName, nil)
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return(jobAUpstreamName, nil, nil)
 
 			jobRepo.On("Update", ctx, mock.Anything).Return([]*job.Job{jobA}, nil)
 			eventHandler.On("HandleEvent", mock.Anything).Times(1)
@@ -1317,6 +1891,7 @@ func TestJobService(t *testing.T) {
 			upstreamResolver.On("BulkResolve", ctx, project.Name(), []*job.Job{jobA}, mock.Anything).Return([]*job.WithUpstream{jobWithUpstream}, nil, nil)
 
 			upstreamRepo.On("ReplaceUpstreams", ctx, []*job.WithUpstream{jobWithUpstream}).Return(nil)
+			upstreamRepo.On("GetUpstreams", ctx, project.Name(), job.Name("job-B")).Return(nil, nil)
 
 			logWriter.On("Write", mock.Anything, mock.Anything).Return(nil)
 
@@ -1324,7 +1899,7 @@ func TestJobService(t *testing.T) {
 			var jobNamesToRemove []string
 			jobDeploymentService.On("UploadJobs", ctx, sampleTenant, jobNamesToUpload, jobNamesToRemove).Return(nil)
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
 			err := jobService.ReplaceAll(ctx, sampleTenant, incomingSpecs, jobNamesWithInvalidSpec, logWriter)
 			assert.NoError(t, err)
 		})
@@ -1379,7 +1954,7 @@ func TestJobService(t *testing.T) {
 			jobNamesToRemove := []string{specB.Name().String()}
 			jobDeploymentService.On("UploadJobs", ctx, sampleTenant, jobNamesToUpload, jobNamesToRemove).Return(nil)
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
 			err := jobService.ReplaceAll(ctx, sampleTenant, incomingSpecs, jobNamesWithInvalidSpec, logWriter)
 			assert.NoError(t, err)
 		})
@@ -1445,7 +2020,7 @@ func TestJobService(t *testing.T) {
 
 			jobDeploymentService.On("UploadJobs", ctx, sampleTenant, mock.Anything, mock.Anything).Return(nil)
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
 			err := jobService.ReplaceAll(ctx, sampleTenant, incomingSpecs, jobNamesWithInvalidSpec, logWriter)
 			assert.NoError(t, err)
 		})
@@ -1495,13 +2070,15 @@ func TestJobService(t *testing.T) {
 
 			jobADestination := job.ResourceURN("resource-A")
 			var jobBDestination job.ResourceURN
+			pluginService.On("ValidateTemplates", ctx, detailedTenant, mock.Anything).Return(nil)
+			pluginService.On("ValidateConfigs", ctx, mock.Anything).Return(nil)
 			pluginService.On("GenerateDestination", ctx, detailedTenant, specA.Task()).Return(jobADestination, nil).Once()
 			pluginService.On("GenerateDestination", ctx, detailedTenant, specB.Task()).Return(jobBDestination, service.ErrUpstreamModNotFound).Once()
 
 			jobAUpstreamNames := []job.ResourceURN{"job-B"}
 			var jobBUpstreamNames []job.ResourceURN
-			pluginService.On("GenerateUpstreams", ctx, detailedTenant, mock.Anything, true).Return(jobAUpstreamNames, nil)
-			pluginService.On("GenerateUpstreams", ctx, detailedTenant, mock.Anything, true).Return(jobBUpstreamNames, service.ErrUpstreamModNotFound)
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, mock.Anything, true).Return(jobAUpstreamNames, nil, nil)
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, mock.Anything, true).Return(jobBUpstreamNames, nil, service.ErrUpstreamModNotFound)
 
 			jobA := job.NewJob(sampleTenant, specA, jobADestination, jobAUpstreamNames)
 			jobRepo.On("Add", ctx, mock.Anything).Return([]*job.Job{jobA}, nil)
@@ -1517,6 +2094,7 @@ func TestJobService(t *testing.T) {
 			upstreamResolver.On("BulkResolve", ctx, project.Name(), []*job.Job{jobA, jobB}, mock.Anything).Return([]*job.WithUpstream{jobWithUpstream}, nil, nil)
 
 			upstreamRepo.On("ReplaceUpstreams", ctx, []*job.WithUpstream{jobWithUpstream}).Return(nil)
+			upstreamRepo.On("GetUpstreams", ctx, project.Name(), job.Name("job-B")).Return(nil, nil)
 
 			logWriter.On("Write", mock.Anything, mock.Anything).Return(nil)
 			eventHandler.On("HandleEvent", mock.Anything).Times(3)
@@ -1525,7 +2103,7 @@ func TestJobService(t *testing.T) {
 			jobNamesToRemove := []string{existingJobC.GetName()}
 			jobDeploymentService.On("UploadJobs", ctx, sampleTenant, jobNamesToUpload, jobNamesToRemove).Return(nil)
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
 			err := jobService.ReplaceAll(ctx, sampleTenant, incomingSpecs, jobNamesWithInvalidSpec, logWriter)
 			assert.NoError(t, err)
 		})
@@ -1577,13 +2155,15 @@ func TestJobService(t *testing.T) {
 
 			jobADestination := job.ResourceURN("resource-A")
 			var jobBDestination job.ResourceURN
+			pluginService.On("ValidateTemplates", ctx, detailedTenant, mock.Anything).Return(nil)
+			pluginService.On("ValidateConfigs", ctx, mock.Anything).Return(nil)
 			pluginService.On("GenerateDestination", ctx, detailedTenant, specA.Task()).Return(jobADestination, nil).Once()
 			pluginService.On("GenerateDestination", ctx, detailedTenant, specB.Task()).Return(jobBDestination, service.ErrUpstreamModNotFound).Once()
 
 			jobAUpstreamNames := []job.ResourceURN{"job-B"}
 			var jobBUpstreamNames []job.ResourceURN
-			pluginService.On("GenerateUpstreams", ctx, detailedTenant, mock.Anything, true).Return(jobAUpstreamNames, nil)
-			pluginService.On("GenerateUpstreams", ctx, detailedTenant, mock.Anything, true).Return(jobBUpstreamNames, service.ErrUpstreamModNotFound)
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, mock.Anything, true).Return(jobAUpstreamNames, nil, nil)
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, mock.Anything, true).Return(jobBUpstreamNames, nil, service.ErrUpstreamModNotFound)
 
 			jobA := job.NewJob(sampleTenant, specA, jobADestination, jobAUpstreamNames)
 			jobRepo.On("Add", ctx, mock.Anything).Return([]*job.Job{jobA}, nil)
@@ -1599,6 +2179,7 @@ func TestJobService(t *testing.T) {
 			upstreamResolver.On("BulkResolve", ctx, project.Name(), []*job.Job{jobA, jobB}, mock.Anything).Return([]*job.WithUpstream{jobWithUpstream}, nil, nil)
 
 			upstreamRepo.On("ReplaceUpstreams", ctx, []*job.WithUpstream{jobWithUpstream}).Return(nil)
+			upstreamRepo.On("GetUpstreams", ctx, project.Name(), job.Name("job-B")).Return(nil, nil)
 
 			logWriter.On("Write", mock.Anything, mock.Anything).Return(nil)
 			eventHandler.On("HandleEvent", mock.Anything).Times(3)
@@ -1607,7 +2188,7 @@ func TestJobService(t *testing.T) {
 			jobNamesToRemove := []string{existingJobC.GetName()}
 			jobDeploymentService.On("UploadJobs", ctx, sampleTenant, jobNamesToUpload, jobNamesToRemove).Return(nil)
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
 			err := jobService.ReplaceAll(ctx, sampleTenant, incomingSpecs, []job.Name{"job-D"}, logWriter)
 			assert.NoError(t, err)
 		})
@@ -1650,6 +2231,8 @@ func TestJobService(t *testing.T) {
 			jobRepo.On("GetAllByTenant", ctx, sampleTenant).Return(existingSpecs, nil)
 
 			var specADestination job.ResourceURN
+			pluginService.On("ValidateTemplates", ctx, detailedTenant, mock.Anything).Return(nil)
+			pluginService.On("ValidateConfigs", ctx, mock.Anything).Return(nil)
 			pluginService.On("GenerateDestination", ctx, detailedTenant, specA.Task()).Return(specADestination, errors.New("internal error")).Once()
 
 			downstreamRepo.On("GetDownstreamByJobName", ctx, project.Name(), existingSpecC.Name()).Return(nil, nil)
@@ -1662,7 +2245,7 @@ func TestJobService(t *testing.T) {
 			jobNamesToRemove := []string{existingJobC.GetName()}
 			jobDeploymentService.On("UploadJobs", ctx, sampleTenant, jobNamesToUpload, jobNamesToRemove).Return(nil)
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
 			err := jobService.ReplaceAll(ctx, sampleTenant, incomingSpecs, jobNamesWithInvalidSpec, logWriter)
 			assert.ErrorContains(t, err, "internal error")
 		})
@@ -1709,6 +2292,8 @@ func TestJobService(t *testing.T) {
 			jobRepo.On("GetAllByTenant", ctx, sampleTenant).Return(existingSpecs, nil)
 
 			var jobBDestination job.ResourceURN
+			pluginService.On("ValidateTemplates", ctx, detailedTenant, mock.Anything).Return(nil)
+			pluginService.On("ValidateConfigs", ctx, mock.Anything).Return(nil)
 			pluginService.On("GenerateDestination", ctx, detailedTenant, specB.Task()).Return(jobBDestination, errors.New("internal error")).Once()
 
 			downstreamRepo.On("GetDownstreamByJobName", ctx, project.Name(), existingSpecC.Name()).Return(nil, nil)
@@ -1721,7 +2306,7 @@ func TestJobService(t *testing.T) {
 			jobNamesToRemove := []string{existingJobC.GetName()}
 			jobDeploymentService.On("UploadJobs", ctx, sampleTenant, jobNamesToUpload, jobNamesToRemove).Return(nil)
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
 			err := jobService.ReplaceAll(ctx, sampleTenant, incomingSpecs, jobNamesWithInvalidSpec, logWriter)
 			assert.ErrorContains(t, err, "internal error")
 		})
@@ -1769,10 +2354,12 @@ func TestJobService(t *testing.T) {
 			jobRepo.On("GetAllByTenant", ctx, sampleTenant).Return(existingSpecs, nil)
 
 			jobADestination := job.ResourceURN("resource-A")
+			pluginService.On("ValidateTemplates", ctx, detailedTenant, mock.Anything).Return(nil)
+			pluginService.On("ValidateConfigs", ctx, mock.Anything).Return(nil)
 			pluginService.On("GenerateDestination", ctx, detailedTenant, specA.Task()).Return(jobADestination, nil).Once()
 
 			jobAUpstreamNames := []job.ResourceURN{"job-B"}
-			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return(jobAUpstreamNames, nil)
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return(jobAUpstreamNames, nil, nil)
 
 			jobA := job.NewJob(sampleTenant, specA, jobADestination, jobAUpstreamNames)
 			jobRepo.On("Add", ctx, mock.Anything).Return([]*job.Job{jobA}, nil)
@@ -1791,6 +2378,7 @@ func TestJobService(t *testing.T) {
 			upstreamResolver.On("BulkResolve", ctx, project.Name(), []*job.Job{jobA}, mock.Anything).Return([]*job.WithUpstream{jobWithUpstream}, nil, nil)
 
 			upstreamRepo.On("ReplaceUpstreams", ctx, []*job.WithUpstream{jobWithUpstream}).Return(nil)
+			upstreamRepo.On("GetUpstreams", ctx, project.Name(), job.Name("job-B")).Return(nil, nil)
 
 			logWriter.On("Write", mock.Anything, mock.Anything).Return(nil)
 			eventHandler.On("HandleEvent", mock.Anything).Times(2)
@@ -1799,7 +2387,7 @@ func TestJobService(t *testing.T) {
 			jobNamesToRemove := []string{existingJobD.GetName()}
 			jobDeploymentService.On("UploadJobs", ctx, sampleTenant, jobNamesToUpload, jobNamesToRemove).Return(nil)
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
 			err := jobService.ReplaceAll(ctx, sampleTenant, incomingSpecs, jobNamesWithInvalidSpec, logWriter)
 			assert.ErrorContains(t, err, "job is being used by")
 		})
@@ -1845,11 +2433,13 @@ func TestJobService(t *testing.T) {
 
 			jobRepo.On("GetAllByTenant", ctx, sampleTenant).Return([]*job.Job{}, nil)
 
+			pluginService.On("ValidateTemplates", ctx, detailedTenant, mock.Anything).Return(nil)
+			pluginService.On("ValidateConfigs", ctx, mock.Anything).Return(nil)
 			pluginService.On("GenerateDestination", ctx, detailedTenant, specA.Task()).Return(jobADestination, nil).Once()
-			pluginService.On("GenerateUpstreams", ctx, detailedTenant, mock.Anything, true).Return(jobAUpstreamName, nil).Once()
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, mock.Anything, true).Return(jobAUpstreamName, nil, nil).Once()
 
 			pluginService.On("GenerateDestination", ctx, detailedTenant, specB.Task()).Return(jobBDestination, nil).Once()
-			pluginService.On("GenerateUpstreams", ctx, detailedTenant, mock.Anything, true).Return(jobBUpstreamName, nil).Once()
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, mock.Anything, true).Return(jobBUpstreamName, nil, nil).Once()
 
 			jobRepo.On("Add", ctx, mock.Anything).Return([]*job.Job{jobA}, errors.New("internal error"))
 
@@ -1859,6 +2449,7 @@ func TestJobService(t *testing.T) {
 			upstreamResolver.On("BulkResolve", ctx, project.Name(), []*job.Job{jobA}, mock.Anything).Return([]*job.WithUpstream{jobWithUpstream}, nil, nil)
 
 			upstreamRepo.On("ReplaceUpstreams", ctx, []*job.WithUpstream{jobWithUpstream}).Return(nil)
+			upstreamRepo.On("GetUpstreams", ctx, project.Name(), job.Name("job-B")).Return(nil, nil)
 
 			logWriter.On("Write", mock.Anything, mock.Anything).Return(nil)
 			eventHandler.On("HandleEvent", mock.Anything).Times(1)
@@ -1867,7 +2458,7 @@ func TestJobService(t *testing.T) {
 			var jobNamesToRemove []string
 			jobDeploymentService.On("UploadJobs", ctx, sampleTenant, jobNamesToUpload, jobNamesToRemove).Return(nil)
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
 			err := jobService.ReplaceAll(ctx, sampleTenant, incomingSpecs, jobNamesWithInvalidSpec, logWriter)
 			assert.ErrorContains(t, err, "internal error")
 		})
@@ -1912,13 +2503,15 @@ func TestJobService(t *testing.T) {
 
 			jobRepo.On("GetAllByTenant", ctx, sampleTenant).Return(existingSpecs, nil)
 
+			pluginService.On("ValidateTemplates", ctx, detailedTenant, mock.Anything).Return(nil)
+			pluginService.On("ValidateConfigs", ctx, mock.Anything).Return(nil)
 			pluginService.On("GenerateDestination", ctx, detailedTenant, specA.Task()).Return(jobADestination, nil).Once()
-			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return(jobAUpstreamName, nil)
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return(jobAUpstreamName, nil, nil)
 
 			jobRepo.On("Update", ctx, mock.Anything).Return([]*job.Job{}, errors.New("internal error"))
 
 			logWriter.On("Write", mock.Anything, mock.Anything).Return(nil)
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, jobDeploymentService)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, jobDeploymentService, nil)
 			err := jobService.ReplaceAll(ctx, sampleTenant, incomingSpecs, jobNamesWithInvalidSpec, logWriter)
 			assert.ErrorContains(t, err, "internal error")
 		})
@@ -1966,7 +2559,7 @@ func TestJobService(t *testing.T) {
 
 			logWriter.On("Write", mock.Anything, mock.Anything).Return(nil).Times(3)
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, jobDeploymentService)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, jobDeploymentService, nil)
 			err := jobService.ReplaceAll(ctx, sampleTenant, incomingSpecs, jobNamesWithInvalidSpec, logWriter)
 			assert.ErrorContains(t, err, "internal error")
 		})
@@ -2013,7 +2606,7 @@ func TestJobService(t *testing.T) {
 
 			logWriter.On("Write", mock.Anything, mock.Anything).Return(nil).Twice()
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, jobDeploymentService)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, jobDeploymentService, nil)
 			err := jobService.ReplaceAll(ctx, sampleTenant, incomingSpecs, jobNamesWithInvalidSpec, logWriter)
 			assert.ErrorContains(t, err, "internal error")
 		})
@@ -2082,7 +2675,7 @@ func TestJobService(t *testing.T) {
 			jobNamesToRemove := []string{specD.Name().String()}
 			jobDeploymentService.On("UploadJobs", ctx, sampleTenant, jobNamesToUpload, jobNamesToRemove).Return(nil)
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
 			err := jobService.ReplaceAll(ctx, sampleTenant, incomingSpecs, jobNamesWithInvalidSpec, logWriter)
 			assert.Error(t, err)
 		})
@@ -2125,7 +2718,7 @@ func TestJobService(t *testing.T) {
 			errorMsg := "project/namespace error"
 			tenantDetailsGetter.On("GetDetails", ctx, sampleTenant).Return(nil, errors.New(errorMsg))
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, jobDeploymentService)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, jobDeploymentService, nil)
 
 			err := jobService.ReplaceAll(ctx, sampleTenant, incomingSpecs, jobNamesWithInvalidSpec, logWriter)
 			assert.ErrorContains(t, err, errorMsg)
@@ -2173,8 +2766,10 @@ func TestJobService(t *testing.T) {
 
 			jobRepo.On("GetAllByTenant", ctx, sampleTenant).Return(existingJobs, nil)
 
+			pluginService.On("ValidateTemplates", ctx, detailedTenant, mock.Anything).Return(nil)
+			pluginService.On("ValidateConfigs", ctx, mock.Anything).Return(nil)
 			pluginService.On("GenerateDestination", ctx, detailedTenant, specA.Task()).Return(jobADestination, nil).Once()
-			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return(jobAUpstreamName, nil)
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return(jobAUpstreamName, nil, nil)
 
 			jobRepo.On("Add", ctx, mock.Anything).Return([]*job.Job{jobA}, nil)
 
@@ -2184,6 +2779,7 @@ func TestJobService(t *testing.T) {
 			upstreamResolver.On("BulkResolve", ctx, project.Name(), []*job.Job{jobA}, mock.Anything).Return([]*job.WithUpstream{jobWithUpstream}, nil, nil)
 
 			upstreamRepo.On("ReplaceUpstreams", ctx, []*job.WithUpstream{jobWithUpstream}).Return(nil)
+			upstreamRepo.On("GetUpstreams", ctx, project.Name(), job.Name("job-B")).Return(nil, nil)
 
 			logWriter.On("Write", mock.Anything, mock.Anything).Return(nil)
 			eventHandler.On("HandleEvent", mock.Anything).Times(1)
@@ -2191,7 +2787,7 @@ func TestJobService(t *testing.T) {
 			errorMsg := "internal error"
 			jobDeploymentService.On("UploadJobs", ctx, sampleTenant, mock.Anything, mock.Anything).Return(errors.New(errorMsg))
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
 			err := jobService.ReplaceAll(ctx, sampleTenant, incomingSpecs, jobNamesWithInvalidSpec, logWriter)
 			assert.ErrorContains(t, err, errorMsg)
 		})
@@ -2239,11 +2835,13 @@ func TestJobService(t *testing.T) {
 
 			tenantDetailsGetter.On("GetDetails", ctx, sampleTenant).Return(detailedTenant, nil)
 
+			pluginService.On("ValidateTemplates", ctx, detailedTenant, mock.Anything).Return(nil)
+			pluginService.On("ValidateConfigs", ctx, mock.Anything).Return(nil)
 			pluginService.On("GenerateDestination", ctx, detailedTenant, specA.Task()).Return(jobADestination, nil).Once()
-			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return(jobAUpstreamName, nil)
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return(jobAUpstreamName, nil, nil)
 
 			pluginService.On("GenerateDestination", ctx, detailedTenant, specB.Task()).Return(jobBDestination, nil).Once()
-			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specB, true).Return(jobBUpstreamName, nil)
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specB, true).Return(jobBUpstreamName, nil, nil)
 
 			jobRepo.On("Update", ctx, mock.Anything).Return([]*job.Job{jobA, jobB}, nil)
 
@@ -2254,6 +2852,7 @@ func TestJobService(t *testing.T) {
 			upstreamResolver.On("BulkResolve", ctx, project.Name(), []*job.Job{jobA, jobB}, mock.Anything).Return([]*job.WithUpstream{jobAWithUpstream, jobBWithUpstream}, nil)
 
 			upstreamRepo.On("ReplaceUpstreams", ctx, []*job.WithUpstream{jobAWithUpstream, jobBWithUpstream}).Return(nil)
+			upstreamRepo.On("GetUpstreams", ctx, project.Name(), job.Name("job-C")).Return(nil, nil)
 
 			logWriter.On("Write", mock.Anything, mock.Anything).Return(nil).Times(3)
 			eventHandler.On("HandleEvent", mock.Anything).Times(2)
@@ -2262,7 +2861,7 @@ func TestJobService(t *testing.T) {
 			jobNamesToUpload := []string{jobA.GetName(), jobB.GetName()}
 			jobDeploymentService.On("UploadJobs", ctx, sampleTenant, jobNamesToUpload, jobNamesToRemove).Return(nil)
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
 			err := jobService.Refresh(ctx, project.Name(), []string{namespace.Name().String()}, nil, logWriter)
 			assert.NoError(t, err)
 		})
@@ -2311,10 +2910,13 @@ func TestJobService(t *testing.T) {
 			tenantDetailsGetter.On("GetDetails", ctx, sampleTenant).Return(detailedTenant, nil).Once()
 			tenantDetailsGetter.On("GetDetails", ctx, otherTenant).Return(detailedOtherTenant, nil).Once()
 
+			pluginService.On("ValidateTemplates", ctx, detailedTenant, mock.Anything).Return(nil)
+			pluginService.On("ValidateConfigs", ctx, mock.Anything).Return(nil)
 			pluginService.On("GenerateDestination", ctx, detailedTenant, specA.Task()).Return(jobADestination, nil).Once()
-			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return(jobAUpstreamName, nil).Once()
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return(jobAUpstreamName, nil, nil).Once()
+			pluginService.On("ValidateTemplates", ctx, detailedOtherTenant, mock.Anything).Return(nil)
 			pluginService.On("GenerateDestination", ctx, detailedOtherTenant, specB.Task()).Return(jobBDestination, nil).Once()
-			pluginService.On("GenerateUpstreams", ctx, detailedOtherTenant, specB, true).Return(jobBUpstreamName, nil).Once()
+			pluginService.On("GenerateUpstreams", ctx, detailedOtherTenant, specB, true).Return(jobBUpstreamName, nil, nil).Once()
 
 			jobRepo.On("Update", ctx, mock.Anything).Return([]*job.Job{jobA}, nil).Once()
 			jobRepo.On("Update", ctx, mock.Anything).Return([]*job.Job{jobB}, nil).Once()
@@ -2329,6 +2931,8 @@ func TestJobService(t *testing.T) {
 
 			upstreamRepo.On("ReplaceUpstreams", ctx, []*job.WithUpstream{jobAWithUpstream}).Return(nil)
 			upstreamRepo.On("ReplaceUpstreams", ctx, []*job.WithUpstream{jobBWithUpstream}).Return(nil)
+			upstreamRepo.On("GetUpstreams", ctx, project.Name(), job.Name("job-B")).Return(nil, nil)
+			upstreamRepo.On("GetUpstreams", ctx, project.Name(), job.Name("job-C")).Return(nil, nil)
 
 			logWriter.On("Write", mock.Anything, mock.Anything).Return(nil).Times(4)
 			eventHandler.On("HandleEvent", mock.Anything).Times(2)
@@ -2337,7 +2941,7 @@ func TestJobService(t *testing.T) {
 			jobDeploymentService.On("UploadJobs", ctx, sampleTenant, []string{jobA.GetName()}, jobNamesToRemove).Return(nil)
 			jobDeploymentService.On("UploadJobs", ctx, otherTenant, []string{jobB.GetName()}, jobNamesToRemove).Return(nil)
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
 			err := jobService.Refresh(ctx, project.Name(), []string{namespace.Name().String(), otherNamespace.Name().String()}, nil, logWriter)
 			assert.NoError(t, err)
 		})
@@ -2362,7 +2966,7 @@ func TestJobService(t *testing.T) {
 
 			jobRepo.On("GetAllByTenant", ctx, sampleTenant).Return(nil, errors.New("internal error"))
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil, nil)
 			err := jobService.Refresh(ctx, project.Name(), []string{namespace.Name().String()}, nil, nil)
 			assert.ErrorContains(t, err, "internal error")
 		})
@@ -2398,7 +3002,7 @@ func TestJobService(t *testing.T) {
 
 			resourceURNs := []job.ResourceURN{"project.dataset.table"}
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
 
 			downstreamRepo.On("GetDownstreamBySources", ctx, resourceURNs).Return(nil, errors.New("internal error"))
 
@@ -2456,11 +3060,13 @@ func TestJobService(t *testing.T) {
 
 			tenantDetailsGetter.On("GetDetails", ctx, sampleTenant).Return(detailedTenant, nil)
 
+			pluginService.On("ValidateTemplates", ctx, detailedTenant, mock.Anything).Return(nil)
+			pluginService.On("ValidateConfigs", ctx, mock.Anything).Return(nil)
 			pluginService.On("GenerateDestination", ctx, detailedTenant, specA.Task()).Return(jobADestination, nil).Once()
-			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return([]job.ResourceURN{jobAUpstreamName}, nil)
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return([]job.ResourceURN{jobAUpstreamName}, nil, nil)
 
 			pluginService.On("GenerateDestination", ctx, detailedTenant, specB.Task()).Return(jobBDestination, nil).Once()
-			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specB, true).Return([]job.ResourceURN{jobBUpstreamName}, nil)
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specB, true).Return([]job.ResourceURN{jobBUpstreamName}, nil, nil)
 
 			jobRepo.On("Update", ctx, mock.Anything).Return([]*job.Job{jobA, jobB}, nil)
 
@@ -2471,6 +3077,7 @@ func TestJobService(t *testing.T) {
 			upstreamResolver.On("BulkResolve", ctx, project.Name(), []*job.Job{jobA, jobB}, mock.Anything).Return([]*job.WithUpstream{jobAWithUpstream, jobBWithUpstream}, nil)
 
 			upstreamRepo.On("ReplaceUpstreams", ctx, []*job.WithUpstream{jobAWithUpstream, jobBWithUpstream}).Return(nil)
+			upstreamRepo.On("GetUpstreams", ctx, project.Name(), job.Name("job-C")).Return(nil, nil)
 
 			logWriter.On("Write", mock.Anything, mock.Anything).Return(nil).Times(3)
 			eventHandler.On("HandleEvent", mock.Anything).Times(2)
@@ -2479,7 +3086,7 @@ func TestJobService(t *testing.T) {
 			jobNamesToUpload := []string{jobA.GetName(), jobB.GetName()}
 			jobDeploymentService.On("UploadJobs", ctx, sampleTenant, jobNamesToUpload, jobNamesToRemove).Return(nil)
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, eventHandler, log, jobDeploymentService, nil)
 
 			err := jobService.RefreshResourceDownstream(ctx, resourceURNs, logWriter)
 			assert.NoError(t, err)
@@ -2500,7 +3107,7 @@ func TestJobService(t *testing.T) {
 			jobName, _ := job.NameFrom("job-A")
 			jobRepo.On("GetByJobName", ctx, sampleTenant.ProjectName(), jobName).Return(nil, errors.New("error when fetch job"))
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, nil, nil, nil, nil, log, nil)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, nil, nil, nil, nil, log, nil, nil)
 
 			actual, err := jobService.Get(ctx, sampleTenant, jobName)
 			assert.Error(t, err, "error when fetch job")
@@ -2520,7 +3127,7 @@ func TestJobService(t *testing.T) {
 			jobA := job.NewJob(sampleTenant, specA, "table-A", []job.ResourceURN{"table-B"})
 			jobRepo.On("GetByJobName", ctx, sampleTenant.ProjectName(), specA.Name()).Return(jobA, nil)
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, nil, nil, nil, nil, log, nil)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, nil, nil, nil, nil, log, nil, nil)
 
 			actual, err := jobService.Get(ctx, sampleTenant, specA.Name())
 			assert.NoError(t, err, "error when fetch job")
@@ -2537,7 +3144,7 @@ func TestJobService(t *testing.T) {
 
 				jobRepo.On("GetAllByResourceDestination", ctx, job.ResourceURN("example")).Return(nil, errors.New("error encountered"))
 
-				jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, log, nil)
+				jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, log, nil, nil)
 				actual, err := jobService.GetByFilter(ctx, filter.WithString(filter.ResourceDestination, "example"))
 				assert.Error(t, err, "error encountered")
 				assert.Nil(t, actual)
@@ -2550,7 +3157,7 @@ func TestJobService(t *testing.T) {
 				jobA := job.NewJob(sampleTenant, specA, "table-A", []job.ResourceURN{"table-B"})
 				jobRepo.On("GetAllByResourceDestination", ctx, job.ResourceURN("table-A")).Return([]*job.Job{jobA}, nil)
 
-				jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, log, nil)
+				jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, log, nil, nil)
 				actual, err := jobService.GetByFilter(ctx, filter.WithString(filter.ResourceDestination, "table-A"))
 				assert.NoError(t, err)
 				assert.NotNil(t, actual)
@@ -2566,7 +3173,7 @@ func TestJobService(t *testing.T) {
 				jobName, _ := job.NameFrom("job-A")
 				jobRepo.On("GetByJobName", ctx, sampleTenant.ProjectName(), jobName).Return(nil, errors.New("error encountered"))
 
-				jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, log, nil)
+				jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, log, nil, nil)
 				actual, err := jobService.GetByFilter(ctx,
 					filter.WithString(filter.ProjectName, sampleTenant.ProjectName().String()),
 					filter.WithStringArray(filter.JobNames, []string{jobName.String()}),
@@ -2584,7 +3191,7 @@ func TestJobService(t *testing.T) {
 				jobRepo.On("GetByJobName", ctx, sampleTenant.ProjectName(), specA.Name()).Return(jobA, nil)
 				jobRepo.On("GetByJobName", ctx, sampleTenant.ProjectName(), specB.Name()).Return(nil, errors.New("error encountered"))
 
-				jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, log, nil)
+				jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, log, nil, nil)
 				actual, err := jobService.GetByFilter(ctx,
 					filter.WithString(filter.ProjectName, sampleTenant.ProjectName().String()),
 					filter.WithStringArray(filter.JobNames, []string{specA.Name().String(), specB.Name().String()}),
@@ -2601,7 +3208,7 @@ func TestJobService(t *testing.T) {
 				specA, _ := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).Build()
 				jobRepo.On("GetByJobName", ctx, sampleTenant.ProjectName(), specA.Name()).Return(nil, optErrors.NotFound(job.EntityJob, "job not found"))
 
-				jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, log, nil)
+				jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, log, nil, nil)
 				actual, err := jobService.GetByFilter(ctx,
 					filter.WithString(filter.ProjectName, sampleTenant.ProjectName().String()),
 					filter.WithStringArray(filter.JobNames, []string{specA.Name().String()}),
@@ -2617,7 +3224,7 @@ func TestJobService(t *testing.T) {
 				jobA := job.NewJob(sampleTenant, specA, "table-A", []job.ResourceURN{"table-B"})
 				jobRepo.On("GetByJobName", ctx, sampleTenant.ProjectName(), specA.Name()).Return(jobA, nil)
 
-				jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, log, nil)
+				jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, log, nil, nil)
 				actual, err := jobService.GetByFilter(ctx,
 					filter.WithString(filter.ProjectName, sampleTenant.ProjectName().String()),
 					filter.WithStringArray(filter.JobNames, []string{specA.Name().String()}),
@@ -2636,7 +3243,7 @@ func TestJobService(t *testing.T) {
 				jobName, _ := job.NameFrom("job-A")
 				jobRepo.On("GetByJobName", ctx, sampleTenant.ProjectName(), jobName).Return(nil, errors.New("error encountered"))
 
-				jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, log, nil)
+				jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, log, nil, nil)
 				actual, err := jobService.GetByFilter(ctx,
 					filter.WithString(filter.ProjectName, sampleTenant.ProjectName().String()),
 					filter.WithString(filter.JobName, jobName.String()),
@@ -2651,7 +3258,7 @@ func TestJobService(t *testing.T) {
 				specA, _ := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).Build()
 				jobRepo.On("GetByJobName", ctx, sampleTenant.ProjectName(), specA.Name()).Return(nil, optErrors.NotFound(job.EntityJob, "job not found"))
 
-				jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, log, nil)
+				jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, log, nil, nil)
 				actual, err := jobService.GetByFilter(ctx,
 					filter.WithString(filter.ProjectName, sampleTenant.ProjectName().String()),
 					filter.WithString(filter.JobName, specA.Name().String()),
@@ -2667,7 +3274,7 @@ func TestJobService(t *testing.T) {
 				jobA := job.NewJob(sampleTenant, specA, "table-A", []job.ResourceURN{"table-B"})
 				jobRepo.On("GetByJobName", ctx, sampleTenant.ProjectName(), specA.Name()).Return(jobA, nil)
 
-				jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, log, nil)
+				jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, log, nil, nil)
 				actual, err := jobService.GetByFilter(ctx,
 					filter.WithString(filter.ProjectName, sampleTenant.ProjectName().String()),
 					filter.WithString(filter.JobName, specA.Name().String()),
@@ -2685,7 +3292,7 @@ func TestJobService(t *testing.T) {
 
 				jobRepo.On("GetAllByTenant", ctx, sampleTenant).Return(nil, errors.New("error encountered"))
 
-				jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, log, nil)
+				jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, log, nil, nil)
 				actual, err := jobService.GetByFilter(ctx,
 					filter.WithString(filter.ProjectName, sampleTenant.ProjectName().String()),
 					filter.WithStringArray(filter.NamespaceNames, []string{sampleTenant.NamespaceName().String()}),
@@ -2697,7 +3304,7 @@ func TestJobService(t *testing.T) {
 				jobRepo := new(JobRepository)
 				defer jobRepo.AssertExpectations(t)
 
-				jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, log, nil)
+				jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, log, nil, nil)
 				actual, err := jobService.GetByFilter(ctx,
 					filter.WithString(filter.ProjectName, sampleTenant.ProjectName().String()),
 					filter.WithStringArray(filter.NamespaceNames, []string{""}),
@@ -2713,7 +3320,7 @@ func TestJobService(t *testing.T) {
 				jobA := job.NewJob(sampleTenant, specA, "table-A", []job.ResourceURN{"table-B"})
 				jobRepo.On("GetAllByTenant", ctx, sampleTenant).Return([]*job.Job{jobA}, nil)
 
-				jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, log, nil)
+				jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, log, nil, nil)
 				actual, err := jobService.GetByFilter(ctx,
 					filter.WithString(filter.ProjectName, sampleTenant.ProjectName().String()),
 					filter.WithStringArray(filter.NamespaceNames, []string{sampleTenant.NamespaceName().String()}),
@@ -2731,7 +3338,7 @@ func TestJobService(t *testing.T) {
 
 				jobRepo.On("GetAllByTenant", ctx, sampleTenant).Return(nil, errors.New("error encountered"))
 
-				jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, log, nil)
+				jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, log, nil, nil)
 				actual, err := jobService.GetByFilter(ctx,
 					filter.WithString(filter.ProjectName, sampleTenant.ProjectName().String()),
 					filter.WithString(filter.NamespaceName, sampleTenant.NamespaceName().String()),
@@ -2747,7 +3354,7 @@ func TestJobService(t *testing.T) {
 				jobA := job.NewJob(sampleTenant, specA, "table-A", []job.ResourceURN{"table-B"})
 				jobRepo.On("GetAllByTenant", ctx, sampleTenant).Return([]*job.Job{jobA}, nil)
 
-				jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, log, nil)
+				jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, log, nil, nil)
 				actual, err := jobService.GetByFilter(ctx,
 					filter.WithString(filter.ProjectName, sampleTenant.ProjectName().String()),
 					filter.WithString(filter.NamespaceName, sampleTenant.NamespaceName().String()),
@@ -2765,7 +3372,7 @@ func TestJobService(t *testing.T) {
 
 				jobRepo.On("GetAllByProjectName", ctx, sampleTenant.ProjectName()).Return(nil, errors.New("error encountered"))
 
-				jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, log, nil)
+				jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, log, nil, nil)
 				actual, err := jobService.GetByFilter(ctx,
 					filter.WithString(filter.ProjectName, sampleTenant.ProjectName().String()),
 				)
@@ -2780,7 +3387,7 @@ func TestJobService(t *testing.T) {
 				jobA := job.NewJob(sampleTenant, specA, "table-A", []job.ResourceURN{"table-B"})
 				jobRepo.On("GetAllByProjectName", ctx, sampleTenant.ProjectName()).Return([]*job.Job{jobA}, nil)
 
-				jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, log, nil)
+				jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, log, nil, nil)
 				actual, err := jobService.GetByFilter(ctx,
 					filter.WithString(filter.ProjectName, sampleTenant.ProjectName().String()),
 				)
@@ -2791,7 +3398,7 @@ func TestJobService(t *testing.T) {
 			})
 		})
 		t.Run("return error when there's no filter", func(t *testing.T) {
-			jobService := service.NewJobService(nil, nil, nil, nil, nil, nil, nil, log, nil)
+			jobService := service.NewJobService(nil, nil, nil, nil, nil, nil, nil, log, nil, nil)
 			actual, err := jobService.GetByFilter(ctx)
 			assert.Error(t, err, "no filter matched")
 			assert.Nil(t, actual)
@@ -2803,9 +3410,9 @@ func TestJobService(t *testing.T) {
 			pluginService := new(PluginService)
 			defer pluginService.AssertExpectations(t)
 
-			pluginService.On("Info", ctx, jobTask.Name()).Return(nil, errors.New("error encountered"))
+			pluginService.On("Info", ctx, jobTask).Return(nil, errors.New("error encountered"))
 
-			jobService := service.NewJobService(nil, nil, nil, pluginService, nil, nil, nil, nil, nil)
+			jobService := service.NewJobService(nil, nil, nil, pluginService, nil, nil, nil, nil, nil, nil)
 
 			actual, err := jobService.GetTaskInfo(ctx, jobTask)
 			assert.Error(t, err, "error encountered")
@@ -2820,9 +3427,9 @@ func TestJobService(t *testing.T) {
 				Description: "plugin desc",
 				Image:       "goto/bq2bq:latest",
 			}
-			pluginService.On("Info", ctx, jobTask.Name()).Return(pluginInfoResp, nil)
+			pluginService.On("Info", ctx, jobTask).Return(pluginInfoResp, nil)
 
-			jobService := service.NewJobService(nil, nil, nil, pluginService, nil, nil, nil, nil, nil)
+			jobService := service.NewJobService(nil, nil, nil, pluginService, nil, nil, nil, nil, nil, nil)
 
 			actual, err := jobService.GetTaskInfo(ctx, jobTask)
 			assert.NoError(t, err)
@@ -2837,7 +3444,7 @@ func TestJobService(t *testing.T) {
 			tenantDetailsGetter.On("GetDetails", ctx, mock.Anything).Return(nil, errors.New("get tenant details fail"))
 			defer tenantDetailsGetter.AssertExpectations(t)
 
-			jobService := service.NewJobService(nil, nil, nil, nil, nil, tenantDetailsGetter, nil, log, nil)
+			jobService := service.NewJobService(nil, nil, nil, nil, nil, tenantDetailsGetter, nil, log, nil, nil)
 			err := jobService.Validate(ctx, sampleTenant, []*job.Spec{}, jobNamesWithInvalidSpec, nil)
 			assert.Error(t, err)
 			assert.Equal(t, "get tenant details fail", err.Error())
@@ -2870,7 +3477,7 @@ func TestJobService(t *testing.T) {
 
 			jobRepo.On("GetAllByTenant", ctx, sampleTenant).Return(nil, nil)
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil, nil)
 
 			logWriter.On("Write", mock.Anything, mock.Anything).Return(nil)
 
@@ -2905,9 +3512,11 @@ func TestJobService(t *testing.T) {
 
 			jobRepo.On("GetAllByTenant", ctx, sampleTenant).Return(nil, nil)
 
+			pluginService.On("ValidateTemplates", ctx, detailedTenant, mock.Anything).Return(nil)
+			pluginService.On("ValidateConfigs", ctx, mock.Anything).Return(nil)
 			pluginService.On("GenerateDestination", ctx, detailedTenant, specA.Task()).Return(job.ResourceURN(""), errors.New("some error on generate destination"))
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil, nil)
 
 			logWriter.On("Write", mock.Anything, mock.Anything).Return(nil)
 
@@ -2961,8 +3570,10 @@ func TestJobService(t *testing.T) {
 
 			jobRepo.On("GetAllByTenant", ctx, sampleTenant).Return([]*job.Job{jobA, jobB, jobC, jobD}, nil)
 
+			pluginService.On("ValidateTemplates", ctx, detailedTenant, mock.Anything).Return(nil)
+			pluginService.On("ValidateConfigs", ctx, mock.Anything).Return(nil)
 			pluginService.On("GenerateDestination", ctx, detailedTenant, jobTaskA).Return(job.ResourceURN("table-A"), nil)
-			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specAUpdated, true).Return([]job.ResourceURN{"table-Z"}, nil)
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specAUpdated, true).Return([]job.ResourceURN{"table-Z"}, nil, nil)
 
 			jobCDownstream := []*job.Downstream{
 				job.NewDownstream("job-B", project.Name(), namespace.Name(), taskName),
@@ -2976,7 +3587,7 @@ func TestJobService(t *testing.T) {
 
 			logWriter.On("Write", mock.Anything, mock.Anything).Return(nil)
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil, nil)
 			err := jobService.Validate(ctx, sampleTenant, specs, jobNamesWithInvalidSpec, logWriter)
 			assert.Error(t, err)
 			assert.ErrorContains(t, err, "deletion of job job-C will fail. job is being used by test-proj/job-B, test-proj/job-D")
@@ -3024,8 +3635,10 @@ func TestJobService(t *testing.T) {
 
 			jobRepo.On("GetAllByTenant", ctx, sampleTenant).Return([]*job.Job{jobA, jobB, jobC}, nil)
 
+			pluginService.On("ValidateTemplates", ctx, detailedTenant, mock.Anything).Return(nil)
+			pluginService.On("ValidateConfigs", ctx, mock.Anything).Return(nil)
 			pluginService.On("GenerateDestination", ctx, detailedTenant, jobTaskA).Return(job.ResourceURN("table-A"), nil)
-			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specAUpdated, true).Return([]job.ResourceURN{"table-Z"}, nil)
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specAUpdated, true).Return([]job.ResourceURN{"table-Z"}, nil, nil)
 
 			jobCDownstream := []*job.Downstream{
 				job.NewDownstream("job-B", project.Name(), namespace.Name(), taskName),
@@ -3035,7 +3648,7 @@ func TestJobService(t *testing.T) {
 
 			logWriter.On("Write", mock.Anything, mock.Anything).Return(nil)
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil, nil)
 			err := jobService.Validate(ctx, sampleTenant, specs, jobNamesWithInvalidSpec, logWriter)
 			assert.NoError(t, err)
 		})
@@ -3072,12 +3685,14 @@ func TestJobService(t *testing.T) {
 
 			jobRepo.On("GetAllByTenant", ctx, sampleTenant).Return([]*job.Job{jobB, jobC}, nil)
 
+			pluginService.On("ValidateTemplates", ctx, detailedTenant, mock.Anything).Return(nil)
+			pluginService.On("ValidateConfigs", ctx, mock.Anything).Return(nil)
 			pluginService.On("GenerateDestination", ctx, detailedTenant, jobTask).Return(job.ResourceURN("table-A"), nil)
-			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return([]job.ResourceURN{"table-C"}, nil)
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return([]job.ResourceURN{"table-C"}, nil, nil)
 
 			logWriter.On("Write", mock.Anything, mock.Anything).Return(nil)
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil, nil)
 			err := jobService.Validate(ctx, sampleTenant, []*job.Spec{specA, specB, specC}, jobNamesWithInvalidSpec, logWriter)
 			assert.Error(t, err)
 			assert.ErrorContains(t, err, "a cycle dependency encountered in the tree:")
@@ -3123,12 +3738,14 @@ func TestJobService(t *testing.T) {
 
 			jobRepo.On("GetAllByTenant", ctx, sampleTenant).Return([]*job.Job{jobA, jobB, jobC}, nil)
 
+			pluginService.On("ValidateTemplates", ctx, detailedTenant, mock.Anything).Return(nil)
+			pluginService.On("ValidateConfigs", ctx, mock.Anything).Return(nil)
 			pluginService.On("GenerateDestination", ctx, detailedTenant, jobTaskA).Return(job.ResourceURN("table-A"), nil)
-			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specAUpdated, true).Return([]job.ResourceURN{"table-C", "table-Z"}, nil)
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specAUpdated, true).Return([]job.ResourceURN{"table-C", "table-Z"}, nil, nil)
 
 			logWriter.On("Write", mock.Anything, mock.Anything).Return(nil)
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil, nil)
 			err := jobService.Validate(ctx, sampleTenant, []*job.Spec{specAUpdated, specB, specC}, jobNamesWithInvalidSpec, logWriter)
 			assert.Error(t, err)
 			assert.ErrorContains(t, err, "a cycle dependency encountered in the tree:")
@@ -3176,16 +3793,18 @@ func TestJobService(t *testing.T) {
 
 			jobRepo.On("GetAllByTenant", ctx, sampleTenant).Return([]*job.Job{jobA, jobB}, nil)
 
+			pluginService.On("ValidateTemplates", ctx, detailedTenant, mock.Anything).Return(nil)
+			pluginService.On("ValidateConfigs", ctx, mock.Anything).Return(nil)
 			pluginService.On("GenerateDestination", ctx, detailedTenant, jobTaskA).Return(job.ResourceURN("table-A"), nil)
 			pluginService.On("GenerateDestination", ctx, detailedTenant, jobTaskB).Return(job.ResourceURN("table-B"), nil)
 			pluginService.On("GenerateDestination", ctx, detailedTenant, jobTaskC).Return(job.ResourceURN(""), service.ErrUpstreamModNotFound)
-			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specAUpdated, true).Return([]job.ResourceURN{"table-Z"}, nil)
-			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specBUpdated, true).Return([]job.ResourceURN{"table-A"}, nil)
-			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specC, true).Return(nil, service.ErrUpstreamModNotFound)
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specAUpdated, true).Return([]job.ResourceURN{"table-Z"}, nil, nil)
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specBUpdated, true).Return([]job.ResourceURN{"table-A"}, nil, nil)
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specC, true).Return(nil, nil, service.ErrUpstreamModNotFound)
 
 			logWriter.On("Write", mock.Anything, mock.Anything).Return(nil)
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil, nil)
 			err := jobService.Validate(ctx, sampleTenant, []*job.Spec{specAUpdated, specBUpdated, specC}, jobNamesWithInvalidSpec, logWriter)
 			assert.Error(t, err)
 			assert.ErrorContains(t, err, "a cycle dependency encountered in the tree:")
@@ -3224,12 +3843,14 @@ func TestJobService(t *testing.T) {
 
 			jobRepo.On("GetAllByTenant", ctx, sampleTenant).Return([]*job.Job{}, nil)
 
+			pluginService.On("ValidateTemplates", ctx, detailedTenant, mock.Anything).Return(nil)
+			pluginService.On("ValidateConfigs", ctx, mock.Anything).Return(nil)
 			pluginService.On("GenerateDestination", ctx, detailedTenant, mock.Anything).Return(job.ResourceURN(""), service.ErrUpstreamModNotFound)
-			pluginService.On("GenerateUpstreams", ctx, detailedTenant, mock.Anything, true).Return(nil, service.ErrUpstreamModNotFound)
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, mock.Anything, true).Return(nil, nil, service.ErrUpstreamModNotFound)
 
 			logWriter.On("Write", mock.Anything, mock.Anything).Return(nil)
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil, nil)
 			err := jobService.Validate(ctx, sampleTenant, specs, jobNamesWithInvalidSpec, logWriter)
 			assert.Error(t, err)
 			assert.ErrorContains(t, err, "a cycle dependency encountered in the tree:")
@@ -3276,18 +3897,90 @@ func TestJobService(t *testing.T) {
 
 			jobRepo.On("GetAllByTenant", ctx, sampleTenant).Return([]*job.Job{jobA, jobB, jobC}, nil)
 
+			pluginService.On("ValidateTemplates", ctx, detailedTenant, mock.Anything).Return(nil)
+			pluginService.On("ValidateConfigs", ctx, mock.Anything).Return(nil)
 			pluginService.On("GenerateDestination", ctx, detailedTenant, jobTaskA).Return(job.ResourceURN("table-A"), nil)
-			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specAUpdated, true).Return([]job.ResourceURN{"table-Z"}, nil)
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specAUpdated, true).Return([]job.ResourceURN{"table-Z"}, nil, nil)
 
 			downstreamRepo.On("GetDownstreamByJobName", ctx, project.Name(), specC.Name()).Return([]*job.Downstream{}, nil)
 
 			logWriter.On("Write", mock.Anything, mock.Anything).Return(nil)
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil, nil)
 			err := jobService.Validate(ctx, sampleTenant, specs, jobNamesWithInvalidSpec, logWriter)
 			assert.NoError(t, err)
 		})
 	})
 
+	t.Run("ValidateJobSpecs", func(t *testing.T) {
+		t.Run("returns error when get tenant details if failed", func(t *testing.T) {
+			tenantDetailsGetter := new(TenantDetailsGetter)
+			tenantDetailsGetter.On("GetDetails", ctx, sampleTenant).Return(nil, errors.New("get tenant details fail"))
+			defer tenantDetailsGetter.AssertExpectations(t)
+
+			jobService := service.NewJobService(nil, nil, nil, nil, nil, tenantDetailsGetter, nil, log, nil, nil)
+			validations, err := jobService.ValidateJobSpecs(ctx, sampleTenant, []*job.Spec{})
+			assert.ErrorContains(t, err, "get tenant details fail")
+			assert.Nil(t, validations)
+		})
+		t.Run("reports invalid interval and job name taken by another namespace without generating the jobs", func(t *testing.T) {
+			tenantDetailsGetter := new(TenantDetailsGetter)
+			tenantDetailsGetter.On("GetDetails", ctx, sampleTenant).Return(detailedTenant, nil)
+			defer tenantDetailsGetter.AssertExpectations(t)
+
+			invalidSchedule, _ := job.NewScheduleBuilder(startDate).WithInterval("0 25 * * *").Build()
+			specA, _ := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", invalidSchedule, jobWindow, jobTask).Build()
+			specB, _ := job.NewSpecBuilder(jobVersion, "job-B", "sample-owner", jobSchedule, jobWindow, jobTask).Build()
+			jobB := job.NewJob(otherTenant, specB, "table-B", nil)
+
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetAllByProjectName", ctx, project.Name()).Return([]*job.Job{jobB}, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, tenantDetailsGetter, nil, log, nil, nil)
+			validations, err := jobService.ValidateJobSpecs(ctx, sampleTenant, []*job.Spec{specA, specB})
+			assert.NoError(t, err)
+			assert.Len(t, validations, 2)
+			assert.False(t, validations[0].IsValid())
+			assert.Contains(t, validations[0].Errors[0], "invalid schedule interval 0 25 * * *")
+			assert.Equal(t, []string{"job job-B already exists in namespace other-ns"}, validations[1].Errors)
+		})
+		t.Run("reports circular dependency introduced along with the deployed jobs", func(t *testing.T) {
+			tenantDetailsGetter := new(TenantDetailsGetter)
+			tenantDetailsGetter.On("GetDetails", ctx, sampleTenant).Return(detailedTenant, nil)
+			defer tenantDetailsGetter.AssertExpectations(t)
+
+			jobTaskConfigA, _ := job.ConfigFrom(map[string]string{"table": "table-A"})
+			jobTaskConfigC, _ := job.ConfigFrom(map[string]string{"table": "table-C"})
+			jobTaskA := job.NewTask(taskName, jobTaskConfigA)
+			jobTaskC := job.NewTask(taskName, jobTaskConfigC)
+			specA, _ := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTaskA).Build()
+			specB, _ := job.NewSpecBuilder(jobVersion, "job-B", "sample-owner", jobSchedule, jobWindow, jobTask).Build()
+			specC, _ := job.NewSpecBuilder(jobVersion, "job-C", "sample-owner", jobSchedule, jobWindow, jobTaskC).Build()
+			jobB := job.NewJob(sampleTenant, specB, "table-B", []job.ResourceURN{"table-A"})
+
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetAllByProjectName", ctx, project.Name()).Return([]*job.Job{jobB}, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			pluginService := new(PluginService)
+			pluginService.On("ValidateTemplates", ctx, detailedTenant, mock.Anything).Return(nil)
+			pluginService.On("ValidateConfigs", ctx, mock.Anything).Return(nil)
+			pluginService.On("GenerateDestination", ctx, detailedTenant, jobTaskA).Return(job.ResourceURN("table-A"), nil)
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return([]job.ResourceURN{"table-B"}, nil, nil)
+			pluginService.On("GenerateDestination", ctx, detailedTenant, jobTaskC).Return(job.ResourceURN("table-C"), nil)
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specC, true).Return([]job.ResourceURN{"table-B"}, nil, nil)
+			defer pluginService.AssertExpectations(t)
+
+			jobService := service.NewJobService(jobRepo, nil, nil, pluginService, nil, tenantDetailsGetter, nil, log, nil, nil)
+			validations, err := jobService.ValidateJobSpecs(ctx, sampleTenant, []*job.Spec{specA, specC})
+			assert.NoError(t, err)
+			assert.Len(t, validations, 2)
+			assert.Len(t, validations[0].Errors, 1)
+			assert.Contains(t, validations[0].Errors[0], "cycle dependency encountered")
+			assert.True(t, validations[1].IsValid())
+		})
+	})
+
 	t.Run("GetUpstreamsToInspect", func(t *testing.T) {
 		t.Run("should return upstream for an existing job", func(t *testing.T) {
 			jobRepo := new(JobRepository)
@@ -3315,7 +4008,7 @@ func TestJobService(t *testing.T) {
 
 			upstreamRepo.On("GetUpstreams", ctx, project.Name(), jobA.Spec().Name()).Return([]*job.Upstream{upstreamB}, nil)
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil, nil)
 			result, err := jobService.GetUpstreamsToInspect(ctx, jobA, false)
 			assert.NoError(t, err)
 			assert.EqualValues(t, []*job.Upstream{upstreamB}, result)
@@ -3349,7 +4042,7 @@ func TestJobService(t *testing.T) {
 
 			upstreamResolver.On("Resolve", ctx, jobA, mock.Anything).Return([]*job.Upstream{upstreamB}, nil)
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil, nil)
 			result, err := jobService.GetUpstreamsToInspect(ctx, jobA, true)
 			assert.NoError(t, err)
 			assert.EqualValues(t, []*job.Upstream{upstreamB}, result)
@@ -3381,16 +4074,18 @@ func TestJobService(t *testing.T) {
 			tenantDetailsGetter.On("GetDetails", ctx, sampleTenant).Return(detailedTenant, nil)
 
 			jobADestination := job.ResourceURN("resource-A")
+			pluginService.On("ValidateTemplates", ctx, detailedTenant, mock.Anything).Return(nil)
+			pluginService.On("ValidateConfigs", ctx, mock.Anything).Return(nil)
 			pluginService.On("GenerateDestination", ctx, detailedTenant, specA.Task()).Return(jobADestination, nil)
 
 			jobASources := []job.ResourceURN{"job-B"}
-			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return(jobASources, nil)
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return(jobASources, nil, nil)
 
 			jobRepo.On("GetAllByResourceDestination", ctx, jobADestination).Return([]*job.Job{}, nil)
 
 			jobA := job.NewJob(sampleTenant, specA, jobADestination, jobASources)
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil, nil)
 			result, logger := jobService.GetJobBasicInfo(ctx, sampleTenant, "", specA)
 			assert.Nil(t, logger.Messages)
 			assert.Equal(t, jobA, result)
@@ -3422,7 +4117,7 @@ func TestJobService(t *testing.T) {
 			jobRepo.On("GetByJobName", ctx, project.Name(), specA.Name()).Return(jobA, nil)
 			jobRepo.On("GetAllByResourceDestination", ctx, jobADestination).Return([]*job.Job{}, nil)
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil, nil)
 			result, logger := jobService.GetJobBasicInfo(ctx, sampleTenant, specA.Name(), nil)
 			assert.Nil(t, logger.Messages)
 			assert.Equal(t, jobA, result)
@@ -3450,7 +4145,7 @@ func TestJobService(t *testing.T) {
 
 			tenantDetailsGetter.On("GetDetails", ctx, sampleTenant).Return(detailedTenant, errors.New("sample error"))
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil, nil)
 			result, logger := jobService.GetJobBasicInfo(ctx, sampleTenant, "", specA)
 			assert.Contains(t, logger.Messages[0].Message, "sample error")
 			assert.Nil(t, result)
@@ -3479,12 +4174,14 @@ func TestJobService(t *testing.T) {
 			tenantDetailsGetter.On("GetDetails", ctx, sampleTenant).Return(detailedTenant, nil)
 
 			jobADestination := job.ResourceURN("resource-A")
+			pluginService.On("ValidateTemplates", ctx, detailedTenant, mock.Anything).Return(nil)
+			pluginService.On("ValidateConfigs", ctx, mock.Anything).Return(nil)
 			pluginService.On("GenerateDestination", ctx, detailedTenant, specA.Task()).Return(jobADestination, nil).Once()
 
 			jobAUpstreamName := []job.ResourceURN{"job-B"}
-			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return(jobAUpstreamName, errors.New("sample error"))
+			pluginService.On("GenerateUpstreams", ctx, detailedTenant, specA, true).Return(jobAUpstreamName, nil, errors.New("sample error"))
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil, nil)
 			result, logger := jobService.GetJobBasicInfo(ctx, sampleTenant, "", specA)
 			assert.Contains(t, logger.Messages[0].Message, "sample error")
 			assert.Nil(t, result)
@@ -3518,7 +4215,7 @@ func TestJobService(t *testing.T) {
 			jobRepo.On("GetByJobName", ctx, project.Name(), specA.Name()).Return(jobA, nil)
 			jobRepo.On("GetAllByResourceDestination", ctx, jobADestination).Return([]*job.Job{}, errors.New("sample-error"))
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil, nil)
 			result, logger := jobService.GetJobBasicInfo(ctx, sampleTenant, specA.Name(), nil)
 			assert.Contains(t, logger.Messages[0].Message, "no job sources detected")
 			assert.Contains(t, logger.Messages[1].Message, "could not perform duplicate job destination check")
@@ -3547,7 +4244,7 @@ func TestJobService(t *testing.T) {
 
 			jobRepo.On("GetByJobName", ctx, project.Name(), specA.Name()).Return(nil, errors.New("internal error"))
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil, nil)
 			result, logger := jobService.GetJobBasicInfo(ctx, sampleTenant, specA.Name(), nil)
 			assert.Contains(t, logger.Messages[0].Message, "internal error")
 			assert.Nil(t, result)
@@ -3575,7 +4272,7 @@ func TestJobService(t *testing.T) {
 
 			jobRepo.On("GetByJobName", ctx, project.Name(), specA.Name()).Return(nil, errors.New("job not found"))
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil, nil)
 			result, logger := jobService.GetJobBasicInfo(ctx, sampleTenant, specA.Name(), nil)
 			assert.Contains(t, logger.Messages[0].Message, "job not found")
 			assert.Nil(t, result)
@@ -3612,7 +4309,7 @@ func TestJobService(t *testing.T) {
 			jobRepo.On("GetByJobName", ctx, project.Name(), specA.Name()).Return(jobA, nil)
 			jobRepo.On("GetAllByResourceDestination", ctx, jobADestination).Return([]*job.Job{jobB, jobA}, nil)
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil, nil)
 			result, logger := jobService.GetJobBasicInfo(ctx, sampleTenant, specA.Name(), nil)
 			assert.Contains(t, logger.Messages[0].Message, "job already exists with same Destination")
 			assert.Equal(t, jobA, result)
@@ -3644,7 +4341,7 @@ func TestJobService(t *testing.T) {
 			jobRepo.On("GetByJobName", ctx, project.Name(), specA.Name()).Return(jobA, nil)
 			jobRepo.On("GetAllByResourceDestination", ctx, jobADestination).Return([]*job.Job{jobA}, nil)
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil, nil)
 			result, logger := jobService.GetJobBasicInfo(ctx, sampleTenant, specA.Name(), nil)
 			assert.Nil(t, logger.Messages)
 			assert.Equal(t, jobA, result)
@@ -3679,7 +4376,7 @@ func TestJobService(t *testing.T) {
 			}
 			downstreamRepo.On("GetDownstreamByDestination", ctx, project.Name(), jobA.Destination()).Return(jobADownstream, nil)
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil, nil)
 			result, err := jobService.GetDownstream(ctx, jobA, true)
 			assert.NoError(t, err)
 			assert.Equal(t, jobADownstream, result)
@@ -3711,12 +4408,39 @@ func TestJobService(t *testing.T) {
 			}
 			downstreamRepo.On("GetDownstreamByJobName", ctx, project.Name(), specA.Name()).Return(jobADownstream, nil)
 
-			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil)
+			jobService := service.NewJobService(jobRepo, upstreamRepo, downstreamRepo, pluginService, upstreamResolver, tenantDetailsGetter, nil, log, nil, nil)
 			result, err := jobService.GetDownstream(ctx, jobA, false)
 			assert.NoError(t, err)
 			assert.Equal(t, jobADownstream, result)
 		})
 	})
+	t.Run("GetColumnDownstreams", func(t *testing.T) {
+		source := job.ResourceURN("bigquery://project:dataset.source")
+
+		t.Run("should return error when column is empty", func(t *testing.T) {
+			downstreamRepo := new(DownstreamRepository)
+			defer downstreamRepo.AssertExpectations(t)
+
+			jobService := service.NewJobService(nil, nil, downstreamRepo, nil, nil, nil, nil, log, nil, nil)
+			result, err := jobService.GetColumnDownstreams(ctx, source, "")
+			assert.ErrorContains(t, err, "column is empty")
+			assert.Nil(t, result)
+		})
+		t.Run("should return jobs reading the column", func(t *testing.T) {
+			downstreamRepo := new(DownstreamRepository)
+			defer downstreamRepo.AssertExpectations(t)
+
+			columnDownstreams := []*job.ColumnDownstream{
+				job.NewColumnDownstream("job-B", project.Name(), "bigquery://project:dataset.target", "id"),
+			}
+			downstreamRepo.On("GetColumnDownstreams", ctx, source, "customer_id").Return(columnDownstreams, nil)
+
+			jobService := service.NewJobService(nil, nil, downstreamRepo, nil, nil, nil, nil, log, nil, nil)
+			result, err := jobService.GetColumnDownstreams(ctx, source, "customer_id")
+			assert.NoError(t, err)
+			assert.Equal(t, columnDownstreams, result)
+		})
+	})
 	t.Run("updateState", func(t *testing.T) {
 		jobName, _ := job.NameFrom("job-A")
 		jobsToUpdateState := []job.Name{jobName}
@@ -3727,7 +4451,7 @@ func TestJobService(t *testing.T) {
 			jobDeploymentService.On("UpdateJobScheduleState", ctx, sampleTenant, jobsToUpdateState, state.String()).Return(fmt.Errorf("some error in update Job State"))
 			defer jobDeploymentService.AssertExpectations(t)
 
-			jobService := service.NewJobService(nil, nil, nil, nil, nil, nil, nil, nil, jobDeploymentService)
+			jobService := service.NewJobService(nil, nil, nil, nil, nil, nil, nil, nil, jobDeploymentService, nil)
 			err := jobService.UpdateState(ctx, sampleTenant, jobsToUpdateState, state, "job disable remark")
 			assert.ErrorContains(t, err, "some error in update Job State")
 		})
@@ -3741,7 +4465,7 @@ func TestJobService(t *testing.T) {
 			jobRepo.On("UpdateState", ctx, sampleTenant, jobsToUpdateState, state, updateRemark).Return(fmt.Errorf("some error in update Job State repo"))
 			defer jobRepo.AssertExpectations(t)
 
-			jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, nil, jobDeploymentService)
+			jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, nil, jobDeploymentService, nil)
 			err := jobService.UpdateState(ctx, sampleTenant, jobsToUpdateState, state, updateRemark)
 			assert.ErrorContains(t, err, "some error in update Job State repo")
 		})
@@ -3758,11 +4482,64 @@ func TestJobService(t *testing.T) {
 			eventHandler := newEventHandler(t)
 			eventHandler.On("HandleEvent", mock.Anything).Times(1)
 
-			jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, eventHandler, nil, jobDeploymentService)
+			jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, eventHandler, nil, jobDeploymentService, nil)
 			err := jobService.UpdateState(ctx, sampleTenant, jobsToUpdateState, state, updateRemark)
 			assert.Nil(t, err)
 		})
 	})
+	t.Run("PauseTenantJobs", func(t *testing.T) {
+		t.Run("returns error when unable to get the enabled jobs", func(t *testing.T) {
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetJobNamesByState", ctx, sampleTenant, job.ENABLED).Return(nil, errors.New("unknown error"))
+			defer jobRepo.AssertExpectations(t)
+
+			jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, log, nil, nil)
+			err := jobService.PauseTenantJobs(ctx, sampleTenant)
+			assert.ErrorContains(t, err, "unknown error")
+		})
+		t.Run("pauses only the enabled jobs on the scheduler", func(t *testing.T) {
+			enabledJobNames := []job.Name{"job-A", "job-B"}
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetJobNamesByState", ctx, sampleTenant, job.ENABLED).Return(enabledJobNames, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			jobDeploymentService := new(JobDeploymentService)
+			jobDeploymentService.On("UpdateJobScheduleState", ctx, sampleTenant, enabledJobNames, job.DISABLED.String()).Return(nil)
+			defer jobDeploymentService.AssertExpectations(t)
+
+			jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, log, jobDeploymentService, nil)
+			err := jobService.PauseTenantJobs(ctx, sampleTenant)
+			assert.NoError(t, err)
+		})
+	})
+	t.Run("ResumeTenantJobs", func(t *testing.T) {
+		t.Run("does nothing when the tenant has no enabled job", func(t *testing.T) {
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetJobNamesByState", ctx, sampleTenant, job.ENABLED).Return(nil, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			jobDeploymentService := new(JobDeploymentService)
+			defer jobDeploymentService.AssertExpectations(t)
+
+			jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, log, jobDeploymentService, nil)
+			err := jobService.ResumeTenantJobs(ctx, sampleTenant)
+			assert.NoError(t, err)
+		})
+		t.Run("resumes the enabled jobs on the scheduler", func(t *testing.T) {
+			enabledJobNames := []job.Name{"job-A"}
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetJobNamesByState", ctx, sampleTenant, job.ENABLED).Return(enabledJobNames, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			jobDeploymentService := new(JobDeploymentService)
+			jobDeploymentService.On("UpdateJobScheduleState", ctx, sampleTenant, enabledJobNames, job.ENABLED.String()).Return(nil)
+			defer jobDeploymentService.AssertExpectations(t)
+
+			jobService := service.NewJobService(jobRepo, nil, nil, nil, nil, nil, nil, log, jobDeploymentService, nil)
+			err := jobService.ResumeTenantJobs(ctx, sampleTenant)
+			assert.NoError(t, err)
+		})
+	})
 }
 
 // JobRepository is an autogenerated mock type for the JobRepository type
@@ -3807,18 +4584,63 @@ func (_m *JobRepository) Delete(ctx context.Context, projectName tenant.ProjectN
 	return r0
 }
 
+// BulkDelete provides a mock function with given fields: ctx, projectName, jobNames, cleanHistory
+func (_m *JobRepository) BulkDelete(ctx context.Context, projectName tenant.ProjectName, jobNames []job.Name, cleanHistory bool) error {
+	ret := _m.Called(ctx, projectName, jobNames, cleanHistory)
+	return ret.Error(0)
+}
+
 // ChangeJobNamespace provides a mock function with given fields: ctx, jobName, jobTenant, jobNewTenant
 func (_m *JobRepository) ChangeJobNamespace(ctx context.Context, jobName job.Name, jobTenant, jobNewTenant tenant.Tenant) error {
 	ret := _m.Called(ctx, jobName, jobTenant, jobNewTenant)
 	return ret.Error(0)
 }
 
+// ChangeJobName provides a mock function with given fields: ctx, jobTenant, jobName, newJobName
+func (_m *JobRepository) ChangeJobName(ctx context.Context, jobTenant tenant.Tenant, jobName, newJobName job.Name) error {
+	ret := _m.Called(ctx, jobTenant, jobName, newJobName)
+	return ret.Error(0)
+}
+
+// GetSpecVersions provides a mock function with given fields: ctx, projectName, jobName
+func (_m *JobRepository) GetSpecVersions(ctx context.Context, projectName tenant.ProjectName, jobName job.Name) ([]*job.SpecVersion, error) {
+	ret := _m.Called(ctx, projectName, jobName)
+
+	var r0 []*job.SpecVersion
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]*job.SpecVersion)
+	}
+	return r0, ret.Error(1)
+}
+
+// GetSpecVersion provides a mock function with given fields: ctx, projectName, jobName, version
+func (_m *JobRepository) GetSpecVersion(ctx context.Context, projectName tenant.ProjectName, jobName job.Name, version int) (*job.SpecVersion, error) {
+	ret := _m.Called(ctx, projectName, jobName, version)
+
+	var r0 *job.SpecVersion
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*job.SpecVersion)
+	}
+	return r0, ret.Error(1)
+}
+
 // UpdateState provides a mock function with given fields: ctx, jobName, jobTenant, jobNewTenant
 func (_m *JobRepository) UpdateState(ctx context.Context, jobTenant tenant.Tenant, jobNames []job.Name, jobState job.State, remark string) error {
 	ret := _m.Called(ctx, jobTenant, jobNames, jobState, remark)
 	return ret.Error(0)
 }
 
+// GetJobNamesByState provides a mock function with given fields: ctx, jobTenant, jobState
+func (_m *JobRepository) GetJobNamesByState(ctx context.Context, jobTenant tenant.Tenant, jobState job.State) ([]job.Name, error) {
+	ret := _m.Called(ctx, jobTenant, jobState)
+
+	var r0 []job.Name
+	if ret.Get(0) != nil {
+		r0 = ret.Get(0).([]job.Name)
+	}
+	return r0, ret.Error(1)
+}
+
 // SyncState provides a mock function with given fields: ctx, jobTenant, disabledJobs, enabledJobs
 func (_m *JobRepository) SyncState(ctx context.Context, jobTenant tenant.Tenant, disabledJobNames, enabledJobNames []job.Name) error {
 	ret := _m.Called(ctx, jobTenant, disabledJobNames, enabledJobNames)
@@ -4013,6 +4835,108 @@ func (_d *DownstreamRepository) GetDownstreamBySources(ctx context.Context, sour
 	return r0, r1
 }
 
+// GetColumnDownstreams provides a mock function with given fields: ctx, source, column
+func (_d *DownstreamRepository) GetColumnDownstreams(ctx context.Context, source job.ResourceURN, column string) ([]*job.ColumnDownstream, error) {
+	ret := _d.Called(ctx, source, column)
+
+	var r0 []*job.ColumnDownstream
+	if rf, ok := ret.Get(0).(func(context.Context, job.ResourceURN, string) []*job.ColumnDownstream); ok {
+		r0 = rf(ctx, source, column)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*job.ColumnDownstream)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, job.ResourceURN, string) error); ok {
+		r1 = rf(ctx, source, column)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeletionRepository is an autogenerated mock type for the DeletionRepository type
+type DeletionRepository struct {
+	mock.Mock
+}
+
+// AddDeletionAudit provides a mock function with given fields: ctx, audit
+func (_m *DeletionRepository) AddDeletionAudit(ctx context.Context, audit *job.DeletionAudit) error {
+	ret := _m.Called(ctx, audit)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *job.DeletionAudit) error); ok {
+		r0 = rf(ctx, audit)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AddDeletionConsent provides a mock function with given fields: ctx, consent
+func (_m *DeletionRepository) AddDeletionConsent(ctx context.Context, consent *job.DeletionConsent) error {
+	ret := _m.Called(ctx, consent)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *job.DeletionConsent) error); ok {
+		r0 = rf(ctx, consent)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetDeletionAudits provides a mock function with given fields: ctx, projectName, jobName
+func (_m *DeletionRepository) GetDeletionAudits(ctx context.Context, projectName tenant.ProjectName, jobName job.Name) ([]*job.DeletionAudit, error) {
+	ret := _m.Called(ctx, projectName, jobName)
+
+	var r0 []*job.DeletionAudit
+	if rf, ok := ret.Get(0).(func(context.Context, tenant.ProjectName, job.Name) []*job.DeletionAudit); ok {
+		r0 = rf(ctx, projectName, jobName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*job.DeletionAudit)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, tenant.ProjectName, job.Name) error); ok {
+		r1 = rf(ctx, projectName, jobName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDeletionConsents provides a mock function with given fields: ctx, projectName, jobName
+func (_m *DeletionRepository) GetDeletionConsents(ctx context.Context, projectName tenant.ProjectName, jobName job.Name) ([]*job.DeletionConsent, error) {
+	ret := _m.Called(ctx, projectName, jobName)
+
+	var r0 []*job.DeletionConsent
+	if rf, ok := ret.Get(0).(func(context.Context, tenant.ProjectName, job.Name) []*job.DeletionConsent); ok {
+		r0 = rf(ctx, projectName, jobName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*job.DeletionConsent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, tenant.ProjectName, job.Name) error); ok {
+		r1 = rf(ctx, projectName, jobName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type UpstreamRepository struct {
 	mock.Mock
 }
@@ -4104,7 +5028,7 @@ func (_m *PluginService) GenerateDestination(_a0 context.Context, _a1 *tenant.Wi
 }
 
 // GenerateUpstreams provides a mock function with given fields: ctx, jobTenant, spec, dryRun
-func (_m *PluginService) GenerateUpstreams(ctx context.Context, jobTenant *tenant.WithDetails, spec *job.Spec, dryRun bool) ([]job.ResourceURN, error) {
+func (_m *PluginService) GenerateUpstreams(ctx context.Context, jobTenant *tenant.WithDetails, spec *job.Spec, dryRun bool) ([]job.ResourceURN, []*job.ColumnLineage, error) {
 	ret := _m.Called(ctx, jobTenant, spec, dryRun)
 
 	var r0 []job.ResourceURN
@@ -4116,22 +5040,51 @@ func (_m *PluginService) GenerateUpstreams(ctx context.Context, jobTenant *tenan
 		}
 	}
 
-	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *tenant.WithDetails, *job.Spec, bool) error); ok {
+	var r1 []*job.ColumnLineage
+	if rf, ok := ret.Get(1).(func(context.Context, *tenant.WithDetails, *job.Spec, bool) []*job.ColumnLineage); ok {
 		r1 = rf(ctx, jobTenant, spec, dryRun)
 	} else {
-		r1 = ret.Error(1)
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]*job.ColumnLineage)
+		}
 	}
 
-	return r0, r1
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, *tenant.WithDetails, *job.Spec, bool) error); ok {
+		r2 = rf(ctx, jobTenant, spec, dryRun)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ValidateConfigs provides a mock function with given fields: ctx, spec
+func (_m *PluginService) ValidateConfigs(ctx context.Context, spec *job.Spec) error {
+	ret := _m.Called(ctx, spec)
+	return ret.Error(0)
+}
+
+// ValidateTemplates provides a mock function with given fields: ctx, jobTenant, spec
+func (_m *PluginService) ValidateTemplates(ctx context.Context, jobTenant *tenant.WithDetails, spec *job.Spec) error {
+	ret := _m.Called(ctx, jobTenant, spec)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *tenant.WithDetails, *job.Spec) error); ok {
+		r0 = rf(ctx, jobTenant, spec)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
 }
 
 // Info provides a mock function with given fields: _a0, _a1
-func (_m *PluginService) Info(_a0 context.Context, _a1 job.TaskName) (*plugin.Info, error) {
+func (_m *PluginService) Info(_a0 context.Context, _a1 job.Task) (*plugin.Info, error) {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 *plugin.Info
-	if rf, ok := ret.Get(0).(func(context.Context, job.TaskName) *plugin.Info); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, job.Task) *plugin.Info); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
@@ -4140,7 +5093,7 @@ func (_m *PluginService) Info(_a0 context.Context, _a1 job.TaskName) (*plugin.In
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, job.TaskName) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, job.Task) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/goto/salt/log"
+
+	"github.com/goto/optimus/core/job"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+)
+
+const MaxLineageDepth = 10
+
+type LineageRepository interface {
+	GetByJobName(ctx context.Context, projectName tenant.ProjectName, jobName job.Name) (*job.Job, error)
+	GetAllByResourceDestination(ctx context.Context, resourceDestination job.ResourceURN) ([]*job.Job, error)
+	GetAllBySource(ctx context.Context, source job.ResourceURN) ([]*job.Job, error)
+}
+
+// LineageService walks the sources and destinations of the jobs, as generated by the plugins on deployment
+type LineageService struct {
+	repo LineageRepository
+
+	logger log.Logger
+}
+
+// GetLineage returns the graph of jobs and resources around the resource, walking up to depth jobs upstream,
+// through the jobs writing to the resource, and downstream, through the jobs reading from it
+func (s LineageService) GetLineage(ctx context.Context, urn job.ResourceURN, depth int) (*job.LineageGraph, error) {
+	if urn == "" {
+		return nil, errors.InvalidArgument(job.EntityJob, "resource urn is empty")
+	}
+	if depth < 1 || depth > MaxLineageDepth {
+		return nil, errors.InvalidArgument(job.EntityJob, fmt.Sprintf("depth should be between 1 and %d", MaxLineageDepth))
+	}
+
+	graph := job.NewLineageGraph()
+	graph.AddResource(urn)
+	if err := s.walkUpstream(ctx, graph, urn, depth); err != nil {
+		return nil, err
+	}
+	if err := s.walkDownstream(ctx, graph, urn, depth); err != nil {
+		return nil, err
+	}
+	return graph, nil
+}
+
+// GetJobLineage returns the lineage graph around the destination of the job
+func (s LineageService) GetJobLineage(ctx context.Context, projectName tenant.ProjectName, jobName job.Name, depth int) (*job.LineageGraph, error) {
+	subjectJob, err := s.repo.GetByJobName(ctx, projectName, jobName)
+	if err != nil {
+		s.logger.Error("error getting job [%s]: %s", jobName, err)
+		return nil, err
+	}
+	if subjectJob.Destination() == "" {
+		graph := job.NewLineageGraph()
+		jobID := graph.AddJob(subjectJob)
+		for _, source := range subjectJob.Sources() {
+			graph.AddEdge(graph.AddResource(source), jobID)
+		}
+		return graph, nil
+	}
+	return s.GetLineage(ctx, subjectJob.Destination(), depth)
+}
+
+func (s LineageService) walkUpstream(ctx context.Context, graph *job.LineageGraph, urn job.ResourceURN, depth int) error {
+	visited := map[job.ResourceURN]bool{urn: true}
+	frontier := []job.ResourceURN{urn}
+	for level := 0; level < depth && len(frontier) > 0; level++ {
+		var next []job.ResourceURN
+		for _, resource := range frontier {
+			producers, err := s.repo.GetAllByResourceDestination(ctx, resource)
+			if err != nil {
+				s.logger.Error("error getting jobs writing to [%s]: %s", resource, err)
+				return err
+			}
+			for _, producer := range producers {
+				jobID := graph.AddJob(producer)
+				graph.AddEdge(jobID, graph.AddResource(resource))
+				for _, source := range producer.Sources() {
+					graph.AddEdge(graph.AddResource(source), jobID)
+					if !visited[source] {
+						visited[source] = true
+						next = append(next, source)
+					}
+				}
+			}
+		}
+		frontier = next
+	}
+	return nil
+}
+
+func (s LineageService) walkDownstream(ctx context.Context, graph *job.LineageGraph, urn job.ResourceURN, depth int) error {
+	visited := map[job.ResourceURN]bool{urn: true}
+	frontier := []job.ResourceURN{urn}
+	for level := 0; level < depth && len(frontier) > 0; level++ {
+		var next []job.ResourceURN
+		for _, resource := range frontier {
+			readers, err := s.repo.GetAllBySource(ctx, resource)
+			if err != nil {
+				s.logger.Error("error getting jobs reading from [%s]: %s", resource, err)
+				return err
+			}
+			for _, reader := range readers {
+				jobID := graph.AddJob(reader)
+				graph.AddEdge(graph.AddResource(resource), jobID)
+				destination := reader.Destination()
+				if destination == "" {
+					continue
+				}
+				graph.AddEdge(jobID, graph.AddResource(destination))
+				if !visited[destination] {
+					visited[destination] = true
+					next = append(next, destination)
+				}
+			}
+		}
+		frontier = next
+	}
+	return nil
+}
+
+func NewLineageService(repo LineageRepository, logger log.Logger) *LineageService {
+	return &LineageService{
+		repo:   repo,
+		logger: logger,
+	}
+}
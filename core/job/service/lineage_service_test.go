@@ -0,0 +1,135 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/goto/optimus/core/job"
+	"github.com/goto/optimus/core/job/service"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/lib/window"
+	"github.com/goto/optimus/internal/models"
+)
+
+func TestLineageService(t *testing.T) {
+	ctx := context.Background()
+	logger := log.NewNoop()
+	sampleTenant, _ := tenant.NewTenant("test-proj", "test-ns")
+
+	startDate, err := job.ScheduleDateFrom("2022-10-01")
+	assert.NoError(t, err)
+	schedule, err := job.NewScheduleBuilder(startDate).WithInterval("0 2 * * *").Build()
+	assert.NoError(t, err)
+	w, _ := models.NewWindow(1, "d", "24h", "24h")
+	jobWindow := window.NewCustomConfig(w)
+	taskName, _ := job.TaskNameFrom("bq2bq")
+	jobTask := job.NewTask(taskName, nil)
+
+	newJob := func(name job.Name, destination job.ResourceURN, sources ...job.ResourceURN) *job.Job {
+		spec, err := job.NewSpecBuilder(1, name, "sample-owner", schedule, jobWindow, jobTask).Build()
+		assert.NoError(t, err)
+		return job.NewJob(sampleTenant, spec, destination, sources)
+	}
+
+	// raw -> job-A -> table-a -> job-B -> table-b -> job-C -> table-c
+	jobA := newJob("job-A", "table-a", "raw")
+	jobB := newJob("job-B", "table-b", "table-a")
+	jobC := newJob("job-C", "table-c", "table-b")
+
+	t.Run("GetLineage", func(t *testing.T) {
+		t.Run("returns error if depth is out of range", func(t *testing.T) {
+			lineageService := service.NewLineageService(nil, logger)
+
+			graph, err := lineageService.GetLineage(ctx, "table-b", 0)
+			assert.ErrorContains(t, err, "depth should be between 1 and 10")
+			assert.Nil(t, graph)
+		})
+		t.Run("walks upstream and downstream up to the depth", func(t *testing.T) {
+			repo := new(mockLineageRepository)
+			defer repo.AssertExpectations(t)
+
+			repo.On("GetAllByResourceDestination", ctx, job.ResourceURN("table-b")).Return([]*job.Job{jobB}, nil)
+			repo.On("GetAllBySource", ctx, job.ResourceURN("table-b")).Return([]*job.Job{jobC}, nil)
+
+			lineageService := service.NewLineageService(repo, logger)
+			graph, err := lineageService.GetLineage(ctx, "table-b", 1)
+			assert.NoError(t, err)
+
+			var nodeIDs []string
+			for _, node := range graph.Nodes {
+				nodeIDs = append(nodeIDs, node.ID)
+			}
+			assert.ElementsMatch(t, []string{
+				"resource:table-b", "job:test-proj/job-B", "resource:table-a", "job:test-proj/job-C", "resource:table-c",
+			}, nodeIDs)
+			assert.ElementsMatch(t, []*job.LineageEdge{
+				{From: "job:test-proj/job-B", To: "resource:table-b"},
+				{From: "resource:table-a", To: "job:test-proj/job-B"},
+				{From: "resource:table-b", To: "job:test-proj/job-C"},
+				{From: "job:test-proj/job-C", To: "resource:table-c"},
+			}, graph.Edges)
+		})
+		t.Run("walks further with a larger depth", func(t *testing.T) {
+			repo := new(mockLineageRepository)
+			defer repo.AssertExpectations(t)
+
+			repo.On("GetAllByResourceDestination", ctx, job.ResourceURN("table-c")).Return([]*job.Job{jobC}, nil)
+			repo.On("GetAllByResourceDestination", ctx, job.ResourceURN("table-b")).Return([]*job.Job{jobB}, nil)
+			repo.On("GetAllBySource", ctx, job.ResourceURN("table-c")).Return(nil, nil)
+
+			lineageService := service.NewLineageService(repo, logger)
+			graph, err := lineageService.GetLineage(ctx, "table-c", 2)
+			assert.NoError(t, err)
+			assert.Len(t, graph.Nodes, 5)
+			assert.Len(t, graph.Edges, 4)
+		})
+	})
+	t.Run("GetJobLineage", func(t *testing.T) {
+		t.Run("returns the lineage around the destination of the job", func(t *testing.T) {
+			repo := new(mockLineageRepository)
+			defer repo.AssertExpectations(t)
+
+			repo.On("GetByJobName", ctx, sampleTenant.ProjectName(), job.Name("job-A")).Return(jobA, nil)
+			repo.On("GetAllByResourceDestination", ctx, job.ResourceURN("table-a")).Return([]*job.Job{jobA}, nil)
+			repo.On("GetAllBySource", ctx, job.ResourceURN("table-a")).Return([]*job.Job{jobB}, nil)
+
+			lineageService := service.NewLineageService(repo, logger)
+			graph, err := lineageService.GetJobLineage(ctx, sampleTenant.ProjectName(), "job-A", 1)
+			assert.NoError(t, err)
+			assert.Len(t, graph.Nodes, 5)
+			assert.Len(t, graph.Edges, 4)
+		})
+	})
+}
+
+type mockLineageRepository struct {
+	mock.Mock
+}
+
+func (m *mockLineageRepository) GetByJobName(ctx context.Context, projectName tenant.ProjectName, jobName job.Name) (*job.Job, error) {
+	args := m.Called(ctx, projectName, jobName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*job.Job), args.Error(1)
+}
+
+func (m *mockLineageRepository) GetAllByResourceDestination(ctx context.Context, resourceDestination job.ResourceURN) ([]*job.Job, error) {
+	args := m.Called(ctx, resourceDestination)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*job.Job), args.Error(1)
+}
+
+func (m *mockLineageRepository) GetAllBySource(ctx context.Context, source job.ResourceURN) ([]*job.Job, error) {
+	args := m.Called(ctx, source)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*job.Job), args.Error(1)
+}
@@ -17,11 +17,22 @@ import (
 
 const (
 	projectConfigPrefix = "GLOBAL__"
+	taskConfigPrefix    = "TASK__"
+
+	contextProject       = "proj"
+	contextSecret        = "secret"
+	contextSystemDefined = "inst"
+	contextTask          = "task"
+
+	// upstreamStringToMatch marks templates using the artifacts of upstream runs, which are only known at run time
+	upstreamStringToMatch = ".upstream"
 
 	configKeyDstart        = "DSTART"
 	configKeyDend          = "DEND"
+	configKeyDintervals    = "DINTERVALS"
 	configKeyExecutionTime = "EXECUTION_TIME"
 	configKeyDestination   = "JOB_DESTINATION"
+	configKeyJobTimezone   = "JOB_TIMEZONE"
 
 	TimeISOFormat = time.RFC3339
 )
@@ -32,31 +43,38 @@ var (
 )
 
 type PluginRepo interface {
-	GetByName(string) (*plugin.Plugin, error)
+	GetByNameAndVersion(name, version string) (*plugin.Plugin, error)
 }
 
 type Engine interface {
 	Compile(templateMap map[string]string, context map[string]any) (map[string]string, error)
 	CompileString(input string, context map[string]any) (string, error)
+	Validate(templateMap, snippets map[string]string, context map[string]any) error
+	CompileMacros(macros map[string]string, context map[string]any) (map[string]string, error)
+}
+
+type SnippetGetter interface {
+	GetSnippets(ctx context.Context, projectName tenant.ProjectName) (map[string]string, error)
 }
 
 type JobPluginService struct {
-	pluginRepo PluginRepo
-	engine     Engine
+	pluginRepo    PluginRepo
+	engine        Engine
+	snippetGetter SnippetGetter
 
 	now func() time.Time
 
 	logger log.Logger
 }
 
-func NewJobPluginService(pluginRepo PluginRepo, engine Engine, logger log.Logger) *JobPluginService {
-	return &JobPluginService{pluginRepo: pluginRepo, engine: engine, logger: logger, now: time.Now}
+func NewJobPluginService(pluginRepo PluginRepo, engine Engine, snippetGetter SnippetGetter, logger log.Logger) *JobPluginService {
+	return &JobPluginService{pluginRepo: pluginRepo, engine: engine, snippetGetter: snippetGetter, logger: logger, now: time.Now}
 }
 
-func (p JobPluginService) Info(_ context.Context, taskName job.TaskName) (*plugin.Info, error) {
-	taskPlugin, err := p.pluginRepo.GetByName(taskName.String())
+func (p JobPluginService) Info(_ context.Context, task job.Task) (*plugin.Info, error) {
+	taskPlugin, err := p.pluginRepo.GetByNameAndVersion(task.Name().String(), task.Version())
 	if err != nil {
-		p.logger.Error("error getting plugin [%s]: %s", taskName.String(), err)
+		p.logger.Error("error getting plugin [%s]: %s", task.Name().String(), err)
 		return nil, err
 	}
 
@@ -69,20 +87,21 @@ func (p JobPluginService) Info(_ context.Context, taskName job.TaskName) (*plugi
 }
 
 func (p JobPluginService) GenerateDestination(ctx context.Context, tnnt *tenant.WithDetails, task job.Task) (job.ResourceURN, error) {
-	taskPlugin, err := p.pluginRepo.GetByName(task.Name().String())
+	taskPlugin, err := p.pluginRepo.GetByNameAndVersion(task.Name().String(), task.Version())
 	if err != nil {
 		p.logger.Error("error getting plugin [%s]: %s", task.Name().String(), err)
 		return "", err
 	}
 
-	if taskPlugin.DependencyMod == nil {
+	dependencyMod := p.dependencyModOf(taskPlugin)
+	if dependencyMod == nil {
 		p.logger.Error(ErrUpstreamModNotFound.Error())
 		return "", ErrUpstreamModNotFound
 	}
 
 	compiledConfig := p.compileConfig(task.Config().Map(), tnnt)
 
-	destination, err := taskPlugin.DependencyMod.GenerateDestination(ctx, plugin.GenerateDestinationRequest{
+	destination, err := dependencyMod.GenerateDestination(ctx, plugin.GenerateDestinationRequest{
 		Config: compiledConfig,
 	})
 	if err != nil {
@@ -93,32 +112,34 @@ func (p JobPluginService) GenerateDestination(ctx context.Context, tnnt *tenant.
 	return job.ResourceURN(destination.URN()), nil
 }
 
-func (p JobPluginService) GenerateUpstreams(ctx context.Context, jobTenant *tenant.WithDetails, spec *job.Spec, dryRun bool) ([]job.ResourceURN, error) {
-	taskPlugin, err := p.pluginRepo.GetByName(spec.Task().Name().String())
+// GenerateUpstreams returns the resources the job reads from, along with the column lineages of its destination when the plugin provides them
+func (p JobPluginService) GenerateUpstreams(ctx context.Context, jobTenant *tenant.WithDetails, spec *job.Spec, dryRun bool) ([]job.ResourceURN, []*job.ColumnLineage, error) {
+	taskPlugin, err := p.pluginRepo.GetByNameAndVersion(spec.Task().Name().String(), spec.Task().Version())
 	if err != nil {
 		p.logger.Error("error getting plugin [%s]: %s", spec.Task().Name().String(), err)
-		return nil, err
+		return nil, nil, err
 	}
 
-	if taskPlugin.DependencyMod == nil {
+	dependencyMod := p.dependencyModOf(taskPlugin)
+	if dependencyMod == nil {
 		p.logger.Error(ErrUpstreamModNotFound.Error())
-		return nil, ErrUpstreamModNotFound
+		return nil, nil, ErrUpstreamModNotFound
 	}
 
 	w, err := getWindow(jobTenant, spec)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	assets, err := p.compileAsset(ctx, taskPlugin, spec, w, p.now())
+	assets, err := p.compileAsset(ctx, dependencyMod, spec, w, p.now(), jobTenant.Project().GetMacros())
 	if err != nil {
 		p.logger.Error("error compiling asset: %s", err)
-		return nil, fmt.Errorf("asset compilation failure: %w", err)
+		return nil, nil, fmt.Errorf("asset compilation failure: %w", err)
 	}
 
 	compiledConfigs := p.compileConfig(spec.Task().Config(), jobTenant)
 
-	resp, err := taskPlugin.DependencyMod.GenerateDependencies(ctx, plugin.GenerateDependenciesRequest{
+	resp, err := dependencyMod.GenerateDependencies(ctx, plugin.GenerateDependenciesRequest{
 		Config: compiledConfigs,
 		Assets: plugin.AssetsFromMap(assets),
 		Options: plugin.Options{
@@ -127,7 +148,7 @@ func (p JobPluginService) GenerateUpstreams(ctx context.Context, jobTenant *tena
 	})
 	if err != nil {
 		p.logger.Error("error generating dependencies: %s", err)
-		return nil, err
+		return nil, nil, err
 	}
 
 	var upstreamURNs []job.ResourceURN
@@ -135,8 +156,47 @@ func (p JobPluginService) GenerateUpstreams(ctx context.Context, jobTenant *tena
 		resourceURN := job.ResourceURN(dependency)
 		upstreamURNs = append(upstreamURNs, resourceURN)
 	}
+	columnLineages := p.toColumnLineages(spec.Name(), resp.ColumnMappings)
+
+	if spec.UpstreamSpec() != nil {
+		upstreamURNs = spec.UpstreamSpec().ApplyTo(upstreamURNs)
+		columnLineages = withoutIgnoredSources(columnLineages, spec.UpstreamSpec().IgnoredResources())
+	}
+	return upstreamURNs, columnLineages, nil
+}
+
+// withoutIgnoredSources drops the column lineages of the sources the job spec declares as false dependencies
+func withoutIgnoredSources(columnLineages []*job.ColumnLineage, ignoredResources []job.ResourceURN) []*job.ColumnLineage {
+	if len(ignoredResources) == 0 {
+		return columnLineages
+	}
+
+	ignored := make(map[job.ResourceURN]bool, len(ignoredResources))
+	for _, urn := range ignoredResources {
+		ignored[urn] = true
+	}
+	var filtered []*job.ColumnLineage
+	for _, columnLineage := range columnLineages {
+		if ignored[columnLineage.Source()] {
+			continue
+		}
+		filtered = append(filtered, columnLineage)
+	}
+	return filtered
+}
 
-	return upstreamURNs, nil
+// toColumnLineages skips the invalid mappings, as column lineage is optional and should not fail the job
+func (p JobPluginService) toColumnLineages(jobName job.Name, columnMappings []plugin.ColumnMapping) []*job.ColumnLineage {
+	var columnLineages []*job.ColumnLineage
+	for _, mapping := range columnMappings {
+		columnLineage, err := job.NewColumnLineage(job.ResourceURN(mapping.Dependency), mapping.DependencyColumn, mapping.Column)
+		if err != nil {
+			p.logger.Warn("skipping column mapping of job [%s]: %s", jobName.String(), err)
+			continue
+		}
+		columnLineages = append(columnLineages, columnLineage)
+	}
+	return columnLineages
 }
 
 func (p JobPluginService) compileConfig(configs job.Config, tnnt *tenant.WithDetails) plugin.Configs {
@@ -160,14 +220,30 @@ func (p JobPluginService) compileConfig(configs job.Config, tnnt *tenant.WithDet
 	return pluginConfigs
 }
 
-func (p JobPluginService) compileAsset(ctx context.Context, taskPlugin *plugin.Plugin, spec *job.Spec, w window.Window, scheduledAt time.Time) (map[string]string, error) {
-	var jobDestination string
+// dependencyModOf returns the dependency resolver mod of the plugin, falling back to the dependency rules
+// declared in its yaml, nil when the plugin has neither
+func (p JobPluginService) dependencyModOf(taskPlugin *plugin.Plugin) plugin.DependencyResolverMod {
 	if taskPlugin.DependencyMod != nil {
+		return taskPlugin.DependencyMod
+	}
+	if taskPlugin.YamlMod == nil {
+		return nil
+	}
+	info := taskPlugin.YamlMod.PluginInfo()
+	if info.DependencyRules == nil {
+		return nil
+	}
+	return ruleDependencyMod{name: info.Name, rules: info.DependencyRules, engine: p.engine}
+}
+
+func (p JobPluginService) compileAsset(ctx context.Context, dependencyMod plugin.DependencyResolverMod, spec *job.Spec, w window.Window, scheduledAt time.Time, macros map[string]string) (map[string]string, error) {
+	var jobDestination string
+	if dependencyMod != nil {
 		var assets map[string]string
 		if spec.Asset() != nil {
 			assets = spec.Asset()
 		}
-		jobDestinationResponse, err := taskPlugin.DependencyMod.GenerateDestination(ctx, plugin.GenerateDestinationRequest{
+		jobDestinationResponse, err := dependencyMod.GenerateDestination(ctx, plugin.GenerateDestinationRequest{
 			Config: plugin.ConfigsFromMap(spec.Task().Config()),
 			Assets: plugin.AssetsFromMap(assets),
 			Options: plugin.Options{
@@ -191,12 +267,29 @@ func (p JobPluginService) compileAsset(ctx context.Context, taskPlugin *plugin.P
 		assets = spec.Asset()
 	}
 
-	templates, err := p.engine.Compile(assets, map[string]interface{}{
+	assetContext := map[string]interface{}{
 		configKeyDstart:        interval.Start.Format(TimeISOFormat),
 		configKeyDend:          interval.End.Format(TimeISOFormat),
 		configKeyExecutionTime: scheduledAt.Format(TimeISOFormat),
 		configKeyDestination:   jobDestination,
-	})
+	}
+	intervals, ok, err := getIntervalsConfig(w, scheduledAt)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		assetContext[configKeyDintervals] = intervals
+	}
+	if len(macros) > 0 {
+		compiledMacros, err := p.engine.CompileMacros(macros, assetContext)
+		if err != nil {
+			p.logger.Error("error compiling macros: %s", err)
+			return nil, fmt.Errorf("failed to compile macros: %w", err)
+		}
+		assetContext[compiler.MacroContextName] = compiledMacros
+	}
+
+	templates, err := p.engine.Compile(assets, assetContext)
 	if err != nil {
 		p.logger.Error("error compiling asset: %s", err)
 		return nil, fmt.Errorf("failed to compile templates: %w", err)
@@ -208,3 +301,20 @@ func (p JobPluginService) compileAsset(ctx context.Context, taskPlugin *plugin.P
 func getWindow(jobTenant *tenant.WithDetails, spec *job.Spec) (window.Window, error) {
 	return window.From(spec.WindowConfig(), spec.Schedule().Interval(), jobTenant.Project().GetPreset)
 }
+
+// getIntervalsConfig returns the intervals carried by the executor input for windows having more than one interval
+func getIntervalsConfig(w window.Window, scheduledAt time.Time) (string, bool, error) {
+	intervals, err := w.GetIntervals(scheduledAt)
+	if err != nil {
+		return "", false, err
+	}
+	if len(intervals) < 2 { //nolint:gomnd
+		return "", false, nil
+	}
+
+	formatted, err := window.FormatIntervals(intervals, time.UTC)
+	if err != nil {
+		return "", false, err
+	}
+	return formatted, true, nil
+}
@@ -59,11 +59,11 @@ func TestPluginService(t *testing.T) {
 	t.Run("Info", func(t *testing.T) {
 		t.Run("returns error when no plugin", func(t *testing.T) {
 			pluginRepo := new(mockPluginRepo)
-			pluginRepo.On("GetByName", jobTask.Name().String()).Return(nil, errors.New("some error when fetch plugin"))
+			pluginRepo.On("GetByNameAndVersion", jobTask.Name().String(), "").Return(nil, errors.New("some error when fetch plugin"))
 			defer pluginRepo.AssertExpectations(t)
 
-			pluginService := service.NewJobPluginService(pluginRepo, nil, logger)
-			result, err := pluginService.Info(ctx, jobTask.Name())
+			pluginService := service.NewJobPluginService(pluginRepo, nil, nil, logger)
+			result, err := pluginService.Info(ctx, jobTask)
 			assert.Error(t, err)
 			assert.Nil(t, result)
 			assert.Equal(t, "some error when fetch plugin", err.Error())
@@ -79,10 +79,10 @@ func TestPluginService(t *testing.T) {
 			defer yamlMod.AssertExpectations(t)
 
 			newPlugin := &plugin.Plugin{DependencyMod: depMod}
-			pluginRepo.On("GetByName", jobTask.Name().String()).Return(newPlugin, nil)
+			pluginRepo.On("GetByNameAndVersion", jobTask.Name().String(), "").Return(newPlugin, nil)
 
-			pluginService := service.NewJobPluginService(pluginRepo, nil, logger)
-			result, err := pluginService.Info(ctx, jobTask.Name())
+			pluginService := service.NewJobPluginService(pluginRepo, nil, nil, logger)
+			result, err := pluginService.Info(ctx, jobTask)
 			assert.Error(t, err)
 			assert.Nil(t, result)
 			assert.Equal(t, "yaml mod not found for plugin", err.Error())
@@ -99,7 +99,7 @@ func TestPluginService(t *testing.T) {
 
 			taskPlugin := &plugin.Plugin{DependencyMod: depMod, YamlMod: yamlMod}
 
-			pluginRepo.On("GetByName", jobTask.Name().String()).Return(taskPlugin, nil)
+			pluginRepo.On("GetByNameAndVersion", jobTask.Name().String(), "").Return(taskPlugin, nil)
 			yamlMod.On("PluginInfo").Return(&plugin.Info{
 				Name:        jobTask.Name().String(),
 				Description: "example",
@@ -107,8 +107,8 @@ func TestPluginService(t *testing.T) {
 			}, nil)
 			defer yamlMod.AssertExpectations(t)
 
-			pluginService := service.NewJobPluginService(pluginRepo, nil, logger)
-			result, err := pluginService.Info(ctx, jobTask.Name())
+			pluginService := service.NewJobPluginService(pluginRepo, nil, nil, logger)
+			result, err := pluginService.Info(ctx, jobTask)
 			assert.NoError(t, err)
 			assert.NotNil(t, result)
 			assert.Equal(t, jobTask.Name().String(), result.Name)
@@ -134,7 +134,7 @@ func TestPluginService(t *testing.T) {
 			defer yamlMod.AssertExpectations(t)
 
 			taskPlugin := &plugin.Plugin{DependencyMod: depMod, YamlMod: yamlMod}
-			pluginRepo.On("GetByName", jobTask.Name().String()).Return(taskPlugin, nil)
+			pluginRepo.On("GetByNameAndVersion", jobTask.Name().String(), "").Return(taskPlugin, nil)
 
 			destination := "project.dataset.table"
 			destinationURN := job.ResourceURN("bigquery://project.dataset.table")
@@ -143,11 +143,129 @@ func TestPluginService(t *testing.T) {
 				Type:        "bigquery",
 			}, nil)
 
-			pluginService := service.NewJobPluginService(pluginRepo, engine, logger)
+			pluginService := service.NewJobPluginService(pluginRepo, engine, nil, logger)
 			result, err := pluginService.GenerateDestination(ctx, tenantDetails, jobTask)
 			assert.Nil(t, err)
 			assert.Equal(t, destinationURN, result)
 		})
+		t.Run("returns destination generated by the plugin version pinned by the task", func(t *testing.T) {
+			pluginRepo := new(mockPluginRepo)
+			defer pluginRepo.AssertExpectations(t)
+
+			depMod := new(mockOpt.DependencyResolverMod)
+			defer depMod.AssertExpectations(t)
+
+			taskPlugin := &plugin.Plugin{DependencyMod: depMod, YamlMod: new(mockOpt.YamlMod)}
+			pluginRepo.On("GetByNameAndVersion", jobTask.Name().String(), "2.0.0").Return(taskPlugin, nil)
+
+			depMod.On("GenerateDestination", ctx, mock.Anything).Return(&plugin.GenerateDestinationResponse{
+				Destination: "project.dataset.table",
+				Type:        "bigquery",
+			}, nil)
+
+			pluginService := service.NewJobPluginService(pluginRepo, compiler.NewEngine(), nil, logger)
+			result, err := pluginService.GenerateDestination(ctx, tenantDetails, jobTask.WithVersion("2.0.0"))
+			assert.Nil(t, err)
+			assert.Equal(t, job.ResourceURN("bigquery://project.dataset.table"), result)
+		})
+		t.Run("returns column lineages provided by the plugin skipping the invalid ones", func(t *testing.T) {
+			logger := log.NewLogrus()
+
+			pluginRepo := new(mockPluginRepo)
+			defer pluginRepo.AssertExpectations(t)
+
+			engine := compiler.NewEngine()
+
+			depMod := new(mockOpt.DependencyResolverMod)
+			defer depMod.AssertExpectations(t)
+
+			yamlMod := new(mockOpt.YamlMod)
+			defer yamlMod.AssertExpectations(t)
+
+			taskPlugin := &plugin.Plugin{DependencyMod: depMod, YamlMod: yamlMod}
+			pluginRepo.On("GetByNameAndVersion", jobTask.Name().String(), "").Return(taskPlugin, nil)
+
+			depMod.On("GenerateDestination", ctx, mock.Anything).Return(&plugin.GenerateDestinationResponse{
+				Destination: "project.dataset.table",
+				Type:        "bigquery",
+			}, nil)
+
+			jobSource := job.ResourceURN("project.dataset.table_upstream")
+			depMod.On("GenerateDependencies", ctx, mock.Anything).Return(&plugin.GenerateDependenciesResponse{
+				Dependencies: []string{jobSource.String()},
+				ColumnMappings: []plugin.ColumnMapping{
+					{Dependency: jobSource.String(), DependencyColumn: "customer_id", Column: "id"},
+					{Dependency: jobSource.String(), DependencyColumn: "", Column: "name"},
+				},
+			}, nil)
+
+			asset, err := job.AssetFrom(map[string]string{"sample-key": "sample-value"})
+			assert.NoError(t, err)
+			specA, err := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).WithAsset(asset).Build()
+			assert.NoError(t, err)
+
+			expectedColumnLineage, err := job.NewColumnLineage(jobSource, "customer_id", "id")
+			assert.NoError(t, err)
+
+			pluginService := service.NewJobPluginService(pluginRepo, engine, nil, logger)
+			result, columnLineages, err := pluginService.GenerateUpstreams(ctx, tenantDetails, specA, false)
+			assert.NoError(t, err)
+			assert.Equal(t, []job.ResourceURN{jobSource}, result)
+			assert.Equal(t, []*job.ColumnLineage{expectedColumnLineage}, columnLineages)
+		})
+		t.Run("applies the upstream overrides of the spec on the generated upstreams", func(t *testing.T) {
+			logger := log.NewLogrus()
+
+			pluginRepo := new(mockPluginRepo)
+			defer pluginRepo.AssertExpectations(t)
+
+			engine := compiler.NewEngine()
+
+			depMod := new(mockOpt.DependencyResolverMod)
+			defer depMod.AssertExpectations(t)
+
+			yamlMod := new(mockOpt.YamlMod)
+			defer yamlMod.AssertExpectations(t)
+
+			taskPlugin := &plugin.Plugin{DependencyMod: depMod, YamlMod: yamlMod}
+			pluginRepo.On("GetByNameAndVersion", jobTask.Name().String(), "").Return(taskPlugin, nil)
+
+			depMod.On("GenerateDestination", ctx, mock.Anything).Return(&plugin.GenerateDestinationResponse{
+				Destination: "project.dataset.table",
+				Type:        "bigquery",
+			}, nil)
+
+			jobSource := job.ResourceURN("project.dataset.table_upstream")
+			falseSource := job.ResourceURN("project.dataset.table_in_comment")
+			extraSource := job.ResourceURN("project.dataset.table_in_procedure")
+			depMod.On("GenerateDependencies", ctx, mock.Anything).Return(&plugin.GenerateDependenciesResponse{
+				Dependencies: []string{jobSource.String(), falseSource.String()},
+				ColumnMappings: []plugin.ColumnMapping{
+					{Dependency: jobSource.String(), DependencyColumn: "customer_id", Column: "id"},
+					{Dependency: falseSource.String(), DependencyColumn: "name", Column: "name"},
+				},
+			}, nil)
+
+			upstreamSpec, err := job.NewSpecUpstreamBuilder().
+				WithIgnoredResources([]job.ResourceURN{falseSource}).
+				WithExtraResources([]job.ResourceURN{extraSource}).
+				Build()
+			assert.NoError(t, err)
+			asset, err := job.AssetFrom(map[string]string{"sample-key": "sample-value"})
+			assert.NoError(t, err)
+			specA, err := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).
+				WithAsset(asset).WithSpecUpstream(upstreamSpec).Build()
+			assert.NoError(t, err)
+
+			expectedColumnLineage, err := job.NewColumnLineage(jobSource, "customer_id", "id")
+			assert.NoError(t, err)
+
+			pluginService := service.NewJobPluginService(pluginRepo, engine, nil, logger)
+			result, columnLineages, err := pluginService.GenerateUpstreams(ctx, tenantDetails, specA, false)
+			assert.NoError(t, err)
+			assert.Equal(t, []job.ResourceURN{jobSource, extraSource}, result)
+			assert.Equal(t, []*job.ColumnLineage{expectedColumnLineage}, columnLineages)
+		})
 		t.Run("returns error if unable to find the plugin", func(t *testing.T) {
 			logger := log.NewLogrus()
 
@@ -157,9 +275,9 @@ func TestPluginService(t *testing.T) {
 			engine := compiler.NewEngine()
 			defer pluginRepo.AssertExpectations(t)
 
-			pluginRepo.On("GetByName", jobTask.Name().String()).Return(nil, errors.New("not found"))
+			pluginRepo.On("GetByNameAndVersion", jobTask.Name().String(), "").Return(nil, errors.New("not found"))
 
-			pluginService := service.NewJobPluginService(pluginRepo, engine, logger)
+			pluginService := service.NewJobPluginService(pluginRepo, engine, nil, logger)
 			result, err := pluginService.GenerateDestination(ctx, tenantDetails, jobTask)
 			assert.ErrorContains(t, err, "not found")
 			assert.Equal(t, "", result.String())
@@ -179,10 +297,11 @@ func TestPluginService(t *testing.T) {
 			yamlMod := new(mockOpt.YamlMod)
 			defer yamlMod.AssertExpectations(t)
 
+			yamlMod.On("PluginInfo").Return(&plugin.Info{Name: "bq2bq"})
 			pluginWithoutDependencyMod := &plugin.Plugin{YamlMod: yamlMod}
-			pluginRepo.On("GetByName", jobTask.Name().String()).Return(pluginWithoutDependencyMod, nil)
+			pluginRepo.On("GetByNameAndVersion", jobTask.Name().String(), "").Return(pluginWithoutDependencyMod, nil)
 
-			pluginService := service.NewJobPluginService(pluginRepo, engine, logger)
+			pluginService := service.NewJobPluginService(pluginRepo, engine, nil, logger)
 			result, err := pluginService.GenerateDestination(ctx, tenantDetails, jobTask)
 			assert.ErrorIs(t, err, service.ErrUpstreamModNotFound)
 			assert.Equal(t, "", result.String())
@@ -202,11 +321,11 @@ func TestPluginService(t *testing.T) {
 			defer yamlMod.AssertExpectations(t)
 
 			taskPlugin := &plugin.Plugin{DependencyMod: depMod, YamlMod: yamlMod}
-			pluginRepo.On("GetByName", jobTask.Name().String()).Return(taskPlugin, nil)
+			pluginRepo.On("GetByNameAndVersion", jobTask.Name().String(), "").Return(taskPlugin, nil)
 
 			depMod.On("GenerateDestination", ctx, mock.Anything).Return(&plugin.GenerateDestinationResponse{}, errors.New("generate destination error"))
 
-			pluginService := service.NewJobPluginService(pluginRepo, engine, logger)
+			pluginService := service.NewJobPluginService(pluginRepo, engine, nil, logger)
 			result, err := pluginService.GenerateDestination(ctx, tenantDetails, jobTask)
 			assert.ErrorContains(t, err, "generate destination error")
 			assert.Equal(t, "", result.String())
@@ -230,7 +349,7 @@ func TestPluginService(t *testing.T) {
 			defer yamlMod.AssertExpectations(t)
 
 			taskPlugin := &plugin.Plugin{DependencyMod: depMod, YamlMod: yamlMod}
-			pluginRepo.On("GetByName", jobTask.Name().String()).Return(taskPlugin, nil)
+			pluginRepo.On("GetByNameAndVersion", jobTask.Name().String(), "").Return(taskPlugin, nil)
 
 			destination := "project.dataset.table"
 			depMod.On("GenerateDestination", ctx, mock.Anything).Return(&plugin.GenerateDestinationResponse{
@@ -249,11 +368,48 @@ func TestPluginService(t *testing.T) {
 			specA, err := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).WithAsset(asset).Build()
 			assert.NoError(t, err)
 
-			pluginService := service.NewJobPluginService(pluginRepo, engine, logger)
-			result, err := pluginService.GenerateUpstreams(ctx, tenantDetails, specA, false)
+			pluginService := service.NewJobPluginService(pluginRepo, engine, nil, logger)
+			result, _, err := pluginService.GenerateUpstreams(ctx, tenantDetails, specA, false)
 			assert.Nil(t, err)
 			assert.Equal(t, []job.ResourceURN{jobSource}, result)
 		})
+		t.Run("returns upstreams extracted by the dependency rules of a plugin without dependency mod", func(t *testing.T) {
+			pluginRepo := new(mockPluginRepo)
+			defer pluginRepo.AssertExpectations(t)
+
+			yamlMod := new(mockOpt.YamlMod)
+			defer yamlMod.AssertExpectations(t)
+
+			yamlMod.On("PluginInfo").Return(&plugin.Info{
+				Name: "bq2bq",
+				DependencyRules: &plugin.DependencyRules{
+					Destination: plugin.DestinationRule{Type: "bigquery", Name: "proj:dataset.{{.SECRET_TABLE_NAME}}"},
+					Upstreams: []plugin.UpstreamRule{
+						{Asset: "*.sql", Pattern: "`([\\w-]+)\\.(\\w+)\\.(\\w+)`", URN: "bigquery://$1:$2.$3"},
+					},
+				},
+			})
+			pluginRepo.On("GetByNameAndVersion", jobTask.Name().String(), "").Return(&plugin.Plugin{YamlMod: yamlMod}, nil)
+
+			asset, err := job.AssetFrom(map[string]string{
+				"query.sql": "select * from `proj.dataset.table_a` a join `proj.dataset.table_b` b on a.id = b.id join `proj.dataset.table_a` c on a.id = c.id",
+				"README.md": "reads from `proj.dataset.table_c`",
+			})
+			assert.NoError(t, err)
+			specA, err := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).WithAsset(asset).Build()
+			assert.NoError(t, err)
+
+			pluginService := service.NewJobPluginService(pluginRepo, compiler.NewEngine(), nil, logger)
+			result, _, err := pluginService.GenerateUpstreams(ctx, tenantDetails, specA, false)
+			assert.NoError(t, err)
+			assert.Equal(t, []job.ResourceURN{"bigquery://proj:dataset.table_a", "bigquery://proj:dataset.table_b"}, result)
+
+			taskConfig, err := job.ConfigFrom(map[string]string{"SECRET_TABLE_NAME": "{{.secret.TABLE_NAME}}"})
+			assert.NoError(t, err)
+			destination, err := pluginService.GenerateDestination(ctx, tenantDetails, job.NewTask("bq2bq", taskConfig))
+			assert.NoError(t, err)
+			assert.Equal(t, job.ResourceURN("bigquery://proj:dataset.secret_table"), destination)
+		})
 		t.Run("returns error if unable to find the plugin", func(t *testing.T) {
 			logger := log.NewLogrus()
 
@@ -263,13 +419,13 @@ func TestPluginService(t *testing.T) {
 			engine := compiler.NewEngine()
 			defer pluginRepo.AssertExpectations(t)
 
-			pluginRepo.On("GetByName", jobTask.Name().String()).Return(nil, errors.New("not found"))
+			pluginRepo.On("GetByNameAndVersion", jobTask.Name().String(), "").Return(nil, errors.New("not found"))
 
 			specA, err := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).Build()
 			assert.NoError(t, err)
 
-			pluginService := service.NewJobPluginService(pluginRepo, engine, logger)
-			result, err := pluginService.GenerateUpstreams(ctx, tenantDetails, specA, false)
+			pluginService := service.NewJobPluginService(pluginRepo, engine, nil, logger)
+			result, _, err := pluginService.GenerateUpstreams(ctx, tenantDetails, specA, false)
 			assert.ErrorContains(t, err, "not found")
 			assert.Nil(t, result)
 		})
@@ -288,14 +444,15 @@ func TestPluginService(t *testing.T) {
 			yamlMod := new(mockOpt.YamlMod)
 			defer yamlMod.AssertExpectations(t)
 
+			yamlMod.On("PluginInfo").Return(&plugin.Info{Name: "bq2bq"})
 			pluginWithoutDependencyMod := &plugin.Plugin{YamlMod: yamlMod}
-			pluginRepo.On("GetByName", jobTask.Name().String()).Return(pluginWithoutDependencyMod, nil)
+			pluginRepo.On("GetByNameAndVersion", jobTask.Name().String(), "").Return(pluginWithoutDependencyMod, nil)
 
 			specA, err := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).Build()
 			assert.NoError(t, err)
 
-			pluginService := service.NewJobPluginService(pluginRepo, engine, logger)
-			result, err := pluginService.GenerateUpstreams(ctx, tenantDetails, specA, false)
+			pluginService := service.NewJobPluginService(pluginRepo, engine, nil, logger)
+			result, _, err := pluginService.GenerateUpstreams(ctx, tenantDetails, specA, false)
 			assert.ErrorContains(t, err, "not found")
 			assert.Nil(t, result)
 		})
@@ -315,15 +472,15 @@ func TestPluginService(t *testing.T) {
 			defer yamlMod.AssertExpectations(t)
 
 			taskPlugin := &plugin.Plugin{DependencyMod: depMod, YamlMod: yamlMod}
-			pluginRepo.On("GetByName", jobTask.Name().String()).Return(taskPlugin, nil)
+			pluginRepo.On("GetByNameAndVersion", jobTask.Name().String(), "").Return(taskPlugin, nil)
 
 			depMod.On("GenerateDestination", ctx, mock.Anything).Return(&plugin.GenerateDestinationResponse{}, errors.New("generate destination error"))
 
 			specA, err := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).Build()
 			assert.NoError(t, err)
 
-			pluginService := service.NewJobPluginService(pluginRepo, engine, logger)
-			result, err := pluginService.GenerateUpstreams(ctx, tenantDetails, specA, false)
+			pluginService := service.NewJobPluginService(pluginRepo, engine, nil, logger)
+			result, _, err := pluginService.GenerateUpstreams(ctx, tenantDetails, specA, false)
 			assert.ErrorContains(t, err, "generate destination error")
 			assert.Nil(t, result)
 		})
@@ -343,7 +500,7 @@ func TestPluginService(t *testing.T) {
 			defer yamlMod.AssertExpectations(t)
 
 			taskPlugin := &plugin.Plugin{DependencyMod: depMod, YamlMod: yamlMod}
-			pluginRepo.On("GetByName", jobTask.Name().String()).Return(taskPlugin, nil)
+			pluginRepo.On("GetByNameAndVersion", jobTask.Name().String(), "").Return(taskPlugin, nil)
 
 			destination := "project.dataset.table"
 			depMod.On("GenerateDestination", ctx, mock.Anything).Return(&plugin.GenerateDestinationResponse{
@@ -357,22 +514,191 @@ func TestPluginService(t *testing.T) {
 			specA, err := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).Build()
 			assert.NoError(t, err)
 
-			pluginService := service.NewJobPluginService(pluginRepo, engine, logger)
-			result, err := pluginService.GenerateUpstreams(ctx, tenantDetails, specA, false)
+			pluginService := service.NewJobPluginService(pluginRepo, engine, nil, logger)
+			result, _, err := pluginService.GenerateUpstreams(ctx, tenantDetails, specA, false)
 			assert.ErrorContains(t, err, "generate dependencies error")
 			assert.Nil(t, result)
 		})
 	})
+	t.Run("ValidateTemplates", func(t *testing.T) {
+		snippets := map[string]string{"window_filter": `ts > "{{ .DSTART }}" and ts <= "{{ .DEND }}"`}
+
+		t.Run("returns error when unable to get snippets", func(t *testing.T) {
+			snippetGetter := new(mockSnippetGetter)
+			snippetGetter.On("GetSnippets", ctx, project.Name()).Return(nil, errors.New("error in get"))
+			defer snippetGetter.AssertExpectations(t)
+
+			specA, err := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).Build()
+			assert.NoError(t, err)
+
+			pluginService := service.NewJobPluginService(nil, compiler.NewEngine(), snippetGetter, logger)
+			err = pluginService.ValidateTemplates(ctx, tenantDetails, specA)
+			assert.ErrorContains(t, err, "error in get")
+		})
+		t.Run("returns no error when templates only reference defined variables", func(t *testing.T) {
+			snippetGetter := new(mockSnippetGetter)
+			snippetGetter.On("GetSnippets", ctx, project.Name()).Return(snippets, nil)
+			defer snippetGetter.AssertExpectations(t)
+
+			asset, err := job.AssetFrom(map[string]string{
+				"query.sql":    `select * from {{ .proj.bucket }} where {{ include "window_filter" }}`,
+				"upstream.sql": `select * from {{ .upstream.job_b.table }}`,
+			})
+			assert.NoError(t, err)
+			taskConfig, err := job.ConfigFrom(map[string]string{"TABLE": "{{ .secret.TABLE_NAME }}", "BUCKET": "{{ .GLOBAL__bucket }}"})
+			assert.NoError(t, err)
+			hookConfig, err := job.ConfigFrom(map[string]string{"TABLE": "{{ .task.TABLE }}", "AT": "{{ .EXECUTION_TIME }}"})
+			assert.NoError(t, err)
+			hook, err := job.NewHook("predator", hookConfig)
+			assert.NoError(t, err)
+			specA, err := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, job.NewTask("bq2bq", taskConfig)).
+				WithAsset(asset).WithHooks([]*job.Hook{hook}).Build()
+			assert.NoError(t, err)
+
+			pluginService := service.NewJobPluginService(nil, compiler.NewEngine(), snippetGetter, logger)
+			err = pluginService.ValidateTemplates(ctx, tenantDetails, specA)
+			assert.NoError(t, err)
+		})
+		t.Run("returns error for every template referencing undefined variables", func(t *testing.T) {
+			snippetGetter := new(mockSnippetGetter)
+			snippetGetter.On("GetSnippets", ctx, project.Name()).Return(snippets, nil)
+			defer snippetGetter.AssertExpectations(t)
+
+			taskConfig, err := job.ConfigFrom(map[string]string{"TABLE": "{{ .secret.unknown_secret }}"})
+			assert.NoError(t, err)
+			asset, err := job.AssetFrom(map[string]string{"query.sql": `select * from t where ts <= "{{ .DEND_TIME }}"`})
+			assert.NoError(t, err)
+			hookConfig, err := job.ConfigFrom(map[string]string{"TABLE": "{{ .task.UNKNOWN }}"})
+			assert.NoError(t, err)
+			hook, err := job.NewHook("predator", hookConfig)
+			assert.NoError(t, err)
+			specA, err := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, job.NewTask("bq2bq", taskConfig)).
+				WithAsset(asset).WithHooks([]*job.Hook{hook}).Build()
+			assert.NoError(t, err)
+
+			pluginService := service.NewJobPluginService(nil, compiler.NewEngine(), snippetGetter, logger)
+			err = pluginService.ValidateTemplates(ctx, tenantDetails, specA)
+			assert.ErrorContains(t, err, `task config: invalid argument for entity compiler: unable to render content for TABLE`)
+			assert.ErrorContains(t, err, `map has no entry for key "unknown_secret"`)
+			assert.ErrorContains(t, err, `asset: invalid argument for entity compiler: unable to render content for query.sql`)
+			assert.ErrorContains(t, err, `hook predator config: invalid argument for entity compiler: unable to render content for TABLE`)
+		})
+		t.Run("validates templates referencing project macros", func(t *testing.T) {
+			snippetGetter := new(mockSnippetGetter)
+			snippetGetter.On("GetSnippets", ctx, project.Name()).Return(nil, nil)
+
+			projectWithMacros, err := tenant.NewProject("test-proj", map[string]string{
+				tenant.ProjectSchedulerHost:            "host",
+				tenant.ProjectStoragePathKey:           "gs://location",
+				tenant.ProjectMacroPrefix + "DAY":      `{{ .DSTART | Date }}`,
+				tenant.ProjectMacroPrefix + "DAY_PART": `_PARTITIONTIME = "{{ .macro.DAY }}"`,
+			})
+			assert.NoError(t, err)
+			detailsWithMacros, err := tenant.NewTenantDetails(projectWithMacros, namespace, nil)
+			assert.NoError(t, err)
+
+			asset, err := job.AssetFrom(map[string]string{
+				"query.sql": `select * from t where {{ .macro.DAY_PART }}`,
+				"other.sql": `select * from t where {{ .macro.UNKNOWN }}`,
+			})
+			assert.NoError(t, err)
+			specA, err := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).WithAsset(asset).Build()
+			assert.NoError(t, err)
+
+			pluginService := service.NewJobPluginService(nil, compiler.NewEngine(), snippetGetter, logger)
+			err = pluginService.ValidateTemplates(ctx, detailsWithMacros, specA)
+			assert.ErrorContains(t, err, `unable to render content for other.sql`)
+			assert.ErrorContains(t, err, `map has no entry for key "UNKNOWN"`)
+			assert.NotContains(t, err.Error(), "query.sql")
+		})
+	})
+	t.Run("ValidateConfigs", func(t *testing.T) {
+		additionalProperties := false
+		schema := &plugin.ConfigSchema{
+			Properties:           map[string]*plugin.PropertySchema{"LOAD_METHOD": {Enum: []string{"APPEND", "REPLACE"}}},
+			AdditionalProperties: &additionalProperties,
+		}
+
+		t.Run("returns error when unable to find the plugin", func(t *testing.T) {
+			pluginRepo := new(mockPluginRepo)
+			pluginRepo.On("GetByNameAndVersion", jobTask.Name().String(), "").Return(nil, errors.New("not found"))
+			defer pluginRepo.AssertExpectations(t)
+
+			specA, err := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).Build()
+			assert.NoError(t, err)
+
+			pluginService := service.NewJobPluginService(pluginRepo, nil, nil, logger)
+			err = pluginService.ValidateConfigs(ctx, specA)
+			assert.ErrorContains(t, err, "not found")
+		})
+		t.Run("returns error for the task and hook configs not following the config schema of their plugins", func(t *testing.T) {
+			taskYamlMod := new(mockOpt.YamlMod)
+			taskYamlMod.On("PluginInfo").Return(&plugin.Info{Name: "bq2bq", ConfigSchema: schema})
+			defer taskYamlMod.AssertExpectations(t)
+
+			hookYamlMod := new(mockOpt.YamlMod)
+			hookYamlMod.On("PluginInfo").Return(&plugin.Info{Name: "predator", ConfigSchema: schema})
+			defer hookYamlMod.AssertExpectations(t)
+
+			pluginRepo := new(mockPluginRepo)
+			pluginRepo.On("GetByNameAndVersion", "bq2bq", "").Return(&plugin.Plugin{YamlMod: taskYamlMod}, nil)
+			pluginRepo.On("GetByNameAndVersion", "predator", "").Return(&plugin.Plugin{YamlMod: hookYamlMod}, nil)
+			defer pluginRepo.AssertExpectations(t)
+
+			taskConfig, err := job.ConfigFrom(map[string]string{"LOAD_METHD": "APPEND"})
+			assert.NoError(t, err)
+			hookConfig, err := job.ConfigFrom(map[string]string{"LOAD_METHOD": "MERGE"})
+			assert.NoError(t, err)
+			hook, err := job.NewHook("predator", hookConfig)
+			assert.NoError(t, err)
+			specA, err := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, job.NewTask("bq2bq", taskConfig)).
+				WithHooks([]*job.Hook{hook}).Build()
+			assert.NoError(t, err)
+
+			pluginService := service.NewJobPluginService(pluginRepo, nil, nil, logger)
+			err = pluginService.ValidateConfigs(ctx, specA)
+			assert.ErrorContains(t, err, "task config: unknown config LOAD_METHD, did you mean LOAD_METHOD?")
+			assert.ErrorContains(t, err, `hook predator config: config LOAD_METHOD: value "MERGE" is not one of APPEND, REPLACE`)
+		})
+		t.Run("returns no error when the plugin has no config schema", func(t *testing.T) {
+			yamlMod := new(mockOpt.YamlMod)
+			yamlMod.On("PluginInfo").Return(&plugin.Info{Name: "bq2bq"})
+			defer yamlMod.AssertExpectations(t)
+
+			pluginRepo := new(mockPluginRepo)
+			pluginRepo.On("GetByNameAndVersion", "bq2bq", "").Return(&plugin.Plugin{YamlMod: yamlMod}, nil)
+			defer pluginRepo.AssertExpectations(t)
+
+			specA, err := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).Build()
+			assert.NoError(t, err)
+
+			pluginService := service.NewJobPluginService(pluginRepo, nil, nil, logger)
+			err = pluginService.ValidateConfigs(ctx, specA)
+			assert.NoError(t, err)
+		})
+	})
 }
 
 type mockPluginRepo struct {
 	mock.Mock
 }
 
-func (m *mockPluginRepo) GetByName(name string) (*plugin.Plugin, error) {
-	args := m.Called(name)
+func (m *mockPluginRepo) GetByNameAndVersion(name, version string) (*plugin.Plugin, error) {
+	args := m.Called(name, version)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).(*plugin.Plugin), args.Error(1)
 }
+
+type mockSnippetGetter struct {
+	mock.Mock
+}
+
+func (m *mockSnippetGetter) GetSnippets(ctx context.Context, projectName tenant.ProjectName) (map[string]string, error) {
+	args := m.Called(ctx, projectName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]string), args.Error(1)
+}
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+
+	"github.com/goto/salt/log"
+
+	"github.com/goto/optimus/core/job"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+)
+
+type ScheduleGroupRepository interface {
+	Upsert(ctx context.Context, group *job.ScheduleGroup) error
+	Get(ctx context.Context, projectName tenant.ProjectName, name string) (*job.ScheduleGroup, error)
+	GetAll(ctx context.Context, projectName tenant.ProjectName) ([]*job.ScheduleGroup, error)
+}
+
+type ScheduleGroupJobRepository interface {
+	GetByJobName(ctx context.Context, projectName tenant.ProjectName, jobName job.Name) (*job.Job, error)
+	Update(context.Context, []*job.Job) (updatedJobs []*job.Job, err error)
+}
+
+type ScheduleGroupService struct {
+	repo    ScheduleGroupRepository
+	jobRepo ScheduleGroupJobRepository
+
+	jobDeploymentService JobDeploymentService
+
+	logger log.Logger
+}
+
+// Register stores the group, after checking every job of the group exists in the project
+func (s ScheduleGroupService) Register(ctx context.Context, group *job.ScheduleGroup) error {
+	for _, jobName := range group.JobNames {
+		if _, err := s.jobRepo.GetByJobName(ctx, group.ProjectName, jobName); err != nil {
+			s.logger.Error("error getting job [%s] of schedule group [%s]: %s", jobName, group.Name, err)
+			return err
+		}
+	}
+
+	if err := s.repo.Upsert(ctx, group); err != nil {
+		s.logger.Error("error storing schedule group [%s]: %s", group.Name, err)
+		return err
+	}
+	return nil
+}
+
+func (s ScheduleGroupService) GetAll(ctx context.Context, projectName tenant.ProjectName) ([]*job.ScheduleGroup, error) {
+	return s.repo.GetAll(ctx, projectName)
+}
+
+// Plan suggests the schedule changes of the jobs of the group, without changing them
+func (s ScheduleGroupService) Plan(ctx context.Context, projectName tenant.ProjectName, name string) (*job.SchedulePlan, error) {
+	plan, _, err := s.plan(ctx, projectName, name)
+	return plan, err
+}
+
+// Apply changes the schedules of the jobs as per the plan of the group and uploads the jobs to the scheduler.
+// The specs of the jobs are changed in the server only, the next deployment of the specs reverts the changes.
+func (s ScheduleGroupService) Apply(ctx context.Context, projectName tenant.ProjectName, name string) (*job.SchedulePlan, error) {
+	plan, jobs, err := s.plan(ctx, projectName, name)
+	if err != nil {
+		return nil, err
+	}
+	if len(plan.Changes) == 0 {
+		return plan, nil
+	}
+
+	var jobsToUpdate []*job.Job
+	for _, change := range plan.Changes {
+		subjectJob := jobs[change.JobName]
+		spec := subjectJob.Spec().WithScheduleInterval(change.SuggestedInterval)
+		jobsToUpdate = append(jobsToUpdate, job.NewJob(subjectJob.Tenant(), spec, subjectJob.Destination(), subjectJob.Sources()))
+	}
+
+	updatedJobs, err := s.jobRepo.Update(ctx, jobsToUpdate)
+	if err != nil {
+		s.logger.Error("error updating schedules of the jobs of schedule group [%s]: %s", name, err)
+		return nil, err
+	}
+
+	jobNamesByTenant := make(map[tenant.Tenant][]string)
+	for _, updatedJob := range updatedJobs {
+		jobNamesByTenant[updatedJob.Tenant()] = append(jobNamesByTenant[updatedJob.Tenant()], updatedJob.GetName())
+	}
+	me := errors.NewMultiError("apply schedule group errors")
+	for jobTenant, jobNames := range jobNamesByTenant {
+		if err := s.jobDeploymentService.UploadJobs(ctx, jobTenant, jobNames, nil); err != nil {
+			s.logger.Error("error uploading jobs of namespace [%s]: %s", jobTenant.NamespaceName(), err)
+			me.Append(err)
+		}
+	}
+	return plan, me.ToErr()
+}
+
+func (s ScheduleGroupService) plan(ctx context.Context, projectName tenant.ProjectName, name string) (*job.SchedulePlan, map[job.Name]*job.Job, error) {
+	group, err := s.repo.Get(ctx, projectName, name)
+	if err != nil {
+		s.logger.Error("error getting schedule group [%s]: %s", name, err)
+		return nil, nil, err
+	}
+
+	jobs := make(map[job.Name]*job.Job, len(group.JobNames))
+	intervals := make(map[job.Name]string, len(group.JobNames))
+	for _, jobName := range group.JobNames {
+		subjectJob, err := s.jobRepo.GetByJobName(ctx, projectName, jobName)
+		if err != nil {
+			if errors.IsErrorType(err, errors.ErrNotFound) {
+				continue
+			}
+			s.logger.Error("error getting job [%s] of schedule group [%s]: %s", jobName, name, err)
+			return nil, nil, err
+		}
+		jobs[jobName] = subjectJob
+		intervals[jobName] = subjectJob.Spec().Schedule().Interval()
+	}
+	return group.Plan(intervals), jobs, nil
+}
+
+func NewScheduleGroupService(repo ScheduleGroupRepository, jobRepo ScheduleGroupJobRepository, jobDeploymentService JobDeploymentService, logger log.Logger) *ScheduleGroupService {
+	return &ScheduleGroupService{
+		repo:                 repo,
+		jobRepo:              jobRepo,
+		jobDeploymentService: jobDeploymentService,
+		logger:               logger,
+	}
+}
@@ -0,0 +1,177 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/goto/optimus/core/job"
+	"github.com/goto/optimus/core/job/service"
+	"github.com/goto/optimus/core/tenant"
+	optErrors "github.com/goto/optimus/internal/errors"
+	"github.com/goto/optimus/internal/lib/window"
+	"github.com/goto/optimus/internal/models"
+)
+
+func TestScheduleGroupService(t *testing.T) {
+	ctx := context.Background()
+	logger := log.NewNoop()
+	sampleTenant, _ := tenant.NewTenant("test-proj", "test-ns")
+	projectName := sampleTenant.ProjectName()
+
+	startDate, err := job.ScheduleDateFrom("2022-10-01")
+	assert.NoError(t, err)
+	w, _ := models.NewWindow(1, "d", "24h", "24h")
+	jobWindow := window.NewCustomConfig(w)
+	taskName, _ := job.TaskNameFrom("bq2bq")
+	jobTask := job.NewTask(taskName, nil)
+
+	jobWithInterval := func(name job.Name, interval string) *job.Job {
+		schedule, err := job.NewScheduleBuilder(startDate).WithInterval(interval).Build()
+		assert.NoError(t, err)
+		spec, err := job.NewSpecBuilder(1, name, "sample-owner", schedule, jobWindow, jobTask).Build()
+		assert.NoError(t, err)
+		return job.NewJob(sampleTenant, spec, "", nil)
+	}
+
+	group, err := job.NewScheduleGroup(projectName, "source-limit", job.ScheduleGroupAntiAffinity, []job.Name{"job-A", "job-B"},
+		time.Hour, 15*time.Minute)
+	assert.NoError(t, err)
+
+	t.Run("Register", func(t *testing.T) {
+		t.Run("returns error when a job of the group does not exist", func(t *testing.T) {
+			groupRepo := new(mockScheduleGroupRepository)
+			jobRepo := new(JobRepository)
+			defer jobRepo.AssertExpectations(t)
+
+			jobRepo.On("GetByJobName", ctx, projectName, job.Name("job-A")).Return(jobWithInterval("job-A", "0 2 * * *"), nil)
+			jobRepo.On("GetByJobName", ctx, projectName, job.Name("job-B")).Return(nil, optErrors.NotFound(job.EntityJob, "unable to get job job-B"))
+
+			scheduleGroupService := service.NewScheduleGroupService(groupRepo, jobRepo, nil, logger)
+			err := scheduleGroupService.Register(ctx, group)
+			assert.ErrorContains(t, err, "unable to get job job-B")
+			groupRepo.AssertNotCalled(t, "Upsert", mock.Anything, mock.Anything)
+		})
+		t.Run("stores the group", func(t *testing.T) {
+			groupRepo := new(mockScheduleGroupRepository)
+			defer groupRepo.AssertExpectations(t)
+			jobRepo := new(JobRepository)
+			defer jobRepo.AssertExpectations(t)
+
+			jobRepo.On("GetByJobName", ctx, projectName, job.Name("job-A")).Return(jobWithInterval("job-A", "0 2 * * *"), nil)
+			jobRepo.On("GetByJobName", ctx, projectName, job.Name("job-B")).Return(jobWithInterval("job-B", "0 2 * * *"), nil)
+			groupRepo.On("Upsert", ctx, group).Return(nil)
+
+			scheduleGroupService := service.NewScheduleGroupService(groupRepo, jobRepo, nil, logger)
+			assert.NoError(t, scheduleGroupService.Register(ctx, group))
+		})
+	})
+	t.Run("Plan", func(t *testing.T) {
+		t.Run("reports the changes without updating the jobs", func(t *testing.T) {
+			groupRepo := new(mockScheduleGroupRepository)
+			defer groupRepo.AssertExpectations(t)
+			jobRepo := new(JobRepository)
+			defer jobRepo.AssertExpectations(t)
+
+			groupRepo.On("Get", ctx, projectName, "source-limit").Return(group, nil)
+			jobRepo.On("GetByJobName", ctx, projectName, job.Name("job-A")).Return(jobWithInterval("job-A", "0 2 * * *"), nil)
+			jobRepo.On("GetByJobName", ctx, projectName, job.Name("job-B")).Return(jobWithInterval("job-B", "5 2 * * *"), nil)
+
+			scheduleGroupService := service.NewScheduleGroupService(groupRepo, jobRepo, nil, logger)
+			plan, err := scheduleGroupService.Plan(ctx, projectName, "source-limit")
+			assert.NoError(t, err)
+			assert.Equal(t, []*job.ScheduleChange{
+				{JobName: "job-B", Interval: "5 2 * * *", SuggestedInterval: "15 2 * * *", Offset: 10 * time.Minute},
+			}, plan.Changes)
+		})
+		t.Run("reports the jobs deleted after the group is registered", func(t *testing.T) {
+			groupRepo := new(mockScheduleGroupRepository)
+			jobRepo := new(JobRepository)
+
+			groupRepo.On("Get", ctx, projectName, "source-limit").Return(group, nil)
+			jobRepo.On("GetByJobName", ctx, projectName, job.Name("job-A")).Return(jobWithInterval("job-A", "0 2 * * *"), nil)
+			jobRepo.On("GetByJobName", ctx, projectName, job.Name("job-B")).Return(nil, optErrors.NotFound(job.EntityJob, "unable to get job job-B"))
+
+			scheduleGroupService := service.NewScheduleGroupService(groupRepo, jobRepo, nil, logger)
+			plan, err := scheduleGroupService.Plan(ctx, projectName, "source-limit")
+			assert.NoError(t, err)
+			assert.Empty(t, plan.Changes)
+			assert.Equal(t, []string{"job job-B is not found"}, plan.Unresolved)
+		})
+		t.Run("returns error when group does not exist", func(t *testing.T) {
+			groupRepo := new(mockScheduleGroupRepository)
+			groupRepo.On("Get", ctx, projectName, "unknown").Return(nil, optErrors.NotFound(job.EntityScheduleGroup, "schedule group unknown is not found"))
+
+			scheduleGroupService := service.NewScheduleGroupService(groupRepo, nil, nil, logger)
+			_, err := scheduleGroupService.Plan(ctx, projectName, "unknown")
+			assert.ErrorContains(t, err, "schedule group unknown is not found")
+		})
+	})
+	t.Run("Apply", func(t *testing.T) {
+		t.Run("updates the schedule of the jobs and uploads them", func(t *testing.T) {
+			groupRepo := new(mockScheduleGroupRepository)
+			defer groupRepo.AssertExpectations(t)
+			jobRepo := new(JobRepository)
+			defer jobRepo.AssertExpectations(t)
+			jobDeploymentService := new(JobDeploymentService)
+			defer jobDeploymentService.AssertExpectations(t)
+
+			groupRepo.On("Get", ctx, projectName, "source-limit").Return(group, nil)
+			jobRepo.On("GetByJobName", ctx, projectName, job.Name("job-A")).Return(jobWithInterval("job-A", "0 2 * * *"), nil)
+			jobRepo.On("GetByJobName", ctx, projectName, job.Name("job-B")).Return(jobWithInterval("job-B", "5 2 * * *"), nil)
+
+			updatedJob := jobWithInterval("job-B", "15 2 * * *")
+			jobRepo.On("Update", ctx, mock.MatchedBy(func(jobs []*job.Job) bool {
+				return len(jobs) == 1 && jobs[0].Spec().Schedule().Interval() == "15 2 * * *"
+			})).Return([]*job.Job{updatedJob}, nil)
+			jobDeploymentService.On("UploadJobs", ctx, sampleTenant, []string{"job-B"}, []string(nil)).Return(nil)
+
+			scheduleGroupService := service.NewScheduleGroupService(groupRepo, jobRepo, jobDeploymentService, logger)
+			plan, err := scheduleGroupService.Apply(ctx, projectName, "source-limit")
+			assert.NoError(t, err)
+			assert.Len(t, plan.Changes, 1)
+		})
+		t.Run("does not update jobs when there is no change", func(t *testing.T) {
+			groupRepo := new(mockScheduleGroupRepository)
+			jobRepo := new(JobRepository)
+			defer jobRepo.AssertExpectations(t)
+
+			groupRepo.On("Get", ctx, projectName, "source-limit").Return(group, nil)
+			jobRepo.On("GetByJobName", ctx, projectName, job.Name("job-A")).Return(jobWithInterval("job-A", "0 2 * * *"), nil)
+			jobRepo.On("GetByJobName", ctx, projectName, job.Name("job-B")).Return(jobWithInterval("job-B", "30 2 * * *"), nil)
+
+			scheduleGroupService := service.NewScheduleGroupService(groupRepo, jobRepo, nil, logger)
+			plan, err := scheduleGroupService.Apply(ctx, projectName, "source-limit")
+			assert.NoError(t, err)
+			assert.Empty(t, plan.Changes)
+		})
+	})
+}
+
+type mockScheduleGroupRepository struct {
+	mock.Mock
+}
+
+func (m *mockScheduleGroupRepository) Upsert(ctx context.Context, group *job.ScheduleGroup) error {
+	return m.Called(ctx, group).Error(0)
+}
+
+func (m *mockScheduleGroupRepository) Get(ctx context.Context, projectName tenant.ProjectName, name string) (*job.ScheduleGroup, error) {
+	args := m.Called(ctx, projectName, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*job.ScheduleGroup), args.Error(1)
+}
+
+func (m *mockScheduleGroupRepository) GetAll(ctx context.Context, projectName tenant.ProjectName) ([]*job.ScheduleGroup, error) {
+	args := m.Called(ctx, projectName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*job.ScheduleGroup), args.Error(1)
+}
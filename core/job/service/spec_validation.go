@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/goto/optimus/core/job"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/lib/cron"
+)
+
+// ValidateJobSpecs runs the checks done when the job specs are deployed, without persisting them. The specs are
+// checked along with the deployed jobs of the project, so the circular dependencies they would introduce are reported
+// as well. Problems of the specs are reported in the returned validations, the error is only for failing to run the checks.
+func (j *JobService) ValidateJobSpecs(ctx context.Context, jobTenant tenant.Tenant, specs []*job.Spec) ([]*job.SpecValidation, error) {
+	l := j.tenantLogger(jobTenant, "")
+
+	tenantWithDetails, err := j.tenantDetailsGetter.GetDetails(ctx, jobTenant)
+	if err != nil {
+		l.Error("error getting tenant details: %s", err)
+		return nil, err
+	}
+
+	existingJobs, err := j.jobRepo.GetAllByProjectName(ctx, jobTenant.ProjectName())
+	if err != nil {
+		l.Error("error getting jobs of project [%s]: %s", jobTenant.ProjectName(), err)
+		return nil, err
+	}
+	existingJobMap := job.Jobs(existingJobs).GetNameAndJobMap()
+
+	validations := make([]*job.SpecValidation, len(specs))
+	validationMap := make(map[job.Name]*job.SpecValidation, len(specs))
+	specCount := make(map[job.Name]int, len(specs))
+	for i, spec := range specs {
+		validations[i] = job.NewSpecValidation(spec.Name())
+		validationMap[spec.Name()] = validations[i]
+		specCount[spec.Name()]++
+	}
+
+	var incomingJobs []*job.Job
+	for i, spec := range specs {
+		validation := validations[i]
+		if specCount[spec.Name()] > 1 {
+			validation.AddError(fmt.Errorf("duplicate %s", spec.Name()))
+			continue
+		}
+		if existingJob, ok := existingJobMap[spec.Name()]; ok && existingJob.Tenant().NamespaceName() != jobTenant.NamespaceName() {
+			validation.AddError(fmt.Errorf("job %s already exists in namespace %s", spec.Name(), existingJob.Tenant().NamespaceName()))
+			continue
+		}
+		if interval := spec.Schedule().Interval(); interval != "" {
+			if _, err := cron.ParseCronSchedule(interval); err != nil {
+				validation.AddError(fmt.Errorf("invalid schedule interval %s: %w", interval, err))
+				continue
+			}
+		}
+
+		incomingJob, err := j.generateJob(ctx, tenantWithDetails, spec)
+		if err != nil {
+			validation.AddError(err)
+			continue
+		}
+		incomingJobs = append(incomingJobs, incomingJob)
+	}
+
+	incomingJobMap := job.Jobs(incomingJobs).GetNameAndJobMap()
+	var unmodifiedSpecs []*job.Spec
+	for _, existingJob := range existingJobs {
+		if _, ok := incomingJobMap[existingJob.Spec().Name()]; !ok {
+			unmodifiedSpecs = append(unmodifiedSpecs, existingJob.Spec())
+		}
+	}
+
+	// the cycle check walks every job of the project regardless of the root, and stops at the first cycle found.
+	// the cycle is reported on the submitted jobs taking part in it.
+	jobsToValidateMap := getAllJobsToValidateMap(incomingJobs, existingJobs, unmodifiedSpecs)
+	identifierToJobsMap := getIdentifierToJobsMap(jobsToValidateMap)
+	for _, incomingJob := range incomingJobs {
+		jobName := incomingJob.Spec().Name()
+		if _, ok := jobsToValidateMap[jobName]; !ok {
+			continue
+		}
+		cyclePath, err := j.validateCyclic(jobName, jobsToValidateMap, identifierToJobsMap)
+		if err != nil {
+			reportCycle(cyclePath, incomingJobMap, validationMap, err)
+		}
+		break
+	}
+
+	return validations, nil
+}
+
+func reportCycle(cyclePath []string, incomingJobMap map[job.Name]*job.Job, validationMap map[job.Name]*job.SpecValidation, cycleErr error) {
+	reported := make(map[job.Name]bool)
+	for _, name := range cyclePath {
+		jobName := job.Name(name)
+		if _, ok := incomingJobMap[jobName]; !ok || reported[jobName] {
+			continue
+		}
+		validationMap[jobName].AddError(cycleErr)
+		reported[jobName] = true
+	}
+}
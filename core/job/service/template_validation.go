@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/goto/optimus/core/job"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/compiler"
+	"github.com/goto/optimus/internal/errors"
+	"github.com/goto/optimus/internal/utils"
+)
+
+// ValidateTemplates compiles the task config, the hook configs and the assets of the spec the way the executor input
+// is compiled, with the window of the current time, and fails on any reference to a variable that would not be
+// defined at run time. Templates using the artifacts of upstream runs are not validated.
+func (p JobPluginService) ValidateTemplates(ctx context.Context, jobTenant *tenant.WithDetails, spec *job.Spec) error {
+	w, err := getWindow(jobTenant, spec)
+	if err != nil {
+		return err
+	}
+	scheduledAt := p.now()
+	interval, err := w.GetInterval(scheduledAt)
+	if err != nil {
+		return err
+	}
+
+	snippets, err := p.snippetGetter.GetSnippets(ctx, jobTenant.Project().Name())
+	if err != nil {
+		p.logger.Error("error getting snippets of project [%s]: %s", jobTenant.Project().Name().String(), err)
+		return err
+	}
+
+	systemDefinedVars := map[string]string{
+		configKeyDstart:        interval.Start.Format(TimeISOFormat),
+		configKeyDend:          interval.End.Format(TimeISOFormat),
+		configKeyExecutionTime: scheduledAt.Format(TimeISOFormat),
+		configKeyDestination:   "",
+		configKeyJobTimezone:   time.UTC.String(),
+	}
+	intervals, ok, err := getIntervalsConfig(w, scheduledAt)
+	if err != nil {
+		return err
+	}
+	if ok {
+		systemDefinedVars[configKeyDintervals] = intervals
+	}
+	taskContext := compiler.PrepareContext(
+		compiler.From(jobTenant.GetConfigs()).WithName(contextProject).WithKeyPrefix(projectConfigPrefix),
+		compiler.From(jobTenant.SecretsMap()).WithName(contextSecret),
+		compiler.From(systemDefinedVars).WithName(contextSystemDefined).AddToContext(),
+	)
+
+	if macros := jobTenant.Project().GetMacros(); len(macros) > 0 {
+		macroContext := utils.MergeAnyMaps(taskContext)
+		delete(macroContext, contextSecret)
+		compiledMacros, err := p.engine.CompileMacros(macros, macroContext)
+		if err != nil {
+			return fmt.Errorf("macros: %w", err)
+		}
+		taskContext[compiler.MacroContextName] = compiledMacros
+	}
+
+	me := errors.NewMultiError("template validation errors")
+	taskConfig := spec.Task().Config().Map()
+	if err := p.engine.Validate(withoutUpstreamTemplates(taskConfig), snippets, taskContext); err != nil {
+		me.Append(fmt.Errorf("task config: %w", err))
+	}
+	if err := p.engine.Validate(withoutUpstreamTemplates(spec.Asset()), snippets, taskContext); err != nil {
+		me.Append(fmt.Errorf("asset: %w", err))
+	}
+
+	hookContext := compiler.PrepareContext(
+		compiler.From(taskConfig).WithName(contextTask).WithKeyPrefix(taskConfigPrefix),
+	)
+	mergedContext := utils.MergeAnyMaps(taskContext, hookContext)
+	for _, hook := range spec.Hooks() {
+		if err := p.engine.Validate(withoutUpstreamTemplates(hook.Config().Map()), snippets, mergedContext); err != nil {
+			me.Append(fmt.Errorf("hook %s config: %w", hook.Name(), err))
+		}
+	}
+	return me.ToErr()
+}
+
+// withoutUpstreamTemplates leaves out the templates referring the artifacts of upstream runs
+func withoutUpstreamTemplates(templates map[string]string) map[string]string {
+	filtered := make(map[string]string, len(templates))
+	for name, content := range templates {
+		if strings.Contains(content, upstreamStringToMatch) {
+			continue
+		}
+		filtered[name] = content
+	}
+	return filtered
+}
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/goto/salt/log"
+
+	"github.com/goto/optimus/core/job"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+	"github.com/goto/optimus/internal/telemetry"
+)
+
+// UpstreamCacheRepository stores the upstreams last generated for each job
+type UpstreamCacheRepository interface {
+	GetResolvedUpstreams(ctx context.Context, projectName tenant.ProjectName, jobName job.Name) (*job.ResolvedUpstreams, error)
+	SaveResolvedUpstreams(ctx context.Context, projectName tenant.ProjectName, jobName job.Name, resolved *job.ResolvedUpstreams) error
+}
+
+// CachedPluginService generates the upstreams of a job through the plugin only when the task, assets or tenant configs
+// of the job changed since the last generation, as calling the plugin for thousands of jobs slows down the deployment
+type CachedPluginService struct {
+	PluginService
+
+	repo   UpstreamCacheRepository
+	logger log.Logger
+}
+
+func (c CachedPluginService) GenerateUpstreams(ctx context.Context, jobTenant *tenant.WithDetails, spec *job.Spec, dryRun bool) ([]job.ResourceURN, []*job.ColumnLineage, error) {
+	info, err := c.PluginService.Info(ctx, spec.Task())
+	if err != nil {
+		c.logger.Warn("error getting plugin info of job [%s], generating upstreams without cache: %s", spec.Name(), err)
+		return c.PluginService.GenerateUpstreams(ctx, jobTenant, spec, dryRun)
+	}
+	hash := upstreamCacheKey(jobTenant, spec, info.PluginVersion, dryRun)
+
+	projectName := jobTenant.Project().Name()
+	cached, err := c.repo.GetResolvedUpstreams(ctx, projectName, spec.Name())
+	if err != nil && !errors.IsErrorType(err, errors.ErrNotFound) {
+		c.logger.Warn("error getting cached upstreams of job [%s]: %s", spec.Name(), err)
+	}
+	if cached != nil && cached.Hash == hash {
+		telemetry.NewCounter("job_upstream_cache_total", map[string]string{"result": "hit"}).Inc()
+		return cached.Sources, cached.ColumnLineages, nil
+	}
+	telemetry.NewCounter("job_upstream_cache_total", map[string]string{"result": "miss"}).Inc()
+
+	sources, columnLineages, err := c.PluginService.GenerateUpstreams(ctx, jobTenant, spec, dryRun)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resolved := &job.ResolvedUpstreams{Hash: hash, Sources: sources, ColumnLineages: columnLineages}
+	if err := c.repo.SaveResolvedUpstreams(ctx, projectName, spec.Name(), resolved); err != nil {
+		c.logger.Warn("error caching upstreams of job [%s]: %s", spec.Name(), err)
+	}
+	return sources, columnLineages, nil
+}
+
+// upstreamCacheKey hashes every input the plugin generates the upstreams from: the task with its plugin version, the
+// assets, the window, and the configs, secrets and macros of the tenant the templates are compiled against, along
+// with the upstream overrides of the spec applied on the generated upstreams
+func upstreamCacheKey(jobTenant *tenant.WithDetails, spec *job.Spec, pluginVersion string, dryRun bool) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s/%s/%t\n", spec.Task().Name(), pluginVersion, dryRun)
+
+	w := spec.WindowConfig()
+	fmt.Fprintf(h, "%s/%s/%s/%s/%s/%d\n", w.Type(), w.Preset, w.GetSize(), w.GetOffset(), w.GetTruncateTo(), w.GetVersion())
+
+	writeSortedMap(h, "task", spec.Task().Config())
+	writeSortedMap(h, "asset", spec.Asset())
+	writeSortedMap(h, "config", jobTenant.GetConfigs())
+	writeSortedMap(h, "secret", jobTenant.SecretsMap())
+	writeSortedMap(h, "macro", jobTenant.Project().GetMacros())
+
+	if upstreamSpec := spec.UpstreamSpec(); upstreamSpec != nil {
+		fmt.Fprintf(h, "[ignore]\n%v\n[extra]\n%v\n", upstreamSpec.IgnoredResources(), upstreamSpec.ExtraResources())
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func writeSortedMap(h io.Writer, section string, m map[string]string) {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	fmt.Fprintf(h, "[%s]\n", section)
+	for _, k := range keys {
+		fmt.Fprintf(h, "%s=%q\n", k, m[k])
+	}
+}
+
+func NewCachedPluginService(pluginService PluginService, repo UpstreamCacheRepository, logger log.Logger) *CachedPluginService {
+	return &CachedPluginService{
+		PluginService: pluginService,
+		repo:          repo,
+		logger:        logger,
+	}
+}
@@ -0,0 +1,137 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/goto/optimus/core/job"
+	"github.com/goto/optimus/core/job/service"
+	"github.com/goto/optimus/core/tenant"
+	optErrors "github.com/goto/optimus/internal/errors"
+	"github.com/goto/optimus/internal/lib/window"
+	"github.com/goto/optimus/internal/models"
+	"github.com/goto/optimus/sdk/plugin"
+)
+
+func TestCachedPluginService(t *testing.T) {
+	ctx := context.Background()
+	project, _ := tenant.NewProject("test-proj",
+		map[string]string{
+			tenant.ProjectSchedulerHost:  "host",
+			tenant.ProjectStoragePathKey: "gs://location",
+		})
+	namespace, _ := tenant.NewNamespace("test-ns", project.Name(), map[string]string{})
+	tenantDetails, _ := tenant.NewTenantDetails(project, namespace, nil)
+
+	startDate, _ := job.ScheduleDateFrom("2022-10-01")
+	jobSchedule, _ := job.NewScheduleBuilder(startDate).Build()
+	w, _ := models.NewWindow(1, "d", "24h", "24h")
+	jobTask := job.NewTask("bq2bq", job.Config{"SQL_TYPE": "STANDARD"})
+	spec, _ := job.NewSpecBuilder(1, "job-A", "sample-owner", jobSchedule, window.NewCustomConfig(w), jobTask).
+		WithAsset(job.Asset{"query.sql": "select * from `proj.dataset.table`"}).
+		Build()
+
+	info := &plugin.Info{Name: "bq2bq", PluginVersion: "0.3.2"}
+	sources := []job.ResourceURN{"bigquery://proj:dataset.table"}
+	logger := log.NewNoop()
+
+	t.Run("GenerateUpstreams", func(t *testing.T) {
+		t.Run("generates upstreams through the plugin and caches them when nothing is cached", func(t *testing.T) {
+			pluginService := new(PluginService)
+			pluginService.On("Info", ctx, jobTask).Return(info, nil)
+			pluginService.On("GenerateUpstreams", ctx, tenantDetails, spec, true).Return(sources, nil, nil)
+			defer pluginService.AssertExpectations(t)
+
+			repo := new(mockUpstreamCacheRepository)
+			repo.On("GetResolvedUpstreams", ctx, project.Name(), spec.Name()).Return(nil, optErrors.NotFound(job.EntityJob, "not found"))
+			repo.On("SaveResolvedUpstreams", ctx, project.Name(), spec.Name(), mock.MatchedBy(func(resolved *job.ResolvedUpstreams) bool {
+				return resolved.Hash != "" && len(resolved.Sources) == 1
+			})).Return(nil)
+			defer repo.AssertExpectations(t)
+
+			cachedService := service.NewCachedPluginService(pluginService, repo, logger)
+			result, _, err := cachedService.GenerateUpstreams(ctx, tenantDetails, spec, true)
+
+			assert.NoError(t, err)
+			assert.Equal(t, sources, result)
+		})
+		t.Run("returns cached upstreams without calling the plugin when the inputs are unchanged", func(t *testing.T) {
+			var hash string
+			pluginService := new(PluginService)
+			pluginService.On("Info", ctx, jobTask).Return(info, nil)
+			pluginService.On("GenerateUpstreams", ctx, tenantDetails, spec, true).Return(sources, nil, nil).Once()
+			defer pluginService.AssertExpectations(t)
+
+			repo := new(mockUpstreamCacheRepository)
+			repo.On("GetResolvedUpstreams", ctx, project.Name(), spec.Name()).Return(nil, optErrors.NotFound(job.EntityJob, "not found")).Once()
+			repo.On("SaveResolvedUpstreams", ctx, project.Name(), spec.Name(), mock.Anything).Run(func(args mock.Arguments) {
+				hash = args.Get(3).(*job.ResolvedUpstreams).Hash
+			}).Return(nil).Once()
+			defer repo.AssertExpectations(t)
+
+			cachedService := service.NewCachedPluginService(pluginService, repo, logger)
+			_, _, err := cachedService.GenerateUpstreams(ctx, tenantDetails, spec, true)
+			assert.NoError(t, err)
+
+			repo.On("GetResolvedUpstreams", ctx, project.Name(), spec.Name()).Return(&job.ResolvedUpstreams{Hash: hash, Sources: sources}, nil).Once()
+			result, _, err := cachedService.GenerateUpstreams(ctx, tenantDetails, spec, true)
+
+			assert.NoError(t, err)
+			assert.Equal(t, sources, result)
+		})
+		t.Run("generates upstreams again when the cached ones are of other inputs", func(t *testing.T) {
+			pluginService := new(PluginService)
+			pluginService.On("Info", ctx, jobTask).Return(info, nil)
+			pluginService.On("GenerateUpstreams", ctx, tenantDetails, spec, true).Return(sources, nil, nil)
+			defer pluginService.AssertExpectations(t)
+
+			repo := new(mockUpstreamCacheRepository)
+			repo.On("GetResolvedUpstreams", ctx, project.Name(), spec.Name()).Return(&job.ResolvedUpstreams{Hash: "outdated"}, nil)
+			repo.On("SaveResolvedUpstreams", ctx, project.Name(), spec.Name(), mock.Anything).Return(errors.New("db error"))
+			defer repo.AssertExpectations(t)
+
+			cachedService := service.NewCachedPluginService(pluginService, repo, logger)
+			result, _, err := cachedService.GenerateUpstreams(ctx, tenantDetails, spec, true)
+
+			assert.NoError(t, err)
+			assert.Equal(t, sources, result)
+		})
+		t.Run("returns error when the plugin fails to generate upstreams", func(t *testing.T) {
+			pluginService := new(PluginService)
+			pluginService.On("Info", ctx, jobTask).Return(info, nil)
+			pluginService.On("GenerateUpstreams", ctx, tenantDetails, spec, true).Return(nil, nil, errors.New("plugin error"))
+			defer pluginService.AssertExpectations(t)
+
+			repo := new(mockUpstreamCacheRepository)
+			repo.On("GetResolvedUpstreams", ctx, project.Name(), spec.Name()).Return(nil, optErrors.NotFound(job.EntityJob, "not found"))
+			defer repo.AssertExpectations(t)
+
+			cachedService := service.NewCachedPluginService(pluginService, repo, logger)
+			result, _, err := cachedService.GenerateUpstreams(ctx, tenantDetails, spec, true)
+
+			assert.ErrorContains(t, err, "plugin error")
+			assert.Nil(t, result)
+		})
+	})
+}
+
+type mockUpstreamCacheRepository struct {
+	mock.Mock
+}
+
+func (m *mockUpstreamCacheRepository) GetResolvedUpstreams(ctx context.Context, projectName tenant.ProjectName, jobName job.Name) (*job.ResolvedUpstreams, error) {
+	args := m.Called(ctx, projectName, jobName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*job.ResolvedUpstreams), args.Error(1)
+}
+
+func (m *mockUpstreamCacheRepository) SaveResolvedUpstreams(ctx context.Context, projectName tenant.ProjectName, jobName job.Name, resolved *job.ResolvedUpstreams) error {
+	return m.Called(ctx, projectName, jobName, resolved).Error(0)
+}
@@ -13,6 +13,9 @@ import (
 const (
 	DateLayout       = "2006-01-02"
 	maxJobNameLength = 125
+
+	HookPhasePre  = "pre"
+	HookPhasePost = "post"
 )
 
 type Spec struct {
@@ -84,6 +87,16 @@ func (s *Spec) Metadata() *Metadata {
 	return s.metadata
 }
 
+// WithScheduleInterval returns a copy of the spec scheduled at the given interval
+func (s *Spec) WithScheduleInterval(interval string) *Spec {
+	schedule := *s.schedule
+	schedule.interval = interval
+
+	spec := *s
+	spec.schedule = &schedule
+	return &spec
+}
+
 type SpecBuilder struct {
 	spec *Spec
 }
@@ -108,6 +121,9 @@ func (s *SpecBuilder) Build() (*Spec, error) {
 	if s.spec.owner == "" {
 		return nil, errors.InvalidArgument(EntityJob, "owner is empty")
 	}
+	if err := validateHooks(s.spec.hooks); err != nil {
+		return nil, err
+	}
 	return s.spec, nil
 }
 
@@ -279,11 +295,42 @@ func NewRetry(count int, delay int32, exponentialBackoff bool) *Retry {
 	return &Retry{count: count, delay: delay, exponentialBackoff: exponentialBackoff}
 }
 
+// CatchUpPolicy controls which of the runs scheduled before the job is deployed are run by the scheduler
+type CatchUpPolicy string
+
+const (
+	// CatchUpNone runs nothing scheduled before the job is first deployed
+	CatchUpNone CatchUpPolicy = "none"
+	// CatchUpLastOnly runs only the latest run scheduled before the deployment
+	CatchUpLastOnly CatchUpPolicy = "last_only"
+	// CatchUpFull runs every run scheduled since the start date of the job
+	CatchUpFull CatchUpPolicy = "full"
+)
+
+// CatchUpPolicyFrom returns the catch up policy, an empty policy defaults to last_only
+func CatchUpPolicyFrom(policy string) (CatchUpPolicy, error) {
+	switch CatchUpPolicy(strings.ToLower(policy)) {
+	case "", CatchUpLastOnly:
+		return CatchUpLastOnly, nil
+	case CatchUpNone:
+		return CatchUpNone, nil
+	case CatchUpFull:
+		return CatchUpFull, nil
+	default:
+		return "", errors.InvalidArgument(EntityJob, "unknown catch up policy "+policy)
+	}
+}
+
+func (c CatchUpPolicy) String() string {
+	return string(c)
+}
+
 type Schedule struct {
 	startDate     ScheduleDate
 	endDate       ScheduleDate
 	interval      string
 	dependsOnPast bool
+	catchUp       CatchUpPolicy
 	retry         *Retry
 }
 
@@ -303,6 +350,10 @@ func (s Schedule) DependsOnPast() bool {
 	return s.dependsOnPast
 }
 
+func (s Schedule) CatchUp() CatchUpPolicy {
+	return s.catchUp
+}
+
 func (s Schedule) Retry() *Retry {
 	return s.retry
 }
@@ -316,6 +367,7 @@ func NewScheduleBuilder(startDate ScheduleDate) *ScheduleBuilder {
 	return &ScheduleBuilder{
 		schedule: &Schedule{
 			startDate: startDate,
+			catchUp:   CatchUpLastOnly,
 		},
 	}
 }
@@ -342,6 +394,11 @@ func (s *ScheduleBuilder) WithDependsOnPast(dependsOnPast bool) *ScheduleBuilder
 	return s
 }
 
+func (s *ScheduleBuilder) WithCatchUp(catchUp CatchUpPolicy) *ScheduleBuilder {
+	s.schedule.catchUp = catchUp
+	return s
+}
+
 func (s *ScheduleBuilder) WithRetry(retry *Retry) *ScheduleBuilder {
 	s.schedule.retry = retry
 	return s
@@ -374,18 +431,29 @@ func (t TaskName) String() string {
 }
 
 type Task struct {
-	name   TaskName
-	config Config
+	name    TaskName
+	version string
+	config  Config
 }
 
 func NewTask(name TaskName, config Config) Task {
 	return Task{name: name, config: config}
 }
 
+// WithVersion pins the version of the plugin running the task, empty version runs the default version
+func (t Task) WithVersion(version string) Task {
+	t.version = version
+	return t
+}
+
 func (t Task) Name() TaskName {
 	return t.name
 }
 
+func (t Task) Version() string {
+	return t.version
+}
+
 func (t Task) Config() Config {
 	return t.config
 }
@@ -424,9 +492,67 @@ func NewResourceMetadata(request, limit *MetadataResourceConfig) *MetadataResour
 	return &MetadataResource{request: request, limit: limit}
 }
 
+// MetadataSensor overrides the poke interval and timeout of the sensor waiting for an upstream,
+// an empty upstream makes it the default for every upstream without an override of its own
+type MetadataSensor struct {
+	upstream     string
+	pokeInterval time.Duration
+	timeout      time.Duration
+	freshness    SensorFreshness
+}
+
+// SensorFreshness requires the data of an upstream to be fresh besides its runs being successful, the partition
+// of the window start of the run existing, and the data modified within max age when it is greater than zero
+type SensorFreshness struct {
+	PartitionExists bool
+	MaxAge          time.Duration
+}
+
+// NewMetadataSensor creates the sensor config for an upstream, referred by its job name,
+// project/job name or resource urn
+func NewMetadataSensor(upstream string, pokeInterval, timeout time.Duration) (*MetadataSensor, error) {
+	if pokeInterval < 0 || timeout < 0 {
+		return nil, errors.InvalidArgument(EntityJob, "sensor poke interval and timeout should not be negative")
+	}
+	if pokeInterval > 0 && timeout > 0 && pokeInterval > timeout {
+		return nil, errors.InvalidArgument(EntityJob, "sensor poke interval should not be greater than its timeout")
+	}
+	return &MetadataSensor{
+		upstream:     strings.TrimSpace(upstream),
+		pokeInterval: pokeInterval,
+		timeout:      timeout,
+	}, nil
+}
+
+func (m MetadataSensor) Upstream() string {
+	return m.upstream
+}
+
+func (m MetadataSensor) PokeInterval() time.Duration {
+	return m.pokeInterval
+}
+
+func (m MetadataSensor) Timeout() time.Duration {
+	return m.timeout
+}
+
+func (m MetadataSensor) Freshness() SensorFreshness {
+	return m.freshness
+}
+
+// WithFreshness sets the freshness predicates evaluated by the sensor after the runs of the upstream succeeded
+func (m *MetadataSensor) WithFreshness(freshness SensorFreshness) (*MetadataSensor, error) {
+	if freshness.MaxAge < 0 {
+		return nil, errors.InvalidArgument(EntityJob, "sensor freshness max age should not be negative")
+	}
+	m.freshness = freshness
+	return m, nil
+}
+
 type Metadata struct {
 	resource  *MetadataResource
 	scheduler map[string]string
+	sensors   []*MetadataSensor
 }
 
 func (m Metadata) Resource() *MetadataResource {
@@ -437,8 +563,22 @@ func (m Metadata) Scheduler() map[string]string {
 	return m.scheduler
 }
 
+func (m Metadata) Sensors() []*MetadataSensor {
+	return m.sensors
+}
+
 func (m Metadata) validate() error {
-	return validateMap(m.scheduler)
+	if err := validateMap(m.scheduler); err != nil {
+		return err
+	}
+	upstreams := make(map[string]bool, len(m.sensors))
+	for _, sensor := range m.sensors {
+		if upstreams[sensor.upstream] {
+			return errors.InvalidArgument(EntityJob, fmt.Sprintf("sensor for upstream [%s] is configured more than once", sensor.upstream))
+		}
+		upstreams[sensor.upstream] = true
+	}
+	return nil
 }
 
 type MetadataBuilder struct {
@@ -468,9 +608,26 @@ func (m *MetadataBuilder) WithScheduler(scheduler map[string]string) *MetadataBu
 	return m
 }
 
+func (m *MetadataBuilder) WithSensors(sensors []*MetadataSensor) *MetadataBuilder {
+	m.metadata.sensors = sensors
+	return m
+}
+
 type Hook struct {
 	name   string
 	config Config
+
+	// enabledWhen is a template evaluated against the project and namespace configs when the job is
+	// deployed to the scheduler, the hook is left out of the job unless it compiles to true
+	enabledWhen string
+
+	// phase overrides the hook type of the plugin, and dependsOn lists the hooks of the job
+	// which should finish before this hook runs
+	phase     string
+	dependsOn []string
+
+	// when is compiled against the task context of every run, the hook is skipped in the runs it does not hold
+	when string
 }
 
 func NewHook(name string, config Config) (*Hook, error) {
@@ -488,6 +645,98 @@ func (h Hook) Config() Config {
 	return h.config
 }
 
+func (h Hook) EnabledWhen() string {
+	return h.enabledWhen
+}
+
+// WithEnabledWhen returns a copy of the hook enabled only when the condition compiles to true
+func (h Hook) WithEnabledWhen(enabledWhen string) *Hook {
+	h.enabledWhen = enabledWhen
+	return &h
+}
+
+func (h Hook) Phase() string {
+	return h.phase
+}
+
+// WithPhase returns a copy of the hook running in the given phase, either pre or post
+func (h Hook) WithPhase(phase string) *Hook {
+	h.phase = phase
+	return &h
+}
+
+func (h Hook) DependsOn() []string {
+	return h.dependsOn
+}
+
+// WithDependsOn returns a copy of the hook running only after the given hooks of the job
+func (h Hook) WithDependsOn(dependsOn []string) *Hook {
+	h.dependsOn = dependsOn
+	return &h
+}
+
+func (h Hook) When() string {
+	return h.when
+}
+
+// WithWhen returns a copy of the hook skipped in the runs where the condition does not compile to true
+func (h Hook) WithWhen(when string) *Hook {
+	h.when = when
+	return &h
+}
+
+// validateHooks checks the phases and the dependencies between the hooks of a job do not form a cycle
+func validateHooks(hooks []*Hook) error {
+	hooksByName := make(map[string]*Hook, len(hooks))
+	for _, hook := range hooks {
+		hooksByName[hook.name] = hook
+	}
+
+	for _, hook := range hooks {
+		if hook.phase != "" && hook.phase != HookPhasePre && hook.phase != HookPhasePost {
+			return errors.InvalidArgument(EntityJob, fmt.Sprintf("phase of hook %s should be %s or %s", hook.name, HookPhasePre, HookPhasePost))
+		}
+		for _, dependency := range hook.dependsOn {
+			if dependency == hook.name {
+				return errors.InvalidArgument(EntityJob, fmt.Sprintf("hook %s can not depend on itself", hook.name))
+			}
+			if _, ok := hooksByName[dependency]; !ok {
+				return errors.InvalidArgument(EntityJob, fmt.Sprintf("hook %s depends on hook %s which is not in the job", hook.name, dependency))
+			}
+		}
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int, len(hooks))
+	var visit func(name string) error
+	visit = func(name string) error {
+		switch state[name] {
+		case visiting:
+			return errors.InvalidArgument(EntityJob, "cyclic dependency between hooks detected at hook "+name)
+		case visited:
+			return nil
+		}
+		state[name] = visiting
+		for _, dependency := range hooksByName[name].dependsOn {
+			if err := visit(dependency); err != nil {
+				return err
+			}
+		}
+		state[name] = visited
+		return nil
+	}
+	for _, hook := range hooks {
+		if err := visit(hook.name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type Asset map[string]string
 
 func AssetFrom(fileNameToContent map[string]string) (Asset, error) {
@@ -630,6 +879,9 @@ func (s SpecUpstreamName) GetJobName() (Name, error) {
 type UpstreamSpec struct {
 	upstreamNames []SpecUpstreamName
 	httpUpstreams []*SpecHTTPUpstream
+
+	ignoredResources []ResourceURN
+	extraResources   []ResourceURN
 }
 
 func (s UpstreamSpec) UpstreamNames() []SpecUpstreamName {
@@ -640,11 +892,69 @@ func (s UpstreamSpec) HTTPUpstreams() []*SpecHTTPUpstream {
 	return s.httpUpstreams
 }
 
+// IgnoredResources are the resources detected by the plugin which should not be treated as upstreams of the job
+func (s UpstreamSpec) IgnoredResources() []ResourceURN {
+	return s.ignoredResources
+}
+
+// ExtraResources are the resources the job reads from which are not detected by the plugin
+func (s UpstreamSpec) ExtraResources() []ResourceURN {
+	return s.extraResources
+}
+
+// ApplyTo removes the ignored resources from the generated sources and adds the extra resources missing from them
+func (s UpstreamSpec) ApplyTo(sources []ResourceURN) []ResourceURN {
+	if len(s.ignoredResources) == 0 && len(s.extraResources) == 0 {
+		return sources
+	}
+
+	ignored := make(map[ResourceURN]bool, len(s.ignoredResources))
+	for _, urn := range s.ignoredResources {
+		ignored[urn] = true
+	}
+
+	var applied []ResourceURN
+	exists := make(map[ResourceURN]bool, len(sources))
+	for _, source := range sources {
+		if ignored[source] || exists[source] {
+			continue
+		}
+		exists[source] = true
+		applied = append(applied, source)
+	}
+	for _, extra := range s.extraResources {
+		if exists[extra] {
+			continue
+		}
+		exists[extra] = true
+		applied = append(applied, extra)
+	}
+	return applied
+}
+
 func (s UpstreamSpec) validate() error {
 	me := errors.NewMultiError("errors on spec upstream")
 	for _, u := range s.httpUpstreams {
 		me.Append(u.validate())
 	}
+
+	ignored := make(map[ResourceURN]bool, len(s.ignoredResources))
+	for _, urn := range s.ignoredResources {
+		if urn == "" {
+			me.Append(errors.InvalidArgument(EntityJob, "ignored upstream resource is empty"))
+			continue
+		}
+		ignored[urn] = true
+	}
+	for _, urn := range s.extraResources {
+		if urn == "" {
+			me.Append(errors.InvalidArgument(EntityJob, "extra upstream resource is empty"))
+			continue
+		}
+		if ignored[urn] {
+			me.Append(errors.InvalidArgument(EntityJob, fmt.Sprintf("upstream resource %s is both ignored and extra", urn)))
+		}
+	}
 	return me.ToErr()
 }
 
@@ -675,6 +985,16 @@ func (s *SpecUpstreamBuilder) WithSpecHTTPUpstream(httpUpstreams []*SpecHTTPUpst
 	return s
 }
 
+func (s *SpecUpstreamBuilder) WithIgnoredResources(urns []ResourceURN) *SpecUpstreamBuilder {
+	s.upstream.ignoredResources = urns
+	return s
+}
+
+func (s *SpecUpstreamBuilder) WithExtraResources(urns []ResourceURN) *SpecUpstreamBuilder {
+	s.upstream.extraResources = urns
+	return s
+}
+
 func NewLabels(labels map[string]string) (map[string]string, error) {
 	if err := validateMap(labels); err != nil {
 		return nil, err
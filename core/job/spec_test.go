@@ -2,6 +2,7 @@ package job_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -88,6 +89,16 @@ func TestEntitySpec(t *testing.T) {
 			assert.Equal(t, hook.Name(), specA.Hooks()[0].Name())
 			assert.Equal(t, hook.Config(), specA.Hooks()[0].Config())
 			assert.Equal(t, hook.Config(), specA.Hooks()[0].Config())
+			assert.Empty(t, specA.Hooks()[0].EnabledWhen())
+
+			conditionalHook := hook.WithEnabledWhen(`{{ eq .proj.ENVIRONMENT "production" }}`)
+			assert.Equal(t, `{{ eq .proj.ENVIRONMENT "production" }}`, conditionalHook.EnabledWhen())
+			assert.Equal(t, hook.Config(), conditionalHook.Config())
+			assert.Empty(t, hook.EnabledWhen())
+
+			skippableHook := hook.WithWhen(`{{ eq .JOB_DESTINATION "project:dataset.table" }}`)
+			assert.Equal(t, `{{ eq .JOB_DESTINATION "project:dataset.table" }}`, skippableHook.When())
+			assert.Empty(t, hook.When())
 
 			assert.Equal(t, []*job.AlertSpec{alert}, specA.AlertSpecs())
 			assert.Equal(t, alert.Config(), specA.AlertSpecs()[0].Config())
@@ -115,6 +126,65 @@ func TestEntitySpec(t *testing.T) {
 			assert.Equal(t, jobMetadata.Scheduler(), specA.Metadata().Scheduler())
 			assert.Equal(t, jobMetadata.Scheduler(), specA.Metadata().Scheduler())
 		})
+		t.Run("should return hooks with phase and dependencies", func(t *testing.T) {
+			preHook, _ := job.NewHook("pre-hook", jobTaskConfig)
+			postHook, _ := job.NewHook("post-hook", jobTaskConfig)
+			postHook = postHook.WithPhase(job.HookPhasePost).WithDependsOn([]string{"pre-hook"})
+
+			specA, err := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).
+				WithHooks([]*job.Hook{preHook, postHook}).
+				Build()
+			assert.NoError(t, err)
+
+			assert.Empty(t, specA.Hooks()[0].Phase())
+			assert.Empty(t, specA.Hooks()[0].DependsOn())
+			assert.Equal(t, job.HookPhasePost, specA.Hooks()[1].Phase())
+			assert.Equal(t, []string{"pre-hook"}, specA.Hooks()[1].DependsOn())
+		})
+		t.Run("should return error if hook phase is invalid", func(t *testing.T) {
+			invalidHook, _ := job.NewHook("hook-a", jobTaskConfig)
+
+			specA, err := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).
+				WithHooks([]*job.Hook{invalidHook.WithPhase("fail")}).
+				Build()
+			assert.ErrorContains(t, err, "phase of hook hook-a should be pre or post")
+			assert.Nil(t, specA)
+		})
+		t.Run("should return error if hook depends on a hook not in the job", func(t *testing.T) {
+			hookA, _ := job.NewHook("hook-a", jobTaskConfig)
+
+			specA, err := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).
+				WithHooks([]*job.Hook{hookA.WithDependsOn([]string{"hook-b"})}).
+				Build()
+			assert.ErrorContains(t, err, "hook hook-a depends on hook hook-b which is not in the job")
+			assert.Nil(t, specA)
+		})
+		t.Run("should return error if hook dependencies are cyclic", func(t *testing.T) {
+			hookA, _ := job.NewHook("hook-a", jobTaskConfig)
+			hookB, _ := job.NewHook("hook-b", jobTaskConfig)
+			hookC, _ := job.NewHook("hook-c", jobTaskConfig)
+
+			specA, err := job.NewSpecBuilder(jobVersion, "job-A", "sample-owner", jobSchedule, jobWindow, jobTask).
+				WithHooks([]*job.Hook{
+					hookA.WithDependsOn([]string{"hook-c"}),
+					hookB.WithDependsOn([]string{"hook-a"}),
+					hookC.WithDependsOn([]string{"hook-b"}),
+				}).
+				Build()
+			assert.ErrorContains(t, err, "cyclic dependency between hooks detected")
+			assert.Nil(t, specA)
+		})
+	})
+
+	t.Run("Task", func(t *testing.T) {
+		t.Run("should return the pinned plugin version", func(t *testing.T) {
+			pinnedTask := jobTask.WithVersion("1.2.0")
+
+			assert.Equal(t, "1.2.0", pinnedTask.Version())
+			assert.Equal(t, jobTask.Name(), pinnedTask.Name())
+			assert.Equal(t, jobTask.Config(), pinnedTask.Config())
+			assert.Empty(t, jobTask.Version())
+		})
 	})
 
 	t.Run("Specs", func(t *testing.T) {
@@ -229,6 +299,46 @@ func TestEntitySpec(t *testing.T) {
 		})
 	})
 
+	t.Run("UpstreamSpec", func(t *testing.T) {
+		t.Run("should return error if a resource is both ignored and extra", func(t *testing.T) {
+			upstreamSpec, err := job.NewSpecUpstreamBuilder().
+				WithIgnoredResources([]job.ResourceURN{"bigquery://project:dataset.table"}).
+				WithExtraResources([]job.ResourceURN{"bigquery://project:dataset.table"}).
+				Build()
+			assert.ErrorContains(t, err, "is both ignored and extra")
+			assert.Nil(t, upstreamSpec)
+		})
+		t.Run("should return error if an ignored or extra resource is empty", func(t *testing.T) {
+			upstreamSpec, err := job.NewSpecUpstreamBuilder().
+				WithIgnoredResources([]job.ResourceURN{""}).
+				WithExtraResources([]job.ResourceURN{""}).
+				Build()
+			assert.ErrorContains(t, err, "ignored upstream resource is empty")
+			assert.ErrorContains(t, err, "extra upstream resource is empty")
+			assert.Nil(t, upstreamSpec)
+		})
+		t.Run("ApplyTo", func(t *testing.T) {
+			t.Run("should return the sources as is when there is no override", func(t *testing.T) {
+				upstreamSpec, err := job.NewSpecUpstreamBuilder().WithUpstreamNames([]job.SpecUpstreamName{"job-a"}).Build()
+				assert.NoError(t, err)
+
+				sources := []job.ResourceURN{"bigquery://project:dataset.a", "bigquery://project:dataset.b"}
+				assert.Equal(t, sources, upstreamSpec.ApplyTo(sources))
+			})
+			t.Run("should remove the ignored resources and add the missing extra resources", func(t *testing.T) {
+				upstreamSpec, err := job.NewSpecUpstreamBuilder().
+					WithIgnoredResources([]job.ResourceURN{"bigquery://project:dataset.b"}).
+					WithExtraResources([]job.ResourceURN{"bigquery://project:dataset.a", "bigquery://project:dataset.c"}).
+					Build()
+				assert.NoError(t, err)
+
+				sources := []job.ResourceURN{"bigquery://project:dataset.a", "bigquery://project:dataset.b"}
+				expected := []job.ResourceURN{"bigquery://project:dataset.a", "bigquery://project:dataset.c"}
+				assert.Equal(t, expected, upstreamSpec.ApplyTo(sources))
+			})
+		})
+	})
+
 	t.Run("Metadata", func(t *testing.T) {
 		t.Run("should return nil if no error found", func(t *testing.T) {
 			schedulerConf := map[string]string{"key": "val"}
@@ -245,6 +355,54 @@ func TestEntitySpec(t *testing.T) {
 			assert.Error(t, err)
 			assert.Nil(t, invalidJobMetadata)
 		})
+		t.Run("should return error if a sensor is configured more than once for an upstream", func(t *testing.T) {
+			sensorA, err := job.NewMetadataSensor("job-a", time.Minute, time.Hour)
+			assert.NoError(t, err)
+			sensorB, err := job.NewMetadataSensor(" job-a ", 5*time.Minute, time.Hour)
+			assert.NoError(t, err)
+
+			invalidJobMetadata, err := job.NewMetadataBuilder().
+				WithSensors([]*job.MetadataSensor{sensorA, sensorB}).
+				Build()
+			assert.ErrorContains(t, err, "sensor for upstream [job-a] is configured more than once")
+			assert.Nil(t, invalidJobMetadata)
+		})
+	})
+
+	t.Run("NewMetadataSensor", func(t *testing.T) {
+		t.Run("should return error if poke interval or timeout is negative", func(t *testing.T) {
+			sensor, err := job.NewMetadataSensor("", -time.Minute, time.Hour)
+			assert.ErrorContains(t, err, "should not be negative")
+			assert.Nil(t, sensor)
+		})
+		t.Run("should return error if poke interval is greater than timeout", func(t *testing.T) {
+			sensor, err := job.NewMetadataSensor("", 2*time.Hour, time.Hour)
+			assert.ErrorContains(t, err, "should not be greater than its timeout")
+			assert.Nil(t, sensor)
+		})
+		t.Run("should return sensor with values as inserted", func(t *testing.T) {
+			sensor, err := job.NewMetadataSensor("bigquery://project:dataset.table", time.Minute, 0)
+			assert.NoError(t, err)
+			assert.Equal(t, "bigquery://project:dataset.table", sensor.Upstream())
+			assert.Equal(t, time.Minute, sensor.PokeInterval())
+			assert.Equal(t, time.Duration(0), sensor.Timeout())
+		})
+		t.Run("should return error if freshness max age is negative", func(t *testing.T) {
+			sensor, err := job.NewMetadataSensor("job-a", time.Minute, time.Hour)
+			assert.NoError(t, err)
+
+			sensor, err = sensor.WithFreshness(job.SensorFreshness{MaxAge: -time.Hour})
+			assert.ErrorContains(t, err, "max age should not be negative")
+			assert.Nil(t, sensor)
+		})
+		t.Run("should return sensor with freshness as inserted", func(t *testing.T) {
+			sensor, err := job.NewMetadataSensor("job-a", time.Minute, time.Hour)
+			assert.NoError(t, err)
+
+			sensor, err = sensor.WithFreshness(job.SensorFreshness{PartitionExists: true, MaxAge: 6 * time.Hour})
+			assert.NoError(t, err)
+			assert.Equal(t, job.SensorFreshness{PartitionExists: true, MaxAge: 6 * time.Hour}, sensor.Freshness())
+		})
 	})
 
 	t.Run("Asset", func(t *testing.T) {
@@ -289,6 +447,24 @@ func TestEntitySpec(t *testing.T) {
 		})
 	})
 
+	t.Run("CatchUpPolicyFrom", func(t *testing.T) {
+		t.Run("should default to last_only if policy is empty", func(t *testing.T) {
+			policy, err := job.CatchUpPolicyFrom("")
+			assert.NoError(t, err)
+			assert.Equal(t, job.CatchUpLastOnly, policy)
+		})
+		t.Run("should return the policy regardless of its case", func(t *testing.T) {
+			policy, err := job.CatchUpPolicyFrom("FULL")
+			assert.NoError(t, err)
+			assert.Equal(t, job.CatchUpFull, policy)
+		})
+		t.Run("should return error if policy is unknown", func(t *testing.T) {
+			policy, err := job.CatchUpPolicyFrom("all")
+			assert.ErrorContains(t, err, "unknown catch up policy all")
+			assert.Empty(t, policy)
+		})
+	})
+
 	t.Run("TaskNameFrom", func(t *testing.T) {
 		t.Run("should return error if task name is empty", func(t *testing.T) {
 			owner, err := job.TaskNameFrom("")
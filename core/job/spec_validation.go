@@ -0,0 +1,19 @@
+package job
+
+// SpecValidation holds the problems found on a job spec by the checks run when the spec is deployed
+type SpecValidation struct {
+	JobName Name
+	Errors  []string
+}
+
+func NewSpecValidation(jobName Name) *SpecValidation {
+	return &SpecValidation{JobName: jobName}
+}
+
+func (v *SpecValidation) AddError(err error) {
+	v.Errors = append(v.Errors, err.Error())
+}
+
+func (v *SpecValidation) IsValid() bool {
+	return len(v.Errors) == 0
+}
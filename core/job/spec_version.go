@@ -0,0 +1,21 @@
+package job
+
+import (
+	"time"
+
+	"github.com/goto/optimus/core/tenant"
+)
+
+const EntityJobSpecVersion = "job_spec_version"
+
+// SpecVersion is a deployed version of the spec of a job, the versions of a job are numbered from 1 in the order
+// they are deployed
+type SpecVersion struct {
+	ProjectName tenant.ProjectName
+	JobName     Name
+	Version     int
+
+	Spec *Spec
+
+	CreatedAt time.Time
+}
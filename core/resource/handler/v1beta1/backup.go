@@ -10,6 +10,7 @@ import (
 	"github.com/goto/optimus/core/resource"
 	"github.com/goto/optimus/core/tenant"
 	"github.com/goto/optimus/internal/errors"
+	"github.com/goto/optimus/internal/logging"
 	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
 )
 
@@ -33,21 +34,23 @@ func (b BackupHandler) CreateBackup(ctx context.Context, req *pb.CreateBackupReq
 		return nil, errors.GRPCErr(err, "invalid backup request")
 	}
 
+	l := b.tenantLogger(tnnt)
+
 	store, err := resource.FromStringToStore(req.GetDatastoreName())
 	if err != nil {
-		b.l.Error("invalid datastore name [%s]: %s", req.GetDatastoreName(), err)
+		l.Error("invalid datastore name [%s]: %s", req.GetDatastoreName(), err)
 		return nil, errors.GRPCErr(err, "invalid backup request")
 	}
 
 	backup, err := resource.NewBackup(store, tnnt, req.ResourceNames, req.Description, time.Now(), req.Config)
 	if err != nil {
-		b.l.Error("error initializing backup: %s", err)
+		l.Error("error initializing backup: %s", err)
 		return nil, errors.GRPCErr(err, "invalid backup request")
 	}
 
 	result, err := b.service.Create(ctx, backup)
 	if err != nil {
-		b.l.Error("error creating backup: %s", err)
+		l.Error("error creating backup: %s", err)
 		return nil, errors.GRPCErr(err, "error during backup")
 	}
 
@@ -65,15 +68,17 @@ func (b BackupHandler) ListBackups(ctx context.Context, req *pb.ListBackupsReque
 		return nil, errors.GRPCErr(err, "invalid list backup request")
 	}
 
+	l := b.tenantLogger(tnnt)
+
 	store, err := resource.FromStringToStore(req.GetDatastoreName())
 	if err != nil {
-		b.l.Error("invalid datastore name [%s]: %s", req.GetDatastoreName(), err)
+		l.Error("invalid datastore name [%s]: %s", req.GetDatastoreName(), err)
 		return nil, errors.GRPCErr(err, "invalid list backup request")
 	}
 
 	results, err := b.service.List(ctx, tnnt, store)
 	if err != nil {
-		b.l.Error("error listing backups: %s", err)
+		l.Error("error listing backups: %s", err)
 		return nil, errors.GRPCErr(err, "error in getting list of backup")
 	}
 
@@ -125,6 +130,11 @@ func toIgnoredResources(ignored []resource.IgnoredResource) []*pb.IgnoredResourc
 	return ignoredResources
 }
 
+// tenantLogger attaches the tenant fields to the lines logged for the request
+func (b BackupHandler) tenantLogger(tnnt tenant.Tenant) log.Logger {
+	return logging.ForTenant(b.l, tnnt.ProjectName().String(), tnnt.NamespaceName().String(), "")
+}
+
 func NewBackupHandler(l log.Logger, service BackupService) *BackupHandler {
 	return &BackupHandler{
 		l:       l,
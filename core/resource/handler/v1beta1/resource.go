@@ -13,6 +13,7 @@ import (
 	"github.com/goto/optimus/core/resource"
 	"github.com/goto/optimus/core/tenant"
 	"github.com/goto/optimus/internal/errors"
+	"github.com/goto/optimus/internal/logging"
 	"github.com/goto/optimus/internal/telemetry"
 	"github.com/goto/optimus/internal/writer"
 	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
@@ -149,9 +150,11 @@ func (rh ResourceHandler) ListResourceSpecification(ctx context.Context, req *pb
 		return nil, errors.GRPCErr(err, "failed to list resource for "+req.GetDatastoreName())
 	}
 
+	l := rh.tenantLogger(tnnt)
+
 	resources, err := rh.service.GetAll(ctx, tnnt, store)
 	if err != nil {
-		rh.l.Error("error getting all resources: %s", err)
+		l.Error("error getting all resources: %s", err)
 		return nil, errors.GRPCErr(err, "failed to list resource for "+req.GetDatastoreName())
 	}
 
@@ -159,7 +162,7 @@ func (rh ResourceHandler) ListResourceSpecification(ctx context.Context, req *pb
 	for _, resourceSpec := range resources {
 		resourceProto, err := toResourceProto(resourceSpec)
 		if err != nil {
-			rh.l.Error("error adapting resource [%s]: %s", resourceSpec.FullName(), err)
+			l.Error("error adapting resource [%s]: %s", resourceSpec.FullName(), err)
 			return nil, errors.GRPCErr(err, "failed to parse resource "+resourceSpec.FullName())
 		}
 		resourceProtos = append(resourceProtos, resourceProto)
@@ -177,22 +180,24 @@ func (rh ResourceHandler) CreateResource(ctx context.Context, req *pb.CreateReso
 		return nil, errors.GRPCErr(err, "failed to create resource")
 	}
 
+	l := rh.tenantLogger(tnnt)
+
 	store, err := resource.FromStringToStore(req.GetDatastoreName())
 	if err != nil {
-		rh.l.Error("invalid datastore name [%s]: %s", req.GetDatastoreName(), err)
+		l.Error("invalid datastore name [%s]: %s", req.GetDatastoreName(), err)
 		return nil, errors.GRPCErr(err, "invalid create resource request")
 	}
 
 	res, err := fromResourceProto(req.Resource, tnnt, store)
 	if err != nil {
-		rh.l.Error("error adapting resource [%s]: %s", req.GetResource().GetName(), err)
+		l.Error("error adapting resource [%s]: %s", req.GetResource().GetName(), err)
 		return nil, errors.GRPCErr(err, "failed to create resource")
 	}
 
 	err = rh.service.Create(ctx, res)
 	raiseResourceDatastoreEventMetric(tnnt, res.Store().String(), res.Kind(), res.Status().String())
 	if err != nil {
-		rh.l.Error("error creating resource [%s]: %s", res.FullName(), err)
+		l.Error("error creating resource [%s]: %s", res.FullName(), err)
 		return nil, errors.GRPCErr(err, "failed to create resource "+res.FullName())
 	}
 
@@ -217,15 +222,17 @@ func (rh ResourceHandler) ReadResource(ctx context.Context, req *pb.ReadResource
 		return nil, errors.GRPCErr(err, "failed to read resource "+req.GetResourceName())
 	}
 
+	l := rh.tenantLogger(tnnt)
+
 	response, err := rh.service.Get(ctx, tnnt, store, req.GetResourceName())
 	if err != nil {
-		rh.l.Error("error getting resource [%s]: %s", req.GetResourceName(), err)
+		l.Error("error getting resource [%s]: %s", req.GetResourceName(), err)
 		return nil, errors.GRPCErr(err, "failed to read resource "+req.GetResourceName())
 	}
 
 	protoResource, err := toResourceProto(response)
 	if err != nil {
-		rh.l.Error("error adapting resource [%s]: %s", req.GetResourceName(), err)
+		l.Error("error adapting resource [%s]: %s", req.GetResourceName(), err)
 		return nil, errors.GRPCErr(err, "failed to read resource "+req.GetResourceName())
 	}
 
@@ -241,15 +248,17 @@ func (rh ResourceHandler) UpdateResource(ctx context.Context, req *pb.UpdateReso
 		return nil, errors.GRPCErr(err, "failed to update resource")
 	}
 
+	l := rh.tenantLogger(tnnt)
+
 	store, err := resource.FromStringToStore(req.GetDatastoreName())
 	if err != nil {
-		rh.l.Error("invalid datastore name [%s]: %s", req.GetDatastoreName(), err)
+		l.Error("invalid datastore name [%s]: %s", req.GetDatastoreName(), err)
 		return nil, errors.GRPCErr(err, "invalid update resource request")
 	}
 
 	res, err := fromResourceProto(req.Resource, tnnt, store)
 	if err != nil {
-		rh.l.Error("error adapting resource [%s]: %s", req.GetResource().GetName(), err)
+		l.Error("error adapting resource [%s]: %s", req.GetResource().GetName(), err)
 		return nil, errors.GRPCErr(err, "failed to update resource")
 	}
 
@@ -258,7 +267,7 @@ func (rh ResourceHandler) UpdateResource(ctx context.Context, req *pb.UpdateReso
 	err = rh.service.Update(ctx, res, logWriter)
 	raiseResourceDatastoreEventMetric(tnnt, res.Store().String(), res.Kind(), res.Status().String())
 	if err != nil {
-		rh.l.Error("error updating resource [%s]: %s", res.FullName(), err)
+		l.Error("error updating resource [%s]: %s", res.FullName(), err)
 		return nil, errors.GRPCErr(err, "failed to update resource "+res.FullName())
 	}
 
@@ -431,6 +440,11 @@ func raiseResourceDatastoreEventMetric(jobTenant tenant.Tenant, datastoreName, r
 	}).Inc()
 }
 
+// tenantLogger attaches the tenant fields to the lines logged for the request
+func (rh ResourceHandler) tenantLogger(tnnt tenant.Tenant) log.Logger {
+	return logging.ForTenant(rh.l, tnnt.ProjectName().String(), tnnt.NamespaceName().String(), "")
+}
+
 func NewResourceHandler(l log.Logger, resourceService ResourceService) *ResourceHandler {
 	return &ResourceHandler{
 		l:       l,
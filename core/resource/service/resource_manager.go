@@ -3,10 +3,12 @@ package service
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/goto/salt/log"
 
 	"github.com/goto/optimus/core/resource"
+	"github.com/goto/optimus/core/tenant"
 	"github.com/goto/optimus/internal/errors"
 )
 
@@ -19,14 +21,27 @@ type DataStore interface {
 	Backup(context.Context, *resource.Backup, []*resource.Resource) (*resource.BackupResult, error)
 }
 
+// SpecValidator is a validation plugin enforcing governance rules, like naming conventions, on the resources of a
+// store. It is only run for the tenants listing its name in their RESOURCE_VALIDATORS config.
+type SpecValidator interface {
+	Name() string
+	Validate(res *resource.Resource, tenantConfigs map[string]string) error
+}
+
 type ResourceStatusRepo interface {
 	UpdateStatus(ctx context.Context, res ...*resource.Resource) error
 }
 
+type TenantDetailsGetter interface {
+	GetDetails(ctx context.Context, tnnt tenant.Tenant) (*tenant.WithDetails, error)
+}
+
 type ResourceMgr struct {
 	datastoreMap map[resource.Store]DataStore
+	validatorMap map[resource.Store][]SpecValidator
 
-	repo ResourceStatusRepo
+	repo         ResourceStatusRepo
+	tenantGetter TenantDetailsGetter
 
 	logger log.Logger
 }
@@ -105,7 +120,7 @@ func (m *ResourceMgr) SyncResource(ctx context.Context, res *resource.Resource)
 	return nil
 }
 
-func (m *ResourceMgr) Validate(res *resource.Resource) error {
+func (m *ResourceMgr) Validate(ctx context.Context, res *resource.Resource) error {
 	store := res.Store()
 	datastore, ok := m.datastoreMap[store]
 	if !ok {
@@ -114,7 +129,45 @@ func (m *ResourceMgr) Validate(res *resource.Resource) error {
 		return errors.InternalError(resource.EntityResource, msg, nil)
 	}
 
-	return datastore.Validate(res)
+	if err := datastore.Validate(res); err != nil {
+		return err
+	}
+	return m.validateSpec(ctx, res)
+}
+
+// validateSpec runs the validators of the resource store which are enabled for the tenant of the resource
+func (m *ResourceMgr) validateSpec(ctx context.Context, res *resource.Resource) error {
+	validators := m.validatorMap[res.Store()]
+	if len(validators) == 0 {
+		return nil
+	}
+
+	tenantDetails, err := m.tenantGetter.GetDetails(ctx, res.Tenant())
+	if err != nil {
+		m.logger.Error("error getting tenant details for resource [%s]: %s", res.FullName(), err)
+		return err
+	}
+
+	enabledValidators := map[string]bool{}
+	enabledConfig, _ := tenantDetails.GetConfig(tenant.ProjectResourceValidators)
+	for _, name := range strings.Split(enabledConfig, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			enabledValidators[name] = true
+		}
+	}
+
+	tenantConfigs := tenantDetails.GetConfigs()
+	me := errors.NewMultiError("error validating resource spec")
+	for _, validator := range validators {
+		if !enabledValidators[validator.Name()] {
+			continue
+		}
+		if err := validator.Validate(res, tenantConfigs); err != nil {
+			m.logger.Error("resource [%s] violates validator [%s]: %s", res.FullName(), validator.Name(), err)
+			me.Append(err)
+		}
+	}
+	return me.ToErr()
 }
 
 func (m *ResourceMgr) GetURN(res *resource.Resource) (string, error) {
@@ -157,10 +210,16 @@ func (m *ResourceMgr) RegisterDatastore(store resource.Store, dataStore DataStor
 	m.datastoreMap[store] = dataStore
 }
 
-func NewResourceManager(repo ResourceStatusRepo, logger log.Logger) *ResourceMgr {
+func (m *ResourceMgr) RegisterValidator(store resource.Store, validator SpecValidator) {
+	m.validatorMap[store] = append(m.validatorMap[store], validator)
+}
+
+func NewResourceManager(repo ResourceStatusRepo, tenantGetter TenantDetailsGetter, logger log.Logger) *ResourceMgr {
 	return &ResourceMgr{
 		repo:         repo,
+		tenantGetter: tenantGetter,
 		datastoreMap: map[resource.Store]DataStore{},
+		validatorMap: map[resource.Store][]SpecValidator{},
 		logger:       logger,
 	}
 }
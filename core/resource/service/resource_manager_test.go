@@ -25,7 +25,7 @@ func TestResourceManager(t *testing.T) {
 		t.Run("return error when service not found for datastore", func(t *testing.T) {
 			repo := new(mockRepo)
 			logger := log.NewLogrus()
-			manager := service.NewResourceManager(repo, logger)
+			manager := service.NewResourceManager(repo, nil, logger)
 
 			spec := map[string]any{"description": "test spec"}
 			res, err := resource.NewResource("proj.ds.name1", "table", store, tnnt, meta, spec)
@@ -50,7 +50,7 @@ func TestResourceManager(t *testing.T) {
 			repo := new(mockRepo)
 			repo.On("UpdateStatus", ctx, argMatcher).Return(nil)
 			logger := log.NewLogrus()
-			manager := service.NewResourceManager(repo, logger)
+			manager := service.NewResourceManager(repo, nil, logger)
 
 			storeService := new(mockDataStore)
 			storeService.On("Create", ctx, createRequest).Return(errors.InternalError("resource", "error in create", nil))
@@ -78,7 +78,7 @@ func TestResourceManager(t *testing.T) {
 			repo.On("UpdateStatus", ctx, argMatcher).
 				Return(errors.NotFound("resource", "error in update"))
 			logger := log.NewLogrus()
-			manager := service.NewResourceManager(repo, logger)
+			manager := service.NewResourceManager(repo, nil, logger)
 
 			storeService := new(mockDataStore)
 			storeService.On("Create", ctx, createRequest).Return(errors.InvalidArgument("res", "error in create"))
@@ -106,7 +106,7 @@ func TestResourceManager(t *testing.T) {
 			repo := new(mockRepo)
 			repo.On("UpdateStatus", ctx, argMatcher).Return(nil)
 			logger := log.NewLogrus()
-			manager := service.NewResourceManager(repo, logger)
+			manager := service.NewResourceManager(repo, nil, logger)
 
 			storeService := new(mockDataStore)
 			storeService.On("Create", ctx, createRequest).Return(errors.AlreadyExists("resource", "error in create"))
@@ -132,7 +132,7 @@ func TestResourceManager(t *testing.T) {
 			repo := new(mockRepo)
 			repo.On("UpdateStatus", ctx, argMatcher).Return(nil)
 			logger := log.NewLogrus()
-			manager := service.NewResourceManager(repo, logger)
+			manager := service.NewResourceManager(repo, nil, logger)
 
 			storeService := new(mockDataStore)
 			storeService.On("Create", ctx, createRequest).Return(nil)
@@ -148,7 +148,7 @@ func TestResourceManager(t *testing.T) {
 		t.Run("return error when service not found for datastore", func(t *testing.T) {
 			repo := new(mockRepo)
 			logger := log.NewLogrus()
-			manager := service.NewResourceManager(repo, logger)
+			manager := service.NewResourceManager(repo, nil, logger)
 
 			spec := map[string]any{"description": "test spec"}
 			res, err := resource.NewResource("proj.ds.name1", "table", store, tnnt, meta, spec)
@@ -173,7 +173,7 @@ func TestResourceManager(t *testing.T) {
 			repo := new(mockRepo)
 			repo.On("UpdateStatus", ctx, argMatcher).Return(nil)
 			logger := log.NewLogrus()
-			manager := service.NewResourceManager(repo, logger)
+			manager := service.NewResourceManager(repo, nil, logger)
 
 			storeService := new(mockDataStore)
 			storeService.On("Update", ctx, updateRequest).Return(errors.InternalError("resource", "error in update", nil))
@@ -202,7 +202,7 @@ func TestResourceManager(t *testing.T) {
 				Return(errors.NotFound("resource", "error in update"))
 			defer repo.AssertExpectations(t)
 			logger := log.NewLogrus()
-			manager := service.NewResourceManager(repo, logger)
+			manager := service.NewResourceManager(repo, nil, logger)
 
 			storeService := new(mockDataStore)
 			storeService.On("Update", ctx, updateRequest).Return(errors.InvalidArgument("res", "error in update"))
@@ -230,7 +230,7 @@ func TestResourceManager(t *testing.T) {
 			repo := new(mockRepo)
 			repo.On("UpdateStatus", ctx, argMatcher).Return(nil)
 			logger := log.NewLogrus()
-			manager := service.NewResourceManager(repo, logger)
+			manager := service.NewResourceManager(repo, nil, logger)
 
 			storeService := new(mockDataStore)
 			storeService.On("Update", ctx, updateRequest).Return(nil)
@@ -251,9 +251,9 @@ func TestResourceManager(t *testing.T) {
 
 			repo := new(mockRepo)
 			logger := log.NewLogrus()
-			manager := service.NewResourceManager(repo, logger)
+			manager := service.NewResourceManager(repo, nil, logger)
 
-			err = manager.Validate(updateRequest)
+			err = manager.Validate(ctx, updateRequest)
 			assert.NotNil(t, err)
 			assert.ErrorContains(t, err, "datastore [snowflake] for resource [proj.ds.name1] is not found")
 		})
@@ -264,7 +264,7 @@ func TestResourceManager(t *testing.T) {
 			updateRequest := resource.FromExisting(res, resource.ReplaceStatus(resource.StatusToUpdate))
 
 			logger := log.NewLogrus()
-			manager := service.NewResourceManager(nil, logger)
+			manager := service.NewResourceManager(nil, nil, logger)
 
 			storeService := new(mockDataStore)
 			storeService.On("Validate", updateRequest).Return(nil)
@@ -272,9 +272,69 @@ func TestResourceManager(t *testing.T) {
 
 			manager.RegisterDatastore(store, storeService)
 
-			err = manager.Validate(updateRequest)
+			err = manager.Validate(ctx, updateRequest)
 			assert.NoError(t, err)
 		})
+		t.Run("runs the validators enabled for the tenant", func(t *testing.T) {
+			spec := map[string]any{"description": "test spec"}
+			res, err := resource.NewResource("proj.ds.name1", "table", store, tnnt, meta, spec)
+			assert.Nil(t, err)
+
+			project, _ := tenant.NewProject("proj", map[string]string{
+				"STORAGE_PATH":        "somePath",
+				"SCHEDULER_HOST":      "localhost",
+				"RESOURCE_VALIDATORS": "naming, partition",
+			})
+			namespace, _ := tenant.NewNamespace("ns", project.Name(), map[string]string{})
+			tenantDetails, _ := tenant.NewTenantDetails(project, namespace, nil)
+
+			tenantGetter := new(mockTenantDetailsGetter)
+			tenantGetter.On("GetDetails", ctx, tnnt).Return(tenantDetails, nil)
+			defer tenantGetter.AssertExpectations(t)
+
+			storeService := new(mockDataStore)
+			storeService.On("Validate", res).Return(nil)
+			defer storeService.AssertExpectations(t)
+
+			namingValidator := &mockSpecValidator{name: "naming"}
+			namingValidator.On("Validate", res, tenantDetails.GetConfigs()).Return(errors.InvalidArgument(resource.EntityResource, "invalid name"))
+			defer namingValidator.AssertExpectations(t)
+			partitionValidator := &mockSpecValidator{name: "partition"}
+			partitionValidator.On("Validate", res, tenantDetails.GetConfigs()).Return(errors.InvalidArgument(resource.EntityResource, "partition is required"))
+			defer partitionValidator.AssertExpectations(t)
+			disabledValidator := &mockSpecValidator{name: "disabled"}
+			defer disabledValidator.AssertExpectations(t)
+
+			manager := service.NewResourceManager(nil, tenantGetter, log.NewNoop())
+			manager.RegisterDatastore(store, storeService)
+			manager.RegisterValidator(store, namingValidator)
+			manager.RegisterValidator(store, partitionValidator)
+			manager.RegisterValidator(store, disabledValidator)
+
+			err = manager.Validate(ctx, res)
+			assert.ErrorContains(t, err, "invalid name")
+			assert.ErrorContains(t, err, "partition is required")
+		})
+		t.Run("returns error when unable to get tenant details for validators", func(t *testing.T) {
+			spec := map[string]any{"description": "test spec"}
+			res, err := resource.NewResource("proj.ds.name1", "table", store, tnnt, meta, spec)
+			assert.Nil(t, err)
+
+			tenantGetter := new(mockTenantDetailsGetter)
+			tenantGetter.On("GetDetails", ctx, tnnt).Return(nil, errors.NotFound(tenant.EntityProject, "project not found"))
+			defer tenantGetter.AssertExpectations(t)
+
+			storeService := new(mockDataStore)
+			storeService.On("Validate", res).Return(nil)
+			defer storeService.AssertExpectations(t)
+
+			manager := service.NewResourceManager(nil, tenantGetter, log.NewNoop())
+			manager.RegisterDatastore(store, storeService)
+			manager.RegisterValidator(store, &mockSpecValidator{name: "naming"})
+
+			err = manager.Validate(ctx, res)
+			assert.ErrorContains(t, err, "project not found")
+		})
 	})
 	t.Run("URN", func(t *testing.T) {
 		t.Run("return error when service not found for datastore", func(t *testing.T) {
@@ -285,7 +345,7 @@ func TestResourceManager(t *testing.T) {
 
 			repo := new(mockRepo)
 			logger := log.NewLogrus()
-			manager := service.NewResourceManager(repo, logger)
+			manager := service.NewResourceManager(repo, nil, logger)
 
 			_, err = manager.GetURN(updateRequest)
 			assert.NotNil(t, err)
@@ -298,7 +358,7 @@ func TestResourceManager(t *testing.T) {
 			updateRequest := resource.FromExisting(res, resource.ReplaceStatus(resource.StatusToUpdate))
 
 			logger := log.NewLogrus()
-			manager := service.NewResourceManager(nil, logger)
+			manager := service.NewResourceManager(nil, nil, logger)
 
 			storeService := new(mockDataStore)
 			storeService.On("GetURN", updateRequest).Return("snowflake://db.schema.table", nil)
@@ -320,7 +380,7 @@ func TestResourceManager(t *testing.T) {
 
 			repo := new(mockRepo)
 			logger := log.NewLogrus()
-			manager := service.NewResourceManager(repo, logger)
+			manager := service.NewResourceManager(repo, nil, logger)
 
 			err = manager.BatchUpdate(ctx, store, []*resource.Resource{updateRequest})
 			assert.NotNil(t, err)
@@ -347,7 +407,7 @@ func TestResourceManager(t *testing.T) {
 			defer repo.AssertExpectations(t)
 
 			logger := log.NewLogrus()
-			manager := service.NewResourceManager(repo, logger)
+			manager := service.NewResourceManager(repo, nil, logger)
 
 			matcher := mock.MatchedBy(func(res []*resource.Resource) bool {
 				if res[0].Name() == updateRequest.Name() {
@@ -385,7 +445,7 @@ func TestResourceManager(t *testing.T) {
 			repo := new(mockRepo)
 			repo.On("UpdateStatus", mock.Anything, argMatcher).Return(nil)
 			logger := log.NewLogrus()
-			manager := service.NewResourceManager(repo, logger)
+			manager := service.NewResourceManager(repo, nil, logger)
 
 			matcher := mock.MatchedBy(func(res []*resource.Resource) bool {
 				if res[0].Name() == updateRequest.Name() {
@@ -408,7 +468,7 @@ func TestResourceManager(t *testing.T) {
 		t.Run("return error when service not found for datastore", func(t *testing.T) {
 			repo := new(mockRepo)
 			logger := log.NewLogrus()
-			manager := service.NewResourceManager(repo, logger)
+			manager := service.NewResourceManager(repo, nil, logger)
 
 			spec := map[string]any{"description": "test spec"}
 			res, err := resource.NewResource("proj.ds.name1", "table", store, tnnt, meta, spec)
@@ -433,7 +493,7 @@ func TestResourceManager(t *testing.T) {
 			assert.NoError(t, err)
 
 			logger := log.NewLogrus()
-			manager := service.NewResourceManager(nil, logger)
+			manager := service.NewResourceManager(nil, nil, logger)
 
 			storeService := new(mockDataStore)
 			storeService.On("Backup", ctx, backup, []*resource.Resource{res}).Return(&resource.BackupResult{
@@ -452,7 +512,7 @@ func TestResourceManager(t *testing.T) {
 		t.Run("returns error when store name is invalid", func(t *testing.T) {
 			repo := new(mockRepo)
 			logger := log.NewLogrus()
-			manager := service.NewResourceManager(repo, logger)
+			manager := service.NewResourceManager(repo, nil, logger)
 
 			spec := map[string]any{"description": "test spec"}
 			res, err := resource.NewResource("proj.ds.name1", "table", store, tnnt, meta, spec)
@@ -476,7 +536,7 @@ func TestResourceManager(t *testing.T) {
 			repo := new(mockRepo)
 			repo.On("UpdateStatus", ctx, argMatcher).Return(nil)
 			logger := log.NewLogrus()
-			manager := service.NewResourceManager(repo, logger)
+			manager := service.NewResourceManager(repo, nil, logger)
 
 			storeService := new(mockDataStore)
 			storeService.On("Create", ctx, res).Return(errors.InternalError("resource", "error in create", nil))
@@ -503,7 +563,7 @@ func TestResourceManager(t *testing.T) {
 			repo := new(mockRepo)
 			repo.On("UpdateStatus", ctx, argMatcher).Return(nil)
 			logger := log.NewLogrus()
-			manager := service.NewResourceManager(repo, logger)
+			manager := service.NewResourceManager(repo, nil, logger)
 
 			storeService := new(mockDataStore)
 			storeService.On("Create", ctx, res).Return(errors.AlreadyExists(resource.EntityResource, "table already exists"))
@@ -531,7 +591,7 @@ func TestResourceManager(t *testing.T) {
 			repo := new(mockRepo)
 			repo.On("UpdateStatus", ctx, argMatcher).Return(errors.InternalError(resource.EntityResource, "error", nil))
 			logger := log.NewLogrus()
-			manager := service.NewResourceManager(repo, logger)
+			manager := service.NewResourceManager(repo, nil, logger)
 
 			storeService := new(mockDataStore)
 			storeService.On("Create", ctx, res).Return(nil)
@@ -558,7 +618,7 @@ func TestResourceManager(t *testing.T) {
 			repo := new(mockRepo)
 			repo.On("UpdateStatus", ctx, argMatcher).Return(nil)
 			logger := log.NewLogrus()
-			manager := service.NewResourceManager(repo, logger)
+			manager := service.NewResourceManager(repo, nil, logger)
 
 			storeService := new(mockDataStore)
 			storeService.On("Create", ctx, res).Return(errors.AlreadyExists(resource.EntityResource, "table already exists"))
@@ -614,3 +674,28 @@ func (m *mockDataStore) Backup(ctx context.Context, backup *resource.Backup, res
 	}
 	return args.Get(0).(*resource.BackupResult), args.Error(1)
 }
+
+type mockTenantDetailsGetter struct {
+	mock.Mock
+}
+
+func (m *mockTenantDetailsGetter) GetDetails(ctx context.Context, tnnt tenant.Tenant) (*tenant.WithDetails, error) {
+	args := m.Called(ctx, tnnt)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*tenant.WithDetails), args.Error(1)
+}
+
+type mockSpecValidator struct {
+	mock.Mock
+	name string
+}
+
+func (m *mockSpecValidator) Name() string {
+	return m.name
+}
+
+func (m *mockSpecValidator) Validate(res *resource.Resource, tenantConfigs map[string]string) error {
+	return m.Called(res, tenantConfigs).Error(0)
+}
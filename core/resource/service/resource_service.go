@@ -14,6 +14,7 @@ import (
 	"github.com/goto/optimus/core/resource"
 	"github.com/goto/optimus/core/tenant"
 	"github.com/goto/optimus/internal/errors"
+	"github.com/goto/optimus/internal/logging"
 	"github.com/goto/optimus/internal/writer"
 )
 
@@ -31,7 +32,7 @@ type ResourceManager interface {
 	UpdateResource(ctx context.Context, res *resource.Resource) error
 	SyncResource(ctx context.Context, res *resource.Resource) error
 	BatchUpdate(ctx context.Context, store resource.Store, resources []*resource.Resource) error
-	Validate(res *resource.Resource) error
+	Validate(ctx context.Context, res *resource.Resource) error
 	GetURN(res *resource.Resource) (string, error)
 }
 
@@ -67,32 +68,33 @@ func NewResourceService(
 }
 
 func (rs ResourceService) Create(ctx context.Context, incoming *resource.Resource) error { // nolint:gocritic
-	if err := rs.mgr.Validate(incoming); err != nil {
-		rs.logger.Error("error validating resource [%s]: %s", incoming.FullName(), err)
+	l := logging.ForTenant(rs.logger, incoming.Tenant().ProjectName().String(), incoming.Tenant().NamespaceName().String(), "")
+	if err := rs.mgr.Validate(ctx, incoming); err != nil {
+		l.Error("error validating resource [%s]: %s", incoming.FullName(), err)
 		return err
 	}
 
 	incoming.MarkValidationSuccess()
 	urn, err := rs.mgr.GetURN(incoming)
 	if err != nil {
-		rs.logger.Error("error validating resource [%s]: %s", incoming.FullName(), err)
+		l.Error("error validating resource [%s]: %s", incoming.FullName(), err)
 		return err
 	}
 	err = incoming.UpdateURN(urn)
 	if err != nil {
-		rs.logger.Error("error updating urn of resource [%s]: %s", incoming.FullName(), err)
+		l.Error("error updating urn of resource [%s]: %s", incoming.FullName(), err)
 		return err
 	}
 
 	if existing, err := rs.repo.ReadByFullName(ctx, incoming.Tenant(), incoming.Store(), incoming.FullName()); err != nil {
 		if !errors.IsErrorType(err, errors.ErrNotFound) {
-			rs.logger.Error("error getting resource [%s]: %s", incoming.FullName(), err)
+			l.Error("error getting resource [%s]: %s", incoming.FullName(), err)
 			return err
 		}
 		incoming.MarkToCreate()
 
 		if err := rs.repo.Create(ctx, incoming); err != nil {
-			rs.logger.Error("error creating resource [%s] to db: %s", incoming.FullName(), err)
+			l.Error("error creating resource [%s] to db: %s", incoming.FullName(), err)
 			return err
 		}
 	} else {
@@ -101,19 +103,19 @@ func (rs ResourceService) Create(ctx context.Context, incoming *resource.Resourc
 		}
 		if !resource.StatusForToCreate(existing.Status()) {
 			msg := fmt.Sprintf("cannot create resource [%s] since it already exists with status [%s]", incoming.FullName(), existing.Status())
-			rs.logger.Error(msg)
+			l.Error(msg)
 			return errors.InvalidArgument(resource.EntityResource, msg)
 		}
 		incoming.MarkToCreate()
 
 		if err := rs.repo.Update(ctx, incoming); err != nil {
-			rs.logger.Error("error updating resource [%s] to db: %s", incoming.FullName(), err)
+			l.Error("error updating resource [%s] to db: %s", incoming.FullName(), err)
 			return err
 		}
 	}
 
 	if err := rs.mgr.CreateResource(ctx, incoming); err != nil {
-		rs.logger.Error("error creating resource [%s] to manager: %s", incoming.FullName(), err)
+		l.Error("error creating resource [%s] to manager: %s", incoming.FullName(), err)
 		return err
 	}
 
@@ -122,43 +124,44 @@ func (rs ResourceService) Create(ctx context.Context, incoming *resource.Resourc
 }
 
 func (rs ResourceService) Update(ctx context.Context, incoming *resource.Resource, logWriter writer.LogWriter) error { // nolint:gocritic
-	if err := rs.mgr.Validate(incoming); err != nil {
-		rs.logger.Error("error validating resource [%s]: %s", incoming.FullName(), err)
+	l := logging.ForTenant(rs.logger, incoming.Tenant().ProjectName().String(), incoming.Tenant().NamespaceName().String(), "")
+	if err := rs.mgr.Validate(ctx, incoming); err != nil {
+		l.Error("error validating resource [%s]: %s", incoming.FullName(), err)
 		return err
 	}
 
 	incoming.MarkValidationSuccess()
 	urn, err := rs.mgr.GetURN(incoming)
 	if err != nil {
-		rs.logger.Error("error validating resource [%s]: %s", incoming.FullName(), err)
+		l.Error("error validating resource [%s]: %s", incoming.FullName(), err)
 		return err
 	}
 	err = incoming.UpdateURN(urn)
 	if err != nil {
-		rs.logger.Error("error updating urn of resource [%s]: %s", incoming.FullName(), err)
+		l.Error("error updating urn of resource [%s]: %s", incoming.FullName(), err)
 		return err
 	}
 
 	existing, err := rs.repo.ReadByFullName(ctx, incoming.Tenant(), incoming.Store(), incoming.FullName())
 	if err != nil {
-		rs.logger.Error("error getting stored resource [%s]: %s", incoming.FullName(), err)
+		l.Error("error getting stored resource [%s]: %s", incoming.FullName(), err)
 		return err
 	}
 
 	if !(resource.StatusForToUpdate(existing.Status())) {
 		msg := fmt.Sprintf("cannot update resource [%s] with existing status [%s]", incoming.FullName(), existing.Status())
-		rs.logger.Error(msg)
+		l.Error(msg)
 		return errors.InvalidArgument(resource.EntityResource, msg)
 	}
 	incoming.MarkToUpdate()
 
 	if err := rs.repo.Update(ctx, incoming); err != nil {
-		rs.logger.Error("error updating stored resource [%s]: %s", incoming.FullName(), err)
+		l.Error("error updating stored resource [%s]: %s", incoming.FullName(), err)
 		return err
 	}
 
 	if err := rs.mgr.UpdateResource(ctx, incoming); err != nil {
-		rs.logger.Error("error updating resource [%s] to manager: %s", incoming.FullName(), err)
+		l.Error("error updating resource [%s] to manager: %s", incoming.FullName(), err)
 		return err
 	}
 
@@ -170,20 +173,21 @@ func (rs ResourceService) Update(ctx context.Context, incoming *resource.Resourc
 		logWriter,
 	)
 	if err != nil {
-		rs.logger.Error("error refreshing downstream for resource [%s]: %s", incoming.FullName(), err)
+		l.Error("error refreshing downstream for resource [%s]: %s", incoming.FullName(), err)
 		return err
 	}
 	return nil
 }
 
 func (rs ResourceService) ChangeNamespace(ctx context.Context, datastore resource.Store, resourceFullName string, oldTenant, newTenant tenant.Tenant) error { // nolint:gocritic
+	l := logging.ForTenant(rs.logger, oldTenant.ProjectName().String(), oldTenant.NamespaceName().String(), "")
 	resourceSpec, err := rs.Get(ctx, oldTenant, datastore, resourceFullName)
 	if err != nil {
-		rs.logger.Error("failed to read existing resource [%s]: %s", resourceFullName, err)
+		l.Error("failed to read existing resource [%s]: %s", resourceFullName, err)
 		return err
 	}
 	if err := rs.repo.ChangeNamespace(ctx, resourceSpec, newTenant); err != nil {
-		rs.logger.Error("error changing namespace of stored resource [%s]: %s", resourceSpec.FullName(), err)
+		l.Error("error changing namespace of stored resource [%s]: %s", resourceSpec.FullName(), err)
 		return err
 	}
 	resourceSpec.UpdateTenant(newTenant)
@@ -192,8 +196,9 @@ func (rs ResourceService) ChangeNamespace(ctx context.Context, datastore resourc
 }
 
 func (rs ResourceService) Get(ctx context.Context, tnnt tenant.Tenant, store resource.Store, resourceFullName string) (*resource.Resource, error) { // nolint:gocritic
+	l := logging.ForTenant(rs.logger, tnnt.ProjectName().String(), tnnt.NamespaceName().String(), "")
 	if resourceFullName == "" {
-		rs.logger.Error("resource full name is empty")
+		l.Error("resource full name is empty")
 		return nil, errors.InvalidArgument(resource.EntityResource, "empty resource full name")
 	}
 	return rs.repo.ReadByFullName(ctx, tnnt, store, resourceFullName)
@@ -204,9 +209,10 @@ func (rs ResourceService) GetAll(ctx context.Context, tnnt tenant.Tenant, store
 }
 
 func (rs ResourceService) SyncResources(ctx context.Context, tnnt tenant.Tenant, store resource.Store, names []string) (*resource.SyncResponse, error) { // nolint:gocritic
+	l := logging.ForTenant(rs.logger, tnnt.ProjectName().String(), tnnt.NamespaceName().String(), "")
 	resources, err := rs.repo.GetResources(ctx, tnnt, store, names)
 	if err != nil {
-		rs.logger.Error("error getting resources [%s] from db: %s", strings.Join(names, ", "), err)
+		l.Error("error getting resources [%s] from db: %s", strings.Join(names, ", "), err)
 		return nil, err
 	}
 
@@ -234,13 +240,14 @@ func (rs ResourceService) SyncResources(ctx context.Context, tnnt tenant.Tenant,
 }
 
 func (rs ResourceService) Deploy(ctx context.Context, tnnt tenant.Tenant, store resource.Store, incomings []*resource.Resource, logWriter writer.LogWriter) error { // nolint:gocritic
+	l := logging.ForTenant(rs.logger, tnnt.ProjectName().String(), tnnt.NamespaceName().String(), "")
 	multiError := errors.NewMultiError("error batch updating resources")
 	for _, r := range incomings {
-		if err := rs.mgr.Validate(r); err != nil {
+		if err := rs.mgr.Validate(ctx, r); err != nil {
 			msg := fmt.Sprintf("error validating [%s]: %s", r.FullName(), err)
 			multiError.Append(errors.Wrap(resource.EntityResource, msg, err))
 
-			rs.logger.Error(msg)
+			l.Error(msg)
 			r.MarkValidationFailure()
 			continue
 		}
@@ -248,13 +255,13 @@ func (rs ResourceService) Deploy(ctx context.Context, tnnt tenant.Tenant, store
 		urn, err := rs.mgr.GetURN(r)
 		if err != nil {
 			multiError.Append(err)
-			rs.logger.Error("error getting resource urn [%s]: %s", r.FullName(), err)
+			l.Error("error getting resource urn [%s]: %s", r.FullName(), err)
 			continue
 		}
 		err = r.UpdateURN(urn)
 		if err != nil {
 			multiError.Append(err)
-			rs.logger.Error("error updating urn of resource [%s]: %s", r.FullName(), err)
+			l.Error("error updating urn of resource [%s]: %s", r.FullName(), err)
 			continue
 		}
 		r.MarkValidationSuccess()
@@ -262,7 +269,7 @@ func (rs ResourceService) Deploy(ctx context.Context, tnnt tenant.Tenant, store
 
 	existingResources, err := rs.repo.ReadAll(ctx, tnnt, store)
 	if err != nil {
-		rs.logger.Error("error reading all existing resources: %s", err)
+		l.Error("error reading all existing resources: %s", err)
 		multiError.Append(err)
 		return multiError.ToErr()
 	}
@@ -272,7 +279,7 @@ func (rs ResourceService) Deploy(ctx context.Context, tnnt tenant.Tenant, store
 	multiError.Append(err)
 
 	if len(toUpdateOnStore) == 0 {
-		rs.logger.Warn("no resources to be batch updated")
+		l.Warn("no resources to be batch updated")
 		return multiError.ToErr()
 	}
 
@@ -345,26 +352,28 @@ func (rs ResourceService) getResourcesToBatchUpdate(ctx context.Context, incomin
 }
 
 func (rs ResourceService) raiseCreateEvent(res *resource.Resource) { // nolint:gocritic
+	l := logging.ForTenant(rs.logger, res.Tenant().ProjectName().String(), res.Tenant().NamespaceName().String(), "")
 	if res.Status() != resource.StatusSuccess {
 		return
 	}
 
 	ev, err := event.NewResourceCreatedEvent(res)
 	if err != nil {
-		rs.logger.Error("error creating event for resource create: %s", err)
+		l.Error("error creating event for resource create: %s", err)
 		return
 	}
 	rs.eventHandler.HandleEvent(ev)
 }
 
 func (rs ResourceService) raiseUpdateEvent(res *resource.Resource) { // nolint:gocritic
+	l := logging.ForTenant(rs.logger, res.Tenant().ProjectName().String(), res.Tenant().NamespaceName().String(), "")
 	if res.Status() != resource.StatusSuccess {
 		return
 	}
 
 	ev, err := event.NewResourceUpdatedEvent(res)
 	if err != nil {
-		rs.logger.Error("error creating event for resource update: %s", err)
+		l.Error("error creating event for resource update: %s", err)
 		return
 	}
 	rs.eventHandler.HandleEvent(ev)
@@ -0,0 +1,82 @@
+package scheduler
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+)
+
+const EntityAlertSilence = "alert_silence"
+
+// AlertSilence mutes the alerts of the jobs it selects until it expires, so a planned maintenance does not
+// page the on-call engineers. A job is selected when it matches the namespace, job name and all the labels set.
+type AlertSilence struct {
+	ID uuid.UUID
+
+	ProjectName   tenant.ProjectName
+	NamespaceName tenant.NamespaceName
+	JobName       JobName
+	Labels        map[string]string
+
+	Reason    string
+	CreatedBy string
+
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+func NewAlertSilence(projectName tenant.ProjectName, namespaceName tenant.NamespaceName, jobName JobName, labels map[string]string,
+	reason, createdBy string, expiresAt, now time.Time,
+) (*AlertSilence, error) {
+	if projectName == "" {
+		return nil, errors.InvalidArgument(EntityAlertSilence, "project name is empty")
+	}
+	if namespaceName == "" && jobName == "" && len(labels) == 0 {
+		return nil, errors.InvalidArgument(EntityAlertSilence, "silence should select a namespace, a job or labels")
+	}
+	if reason == "" {
+		return nil, errors.InvalidArgument(EntityAlertSilence, "reason is empty")
+	}
+	if !expiresAt.After(now) {
+		return nil, errors.InvalidArgument(EntityAlertSilence, "silence should expire in the future")
+	}
+
+	return &AlertSilence{
+		ProjectName:   projectName,
+		NamespaceName: namespaceName,
+		JobName:       jobName,
+		Labels:        labels,
+		Reason:        reason,
+		CreatedBy:     createdBy,
+		ExpiresAt:     expiresAt,
+	}, nil
+}
+
+func (s *AlertSilence) IsActive(now time.Time) bool {
+	return now.Before(s.ExpiresAt)
+}
+
+// Selects returns true when the silence mutes the alerts of the job
+func (s *AlertSilence) Selects(job *JobWithDetails) bool {
+	if job.Job.Tenant.ProjectName() != s.ProjectName {
+		return false
+	}
+	if s.NamespaceName != "" && job.Job.Tenant.NamespaceName() != s.NamespaceName {
+		return false
+	}
+	if s.JobName != "" && job.Name != s.JobName {
+		return false
+	}
+	for key, value := range s.Labels {
+		if job.JobMetadata == nil {
+			return false
+		}
+		if jobValue, ok := job.JobMetadata.Labels[key]; !ok || jobValue != value {
+			return false
+		}
+	}
+	return true
+}
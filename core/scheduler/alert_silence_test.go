@@ -0,0 +1,71 @@
+package scheduler_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/tenant"
+)
+
+func TestAlertSilence(t *testing.T) {
+	now := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+	tnnt, _ := tenant.NewTenant("proj", "ns1")
+	job := &scheduler.JobWithDetails{
+		Name: "job-a",
+		Job:  &scheduler.Job{Name: "job-a", Tenant: tnnt},
+		JobMetadata: &scheduler.JobMetadata{
+			Labels: map[string]string{"team": "data", "tier": "1"},
+		},
+	}
+
+	t.Run("NewAlertSilence", func(t *testing.T) {
+		t.Run("should return error if silence selects nothing", func(t *testing.T) {
+			silence, err := scheduler.NewAlertSilence("proj", "", "", nil, "maintenance", "user", now.Add(time.Hour), now)
+			assert.ErrorContains(t, err, "silence should select a namespace, a job or labels")
+			assert.Nil(t, silence)
+		})
+		t.Run("should return error if reason is empty", func(t *testing.T) {
+			silence, err := scheduler.NewAlertSilence("proj", "ns1", "", nil, "", "user", now.Add(time.Hour), now)
+			assert.ErrorContains(t, err, "reason is empty")
+			assert.Nil(t, silence)
+		})
+		t.Run("should return error if silence expires in the past", func(t *testing.T) {
+			silence, err := scheduler.NewAlertSilence("proj", "ns1", "", nil, "maintenance", "user", now, now)
+			assert.ErrorContains(t, err, "silence should expire in the future")
+			assert.Nil(t, silence)
+		})
+	})
+	t.Run("IsActive", func(t *testing.T) {
+		silence, err := scheduler.NewAlertSilence("proj", "ns1", "", nil, "maintenance", "user", now.Add(time.Hour), now)
+		assert.NoError(t, err)
+
+		assert.True(t, silence.IsActive(now))
+		assert.False(t, silence.IsActive(now.Add(time.Hour)))
+	})
+	t.Run("Selects", func(t *testing.T) {
+		t.Run("should select job of the namespace", func(t *testing.T) {
+			silence, _ := scheduler.NewAlertSilence("proj", "ns1", "", nil, "maintenance", "user", now.Add(time.Hour), now)
+			assert.True(t, silence.Selects(job))
+
+			silence, _ = scheduler.NewAlertSilence("proj", "ns2", "", nil, "maintenance", "user", now.Add(time.Hour), now)
+			assert.False(t, silence.Selects(job))
+		})
+		t.Run("should select job by name", func(t *testing.T) {
+			silence, _ := scheduler.NewAlertSilence("proj", "", "job-a", nil, "maintenance", "user", now.Add(time.Hour), now)
+			assert.True(t, silence.Selects(job))
+
+			silence, _ = scheduler.NewAlertSilence("other-proj", "", "job-a", nil, "maintenance", "user", now.Add(time.Hour), now)
+			assert.False(t, silence.Selects(job))
+		})
+		t.Run("should select job only if it has all the labels", func(t *testing.T) {
+			silence, _ := scheduler.NewAlertSilence("proj", "", "", map[string]string{"team": "data"}, "maintenance", "user", now.Add(time.Hour), now)
+			assert.True(t, silence.Selects(job))
+
+			silence, _ = scheduler.NewAlertSilence("proj", "", "", map[string]string{"team": "data", "tier": "2"}, "maintenance", "user", now.Add(time.Hour), now)
+			assert.False(t, silence.Selects(job))
+		})
+	})
+}
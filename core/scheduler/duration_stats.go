@@ -0,0 +1,78 @@
+package scheduler
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/goto/optimus/core/tenant"
+)
+
+// DurationStats keeps the durations of the latest successful runs of a job, the oldest first, to tell how long
+// a run of the job usually takes
+type DurationStats struct {
+	Tenant    tenant.Tenant
+	JobName   JobName
+	Durations []time.Duration
+}
+
+// Add records the duration of a run, dropping the oldest durations beyond the window size
+func (s *DurationStats) Add(duration time.Duration, windowSize int) {
+	s.Durations = append(s.Durations, duration)
+	if windowSize > 0 && len(s.Durations) > windowSize {
+		s.Durations = s.Durations[len(s.Durations)-windowSize:]
+	}
+}
+
+func (s *DurationStats) Mean() time.Duration {
+	if len(s.Durations) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, duration := range s.Durations {
+		sum += float64(duration)
+	}
+	return time.Duration(sum / float64(len(s.Durations)))
+}
+
+func (s *DurationStats) StdDev() time.Duration {
+	if len(s.Durations) == 0 {
+		return 0
+	}
+	mean := float64(s.Mean())
+	var squares float64
+	for _, duration := range s.Durations {
+		deviation := float64(duration) - mean
+		squares += deviation * deviation
+	}
+	return time.Duration(math.Sqrt(squares / float64(len(s.Durations))))
+}
+
+// ZScore returns how many standard deviations the duration is away from the mean, zero when the durations
+// recorded do not deviate at all
+func (s *DurationStats) ZScore(duration time.Duration) float64 {
+	stdDev := s.StdDev()
+	if stdDev == 0 {
+		return 0
+	}
+	return float64(duration-s.Mean()) / float64(stdDev)
+}
+
+// Percentile returns the duration below which the given percent of the durations fall, using the nearest rank
+func (s *DurationStats) Percentile(percent float64) time.Duration {
+	if len(s.Durations) == 0 {
+		return 0
+	}
+	sorted := make([]time.Duration, len(s.Durations))
+	copy(sorted, s.Durations)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	rank := int(math.Ceil(percent / 100 * float64(len(sorted)))) //nolint:gomnd
+	if rank < 1 {
+		rank = 1
+	}
+	if rank > len(sorted) {
+		rank = len(sorted)
+	}
+	return sorted[rank-1]
+}
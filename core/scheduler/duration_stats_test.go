@@ -0,0 +1,42 @@
+package scheduler_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/core/scheduler"
+)
+
+func TestDurationStats(t *testing.T) {
+	t.Run("Add", func(t *testing.T) {
+		t.Run("keeps only the latest durations within the window", func(t *testing.T) {
+			stats := &scheduler.DurationStats{}
+			for i := 1; i <= 4; i++ {
+				stats.Add(time.Duration(i)*time.Minute, 3)
+			}
+			assert.Equal(t, []time.Duration{2 * time.Minute, 3 * time.Minute, 4 * time.Minute}, stats.Durations)
+		})
+	})
+	t.Run("returns the mean, deviation and z score of the durations", func(t *testing.T) {
+		stats := &scheduler.DurationStats{
+			Durations: []time.Duration{2 * time.Minute, 4 * time.Minute, 4 * time.Minute, 4 * time.Minute, 5 * time.Minute, 5 * time.Minute, 7 * time.Minute, 9 * time.Minute},
+		}
+		assert.Equal(t, 5*time.Minute, stats.Mean())
+		assert.Equal(t, 2*time.Minute, stats.StdDev())
+		assert.InDelta(t, 2.5, stats.ZScore(10*time.Minute), 0.0001)
+	})
+	t.Run("returns zero z score when the durations do not deviate", func(t *testing.T) {
+		stats := &scheduler.DurationStats{Durations: []time.Duration{time.Minute, time.Minute}}
+		assert.Zero(t, stats.ZScore(time.Hour))
+	})
+	t.Run("returns the nearest rank percentile of the durations", func(t *testing.T) {
+		stats := &scheduler.DurationStats{
+			Durations: []time.Duration{5 * time.Minute, time.Minute, 4 * time.Minute, 2 * time.Minute, 3 * time.Minute},
+		}
+		assert.Equal(t, 4*time.Minute, stats.Percentile(80))
+		assert.Equal(t, 5*time.Minute, stats.Percentile(95))
+		assert.Equal(t, time.Minute, stats.Percentile(0))
+	})
+}
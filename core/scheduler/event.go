@@ -44,6 +44,19 @@ const (
 	SensorRetryEvent   JobEventType = "sensor_retry"
 	SensorFailEvent    JobEventType = "sensor_fail"
 	SensorSuccessEvent JobEventType = "sensor_success"
+
+	UpstreamAccessRequestEvent JobEventType = "upstream_access_request"
+
+	// SLAProjectedBreachEvent is raised by optimus ahead of the deadline, when the run is not expected to finish within its sla
+	SLAProjectedBreachEvent JobEventType = "sla_projected_breach"
+	// SLABreachEvent is raised by optimus once the deadline has passed, when the run has not finished successfully by then
+	SLABreachEvent JobEventType = "sla_breach"
+)
+
+const (
+	// HookTypeKey is the event value carrying the type of the hook, set to fail for the events of the failure hooks
+	HookTypeKey  = "hook_type"
+	HookTypeFail = "fail"
 )
 
 func FromStringToEventType(name string) (JobEventType, error) {
@@ -99,6 +112,7 @@ type Event struct {
 	Type           JobEventType
 	EventTime      time.Time
 	OperatorName   string
+	Attempt        int
 	Status         State
 	JobScheduledAt time.Time
 	Values         map[string]any
@@ -112,13 +126,23 @@ func (event JobEventType) IsOfType(category JobEventCategory) bool {
 			return true
 		}
 	case EventCategorySLAMiss:
-		if event == SLAMissEvent {
+		if event == SLAMissEvent || event == SLAProjectedBreachEvent || event == SLABreachEvent {
 			return true
 		}
 	}
 	return false
 }
 
+// IsFailureHook tells if the event is of a hook of type fail, which only runs when the task fails
+func (event *Event) IsFailureHook() bool {
+	switch event.Type {
+	case HookStartEvent, HookRetryEvent, HookFailEvent, HookSuccessEvent:
+		return utils.ConfigAs[string](event.Values, HookTypeKey) == HookTypeFail
+	default:
+		return false
+	}
+}
+
 func (event JobEventType) String() string {
 	return string(event)
 }
@@ -185,6 +209,7 @@ func EventFrom(eventTypeName string, eventValues map[string]any, jobName JobName
 			return nil, errors.InvalidArgument(EntityEvent, "property 'task_id'(string) is missing in event payload")
 		}
 		eventObj.OperatorName = operatorName
+		eventObj.Attempt = int(utils.ConfigAs[float64](eventValues, "attempt"))
 
 		scheduledAtString := utils.ConfigAs[string](eventValues, "scheduled_at")
 		if scheduledAtString == "" {
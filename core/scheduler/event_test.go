@@ -188,6 +188,7 @@ func TestFromStringToEventType(t *testing.T) {
 				"someKey":      "someValue",
 				"event_time":   16000631600.0,
 				"task_id":      "some_txbq",
+				"attempt":      2.0,
 				"status":       "running",
 				"scheduled_at": "2022-01-02T15:04:05Z",
 			}
@@ -202,6 +203,7 @@ func TestFromStringToEventType(t *testing.T) {
 				Type:           scheduler.TaskRetryEvent,
 				EventTime:      time.Date(2477, time.January, 14, 11, 53, 20, 0, time.UTC),
 				OperatorName:   "some_txbq",
+				Attempt:        2,
 				Status:         scheduler.StateRunning,
 				JobScheduledAt: time.Date(2022, time.January, 2, 15, 0o4, 0o5, 0, time.UTC),
 				Values:         eventValues,
@@ -214,8 +216,9 @@ func TestFromStringToEventType(t *testing.T) {
 	})
 	t.Run("IsOfType JobEventCategory", func(t *testing.T) {
 		positiveExpectationMap := map[scheduler.JobEventType]scheduler.JobEventCategory{
-			scheduler.JobFailureEvent: scheduler.EventCategoryJobFailure,
-			scheduler.SLAMissEvent:    scheduler.EventCategorySLAMiss,
+			scheduler.JobFailureEvent:         scheduler.EventCategoryJobFailure,
+			scheduler.SLAMissEvent:            scheduler.EventCategorySLAMiss,
+			scheduler.SLAProjectedBreachEvent: scheduler.EventCategorySLAMiss,
 		}
 		for eventType, category := range positiveExpectationMap {
 			assert.True(t, eventType.IsOfType(category))
@@ -224,6 +227,8 @@ func TestFromStringToEventType(t *testing.T) {
 			scheduler.SLAMissEvent:       scheduler.EventCategoryJobFailure,
 			scheduler.SensorRetryEvent:   scheduler.EventCategoryJobFailure,
 			scheduler.SensorSuccessEvent: scheduler.EventCategorySLAMiss,
+
+			scheduler.SLAProjectedBreachEvent: scheduler.EventCategoryJobFailure,
 		}
 		for eventType, category := range negativeExpectationMap {
 			assert.False(t, eventType.IsOfType(category))
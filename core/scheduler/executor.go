@@ -1,6 +1,7 @@
 package scheduler
 
 import (
+	"strconv"
 	"strings"
 	"time"
 
@@ -11,6 +12,18 @@ import (
 const (
 	ExecutorTask ExecutorType = "task"
 	ExecutorHook ExecutorType = "hook"
+	// ExecutorHookFailure runs a hook of type fail, only when the task of the job fails
+	ExecutorHookFailure ExecutorType = "hook_failure"
+)
+
+const (
+	RunTypeScheduled RunType = "scheduled"
+	RunTypeManual    RunType = "manual"
+	RunTypeReplayed  RunType = "replayed"
+
+	// metadata keys used by the executors to pass the attempt and the run id in the scheduler along with the run input request
+	JobRunAttemptMetadataKey  = "x-job-run-attempt"
+	SchedulerRunIDMetadataKey = "x-scheduler-run-id"
 )
 
 type ExecutorType string
@@ -25,6 +38,8 @@ func ExecutorTypeFrom(val string) (ExecutorType, error) {
 		return ExecutorTask, nil
 	case string(ExecutorHook):
 		return ExecutorHook, nil
+	case string(ExecutorHookFailure):
+		return ExecutorHookFailure, nil
 	}
 	return "", errors.InvalidArgument(EntityJobRun, "failed to convert to executor type, invalid value: "+val)
 }
@@ -58,11 +73,65 @@ func ExecutorFromEnum(name, enum string) (Executor, error) {
 	return ExecutorFrom(name, _typ)
 }
 
+// RunType tells how the job run got triggered in the scheduler
+type RunType string
+
+func (r RunType) String() string {
+	return string(r)
+}
+
+// RunTypeFromSchedulerRunID derives the run type from the prefix of the run id given by the scheduler,
+// runs without a known prefix are considered scheduled
+func RunTypeFromSchedulerRunID(schedulerRunID string) RunType {
+	switch {
+	case strings.HasPrefix(schedulerRunID, RunTypeManual.String()+"__"):
+		return RunTypeManual
+	case strings.HasPrefix(schedulerRunID, RunTypeReplayed.String()+"__"):
+		return RunTypeReplayed
+	}
+	return RunTypeScheduled
+}
+
 type RunConfig struct {
 	Executor Executor
 
 	ScheduledAt time.Time
 	JobRunID    JobRunID
+
+	// Attempt and SchedulerRunID are optional, zero values are used when the executor does not send them
+	Attempt        int
+	SchedulerRunID string
+
+	// ConfigOverrides are set on the compiled task configs, for the manual runs triggered with overrides
+	ConfigOverrides map[string]string
+}
+
+// WithAttempt sets the attempt of the run, attempt can be empty or a positive number
+func (r RunConfig) WithAttempt(attempt string) (RunConfig, error) {
+	if attempt == "" {
+		return r, nil
+	}
+
+	attemptNumber, err := strconv.Atoi(attempt)
+	if err != nil || attemptNumber < 1 {
+		return RunConfig{}, errors.InvalidArgument(EntityJobRun, "invalid job run attempt "+attempt)
+	}
+	r.Attempt = attemptNumber
+	return r, nil
+}
+
+func (r RunConfig) WithSchedulerRunID(schedulerRunID string) RunConfig {
+	r.SchedulerRunID = schedulerRunID
+	return r
+}
+
+func (r RunConfig) WithConfigOverrides(overrides map[string]string) RunConfig {
+	r.ConfigOverrides = overrides
+	return r
+}
+
+func (r RunConfig) RunType() RunType {
+	return RunTypeFromSchedulerRunID(r.SchedulerRunID)
 }
 
 func RunConfigFrom(executor Executor, scheduledAt time.Time, runID string) (RunConfig, error) {
@@ -84,4 +153,10 @@ type ExecutorInput struct {
 	Configs ConfigMap
 	Secrets ConfigMap
 	Files   ConfigMap
+
+	// SecretFiles are the compiled files holding secret values, these are only handed over encrypted for the executor
+	SecretFiles ConfigMap
+
+	// Skipped is set for the hooks whose when condition does not hold in the run, the executor exits without running
+	Skipped bool
 }
@@ -0,0 +1,115 @@
+package scheduler
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"io"
+
+	"github.com/goto/optimus/internal/errors"
+)
+
+const (
+	fileKeyLength = 32
+
+	// FileKeyLabel is the label used to encrypt the file key with the public key of the executor
+	FileKeyLabel = "optimus-executor-input"
+
+	// SecretDataPrefix prefixes the name of a secret in the additional data it is sealed with, so an encrypted
+	// secret can not be passed off as the file of the same name
+	SecretDataPrefix = "secret:"
+)
+
+// EncryptedInput is the part of the executor input encrypted with a key generated for the request, the key is itself
+// encrypted with the public key sent by the executor. Key is the base64 of the RSA-OAEP (SHA-256) encrypted key, and
+// every file and secret is the base64 of the AES-GCM nonce followed by the sealed content, sealed with the file name,
+// or the secret name prefixed with SecretDataPrefix, as additional data
+type EncryptedInput struct {
+	Key     string
+	Files   ConfigMap
+	Secrets ConfigMap
+}
+
+// EncryptInput encrypts the files and secrets for the executor holding the private key of publicKey, which is the
+// base64 of an RSA public key in PKIX, ASN.1 DER form
+func EncryptInput(files, secrets ConfigMap, publicKey string) (*EncryptedInput, error) {
+	rsaKey, err := parsePublicKey(publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	fileKey := make([]byte, fileKeyLength)
+	if _, err := io.ReadFull(rand.Reader, fileKey); err != nil {
+		return nil, errors.InternalError(EntityJobRun, "unable to generate file key", err)
+	}
+	encryptedKey, err := rsa.EncryptOAEP(sha256.New(), rand.Reader, rsaKey, fileKey, []byte(FileKeyLabel))
+	if err != nil {
+		return nil, errors.InternalError(EntityJobRun, "unable to encrypt file key", err)
+	}
+
+	block, err := aes.NewCipher(fileKey)
+	if err != nil {
+		return nil, errors.InternalError(EntityJobRun, "unable to create cipher", err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.InternalError(EntityJobRun, "unable to create cipher", err)
+	}
+
+	encryptedFiles := make(ConfigMap, len(files))
+	for name, content := range files {
+		sealed, err := seal(gcm, content, name)
+		if err != nil {
+			return nil, err
+		}
+		encryptedFiles[name] = sealed
+	}
+
+	encryptedSecrets := make(ConfigMap, len(secrets))
+	for name, value := range secrets {
+		sealed, err := seal(gcm, value, SecretDataPrefix+name)
+		if err != nil {
+			return nil, err
+		}
+		encryptedSecrets[name] = sealed
+	}
+
+	return &EncryptedInput{
+		Key:     base64.StdEncoding.EncodeToString(encryptedKey),
+		Files:   encryptedFiles,
+		Secrets: encryptedSecrets,
+	}, nil
+}
+
+func seal(gcm cipher.AEAD, content, additionalData string) (string, error) {
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", errors.InternalError(EntityJobRun, "unable to generate nonce", err)
+	}
+	sealed := gcm.Seal(nonce, nonce, []byte(content), []byte(additionalData))
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+func parsePublicKey(publicKey string) (*rsa.PublicKey, error) {
+	if publicKey == "" {
+		return nil, errors.InvalidArgument(EntityJobRun, "public key is empty")
+	}
+
+	der, err := base64.StdEncoding.DecodeString(publicKey)
+	if err != nil {
+		return nil, errors.InvalidArgument(EntityJobRun, "public key is not base64 encoded")
+	}
+	parsed, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return nil, errors.InvalidArgument(EntityJobRun, "invalid public key: "+err.Error())
+	}
+	rsaKey, ok := parsed.(*rsa.PublicKey)
+	if !ok {
+		return nil, errors.InvalidArgument(EntityJobRun, "public key is not an rsa key")
+	}
+	return rsaKey, nil
+}
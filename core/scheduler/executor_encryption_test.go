@@ -0,0 +1,88 @@
+package scheduler_test
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/core/scheduler"
+)
+
+func TestEncryptInput(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	assert.NoError(t, err)
+	publicKey := base64.StdEncoding.EncodeToString(der)
+
+	t.Run("returns error when public key is empty", func(t *testing.T) {
+		_, err := scheduler.EncryptInput(scheduler.ConfigMap{"query.sql": "select 1"}, nil, "")
+		assert.EqualError(t, err, "invalid argument for entity jobRun: public key is empty")
+	})
+	t.Run("returns error when public key is not base64 encoded", func(t *testing.T) {
+		_, err := scheduler.EncryptInput(scheduler.ConfigMap{"query.sql": "select 1"}, nil, "not-a-key!")
+		assert.EqualError(t, err, "invalid argument for entity jobRun: public key is not base64 encoded")
+	})
+	t.Run("returns error when public key is not rsa", func(t *testing.T) {
+		ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		assert.NoError(t, err)
+		ecDer, err := x509.MarshalPKIXPublicKey(&ecKey.PublicKey)
+		assert.NoError(t, err)
+
+		_, err = scheduler.EncryptInput(scheduler.ConfigMap{"query.sql": "select 1"}, nil, base64.StdEncoding.EncodeToString(ecDer))
+		assert.EqualError(t, err, "invalid argument for entity jobRun: public key is not an rsa key")
+	})
+	t.Run("encrypts files and secrets to be decrypted with the private key", func(t *testing.T) {
+		files := scheduler.ConfigMap{
+			"query.sql":  "select * from t where token = 'secret-token'",
+			"config.yml": "password: secret-password",
+		}
+		secrets := scheduler.ConfigMap{
+			"query.sql": "secret-token",
+		}
+
+		encrypted, err := scheduler.EncryptInput(files, secrets, publicKey)
+		assert.NoError(t, err)
+		assert.Len(t, encrypted.Files, 2)
+		assert.Len(t, encrypted.Secrets, 1)
+
+		encryptedKey, err := base64.StdEncoding.DecodeString(encrypted.Key)
+		assert.NoError(t, err)
+		fileKey, err := rsa.DecryptOAEP(sha256.New(), rand.Reader, privateKey, encryptedKey, []byte(scheduler.FileKeyLabel))
+		assert.NoError(t, err)
+
+		block, err := aes.NewCipher(fileKey)
+		assert.NoError(t, err)
+		gcm, err := cipher.NewGCM(block)
+		assert.NoError(t, err)
+
+		for name, content := range files {
+			assert.NotContains(t, encrypted.Files[name], content)
+
+			sealed, err := base64.StdEncoding.DecodeString(encrypted.Files[name])
+			assert.NoError(t, err)
+			nonce, ciphertext := sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():]
+			plain, err := gcm.Open(nil, nonce, ciphertext, []byte(name))
+			assert.NoError(t, err)
+			assert.Equal(t, content, string(plain))
+		}
+
+		sealed, err := base64.StdEncoding.DecodeString(encrypted.Secrets["query.sql"])
+		assert.NoError(t, err)
+		nonce, ciphertext := sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():]
+		_, err = gcm.Open(nil, nonce, ciphertext, []byte("query.sql"))
+		assert.Error(t, err)
+		plain, err := gcm.Open(nil, nonce, ciphertext, []byte(scheduler.SecretDataPrefix+"query.sql"))
+		assert.NoError(t, err)
+		assert.Equal(t, "secret-token", string(plain))
+	})
+}
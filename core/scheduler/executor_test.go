@@ -19,7 +19,7 @@ func TestExecutor(t *testing.T) {
 				" invalid value: invalid")
 		})
 		t.Run("returns error when executor type is invalid", func(t *testing.T) {
-			validExecutorTypes := []string{"task", "hook"}
+			validExecutorTypes := []string{"task", "hook", "hook_failure"}
 
 			for _, executorType := range validExecutorTypes {
 				typ, err := scheduler.ExecutorTypeFrom(executorType)
@@ -79,5 +79,32 @@ func TestExecutor(t *testing.T) {
 			assert.Equal(t, "bq2bq", runConfig.Executor.Name)
 			assert.Equal(t, now, runConfig.ScheduledAt)
 		})
+		t.Run("returns error when attempt is invalid", func(t *testing.T) {
+			executor := scheduler.Executor{Name: "bq2bq", Type: scheduler.ExecutorTask}
+			runConfig, err := scheduler.RunConfigFrom(executor, time.Now(), "")
+			assert.NoError(t, err)
+
+			_, err = runConfig.WithAttempt("0")
+			assert.EqualError(t, err, "invalid argument for entity jobRun: invalid job run attempt 0")
+		})
+		t.Run("returns run config with attempt and scheduler run id", func(t *testing.T) {
+			executor := scheduler.Executor{Name: "bq2bq", Type: scheduler.ExecutorTask}
+			runConfig, err := scheduler.RunConfigFrom(executor, time.Now(), "")
+			assert.NoError(t, err)
+
+			runConfig, err = runConfig.WithAttempt("2")
+			assert.NoError(t, err)
+			runConfig = runConfig.WithSchedulerRunID("manual__2023-01-02T03:00:00+00:00")
+
+			assert.Equal(t, 2, runConfig.Attempt)
+			assert.Equal(t, "manual__2023-01-02T03:00:00+00:00", runConfig.SchedulerRunID)
+			assert.Equal(t, scheduler.RunTypeManual, runConfig.RunType())
+		})
+	})
+	t.Run("RunTypeFromSchedulerRunID", func(t *testing.T) {
+		assert.Equal(t, scheduler.RunTypeScheduled, scheduler.RunTypeFromSchedulerRunID(""))
+		assert.Equal(t, scheduler.RunTypeScheduled, scheduler.RunTypeFromSchedulerRunID("scheduled__2023-01-02T03:00:00+00:00"))
+		assert.Equal(t, scheduler.RunTypeManual, scheduler.RunTypeFromSchedulerRunID("manual__2023-01-02T03:00:00+00:00"))
+		assert.Equal(t, scheduler.RunTypeReplayed, scheduler.RunTypeFromSchedulerRunID("replayed__2023-01-02T03:00:00+00:00"))
 	})
 }
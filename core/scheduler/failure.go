@@ -0,0 +1,137 @@
+package scheduler
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/goto/optimus/internal/errors"
+	"github.com/goto/optimus/internal/utils"
+)
+
+const (
+	EntityFailureRule = "failure_rule"
+
+	// FailureCategoryKey is the event value carrying the category of a failure
+	FailureCategoryKey = "failure_category"
+
+	hookOperatorPrefix   = "hook_"
+	sensorOperatorPrefix = "wait_"
+)
+
+type FailureCategory string
+
+const (
+	FailureCategoryInfra   FailureCategory = "infra"
+	FailureCategoryData    FailureCategory = "data"
+	FailureCategoryQuota   FailureCategory = "quota"
+	FailureCategoryCode    FailureCategory = "code"
+	FailureCategoryUnknown FailureCategory = "unknown"
+)
+
+func (c FailureCategory) String() string {
+	return string(c)
+}
+
+func FailureCategoryFrom(category string) (FailureCategory, error) {
+	switch FailureCategory(strings.ToLower(category)) {
+	case FailureCategoryInfra:
+		return FailureCategoryInfra, nil
+	case FailureCategoryData:
+		return FailureCategoryData, nil
+	case FailureCategoryQuota:
+		return FailureCategoryQuota, nil
+	case FailureCategoryCode:
+		return FailureCategoryCode, nil
+	default:
+		return "", errors.InvalidArgument(EntityFailureRule, "unknown failure category "+category)
+	}
+}
+
+// FailureRule tags the failures of a plugin matching the pattern or reporting one of the codes with the category
+type FailureRule struct {
+	Plugin   string
+	Category FailureCategory
+	Pattern  *regexp.Regexp
+	Codes    []string
+}
+
+// NewFailureRule creates a rule, empty plugin applies the rule to the failures of every plugin
+func NewFailureRule(plugin, category, pattern string, codes []string) (*FailureRule, error) {
+	failureCategory, err := FailureCategoryFrom(category)
+	if err != nil {
+		return nil, err
+	}
+	if pattern == "" && len(codes) == 0 {
+		return nil, errors.InvalidArgument(EntityFailureRule, "either pattern or codes is required for category "+category)
+	}
+
+	var compiled *regexp.Regexp
+	if pattern != "" {
+		compiled, err = regexp.Compile(pattern)
+		if err != nil {
+			return nil, errors.InvalidArgument(EntityFailureRule, "invalid pattern "+pattern+": "+err.Error())
+		}
+	}
+	return &FailureRule{
+		Plugin:   plugin,
+		Category: failureCategory,
+		Pattern:  compiled,
+		Codes:    codes,
+	}, nil
+}
+
+func (r *FailureRule) Matches(event *Event) bool {
+	if r.Plugin != "" && r.Plugin != event.FailedPlugin() {
+		return false
+	}
+	if code := event.FailureCode(); code != "" {
+		for _, c := range r.Codes {
+			if c == code {
+				return true
+			}
+		}
+	}
+	return r.Pattern != nil && r.Pattern.MatchString(event.FailureMessage())
+}
+
+func (event *Event) IsFailure() bool {
+	switch event.Type {
+	case JobFailureEvent, TaskFailEvent, HookFailEvent, SensorFailEvent:
+		return true
+	default:
+		return false
+	}
+}
+
+// FailedPlugin is the plugin of the operator reported as failed, hooks are named after their plugin
+// while every sensor is run by the same operator
+func (event *Event) FailedPlugin() string {
+	switch {
+	case strings.HasPrefix(event.OperatorName, hookOperatorPrefix):
+		return strings.TrimPrefix(event.OperatorName, hookOperatorPrefix)
+	case strings.HasPrefix(event.OperatorName, sensorOperatorPrefix):
+		return OperatorSensor.String()
+	default:
+		return event.OperatorName
+	}
+}
+
+func (event *Event) FailureMessage() string {
+	exception := utils.ConfigAs[string](event.Values, "exception")
+	message := utils.ConfigAs[string](event.Values, "message")
+	return strings.TrimSpace(exception + "\n" + message)
+}
+
+// FailureCode is the error code reported by the run, codes sent as numbers are compared in their string form
+func (event *Event) FailureCode() string {
+	code, ok := event.Values["error_code"]
+	if !ok || code == nil {
+		return ""
+	}
+	return fmt.Sprint(code)
+}
+
+func (event *Event) FailureCategory() FailureCategory {
+	return FailureCategory(utils.ConfigAs[string](event.Values, FailureCategoryKey))
+}
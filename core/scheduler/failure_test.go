@@ -0,0 +1,84 @@
+package scheduler_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/core/scheduler"
+)
+
+func TestFailureRule(t *testing.T) {
+	t.Run("NewFailureRule", func(t *testing.T) {
+		t.Run("returns error when category is unknown", func(t *testing.T) {
+			rule, err := scheduler.NewFailureRule("bq2bq", "network", "timeout", nil)
+			assert.Nil(t, rule)
+			assert.ErrorContains(t, err, "unknown failure category network")
+		})
+		t.Run("returns error when neither pattern nor codes are given", func(t *testing.T) {
+			rule, err := scheduler.NewFailureRule("bq2bq", "infra", "", nil)
+			assert.Nil(t, rule)
+			assert.ErrorContains(t, err, "either pattern or codes is required")
+		})
+		t.Run("returns error when pattern is invalid", func(t *testing.T) {
+			rule, err := scheduler.NewFailureRule("bq2bq", "infra", "(timeout", nil)
+			assert.Nil(t, rule)
+			assert.ErrorContains(t, err, "invalid pattern (timeout")
+		})
+	})
+	t.Run("Matches", func(t *testing.T) {
+		event := &scheduler.Event{
+			Type:         scheduler.TaskFailEvent,
+			OperatorName: "bq2bq",
+			Values: map[string]any{
+				"exception":  "None",
+				"message":    "Access Denied: Table sample.table",
+				"error_code": float64(403),
+			},
+		}
+		t.Run("matches the pattern against the failure message", func(t *testing.T) {
+			rule, err := scheduler.NewFailureRule("", "data", "Access Denied: Table", nil)
+			assert.NoError(t, err)
+			assert.True(t, rule.Matches(event))
+		})
+		t.Run("matches the reported error code", func(t *testing.T) {
+			rule, err := scheduler.NewFailureRule("bq2bq", "code", "", []string{"400", "403"})
+			assert.NoError(t, err)
+			assert.True(t, rule.Matches(event))
+		})
+		t.Run("does not match failures of other plugins", func(t *testing.T) {
+			rule, err := scheduler.NewFailureRule("transporter", "data", "Access Denied", nil)
+			assert.NoError(t, err)
+			assert.False(t, rule.Matches(event))
+		})
+		t.Run("matches hook failures on the hook plugin", func(t *testing.T) {
+			hookEvent := &scheduler.Event{
+				Type:         scheduler.HookFailEvent,
+				OperatorName: "hook_predator",
+				Values:       map[string]any{"message": "rate limit exceeded"},
+			}
+			rule, err := scheduler.NewFailureRule("predator", "quota", "rate limit", nil)
+			assert.NoError(t, err)
+			assert.True(t, rule.Matches(hookEvent))
+		})
+	})
+}
+
+func TestEventFailure(t *testing.T) {
+	t.Run("IsFailure", func(t *testing.T) {
+		assert.True(t, (&scheduler.Event{Type: scheduler.JobFailureEvent}).IsFailure())
+		assert.True(t, (&scheduler.Event{Type: scheduler.SensorFailEvent}).IsFailure())
+		assert.False(t, (&scheduler.Event{Type: scheduler.TaskRetryEvent}).IsFailure())
+	})
+	t.Run("IsFailureHook", func(t *testing.T) {
+		failureHookValues := map[string]any{scheduler.HookTypeKey: scheduler.HookTypeFail}
+		assert.True(t, (&scheduler.Event{Type: scheduler.HookSuccessEvent, Values: failureHookValues}).IsFailureHook())
+		assert.False(t, (&scheduler.Event{Type: scheduler.HookSuccessEvent, Values: map[string]any{}}).IsFailureHook())
+		assert.False(t, (&scheduler.Event{Type: scheduler.TaskSuccessEvent, Values: failureHookValues}).IsFailureHook())
+	})
+	t.Run("FailedPlugin", func(t *testing.T) {
+		assert.Equal(t, "bq2bq", (&scheduler.Event{OperatorName: "bq2bq"}).FailedPlugin())
+		assert.Equal(t, "predator", (&scheduler.Event{OperatorName: "hook_predator"}).FailedPlugin())
+		assert.Equal(t, "sensor", (&scheduler.Event{OperatorName: "wait_sample-job"}).FailedPlugin())
+	})
+}
@@ -0,0 +1,17 @@
+package scheduler
+
+import "time"
+
+// FreshnessPredicate is the freshness required from the data of an upstream by its sensor, evaluated by the
+// resource manager integration of the data store
+type FreshnessPredicate struct {
+	PartitionTime time.Time     // the partition holding the time is required to exist when it is not zero
+	MaxAge        time.Duration // the data is required to be modified within it when greater than zero
+}
+
+// Freshness is the evaluation of a freshness predicate, the reason tells why the data is not fresh
+type Freshness struct {
+	Fresh        bool
+	LastModified time.Time
+	Reason       string
+}
@@ -0,0 +1,183 @@
+package v1beta1
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/goto/salt/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/auth"
+	"github.com/goto/optimus/internal/errors"
+	"github.com/goto/optimus/internal/logging"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+type AlertSilenceService interface {
+	Create(ctx context.Context, silence *scheduler.AlertSilence) error
+	GetActive(ctx context.Context, projectName tenant.ProjectName) ([]*scheduler.AlertSilence, error)
+	Expire(ctx context.Context, projectName tenant.ProjectName, id uuid.UUID) error
+}
+
+type SchedulerHealthGetter interface {
+	GetSchedulerHealth(ctx context.Context, tnnt tenant.Tenant) (*scheduler.EnvironmentHealth, error)
+}
+
+type AlertSilenceHandler struct {
+	l            log.Logger
+	service      AlertSilenceService
+	healthGetter SchedulerHealthGetter
+
+	pb.UnimplementedAlertSilenceServiceServer
+}
+
+// CreateAlertSilence records the caller as the creator of the silence, so the alerts can not be silenced without credentials
+func (h AlertSilenceHandler) CreateAlertSilence(ctx context.Context, req *pb.CreateAlertSilenceRequest) (*pb.CreateAlertSilenceResponse, error) {
+	principal := auth.PrincipalFrom(ctx)
+	if principal == nil {
+		return nil, status.Error(codes.Unauthenticated, "alerts of project "+req.GetProjectName()+" can only be silenced with credentials")
+	}
+
+	now := time.Now().UTC()
+	expiresAt, err := parseSilenceExpiry(req.GetExpiresAt(), now)
+	if err != nil {
+		h.l.Error("error adapting expiry of alert silence [%s]: %s", req.GetExpiresAt(), err)
+		return nil, errors.GRPCErr(err, "unable to create alert silence in "+req.GetProjectName())
+	}
+
+	silence, err := scheduler.NewAlertSilence(tenant.ProjectName(req.GetProjectName()), tenant.NamespaceName(req.GetNamespaceName()),
+		scheduler.JobName(req.GetJobName()), req.GetLabels(), req.GetReason(), principal.String(), expiresAt, now)
+	if err != nil {
+		h.l.Error("error adapting alert silence: %s", err)
+		return nil, errors.GRPCErr(err, "unable to create alert silence in "+req.GetProjectName())
+	}
+
+	if err := h.service.Create(ctx, silence); err != nil {
+		h.l.Error("error creating alert silence in project [%s]: %s", req.GetProjectName(), err)
+		return nil, errors.GRPCErr(err, "unable to create alert silence in "+req.GetProjectName())
+	}
+	return &pb.CreateAlertSilenceResponse{}, nil
+}
+
+func (h AlertSilenceHandler) ListAlertSilences(ctx context.Context, req *pb.ListAlertSilencesRequest) (*pb.ListAlertSilencesResponse, error) {
+	l := logging.ForTenant(h.l, req.GetProjectName(), "", "")
+	projectName, err := tenant.ProjectNameFrom(req.GetProjectName())
+	if err != nil {
+		l.Error("error adapting project name [%s]: %s", req.GetProjectName(), err)
+		return nil, errors.GRPCErr(err, "unable to list alert silences of "+req.GetProjectName())
+	}
+
+	silences, err := h.service.GetActive(ctx, projectName)
+	if err != nil {
+		l.Error("error getting alert silences of project [%s]: %s", projectName, err)
+		return nil, errors.GRPCErr(err, "unable to list alert silences of "+req.GetProjectName())
+	}
+	return &pb.ListAlertSilencesResponse{Silences: toAlertSilences(silences)}, nil
+}
+
+func (h AlertSilenceHandler) ExpireAlertSilence(ctx context.Context, req *pb.ExpireAlertSilenceRequest) (*pb.ExpireAlertSilenceResponse, error) {
+	l := logging.ForTenant(h.l, req.GetProjectName(), "", "")
+	projectName, err := tenant.ProjectNameFrom(req.GetProjectName())
+	if err != nil {
+		l.Error("error adapting project name [%s]: %s", req.GetProjectName(), err)
+		return nil, errors.GRPCErr(err, "unable to expire alert silence "+req.GetId())
+	}
+
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		l.Error("error parsing alert silence id [%s]: %s", req.GetId(), err)
+		return nil, errors.GRPCErr(errors.InvalidArgument(scheduler.EntityAlertSilence, "invalid silence id "+req.GetId()),
+			"unable to expire alert silence "+req.GetId())
+	}
+
+	if err := h.service.Expire(ctx, projectName, id); err != nil {
+		l.Error("error expiring alert silence [%s]: %s", req.GetId(), err)
+		return nil, errors.GRPCErr(err, "unable to expire alert silence "+req.GetId())
+	}
+	return &pb.ExpireAlertSilenceResponse{}, nil
+}
+
+// GetHealthSummary reports the health of the scheduler of the namespace along with the active alert silences of the project
+func (h AlertSilenceHandler) GetHealthSummary(ctx context.Context, req *pb.GetHealthSummaryRequest) (*pb.GetHealthSummaryResponse, error) {
+	tnnt, err := tenant.NewTenant(req.GetProjectName(), req.GetNamespaceName())
+	if err != nil {
+		h.l.Error("error adapting tenant [%s/%s]: %s", req.GetProjectName(), req.GetNamespaceName(), err)
+		return nil, errors.GRPCErr(err, "unable to get health summary of "+req.GetNamespaceName())
+	}
+
+	l := h.tenantLogger(tnnt)
+
+	health, err := h.healthGetter.GetSchedulerHealth(ctx, tnnt)
+	if err != nil {
+		l.Error("error getting scheduler health of namespace [%s]: %s", req.GetNamespaceName(), err)
+		return nil, errors.GRPCErr(err, "unable to get health summary of "+req.GetNamespaceName())
+	}
+
+	silences, err := h.service.GetActive(ctx, tnnt.ProjectName())
+	if err != nil {
+		l.Error("error getting alert silences of project [%s]: %s", req.GetProjectName(), err)
+		return nil, errors.GRPCErr(err, "unable to get health summary of "+req.GetNamespaceName())
+	}
+
+	summary := &pb.GetHealthSummaryResponse{
+		SchedulerType:       health.Type,
+		Healthy:             health.IsHealthy(),
+		MetadatabaseHealthy: health.MetadatabaseHealthy,
+		SchedulerHealthy:    health.SchedulerHealthy,
+		ActiveSilences:      toAlertSilences(silences),
+	}
+	if !health.LatestSchedulerHeartbeat.IsZero() {
+		summary.LatestSchedulerHeartbeat = timestamppb.New(health.LatestSchedulerHeartbeat)
+	}
+	return summary, nil
+}
+
+func parseSilenceExpiry(raw string, now time.Time) (time.Time, error) {
+	if raw == "" {
+		return time.Time{}, errors.InvalidArgument(scheduler.EntityAlertSilence, "expires_at is empty")
+	}
+	if duration, err := time.ParseDuration(raw); err == nil {
+		return now.Add(duration), nil
+	}
+	expiresAt, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return time.Time{}, errors.InvalidArgument(scheduler.EntityAlertSilence, "expires_at should be a RFC3339 timestamp or a duration: "+raw)
+	}
+	return expiresAt.UTC(), nil
+}
+
+func toAlertSilences(silences []*scheduler.AlertSilence) []*pb.AlertSilence {
+	response := make([]*pb.AlertSilence, len(silences))
+	for i, silence := range silences {
+		response[i] = &pb.AlertSilence{
+			Id:            silence.ID.String(),
+			ProjectName:   silence.ProjectName.String(),
+			NamespaceName: silence.NamespaceName.String(),
+			JobName:       silence.JobName.String(),
+			Labels:        silence.Labels,
+			Reason:        silence.Reason,
+			CreatedBy:     silence.CreatedBy,
+			ExpiresAt:     timestamppb.New(silence.ExpiresAt),
+			CreatedAt:     timestamppb.New(silence.CreatedAt),
+		}
+	}
+	return response
+}
+
+// tenantLogger attaches the tenant fields to the lines logged for the request
+func (h AlertSilenceHandler) tenantLogger(tnnt tenant.Tenant) log.Logger {
+	return logging.ForTenant(h.l, tnnt.ProjectName().String(), tnnt.NamespaceName().String(), "")
+}
+
+func NewAlertSilenceHandler(l log.Logger, service AlertSilenceService, healthGetter SchedulerHealthGetter) *AlertSilenceHandler {
+	return &AlertSilenceHandler{
+		l:            l,
+		service:      service,
+		healthGetter: healthGetter,
+	}
+}
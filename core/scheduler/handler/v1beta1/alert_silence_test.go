@@ -0,0 +1,211 @@
+package v1beta1_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/scheduler/handler/v1beta1"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/auth"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+func TestAlertSilenceHandler(t *testing.T) {
+	logger := log.NewNoop()
+	ctx := context.Background()
+	projectName := tenant.ProjectName("proj")
+	silenceID := uuid.New()
+	expiresAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	principalCtx := auth.WithPrincipal(ctx, &auth.Principal{Type: auth.PrincipalOIDC, Name: "john@example.com"})
+
+	t.Run("CreateAlertSilence", func(t *testing.T) {
+		t.Run("returns error when the caller is not authenticated", func(t *testing.T) {
+			handler := v1beta1.NewAlertSilenceHandler(logger, new(mockAlertSilenceService), new(mockJobRunService))
+
+			_, err := handler.CreateAlertSilence(ctx, &pb.CreateAlertSilenceRequest{
+				ProjectName:   projectName.String(),
+				NamespaceName: "sales",
+				Reason:        "warehouse maintenance",
+				ExpiresAt:     "2h",
+			})
+			assert.ErrorContains(t, err, "code = Unauthenticated")
+		})
+		t.Run("returns error when expiry is invalid", func(t *testing.T) {
+			handler := v1beta1.NewAlertSilenceHandler(logger, new(mockAlertSilenceService), new(mockJobRunService))
+
+			_, err := handler.CreateAlertSilence(principalCtx, &pb.CreateAlertSilenceRequest{
+				ProjectName:   projectName.String(),
+				NamespaceName: "sales",
+				Reason:        "warehouse maintenance",
+				ExpiresAt:     "tomorrow",
+			})
+			assert.ErrorContains(t, err, "code = InvalidArgument")
+		})
+		t.Run("returns error when silence selects no jobs", func(t *testing.T) {
+			handler := v1beta1.NewAlertSilenceHandler(logger, new(mockAlertSilenceService), new(mockJobRunService))
+
+			_, err := handler.CreateAlertSilence(principalCtx, &pb.CreateAlertSilenceRequest{
+				ProjectName: projectName.String(),
+				Reason:      "warehouse maintenance",
+				ExpiresAt:   "2h",
+			})
+			assert.ErrorContains(t, err, "code = InvalidArgument")
+		})
+		t.Run("records the caller as the creator of the silence", func(t *testing.T) {
+			service := new(mockAlertSilenceService)
+			service.On("Create", principalCtx, mock.MatchedBy(func(silence *scheduler.AlertSilence) bool {
+				return silence.ProjectName == projectName && silence.NamespaceName == "sales" &&
+					silence.Labels["team"] == "data" && silence.CreatedBy == "john@example.com" && silence.ExpiresAt.Equal(expiresAt)
+			})).Return(nil)
+			defer service.AssertExpectations(t)
+
+			handler := v1beta1.NewAlertSilenceHandler(logger, service, new(mockJobRunService))
+
+			_, err := handler.CreateAlertSilence(principalCtx, &pb.CreateAlertSilenceRequest{
+				ProjectName:   projectName.String(),
+				NamespaceName: "sales",
+				Labels:        map[string]string{"team": "data"},
+				Reason:        "warehouse maintenance",
+				ExpiresAt:     expiresAt.Format(time.RFC3339),
+			})
+			assert.NoError(t, err)
+		})
+	})
+	t.Run("ListAlertSilences", func(t *testing.T) {
+		t.Run("returns error when project name is invalid", func(t *testing.T) {
+			handler := v1beta1.NewAlertSilenceHandler(logger, new(mockAlertSilenceService), new(mockJobRunService))
+
+			_, err := handler.ListAlertSilences(ctx, &pb.ListAlertSilencesRequest{})
+			assert.ErrorContains(t, err, "code = InvalidArgument")
+		})
+		t.Run("returns the active silences of the project", func(t *testing.T) {
+			service := new(mockAlertSilenceService)
+			service.On("GetActive", ctx, projectName).Return([]*scheduler.AlertSilence{{
+				ID:            silenceID,
+				ProjectName:   projectName,
+				NamespaceName: "sales",
+				Reason:        "warehouse maintenance",
+				CreatedBy:     "john@example.com",
+				ExpiresAt:     expiresAt,
+			}}, nil)
+			defer service.AssertExpectations(t)
+
+			handler := v1beta1.NewAlertSilenceHandler(logger, service, new(mockJobRunService))
+
+			resp, err := handler.ListAlertSilences(ctx, &pb.ListAlertSilencesRequest{ProjectName: projectName.String()})
+			assert.NoError(t, err)
+			assert.Len(t, resp.GetSilences(), 1)
+			assert.Equal(t, silenceID.String(), resp.GetSilences()[0].GetId())
+			assert.Equal(t, "john@example.com", resp.GetSilences()[0].GetCreatedBy())
+			assert.Equal(t, expiresAt, resp.GetSilences()[0].GetExpiresAt().AsTime())
+		})
+	})
+	t.Run("ExpireAlertSilence", func(t *testing.T) {
+		t.Run("returns error when silence id is invalid", func(t *testing.T) {
+			handler := v1beta1.NewAlertSilenceHandler(logger, new(mockAlertSilenceService), new(mockJobRunService))
+
+			_, err := handler.ExpireAlertSilence(ctx, &pb.ExpireAlertSilenceRequest{ProjectName: projectName.String(), Id: "invalid"})
+			assert.ErrorContains(t, err, "code = InvalidArgument")
+		})
+		t.Run("returns error when unable to expire the silence", func(t *testing.T) {
+			service := new(mockAlertSilenceService)
+			service.On("Expire", ctx, projectName, silenceID).Return(errors.New("unknown error"))
+			defer service.AssertExpectations(t)
+
+			handler := v1beta1.NewAlertSilenceHandler(logger, service, new(mockJobRunService))
+
+			_, err := handler.ExpireAlertSilence(ctx, &pb.ExpireAlertSilenceRequest{ProjectName: projectName.String(), Id: silenceID.String()})
+			assert.ErrorContains(t, err, "code = Internal")
+		})
+		t.Run("expires the silence of the project", func(t *testing.T) {
+			service := new(mockAlertSilenceService)
+			service.On("Expire", ctx, projectName, silenceID).Return(nil)
+			defer service.AssertExpectations(t)
+
+			handler := v1beta1.NewAlertSilenceHandler(logger, service, new(mockJobRunService))
+
+			_, err := handler.ExpireAlertSilence(ctx, &pb.ExpireAlertSilenceRequest{ProjectName: projectName.String(), Id: silenceID.String()})
+			assert.NoError(t, err)
+		})
+	})
+	t.Run("GetHealthSummary", func(t *testing.T) {
+		tnnt, _ := tenant.NewTenant(projectName.String(), "sales")
+
+		t.Run("returns error when namespace name is empty", func(t *testing.T) {
+			handler := v1beta1.NewAlertSilenceHandler(logger, new(mockAlertSilenceService), new(mockJobRunService))
+
+			_, err := handler.GetHealthSummary(ctx, &pb.GetHealthSummaryRequest{ProjectName: projectName.String()})
+			assert.ErrorContains(t, err, "code = InvalidArgument")
+		})
+		t.Run("returns error when unable to get the scheduler health", func(t *testing.T) {
+			healthGetter := new(mockJobRunService)
+			healthGetter.On("GetSchedulerHealth", ctx, tnnt).Return(nil, errors.New("unknown error"))
+			defer healthGetter.AssertExpectations(t)
+
+			handler := v1beta1.NewAlertSilenceHandler(logger, new(mockAlertSilenceService), healthGetter)
+
+			_, err := handler.GetHealthSummary(ctx, &pb.GetHealthSummaryRequest{ProjectName: projectName.String(), NamespaceName: "sales"})
+			assert.ErrorContains(t, err, "code = Internal")
+		})
+		t.Run("returns the scheduler health along with the active silences", func(t *testing.T) {
+			heartbeat := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+			healthGetter := new(mockJobRunService)
+			healthGetter.On("GetSchedulerHealth", ctx, tnnt).Return(&scheduler.EnvironmentHealth{
+				Type:                     "airflow",
+				MetadatabaseHealthy:      true,
+				SchedulerHealthy:         false,
+				LatestSchedulerHeartbeat: heartbeat,
+			}, nil)
+			defer healthGetter.AssertExpectations(t)
+
+			service := new(mockAlertSilenceService)
+			service.On("GetActive", ctx, projectName).Return([]*scheduler.AlertSilence{{
+				ID:          silenceID,
+				ProjectName: projectName,
+				JobName:     "job-a",
+				Reason:      "warehouse maintenance",
+				ExpiresAt:   expiresAt,
+			}}, nil)
+			defer service.AssertExpectations(t)
+
+			handler := v1beta1.NewAlertSilenceHandler(logger, service, healthGetter)
+
+			resp, err := handler.GetHealthSummary(ctx, &pb.GetHealthSummaryRequest{ProjectName: projectName.String(), NamespaceName: "sales"})
+			assert.NoError(t, err)
+			assert.Equal(t, "airflow", resp.GetSchedulerType())
+			assert.False(t, resp.GetHealthy())
+			assert.True(t, resp.GetMetadatabaseHealthy())
+			assert.Equal(t, heartbeat, resp.GetLatestSchedulerHeartbeat().AsTime())
+			assert.Len(t, resp.GetActiveSilences(), 1)
+			assert.Equal(t, "job-a", resp.GetActiveSilences()[0].GetJobName())
+		})
+	})
+}
+
+type mockAlertSilenceService struct {
+	mock.Mock
+}
+
+func (m *mockAlertSilenceService) Create(ctx context.Context, silence *scheduler.AlertSilence) error {
+	return m.Called(ctx, silence).Error(0)
+}
+
+func (m *mockAlertSilenceService) GetActive(ctx context.Context, projectName tenant.ProjectName) ([]*scheduler.AlertSilence, error) {
+	args := m.Called(ctx, projectName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*scheduler.AlertSilence), args.Error(1)
+}
+
+func (m *mockAlertSilenceService) Expire(ctx context.Context, projectName tenant.ProjectName, id uuid.UUID) error {
+	return m.Called(ctx, projectName, id).Error(0)
+}
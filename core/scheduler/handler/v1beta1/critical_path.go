@@ -0,0 +1,63 @@
+package v1beta1
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+	"github.com/goto/optimus/internal/logging"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+// GetJobRunCriticalPath returns the chain of upstream runs which gated the completion of the job run
+func (h JobRunHandler) GetJobRunCriticalPath(ctx context.Context, req *pb.GetJobRunCriticalPathRequest) (*pb.GetJobRunCriticalPathResponse, error) {
+	l := logging.ForTenant(h.l, req.GetProjectName(), "", req.GetJobName())
+	projectName, err := tenant.ProjectNameFrom(req.GetProjectName())
+	if err != nil {
+		l.Error("error adapting project name [%s]: %s", req.GetProjectName(), err)
+		return nil, errors.GRPCErr(err, "unable to get critical path for "+req.GetJobName())
+	}
+
+	jobName, err := scheduler.JobNameFrom(req.GetJobName())
+	if err != nil {
+		l.Error("error adapting job name [%s]: %s", req.GetJobName(), err)
+		return nil, errors.GRPCErr(err, "unable to get critical path for "+req.GetJobName())
+	}
+
+	if err := req.GetScheduledAt().CheckValid(); err != nil {
+		l.Error("invalid scheduled at: %s", err)
+		return nil, errors.GRPCErr(errors.InvalidArgument(scheduler.EntityJobRun, "invalid scheduled_at"),
+			"unable to get critical path for "+req.GetJobName())
+	}
+
+	path, err := h.service.GetCriticalPath(ctx, projectName, jobName, req.GetScheduledAt().AsTime())
+	if err != nil {
+		l.Error("error getting critical path of job run [%s]: %s", jobName, err)
+		return nil, errors.GRPCErr(err, "unable to get critical path for "+req.GetJobName())
+	}
+
+	response := &pb.GetJobRunCriticalPathResponse{Runs: make([]*pb.GetJobRunCriticalPathResponse_Run, len(path.Runs))}
+	if duration := path.Duration(); duration > 0 {
+		response.Duration = durationpb.New(duration)
+	}
+	for i, run := range path.Runs {
+		response.Runs[i] = &pb.GetJobRunCriticalPathResponse_Run{
+			ProjectName:   run.Tenant.ProjectName().String(),
+			NamespaceName: run.Tenant.NamespaceName().String(),
+			JobName:       run.JobName.String(),
+			ScheduledAt:   timestamppb.New(run.ScheduledAt),
+			State:         run.State.String(),
+			StartTime:     timestamppb.New(run.StartTime),
+			Wait:          durationpb.New(run.StartTime.Sub(run.ScheduledAt)),
+		}
+		if run.EndTime != nil {
+			response.Runs[i].EndTime = timestamppb.New(*run.EndTime)
+			response.Runs[i].Duration = durationpb.New(run.EndTime.Sub(run.StartTime))
+		}
+	}
+	return response, nil
+}
@@ -0,0 +1,77 @@
+package v1beta1
+
+import (
+	"context"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+	"github.com/goto/optimus/internal/logging"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+// EncryptedJobRunInput is used by the executor of a job run to get the job run input when some of the compiled
+// files have secrets, the secrets and those files are returned encrypted with a key that only the holder of the
+// private key of public_key can decrypt. The attempt and the scheduler run id are read from the same metadata as the run input.
+func (h JobRunHandler) EncryptedJobRunInput(ctx context.Context, req *pb.EncryptedJobRunInputRequest) (*pb.EncryptedJobRunInputResponse, error) {
+	l := logging.ForTenant(h.l, req.GetProjectName(), "", req.GetJobName())
+	projectName, err := tenant.ProjectNameFrom(req.GetProjectName())
+	if err != nil {
+		l.Error("error adapting project name [%s]: %s", req.GetProjectName(), err)
+		return nil, errors.GRPCErr(err, "unable to get encrypted job run input for "+req.GetJobName())
+	}
+
+	jobName, err := scheduler.JobNameFrom(req.GetJobName())
+	if err != nil {
+		l.Error("error adapting job name [%s]: %s", req.GetJobName(), err)
+		return nil, errors.GRPCErr(err, "unable to get encrypted job run input for "+req.GetJobName())
+	}
+
+	executor, err := scheduler.ExecutorFromEnum(req.GetInstanceName(), req.GetInstanceType())
+	if err != nil {
+		l.Error("error adapting executor: %s", err)
+		return nil, errors.GRPCErr(err, "unable to get encrypted job run input for "+req.GetJobName())
+	}
+
+	if err := req.GetScheduledAt().CheckValid(); err != nil {
+		l.Error("invalid scheduled at: %s", err)
+		return nil, errors.GRPCErr(errors.InvalidArgument(scheduler.EntityJobRun, "invalid scheduled_at"),
+			"unable to get encrypted job run input for "+req.GetJobName())
+	}
+
+	runConfig, err := scheduler.RunConfigFrom(executor, req.GetScheduledAt().AsTime(), req.GetJobrunId())
+	if err != nil {
+		l.Error("error adapting run config: %s", err)
+		return nil, errors.GRPCErr(err, "unable to get encrypted job run input for "+req.GetJobName())
+	}
+
+	attempt, schedulerRunID := runAttemptFromContext(ctx)
+	runConfig, err = runConfig.WithAttempt(attempt)
+	if err != nil {
+		l.Error("error adapting run attempt: %s", err)
+		return nil, errors.GRPCErr(err, "unable to get encrypted job run input for "+req.GetJobName())
+	}
+	runConfig = runConfig.WithSchedulerRunID(schedulerRunID)
+
+	input, err := h.service.JobRunInput(ctx, projectName, jobName, runConfig)
+	if err != nil {
+		l.Error("error getting job run input of job [%s]: %s", jobName, err)
+		return nil, errors.GRPCErr(err, "unable to get encrypted job run input for "+req.GetJobName())
+	}
+
+	response := &pb.EncryptedJobRunInputResponse{
+		Envs:  input.Configs,
+		Files: input.Files,
+	}
+	if len(input.SecretFiles) > 0 || len(input.Secrets) > 0 {
+		encrypted, err := scheduler.EncryptInput(input.SecretFiles, input.Secrets, req.GetPublicKey())
+		if err != nil {
+			l.Error("error encrypting input of job [%s]: %s", jobName, err)
+			return nil, errors.GRPCErr(err, "unable to get encrypted job run input for "+req.GetJobName())
+		}
+		response.EncryptedKey = encrypted.Key
+		response.EncryptedFiles = encrypted.Files
+		response.EncryptedSecrets = encrypted.Secrets
+	}
+	return response, nil
+}
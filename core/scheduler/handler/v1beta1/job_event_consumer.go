@@ -0,0 +1,40 @@
+package v1beta1
+
+import (
+	"context"
+
+	"github.com/goto/salt/log"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+type JobEventRegisterer interface {
+	RegisterJobEvent(context.Context, *pb.RegisterJobEventRequest) (*pb.RegisterJobEventResponse, error)
+}
+
+// JobEventConsumer registers the job events published by the scheduler to a message queue, instead of sent
+// through the event endpoint. A message is the json of the request of the endpoint
+type JobEventConsumer struct {
+	l          log.Logger
+	registerer JobEventRegisterer
+}
+
+func (c JobEventConsumer) Consume(ctx context.Context, message []byte) error {
+	var req pb.RegisterJobEventRequest
+	if err := protojson.Unmarshal(message, &req); err != nil {
+		// a malformed message does not succeed on retry, hence it is dropped
+		c.l.Error("error decoding job event message, discarding: %s", err)
+		return nil
+	}
+
+	_, err := c.registerer.RegisterJobEvent(ctx, &req)
+	return err
+}
+
+func NewJobEventConsumer(l log.Logger, registerer JobEventRegisterer) *JobEventConsumer {
+	return &JobEventConsumer{
+		l:          l,
+		registerer: registerer,
+	}
+}
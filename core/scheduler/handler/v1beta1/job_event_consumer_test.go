@@ -0,0 +1,69 @@
+package v1beta1_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/goto/optimus/core/scheduler/handler/v1beta1"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+func TestJobEventConsumer(t *testing.T) {
+	logger := log.NewNoop()
+	ctx := context.Background()
+
+	t.Run("Consume", func(t *testing.T) {
+		t.Run("registers the event of the message", func(t *testing.T) {
+			message := `{"project_name": "proj", "namespace_name": "ns1", "job_name": "job1",
+				"event": {"type": "TYPE_JOB_SUCCESS", "value": {"scheduled_at": "2023-01-02T00:00:00Z", "attempt": 1}}}`
+
+			registerer := new(mockJobEventRegisterer)
+			registerer.On("RegisterJobEvent", ctx, mock.MatchedBy(func(req *pb.RegisterJobEventRequest) bool {
+				return req.GetProjectName() == "proj" && req.GetNamespaceName() == "ns1" && req.GetJobName() == "job1" &&
+					req.GetEvent().GetType() == pb.JobEvent_TYPE_JOB_SUCCESS &&
+					req.GetEvent().GetValue().AsMap()["scheduled_at"] == "2023-01-02T00:00:00Z"
+			})).Return(&pb.RegisterJobEventResponse{}, nil)
+			defer registerer.AssertExpectations(t)
+
+			consumer := v1beta1.NewJobEventConsumer(logger, registerer)
+			err := consumer.Consume(ctx, []byte(message))
+			assert.NoError(t, err)
+		})
+		t.Run("returns error when registering the event fails", func(t *testing.T) {
+			message := `{"project_name": "proj", "namespace_name": "ns1", "job_name": "job1", "event": {"type": "TYPE_JOB_SUCCESS"}}`
+
+			registerer := new(mockJobEventRegisterer)
+			registerer.On("RegisterJobEvent", ctx, mock.Anything).Return(nil, errors.New("db unavailable"))
+			defer registerer.AssertExpectations(t)
+
+			consumer := v1beta1.NewJobEventConsumer(logger, registerer)
+			err := consumer.Consume(ctx, []byte(message))
+			assert.EqualError(t, err, "db unavailable")
+		})
+		t.Run("discards a malformed message", func(t *testing.T) {
+			registerer := new(mockJobEventRegisterer)
+			defer registerer.AssertExpectations(t)
+
+			consumer := v1beta1.NewJobEventConsumer(logger, registerer)
+			err := consumer.Consume(ctx, []byte(`{"event": {"type": "TYPE_UNKNOWN_EVENT"}`))
+			assert.NoError(t, err)
+		})
+	})
+}
+
+type mockJobEventRegisterer struct {
+	mock.Mock
+}
+
+func (m *mockJobEventRegisterer) RegisterJobEvent(ctx context.Context, req *pb.RegisterJobEventRequest) (*pb.RegisterJobEventResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.RegisterJobEventResponse), args.Error(1)
+}
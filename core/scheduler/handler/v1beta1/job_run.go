@@ -2,74 +2,109 @@ package v1beta1
 
 import (
 	"context"
+	"sort"
 	"time"
 
 	"github.com/goto/salt/log"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/goto/optimus/core/scheduler"
 	"github.com/goto/optimus/core/tenant"
 	"github.com/goto/optimus/internal/errors"
 	"github.com/goto/optimus/internal/lib/window"
+	"github.com/goto/optimus/internal/logging"
 	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
 )
 
 type JobRunService interface {
 	JobRunInput(context.Context, tenant.ProjectName, scheduler.JobName, scheduler.RunConfig) (*scheduler.ExecutorInput, error)
+	CompileExecutorInputAt(ctx context.Context, projectName tenant.ProjectName, jobName scheduler.JobName, config scheduler.RunConfig, executedAt time.Time) (*scheduler.ExecutorInput, error)
 	UpdateJobState(context.Context, *scheduler.Event) error
 	GetJobRuns(ctx context.Context, projectName tenant.ProjectName, jobName scheduler.JobName, criteria *scheduler.JobRunsCriteria) ([]*scheduler.JobRunStatus, error)
 	UploadToScheduler(ctx context.Context, projectName tenant.ProjectName) error
+	GetUploadProgress(ctx context.Context, projectName tenant.ProjectName) (*scheduler.UploadProgress, error)
 	GetInterval(ctx context.Context, projectName tenant.ProjectName, jobName scheduler.JobName, referenceTime time.Time) (window.Interval, error)
+	GetSchedulerHealth(ctx context.Context, tnnt tenant.Tenant) (*scheduler.EnvironmentHealth, error)
+	EstimateRunStart(ctx context.Context, projectName tenant.ProjectName, jobName scheduler.JobName, scheduledAt time.Time) (*scheduler.RunStartEstimate, error)
+	Heartbeat(ctx context.Context, tnnt tenant.Tenant, jobName scheduler.JobName, scheduledAt time.Time) error
+	RecommendSchedule(ctx context.Context, projectName tenant.ProjectName, jobName scheduler.JobName, referenceTime time.Time) (*scheduler.ScheduleRecommendation, error)
+	GetJobRunDetail(ctx context.Context, projectName tenant.ProjectName, jobName scheduler.JobName, scheduledAt time.Time) (*scheduler.JobRunDetail, error)
+	QueryJobRuns(ctx context.Context, query *scheduler.JobRunQuery) (*scheduler.JobRunPage, error)
+	GetCriticalPath(ctx context.Context, projectName tenant.ProjectName, jobName scheduler.JobName, scheduledAt time.Time) (*scheduler.CriticalPath, error)
+	TriggerJobRun(ctx context.Context, trigger *scheduler.JobRunTrigger) error
 }
 
 type Notifier interface {
 	Push(ctx context.Context, event *scheduler.Event) error
 }
 
+type UpstreamAccessChecker interface {
+	IsAccessAllowed(ctx context.Context, downstreamProject tenant.ProjectName, downstreamJob scheduler.JobName, upstreamProject tenant.ProjectName, upstreamJob scheduler.JobName) (bool, error)
+}
+
 type JobRunHandler struct {
-	l        log.Logger
-	service  JobRunService
-	notifier Notifier
+	l              log.Logger
+	service        JobRunService
+	notifier       Notifier
+	upstreamAccess UpstreamAccessChecker
+	freshness      FreshnessEvaluator
 
 	pb.UnimplementedJobRunServiceServer
 }
 
 func (h JobRunHandler) JobRunInput(ctx context.Context, req *pb.JobRunInputRequest) (*pb.JobRunInputResponse, error) {
+	l := logging.ForTenant(h.l, req.GetProjectName(), "", req.GetJobName())
 	projectName, err := tenant.ProjectNameFrom(req.GetProjectName())
 	if err != nil {
-		h.l.Error("error adapting project name [%s]: %s", req.GetProjectName(), err)
+		l.Error("error adapting project name [%s]: %s", req.GetProjectName(), err)
 		return nil, errors.GRPCErr(err, "unable to get job run input for "+req.GetJobName())
 	}
 
 	jobName, err := scheduler.JobNameFrom(req.GetJobName())
 	if err != nil {
-		h.l.Error("error adapting job name [%s]: %s", req.GetJobName(), err)
+		l.Error("error adapting job name [%s]: %s", req.GetJobName(), err)
 		return nil, errors.GRPCErr(err, "unable to get job run input for "+req.GetJobName())
 	}
 
 	executor, err := scheduler.ExecutorFromEnum(req.InstanceName, req.InstanceType.String())
 	if err != nil {
-		h.l.Error("error adapting executor: %s", err)
+		l.Error("error adapting executor: %s", err)
 		return nil, errors.GRPCErr(err, "unable to get job run input for "+req.GetJobName())
 	}
 
 	err = req.ScheduledAt.CheckValid()
 	if err != nil {
-		h.l.Error("invalid scheduled at: %s", err)
+		l.Error("invalid scheduled at: %s", err)
 		return nil, errors.GRPCErr(errors.InvalidArgument(scheduler.EntityJobRun, "invalid scheduled_at"), "unable to get job run input for "+req.GetJobName())
 	}
 
 	runConfig, err := scheduler.RunConfigFrom(executor, req.ScheduledAt.AsTime(), req.JobrunId)
 	if err != nil {
-		h.l.Error("error adapting run config: %s", err)
+		l.Error("error adapting run config: %s", err)
+		return nil, errors.GRPCErr(err, "unable to get job run input for "+req.GetJobName())
+	}
+
+	attempt, schedulerRunID := runAttemptFromContext(ctx)
+	runConfig, err = runConfig.WithAttempt(attempt)
+	if err != nil {
+		l.Error("error adapting run attempt: %s", err)
 		return nil, errors.GRPCErr(err, "unable to get job run input for "+req.GetJobName())
 	}
+	runConfig = runConfig.WithSchedulerRunID(schedulerRunID)
 
 	input, err := h.service.JobRunInput(ctx, projectName, jobName, runConfig)
 	if err != nil {
-		h.l.Error("error getting job run input: %s", err)
+		l.Error("error getting job run input: %s", err)
 		return nil, errors.GRPCErr(err, "unable to get job run input for "+req.GetJobName())
 	}
+	if len(input.SecretFiles) > 0 {
+		l.Error("job run input of job [%s] has files with secrets", jobName)
+		failedPrecondErr := errors.NewError(errors.ErrFailedPrecond, scheduler.EntityJobRun,
+			"job run input has files with secrets, fetch it encrypted through EncryptedJobRunInput")
+		return nil, errors.GRPCErr(failedPrecondErr, "unable to get job run input for "+req.GetJobName())
+	}
 
 	return &pb.JobRunInputResponse{
 		Envs:    input.Configs,
@@ -78,33 +113,105 @@ func (h JobRunHandler) JobRunInput(ctx context.Context, req *pb.JobRunInputReque
 	}, nil
 }
 
+// CompileExecutorInputAt compiles the executor input of a job run for any scheduled and executed time, to see
+// the configs, secrets and assets a past run got without running the job again
+func (h JobRunHandler) CompileExecutorInputAt(ctx context.Context, req *pb.CompileExecutorInputAtRequest) (*pb.CompileExecutorInputAtResponse, error) {
+	l := logging.ForTenant(h.l, req.GetProjectName(), "", req.GetJobName())
+	projectName, err := tenant.ProjectNameFrom(req.GetProjectName())
+	if err != nil {
+		l.Error("error adapting project name [%s]: %s", req.GetProjectName(), err)
+		return nil, errors.GRPCErr(err, "unable to compile executor input for "+req.GetJobName())
+	}
+
+	jobName, err := scheduler.JobNameFrom(req.GetJobName())
+	if err != nil {
+		l.Error("error adapting job name [%s]: %s", req.GetJobName(), err)
+		return nil, errors.GRPCErr(err, "unable to compile executor input for "+req.GetJobName())
+	}
+
+	executor, err := scheduler.ExecutorFromEnum(req.GetInstanceName(), req.GetInstanceType().String())
+	if err != nil {
+		l.Error("error adapting executor: %s", err)
+		return nil, errors.GRPCErr(err, "unable to compile executor input for "+req.GetJobName())
+	}
+
+	if err := req.GetScheduledAt().CheckValid(); err != nil {
+		l.Error("invalid scheduled at: %s", err)
+		return nil, errors.GRPCErr(errors.InvalidArgument(scheduler.EntityJobRun, "invalid scheduled_at"), "unable to compile executor input for "+req.GetJobName())
+	}
+
+	var executedAt time.Time
+	if req.GetExecutedAt() != nil {
+		if err := req.GetExecutedAt().CheckValid(); err != nil {
+			l.Error("invalid executed at: %s", err)
+			return nil, errors.GRPCErr(errors.InvalidArgument(scheduler.EntityJobRun, "invalid executed_at"), "unable to compile executor input for "+req.GetJobName())
+		}
+		executedAt = req.GetExecutedAt().AsTime()
+	}
+
+	runConfig, err := scheduler.RunConfigFrom(executor, req.GetScheduledAt().AsTime(), "")
+	if err != nil {
+		l.Error("error adapting run config: %s", err)
+		return nil, errors.GRPCErr(err, "unable to compile executor input for "+req.GetJobName())
+	}
+
+	input, err := h.service.CompileExecutorInputAt(ctx, projectName, jobName, runConfig, executedAt)
+	if err != nil {
+		l.Error("error compiling executor input of job [%s]: %s", jobName, err)
+		return nil, errors.GRPCErr(err, "unable to compile executor input for "+req.GetJobName())
+	}
+
+	secretFiles := make([]string, 0, len(input.SecretFiles))
+	for name := range input.SecretFiles {
+		secretFiles = append(secretFiles, name)
+	}
+	sort.Strings(secretFiles)
+
+	return &pb.CompileExecutorInputAtResponse{
+		Envs:        input.Configs,
+		Files:       input.Files,
+		Secrets:     input.Secrets,
+		SecretFiles: secretFiles,
+	}, nil
+}
+
 // JobRun currently gets the job runs from scheduler based on the criteria
 // TODO: later should collect the job runs from optimus
 func (h JobRunHandler) JobRun(ctx context.Context, req *pb.JobRunRequest) (*pb.JobRunResponse, error) {
+	l := logging.ForTenant(h.l, req.GetProjectName(), "", req.GetJobName())
 	projectName, err := tenant.ProjectNameFrom(req.GetProjectName())
 	if err != nil {
-		h.l.Error("error adapting project name [%s]: %s", req.GetProjectName(), err)
+		l.Error("error adapting project name [%s]: %s", req.GetProjectName(), err)
 		return nil, errors.GRPCErr(err, "unable to get job run for "+req.GetJobName())
 	}
 
 	jobName, err := scheduler.JobNameFrom(req.GetJobName())
 	if err != nil {
-		h.l.Error("error adapting job name [%s]: %s", req.GetJobName(), err)
+		l.Error("error adapting job name [%s]: %s", req.GetJobName(), err)
 		return nil, errors.GRPCErr(err, "unable to get job run for "+req.GetJobName())
 	}
 
 	criteria, err := buildCriteriaForJobRun(req)
 	if err != nil {
-		h.l.Error("error building job run criteria: %s", err)
+		l.Error("error building job run criteria: %s", err)
 		return nil, errors.GRPCErr(err, "unable to get job run for "+req.GetJobName())
 	}
 
 	var jobRuns []*scheduler.JobRunStatus
 	jobRuns, err = h.service.GetJobRuns(ctx, projectName, jobName, criteria)
 	if err != nil {
-		h.l.Error("error getting job runs: %s", err)
+		l.Error("error getting job runs: %s", err)
+		return nil, errors.GRPCErr(err, "unable to get job run for "+req.GetJobName())
+	}
+
+	accessAllowed, err := h.isUpstreamAccessAllowed(ctx, req, projectName, jobName)
+	if err != nil {
+		l.Error("error checking upstream access: %s", err)
 		return nil, errors.GRPCErr(err, "unable to get job run for "+req.GetJobName())
 	}
+	if !accessAllowed {
+		jobRuns = holdJobRuns(jobRuns, criteria)
+	}
 
 	var runs []*pb.JobRun
 	for _, run := range jobRuns {
@@ -117,6 +224,42 @@ func (h JobRunHandler) JobRun(ctx context.Context, req *pb.JobRunRequest) (*pb.J
 	return &pb.JobRunResponse{JobRuns: runs}, nil
 }
 
+// isUpstreamAccessAllowed checks the access of the downstream job requesting the runs, if any
+func (h JobRunHandler) isUpstreamAccessAllowed(ctx context.Context, req *pb.JobRunRequest, projectName tenant.ProjectName, jobName scheduler.JobName) (bool, error) {
+	if h.upstreamAccess == nil || req.GetDownstreamProjectName() == "" {
+		return true, nil
+	}
+
+	downstreamProject, err := tenant.ProjectNameFrom(req.GetDownstreamProjectName())
+	if err != nil {
+		return false, err
+	}
+	downstreamJob, err := scheduler.JobNameFrom(req.GetDownstreamJobName())
+	if err != nil {
+		return false, err
+	}
+	return h.upstreamAccess.IsAccessAllowed(ctx, downstreamProject, downstreamJob, projectName, jobName)
+}
+
+// holdJobRuns marks the runs as pending approval, so that the sensor of the downstream job keeps waiting
+func holdJobRuns(jobRuns []*scheduler.JobRunStatus, criteria *scheduler.JobRunsCriteria) []*scheduler.JobRunStatus {
+	if len(jobRuns) == 0 {
+		return []*scheduler.JobRunStatus{{
+			ScheduledAt: criteria.StartDate,
+			State:       scheduler.State(scheduler.UpstreamAccessStatePending),
+		}}
+	}
+
+	heldRuns := make([]*scheduler.JobRunStatus, len(jobRuns))
+	for i, run := range jobRuns {
+		heldRuns[i] = &scheduler.JobRunStatus{
+			ScheduledAt: run.ScheduledAt,
+			State:       scheduler.State(scheduler.UpstreamAccessStatePending),
+		}
+	}
+	return heldRuns
+}
+
 func buildCriteriaForJobRun(req *pb.JobRunRequest) (*scheduler.JobRunsCriteria, error) {
 	if !req.GetStartDate().IsValid() && !req.GetEndDate().IsValid() {
 		return &scheduler.JobRunsCriteria{
@@ -138,21 +281,92 @@ func buildCriteriaForJobRun(req *pb.JobRunRequest) (*scheduler.JobRunsCriteria,
 	}, nil
 }
 
-func (h JobRunHandler) UploadToScheduler(_ context.Context, req *pb.UploadToSchedulerRequest) (*pb.UploadToSchedulerResponse, error) {
+func (h JobRunHandler) UploadToScheduler(ctx context.Context, req *pb.UploadToSchedulerRequest) (*pb.UploadToSchedulerResponse, error) {
+	l := logging.ForTenant(h.l, req.GetProjectName(), "", "")
 	projectName, err := tenant.ProjectNameFrom(req.GetProjectName())
 	if err != nil {
-		h.l.Error("error adapting project name [%s]: %s", req.GetProjectName(), err)
+		l.Error("error adapting project name [%s]: %s", req.GetProjectName(), err)
 		return nil, errors.GRPCErr(err, "unable to get projectName")
 	}
+	if progress, err := h.service.GetUploadProgress(ctx, projectName); err == nil && progress.IsInProgress() {
+		l.Warn("upload to scheduler of project [%s] is still in progress", projectName)
+		err := errors.NewError(errors.ErrFailedPrecond, scheduler.EntityUpload, "upload of project "+projectName.String()+" is still in progress")
+		return nil, errors.GRPCErr(err, "unable to upload to scheduler")
+	}
 	go func() {
 		err = h.service.UploadToScheduler(context.Background(), projectName)
 		if err != nil {
-			h.l.Error("Finished upload to scheduler with error: %s", err)
+			l.Error("Finished upload to scheduler with error: %s", err)
 		}
 	}()
 	return &pb.UploadToSchedulerResponse{}, nil
 }
 
+// GetUploadProgress returns the progress of the latest upload of the project to the scheduler
+func (h JobRunHandler) GetUploadProgress(ctx context.Context, req *pb.GetUploadProgressRequest) (*pb.GetUploadProgressResponse, error) {
+	l := logging.ForTenant(h.l, req.GetProjectName(), "", "")
+	projectName, err := tenant.ProjectNameFrom(req.GetProjectName())
+	if err != nil {
+		l.Error("error adapting project name [%s]: %s", req.GetProjectName(), err)
+		return nil, errors.GRPCErr(err, "unable to get upload progress of "+req.GetProjectName())
+	}
+
+	progress, err := h.service.GetUploadProgress(ctx, projectName)
+	if err != nil {
+		l.Error("error getting upload progress of project [%s]: %s", projectName, err)
+		return nil, errors.GRPCErr(err, "unable to get upload progress of "+req.GetProjectName())
+	}
+
+	namespaces := make([]string, len(progress.CompletedNamespaces))
+	for i, namespaceName := range progress.CompletedNamespaces {
+		namespaces[i] = namespaceName.String()
+	}
+	response := &pb.GetUploadProgressResponse{
+		ProjectName:         progress.ProjectName.String(),
+		Status:              progress.State.String(),
+		TotalJobs:           int32(progress.TotalJobs),
+		UploadedJobs:        int32(progress.UploadedJobs),
+		FailedJobs:          int32(progress.FailedJobs),
+		CompletedNamespaces: namespaces,
+		Message:             progress.Message,
+		StartedAt:           timestamppb.New(progress.StartedAt),
+	}
+	if !progress.FinishedAt.IsZero() {
+		response.FinishedAt = timestamppb.New(progress.FinishedAt)
+	}
+	return response, nil
+}
+
+// JobRunHeartbeat records that the executor of the job run is still alive
+func (h JobRunHandler) JobRunHeartbeat(ctx context.Context, req *pb.JobRunHeartbeatRequest) (*pb.JobRunHeartbeatResponse, error) {
+	tnnt, err := tenant.NewTenant(req.GetProjectName(), req.GetNamespaceName())
+	if err != nil {
+		h.l.Error("invalid tenant information request project [%s] namespace [%s]: %s", req.GetProjectName(), req.GetNamespaceName(), err)
+		return nil, errors.GRPCErr(err, "unable to record heartbeat of "+req.GetJobName())
+	}
+
+	l := h.tenantLogger(tnnt)
+
+	jobName, err := scheduler.JobNameFrom(req.GetJobName())
+	if err != nil {
+		l.Error("error adapting job name [%s]: %s", req.GetJobName(), err)
+		return nil, errors.GRPCErr(err, "unable to record heartbeat of "+req.GetJobName())
+	}
+
+	if err := req.GetScheduledAt().CheckValid(); err != nil {
+		l.Error("invalid scheduled at of job [%s]: %s", jobName, err)
+		return nil, errors.GRPCErr(errors.InvalidArgument(scheduler.EntityJobRun, "invalid scheduled_at"),
+			"unable to record heartbeat of "+req.GetJobName())
+	}
+	scheduledAt := req.GetScheduledAt().AsTime()
+
+	if err := h.service.Heartbeat(ctx, tnnt, jobName, scheduledAt); err != nil {
+		l.Error("error recording heartbeat of job [%s] scheduled at [%s]: %s", jobName, scheduledAt, err)
+		return nil, errors.GRPCErr(err, "unable to record heartbeat of "+req.GetJobName())
+	}
+	return &pb.JobRunHeartbeatResponse{}, nil
+}
+
 // RegisterJobEvent TODO: check in jaeger if this api takes time, then we can make this async
 func (h JobRunHandler) RegisterJobEvent(ctx context.Context, req *pb.RegisterJobEventRequest) (*pb.RegisterJobEventResponse, error) {
 	tnnt, err := tenant.NewTenant(req.GetProjectName(), req.GetNamespaceName())
@@ -161,22 +375,24 @@ func (h JobRunHandler) RegisterJobEvent(ctx context.Context, req *pb.RegisterJob
 		return nil, errors.GRPCErr(err, "unable to get tenant")
 	}
 
+	l := h.tenantLogger(tnnt)
+
 	jobName, err := scheduler.JobNameFrom(req.GetJobName())
 	if err != nil {
-		h.l.Error("error adapting job name [%s]: %s", jobName, err)
+		l.Error("error adapting job name [%s]: %s", jobName, err)
 		return nil, errors.GRPCErr(err, "unable to get job name"+req.GetJobName())
 	}
 
 	event, err := scheduler.EventFrom(req.GetEvent().Type.String(), req.GetEvent().Value.AsMap(), jobName, tnnt)
 	if err != nil {
-		h.l.Error("error adapting event: %s", err)
+		l.Error("error adapting event: %s", err)
 		return nil, errors.GRPCErr(err, "unable to parse event")
 	}
 	me := errors.NewMultiError("errors in RegisterJobEvent")
 
 	err = h.service.UpdateJobState(ctx, event)
 	if err != nil {
-		h.l.Error("error updating job run state for Job: %s, Project: %s, eventType: %s, schedule_at: %s, err: %s", jobName, tnnt.ProjectName(), event.Type, event.JobScheduledAt.String(), err.Error())
+		l.Error("error updating job run state for Job: %s, Project: %s, eventType: %s, schedule_at: %s, err: %s", jobName, tnnt.ProjectName(), event.Type, event.JobScheduledAt.String(), err.Error())
 		me.Append(errors.AddErrContext(err, scheduler.EntityJobRun, "scheduler could not update job run state"))
 	}
 
@@ -188,26 +404,27 @@ func (h JobRunHandler) RegisterJobEvent(ctx context.Context, req *pb.RegisterJob
 
 // GetInterval gets interval on specific job given reference time.
 func (h JobRunHandler) GetInterval(ctx context.Context, req *pb.GetIntervalRequest) (*pb.GetIntervalResponse, error) {
+	l := logging.ForTenant(h.l, req.GetProjectName(), "", req.GetJobName())
 	projectName, err := tenant.ProjectNameFrom(req.GetProjectName())
 	if err != nil {
-		h.l.Error("error adapting project name [%s]: %v", req.GetProjectName(), err)
+		l.Error("error adapting project name [%s]: %v", req.GetProjectName(), err)
 		return nil, errors.GRPCErr(err, "unable to adapt project name")
 	}
 
 	jobName, err := scheduler.JobNameFrom(req.GetJobName())
 	if err != nil {
-		h.l.Error("error adapting job name [%s]: %v", req.GetJobName(), err)
+		l.Error("error adapting job name [%s]: %v", req.GetJobName(), err)
 		return nil, errors.GRPCErr(err, "unable to adapt job name")
 	}
 
 	if err := req.ReferenceTime.CheckValid(); err != nil {
-		h.l.Error("invalid reference time for interval at: %s", err)
+		l.Error("invalid reference time for interval at: %s", err)
 		return nil, errors.GRPCErr(errors.InvalidArgument(scheduler.EntityJobRun, "invalid reference time"), "unable to get interval for "+jobName.String())
 	}
 
 	interval, err := h.service.GetInterval(ctx, projectName, jobName, req.ReferenceTime.AsTime())
 	if err != nil {
-		h.l.Error("error getting interval for job [%s] under project [%s]: %v", jobName, projectName, err)
+		l.Error("error getting interval for job [%s] under project [%s]: %v", jobName, projectName, err)
 		return nil, errors.GRPCErr(err, "error getting interval for job "+jobName.String())
 	}
 
@@ -217,10 +434,221 @@ func (h JobRunHandler) GetInterval(ctx context.Context, req *pb.GetIntervalReque
 	}, nil
 }
 
-func NewJobRunHandler(l log.Logger, service JobRunService, notifier Notifier) *JobRunHandler {
+// GetSchedulerHealth returns the health of the scheduler environment serving the namespace.
+func (h JobRunHandler) GetSchedulerHealth(ctx context.Context, req *pb.GetSchedulerHealthRequest) (*pb.GetSchedulerHealthResponse, error) {
+	tnnt, err := tenant.NewTenant(req.GetProjectName(), req.GetNamespaceName())
+	if err != nil {
+		h.l.Error("error adapting tenant [%s/%s]: %s", req.GetProjectName(), req.GetNamespaceName(), err)
+		return nil, errors.GRPCErr(err, "unable to adapt tenant")
+	}
+
+	l := h.tenantLogger(tnnt)
+
+	health, err := h.service.GetSchedulerHealth(ctx, tnnt)
+	if err != nil {
+		l.Error("error getting scheduler health for namespace [%s] under project [%s]: %s", tnnt.NamespaceName(), tnnt.ProjectName(), err)
+		return nil, errors.GRPCErr(err, "unable to get scheduler health for "+tnnt.NamespaceName().String())
+	}
+
+	response := &pb.GetSchedulerHealthResponse{
+		SchedulerType:       health.Type,
+		Healthy:             health.IsHealthy(),
+		MetadatabaseHealthy: health.MetadatabaseHealthy,
+		SchedulerHealthy:    health.SchedulerHealthy,
+	}
+	if !health.LatestSchedulerHeartbeat.IsZero() {
+		response.LatestSchedulerHeartbeat = timestamppb.New(health.LatestSchedulerHeartbeat)
+	}
+	return response, nil
+}
+
+// GetJobRunDetail returns the timeline of a job run, the duration of the run and its operators which have not ended
+// is the time they have been running for
+func (h JobRunHandler) GetJobRunDetail(ctx context.Context, req *pb.GetJobRunDetailRequest) (*pb.GetJobRunDetailResponse, error) {
+	l := logging.ForTenant(h.l, req.GetProjectName(), "", req.GetJobName())
+	projectName, err := tenant.ProjectNameFrom(req.GetProjectName())
+	if err != nil {
+		l.Error("error adapting project name [%s]: %s", req.GetProjectName(), err)
+		return nil, errors.GRPCErr(err, "unable to get job run detail for "+req.GetJobName())
+	}
+
+	jobName, err := scheduler.JobNameFrom(req.GetJobName())
+	if err != nil {
+		l.Error("error adapting job name [%s]: %s", req.GetJobName(), err)
+		return nil, errors.GRPCErr(err, "unable to get job run detail for "+req.GetJobName())
+	}
+
+	if err := req.GetScheduledAt().CheckValid(); err != nil {
+		l.Error("invalid scheduled at: %s", err)
+		return nil, errors.GRPCErr(errors.InvalidArgument(scheduler.EntityJobRun, "invalid scheduled_at"), "unable to get job run detail for "+req.GetJobName())
+	}
+
+	detail, err := h.service.GetJobRunDetail(ctx, projectName, jobName, req.GetScheduledAt().AsTime())
+	if err != nil {
+		l.Error("error getting detail of job run [%s] scheduled at [%s]: %s", jobName, req.GetScheduledAt().AsTime(), err)
+		return nil, errors.GRPCErr(err, "unable to get job run detail for "+req.GetJobName())
+	}
+
+	now := time.Now().UTC()
+	jobRun := detail.JobRun
+	response := &pb.GetJobRunDetailResponse{
+		JobName:     jobRun.JobName.String(),
+		ScheduledAt: timestamppb.New(jobRun.ScheduledAt),
+		State:       jobRun.State.String(),
+		StartTime:   timestamppb.New(jobRun.StartTime),
+		Duration:    durationpb.New(now.Sub(jobRun.StartTime)),
+		Operators:   make([]*pb.GetJobRunDetailResponse_OperatorRun, len(detail.Operators)),
+	}
+	if jobRun.EndTime != nil {
+		response.EndTime = timestamppb.New(*jobRun.EndTime)
+		response.Duration = durationpb.New(jobRun.EndTime.Sub(jobRun.StartTime))
+	}
+	for i, operator := range detail.Operators {
+		response.Operators[i] = &pb.GetJobRunDetailResponse_OperatorRun{
+			Name:      operator.Name,
+			Type:      operator.OperatorType.String(),
+			State:     operator.Status.String(),
+			StartTime: timestamppb.New(operator.StartTime),
+			Duration:  durationpb.New(operator.Duration(now)),
+		}
+		if operator.EndTime != nil {
+			response.Operators[i].EndTime = timestamppb.New(*operator.EndTime)
+		}
+	}
+	return response, nil
+}
+
+func (h JobRunHandler) QueryJobRuns(ctx context.Context, req *pb.QueryJobRunsRequest) (*pb.QueryJobRunsResponse, error) {
+	query, err := fromQueryJobRunsRequest(req)
+	if err != nil {
+		h.l.Error("error adapting job run query: %s", err)
+		return nil, errors.GRPCErr(err, "unable to query job runs of "+req.GetProjectName())
+	}
+
+	page, err := h.service.QueryJobRuns(ctx, query)
+	if err != nil {
+		h.l.Error("error querying job runs of project [%s]: %s", query.ProjectName, err)
+		return nil, errors.GRPCErr(err, "unable to query job runs of "+req.GetProjectName())
+	}
+
+	runs := make([]*pb.QueryJobRunsResponse_JobRun, len(page.Runs))
+	for i, run := range page.Runs {
+		runs[i] = &pb.QueryJobRunsResponse_JobRun{
+			NamespaceName: run.Tenant.NamespaceName().String(),
+			JobName:       run.JobName.String(),
+			ScheduledAt:   timestamppb.New(run.ScheduledAt),
+			State:         run.State.String(),
+			StartTime:     timestamppb.New(run.StartTime),
+			SlaMissed:     run.SLAAlert,
+		}
+		if run.EndTime != nil {
+			runs[i].EndTime = timestamppb.New(*run.EndTime)
+		}
+	}
+	return &pb.QueryJobRunsResponse{
+		Runs:       runs,
+		NextOffset: int32(page.NextOffset),
+	}, nil
+}
+
+func fromQueryJobRunsRequest(req *pb.QueryJobRunsRequest) (*scheduler.JobRunQuery, error) {
+	projectName, err := tenant.ProjectNameFrom(req.GetProjectName())
+	if err != nil {
+		return nil, err
+	}
+	query := &scheduler.JobRunQuery{
+		ProjectName:    projectName,
+		NamespaceName:  tenant.NamespaceName(req.GetNamespaceName()),
+		JobNamePattern: req.GetJobName(),
+		Descending:     req.GetDescending(),
+		Offset:         int(req.GetOffset()),
+		PageSize:       int(req.GetPageSize()),
+	}
+
+	for _, rawState := range req.GetStates() {
+		state, err := scheduler.StateFromString(rawState)
+		if err != nil {
+			return nil, err
+		}
+		query.States = append(query.States, state)
+	}
+	if req.GetScheduledFrom() != nil {
+		query.ScheduledFrom = req.GetScheduledFrom().AsTime()
+	}
+	if req.GetScheduledTo() != nil {
+		query.ScheduledTo = req.GetScheduledTo().AsTime()
+	}
+	if query.SortBy, err = scheduler.JobRunSortFieldFrom(req.GetSortBy()); err != nil {
+		return nil, err
+	}
+	return query, nil
+}
+
+// TriggerJobRun creates a manual run of a job for the scheduled time, with configs overriding its task configs
+func (h JobRunHandler) TriggerJobRun(ctx context.Context, req *pb.TriggerJobRunRequest) (*pb.TriggerJobRunResponse, error) {
+	tnnt, err := tenant.NewTenant(req.GetProjectName(), req.GetNamespaceName())
+	if err != nil {
+		h.l.Error("error adapting tenant [%s/%s]: %s", req.GetProjectName(), req.GetNamespaceName(), err)
+		return nil, errors.GRPCErr(err, "unable to trigger run of "+req.GetJobName())
+	}
+
+	l := h.tenantLogger(tnnt)
+
+	jobName, err := scheduler.JobNameFrom(req.GetJobName())
+	if err != nil {
+		l.Error("error adapting job name [%s]: %s", req.GetJobName(), err)
+		return nil, errors.GRPCErr(err, "unable to trigger run of "+req.GetJobName())
+	}
+
+	if err := req.GetScheduledAt().CheckValid(); err != nil {
+		l.Error("invalid scheduled at: %s", err)
+		return nil, errors.GRPCErr(errors.InvalidArgument(scheduler.EntityJobRun, "invalid scheduled_at"), "unable to trigger run of "+req.GetJobName())
+	}
+
+	trigger, err := scheduler.NewJobRunTrigger(tnnt, jobName, req.GetScheduledAt().AsTime(), req.GetConfigOverrides())
+	if err != nil {
+		l.Error("error adapting job run trigger: %s", err)
+		return nil, errors.GRPCErr(err, "unable to trigger run of "+req.GetJobName())
+	}
+
+	if err := h.service.TriggerJobRun(ctx, trigger); err != nil {
+		l.Error("error triggering run of job [%s] scheduled at [%s]: %s", jobName, trigger.ScheduledAt, err)
+		return nil, errors.GRPCErr(err, "unable to trigger run of "+req.GetJobName())
+	}
+	return &pb.TriggerJobRunResponse{
+		JobName:     jobName.String(),
+		ScheduledAt: timestamppb.New(trigger.ScheduledAt),
+	}, nil
+}
+
+// tenantLogger attaches the tenant fields to the lines logged for the request
+func (h JobRunHandler) tenantLogger(tnnt tenant.Tenant) log.Logger {
+	return logging.ForTenant(h.l, tnnt.ProjectName().String(), tnnt.NamespaceName().String(), "")
+}
+
+func NewJobRunHandler(l log.Logger, service JobRunService, notifier Notifier, upstreamAccess UpstreamAccessChecker,
+	freshness FreshnessEvaluator,
+) *JobRunHandler {
 	return &JobRunHandler{
-		l:        l,
-		service:  service,
-		notifier: notifier,
+		l:              l,
+		service:        service,
+		notifier:       notifier,
+		upstreamAccess: upstreamAccess,
+		freshness:      freshness,
+	}
+}
+
+// runAttemptFromContext reads the attempt and the run id in the scheduler from the incoming request metadata
+func runAttemptFromContext(ctx context.Context) (attempt, schedulerRunID string) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", ""
+	}
+	if values := md.Get(scheduler.JobRunAttemptMetadataKey); len(values) > 0 {
+		attempt = values[0]
+	}
+	if values := md.Get(scheduler.SchedulerRunIDMetadataKey); len(values) > 0 {
+		schedulerRunID = values[0]
 	}
+	return attempt, schedulerRunID
 }
@@ -2,6 +2,10 @@ package v1beta1_test
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"testing"
@@ -10,6 +14,8 @@ import (
 	"github.com/goto/salt/log"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -33,7 +39,7 @@ func TestJobRunHandler(t *testing.T) {
 	t.Run("JobRunInput", func(t *testing.T) {
 		t.Run("returns error when project name is invalid", func(t *testing.T) {
 			service := new(mockJobRunService)
-			handler := v1beta1.NewJobRunHandler(logger, service, nil)
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
 
 			inputRequest := pb.JobRunInputRequest{
 				ProjectName:  "",
@@ -51,7 +57,7 @@ func TestJobRunHandler(t *testing.T) {
 		})
 		t.Run("returns error when job name is invalid", func(t *testing.T) {
 			service := new(mockJobRunService)
-			handler := v1beta1.NewJobRunHandler(logger, service, nil)
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
 
 			inputRequest := pb.JobRunInputRequest{
 				ProjectName:  "proj",
@@ -69,7 +75,7 @@ func TestJobRunHandler(t *testing.T) {
 		})
 		t.Run("returns error when executor is invalid", func(t *testing.T) {
 			service := new(mockJobRunService)
-			handler := v1beta1.NewJobRunHandler(logger, service, nil)
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
 
 			inputRequest := pb.JobRunInputRequest{
 				ProjectName:  "proj",
@@ -87,7 +93,7 @@ func TestJobRunHandler(t *testing.T) {
 		})
 		t.Run("returns error when scheduled_at is invalid", func(t *testing.T) {
 			service := new(mockJobRunService)
-			handler := v1beta1.NewJobRunHandler(logger, service, nil)
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
 
 			inputRequest := pb.JobRunInputRequest{
 				ProjectName:  "proj",
@@ -104,7 +110,7 @@ func TestJobRunHandler(t *testing.T) {
 		})
 		t.Run("returns error when run config is invalid", func(t *testing.T) {
 			service := new(mockJobRunService)
-			handler := v1beta1.NewJobRunHandler(logger, service, nil)
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
 
 			inputRequest := pb.JobRunInputRequest{
 				ProjectName:  "proj",
@@ -120,13 +126,59 @@ func TestJobRunHandler(t *testing.T) {
 			assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid argument for entity "+
 				"jobRun: invalid job run ID 1234: unable to get job run input for job1")
 		})
+		t.Run("returns error when run attempt is invalid", func(t *testing.T) {
+			service := new(mockJobRunService)
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
+
+			inputRequest := pb.JobRunInputRequest{
+				ProjectName:  "proj",
+				JobName:      "job1",
+				ScheduledAt:  timestamppb.Now(),
+				InstanceName: "bq2bq",
+				InstanceType: pb.InstanceSpec_TYPE_TASK,
+			}
+			attemptCtx := metadata.NewIncomingContext(ctx, metadata.Pairs(scheduler.JobRunAttemptMetadataKey, "first"))
+
+			_, err := handler.JobRunInput(attemptCtx, &inputRequest)
+			assert.NotNil(t, err)
+			assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid argument for entity "+
+				"jobRun: invalid job run attempt first: unable to get job run input for job1")
+		})
+		t.Run("passes the run attempt and scheduler run id to the service", func(t *testing.T) {
+			attemptCtx := metadata.NewIncomingContext(ctx, metadata.Pairs(
+				scheduler.JobRunAttemptMetadataKey, "2",
+				scheduler.SchedulerRunIDMetadataKey, "replayed__2023-01-02T03:00:00+00:00",
+			))
+
+			service := new(mockJobRunService)
+			service.On("JobRunInput", attemptCtx, tenant.ProjectName("proj"), scheduler.JobName("job1"),
+				mock.MatchedBy(func(config scheduler.RunConfig) bool {
+					return config.Attempt == 2 && config.SchedulerRunID == "replayed__2023-01-02T03:00:00+00:00"
+				})).
+				Return(&scheduler.ExecutorInput{Configs: map[string]string{"JOB_RUN_ATTEMPT": "2"}}, nil)
+			defer service.AssertExpectations(t)
+
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
+
+			inputRequest := pb.JobRunInputRequest{
+				ProjectName:  "proj",
+				JobName:      "job1",
+				ScheduledAt:  timestamppb.Now(),
+				InstanceName: "bq2bq",
+				InstanceType: pb.InstanceSpec_TYPE_TASK,
+			}
+
+			input, err := handler.JobRunInput(attemptCtx, &inputRequest)
+			assert.Nil(t, err)
+			assert.Equal(t, "2", input.Envs["JOB_RUN_ATTEMPT"])
+		})
 		t.Run("returns error when service returns error", func(t *testing.T) {
 			service := new(mockJobRunService)
 			service.On("JobRunInput", ctx, tenant.ProjectName("proj"), scheduler.JobName("job1"), mock.Anything).
 				Return(&scheduler.ExecutorInput{}, fmt.Errorf("error in service"))
 			defer service.AssertExpectations(t)
 
-			handler := v1beta1.NewJobRunHandler(logger, service, nil)
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
 
 			inputRequest := pb.JobRunInputRequest{
 				ProjectName:  "proj",
@@ -142,6 +194,31 @@ func TestJobRunHandler(t *testing.T) {
 			assert.EqualError(t, err, "rpc error: code = Internal desc = error in service: unable to get job "+
 				"run input for job1")
 		})
+		t.Run("returns error when job run input has files with secrets", func(t *testing.T) {
+			service := new(mockJobRunService)
+			service.On("JobRunInput", ctx, tenant.ProjectName("proj"), scheduler.JobName("job1"), mock.Anything).
+				Return(&scheduler.ExecutorInput{
+					Configs:     map[string]string{"a": "b"},
+					SecretFiles: map[string]string{"query.sql": "select 'secret_value'"},
+				}, nil)
+			defer service.AssertExpectations(t)
+
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
+
+			inputRequest := pb.JobRunInputRequest{
+				ProjectName:  "proj",
+				JobName:      "job1",
+				ScheduledAt:  timestamppb.Now(),
+				InstanceName: "bq2bq",
+				InstanceType: pb.InstanceSpec_TYPE_TASK,
+			}
+
+			_, err := handler.JobRunInput(ctx, &inputRequest)
+			assert.NotNil(t, err)
+			assert.EqualError(t, err, "rpc error: code = FailedPrecondition desc = failed precondition for entity "+
+				"jobRun: job run input has files with secrets, fetch it encrypted through EncryptedJobRunInput"+
+				": unable to get job run input for job1")
+		})
 		t.Run("returns job run input successfully", func(t *testing.T) {
 			service := new(mockJobRunService)
 			service.On("JobRunInput", ctx, tenant.ProjectName("proj"), scheduler.JobName("job1"), mock.Anything).
@@ -152,7 +229,7 @@ func TestJobRunHandler(t *testing.T) {
 				}, nil)
 			defer service.AssertExpectations(t)
 
-			handler := v1beta1.NewJobRunHandler(logger, service, nil)
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
 
 			inputRequest := pb.JobRunInputRequest{
 				ProjectName:  "proj",
@@ -169,6 +246,199 @@ func TestJobRunHandler(t *testing.T) {
 			assert.Equal(t, "secret_value", input.Secrets["name"])
 		})
 	})
+	t.Run("EncryptedJobRunInput", func(t *testing.T) {
+		newRequest := func(publicKey string) *pb.EncryptedJobRunInputRequest {
+			return &pb.EncryptedJobRunInputRequest{
+				ProjectName:  "proj",
+				JobName:      "job1",
+				ScheduledAt:  timestamppb.Now(),
+				InstanceName: "bq2bq",
+				InstanceType: "TYPE_TASK",
+				PublicKey:    publicKey,
+			}
+		}
+
+		t.Run("returns error when instance type is invalid", func(t *testing.T) {
+			handler := v1beta1.NewJobRunHandler(logger, new(mockJobRunService), nil, nil, nil)
+
+			req := newRequest("")
+			req.InstanceType = ""
+			_, err := handler.EncryptedJobRunInput(ctx, req)
+			assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid argument for entity "+
+				"jobRun: executor type is empty: unable to get encrypted job run input for job1")
+		})
+		t.Run("returns error when scheduled at is not set", func(t *testing.T) {
+			handler := v1beta1.NewJobRunHandler(logger, new(mockJobRunService), nil, nil, nil)
+
+			req := newRequest("")
+			req.ScheduledAt = nil
+			_, err := handler.EncryptedJobRunInput(ctx, req)
+			assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid argument for entity "+
+				"jobRun: invalid scheduled_at: unable to get encrypted job run input for job1")
+		})
+		t.Run("returns error when the public key is invalid for the files with secrets", func(t *testing.T) {
+			service := new(mockJobRunService)
+			service.On("JobRunInput", ctx, tenant.ProjectName("proj"), scheduler.JobName("job1"), mock.Anything).
+				Return(&scheduler.ExecutorInput{SecretFiles: map[string]string{"query.sql": "select 'secret'"}}, nil)
+			defer service.AssertExpectations(t)
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
+
+			_, err := handler.EncryptedJobRunInput(ctx, newRequest("invalid"))
+			assert.ErrorContains(t, err, "code = InvalidArgument")
+		})
+		t.Run("returns the input with the files with secrets encrypted", func(t *testing.T) {
+			key, err := rsa.GenerateKey(rand.Reader, 2048)
+			assert.NoError(t, err)
+			der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+			assert.NoError(t, err)
+
+			service := new(mockJobRunService)
+			service.On("JobRunInput", ctx, tenant.ProjectName("proj"), scheduler.JobName("job1"), mock.Anything).
+				Return(&scheduler.ExecutorInput{
+					Configs:     map[string]string{"a": "b"},
+					Secrets:     map[string]string{"name": "secret"},
+					Files:       map[string]string{"plain.sql": "select 1"},
+					SecretFiles: map[string]string{"query.sql": "select 'secret'"},
+				}, nil)
+			defer service.AssertExpectations(t)
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
+
+			resp, err := handler.EncryptedJobRunInput(ctx, newRequest(base64.StdEncoding.EncodeToString(der)))
+			assert.NoError(t, err)
+			assert.Equal(t, "b", resp.GetEnvs()["a"])
+			assert.Equal(t, "select 1", resp.GetFiles()["plain.sql"])
+			assert.NotContains(t, resp.GetFiles(), "query.sql")
+			assert.Empty(t, resp.GetSecrets())
+			assert.NotEmpty(t, resp.GetEncryptedKey())
+			assert.Contains(t, resp.GetEncryptedFiles(), "query.sql")
+			assert.Contains(t, resp.GetEncryptedSecrets(), "name")
+			assert.NotEqual(t, "secret", resp.GetEncryptedSecrets()["name"])
+		})
+		t.Run("returns the secrets encrypted when no file has secrets", func(t *testing.T) {
+			key, err := rsa.GenerateKey(rand.Reader, 2048)
+			assert.NoError(t, err)
+			der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+			assert.NoError(t, err)
+
+			service := new(mockJobRunService)
+			service.On("JobRunInput", ctx, tenant.ProjectName("proj"), scheduler.JobName("job1"), mock.Anything).
+				Return(&scheduler.ExecutorInput{
+					Secrets: map[string]string{"name": "secret"},
+					Files:   map[string]string{"plain.sql": "select 1"},
+				}, nil)
+			defer service.AssertExpectations(t)
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
+
+			resp, err := handler.EncryptedJobRunInput(ctx, newRequest(base64.StdEncoding.EncodeToString(der)))
+			assert.NoError(t, err)
+			assert.Empty(t, resp.GetSecrets())
+			assert.Empty(t, resp.GetEncryptedFiles())
+			assert.NotEmpty(t, resp.GetEncryptedKey())
+			assert.Contains(t, resp.GetEncryptedSecrets(), "name")
+		})
+	})
+	t.Run("CompileExecutorInputAt", func(t *testing.T) {
+		t.Run("returns error when project name is invalid", func(t *testing.T) {
+			service := new(mockJobRunService)
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
+
+			req := &pb.CompileExecutorInputAtRequest{
+				JobName:      "job1",
+				ScheduledAt:  timestamppb.Now(),
+				InstanceName: "bq2bq",
+				InstanceType: pb.InstanceSpec_TYPE_TASK,
+			}
+
+			_, err := handler.CompileExecutorInputAt(ctx, req)
+			assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid argument for "+
+				"entity project: project name is empty: unable to compile executor input for job1")
+		})
+		t.Run("returns error when executor is invalid", func(t *testing.T) {
+			service := new(mockJobRunService)
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
+
+			req := &pb.CompileExecutorInputAtRequest{
+				ProjectName:  "proj",
+				JobName:      "job1",
+				ScheduledAt:  timestamppb.Now(),
+				InstanceType: pb.InstanceSpec_TYPE_TASK,
+			}
+
+			_, err := handler.CompileExecutorInputAt(ctx, req)
+			assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid argument for entity "+
+				"jobRun: executor name is invalid: unable to compile executor input for job1")
+		})
+		t.Run("returns error when scheduled_at is invalid", func(t *testing.T) {
+			service := new(mockJobRunService)
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
+
+			req := &pb.CompileExecutorInputAtRequest{
+				ProjectName:  "proj",
+				JobName:      "job1",
+				InstanceName: "bq2bq",
+				InstanceType: pb.InstanceSpec_TYPE_TASK,
+			}
+
+			_, err := handler.CompileExecutorInputAt(ctx, req)
+			assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid argument for entity "+
+				"jobRun: invalid scheduled_at: unable to compile executor input for job1")
+		})
+		t.Run("returns error when service returns error", func(t *testing.T) {
+			service := new(mockJobRunService)
+			service.On("CompileExecutorInputAt", ctx, tenant.ProjectName("proj"), scheduler.JobName("job1"), mock.Anything, time.Time{}).
+				Return(nil, errors.New("error in compiling"))
+			defer service.AssertExpectations(t)
+
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
+
+			req := &pb.CompileExecutorInputAtRequest{
+				ProjectName:  "proj",
+				JobName:      "job1",
+				ScheduledAt:  timestamppb.Now(),
+				InstanceName: "bq2bq",
+				InstanceType: pb.InstanceSpec_TYPE_TASK,
+			}
+
+			_, err := handler.CompileExecutorInputAt(ctx, req)
+			assert.EqualError(t, err, "rpc error: code = Internal desc = error in compiling: unable to compile "+
+				"executor input for job1")
+		})
+		t.Run("returns the compiled input with the names of the files with secrets", func(t *testing.T) {
+			scheduledAt := time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
+			executedAt := time.Date(2023, 1, 2, 0, 12, 0, 0, time.UTC)
+
+			service := new(mockJobRunService)
+			service.On("CompileExecutorInputAt", ctx, tenant.ProjectName("proj"), scheduler.JobName("job1"),
+				mock.MatchedBy(func(config scheduler.RunConfig) bool {
+					return config.ScheduledAt.Equal(scheduledAt) && config.Executor.Name == "bq2bq"
+				}), executedAt).
+				Return(&scheduler.ExecutorInput{
+					Configs:     map[string]string{"a": "b"},
+					Secrets:     map[string]string{"name": "secret_value"},
+					Files:       map[string]string{"query.sql": "select 1"},
+					SecretFiles: map[string]string{"z.sql": "select 'secret_value'", "a.sql": "select 'secret_value'"},
+				}, nil)
+			defer service.AssertExpectations(t)
+
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
+
+			req := &pb.CompileExecutorInputAtRequest{
+				ProjectName:  "proj",
+				JobName:      "job1",
+				ScheduledAt:  timestamppb.New(scheduledAt),
+				ExecutedAt:   timestamppb.New(executedAt),
+				InstanceName: "bq2bq",
+				InstanceType: pb.InstanceSpec_TYPE_TASK,
+			}
+
+			resp, err := handler.CompileExecutorInputAt(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, map[string]string{"a": "b"}, resp.Envs)
+			assert.Equal(t, map[string]string{"name": "secret_value"}, resp.Secrets)
+			assert.Equal(t, map[string]string{"query.sql": "select 1"}, resp.Files)
+			assert.Equal(t, []string{"a.sql", "z.sql"}, resp.SecretFiles)
+		})
+	})
 	t.Run("JobRun", func(t *testing.T) {
 		date, err := time.Parse(AirflowDateFormat, "2022-03-25T02:00:00+00:00")
 		if err != nil {
@@ -193,7 +463,7 @@ func TestJobRunHandler(t *testing.T) {
 			jobRunService.On("GetJobRuns", ctx, tenant.ProjectName(projectName), job.Name, query).Return(jobRuns, nil)
 			defer jobRunService.AssertExpectations(t)
 
-			jobRunHandler := v1beta1.NewJobRunHandler(logger, jobRunService, nil)
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, jobRunService, nil, nil, nil)
 
 			req := &pb.JobRunRequest{
 				ProjectName: projectName,
@@ -238,7 +508,7 @@ func TestJobRunHandler(t *testing.T) {
 			jobRunService.On("GetJobRuns", ctx, tenant.ProjectName(projectName), job.Name, query).Return(jobRuns, nil)
 			defer jobRunService.AssertExpectations(t)
 
-			jobRunHandler := v1beta1.NewJobRunHandler(logger, jobRunService, nil)
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, jobRunService, nil, nil, nil)
 
 			req := &pb.JobRunRequest{
 				ProjectName: projectName,
@@ -276,7 +546,7 @@ func TestJobRunHandler(t *testing.T) {
 			jobRunService.On("GetJobRuns", ctx, tenant.ProjectName(projectName), job.Name, query).Return(nil, fmt.Errorf("some random error"))
 			defer jobRunService.AssertExpectations(t)
 
-			jobRunHandler := v1beta1.NewJobRunHandler(logger, jobRunService, nil)
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, jobRunService, nil, nil, nil)
 
 			req := &pb.JobRunRequest{
 				ProjectName: projectName,
@@ -289,8 +559,86 @@ func TestJobRunHandler(t *testing.T) {
 			assert.Nil(t, resp)
 		})
 
+		t.Run("should hold job runs if upstream access of downstream job is not allowed", func(t *testing.T) {
+			job := scheduler.Job{
+				Name: "transform-tables",
+			}
+
+			jobRuns := []*scheduler.JobRunStatus{{
+				ScheduledAt: date,
+				State:       scheduler.StateSuccess,
+			}}
+			query := &scheduler.JobRunsCriteria{
+				Name:      job.Name.String(),
+				StartDate: date,
+				EndDate:   date.Add(time.Hour * 24),
+			}
+			jobRunService := new(mockJobRunService)
+			jobRunService.On("GetJobRuns", ctx, tenant.ProjectName(projectName), job.Name, query).Return(jobRuns, nil)
+			defer jobRunService.AssertExpectations(t)
+
+			upstreamAccess := new(mockUpstreamAccessChecker)
+			upstreamAccess.On("IsAccessAllowed", ctx, tenant.ProjectName("other-proj"), scheduler.JobName("downstream-job"),
+				tenant.ProjectName(projectName), job.Name).Return(false, nil)
+			defer upstreamAccess.AssertExpectations(t)
+
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, jobRunService, nil, upstreamAccess, nil)
+
+			req := &pb.JobRunRequest{
+				ProjectName:           projectName,
+				JobName:               job.Name.String(),
+				StartDate:             timestamppb.New(date),
+				EndDate:               timestamppb.New(date.Add(time.Hour * 24)),
+				DownstreamProjectName: "other-proj",
+				DownstreamJobName:     "downstream-job",
+			}
+			resp, err := jobRunHandler.JobRun(ctx, req)
+			assert.Nil(t, err)
+			assert.Len(t, resp.JobRuns, 1)
+			assert.Equal(t, "pending approval", resp.JobRuns[0].State)
+			assert.True(t, date.Equal(resp.JobRuns[0].ScheduledAt.AsTime()))
+		})
+		t.Run("should return job runs if upstream access of downstream job is allowed", func(t *testing.T) {
+			job := scheduler.Job{
+				Name: "transform-tables",
+			}
+
+			jobRuns := []*scheduler.JobRunStatus{{
+				ScheduledAt: date,
+				State:       scheduler.StateSuccess,
+			}}
+			query := &scheduler.JobRunsCriteria{
+				Name:      job.Name.String(),
+				StartDate: date,
+				EndDate:   date.Add(time.Hour * 24),
+			}
+			jobRunService := new(mockJobRunService)
+			jobRunService.On("GetJobRuns", ctx, tenant.ProjectName(projectName), job.Name, query).Return(jobRuns, nil)
+			defer jobRunService.AssertExpectations(t)
+
+			upstreamAccess := new(mockUpstreamAccessChecker)
+			upstreamAccess.On("IsAccessAllowed", ctx, tenant.ProjectName("other-proj"), scheduler.JobName("downstream-job"),
+				tenant.ProjectName(projectName), job.Name).Return(true, nil)
+			defer upstreamAccess.AssertExpectations(t)
+
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, jobRunService, nil, upstreamAccess, nil)
+
+			req := &pb.JobRunRequest{
+				ProjectName:           projectName,
+				JobName:               job.Name.String(),
+				StartDate:             timestamppb.New(date),
+				EndDate:               timestamppb.New(date.Add(time.Hour * 24)),
+				DownstreamProjectName: "other-proj",
+				DownstreamJobName:     "downstream-job",
+			}
+			resp, err := jobRunHandler.JobRun(ctx, req)
+			assert.Nil(t, err)
+			assert.Len(t, resp.JobRuns, 1)
+			assert.Equal(t, scheduler.StateSuccess.String(), resp.JobRuns[0].State)
+		})
+
 		t.Run("should not return job runs if project name is not valid", func(t *testing.T) {
-			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil)
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil, nil, nil)
 			req := &pb.JobRunRequest{
 				ProjectName: "",
 				JobName:     "transform-tables",
@@ -305,7 +653,7 @@ func TestJobRunHandler(t *testing.T) {
 		})
 
 		t.Run("should not return job runs if job name is not valid", func(t *testing.T) {
-			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil)
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil, nil, nil)
 			req := &pb.JobRunRequest{
 				ProjectName: "some-project",
 				JobName:     "",
@@ -319,7 +667,7 @@ func TestJobRunHandler(t *testing.T) {
 			assert.Nil(t, resp)
 		})
 		t.Run("should not return job runs if only start date is invalid", func(t *testing.T) {
-			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil)
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil, nil, nil)
 			req := &pb.JobRunRequest{
 				ProjectName: "some-project",
 				JobName:     "jobname",
@@ -332,7 +680,7 @@ func TestJobRunHandler(t *testing.T) {
 			assert.Nil(t, resp)
 		})
 		t.Run("should not return job runs if only end date is invalid", func(t *testing.T) {
-			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil)
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil, nil, nil)
 			req := &pb.JobRunRequest{
 				ProjectName: "some-project",
 				JobName:     "jobname",
@@ -345,9 +693,102 @@ func TestJobRunHandler(t *testing.T) {
 			assert.Nil(t, resp)
 		})
 	})
+	t.Run("GetUploadProgress", func(t *testing.T) {
+		t.Run("should return error if project name is empty", func(t *testing.T) {
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil, nil, nil)
+
+			resp, err := jobRunHandler.GetUploadProgress(ctx, &pb.GetUploadProgressRequest{})
+			assert.ErrorContains(t, err, "code = InvalidArgument")
+			assert.Nil(t, resp)
+		})
+		t.Run("should return error if unable to get the upload progress", func(t *testing.T) {
+			jobRunService := new(mockJobRunService)
+			defer jobRunService.AssertExpectations(t)
+			jobRunService.On("GetUploadProgress", ctx, tenant.ProjectName(projectName)).
+				Return(nil, errors.New("unknown error"))
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, jobRunService, nil, nil, nil)
+
+			resp, err := jobRunHandler.GetUploadProgress(ctx, &pb.GetUploadProgressRequest{ProjectName: projectName})
+			assert.ErrorContains(t, err, "unable to get upload progress of "+projectName)
+			assert.Nil(t, resp)
+		})
+		t.Run("should return the progress of the latest upload", func(t *testing.T) {
+			startedAt := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+			progress := scheduler.NewUploadProgress(tenant.ProjectName(projectName), 10, startedAt)
+			jobRunService := new(mockJobRunService)
+			defer jobRunService.AssertExpectations(t)
+			jobRunService.On("GetUploadProgress", ctx, tenant.ProjectName(projectName)).Return(progress, nil)
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, jobRunService, nil, nil, nil)
+
+			resp, err := jobRunHandler.GetUploadProgress(ctx, &pb.GetUploadProgressRequest{ProjectName: projectName})
+			assert.NoError(t, err)
+			assert.Equal(t, projectName, resp.GetProjectName())
+			assert.Equal(t, progress.State.String(), resp.GetStatus())
+			assert.EqualValues(t, 10, resp.GetTotalJobs())
+			assert.Equal(t, startedAt, resp.GetStartedAt().AsTime())
+			assert.Nil(t, resp.GetFinishedAt())
+		})
+	})
+	t.Run("JobRunHeartbeat", func(t *testing.T) {
+		scheduledAt := time.Date(2023, 1, 2, 3, 0, 0, 0, time.UTC)
+		jobTenant, _ := tenant.NewTenant(projectName, "namespace-name")
+
+		t.Run("should return error if namespace name is empty", func(t *testing.T) {
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil, nil, nil)
+
+			resp, err := jobRunHandler.JobRunHeartbeat(ctx, &pb.JobRunHeartbeatRequest{
+				ProjectName: projectName,
+				JobName:     jobName,
+				ScheduledAt: timestamppb.New(scheduledAt),
+			})
+			assert.ErrorContains(t, err, "code = InvalidArgument")
+			assert.Nil(t, resp)
+		})
+		t.Run("should return error if scheduled at is not set", func(t *testing.T) {
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil, nil, nil)
+
+			resp, err := jobRunHandler.JobRunHeartbeat(ctx, &pb.JobRunHeartbeatRequest{
+				ProjectName:   projectName,
+				NamespaceName: "namespace-name",
+				JobName:       jobName,
+			})
+			assert.ErrorContains(t, err, "invalid scheduled_at")
+			assert.Nil(t, resp)
+		})
+		t.Run("should return error if unable to record the heartbeat", func(t *testing.T) {
+			jobRunService := new(mockJobRunService)
+			defer jobRunService.AssertExpectations(t)
+			jobRunService.On("Heartbeat", ctx, jobTenant, scheduler.JobName(jobName), scheduledAt).
+				Return(errors.New("unknown error"))
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, jobRunService, nil, nil, nil)
+
+			resp, err := jobRunHandler.JobRunHeartbeat(ctx, &pb.JobRunHeartbeatRequest{
+				ProjectName:   projectName,
+				NamespaceName: "namespace-name",
+				JobName:       jobName,
+				ScheduledAt:   timestamppb.New(scheduledAt),
+			})
+			assert.ErrorContains(t, err, "unable to record heartbeat of "+jobName)
+			assert.Nil(t, resp)
+		})
+		t.Run("should record the heartbeat of the job run", func(t *testing.T) {
+			jobRunService := new(mockJobRunService)
+			defer jobRunService.AssertExpectations(t)
+			jobRunService.On("Heartbeat", ctx, jobTenant, scheduler.JobName(jobName), scheduledAt).Return(nil)
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, jobRunService, nil, nil, nil)
+
+			_, err := jobRunHandler.JobRunHeartbeat(ctx, &pb.JobRunHeartbeatRequest{
+				ProjectName:   projectName,
+				NamespaceName: "namespace-name",
+				JobName:       jobName,
+				ScheduledAt:   timestamppb.New(scheduledAt),
+			})
+			assert.NoError(t, err)
+		})
+	})
 	t.Run("UploadToScheduler", func(t *testing.T) {
 		t.Run("should fail deployment if project name empty", func(t *testing.T) {
-			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil)
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil, nil, nil)
 			namespaceName := "namespace-name"
 			req := &pb.UploadToSchedulerRequest{
 				ProjectName:   "",
@@ -365,12 +806,30 @@ func TestJobRunHandler(t *testing.T) {
 				NamespaceName: &namespaceName,
 			}
 			jobRunService := new(mockJobRunService)
+			jobRunService.On("GetUploadProgress", ctx, tenant.ProjectName(projectName)).
+				Return(nil, errors.New("no upload found"))
 			jobRunService.On("UploadToScheduler", ctx, tenant.ProjectName(projectName)).Return(nil)
-			jobRunHandler := v1beta1.NewJobRunHandler(logger, jobRunService, nil)
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, jobRunService, nil, nil, nil)
 
 			_, err := jobRunHandler.UploadToScheduler(ctx, req)
 			assert.Nil(t, err)
 		})
+		t.Run("should return error if previous upload of the project is still in progress", func(t *testing.T) {
+			namespaceName := "namespace-name"
+			req := &pb.UploadToSchedulerRequest{
+				ProjectName:   projectName,
+				NamespaceName: &namespaceName,
+			}
+			progress := scheduler.NewUploadProgress(tenant.ProjectName(projectName), 10, time.Now())
+			jobRunService := new(mockJobRunService)
+			defer jobRunService.AssertExpectations(t)
+			jobRunService.On("GetUploadProgress", ctx, tenant.ProjectName(projectName)).Return(progress, nil)
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, jobRunService, nil, nil, nil)
+
+			resp, err := jobRunHandler.UploadToScheduler(ctx, req)
+			assert.ErrorContains(t, err, "is still in progress")
+			assert.Nil(t, resp)
+		})
 		t.Run("should return error if projectName is not valid", func(t *testing.T) {
 			namespaceName := "namespace-name"
 			eventValues, _ := structpb.NewStruct(
@@ -387,7 +846,7 @@ func TestJobRunHandler(t *testing.T) {
 					Value: eventValues,
 				},
 			}
-			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil)
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil, nil, nil)
 
 			resp, err := jobRunHandler.RegisterJobEvent(ctx, req)
 			assert.NotNil(t, err)
@@ -411,7 +870,7 @@ func TestJobRunHandler(t *testing.T) {
 					Value: eventValues,
 				},
 			}
-			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil)
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil, nil, nil)
 
 			resp, err := jobRunHandler.RegisterJobEvent(ctx, req)
 			assert.NotNil(t, err)
@@ -434,7 +893,7 @@ func TestJobRunHandler(t *testing.T) {
 					Value: eventValues,
 				},
 			}
-			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil)
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil, nil, nil)
 
 			resp, err := jobRunHandler.RegisterJobEvent(ctx, req)
 			assert.NotNil(t, err)
@@ -458,7 +917,7 @@ func TestJobRunHandler(t *testing.T) {
 					Value: eventValues,
 				},
 			}
-			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil)
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, nil, nil, nil, nil)
 
 			resp, err := jobRunHandler.RegisterJobEvent(ctx, req)
 			assert.NotNil(t, err)
@@ -502,7 +961,7 @@ func TestJobRunHandler(t *testing.T) {
 				Return(nil)
 			defer jobRunService.AssertExpectations(t)
 
-			jobRunHandler := v1beta1.NewJobRunHandler(logger, jobRunService, notifier)
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, jobRunService, notifier, nil, nil)
 
 			resp, err := jobRunHandler.RegisterJobEvent(ctx, req)
 			assert.NotNil(t, err)
@@ -546,7 +1005,7 @@ func TestJobRunHandler(t *testing.T) {
 				Return(fmt.Errorf("some error"))
 			defer jobRunService.AssertExpectations(t)
 
-			jobRunHandler := v1beta1.NewJobRunHandler(logger, jobRunService, notifier)
+			jobRunHandler := v1beta1.NewJobRunHandler(logger, jobRunService, notifier, nil, nil)
 
 			resp, err := jobRunHandler.RegisterJobEvent(ctx, req)
 			assert.NotNil(t, err)
@@ -561,7 +1020,7 @@ func TestJobRunHandler(t *testing.T) {
 			service := new(mockJobRunService)
 			defer service.AssertExpectations(t)
 
-			handler := v1beta1.NewJobRunHandler(logger, service, nil)
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
 			request := &pb.GetIntervalRequest{
 				ProjectName:   "",
 				JobName:       "test_job",
@@ -579,7 +1038,7 @@ func TestJobRunHandler(t *testing.T) {
 			service := new(mockJobRunService)
 			defer service.AssertExpectations(t)
 
-			handler := v1beta1.NewJobRunHandler(logger, service, nil)
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
 			request := &pb.GetIntervalRequest{
 				ProjectName:   "test_project",
 				JobName:       "",
@@ -597,7 +1056,7 @@ func TestJobRunHandler(t *testing.T) {
 			service := new(mockJobRunService)
 			defer service.AssertExpectations(t)
 
-			handler := v1beta1.NewJobRunHandler(logger, service, nil)
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
 			request := &pb.GetIntervalRequest{
 				ProjectName:   "test_project",
 				JobName:       "test_job",
@@ -615,7 +1074,7 @@ func TestJobRunHandler(t *testing.T) {
 			service := new(mockJobRunService)
 			defer service.AssertExpectations(t)
 
-			handler := v1beta1.NewJobRunHandler(logger, service, nil)
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
 			request := &pb.GetIntervalRequest{
 				ProjectName:   "test_project",
 				JobName:       "test_job",
@@ -666,7 +1125,7 @@ func TestJobRunHandler(t *testing.T) {
 			assert.NotNil(t, interval)
 			assert.NoError(t, err)
 
-			handler := v1beta1.NewJobRunHandler(logger, service, nil)
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
 			request := &pb.GetIntervalRequest{
 				ProjectName:   "test_project",
 				JobName:       "test_job",
@@ -681,6 +1140,526 @@ func TestJobRunHandler(t *testing.T) {
 			assert.NoError(t, actualError)
 		})
 	})
+	t.Run("EstimateJobRunStart", func(t *testing.T) {
+		scheduledAt := time.Date(2023, 10, 10, 10, 0, 0, 0, time.UTC)
+
+		t.Run("returns error when scheduled_at is invalid", func(t *testing.T) {
+			service := new(mockJobRunService)
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
+
+			_, err := handler.EstimateJobRunStart(ctx, &pb.EstimateJobRunStartRequest{ProjectName: projectName, JobName: jobName})
+			assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid argument for entity "+
+				"jobRun: invalid scheduled_at: unable to estimate run start for "+jobName)
+		})
+		t.Run("returns error when unable to estimate the run start", func(t *testing.T) {
+			service := new(mockJobRunService)
+			service.On("EstimateRunStart", ctx, tenant.ProjectName(projectName), scheduler.JobName(jobName), scheduledAt).
+				Return(nil, errors.New("unexpected error"))
+			defer service.AssertExpectations(t)
+
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
+
+			_, err := handler.EstimateJobRunStart(ctx, &pb.EstimateJobRunStartRequest{
+				ProjectName: projectName,
+				JobName:     jobName,
+				ScheduledAt: timestamppb.New(scheduledAt),
+			})
+			assert.EqualError(t, err, "rpc error: code = Internal desc = unexpected error: unable to estimate run start for "+jobName)
+		})
+		t.Run("returns the estimated start with the pool of the run", func(t *testing.T) {
+			estimatedStart := scheduledAt.Add(time.Minute * 40)
+			service := new(mockJobRunService)
+			service.On("EstimateRunStart", ctx, tenant.ProjectName(projectName), scheduler.JobName(jobName), scheduledAt).
+				Return(&scheduler.RunStartEstimate{
+					ScheduledAt:        scheduledAt,
+					State:              scheduler.StateQueued,
+					EstimatedStartTime: estimatedStart,
+					Reason:             "waiting for a free slot in pool etl, 4 of 4 slots are occupied and 2 tasks are queued",
+					Pool:               &scheduler.PoolSlots{Name: "etl", Slots: 4, Occupied: 4, Queued: 2},
+				}, nil)
+			defer service.AssertExpectations(t)
+
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
+
+			resp, err := handler.EstimateJobRunStart(ctx, &pb.EstimateJobRunStartRequest{
+				ProjectName: projectName,
+				JobName:     jobName,
+				ScheduledAt: timestamppb.New(scheduledAt),
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, scheduler.StateQueued.String(), resp.GetState())
+			assert.Equal(t, estimatedStart, resp.GetEstimatedStartTime().AsTime())
+			assert.Equal(t, "etl", resp.GetPool().GetName())
+			assert.EqualValues(t, 4, resp.GetPool().GetOccupiedSlots())
+			assert.EqualValues(t, 2, resp.GetPool().GetQueuedSlots())
+			assert.EqualValues(t, 0, resp.GetPool().GetOpenSlots())
+		})
+	})
+	t.Run("GetJobRunDetail", func(t *testing.T) {
+		scheduledAt := time.Date(2023, 10, 10, 10, 0, 0, 0, time.UTC)
+
+		t.Run("returns error when scheduled_at is invalid", func(t *testing.T) {
+			service := new(mockJobRunService)
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
+
+			_, err := handler.GetJobRunDetail(ctx, &pb.GetJobRunDetailRequest{ProjectName: projectName, JobName: jobName})
+			assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid argument for entity "+
+				"jobRun: invalid scheduled_at: unable to get job run detail for "+jobName)
+		})
+		t.Run("returns error when unable to get the detail of the run", func(t *testing.T) {
+			service := new(mockJobRunService)
+			service.On("GetJobRunDetail", ctx, tenant.ProjectName(projectName), scheduler.JobName(jobName), scheduledAt).
+				Return(nil, errors.New("unexpected error"))
+			defer service.AssertExpectations(t)
+
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
+
+			_, err := handler.GetJobRunDetail(ctx, &pb.GetJobRunDetailRequest{
+				ProjectName: projectName,
+				JobName:     jobName,
+				ScheduledAt: timestamppb.New(scheduledAt),
+			})
+			assert.EqualError(t, err, "rpc error: code = Internal desc = unexpected error: unable to get job run "+
+				"detail for "+jobName)
+		})
+		t.Run("returns the timeline of the run", func(t *testing.T) {
+			startTime := scheduledAt.Add(time.Minute)
+			endTime := startTime.Add(time.Hour)
+			taskEndTime := startTime.Add(time.Minute * 40)
+			detail := &scheduler.JobRunDetail{
+				JobRun: &scheduler.JobRun{
+					JobName:     scheduler.JobName(jobName),
+					ScheduledAt: scheduledAt,
+					State:       scheduler.StateSuccess,
+					StartTime:   startTime,
+					EndTime:     &endTime,
+				},
+				Operators: []*scheduler.OperatorRun{
+					{Name: "bq2bq", OperatorType: scheduler.OperatorTask, Status: scheduler.StateSuccess, StartTime: startTime, EndTime: &taskEndTime},
+					{Name: "predator", OperatorType: scheduler.OperatorHook, Status: scheduler.StateRunning, StartTime: taskEndTime},
+				},
+			}
+			service := new(mockJobRunService)
+			service.On("GetJobRunDetail", ctx, tenant.ProjectName(projectName), scheduler.JobName(jobName), scheduledAt).
+				Return(detail, nil)
+			defer service.AssertExpectations(t)
+
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
+
+			resp, err := handler.GetJobRunDetail(ctx, &pb.GetJobRunDetailRequest{
+				ProjectName: projectName,
+				JobName:     jobName,
+				ScheduledAt: timestamppb.New(scheduledAt),
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, jobName, resp.GetJobName())
+			assert.Equal(t, scheduler.StateSuccess.String(), resp.GetState())
+			assert.Equal(t, time.Hour, resp.GetDuration().AsDuration())
+			assert.Len(t, resp.GetOperators(), 2)
+			assert.Equal(t, time.Minute*40, resp.GetOperators()[0].GetDuration().AsDuration())
+			assert.Equal(t, taskEndTime, resp.GetOperators()[0].GetEndTime().AsTime())
+			assert.Nil(t, resp.GetOperators()[1].GetEndTime())
+		})
+	})
+	t.Run("GetScheduleRecommendation", func(t *testing.T) {
+		t.Run("returns error when job name is invalid", func(t *testing.T) {
+			service := new(mockJobRunService)
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
+
+			_, err := handler.GetScheduleRecommendation(ctx, &pb.GetScheduleRecommendationRequest{ProjectName: projectName})
+			assert.ErrorContains(t, err, "code = InvalidArgument")
+		})
+		t.Run("returns error when unable to recommend the schedule", func(t *testing.T) {
+			service := new(mockJobRunService)
+			service.On("RecommendSchedule", ctx, tenant.ProjectName(projectName), scheduler.JobName(jobName), mock.Anything).
+				Return(nil, errors.New("unexpected error"))
+			defer service.AssertExpectations(t)
+
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
+
+			_, err := handler.GetScheduleRecommendation(ctx, &pb.GetScheduleRecommendationRequest{ProjectName: projectName, JobName: jobName})
+			assert.EqualError(t, err, "rpc error: code = Internal desc = unexpected error: unable to recommend schedule for "+jobName)
+		})
+		t.Run("returns the schedule recommended for the job", func(t *testing.T) {
+			service := new(mockJobRunService)
+			service.On("RecommendSchedule", ctx, tenant.ProjectName(projectName), scheduler.JobName(jobName), mock.Anything).
+				Return(&scheduler.ScheduleRecommendation{
+					JobName:             scheduler.JobName(jobName),
+					CurrentSchedule:     "0 1 * * *",
+					RecommendedSchedule: "30 2 * * *",
+					Shift:               time.Minute * 90,
+					UpstreamsReadyAfter: time.Minute * 85,
+					SampleSize:          14,
+					Reason:              "upstreams complete 85m after the schedule",
+				}, nil)
+			defer service.AssertExpectations(t)
+
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
+
+			resp, err := handler.GetScheduleRecommendation(ctx, &pb.GetScheduleRecommendationRequest{ProjectName: projectName, JobName: jobName})
+			assert.NoError(t, err)
+			assert.Equal(t, "30 2 * * *", resp.GetRecommendedSchedule())
+			assert.Equal(t, time.Minute*90, resp.GetShift().AsDuration())
+			assert.Equal(t, time.Minute*85, resp.GetUpstreamsReadyAfter().AsDuration())
+			assert.EqualValues(t, 14, resp.GetSampleSize())
+		})
+	})
+	t.Run("GetJobRunCriticalPath", func(t *testing.T) {
+		scheduledAt := time.Date(2023, 10, 10, 10, 0, 0, 0, time.UTC)
+
+		t.Run("returns error when scheduled_at is invalid", func(t *testing.T) {
+			service := new(mockJobRunService)
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
+
+			_, err := handler.GetJobRunCriticalPath(ctx, &pb.GetJobRunCriticalPathRequest{ProjectName: projectName, JobName: jobName})
+			assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid argument for entity "+
+				"jobRun: invalid scheduled_at: unable to get critical path for "+jobName)
+		})
+		t.Run("returns error when unable to get the critical path", func(t *testing.T) {
+			service := new(mockJobRunService)
+			service.On("GetCriticalPath", ctx, tenant.ProjectName(projectName), scheduler.JobName(jobName), scheduledAt).
+				Return(nil, errors.New("unexpected error"))
+			defer service.AssertExpectations(t)
+
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
+
+			_, err := handler.GetJobRunCriticalPath(ctx, &pb.GetJobRunCriticalPathRequest{
+				ProjectName: projectName,
+				JobName:     jobName,
+				ScheduledAt: timestamppb.New(scheduledAt),
+			})
+			assert.EqualError(t, err, "rpc error: code = Internal desc = unexpected error: unable to get critical path for "+jobName)
+		})
+		t.Run("returns the runs which gated the completion of the run", func(t *testing.T) {
+			tnnt, _ := tenant.NewTenant(projectName, "sales")
+			upstreamTenant, _ := tenant.NewTenant("upstream-proj", "upstream-ns")
+			upstreamStart := scheduledAt.Add(-time.Hour)
+			upstreamEnd := scheduledAt.Add(time.Minute * 30)
+			runEnd := upstreamEnd.Add(time.Minute * 20)
+			service := new(mockJobRunService)
+			service.On("GetCriticalPath", ctx, tenant.ProjectName(projectName), scheduler.JobName(jobName), scheduledAt).
+				Return(&scheduler.CriticalPath{Runs: []*scheduler.JobRun{
+					{
+						JobName:     "upstream-job",
+						Tenant:      upstreamTenant,
+						State:       scheduler.StateSuccess,
+						ScheduledAt: upstreamStart,
+						StartTime:   upstreamStart,
+						EndTime:     &upstreamEnd,
+					},
+					{
+						JobName:     scheduler.JobName(jobName),
+						Tenant:      tnnt,
+						State:       scheduler.StateSuccess,
+						ScheduledAt: scheduledAt,
+						StartTime:   upstreamEnd,
+						EndTime:     &runEnd,
+					},
+				}}, nil)
+			defer service.AssertExpectations(t)
+
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
+
+			resp, err := handler.GetJobRunCriticalPath(ctx, &pb.GetJobRunCriticalPathRequest{
+				ProjectName: projectName,
+				JobName:     jobName,
+				ScheduledAt: timestamppb.New(scheduledAt),
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, runEnd.Sub(upstreamStart), resp.GetDuration().AsDuration())
+			assert.Len(t, resp.GetRuns(), 2)
+			assert.Equal(t, "upstream-proj", resp.GetRuns()[0].GetProjectName())
+			assert.Equal(t, time.Hour+time.Minute*30, resp.GetRuns()[0].GetDuration().AsDuration())
+			assert.Equal(t, time.Minute*30, resp.GetRuns()[1].GetWait().AsDuration())
+		})
+	})
+	t.Run("GetUpstreamFreshness", func(t *testing.T) {
+		resourceURN := "bigquery://proj:dataset.table"
+		partitionTime := time.Date(2023, 10, 10, 0, 0, 0, 0, time.UTC)
+
+		t.Run("returns error when resource urn is empty", func(t *testing.T) {
+			handler := v1beta1.NewJobRunHandler(logger, nil, nil, nil, new(mockFreshnessEvaluator))
+
+			_, err := handler.GetUpstreamFreshness(ctx, &pb.GetUpstreamFreshnessRequest{})
+			assert.ErrorContains(t, err, "code = InvalidArgument")
+		})
+		t.Run("returns error when max age is negative", func(t *testing.T) {
+			handler := v1beta1.NewJobRunHandler(logger, nil, nil, nil, new(mockFreshnessEvaluator))
+
+			_, err := handler.GetUpstreamFreshness(ctx, &pb.GetUpstreamFreshnessRequest{
+				ResourceUrn: resourceURN,
+				MaxAge:      durationpb.New(-time.Hour),
+			})
+			assert.ErrorContains(t, err, "code = InvalidArgument")
+		})
+		t.Run("returns error when unable to evaluate the freshness", func(t *testing.T) {
+			evaluator := new(mockFreshnessEvaluator)
+			evaluator.On("EvaluateFreshness", ctx, resourceURN, scheduler.FreshnessPredicate{MaxAge: time.Hour}).
+				Return(nil, errors.New("unexpected error"))
+			defer evaluator.AssertExpectations(t)
+
+			handler := v1beta1.NewJobRunHandler(logger, nil, nil, nil, evaluator)
+
+			_, err := handler.GetUpstreamFreshness(ctx, &pb.GetUpstreamFreshnessRequest{
+				ResourceUrn: resourceURN,
+				MaxAge:      durationpb.New(time.Hour),
+			})
+			assert.EqualError(t, err, "rpc error: code = Internal desc = unexpected error: unable to get upstream freshness of "+resourceURN)
+		})
+		t.Run("returns the freshness of the data of the upstream", func(t *testing.T) {
+			lastModified := partitionTime.Add(-time.Hour * 2)
+			evaluator := new(mockFreshnessEvaluator)
+			evaluator.On("EvaluateFreshness", ctx, resourceURN, scheduler.FreshnessPredicate{PartitionTime: partitionTime, MaxAge: time.Hour}).
+				Return(&scheduler.Freshness{LastModified: lastModified, Reason: "data is older than 1h0m0s"}, nil)
+			defer evaluator.AssertExpectations(t)
+
+			handler := v1beta1.NewJobRunHandler(logger, nil, nil, nil, evaluator)
+
+			resp, err := handler.GetUpstreamFreshness(ctx, &pb.GetUpstreamFreshnessRequest{
+				ResourceUrn:   resourceURN,
+				PartitionTime: timestamppb.New(partitionTime),
+				MaxAge:        durationpb.New(time.Hour),
+			})
+			assert.NoError(t, err)
+			assert.False(t, resp.GetFresh())
+			assert.Equal(t, lastModified, resp.GetLastModified().AsTime())
+			assert.Equal(t, "data is older than 1h0m0s", resp.GetReason())
+		})
+	})
+	t.Run("QueryJobRuns", func(t *testing.T) {
+		t.Run("returns error when state is invalid", func(t *testing.T) {
+			service := new(mockJobRunService)
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
+
+			_, err := handler.QueryJobRuns(ctx, &pb.QueryJobRunsRequest{ProjectName: projectName, States: []string{"unknown"}})
+			assert.ErrorContains(t, err, "code = InvalidArgument")
+		})
+		t.Run("returns error when sort field is invalid", func(t *testing.T) {
+			service := new(mockJobRunService)
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
+
+			_, err := handler.QueryJobRuns(ctx, &pb.QueryJobRunsRequest{ProjectName: projectName, SortBy: "duration"})
+			assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid argument for entity "+
+				"jobRun: invalid sort field duration: unable to query job runs of "+projectName)
+		})
+		t.Run("returns a page of the job runs matching the query", func(t *testing.T) {
+			scheduledFrom := time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
+			scheduledAt := scheduledFrom.Add(time.Hour)
+			endTime := scheduledAt.Add(time.Minute * 30)
+			tnnt, _ := tenant.NewTenant(projectName, "sales")
+
+			service := new(mockJobRunService)
+			service.On("QueryJobRuns", ctx, &scheduler.JobRunQuery{
+				ProjectName:    tenant.ProjectName(projectName),
+				NamespaceName:  "sales",
+				JobNamePattern: "sales_*",
+				States:         []scheduler.State{scheduler.StateFailed, scheduler.StateRunning},
+				ScheduledFrom:  scheduledFrom,
+				SortBy:         scheduler.JobRunSortByStartTime,
+				Descending:     true,
+				PageSize:       1,
+			}).Return(&scheduler.JobRunPage{
+				Runs: []*scheduler.JobRun{{
+					JobName: "sales_daily", Tenant: tnnt, ScheduledAt: scheduledAt, State: scheduler.StateFailed,
+					StartTime: scheduledAt, EndTime: &endTime, SLAAlert: true,
+				}},
+				NextOffset: 1,
+			}, nil)
+			defer service.AssertExpectations(t)
+
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
+
+			resp, err := handler.QueryJobRuns(ctx, &pb.QueryJobRunsRequest{
+				ProjectName:   projectName,
+				NamespaceName: "sales",
+				JobName:       "sales_*",
+				States:        []string{"failed", "running"},
+				ScheduledFrom: timestamppb.New(scheduledFrom),
+				SortBy:        "start_time",
+				Descending:    true,
+				PageSize:      1,
+			})
+			assert.NoError(t, err)
+			assert.EqualValues(t, 1, resp.GetNextOffset())
+			assert.Len(t, resp.GetRuns(), 1)
+			assert.Equal(t, "sales", resp.GetRuns()[0].GetNamespaceName())
+			assert.Equal(t, "sales_daily", resp.GetRuns()[0].GetJobName())
+			assert.Equal(t, scheduler.StateFailed.String(), resp.GetRuns()[0].GetState())
+			assert.Equal(t, endTime, resp.GetRuns()[0].GetEndTime().AsTime())
+			assert.True(t, resp.GetRuns()[0].GetSlaMissed())
+		})
+	})
+	t.Run("TriggerJobRun", func(t *testing.T) {
+		scheduledAt := time.Date(2023, 10, 10, 10, 0, 0, 0, time.UTC)
+
+		t.Run("returns error when scheduled at is missing", func(t *testing.T) {
+			service := new(mockJobRunService)
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
+
+			_, err := handler.TriggerJobRun(ctx, &pb.TriggerJobRunRequest{
+				ProjectName:   projectName,
+				NamespaceName: "sales",
+				JobName:       jobName,
+			})
+			assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid argument for entity "+
+				"jobRun: invalid scheduled_at: unable to trigger run of "+jobName)
+		})
+		t.Run("returns error when config override key is empty", func(t *testing.T) {
+			service := new(mockJobRunService)
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
+
+			_, err := handler.TriggerJobRun(ctx, &pb.TriggerJobRunRequest{
+				ProjectName:     projectName,
+				NamespaceName:   "sales",
+				JobName:         jobName,
+				ScheduledAt:     timestamppb.New(scheduledAt),
+				ConfigOverrides: map[string]string{"": "REPLACE"},
+			})
+			assert.ErrorContains(t, err, "code = InvalidArgument")
+		})
+		t.Run("returns error when unable to trigger the run", func(t *testing.T) {
+			service := new(mockJobRunService)
+			service.On("TriggerJobRun", ctx, mock.Anything).Return(errors.New("unknown error"))
+			defer service.AssertExpectations(t)
+
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
+
+			_, err := handler.TriggerJobRun(ctx, &pb.TriggerJobRunRequest{
+				ProjectName:   projectName,
+				NamespaceName: "sales",
+				JobName:       jobName,
+				ScheduledAt:   timestamppb.New(scheduledAt),
+			})
+			assert.EqualError(t, err, "rpc error: code = Internal desc = unknown error: unable to trigger run of "+jobName)
+		})
+		t.Run("triggers the run with the config overrides", func(t *testing.T) {
+			tnnt, _ := tenant.NewTenant(projectName, "sales")
+			trigger, _ := scheduler.NewJobRunTrigger(tnnt, scheduler.JobName(jobName), scheduledAt,
+				map[string]string{"LOAD_METHOD": "REPLACE"})
+
+			service := new(mockJobRunService)
+			service.On("TriggerJobRun", ctx, trigger).Return(nil)
+			defer service.AssertExpectations(t)
+
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
+
+			resp, err := handler.TriggerJobRun(ctx, &pb.TriggerJobRunRequest{
+				ProjectName:     projectName,
+				NamespaceName:   "sales",
+				JobName:         jobName,
+				ScheduledAt:     timestamppb.New(scheduledAt),
+				ConfigOverrides: map[string]string{"LOAD_METHOD": "REPLACE"},
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, jobName, resp.GetJobName())
+			assert.Equal(t, scheduledAt, resp.GetScheduledAt().AsTime())
+		})
+	})
+	t.Run("GetSchedulerHealth", func(t *testing.T) {
+		t.Run("returns error when tenant is invalid", func(t *testing.T) {
+			service := new(mockJobRunService)
+			defer service.AssertExpectations(t)
+
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
+			request := &pb.GetSchedulerHealthRequest{
+				ProjectName:   projectName,
+				NamespaceName: "",
+			}
+
+			actualResponse, actualError := handler.GetSchedulerHealth(ctx, request)
+
+			assert.Nil(t, actualResponse)
+			assert.EqualError(t, actualError, "rpc error: code = InvalidArgument desc = invalid argument for entity namespace: namespace name is empty: unable to adapt tenant")
+		})
+		t.Run("returns error when unable to get the scheduler health", func(t *testing.T) {
+			service := new(mockJobRunService)
+			defer service.AssertExpectations(t)
+
+			service.On("GetSchedulerHealth", ctx, mock.Anything).Return(nil, errors.New("unexpected error"))
+
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
+			request := &pb.GetSchedulerHealthRequest{
+				ProjectName:   projectName,
+				NamespaceName: "a-namespace",
+			}
+
+			actualResponse, actualError := handler.GetSchedulerHealth(ctx, request)
+
+			assert.Nil(t, actualResponse)
+			assert.EqualError(t, actualError, "rpc error: code = Internal desc = unexpected error: unable to get scheduler health for a-namespace")
+		})
+		t.Run("returns the health of the scheduler environment", func(t *testing.T) {
+			service := new(mockJobRunService)
+			defer service.AssertExpectations(t)
+
+			heartbeat := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+			tnnt, _ := tenant.NewTenant(projectName, "a-namespace")
+			service.On("GetSchedulerHealth", ctx, tnnt).Return(&scheduler.EnvironmentHealth{
+				Type:                     "composer",
+				MetadatabaseHealthy:      true,
+				SchedulerHealthy:         false,
+				LatestSchedulerHeartbeat: heartbeat,
+			}, nil)
+
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
+			request := &pb.GetSchedulerHealthRequest{
+				ProjectName:   projectName,
+				NamespaceName: "a-namespace",
+			}
+
+			actualResponse, actualError := handler.GetSchedulerHealth(ctx, request)
+
+			assert.NoError(t, actualError)
+			assert.Equal(t, "composer", actualResponse.SchedulerType)
+			assert.False(t, actualResponse.Healthy)
+			assert.True(t, actualResponse.MetadatabaseHealthy)
+			assert.False(t, actualResponse.SchedulerHealthy)
+			assert.Equal(t, heartbeat, actualResponse.LatestSchedulerHeartbeat.AsTime())
+		})
+	})
+	t.Run("GetScheduleRecommendation", func(t *testing.T) {
+		t.Run("returns error when job name is invalid", func(t *testing.T) {
+			service := new(mockJobRunService)
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
+
+			_, err := handler.GetScheduleRecommendation(ctx, &pb.GetScheduleRecommendationRequest{ProjectName: projectName})
+			assert.ErrorContains(t, err, "code = InvalidArgument")
+		})
+		t.Run("returns error when unable to recommend the schedule", func(t *testing.T) {
+			service := new(mockJobRunService)
+			service.On("RecommendSchedule", ctx, tenant.ProjectName(projectName), scheduler.JobName(jobName), mock.Anything).
+				Return(nil, errors.New("unexpected error"))
+			defer service.AssertExpectations(t)
+
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
+
+			_, err := handler.GetScheduleRecommendation(ctx, &pb.GetScheduleRecommendationRequest{ProjectName: projectName, JobName: jobName})
+			assert.EqualError(t, err, "rpc error: code = Internal desc = unexpected error: unable to recommend schedule for "+jobName)
+		})
+		t.Run("returns the schedule recommended for the job", func(t *testing.T) {
+			service := new(mockJobRunService)
+			service.On("RecommendSchedule", ctx, tenant.ProjectName(projectName), scheduler.JobName(jobName), mock.Anything).
+				Return(&scheduler.ScheduleRecommendation{
+					JobName:             scheduler.JobName(jobName),
+					CurrentSchedule:     "0 1 * * *",
+					RecommendedSchedule: "30 2 * * *",
+					Shift:               time.Minute * 90,
+					UpstreamsReadyAfter: time.Minute * 85,
+					SampleSize:          14,
+					Reason:              "upstreams complete 85m after the schedule",
+				}, nil)
+			defer service.AssertExpectations(t)
+
+			handler := v1beta1.NewJobRunHandler(logger, service, nil, nil, nil)
+
+			resp, err := handler.GetScheduleRecommendation(ctx, &pb.GetScheduleRecommendationRequest{ProjectName: projectName, JobName: jobName})
+			assert.NoError(t, err)
+			assert.Equal(t, "30 2 * * *", resp.GetRecommendedSchedule())
+			assert.Equal(t, time.Minute*90, resp.GetShift().AsDuration())
+			assert.Equal(t, time.Minute*85, resp.GetUpstreamsReadyAfter().AsDuration())
+			assert.EqualValues(t, 14, resp.GetSampleSize())
+		})
+	})
 }
 
 type mockJobRunService struct {
@@ -705,6 +1684,14 @@ func (m *mockJobRunService) UploadToScheduler(ctx context.Context, projectName t
 	return args.Error(0)
 }
 
+func (m *mockJobRunService) GetUploadProgress(ctx context.Context, projectName tenant.ProjectName) (*scheduler.UploadProgress, error) {
+	args := m.Called(ctx, projectName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*scheduler.UploadProgress), args.Error(1)
+}
+
 func (m *mockJobRunService) GetJobRuns(ctx context.Context, projectName tenant.ProjectName, jobName scheduler.JobName, criteria *scheduler.JobRunsCriteria) ([]*scheduler.JobRunStatus, error) {
 	args := m.Called(ctx, projectName, jobName, criteria)
 	if args.Get(0) == nil {
@@ -721,6 +1708,14 @@ func (m *mockJobRunService) GetInterval(ctx context.Context, projectName tenant.
 	return args.Get(0).(window.Interval), args.Error(1)
 }
 
+func (m *mockJobRunService) CompileExecutorInputAt(ctx context.Context, projectName tenant.ProjectName, jobName scheduler.JobName, config scheduler.RunConfig, executedAt time.Time) (*scheduler.ExecutorInput, error) {
+	args := m.Called(ctx, projectName, jobName, config, executedAt)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*scheduler.ExecutorInput), args.Error(1)
+}
+
 type mockNotifier struct {
 	mock.Mock
 }
@@ -729,3 +1724,84 @@ func (m *mockNotifier) Push(ctx context.Context, event *scheduler.Event) error {
 	args := m.Called(ctx, event)
 	return args.Error(0)
 }
+
+func (m *mockJobRunService) GetSchedulerHealth(ctx context.Context, tnnt tenant.Tenant) (*scheduler.EnvironmentHealth, error) {
+	args := m.Called(ctx, tnnt)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*scheduler.EnvironmentHealth), args.Error(1)
+}
+
+func (m *mockJobRunService) EstimateRunStart(ctx context.Context, projectName tenant.ProjectName, jobName scheduler.JobName, scheduledAt time.Time) (*scheduler.RunStartEstimate, error) {
+	args := m.Called(ctx, projectName, jobName, scheduledAt)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*scheduler.RunStartEstimate), args.Error(1)
+}
+
+type mockUpstreamAccessChecker struct {
+	mock.Mock
+}
+
+func (m *mockUpstreamAccessChecker) IsAccessAllowed(ctx context.Context, downstreamProject tenant.ProjectName, downstreamJob scheduler.JobName,
+	upstreamProject tenant.ProjectName, upstreamJob scheduler.JobName,
+) (bool, error) {
+	args := m.Called(ctx, downstreamProject, downstreamJob, upstreamProject, upstreamJob)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *mockJobRunService) Heartbeat(ctx context.Context, tnnt tenant.Tenant, jobName scheduler.JobName, scheduledAt time.Time) error {
+	args := m.Called(ctx, tnnt, jobName, scheduledAt)
+	return args.Error(0)
+}
+
+func (m *mockJobRunService) RecommendSchedule(ctx context.Context, projectName tenant.ProjectName, jobName scheduler.JobName, referenceTime time.Time) (*scheduler.ScheduleRecommendation, error) {
+	args := m.Called(ctx, projectName, jobName, referenceTime)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*scheduler.ScheduleRecommendation), args.Error(1)
+}
+
+func (m *mockJobRunService) GetJobRunDetail(ctx context.Context, projectName tenant.ProjectName, jobName scheduler.JobName, scheduledAt time.Time) (*scheduler.JobRunDetail, error) {
+	args := m.Called(ctx, projectName, jobName, scheduledAt)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*scheduler.JobRunDetail), args.Error(1)
+}
+
+func (m *mockJobRunService) QueryJobRuns(ctx context.Context, query *scheduler.JobRunQuery) (*scheduler.JobRunPage, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*scheduler.JobRunPage), args.Error(1)
+}
+
+func (m *mockJobRunService) GetCriticalPath(ctx context.Context, projectName tenant.ProjectName, jobName scheduler.JobName, scheduledAt time.Time) (*scheduler.CriticalPath, error) {
+	args := m.Called(ctx, projectName, jobName, scheduledAt)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*scheduler.CriticalPath), args.Error(1)
+}
+
+func (m *mockJobRunService) TriggerJobRun(ctx context.Context, trigger *scheduler.JobRunTrigger) error {
+	args := m.Called(ctx, trigger)
+	return args.Error(0)
+}
+
+type mockFreshnessEvaluator struct {
+	mock.Mock
+}
+
+func (m *mockFreshnessEvaluator) EvaluateFreshness(ctx context.Context, resourceURN string, predicate scheduler.FreshnessPredicate) (*scheduler.Freshness, error) {
+	args := m.Called(ctx, resourceURN, predicate)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*scheduler.Freshness), args.Error(1)
+}
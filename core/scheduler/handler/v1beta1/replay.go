@@ -1,24 +1,32 @@
 package v1beta1
 
 import (
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/goto/salt/log"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/goto/optimus/core/scheduler"
 	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/auth"
 	"github.com/goto/optimus/internal/errors"
+	"github.com/goto/optimus/internal/logging"
 	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
 )
 
 type ReplayService interface {
 	CreateReplay(ctx context.Context, tenant tenant.Tenant, jobName scheduler.JobName, config *scheduler.ReplayConfig) (replayID uuid.UUID, err error)
+	CreateReplayFromFailedRuns(ctx context.Context, tenant tenant.Tenant, jobName scheduler.JobName, config *scheduler.ReplayConfig) (replayID uuid.UUID, err error)
 	GetReplayList(ctx context.Context, projectName tenant.ProjectName) (replays []*scheduler.Replay, err error)
 	GetReplayByID(ctx context.Context, replayID uuid.UUID) (replay *scheduler.ReplayWithRun, err error)
+	GetReplayDetails(ctx context.Context, replayID uuid.UUID) (details *scheduler.ReplayDetails, err error)
 	GetRunsStatus(ctx context.Context, tenant tenant.Tenant, jobName scheduler.JobName, config *scheduler.ReplayConfig) (runs []*scheduler.JobRunStatus, err error)
+	SubscribeReplayStatus(ctx context.Context, replayID uuid.UUID) (<-chan *scheduler.ReplayWithRun, error)
 }
 
 type replayRequest interface {
@@ -44,13 +52,23 @@ func (h ReplayHandler) ReplayDryRun(ctx context.Context, req *pb.ReplayDryRunReq
 	if err != nil {
 		return nil, err
 	}
+	l := h.replayLogger(replayReq)
+
+	replayReq.Config().ExcludedScheduledAt, err = replayExclusionFromContext(ctx)
+	if err != nil {
+		l.Error("error adapting excluded runs of replay dry run: %s", err)
+		return nil, errors.GRPCErr(err, "unable to fetch runs status for "+req.JobName)
+	}
 
 	// TODO: should convert from logical time
 	runs, err := h.service.GetRunsStatus(ctx, replayReq.Tenant(), replayReq.JobName(), replayReq.Config())
 	if err != nil {
-		h.l.Error("error fetching runs status for replay dry run: %s", err)
+		l.Error("error fetching runs status for replay dry run: %s", err)
 		return nil, errors.GRPCErr(err, "unable to fetch runs status for "+req.JobName)
 	}
+	if replayFromFailedFromContext(ctx) {
+		runs = scheduler.JobRunStatusList(runs).GetSortedRunsByStates(scheduler.ReplayFromFailedStates)
+	}
 
 	return &pb.ReplayDryRunResponse{
 		ReplayRuns: replayRunsToProto(runs),
@@ -62,11 +80,23 @@ func (h ReplayHandler) Replay(ctx context.Context, req *pb.ReplayRequest) (*pb.R
 	if err != nil {
 		return nil, err
 	}
+	l := h.replayLogger(replayReq)
+	replayReq.Config().RequestedBy, replayReq.Config().Reason = replayAuditFromContext(ctx)
+	replayReq.Config().ExcludedScheduledAt, err = replayExclusionFromContext(ctx)
+	if err != nil {
+		l.Error("error adapting excluded runs of replay for job [%s]: %s", req.GetJobName(), err)
+		return nil, errors.GRPCErr(err, "unable to start replay for "+req.GetJobName())
+	}
+
+	createReplay := h.service.CreateReplay
+	if replayFromFailedFromContext(ctx) {
+		createReplay = h.service.CreateReplayFromFailedRuns
+	}
 
 	// TODO: should convert from logical time
-	replayID, err := h.service.CreateReplay(ctx, replayReq.Tenant(), replayReq.JobName(), replayReq.Config())
+	replayID, err := createReplay(ctx, replayReq.Tenant(), replayReq.JobName(), replayReq.Config())
 	if err != nil {
-		h.l.Error("error creating replay for job [%s]: %s", req.GetJobName(), err)
+		l.Error("error creating replay for job [%s]: %s", req.GetJobName(), err)
 		return nil, errors.GRPCErr(err, "unable to start replay for "+req.GetJobName())
 	}
 
@@ -74,15 +104,16 @@ func (h ReplayHandler) Replay(ctx context.Context, req *pb.ReplayRequest) (*pb.R
 }
 
 func (h ReplayHandler) ListReplay(ctx context.Context, req *pb.ListReplayRequest) (*pb.ListReplayResponse, error) {
+	l := logging.ForTenant(h.l, req.GetProjectName(), "", "")
 	projectName, err := tenant.ProjectNameFrom(req.GetProjectName())
 	if err != nil {
-		h.l.Error("error adapting project name [%s]: %s", req.GetProjectName(), err)
+		l.Error("error adapting project name [%s]: %s", req.GetProjectName(), err)
 		return nil, errors.GRPCErr(err, "unable to get replay list for "+req.GetProjectName())
 	}
 
 	replays, err := h.service.GetReplayList(ctx, projectName)
 	if err != nil {
-		h.l.Error("error getting replay list for project [%s]: %s", projectName, err)
+		l.Error("error getting replay list for project [%s]: %s", projectName, err)
 		return nil, errors.GRPCErr(err, "unable to get replay list for "+req.GetProjectName())
 	}
 
@@ -95,9 +126,10 @@ func (h ReplayHandler) ListReplay(ctx context.Context, req *pb.ListReplayRequest
 }
 
 func (h ReplayHandler) GetReplay(ctx context.Context, req *pb.GetReplayRequest) (*pb.GetReplayResponse, error) {
+	l := logging.ForTenant(h.l, req.GetProjectName(), "", "")
 	id, err := uuid.Parse(req.GetReplayId())
 	if err != nil {
-		h.l.Error("error parsing replay id [%s]: %s", req.GetReplayId(), err)
+		l.Error("error parsing replay id [%s]: %s", req.GetReplayId(), err)
 		err = errors.InvalidArgument(scheduler.EntityReplay, err.Error())
 		return nil, errors.GRPCErr(err, "unable to get replay for replayID "+req.GetReplayId())
 	}
@@ -105,12 +137,16 @@ func (h ReplayHandler) GetReplay(ctx context.Context, req *pb.GetReplayRequest)
 	replay, err := h.service.GetReplayByID(ctx, id)
 	if err != nil {
 		if errors.IsErrorType(err, errors.ErrNotFound) {
-			h.l.Warn("replay with id [%s] is not found", id.String())
+			l.Warn("replay with id [%s] is not found", id.String())
 			return &pb.GetReplayResponse{}, nil
 		}
-		h.l.Error("error getting replay with id [%s]: %s", id.String(), err)
+		l.Error("error getting replay with id [%s]: %s", id.String(), err)
 		return nil, errors.GRPCErr(err, "unable to get replay for replayID "+req.GetReplayId())
 	}
+	if !isReplayOfProject(replay.Replay, req.GetProjectName()) {
+		l.Warn("replay with id [%s] is not found in project [%s]", id.String(), req.GetProjectName())
+		return &pb.GetReplayResponse{}, nil
+	}
 
 	replayProto := replayToProto(replay.Replay)
 	replayProto.ReplayRuns = replayRunsToProto(replay.Runs)
@@ -118,6 +154,138 @@ func (h ReplayHandler) GetReplay(ctx context.Context, req *pb.GetReplayRequest)
 	return replayProto, nil
 }
 
+// GetReplayDetails returns the replay along with who requested it, the reason and every state it went through
+func (h ReplayHandler) GetReplayDetails(ctx context.Context, req *pb.GetReplayDetailsRequest) (*pb.GetReplayDetailsResponse, error) {
+	l := logging.ForTenant(h.l, req.GetProjectName(), "", "")
+	id, err := uuid.Parse(req.GetReplayId())
+	if err != nil {
+		l.Error("error parsing replay id [%s]: %s", req.GetReplayId(), err)
+		err = errors.InvalidArgument(scheduler.EntityReplay, err.Error())
+		return nil, errors.GRPCErr(err, "unable to get details of replay "+req.GetReplayId())
+	}
+
+	details, err := h.service.GetReplayDetails(ctx, id)
+	if err != nil {
+		l.Error("error getting details of replay [%s]: %s", id.String(), err)
+		return nil, errors.GRPCErr(err, "unable to get details of replay "+req.GetReplayId())
+	}
+	if !isReplayOfProject(details.Replay, req.GetProjectName()) {
+		l.Warn("replay with id [%s] is not found in project [%s]", id.String(), req.GetProjectName())
+		err = errors.NotFound(scheduler.EntityReplay, "no replay "+id.String()+" in project "+req.GetProjectName())
+		return nil, errors.GRPCErr(err, "unable to get details of replay "+req.GetReplayId())
+	}
+
+	replayProto := replayToProto(details.Replay)
+	replayProto.ReplayRuns = replayRunsToProto(details.Runs)
+
+	transitions := make([]*pb.ReplayStateTransition, len(details.Transitions))
+	for i, transition := range details.Transitions {
+		transitions[i] = &pb.ReplayStateTransition{
+			State:     transition.State.String(),
+			Message:   transition.Message,
+			CreatedAt: timestamppb.New(transition.CreatedAt),
+		}
+	}
+
+	return &pb.GetReplayDetailsResponse{
+		Replay:      replayProto,
+		RequestedBy: details.Replay.Config().RequestedBy,
+		Reason:      details.Replay.Config().Reason,
+		Transitions: transitions,
+	}, nil
+}
+
+// StreamReplayStatus sends the status of the replay followed by every update made on it, until the replay is done
+func (h ReplayHandler) StreamReplayStatus(req *pb.StreamReplayStatusRequest, stream pb.ReplayService_StreamReplayStatusServer) error {
+	l := logging.ForTenant(h.l, req.GetProjectName(), "", "")
+	id, err := uuid.Parse(req.GetReplayId())
+	if err != nil {
+		l.Error("error parsing replay id [%s]: %s", req.GetReplayId(), err)
+		err = errors.InvalidArgument(scheduler.EntityReplay, err.Error())
+		return errors.GRPCErr(err, "unable to stream status of replay "+req.GetReplayId())
+	}
+
+	updates, err := h.service.SubscribeReplayStatus(stream.Context(), id)
+	if err != nil {
+		l.Error("error subscribing to status of replay [%s]: %s", id.String(), err)
+		return errors.GRPCErr(err, "unable to stream status of replay "+req.GetReplayId())
+	}
+
+	for update := range updates {
+		if !isReplayOfProject(update.Replay, req.GetProjectName()) {
+			l.Warn("replay with id [%s] is not found in project [%s]", id.String(), req.GetProjectName())
+			err = errors.NotFound(scheduler.EntityReplay, "no replay "+id.String()+" in project "+req.GetProjectName())
+			return errors.GRPCErr(err, "unable to stream status of replay "+req.GetReplayId())
+		}
+		replayProto := replayToProto(update.Replay)
+		replayProto.ReplayRuns = replayRunsToProto(update.Runs)
+		if err := stream.Send(replayProto); err != nil {
+			l.Error("error sending status of replay [%s]: %s", id.String(), err)
+			return err
+		}
+	}
+	return nil
+}
+
+// isReplayOfProject tells whether the replay belongs to the project of the request, as the request is authorized on
+// that project while the replay is looked up by its id only
+func isReplayOfProject(replay *scheduler.Replay, projectName string) bool {
+	return replay.Tenant().ProjectName().String() == projectName
+}
+
+// replayAuditFromContext reads the reason of the replay from the incoming request metadata. The replay is requested by
+// the principal the call is authenticated as, as anything sent by the client can be made up.
+func replayAuditFromContext(ctx context.Context) (requestedBy, reason string) {
+	if principal := auth.PrincipalFrom(ctx); principal != nil {
+		requestedBy = principal.String()
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return requestedBy, ""
+	}
+	if values := md.Get(scheduler.ReplayReasonMetadataKey); len(values) > 0 {
+		reason = values[0]
+	}
+	return requestedBy, reason
+}
+
+// replayExclusionFromContext reads the scheduled_at of runs to be skipped by the replay from the incoming request metadata
+func replayExclusionFromContext(ctx context.Context) ([]time.Time, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+
+	var excludedScheduledAt []time.Time
+	for _, value := range md.Get(scheduler.ReplayExcludedScheduledAtMetadataKey) {
+		for _, rawTime := range strings.Split(value, ",") {
+			if strings.TrimSpace(rawTime) == "" {
+				continue
+			}
+			scheduledAt, err := time.Parse(time.RFC3339, strings.TrimSpace(rawTime))
+			if err != nil {
+				return nil, errors.InvalidArgument(scheduler.EntityReplay, "invalid excluded scheduled_at "+rawTime)
+			}
+			excludedScheduledAt = append(excludedScheduledAt, scheduledAt.UTC())
+		}
+	}
+	return excludedScheduledAt, nil
+}
+
+// replayFromFailedFromContext tells whether the client asked to replay only the failed and missing runs within the range
+func replayFromFailedFromContext(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+	values := md.Get(scheduler.ReplayFromFailedMetadataKey)
+	if len(values) == 0 {
+		return false
+	}
+	fromFailed, err := strconv.ParseBool(values[0])
+	return err == nil && fromFailed
+}
+
 func replayRunsToProto(runs []*scheduler.JobRunStatus) []*pb.ReplayRun {
 	runsProto := make([]*pb.ReplayRun, len(runs))
 	for i, run := range runs {
@@ -135,6 +303,7 @@ func newReplayRequest(l log.Logger, req replayRequest) (*scheduler.Replay, error
 		l.Error("invalid tenant information request project [%s] namespace [%s]: %s", req.GetProjectName(), req.GetNamespaceName(), err)
 		return nil, errors.GRPCErr(err, "unable to start replay for "+req.GetJobName())
 	}
+	l = logging.ForTenant(l, req.GetProjectName(), req.GetNamespaceName(), req.GetJobName())
 
 	jobName, err := scheduler.JobNameFrom(req.GetJobName())
 	if err != nil {
@@ -201,6 +370,11 @@ func parseJobConfig(jobConfig string) (map[string]string, error) {
 	return configs, nil
 }
 
+// replayLogger attaches the tenant and job fields of the replay to the lines logged for it
+func (h ReplayHandler) replayLogger(replay *scheduler.Replay) log.Logger {
+	return logging.ForTenant(h.l, replay.Tenant().ProjectName().String(), replay.Tenant().NamespaceName().String(), replay.JobName().String())
+}
+
 func NewReplayHandler(l log.Logger, service ReplayService) *ReplayHandler {
 	return &ReplayHandler{l: l, service: service}
 }
@@ -10,11 +10,13 @@ import (
 	"github.com/goto/salt/log"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/goto/optimus/core/scheduler"
 	"github.com/goto/optimus/core/scheduler/handler/v1beta1"
 	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/auth"
 	errs "github.com/goto/optimus/internal/errors"
 	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
 )
@@ -34,6 +36,83 @@ func TestReplayHandler(t *testing.T) {
 	replayID := uuid.New()
 
 	t.Run("ReplayDryRun", func(t *testing.T) {
+		t.Run("passes the principal as the replay actor and the reason from request metadata", func(t *testing.T) {
+			service := new(mockReplayService)
+			replayHandler := v1beta1.NewReplayHandler(logger, service)
+
+			req := &pb.ReplayRequest{
+				ProjectName:   projectName,
+				JobName:       jobName.String(),
+				NamespaceName: namespaceName,
+				StartTime:     startTime,
+				EndTime:       endTime,
+				Parallel:      false,
+				Description:   description,
+			}
+			replayConfig := scheduler.NewReplayConfig(req.StartTime.AsTime(), req.EndTime.AsTime(), false, map[string]string{}, description)
+			replayConfig.RequestedBy = "optimus-user"
+			replayConfig.Reason = "upstream data correction"
+
+			mdCtx := metadata.NewIncomingContext(ctx, metadata.Pairs(
+				"x-replay-requested-by", "someone-else",
+				scheduler.ReplayReasonMetadataKey, "upstream data correction",
+			))
+			mdCtx = auth.WithPrincipal(mdCtx, &auth.Principal{Type: auth.PrincipalOIDC, Name: "optimus-user"})
+			service.On("CreateReplay", mdCtx, jobTenant, jobName, replayConfig).Return(replayID, nil)
+
+			result, err := replayHandler.Replay(mdCtx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, replayID.String(), result.Id)
+		})
+		t.Run("passes excluded runs from request metadata", func(t *testing.T) {
+			service := new(mockReplayService)
+			replayHandler := v1beta1.NewReplayHandler(logger, service)
+
+			req := &pb.ReplayRequest{
+				ProjectName:   projectName,
+				JobName:       jobName.String(),
+				NamespaceName: namespaceName,
+				StartTime:     startTime,
+				EndTime:       endTime,
+				Parallel:      true,
+				Description:   description,
+			}
+			replayConfig := scheduler.NewReplayConfig(req.StartTime.AsTime(), req.EndTime.AsTime(), true, map[string]string{}, description)
+			replayConfig.ExcludedScheduledAt = []time.Time{
+				time.Date(2023, 0o1, 0o1, 13, 0, 0, 0, time.UTC),
+				time.Date(2023, 0o1, 0o2, 13, 0, 0, 0, time.UTC),
+			}
+
+			mdCtx := metadata.NewIncomingContext(ctx, metadata.Pairs(
+				scheduler.ReplayExcludedScheduledAtMetadataKey, "2023-01-01T13:00:00Z,2023-01-02T13:00:00Z",
+			))
+			service.On("CreateReplay", mdCtx, jobTenant, jobName, replayConfig).Return(replayID, nil)
+
+			result, err := replayHandler.Replay(mdCtx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, replayID.String(), result.Id)
+		})
+		t.Run("returns error when excluded run in request metadata is invalid", func(t *testing.T) {
+			service := new(mockReplayService)
+			replayHandler := v1beta1.NewReplayHandler(logger, service)
+
+			req := &pb.ReplayRequest{
+				ProjectName:   projectName,
+				JobName:       jobName.String(),
+				NamespaceName: namespaceName,
+				StartTime:     startTime,
+				EndTime:       endTime,
+				Description:   description,
+			}
+
+			mdCtx := metadata.NewIncomingContext(ctx, metadata.Pairs(
+				scheduler.ReplayExcludedScheduledAtMetadataKey, "2023-01-01",
+			))
+
+			result, err := replayHandler.Replay(mdCtx, req)
+			assert.ErrorContains(t, err, "invalid excluded scheduled_at")
+			assert.Nil(t, result)
+		})
 		t.Run("returns error when unable to create tenant", func(t *testing.T) {
 			service := new(mockReplayService)
 			replayHandler := v1beta1.NewReplayHandler(logger, service)
@@ -161,6 +240,45 @@ func TestReplayHandler(t *testing.T) {
 			assert.NotNil(t, result)
 			assert.Len(t, result.ReplayRuns, 1)
 		})
+		t.Run("returns only failed and missing runs when requested from failed runs", func(t *testing.T) {
+			service := new(mockReplayService)
+			replayHandler := v1beta1.NewReplayHandler(logger, service)
+
+			req := &pb.ReplayDryRunRequest{
+				ProjectName:   projectName,
+				JobName:       jobName.String(),
+				NamespaceName: namespaceName,
+				StartTime:     startTime,
+				EndTime:       endTime,
+				Parallel:      false,
+				JobConfig:     jobConfigStr,
+				Description:   description,
+			}
+			replayConfig := scheduler.NewReplayConfig(req.StartTime.AsTime(), req.EndTime.AsTime(), false, jobConfig, description)
+			runs := []*scheduler.JobRunStatus{
+				{
+					ScheduledAt: time.Date(2023, 0o1, 0o1, 13, 0, 0, 0, time.UTC),
+					State:       scheduler.StateSuccess,
+				},
+				{
+					ScheduledAt: time.Date(2023, 0o1, 0o2, 13, 0, 0, 0, time.UTC),
+					State:       scheduler.StateFailed,
+				},
+				{
+					ScheduledAt: time.Date(2023, 0o1, 0o3, 13, 0, 0, 0, time.UTC),
+					State:       scheduler.StateMissing,
+				},
+			}
+
+			mdCtx := metadata.NewIncomingContext(ctx, metadata.Pairs(scheduler.ReplayFromFailedMetadataKey, "true"))
+			service.On("GetRunsStatus", mdCtx, jobTenant, jobName, replayConfig).Return(runs, nil)
+
+			result, err := replayHandler.ReplayDryRun(mdCtx, req)
+			assert.NoError(t, err)
+			assert.Len(t, result.ReplayRuns, 2)
+			assert.Equal(t, scheduler.StateFailed.String(), result.ReplayRuns[0].Status)
+			assert.Equal(t, scheduler.StateMissing.String(), result.ReplayRuns[1].Status)
+		})
 	})
 	t.Run("Replay", func(t *testing.T) {
 		t.Run("returns replay ID when able to create replay successfully", func(t *testing.T) {
@@ -206,6 +324,30 @@ func TestReplayHandler(t *testing.T) {
 			assert.NoError(t, err)
 			assert.Equal(t, replayID.String(), result.Id)
 		})
+		t.Run("creates replay from failed runs when requested in metadata", func(t *testing.T) {
+			service := new(mockReplayService)
+			defer service.AssertExpectations(t)
+
+			replayHandler := v1beta1.NewReplayHandler(logger, service)
+
+			req := &pb.ReplayRequest{
+				ProjectName:   projectName,
+				JobName:       jobName.String(),
+				NamespaceName: namespaceName,
+				StartTime:     startTime,
+				EndTime:       endTime,
+				Parallel:      false,
+				Description:   description,
+			}
+			replayConfig := scheduler.NewReplayConfig(req.StartTime.AsTime(), req.EndTime.AsTime(), false, map[string]string{}, description)
+
+			mdCtx := metadata.NewIncomingContext(ctx, metadata.Pairs(scheduler.ReplayFromFailedMetadataKey, "true"))
+			service.On("CreateReplayFromFailedRuns", mdCtx, jobTenant, jobName, replayConfig).Return(replayID, nil)
+
+			result, err := replayHandler.Replay(mdCtx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, replayID.String(), result.Id)
+		})
 		t.Run("returns error when unable to create tenant", func(t *testing.T) {
 			service := new(mockReplayService)
 			replayHandler := v1beta1.NewReplayHandler(logger, service)
@@ -449,7 +591,7 @@ func TestReplayHandler(t *testing.T) {
 			defer service.AssertExpectations(t)
 
 			replayID := uuid.New()
-			tnnt, _ := tenant.NewTenant("project-test", "ns-1")
+			tnnt, _ := tenant.NewTenant(projectName, "ns-1")
 			startTimeStr := "2023-01-02T15:00:00Z"
 			startTime, _ := time.Parse(scheduler.ISODateFormat, startTimeStr)
 			endTime := startTime.Add(48 * time.Hour)
@@ -475,6 +617,231 @@ func TestReplayHandler(t *testing.T) {
 			assert.NoError(t, err)
 			assert.NotEmpty(t, result)
 		})
+		t.Run("returns empty if replay is of another project", func(t *testing.T) {
+			service := new(mockReplayService)
+			defer service.AssertExpectations(t)
+
+			replayID := uuid.New()
+			otherTenant, _ := tenant.NewTenant("other-project", "ns-1")
+			replayConfig := scheduler.NewReplayConfig(startTime.AsTime(), endTime.AsTime(), true, map[string]string{}, description)
+			replay := scheduler.NewReplay(replayID, "sample-job-A", otherTenant, replayConfig, scheduler.ReplayStateInProgress, startTime.AsTime())
+			service.On("GetReplayByID", ctx, replayID).Return(&scheduler.ReplayWithRun{Replay: replay}, nil)
+
+			replayHandler := v1beta1.NewReplayHandler(logger, service)
+
+			req := &pb.GetReplayRequest{
+				ProjectName: projectName,
+				ReplayId:    replayID.String(),
+			}
+			result, err := replayHandler.GetReplay(ctx, req)
+			assert.NoError(t, err)
+			assert.Empty(t, result.GetId())
+		})
+	})
+	t.Run("StreamReplayStatus", func(t *testing.T) {
+		t.Run("returns error when uuid is not valid", func(t *testing.T) {
+			replayHandler := v1beta1.NewReplayHandler(logger, nil)
+
+			req := &pb.StreamReplayStatusRequest{
+				ProjectName: projectName,
+				ReplayId:    "invalid-id",
+			}
+			err := replayHandler.StreamReplayStatus(req, new(mockStreamReplayStatusServer))
+			assert.ErrorContains(t, err, "invalid UUID")
+		})
+		t.Run("returns error when unable to subscribe to the replay status", func(t *testing.T) {
+			service := new(mockReplayService)
+			defer service.AssertExpectations(t)
+
+			stream := new(mockStreamReplayStatusServer)
+			defer stream.AssertExpectations(t)
+			stream.On("Context").Return(ctx)
+
+			service.On("SubscribeReplayStatus", ctx, replayID).Return(nil, errors.New("internal error"))
+
+			replayHandler := v1beta1.NewReplayHandler(logger, service)
+
+			req := &pb.StreamReplayStatusRequest{
+				ProjectName: projectName,
+				ReplayId:    replayID.String(),
+			}
+			err := replayHandler.StreamReplayStatus(req, stream)
+			assert.ErrorContains(t, err, "internal error")
+		})
+		t.Run("returns error when unable to send the replay status", func(t *testing.T) {
+			service := new(mockReplayService)
+			defer service.AssertExpectations(t)
+
+			stream := new(mockStreamReplayStatusServer)
+			defer stream.AssertExpectations(t)
+			stream.On("Context").Return(ctx)
+			stream.On("Send", mock.Anything).Return(errors.New("connection closed"))
+
+			replayConfig := scheduler.NewReplayConfig(startTime.AsTime(), endTime.AsTime(), false, map[string]string{}, description)
+			replay := scheduler.NewReplay(replayID, jobName, jobTenant, replayConfig, scheduler.ReplayStateInProgress, startTime.AsTime())
+			updates := make(chan *scheduler.ReplayWithRun, 1)
+			updates <- &scheduler.ReplayWithRun{Replay: replay}
+			close(updates)
+			service.On("SubscribeReplayStatus", ctx, replayID).Return((<-chan *scheduler.ReplayWithRun)(updates), nil)
+
+			replayHandler := v1beta1.NewReplayHandler(logger, service)
+
+			req := &pb.StreamReplayStatusRequest{
+				ProjectName: projectName,
+				ReplayId:    replayID.String(),
+			}
+			err := replayHandler.StreamReplayStatus(req, stream)
+			assert.ErrorContains(t, err, "connection closed")
+		})
+		t.Run("sends every update of the replay status", func(t *testing.T) {
+			service := new(mockReplayService)
+			defer service.AssertExpectations(t)
+
+			replayConfig := scheduler.NewReplayConfig(startTime.AsTime(), endTime.AsTime(), false, map[string]string{}, description)
+			inProgress := scheduler.NewReplay(replayID, jobName, jobTenant, replayConfig, scheduler.ReplayStateInProgress, startTime.AsTime())
+			success := scheduler.NewReplay(replayID, jobName, jobTenant, replayConfig, scheduler.ReplayStateSuccess, startTime.AsTime())
+			updates := make(chan *scheduler.ReplayWithRun, 2)
+			updates <- &scheduler.ReplayWithRun{
+				Replay: inProgress,
+				Runs:   []*scheduler.JobRunStatus{{ScheduledAt: startTime.AsTime(), State: scheduler.StateInProgress}},
+			}
+			updates <- &scheduler.ReplayWithRun{
+				Replay: success,
+				Runs:   []*scheduler.JobRunStatus{{ScheduledAt: startTime.AsTime(), State: scheduler.StateSuccess}},
+			}
+			close(updates)
+			service.On("SubscribeReplayStatus", ctx, replayID).Return((<-chan *scheduler.ReplayWithRun)(updates), nil)
+
+			var sent []*pb.GetReplayResponse
+			stream := new(mockStreamReplayStatusServer)
+			defer stream.AssertExpectations(t)
+			stream.On("Context").Return(ctx)
+			stream.On("Send", mock.Anything).Run(func(args mock.Arguments) {
+				sent = append(sent, args.Get(0).(*pb.GetReplayResponse))
+			}).Return(nil)
+
+			replayHandler := v1beta1.NewReplayHandler(logger, service)
+
+			req := &pb.StreamReplayStatusRequest{
+				ProjectName: projectName,
+				ReplayId:    replayID.String(),
+			}
+			err := replayHandler.StreamReplayStatus(req, stream)
+			assert.NoError(t, err)
+			assert.Len(t, sent, 2)
+			assert.Equal(t, "in progress", sent[0].Status)
+			assert.Equal(t, scheduler.StateInProgress.String(), sent[0].ReplayRuns[0].Status)
+			assert.Equal(t, "success", sent[1].Status)
+			assert.Equal(t, scheduler.StateSuccess.String(), sent[1].ReplayRuns[0].Status)
+		})
+		t.Run("returns error when the replay is of another project", func(t *testing.T) {
+			service := new(mockReplayService)
+			defer service.AssertExpectations(t)
+
+			otherTenant, _ := tenant.NewTenant("other-project", namespaceName)
+			replayConfig := scheduler.NewReplayConfig(startTime.AsTime(), endTime.AsTime(), false, map[string]string{}, description)
+			replay := scheduler.NewReplay(replayID, jobName, otherTenant, replayConfig, scheduler.ReplayStateInProgress, startTime.AsTime())
+			updates := make(chan *scheduler.ReplayWithRun, 1)
+			updates <- &scheduler.ReplayWithRun{Replay: replay}
+			close(updates)
+			service.On("SubscribeReplayStatus", ctx, replayID).Return((<-chan *scheduler.ReplayWithRun)(updates), nil)
+
+			stream := new(mockStreamReplayStatusServer)
+			defer stream.AssertExpectations(t)
+			stream.On("Context").Return(ctx)
+
+			replayHandler := v1beta1.NewReplayHandler(logger, service)
+
+			req := &pb.StreamReplayStatusRequest{
+				ProjectName: projectName,
+				ReplayId:    replayID.String(),
+			}
+			err := replayHandler.StreamReplayStatus(req, stream)
+			assert.ErrorContains(t, err, "not found")
+		})
+	})
+	t.Run("GetReplayDetails", func(t *testing.T) {
+		t.Run("returns error when uuid is not valid", func(t *testing.T) {
+			replayHandler := v1beta1.NewReplayHandler(logger, nil)
+
+			req := &pb.GetReplayDetailsRequest{
+				ProjectName: projectName,
+				ReplayId:    "invalid-id",
+			}
+			result, err := replayHandler.GetReplayDetails(ctx, req)
+			assert.ErrorContains(t, err, "invalid UUID")
+			assert.Nil(t, result)
+		})
+		t.Run("returns error when unable to get the replay details", func(t *testing.T) {
+			service := new(mockReplayService)
+			defer service.AssertExpectations(t)
+
+			service.On("GetReplayDetails", ctx, replayID).Return(nil, errs.NotFound(scheduler.EntityReplay, "replay not found"))
+
+			replayHandler := v1beta1.NewReplayHandler(logger, service)
+
+			req := &pb.GetReplayDetailsRequest{
+				ProjectName: projectName,
+				ReplayId:    replayID.String(),
+			}
+			result, err := replayHandler.GetReplayDetails(ctx, req)
+			assert.ErrorContains(t, err, "code = NotFound")
+			assert.Nil(t, result)
+		})
+		t.Run("returns error when the replay is of another project", func(t *testing.T) {
+			service := new(mockReplayService)
+			defer service.AssertExpectations(t)
+
+			otherTenant, _ := tenant.NewTenant("other-project", namespaceName)
+			replayConfig := scheduler.NewReplayConfig(startTime.AsTime(), endTime.AsTime(), false, map[string]string{}, description)
+			replay := scheduler.NewReplay(replayID, jobName, otherTenant, replayConfig, scheduler.ReplayStateSuccess, startTime.AsTime())
+			service.On("GetReplayDetails", ctx, replayID).Return(&scheduler.ReplayDetails{Replay: replay}, nil)
+
+			replayHandler := v1beta1.NewReplayHandler(logger, service)
+
+			req := &pb.GetReplayDetailsRequest{
+				ProjectName: projectName,
+				ReplayId:    replayID.String(),
+			}
+			result, err := replayHandler.GetReplayDetails(ctx, req)
+			assert.ErrorContains(t, err, "code = NotFound")
+			assert.Nil(t, result)
+		})
+		t.Run("returns the requester, reason and state transitions of the replay", func(t *testing.T) {
+			service := new(mockReplayService)
+			defer service.AssertExpectations(t)
+
+			createdAt := startTime.AsTime().Add(time.Hour)
+			replayConfig := scheduler.NewReplayConfig(startTime.AsTime(), endTime.AsTime(), false, map[string]string{}, description)
+			replayConfig.RequestedBy = "optimus-user"
+			replayConfig.Reason = "upstream data correction"
+			replay := scheduler.NewReplay(replayID, jobName, jobTenant, replayConfig, scheduler.ReplayStateSuccess, startTime.AsTime())
+			service.On("GetReplayDetails", ctx, replayID).Return(&scheduler.ReplayDetails{
+				Replay: replay,
+				Runs:   []*scheduler.JobRunStatus{{ScheduledAt: startTime.AsTime(), State: scheduler.StateSuccess}},
+				Transitions: []*scheduler.ReplayStateTransition{
+					{State: scheduler.ReplayStateCreated, CreatedAt: createdAt},
+					{State: scheduler.ReplayStateSuccess, Message: "all runs succeeded", CreatedAt: createdAt.Add(time.Hour)},
+				},
+			}, nil)
+
+			replayHandler := v1beta1.NewReplayHandler(logger, service)
+
+			req := &pb.GetReplayDetailsRequest{
+				ProjectName: projectName,
+				ReplayId:    replayID.String(),
+			}
+			result, err := replayHandler.GetReplayDetails(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, replayID.String(), result.GetReplay().GetId())
+			assert.Len(t, result.GetReplay().GetReplayRuns(), 1)
+			assert.Equal(t, "optimus-user", result.GetRequestedBy())
+			assert.Equal(t, "upstream data correction", result.GetReason())
+			assert.Len(t, result.GetTransitions(), 2)
+			assert.Equal(t, scheduler.ReplayStateCreated.String(), result.GetTransitions()[0].GetState())
+			assert.Equal(t, "all runs succeeded", result.GetTransitions()[1].GetMessage())
+			assert.Equal(t, createdAt, result.GetTransitions()[0].GetCreatedAt().AsTime())
+		})
 	})
 }
 
@@ -509,6 +876,32 @@ func (_m *mockReplayService) GetReplayByID(ctx context.Context, replayID uuid.UU
 	return r0, r1
 }
 
+// GetReplayDetails provides a mock function with given fields: ctx, replayID
+func (_m *mockReplayService) GetReplayDetails(ctx context.Context, replayID uuid.UUID) (*scheduler.ReplayDetails, error) {
+	ret := _m.Called(ctx, replayID)
+
+	var r0 *scheduler.ReplayDetails
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*scheduler.ReplayDetails, error)); ok {
+		return rf(ctx, replayID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *scheduler.ReplayDetails); ok {
+		r0 = rf(ctx, replayID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*scheduler.ReplayDetails)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, replayID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateReplay provides a mock function with given fields: ctx, _a1, jobName, config
 func (_m *mockReplayService) CreateReplay(ctx context.Context, _a1 tenant.Tenant, jobName scheduler.JobName, config *scheduler.ReplayConfig) (uuid.UUID, error) {
 	ret := _m.Called(ctx, _a1, jobName, config)
@@ -532,6 +925,29 @@ func (_m *mockReplayService) CreateReplay(ctx context.Context, _a1 tenant.Tenant
 	return r0, r1
 }
 
+// CreateReplayFromFailedRuns provides a mock function with given fields: ctx, _a1, jobName, config
+func (_m *mockReplayService) CreateReplayFromFailedRuns(ctx context.Context, _a1 tenant.Tenant, jobName scheduler.JobName, config *scheduler.ReplayConfig) (uuid.UUID, error) {
+	ret := _m.Called(ctx, _a1, jobName, config)
+
+	var r0 uuid.UUID
+	if rf, ok := ret.Get(0).(func(context.Context, tenant.Tenant, scheduler.JobName, *scheduler.ReplayConfig) uuid.UUID); ok {
+		r0 = rf(ctx, _a1, jobName, config)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(uuid.UUID)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, tenant.Tenant, scheduler.JobName, *scheduler.ReplayConfig) error); ok {
+		r1 = rf(ctx, _a1, jobName, config)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetReplayList provides a mock function with given fields: ctx, projectName
 func (_m *mockReplayService) GetReplayList(ctx context.Context, projectName tenant.ProjectName) ([]*scheduler.Replay, error) {
 	ret := _m.Called(ctx, projectName)
@@ -583,3 +999,94 @@ func (_m *mockReplayService) GetRunsStatus(ctx context.Context, _a1 tenant.Tenan
 
 	return r0, r1
 }
+
+// SubscribeReplayStatus provides a mock function with given fields: ctx, replayID
+func (_m *mockReplayService) SubscribeReplayStatus(ctx context.Context, replayID uuid.UUID) (<-chan *scheduler.ReplayWithRun, error) {
+	ret := _m.Called(ctx, replayID)
+
+	var r0 <-chan *scheduler.ReplayWithRun
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) <-chan *scheduler.ReplayWithRun); ok {
+		r0 = rf(ctx, replayID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan *scheduler.ReplayWithRun)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, replayID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockStreamReplayStatusServer is an autogenerated mock type for the ReplayService_StreamReplayStatusServer type
+type mockStreamReplayStatusServer struct {
+	mock.Mock
+}
+
+// Context provides a mock function with given fields:
+func (_m *mockStreamReplayStatusServer) Context() context.Context {
+	ret := _m.Called()
+
+	var r0 context.Context
+	if rf, ok := ret.Get(0).(func() context.Context); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(context.Context)
+		}
+	}
+
+	return r0
+}
+
+// RecvMsg provides a mock function with given fields: m
+func (_m *mockStreamReplayStatusServer) RecvMsg(m interface{}) error {
+	ret := _m.Called(m)
+
+	return ret.Error(0)
+}
+
+// Send provides a mock function with given fields: _a0
+func (_m *mockStreamReplayStatusServer) Send(_a0 *pb.GetReplayResponse) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*pb.GetReplayResponse) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SendHeader provides a mock function with given fields: _a0
+func (_m *mockStreamReplayStatusServer) SendHeader(_a0 metadata.MD) error {
+	ret := _m.Called(_a0)
+
+	return ret.Error(0)
+}
+
+// SendMsg provides a mock function with given fields: m
+func (_m *mockStreamReplayStatusServer) SendMsg(m interface{}) error {
+	ret := _m.Called(m)
+
+	return ret.Error(0)
+}
+
+// SetHeader provides a mock function with given fields: _a0
+func (_m *mockStreamReplayStatusServer) SetHeader(_a0 metadata.MD) error {
+	ret := _m.Called(_a0)
+
+	return ret.Error(0)
+}
+
+// SetTrailer provides a mock function with given fields: _a0
+func (_m *mockStreamReplayStatusServer) SetTrailer(_a0 metadata.MD) {
+	_m.Called(_a0)
+}
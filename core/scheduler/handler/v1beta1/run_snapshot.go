@@ -0,0 +1,103 @@
+package v1beta1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/goto/salt/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+	"github.com/goto/optimus/internal/logging"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+type RunSnapshotService interface {
+	Get(ctx context.Context, projectName tenant.ProjectName, id uuid.UUID) (*scheduler.RunSnapshot, error)
+	GetByJob(ctx context.Context, projectName tenant.ProjectName, jobName scheduler.JobName) ([]*scheduler.RunSnapshot, error)
+}
+
+// RunSnapshotHandler serves the snapshots of the run inputs of the jobs. Snapshots are only returned for the
+// project they belong to, until they expire.
+type RunSnapshotHandler struct {
+	l       log.Logger
+	service RunSnapshotService
+
+	pb.UnimplementedRunSnapshotServiceServer
+}
+
+func (h RunSnapshotHandler) ListRunSnapshots(ctx context.Context, req *pb.ListRunSnapshotsRequest) (*pb.ListRunSnapshotsResponse, error) {
+	l := logging.ForTenant(h.l, req.GetProjectName(), "", req.GetJobName())
+	projectName, err := tenant.ProjectNameFrom(req.GetProjectName())
+	if err != nil {
+		l.Error("error adapting project name [%s]: %s", req.GetProjectName(), err)
+		return nil, errors.GRPCErr(err, "unable to list run snapshots of "+req.GetJobName())
+	}
+
+	jobName, err := scheduler.JobNameFrom(req.GetJobName())
+	if err != nil {
+		l.Error("error adapting job name [%s]: %s", req.GetJobName(), err)
+		return nil, errors.GRPCErr(err, "unable to list run snapshots of "+req.GetJobName())
+	}
+
+	snapshots, err := h.service.GetByJob(ctx, projectName, jobName)
+	if err != nil {
+		l.Error("error getting run snapshots of job [%s]: %s", jobName, err)
+		return nil, errors.GRPCErr(err, "unable to list run snapshots of "+req.GetJobName())
+	}
+
+	response := make([]*pb.RunSnapshot, len(snapshots))
+	for i, snapshot := range snapshots {
+		response[i] = toRunSnapshot(snapshot)
+	}
+	return &pb.ListRunSnapshotsResponse{Snapshots: response}, nil
+}
+
+// GetRunSnapshot returns the snapshot with its decrypted configs and files, every read of a snapshot is logged
+func (h RunSnapshotHandler) GetRunSnapshot(ctx context.Context, req *pb.GetRunSnapshotRequest) (*pb.GetRunSnapshotResponse, error) {
+	l := logging.ForTenant(h.l, req.GetProjectName(), "", "")
+	projectName, err := tenant.ProjectNameFrom(req.GetProjectName())
+	if err != nil {
+		l.Error("error adapting project name [%s]: %s", req.GetProjectName(), err)
+		return nil, errors.GRPCErr(err, "unable to get run snapshot "+req.GetId())
+	}
+
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		l.Error("error parsing run snapshot id [%s]: %s", req.GetId(), err)
+		return nil, errors.GRPCErr(errors.InvalidArgument(scheduler.EntityRunSnapshot, "invalid snapshot id "+req.GetId()),
+			"unable to get run snapshot "+req.GetId())
+	}
+
+	snapshot, err := h.service.Get(ctx, projectName, id)
+	if err != nil {
+		l.Error("error getting run snapshot [%s] of project [%s]: %s", req.GetId(), projectName, err)
+		return nil, errors.GRPCErr(err, "unable to get run snapshot "+req.GetId())
+	}
+	l.Info("run snapshot [%s] of job [%s] in project [%s] is read", req.GetId(), snapshot.JobName, projectName)
+	return &pb.GetRunSnapshotResponse{Snapshot: toRunSnapshot(snapshot)}, nil
+}
+
+func toRunSnapshot(snapshot *scheduler.RunSnapshot) *pb.RunSnapshot {
+	return &pb.RunSnapshot{
+		Id:           snapshot.ID.String(),
+		JobName:      snapshot.JobName.String(),
+		ExecutorName: snapshot.Executor.Name,
+		ExecutorType: snapshot.Executor.Type.String(),
+		ScheduledAt:  timestamppb.New(snapshot.ScheduledAt),
+		Attempt:      int32(snapshot.Attempt),
+		Configs:      snapshot.Configs,
+		Files:        snapshot.Files,
+		ExpiresAt:    timestamppb.New(snapshot.ExpiresAt),
+		CreatedAt:    timestamppb.New(snapshot.CreatedAt),
+	}
+}
+
+func NewRunSnapshotHandler(l log.Logger, service RunSnapshotService) *RunSnapshotHandler {
+	return &RunSnapshotHandler{
+		l:       l,
+		service: service,
+	}
+}
@@ -0,0 +1,129 @@
+package v1beta1_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/scheduler/handler/v1beta1"
+	"github.com/goto/optimus/core/tenant"
+	errs "github.com/goto/optimus/internal/errors"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+func TestRunSnapshotHandler(t *testing.T) {
+	logger := log.NewNoop()
+	ctx := context.Background()
+	projectName := tenant.ProjectName("proj")
+	jobName := scheduler.JobName("job1")
+	snapshotID := uuid.New()
+	scheduledAt := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("ListRunSnapshots", func(t *testing.T) {
+		t.Run("returns error when job name is invalid", func(t *testing.T) {
+			handler := v1beta1.NewRunSnapshotHandler(logger, new(mockRunSnapshotService))
+
+			_, err := handler.ListRunSnapshots(ctx, &pb.ListRunSnapshotsRequest{ProjectName: projectName.String()})
+			assert.ErrorContains(t, err, "code = InvalidArgument")
+		})
+		t.Run("returns error when unable to get the snapshots", func(t *testing.T) {
+			service := new(mockRunSnapshotService)
+			service.On("GetByJob", ctx, projectName, jobName).Return(nil, errors.New("unknown error"))
+			defer service.AssertExpectations(t)
+
+			handler := v1beta1.NewRunSnapshotHandler(logger, service)
+
+			_, err := handler.ListRunSnapshots(ctx, &pb.ListRunSnapshotsRequest{ProjectName: projectName.String(), JobName: jobName.String()})
+			assert.EqualError(t, err, "rpc error: code = Internal desc = unknown error: unable to list run snapshots of job1")
+		})
+		t.Run("returns the snapshots of the job", func(t *testing.T) {
+			service := new(mockRunSnapshotService)
+			service.On("GetByJob", ctx, projectName, jobName).Return([]*scheduler.RunSnapshot{{
+				ID:          snapshotID,
+				ProjectName: projectName,
+				JobName:     jobName,
+				Executor:    scheduler.Executor{Name: "bq2bq", Type: scheduler.ExecutorTask},
+				ScheduledAt: scheduledAt,
+				Attempt:     2,
+			}}, nil)
+			defer service.AssertExpectations(t)
+
+			handler := v1beta1.NewRunSnapshotHandler(logger, service)
+
+			resp, err := handler.ListRunSnapshots(ctx, &pb.ListRunSnapshotsRequest{ProjectName: projectName.String(), JobName: jobName.String()})
+			assert.NoError(t, err)
+			assert.Len(t, resp.GetSnapshots(), 1)
+			assert.Equal(t, snapshotID.String(), resp.GetSnapshots()[0].GetId())
+			assert.Equal(t, "bq2bq", resp.GetSnapshots()[0].GetExecutorName())
+			assert.Equal(t, scheduledAt, resp.GetSnapshots()[0].GetScheduledAt().AsTime())
+			assert.EqualValues(t, 2, resp.GetSnapshots()[0].GetAttempt())
+			assert.Empty(t, resp.GetSnapshots()[0].GetFiles())
+		})
+	})
+	t.Run("GetRunSnapshot", func(t *testing.T) {
+		t.Run("returns error when snapshot id is invalid", func(t *testing.T) {
+			handler := v1beta1.NewRunSnapshotHandler(logger, new(mockRunSnapshotService))
+
+			_, err := handler.GetRunSnapshot(ctx, &pb.GetRunSnapshotRequest{ProjectName: projectName.String(), Id: "invalid"})
+			assert.ErrorContains(t, err, "code = InvalidArgument")
+		})
+		t.Run("returns error when the snapshot is not found in the project", func(t *testing.T) {
+			service := new(mockRunSnapshotService)
+			service.On("Get", ctx, projectName, snapshotID).
+				Return(nil, errs.NotFound(scheduler.EntityRunSnapshot, "no snapshot found"))
+			defer service.AssertExpectations(t)
+
+			handler := v1beta1.NewRunSnapshotHandler(logger, service)
+
+			_, err := handler.GetRunSnapshot(ctx, &pb.GetRunSnapshotRequest{ProjectName: projectName.String(), Id: snapshotID.String()})
+			assert.ErrorContains(t, err, "code = NotFound")
+		})
+		t.Run("returns the snapshot with its configs and files", func(t *testing.T) {
+			service := new(mockRunSnapshotService)
+			service.On("Get", ctx, projectName, snapshotID).Return(&scheduler.RunSnapshot{
+				ID:          snapshotID,
+				ProjectName: projectName,
+				JobName:     jobName,
+				Executor:    scheduler.Executor{Name: "bq2bq", Type: scheduler.ExecutorTask},
+				ScheduledAt: scheduledAt,
+				Configs:     map[string]string{"DATASET": "playground"},
+				Files:       map[string]string{"query.sql": "select 1"},
+			}, nil)
+			defer service.AssertExpectations(t)
+
+			handler := v1beta1.NewRunSnapshotHandler(logger, service)
+
+			resp, err := handler.GetRunSnapshot(ctx, &pb.GetRunSnapshotRequest{ProjectName: projectName.String(), Id: snapshotID.String()})
+			assert.NoError(t, err)
+			assert.Equal(t, "playground", resp.GetSnapshot().GetConfigs()["DATASET"])
+			assert.Equal(t, "select 1", resp.GetSnapshot().GetFiles()["query.sql"])
+		})
+	})
+}
+
+type mockRunSnapshotService struct {
+	mock.Mock
+}
+
+func (m *mockRunSnapshotService) Get(ctx context.Context, projectName tenant.ProjectName, id uuid.UUID) (*scheduler.RunSnapshot, error) {
+	args := m.Called(ctx, projectName, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*scheduler.RunSnapshot), args.Error(1)
+}
+
+func (m *mockRunSnapshotService) GetByJob(ctx context.Context, projectName tenant.ProjectName, jobName scheduler.JobName) ([]*scheduler.RunSnapshot, error) {
+	args := m.Called(ctx, projectName, jobName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*scheduler.RunSnapshot), args.Error(1)
+}
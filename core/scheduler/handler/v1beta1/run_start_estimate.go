@@ -0,0 +1,59 @@
+package v1beta1
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+	"github.com/goto/optimus/internal/logging"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+// EstimateJobRunStart estimates when a job run which has not started yet is expected to start
+func (h JobRunHandler) EstimateJobRunStart(ctx context.Context, req *pb.EstimateJobRunStartRequest) (*pb.EstimateJobRunStartResponse, error) {
+	l := logging.ForTenant(h.l, req.GetProjectName(), "", req.GetJobName())
+	projectName, err := tenant.ProjectNameFrom(req.GetProjectName())
+	if err != nil {
+		l.Error("error adapting project name [%s]: %s", req.GetProjectName(), err)
+		return nil, errors.GRPCErr(err, "unable to estimate run start for "+req.GetJobName())
+	}
+
+	jobName, err := scheduler.JobNameFrom(req.GetJobName())
+	if err != nil {
+		l.Error("error adapting job name [%s]: %s", req.GetJobName(), err)
+		return nil, errors.GRPCErr(err, "unable to estimate run start for "+req.GetJobName())
+	}
+
+	if err := req.GetScheduledAt().CheckValid(); err != nil {
+		l.Error("invalid scheduled at: %s", err)
+		return nil, errors.GRPCErr(errors.InvalidArgument(scheduler.EntityJobRun, "invalid scheduled_at"),
+			"unable to estimate run start for "+req.GetJobName())
+	}
+
+	estimate, err := h.service.EstimateRunStart(ctx, projectName, jobName, req.GetScheduledAt().AsTime())
+	if err != nil {
+		l.Error("error estimating run start of job [%s]: %s", jobName, err)
+		return nil, errors.GRPCErr(err, "unable to estimate run start for "+req.GetJobName())
+	}
+
+	response := &pb.EstimateJobRunStartResponse{
+		ScheduledAt:        timestamppb.New(estimate.ScheduledAt),
+		State:              estimate.State.String(),
+		QueuePosition:      int32(estimate.QueuePosition),
+		EstimatedStartTime: timestamppb.New(estimate.EstimatedStartTime),
+		Reason:             estimate.Reason,
+	}
+	if estimate.Pool != nil {
+		response.Pool = &pb.EstimateJobRunStartResponse_Pool{
+			Name:          estimate.Pool.Name,
+			Slots:         int32(estimate.Pool.Slots),
+			OccupiedSlots: int32(estimate.Pool.Occupied),
+			QueuedSlots:   int32(estimate.Pool.Queued),
+			OpenSlots:     int32(estimate.Pool.Open),
+		}
+	}
+	return response, nil
+}
@@ -0,0 +1,46 @@
+package v1beta1
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+	"github.com/goto/optimus/internal/logging"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+// GetScheduleRecommendation recommends a schedule for the job from the completion times of its upstreams
+func (h JobRunHandler) GetScheduleRecommendation(ctx context.Context, req *pb.GetScheduleRecommendationRequest) (*pb.GetScheduleRecommendationResponse, error) {
+	l := logging.ForTenant(h.l, req.GetProjectName(), "", req.GetJobName())
+	projectName, err := tenant.ProjectNameFrom(req.GetProjectName())
+	if err != nil {
+		l.Error("error adapting project name [%s]: %s", req.GetProjectName(), err)
+		return nil, errors.GRPCErr(err, "unable to recommend schedule for "+req.GetJobName())
+	}
+
+	jobName, err := scheduler.JobNameFrom(req.GetJobName())
+	if err != nil {
+		l.Error("error adapting job name [%s]: %s", req.GetJobName(), err)
+		return nil, errors.GRPCErr(err, "unable to recommend schedule for "+req.GetJobName())
+	}
+
+	recommendation, err := h.service.RecommendSchedule(ctx, projectName, jobName, time.Now().UTC())
+	if err != nil {
+		l.Error("error recommending schedule of job [%s]: %s", jobName, err)
+		return nil, errors.GRPCErr(err, "unable to recommend schedule for "+req.GetJobName())
+	}
+
+	return &pb.GetScheduleRecommendationResponse{
+		JobName:             recommendation.JobName.String(),
+		CurrentSchedule:     recommendation.CurrentSchedule,
+		RecommendedSchedule: recommendation.RecommendedSchedule,
+		Shift:               durationpb.New(recommendation.Shift),
+		UpstreamsReadyAfter: durationpb.New(recommendation.UpstreamsReadyAfter),
+		SampleSize:          int32(recommendation.SampleSize),
+		Reason:              recommendation.Reason,
+	}, nil
+}
@@ -0,0 +1,115 @@
+package v1beta1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/goto/salt/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/auth"
+	"github.com/goto/optimus/internal/errors"
+	"github.com/goto/optimus/internal/logging"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+type UpstreamAccessService interface {
+	GetAccessRequests(ctx context.Context, upstreamProject tenant.ProjectName) ([]*scheduler.UpstreamAccessRequest, error)
+	Approve(ctx context.Context, upstreamProject tenant.ProjectName, id uuid.UUID, decidedBy, reason string) error
+	Deny(ctx context.Context, upstreamProject tenant.ProjectName, id uuid.UUID, decidedBy, reason string) error
+}
+
+type UpstreamAccessHandler struct {
+	l       log.Logger
+	service UpstreamAccessService
+
+	pb.UnimplementedUpstreamAccessServiceServer
+}
+
+func (h UpstreamAccessHandler) ListUpstreamAccessRequests(ctx context.Context, req *pb.ListUpstreamAccessRequestsRequest) (*pb.ListUpstreamAccessRequestsResponse, error) {
+	l := logging.ForTenant(h.l, req.GetProjectName(), "", "")
+	projectName, err := tenant.ProjectNameFrom(req.GetProjectName())
+	if err != nil {
+		l.Error("error adapting project name [%s]: %s", req.GetProjectName(), err)
+		return nil, errors.GRPCErr(err, "unable to list upstream access requests of "+req.GetProjectName())
+	}
+
+	requests, err := h.service.GetAccessRequests(ctx, projectName)
+	if err != nil {
+		l.Error("error getting upstream access requests of project [%s]: %s", projectName, err)
+		return nil, errors.GRPCErr(err, "unable to list upstream access requests of "+req.GetProjectName())
+	}
+
+	response := make([]*pb.UpstreamAccessRequest, len(requests))
+	for i, request := range requests {
+		response[i] = toUpstreamAccessRequest(request)
+	}
+	return &pb.ListUpstreamAccessRequestsResponse{Requests: response}, nil
+}
+
+func (h UpstreamAccessHandler) ApproveUpstreamAccess(ctx context.Context, req *pb.DecideUpstreamAccessRequest) (*pb.DecideUpstreamAccessResponse, error) {
+	return h.decide(ctx, req, h.service.Approve)
+}
+
+func (h UpstreamAccessHandler) DenyUpstreamAccess(ctx context.Context, req *pb.DecideUpstreamAccessRequest) (*pb.DecideUpstreamAccessResponse, error) {
+	return h.decide(ctx, req, h.service.Deny)
+}
+
+// decide records the decision as made by the caller, so the decisions can not be made without credentials
+func (h UpstreamAccessHandler) decide(ctx context.Context, req *pb.DecideUpstreamAccessRequest,
+	decideFn func(context.Context, tenant.ProjectName, uuid.UUID, string, string) error,
+) (*pb.DecideUpstreamAccessResponse, error) {
+	l := logging.ForTenant(h.l, req.GetProjectName(), "", "")
+	principal := auth.PrincipalFrom(ctx)
+	if principal == nil {
+		return nil, status.Error(codes.Unauthenticated, "upstream access of project "+req.GetProjectName()+" can only be decided with credentials")
+	}
+
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		l.Error("error parsing upstream access request id [%s]: %s", req.GetId(), err)
+		return nil, errors.GRPCErr(errors.InvalidArgument(scheduler.EntityUpstreamAccess, "invalid request id "+req.GetId()),
+			"unable to decide upstream access request")
+	}
+
+	projectName, err := tenant.ProjectNameFrom(req.GetProjectName())
+	if err != nil {
+		l.Error("error adapting project name [%s]: %s", req.GetProjectName(), err)
+		return nil, errors.GRPCErr(err, "unable to decide upstream access request "+req.GetId())
+	}
+
+	if err := decideFn(ctx, projectName, id, principal.String(), req.GetReason()); err != nil {
+		l.Error("error deciding upstream access request [%s]: %s", id.String(), err)
+		return nil, errors.GRPCErr(err, "unable to decide upstream access request "+req.GetId())
+	}
+	return &pb.DecideUpstreamAccessResponse{}, nil
+}
+
+func toUpstreamAccessRequest(request *scheduler.UpstreamAccessRequest) *pb.UpstreamAccessRequest {
+	response := &pb.UpstreamAccessRequest{
+		Id:                    request.ID.String(),
+		DownstreamProjectName: request.DownstreamProject.String(),
+		DownstreamJobName:     request.DownstreamJob.String(),
+		UpstreamProjectName:   request.UpstreamProject.String(),
+		UpstreamJobName:       request.UpstreamJob.String(),
+		Status:                request.State.String(),
+		DecidedBy:             request.DecidedBy,
+		Reason:                request.Reason,
+		CreatedAt:             timestamppb.New(request.CreatedAt),
+	}
+	if !request.DecidedAt.IsZero() {
+		response.DecidedAt = timestamppb.New(request.DecidedAt)
+	}
+	return response
+}
+
+func NewUpstreamAccessHandler(l log.Logger, service UpstreamAccessService) *UpstreamAccessHandler {
+	return &UpstreamAccessHandler{
+		l:       l,
+		service: service,
+	}
+}
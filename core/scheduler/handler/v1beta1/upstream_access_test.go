@@ -0,0 +1,142 @@
+package v1beta1_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/scheduler/handler/v1beta1"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/auth"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+func TestUpstreamAccessHandler(t *testing.T) {
+	logger := log.NewNoop()
+	ctx := context.Background()
+	projectName := tenant.ProjectName("upstream-proj")
+	requestID := uuid.New()
+	createdAt := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+	principalCtx := auth.WithPrincipal(ctx, &auth.Principal{Type: auth.PrincipalOIDC, Name: "owner@example.com"})
+
+	t.Run("ListUpstreamAccessRequests", func(t *testing.T) {
+		t.Run("returns error when project name is invalid", func(t *testing.T) {
+			handler := v1beta1.NewUpstreamAccessHandler(logger, new(mockUpstreamAccessService))
+
+			_, err := handler.ListUpstreamAccessRequests(ctx, &pb.ListUpstreamAccessRequestsRequest{})
+			assert.ErrorContains(t, err, "code = InvalidArgument")
+		})
+		t.Run("returns the access requests of the project", func(t *testing.T) {
+			request := scheduler.NewUpstreamAccessRequest("downstream-proj", "downstream-job", projectName, "upstream-job")
+			request.ID = requestID
+			request.CreatedAt = createdAt
+
+			service := new(mockUpstreamAccessService)
+			service.On("GetAccessRequests", ctx, projectName).Return([]*scheduler.UpstreamAccessRequest{request}, nil)
+			defer service.AssertExpectations(t)
+
+			handler := v1beta1.NewUpstreamAccessHandler(logger, service)
+
+			resp, err := handler.ListUpstreamAccessRequests(ctx, &pb.ListUpstreamAccessRequestsRequest{ProjectName: projectName.String()})
+			assert.NoError(t, err)
+			assert.Len(t, resp.GetRequests(), 1)
+			assert.Equal(t, requestID.String(), resp.GetRequests()[0].GetId())
+			assert.Equal(t, "downstream-job", resp.GetRequests()[0].GetDownstreamJobName())
+			assert.Equal(t, scheduler.UpstreamAccessStatePending.String(), resp.GetRequests()[0].GetStatus())
+			assert.Equal(t, createdAt, resp.GetRequests()[0].GetCreatedAt().AsTime())
+			assert.Nil(t, resp.GetRequests()[0].GetDecidedAt())
+		})
+	})
+	t.Run("ApproveUpstreamAccess", func(t *testing.T) {
+		t.Run("returns error when the caller is not authenticated", func(t *testing.T) {
+			handler := v1beta1.NewUpstreamAccessHandler(logger, new(mockUpstreamAccessService))
+
+			_, err := handler.ApproveUpstreamAccess(ctx, &pb.DecideUpstreamAccessRequest{
+				ProjectName: projectName.String(),
+				Id:          requestID.String(),
+			})
+			assert.ErrorContains(t, err, "code = Unauthenticated")
+		})
+		t.Run("returns error when request id is invalid", func(t *testing.T) {
+			handler := v1beta1.NewUpstreamAccessHandler(logger, new(mockUpstreamAccessService))
+
+			_, err := handler.ApproveUpstreamAccess(principalCtx, &pb.DecideUpstreamAccessRequest{
+				ProjectName: projectName.String(),
+				Id:          "invalid",
+			})
+			assert.ErrorContains(t, err, "code = InvalidArgument")
+		})
+		t.Run("records the caller as the approver", func(t *testing.T) {
+			service := new(mockUpstreamAccessService)
+			service.On("Approve", principalCtx, projectName, requestID, "owner@example.com", "shared dataset").Return(nil)
+			defer service.AssertExpectations(t)
+
+			handler := v1beta1.NewUpstreamAccessHandler(logger, service)
+
+			_, err := handler.ApproveUpstreamAccess(principalCtx, &pb.DecideUpstreamAccessRequest{
+				ProjectName: projectName.String(),
+				Id:          requestID.String(),
+				Reason:      "shared dataset",
+			})
+			assert.NoError(t, err)
+		})
+	})
+	t.Run("DenyUpstreamAccess", func(t *testing.T) {
+		t.Run("returns error when unable to deny the request", func(t *testing.T) {
+			service := new(mockUpstreamAccessService)
+			service.On("Deny", principalCtx, projectName, requestID, "owner@example.com", "contains pii").
+				Return(errors.New("unknown error"))
+			defer service.AssertExpectations(t)
+
+			handler := v1beta1.NewUpstreamAccessHandler(logger, service)
+
+			_, err := handler.DenyUpstreamAccess(principalCtx, &pb.DecideUpstreamAccessRequest{
+				ProjectName: projectName.String(),
+				Id:          requestID.String(),
+				Reason:      "contains pii",
+			})
+			assert.ErrorContains(t, err, "code = Internal")
+		})
+		t.Run("records the caller as the decider", func(t *testing.T) {
+			service := new(mockUpstreamAccessService)
+			service.On("Deny", principalCtx, projectName, requestID, "owner@example.com", "contains pii").Return(nil)
+			defer service.AssertExpectations(t)
+
+			handler := v1beta1.NewUpstreamAccessHandler(logger, service)
+
+			_, err := handler.DenyUpstreamAccess(principalCtx, &pb.DecideUpstreamAccessRequest{
+				ProjectName: projectName.String(),
+				Id:          requestID.String(),
+				Reason:      "contains pii",
+			})
+			assert.NoError(t, err)
+		})
+	})
+}
+
+type mockUpstreamAccessService struct {
+	mock.Mock
+}
+
+func (m *mockUpstreamAccessService) GetAccessRequests(ctx context.Context, upstreamProject tenant.ProjectName) ([]*scheduler.UpstreamAccessRequest, error) {
+	args := m.Called(ctx, upstreamProject)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*scheduler.UpstreamAccessRequest), args.Error(1)
+}
+
+func (m *mockUpstreamAccessService) Approve(ctx context.Context, upstreamProject tenant.ProjectName, id uuid.UUID, decidedBy, reason string) error {
+	return m.Called(ctx, upstreamProject, id, decidedBy, reason).Error(0)
+}
+
+func (m *mockUpstreamAccessService) Deny(ctx context.Context, upstreamProject tenant.ProjectName, id uuid.UUID, decidedBy, reason string) error {
+	return m.Called(ctx, upstreamProject, id, decidedBy, reason).Error(0)
+}
@@ -0,0 +1,51 @@
+package v1beta1
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/internal/errors"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+type FreshnessEvaluator interface {
+	EvaluateFreshness(ctx context.Context, resourceURN string, predicate scheduler.FreshnessPredicate) (*scheduler.Freshness, error)
+}
+
+// GetUpstreamFreshness evaluates the freshness predicates of the data of an upstream for the sensors waiting on it
+func (h JobRunHandler) GetUpstreamFreshness(ctx context.Context, req *pb.GetUpstreamFreshnessRequest) (*pb.GetUpstreamFreshnessResponse, error) {
+	if req.GetResourceUrn() == "" {
+		return nil, errors.GRPCErr(errors.InvalidArgument(scheduler.EntityJobRun, "resource_urn is required"),
+			"unable to get upstream freshness")
+	}
+
+	var predicate scheduler.FreshnessPredicate
+	if req.GetPartitionTime() != nil {
+		predicate.PartitionTime = req.GetPartitionTime().AsTime()
+	}
+	if req.GetMaxAge() != nil {
+		maxAge := req.GetMaxAge().AsDuration()
+		if maxAge < 0 {
+			return nil, errors.GRPCErr(errors.InvalidArgument(scheduler.EntityJobRun, "max_age should not be negative"),
+				"unable to get upstream freshness of "+req.GetResourceUrn())
+		}
+		predicate.MaxAge = maxAge
+	}
+
+	freshness, err := h.freshness.EvaluateFreshness(ctx, req.GetResourceUrn(), predicate)
+	if err != nil {
+		h.l.Error("error evaluating freshness of [%s]: %s", req.GetResourceUrn(), err)
+		return nil, errors.GRPCErr(err, "unable to get upstream freshness of "+req.GetResourceUrn())
+	}
+
+	response := &pb.GetUpstreamFreshnessResponse{
+		Fresh:  freshness.Fresh,
+		Reason: freshness.Reason,
+	}
+	if !freshness.LastModified.IsZero() {
+		response.LastModified = timestamppb.New(freshness.LastModified)
+	}
+	return response, nil
+}
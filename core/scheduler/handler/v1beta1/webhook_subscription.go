@@ -0,0 +1,204 @@
+package v1beta1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/goto/salt/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+	"github.com/goto/optimus/internal/logging"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+const defaultWebhookDeliveryLimit = 20
+
+type WebhookSubscriptionService interface {
+	Create(ctx context.Context, subscription *scheduler.WebhookSubscription) (uuid.UUID, error)
+	Update(ctx context.Context, subscription *scheduler.WebhookSubscription) error
+	GetAll(ctx context.Context, tnnt tenant.Tenant) ([]*scheduler.WebhookSubscription, error)
+	Delete(ctx context.Context, tnnt tenant.Tenant, id uuid.UUID) error
+	GetDeliveries(ctx context.Context, tnnt tenant.Tenant, id uuid.UUID, limit int) ([]*scheduler.WebhookDelivery, error)
+}
+
+type WebhookSubscriptionHandler struct {
+	l       log.Logger
+	service WebhookSubscriptionService
+
+	pb.UnimplementedWebhookSubscriptionServiceServer
+}
+
+func (h WebhookSubscriptionHandler) CreateWebhookSubscription(ctx context.Context, req *pb.CreateWebhookSubscriptionRequest) (*pb.CreateWebhookSubscriptionResponse, error) {
+	subscription, err := webhookSubscriptionFrom(req.GetProjectName(), req.GetNamespaceName(), req.GetUrl(), req.GetSecretName(), req.GetEventTypes())
+	if err != nil {
+		h.l.Error("error adapting webhook subscription of namespace [%s]: %s", req.GetNamespaceName(), err)
+		return nil, errors.GRPCErr(err, "unable to create webhook subscription in "+req.GetNamespaceName())
+	}
+
+	id, err := h.service.Create(ctx, subscription)
+	if err != nil {
+		h.l.Error("error creating webhook subscription in namespace [%s]: %s", req.GetNamespaceName(), err)
+		return nil, errors.GRPCErr(err, "unable to create webhook subscription in "+req.GetNamespaceName())
+	}
+	return &pb.CreateWebhookSubscriptionResponse{Id: id.String()}, nil
+}
+
+func (h WebhookSubscriptionHandler) UpdateWebhookSubscription(ctx context.Context, req *pb.UpdateWebhookSubscriptionRequest) (*pb.UpdateWebhookSubscriptionResponse, error) {
+	id, err := subscriptionIDFrom(req.GetId())
+	if err != nil {
+		h.l.Error("error parsing webhook subscription id [%s]: %s", req.GetId(), err)
+		return nil, errors.GRPCErr(err, "unable to update webhook subscription "+req.GetId())
+	}
+
+	subscription, err := webhookSubscriptionFrom(req.GetProjectName(), req.GetNamespaceName(), req.GetUrl(), req.GetSecretName(), req.GetEventTypes())
+	if err != nil {
+		h.l.Error("error adapting webhook subscription [%s]: %s", req.GetId(), err)
+		return nil, errors.GRPCErr(err, "unable to update webhook subscription "+req.GetId())
+	}
+	subscription.ID = id
+
+	if err := h.service.Update(ctx, subscription); err != nil {
+		h.l.Error("error updating webhook subscription [%s]: %s", req.GetId(), err)
+		return nil, errors.GRPCErr(err, "unable to update webhook subscription "+req.GetId())
+	}
+	return &pb.UpdateWebhookSubscriptionResponse{}, nil
+}
+
+func (h WebhookSubscriptionHandler) ListWebhookSubscriptions(ctx context.Context, req *pb.ListWebhookSubscriptionsRequest) (*pb.ListWebhookSubscriptionsResponse, error) {
+	tnnt, err := tenant.NewTenant(req.GetProjectName(), req.GetNamespaceName())
+	if err != nil {
+		h.l.Error("error adapting tenant [%s/%s]: %s", req.GetProjectName(), req.GetNamespaceName(), err)
+		return nil, errors.GRPCErr(err, "unable to list webhook subscriptions of "+req.GetNamespaceName())
+	}
+
+	l := h.tenantLogger(tnnt)
+
+	subscriptions, err := h.service.GetAll(ctx, tnnt)
+	if err != nil {
+		l.Error("error getting webhook subscriptions of namespace [%s]: %s", tnnt.NamespaceName(), err)
+		return nil, errors.GRPCErr(err, "unable to list webhook subscriptions of "+req.GetNamespaceName())
+	}
+
+	response := make([]*pb.WebhookSubscription, len(subscriptions))
+	for i, subscription := range subscriptions {
+		eventTypes := make([]string, len(subscription.EventTypes))
+		for j, eventType := range subscription.EventTypes {
+			eventTypes[j] = eventType.String()
+		}
+		response[i] = &pb.WebhookSubscription{
+			Id:            subscription.ID.String(),
+			ProjectName:   subscription.Tenant.ProjectName().String(),
+			NamespaceName: subscription.Tenant.NamespaceName().String(),
+			Url:           subscription.URL,
+			SecretName:    subscription.SecretName,
+			EventTypes:    eventTypes,
+			CreatedAt:     timestamppb.New(subscription.CreatedAt),
+		}
+	}
+	return &pb.ListWebhookSubscriptionsResponse{Subscriptions: response}, nil
+}
+
+func (h WebhookSubscriptionHandler) DeleteWebhookSubscription(ctx context.Context, req *pb.DeleteWebhookSubscriptionRequest) (*pb.DeleteWebhookSubscriptionResponse, error) {
+	tnnt, err := tenant.NewTenant(req.GetProjectName(), req.GetNamespaceName())
+	if err != nil {
+		h.l.Error("error adapting tenant [%s/%s]: %s", req.GetProjectName(), req.GetNamespaceName(), err)
+		return nil, errors.GRPCErr(err, "unable to delete webhook subscription "+req.GetId())
+	}
+
+	l := h.tenantLogger(tnnt)
+
+	id, err := subscriptionIDFrom(req.GetId())
+	if err != nil {
+		l.Error("error parsing webhook subscription id [%s]: %s", req.GetId(), err)
+		return nil, errors.GRPCErr(err, "unable to delete webhook subscription "+req.GetId())
+	}
+
+	if err := h.service.Delete(ctx, tnnt, id); err != nil {
+		l.Error("error deleting webhook subscription [%s]: %s", req.GetId(), err)
+		return nil, errors.GRPCErr(err, "unable to delete webhook subscription "+req.GetId())
+	}
+	return &pb.DeleteWebhookSubscriptionResponse{}, nil
+}
+
+// ListWebhookDeliveries returns the latest deliveries of a subscription, the newest first
+func (h WebhookSubscriptionHandler) ListWebhookDeliveries(ctx context.Context, req *pb.ListWebhookDeliveriesRequest) (*pb.ListWebhookDeliveriesResponse, error) {
+	tnnt, err := tenant.NewTenant(req.GetProjectName(), req.GetNamespaceName())
+	if err != nil {
+		h.l.Error("error adapting tenant [%s/%s]: %s", req.GetProjectName(), req.GetNamespaceName(), err)
+		return nil, errors.GRPCErr(err, "unable to list deliveries of webhook subscription "+req.GetId())
+	}
+
+	l := h.tenantLogger(tnnt)
+
+	id, err := subscriptionIDFrom(req.GetId())
+	if err != nil {
+		l.Error("error parsing webhook subscription id [%s]: %s", req.GetId(), err)
+		return nil, errors.GRPCErr(err, "unable to list deliveries of webhook subscription "+req.GetId())
+	}
+	limit := defaultWebhookDeliveryLimit
+	if req.GetLimit() < 0 {
+		return nil, errors.GRPCErr(errors.InvalidArgument(scheduler.EntityWebhookSubscription, "limit should be a positive number"),
+			"unable to list deliveries of webhook subscription "+req.GetId())
+	} else if req.GetLimit() > 0 {
+		limit = int(req.GetLimit())
+	}
+
+	deliveries, err := h.service.GetDeliveries(ctx, tnnt, id, limit)
+	if err != nil {
+		l.Error("error getting deliveries of webhook subscription [%s]: %s", req.GetId(), err)
+		return nil, errors.GRPCErr(err, "unable to list deliveries of webhook subscription "+req.GetId())
+	}
+
+	response := make([]*pb.WebhookDelivery, len(deliveries))
+	for i, delivery := range deliveries {
+		response[i] = &pb.WebhookDelivery{
+			Id:         delivery.ID.String(),
+			JobName:    delivery.JobName.String(),
+			EventType:  delivery.EventType.String(),
+			Attempts:   int32(delivery.Attempts),
+			StatusCode: int32(delivery.StatusCode),
+			Error:      delivery.Error,
+			Delivered:  delivery.Delivered,
+			CreatedAt:  timestamppb.New(delivery.CreatedAt),
+		}
+		if !delivery.ScheduledAt.IsZero() {
+			response[i].ScheduledAt = timestamppb.New(delivery.ScheduledAt)
+		}
+	}
+	return &pb.ListWebhookDeliveriesResponse{Deliveries: response}, nil
+}
+
+func webhookSubscriptionFrom(projectName, namespaceName, url, secretName string, rawEventTypes []string) (*scheduler.WebhookSubscription, error) {
+	tnnt, err := tenant.NewTenant(projectName, namespaceName)
+	if err != nil {
+		return nil, err
+	}
+	eventTypes := make([]scheduler.JobEventType, len(rawEventTypes))
+	for i, eventType := range rawEventTypes {
+		eventTypes[i] = scheduler.JobEventType(eventType)
+	}
+	return scheduler.NewWebhookSubscription(tnnt, url, secretName, eventTypes)
+}
+
+func subscriptionIDFrom(rawID string) (uuid.UUID, error) {
+	id, err := uuid.Parse(rawID)
+	if err != nil {
+		return uuid.Nil, errors.InvalidArgument(scheduler.EntityWebhookSubscription, "invalid subscription id "+rawID)
+	}
+	return id, nil
+}
+
+// tenantLogger attaches the tenant fields to the lines logged for the request
+func (h WebhookSubscriptionHandler) tenantLogger(tnnt tenant.Tenant) log.Logger {
+	return logging.ForTenant(h.l, tnnt.ProjectName().String(), tnnt.NamespaceName().String(), "")
+}
+
+func NewWebhookSubscriptionHandler(l log.Logger, service WebhookSubscriptionService) *WebhookSubscriptionHandler {
+	return &WebhookSubscriptionHandler{
+		l:       l,
+		service: service,
+	}
+}
@@ -0,0 +1,225 @@
+package v1beta1_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/scheduler/handler/v1beta1"
+	"github.com/goto/optimus/core/tenant"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+func TestWebhookSubscriptionHandler(t *testing.T) {
+	logger := log.NewNoop()
+	ctx := context.Background()
+	tnnt, _ := tenant.NewTenant("proj", "sales")
+	subscriptionID := uuid.New()
+	createdAt := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("CreateWebhookSubscription", func(t *testing.T) {
+		t.Run("returns error when event type is invalid", func(t *testing.T) {
+			handler := v1beta1.NewWebhookSubscriptionHandler(logger, new(mockWebhookSubscriptionService))
+
+			_, err := handler.CreateWebhookSubscription(ctx, &pb.CreateWebhookSubscriptionRequest{
+				ProjectName:   "proj",
+				NamespaceName: "sales",
+				Url:           "https://example.com/hook",
+				SecretName:    "WEBHOOK_KEY",
+				EventTypes:    []string{"unknown"},
+			})
+			assert.ErrorContains(t, err, "code = InvalidArgument")
+		})
+		t.Run("returns the id of the subscription created", func(t *testing.T) {
+			service := new(mockWebhookSubscriptionService)
+			service.On("Create", ctx, mock.MatchedBy(func(subscription *scheduler.WebhookSubscription) bool {
+				return subscription.Tenant == tnnt && subscription.URL == "https://example.com/hook" && subscription.SecretName == "WEBHOOK_KEY"
+			})).Return(subscriptionID, nil)
+			defer service.AssertExpectations(t)
+
+			handler := v1beta1.NewWebhookSubscriptionHandler(logger, service)
+
+			resp, err := handler.CreateWebhookSubscription(ctx, &pb.CreateWebhookSubscriptionRequest{
+				ProjectName:   "proj",
+				NamespaceName: "sales",
+				Url:           "https://example.com/hook",
+				SecretName:    "WEBHOOK_KEY",
+				EventTypes:    []string{scheduler.JobFailureEvent.String()},
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, subscriptionID.String(), resp.GetId())
+		})
+	})
+	t.Run("UpdateWebhookSubscription", func(t *testing.T) {
+		t.Run("returns error when subscription id is invalid", func(t *testing.T) {
+			handler := v1beta1.NewWebhookSubscriptionHandler(logger, new(mockWebhookSubscriptionService))
+
+			_, err := handler.UpdateWebhookSubscription(ctx, &pb.UpdateWebhookSubscriptionRequest{
+				ProjectName:   "proj",
+				NamespaceName: "sales",
+				Id:            "invalid",
+			})
+			assert.ErrorContains(t, err, "code = InvalidArgument")
+		})
+		t.Run("updates the subscription with the id", func(t *testing.T) {
+			service := new(mockWebhookSubscriptionService)
+			service.On("Update", ctx, mock.MatchedBy(func(subscription *scheduler.WebhookSubscription) bool {
+				return subscription.ID == subscriptionID && subscription.Tenant == tnnt
+			})).Return(nil)
+			defer service.AssertExpectations(t)
+
+			handler := v1beta1.NewWebhookSubscriptionHandler(logger, service)
+
+			_, err := handler.UpdateWebhookSubscription(ctx, &pb.UpdateWebhookSubscriptionRequest{
+				ProjectName:   "proj",
+				NamespaceName: "sales",
+				Id:            subscriptionID.String(),
+				Url:           "https://example.com/hook",
+				SecretName:    "WEBHOOK_KEY",
+				EventTypes:    []string{scheduler.JobFailureEvent.String()},
+			})
+			assert.NoError(t, err)
+		})
+	})
+	t.Run("ListWebhookSubscriptions", func(t *testing.T) {
+		t.Run("returns error when namespace name is empty", func(t *testing.T) {
+			handler := v1beta1.NewWebhookSubscriptionHandler(logger, new(mockWebhookSubscriptionService))
+
+			_, err := handler.ListWebhookSubscriptions(ctx, &pb.ListWebhookSubscriptionsRequest{ProjectName: "proj"})
+			assert.ErrorContains(t, err, "code = InvalidArgument")
+		})
+		t.Run("returns the subscriptions of the namespace", func(t *testing.T) {
+			service := new(mockWebhookSubscriptionService)
+			service.On("GetAll", ctx, tnnt).Return([]*scheduler.WebhookSubscription{{
+				ID:         subscriptionID,
+				Tenant:     tnnt,
+				URL:        "https://example.com/hook",
+				SecretName: "WEBHOOK_KEY",
+				EventTypes: []scheduler.JobEventType{scheduler.JobFailureEvent},
+				CreatedAt:  createdAt,
+			}}, nil)
+			defer service.AssertExpectations(t)
+
+			handler := v1beta1.NewWebhookSubscriptionHandler(logger, service)
+
+			resp, err := handler.ListWebhookSubscriptions(ctx, &pb.ListWebhookSubscriptionsRequest{ProjectName: "proj", NamespaceName: "sales"})
+			assert.NoError(t, err)
+			assert.Len(t, resp.GetSubscriptions(), 1)
+			assert.Equal(t, subscriptionID.String(), resp.GetSubscriptions()[0].GetId())
+			assert.Equal(t, []string{scheduler.JobFailureEvent.String()}, resp.GetSubscriptions()[0].GetEventTypes())
+			assert.Equal(t, createdAt, resp.GetSubscriptions()[0].GetCreatedAt().AsTime())
+		})
+	})
+	t.Run("DeleteWebhookSubscription", func(t *testing.T) {
+		t.Run("returns error when unable to delete the subscription", func(t *testing.T) {
+			service := new(mockWebhookSubscriptionService)
+			service.On("Delete", ctx, tnnt, subscriptionID).Return(errors.New("unknown error"))
+			defer service.AssertExpectations(t)
+
+			handler := v1beta1.NewWebhookSubscriptionHandler(logger, service)
+
+			_, err := handler.DeleteWebhookSubscription(ctx, &pb.DeleteWebhookSubscriptionRequest{
+				ProjectName:   "proj",
+				NamespaceName: "sales",
+				Id:            subscriptionID.String(),
+			})
+			assert.ErrorContains(t, err, "code = Internal")
+		})
+		t.Run("deletes the subscription of the namespace", func(t *testing.T) {
+			service := new(mockWebhookSubscriptionService)
+			service.On("Delete", ctx, tnnt, subscriptionID).Return(nil)
+			defer service.AssertExpectations(t)
+
+			handler := v1beta1.NewWebhookSubscriptionHandler(logger, service)
+
+			_, err := handler.DeleteWebhookSubscription(ctx, &pb.DeleteWebhookSubscriptionRequest{
+				ProjectName:   "proj",
+				NamespaceName: "sales",
+				Id:            subscriptionID.String(),
+			})
+			assert.NoError(t, err)
+		})
+	})
+	t.Run("ListWebhookDeliveries", func(t *testing.T) {
+		t.Run("returns error when limit is negative", func(t *testing.T) {
+			handler := v1beta1.NewWebhookSubscriptionHandler(logger, new(mockWebhookSubscriptionService))
+
+			_, err := handler.ListWebhookDeliveries(ctx, &pb.ListWebhookDeliveriesRequest{
+				ProjectName:   "proj",
+				NamespaceName: "sales",
+				Id:            subscriptionID.String(),
+				Limit:         -1,
+			})
+			assert.ErrorContains(t, err, "code = InvalidArgument")
+		})
+		t.Run("returns the latest deliveries with the default limit", func(t *testing.T) {
+			service := new(mockWebhookSubscriptionService)
+			service.On("GetDeliveries", ctx, tnnt, subscriptionID, 20).Return([]*scheduler.WebhookDelivery{{
+				ID:             uuid.New(),
+				SubscriptionID: subscriptionID,
+				JobName:        "job1",
+				EventType:      scheduler.JobFailureEvent,
+				Attempts:       3,
+				StatusCode:     500,
+				Error:          "internal server error",
+				CreatedAt:      createdAt,
+			}}, nil)
+			defer service.AssertExpectations(t)
+
+			handler := v1beta1.NewWebhookSubscriptionHandler(logger, service)
+
+			resp, err := handler.ListWebhookDeliveries(ctx, &pb.ListWebhookDeliveriesRequest{
+				ProjectName:   "proj",
+				NamespaceName: "sales",
+				Id:            subscriptionID.String(),
+			})
+			assert.NoError(t, err)
+			assert.Len(t, resp.GetDeliveries(), 1)
+			assert.Equal(t, "job1", resp.GetDeliveries()[0].GetJobName())
+			assert.EqualValues(t, 3, resp.GetDeliveries()[0].GetAttempts())
+			assert.EqualValues(t, 500, resp.GetDeliveries()[0].GetStatusCode())
+			assert.False(t, resp.GetDeliveries()[0].GetDelivered())
+			assert.Nil(t, resp.GetDeliveries()[0].GetScheduledAt())
+		})
+	})
+}
+
+type mockWebhookSubscriptionService struct {
+	mock.Mock
+}
+
+func (m *mockWebhookSubscriptionService) Create(ctx context.Context, subscription *scheduler.WebhookSubscription) (uuid.UUID, error) {
+	args := m.Called(ctx, subscription)
+	return args.Get(0).(uuid.UUID), args.Error(1)
+}
+
+func (m *mockWebhookSubscriptionService) Update(ctx context.Context, subscription *scheduler.WebhookSubscription) error {
+	return m.Called(ctx, subscription).Error(0)
+}
+
+func (m *mockWebhookSubscriptionService) GetAll(ctx context.Context, tnnt tenant.Tenant) ([]*scheduler.WebhookSubscription, error) {
+	args := m.Called(ctx, tnnt)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*scheduler.WebhookSubscription), args.Error(1)
+}
+
+func (m *mockWebhookSubscriptionService) Delete(ctx context.Context, tnnt tenant.Tenant, id uuid.UUID) error {
+	return m.Called(ctx, tnnt, id).Error(0)
+}
+
+func (m *mockWebhookSubscriptionService) GetDeliveries(ctx context.Context, tnnt tenant.Tenant, id uuid.UUID, limit int) ([]*scheduler.WebhookDelivery, error) {
+	args := m.Called(ctx, tnnt, id, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*scheduler.WebhookDelivery), args.Error(1)
+}
@@ -2,6 +2,7 @@ package scheduler
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -9,6 +10,7 @@ import (
 
 	"github.com/goto/optimus/core/tenant"
 	"github.com/goto/optimus/internal/errors"
+	"github.com/goto/optimus/internal/lib/cron"
 	"github.com/goto/optimus/internal/lib/window"
 )
 
@@ -27,6 +29,8 @@ const (
 	OperatorTask   OperatorType = "task"
 	OperatorSensor OperatorType = "sensor"
 	OperatorHook   OperatorType = "hook"
+	// OperatorFailureHook runs the hooks of type fail, which only run when the task fails
+	OperatorFailureHook OperatorType = "failure_hook"
 
 	UpstreamTypeStatic   = "static"
 	UpstreamTypeInferred = "inferred"
@@ -55,6 +59,11 @@ type Job struct {
 
 	WindowConfig window.Config
 	Assets       map[string]string
+
+	// CreatedAt is the time the job was first deployed
+	CreatedAt time.Time
+	// UpdatedAt changes on every deployment of the job
+	UpdatedAt time.Time
 }
 
 func (j *Job) GetHook(hookName string) (*Hook, error) {
@@ -66,14 +75,85 @@ func (j *Job) GetHook(hookName string) (*Hook, error) {
 	return nil, errors.NotFound(EntityJobRun, "hook:"+hookName)
 }
 
+// OrderedHooks returns the hooks of the job in the order of their execution, a hook comes after the hooks it depends on
+// and the hooks independent of each other keep their order in the job spec. pluginDependsOn adds the dependencies
+// declared by the plugins of the hooks, dependencies on the hooks not in the job are skipped.
+func (j *Job) OrderedHooks(pluginDependsOn map[string][]string) []*Hook {
+	inJob := make(map[string]bool, len(j.Hooks))
+	for _, hook := range j.Hooks {
+		inJob[hook.Name] = true
+	}
+
+	pending := map[string]int{}
+	dependents := map[string][]string{}
+	for _, hook := range j.Hooks {
+		seen := map[string]bool{}
+		for _, before := range append(append([]string{}, pluginDependsOn[hook.Name]...), hook.DependsOn...) {
+			if !inJob[before] || before == hook.Name || seen[before] {
+				continue
+			}
+			seen[before] = true
+			pending[hook.Name]++
+			dependents[before] = append(dependents[before], hook.Name)
+		}
+	}
+
+	ordered := make([]*Hook, 0, len(j.Hooks))
+	done := make(map[string]bool, len(j.Hooks))
+	for len(ordered) < len(j.Hooks) {
+		var next *Hook
+		for _, hook := range j.Hooks {
+			if !done[hook.Name] && pending[hook.Name] == 0 {
+				next = hook
+				break
+			}
+		}
+		if next == nil {
+			// cyclic dependencies are rejected on deployment, the hooks left keep their order in the job spec
+			for _, hook := range j.Hooks {
+				if !done[hook.Name] {
+					ordered = append(ordered, hook)
+				}
+			}
+			break
+		}
+
+		done[next.Name] = true
+		ordered = append(ordered, next)
+		for _, after := range dependents[next.Name] {
+			pending[after]--
+		}
+	}
+	return ordered
+}
+
 type Task struct {
-	Name   string
-	Config map[string]string
+	Name    string
+	Version string // version of the plugin pinned by the job, empty runs the default version
+	Config  map[string]string
 }
 
 type Hook struct {
 	Name   string
 	Config map[string]string
+
+	// EnabledWhen is a template compiled against the project and namespace configs on deployment,
+	// the hook is left out of the deployed job unless it compiles to true
+	EnabledWhen string
+
+	// Phase overrides the hook type of the plugin with pre or post, and DependsOn lists
+	// the hooks of the job which should finish before the hook runs
+	Phase     string
+	DependsOn []string
+
+	// When is a template compiled against the task context of every run, the hook is skipped
+	// in the runs where it does not compile to true
+	When string
+}
+
+// OperatorName is the name of the operator running the hook in the scheduler
+func (h Hook) OperatorName() string {
+	return hookOperatorPrefix + h.Name
 }
 
 // JobWithDetails contains the details for a job
@@ -120,6 +200,62 @@ func (j *JobWithDetails) SLADuration() (int64, error) {
 	return 0, nil
 }
 
+const (
+	slaConfigDuration = "duration"
+	slaConfigDeadline = "deadline"
+)
+
+// SLA is when the runs of the job are expected to finish, either a duration after the schedule time
+// or the next tick of the deadline cron after it, the earlier of the two when both are defined
+type SLA struct {
+	Duration time.Duration
+	Deadline *cron.ScheduleSpec
+}
+
+func (s SLA) IsEmpty() bool {
+	return s.Duration == 0 && s.Deadline == nil
+}
+
+// DeadlineFor returns the time by which the run scheduled at scheduledAt is expected to finish
+func (s SLA) DeadlineFor(scheduledAt time.Time) time.Time {
+	var deadline time.Time
+	if s.Duration > 0 {
+		deadline = scheduledAt.Add(s.Duration)
+	}
+	if s.Deadline != nil {
+		if next := s.Deadline.Next(scheduledAt); deadline.IsZero() || next.Before(deadline) {
+			deadline = next
+		}
+	}
+	return deadline
+}
+
+// SLA reads the sla of the job from the config of its sla_miss alerts, the duration and the deadline
+// cron are taken from the first alert defining them
+func (j *JobWithDetails) SLA() (SLA, error) {
+	var sla SLA
+	for _, notify := range j.Alerts {
+		if notify.On != EventCategorySLAMiss {
+			continue
+		}
+		if raw, ok := notify.Config[slaConfigDuration]; ok && sla.Duration == 0 {
+			dur, err := time.ParseDuration(raw)
+			if err != nil {
+				return SLA{}, fmt.Errorf("failed to parse sla_miss duration %s: %w", raw, err)
+			}
+			sla.Duration = dur
+		}
+		if raw, ok := notify.Config[slaConfigDeadline]; ok && sla.Deadline == nil {
+			deadline, err := cron.ParseCronSchedule(raw)
+			if err != nil {
+				return SLA{}, fmt.Errorf("failed to parse sla_miss deadline %s: %w", raw, err)
+			}
+			sla.Deadline = deadline
+		}
+	}
+	return sla, nil
+}
+
 type JobMetadata struct {
 	Version     int
 	Owner       string
@@ -132,6 +268,34 @@ type Schedule struct {
 	StartDate     time.Time
 	EndDate       *time.Time
 	Interval      string
+	CatchUp       CatchUpPolicy
+}
+
+// CatchUpPolicy controls which of the runs scheduled before the job is deployed are run, empty policy is last_only
+type CatchUpPolicy string
+
+const (
+	CatchUpNone     CatchUpPolicy = "none"
+	CatchUpLastOnly CatchUpPolicy = "last_only"
+	CatchUpFull     CatchUpPolicy = "full"
+)
+
+// Backfills tells whether the scheduler runs every run missed since the start date
+func (c CatchUpPolicy) Backfills() bool {
+	return c == CatchUpFull
+}
+
+// RunsFrom returns the date the scheduler starts scheduling the job from, under the none catch up policy
+// the runs scheduled before the job was first deployed are skipped
+func (j *JobWithDetails) RunsFrom() time.Time {
+	if j.Schedule == nil {
+		return time.Time{}
+	}
+	startDate := j.Schedule.StartDate
+	if j.Schedule.CatchUp == CatchUpNone && j.Job != nil && j.Job.CreatedAt.After(startDate) {
+		return j.Job.CreatedAt.UTC()
+	}
+	return startDate
 }
 
 func (j *JobWithDetails) GetLabelsAsString() string {
@@ -166,9 +330,83 @@ type Alert struct {
 	Config   map[string]string
 }
 
+// AlertRoutesFrom reads the alerts routed to every job of the namespace from its configs, skipping
+// the channels which are not in the scheme://route form
+func AlertRoutesFrom(configs map[string]string) []Alert {
+	var alerts []Alert
+	for key, value := range configs {
+		if !strings.HasPrefix(key, tenant.NamespaceAlertRoutePrefix) {
+			continue
+		}
+		var channels []string
+		for _, channel := range strings.Split(value, ",") {
+			channel = strings.TrimSpace(channel)
+			if parts := strings.SplitN(channel, "://", 2); len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+				continue
+			}
+			channels = append(channels, channel)
+		}
+		if len(channels) == 0 {
+			continue
+		}
+		alerts = append(alerts, Alert{
+			On:       JobEventCategory(strings.ToLower(strings.TrimPrefix(key, tenant.NamespaceAlertRoutePrefix))),
+			Channels: channels,
+		})
+	}
+	sort.Slice(alerts, func(i, j int) bool { return alerts[i].On < alerts[j].On })
+	return alerts
+}
+
 type RuntimeConfig struct {
 	Resource  *Resource
 	Scheduler map[string]string
+	Sensors   []SensorConfig
+}
+
+// SensorConfig overrides the poke interval, timeout and freshness of the sensor waiting for an upstream, a zero
+// value leaves it to the scheduler default and an empty upstream applies to every upstream
+type SensorConfig struct {
+	Upstream     string
+	PokeInterval time.Duration
+	Timeout      time.Duration
+	Freshness    SensorFreshness
+}
+
+// SensorFreshness requires the data of an upstream to be fresh besides its runs being successful, the partition
+// of the window start of the run existing, and the data modified within max age when it is greater than zero
+type SensorFreshness struct {
+	PartitionExists bool
+	MaxAge          time.Duration
+}
+
+func (f SensorFreshness) IsEmpty() bool {
+	return !f.PartitionExists && f.MaxAge == 0
+}
+
+// SensorFor returns the sensor config of an upstream, the upstream is matched on its resource urn,
+// project/job name or job name, and falls back per field to the default sensor config
+func (r RuntimeConfig) SensorFor(upstream *JobUpstream) SensorConfig {
+	var defaultSensor, upstreamSensor SensorConfig
+	for _, sensor := range r.Sensors {
+		switch sensor.Upstream {
+		case "":
+			defaultSensor = sensor
+		case upstream.DestinationURN, upstream.JobName, upstream.Tenant.ProjectName().String() + "/" + upstream.JobName:
+			upstreamSensor = sensor
+		}
+	}
+
+	if upstreamSensor.PokeInterval == 0 {
+		upstreamSensor.PokeInterval = defaultSensor.PokeInterval
+	}
+	if upstreamSensor.Timeout == 0 {
+		upstreamSensor.Timeout = defaultSensor.Timeout
+	}
+	if upstreamSensor.Freshness.IsEmpty() {
+		upstreamSensor.Freshness = defaultSensor.Freshness
+	}
+	return upstreamSensor
 }
 
 type Resource struct {
@@ -193,6 +431,10 @@ type HTTPUpstreams struct {
 	Params  map[string]string
 }
 
+// UpstreamHostBigQuery is the host of the external upstreams on bigquery tables not managed by any optimus,
+// their sensors wait on the table being modified instead of on a job run
+const UpstreamHostBigQuery = "bigquery"
+
 type JobUpstream struct {
 	JobName        string
 	Host           string
@@ -203,3 +445,7 @@ type JobUpstream struct {
 	External       bool
 	State          string
 }
+
+func (u *JobUpstream) IsBigQueryTable() bool {
+	return u.External && u.Host == UpstreamHostBigQuery
+}
@@ -45,6 +45,10 @@ type JobRun struct {
 	SLADefinition int64
 
 	Monitoring map[string]any
+	Artifacts  map[string]any
+
+	// HeartbeatAt is the last time the executor of the run reported it is alive
+	HeartbeatAt *time.Time
 }
 
 func (j *JobRun) HasSLABreached() bool {
@@ -54,6 +58,48 @@ func (j *JobRun) HasSLABreached() bool {
 	return time.Now().After(j.StartTime.Add(time.Second * time.Duration(j.SLADefinition)))
 }
 
+// RunStartEstimate describes when a run which has not started yet is expected to start
+type RunStartEstimate struct {
+	ScheduledAt        time.Time
+	State              State
+	QueuePosition      int
+	EstimatedStartTime time.Time
+	Reason             string
+
+	// Pool is the usage of the scheduler pool of the job when the run is due, nil when it is not known
+	Pool *PoolSlots
+}
+
+// ScheduleRecommendation suggests shifting the schedule of a job to the time its upstreams are usually
+// done, a positive Shift delays the schedule and a negative one brings it forward
+type ScheduleRecommendation struct {
+	JobName             JobName
+	CurrentSchedule     string
+	RecommendedSchedule string
+	Shift               time.Duration
+
+	// UpstreamsReadyAfter is the 90th percentile of the time between the schedule of the job and the
+	// completion of its last upstream, over SampleSize schedules
+	UpstreamsReadyAfter time.Duration
+	SampleSize          int
+	Reason              string
+}
+
+// CriticalPath is the chain of runs which gated the completion of a run, starting from the furthest upstream run
+// and ending with the run itself, every run in the chain waited for the run before it, its last upstream to finish
+type CriticalPath struct {
+	Runs []*JobRun
+}
+
+// Duration returns the time from the start of the first run of the chain to the end of the last one, zero when
+// the last run has not finished
+func (c *CriticalPath) Duration() time.Duration {
+	if len(c.Runs) == 0 || c.Runs[len(c.Runs)-1].EndTime == nil {
+		return 0
+	}
+	return c.Runs[len(c.Runs)-1].EndTime.Sub(c.Runs[0].StartTime)
+}
+
 type OperatorRun struct {
 	ID           uuid.UUID
 	Name         string
@@ -64,6 +110,20 @@ type OperatorRun struct {
 	EndTime      *time.Time
 }
 
+// Duration returns the time taken by the operator run, or taken so far when it has not finished by the time
+func (o *OperatorRun) Duration(now time.Time) time.Duration {
+	if o.EndTime != nil {
+		return o.EndTime.Sub(o.StartTime)
+	}
+	return now.Sub(o.StartTime)
+}
+
+// JobRunDetail is the timeline of a run, with the sensor, task and hook runs in the order they started
+type JobRunDetail struct {
+	JobRun    *JobRun
+	Operators []*OperatorRun
+}
+
 type NotifyAttrs struct {
 	Owner    string
 	JobEvent *Event
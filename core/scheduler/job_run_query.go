@@ -0,0 +1,81 @@
+package scheduler
+
+import (
+	"strings"
+	"time"
+
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+)
+
+const (
+	DefaultJobRunQueryPageSize = 100
+	MaxJobRunQueryPageSize     = 1000
+)
+
+// JobRunSortField is the field job runs returned by a query are sorted on
+type JobRunSortField string
+
+const (
+	JobRunSortByScheduledAt JobRunSortField = "scheduled_at"
+	JobRunSortByStartTime   JobRunSortField = "start_time"
+	JobRunSortByEndTime     JobRunSortField = "end_time"
+	JobRunSortByJobName     JobRunSortField = "job_name"
+)
+
+func JobRunSortFieldFrom(field string) (JobRunSortField, error) {
+	switch JobRunSortField(strings.ToLower(field)) {
+	case "":
+		return JobRunSortByScheduledAt, nil
+	case JobRunSortByScheduledAt, JobRunSortByStartTime, JobRunSortByEndTime, JobRunSortByJobName:
+		return JobRunSortField(strings.ToLower(field)), nil
+	default:
+		return "", errors.InvalidArgument(EntityJobRun, "invalid sort field "+field)
+	}
+}
+
+// JobRunQuery selects the runs of the jobs of a project, optionally narrowed down to a namespace, to the jobs with
+// names matching a pattern where * matches any characters, to some states and to a range of scheduled times
+type JobRunQuery struct {
+	ProjectName    tenant.ProjectName
+	NamespaceName  tenant.NamespaceName
+	JobNamePattern string
+	States         []State
+
+	ScheduledFrom time.Time
+	ScheduledTo   time.Time
+
+	SortBy     JobRunSortField
+	Descending bool
+
+	Offset   int
+	PageSize int
+}
+
+func (q *JobRunQuery) Validate() error {
+	if q.ProjectName == "" {
+		return errors.InvalidArgument(EntityJobRun, "project name is empty")
+	}
+	if !q.ScheduledFrom.IsZero() && !q.ScheduledTo.IsZero() && q.ScheduledTo.Before(q.ScheduledFrom) {
+		return errors.InvalidArgument(EntityJobRun, "scheduled to should not be before scheduled from")
+	}
+	if q.Offset < 0 {
+		return errors.InvalidArgument(EntityJobRun, "offset should not be negative")
+	}
+	if q.PageSize < 0 || q.PageSize > MaxJobRunQueryPageSize {
+		return errors.InvalidArgument(EntityJobRun, "page size should be between 1 and 1000")
+	}
+	if q.PageSize == 0 {
+		q.PageSize = DefaultJobRunQueryPageSize
+	}
+	if q.SortBy == "" {
+		q.SortBy = JobRunSortByScheduledAt
+	}
+	return nil
+}
+
+// JobRunPage is a page of the runs matching a query, NextOffset is set when there are more runs to fetch
+type JobRunPage struct {
+	Runs       []*JobRun
+	NextOffset int
+}
@@ -0,0 +1,42 @@
+package scheduler_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/core/scheduler"
+)
+
+func TestJobRunQuery(t *testing.T) {
+	t.Run("Validate", func(t *testing.T) {
+		t.Run("returns error when project name is empty", func(t *testing.T) {
+			query := &scheduler.JobRunQuery{}
+			assert.ErrorContains(t, query.Validate(), "project name is empty")
+		})
+		t.Run("returns error when page size is over the limit", func(t *testing.T) {
+			query := &scheduler.JobRunQuery{ProjectName: "proj", PageSize: scheduler.MaxJobRunQueryPageSize + 1}
+			assert.ErrorContains(t, query.Validate(), "page size should be between")
+		})
+		t.Run("returns error when the scheduled time range is reversed", func(t *testing.T) {
+			now := time.Now()
+			query := &scheduler.JobRunQuery{ProjectName: "proj", ScheduledFrom: now, ScheduledTo: now.Add(-time.Hour)}
+			assert.ErrorContains(t, query.Validate(), "scheduled to should not be before scheduled from")
+		})
+		t.Run("sets the default page size and sort field", func(t *testing.T) {
+			query := &scheduler.JobRunQuery{ProjectName: "proj"}
+			assert.NoError(t, query.Validate())
+			assert.Equal(t, scheduler.DefaultJobRunQueryPageSize, query.PageSize)
+			assert.Equal(t, scheduler.JobRunSortByScheduledAt, query.SortBy)
+		})
+	})
+	t.Run("JobRunSortFieldFrom", func(t *testing.T) {
+		field, err := scheduler.JobRunSortFieldFrom("START_TIME")
+		assert.NoError(t, err)
+		assert.Equal(t, scheduler.JobRunSortByStartTime, field)
+
+		_, err = scheduler.JobRunSortFieldFrom("duration")
+		assert.ErrorContains(t, err, "invalid sort field duration")
+	})
+}
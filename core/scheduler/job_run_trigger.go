@@ -0,0 +1,38 @@
+package scheduler
+
+import (
+	"time"
+
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+)
+
+// JobRunTrigger is a run of the job triggered by hand for a logical time, the config overrides are set on the
+// compiled task configs of that run only
+type JobRunTrigger struct {
+	Tenant          tenant.Tenant
+	JobName         JobName
+	ScheduledAt     time.Time
+	ConfigOverrides map[string]string
+}
+
+func NewJobRunTrigger(tnnt tenant.Tenant, jobName JobName, scheduledAt time.Time, configOverrides map[string]string) (*JobRunTrigger, error) {
+	if scheduledAt.IsZero() {
+		return nil, errors.InvalidArgument(EntityJobRun, "scheduled_at of the run to trigger is empty")
+	}
+	for key := range configOverrides {
+		if key == "" {
+			return nil, errors.InvalidArgument(EntityJobRun, "config override key is empty")
+		}
+	}
+	if configOverrides == nil {
+		configOverrides = map[string]string{}
+	}
+
+	return &JobRunTrigger{
+		Tenant:          tnnt,
+		JobName:         jobName,
+		ScheduledAt:     scheduledAt.UTC(),
+		ConfigOverrides: configOverrides,
+	}, nil
+}
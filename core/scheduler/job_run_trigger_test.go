@@ -0,0 +1,35 @@
+package scheduler_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/tenant"
+)
+
+func TestJobRunTrigger(t *testing.T) {
+	tnnt, _ := tenant.NewTenant("proj", "ns1")
+	scheduledAt := time.Date(2023, 10, 10, 10, 0, 0, 0, time.FixedZone("WIB", 7*60*60))
+
+	t.Run("NewJobRunTrigger", func(t *testing.T) {
+		t.Run("should return error if scheduled at is empty", func(t *testing.T) {
+			trigger, err := scheduler.NewJobRunTrigger(tnnt, "job-a", time.Time{}, nil)
+			assert.ErrorContains(t, err, "scheduled_at of the run to trigger is empty")
+			assert.Nil(t, trigger)
+		})
+		t.Run("should return error if a config override key is empty", func(t *testing.T) {
+			trigger, err := scheduler.NewJobRunTrigger(tnnt, "job-a", scheduledAt, map[string]string{"": "value"})
+			assert.ErrorContains(t, err, "config override key is empty")
+			assert.Nil(t, trigger)
+		})
+		t.Run("should return trigger scheduled at the time in utc", func(t *testing.T) {
+			trigger, err := scheduler.NewJobRunTrigger(tnnt, "job-a", scheduledAt, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, time.Date(2023, 10, 10, 3, 0, 0, 0, time.UTC), trigger.ScheduledAt)
+			assert.Empty(t, trigger.ConfigOverrides)
+		})
+	})
+}
@@ -67,12 +67,74 @@ func TestJob(t *testing.T) {
 			assert.Nil(t, hook)
 		})
 	})
+	t.Run("OrderedHooks", func(t *testing.T) {
+		t.Run("keeps the order of the job spec for independent hooks", func(t *testing.T) {
+			publish := &scheduler.Hook{Name: "publish"}
+			quality := &scheduler.Hook{Name: "quality"}
+			job := scheduler.Job{Name: "jobName", Hooks: []*scheduler.Hook{publish, quality}}
+
+			assert.Equal(t, []*scheduler.Hook{publish, quality}, job.OrderedHooks(nil))
+		})
+		t.Run("orders the hooks after the hooks they depend on", func(t *testing.T) {
+			audit := &scheduler.Hook{Name: "audit"}
+			publish := &scheduler.Hook{Name: "publish", DependsOn: []string{"quality"}}
+			quality := &scheduler.Hook{Name: "quality"}
+			notify := &scheduler.Hook{Name: "notify"}
+			job := scheduler.Job{Name: "jobName", Hooks: []*scheduler.Hook{audit, publish, quality, notify}}
+
+			pluginDependsOn := map[string][]string{"audit": {"notify"}}
+			assert.Equal(t, []*scheduler.Hook{quality, publish, notify, audit}, job.OrderedHooks(pluginDependsOn))
+		})
+		t.Run("skips the dependencies on hooks not in the job", func(t *testing.T) {
+			publish := &scheduler.Hook{Name: "publish", DependsOn: []string{"quality"}}
+			audit := &scheduler.Hook{Name: "audit"}
+			job := scheduler.Job{Name: "jobName", Hooks: []*scheduler.Hook{publish, audit}}
+
+			assert.Equal(t, []*scheduler.Hook{publish, audit}, job.OrderedHooks(nil))
+		})
+		t.Run("keeps the order of the job spec for cyclic hooks", func(t *testing.T) {
+			publish := &scheduler.Hook{Name: "publish", DependsOn: []string{"quality"}}
+			quality := &scheduler.Hook{Name: "quality", DependsOn: []string{"publish"}}
+			audit := &scheduler.Hook{Name: "audit"}
+			job := scheduler.Job{Name: "jobName", Hooks: []*scheduler.Hook{publish, quality, audit}}
+
+			assert.Equal(t, []*scheduler.Hook{audit, publish, quality}, job.OrderedHooks(nil))
+		})
+	})
 	t.Run("GetName", func(t *testing.T) {
 		jobWithDetails := scheduler.JobWithDetails{
 			Name: "jobName",
 		}
 		assert.Equal(t, "jobName", jobWithDetails.GetName())
 	})
+	t.Run("RunsFrom", func(t *testing.T) {
+		startDate := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+		createdAt := time.Date(2023, 5, 6, 7, 8, 0, 0, time.UTC)
+
+		t.Run("returns the start date for the last_only and full policies", func(t *testing.T) {
+			for _, policy := range []scheduler.CatchUpPolicy{"", scheduler.CatchUpLastOnly, scheduler.CatchUpFull} {
+				jobWithDetails := scheduler.JobWithDetails{
+					Job:      &scheduler.Job{CreatedAt: createdAt},
+					Schedule: &scheduler.Schedule{StartDate: startDate, CatchUp: policy},
+				}
+				assert.Equal(t, startDate, jobWithDetails.RunsFrom())
+			}
+		})
+		t.Run("returns the first deployment for the none policy", func(t *testing.T) {
+			jobWithDetails := scheduler.JobWithDetails{
+				Job:      &scheduler.Job{CreatedAt: createdAt},
+				Schedule: &scheduler.Schedule{StartDate: startDate, CatchUp: scheduler.CatchUpNone},
+			}
+			assert.Equal(t, createdAt, jobWithDetails.RunsFrom())
+		})
+		t.Run("returns the start date for the none policy when it is after the first deployment", func(t *testing.T) {
+			jobWithDetails := scheduler.JobWithDetails{
+				Job:      &scheduler.Job{CreatedAt: startDate},
+				Schedule: &scheduler.Schedule{StartDate: createdAt, CatchUp: scheduler.CatchUpNone},
+			}
+			assert.Equal(t, createdAt, jobWithDetails.RunsFrom())
+		})
+	})
 	t.Run("SLADuration", func(t *testing.T) {
 		t.Run("has job breached SLA", func(t *testing.T) {
 			t.Run("duration 1.5 hr", func(t *testing.T) {
@@ -187,6 +249,62 @@ func TestJob(t *testing.T) {
 			assert.Equal(t, int64(7200), duration)
 		})
 	})
+	t.Run("SLA", func(t *testing.T) {
+		scheduledAt := time.Date(2023, 10, 10, 10, 0, 0, 0, time.UTC)
+		t.Run("returns empty sla when job has no sla_miss alert", func(t *testing.T) {
+			jobWithDetails := scheduler.JobWithDetails{Name: "jobName"}
+			sla, err := jobWithDetails.SLA()
+			assert.Nil(t, err)
+			assert.True(t, sla.IsEmpty())
+		})
+		t.Run("returns error when deadline is not a valid cron", func(t *testing.T) {
+			jobWithDetails := scheduler.JobWithDetails{
+				Name: "jobName",
+				Alerts: []scheduler.Alert{
+					{On: scheduler.EventCategorySLAMiss, Config: map[string]string{"deadline": "invalid"}},
+				},
+			}
+			_, err := jobWithDetails.SLA()
+			assert.ErrorContains(t, err, "failed to parse sla_miss deadline invalid")
+		})
+		t.Run("returns deadline after the duration", func(t *testing.T) {
+			jobWithDetails := scheduler.JobWithDetails{
+				Name: "jobName",
+				Alerts: []scheduler.Alert{
+					{On: scheduler.EventCategorySLAMiss, Config: map[string]string{"duration": "2h"}},
+				},
+			}
+			sla, err := jobWithDetails.SLA()
+			assert.Nil(t, err)
+			assert.Equal(t, time.Date(2023, 10, 10, 12, 0, 0, 0, time.UTC), sla.DeadlineFor(scheduledAt))
+		})
+		t.Run("returns the earlier of the duration and the deadline cron", func(t *testing.T) {
+			jobWithDetails := scheduler.JobWithDetails{
+				Name: "jobName",
+				Alerts: []scheduler.Alert{
+					{On: scheduler.EventCategorySLAMiss, Config: map[string]string{"duration": "2h"}},
+					{On: scheduler.EventCategorySLAMiss, Config: map[string]string{"deadline": "30 11 * * *"}},
+				},
+			}
+			sla, err := jobWithDetails.SLA()
+			assert.Nil(t, err)
+			assert.Equal(t, time.Date(2023, 10, 10, 11, 30, 0, 0, time.UTC), sla.DeadlineFor(scheduledAt))
+		})
+	})
+	t.Run("AlertRoutesFrom", func(t *testing.T) {
+		t.Run("returns the channels routed per event category skipping invalid ones", func(t *testing.T) {
+			alerts := scheduler.AlertRoutesFrom(map[string]string{
+				"ALERT_ROUTE__SLA_MISS": "webhook://#sla",
+				"ALERT_ROUTE__FAILURE":  "slack://#alerts, invalid, pagerduty://#oncall",
+				"ALERT_ROUTE__EMPTY":    " ",
+				"STORAGE_PATH":          "somePath",
+			})
+			assert.Equal(t, []scheduler.Alert{
+				{On: scheduler.EventCategoryJobFailure, Channels: []string{"slack://#alerts", "pagerduty://#oncall"}},
+				{On: scheduler.EventCategorySLAMiss, Channels: []string{"webhook://#sla"}},
+			}, alerts)
+		})
+	})
 	t.Run("GetLabelsAsString", func(t *testing.T) {
 		jobWithDetails := scheduler.JobWithDetails{
 			Name: "jobName",
@@ -252,4 +370,58 @@ func TestJob(t *testing.T) {
 		assert.Equal(t, 3, len(group[t1]))
 		assert.Equal(t, 1, len(group[t3]))
 	})
+	t.Run("SensorFor", func(t *testing.T) {
+		upstreamTenant, _ := tenant.NewTenant("upstream-proj", "ns1")
+		upstream := &scheduler.JobUpstream{
+			JobName:        "upstream-job",
+			DestinationURN: "bigquery://upstream-proj:dataset.table",
+			Tenant:         upstreamTenant,
+		}
+		t.Run("should return zero config if no sensor is configured", func(t *testing.T) {
+			runtimeConfig := scheduler.RuntimeConfig{}
+
+			assert.Equal(t, scheduler.SensorConfig{}, runtimeConfig.SensorFor(upstream))
+		})
+		t.Run("should return default config if upstream has no override", func(t *testing.T) {
+			runtimeConfig := scheduler.RuntimeConfig{
+				Sensors: []scheduler.SensorConfig{
+					{PokeInterval: time.Minute, Timeout: time.Hour},
+					{Upstream: "another-job", PokeInterval: time.Second, Timeout: time.Second},
+				},
+			}
+
+			sensor := runtimeConfig.SensorFor(upstream)
+			assert.Equal(t, time.Minute, sensor.PokeInterval)
+			assert.Equal(t, time.Hour, sensor.Timeout)
+		})
+		t.Run("should match upstream on urn, project/job name and job name falling back to default per field", func(t *testing.T) {
+			for _, name := range []string{"bigquery://upstream-proj:dataset.table", "upstream-proj/upstream-job", "upstream-job"} {
+				runtimeConfig := scheduler.RuntimeConfig{
+					Sensors: []scheduler.SensorConfig{
+						{PokeInterval: time.Minute, Timeout: time.Hour},
+						{Upstream: name, PokeInterval: 5 * time.Minute},
+					},
+				}
+
+				sensor := runtimeConfig.SensorFor(upstream)
+				assert.Equal(t, name, sensor.Upstream)
+				assert.Equal(t, 5*time.Minute, sensor.PokeInterval)
+				assert.Equal(t, time.Hour, sensor.Timeout)
+			}
+		})
+		t.Run("should fall back to default freshness if upstream has no freshness of its own", func(t *testing.T) {
+			defaultFreshness := scheduler.SensorFreshness{PartitionExists: true}
+			runtimeConfig := scheduler.RuntimeConfig{
+				Sensors: []scheduler.SensorConfig{
+					{Freshness: defaultFreshness},
+					{Upstream: "upstream-job", PokeInterval: 5 * time.Minute},
+				},
+			}
+			assert.Equal(t, defaultFreshness, runtimeConfig.SensorFor(upstream).Freshness)
+
+			upstreamFreshness := scheduler.SensorFreshness{MaxAge: 6 * time.Hour}
+			runtimeConfig.Sensors[1].Freshness = upstreamFreshness
+			assert.Equal(t, upstreamFreshness, runtimeConfig.SensorFor(upstream).Freshness)
+		})
+	})
 }
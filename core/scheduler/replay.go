@@ -14,6 +14,9 @@ const (
 	// initial state
 	ReplayStateCreated ReplayState = "created"
 
+	// queued state, when the tenant already has the maximum number of active replays
+	ReplayStateWaiting ReplayState = "waiting"
+
 	// running state
 	ReplayStateInProgress      ReplayState = "in progress"
 	ReplayStatePartialReplayed ReplayState = "partial replayed"
@@ -26,14 +29,27 @@ const (
 
 	// state on presentation layer
 	ReplayUserStateCreated    ReplayUserState = "created"
+	ReplayUserStateWaiting    ReplayUserState = "waiting"
 	ReplayUserStateInProgress ReplayUserState = "in progress"
 	ReplayUserStateInvalid    ReplayUserState = "invalid"
 	ReplayUserStateSuccess    ReplayUserState = "success"
 	ReplayUserStateFailed     ReplayUserState = "failed"
 
 	EntityReplay = "replay"
+
+	// ReplayReasonMetadataKey is the metadata key used by clients to pass the reason along with the replay request
+	ReplayReasonMetadataKey = "x-replay-reason"
+
+	// ReplayExcludedScheduledAtMetadataKey is used by clients to pass the scheduled_at (RFC3339) of runs to be skipped
+	ReplayExcludedScheduledAtMetadataKey = "x-replay-excluded-scheduled-at"
+
+	// ReplayFromFailedMetadataKey is used by clients to replay only the failed and missing runs within the range
+	ReplayFromFailedMetadataKey = "x-replay-from-failed"
 )
 
+// ReplayFromFailedStates are the run states picked up by a replay created from failed runs
+var ReplayFromFailedStates = []State{StateFailed, StateMissing}
+
 type (
 	ReplayState     string // contract status for business layer
 	ReplayUserState string // contract status for presentation layer
@@ -43,6 +59,8 @@ func ReplayStateFromString(state string) (ReplayState, error) {
 	switch strings.ToLower(state) {
 	case string(ReplayStateCreated):
 		return ReplayStateCreated, nil
+	case string(ReplayStateWaiting):
+		return ReplayStateWaiting, nil
 	case string(ReplayStateInProgress):
 		return ReplayStateInProgress, nil
 	case string(ReplayStateInvalid):
@@ -64,6 +82,10 @@ func (j ReplayState) String() string {
 	return string(j)
 }
 
+func (j ReplayState) IsTerminal() bool {
+	return j == ReplayStateInvalid || j == ReplayStateSuccess || j == ReplayStateFailed
+}
+
 func (j ReplayUserState) String() string {
 	return string(j)
 }
@@ -105,6 +127,8 @@ func (r *Replay) UserState() ReplayUserState {
 	switch r.state {
 	case ReplayStateCreated:
 		return ReplayUserStateCreated
+	case ReplayStateWaiting:
+		return ReplayUserStateWaiting
 	case ReplayStateInProgress, ReplayStatePartialReplayed, ReplayStateReplayed:
 		return ReplayUserStateInProgress
 	case ReplayStateInvalid:
@@ -155,12 +179,54 @@ func (r *ReplayWithRun) GetLastExecutableRun() *JobRunStatus {
 	return nil
 }
 
+// ReplayStateTransition records a state the replay has moved into, used for auditing replays
+type ReplayStateTransition struct {
+	State     ReplayState
+	Message   string
+	CreatedAt time.Time
+}
+
+// ReplayDetails contains the replay along with its runs and every state transition it went through
+type ReplayDetails struct {
+	Replay      *Replay
+	Runs        []*JobRunStatus
+	Transitions []*ReplayStateTransition
+}
+
 type ReplayConfig struct {
 	StartTime   time.Time
 	EndTime     time.Time
 	Parallel    bool
 	JobConfig   map[string]string
 	Description string
+
+	RequestedBy string // actor who requested the replay
+	Reason      string // optional free-text reason of the replay
+
+	ExcludedScheduledAt []time.Time // runs within the range which should not be cleared or created
+}
+
+func (c *ReplayConfig) IsExcluded(scheduledAt time.Time) bool {
+	for _, excluded := range c.ExcludedScheduledAt {
+		if excluded.Equal(scheduledAt) {
+			return true
+		}
+	}
+	return false
+}
+
+// ExcludeRuns removes the runs which are excluded from the replay
+func (c *ReplayConfig) ExcludeRuns(runs []*JobRunStatus) []*JobRunStatus {
+	if len(c.ExcludedScheduledAt) == 0 {
+		return runs
+	}
+	var filteredRuns []*JobRunStatus
+	for _, run := range runs {
+		if !c.IsExcluded(run.ScheduledAt) {
+			filteredRuns = append(filteredRuns, run)
+		}
+	}
+	return filteredRuns
 }
 
 func NewReplayConfig(startTime, endTime time.Time, parallel bool, jobConfig map[string]string, description string) *ReplayConfig {
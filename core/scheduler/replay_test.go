@@ -105,6 +105,8 @@ func TestReplay(t *testing.T) {
 		expectationsMap := map[string]scheduler.ReplayState{
 			"created":          scheduler.ReplayStateCreated,
 			"CREATED":          scheduler.ReplayStateCreated,
+			"waiting":          scheduler.ReplayStateWaiting,
+			"WAITING":          scheduler.ReplayStateWaiting,
 			"in progress":      scheduler.ReplayStateInProgress,
 			"IN PROGRESS":      scheduler.ReplayStateInProgress,
 			"invalid":          scheduler.ReplayStateInvalid,
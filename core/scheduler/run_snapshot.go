@@ -0,0 +1,30 @@
+package scheduler
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/goto/optimus/core/tenant"
+)
+
+const EntityRunSnapshot = "run_snapshot"
+
+// RunSnapshot is the compiled input handed over to the executor of a job run, kept to audit what was run until it
+// expires. Only the configs and the files are kept, the secrets and the files holding secrets are never stored.
+type RunSnapshot struct {
+	ID uuid.UUID
+
+	ProjectName tenant.ProjectName
+	JobName     JobName
+	Executor    Executor
+	ScheduledAt time.Time
+	Attempt     int
+
+	// Configs and Files are only set when the snapshot is read by its id
+	Configs map[string]string
+	Files   map[string]string
+
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
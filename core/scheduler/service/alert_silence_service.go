@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/goto/salt/log"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+)
+
+type AlertSilenceRepository interface {
+	Create(ctx context.Context, silence *scheduler.AlertSilence) error
+	GetActive(ctx context.Context, projectName tenant.ProjectName, at time.Time) ([]*scheduler.AlertSilence, error)
+	Expire(ctx context.Context, projectName tenant.ProjectName, id uuid.UUID, at time.Time) error
+}
+
+type AlertSilenceService struct {
+	repo AlertSilenceRepository
+	now  func() time.Time
+
+	l log.Logger
+}
+
+func (s *AlertSilenceService) Create(ctx context.Context, silence *scheduler.AlertSilence) error {
+	if !silence.IsActive(s.now()) {
+		return errors.InvalidArgument(scheduler.EntityAlertSilence, "silence should expire in the future")
+	}
+	if err := s.repo.Create(ctx, silence); err != nil {
+		s.l.Error("error creating alert silence in project [%s]: %s", silence.ProjectName, err)
+		return err
+	}
+	s.l.Info("alerts in project [%s] are silenced by %s until %s: %s", silence.ProjectName, silence.CreatedBy,
+		silence.ExpiresAt.Format(time.RFC3339), silence.Reason)
+	return nil
+}
+
+func (s *AlertSilenceService) GetActive(ctx context.Context, projectName tenant.ProjectName) ([]*scheduler.AlertSilence, error) {
+	return s.repo.GetActive(ctx, projectName, s.now())
+}
+
+// Expire ends the silence right away instead of waiting for it to expire
+func (s *AlertSilenceService) Expire(ctx context.Context, projectName tenant.ProjectName, id uuid.UUID) error {
+	if err := s.repo.Expire(ctx, projectName, id, s.now()); err != nil {
+		s.l.Error("error expiring alert silence [%s] in project [%s]: %s", id.String(), projectName, err)
+		return err
+	}
+	return nil
+}
+
+// GetSilence returns the active silence selecting the job, or nil when the alerts of the job are not silenced
+func (s *AlertSilenceService) GetSilence(ctx context.Context, job *scheduler.JobWithDetails) (*scheduler.AlertSilence, error) {
+	silences, err := s.repo.GetActive(ctx, job.Job.Tenant.ProjectName(), s.now())
+	if err != nil {
+		return nil, err
+	}
+	for _, silence := range silences {
+		if silence.Selects(job) {
+			return silence, nil
+		}
+	}
+	return nil, nil
+}
+
+func NewAlertSilenceService(l log.Logger, repo AlertSilenceRepository, now func() time.Time) *AlertSilenceService {
+	return &AlertSilenceService{
+		repo: repo,
+		now:  now,
+		l:    l,
+	}
+}
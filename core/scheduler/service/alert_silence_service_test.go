@@ -0,0 +1,126 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/scheduler/service"
+	"github.com/goto/optimus/core/tenant"
+)
+
+func TestAlertSilenceService(t *testing.T) {
+	ctx := context.Background()
+	logger := log.NewNoop()
+	now := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+	nowFn := func() time.Time { return now }
+
+	projectName := tenant.ProjectName("proj")
+	tnnt, _ := tenant.NewTenant(projectName.String(), "ns1")
+	job := &scheduler.JobWithDetails{
+		Name:        "job-a",
+		Job:         &scheduler.Job{Name: "job-a", Tenant: tnnt},
+		JobMetadata: &scheduler.JobMetadata{Labels: map[string]string{"team": "data"}},
+	}
+
+	t.Run("Create", func(t *testing.T) {
+		t.Run("returns error if silence is already expired", func(t *testing.T) {
+			silence, _ := scheduler.NewAlertSilence(projectName, "ns1", "", nil, "maintenance", "user", now.Add(time.Minute), now.Add(-time.Hour))
+			silenceService := service.NewAlertSilenceService(logger, nil, func() time.Time { return now.Add(time.Hour) })
+
+			err := silenceService.Create(ctx, silence)
+			assert.ErrorContains(t, err, "silence should expire in the future")
+		})
+		t.Run("stores the silence", func(t *testing.T) {
+			silence, _ := scheduler.NewAlertSilence(projectName, "ns1", "", nil, "maintenance", "user", now.Add(time.Hour), now)
+			repo := new(mockAlertSilenceRepository)
+			defer repo.AssertExpectations(t)
+			repo.On("Create", ctx, silence).Return(nil)
+
+			silenceService := service.NewAlertSilenceService(logger, repo, nowFn)
+
+			err := silenceService.Create(ctx, silence)
+			assert.NoError(t, err)
+		})
+	})
+	t.Run("Expire", func(t *testing.T) {
+		t.Run("expires the silence at the current time", func(t *testing.T) {
+			id := uuid.New()
+			repo := new(mockAlertSilenceRepository)
+			defer repo.AssertExpectations(t)
+			repo.On("Expire", ctx, projectName, id, now).Return(errors.New("some error"))
+
+			silenceService := service.NewAlertSilenceService(logger, repo, nowFn)
+
+			err := silenceService.Expire(ctx, projectName, id)
+			assert.ErrorContains(t, err, "some error")
+		})
+	})
+	t.Run("GetSilence", func(t *testing.T) {
+		t.Run("returns error if unable to get active silences", func(t *testing.T) {
+			repo := new(mockAlertSilenceRepository)
+			defer repo.AssertExpectations(t)
+			repo.On("GetActive", ctx, projectName, now).Return(nil, errors.New("some error"))
+
+			silenceService := service.NewAlertSilenceService(logger, repo, nowFn)
+
+			silence, err := silenceService.GetSilence(ctx, job)
+			assert.ErrorContains(t, err, "some error")
+			assert.Nil(t, silence)
+		})
+		t.Run("returns the active silence selecting the job", func(t *testing.T) {
+			otherNamespace, _ := scheduler.NewAlertSilence(projectName, "ns2", "", nil, "maintenance", "user", now.Add(time.Hour), now)
+			byLabel, _ := scheduler.NewAlertSilence(projectName, "", "", map[string]string{"team": "data"}, "maintenance", "user", now.Add(time.Hour), now)
+			repo := new(mockAlertSilenceRepository)
+			defer repo.AssertExpectations(t)
+			repo.On("GetActive", ctx, projectName, now).Return([]*scheduler.AlertSilence{otherNamespace, byLabel}, nil)
+
+			silenceService := service.NewAlertSilenceService(logger, repo, nowFn)
+
+			silence, err := silenceService.GetSilence(ctx, job)
+			assert.NoError(t, err)
+			assert.Equal(t, byLabel, silence)
+		})
+		t.Run("returns nil if no silence selects the job", func(t *testing.T) {
+			otherJob, _ := scheduler.NewAlertSilence(projectName, "", "job-b", nil, "maintenance", "user", now.Add(time.Hour), now)
+			repo := new(mockAlertSilenceRepository)
+			defer repo.AssertExpectations(t)
+			repo.On("GetActive", ctx, projectName, now).Return([]*scheduler.AlertSilence{otherJob}, nil)
+
+			silenceService := service.NewAlertSilenceService(logger, repo, nowFn)
+
+			silence, err := silenceService.GetSilence(ctx, job)
+			assert.NoError(t, err)
+			assert.Nil(t, silence)
+		})
+	})
+}
+
+type mockAlertSilenceRepository struct {
+	mock.Mock
+}
+
+func (m *mockAlertSilenceRepository) Create(ctx context.Context, silence *scheduler.AlertSilence) error {
+	args := m.Called(ctx, silence)
+	return args.Error(0)
+}
+
+func (m *mockAlertSilenceRepository) GetActive(ctx context.Context, projectName tenant.ProjectName, at time.Time) ([]*scheduler.AlertSilence, error) {
+	args := m.Called(ctx, projectName, at)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*scheduler.AlertSilence), args.Error(1)
+}
+
+func (m *mockAlertSilenceRepository) Expire(ctx context.Context, projectName tenant.ProjectName, id uuid.UUID, at time.Time) error {
+	args := m.Called(ctx, projectName, id, at)
+	return args.Error(0)
+}
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+	"github.com/goto/optimus/internal/lib/cron"
+)
+
+// maxCriticalPathLength bounds the upstream runs walked through, in case of dependency cycles across projects
+const maxCriticalPathLength = 50
+
+// GetCriticalPath walks up from the run of the job at scheduledAt through the upstream run which finished last,
+// for as long as that upstream kept the run waiting past its schedule, giving the chain of runs which gated its completion
+func (s *JobRunService) GetCriticalPath(ctx context.Context, projectName tenant.ProjectName, jobName scheduler.JobName, scheduledAt time.Time) (*scheduler.CriticalPath, error) {
+	details, err := s.jobRepo.GetJobDetails(ctx, projectName, jobName)
+	if err != nil {
+		s.l.Error("error getting job [%s]: %s", jobName, err)
+		return nil, err
+	}
+	jobRun, err := s.repo.GetByScheduledAt(ctx, details.Job.Tenant, jobName, scheduledAt)
+	if err != nil {
+		s.l.Error("error getting job run [%s] scheduled at [%s]: %s", jobName, scheduledAt.String(), err)
+		return nil, err
+	}
+
+	runs := []*scheduler.JobRun{jobRun}
+	visited := map[string]bool{criticalPathKey(details.Job.Tenant, jobName): true}
+	for len(runs) < maxCriticalPathLength {
+		gatingDetails, gatingRun := s.lastUpstreamRun(ctx, details, jobRun, visited)
+		if gatingRun == nil {
+			break
+		}
+		// an upstream done before the schedule did not keep the run waiting
+		if gatingRun.EndTime != nil && !gatingRun.EndTime.After(jobRun.ScheduledAt) {
+			break
+		}
+		runs = append(runs, gatingRun)
+		visited[criticalPathKey(gatingRun.Tenant, gatingRun.JobName)] = true
+		details, jobRun = gatingDetails, gatingRun
+	}
+
+	path := &scheduler.CriticalPath{Runs: make([]*scheduler.JobRun, len(runs))}
+	for i, run := range runs {
+		path.Runs[len(runs)-1-i] = run
+	}
+	return path, nil
+}
+
+// lastUpstreamRun returns the run, among the runs of the upstreams the run depends on, which finished last or has
+// not finished yet
+func (s *JobRunService) lastUpstreamRun(ctx context.Context, details *scheduler.JobWithDetails, jobRun *scheduler.JobRun, visited map[string]bool) (*scheduler.JobWithDetails, *scheduler.JobRun) {
+	var lastDetails *scheduler.JobWithDetails
+	var lastRun *scheduler.JobRun
+	for _, upstream := range details.Upstreams.UpstreamJobs {
+		if upstream.External || upstream.JobName == "" || visited[criticalPathKey(upstream.Tenant, scheduler.JobName(upstream.JobName))] {
+			continue
+		}
+		upstreamDetails, upstreamRun, err := s.upstreamRun(ctx, upstream, jobRun.ScheduledAt)
+		if err != nil {
+			if !errors.IsErrorType(err, errors.ErrNotFound) {
+				s.l.Warn("skipping upstream [%s] of job [%s] for critical path: %s", upstream.JobName, details.Name, err)
+			}
+			continue
+		}
+		if lastRun == nil || finishedAfter(upstreamRun, lastRun) {
+			lastDetails, lastRun = upstreamDetails, upstreamRun
+		}
+	}
+	return lastDetails, lastRun
+}
+
+func (s *JobRunService) upstreamRun(ctx context.Context, upstream *scheduler.JobUpstream, scheduledAt time.Time) (*scheduler.JobWithDetails, *scheduler.JobRun, error) {
+	upstreamDetails, err := s.jobRepo.GetJobDetails(ctx, upstream.Tenant.ProjectName(), scheduler.JobName(upstream.JobName))
+	if err != nil {
+		return nil, nil, err
+	}
+	if upstreamDetails.Schedule == nil {
+		return nil, nil, errors.InvalidArgument(scheduler.EntityJobRun, "upstream has no schedule")
+	}
+	upstreamCron, err := cron.ParseCronSchedule(upstreamDetails.Schedule.Interval)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	run, err := s.repo.GetByScheduledAt(ctx, upstreamDetails.Job.Tenant, upstreamDetails.Name, upstreamScheduleTime(upstreamCron, scheduledAt))
+	if err != nil {
+		return nil, nil, err
+	}
+	return upstreamDetails, run, nil
+}
+
+// finishedAfter tells whether the run finished after the other one, a run which has not finished yet is the last
+func finishedAfter(run, other *scheduler.JobRun) bool {
+	if other.EndTime == nil {
+		return false
+	}
+	return run.EndTime == nil || run.EndTime.After(*other.EndTime)
+}
+
+func criticalPathKey(tnnt tenant.Tenant, jobName scheduler.JobName) string {
+	return tnnt.ProjectName().String() + "/" + jobName.String()
+}
@@ -0,0 +1,91 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/scheduler/service"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+)
+
+func TestJobRunServiceGetCriticalPath(t *testing.T) {
+	ctx := context.Background()
+	logger := log.NewNoop()
+	projName := tenant.ProjectName("proj")
+	tnnt, _ := tenant.NewTenant(projName.String(), "ns1")
+	scheduledAt := time.Date(2023, 10, 10, 2, 0, 0, 0, time.UTC)
+
+	jobWith := func(name, interval string, upstreams ...string) *scheduler.JobWithDetails {
+		details := &scheduler.JobWithDetails{
+			Name:     scheduler.JobName(name),
+			Job:      &scheduler.Job{Name: scheduler.JobName(name), Tenant: tnnt},
+			Schedule: &scheduler.Schedule{Interval: interval},
+		}
+		for _, upstream := range upstreams {
+			details.Upstreams.UpstreamJobs = append(details.Upstreams.UpstreamJobs, &scheduler.JobUpstream{JobName: upstream, Tenant: tnnt})
+		}
+		return details
+	}
+	runOf := func(name string, runScheduledAt time.Time, startAfter, endAfter time.Duration) *scheduler.JobRun {
+		endTime := scheduledAt.Add(endAfter)
+		return &scheduler.JobRun{
+			JobName:     scheduler.JobName(name),
+			Tenant:      tnnt,
+			State:       scheduler.StateSuccess,
+			ScheduledAt: runScheduledAt,
+			StartTime:   scheduledAt.Add(startAfter),
+			EndTime:     &endTime,
+		}
+	}
+
+	t.Run("returns error when the run of the job is not found", func(t *testing.T) {
+		jobRepo := new(JobRepository)
+		defer jobRepo.AssertExpectations(t)
+		jobRunRepo := new(mockJobRunRepository)
+		defer jobRunRepo.AssertExpectations(t)
+
+		jobRepo.On("GetJobDetails", ctx, projName, scheduler.JobName("target")).Return(jobWith("target", "0 2 * * *"), nil)
+		jobRunRepo.On("GetByScheduledAt", ctx, tnnt, scheduler.JobName("target"), scheduledAt).
+			Return(nil, errors.NotFound(scheduler.EntityJobRun, "no record"))
+
+		runService := service.NewJobRunService(logger, jobRepo, jobRunRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		path, err := runService.GetCriticalPath(ctx, projName, "target", scheduledAt)
+
+		assert.Nil(t, path)
+		assert.True(t, errors.IsErrorType(err, errors.ErrNotFound))
+	})
+	t.Run("follows the upstreams finishing last while they kept the run waiting", func(t *testing.T) {
+		jobRepo := new(JobRepository)
+		defer jobRepo.AssertExpectations(t)
+		jobRunRepo := new(mockJobRunRepository)
+		defer jobRunRepo.AssertExpectations(t)
+
+		// target waits on fast and slow, slow waits on early, which was done before slow was scheduled
+		jobRepo.On("GetJobDetails", ctx, projName, scheduler.JobName("target")).Return(jobWith("target", "0 2 * * *", "fast", "slow"), nil)
+		jobRepo.On("GetJobDetails", ctx, projName, scheduler.JobName("fast")).Return(jobWith("fast", "0 2 * * *"), nil)
+		jobRepo.On("GetJobDetails", ctx, projName, scheduler.JobName("slow")).Return(jobWith("slow", "0 1 * * *", "early"), nil)
+		jobRepo.On("GetJobDetails", ctx, projName, scheduler.JobName("early")).Return(jobWith("early", "0 0 * * *"), nil)
+
+		target := runOf("target", scheduledAt, time.Hour, 2*time.Hour)
+		fast := runOf("fast", scheduledAt, 0, 10*time.Minute)
+		slow := runOf("slow", scheduledAt.Add(-time.Hour), -time.Hour, time.Hour)
+		early := runOf("early", scheduledAt.Add(-2*time.Hour), -2*time.Hour, -90*time.Minute)
+		jobRunRepo.On("GetByScheduledAt", ctx, tnnt, scheduler.JobName("target"), scheduledAt).Return(target, nil)
+		jobRunRepo.On("GetByScheduledAt", ctx, tnnt, scheduler.JobName("fast"), scheduledAt).Return(fast, nil)
+		jobRunRepo.On("GetByScheduledAt", ctx, tnnt, scheduler.JobName("slow"), scheduledAt.Add(-time.Hour)).Return(slow, nil)
+		jobRunRepo.On("GetByScheduledAt", ctx, tnnt, scheduler.JobName("early"), scheduledAt.Add(-2*time.Hour)).Return(early, nil)
+
+		runService := service.NewJobRunService(logger, jobRepo, jobRunRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		path, err := runService.GetCriticalPath(ctx, projName, "target", scheduledAt)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []*scheduler.JobRun{slow, target}, path.Runs)
+		assert.Equal(t, 3*time.Hour, path.Duration())
+	})
+}
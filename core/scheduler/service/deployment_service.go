@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 
+	"github.com/kushsharma/parallel"
 	"go.opentelemetry.io/otel"
 
 	"github.com/goto/optimus/core/job"
@@ -11,6 +12,16 @@ import (
 	"github.com/goto/optimus/internal/errors"
 )
 
+const (
+	// uploadBatchSize is the number of jobs deployed to the scheduler at once, progress is reported after every batch
+	uploadBatchSize = 500
+	// uploadNamespaceLimit is the number of namespaces uploaded concurrently
+	uploadNamespaceLimit = 10
+)
+
+// UploadToScheduler deploys all jobs of the project to the scheduler, namespaces are uploaded concurrently in batches
+// and the progress can be fetched using GetUploadProgress. Interrupted upload can be resumed by uploading again, as
+// the scheduler skips the jobs which are already deployed.
 func (s *JobRunService) UploadToScheduler(ctx context.Context, projectName tenant.ProjectName) error {
 	spanCtx, span := otel.Tracer("optimus").Start(ctx, "UploadToScheduler")
 	defer span.End()
@@ -23,34 +34,79 @@ func (s *JobRunService) UploadToScheduler(ctx context.Context, projectName tenan
 	}
 	span.AddEvent("got all the jobs to upload")
 
+	progress, err := s.uploads.start(projectName, len(allJobsWithDetails))
+	if err != nil {
+		me.Append(err)
+		return me.ToErr()
+	}
+
 	err = s.priorityResolver.Resolve(spanCtx, allJobsWithDetails)
 	if err != nil {
 		s.l.Error("error resolving priority: %s", err)
 		me.Append(err)
+		s.uploads.finish(progress, me.ToErr())
 		return me.ToErr()
 	}
 	span.AddEvent("done with priority resolution")
 
 	jobGroupByTenant := scheduler.GroupJobsByTenant(allJobsWithDetails)
+	runner := parallel.NewRunner(parallel.WithLimit(uploadNamespaceLimit))
 	for t, jobs := range jobGroupByTenant {
-		span.AddEvent("uploading job specs")
-		if err = s.deployJobsPerNamespace(spanCtx, t, jobs); err == nil {
-			s.l.Info("[success] namespace: %s, project: %s, deployed", t.NamespaceName().String(), t.ProjectName().String())
-		}
-		me.Append(err)
-
-		span.AddEvent("uploading job metrics")
+		runner.Add(func(t tenant.Tenant, jobs []*scheduler.JobWithDetails) func() (interface{}, error) {
+			return func() (interface{}, error) {
+				span.AddEvent("uploading job specs")
+				err := s.deployJobsPerNamespace(spanCtx, t, jobs, progress)
+				if err == nil {
+					s.l.Info("[success] namespace: %s, project: %s, deployed", t.NamespaceName().String(), t.ProjectName().String())
+				}
+				return nil, err
+			}
+		}(t, jobs))
 	}
+	for _, result := range runner.Run() {
+		me.Append(result.Err)
+	}
+
+	s.uploads.finish(progress, me.ToErr())
 	return me.ToErr()
 }
 
-func (s *JobRunService) deployJobsPerNamespace(ctx context.Context, t tenant.Tenant, jobs []*scheduler.JobWithDetails) error {
-	err := s.scheduler.DeployJobs(ctx, t, jobs)
-	if err != nil {
-		s.l.Error("error deploying jobs under project [%s] namespace [%s]: %s", t.ProjectName().String(), t.NamespaceName().String(), err)
+// GetUploadProgress returns the progress of the latest upload of the project to the scheduler
+func (s *JobRunService) GetUploadProgress(_ context.Context, projectName tenant.ProjectName) (*scheduler.UploadProgress, error) {
+	return s.uploads.get(projectName)
+}
+
+func (s *JobRunService) deployJobsPerNamespace(ctx context.Context, t tenant.Tenant, jobs []*scheduler.JobWithDetails, progress *scheduler.UploadProgress) error {
+	if err := s.removeDisabledHooks(ctx, jobs); err != nil {
+		s.uploads.addFailed(progress, len(jobs))
+		return err
+	}
+
+	me := errors.NewMultiError("errorInDeployJobsPerNamespace")
+	for start := 0; start < len(jobs); start += uploadBatchSize {
+		end := start + uploadBatchSize
+		if end > len(jobs) {
+			end = len(jobs)
+		}
+
+		batch := jobs[start:end]
+		if err := s.scheduler.DeployJobs(ctx, t, batch); err != nil {
+			s.l.Error("error deploying jobs under project [%s] namespace [%s]: %s", t.ProjectName().String(), t.NamespaceName().String(), err)
+			s.uploads.addFailed(progress, len(batch))
+			me.Append(err)
+			continue
+		}
+		s.uploads.addUploaded(progress, len(batch))
+	}
+	if err := me.ToErr(); err != nil {
+		return err
+	}
+
+	if err := s.cleanPerNamespace(ctx, t, jobs); err != nil {
 		return err
 	}
-	return s.cleanPerNamespace(ctx, t, jobs)
+	s.uploads.completeNamespace(progress, t.NamespaceName())
+	return nil
 }
 
 func (s *JobRunService) cleanPerNamespace(ctx context.Context, t tenant.Tenant, jobs []*scheduler.JobWithDetails) error {
@@ -110,5 +166,38 @@ func (s *JobRunService) resolveAndDeployJobs(ctx context.Context, tnnt tenant.Te
 		return err
 	}
 
+	if err := s.removeDisabledHooks(ctx, allJobsWithDetails); err != nil {
+		return err
+	}
+
 	return s.scheduler.DeployJobs(ctx, tnnt, allJobsWithDetails)
 }
+
+// removeDisabledHooks leaves out the hooks whose enabled_when condition does not hold in the tenant of the job
+func (s *JobRunService) removeDisabledHooks(ctx context.Context, jobs []*scheduler.JobWithDetails) error {
+	me := errors.NewMultiError("errorInRemoveDisabledHooks")
+	for _, jobWithDetails := range jobs {
+		if !hasConditionalHooks(jobWithDetails.Job) {
+			continue
+		}
+		enabledHooks, err := s.compiler.EnabledHooks(ctx, jobWithDetails.Job)
+		if err != nil {
+			s.l.Error("error evaluating hooks of job [%s]: %s", jobWithDetails.Name, err)
+			me.Append(err)
+			continue
+		}
+		jobWithEnabledHooks := *jobWithDetails.Job
+		jobWithEnabledHooks.Hooks = enabledHooks
+		jobWithDetails.Job = &jobWithEnabledHooks
+	}
+	return me.ToErr()
+}
+
+func hasConditionalHooks(schedulerJob *scheduler.Job) bool {
+	for _, hook := range schedulerJob.Hooks {
+		if hook.EnabledWhen != "" {
+			return true
+		}
+	}
+	return false
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/goto/optimus/core/scheduler"
 	"github.com/goto/optimus/core/scheduler/service"
 	"github.com/goto/optimus/core/tenant"
+	errs "github.com/goto/optimus/internal/errors"
 )
 
 func TestDeploymentService(t *testing.T) {
@@ -78,7 +79,7 @@ func TestDeploymentService(t *testing.T) {
 			defer jobRepo.AssertExpectations(t)
 
 			runService := service.NewJobRunService(logger,
-				jobRepo, nil, nil, nil, nil, nil, nil, nil, nil)
+				jobRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			err := runService.UploadToScheduler(ctx, proj1Name)
 			assert.NotNil(t, err)
@@ -94,7 +95,7 @@ func TestDeploymentService(t *testing.T) {
 			defer priorityResolver.AssertExpectations(t)
 
 			runService := service.NewJobRunService(logger,
-				jobRepo, nil, nil, nil, nil, priorityResolver, nil, nil, nil)
+				jobRepo, nil, nil, nil, nil, priorityResolver, nil, nil, nil, nil, nil)
 
 			err := runService.UploadToScheduler(ctx, proj1Name)
 			assert.NotNil(t, err)
@@ -115,7 +116,7 @@ func TestDeploymentService(t *testing.T) {
 			defer mScheduler.AssertExpectations(t)
 
 			runService := service.NewJobRunService(logger, jobRepo, nil, nil, nil,
-				mScheduler, priorityResolver, nil, nil, nil)
+				mScheduler, priorityResolver, nil, nil, nil, nil, nil)
 
 			err := runService.UploadToScheduler(ctx, proj1Name)
 			assert.NotNil(t, err)
@@ -143,7 +144,7 @@ func TestDeploymentService(t *testing.T) {
 			defer mScheduler.AssertExpectations(t)
 
 			runService := service.NewJobRunService(logger, jobRepo, nil, nil, nil,
-				mScheduler, priorityResolver, nil, nil, nil)
+				mScheduler, priorityResolver, nil, nil, nil, nil, nil)
 
 			err := runService.UploadToScheduler(ctx, proj1Name)
 			assert.Nil(t, err)
@@ -168,7 +169,7 @@ func TestDeploymentService(t *testing.T) {
 			defer mScheduler.AssertExpectations(t)
 
 			runService := service.NewJobRunService(logger, jobRepo, nil, nil, nil,
-				mScheduler, priorityResolver, nil, nil, nil)
+				mScheduler, priorityResolver, nil, nil, nil, nil, nil)
 
 			err := runService.UploadToScheduler(ctx, proj1Name)
 			assert.NotNil(t, err)
@@ -176,6 +177,91 @@ func TestDeploymentService(t *testing.T) {
 		})
 	})
 
+	t.Run("GetUploadProgress", func(t *testing.T) {
+		t.Run("should return not found error if project is never uploaded", func(t *testing.T) {
+			runService := service.NewJobRunService(logger, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			progress, err := runService.GetUploadProgress(ctx, proj1Name)
+			assert.True(t, errs.IsErrorType(err, errs.ErrNotFound))
+			assert.Nil(t, progress)
+		})
+		t.Run("should return progress of the finished upload", func(t *testing.T) {
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetAll", mock.Anything, proj1Name).Return(jobsWithDetails, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			priorityResolver := new(mockPriorityResolver)
+			priorityResolver.On("Resolve", mock.Anything, jobsWithDetails).Return(nil)
+			defer priorityResolver.AssertExpectations(t)
+
+			mScheduler := new(mockScheduler)
+			mScheduler.On("DeployJobs", mock.Anything, tnnt1, []*scheduler.JobWithDetails{jobsWithDetails[0], jobsWithDetails[2]}).
+				Return(nil)
+			mScheduler.On("DeployJobs", mock.Anything, tnnt2, []*scheduler.JobWithDetails{jobsWithDetails[1]}).
+				Return(fmt.Errorf("DeployJobs tnnt2 error"))
+			mScheduler.On("ListJobs", mock.Anything, tnnt1).Return([]string{"job1", "job3"}, nil)
+			var jobsToDelete []string
+			mScheduler.On("DeleteJobs", mock.Anything, tnnt1, jobsToDelete).Return(nil)
+			defer mScheduler.AssertExpectations(t)
+
+			runService := service.NewJobRunService(logger, jobRepo, nil, nil, nil,
+				mScheduler, priorityResolver, nil, nil, nil, nil, nil)
+
+			err := runService.UploadToScheduler(ctx, proj1Name)
+			assert.EqualError(t, err, "errorInUploadToScheduler:\n DeployJobs tnnt2 error")
+
+			progress, err := runService.GetUploadProgress(ctx, proj1Name)
+			assert.NoError(t, err)
+			assert.Equal(t, scheduler.UploadStateFailed, progress.State)
+			assert.Equal(t, 3, progress.TotalJobs)
+			assert.Equal(t, 2, progress.UploadedJobs)
+			assert.Equal(t, 1, progress.FailedJobs)
+			assert.Equal(t, []tenant.NamespaceName{tnnt1.NamespaceName()}, progress.CompletedNamespaces)
+			assert.False(t, progress.FinishedAt.IsZero())
+		})
+		t.Run("should reject another upload of the project while one is in progress", func(t *testing.T) {
+			jobs := []*scheduler.JobWithDetails{jobsWithDetails[1]}
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetAll", mock.Anything, proj1Name).Return(jobs, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			priorityResolver := new(mockPriorityResolver)
+			priorityResolver.On("Resolve", mock.Anything, jobs).Return(nil)
+			defer priorityResolver.AssertExpectations(t)
+
+			deploying := make(chan struct{})
+			release := make(chan struct{})
+			mScheduler := new(mockScheduler)
+			mScheduler.On("DeployJobs", mock.Anything, tnnt2, jobs).Return(nil).Run(func(mock.Arguments) {
+				close(deploying)
+				<-release
+			}).Once()
+			mScheduler.On("ListJobs", mock.Anything, tnnt2).Return([]string{"job2"}, nil)
+			var jobsToDelete []string
+			mScheduler.On("DeleteJobs", mock.Anything, tnnt2, jobsToDelete).Return(nil)
+			defer mScheduler.AssertExpectations(t)
+
+			runService := service.NewJobRunService(logger, jobRepo, nil, nil, nil,
+				mScheduler, priorityResolver, nil, nil, nil, nil, nil)
+
+			done := make(chan error)
+			go func() {
+				done <- runService.UploadToScheduler(ctx, proj1Name)
+			}()
+			<-deploying
+
+			progress, err := runService.GetUploadProgress(ctx, proj1Name)
+			assert.NoError(t, err)
+			assert.True(t, progress.IsInProgress())
+
+			err = runService.UploadToScheduler(ctx, proj1Name)
+			assert.ErrorContains(t, err, "upload of project proj1 is still in progress")
+
+			close(release)
+			assert.NoError(t, <-done)
+		})
+	})
+
 	t.Run("UploadJobs", func(t *testing.T) {
 		t.Run("should return error if unable to get jobs", func(t *testing.T) {
 			jobNamesToUpload := []string{"job1", "job3"}
@@ -192,7 +278,7 @@ func TestDeploymentService(t *testing.T) {
 			defer mScheduler.AssertExpectations(t)
 
 			runService := service.NewJobRunService(logger, jobRepo, nil, nil, nil,
-				mScheduler, priorityResolver, nil, nil, nil)
+				mScheduler, priorityResolver, nil, nil, nil, nil, nil)
 
 			err := runService.UploadJobs(ctx, tnnt1, jobNamesToUpload, jobNamesToDelete)
 			assert.Error(t, err)
@@ -214,7 +300,7 @@ func TestDeploymentService(t *testing.T) {
 			defer mScheduler.AssertExpectations(t)
 
 			runService := service.NewJobRunService(logger, jobRepo, nil, nil, nil,
-				mScheduler, priorityResolver, nil, nil, nil)
+				mScheduler, priorityResolver, nil, nil, nil, nil, nil)
 
 			err := runService.UploadJobs(ctx, tnnt1, jobNamesToUpload, jobNamesToDelete)
 			assert.Error(t, err)
@@ -237,7 +323,7 @@ func TestDeploymentService(t *testing.T) {
 			defer mScheduler.AssertExpectations(t)
 
 			runService := service.NewJobRunService(logger, jobRepo, nil, nil, nil,
-				mScheduler, priorityResolver, nil, nil, nil)
+				mScheduler, priorityResolver, nil, nil, nil, nil, nil)
 
 			err := runService.UploadJobs(ctx, tnnt1, jobNamesToUpload, jobNamesToDelete)
 			assert.Error(t, err)
@@ -251,7 +337,7 @@ func TestDeploymentService(t *testing.T) {
 			defer mScheduler.AssertExpectations(t)
 
 			runService := service.NewJobRunService(logger, nil, nil, nil, nil,
-				mScheduler, nil, nil, nil, nil)
+				mScheduler, nil, nil, nil, nil, nil, nil)
 
 			err := runService.UploadJobs(ctx, tnnt1, jobNamesToUpload, jobNamesToDelete)
 			assert.Error(t, err)
@@ -275,7 +361,7 @@ func TestDeploymentService(t *testing.T) {
 			defer mScheduler.AssertExpectations(t)
 
 			runService := service.NewJobRunService(logger, jobRepo, nil, nil, nil,
-				mScheduler, priorityResolver, nil, nil, nil)
+				mScheduler, priorityResolver, nil, nil, nil, nil, nil)
 
 			err := runService.UploadJobs(ctx, tnnt1, jobNamesToUpload, jobNamesToDelete)
 			assert.Nil(t, err)
@@ -298,11 +384,80 @@ func TestDeploymentService(t *testing.T) {
 			defer mScheduler.AssertExpectations(t)
 
 			runService := service.NewJobRunService(logger, jobRepo, nil, nil, nil,
-				mScheduler, priorityResolver, nil, nil, nil)
+				mScheduler, priorityResolver, nil, nil, nil, nil, nil)
 
 			err := runService.UploadJobs(ctx, tnnt1, jobNamesToUpload, jobNamesToDelete)
 			assert.Nil(t, err)
 		})
+		t.Run("should upload requested jobs without the hooks not enabled in the tenant", func(t *testing.T) {
+			jobNamesToUpload := []string{"job4"}
+			auditHook := &scheduler.Hook{Name: "audit", EnabledWhen: `{{ eq .proj.ENVIRONMENT "production" }}`}
+			transporterHook := &scheduler.Hook{Name: "transporter"}
+			jobWithHooks := &scheduler.JobWithDetails{
+				Name: "job4",
+				Job: &scheduler.Job{
+					Name:   "job4",
+					Tenant: tnnt1,
+					Hooks:  []*scheduler.Hook{auditHook, transporterHook},
+				},
+			}
+
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetJobs", mock.Anything, proj1Name, jobNamesToUpload).Return([]*scheduler.JobWithDetails{jobWithHooks}, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			priorityResolver := new(mockPriorityResolver)
+			priorityResolver.On("Resolve", mock.Anything, mock.Anything).Return(nil)
+			defer priorityResolver.AssertExpectations(t)
+
+			jobInputCompiler := new(mockJobInputCompiler)
+			jobInputCompiler.On("EnabledHooks", mock.Anything, jobWithHooks.Job).Return([]*scheduler.Hook{transporterHook}, nil)
+			defer jobInputCompiler.AssertExpectations(t)
+
+			mScheduler := new(mockScheduler)
+			mScheduler.On("DeployJobs", mock.Anything, tnnt1, mock.MatchedBy(func(jobs []*scheduler.JobWithDetails) bool {
+				return len(jobs) == 1 && len(jobs[0].Job.Hooks) == 1 && jobs[0].Job.Hooks[0].Name == "transporter"
+			})).Return(nil)
+			defer mScheduler.AssertExpectations(t)
+
+			runService := service.NewJobRunService(logger, jobRepo, nil, nil, nil,
+				mScheduler, priorityResolver, jobInputCompiler, nil, nil, nil, nil)
+
+			err := runService.UploadJobs(ctx, tnnt1, jobNamesToUpload, nil)
+			assert.Nil(t, err)
+		})
+		t.Run("should return error if unable to evaluate the hooks", func(t *testing.T) {
+			jobNamesToUpload := []string{"job4"}
+			jobWithHooks := &scheduler.JobWithDetails{
+				Name: "job4",
+				Job: &scheduler.Job{
+					Name:   "job4",
+					Tenant: tnnt1,
+					Hooks:  []*scheduler.Hook{{Name: "audit", EnabledWhen: "{{ .proj.ENVIRONMENT }}"}},
+				},
+			}
+
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetJobs", mock.Anything, proj1Name, jobNamesToUpload).Return([]*scheduler.JobWithDetails{jobWithHooks}, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			priorityResolver := new(mockPriorityResolver)
+			priorityResolver.On("Resolve", mock.Anything, mock.Anything).Return(nil)
+			defer priorityResolver.AssertExpectations(t)
+
+			jobInputCompiler := new(mockJobInputCompiler)
+			jobInputCompiler.On("EnabledHooks", mock.Anything, jobWithHooks.Job).Return(nil, errors.New("invalid enabled_when"))
+			defer jobInputCompiler.AssertExpectations(t)
+
+			mScheduler := new(mockScheduler)
+			defer mScheduler.AssertExpectations(t)
+
+			runService := service.NewJobRunService(logger, jobRepo, nil, nil, nil,
+				mScheduler, priorityResolver, jobInputCompiler, nil, nil, nil, nil)
+
+			err := runService.UploadJobs(ctx, tnnt1, jobNamesToUpload, nil)
+			assert.ErrorContains(t, err, "invalid enabled_when")
+		})
 		t.Run("should delete requested jobs, appropriately", func(t *testing.T) {
 			var jobNamesToUpload []string
 			jobNamesToDelete := []string{"job2"}
@@ -312,7 +467,7 @@ func TestDeploymentService(t *testing.T) {
 			defer mScheduler.AssertExpectations(t)
 
 			runService := service.NewJobRunService(logger, nil, nil, nil, nil,
-				mScheduler, nil, nil, nil, nil)
+				mScheduler, nil, nil, nil, nil, nil, nil)
 
 			err := runService.UploadJobs(ctx, tnnt1, jobNamesToUpload, jobNamesToDelete)
 			assert.Nil(t, err)
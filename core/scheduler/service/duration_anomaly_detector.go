@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/goto/salt/log"
+
+	"github.com/goto/optimus/config"
+	"github.com/goto/optimus/core/event"
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+	"github.com/goto/optimus/internal/telemetry"
+)
+
+const (
+	defaultDurationWindowSize = 30
+	defaultDurationMinSamples = 10
+)
+
+type DurationStatsRepository interface {
+	Get(ctx context.Context, tnnt tenant.Tenant, jobName scheduler.JobName) (*scheduler.DurationStats, error)
+	Save(ctx context.Context, stats *scheduler.DurationStats) error
+}
+
+// DurationAnomalyDetector keeps the durations of the latest successful runs of every job, and flags the runs taking
+// much longer than those, so that the runs getting slower over time do not go unnoticed
+type DurationAnomalyDetector struct {
+	l            log.Logger
+	repo         DurationStatsRepository
+	eventHandler EventHandler
+	config       config.DurationAnomalyConfig
+}
+
+// Observe checks the duration of the finished run against the latest runs of the job, then records it
+func (d *DurationAnomalyDetector) Observe(ctx context.Context, jobRun *scheduler.JobRun) error {
+	if jobRun.EndTime == nil {
+		return nil
+	}
+	duration := jobRun.EndTime.Sub(jobRun.StartTime)
+
+	stats, err := d.repo.Get(ctx, jobRun.Tenant, jobRun.JobName)
+	if err != nil {
+		if !errors.IsErrorType(err, errors.ErrNotFound) {
+			return err
+		}
+		stats = &scheduler.DurationStats{Tenant: jobRun.Tenant, JobName: jobRun.JobName}
+	}
+
+	if len(stats.Durations) >= d.config.MinSamples && d.isAnomaly(stats, duration) {
+		d.raiseAnomaly(jobRun, stats, duration)
+	}
+
+	stats.Add(duration, d.config.WindowSize)
+	return d.repo.Save(ctx, stats)
+}
+
+func (d *DurationAnomalyDetector) isAnomaly(stats *scheduler.DurationStats, duration time.Duration) bool {
+	if d.config.ZScoreThreshold > 0 && stats.ZScore(duration) > d.config.ZScoreThreshold {
+		return true
+	}
+	return d.config.Percentile > 0 && duration > stats.Percentile(d.config.Percentile)
+}
+
+func (d *DurationAnomalyDetector) raiseAnomaly(jobRun *scheduler.JobRun, stats *scheduler.DurationStats, duration time.Duration) {
+	d.l.Warn("run of job [%s] scheduled at [%s] took %s, while the latest %d runs took %s on average", jobRun.JobName,
+		jobRun.ScheduledAt, duration, len(stats.Durations), stats.Mean())
+
+	telemetry.NewCounter("jobrun_duration_anomalies_total", map[string]string{
+		"project":   jobRun.Tenant.ProjectName().String(),
+		"namespace": jobRun.Tenant.NamespaceName().String(),
+	}).Inc()
+
+	anomalyEvent, err := event.NewJobRunDurationAnomalyEvent(jobRun)
+	if err != nil {
+		d.l.Error("error creating duration anomaly event for job [%s]: %s", jobRun.JobName, err)
+		return
+	}
+	d.eventHandler.HandleEvent(anomalyEvent)
+}
+
+func NewDurationAnomalyDetector(l log.Logger, repo DurationStatsRepository, eventHandler EventHandler, conf config.DurationAnomalyConfig) *DurationAnomalyDetector {
+	if conf.WindowSize <= 0 {
+		conf.WindowSize = defaultDurationWindowSize
+	}
+	if conf.MinSamples <= 0 {
+		conf.MinSamples = defaultDurationMinSamples
+	}
+	return &DurationAnomalyDetector{
+		l:            l,
+		repo:         repo,
+		eventHandler: eventHandler,
+		config:       conf,
+	}
+}
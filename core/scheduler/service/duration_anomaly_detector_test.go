@@ -0,0 +1,127 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/goto/optimus/config"
+	"github.com/goto/optimus/core/event"
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/scheduler/service"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+)
+
+func TestDurationAnomalyDetector(t *testing.T) {
+	ctx := context.Background()
+	logger := log.NewNoop()
+	tnnt, _ := tenant.NewTenant("proj", "ns1")
+	jobName := scheduler.JobName("sample_select")
+	conf := config.DurationAnomalyConfig{WindowSize: 5, MinSamples: 3, ZScoreThreshold: 3}
+	startTime := time.Date(2023, 10, 10, 10, 0, 0, 0, time.UTC)
+
+	runTaking := func(duration time.Duration) *scheduler.JobRun {
+		endTime := startTime.Add(duration)
+		return &scheduler.JobRun{
+			ID:          uuid.New(),
+			JobName:     jobName,
+			Tenant:      tnnt,
+			State:       scheduler.StateSuccess,
+			ScheduledAt: startTime,
+			StartTime:   startTime,
+			EndTime:     &endTime,
+		}
+	}
+	usualStats := func() *scheduler.DurationStats {
+		return &scheduler.DurationStats{
+			Tenant:    tnnt,
+			JobName:   jobName,
+			Durations: []time.Duration{9 * time.Minute, 10 * time.Minute, 11 * time.Minute, 10 * time.Minute},
+		}
+	}
+
+	t.Run("starts the stats of the job with the first run", func(t *testing.T) {
+		repo := new(mockDurationStatsRepository)
+		defer repo.AssertExpectations(t)
+
+		repo.On("Get", ctx, tnnt, jobName).Return(nil, errors.NotFound(scheduler.EntityJobRun, "no duration stats"))
+		repo.On("Save", ctx, &scheduler.DurationStats{Tenant: tnnt, JobName: jobName, Durations: []time.Duration{time.Hour}}).Return(nil)
+
+		detector := service.NewDurationAnomalyDetector(logger, repo, nil, conf)
+		assert.NoError(t, detector.Observe(ctx, runTaking(time.Hour)))
+	})
+	t.Run("does not flag the runs until there are enough samples", func(t *testing.T) {
+		repo := new(mockDurationStatsRepository)
+		defer repo.AssertExpectations(t)
+
+		repo.On("Get", ctx, tnnt, jobName).Return(&scheduler.DurationStats{
+			Tenant: tnnt, JobName: jobName, Durations: []time.Duration{time.Minute, time.Minute},
+		}, nil)
+		repo.On("Save", ctx, mock.Anything).Return(nil)
+
+		detector := service.NewDurationAnomalyDetector(logger, repo, nil, conf)
+		assert.NoError(t, detector.Observe(ctx, runTaking(time.Hour)))
+	})
+	t.Run("records the usual runs without flagging them", func(t *testing.T) {
+		repo := new(mockDurationStatsRepository)
+		defer repo.AssertExpectations(t)
+
+		repo.On("Get", ctx, tnnt, jobName).Return(usualStats(), nil)
+		repo.On("Save", ctx, mock.MatchedBy(func(stats *scheduler.DurationStats) bool {
+			return len(stats.Durations) == 5 && stats.Durations[4] == 11*time.Minute
+		})).Return(nil)
+
+		detector := service.NewDurationAnomalyDetector(logger, repo, nil, conf)
+		assert.NoError(t, detector.Observe(ctx, runTaking(11*time.Minute)))
+	})
+	t.Run("flags the run exceeding the z score threshold and raises an event", func(t *testing.T) {
+		repo := new(mockDurationStatsRepository)
+		defer repo.AssertExpectations(t)
+		eventHandler := newEventHandler(t)
+
+		repo.On("Get", ctx, tnnt, jobName).Return(usualStats(), nil)
+		eventHandler.On("HandleEvent", mock.AnythingOfType("*event.JobRunDurationAnomaly")).Once()
+		repo.On("Save", ctx, mock.Anything).Return(nil)
+
+		detector := service.NewDurationAnomalyDetector(logger, repo, eventHandler, conf)
+		assert.NoError(t, detector.Observe(ctx, runTaking(20*time.Minute)))
+	})
+	t.Run("flags the run exceeding the percentile threshold", func(t *testing.T) {
+		repo := new(mockDurationStatsRepository)
+		defer repo.AssertExpectations(t)
+		eventHandler := newEventHandler(t)
+
+		repo.On("Get", ctx, tnnt, jobName).Return(usualStats(), nil)
+		eventHandler.On("HandleEvent", mock.MatchedBy(func(e *event.JobRunDurationAnomaly) bool {
+			return e.JobRun.JobName == jobName
+		})).Once()
+		repo.On("Save", ctx, mock.Anything).Return(nil)
+
+		percentileConf := config.DurationAnomalyConfig{WindowSize: 5, MinSamples: 3, Percentile: 95}
+		detector := service.NewDurationAnomalyDetector(logger, repo, eventHandler, percentileConf)
+		assert.NoError(t, detector.Observe(ctx, runTaking(12*time.Minute)))
+	})
+}
+
+type mockDurationStatsRepository struct {
+	mock.Mock
+}
+
+func (m *mockDurationStatsRepository) Get(ctx context.Context, tnnt tenant.Tenant, jobName scheduler.JobName) (*scheduler.DurationStats, error) {
+	args := m.Called(ctx, tnnt, jobName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*scheduler.DurationStats), args.Error(1)
+}
+
+func (m *mockDurationStatsRepository) Save(ctx context.Context, stats *scheduler.DurationStats) error {
+	args := m.Called(ctx, stats)
+	return args.Error(0)
+}
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/tenant"
+)
+
+// ExecutorInputCache stores the compiled executor inputs, only the in memory lru cache of each server is provided,
+// a store shared between the servers is not supported
+type ExecutorInputCache interface {
+	Get(key string) (*scheduler.ExecutorInput, bool)
+	Add(key string, input *scheduler.ExecutorInput)
+}
+
+// CachedInputCompiler serves the executor inputs compiled earlier for the same deployment of the job, run and executor,
+// as executors of a run fetch the same input on every bootstrap and retry
+type CachedInputCompiler struct {
+	compiler JobInputCompiler
+	cache    ExecutorInputCache
+
+	tenantService TenantService
+	snippetGetter SnippetGetter
+}
+
+func (c CachedInputCompiler) Compile(ctx context.Context, job *scheduler.JobWithDetails, config scheduler.RunConfig, executedAt time.Time) (*scheduler.ExecutorInput, error) {
+	// tenant details and snippets are read on every compilation, as the input is compiled from their current values
+	tenantDetails, err := c.tenantService.GetDetails(ctx, job.Job.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	snippets, err := c.snippetGetter.GetSnippets(ctx, job.Job.Tenant.ProjectName())
+	if err != nil {
+		return nil, err
+	}
+
+	key := executorInputCacheKey(job.Job, config, executedAt, tenantDetails, snippets)
+	if input, ok := c.cache.Get(key); ok {
+		return input, nil
+	}
+
+	input, err := c.compiler.Compile(ctx, job, config, executedAt)
+	if err != nil {
+		return nil, err
+	}
+	c.cache.Add(key, input)
+	return input, nil
+}
+
+func (c CachedInputCompiler) EnabledHooks(ctx context.Context, job *scheduler.Job) ([]*scheduler.Hook, error) {
+	return c.compiler.EnabledHooks(ctx, job)
+}
+
+// executorInputCacheKey identifies the input by the job update time, which changes on every deployment, by the
+// task config, which also carries the config of a replay of the run, and by the configs, macros, secrets and snippets
+// of the tenant, so a change to any of them is compiled again instead of served from the cache
+func executorInputCacheKey(job *scheduler.Job, config scheduler.RunConfig, executedAt time.Time, tenantDetails *tenant.WithDetails, snippets map[string]string) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s/%s/%d\n", job.Tenant.ProjectName(), job.Name, job.UpdatedAt.UnixNano())
+	fmt.Fprintf(h, "%s/%s\n", config.Executor.Type, config.Executor.Name)
+	fmt.Fprintf(h, "%d/%d/%s/%d/%s\n", config.ScheduledAt.UnixNano(), executedAt.UnixNano(), config.JobRunID.UUID(),
+		config.Attempt, config.SchedulerRunID)
+
+	if job.Task != nil {
+		writeSortedMap(h, "task", job.Task.Config)
+	}
+	writeSortedMap(h, "project", tenantDetails.Project().GetConfigs())
+	writeSortedMap(h, "macro", tenantDetails.Project().GetMacros())
+	writeSortedMap(h, "namespace", tenantDetails.Namespace().GetConfigs())
+	writeSortedMap(h, "secret", tenantDetails.SecretsMap())
+	writeSortedMap(h, "snippet", snippets)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func writeSortedMap(w io.Writer, section string, m map[string]string) {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Fprintf(w, "%s/%s=%s\n", section, k, m[k])
+	}
+}
+
+func NewCachedInputCompiler(compiler JobInputCompiler, cache ExecutorInputCache, tenantService TenantService, snippetGetter SnippetGetter) *CachedInputCompiler {
+	return &CachedInputCompiler{
+		compiler:      compiler,
+		cache:         cache,
+		tenantService: tenantService,
+		snippetGetter: snippetGetter,
+	}
+}
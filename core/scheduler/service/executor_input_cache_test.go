@@ -0,0 +1,180 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/scheduler/service"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/lib/lru"
+)
+
+func TestCachedInputCompiler(t *testing.T) {
+	ctx := context.Background()
+	tnnt, _ := tenant.NewTenant("proj", "ns1")
+	deployedAt := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	scheduledAt := time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
+	executedAt := scheduledAt.Add(time.Minute)
+	config := scheduler.RunConfig{
+		Executor:    scheduler.Executor{Name: "bq2bq", Type: scheduler.ExecutorTask},
+		ScheduledAt: scheduledAt,
+	}
+	input := &scheduler.ExecutorInput{Configs: map[string]string{"EXECUTION_TIME": executedAt.Format(time.RFC3339)}}
+
+	project, _ := tenant.NewProject("proj", map[string]string{tenant.ProjectSchedulerHost: "host", tenant.ProjectStoragePathKey: "gs://location"})
+	namespace, _ := tenant.NewNamespace("ns1", project.Name(), map[string]string{})
+	newTenantService := func(secretValues ...string) *mockTenantService {
+		tenantService := new(mockTenantService)
+		for _, value := range secretValues {
+			secret, _ := tenant.NewPlainTextSecret("secret1", value)
+			details, _ := tenant.NewTenantDetails(project, namespace, tenant.PlainTextSecrets{secret})
+			tenantService.On("GetDetails", ctx, tnnt).Return(details, nil).Once()
+		}
+		return tenantService
+	}
+	newSnippetGetter := func(snippets ...map[string]string) *mockSnippetGetter {
+		snippetGetter := new(mockSnippetGetter)
+		for _, snippet := range snippets {
+			snippetGetter.On("GetSnippets", ctx, tnnt.ProjectName()).Return(snippet, nil).Once()
+		}
+		return snippetGetter
+	}
+	snippets := map[string]string{"dedup": "select 1"}
+
+	newJob := func(updatedAt time.Time, taskConfig map[string]string) *scheduler.JobWithDetails {
+		return &scheduler.JobWithDetails{
+			Name: "job1",
+			Job: &scheduler.Job{
+				Name:      "job1",
+				Tenant:    tnnt,
+				Task:      &scheduler.Task{Name: "bq2bq", Config: taskConfig},
+				UpdatedAt: updatedAt,
+			},
+		}
+	}
+
+	t.Run("Compile", func(t *testing.T) {
+		t.Run("returns the cached input for the same job deployment, run and executor", func(t *testing.T) {
+			job := newJob(deployedAt, map[string]string{"a": "b"})
+			compiler := new(mockJobInputCompiler)
+			compiler.On("Compile", ctx, job, config, executedAt).Return(input, nil).Once()
+			defer compiler.AssertExpectations(t)
+
+			tenantService := newTenantService("value", "value")
+			defer tenantService.AssertExpectations(t)
+			snippetGetter := newSnippetGetter(snippets, snippets)
+			defer snippetGetter.AssertExpectations(t)
+
+			cachedCompiler := service.NewCachedInputCompiler(compiler, lru.New[string, *scheduler.ExecutorInput](10, 0), tenantService, snippetGetter)
+
+			first, err := cachedCompiler.Compile(ctx, job, config, executedAt)
+			assert.NoError(t, err)
+			second, err := cachedCompiler.Compile(ctx, newJob(deployedAt, map[string]string{"a": "b"}), config, executedAt)
+			assert.NoError(t, err)
+			assert.Equal(t, input, first)
+			assert.Equal(t, input, second)
+		})
+		t.Run("compiles again when job is deployed again", func(t *testing.T) {
+			job := newJob(deployedAt, nil)
+			redeployedJob := newJob(deployedAt.Add(time.Hour), nil)
+			compiler := new(mockJobInputCompiler)
+			compiler.On("Compile", ctx, job, config, executedAt).Return(input, nil).Once()
+			compiler.On("Compile", ctx, redeployedJob, config, executedAt).Return(input, nil).Once()
+			defer compiler.AssertExpectations(t)
+
+			tenantService := newTenantService("value", "value")
+			defer tenantService.AssertExpectations(t)
+			snippetGetter := newSnippetGetter(snippets, snippets)
+			defer snippetGetter.AssertExpectations(t)
+
+			cachedCompiler := service.NewCachedInputCompiler(compiler, lru.New[string, *scheduler.ExecutorInput](10, 0), tenantService, snippetGetter)
+
+			_, err := cachedCompiler.Compile(ctx, job, config, executedAt)
+			assert.NoError(t, err)
+			_, err = cachedCompiler.Compile(ctx, redeployedJob, config, executedAt)
+			assert.NoError(t, err)
+		})
+		t.Run("compiles again for another executor or task config", func(t *testing.T) {
+			job := newJob(deployedAt, map[string]string{"a": "b"})
+			replayedJob := newJob(deployedAt, map[string]string{"a": "c"})
+			hookConfig := config
+			hookConfig.Executor = scheduler.Executor{Name: "predator", Type: scheduler.ExecutorHook}
+			compiler := new(mockJobInputCompiler)
+			compiler.On("Compile", ctx, job, config, executedAt).Return(input, nil).Once()
+			compiler.On("Compile", ctx, job, hookConfig, executedAt).Return(input, nil).Once()
+			compiler.On("Compile", ctx, replayedJob, config, executedAt).Return(input, nil).Once()
+			defer compiler.AssertExpectations(t)
+
+			tenantService := newTenantService("value", "value", "value")
+			defer tenantService.AssertExpectations(t)
+			snippetGetter := newSnippetGetter(snippets, snippets, snippets)
+			defer snippetGetter.AssertExpectations(t)
+
+			cachedCompiler := service.NewCachedInputCompiler(compiler, lru.New[string, *scheduler.ExecutorInput](10, 0), tenantService, snippetGetter)
+
+			_, err := cachedCompiler.Compile(ctx, job, config, executedAt)
+			assert.NoError(t, err)
+			_, err = cachedCompiler.Compile(ctx, job, hookConfig, executedAt)
+			assert.NoError(t, err)
+			_, err = cachedCompiler.Compile(ctx, replayedJob, config, executedAt)
+			assert.NoError(t, err)
+		})
+		t.Run("compiles again when secrets or snippets of the tenant change", func(t *testing.T) {
+			job := newJob(deployedAt, nil)
+			compiler := new(mockJobInputCompiler)
+			compiler.On("Compile", ctx, job, config, executedAt).Return(input, nil).Times(3)
+			defer compiler.AssertExpectations(t)
+			tenantService := newTenantService("value", "rotated", "rotated")
+			defer tenantService.AssertExpectations(t)
+			snippetGetter := newSnippetGetter(snippets, snippets, map[string]string{"dedup": "select 2"})
+			defer snippetGetter.AssertExpectations(t)
+
+			cachedCompiler := service.NewCachedInputCompiler(compiler, lru.New[string, *scheduler.ExecutorInput](10, 0), tenantService, snippetGetter)
+
+			_, err := cachedCompiler.Compile(ctx, job, config, executedAt)
+			assert.NoError(t, err)
+			_, err = cachedCompiler.Compile(ctx, job, config, executedAt)
+			assert.NoError(t, err)
+			_, err = cachedCompiler.Compile(ctx, job, config, executedAt)
+			assert.NoError(t, err)
+		})
+		t.Run("returns error when tenant details can not be fetched", func(t *testing.T) {
+			job := newJob(deployedAt, nil)
+			compiler := new(mockJobInputCompiler)
+			defer compiler.AssertExpectations(t)
+			tenantService := new(mockTenantService)
+			tenantService.On("GetDetails", ctx, tnnt).Return(nil, errors.New("error in getting tenant")).Once()
+			defer tenantService.AssertExpectations(t)
+
+			cachedCompiler := service.NewCachedInputCompiler(compiler, lru.New[string, *scheduler.ExecutorInput](10, 0), tenantService, newSnippetGetter())
+
+			_, err := cachedCompiler.Compile(ctx, job, config, executedAt)
+			assert.EqualError(t, err, "error in getting tenant")
+		})
+		t.Run("does not cache when compilation fails", func(t *testing.T) {
+			job := newJob(deployedAt, nil)
+			compiler := new(mockJobInputCompiler)
+			compiler.On("Compile", ctx, job, config, executedAt).Return(nil, errors.New("error in compiling")).Once()
+			compiler.On("Compile", ctx, job, config, executedAt).Return(input, nil).Once()
+			defer compiler.AssertExpectations(t)
+
+			tenantService := newTenantService("value", "value")
+			defer tenantService.AssertExpectations(t)
+			snippetGetter := newSnippetGetter(snippets, snippets)
+			defer snippetGetter.AssertExpectations(t)
+
+			cachedCompiler := service.NewCachedInputCompiler(compiler, lru.New[string, *scheduler.ExecutorInput](10, 0), tenantService, snippetGetter)
+
+			_, err := cachedCompiler.Compile(ctx, job, config, executedAt)
+			assert.EqualError(t, err, "error in compiling")
+			result, err := cachedCompiler.Compile(ctx, job, config, executedAt)
+			assert.NoError(t, err)
+			assert.Equal(t, input, result)
+		})
+	})
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -12,6 +14,7 @@ import (
 	"github.com/goto/optimus/core/scheduler"
 	"github.com/goto/optimus/core/tenant"
 	"github.com/goto/optimus/internal/compiler"
+	"github.com/goto/optimus/internal/errors"
 	"github.com/goto/optimus/internal/lib/window"
 	"github.com/goto/optimus/internal/utils"
 )
@@ -29,20 +32,51 @@ const (
 	contextSecret        = "secret"
 	contextSystemDefined = "inst"
 	contextTask          = "task"
+	contextUpstream      = "upstream"
 
-	SecretsStringToMatch = ".secret."
+	SecretsStringToMatch  = ".secret."
+	UpstreamStringToMatch = ".upstream"
+
+	upstreamArtifactsKey = "artifacts"
 
 	TimeISOFormat = time.RFC3339
 
 	// Configuration for system defined variables
 	configDstart        = "DSTART"
 	configDend          = "DEND"
+	configDintervals    = "DINTERVALS"
 	configExecutionTime = "EXECUTION_TIME"
 	configDestination   = "JOB_DESTINATION"
+	configJobTimezone   = "JOB_TIMEZONE"
+
+	// Configuration identifying the job run
+	configJobRunAttempt  = "JOB_RUN_ATTEMPT"
+	configJobRunType     = "JOB_RUN_TYPE"
+	configSchedulerRunID = "SCHEDULER_DAG_RUN_ID"
+	configOptimusHost    = "OPTIMUS_HOST"
+
+	// Configuration identifying the hook being run, for hook images shared by different kind of hooks
+	configHookName      = "HOOK_NAME"
+	configHookType      = "HOOK_TYPE"
+	configHookIndex     = "HOOK_INDEX"
+	configHookOrder     = "HOOK_ORDER"
+	configHookDependsOn = "HOOK_DEPENDS_ON"
+	// configHookSkipped is set when the when condition of the hook does not hold, for the executor to exit right away
+	configHookSkipped = "HOOK_SKIPPED"
 
 	JobAttributionLabelsKey = "JOB_LABELS"
 
 	maxJobAttributionLabelLength = 63
+
+	// Policies on secret values found in compiled assets
+	AssetSecretPolicyRedact  = "redact"
+	AssetSecretPolicyReject  = "reject"
+	AssetSecretPolicyEncrypt = "encrypt"
+
+	redactedSecretValue = "*****"
+
+	// minSecretLengthToScan avoids redacting common short words when they happen to be secret values
+	minSecretLengthToScan = 6
 )
 
 var invalidLabelCharacterRegex *regexp.Regexp
@@ -54,16 +88,26 @@ type TenantService interface {
 
 type TemplateCompiler interface {
 	Compile(templateMap map[string]string, context map[string]any) (map[string]string, error)
+	CompileMacros(macros map[string]string, context map[string]any) (map[string]string, error)
 }
 
 type AssetCompiler interface {
 	CompileJobRunAssets(ctx context.Context, job *scheduler.Job, systemEnvVars map[string]string, interval window.Interval, contextForTask map[string]interface{}) (map[string]string, error)
 }
 
+type UpstreamRunGetter interface {
+	GetLatestSuccessRun(ctx context.Context, tnnt tenant.Tenant, jobName scheduler.JobName, scheduledAt time.Time) (*scheduler.JobRun, error)
+}
+
 type InputCompiler struct {
-	tenantService TenantService
-	compiler      TemplateCompiler
-	assetCompiler AssetCompiler
+	tenantService     TenantService
+	compiler          TemplateCompiler
+	assetCompiler     AssetCompiler
+	upstreamRunGetter UpstreamRunGetter
+	pluginRepo        PluginRepo
+
+	// hostname is the address used by the executors to communicate back to optimus
+	hostname string
 
 	logger log.Logger
 }
@@ -103,7 +147,17 @@ func (i InputCompiler) Compile(ctx context.Context, job *scheduler.JobWithDetail
 		return nil, err
 	}
 
-	systemDefinedVars := getSystemDefinedConfigs(job.Job, interval, executedAt)
+	location, err := getJobTimezone(job.Job, tenantDetails)
+	if err != nil {
+		i.logger.Error("error getting timezone of job [%s]: %s", job.Name.String(), err)
+		return nil, err
+	}
+
+	systemDefinedVars := getSystemDefinedConfigs(job.Job, interval, executedAt, location)
+	if err := addIntervalsConfig(systemDefinedVars, w, config.ScheduledAt, location); err != nil {
+		i.logger.Error("error getting intervals of job [%s]: %s", job.Name, err)
+		return nil, err
+	}
 
 	// Prepare template context and compile task config
 	taskContext := compiler.PrepareContext(
@@ -111,6 +165,22 @@ func (i InputCompiler) Compile(ctx context.Context, job *scheduler.JobWithDetail
 		compiler.From(tenantDetails.SecretsMap()).WithName(contextSecret),
 		compiler.From(systemDefinedVars).WithName(contextSystemDefined).AddToContext(),
 	)
+	if referencesUpstream(job) {
+		upstreamContext, err := i.getUpstreamContext(ctx, job, config.ScheduledAt)
+		if err != nil {
+			i.logger.Error("error getting upstream artifacts: %s", err)
+			return nil, err
+		}
+		taskContext[contextUpstream] = upstreamContext
+	}
+	if macros := tenantDetails.Project().GetMacros(); len(macros) > 0 {
+		compiledMacros, err := i.compileMacros(macros, taskContext)
+		if err != nil {
+			i.logger.Error("error compiling macros of project [%s]: %s", job.Job.Tenant.ProjectName().String(), err)
+			return nil, err
+		}
+		taskContext[compiler.MacroContextName] = compiledMacros
+	}
 
 	// Compile asset files
 	fileMap, err := i.assetCompiler.CompileJobRunAssets(ctx, job.Job, systemDefinedVars, interval, taskContext)
@@ -119,11 +189,23 @@ func (i InputCompiler) Compile(ctx context.Context, job *scheduler.JobWithDetail
 		return nil, err
 	}
 
+	policy, _ := tenantDetails.GetConfig(tenant.NamespaceAssetSecretPolicy)
+	fileMap, secretFileMap, err := scanAssetsForSecrets(fileMap, tenantDetails.SecretsMap(), policy)
+	if err != nil {
+		i.logger.Error("error scanning compiled assets of job [%s] for secrets: %s", job.Name.String(), err)
+		return nil, err
+	}
+
 	confs, secretConfs, err := i.compileConfigs(job.Job.Task.Config, taskContext)
 	if err != nil {
 		i.logger.Error("error compiling task config: %s", err)
 		return nil, err
 	}
+	// overrides of a manual run replace the compiled task configs, the secret ones included
+	for key, value := range config.ConfigOverrides {
+		delete(secretConfs, key)
+		confs[key] = value
+	}
 
 	jobLabelsToAdd := map[string]string{
 		"project":   job.Job.Tenant.ProjectName().String(),
@@ -142,11 +224,13 @@ func (i InputCompiler) Compile(ctx context.Context, job *scheduler.JobWithDetail
 		confs[JobAttributionLabelsKey] = jobAttributionLabels
 	}
 
+	runVars := i.getRunConfigs(config)
 	if config.Executor.Type == scheduler.ExecutorTask {
 		return &scheduler.ExecutorInput{
-			Configs: utils.MergeMaps(confs, systemDefinedVars),
-			Secrets: secretConfs,
-			Files:   fileMap,
+			Configs:     utils.MergeMaps(confs, systemDefinedVars, runVars),
+			Secrets:     secretConfs,
+			Files:       fileMap,
+			SecretFiles: secretFileMap,
 		}, nil
 	}
 
@@ -163,19 +247,177 @@ func (i InputCompiler) Compile(ctx context.Context, job *scheduler.JobWithDetail
 		return nil, err
 	}
 
+	skipped, err := i.isHookSkipped(job.Job, hook, mergedContext)
+	if err != nil {
+		return nil, err
+	}
+	if skipped {
+		hookVars, err := i.getHookConfigs(job.Job, hook, config.Executor.Type)
+		if err != nil {
+			i.logger.Error("error getting plugin of hook [%s]: %s", hook.Name, err)
+			return nil, err
+		}
+		hookVars[configHookSkipped] = "true"
+		return &scheduler.ExecutorInput{
+			Configs: utils.MergeMaps(systemDefinedVars, runVars, hookVars),
+			Skipped: true,
+		}, nil
+	}
+
 	hookConfs, hookSecrets, err := i.compileConfigs(hook.Config, mergedContext)
 	if err != nil {
 		i.logger.Error("error compiling configs for hook [%s]: %s", hook.Name, err)
 		return nil, err
 	}
 
+	hookVars, err := i.getHookConfigs(job.Job, hook, config.Executor.Type)
+	if err != nil {
+		i.logger.Error("error getting plugin of hook [%s]: %s", hook.Name, err)
+		return nil, err
+	}
+
 	return &scheduler.ExecutorInput{
-		Configs: utils.MergeMaps(hookConfs, systemDefinedVars),
-		Secrets: hookSecrets,
-		Files:   fileMap,
+		Configs:     utils.MergeMaps(hookConfs, systemDefinedVars, runVars, hookVars),
+		Secrets:     hookSecrets,
+		Files:       fileMap,
+		SecretFiles: secretFileMap,
+	}, nil
+}
+
+// EnabledHooks returns the hooks of the job which are enabled in its tenant, a hook with an enabled_when
+// condition is enabled only when the condition compiles to true against the project and namespace configs
+func (i InputCompiler) EnabledHooks(ctx context.Context, job *scheduler.Job) ([]*scheduler.Hook, error) {
+	var tenantDetails *tenant.WithDetails
+	var enabledHooks []*scheduler.Hook
+	for _, hook := range job.Hooks {
+		if hook.EnabledWhen == "" {
+			enabledHooks = append(enabledHooks, hook)
+			continue
+		}
+
+		if tenantDetails == nil {
+			var err error
+			if tenantDetails, err = i.tenantService.GetDetails(ctx, job.Tenant); err != nil {
+				i.logger.Error("error getting tenant details: %s", err)
+				return nil, err
+			}
+		}
+		conditionContext := compiler.PrepareContext(
+			compiler.From(tenantDetails.GetConfigs()).WithName(contextProject).WithKeyPrefix(projectConfigPrefix),
+		)
+		compiled, err := i.compiler.Compile(map[string]string{hook.Name: hook.EnabledWhen}, conditionContext)
+		if err != nil {
+			i.logger.Error("error compiling enabled_when of hook [%s] of job [%s]: %s", hook.Name, job.Name, err)
+			return nil, err
+		}
+		enabled, err := strconv.ParseBool(strings.TrimSpace(compiled[hook.Name]))
+		if err != nil {
+			msg := fmt.Sprintf("enabled_when of hook %s of job %s should compile to true or false, got %q", hook.Name, job.Name, compiled[hook.Name])
+			return nil, errors.InvalidArgument(scheduler.EntityJobRun, msg)
+		}
+		if enabled {
+			enabledHooks = append(enabledHooks, hook)
+		}
+	}
+	return enabledHooks, nil
+}
+
+// isHookSkipped compiles the when condition of the hook against the task context of the run,
+// the hook is skipped unless the condition compiles to true
+func (i InputCompiler) isHookSkipped(job *scheduler.Job, hook *scheduler.Hook, templateCtx map[string]any) (bool, error) {
+	if hook.When == "" {
+		return false, nil
+	}
+	compiled, err := i.compiler.Compile(map[string]string{hook.Name: hook.When}, templateCtx)
+	if err != nil {
+		i.logger.Error("error compiling when of hook [%s] of job [%s]: %s", hook.Name, job.Name, err)
+		return false, err
+	}
+	holds, err := strconv.ParseBool(strings.TrimSpace(compiled[hook.Name]))
+	if err != nil {
+		msg := fmt.Sprintf("when of hook %s of job %s should compile to true or false, got %q", hook.Name, job.Name, compiled[hook.Name])
+		return false, errors.InvalidArgument(scheduler.EntityJobRun, msg)
+	}
+	return !holds, nil
+}
+
+// getHookConfigs returns the name, the type (pre, post or fail), the position in the job spec, the position in
+// the order of execution and the hooks to finish before the hook. Only the hooks of type fail are run by a failure
+// hook executor
+func (i InputCompiler) getHookConfigs(job *scheduler.Job, hook *scheduler.Hook, executorType scheduler.ExecutorType) (map[string]string, error) {
+	pluginDependsOn := make(map[string][]string, len(job.Hooks))
+	var hookType string
+	var found bool
+	for _, jobHook := range job.Hooks {
+		hookPlugin, err := i.pluginRepo.GetByName(jobHook.Name)
+		if err != nil {
+			return nil, err
+		}
+		info := hookPlugin.Info()
+		if info == nil {
+			return nil, errors.NotFound(scheduler.EntityJobRun, "plugin info not found for hook "+jobHook.Name)
+		}
+		pluginDependsOn[jobHook.Name] = info.DependsOn
+		if jobHook.Name == hook.Name {
+			hookType, found = info.HookType.String(), true
+		}
+	}
+	if !found {
+		return nil, errors.NotFound(scheduler.EntityJobRun, "hook:"+hook.Name)
+	}
+
+	var index int
+	for idx, jobHook := range job.Hooks {
+		if jobHook.Name == hook.Name {
+			index = idx
+			break
+		}
+	}
+	var order int
+	for idx, orderedHook := range job.OrderedHooks(pluginDependsOn) {
+		if orderedHook.Name == hook.Name {
+			order = idx
+			break
+		}
+	}
+
+	var dependsOn []string
+	seen := map[string]bool{}
+	for _, before := range append(append([]string{}, pluginDependsOn[hook.Name]...), hook.DependsOn...) {
+		if _, err := job.GetHook(before); err != nil || seen[before] {
+			continue
+		}
+		seen[before] = true
+		dependsOn = append(dependsOn, before)
+	}
+
+	if hook.Phase != "" {
+		hookType = hook.Phase
+	}
+	if executorType == scheduler.ExecutorHookFailure && hookType != scheduler.HookTypeFail {
+		msg := fmt.Sprintf("hook %s of job %s is not a failure hook, it is of type %s", hook.Name, job.Name, hookType)
+		return nil, errors.InvalidArgument(scheduler.EntityJobRun, msg)
+	}
+	return map[string]string{
+		configHookName:      hook.Name,
+		configHookType:      hookType,
+		configHookIndex:     strconv.Itoa(index),
+		configHookOrder:     strconv.Itoa(order),
+		configHookDependsOn: strings.Join(dependsOn, ","),
 	}, nil
 }
 
+// compileMacros compiles the project macros without the secrets, as the compiled macros end up in plain configs
+func (i InputCompiler) compileMacros(macros map[string]string, templateCtx map[string]any) (map[string]string, error) {
+	macroCtx := make(map[string]any, len(templateCtx))
+	for k, v := range templateCtx {
+		if k != contextSecret {
+			macroCtx[k] = v
+		}
+	}
+	return i.compiler.CompileMacros(macros, macroCtx)
+}
+
 func (i InputCompiler) compileConfigs(configs map[string]string, templateCtx map[string]any) (map[string]string, map[string]string, error) {
 	conf, secretsConfig := splitConfigWithSecrets(configs)
 
@@ -193,13 +435,170 @@ func (i InputCompiler) compileConfigs(configs map[string]string, templateCtx map
 	return conf, secretsConfig, nil
 }
 
-func getSystemDefinedConfigs(job *scheduler.Job, interval window.Interval, executedAt time.Time) map[string]string {
+// getUpstreamContext prepares the artifacts reported by the latest successful run of each upstream, scheduled at or
+// before the given time, keyed by <project>/<job> of the upstream
+func (i InputCompiler) getUpstreamContext(ctx context.Context, job *scheduler.JobWithDetails, scheduledAt time.Time) (map[string]any, error) {
+	upstreamContext := map[string]any{}
+	for _, upstream := range job.Upstreams.UpstreamJobs {
+		if upstream.External {
+			continue
+		}
+
+		artifacts := map[string]any{}
+		jobRun, err := i.upstreamRunGetter.GetLatestSuccessRun(ctx, upstream.Tenant, scheduler.JobName(upstream.JobName), scheduledAt)
+		if err != nil && !errors.IsErrorType(err, errors.ErrNotFound) {
+			return nil, err
+		}
+		if jobRun != nil && jobRun.Artifacts != nil {
+			artifacts = jobRun.Artifacts
+		}
+
+		key := upstream.Tenant.ProjectName().String() + "/" + upstream.JobName
+		upstreamContext[key] = map[string]any{upstreamArtifactsKey: artifacts}
+	}
+	return upstreamContext, nil
+}
+
+func referencesUpstream(job *scheduler.JobWithDetails) bool {
+	configs := []map[string]string{job.Job.Assets}
+	if job.Job.Task != nil {
+		configs = append(configs, job.Job.Task.Config)
+	}
+	for _, hook := range job.Job.Hooks {
+		configs = append(configs, hook.Config)
+	}
+	for _, config := range configs {
+		for _, val := range config {
+			if strings.Contains(val, UpstreamStringToMatch) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// getJobTimezone returns the timezone set in the task config of the job, falling back to the one of the tenant and UTC
+func getJobTimezone(job *scheduler.Job, tenantDetails *tenant.WithDetails) (*time.Location, error) {
+	timezone, _ := tenantDetails.GetConfig(tenant.ProjectJobTimezone)
+	if job.Task != nil && job.Task.Config[configJobTimezone] != "" {
+		timezone = job.Task.Config[configJobTimezone]
+	}
+	if timezone == "" {
+		return time.UTC, nil
+	}
+
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, errors.InvalidArgument(scheduler.EntityJobRun, "invalid job timezone "+timezone)
+	}
+	return location, nil
+}
+
+func getSystemDefinedConfigs(job *scheduler.Job, interval window.Interval, executedAt time.Time, location *time.Location) map[string]string {
 	return map[string]string{
-		configDstart:        interval.Start.Format(TimeISOFormat),
-		configDend:          interval.End.Format(TimeISOFormat),
-		configExecutionTime: executedAt.Format(TimeISOFormat),
+		configDstart:        interval.Start.In(location).Format(TimeISOFormat),
+		configDend:          interval.End.In(location).Format(TimeISOFormat),
+		configExecutionTime: executedAt.In(location).Format(TimeISOFormat),
 		configDestination:   job.Destination,
+		configJobTimezone:   location.String(),
+	}
+}
+
+// addIntervalsConfig adds the intervals of windows having more than one interval, for the jobs processing
+// non-contiguous ranges, DSTART and DEND still span from the earliest to the latest interval
+func addIntervalsConfig(configs map[string]string, w window.Window, scheduledAt time.Time, location *time.Location) error {
+	intervals, err := w.GetIntervals(scheduledAt)
+	if err != nil {
+		return err
+	}
+	if len(intervals) < 2 { //nolint:gomnd
+		return nil
+	}
+
+	formatted, err := window.FormatIntervals(intervals, location)
+	if err != nil {
+		return err
+	}
+	configs[configDintervals] = formatted
+	return nil
+}
+
+// getRunConfigs returns the configs identifying the job run, attempt and scheduler run id are only
+// added when sent by the executor
+func (i InputCompiler) getRunConfigs(config scheduler.RunConfig) map[string]string {
+	runConfigs := map[string]string{
+		configJobRunType:  config.RunType().String(),
+		configOptimusHost: i.hostname,
+	}
+	if config.Attempt > 0 {
+		runConfigs[configJobRunAttempt] = strconv.Itoa(config.Attempt)
+	}
+	if config.SchedulerRunID != "" {
+		runConfigs[configSchedulerRunID] = config.SchedulerRunID
+	}
+	return runConfigs
+}
+
+// scanAssetsForSecrets looks for the values of tenant secrets in the compiled assets, these are either replaced
+// with a placeholder, fail the compilation or are returned separately as secret files as per the policy of the namespace
+func scanAssetsForSecrets(files, secrets map[string]string, policy string) (map[string]string, map[string]string, error) {
+	if policy == "" || len(files) == 0 {
+		return files, nil, nil
+	}
+	if policy != AssetSecretPolicyRedact && policy != AssetSecretPolicyReject && policy != AssetSecretPolicyEncrypt {
+		return nil, nil, errors.InvalidArgument(scheduler.EntityJobRun, "invalid asset secret policy "+policy)
+	}
+
+	// longer values are replaced first, so that a secret containing another one is redacted entirely
+	secretNames := make([]string, 0, len(secrets))
+	for name, value := range secrets {
+		if len(value) >= minSecretLengthToScan {
+			secretNames = append(secretNames, name)
+		}
+	}
+	sort.Slice(secretNames, func(a, b int) bool {
+		if len(secrets[secretNames[a]]) != len(secrets[secretNames[b]]) {
+			return len(secrets[secretNames[a]]) > len(secrets[secretNames[b]])
+		}
+		return secretNames[a] < secretNames[b]
+	})
+
+	fileNames := make([]string, 0, len(files))
+	for name := range files {
+		fileNames = append(fileNames, name)
+	}
+	sort.Strings(fileNames)
+
+	scanned := make(map[string]string, len(files))
+	var secretFiles map[string]string
+	for _, fileName := range fileNames {
+		content := files[fileName]
+		hasSecret := false
+		for _, secretName := range secretNames {
+			if !strings.Contains(content, secrets[secretName]) {
+				continue
+			}
+			if policy == AssetSecretPolicyReject {
+				msg := fmt.Sprintf("compiled asset %s contains the value of secret %s", fileName, secretName)
+				return nil, nil, errors.InvalidArgument(scheduler.EntityJobRun, msg)
+			}
+			if policy == AssetSecretPolicyEncrypt {
+				hasSecret = true
+				break
+			}
+			content = strings.ReplaceAll(content, secrets[secretName], redactedSecretValue)
+		}
+
+		if hasSecret {
+			if secretFiles == nil {
+				secretFiles = map[string]string{}
+			}
+			secretFiles[fileName] = content
+			continue
+		}
+		scanned[fileName] = content
 	}
+	return scanned, secretFiles, nil
 }
 
 func splitConfigWithSecrets(conf map[string]string) (map[string]string, map[string]string) {
@@ -216,13 +615,18 @@ func splitConfigWithSecrets(conf map[string]string) (map[string]string, map[stri
 	return configs, configWithSecrets
 }
 
-func NewJobInputCompiler(tenantService TenantService, compiler TemplateCompiler, assetCompiler AssetCompiler, logger log.Logger) *InputCompiler {
+func NewJobInputCompiler(tenantService TenantService, compiler TemplateCompiler, assetCompiler AssetCompiler, upstreamRunGetter UpstreamRunGetter,
+	pluginRepo PluginRepo, hostname string, logger log.Logger,
+) *InputCompiler {
 	invalidLabelCharacterRegex = regexp.MustCompile(`[^\w-]`)
 	return &InputCompiler{
-		tenantService: tenantService,
-		compiler:      compiler,
-		assetCompiler: assetCompiler,
-		logger:        logger,
+		tenantService:     tenantService,
+		compiler:          compiler,
+		assetCompiler:     assetCompiler,
+		upstreamRunGetter: upstreamRunGetter,
+		pluginRepo:        pluginRepo,
+		hostname:          hostname,
+		logger:            logger,
 	}
 }
 
@@ -15,8 +15,12 @@ import (
 	"github.com/goto/optimus/core/scheduler"
 	"github.com/goto/optimus/core/scheduler/service"
 	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/compiler"
+	"github.com/goto/optimus/internal/errors"
 	"github.com/goto/optimus/internal/lib/window"
 	"github.com/goto/optimus/internal/models"
+	"github.com/goto/optimus/sdk/plugin"
+	smock "github.com/goto/optimus/sdk/plugin/mock"
 )
 
 func TestExecutorCompiler(t *testing.T) {
@@ -61,7 +65,7 @@ func TestExecutorCompiler(t *testing.T) {
 			tenantService.On("GetDetails", ctx, tnnt).Return(nil, fmt.Errorf("get details error"))
 			defer tenantService.AssertExpectations(t)
 
-			inputCompiler := service.NewJobInputCompiler(tenantService, nil, nil, logger)
+			inputCompiler := service.NewJobInputCompiler(tenantService, nil, nil, nil, nil, "optimus.example.io:80", logger)
 			inputExecutor, err := inputCompiler.Compile(ctx, &details, config, currentTime.Add(time.Hour))
 
 			assert.NotNil(t, err)
@@ -96,7 +100,7 @@ func TestExecutorCompiler(t *testing.T) {
 			tenantService.On("GetDetails", ctx, tnnt).Return(tenantDetails, nil)
 			defer tenantService.AssertExpectations(t)
 
-			inputCompiler := service.NewJobInputCompiler(tenantService, nil, nil, logger)
+			inputCompiler := service.NewJobInputCompiler(tenantService, nil, nil, nil, nil, "optimus.example.io:80", logger)
 			inputExecutor, err := inputCompiler.Compile(ctx, &details, config, currentTime.Add(time.Hour))
 
 			assert.NotNil(t, err)
@@ -139,6 +143,7 @@ func TestExecutorCompiler(t *testing.T) {
 				"DEND":            interval.End.Format(time.RFC3339),
 				"EXECUTION_TIME":  executedAt.Format(time.RFC3339),
 				"JOB_DESTINATION": job.Destination,
+				"JOB_TIMEZONE":    "UTC",
 			}
 			taskContext := mock.Anything
 
@@ -146,7 +151,7 @@ func TestExecutorCompiler(t *testing.T) {
 			assetCompiler.On("CompileJobRunAssets", ctx, &job, systemDefinedVars, interval, taskContext).Return(nil, fmt.Errorf("CompileJobRunAssets error"))
 			defer assetCompiler.AssertExpectations(t)
 
-			inputCompiler := service.NewJobInputCompiler(tenantService, nil, assetCompiler, logger)
+			inputCompiler := service.NewJobInputCompiler(tenantService, nil, assetCompiler, nil, nil, "optimus.example.io:80", logger)
 			inputExecutor, err := inputCompiler.Compile(ctx, &details, config, executedAt)
 
 			assert.NotNil(t, err)
@@ -199,6 +204,7 @@ func TestExecutorCompiler(t *testing.T) {
 				"DEND":            interval.End.Format(time.RFC3339),
 				"EXECUTION_TIME":  executedAt.Format(time.RFC3339),
 				"JOB_DESTINATION": job.Destination,
+				"JOB_TIMEZONE":    "UTC",
 			}
 			taskContext := mock.Anything
 
@@ -214,7 +220,7 @@ func TestExecutorCompiler(t *testing.T) {
 				assetCompiler := new(mockAssetCompiler)
 				assetCompiler.On("CompileJobRunAssets", ctx, &job, systemDefinedVars, interval, taskContext).Return(compiledFile, nil)
 				defer assetCompiler.AssertExpectations(t)
-				inputCompiler := service.NewJobInputCompiler(tenantService, templateCompiler, assetCompiler, logger)
+				inputCompiler := service.NewJobInputCompiler(tenantService, templateCompiler, assetCompiler, nil, nil, "optimus.example.io:80", logger)
 				inputExecutor, err := inputCompiler.Compile(ctx, &details, config, executedAt)
 
 				assert.NotNil(t, err)
@@ -231,7 +237,7 @@ func TestExecutorCompiler(t *testing.T) {
 				assetCompiler := new(mockAssetCompiler)
 				assetCompiler.On("CompileJobRunAssets", ctx, &job, systemDefinedVars, interval, taskContext).Return(compiledFile, nil)
 				defer assetCompiler.AssertExpectations(t)
-				inputCompiler := service.NewJobInputCompiler(tenantService, templateCompiler, assetCompiler, logger)
+				inputCompiler := service.NewJobInputCompiler(tenantService, templateCompiler, assetCompiler, nil, nil, "optimus.example.io:80", logger)
 				inputExecutor, err := inputCompiler.Compile(ctx, &details, config, executedAt)
 
 				assert.NotNil(t, err)
@@ -248,7 +254,7 @@ func TestExecutorCompiler(t *testing.T) {
 				assetCompiler := new(mockAssetCompiler)
 				assetCompiler.On("CompileJobRunAssets", ctx, &job, systemDefinedVars, interval, taskContext).Return(compiledFile, nil)
 				defer assetCompiler.AssertExpectations(t)
-				inputCompiler := service.NewJobInputCompiler(tenantService, templateCompiler, assetCompiler, logger)
+				inputCompiler := service.NewJobInputCompiler(tenantService, templateCompiler, assetCompiler, nil, nil, "optimus.example.io:80", logger)
 				inputExecutorResp, err := inputCompiler.Compile(ctx, &details, config, executedAt)
 
 				assert.Nil(t, err)
@@ -258,6 +264,9 @@ func TestExecutorCompiler(t *testing.T) {
 						"DEND":                 interval.End.Format(time.RFC3339),
 						"EXECUTION_TIME":       executedAt.Format(time.RFC3339),
 						"JOB_DESTINATION":      job.Destination,
+						"JOB_TIMEZONE":         "UTC",
+						"JOB_RUN_TYPE":         "scheduled",
+						"OPTIMUS_HOST":         "optimus.example.io:80",
 						"some.config.compiled": "val.compiled",
 					},
 					Secrets: map[string]string{"secret.config.compiled": "a.secret.val.compiled"},
@@ -297,7 +306,7 @@ func TestExecutorCompiler(t *testing.T) {
 				assetCompilerNew.On("CompileJobRunAssets", ctx, &jobNew, systemDefinedVars, interval, taskContext).Return(compiledFile, nil)
 				defer assetCompilerNew.AssertExpectations(t)
 
-				inputCompiler := service.NewJobInputCompiler(tenantService, templateCompiler, assetCompilerNew, logger)
+				inputCompiler := service.NewJobInputCompiler(tenantService, templateCompiler, assetCompilerNew, nil, nil, "optimus.example.io:80", logger)
 
 				inputExecutorResp, err := inputCompiler.Compile(ctx, &detailsNew, config, executedAt)
 				assert.Nil(t, err)
@@ -308,6 +317,9 @@ func TestExecutorCompiler(t *testing.T) {
 						"DEND":                 interval.End.Format(time.RFC3339),
 						"EXECUTION_TIME":       executedAt.Format(time.RFC3339),
 						"JOB_DESTINATION":      job.Destination,
+						"JOB_TIMEZONE":         "UTC",
+						"JOB_RUN_TYPE":         "scheduled",
+						"OPTIMUS_HOST":         "optimus.example.io:80",
 						"some.config.compiled": "val.compiled",
 					},
 					Secrets: map[string]string{"secret.config.compiled": "a.secret.val.compiled"},
@@ -329,6 +341,437 @@ func TestExecutorCompiler(t *testing.T) {
 				assert.Equal(t, expectedInputExecutor, inputExecutorResp)
 			})
 		})
+		t.Run("compileConfigs with artifacts of upstream runs", func(t *testing.T) {
+			w1, _ := models.NewWindow(2, "d", "1h", "24h")
+			window1 := window.NewCustomConfig(w1)
+			upstreamTnnt, _ := tenant.NewTenant("proj2", "ns2")
+			job := scheduler.Job{
+				Name:        "job1",
+				Tenant:      tnnt,
+				Destination: "some_destination_table_name",
+				Task: &scheduler.Task{
+					Name: "bq2bq",
+					Config: map[string]string{
+						"FROM_ID":    `{{ (index .upstream "proj2/job2").artifacts.max_id }}`,
+						"FROM_COUNT": `{{ (index .upstream "proj2/job3").artifacts.count }}`,
+					},
+				},
+				WindowConfig: window1,
+			}
+			details := scheduler.JobWithDetails{
+				Job: &job,
+				Schedule: &scheduler.Schedule{
+					Interval: "0 * * * *",
+				},
+				Upstreams: scheduler.Upstreams{
+					UpstreamJobs: []*scheduler.JobUpstream{
+						{JobName: "job2", Tenant: upstreamTnnt},
+						{JobName: "job3", Tenant: upstreamTnnt},
+						{JobName: "job4", Tenant: upstreamTnnt, External: true},
+					},
+				},
+			}
+			config := scheduler.RunConfig{
+				Executor: scheduler.Executor{
+					Name: "bq2bq",
+					Type: scheduler.ExecutorTask,
+				},
+				ScheduledAt: currentTime.Add(-time.Hour),
+				JobRunID:    scheduler.JobRunID{},
+			}
+
+			tenantService := new(mockTenantService)
+			tenantService.On("GetDetails", ctx, tnnt).Return(tenantDetails, nil)
+			defer tenantService.AssertExpectations(t)
+
+			assetCompiler := new(mockAssetCompiler)
+			assetCompiler.On("CompileJobRunAssets", ctx, &job, mock.Anything, mock.Anything, mock.Anything).Return(map[string]string{}, nil)
+			defer assetCompiler.AssertExpectations(t)
+
+			upstreamRunGetter := new(mockUpstreamRunGetter)
+			upstreamRunGetter.On("GetLatestSuccessRun", ctx, upstreamTnnt, scheduler.JobName("job2"), config.ScheduledAt).
+				Return(&scheduler.JobRun{Artifacts: map[string]any{"max_id": "1024"}}, nil)
+			upstreamRunGetter.On("GetLatestSuccessRun", ctx, upstreamTnnt, scheduler.JobName("job3"), config.ScheduledAt).
+				Return(nil, errors.NotFound(scheduler.EntityJobRun, "no successful run"))
+			defer upstreamRunGetter.AssertExpectations(t)
+
+			inputCompiler := service.NewJobInputCompiler(tenantService, compiler.NewEngine(), assetCompiler, upstreamRunGetter, nil, "optimus.example.io:80", logger)
+			inputExecutorResp, err := inputCompiler.Compile(ctx, &details, config, currentTime)
+
+			assert.Nil(t, err)
+			assert.Equal(t, "1024", inputExecutorResp.Configs["FROM_ID"])
+			assert.Equal(t, "<no value>", inputExecutorResp.Configs["FROM_COUNT"])
+		})
+		t.Run("compileConfigs with the intervals of repeated window", func(t *testing.T) {
+			w1, _ := models.NewWindow(2, "d", "0", "24h;repeat=3;every=1w")
+			job := scheduler.Job{
+				Name:         "job1",
+				Tenant:       tnnt,
+				Task:         &scheduler.Task{Name: "bq2bq", Config: map[string]string{}},
+				WindowConfig: window.NewCustomConfig(w1),
+			}
+			details := scheduler.JobWithDetails{Job: &job, Schedule: &scheduler.Schedule{Interval: "0 0 * * 0"}}
+			runConfig := scheduler.RunConfig{
+				Executor: scheduler.Executor{
+					Name: "bq2bq",
+					Type: scheduler.ExecutorTask,
+				},
+				ScheduledAt: time.Date(2023, 1, 22, 0, 0, 0, 0, time.UTC),
+			}
+
+			tenantService := new(mockTenantService)
+			tenantService.On("GetDetails", ctx, tnnt).Return(tenantDetails, nil)
+			defer tenantService.AssertExpectations(t)
+
+			assetCompiler := new(mockAssetCompiler)
+			assetCompiler.On("CompileJobRunAssets", ctx, &job, mock.Anything, mock.Anything, mock.Anything).Return(map[string]string{}, nil)
+			defer assetCompiler.AssertExpectations(t)
+
+			inputCompiler := service.NewJobInputCompiler(tenantService, compiler.NewEngine(), assetCompiler, nil, nil, "optimus.example.io:80", logger)
+			inputExecutorResp, err := inputCompiler.Compile(ctx, &details, runConfig, currentTime)
+
+			assert.Nil(t, err)
+			assert.Equal(t, "2023-01-07T00:00:00Z", inputExecutorResp.Configs["DSTART"])
+			assert.Equal(t, "2023-01-22T00:00:00Z", inputExecutorResp.Configs["DEND"])
+			assert.JSONEq(t, `[
+				{"start": "2023-01-07T00:00:00Z", "end": "2023-01-08T00:00:00Z"},
+				{"start": "2023-01-14T00:00:00Z", "end": "2023-01-15T00:00:00Z"},
+				{"start": "2023-01-21T00:00:00Z", "end": "2023-01-22T00:00:00Z"}
+			]`, inputExecutorResp.Configs["DINTERVALS"])
+		})
+		t.Run("compileConfigs in the timezone of the job", func(t *testing.T) {
+			w1, _ := models.NewWindow(2, "d", "0", "24h")
+			window1 := window.NewCustomConfig(w1)
+			executedAt := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+			runConfig := scheduler.RunConfig{
+				Executor: scheduler.Executor{
+					Name: "bq2bq",
+					Type: scheduler.ExecutorTask,
+				},
+				ScheduledAt: time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC),
+			}
+			projectWithTimezone, _ := tenant.NewProject("proj1", map[string]string{
+				"STORAGE_PATH":   "somePath",
+				"SCHEDULER_HOST": "localhost",
+				"JOB_TIMEZONE":   "Asia/Jakarta",
+			})
+			tenantDetailsWithTimezone, _ := tenant.NewTenantDetails(projectWithTimezone, namespace, secretsArray)
+
+			t.Run("should use the timezone of the project when not set in the job", func(t *testing.T) {
+				job := scheduler.Job{
+					Name:         "job1",
+					Tenant:       tnnt,
+					Task:         &scheduler.Task{Name: "bq2bq", Config: map[string]string{}},
+					WindowConfig: window1,
+				}
+				details := scheduler.JobWithDetails{Job: &job, Schedule: &scheduler.Schedule{Interval: "0 0 * * *"}}
+
+				tenantService := new(mockTenantService)
+				tenantService.On("GetDetails", ctx, tnnt).Return(tenantDetailsWithTimezone, nil)
+				defer tenantService.AssertExpectations(t)
+
+				assetCompiler := new(mockAssetCompiler)
+				assetCompiler.On("CompileJobRunAssets", ctx, &job, mock.Anything, mock.Anything, mock.Anything).Return(map[string]string{}, nil)
+				defer assetCompiler.AssertExpectations(t)
+
+				inputCompiler := service.NewJobInputCompiler(tenantService, compiler.NewEngine(), assetCompiler, nil, nil, "optimus.example.io:80", logger)
+				inputExecutorResp, err := inputCompiler.Compile(ctx, &details, runConfig, executedAt)
+
+				assert.Nil(t, err)
+				assert.Equal(t, "2023-01-01T07:00:00+07:00", inputExecutorResp.Configs["DSTART"])
+				assert.Equal(t, "2023-01-02T07:00:00+07:00", inputExecutorResp.Configs["DEND"])
+				assert.Equal(t, "2023-01-02T10:04:05+07:00", inputExecutorResp.Configs["EXECUTION_TIME"])
+				assert.Equal(t, "Asia/Jakarta", inputExecutorResp.Configs["JOB_TIMEZONE"])
+			})
+			t.Run("should use the timezone set in the job over the one of the project", func(t *testing.T) {
+				job := scheduler.Job{
+					Name:         "job1",
+					Tenant:       tnnt,
+					Task:         &scheduler.Task{Name: "bq2bq", Config: map[string]string{"JOB_TIMEZONE": "Asia/Tokyo"}},
+					WindowConfig: window1,
+				}
+				details := scheduler.JobWithDetails{Job: &job, Schedule: &scheduler.Schedule{Interval: "0 0 * * *"}}
+
+				tenantService := new(mockTenantService)
+				tenantService.On("GetDetails", ctx, tnnt).Return(tenantDetailsWithTimezone, nil)
+				defer tenantService.AssertExpectations(t)
+
+				assetCompiler := new(mockAssetCompiler)
+				assetCompiler.On("CompileJobRunAssets", ctx, &job, mock.Anything, mock.Anything, mock.Anything).Return(map[string]string{}, nil)
+				defer assetCompiler.AssertExpectations(t)
+
+				inputCompiler := service.NewJobInputCompiler(tenantService, compiler.NewEngine(), assetCompiler, nil, nil, "optimus.example.io:80", logger)
+				inputExecutorResp, err := inputCompiler.Compile(ctx, &details, runConfig, executedAt)
+
+				assert.Nil(t, err)
+				assert.Equal(t, "2023-01-01T09:00:00+09:00", inputExecutorResp.Configs["DSTART"])
+				assert.Equal(t, "2023-01-02T09:00:00+09:00", inputExecutorResp.Configs["DEND"])
+				assert.Equal(t, "2023-01-02T12:04:05+09:00", inputExecutorResp.Configs["EXECUTION_TIME"])
+				assert.Equal(t, "Asia/Tokyo", inputExecutorResp.Configs["JOB_TIMEZONE"])
+			})
+			t.Run("should give error if the timezone is invalid", func(t *testing.T) {
+				job := scheduler.Job{
+					Name:         "job1",
+					Tenant:       tnnt,
+					Task:         &scheduler.Task{Name: "bq2bq", Config: map[string]string{"JOB_TIMEZONE": "Mars/Olympus"}},
+					WindowConfig: window1,
+				}
+				details := scheduler.JobWithDetails{Job: &job, Schedule: &scheduler.Schedule{Interval: "0 0 * * *"}}
+
+				tenantService := new(mockTenantService)
+				tenantService.On("GetDetails", ctx, tnnt).Return(tenantDetails, nil)
+				defer tenantService.AssertExpectations(t)
+
+				inputCompiler := service.NewJobInputCompiler(tenantService, compiler.NewEngine(), nil, nil, nil, "optimus.example.io:80", logger)
+				inputExecutorResp, err := inputCompiler.Compile(ctx, &details, runConfig, executedAt)
+
+				assert.Nil(t, inputExecutorResp)
+				assert.EqualError(t, err, "invalid argument for entity jobRun: invalid job timezone Mars/Olympus")
+			})
+		})
+		t.Run("compileConfigs with attempt, run type and scheduler run id of the run", func(t *testing.T) {
+			w1, _ := models.NewWindow(2, "d", "0", "24h")
+			window1 := window.NewCustomConfig(w1)
+			job := scheduler.Job{
+				Name:         "job1",
+				Tenant:       tnnt,
+				Task:         &scheduler.Task{Name: "bq2bq", Config: map[string]string{}},
+				WindowConfig: window1,
+			}
+			details := scheduler.JobWithDetails{Job: &job, Schedule: &scheduler.Schedule{Interval: "0 0 * * *"}}
+			runConfig := scheduler.RunConfig{
+				Executor:       scheduler.Executor{Name: "bq2bq", Type: scheduler.ExecutorTask},
+				ScheduledAt:    time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC),
+				Attempt:        3,
+				SchedulerRunID: "replayed__2023-01-02T00:00:00+00:00",
+			}
+
+			tenantService := new(mockTenantService)
+			tenantService.On("GetDetails", ctx, tnnt).Return(tenantDetails, nil)
+			defer tenantService.AssertExpectations(t)
+
+			assetCompiler := new(mockAssetCompiler)
+			assetCompiler.On("CompileJobRunAssets", ctx, &job, mock.Anything, mock.Anything, mock.Anything).Return(map[string]string{}, nil)
+			defer assetCompiler.AssertExpectations(t)
+
+			inputCompiler := service.NewJobInputCompiler(tenantService, compiler.NewEngine(), assetCompiler, nil, nil, "optimus.example.io:80", logger)
+			inputExecutorResp, err := inputCompiler.Compile(ctx, &details, runConfig, time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC))
+
+			assert.Nil(t, err)
+			assert.Equal(t, "3", inputExecutorResp.Configs["JOB_RUN_ATTEMPT"])
+			assert.Equal(t, "replayed", inputExecutorResp.Configs["JOB_RUN_TYPE"])
+			assert.Equal(t, "replayed__2023-01-02T00:00:00+00:00", inputExecutorResp.Configs["SCHEDULER_DAG_RUN_ID"])
+			assert.Equal(t, "optimus.example.io:80", inputExecutorResp.Configs["OPTIMUS_HOST"])
+		})
+		t.Run("compileConfigs with config overrides of a manual run", func(t *testing.T) {
+			w1, _ := models.NewWindow(2, "d", "0", "24h")
+			window1 := window.NewCustomConfig(w1)
+			job := scheduler.Job{
+				Name:   "job1",
+				Tenant: tnnt,
+				Task: &scheduler.Task{Name: "bq2bq", Config: map[string]string{
+					"LOAD_METHOD": "APPEND",
+					"TABLE":       "{{.proj.STORAGE_PATH}}",
+					"SECRET_KEY":  "{{.secret.secretName}}",
+				}},
+				WindowConfig: window1,
+			}
+			details := scheduler.JobWithDetails{Job: &job, Schedule: &scheduler.Schedule{Interval: "0 0 * * *"}}
+			runConfig := scheduler.RunConfig{
+				Executor:       scheduler.Executor{Name: "bq2bq", Type: scheduler.ExecutorTask},
+				ScheduledAt:    time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC),
+				SchedulerRunID: "manual__2023-01-02T00:00:00+00:00",
+			}.WithConfigOverrides(map[string]string{"LOAD_METHOD": "REPLACE", "SECRET_KEY": "other"})
+
+			tenantService := new(mockTenantService)
+			tenantService.On("GetDetails", ctx, tnnt).Return(tenantDetails, nil)
+			defer tenantService.AssertExpectations(t)
+
+			assetCompiler := new(mockAssetCompiler)
+			assetCompiler.On("CompileJobRunAssets", ctx, &job, mock.Anything, mock.Anything, mock.Anything).Return(map[string]string{}, nil)
+			defer assetCompiler.AssertExpectations(t)
+
+			inputCompiler := service.NewJobInputCompiler(tenantService, compiler.NewEngine(), assetCompiler, nil, nil, "optimus.example.io:80", logger)
+			inputExecutorResp, err := inputCompiler.Compile(ctx, &details, runConfig, time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC))
+
+			assert.Nil(t, err)
+			assert.Equal(t, "REPLACE", inputExecutorResp.Configs["LOAD_METHOD"])
+			assert.Equal(t, "somePath", inputExecutorResp.Configs["TABLE"])
+			assert.Equal(t, "other", inputExecutorResp.Configs["SECRET_KEY"])
+			assert.NotContains(t, inputExecutorResp.Secrets, "SECRET_KEY")
+		})
+		t.Run("compileConfigs with project macros", func(t *testing.T) {
+			w1, _ := models.NewWindow(2, "d", "0", "24h")
+			window1 := window.NewCustomConfig(w1)
+			job := scheduler.Job{
+				Name:   "job1",
+				Tenant: tnnt,
+				Task: &scheduler.Task{Name: "bq2bq", Config: map[string]string{
+					"FILTER": "{{ .macro.EVENTS_FILTER }}",
+				}},
+				WindowConfig: window1,
+			}
+			details := scheduler.JobWithDetails{Job: &job, Schedule: &scheduler.Schedule{Interval: "0 0 * * *"}}
+			runConfig := scheduler.RunConfig{
+				Executor:    scheduler.Executor{Name: "bq2bq", Type: scheduler.ExecutorTask},
+				ScheduledAt: time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC),
+			}
+			executedAt := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+			projectWithMacros := func(macros map[string]string) *tenant.WithDetails {
+				configs := map[string]string{"STORAGE_PATH": "somePath", "SCHEDULER_HOST": "localhost"}
+				for name, macro := range macros {
+					configs[tenant.ProjectMacroPrefix+name] = macro
+				}
+				projWithMacros, _ := tenant.NewProject("proj1", configs)
+				details, _ := tenant.NewTenantDetails(projWithMacros, namespace, secretsArray)
+				return details
+			}
+
+			t.Run("should expose the compiled macros to the configs and the assets", func(t *testing.T) {
+				tenantService := new(mockTenantService)
+				tenantService.On("GetDetails", ctx, tnnt).Return(projectWithMacros(map[string]string{
+					"EVENTS_TABLE":  "{{ .GLOBAL__SCHEDULER_HOST }}.events",
+					"EVENTS_FILTER": `ts >= "{{ .DSTART | Date }}" AND table = "{{ .macro.EVENTS_TABLE }}"`,
+				}), nil)
+				defer tenantService.AssertExpectations(t)
+
+				assetCompiler := new(mockAssetCompiler)
+				assetCompiler.On("CompileJobRunAssets", ctx, &job, mock.Anything, mock.Anything, mock.MatchedBy(func(taskContext map[string]any) bool {
+					macros, ok := taskContext["macro"].(map[string]string)
+					return ok && macros["EVENTS_TABLE"] == "localhost.events"
+				})).Return(map[string]string{}, nil)
+				defer assetCompiler.AssertExpectations(t)
+
+				inputCompiler := service.NewJobInputCompiler(tenantService, compiler.NewEngine(), assetCompiler, nil, nil, "optimus.example.io:80", logger)
+				inputExecutorResp, err := inputCompiler.Compile(ctx, &details, runConfig, executedAt)
+
+				assert.Nil(t, err)
+				assert.Equal(t, `ts >= "2023-01-01" AND table = "localhost.events"`, inputExecutorResp.Configs["FILTER"])
+			})
+			t.Run("should give error if macros refer to each other in a cycle", func(t *testing.T) {
+				tenantService := new(mockTenantService)
+				tenantService.On("GetDetails", ctx, tnnt).Return(projectWithMacros(map[string]string{
+					"EVENTS_TABLE":  "{{ .macro.EVENTS_FILTER }}",
+					"EVENTS_FILTER": "{{ .macro.EVENTS_TABLE }}",
+				}), nil)
+				defer tenantService.AssertExpectations(t)
+
+				inputCompiler := service.NewJobInputCompiler(tenantService, compiler.NewEngine(), nil, nil, nil, "optimus.example.io:80", logger)
+				inputExecutorResp, err := inputCompiler.Compile(ctx, &details, runConfig, executedAt)
+
+				assert.Nil(t, inputExecutorResp)
+				assert.ErrorContains(t, err, "macros refer to each other in a cycle: EVENTS_FILTER -> EVENTS_TABLE -> EVENTS_FILTER")
+			})
+		})
+		t.Run("compileConfigs with secret values in compiled assets", func(t *testing.T) {
+			w1, _ := models.NewWindow(2, "d", "0", "24h")
+			window1 := window.NewCustomConfig(w1)
+			job := scheduler.Job{
+				Name:         "job1",
+				Tenant:       tnnt,
+				Task:         &scheduler.Task{Name: "bq2bq", Config: map[string]string{}},
+				WindowConfig: window1,
+			}
+			details := scheduler.JobWithDetails{Job: &job, Schedule: &scheduler.Schedule{Interval: "0 0 * * *"}}
+			runConfig := scheduler.RunConfig{
+				Executor:    scheduler.Executor{Name: "bq2bq", Type: scheduler.ExecutorTask},
+				ScheduledAt: time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC),
+			}
+			executedAt := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+			compiledFiles := map[string]string{
+				"query.sql": "select * from table where token = 'secretValue'",
+				"other.sql": "select 1",
+			}
+			tenantDetailsWithPolicy := func(policy string) *tenant.WithDetails {
+				namespaceWithPolicy, _ := tenant.NewNamespace("ns1", project.Name(), map[string]string{
+					tenant.NamespaceAssetSecretPolicy: policy,
+				})
+				details, _ := tenant.NewTenantDetails(project, namespaceWithPolicy, secretsArray)
+				return details
+			}
+
+			t.Run("should keep assets as is when policy is not set", func(t *testing.T) {
+				tenantService := new(mockTenantService)
+				tenantService.On("GetDetails", ctx, tnnt).Return(tenantDetails, nil)
+				defer tenantService.AssertExpectations(t)
+
+				assetCompiler := new(mockAssetCompiler)
+				assetCompiler.On("CompileJobRunAssets", ctx, &job, mock.Anything, mock.Anything, mock.Anything).Return(compiledFiles, nil)
+				defer assetCompiler.AssertExpectations(t)
+
+				inputCompiler := service.NewJobInputCompiler(tenantService, compiler.NewEngine(), assetCompiler, nil, nil, "optimus.example.io:80", logger)
+				inputExecutorResp, err := inputCompiler.Compile(ctx, &details, runConfig, executedAt)
+
+				assert.Nil(t, err)
+				assert.Equal(t, scheduler.ConfigMap(compiledFiles), inputExecutorResp.Files)
+			})
+			t.Run("should redact secret values when policy is redact", func(t *testing.T) {
+				tenantService := new(mockTenantService)
+				tenantService.On("GetDetails", ctx, tnnt).Return(tenantDetailsWithPolicy("redact"), nil)
+				defer tenantService.AssertExpectations(t)
+
+				assetCompiler := new(mockAssetCompiler)
+				assetCompiler.On("CompileJobRunAssets", ctx, &job, mock.Anything, mock.Anything, mock.Anything).Return(compiledFiles, nil)
+				defer assetCompiler.AssertExpectations(t)
+
+				inputCompiler := service.NewJobInputCompiler(tenantService, compiler.NewEngine(), assetCompiler, nil, nil, "optimus.example.io:80", logger)
+				inputExecutorResp, err := inputCompiler.Compile(ctx, &details, runConfig, executedAt)
+
+				assert.Nil(t, err)
+				assert.Equal(t, scheduler.ConfigMap{
+					"query.sql": "select * from table where token = '*****'",
+					"other.sql": "select 1",
+				}, inputExecutorResp.Files)
+			})
+			t.Run("should give error when policy is reject", func(t *testing.T) {
+				tenantService := new(mockTenantService)
+				tenantService.On("GetDetails", ctx, tnnt).Return(tenantDetailsWithPolicy("reject"), nil)
+				defer tenantService.AssertExpectations(t)
+
+				assetCompiler := new(mockAssetCompiler)
+				assetCompiler.On("CompileJobRunAssets", ctx, &job, mock.Anything, mock.Anything, mock.Anything).Return(compiledFiles, nil)
+				defer assetCompiler.AssertExpectations(t)
+
+				inputCompiler := service.NewJobInputCompiler(tenantService, compiler.NewEngine(), assetCompiler, nil, nil, "optimus.example.io:80", logger)
+				inputExecutorResp, err := inputCompiler.Compile(ctx, &details, runConfig, executedAt)
+
+				assert.Nil(t, inputExecutorResp)
+				assert.EqualError(t, err, "invalid argument for entity jobRun: compiled asset query.sql contains the value of secret SECRETNAME")
+			})
+			t.Run("should move assets with secret values to secret files when policy is encrypt", func(t *testing.T) {
+				tenantService := new(mockTenantService)
+				tenantService.On("GetDetails", ctx, tnnt).Return(tenantDetailsWithPolicy("encrypt"), nil)
+				defer tenantService.AssertExpectations(t)
+
+				assetCompiler := new(mockAssetCompiler)
+				assetCompiler.On("CompileJobRunAssets", ctx, &job, mock.Anything, mock.Anything, mock.Anything).Return(compiledFiles, nil)
+				defer assetCompiler.AssertExpectations(t)
+
+				inputCompiler := service.NewJobInputCompiler(tenantService, compiler.NewEngine(), assetCompiler, nil, nil, "optimus.example.io:80", logger)
+				inputExecutorResp, err := inputCompiler.Compile(ctx, &details, runConfig, executedAt)
+
+				assert.Nil(t, err)
+				assert.Equal(t, scheduler.ConfigMap{"other.sql": "select 1"}, inputExecutorResp.Files)
+				assert.Equal(t, scheduler.ConfigMap{
+					"query.sql": "select * from table where token = 'secretValue'",
+				}, inputExecutorResp.SecretFiles)
+			})
+			t.Run("should give error when policy is invalid", func(t *testing.T) {
+				tenantService := new(mockTenantService)
+				tenantService.On("GetDetails", ctx, tnnt).Return(tenantDetailsWithPolicy("mask"), nil)
+				defer tenantService.AssertExpectations(t)
+
+				assetCompiler := new(mockAssetCompiler)
+				assetCompiler.On("CompileJobRunAssets", ctx, &job, mock.Anything, mock.Anything, mock.Anything).Return(compiledFiles, nil)
+				defer assetCompiler.AssertExpectations(t)
+
+				inputCompiler := service.NewJobInputCompiler(tenantService, compiler.NewEngine(), assetCompiler, nil, nil, "optimus.example.io:80", logger)
+				inputExecutorResp, err := inputCompiler.Compile(ctx, &details, runConfig, executedAt)
+
+				assert.Nil(t, inputExecutorResp)
+				assert.EqualError(t, err, "invalid argument for entity jobRun: invalid asset secret policy mask")
+			})
+		})
 		t.Run("compileConfigs for Executor type Hook", func(t *testing.T) {
 			w1, _ := models.NewWindow(2, "d", "1h", "24h")
 			window1 := window.NewCustomConfig(w1)
@@ -382,6 +825,7 @@ func TestExecutorCompiler(t *testing.T) {
 				"DEND":            interval.End.Format(time.RFC3339),
 				"EXECUTION_TIME":  executedAt.Format(time.RFC3339),
 				"JOB_DESTINATION": job.Destination,
+				"JOB_TIMEZONE":    "UTC",
 			}
 			taskContext := mock.Anything
 
@@ -403,7 +847,14 @@ func TestExecutorCompiler(t *testing.T) {
 				Return(map[string]string{"secret.hook.compiled": "hook.s.val.compiled"}, nil)
 			defer templateCompiler.AssertExpectations(t)
 
-			inputCompiler := service.NewJobInputCompiler(tenantService, templateCompiler, assetCompiler, logger)
+			yamlMod := new(smock.YamlMod)
+			yamlMod.On("PluginInfo").Return(&plugin.Info{Name: "predator", HookType: plugin.HookTypePost})
+			defer yamlMod.AssertExpectations(t)
+			pluginRepo := new(mockPluginRepo)
+			pluginRepo.On("GetByName", "predator").Return(&plugin.Plugin{YamlMod: yamlMod}, nil)
+			defer pluginRepo.AssertExpectations(t)
+
+			inputCompiler := service.NewJobInputCompiler(tenantService, templateCompiler, assetCompiler, nil, pluginRepo, "optimus.example.io:80", logger)
 			inputExecutorResp, err := inputCompiler.Compile(ctx, &details, config, executedAt)
 
 			assert.Nil(t, err)
@@ -413,6 +864,14 @@ func TestExecutorCompiler(t *testing.T) {
 					"DEND":            interval.End.Format(time.RFC3339),
 					"EXECUTION_TIME":  executedAt.Format(time.RFC3339),
 					"JOB_DESTINATION": job.Destination,
+					"JOB_TIMEZONE":    "UTC",
+					"JOB_RUN_TYPE":    "scheduled",
+					"OPTIMUS_HOST":    "optimus.example.io:80",
+					"HOOK_NAME":       "predator",
+					"HOOK_TYPE":       "post",
+					"HOOK_INDEX":      "0",
+					"HOOK_ORDER":      "0",
+					"HOOK_DEPENDS_ON": "",
 					"hook.compiled":   "hook.val.compiled",
 				},
 				Secrets: map[string]string{"secret.hook.compiled": "hook.s.val.compiled"},
@@ -420,6 +879,204 @@ func TestExecutorCompiler(t *testing.T) {
 			}
 			assert.Equal(t, expectedInputExecutor, inputExecutorResp)
 		})
+		t.Run("compileConfigs for Executor type Hook skips the hook when its condition does not hold", func(t *testing.T) {
+			w1, _ := models.NewWindow(2, "d", "1h", "24h")
+			job := scheduler.Job{
+				Name:        "job1",
+				Tenant:      tnnt,
+				Destination: "some_destination_table_name",
+				Task:        &scheduler.Task{Name: "bq2bq", Config: map[string]string{"some.config": "val"}},
+				Hooks: []*scheduler.Hook{
+					{
+						Name:   "predator",
+						Config: map[string]string{"hook_some_config": "{{ .unknown.config }}"},
+						When:   `{{ eq .JOB_DESTINATION "another_table_name" }}`,
+					},
+				},
+				WindowConfig: window.NewCustomConfig(w1),
+			}
+			details := scheduler.JobWithDetails{Job: &job, Schedule: &scheduler.Schedule{Interval: "0 * * * *"}}
+			config := scheduler.RunConfig{
+				Executor:    scheduler.Executor{Name: "predator", Type: scheduler.ExecutorHook},
+				ScheduledAt: currentTime.Add(-time.Hour),
+			}
+
+			tenantService := new(mockTenantService)
+			tenantService.On("GetDetails", ctx, tnnt).Return(tenantDetails, nil)
+			defer tenantService.AssertExpectations(t)
+
+			assetCompiler := new(mockAssetCompiler)
+			assetCompiler.On("CompileJobRunAssets", ctx, &job, mock.Anything, mock.Anything, mock.Anything).Return(map[string]string{}, nil)
+			defer assetCompiler.AssertExpectations(t)
+
+			yamlMod := new(smock.YamlMod)
+			yamlMod.On("PluginInfo").Return(&plugin.Info{Name: "predator", HookType: plugin.HookTypePost})
+			defer yamlMod.AssertExpectations(t)
+			pluginRepo := new(mockPluginRepo)
+			pluginRepo.On("GetByName", "predator").Return(&plugin.Plugin{YamlMod: yamlMod}, nil)
+			defer pluginRepo.AssertExpectations(t)
+
+			inputCompiler := service.NewJobInputCompiler(tenantService, compiler.NewEngine(), assetCompiler, nil, pluginRepo, "optimus.example.io:80", logger)
+			inputExecutorResp, err := inputCompiler.Compile(ctx, &details, config, currentTime)
+
+			assert.NoError(t, err)
+			assert.True(t, inputExecutorResp.Skipped)
+			assert.Equal(t, "true", inputExecutorResp.Configs["HOOK_SKIPPED"])
+			assert.Equal(t, "predator", inputExecutorResp.Configs["HOOK_NAME"])
+			assert.NotContains(t, inputExecutorResp.Configs, "hook_some_config")
+		})
+		t.Run("compileConfigs for Executor type HookFailure returns error when the hook is not a failure hook", func(t *testing.T) {
+			w1, _ := models.NewWindow(2, "d", "1h", "24h")
+			job := scheduler.Job{
+				Name:         "job1",
+				Tenant:       tnnt,
+				Task:         &scheduler.Task{Name: "bq2bq", Config: map[string]string{"some.config": "val"}},
+				Hooks:        []*scheduler.Hook{{Name: "predator", Config: map[string]string{}}},
+				WindowConfig: window.NewCustomConfig(w1),
+				Destination:  "some_destination_table_name",
+			}
+			details := scheduler.JobWithDetails{Job: &job, Schedule: &scheduler.Schedule{Interval: "0 * * * *"}}
+			config := scheduler.RunConfig{
+				Executor:    scheduler.Executor{Name: "predator", Type: scheduler.ExecutorHookFailure},
+				ScheduledAt: currentTime.Add(-time.Hour),
+			}
+
+			tenantService := new(mockTenantService)
+			tenantService.On("GetDetails", ctx, tnnt).Return(tenantDetails, nil)
+			defer tenantService.AssertExpectations(t)
+
+			assetCompiler := new(mockAssetCompiler)
+			assetCompiler.On("CompileJobRunAssets", ctx, &job, mock.Anything, mock.Anything, mock.Anything).Return(map[string]string{}, nil)
+			defer assetCompiler.AssertExpectations(t)
+
+			yamlMod := new(smock.YamlMod)
+			yamlMod.On("PluginInfo").Return(&plugin.Info{Name: "predator", HookType: plugin.HookTypePost})
+			defer yamlMod.AssertExpectations(t)
+			pluginRepo := new(mockPluginRepo)
+			pluginRepo.On("GetByName", "predator").Return(&plugin.Plugin{YamlMod: yamlMod}, nil)
+			defer pluginRepo.AssertExpectations(t)
+
+			inputCompiler := service.NewJobInputCompiler(tenantService, compiler.NewEngine(), assetCompiler, nil, pluginRepo, "optimus.example.io:80", logger)
+			inputExecutorResp, err := inputCompiler.Compile(ctx, &details, config, currentTime)
+
+			assert.Nil(t, inputExecutorResp)
+			assert.ErrorContains(t, err, "hook predator of job job1 is not a failure hook, it is of type post")
+		})
+		t.Run("compileConfigs for Executor type Hook returns error when its condition is not a boolean", func(t *testing.T) {
+			w1, _ := models.NewWindow(2, "d", "1h", "24h")
+			job := scheduler.Job{
+				Name:         "job1",
+				Tenant:       tnnt,
+				Task:         &scheduler.Task{Name: "bq2bq", Config: map[string]string{"some.config": "val"}},
+				Hooks:        []*scheduler.Hook{{Name: "predator", When: "{{ .JOB_DESTINATION }}"}},
+				WindowConfig: window.NewCustomConfig(w1),
+				Destination:  "some_destination_table_name",
+			}
+			details := scheduler.JobWithDetails{Job: &job, Schedule: &scheduler.Schedule{Interval: "0 * * * *"}}
+			config := scheduler.RunConfig{
+				Executor:    scheduler.Executor{Name: "predator", Type: scheduler.ExecutorHook},
+				ScheduledAt: currentTime.Add(-time.Hour),
+			}
+
+			tenantService := new(mockTenantService)
+			tenantService.On("GetDetails", ctx, tnnt).Return(tenantDetails, nil)
+			defer tenantService.AssertExpectations(t)
+
+			assetCompiler := new(mockAssetCompiler)
+			assetCompiler.On("CompileJobRunAssets", ctx, &job, mock.Anything, mock.Anything, mock.Anything).Return(map[string]string{}, nil)
+			defer assetCompiler.AssertExpectations(t)
+
+			inputCompiler := service.NewJobInputCompiler(tenantService, compiler.NewEngine(), assetCompiler, nil, nil, "optimus.example.io:80", logger)
+			inputExecutorResp, err := inputCompiler.Compile(ctx, &details, config, currentTime)
+
+			assert.Nil(t, inputExecutorResp)
+			assert.ErrorContains(t, err, "when of hook predator of job job1 should compile to true or false")
+		})
+		t.Run("compileConfigs for Executor type Hook should fail if error in getting hook plugin", func(t *testing.T) {
+			w1, _ := models.NewWindow(2, "d", "1h", "24h")
+			window1 := window.NewCustomConfig(w1)
+			job := scheduler.Job{
+				Name:        "job1",
+				Tenant:      tnnt,
+				Destination: "some_destination_table_name",
+				Task: &scheduler.Task{
+					Name: "bq2bq",
+					Config: map[string]string{
+						"secret.config": "a.secret.val",
+						"some.config":   "val",
+					},
+				},
+				Hooks: []*scheduler.Hook{
+					{
+						Name: "predator",
+						Config: map[string]string{
+							"hook_secret":      "a.secret.val",
+							"hook_some_config": "val",
+						},
+					},
+				},
+				WindowConfig: window1,
+				Assets:       nil,
+			}
+			details := scheduler.JobWithDetails{
+				Job: &job,
+				Schedule: &scheduler.Schedule{
+					Interval: "0 * * * *",
+				},
+			}
+			config := scheduler.RunConfig{
+				Executor: scheduler.Executor{
+					Name: "predator",
+					Type: scheduler.ExecutorHook,
+				},
+				ScheduledAt: currentTime.Add(-time.Hour),
+				JobRunID:    scheduler.JobRunID{},
+			}
+
+			tenantService := new(mockTenantService)
+			tenantService.On("GetDetails", ctx, tnnt).Return(tenantDetails, nil)
+			defer tenantService.AssertExpectations(t)
+
+			interval, err := window.FromBaseWindow(w1).GetInterval(config.ScheduledAt)
+			assert.NoError(t, err)
+			executedAt := currentTime.Add(time.Hour)
+			systemDefinedVars := map[string]string{
+				"DSTART":          interval.Start.Format(time.RFC3339),
+				"DEND":            interval.End.Format(time.RFC3339),
+				"EXECUTION_TIME":  executedAt.Format(time.RFC3339),
+				"JOB_DESTINATION": job.Destination,
+				"JOB_TIMEZONE":    "UTC",
+			}
+			taskContext := mock.Anything
+
+			compiledFile := map[string]string{
+				"someFileName": "fileContents",
+			}
+			assetCompiler := new(mockAssetCompiler)
+			assetCompiler.On("CompileJobRunAssets", ctx, &job, systemDefinedVars, interval, taskContext).Return(compiledFile, nil)
+			defer assetCompiler.AssertExpectations(t)
+
+			templateCompiler := new(mockTemplateCompiler)
+			templateCompiler.On("Compile", map[string]string{"some.config": "val"}, taskContext).
+				Return(map[string]string{"some.config.compiled": "val.compiled"}, nil)
+			templateCompiler.On("Compile", map[string]string{"secret.config": "a.secret.val"}, taskContext).
+				Return(map[string]string{"secret.config.compiled": "a.secret.val.compiled"}, nil)
+			templateCompiler.On("Compile", map[string]string{"hook_some_config": "val"}, taskContext).
+				Return(map[string]string{"hook.compiled": "hook.val.compiled"}, nil)
+			templateCompiler.On("Compile", map[string]string{"hook_secret": "a.secret.val"}, taskContext).
+				Return(map[string]string{"secret.hook.compiled": "hook.s.val.compiled"}, nil)
+			defer templateCompiler.AssertExpectations(t)
+
+			pluginRepo := new(mockPluginRepo)
+			pluginRepo.On("GetByName", "predator").Return(nil, fmt.Errorf("plugin not found"))
+			defer pluginRepo.AssertExpectations(t)
+
+			inputCompiler := service.NewJobInputCompiler(tenantService, templateCompiler, assetCompiler, nil, pluginRepo, "optimus.example.io:80", logger)
+			inputExecutorResp, err := inputCompiler.Compile(ctx, &details, config, executedAt)
+
+			assert.Nil(t, inputExecutorResp)
+			assert.EqualError(t, err, "plugin not found")
+		})
 		t.Run("compileConfigs for Executor type Hook should fail if error in hook compilation", func(t *testing.T) {
 			w1, _ := models.NewWindow(2, "d", "1h", "24h")
 			window1 := window.NewCustomConfig(w1)
@@ -473,6 +1130,7 @@ func TestExecutorCompiler(t *testing.T) {
 				"DEND":            interval.End.Format(time.RFC3339),
 				"EXECUTION_TIME":  executedAt.Format(time.RFC3339),
 				"JOB_DESTINATION": job.Destination,
+				"JOB_TIMEZONE":    "UTC",
 			}
 			taskContext := mock.Anything
 
@@ -493,7 +1151,7 @@ func TestExecutorCompiler(t *testing.T) {
 
 			defer templateCompiler.AssertExpectations(t)
 
-			inputCompiler := service.NewJobInputCompiler(tenantService, templateCompiler, assetCompiler, logger)
+			inputCompiler := service.NewJobInputCompiler(tenantService, templateCompiler, assetCompiler, nil, nil, "optimus.example.io:80", logger)
 			inputExecutorResp, err := inputCompiler.Compile(ctx, &details, config, executedAt)
 
 			assert.NotNil(t, err)
@@ -545,6 +1203,7 @@ func TestExecutorCompiler(t *testing.T) {
 				"DEND":            interval.End.Format(time.RFC3339),
 				"EXECUTION_TIME":  executedAt.Format(time.RFC3339),
 				"JOB_DESTINATION": job.Destination,
+				"JOB_TIMEZONE":    "UTC",
 			}
 			taskContext := mock.Anything
 
@@ -562,7 +1221,7 @@ func TestExecutorCompiler(t *testing.T) {
 				Return(map[string]string{"secret.config.compiled": "a.secret.val.compiled"}, nil)
 			defer templateCompiler.AssertExpectations(t)
 
-			inputCompiler := service.NewJobInputCompiler(tenantService, templateCompiler, assetCompiler, logger)
+			inputCompiler := service.NewJobInputCompiler(tenantService, templateCompiler, assetCompiler, nil, nil, "optimus.example.io:80", logger)
 			inputExecutorResp, err := inputCompiler.Compile(ctx, &details, config, executedAt)
 
 			assert.NotNil(t, err)
@@ -570,6 +1229,50 @@ func TestExecutorCompiler(t *testing.T) {
 			assert.ErrorContains(t, err, "hook:predator")
 		})
 	})
+	t.Run("EnabledHooks", func(t *testing.T) {
+		productionNamespace, _ := tenant.NewNamespace("ns1", project.Name(), map[string]string{"ENVIRONMENT": "production"})
+		productionDetails, _ := tenant.NewTenantDetails(project, productionNamespace, secretsArray)
+
+		t.Run("should return all hooks without getting tenant details when hooks have no condition", func(t *testing.T) {
+			hooks := []*scheduler.Hook{{Name: "predator"}, {Name: "transporter"}}
+			job := scheduler.Job{Name: "job1", Tenant: tnnt, Hooks: hooks}
+
+			inputCompiler := service.NewJobInputCompiler(nil, compiler.NewEngine(), nil, nil, nil, "optimus.example.io:80", logger)
+			enabledHooks, err := inputCompiler.EnabledHooks(ctx, &job)
+
+			assert.NoError(t, err)
+			assert.Equal(t, hooks, enabledHooks)
+		})
+		t.Run("should leave out hooks whose condition compiles to false", func(t *testing.T) {
+			auditHook := &scheduler.Hook{Name: "audit", EnabledWhen: `{{ eq .proj.ENVIRONMENT "production" }}`}
+			debugHook := &scheduler.Hook{Name: "debug", EnabledWhen: `{{ ne .GLOBAL__ENVIRONMENT "production" }}`}
+			predatorHook := &scheduler.Hook{Name: "predator"}
+			job := scheduler.Job{Name: "job1", Tenant: tnnt, Hooks: []*scheduler.Hook{auditHook, debugHook, predatorHook}}
+
+			tenantService := new(mockTenantService)
+			tenantService.On("GetDetails", ctx, tnnt).Return(productionDetails, nil).Once()
+			defer tenantService.AssertExpectations(t)
+
+			inputCompiler := service.NewJobInputCompiler(tenantService, compiler.NewEngine(), nil, nil, nil, "optimus.example.io:80", logger)
+			enabledHooks, err := inputCompiler.EnabledHooks(ctx, &job)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []*scheduler.Hook{auditHook, predatorHook}, enabledHooks)
+		})
+		t.Run("should give error if condition does not compile to a boolean", func(t *testing.T) {
+			job := scheduler.Job{Name: "job1", Tenant: tnnt, Hooks: []*scheduler.Hook{{Name: "audit", EnabledWhen: "{{ .proj.ENVIRONMENT }}"}}}
+
+			tenantService := new(mockTenantService)
+			tenantService.On("GetDetails", ctx, tnnt).Return(productionDetails, nil)
+			defer tenantService.AssertExpectations(t)
+
+			inputCompiler := service.NewJobInputCompiler(tenantService, compiler.NewEngine(), nil, nil, nil, "optimus.example.io:80", logger)
+			enabledHooks, err := inputCompiler.EnabledHooks(ctx, &job)
+
+			assert.Nil(t, enabledHooks)
+			assert.EqualError(t, err, "invalid argument for entity jobRun: enabled_when of hook audit of job job1 should compile to true or false, got \"production\"")
+		})
+	})
 }
 
 type mockTenantService struct {
@@ -615,3 +1318,23 @@ func (m *mockTemplateCompiler) Compile(templateMap map[string]string, context ma
 	}
 	return args.Get(0).(map[string]string), args.Error(1)
 }
+
+func (m *mockTemplateCompiler) CompileMacros(macros map[string]string, context map[string]any) (map[string]string, error) {
+	args := m.Called(macros, context)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]string), args.Error(1)
+}
+
+type mockUpstreamRunGetter struct {
+	mock.Mock
+}
+
+func (m *mockUpstreamRunGetter) GetLatestSuccessRun(ctx context.Context, tnnt tenant.Tenant, jobName scheduler.JobName, scheduledAt time.Time) (*scheduler.JobRun, error) {
+	args := m.Called(ctx, tnnt, jobName, scheduledAt)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*scheduler.JobRun), args.Error(1)
+}
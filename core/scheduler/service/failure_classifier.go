@@ -0,0 +1,41 @@
+package service
+
+import (
+	"github.com/goto/optimus/config"
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/internal/errors"
+)
+
+// FailureRuleClassifier tags the run failures using the configured rules, the rules of the failed plugin
+// take precedence over the ones applying to every plugin
+type FailureRuleClassifier struct {
+	rules []*scheduler.FailureRule
+}
+
+func (c *FailureRuleClassifier) Classify(event *scheduler.Event) scheduler.FailureCategory {
+	for _, pluginRules := range []bool{true, false} {
+		for _, rule := range c.rules {
+			if (rule.Plugin != "") == pluginRules && rule.Matches(event) {
+				return rule.Category
+			}
+		}
+	}
+	return scheduler.FailureCategoryUnknown
+}
+
+func NewFailureRuleClassifier(rulesConfig []config.FailureRuleConfig) (*FailureRuleClassifier, error) {
+	me := errors.NewMultiError("failure rules errors")
+	rules := make([]*scheduler.FailureRule, 0, len(rulesConfig))
+	for _, ruleConfig := range rulesConfig {
+		rule, err := scheduler.NewFailureRule(ruleConfig.Plugin, ruleConfig.Category, ruleConfig.Pattern, ruleConfig.Codes)
+		if err != nil {
+			me.Append(err)
+			continue
+		}
+		rules = append(rules, rule)
+	}
+	if err := me.ToErr(); err != nil {
+		return nil, err
+	}
+	return &FailureRuleClassifier{rules: rules}, nil
+}
@@ -0,0 +1,60 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/config"
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/scheduler/service"
+)
+
+func TestFailureRuleClassifier(t *testing.T) {
+	rules := []config.FailureRuleConfig{
+		{Category: "infra", Pattern: "(?i)connection (reset|refused)"},
+		{Category: "quota", Codes: []string{"429"}},
+		{Plugin: "bq2bq", Category: "data", Pattern: "Not found: Table"},
+		{Plugin: "bq2bq", Category: "code", Pattern: "Syntax error"},
+	}
+
+	t.Run("returns error when a rule is invalid", func(t *testing.T) {
+		classifier, err := service.NewFailureRuleClassifier([]config.FailureRuleConfig{{Category: "infra"}, {Category: "other", Pattern: "x"}})
+		assert.Nil(t, classifier)
+		assert.ErrorContains(t, err, "either pattern or codes is required")
+		assert.ErrorContains(t, err, "unknown failure category other")
+	})
+	t.Run("prefers the rules of the failed plugin", func(t *testing.T) {
+		classifier, err := service.NewFailureRuleClassifier(rules)
+		assert.NoError(t, err)
+
+		event := &scheduler.Event{
+			Type:         scheduler.JobFailureEvent,
+			OperatorName: "bq2bq",
+			Values:       map[string]any{"message": "Not found: Table sample.table, connection reset"},
+		}
+		assert.Equal(t, scheduler.FailureCategoryData, classifier.Classify(event))
+	})
+	t.Run("falls back to the rules of every plugin", func(t *testing.T) {
+		classifier, err := service.NewFailureRuleClassifier(rules)
+		assert.NoError(t, err)
+
+		event := &scheduler.Event{
+			Type:         scheduler.TaskFailEvent,
+			OperatorName: "transporter",
+			Values:       map[string]any{"error_code": "429"},
+		}
+		assert.Equal(t, scheduler.FailureCategoryQuota, classifier.Classify(event))
+	})
+	t.Run("returns unknown when no rule matches", func(t *testing.T) {
+		classifier, err := service.NewFailureRuleClassifier(rules)
+		assert.NoError(t, err)
+
+		event := &scheduler.Event{
+			Type:         scheduler.JobFailureEvent,
+			OperatorName: "bq2bq",
+			Values:       map[string]any{"message": "something went wrong"},
+		}
+		assert.Equal(t, scheduler.FailureCategoryUnknown, classifier.Classify(event))
+	})
+}
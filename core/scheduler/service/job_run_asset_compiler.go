@@ -6,6 +6,7 @@ import (
 	"github.com/goto/salt/log"
 
 	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/tenant"
 	"github.com/goto/optimus/internal/lib/window"
 	"github.com/goto/optimus/sdk/plugin"
 )
@@ -15,30 +16,37 @@ const (
 )
 
 type FilesCompiler interface {
-	Compile(fileMap map[string]string, context map[string]any) (map[string]string, error)
+	CompileWithSnippets(fileMap, snippets map[string]string, context map[string]any) (map[string]string, error)
+}
+
+type SnippetGetter interface {
+	GetSnippets(ctx context.Context, projectName tenant.ProjectName) (map[string]string, error)
 }
 
 type PluginRepo interface {
 	GetByName(name string) (*plugin.Plugin, error)
+	GetByNameAndVersion(name, version string) (*plugin.Plugin, error)
 }
 
 type JobRunAssetsCompiler struct {
-	compiler   FilesCompiler
-	pluginRepo PluginRepo
+	compiler      FilesCompiler
+	pluginRepo    PluginRepo
+	snippetGetter SnippetGetter
 
 	logger log.Logger
 }
 
-func NewJobAssetsCompiler(engine FilesCompiler, pluginRepo PluginRepo, logger log.Logger) *JobRunAssetsCompiler {
+func NewJobAssetsCompiler(engine FilesCompiler, pluginRepo PluginRepo, snippetGetter SnippetGetter, logger log.Logger) *JobRunAssetsCompiler {
 	return &JobRunAssetsCompiler{
-		compiler:   engine,
-		pluginRepo: pluginRepo,
-		logger:     logger,
+		compiler:      engine,
+		pluginRepo:    pluginRepo,
+		snippetGetter: snippetGetter,
+		logger:        logger,
 	}
 }
 
 func (c *JobRunAssetsCompiler) CompileJobRunAssets(ctx context.Context, job *scheduler.Job, systemEnvVars map[string]string, interval window.Interval, contextForTask map[string]interface{}) (map[string]string, error) {
-	taskPlugin, err := c.pluginRepo.GetByName(job.Task.Name)
+	taskPlugin, err := c.pluginRepo.GetByNameAndVersion(job.Task.Name, job.Task.Version)
 	if err != nil {
 		c.logger.Error("error getting plugin [%s]: %s", job.Task.Name, err)
 		return nil, err
@@ -62,7 +70,13 @@ func (c *JobRunAssetsCompiler) CompileJobRunAssets(ctx context.Context, job *sch
 		inputFiles = compiledAssetResponse.Assets.ToMap()
 	}
 
-	fileMap, err := c.compiler.Compile(inputFiles, contextForTask)
+	snippets, err := c.snippetGetter.GetSnippets(ctx, job.Tenant.ProjectName())
+	if err != nil {
+		c.logger.Error("error getting snippets of project [%s]: %s", job.Tenant.ProjectName().String(), err)
+		return nil, err
+	}
+
+	fileMap, err := c.compiler.CompileWithSnippets(inputFiles, snippets, contextForTask)
 	if err != nil {
 		c.logger.Error("error compiling assets: %s", err)
 		return nil, err
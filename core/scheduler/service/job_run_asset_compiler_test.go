@@ -65,12 +65,12 @@ func TestJobAssetsCompiler(t *testing.T) {
 	t.Run("CompileJobRunAssets", func(t *testing.T) {
 		t.Run("should error if plugin repo get plugin by name fails", func(t *testing.T) {
 			pluginRepo := new(mockPluginRepo)
-			pluginRepo.On("GetByName", taskName).Return(nil, fmt.Errorf("error in getting plugin by name"))
+			pluginRepo.On("GetByNameAndVersion", taskName, "").Return(nil, fmt.Errorf("error in getting plugin by name"))
 			defer pluginRepo.AssertExpectations(t)
 
 			contextForTask := map[string]any{}
 
-			jobRunAssetsCompiler := service.NewJobAssetsCompiler(nil, pluginRepo, logger)
+			jobRunAssetsCompiler := service.NewJobAssetsCompiler(nil, pluginRepo, nil, logger)
 			assets, err := jobRunAssetsCompiler.CompileJobRunAssets(ctx, job, systemEnvVars, interval, contextForTask)
 			assert.NotNil(t, err)
 			assert.EqualError(t, err, "error in getting plugin by name")
@@ -83,12 +83,12 @@ func TestJobAssetsCompiler(t *testing.T) {
 			dependencyResolverMod := new(smock.DependencyResolverMod)
 			dependencyResolverMod.On("CompileAssets", ctx, mock.Anything).Return(nil, fmt.Errorf("error in dependencyMod compile assets"))
 			pluginRepo := new(mockPluginRepo)
-			pluginRepo.On("GetByName", taskName).Return(&plugin.Plugin{
+			pluginRepo.On("GetByNameAndVersion", taskName, "").Return(&plugin.Plugin{
 				DependencyMod: dependencyResolverMod,
 				YamlMod:       yamlMod,
 			}, nil)
 			defer pluginRepo.AssertExpectations(t)
-			jobRunAssetsCompiler := service.NewJobAssetsCompiler(nil, pluginRepo, logger)
+			jobRunAssetsCompiler := service.NewJobAssetsCompiler(nil, pluginRepo, nil, logger)
 
 			contextForTask := map[string]any{}
 			assets, err := jobRunAssetsCompiler.CompileJobRunAssets(ctx, job, systemEnvVars, interval, contextForTask)
@@ -113,7 +113,7 @@ func TestJobAssetsCompiler(t *testing.T) {
 			defer dependencyResolverMod.AssertExpectations(t)
 
 			pluginRepo := new(mockPluginRepo)
-			pluginRepo.On("GetByName", taskName).Return(&plugin.Plugin{
+			pluginRepo.On("GetByNameAndVersion", taskName, "").Return(&plugin.Plugin{
 				DependencyMod: dependencyResolverMod,
 				YamlMod:       yamlMod,
 			}, nil)
@@ -121,13 +121,30 @@ func TestJobAssetsCompiler(t *testing.T) {
 
 			contextForTask := map[string]any{}
 
+			snippets := map[string]string{"dedup_by_key": "select 1"}
+
+			t.Run("return error if getting snippets fails", func(t *testing.T) {
+				snippetGetter := new(mockSnippetGetter)
+				snippetGetter.On("GetSnippets", ctx, project.Name()).Return(nil, fmt.Errorf("error in getting snippets"))
+				defer snippetGetter.AssertExpectations(t)
+
+				jobRunAssetsCompiler := service.NewJobAssetsCompiler(nil, pluginRepo, snippetGetter, logger)
+				assets, err := jobRunAssetsCompiler.CompileJobRunAssets(ctx, job, systemEnvVars, interval, contextForTask)
+
+				assert.EqualError(t, err, "error in getting snippets")
+				assert.Nil(t, assets)
+			})
 			t.Run("return error if compiler.compile fails", func(t *testing.T) {
+				snippetGetter := new(mockSnippetGetter)
+				snippetGetter.On("GetSnippets", ctx, project.Name()).Return(snippets, nil)
+				defer snippetGetter.AssertExpectations(t)
+
 				filesCompiler := new(mockFilesCompiler)
-				filesCompiler.On("Compile", map[string]string{"assetName": "assetValue"}, contextForTask).
+				filesCompiler.On("CompileWithSnippets", map[string]string{"assetName": "assetValue"}, snippets, contextForTask).
 					Return(nil, fmt.Errorf("error in compiling"))
 				defer filesCompiler.AssertExpectations(t)
 
-				jobRunAssetsCompiler := service.NewJobAssetsCompiler(filesCompiler, pluginRepo, logger)
+				jobRunAssetsCompiler := service.NewJobAssetsCompiler(filesCompiler, pluginRepo, snippetGetter, logger)
 				assets, err := jobRunAssetsCompiler.CompileJobRunAssets(ctx, job, systemEnvVars, interval, contextForTask)
 
 				assert.NotNil(t, err)
@@ -139,12 +156,16 @@ func TestJobAssetsCompiler(t *testing.T) {
 					"filename": "fileContent",
 				}
 
+				snippetGetter := new(mockSnippetGetter)
+				snippetGetter.On("GetSnippets", ctx, project.Name()).Return(snippets, nil)
+				defer snippetGetter.AssertExpectations(t)
+
 				filesCompiler := new(mockFilesCompiler)
-				filesCompiler.On("Compile", map[string]string{"assetName": "assetValue"}, contextForTask).
+				filesCompiler.On("CompileWithSnippets", map[string]string{"assetName": "assetValue"}, snippets, contextForTask).
 					Return(expectedFileMap, nil)
 				defer filesCompiler.AssertExpectations(t)
 
-				jobRunAssetsCompiler := service.NewJobAssetsCompiler(filesCompiler, pluginRepo, logger)
+				jobRunAssetsCompiler := service.NewJobAssetsCompiler(filesCompiler, pluginRepo, snippetGetter, logger)
 				assets, err := jobRunAssetsCompiler.CompileJobRunAssets(ctx, job, systemEnvVars, interval, contextForTask)
 
 				assert.Nil(t, err)
@@ -166,12 +187,32 @@ func (m *mockPluginRepo) GetByName(name string) (*plugin.Plugin, error) {
 	return args.Get(0).(*plugin.Plugin), args.Error(1)
 }
 
+func (m *mockPluginRepo) GetByNameAndVersion(name, version string) (*plugin.Plugin, error) {
+	args := m.Called(name, version)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*plugin.Plugin), args.Error(1)
+}
+
 type mockFilesCompiler struct {
 	mock.Mock
 }
 
-func (m *mockFilesCompiler) Compile(fileMap map[string]string, context map[string]any) (map[string]string, error) {
-	args := m.Called(fileMap, context)
+func (m *mockFilesCompiler) CompileWithSnippets(fileMap, snippets map[string]string, context map[string]any) (map[string]string, error) {
+	args := m.Called(fileMap, snippets, context)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]string), args.Error(1)
+}
+
+type mockSnippetGetter struct {
+	mock.Mock
+}
+
+func (m *mockSnippetGetter) GetSnippets(ctx context.Context, projectName tenant.ProjectName) (map[string]string, error) {
+	args := m.Called(ctx, projectName)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
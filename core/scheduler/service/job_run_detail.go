@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/tenant"
+)
+
+// GetJobRunDetail returns the run of the job at scheduledAt along with the runs of its sensors, task and hooks,
+// as recorded from the operator events sent by the scheduler
+func (s *JobRunService) GetJobRunDetail(ctx context.Context, projectName tenant.ProjectName, jobName scheduler.JobName, scheduledAt time.Time) (*scheduler.JobRunDetail, error) {
+	details, err := s.jobRepo.GetJobDetails(ctx, projectName, jobName)
+	if err != nil {
+		s.l.Error("error getting job [%s]: %s", jobName, err)
+		return nil, err
+	}
+
+	jobRun, err := s.repo.GetByScheduledAt(ctx, details.Job.Tenant, jobName, scheduledAt)
+	if err != nil {
+		s.l.Error("error getting job run [%s] scheduled at [%s]: %s", jobName, scheduledAt.String(), err)
+		return nil, err
+	}
+
+	operatorRuns, err := s.operatorRunRepo.GetOperatorRuns(ctx, jobRun.ID)
+	if err != nil {
+		s.l.Error("error getting operator runs of job run [%s]: %s", jobRun.ID.String(), err)
+		return nil, err
+	}
+
+	return &scheduler.JobRunDetail{
+		JobRun:    jobRun,
+		Operators: operatorRuns,
+	}, nil
+}
@@ -0,0 +1,79 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/scheduler/service"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+)
+
+func TestJobRunServiceGetJobRunDetail(t *testing.T) {
+	ctx := context.Background()
+	logger := log.NewNoop()
+	projName := tenant.ProjectName("proj")
+	jobName := scheduler.JobName("sample_select")
+	tnnt, _ := tenant.NewTenant(projName.String(), "ns1")
+	scheduledAt := time.Date(2023, 10, 10, 10, 0, 0, 0, time.UTC)
+
+	jobWithDetails := &scheduler.JobWithDetails{
+		Name: jobName,
+		Job: &scheduler.Job{
+			Name:   jobName,
+			Tenant: tnnt,
+		},
+	}
+	jobRun := &scheduler.JobRun{
+		ID:          uuid.New(),
+		JobName:     jobName,
+		Tenant:      tnnt,
+		State:       scheduler.StateRunning,
+		ScheduledAt: scheduledAt,
+	}
+
+	t.Run("returns error when the job run is not found", func(t *testing.T) {
+		jobRepo := new(JobRepository)
+		defer jobRepo.AssertExpectations(t)
+		jobRunRepo := new(mockJobRunRepository)
+		defer jobRunRepo.AssertExpectations(t)
+
+		jobRepo.On("GetJobDetails", ctx, projName, jobName).Return(jobWithDetails, nil)
+		jobRunRepo.On("GetByScheduledAt", ctx, tnnt, jobName, scheduledAt).Return(nil, errors.NotFound(scheduler.EntityJobRun, "no record"))
+
+		runService := service.NewJobRunService(logger, jobRepo, jobRunRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		detail, err := runService.GetJobRunDetail(ctx, projName, jobName, scheduledAt)
+
+		assert.Nil(t, detail)
+		assert.True(t, errors.IsErrorType(err, errors.ErrNotFound))
+	})
+	t.Run("returns the job run with the runs of its operators", func(t *testing.T) {
+		jobRepo := new(JobRepository)
+		defer jobRepo.AssertExpectations(t)
+		jobRunRepo := new(mockJobRunRepository)
+		defer jobRunRepo.AssertExpectations(t)
+		operatorRunRepo := new(mockOperatorRunRepository)
+		defer operatorRunRepo.AssertExpectations(t)
+
+		operatorRuns := []*scheduler.OperatorRun{
+			{Name: "wait-upstream", OperatorType: scheduler.OperatorSensor, JobRunID: jobRun.ID, Status: scheduler.StateSuccess},
+			{Name: "sample_select", OperatorType: scheduler.OperatorTask, JobRunID: jobRun.ID, Status: scheduler.StateRunning},
+		}
+		jobRepo.On("GetJobDetails", ctx, projName, jobName).Return(jobWithDetails, nil)
+		jobRunRepo.On("GetByScheduledAt", ctx, tnnt, jobName, scheduledAt).Return(jobRun, nil)
+		operatorRunRepo.On("GetOperatorRuns", ctx, jobRun.ID).Return(operatorRuns, nil)
+
+		runService := service.NewJobRunService(logger, jobRepo, jobRunRepo, nil, operatorRunRepo, nil, nil, nil, nil, nil, nil, nil)
+		detail, err := runService.GetJobRunDetail(ctx, projName, jobName, scheduledAt)
+
+		assert.NoError(t, err)
+		assert.Equal(t, jobRun, detail.JobRun)
+		assert.Equal(t, operatorRuns, detail.Operators)
+	})
+}
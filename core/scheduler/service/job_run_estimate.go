@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+	"github.com/goto/optimus/internal/lib/cron"
+)
+
+const (
+	// historicalRunsForEstimate is the number of previous runs used to calculate average wait and run duration
+	historicalRunsForEstimate = 10
+
+	// schedulerPoolKey is the key of the scheduler config of the job naming the pool its tasks run in
+	schedulerPoolKey = "pool"
+)
+
+// EstimateRunStart estimates when the run of the job at scheduledAt will start, based on runs
+// queued ahead of it, the free slots of its scheduler pool, its sensor state and historical wait times of the job
+func (s *JobRunService) EstimateRunStart(ctx context.Context, projectName tenant.ProjectName, jobName scheduler.JobName, scheduledAt time.Time) (*scheduler.RunStartEstimate, error) {
+	details, err := s.jobRepo.GetJobDetails(ctx, projectName, jobName)
+	if err != nil {
+		s.l.Error("error getting job [%s]: %s", jobName, err)
+		return nil, err
+	}
+	jobCron, err := cron.ParseCronSchedule(details.Schedule.Interval)
+	if err != nil {
+		s.l.Error("error parsing cron interval for job [%s]: %s", jobName, err)
+		return nil, errors.InternalError(scheduler.EntityJobRun, "unable to parse job cron interval", err)
+	}
+
+	historicalTimes := previousScheduleTimes(jobCron, scheduledAt, historicalRunsForEstimate)
+	startDate := scheduledAt
+	if len(historicalTimes) > 0 {
+		startDate = historicalTimes[len(historicalTimes)-1]
+	}
+	criteria := &scheduler.JobRunsCriteria{
+		Name:      jobName.String(),
+		StartDate: startDate,
+		EndDate:   scheduledAt,
+	}
+	runs, err := s.scheduler.GetJobRuns(ctx, details.Job.Tenant, criteria, jobCron)
+	if err != nil {
+		s.l.Error("error getting job runs from scheduler for job [%s]: %s", jobName, err)
+		return nil, err
+	}
+
+	estimate := &scheduler.RunStartEstimate{
+		ScheduledAt: scheduledAt,
+		State:       scheduler.StatePending,
+	}
+	for _, run := range runs {
+		if run.ScheduledAt.Equal(scheduledAt) {
+			estimate.State = run.State
+			continue
+		}
+		if run.ScheduledAt.Before(scheduledAt) && !isRunFinished(run.State) {
+			estimate.QueuePosition++
+		}
+	}
+
+	if hasRunStarted(estimate.State) {
+		estimate.Reason = "run has already started"
+		if jobRun, err := s.repo.GetByScheduledAt(ctx, details.Job.Tenant, jobName, scheduledAt); err == nil {
+			estimate.EstimatedStartTime = jobRun.StartTime
+		}
+		return estimate, nil
+	}
+
+	pastRuns, err := s.repo.GetByScheduledTimes(ctx, details.Job.Tenant, jobName, historicalTimes)
+	if err != nil && !errors.IsErrorType(err, errors.ErrNotFound) {
+		s.l.Error("error getting historical runs for job [%s]: %s", jobName, err)
+		return nil, err
+	}
+	avgWait, avgDuration := averageWaitAndDuration(pastRuns)
+
+	estimatedStart := scheduledAt.Add(avgWait).Add(avgDuration * time.Duration(estimate.QueuePosition))
+	now := time.Now()
+	if estimatedStart.Before(now) {
+		estimatedStart = now
+	}
+
+	// the usage of the pool only tells about the runs which are due, as it changes until the others are
+	if !scheduledAt.After(now) {
+		estimate.Pool = s.getPoolSlots(ctx, details)
+		if estimate.Pool != nil && estimate.Pool.Open <= 0 {
+			if poolStart := now.Add(avgDuration * time.Duration(poolWaitRounds(estimate.Pool))); poolStart.After(estimatedStart) {
+				estimatedStart = poolStart
+			}
+		}
+	}
+	estimate.EstimatedStartTime = estimatedStart
+	estimate.Reason = waitReason(estimate)
+	return estimate, nil
+}
+
+// getPoolSlots returns the usage of the scheduler pool of the job, the estimate is made without it when the
+// scheduler can not tell
+func (s *JobRunService) getPoolSlots(ctx context.Context, details *scheduler.JobWithDetails) *scheduler.PoolSlots {
+	pool := details.RuntimeConfig.Scheduler[schedulerPoolKey]
+	slots, err := s.scheduler.GetPoolSlots(ctx, details.Job.Tenant, pool)
+	if err != nil {
+		s.l.Warn("unable to get slots of pool [%s] for job [%s]: %s", pool, details.Name, err)
+		return nil
+	}
+	return slots
+}
+
+// poolWaitRounds is the number of times the slots of the full pool are expected to be freed before the run gets
+// one, which is once for a running task to finish and once more for every round of tasks queued ahead
+func poolWaitRounds(pool *scheduler.PoolSlots) int {
+	if pool.Slots <= 0 {
+		return 1
+	}
+	return 1 + pool.Queued/pool.Slots
+}
+
+func previousScheduleTimes(jobCron *cron.ScheduleSpec, scheduledAt time.Time, count int) []time.Time {
+	var times []time.Time
+	current := scheduledAt
+	for i := 0; i < count; i++ {
+		current = jobCron.Prev(current)
+		times = append(times, current)
+	}
+	return times
+}
+
+func averageWaitAndDuration(runs []*scheduler.JobRun) (time.Duration, time.Duration) {
+	var totalWait, totalDuration time.Duration
+	var countWait, countDuration int
+	for _, run := range runs {
+		if run.StartTime.IsZero() {
+			continue
+		}
+		totalWait += run.StartTime.Sub(run.ScheduledAt)
+		countWait++
+
+		if run.EndTime != nil {
+			totalDuration += run.EndTime.Sub(run.StartTime)
+			countDuration++
+		}
+	}
+
+	var avgWait, avgDuration time.Duration
+	if countWait > 0 {
+		avgWait = totalWait / time.Duration(countWait)
+	}
+	if countDuration > 0 {
+		avgDuration = totalDuration / time.Duration(countDuration)
+	}
+	return avgWait, avgDuration
+}
+
+func isRunFinished(state scheduler.State) bool {
+	return state == scheduler.StateSuccess || state == scheduler.StateFailed
+}
+
+func hasRunStarted(state scheduler.State) bool {
+	return state == scheduler.StateRunning || isRunFinished(state)
+}
+
+func waitReason(estimate *scheduler.RunStartEstimate) string {
+	switch {
+	case estimate.State == scheduler.StateWaitUpstream:
+		return "waiting for upstream sensors to succeed"
+	case estimate.QueuePosition > 0:
+		return fmt.Sprintf("waiting for %d earlier runs to finish", estimate.QueuePosition)
+	case estimate.Pool != nil && estimate.Pool.Open <= 0:
+		return fmt.Sprintf("waiting for a free slot in pool %s, %d of %d slots are occupied and %d tasks are queued",
+			estimate.Pool.Name, estimate.Pool.Occupied, estimate.Pool.Slots, estimate.Pool.Queued)
+	case estimate.State == scheduler.StateQueued:
+		return "waiting for a free slot on scheduler"
+	default:
+		return "waiting for scheduled time"
+	}
+}
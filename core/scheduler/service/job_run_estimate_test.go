@@ -0,0 +1,135 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/scheduler/service"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+)
+
+func TestJobRunServiceEstimateRunStart(t *testing.T) {
+	ctx := context.Background()
+	logger := log.NewNoop()
+	projName := tenant.ProjectName("proj")
+	jobName := scheduler.JobName("sample_select")
+	tnnt, _ := tenant.NewTenant(projName.String(), "ns1")
+	scheduledAt := time.Now().Add(time.Hour * 24).Truncate(time.Hour).UTC()
+
+	jobWithDetails := &scheduler.JobWithDetails{
+		Name: jobName,
+		Job: &scheduler.Job{
+			Name:   jobName,
+			Tenant: tnnt,
+		},
+		Schedule: &scheduler.Schedule{
+			Interval: "0 * * * *",
+		},
+	}
+
+	t.Run("returns error when unable to get job details", func(t *testing.T) {
+		jobRepo := new(JobRepository)
+		defer jobRepo.AssertExpectations(t)
+
+		jobRepo.On("GetJobDetails", ctx, projName, jobName).Return(nil, errors.NotFound(scheduler.EntityJobRun, "job not found"))
+
+		runService := service.NewJobRunService(logger, jobRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		estimate, err := runService.EstimateRunStart(ctx, projName, jobName, scheduledAt)
+
+		assert.Nil(t, estimate)
+		assert.ErrorContains(t, err, "job not found")
+	})
+	t.Run("returns estimate based on runs ahead and historical wait", func(t *testing.T) {
+		jobRepo := new(JobRepository)
+		defer jobRepo.AssertExpectations(t)
+		sch := new(mockScheduler)
+		defer sch.AssertExpectations(t)
+		jobRunRepo := new(mockJobRunRepository)
+		defer jobRunRepo.AssertExpectations(t)
+
+		jobRepo.On("GetJobDetails", ctx, projName, jobName).Return(jobWithDetails, nil)
+		sch.On("GetJobRuns", ctx, tnnt, mock.Anything, mock.Anything).Return([]*scheduler.JobRunStatus{
+			{ScheduledAt: scheduledAt.Add(-time.Hour * 2), State: scheduler.StateSuccess},
+			{ScheduledAt: scheduledAt.Add(-time.Hour), State: scheduler.StateRunning},
+			{ScheduledAt: scheduledAt, State: scheduler.StateQueued},
+		}, nil)
+		pastEnd := scheduledAt.Add(-time.Hour*2 + time.Minute*25)
+		jobRunRepo.On("GetByScheduledTimes", ctx, tnnt, jobName, mock.Anything).Return([]*scheduler.JobRun{
+			{
+				ScheduledAt: scheduledAt.Add(-time.Hour * 2),
+				StartTime:   scheduledAt.Add(-time.Hour*2 + time.Minute*5),
+				EndTime:     &pastEnd,
+			},
+		}, nil)
+
+		runService := service.NewJobRunService(logger, jobRepo, jobRunRepo, nil, nil, sch, nil, nil, nil, nil, nil, nil)
+		estimate, err := runService.EstimateRunStart(ctx, projName, jobName, scheduledAt)
+
+		assert.NoError(t, err)
+		assert.Equal(t, scheduler.StateQueued, estimate.State)
+		assert.Equal(t, 1, estimate.QueuePosition)
+		assert.Equal(t, scheduledAt.Add(time.Minute*25), estimate.EstimatedStartTime)
+		assert.Equal(t, "waiting for 1 earlier runs to finish", estimate.Reason)
+	})
+	t.Run("returns start time when run has already started", func(t *testing.T) {
+		jobRepo := new(JobRepository)
+		defer jobRepo.AssertExpectations(t)
+		sch := new(mockScheduler)
+		defer sch.AssertExpectations(t)
+		jobRunRepo := new(mockJobRunRepository)
+		defer jobRunRepo.AssertExpectations(t)
+
+		startTime := scheduledAt.Add(time.Minute)
+		jobRepo.On("GetJobDetails", ctx, projName, jobName).Return(jobWithDetails, nil)
+		sch.On("GetJobRuns", ctx, tnnt, mock.Anything, mock.Anything).Return([]*scheduler.JobRunStatus{
+			{ScheduledAt: scheduledAt, State: scheduler.StateRunning},
+		}, nil)
+		jobRunRepo.On("GetByScheduledAt", ctx, tnnt, jobName, scheduledAt).Return(&scheduler.JobRun{StartTime: startTime}, nil)
+
+		runService := service.NewJobRunService(logger, jobRepo, jobRunRepo, nil, nil, sch, nil, nil, nil, nil, nil, nil)
+		estimate, err := runService.EstimateRunStart(ctx, projName, jobName, scheduledAt)
+
+		assert.NoError(t, err)
+		assert.Equal(t, startTime, estimate.EstimatedStartTime)
+		assert.Equal(t, "run has already started", estimate.Reason)
+	})
+	t.Run("returns estimate waiting for a free slot when the pool of a due run is full", func(t *testing.T) {
+		jobRepo := new(JobRepository)
+		defer jobRepo.AssertExpectations(t)
+		sch := new(mockScheduler)
+		defer sch.AssertExpectations(t)
+		jobRunRepo := new(mockJobRunRepository)
+		defer jobRunRepo.AssertExpectations(t)
+
+		dueAt := time.Now().Add(-time.Minute).Truncate(time.Minute).UTC()
+		pooledJob := *jobWithDetails
+		pooledJob.RuntimeConfig = scheduler.RuntimeConfig{Scheduler: map[string]string{"pool": "bq_pool"}}
+		jobRepo.On("GetJobDetails", ctx, projName, jobName).Return(&pooledJob, nil)
+		sch.On("GetJobRuns", ctx, tnnt, mock.Anything, mock.Anything).Return([]*scheduler.JobRunStatus{
+			{ScheduledAt: dueAt, State: scheduler.StateQueued},
+		}, nil)
+		pastStart := dueAt.Add(-time.Hour)
+		pastEnd := pastStart.Add(time.Minute * 20)
+		jobRunRepo.On("GetByScheduledTimes", ctx, tnnt, jobName, mock.Anything).Return([]*scheduler.JobRun{
+			{ScheduledAt: pastStart, StartTime: pastStart, EndTime: &pastEnd},
+		}, nil)
+		sch.On("GetPoolSlots", ctx, tnnt, "bq_pool").Return(&scheduler.PoolSlots{
+			Name: "bq_pool", Slots: 4, Occupied: 4, Queued: 4,
+		}, nil)
+
+		runService := service.NewJobRunService(logger, jobRepo, jobRunRepo, nil, nil, sch, nil, nil, nil, nil, nil, nil)
+		estimate, err := runService.EstimateRunStart(ctx, projName, jobName, dueAt)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "bq_pool", estimate.Pool.Name)
+		assert.WithinDuration(t, time.Now().Add(time.Minute*40), estimate.EstimatedStartTime, time.Minute)
+		assert.Equal(t, "waiting for a free slot in pool bq_pool, 4 of 4 slots are occupied and 4 tasks are queued", estimate.Reason)
+	})
+}
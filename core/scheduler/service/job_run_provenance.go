@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/goto/salt/log"
+
+	"github.com/goto/optimus/core/scheduler"
+)
+
+// configJobRunProvenance carries the provenance of the run for the lineage systems to attribute the produced data
+// to the exact version of the job spec
+const configJobRunProvenance = "JOB_RUN_PROVENANCE"
+
+type jobRunProvenance struct {
+	JobVersion int               `json:"job_version,omitempty"`
+	SpecHash   string            `json:"spec_hash"`
+	Plugins    map[string]string `json:"plugins,omitempty"`
+	Window     provenanceWindow  `json:"window"`
+	Upstreams  []string          `json:"upstreams,omitempty"`
+}
+
+type provenanceWindow struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// ProvenanceInputCompiler adds the provenance of the run to the configs of every executor input, with the hash of
+// the job spec, the versions of the plugins, the window of the run and the resources of the upstreams
+type ProvenanceInputCompiler struct {
+	compiler   JobInputCompiler
+	pluginRepo PluginRepo
+
+	logger log.Logger
+}
+
+func (c ProvenanceInputCompiler) Compile(ctx context.Context, job *scheduler.JobWithDetails, config scheduler.RunConfig, executedAt time.Time) (*scheduler.ExecutorInput, error) {
+	input, err := c.compiler.Compile(ctx, job, config, executedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	pluginVersions, err := c.getPluginVersions(job.Job)
+	if err != nil {
+		c.logger.Error("error getting plugin versions of job [%s]: %s", job.Name, err)
+		return nil, err
+	}
+
+	provenance := jobRunProvenance{
+		SpecHash: jobSpecHash(job.Job),
+		Plugins:  pluginVersions,
+		Window: provenanceWindow{
+			Start: input.Configs[configDstart],
+			End:   input.Configs[configDend],
+		},
+		Upstreams: upstreamURNs(job.Upstreams),
+	}
+	if job.JobMetadata != nil {
+		provenance.JobVersion = job.JobMetadata.Version
+	}
+
+	raw, err := json.Marshal(provenance)
+	if err != nil {
+		return nil, err
+	}
+	if input.Configs == nil {
+		input.Configs = map[string]string{}
+	}
+	input.Configs[configJobRunProvenance] = string(raw)
+	return input, nil
+}
+
+func (c ProvenanceInputCompiler) EnabledHooks(ctx context.Context, job *scheduler.Job) ([]*scheduler.Hook, error) {
+	return c.compiler.EnabledHooks(ctx, job)
+}
+
+// getPluginVersions returns the versions of the plugins run by the job, the task plugin is resolved with the version
+// pinned by the job
+func (c ProvenanceInputCompiler) getPluginVersions(job *scheduler.Job) (map[string]string, error) {
+	versions := make(map[string]string, len(job.Hooks)+1)
+	if job.Task != nil {
+		taskPlugin, err := c.pluginRepo.GetByNameAndVersion(job.Task.Name, job.Task.Version)
+		if err != nil {
+			return nil, err
+		}
+		if info := taskPlugin.Info(); info != nil {
+			versions[job.Task.Name] = info.PluginVersion
+		}
+	}
+	for _, hook := range job.Hooks {
+		hookPlugin, err := c.pluginRepo.GetByName(hook.Name)
+		if err != nil {
+			return nil, err
+		}
+		if info := hookPlugin.Info(); info != nil {
+			versions[hook.Name] = info.PluginVersion
+		}
+	}
+	return versions, nil
+}
+
+// jobSpecHash identifies the version of the spec the job is run with, by the task, hooks, window and assets of the job
+func jobSpecHash(job *scheduler.Job) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s/%s/%s\n", job.Tenant.ProjectName(), job.Tenant.NamespaceName(), job.Name)
+	fmt.Fprintf(h, "%s\n", job.Destination)
+
+	if job.Task != nil {
+		fmt.Fprintf(h, "%s/%s\n", job.Task.Name, job.Task.Version)
+		writeSortedConfigs(h, "task", job.Task.Config)
+	}
+	for _, hook := range job.Hooks {
+		fmt.Fprintf(h, "%s/%s/%v/%q\n", hook.Name, hook.Phase, hook.DependsOn, hook.When)
+		writeSortedConfigs(h, "hook", hook.Config)
+	}
+
+	w := job.WindowConfig
+	fmt.Fprintf(h, "%s/%s/%s/%s/%s/%d\n", w.Type(), w.Preset, w.GetSize(), w.GetOffset(), w.GetTruncateTo(), w.GetVersion())
+	writeSortedConfigs(h, "asset", job.Assets)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func writeSortedConfigs(h io.Writer, section string, configs map[string]string) {
+	keys := make([]string, 0, len(configs))
+	for k := range configs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	fmt.Fprintf(h, "[%s]\n", section)
+	for _, k := range keys {
+		fmt.Fprintf(h, "%s=%q\n", k, configs[k])
+	}
+}
+
+// upstreamURNs returns the sorted resource urns of the upstreams of the job, the upstreams without resource are left out
+func upstreamURNs(upstreams scheduler.Upstreams) []string {
+	seen := make(map[string]bool, len(upstreams.UpstreamJobs))
+	var urns []string
+	for _, upstream := range upstreams.UpstreamJobs {
+		if upstream.DestinationURN == "" || seen[upstream.DestinationURN] {
+			continue
+		}
+		seen[upstream.DestinationURN] = true
+		urns = append(urns, upstream.DestinationURN)
+	}
+	sort.Strings(urns)
+	return urns
+}
+
+func NewProvenanceInputCompiler(compiler JobInputCompiler, pluginRepo PluginRepo, logger log.Logger) *ProvenanceInputCompiler {
+	return &ProvenanceInputCompiler{
+		compiler:   compiler,
+		pluginRepo: pluginRepo,
+		logger:     logger,
+	}
+}
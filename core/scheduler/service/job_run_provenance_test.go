@@ -0,0 +1,131 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/scheduler/service"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/sdk/plugin"
+	smock "github.com/goto/optimus/sdk/plugin/mock"
+)
+
+func TestProvenanceInputCompiler(t *testing.T) {
+	ctx := context.Background()
+	logger := log.NewNoop()
+	tnnt, _ := tenant.NewTenant("proj", "ns1")
+	executedAt := time.Date(2023, 1, 2, 0, 1, 0, 0, time.UTC)
+	config := scheduler.RunConfig{
+		Executor:    scheduler.Executor{Name: "bq2bq", Type: scheduler.ExecutorTask},
+		ScheduledAt: time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	newJob := func(taskConfig map[string]string) *scheduler.JobWithDetails {
+		return &scheduler.JobWithDetails{
+			Name: "job1",
+			Job: &scheduler.Job{
+				Name:        "job1",
+				Tenant:      tnnt,
+				Destination: "bigquery://proj:dataset.table",
+				Task:        &scheduler.Task{Name: "bq2bq", Version: "1.2.0", Config: taskConfig},
+				Hooks:       []*scheduler.Hook{{Name: "predator"}},
+			},
+			JobMetadata: &scheduler.JobMetadata{Version: 3},
+			Upstreams: scheduler.Upstreams{UpstreamJobs: []*scheduler.JobUpstream{
+				{JobName: "upstream2", DestinationURN: "bigquery://proj:dataset.upstream2"},
+				{JobName: "upstream1", DestinationURN: "bigquery://proj:dataset.upstream1"},
+				{JobName: "upstream3"},
+			}},
+		}
+	}
+	newInput := func() *scheduler.ExecutorInput {
+		return &scheduler.ExecutorInput{Configs: map[string]string{
+			"DSTART": "2023-01-01T00:00:00Z",
+			"DEND":   "2023-01-02T00:00:00Z",
+		}}
+	}
+	newPluginRepo := func() *mockPluginRepo {
+		taskMod := new(smock.YamlMod)
+		taskMod.On("PluginInfo").Return(&plugin.Info{Name: "bq2bq", PluginVersion: "1.2.0"})
+		hookMod := new(smock.YamlMod)
+		hookMod.On("PluginInfo").Return(&plugin.Info{Name: "predator", PluginVersion: "0.4.1"})
+
+		pluginRepo := new(mockPluginRepo)
+		pluginRepo.On("GetByNameAndVersion", "bq2bq", "1.2.0").Return(&plugin.Plugin{YamlMod: taskMod}, nil)
+		pluginRepo.On("GetByName", "predator").Return(&plugin.Plugin{YamlMod: hookMod}, nil)
+		return pluginRepo
+	}
+
+	t.Run("Compile", func(t *testing.T) {
+		t.Run("returns error when compilation fails", func(t *testing.T) {
+			job := newJob(nil)
+			compiler := new(mockJobInputCompiler)
+			compiler.On("Compile", ctx, job, config, executedAt).Return(nil, errors.New("unable to compile"))
+			defer compiler.AssertExpectations(t)
+
+			provenanceCompiler := service.NewProvenanceInputCompiler(compiler, new(mockPluginRepo), logger)
+			_, err := provenanceCompiler.Compile(ctx, job, config, executedAt)
+			assert.ErrorContains(t, err, "unable to compile")
+		})
+		t.Run("returns error when unable to get the plugin of the task", func(t *testing.T) {
+			job := newJob(nil)
+			compiler := new(mockJobInputCompiler)
+			compiler.On("Compile", ctx, job, config, executedAt).Return(newInput(), nil)
+			defer compiler.AssertExpectations(t)
+
+			pluginRepo := new(mockPluginRepo)
+			pluginRepo.On("GetByNameAndVersion", "bq2bq", "1.2.0").Return(nil, errors.New("plugin not found"))
+			defer pluginRepo.AssertExpectations(t)
+
+			provenanceCompiler := service.NewProvenanceInputCompiler(compiler, pluginRepo, logger)
+			_, err := provenanceCompiler.Compile(ctx, job, config, executedAt)
+			assert.ErrorContains(t, err, "plugin not found")
+		})
+		t.Run("adds the provenance of the run to the configs", func(t *testing.T) {
+			job := newJob(map[string]string{"PROJECT": "proj"})
+			compiler := new(mockJobInputCompiler)
+			compiler.On("Compile", ctx, job, config, executedAt).Return(newInput(), nil)
+			defer compiler.AssertExpectations(t)
+
+			pluginRepo := newPluginRepo()
+			defer pluginRepo.AssertExpectations(t)
+
+			provenanceCompiler := service.NewProvenanceInputCompiler(compiler, pluginRepo, logger)
+			input, err := provenanceCompiler.Compile(ctx, job, config, executedAt)
+			assert.NoError(t, err)
+
+			var provenance map[string]any
+			assert.NoError(t, json.Unmarshal([]byte(input.Configs["JOB_RUN_PROVENANCE"]), &provenance))
+			assert.EqualValues(t, 3, provenance["job_version"])
+			assert.Len(t, provenance["spec_hash"], 64)
+			assert.Equal(t, map[string]any{"bq2bq": "1.2.0", "predator": "0.4.1"}, provenance["plugins"])
+			assert.Equal(t, map[string]any{"start": "2023-01-01T00:00:00Z", "end": "2023-01-02T00:00:00Z"}, provenance["window"])
+			assert.Equal(t, []any{"bigquery://proj:dataset.upstream1", "bigquery://proj:dataset.upstream2"}, provenance["upstreams"])
+		})
+		t.Run("changes the spec hash only when the spec of the job changes", func(t *testing.T) {
+			specHashOf := func(job *scheduler.JobWithDetails) string {
+				compiler := new(mockJobInputCompiler)
+				compiler.On("Compile", ctx, job, config, executedAt).Return(newInput(), nil)
+
+				provenanceCompiler := service.NewProvenanceInputCompiler(compiler, newPluginRepo(), logger)
+				input, err := provenanceCompiler.Compile(ctx, job, config, executedAt)
+				assert.NoError(t, err)
+
+				var provenance map[string]any
+				assert.NoError(t, json.Unmarshal([]byte(input.Configs["JOB_RUN_PROVENANCE"]), &provenance))
+				return provenance["spec_hash"].(string)
+			}
+
+			specHash := specHashOf(newJob(map[string]string{"PROJECT": "proj", "DATASET": "dataset"}))
+			assert.Equal(t, specHash, specHashOf(newJob(map[string]string{"DATASET": "dataset", "PROJECT": "proj"})))
+			assert.NotEqual(t, specHash, specHashOf(newJob(map[string]string{"PROJECT": "proj", "DATASET": "other"})))
+		})
+	})
+}
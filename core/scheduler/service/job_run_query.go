@@ -0,0 +1,30 @@
+package service
+
+import (
+	"context"
+
+	"github.com/goto/optimus/core/scheduler"
+)
+
+// QueryJobRuns returns a page of the runs recorded for the jobs of a project matching the query, one extra run is
+// fetched to tell whether there is a next page
+func (s *JobRunService) QueryJobRuns(ctx context.Context, query *scheduler.JobRunQuery) (*scheduler.JobRunPage, error) {
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
+	pageQuery := *query
+	pageQuery.PageSize++
+	runs, err := s.repo.Query(ctx, &pageQuery)
+	if err != nil {
+		s.l.Error("error querying job runs of project [%s]: %s", query.ProjectName, err)
+		return nil, err
+	}
+
+	page := &scheduler.JobRunPage{Runs: runs}
+	if len(runs) > query.PageSize {
+		page.Runs = runs[:query.PageSize]
+		page.NextOffset = query.Offset + query.PageSize
+	}
+	return page, nil
+}
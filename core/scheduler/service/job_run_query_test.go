@@ -0,0 +1,73 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/scheduler/service"
+	"github.com/goto/optimus/internal/errors"
+)
+
+func TestJobRunServiceQueryJobRuns(t *testing.T) {
+	ctx := context.Background()
+	logger := log.NewNoop()
+	scheduledAt := time.Date(2023, 10, 10, 10, 0, 0, 0, time.UTC)
+
+	runs := []*scheduler.JobRun{
+		{ID: uuid.New(), JobName: "job-a", State: scheduler.StateFailed, ScheduledAt: scheduledAt},
+		{ID: uuid.New(), JobName: "job-b", State: scheduler.StateFailed, ScheduledAt: scheduledAt},
+		{ID: uuid.New(), JobName: "job-c", State: scheduler.StateFailed, ScheduledAt: scheduledAt},
+	}
+
+	t.Run("returns error when the query is invalid", func(t *testing.T) {
+		runService := service.NewJobRunService(logger, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		page, err := runService.QueryJobRuns(ctx, &scheduler.JobRunQuery{
+			ProjectName:   "proj",
+			ScheduledFrom: scheduledAt,
+			ScheduledTo:   scheduledAt.Add(-time.Hour),
+		})
+
+		assert.Nil(t, page)
+		assert.True(t, errors.IsErrorType(err, errors.ErrInvalidArgument))
+	})
+	t.Run("returns the page with the offset of the next page when there are more runs", func(t *testing.T) {
+		jobRunRepo := new(mockJobRunRepository)
+		defer jobRunRepo.AssertExpectations(t)
+
+		jobRunRepo.On("Query", ctx, mock.MatchedBy(func(query *scheduler.JobRunQuery) bool {
+			return query.PageSize == 3 && query.Offset == 4 && query.SortBy == scheduler.JobRunSortByScheduledAt
+		})).Return(runs, nil)
+
+		runService := service.NewJobRunService(logger, nil, jobRunRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		page, err := runService.QueryJobRuns(ctx, &scheduler.JobRunQuery{
+			ProjectName: "proj",
+			States:      []scheduler.State{scheduler.StateFailed},
+			Offset:      4,
+			PageSize:    2,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, runs[:2], page.Runs)
+		assert.Equal(t, 6, page.NextOffset)
+	})
+	t.Run("returns the last page without the offset of a next page", func(t *testing.T) {
+		jobRunRepo := new(mockJobRunRepository)
+		defer jobRunRepo.AssertExpectations(t)
+
+		jobRunRepo.On("Query", ctx, mock.Anything).Return(runs, nil)
+
+		runService := service.NewJobRunService(logger, nil, jobRunRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		page, err := runService.QueryJobRuns(ctx, &scheduler.JobRunQuery{ProjectName: "proj"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, runs, page.Runs)
+		assert.Zero(t, page.NextOffset)
+	})
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/goto/optimus/internal/errors"
 	"github.com/goto/optimus/internal/lib/cron"
 	"github.com/goto/optimus/internal/lib/window"
+	"github.com/goto/optimus/internal/logging"
 	"github.com/goto/optimus/internal/models"
 	"github.com/goto/optimus/internal/telemetry"
 )
@@ -31,7 +32,8 @@ func (m metricType) String() string {
 const (
 	scheduleDelay metricType = "schedule_delay"
 
-	metricJobRunEvents = "jobrun_events_total"
+	metricJobRunEvents               = "jobrun_events_total"
+	metricJobRunInputCompileDuration = "jobrun_input_compile_duration_seconds"
 )
 
 type JobRepository interface {
@@ -45,11 +47,17 @@ type JobRunRepository interface {
 	GetByID(ctx context.Context, id scheduler.JobRunID) (*scheduler.JobRun, error)
 	GetByScheduledAt(ctx context.Context, tenant tenant.Tenant, name scheduler.JobName, scheduledAt time.Time) (*scheduler.JobRun, error)
 	GetByScheduledTimes(ctx context.Context, tenant tenant.Tenant, jobName scheduler.JobName, scheduledTimes []time.Time) ([]*scheduler.JobRun, error)
+	Query(ctx context.Context, query *scheduler.JobRunQuery) ([]*scheduler.JobRun, error)
 	Create(ctx context.Context, tenant tenant.Tenant, name scheduler.JobName, scheduledAt time.Time, slaDefinitionInSec int64) error
 	Update(ctx context.Context, jobRunID uuid.UUID, endTime time.Time, jobRunStatus scheduler.State) error
 	UpdateState(ctx context.Context, jobRunID uuid.UUID, jobRunStatus scheduler.State) error
 	UpdateSLA(ctx context.Context, jobName scheduler.JobName, project tenant.ProjectName, scheduledTimes []time.Time) error
 	UpdateMonitoring(ctx context.Context, jobRunID uuid.UUID, monitoring map[string]any) error
+	UpdateArtifacts(ctx context.Context, jobRunID uuid.UUID, artifacts map[string]any) error
+	UpdateHeartbeat(ctx context.Context, jobRunID uuid.UUID, heartbeatAt time.Time) error
+	AddEvent(ctx context.Context, jobRunID uuid.UUID, event *scheduler.Event) (bool, error)
+	SaveTrigger(ctx context.Context, trigger *scheduler.JobRunTrigger) error
+	GetConfigOverrides(ctx context.Context, tenant tenant.Tenant, jobName scheduler.JobName, scheduledAt time.Time) (map[string]string, error)
 }
 
 type JobReplayRepository interface {
@@ -60,10 +68,12 @@ type OperatorRunRepository interface {
 	GetOperatorRun(ctx context.Context, operatorName string, operator scheduler.OperatorType, jobRunID uuid.UUID) (*scheduler.OperatorRun, error)
 	CreateOperatorRun(ctx context.Context, operatorName string, operator scheduler.OperatorType, jobRunID uuid.UUID, startTime time.Time) error
 	UpdateOperatorRun(ctx context.Context, operator scheduler.OperatorType, jobRunID uuid.UUID, eventTime time.Time, state scheduler.State) error
+	GetOperatorRuns(ctx context.Context, jobRunID uuid.UUID) ([]*scheduler.OperatorRun, error)
 }
 
 type JobInputCompiler interface {
 	Compile(ctx context.Context, job *scheduler.JobWithDetails, config scheduler.RunConfig, executedAt time.Time) (*scheduler.ExecutorInput, error)
+	EnabledHooks(ctx context.Context, job *scheduler.Job) ([]*scheduler.Hook, error)
 }
 
 type PriorityResolver interface {
@@ -76,6 +86,9 @@ type Scheduler interface {
 	ListJobs(ctx context.Context, t tenant.Tenant) ([]string, error)
 	DeleteJobs(ctx context.Context, t tenant.Tenant, jobsToDelete []string) error
 	UpdateJobState(ctx context.Context, tnnt tenant.Tenant, jobName []job.Name, state string) error
+	GetEnvironmentHealth(ctx context.Context, tnnt tenant.Tenant) (*scheduler.EnvironmentHealth, error)
+	GetPoolSlots(ctx context.Context, tnnt tenant.Tenant, pool string) (*scheduler.PoolSlots, error)
+	CreateRun(ctx context.Context, tnnt tenant.Tenant, jobName scheduler.JobName, executionTime time.Time, dagRunIDPrefix string) error
 }
 
 type EventHandler interface {
@@ -86,6 +99,14 @@ type ProjectGetter interface {
 	GetByName(context.Context, tenant.ProjectName) (*tenant.Project, error)
 }
 
+type FailureClassifier interface {
+	Classify(event *scheduler.Event) scheduler.FailureCategory
+}
+
+type DurationObserver interface {
+	Observe(ctx context.Context, jobRun *scheduler.JobRun) error
+}
+
 type JobRunService struct {
 	l                log.Logger
 	repo             JobRunRepository
@@ -97,6 +118,10 @@ type JobRunService struct {
 	priorityResolver PriorityResolver
 	compiler         JobInputCompiler
 	projectGetter    ProjectGetter
+	classifier       FailureClassifier
+	durationObserver DurationObserver
+
+	uploads *uploadTracker
 }
 
 func (s *JobRunService) JobRunInput(ctx context.Context, projectName tenant.ProjectName, jobName scheduler.JobName, config scheduler.RunConfig) (*scheduler.ExecutorInput, error) {
@@ -105,37 +130,120 @@ func (s *JobRunService) JobRunInput(ctx context.Context, projectName tenant.Proj
 		s.l.Error("error getting job [%s]: %s", jobName, err)
 		return nil, err
 	}
+	l := s.jobLogger(details.Job.Tenant, jobName)
+
 	// TODO: Use scheduled_at instead of executed_at for computations, for deterministic calculations
 	// Todo: later, always return scheduleTime, for scheduleTimes greater than a given date
 	var jobRun *scheduler.JobRun
 	if config.JobRunID.IsEmpty() {
-		s.l.Warn("getting job run by scheduled at")
+		l.Warn("getting job run by scheduled at")
 		jobRun, err = s.repo.GetByScheduledAt(ctx, details.Job.Tenant, jobName, config.ScheduledAt)
 	} else {
-		s.l.Warn("getting job run by id")
+		l.Warn("getting job run by id")
 		jobRun, err = s.repo.GetByID(ctx, config.JobRunID)
 	}
 
 	var executedAt time.Time
 	if err != nil { // Fallback for executed_at to scheduled_at
 		executedAt = config.ScheduledAt
-		s.l.Warn("suppressed error is encountered when getting job run: %s", err)
+		l.Warn("suppressed error is encountered when getting job run: %s", err)
 	} else {
 		executedAt = jobRun.StartTime
 	}
+
+	compileStartTime := time.Now()
+	input, err := s.compileWithReplayConfig(ctx, l, details, config, executedAt)
+	telemetry.NewHistogram(metricJobRunInputCompileDuration, map[string]string{
+		"project":       details.Job.Tenant.ProjectName().String(),
+		"namespace":     details.Job.Tenant.NamespaceName().String(),
+		"job":           jobName.String(),
+		"executor_type": config.Executor.Type.String(),
+	}).Observe(time.Since(compileStartTime).Seconds())
+	if err != nil {
+		return nil, err
+	}
+	if input.Skipped && jobRun != nil {
+		operatorType := scheduler.OperatorHook
+		if input.Configs[configHookType] == scheduler.HookTypeFail {
+			operatorType = scheduler.OperatorFailureHook
+		}
+		s.skipHookRun(ctx, l, jobRun, scheduler.Hook{Name: config.Executor.Name}, operatorType)
+	}
+	return input, nil
+}
+
+// skipHookRun records the run of the hook as skipped, the success reported once its executor exits keeps it skipped.
+// Failing to record it does not fail the run, as the hook is skipped by the executor regardless
+func (s *JobRunService) skipHookRun(ctx context.Context, l log.Logger, jobRun *scheduler.JobRun, hook scheduler.Hook, operatorType scheduler.OperatorType) {
+	now := time.Now()
+	operatorRun, err := s.operatorRunRepo.GetOperatorRun(ctx, hook.OperatorName(), operatorType, jobRun.ID)
+	if err != nil {
+		if !errors.IsErrorType(err, errors.ErrNotFound) {
+			l.Error("error getting run of hook [%s] for job run [%s]: %s", hook.Name, jobRun.ID, err)
+			return
+		}
+		if err := s.operatorRunRepo.CreateOperatorRun(ctx, hook.OperatorName(), operatorType, jobRun.ID, now); err != nil {
+			l.Error("error creating run of hook [%s] for job run [%s]: %s", hook.Name, jobRun.ID, err)
+			return
+		}
+		if operatorRun, err = s.operatorRunRepo.GetOperatorRun(ctx, hook.OperatorName(), operatorType, jobRun.ID); err != nil {
+			l.Error("error getting the registered run of hook [%s]: %s", hook.Name, err)
+			return
+		}
+	}
+
+	if err := s.operatorRunRepo.UpdateOperatorRun(ctx, operatorType, operatorRun.ID, now, scheduler.StateSkipped); err != nil {
+		l.Error("error marking run of hook [%s] for job run [%s] as skipped: %s", hook.Name, jobRun.ID, err)
+		return
+	}
+	l.Info("hook [%s] is skipped for job run [%s] as its when condition does not hold", hook.Name, jobRun.ID)
+}
+
+// CompileExecutorInputAt compiles the executor input as it is for the run scheduled at the time in config, executed
+// at the given time, without looking up the job run. It reproduces the input of past runs to debug their templates
+func (s *JobRunService) CompileExecutorInputAt(ctx context.Context, projectName tenant.ProjectName, jobName scheduler.JobName, config scheduler.RunConfig, executedAt time.Time) (*scheduler.ExecutorInput, error) {
+	details, err := s.jobRepo.GetJobDetails(ctx, projectName, jobName)
+	if err != nil {
+		s.l.Error("error getting job [%s]: %s", jobName, err)
+		return nil, err
+	}
+	l := s.jobLogger(details.Job.Tenant, jobName)
+
+	if executedAt.IsZero() {
+		executedAt = config.ScheduledAt
+	}
+	l.Info("compiling executor input of [%s] scheduled at [%s] executed at [%s]", config.Executor.Name, config.ScheduledAt, executedAt)
+	return s.compileWithReplayConfig(ctx, l, details, config, executedAt)
+}
+
+func (s *JobRunService) compileWithReplayConfig(ctx context.Context, l log.Logger, details *scheduler.JobWithDetails, config scheduler.RunConfig, executedAt time.Time) (*scheduler.ExecutorInput, error) {
 	// Additional task config from existing replay
 	replayJobConfig, err := s.replayRepo.GetReplayJobConfig(ctx, details.Job.Tenant, details.Job.Name, config.ScheduledAt)
 	if err != nil {
-		s.l.Error("error getting replay job config from db: %s", err)
+		l.Error("error getting replay job config from db: %s", err)
 		return nil, err
 	}
 	for k, v := range replayJobConfig {
 		details.Job.Task.Config[k] = v
 	}
 
+	if config.RunType() == scheduler.RunTypeManual {
+		overrides, err := s.repo.GetConfigOverrides(ctx, details.Job.Tenant, details.Job.Name, config.ScheduledAt)
+		if err != nil {
+			l.Error("error getting config overrides of manual run from db: %s", err)
+			return nil, err
+		}
+		config = config.WithConfigOverrides(overrides)
+	}
+
 	return s.compiler.Compile(ctx, details, config, executedAt)
 }
 
+// jobLogger attaches the tenant and job fields to the lines logged for the job
+func (s *JobRunService) jobLogger(tnnt tenant.Tenant, jobName scheduler.JobName) log.Logger {
+	return logging.ForTenant(s.l, tnnt.ProjectName().String(), tnnt.NamespaceName().String(), jobName.String())
+}
+
 func (s *JobRunService) GetJobRuns(ctx context.Context, projectName tenant.ProjectName, jobName scheduler.JobName, criteria *scheduler.JobRunsCriteria) ([]*scheduler.JobRunStatus, error) {
 	jobWithDetails, err := s.jobRepo.GetJobDetails(ctx, projectName, jobName)
 	if err != nil {
@@ -178,6 +286,15 @@ func (s *JobRunService) GetJobRuns(ctx context.Context, projectName tenant.Proje
 	return result, nil
 }
 
+func (s *JobRunService) GetSchedulerHealth(ctx context.Context, tnnt tenant.Tenant) (*scheduler.EnvironmentHealth, error) {
+	health, err := s.scheduler.GetEnvironmentHealth(ctx, tnnt)
+	if err != nil {
+		s.l.Error("error getting scheduler health for project [%s]: %s", tnnt.ProjectName(), err)
+		return nil, err
+	}
+	return health, nil
+}
+
 func (s *JobRunService) GetInterval(ctx context.Context, projectName tenant.ProjectName, jobName scheduler.JobName, referenceTime time.Time) (window.Interval, error) {
 	project, err := s.projectGetter.GetByName(ctx, projectName)
 	if err != nil {
@@ -314,19 +431,20 @@ func validateJobQuery(jobQuery *scheduler.JobRunsCriteria, jobWithDetails *sched
 }
 
 func (s *JobRunService) registerNewJobRun(ctx context.Context, tenant tenant.Tenant, jobName scheduler.JobName, scheduledAt time.Time) error {
+	l := s.jobLogger(tenant, jobName)
 	job, err := s.jobRepo.GetJobDetails(ctx, tenant.ProjectName(), jobName)
 	if err != nil {
-		s.l.Error("error getting job details for job [%s]: %s", jobName, err)
+		l.Error("error getting job details for job [%s]: %s", jobName, err)
 		return err
 	}
 	slaDefinitionInSec, err := job.SLADuration()
 	if err != nil {
-		s.l.Error("error getting sla duration: %s", err)
+		l.Error("error getting sla duration: %s", err)
 		return err
 	}
 	err = s.repo.Create(ctx, tenant, jobName, scheduledAt, slaDefinitionInSec)
 	if err != nil {
-		s.l.Error("error creating job run: %s", err)
+		l.Error("error creating job run: %s", err)
 		return err
 	}
 
@@ -340,22 +458,23 @@ func (s *JobRunService) registerNewJobRun(ctx context.Context, tenant tenant.Ten
 }
 
 func (s *JobRunService) getJobRunByScheduledAt(ctx context.Context, tenant tenant.Tenant, jobName scheduler.JobName, scheduledAt time.Time) (*scheduler.JobRun, error) {
+	l := s.jobLogger(tenant, jobName)
 	var jobRun *scheduler.JobRun
 	jobRun, err := s.repo.GetByScheduledAt(ctx, tenant, jobName, scheduledAt)
 	if err != nil {
 		if !errors.IsErrorType(err, errors.ErrNotFound) {
-			s.l.Error("error getting job run by scheduled at: %s", err)
+			l.Error("error getting job run by scheduled at: %s", err)
 			return nil, err
 		}
 		// TODO: consider moving below call outside as the caller is a 'getter'
 		err = s.registerNewJobRun(ctx, tenant, jobName, scheduledAt)
 		if err != nil {
-			s.l.Error("error registering new job run: %s", err)
+			l.Error("error registering new job run: %s", err)
 			return nil, err
 		}
 		jobRun, err = s.repo.GetByScheduledAt(ctx, tenant, jobName, scheduledAt)
 		if err != nil {
-			s.l.Error("error getting the registered job run: %s", err)
+			l.Error("error getting the registered job run: %s", err)
 			return nil, err
 		}
 	}
@@ -363,20 +482,43 @@ func (s *JobRunService) getJobRunByScheduledAt(ctx context.Context, tenant tenan
 }
 
 func (s *JobRunService) updateJobRun(ctx context.Context, event *scheduler.Event) error {
+	l := s.jobLogger(event.Tenant, event.JobName)
 	var jobRun *scheduler.JobRun
 	jobRun, err := s.getJobRunByScheduledAt(ctx, event.Tenant, event.JobName, event.JobScheduledAt)
 	if err != nil {
-		s.l.Error("error getting job run by schedule time [%s]: %s", event.JobScheduledAt, err)
+		l.Error("error getting job run by schedule time [%s]: %s", event.JobScheduledAt, err)
+		return err
+	}
+	if processed, err := s.isEventProcessed(ctx, jobRun, event); err != nil || processed {
 		return err
 	}
+	if isStaleForJobRun(jobRun, event) {
+		l.Warn("job run [%s] already ended as %s, event [%s] at [%s] is ignored", jobRun.ID, jobRun.State, event.Type, event.EventTime)
+		return nil
+	}
 	if err := s.repo.Update(ctx, jobRun.ID, event.EventTime, event.Status); err != nil {
-		s.l.Error("error updating job run with id [%s]: %s", jobRun.ID, err)
+		l.Error("error updating job run with id [%s]: %s", jobRun.ID, err)
 		return err
 	}
 	jobRun.State = event.Status
+	jobRun.EndTime = &event.EventTime
 	s.raiseJobRunStateChangeEvent(jobRun)
+	if s.durationObserver != nil && jobRun.State == scheduler.StateSuccess {
+		if err := s.durationObserver.Observe(ctx, jobRun); err != nil {
+			l.Error("error observing duration of job run [%s]: %s", jobRun.ID, err)
+		}
+	}
 	monitoringValues := s.getMonitoringValues(event)
-	return s.repo.UpdateMonitoring(ctx, jobRun.ID, monitoringValues)
+	if err := s.repo.UpdateMonitoring(ctx, jobRun.ID, monitoringValues); err != nil {
+		return err
+	}
+
+	// artifacts are reported by the run to be consumed by the downstream runs
+	artifacts := s.getArtifacts(event)
+	if len(artifacts) == 0 {
+		return nil
+	}
+	return s.repo.UpdateArtifacts(ctx, jobRun.ID, artifacts)
 }
 
 func (*JobRunService) getMonitoringValues(event *scheduler.Event) map[string]any {
@@ -387,7 +529,27 @@ func (*JobRunService) getMonitoringValues(event *scheduler.Event) map[string]any
 	return output
 }
 
+func (*JobRunService) getArtifacts(event *scheduler.Event) map[string]any {
+	var output map[string]any
+	if value, ok := event.Values["artifacts"]; ok && value != nil {
+		output, _ = value.(map[string]any)
+	}
+	return output
+}
+
+// Heartbeat records that the executor of the job run is still alive
+func (s *JobRunService) Heartbeat(ctx context.Context, tnnt tenant.Tenant, jobName scheduler.JobName, scheduledAt time.Time) error {
+	l := s.jobLogger(tnnt, jobName)
+	jobRun, err := s.repo.GetByScheduledAt(ctx, tnnt, jobName, scheduledAt)
+	if err != nil {
+		l.Error("error getting job run of [%s] scheduled at [%s]: %s", jobName, scheduledAt, err)
+		return err
+	}
+	return s.repo.UpdateHeartbeat(ctx, jobRun.ID, time.Now())
+}
+
 func (s *JobRunService) updateJobRunSLA(ctx context.Context, event *scheduler.Event) error {
+	l := s.jobLogger(event.Tenant, event.JobName)
 	if len(event.SLAObjectList) < 1 {
 		return nil
 	}
@@ -397,7 +559,7 @@ func (s *JobRunService) updateJobRunSLA(ctx context.Context, event *scheduler.Ev
 	}
 	jobRuns, err := s.repo.GetByScheduledTimes(ctx, event.Tenant, event.JobName, scheduleTimesList)
 	if err != nil {
-		s.l.Error("error getting job runs by schedule time", err)
+		l.Error("error getting job runs by schedule time", err)
 		return err
 	}
 
@@ -405,7 +567,7 @@ func (s *JobRunService) updateJobRunSLA(ctx context.Context, event *scheduler.Ev
 	var filteredSLAObject []*scheduler.SLAObject
 	for _, jobRun := range jobRuns {
 		if !jobRun.HasSLABreached() {
-			s.l.Error("received sla miss callback for job run that has not breached SLA, jobName: %s, scheduled_at: %s, start_time: %s, end_time: %s, SLA definition: %s",
+			l.Error("received sla miss callback for job run that has not breached SLA, jobName: %s, scheduled_at: %s, start_time: %s, end_time: %s, SLA definition: %s",
 				jobRun.JobName, jobRun.ScheduledAt.String(), jobRun.StartTime, jobRun.EndTime, time.Second*time.Duration(jobRun.SLADefinition))
 			continue
 		}
@@ -420,7 +582,7 @@ func (s *JobRunService) updateJobRunSLA(ctx context.Context, event *scheduler.Ev
 
 	err = s.repo.UpdateSLA(ctx, event.JobName, event.Tenant.ProjectName(), slaBreachedJobRunScheduleTimes)
 	if err != nil {
-		s.l.Error("error updating job run sla status", err)
+		l.Error("error updating job run sla status", err)
 		return err
 	}
 	telemetry.NewCounter(metricJobRunEvents, map[string]string{
@@ -438,7 +600,7 @@ func operatorStartToJobState(operatorType scheduler.OperatorType) (scheduler.Sta
 		return scheduler.StateInProgress, nil
 	case scheduler.OperatorSensor:
 		return scheduler.StateWaitUpstream, nil
-	case scheduler.OperatorHook:
+	case scheduler.OperatorHook, scheduler.OperatorFailureHook:
 		return scheduler.StateInProgress, nil
 	default:
 		return "", errors.InvalidArgument(scheduler.EntityJobRun, "Invalid operator type")
@@ -446,6 +608,7 @@ func operatorStartToJobState(operatorType scheduler.OperatorType) (scheduler.Sta
 }
 
 func (s *JobRunService) raiseJobRunStateChangeEvent(jobRun *scheduler.JobRun) {
+	l := s.jobLogger(jobRun.Tenant, jobRun.JobName)
 	var schedulerEvent moderator.Event
 	var err error
 	switch jobRun.State {
@@ -458,11 +621,11 @@ func (s *JobRunService) raiseJobRunStateChangeEvent(jobRun *scheduler.JobRun) {
 	case scheduler.StateFailed:
 		schedulerEvent, err = event.NewJobRunFailedEvent(jobRun)
 	default:
-		s.l.Error("state [%s] is unrecognized, event is not published", jobRun.State)
+		l.Error("state [%s] is unrecognized, event is not published", jobRun.State)
 		return
 	}
 	if err != nil {
-		s.l.Error("error creating event for job run state change : %s", err)
+		l.Error("error creating event for job run state change : %s", err)
 		return
 	}
 	s.eventHandler.HandleEvent(schedulerEvent)
@@ -475,20 +638,39 @@ func (s *JobRunService) raiseJobRunStateChangeEvent(jobRun *scheduler.JobRun) {
 }
 
 func (s *JobRunService) createOperatorRun(ctx context.Context, event *scheduler.Event, operatorType scheduler.OperatorType) error {
+	l := s.jobLogger(event.Tenant, event.JobName)
 	jobRun, err := s.getJobRunByScheduledAt(ctx, event.Tenant, event.JobName, event.JobScheduledAt)
 	if err != nil {
-		s.l.Error("error getting job run by scheduled time [%s]: %s", event.JobScheduledAt, err)
+		l.Error("error getting job run by scheduled time [%s]: %s", event.JobScheduledAt, err)
 		return err
 	}
+	if processed, err := s.isEventProcessed(ctx, jobRun, event); err != nil || processed {
+		return err
+	}
+	latestRun, err := s.operatorRunRepo.GetOperatorRun(ctx, event.OperatorName, operatorType, jobRun.ID)
+	if err != nil && !errors.IsErrorType(err, errors.ErrNotFound) {
+		l.Error("error getting operator for job run [%s]: %s", jobRun.ID, err)
+		return err
+	}
+	if latestRun != nil && event.EventTime.Before(latestRun.StartTime) {
+		l.Warn("operator [%s] already has a run started at [%s], event [%s] at [%s] is ignored", event.OperatorName, latestRun.StartTime, event.Type, event.EventTime)
+		return nil
+	}
+	return s.startOperatorRun(ctx, event, operatorType, jobRun)
+}
+
+func (s *JobRunService) startOperatorRun(ctx context.Context, event *scheduler.Event, operatorType scheduler.OperatorType, jobRun *scheduler.JobRun) error {
+	l := s.jobLogger(event.Tenant, event.JobName)
 	jobState, err := operatorStartToJobState(operatorType)
 	if err != nil {
-		s.l.Error("error converting operator to job state: %s", err)
+		l.Error("error converting operator to job state: %s", err)
 		return err
 	}
-	if jobRun.State != jobState {
+	// a finished job run is only moved back to running by the operators started after its end
+	if jobRun.State != jobState && !isStaleForJobRun(jobRun, event) {
 		err := s.repo.UpdateState(ctx, jobRun.ID, jobState)
 		if err != nil {
-			s.l.Error("error updating state for job run id [%d] to [%s]: %s", jobRun.ID, jobState, err)
+			l.Error("error updating state for job run id [%d] to [%s]: %s", jobRun.ID, jobState, err)
 			return err
 		}
 		jobRun.State = jobState
@@ -498,25 +680,26 @@ func (s *JobRunService) createOperatorRun(ctx context.Context, event *scheduler.
 	return s.operatorRunRepo.CreateOperatorRun(ctx, event.OperatorName, operatorType, jobRun.ID, event.EventTime)
 }
 
-func (s *JobRunService) getOperatorRun(ctx context.Context, event *scheduler.Event, operatorType scheduler.OperatorType, jobRunID uuid.UUID) (*scheduler.OperatorRun, error) {
+func (s *JobRunService) getOperatorRun(ctx context.Context, event *scheduler.Event, operatorType scheduler.OperatorType, jobRun *scheduler.JobRun) (*scheduler.OperatorRun, error) {
+	l := s.jobLogger(event.Tenant, event.JobName)
 	var operatorRun *scheduler.OperatorRun
-	operatorRun, err := s.operatorRunRepo.GetOperatorRun(ctx, event.OperatorName, operatorType, jobRunID)
+	operatorRun, err := s.operatorRunRepo.GetOperatorRun(ctx, event.OperatorName, operatorType, jobRun.ID)
 	if err != nil {
 		if !errors.IsErrorType(err, errors.ErrNotFound) {
-			s.l.Error("error getting operator for job run [%s]: %s", jobRunID, err)
+			l.Error("error getting operator for job run [%s]: %s", jobRun.ID, err)
 			return nil, err
 		}
-		s.l.Warn("operator is not found, creating it")
+		l.Warn("operator is not found, creating it")
 
 		// TODO: consider moving below call outside as the caller is a 'getter'
-		err = s.createOperatorRun(ctx, event, operatorType)
+		err = s.startOperatorRun(ctx, event, operatorType, jobRun)
 		if err != nil {
-			s.l.Error("error creating operator run: %s", err)
+			l.Error("error creating operator run: %s", err)
 			return nil, err
 		}
-		operatorRun, err = s.operatorRunRepo.GetOperatorRun(ctx, event.OperatorName, operatorType, jobRunID)
+		operatorRun, err = s.operatorRunRepo.GetOperatorRun(ctx, event.OperatorName, operatorType, jobRun.ID)
 		if err != nil {
-			s.l.Error("error getting the registered operator run: %s", err)
+			l.Error("error getting the registered operator run: %s", err)
 			return nil, err
 		}
 	}
@@ -524,19 +707,31 @@ func (s *JobRunService) getOperatorRun(ctx context.Context, event *scheduler.Eve
 }
 
 func (s *JobRunService) updateOperatorRun(ctx context.Context, event *scheduler.Event, operatorType scheduler.OperatorType) error {
+	l := s.jobLogger(event.Tenant, event.JobName)
 	jobRun, err := s.getJobRunByScheduledAt(ctx, event.Tenant, event.JobName, event.JobScheduledAt)
 	if err != nil {
-		s.l.Error("error getting job run by scheduled time [%s]: %s", event.JobScheduledAt, err)
+		l.Error("error getting job run by scheduled time [%s]: %s", event.JobScheduledAt, err)
 		return err
 	}
-	operatorRun, err := s.getOperatorRun(ctx, event, operatorType, jobRun.ID)
+	if processed, err := s.isEventProcessed(ctx, jobRun, event); err != nil || processed {
+		return err
+	}
+	operatorRun, err := s.getOperatorRun(ctx, event, operatorType, jobRun)
 	if err != nil {
-		s.l.Error("error getting operator for job run id [%s]: %s", jobRun.ID, err)
+		l.Error("error getting operator for job run id [%s]: %s", jobRun.ID, err)
 		return err
 	}
+	if isStaleForOperatorRun(operatorRun, event) {
+		l.Warn("operator run [%s] is newer than event [%s] at [%s], event is ignored", operatorRun.ID, event.Type, event.EventTime)
+		return nil
+	}
+	if operatorRun.Status == scheduler.StateSkipped && event.Status == scheduler.StateSuccess {
+		l.Info("operator run [%s] is skipped, success of its executor is ignored", operatorRun.ID)
+		return nil
+	}
 	err = s.operatorRunRepo.UpdateOperatorRun(ctx, operatorType, operatorRun.ID, event.EventTime, event.Status)
 	if err != nil {
-		s.l.Error("error updating operator run id [%s]: %s", operatorRun.ID, err)
+		l.Error("error updating operator run id [%s]: %s", operatorRun.ID, err)
 		return err
 	}
 	telemetry.NewGauge("jobrun_durations_breakdown_seconds", map[string]string{
@@ -548,15 +743,44 @@ func (s *JobRunService) updateOperatorRun(ctx context.Context, event *scheduler.
 	return nil
 }
 
+// isEventProcessed records the event for the job run, as the scheduler delivers the callbacks at least once,
+// an event already recorded for the same attempt of the operator is reported as processed
+func (s *JobRunService) isEventProcessed(ctx context.Context, jobRun *scheduler.JobRun, event *scheduler.Event) (bool, error) {
+	l := s.jobLogger(event.Tenant, event.JobName)
+	added, err := s.repo.AddEvent(ctx, jobRun.ID, event)
+	if err != nil {
+		l.Error("error recording event [%s] for job run [%s]: %s", event.Type, jobRun.ID, err)
+		return false, err
+	}
+	if !added {
+		l.Info("event [%s] of operator [%s] attempt [%d] is already processed for job run [%s]", event.Type, event.OperatorName, event.Attempt, jobRun.ID)
+	}
+	return !added, nil
+}
+
+// isStaleForJobRun tells if the event happened before the finished job run ended, such an event arrived out of order
+func isStaleForJobRun(jobRun *scheduler.JobRun, event *scheduler.Event) bool {
+	return jobRun.State.IsFinished() && jobRun.EndTime != nil && event.EventTime.Before(*jobRun.EndTime)
+}
+
+// isStaleForOperatorRun tells if the event happened before the operator run started or before the finished operator run ended
+func isStaleForOperatorRun(operatorRun *scheduler.OperatorRun, event *scheduler.Event) bool {
+	if event.EventTime.Before(operatorRun.StartTime) {
+		return true
+	}
+	return operatorRun.Status.IsFinished() && operatorRun.EndTime != nil && event.EventTime.Before(*operatorRun.EndTime)
+}
+
 func (s *JobRunService) trackEvent(event *scheduler.Event) {
+	l := s.jobLogger(event.Tenant, event.JobName)
 	if event.Type.IsOfType(scheduler.EventCategorySLAMiss) {
 		jsonSLAObjectList, err := json.Marshal(event.SLAObjectList)
 		if err != nil {
 			jsonSLAObjectList = []byte("unable to json Marshal SLAObjectList")
 		}
-		s.l.Info("received job sla_miss event, jobName: %v , slaPayload: %s", event.JobName, string(jsonSLAObjectList))
+		l.Info("received job sla_miss event, jobName: %v , slaPayload: %s", event.JobName, string(jsonSLAObjectList))
 	} else {
-		s.l.Info("received event: %v, eventTime: %s, jobName: %v, Operator: %v, schedule: %s, status: %s",
+		l.Info("received event: %v, eventTime: %s, jobName: %v, Operator: %v, schedule: %s, status: %s",
 			event.Type, event.EventTime.Format("01/02/06 15:04:05 MST"), event.JobName, event.OperatorName, event.JobScheduledAt.Format("01/02/06 15:04:05 MST"), event.Status)
 	}
 
@@ -590,8 +814,30 @@ func (s *JobRunService) trackEvent(event *scheduler.Event) {
 	}
 }
 
+// tagFailure classifies the failure reported by the event, the category is kept in the event values
+// to be carried along to the notifications and the recorded job run events
+func (s *JobRunService) tagFailure(event *scheduler.Event) {
+	if s.classifier == nil || !event.IsFailure() {
+		return
+	}
+	category := s.classifier.Classify(event)
+	if event.Values == nil {
+		event.Values = map[string]any{}
+	}
+	event.Values[scheduler.FailureCategoryKey] = category.String()
+
+	telemetry.NewCounter("jobrun_failures_total", map[string]string{
+		"project":    event.Tenant.ProjectName().String(),
+		"namespace":  event.Tenant.NamespaceName().String(),
+		"event_type": event.Type.String(),
+		"plugin":     event.FailedPlugin(),
+		"category":   category.String(),
+	}).Inc()
+}
+
 func (s *JobRunService) UpdateJobState(ctx context.Context, event *scheduler.Event) error {
 	s.trackEvent(event)
+	s.tagFailure(event)
 
 	switch event.Type {
 	case scheduler.SLAMissEvent:
@@ -607,17 +853,25 @@ func (s *JobRunService) UpdateJobState(ctx context.Context, event *scheduler.Eve
 	case scheduler.SensorSuccessEvent, scheduler.SensorRetryEvent, scheduler.SensorFailEvent:
 		return s.updateOperatorRun(ctx, event, scheduler.OperatorSensor)
 	case scheduler.HookStartEvent:
-		return s.createOperatorRun(ctx, event, scheduler.OperatorHook)
+		return s.createOperatorRun(ctx, event, hookOperatorType(event))
 	case scheduler.HookSuccessEvent, scheduler.HookRetryEvent, scheduler.HookFailEvent:
-		return s.updateOperatorRun(ctx, event, scheduler.OperatorHook)
+		return s.updateOperatorRun(ctx, event, hookOperatorType(event))
 	default:
 		return errors.InvalidArgument(scheduler.EntityEvent, "invalid event type: "+string(event.Type))
 	}
 }
 
+// hookOperatorType records the events of the failure hooks apart from the other hooks
+func hookOperatorType(event *scheduler.Event) scheduler.OperatorType {
+	if event.IsFailureHook() {
+		return scheduler.OperatorFailureHook
+	}
+	return scheduler.OperatorHook
+}
+
 func NewJobRunService(logger log.Logger, jobRepo JobRepository, jobRunRepo JobRunRepository, replayRepo JobReplayRepository,
 	operatorRunRepo OperatorRunRepository, scheduler Scheduler, resolver PriorityResolver, compiler JobInputCompiler, eventHandler EventHandler,
-	projectGetter ProjectGetter,
+	projectGetter ProjectGetter, classifier FailureClassifier, durationObserver DurationObserver,
 ) *JobRunService {
 	return &JobRunService{
 		l:                logger,
@@ -630,5 +884,8 @@ func NewJobRunService(logger log.Logger, jobRepo JobRepository, jobRunRepo JobRu
 		priorityResolver: resolver,
 		compiler:         compiler,
 		projectGetter:    projectGetter,
+		classifier:       classifier,
+		durationObserver: durationObserver,
+		uploads:          newUploadTracker(),
 	}
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
+	"github.com/goto/optimus/config"
 	"github.com/goto/optimus/core/event/moderator"
 	"github.com/goto/optimus/core/job"
 	"github.com/goto/optimus/core/scheduler"
@@ -40,7 +41,7 @@ func TestJobRunService(t *testing.T) {
 
 		t.Run("should reject unregistered events", func(t *testing.T) {
 			runService := service.NewJobRunService(logger,
-				nil, nil, nil, nil, nil, nil, nil, nil, nil)
+				nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			event := &scheduler.Event{
 				JobName: jobName,
@@ -63,7 +64,7 @@ func TestJobRunService(t *testing.T) {
 				defer jobRepo.AssertExpectations(t)
 
 				runService := service.NewJobRunService(logger,
-					jobRepo, jobRunRepository, nil, nil, nil, nil, nil, nil, nil)
+					jobRepo, jobRunRepository, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 				event := &scheduler.Event{
 					JobName:        jobName,
@@ -108,7 +109,7 @@ func TestJobRunService(t *testing.T) {
 				defer jobRunRepository.AssertExpectations(t)
 
 				runService := service.NewJobRunService(logger,
-					jobRepo, jobRunRepository, nil, nil, nil, nil, nil, nil, nil)
+					jobRepo, jobRunRepository, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 				event := &scheduler.Event{
 					JobName:        jobName,
@@ -175,6 +176,7 @@ func TestJobRunService(t *testing.T) {
 				jobRunRepo.On("GetByScheduledAt", ctx, tnnt, jobName, scheduledAtTimeStamp).Return(nil, errors.NotFound(scheduler.EntityJobRun, "job run not found in db for given schedule date")).Once()
 				jobRunRepo.On("Create", ctx, tnnt, jobName, scheduledAtTimeStamp, slaDefinitionInSec).Return(nil)
 				jobRunRepo.On("GetByScheduledAt", ctx, tnnt, jobName, scheduledAtTimeStamp).Return(jobRun, nil).Once()
+				jobRunRepo.On("AddEvent", ctx, jobRun.ID, event).Return(true, nil)
 				jobRunRepo.On("Update", ctx, jobRun.ID, event.EventTime, scheduler.StateSuccess).Return(nil)
 				jobRunRepo.On("UpdateMonitoring", ctx, jobRun.ID, monitoring).Return(nil)
 				defer jobRunRepo.AssertExpectations(t)
@@ -187,7 +189,7 @@ func TestJobRunService(t *testing.T) {
 				defer eventHandler.AssertExpectations(t)
 
 				runService := service.NewJobRunService(logger,
-					jobRepo, jobRunRepo, nil, operatorRunRepo, nil, nil, nil, eventHandler, nil)
+					jobRepo, jobRunRepo, nil, operatorRunRepo, nil, nil, nil, eventHandler, nil, nil, nil)
 
 				err = runService.UpdateJobState(ctx, event)
 				assert.Nil(t, err)
@@ -221,6 +223,7 @@ func TestJobRunService(t *testing.T) {
 
 				jobRunRepo := new(mockJobRunRepository)
 				jobRunRepo.On("GetByScheduledAt", ctx, tnnt, jobName, scheduledAtTimeStamp).Return(&jobRun, nil)
+				jobRunRepo.On("AddEvent", ctx, jobRun.ID, event).Return(true, nil)
 				jobRunRepo.On("Update", ctx, jobRun.ID, endTime, scheduler.StateSuccess).Return(nil)
 				jobRunRepo.On("UpdateMonitoring", ctx, jobRun.ID, monitoring).Return(nil)
 				defer jobRunRepo.AssertExpectations(t)
@@ -230,7 +233,50 @@ func TestJobRunService(t *testing.T) {
 				defer eventHandler.AssertExpectations(t)
 
 				runService := service.NewJobRunService(logger,
-					nil, jobRunRepo, nil, nil, nil, nil, nil, eventHandler, nil)
+					nil, jobRunRepo, nil, nil, nil, nil, nil, eventHandler, nil, nil, nil)
+
+				err := runService.UpdateJobState(ctx, event)
+				assert.Nil(t, err)
+			})
+			t.Run("should store artifacts reported on JobSuccessEvent", func(t *testing.T) {
+				scheduledAtTimeStamp, _ := time.Parse(scheduler.ISODateFormat, "2022-01-02T15:04:05Z")
+				eventTime := time.Unix(todayDate.Add(time.Hour).Unix(), 0)
+				artifacts := map[string]any{"max_id": "1024"}
+				event := &scheduler.Event{
+					JobName:        jobName,
+					Tenant:         tnnt,
+					Type:           scheduler.JobSuccessEvent,
+					Status:         scheduler.StateSuccess,
+					JobScheduledAt: scheduledAtTimeStamp,
+					EventTime:      eventTime,
+					Values: map[string]any{
+						"status":     "success",
+						"monitoring": monitoring,
+						"artifacts":  artifacts,
+					},
+				}
+
+				jobRun := scheduler.JobRun{
+					ID:        uuid.New(),
+					JobName:   jobName,
+					Tenant:    tnnt,
+					StartTime: todayDate,
+				}
+
+				jobRunRepo := new(mockJobRunRepository)
+				jobRunRepo.On("GetByScheduledAt", ctx, tnnt, jobName, scheduledAtTimeStamp).Return(&jobRun, nil)
+				jobRunRepo.On("AddEvent", ctx, jobRun.ID, event).Return(true, nil)
+				jobRunRepo.On("Update", ctx, jobRun.ID, eventTime, scheduler.StateSuccess).Return(nil)
+				jobRunRepo.On("UpdateMonitoring", ctx, jobRun.ID, monitoring).Return(nil)
+				jobRunRepo.On("UpdateArtifacts", ctx, jobRun.ID, artifacts).Return(nil)
+				defer jobRunRepo.AssertExpectations(t)
+
+				eventHandler := newEventHandler(t)
+				eventHandler.On("HandleEvent", mock.Anything).Times(1)
+				defer eventHandler.AssertExpectations(t)
+
+				runService := service.NewJobRunService(logger,
+					nil, jobRunRepo, nil, nil, nil, nil, nil, eventHandler, nil, nil, nil)
 
 				err := runService.UpdateJobState(ctx, event)
 				assert.Nil(t, err)
@@ -287,7 +333,7 @@ func TestJobRunService(t *testing.T) {
 					defer jobRunRepo.AssertExpectations(t)
 
 					runService := service.NewJobRunService(logger,
-						nil, jobRunRepo, nil, nil, nil, nil, nil, nil, nil)
+						nil, jobRunRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 					err := runService.UpdateJobState(ctx, event)
 					assert.NotNil(t, err)
@@ -304,7 +350,7 @@ func TestJobRunService(t *testing.T) {
 					defer jobRepo.AssertExpectations(t)
 
 					runService := service.NewJobRunService(logger,
-						jobRepo, jobRunRepo, nil, nil, nil, nil, nil, nil, nil)
+						jobRepo, jobRunRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 					err := runService.UpdateJobState(ctx, event)
 					assert.NotNil(t, err)
@@ -321,7 +367,7 @@ func TestJobRunService(t *testing.T) {
 					defer jobRepo.AssertExpectations(t)
 
 					runService := service.NewJobRunService(logger,
-						jobRepo, jobRunRepo, nil, nil, nil, nil, nil, nil, nil)
+						jobRepo, jobRunRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 					err := runService.UpdateJobState(ctx, event)
 					assert.NotNil(t, err)
@@ -332,6 +378,7 @@ func TestJobRunService(t *testing.T) {
 					jobRunRepo.On("GetByScheduledAt", ctx, tnnt, jobName, scheduledAtTimeStamp).Return(nil, errors.NotFound(scheduler.EntityJobRun, "job run not found")).Once()
 					jobRunRepo.On("Create", ctx, tnnt, jobName, scheduledAtTimeStamp, slaDefinitionInSec).Return(nil).Once()
 					jobRunRepo.On("GetByScheduledAt", ctx, tnnt, jobName, scheduledAtTimeStamp).Return(&jobRun, nil).Once()
+					jobRunRepo.On("AddEvent", ctx, jobRun.ID, event).Return(true, nil)
 					jobRunRepo.On("Update", ctx, jobRun.ID, endTime, scheduler.StateSuccess).Return(nil)
 					jobRunRepo.On("UpdateMonitoring", ctx, jobRun.ID, monitoring).Return(nil)
 					defer jobRunRepo.AssertExpectations(t)
@@ -345,7 +392,7 @@ func TestJobRunService(t *testing.T) {
 					defer jobRepo.AssertExpectations(t)
 
 					runService := service.NewJobRunService(logger,
-						jobRepo, jobRunRepo, nil, nil, nil, nil, nil, eventHandler, nil)
+						jobRepo, jobRunRepo, nil, nil, nil, nil, nil, eventHandler, nil, nil, nil)
 
 					err := runService.UpdateJobState(ctx, event)
 					assert.Nil(t, err)
@@ -371,7 +418,7 @@ func TestJobRunService(t *testing.T) {
 				defer jobRunRepo.AssertExpectations(t)
 
 				runService := service.NewJobRunService(logger,
-					nil, jobRunRepo, nil, nil, nil, nil, nil, nil, nil)
+					nil, jobRunRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 				err := runService.UpdateJobState(ctx, event)
 				assert.NotNil(t, err)
@@ -394,7 +441,7 @@ func TestJobRunService(t *testing.T) {
 				defer jobRunRepo.AssertExpectations(t)
 
 				runService := service.NewJobRunService(logger,
-					nil, jobRunRepo, nil, nil, nil, nil, nil, nil, nil)
+					nil, jobRunRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 				err := runService.UpdateJobState(ctx, event)
 				assert.NotNil(t, err)
@@ -417,7 +464,7 @@ func TestJobRunService(t *testing.T) {
 				defer jobRunRepo.AssertExpectations(t)
 
 				runService := service.NewJobRunService(logger,
-					nil, jobRunRepo, nil, nil, nil, nil, nil, nil, nil)
+					nil, jobRunRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 				err := runService.UpdateJobState(ctx, event)
 				assert.NotNil(t, err)
@@ -446,11 +493,13 @@ func TestJobRunService(t *testing.T) {
 
 				jobRunRepo := new(mockJobRunRepository)
 				jobRunRepo.On("GetByScheduledAt", ctx, tnnt, jobName, scheduledAtTimeStamp).Return(&jobRun, nil)
+				jobRunRepo.On("AddEvent", ctx, jobRun.ID, event).Return(true, nil)
 				jobRunRepo.On("UpdateState", ctx, jobRun.ID, scheduler.StateInProgress).Return(nil)
 				defer jobRunRepo.AssertExpectations(t)
 
 				t.Run("should pass creating new operator run ", func(t *testing.T) {
 					operatorRunRepository := new(mockOperatorRunRepository)
+					operatorRunRepository.On("GetOperatorRun", ctx, event.OperatorName, scheduler.OperatorTask, jobRun.ID).Return(nil, errors.NotFound(scheduler.EntityEvent, "operator not found in db"))
 					operatorRunRepository.On("CreateOperatorRun", ctx, event.OperatorName, scheduler.OperatorTask, jobRun.ID, eventTime).Return(nil)
 					defer operatorRunRepository.AssertExpectations(t)
 
@@ -459,13 +508,50 @@ func TestJobRunService(t *testing.T) {
 					defer eventHandler.AssertExpectations(t)
 
 					runService := service.NewJobRunService(logger,
-						nil, jobRunRepo, nil, operatorRunRepository, nil, nil, nil, eventHandler, nil)
+						nil, jobRunRepo, nil, operatorRunRepository, nil, nil, nil, eventHandler, nil, nil, nil)
 
 					err := runService.UpdateJobState(ctx, event)
 					assert.Nil(t, err)
 				})
 			})
 		})
+		t.Run("on HookStartEvent of a failure hook should create failure_hook_run row", func(t *testing.T) {
+			scheduledAtTimeStamp, _ := time.Parse(scheduler.ISODateFormat, "2022-01-02T15:04:05Z")
+			eventTime := time.Unix(todayDate.Add(time.Hour).Unix(), 0)
+			event := &scheduler.Event{
+				JobName:        jobName,
+				Tenant:         tnnt,
+				Type:           scheduler.HookStartEvent,
+				Status:         scheduler.StateRunning,
+				EventTime:      eventTime,
+				OperatorName:   "hook_failureHook",
+				JobScheduledAt: scheduledAtTimeStamp,
+				Values:         map[string]any{scheduler.HookTypeKey: scheduler.HookTypeFail},
+			}
+
+			jobRun := scheduler.JobRun{
+				ID:        uuid.New(),
+				JobName:   jobName,
+				Tenant:    tnnt,
+				State:     scheduler.StateInProgress,
+				StartTime: time.Now(),
+			}
+			jobRunRepo := new(mockJobRunRepository)
+			jobRunRepo.On("GetByScheduledAt", ctx, tnnt, jobName, scheduledAtTimeStamp).Return(&jobRun, nil)
+			jobRunRepo.On("AddEvent", ctx, jobRun.ID, event).Return(true, nil)
+			defer jobRunRepo.AssertExpectations(t)
+
+			operatorRunRepository := new(mockOperatorRunRepository)
+			operatorRunRepository.On("GetOperatorRun", ctx, event.OperatorName, scheduler.OperatorFailureHook, jobRun.ID).Return(nil, errors.NotFound(scheduler.EntityEvent, "operator not found in db"))
+			operatorRunRepository.On("CreateOperatorRun", ctx, event.OperatorName, scheduler.OperatorFailureHook, jobRun.ID, eventTime).Return(nil)
+			defer operatorRunRepository.AssertExpectations(t)
+
+			runService := service.NewJobRunService(logger,
+				nil, jobRunRepo, nil, operatorRunRepository, nil, nil, nil, nil, nil, nil, nil)
+
+			err := runService.UpdateJobState(ctx, event)
+			assert.Nil(t, err)
+		})
 		t.Run("updateOperatorRun", func(t *testing.T) {
 			t.Run("on TaskSuccessEvent should create task_run row", func(t *testing.T) {
 				scheduledAtTimeStamp, _ := time.Parse(scheduler.ISODateFormat, "2022-01-02T15:04:05Z")
@@ -497,6 +583,7 @@ func TestJobRunService(t *testing.T) {
 
 					jobRunRepo := new(mockJobRunRepository)
 					jobRunRepo.On("GetByScheduledAt", ctx, tnnt, jobName, scheduledAtTimeStamp).Return(&jobRun, nil)
+					jobRunRepo.On("AddEvent", ctx, jobRun.ID, event).Return(true, nil)
 					jobRunRepo.On("UpdateState", ctx, jobRun.ID, scheduler.StateInProgress).Return(nil)
 					defer jobRunRepo.AssertExpectations(t)
 
@@ -505,7 +592,7 @@ func TestJobRunService(t *testing.T) {
 					defer eventHandler.AssertExpectations(t)
 
 					runService := service.NewJobRunService(logger,
-						nil, jobRunRepo, nil, operatorRunRepository, nil, nil, nil, eventHandler, nil)
+						nil, jobRunRepo, nil, operatorRunRepository, nil, nil, nil, eventHandler, nil, nil, nil)
 
 					err := runService.UpdateJobState(ctx, event)
 					assert.NotNil(t, err)
@@ -527,6 +614,7 @@ func TestJobRunService(t *testing.T) {
 
 					jobRunRepo := new(mockJobRunRepository)
 					jobRunRepo.On("GetByScheduledAt", ctx, tnnt, jobName, scheduledAtTimeStamp).Return(&jobRun, nil)
+					jobRunRepo.On("AddEvent", ctx, jobRun.ID, event).Return(true, nil)
 					jobRunRepo.On("UpdateState", ctx, jobRun.ID, scheduler.StateInProgress).Return(nil)
 					defer jobRunRepo.AssertExpectations(t)
 
@@ -535,7 +623,7 @@ func TestJobRunService(t *testing.T) {
 					defer eventHandler.AssertExpectations(t)
 
 					runService := service.NewJobRunService(logger,
-						nil, jobRunRepo, nil, operatorRunRepository, nil, nil, nil, eventHandler, nil)
+						nil, jobRunRepo, nil, operatorRunRepository, nil, nil, nil, eventHandler, nil, nil, nil)
 
 					err := runService.UpdateJobState(ctx, event)
 					assert.NotNil(t, err)
@@ -563,10 +651,11 @@ func TestJobRunService(t *testing.T) {
 
 					jobRunRepo := new(mockJobRunRepository)
 					jobRunRepo.On("GetByScheduledAt", ctx, tnnt, jobName, scheduledAtTimeStamp).Return(&jobRun, nil)
+					jobRunRepo.On("AddEvent", ctx, jobRun.ID, event).Return(true, nil)
 					defer jobRunRepo.AssertExpectations(t)
 
 					runService := service.NewJobRunService(logger,
-						nil, jobRunRepo, nil, operatorRunRepository, nil, nil, nil, nil, nil)
+						nil, jobRunRepo, nil, operatorRunRepository, nil, nil, nil, nil, nil, nil, nil)
 
 					err := runService.UpdateJobState(ctx, event)
 					assert.Nil(t, err)
@@ -592,7 +681,7 @@ func TestJobRunService(t *testing.T) {
 				defer jobRunRepo.AssertExpectations(t)
 
 				runService := service.NewJobRunService(logger,
-					nil, jobRunRepo, nil, nil, nil, nil, nil, nil, nil)
+					nil, jobRunRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 				err := runService.UpdateJobState(ctx, event)
 				assert.NotNil(t, err)
@@ -622,6 +711,7 @@ func TestJobRunService(t *testing.T) {
 
 				jobRunRepo := new(mockJobRunRepository)
 				jobRunRepo.On("GetByScheduledAt", ctx, tnnt, jobName, scheduledAtTimeStamp).Return(&jobRun, nil)
+				jobRunRepo.On("AddEvent", ctx, jobRun.ID, event).Return(true, nil)
 				defer jobRunRepo.AssertExpectations(t)
 
 				operatorRunRepository := new(mockOperatorRunRepository)
@@ -629,7 +719,7 @@ func TestJobRunService(t *testing.T) {
 				// operatorRunRepository.On("UpdateOperatorRun", ctx, scheduler.OperatorSensor, operatorRun.ID, eventTime, "success").Return(nil)
 				defer operatorRunRepository.AssertExpectations(t)
 				runService := service.NewJobRunService(logger,
-					nil, jobRunRepo, nil, operatorRunRepository, nil, nil, nil, nil, nil)
+					nil, jobRunRepo, nil, operatorRunRepository, nil, nil, nil, nil, nil, nil, nil)
 
 				err := runService.UpdateJobState(ctx, event)
 				assert.NotNil(t, err)
@@ -637,6 +727,277 @@ func TestJobRunService(t *testing.T) {
 			})
 		})
 
+		t.Run("idempotency", func(t *testing.T) {
+			scheduledAtTimeStamp, _ := time.Parse(scheduler.ISODateFormat, "2022-01-02T15:04:05Z")
+			endTime := time.Unix(todayDate.Add(time.Hour).Unix(), 0)
+
+			t.Run("should skip the event already processed for the attempt of operator", func(t *testing.T) {
+				event := &scheduler.Event{
+					JobName:        jobName,
+					Tenant:         tnnt,
+					Type:           scheduler.TaskSuccessEvent,
+					EventTime:      endTime,
+					Status:         scheduler.StateSuccess,
+					OperatorName:   "task_bq2bq",
+					Attempt:        1,
+					JobScheduledAt: scheduledAtTimeStamp,
+					Values:         map[string]any{},
+				}
+				jobRun := scheduler.JobRun{
+					ID:      uuid.New(),
+					JobName: jobName,
+					Tenant:  tnnt,
+				}
+
+				jobRunRepo := new(mockJobRunRepository)
+				jobRunRepo.On("GetByScheduledAt", ctx, tnnt, jobName, scheduledAtTimeStamp).Return(&jobRun, nil)
+				jobRunRepo.On("AddEvent", ctx, jobRun.ID, event).Return(false, nil)
+				defer jobRunRepo.AssertExpectations(t)
+
+				operatorRunRepository := new(mockOperatorRunRepository)
+				defer operatorRunRepository.AssertExpectations(t)
+
+				runService := service.NewJobRunService(logger,
+					nil, jobRunRepo, nil, operatorRunRepository, nil, nil, nil, nil, nil, nil, nil)
+
+				err := runService.UpdateJobState(ctx, event)
+				assert.Nil(t, err)
+			})
+			t.Run("should return error when unable to record the event", func(t *testing.T) {
+				event := &scheduler.Event{
+					JobName:        jobName,
+					Tenant:         tnnt,
+					Type:           scheduler.JobSuccessEvent,
+					EventTime:      endTime,
+					Status:         scheduler.StateSuccess,
+					JobScheduledAt: scheduledAtTimeStamp,
+					Values:         map[string]any{},
+				}
+				jobRun := scheduler.JobRun{
+					ID:      uuid.New(),
+					JobName: jobName,
+					Tenant:  tnnt,
+				}
+
+				jobRunRepo := new(mockJobRunRepository)
+				jobRunRepo.On("GetByScheduledAt", ctx, tnnt, jobName, scheduledAtTimeStamp).Return(&jobRun, nil)
+				jobRunRepo.On("AddEvent", ctx, jobRun.ID, event).Return(false, fmt.Errorf("some error in adding event"))
+				defer jobRunRepo.AssertExpectations(t)
+
+				runService := service.NewJobRunService(logger,
+					nil, jobRunRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+				err := runService.UpdateJobState(ctx, event)
+				assert.EqualError(t, err, "some error in adding event")
+			})
+			t.Run("should not update finished job run with the event older than its end", func(t *testing.T) {
+				event := &scheduler.Event{
+					JobName:        jobName,
+					Tenant:         tnnt,
+					Type:           scheduler.JobFailureEvent,
+					EventTime:      endTime.Add(-time.Minute),
+					Status:         scheduler.StateFailed,
+					JobScheduledAt: scheduledAtTimeStamp,
+					Values:         map[string]any{},
+				}
+				jobRun := scheduler.JobRun{
+					ID:      uuid.New(),
+					JobName: jobName,
+					Tenant:  tnnt,
+					State:   scheduler.StateSuccess,
+					EndTime: &endTime,
+				}
+
+				jobRunRepo := new(mockJobRunRepository)
+				jobRunRepo.On("GetByScheduledAt", ctx, tnnt, jobName, scheduledAtTimeStamp).Return(&jobRun, nil)
+				jobRunRepo.On("AddEvent", ctx, jobRun.ID, event).Return(true, nil)
+				defer jobRunRepo.AssertExpectations(t)
+
+				runService := service.NewJobRunService(logger,
+					nil, jobRunRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+				err := runService.UpdateJobState(ctx, event)
+				assert.Nil(t, err)
+			})
+			t.Run("should tag the failure with the category of the matching rule", func(t *testing.T) {
+				classifier, err := service.NewFailureRuleClassifier([]config.FailureRuleConfig{
+					{Category: "infra", Pattern: "(?i)connection reset"},
+					{Plugin: "bq2bq", Category: "quota", Pattern: "(?i)quota exceeded"},
+				})
+				assert.NoError(t, err)
+
+				event := &scheduler.Event{
+					JobName:        jobName,
+					Tenant:         tnnt,
+					Type:           scheduler.JobFailureEvent,
+					EventTime:      endTime.Add(-time.Minute),
+					Status:         scheduler.StateFailed,
+					OperatorName:   "bq2bq",
+					JobScheduledAt: scheduledAtTimeStamp,
+					Values: map[string]any{
+						"exception": "Quota exceeded, connection reset by peer",
+					},
+				}
+				jobRun := scheduler.JobRun{
+					ID:      uuid.New(),
+					JobName: jobName,
+					Tenant:  tnnt,
+					State:   scheduler.StateSuccess,
+					EndTime: &endTime,
+				}
+
+				jobRunRepo := new(mockJobRunRepository)
+				jobRunRepo.On("GetByScheduledAt", ctx, tnnt, jobName, scheduledAtTimeStamp).Return(&jobRun, nil)
+				jobRunRepo.On("AddEvent", ctx, jobRun.ID, event).Return(true, nil)
+				defer jobRunRepo.AssertExpectations(t)
+
+				runService := service.NewJobRunService(logger,
+					nil, jobRunRepo, nil, nil, nil, nil, nil, nil, nil, classifier, nil)
+
+				err = runService.UpdateJobState(ctx, event)
+				assert.Nil(t, err)
+				assert.Equal(t, scheduler.FailureCategoryQuota, event.FailureCategory())
+			})
+			t.Run("should not create operator run when the operator has a run started after the event", func(t *testing.T) {
+				event := &scheduler.Event{
+					JobName:        jobName,
+					Tenant:         tnnt,
+					Type:           scheduler.TaskStartEvent,
+					EventTime:      endTime.Add(-time.Hour),
+					Status:         scheduler.StateRunning,
+					OperatorName:   "task_bq2bq",
+					Attempt:        1,
+					JobScheduledAt: scheduledAtTimeStamp,
+					Values:         map[string]any{},
+				}
+				jobRun := scheduler.JobRun{
+					ID:      uuid.New(),
+					JobName: jobName,
+					Tenant:  tnnt,
+					State:   scheduler.StateSuccess,
+					EndTime: &endTime,
+				}
+				operatorRun := scheduler.OperatorRun{
+					ID:           uuid.New(),
+					Name:         "task_bq2bq",
+					JobRunID:     jobRun.ID,
+					OperatorType: scheduler.OperatorTask,
+					Status:       scheduler.StateSuccess,
+					StartTime:    endTime.Add(-time.Minute),
+					EndTime:      &endTime,
+				}
+
+				jobRunRepo := new(mockJobRunRepository)
+				jobRunRepo.On("GetByScheduledAt", ctx, tnnt, jobName, scheduledAtTimeStamp).Return(&jobRun, nil)
+				jobRunRepo.On("AddEvent", ctx, jobRun.ID, event).Return(true, nil)
+				defer jobRunRepo.AssertExpectations(t)
+
+				operatorRunRepository := new(mockOperatorRunRepository)
+				operatorRunRepository.On("GetOperatorRun", ctx, event.OperatorName, scheduler.OperatorTask, jobRun.ID).Return(&operatorRun, nil)
+				defer operatorRunRepository.AssertExpectations(t)
+
+				runService := service.NewJobRunService(logger,
+					nil, jobRunRepo, nil, operatorRunRepository, nil, nil, nil, nil, nil, nil, nil)
+
+				err := runService.UpdateJobState(ctx, event)
+				assert.Nil(t, err)
+			})
+			t.Run("should move finished job run back to in progress for the operator started after its end", func(t *testing.T) {
+				eventTime := endTime.Add(time.Hour)
+				event := &scheduler.Event{
+					JobName:        jobName,
+					Tenant:         tnnt,
+					Type:           scheduler.TaskStartEvent,
+					EventTime:      eventTime,
+					Status:         scheduler.StateRunning,
+					OperatorName:   "task_bq2bq",
+					Attempt:        2,
+					JobScheduledAt: scheduledAtTimeStamp,
+					Values:         map[string]any{},
+				}
+				jobRun := scheduler.JobRun{
+					ID:      uuid.New(),
+					JobName: jobName,
+					Tenant:  tnnt,
+					State:   scheduler.StateFailed,
+					EndTime: &endTime,
+				}
+				operatorRun := scheduler.OperatorRun{
+					ID:           uuid.New(),
+					Name:         "task_bq2bq",
+					JobRunID:     jobRun.ID,
+					OperatorType: scheduler.OperatorTask,
+					Status:       scheduler.StateFailed,
+					StartTime:    endTime.Add(-time.Minute),
+					EndTime:      &endTime,
+				}
+
+				jobRunRepo := new(mockJobRunRepository)
+				jobRunRepo.On("GetByScheduledAt", ctx, tnnt, jobName, scheduledAtTimeStamp).Return(&jobRun, nil)
+				jobRunRepo.On("AddEvent", ctx, jobRun.ID, event).Return(true, nil)
+				jobRunRepo.On("UpdateState", ctx, jobRun.ID, scheduler.StateInProgress).Return(nil)
+				defer jobRunRepo.AssertExpectations(t)
+
+				operatorRunRepository := new(mockOperatorRunRepository)
+				operatorRunRepository.On("GetOperatorRun", ctx, event.OperatorName, scheduler.OperatorTask, jobRun.ID).Return(&operatorRun, nil)
+				operatorRunRepository.On("CreateOperatorRun", ctx, event.OperatorName, scheduler.OperatorTask, jobRun.ID, eventTime).Return(nil)
+				defer operatorRunRepository.AssertExpectations(t)
+
+				eventHandler := newEventHandler(t)
+				eventHandler.On("HandleEvent", mock.Anything).Times(1)
+				defer eventHandler.AssertExpectations(t)
+
+				runService := service.NewJobRunService(logger,
+					nil, jobRunRepo, nil, operatorRunRepository, nil, nil, nil, eventHandler, nil, nil, nil)
+
+				err := runService.UpdateJobState(ctx, event)
+				assert.Nil(t, err)
+			})
+			t.Run("should not update finished operator run with the event older than its end", func(t *testing.T) {
+				event := &scheduler.Event{
+					JobName:        jobName,
+					Tenant:         tnnt,
+					Type:           scheduler.TaskRetryEvent,
+					EventTime:      endTime.Add(-time.Second),
+					Status:         scheduler.StateRetry,
+					OperatorName:   "task_bq2bq",
+					Attempt:        1,
+					JobScheduledAt: scheduledAtTimeStamp,
+					Values:         map[string]any{},
+				}
+				jobRun := scheduler.JobRun{
+					ID:      uuid.New(),
+					JobName: jobName,
+					Tenant:  tnnt,
+					State:   scheduler.StateInProgress,
+				}
+				operatorRun := scheduler.OperatorRun{
+					ID:           uuid.New(),
+					Name:         "task_bq2bq",
+					JobRunID:     jobRun.ID,
+					OperatorType: scheduler.OperatorTask,
+					Status:       scheduler.StateSuccess,
+					StartTime:    endTime.Add(-time.Minute),
+					EndTime:      &endTime,
+				}
+
+				jobRunRepo := new(mockJobRunRepository)
+				jobRunRepo.On("GetByScheduledAt", ctx, tnnt, jobName, scheduledAtTimeStamp).Return(&jobRun, nil)
+				jobRunRepo.On("AddEvent", ctx, jobRun.ID, event).Return(true, nil)
+				defer jobRunRepo.AssertExpectations(t)
+
+				operatorRunRepository := new(mockOperatorRunRepository)
+				operatorRunRepository.On("GetOperatorRun", ctx, event.OperatorName, scheduler.OperatorTask, jobRun.ID).Return(&operatorRun, nil)
+				defer operatorRunRepository.AssertExpectations(t)
+
+				runService := service.NewJobRunService(logger,
+					nil, jobRunRepo, nil, operatorRunRepository, nil, nil, nil, nil, nil, nil, nil)
+
+				err := runService.UpdateJobState(ctx, event)
+				assert.Nil(t, err)
+			})
+		})
+
 		t.Run("updateJobRunSLA", func(t *testing.T) {
 			t.Run("scenario false sla notification", func(t *testing.T) {
 				scheduledAtTimeStamp, _ := time.Parse(scheduler.ISODateFormat, "2022-01-02T15:04:05Z")
@@ -696,7 +1057,7 @@ func TestJobRunService(t *testing.T) {
 				defer jobRunRepo.AssertExpectations(t)
 
 				runService := service.NewJobRunService(logger,
-					nil, jobRunRepo, nil, nil, nil, nil, nil, nil, nil)
+					nil, jobRunRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 				err := runService.UpdateJobState(ctx, event)
 				assert.Nil(t, err)
@@ -719,7 +1080,7 @@ func TestJobRunService(t *testing.T) {
 			defer jobRepo.AssertExpectations(t)
 
 			runService := service.NewJobRunService(logger,
-				jobRepo, nil, nil, nil, nil, nil, nil, nil, nil)
+				jobRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 			executorInput, err := runService.JobRunInput(ctx, projName, jobName, scheduler.RunConfig{})
 			assert.Nil(t, executorInput)
 			assert.NotNil(t, err)
@@ -779,12 +1140,58 @@ func TestJobRunService(t *testing.T) {
 			defer jobInputCompiler.AssertExpectations(t)
 
 			runService := service.NewJobRunService(logger,
-				jobRepo, jobRunRepo, jobReplayRepo, nil, nil, nil, jobInputCompiler, nil, nil)
+				jobRepo, jobRunRepo, jobReplayRepo, nil, nil, nil, jobInputCompiler, nil, nil, nil, nil)
 			executorInput, err := runService.JobRunInput(ctx, projName, jobName, runConfig)
 
 			assert.Equal(t, &dummyExecutorInput, executorInput)
 			assert.Nil(t, err)
 		})
+		t.Run("should record the run of the hook as skipped when the hook is skipped", func(t *testing.T) {
+			tnnt, _ := tenant.NewTenant(projName.String(), namespaceName.String())
+			job := scheduler.Job{
+				Name:   jobName,
+				Tenant: tnnt,
+				Task:   &scheduler.Task{Config: map[string]string{}},
+			}
+			details := scheduler.JobWithDetails{Job: &job}
+
+			someScheduleTime := todayDate.Add(time.Hour * 24 * -1)
+			runConfig := scheduler.RunConfig{
+				Executor:    scheduler.Executor{Name: "predator", Type: scheduler.ExecutorHook},
+				ScheduledAt: someScheduleTime,
+			}
+
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetJobDetails", ctx, projName, jobName).Return(&details, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			jobRun := scheduler.JobRun{ID: uuid.New(), JobName: jobName, Tenant: tnnt, StartTime: someScheduleTime}
+			jobRunRepo := new(mockJobRunRepository)
+			jobRunRepo.On("GetByScheduledAt", ctx, tnnt, jobName, someScheduleTime).Return(&jobRun, nil)
+			defer jobRunRepo.AssertExpectations(t)
+
+			jobReplayRepo := new(ReplayRepository)
+			jobReplayRepo.On("GetReplayJobConfig", ctx, tnnt, jobName, someScheduleTime).Return(map[string]string{}, nil)
+			defer jobReplayRepo.AssertExpectations(t)
+
+			skippedInput := scheduler.ExecutorInput{Configs: scheduler.ConfigMap{"HOOK_SKIPPED": "true"}, Skipped: true}
+			jobInputCompiler := new(mockJobInputCompiler)
+			jobInputCompiler.On("Compile", ctx, &details, runConfig, someScheduleTime).Return(&skippedInput, nil)
+			defer jobInputCompiler.AssertExpectations(t)
+
+			hookRun := scheduler.OperatorRun{ID: uuid.New(), Name: "hook_predator", Status: scheduler.StateRunning}
+			operatorRunRepo := new(mockOperatorRunRepository)
+			operatorRunRepo.On("GetOperatorRun", ctx, "hook_predator", scheduler.OperatorHook, jobRun.ID).Return(&hookRun, nil)
+			operatorRunRepo.On("UpdateOperatorRun", ctx, scheduler.OperatorHook, hookRun.ID, mock.Anything, scheduler.StateSkipped).Return(nil)
+			defer operatorRunRepo.AssertExpectations(t)
+
+			runService := service.NewJobRunService(logger,
+				jobRepo, jobRunRepo, jobReplayRepo, operatorRunRepo, nil, nil, jobInputCompiler, nil, nil, nil, nil)
+			executorInput, err := runService.JobRunInput(ctx, projName, jobName, runConfig)
+
+			assert.NoError(t, err)
+			assert.Equal(t, &skippedInput, executorInput)
+		})
 		t.Run("should use GetByID if job run id is given", func(t *testing.T) {
 			tnnt, _ := tenant.NewTenant(projName.String(), namespaceName.String())
 			job := scheduler.Job{
@@ -841,7 +1248,7 @@ func TestJobRunService(t *testing.T) {
 			defer jobInputCompiler.AssertExpectations(t)
 
 			runService := service.NewJobRunService(logger,
-				jobRepo, jobRunRepo, jobReplayRepo, nil, nil, nil, jobInputCompiler, nil, nil)
+				jobRepo, jobRunRepo, jobReplayRepo, nil, nil, nil, jobInputCompiler, nil, nil, nil, nil)
 			executorInput, err := runService.JobRunInput(ctx, projName, jobName, runConfig)
 
 			assert.Equal(t, &dummyExecutorInput, executorInput)
@@ -896,7 +1303,7 @@ func TestJobRunService(t *testing.T) {
 			defer jobInputCompiler.AssertExpectations(t)
 
 			runService := service.NewJobRunService(logger,
-				jobRepo, jobRunRepo, jobReplayRepo, nil, nil, nil, jobInputCompiler, nil, nil)
+				jobRepo, jobRunRepo, jobReplayRepo, nil, nil, nil, jobInputCompiler, nil, nil, nil, nil)
 			executorInput, err := runService.JobRunInput(ctx, projName, jobName, runConfig)
 
 			assert.Equal(t, &dummyExecutorInput, executorInput)
@@ -950,7 +1357,7 @@ func TestJobRunService(t *testing.T) {
 			defer jobInputCompiler.AssertExpectations(t)
 
 			runService := service.NewJobRunService(logger,
-				jobRepo, jobRunRepo, jobReplayRepo, nil, nil, nil, jobInputCompiler, nil, nil)
+				jobRepo, jobRunRepo, jobReplayRepo, nil, nil, nil, jobInputCompiler, nil, nil, nil, nil)
 			executorInput, err := runService.JobRunInput(ctx, projName, jobName, runConfig)
 
 			assert.Nil(t, err)
@@ -958,6 +1365,83 @@ func TestJobRunService(t *testing.T) {
 		})
 	})
 
+	t.Run("CompileExecutorInputAt", func(t *testing.T) {
+		tnnt, _ := tenant.NewTenant(projName.String(), namespaceName.String())
+		someScheduleTime := todayDate.Add(time.Hour * 24 * -1)
+		runConfig := scheduler.RunConfig{
+			Executor:    scheduler.Executor{Name: "bq2bq", Type: scheduler.ExecutorTask},
+			ScheduledAt: someScheduleTime,
+		}
+
+		t.Run("should return error if getJob fails", func(t *testing.T) {
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetJobDetails", ctx, projName, jobName).Return(&scheduler.JobWithDetails{}, fmt.Errorf("some error"))
+			defer jobRepo.AssertExpectations(t)
+
+			runService := service.NewJobRunService(logger,
+				jobRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			executorInput, err := runService.CompileExecutorInputAt(ctx, projName, jobName, runConfig, todayDate)
+			assert.Nil(t, executorInput)
+			assert.EqualError(t, err, "some error")
+		})
+		t.Run("should compile at the given executed at without looking up the job run", func(t *testing.T) {
+			job := scheduler.Job{
+				Name:   jobName,
+				Tenant: tnnt,
+				Task:   &scheduler.Task{Config: map[string]string{}},
+			}
+			details := scheduler.JobWithDetails{Job: &job}
+			executedAt := todayDate.Add(time.Hour * 22 * -1)
+
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetJobDetails", ctx, projName, jobName).Return(&details, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			jobReplayRepo := new(ReplayRepository)
+			jobReplayRepo.On("GetReplayJobConfig", ctx, tnnt, jobName, someScheduleTime).Return(map[string]string{}, nil)
+			defer jobReplayRepo.AssertExpectations(t)
+
+			dummyExecutorInput := scheduler.ExecutorInput{Configs: scheduler.ConfigMap{"someKey": "someValue"}}
+			jobInputCompiler := new(mockJobInputCompiler)
+			jobInputCompiler.On("Compile", ctx, &details, runConfig, executedAt).Return(&dummyExecutorInput, nil)
+			defer jobInputCompiler.AssertExpectations(t)
+
+			runService := service.NewJobRunService(logger,
+				jobRepo, nil, jobReplayRepo, nil, nil, nil, jobInputCompiler, nil, nil, nil, nil)
+			executorInput, err := runService.CompileExecutorInputAt(ctx, projName, jobName, runConfig, executedAt)
+
+			assert.Nil(t, err)
+			assert.Equal(t, &dummyExecutorInput, executorInput)
+		})
+		t.Run("should compile at the scheduled at when executed at is not given", func(t *testing.T) {
+			job := scheduler.Job{
+				Name:   jobName,
+				Tenant: tnnt,
+				Task:   &scheduler.Task{Config: map[string]string{}},
+			}
+			details := scheduler.JobWithDetails{Job: &job}
+
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetJobDetails", ctx, projName, jobName).Return(&details, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			jobReplayRepo := new(ReplayRepository)
+			jobReplayRepo.On("GetReplayJobConfig", ctx, tnnt, jobName, someScheduleTime).Return(map[string]string{}, nil)
+			defer jobReplayRepo.AssertExpectations(t)
+
+			dummyExecutorInput := scheduler.ExecutorInput{Configs: scheduler.ConfigMap{"someKey": "someValue"}}
+			jobInputCompiler := new(mockJobInputCompiler)
+			jobInputCompiler.On("Compile", ctx, &details, runConfig, someScheduleTime).Return(&dummyExecutorInput, nil)
+			defer jobInputCompiler.AssertExpectations(t)
+
+			runService := service.NewJobRunService(logger,
+				jobRepo, nil, jobReplayRepo, nil, nil, nil, jobInputCompiler, nil, nil, nil, nil)
+			executorInput, err := runService.CompileExecutorInputAt(ctx, projName, jobName, runConfig, time.Time{})
+
+			assert.Nil(t, err)
+			assert.Equal(t, &dummyExecutorInput, executorInput)
+		})
+	})
 	t.Run("GetJobRunList", func(t *testing.T) {
 		startDate, err := time.Parse(time.RFC3339, "2022-03-20T02:00:00+00:00")
 		if err != nil {
@@ -984,7 +1468,7 @@ func TestJobRunService(t *testing.T) {
 			defer jobRepo.AssertExpectations(t)
 
 			runService := service.NewJobRunService(logger,
-				jobRepo, nil, nil, nil, nil, nil, nil, nil, nil)
+				jobRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 			returnedRuns, err := runService.GetJobRuns(ctx, projName, jobName, criteria)
 			assert.Error(t, err)
 			assert.ErrorContains(t, err, "unable to get job details for jobName: sample_select, project:proj")
@@ -1022,7 +1506,7 @@ func TestJobRunService(t *testing.T) {
 			defer jobRepo.AssertExpectations(t)
 
 			runService := service.NewJobRunService(logger,
-				jobRepo, nil, nil, nil, sch, nil, nil, nil, nil)
+				jobRepo, nil, nil, nil, sch, nil, nil, nil, nil, nil, nil)
 			returnedRuns, err := runService.GetJobRuns(ctx, projName, jobName, criteria)
 			assert.Nil(t, err)
 			assert.Nil(t, returnedRuns)
@@ -1157,7 +1641,7 @@ func TestJobRunService(t *testing.T) {
 					jobRepo.On("GetJobDetails", ctx, projName, jobName).Return(&jobWithDetails, nil)
 					defer jobRepo.AssertExpectations(t)
 					runService := service.NewJobRunService(logger,
-						jobRepo, nil, nil, nil, sch, nil, nil, nil, nil)
+						jobRepo, nil, nil, nil, sch, nil, nil, nil, nil, nil, nil)
 					returnedRuns, err := runService.GetJobRuns(ctx, projName, jobName, scenario.input)
 					assert.Nil(t, err)
 					assert.Equal(t, scenario.expectedResult, returnedRuns)
@@ -1193,7 +1677,7 @@ func TestJobRunService(t *testing.T) {
 			jobRepo.On("GetJobDetails", ctx, projName, jobName).Return(&jobWithDetails, nil)
 			defer jobRepo.AssertExpectations(t)
 
-			runService := service.NewJobRunService(logger, jobRepo, nil, nil, nil, nil, nil, nil, nil, nil)
+			runService := service.NewJobRunService(logger, jobRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 			returnedRuns, err := runService.GetJobRuns(ctx, projName, jobName, jobQuery)
 			assert.Error(t, err)
 			assert.ErrorContains(t, err, "invalid date range, interval contains dates before job start")
@@ -1228,7 +1712,7 @@ func TestJobRunService(t *testing.T) {
 			jobRepo.On("GetJobDetails", ctx, projName, jobName).Return(&jobWithDetails, nil)
 			defer jobRepo.AssertExpectations(t)
 
-			runService := service.NewJobRunService(logger, jobRepo, nil, nil, nil, nil, nil, nil, nil, nil)
+			runService := service.NewJobRunService(logger, jobRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 			returnedRuns, err := runService.GetJobRuns(ctx, projName, jobName, jobQuery)
 			assert.Error(t, err)
 			assert.ErrorContains(t, err, "unable to parse job cron interval: expected exactly 5 fields, found 2: [invalid interval]")
@@ -1262,7 +1746,7 @@ func TestJobRunService(t *testing.T) {
 			jobRepo.On("GetJobDetails", ctx, projName, jobName).Return(&jobWithDetails, nil)
 			defer jobRepo.AssertExpectations(t)
 
-			runService := service.NewJobRunService(logger, jobRepo, nil, nil, nil, nil, nil, nil, nil, nil)
+			runService := service.NewJobRunService(logger, jobRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 			returnedRuns, err := runService.GetJobRuns(ctx, projName, jobName, jobQuery)
 			assert.Error(t, err)
 			assert.ErrorContains(t, err, "cannot get job runs, job interval is empty")
@@ -1294,7 +1778,7 @@ func TestJobRunService(t *testing.T) {
 			jobRepo := new(JobRepository)
 			jobRepo.On("GetJobDetails", ctx, projName, jobName).Return(&jobWithDetails, nil)
 			defer jobRepo.AssertExpectations(t)
-			runService := service.NewJobRunService(logger, jobRepo, nil, nil, nil, nil, nil, nil, nil, nil)
+			runService := service.NewJobRunService(logger, jobRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 			returnedRuns, err := runService.GetJobRuns(ctx, projName, jobName, jobQuery)
 			assert.Error(t, err)
 			assert.ErrorContains(t, err, "job schedule startDate not found in job")
@@ -1337,13 +1821,76 @@ func TestJobRunService(t *testing.T) {
 			jobRepo.On("GetJobDetails", ctx, projName, jobName).Return(&jobWithDetails, nil)
 			defer jobRepo.AssertExpectations(t)
 
-			runService := service.NewJobRunService(logger, jobRepo, nil, nil, nil, sch, nil, nil, nil, nil)
+			runService := service.NewJobRunService(logger, jobRepo, nil, nil, nil, sch, nil, nil, nil, nil, nil, nil)
 			returnedRuns, err := runService.GetJobRuns(ctx, projName, jobName, criteria)
 			assert.Nil(t, err)
 			assert.Equal(t, runs, returnedRuns)
 		})
 	})
 
+	t.Run("GetSchedulerHealth", func(t *testing.T) {
+		tnnt, _ := tenant.NewTenant(projName.String(), namespaceName.String())
+
+		t.Run("returns error if scheduler is unable to get environment health", func(t *testing.T) {
+			sch := new(mockScheduler)
+			defer sch.AssertExpectations(t)
+
+			sch.On("GetEnvironmentHealth", ctx, tnnt).Return(nil, errors.InternalError("Airflow", "unreachable", nil))
+
+			runService := service.NewJobRunService(logger, nil, nil, nil, nil, sch, nil, nil, nil, nil, nil, nil)
+			health, err := runService.GetSchedulerHealth(ctx, tnnt)
+
+			assert.Nil(t, health)
+			assert.ErrorContains(t, err, "unreachable")
+		})
+		t.Run("returns environment health from scheduler", func(t *testing.T) {
+			sch := new(mockScheduler)
+			defer sch.AssertExpectations(t)
+
+			expectedHealth := &scheduler.EnvironmentHealth{
+				Type:                "composer",
+				MetadatabaseHealthy: true,
+				SchedulerHealthy:    true,
+			}
+			sch.On("GetEnvironmentHealth", ctx, tnnt).Return(expectedHealth, nil)
+
+			runService := service.NewJobRunService(logger, nil, nil, nil, nil, sch, nil, nil, nil, nil, nil, nil)
+			health, err := runService.GetSchedulerHealth(ctx, tnnt)
+
+			assert.NoError(t, err)
+			assert.Equal(t, expectedHealth, health)
+			assert.True(t, health.IsHealthy())
+		})
+	})
+
+	t.Run("Heartbeat", func(t *testing.T) {
+		tnnt, _ := tenant.NewTenant(projName.String(), namespaceName.String())
+
+		t.Run("returns error if job run is not found", func(t *testing.T) {
+			jobRunRepo := new(mockJobRunRepository)
+			defer jobRunRepo.AssertExpectations(t)
+
+			jobRunRepo.On("GetByScheduledAt", ctx, tnnt, jobName, scheduledAtTimeStamp).
+				Return(nil, errors.NotFound(scheduler.EntityJobRun, "no record for job run"))
+
+			runService := service.NewJobRunService(logger, nil, jobRunRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			err := runService.Heartbeat(ctx, tnnt, jobName, scheduledAtTimeStamp)
+			assert.True(t, errors.IsErrorType(err, errors.ErrNotFound))
+		})
+		t.Run("records heartbeat of the job run", func(t *testing.T) {
+			jobRun := &scheduler.JobRun{ID: uuid.New(), JobName: jobName, Tenant: tnnt, ScheduledAt: scheduledAtTimeStamp}
+			jobRunRepo := new(mockJobRunRepository)
+			defer jobRunRepo.AssertExpectations(t)
+
+			jobRunRepo.On("GetByScheduledAt", ctx, tnnt, jobName, scheduledAtTimeStamp).Return(jobRun, nil)
+			jobRunRepo.On("UpdateHeartbeat", ctx, jobRun.ID, mock.AnythingOfType("time.Time")).Return(nil)
+
+			runService := service.NewJobRunService(logger, nil, jobRunRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			err := runService.Heartbeat(ctx, tnnt, jobName, scheduledAtTimeStamp)
+			assert.NoError(t, err)
+		})
+	})
+
 	t.Run("GetInterval", func(t *testing.T) {
 		referenceTime := time.Now()
 
@@ -1371,7 +1918,7 @@ func TestJobRunService(t *testing.T) {
 
 			projectGetter.On("GetByName", ctx, projName).Return(nil, errors.NewError(errors.ErrInternalError, tenant.EntityProject, "unexpected error"))
 
-			service := service.NewJobRunService(logger, nil, nil, nil, nil, nil, nil, nil, nil, projectGetter)
+			service := service.NewJobRunService(logger, nil, nil, nil, nil, nil, nil, nil, nil, projectGetter, nil, nil)
 
 			actualInterval, actualError := service.GetInterval(ctx, projName, jobName, referenceTime)
 
@@ -1390,7 +1937,7 @@ func TestJobRunService(t *testing.T) {
 
 			jobRepo.On("GetJobDetails", ctx, projName, jobName).Return(nil, errors.NewError(errors.ErrInternalError, job.EntityJob, "unexpected error"))
 
-			service := service.NewJobRunService(logger, jobRepo, nil, nil, nil, nil, nil, nil, nil, projectGetter)
+			service := service.NewJobRunService(logger, jobRepo, nil, nil, nil, nil, nil, nil, nil, projectGetter, nil, nil)
 
 			actualInterval, actualError := service.GetInterval(ctx, projName, jobName, referenceTime)
 
@@ -1423,7 +1970,7 @@ func TestJobRunService(t *testing.T) {
 
 			jobRepo.On("GetJobDetails", ctx, projName, jobName).Return(job, nil)
 
-			service := service.NewJobRunService(logger, jobRepo, nil, nil, nil, nil, nil, nil, nil, projectGetter)
+			service := service.NewJobRunService(logger, jobRepo, nil, nil, nil, nil, nil, nil, nil, projectGetter, nil, nil)
 
 			actualInterval, actualError := service.GetInterval(ctx, projName, jobName, referenceTime)
 
@@ -1462,6 +2009,14 @@ func (m *mockJobInputCompiler) Compile(ctx context.Context, job *scheduler.JobWi
 	return args.Get(0).(*scheduler.ExecutorInput), args.Error(1)
 }
 
+func (m *mockJobInputCompiler) EnabledHooks(ctx context.Context, job *scheduler.Job) ([]*scheduler.Hook, error) {
+	args := m.Called(ctx, job)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*scheduler.Hook), args.Error(1)
+}
+
 type mockJobRunRepository struct {
 	mock.Mock
 }
@@ -1482,6 +2037,14 @@ func (m *mockJobRunRepository) GetByScheduledAt(ctx context.Context, tenant tena
 	return args.Get(0).(*scheduler.JobRun), args.Error(1)
 }
 
+func (m *mockJobRunRepository) Query(ctx context.Context, query *scheduler.JobRunQuery) ([]*scheduler.JobRun, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*scheduler.JobRun), args.Error(1)
+}
+
 func (m *mockJobRunRepository) Create(ctx context.Context, tenant tenant.Tenant, name scheduler.JobName, scheduledAt time.Time, slaDefinitionInSec int64) error {
 	args := m.Called(ctx, tenant, name, scheduledAt, slaDefinitionInSec)
 	return args.Error(0)
@@ -1512,6 +2075,34 @@ func (m *mockJobRunRepository) UpdateMonitoring(ctx context.Context, jobRunID uu
 	return args.Error(0)
 }
 
+func (m *mockJobRunRepository) UpdateArtifacts(ctx context.Context, jobRunID uuid.UUID, artifacts map[string]any) error {
+	args := m.Called(ctx, jobRunID, artifacts)
+	return args.Error(0)
+}
+
+func (m *mockJobRunRepository) UpdateHeartbeat(ctx context.Context, jobRunID uuid.UUID, heartbeatAt time.Time) error {
+	args := m.Called(ctx, jobRunID, heartbeatAt)
+	return args.Error(0)
+}
+
+func (m *mockJobRunRepository) AddEvent(ctx context.Context, jobRunID uuid.UUID, event *scheduler.Event) (bool, error) {
+	args := m.Called(ctx, jobRunID, event)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *mockJobRunRepository) SaveTrigger(ctx context.Context, trigger *scheduler.JobRunTrigger) error {
+	args := m.Called(ctx, trigger)
+	return args.Error(0)
+}
+
+func (m *mockJobRunRepository) GetConfigOverrides(ctx context.Context, t tenant.Tenant, jobName scheduler.JobName, scheduledAt time.Time) (map[string]string, error) {
+	args := m.Called(ctx, t, jobName, scheduledAt)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]string), args.Error(1)
+}
+
 type JobRepository struct {
 	mock.Mock
 }
@@ -1580,6 +2171,27 @@ func (ms *mockScheduler) UpdateJobState(ctx context.Context, tnnt tenant.Tenant,
 	return args.Error(0)
 }
 
+func (ms *mockScheduler) GetEnvironmentHealth(ctx context.Context, tnnt tenant.Tenant) (*scheduler.EnvironmentHealth, error) {
+	args := ms.Called(ctx, tnnt)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*scheduler.EnvironmentHealth), args.Error(1)
+}
+
+func (ms *mockScheduler) GetPoolSlots(ctx context.Context, tnnt tenant.Tenant, pool string) (*scheduler.PoolSlots, error) {
+	args := ms.Called(ctx, tnnt, pool)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*scheduler.PoolSlots), args.Error(1)
+}
+
+func (ms *mockScheduler) CreateRun(ctx context.Context, tnnt tenant.Tenant, jobName scheduler.JobName, executionTime time.Time, dagRunIDPrefix string) error {
+	args := ms.Called(ctx, tnnt, jobName, executionTime, dagRunIDPrefix)
+	return args.Error(0)
+}
+
 type mockOperatorRunRepository struct {
 	mock.Mock
 }
@@ -1602,6 +2214,14 @@ func (m *mockOperatorRunRepository) UpdateOperatorRun(ctx context.Context, opera
 	return args.Error(0)
 }
 
+func (m *mockOperatorRunRepository) GetOperatorRuns(ctx context.Context, jobRunID uuid.UUID) ([]*scheduler.OperatorRun, error) {
+	args := m.Called(ctx, jobRunID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*scheduler.OperatorRun), args.Error(1)
+}
+
 type mockEventHandler struct {
 	mock.Mock
 }
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/internal/errors"
+)
+
+// TriggerJobRun creates a manual run of the job in the scheduler for the time in trigger, the config overrides
+// are stored first for the executor input of the run to pick them up
+func (s *JobRunService) TriggerJobRun(ctx context.Context, trigger *scheduler.JobRunTrigger) error {
+	details, err := s.jobRepo.GetJobDetails(ctx, trigger.Tenant.ProjectName(), trigger.JobName)
+	if err != nil {
+		s.l.Error("error getting job [%s]: %s", trigger.JobName, err)
+		return err
+	}
+	if details.Job.Tenant.NamespaceName() != trigger.Tenant.NamespaceName() {
+		msg := fmt.Sprintf("job %s is not in namespace %s", trigger.JobName, trigger.Tenant.NamespaceName())
+		return errors.InvalidArgument(scheduler.EntityJobRun, msg)
+	}
+	l := s.jobLogger(trigger.Tenant, trigger.JobName)
+
+	if err := s.repo.SaveTrigger(ctx, trigger); err != nil {
+		l.Error("error saving trigger of job run scheduled at [%s]: %s", trigger.ScheduledAt, err)
+		return err
+	}
+
+	if err := s.scheduler.CreateRun(ctx, trigger.Tenant, trigger.JobName, trigger.ScheduledAt, scheduler.RunTypeManual.String()); err != nil {
+		l.Error("error creating manual run scheduled at [%s]: %s", trigger.ScheduledAt, err)
+		return err
+	}
+	l.Info("triggered manual run scheduled at [%s] with %d config overrides", trigger.ScheduledAt, len(trigger.ConfigOverrides))
+	return nil
+}
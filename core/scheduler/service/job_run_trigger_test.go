@@ -0,0 +1,117 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/scheduler/service"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+)
+
+func TestJobRunServiceTriggerJobRun(t *testing.T) {
+	ctx := context.Background()
+	logger := log.NewNoop()
+	projName := tenant.ProjectName("proj")
+	jobName := scheduler.JobName("sample_select")
+	tnnt, _ := tenant.NewTenant(projName.String(), "ns1")
+	scheduledAt := time.Date(2023, 10, 10, 10, 0, 0, 0, time.UTC)
+
+	jobWithDetails := &scheduler.JobWithDetails{
+		Name: jobName,
+		Job: &scheduler.Job{
+			Name:   jobName,
+			Tenant: tnnt,
+			Task:   &scheduler.Task{Name: "bq2bq", Config: map[string]string{}},
+		},
+	}
+
+	t.Run("TriggerJobRun", func(t *testing.T) {
+		t.Run("returns error when the job is not in the namespace of the trigger", func(t *testing.T) {
+			jobRepo := new(JobRepository)
+			defer jobRepo.AssertExpectations(t)
+
+			otherTnnt, _ := tenant.NewTenant(projName.String(), "ns2")
+			trigger, _ := scheduler.NewJobRunTrigger(otherTnnt, jobName, scheduledAt, nil)
+			jobRepo.On("GetJobDetails", ctx, projName, jobName).Return(jobWithDetails, nil)
+
+			runService := service.NewJobRunService(logger, jobRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			err := runService.TriggerJobRun(ctx, trigger)
+
+			assert.True(t, errors.IsErrorType(err, errors.ErrInvalidArgument))
+			assert.ErrorContains(t, err, "job sample_select is not in namespace ns2")
+		})
+		t.Run("returns error when the run cannot be created in the scheduler", func(t *testing.T) {
+			jobRepo := new(JobRepository)
+			defer jobRepo.AssertExpectations(t)
+			jobRunRepo := new(mockJobRunRepository)
+			defer jobRunRepo.AssertExpectations(t)
+			sch := new(mockScheduler)
+			defer sch.AssertExpectations(t)
+
+			trigger, _ := scheduler.NewJobRunTrigger(tnnt, jobName, scheduledAt, map[string]string{"LOAD_METHOD": "REPLACE"})
+			jobRepo.On("GetJobDetails", ctx, projName, jobName).Return(jobWithDetails, nil)
+			jobRunRepo.On("SaveTrigger", ctx, trigger).Return(nil)
+			sch.On("CreateRun", ctx, tnnt, jobName, scheduledAt, "manual").Return(errors.InternalError("Airflow", "dag run already exists", nil))
+
+			runService := service.NewJobRunService(logger, jobRepo, jobRunRepo, nil, nil, sch, nil, nil, nil, nil, nil, nil)
+			err := runService.TriggerJobRun(ctx, trigger)
+
+			assert.ErrorContains(t, err, "dag run already exists")
+		})
+		t.Run("stores the config overrides and creates a manual run", func(t *testing.T) {
+			jobRepo := new(JobRepository)
+			defer jobRepo.AssertExpectations(t)
+			jobRunRepo := new(mockJobRunRepository)
+			defer jobRunRepo.AssertExpectations(t)
+			sch := new(mockScheduler)
+			defer sch.AssertExpectations(t)
+
+			trigger, _ := scheduler.NewJobRunTrigger(tnnt, jobName, scheduledAt, map[string]string{"LOAD_METHOD": "REPLACE"})
+			jobRepo.On("GetJobDetails", ctx, projName, jobName).Return(jobWithDetails, nil)
+			jobRunRepo.On("SaveTrigger", ctx, trigger).Return(nil)
+			sch.On("CreateRun", ctx, tnnt, jobName, scheduledAt, "manual").Return(nil)
+
+			runService := service.NewJobRunService(logger, jobRepo, jobRunRepo, nil, nil, sch, nil, nil, nil, nil, nil, nil)
+			err := runService.TriggerJobRun(ctx, trigger)
+
+			assert.NoError(t, err)
+		})
+	})
+	t.Run("CompileExecutorInputAt", func(t *testing.T) {
+		t.Run("passes the config overrides of the manual run to the compiler", func(t *testing.T) {
+			jobRepo := new(JobRepository)
+			defer jobRepo.AssertExpectations(t)
+			jobRunRepo := new(mockJobRunRepository)
+			defer jobRunRepo.AssertExpectations(t)
+			replayRepo := new(ReplayRepository)
+			defer replayRepo.AssertExpectations(t)
+			inputCompiler := new(mockJobInputCompiler)
+			defer inputCompiler.AssertExpectations(t)
+
+			overrides := map[string]string{"LOAD_METHOD": "REPLACE"}
+			runConfig := scheduler.RunConfig{
+				Executor:       scheduler.Executor{Name: "bq2bq", Type: scheduler.ExecutorTask},
+				ScheduledAt:    scheduledAt,
+				SchedulerRunID: "manual__2023-10-10T10:00:00+00:00",
+			}
+			executorInput := &scheduler.ExecutorInput{Configs: scheduler.ConfigMap{"LOAD_METHOD": "REPLACE"}}
+
+			jobRepo.On("GetJobDetails", ctx, projName, jobName).Return(jobWithDetails, nil)
+			replayRepo.On("GetReplayJobConfig", ctx, tnnt, jobName, scheduledAt).Return(map[string]string{}, nil)
+			jobRunRepo.On("GetConfigOverrides", ctx, tnnt, jobName, scheduledAt).Return(overrides, nil)
+			inputCompiler.On("Compile", ctx, jobWithDetails, runConfig.WithConfigOverrides(overrides), scheduledAt).Return(executorInput, nil)
+
+			runService := service.NewJobRunService(logger, jobRepo, jobRunRepo, replayRepo, nil, nil, nil, inputCompiler, nil, nil, nil, nil)
+			input, err := runService.CompileExecutorInputAt(ctx, projName, jobName, runConfig, time.Time{})
+
+			assert.NoError(t, err)
+			assert.Equal(t, executorInput, input)
+		})
+	})
+}
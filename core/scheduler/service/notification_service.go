@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"time"
 
 	"github.com/goto/salt/log"
 
@@ -17,6 +18,7 @@ import (
 const (
 	NotificationSchemeSlack     = "slack"
 	NotificationSchemePagerDuty = "pagerduty"
+	NotificationSchemeWebhook   = "webhook"
 )
 
 type Notifier interface {
@@ -24,27 +26,57 @@ type Notifier interface {
 	Notify(ctx context.Context, attr scheduler.NotifyAttrs) error
 }
 
+type AlertSilencer interface {
+	GetSilence(ctx context.Context, job *scheduler.JobWithDetails) (*scheduler.AlertSilence, error)
+}
+
+type WebhookDispatcher interface {
+	Dispatch(ctx context.Context, event *scheduler.Event)
+}
+
 type NotifyService struct {
 	notifyChannels map[string]Notifier
 	jobRepo        JobRepository
 	tenantService  TenantService
+	silencer       AlertSilencer
+	dispatcher     WebhookDispatcher
 	l              log.Logger
 }
 
 func (n *NotifyService) Push(ctx context.Context, event *scheduler.Event) error {
+	// webhook subscriptions are integrations rather than alerts, they get the events of silenced jobs too
+	if n.dispatcher != nil {
+		n.dispatcher.Dispatch(ctx, event)
+	}
+
 	jobDetails, err := n.jobRepo.GetJobDetails(ctx, event.Tenant.ProjectName(), event.JobName)
 	if err != nil {
 		n.l.Error("error getting detail for job [%s]: %s", event.JobName, err)
 		return err
 	}
-	notificationConfig := jobDetails.Alerts
+	if n.isSilenced(ctx, jobDetails, event) {
+		return nil
+	}
+
+	notificationConfig := append(append([]scheduler.Alert{}, jobDetails.Alerts...), n.namespaceAlerts(ctx, event)...)
 	multierror := errors.NewMultiError("ErrorsInNotifypush")
 	var secretMap tenant.SecretMap
 	var plainTextSecretsList []*tenant.PlainTextSecret
+	// a channel in both the job alerts and the namespace routes is notified once
+	notifiedChannels := map[string]bool{}
 	for _, notify := range notificationConfig {
 		if event.Type.IsOfType(notify.On) {
 			for _, channel := range notify.Channels {
-				chanParts := strings.Split(channel, "://")
+				if notifiedChannels[channel] {
+					continue
+				}
+				notifiedChannels[channel] = true
+
+				chanParts := strings.SplitN(channel, "://", 2) //nolint:gomnd
+				if len(chanParts) != 2 {
+					multierror.Append(fmt.Errorf("invalid notification channel %s", channel))
+					continue
+				}
 				scheme := chanParts[0]
 				route := chanParts[1]
 
@@ -66,6 +98,8 @@ func (n *NotifyService) Push(ctx context.Context, event *scheduler.Event) error
 					secretName = tenant.SecretNotifySlack
 				case NotificationSchemePagerDuty:
 					secretName = strings.ReplaceAll(route, "#", "notify_")
+				case NotificationSchemeWebhook:
+					secretName = strings.ReplaceAll(route, "#", tenant.SecretNotifyWebhookPrefix)
 				}
 				secret, err := secretMap.Get(secretName)
 				if err != nil {
@@ -95,6 +129,86 @@ func (n *NotifyService) Push(ctx context.Context, event *scheduler.Event) error
 	return multierror.ToErr()
 }
 
+// namespaceAlerts returns the alerts routed to every job of the namespace, the routes apply to the run failure
+// and sla events only, and the alerts of the job are still sent when the routes cannot be read
+func (n *NotifyService) namespaceAlerts(ctx context.Context, event *scheduler.Event) []scheduler.Alert {
+	if !event.Type.IsOfType(scheduler.EventCategoryJobFailure) && !event.Type.IsOfType(scheduler.EventCategorySLAMiss) {
+		return nil
+	}
+	details, err := n.tenantService.GetDetails(ctx, event.Tenant)
+	if err != nil {
+		n.l.Warn("unable to get alert routes of namespace [%s], only the alerts of job [%s] are sent: %s",
+			event.Tenant.NamespaceName().String(), event.JobName, err)
+		return nil
+	}
+	return scheduler.AlertRoutesFrom(details.Namespace().GetConfigs())
+}
+
+// isSilenced checks whether the alerts of the job are muted by an active silence, the alert is still sent
+// when the silences cannot be checked, as a missed page costs more than an unwanted one
+func (n *NotifyService) isSilenced(ctx context.Context, jobDetails *scheduler.JobWithDetails, event *scheduler.Event) bool {
+	if n.silencer == nil {
+		return false
+	}
+	silence, err := n.silencer.GetSilence(ctx, jobDetails)
+	if err != nil {
+		n.l.Warn("unable to check alert silences for job [%s], alert is not silenced: %s", event.JobName, err)
+		return false
+	}
+	if silence == nil {
+		return false
+	}
+
+	n.l.Debug("alert for job [%s] on event [%s] is silenced by [%s] until %s", event.JobName, event.Type,
+		silence.ID.String(), silence.ExpiresAt.Format(time.RFC3339))
+	telemetry.NewCounter("jobrun_alerts_silenced_total", map[string]string{
+		"project":   event.Tenant.ProjectName().String(),
+		"namespace": event.Tenant.NamespaceName().String(),
+		"type":      event.Type.String(),
+	}).Inc()
+	return true
+}
+
+// NotifyUpstreamAccessRequest notifies the owner of upstream job through slack to approve or deny the access request
+func (n *NotifyService) NotifyUpstreamAccessRequest(ctx context.Context, request *scheduler.UpstreamAccessRequest) error {
+	notifyChannel, ok := n.notifyChannels[NotificationSchemeSlack]
+	if !ok {
+		return errors.InvalidArgument(scheduler.EntityUpstreamAccess, "slack notification channel is not configured")
+	}
+
+	jobDetails, err := n.jobRepo.GetJobDetails(ctx, request.UpstreamProject, request.UpstreamJob)
+	if err != nil {
+		n.l.Error("error getting detail for job [%s]: %s", request.UpstreamJob, err)
+		return err
+	}
+
+	plainTextSecretsList, err := n.tenantService.GetSecrets(ctx, jobDetails.Job.Tenant)
+	if err != nil {
+		return err
+	}
+	secret, err := tenant.PlainTextSecrets(plainTextSecretsList).ToSecretMap().Get(tenant.SecretNotifySlack)
+	if err != nil {
+		return err
+	}
+
+	return notifyChannel.Notify(ctx, scheduler.NotifyAttrs{
+		Owner: jobDetails.JobMetadata.Owner,
+		JobEvent: &scheduler.Event{
+			JobName:   request.UpstreamJob,
+			Tenant:    jobDetails.Job.Tenant,
+			Type:      scheduler.UpstreamAccessRequestEvent,
+			EventTime: time.Now(),
+			Values: map[string]any{
+				"request_id":         request.ID.String(),
+				"downstream_project": request.DownstreamProject.String(),
+				"downstream_job":     request.DownstreamJob.String(),
+			},
+		},
+		Secret: secret,
+		Route:  jobDetails.JobMetadata.Owner,
+	})
+}
+
 func (n *NotifyService) Close() error {
 	me := errors.NewMultiError("ErrorsInNotifyClose")
 	for _, notify := range n.notifyChannels {
@@ -106,11 +220,15 @@ func (n *NotifyService) Close() error {
 	return me.ToErr()
 }
 
-func NewNotifyService(l log.Logger, jobRepo JobRepository, tenantService TenantService, notifyChan map[string]Notifier) *NotifyService {
+func NewNotifyService(l log.Logger, jobRepo JobRepository, tenantService TenantService, silencer AlertSilencer,
+	dispatcher WebhookDispatcher, notifyChan map[string]Notifier,
+) *NotifyService {
 	return &NotifyService{
 		l:              l,
 		jobRepo:        jobRepo,
 		tenantService:  tenantService,
+		silencer:       silencer,
+		dispatcher:     dispatcher,
 		notifyChannels: notifyChan,
 	}
 }
@@ -25,6 +25,7 @@ func TestNotificationService(t *testing.T) {
 	})
 	namespace, _ := tenant.NewNamespace("ns1", project.Name(), map[string]string{})
 	tnnt, _ := tenant.NewTenant(project.Name().String(), namespace.Name().String())
+	tenantDetails, _ := tenant.NewTenantDetails(project, namespace, nil)
 	startDate, _ := time.Parse(time.RFC3339, "2022-03-20T02:00:00+00:00")
 	jobName := scheduler.JobName("job1")
 	t.Run("Push", func(t *testing.T) {
@@ -33,7 +34,7 @@ func TestNotificationService(t *testing.T) {
 			jobRepo.On("GetJobDetails", ctx, project.Name(), jobName).Return(nil, fmt.Errorf("some error"))
 			defer jobRepo.AssertExpectations(t)
 
-			notifyService := service.NewNotifyService(logger, jobRepo, nil, nil)
+			notifyService := service.NewNotifyService(logger, jobRepo, nil, nil, nil, nil)
 
 			event := &scheduler.Event{
 				JobName: jobName,
@@ -84,6 +85,7 @@ func TestNotificationService(t *testing.T) {
 			plainSecrets := []*tenant.PlainTextSecret{plainSecret}
 			tenantService := new(mockTenantService)
 			tenantService.On("GetSecrets", ctx, tnnt).Return(plainSecrets, nil)
+			tenantService.On("GetDetails", ctx, tnnt).Return(tenantDetails, nil)
 			defer tenantService.AssertExpectations(t)
 
 			notifyChanelSlack := new(mockNotificationChanel)
@@ -102,7 +104,7 @@ func TestNotificationService(t *testing.T) {
 				"pagerduty": notifyChanelPager,
 			}
 
-			notifyService := service.NewNotifyService(logger, jobRepo, tenantService, notifierChannels)
+			notifyService := service.NewNotifyService(logger, jobRepo, tenantService, nil, nil, notifierChannels)
 
 			err := notifyService.Push(ctx, event)
 			assert.Nil(t, err)
@@ -146,6 +148,7 @@ func TestNotificationService(t *testing.T) {
 			plainSecrets := []*tenant.PlainTextSecret{plainSecret}
 			tenantService := new(mockTenantService)
 			tenantService.On("GetSecrets", ctx, tnnt).Return(plainSecrets, nil)
+			tenantService.On("GetDetails", ctx, tnnt).Return(tenantDetails, nil)
 			defer tenantService.AssertExpectations(t)
 
 			notifChanelSlack := new(mockNotificationChanel)
@@ -164,7 +167,7 @@ func TestNotificationService(t *testing.T) {
 				"pagerduty": notifyChanelPager,
 			}
 
-			notifyService := service.NewNotifyService(logger, jobRepo, tenantService, notifierChannels)
+			notifyService := service.NewNotifyService(logger, jobRepo, tenantService, nil, nil, notifierChannels)
 
 			err := notifyService.Push(ctx, event)
 			assert.Nil(t, err)
@@ -208,6 +211,7 @@ func TestNotificationService(t *testing.T) {
 			plainSecrets := []*tenant.PlainTextSecret{plainSecret}
 			tenantService := new(mockTenantService)
 			tenantService.On("GetSecrets", ctx, tnnt).Return(plainSecrets, nil)
+			tenantService.On("GetDetails", ctx, tnnt).Return(tenantDetails, nil)
 			defer tenantService.AssertExpectations(t)
 
 			notifyChanelSlack := new(mockNotificationChanel)
@@ -226,16 +230,149 @@ func TestNotificationService(t *testing.T) {
 				"pagerduty": notifyChanelPager,
 			}
 
-			notifyService := service.NewNotifyService(logger, jobRepo, tenantService, notifierChannels)
+			notifyService := service.NewNotifyService(logger, jobRepo, tenantService, nil, nil, notifierChannels)
 
 			err := notifyService.Push(ctx, event)
 
 			assert.NotNil(t, err)
 			assert.EqualError(t, err, "ErrorsInNotifypush:\n notifyChannel.Notify: pagerduty://#chanel-name: error in pagerduty push")
 		})
+		t.Run("should send notification to the channels routed in the namespace once", func(t *testing.T) {
+			jobWithDetails := scheduler.JobWithDetails{
+				Name: jobName,
+				Job: &scheduler.Job{
+					Name:   jobName,
+					Tenant: tnnt,
+				},
+				JobMetadata: &scheduler.JobMetadata{
+					Version: 1,
+					Owner:   "jobOwnerName",
+				},
+				Alerts: []scheduler.Alert{
+					{
+						On:       scheduler.EventCategoryJobFailure,
+						Channels: []string{"slack://#chanel-name"},
+					},
+				},
+			}
+			event := &scheduler.Event{
+				JobName: jobName,
+				Tenant:  tnnt,
+				Type:    scheduler.JobFailureEvent,
+				Values:  map[string]any{},
+			}
+
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetJobDetails", ctx, project.Name(), jobName).Return(&jobWithDetails, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			routedNamespace, _ := tenant.NewNamespace("ns1", project.Name(), map[string]string{
+				"ALERT_ROUTE__FAILURE":  "slack://#chanel-name, webhook://#ops",
+				"ALERT_ROUTE__SLA_MISS": "webhook://#sla",
+			})
+			routedDetails, _ := tenant.NewTenantDetails(project, routedNamespace, nil)
+			slackSecret, _ := tenant.NewPlainTextSecret("NOTIFY_SLACK", "slackSecret")
+			webhookSecret, _ := tenant.NewPlainTextSecret("NOTIFY_WEBHOOK_OPS", "https://hooks.example.com/ops")
+			tenantService := new(mockTenantService)
+			tenantService.On("GetSecrets", ctx, tnnt).Return([]*tenant.PlainTextSecret{slackSecret, webhookSecret}, nil)
+			tenantService.On("GetDetails", ctx, tnnt).Return(routedDetails, nil)
+			defer tenantService.AssertExpectations(t)
+
+			notifyChanelSlack := new(mockNotificationChanel)
+			notifyChanelSlack.On("Notify", ctx, scheduler.NotifyAttrs{
+				Owner:    "jobOwnerName",
+				JobEvent: event,
+				Route:    "#chanel-name",
+				Secret:   "slackSecret",
+			}).Return(nil).Once()
+			defer notifyChanelSlack.AssertExpectations(t)
+			notifyChanelWebhook := new(mockNotificationChanel)
+			notifyChanelWebhook.On("Notify", ctx, scheduler.NotifyAttrs{
+				Owner:    "jobOwnerName",
+				JobEvent: event,
+				Route:    "#ops",
+				Secret:   "https://hooks.example.com/ops",
+			}).Return(nil).Once()
+			defer notifyChanelWebhook.AssertExpectations(t)
+
+			notifierChannels := map[string]service.Notifier{
+				"slack":   notifyChanelSlack,
+				"webhook": notifyChanelWebhook,
+			}
+			notifyService := service.NewNotifyService(logger, jobRepo, tenantService, nil, nil, notifierChannels)
+
+			err := notifyService.Push(ctx, event)
+			assert.NoError(t, err)
+		})
+		t.Run("should not send notification but dispatch webhooks if the alerts of the job are silenced", func(t *testing.T) {
+			jobWithDetails := scheduler.JobWithDetails{
+				Name: jobName,
+				Job: &scheduler.Job{
+					Name:   jobName,
+					Tenant: tnnt,
+				},
+				JobMetadata: &scheduler.JobMetadata{
+					Version: 1,
+					Owner:   "jobOwnerName",
+				},
+				Alerts: []scheduler.Alert{
+					{
+						On:       scheduler.EventCategoryJobFailure,
+						Channels: []string{"slack://#chanel-name"},
+					},
+				},
+			}
+			event := &scheduler.Event{
+				JobName: jobName,
+				Tenant:  tnnt,
+				Type:    scheduler.JobFailureEvent,
+				Values:  map[string]any{},
+			}
+
+			jobRepo := new(JobRepository)
+			jobRepo.On("GetJobDetails", ctx, project.Name(), jobName).Return(&jobWithDetails, nil)
+			defer jobRepo.AssertExpectations(t)
+
+			silence, _ := scheduler.NewAlertSilence(project.Name(), namespace.Name(), "", nil, "maintenance", "user", time.Now().Add(time.Hour), time.Now())
+			silencer := new(mockAlertSilencer)
+			silencer.On("GetSilence", ctx, &jobWithDetails).Return(silence, nil)
+			defer silencer.AssertExpectations(t)
+
+			notifyChanelSlack := new(mockNotificationChanel)
+			defer notifyChanelSlack.AssertExpectations(t)
+
+			dispatcher := new(mockWebhookDispatcher)
+			dispatcher.On("Dispatch", ctx, event)
+			defer dispatcher.AssertExpectations(t)
+
+			notifyService := service.NewNotifyService(logger, jobRepo, nil, silencer, dispatcher, map[string]service.Notifier{"slack": notifyChanelSlack})
+
+			err := notifyService.Push(ctx, event)
+			assert.NoError(t, err)
+		})
 	})
 }
 
+type mockAlertSilencer struct {
+	mock.Mock
+}
+
+func (m *mockAlertSilencer) GetSilence(ctx context.Context, job *scheduler.JobWithDetails) (*scheduler.AlertSilence, error) {
+	args := m.Called(ctx, job)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*scheduler.AlertSilence), args.Error(1)
+}
+
+type mockWebhookDispatcher struct {
+	mock.Mock
+}
+
+func (m *mockWebhookDispatcher) Dispatch(ctx context.Context, event *scheduler.Event) {
+	m.Called(ctx, event)
+}
+
 type mockNotificationChanel struct {
 	io.Closer
 	mock.Mock
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/goto/optimus/core/scheduler"
+)
+
+const replaySubscriberBuffer = 16
+
+type replaySubscriber struct {
+	updates chan *scheduler.ReplayWithRun
+	missed  chan struct{}
+}
+
+// ReplayBroadcaster fans out replay updates made by the replay worker to subscribers of the replay
+type ReplayBroadcaster struct {
+	mu          sync.RWMutex
+	subscribers map[uuid.UUID]map[*replaySubscriber]struct{}
+}
+
+func NewReplayBroadcaster() *ReplayBroadcaster {
+	return &ReplayBroadcaster{
+		subscribers: make(map[uuid.UUID]map[*replaySubscriber]struct{}),
+	}
+}
+
+// Subscribe registers a subscriber for updates of a replay, missed channel is signaled when an update is dropped
+// for the subscriber being slow. Returned func should be called to unsubscribe
+func (b *ReplayBroadcaster) Subscribe(replayID uuid.UUID) (<-chan *scheduler.ReplayWithRun, <-chan struct{}, func()) {
+	sub := &replaySubscriber{
+		updates: make(chan *scheduler.ReplayWithRun, replaySubscriberBuffer),
+		missed:  make(chan struct{}, 1),
+	}
+
+	b.mu.Lock()
+	if _, ok := b.subscribers[replayID]; !ok {
+		b.subscribers[replayID] = make(map[*replaySubscriber]struct{})
+	}
+	b.subscribers[replayID][sub] = struct{}{}
+	b.mu.Unlock()
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+
+			delete(b.subscribers[replayID], sub)
+			if len(b.subscribers[replayID]) == 0 {
+				delete(b.subscribers, replayID)
+			}
+			close(sub.updates)
+		})
+	}
+	return sub.updates, sub.missed, unsubscribe
+}
+
+// Publish sends the update to every subscriber of the replay, slow subscribers miss the update instead of blocking the worker
+// and are signaled about it to re-read the replay
+func (b *ReplayBroadcaster) Publish(replay *scheduler.ReplayWithRun) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for sub := range b.subscribers[replay.Replay.ID()] {
+		select {
+		case sub.updates <- replay:
+		default:
+			select {
+			case sub.missed <- struct{}{}:
+			default:
+			}
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/scheduler/service"
+	"github.com/goto/optimus/core/tenant"
+)
+
+func TestReplayBroadcaster(t *testing.T) {
+	tnnt, _ := tenant.NewTenant("proj", "ns1")
+	replayConfig := scheduler.NewReplayConfig(time.Now(), time.Now(), false, nil, "")
+
+	t.Run("publishes update only to subscribers of the replay", func(t *testing.T) {
+		broadcaster := service.NewReplayBroadcaster()
+		replayID := uuid.New()
+
+		updates, _, unsubscribe := broadcaster.Subscribe(replayID)
+		defer unsubscribe()
+		otherUpdates, _, unsubscribeOther := broadcaster.Subscribe(uuid.New())
+		defer unsubscribeOther()
+
+		replay := scheduler.NewReplay(replayID, "sample-job", tnnt, replayConfig, scheduler.ReplayStateReplayed, time.Now())
+		broadcaster.Publish(&scheduler.ReplayWithRun{Replay: replay})
+
+		assert.Equal(t, replay, (<-updates).Replay)
+		assert.Len(t, otherUpdates, 0)
+	})
+	t.Run("closes subscription channel on unsubscribe", func(t *testing.T) {
+		broadcaster := service.NewReplayBroadcaster()
+		replayID := uuid.New()
+
+		updates, _, unsubscribe := broadcaster.Subscribe(replayID)
+		unsubscribe()
+		unsubscribe()
+
+		_, ok := <-updates
+		assert.False(t, ok)
+
+		replay := scheduler.NewReplay(replayID, "sample-job", tnnt, replayConfig, scheduler.ReplayStateReplayed, time.Now())
+		assert.NotPanics(t, func() {
+			broadcaster.Publish(&scheduler.ReplayWithRun{Replay: replay})
+		})
+	})
+	t.Run("signals subscriber missing an update for being slow", func(t *testing.T) {
+		broadcaster := service.NewReplayBroadcaster()
+		replayID := uuid.New()
+
+		updates, missed, unsubscribe := broadcaster.Subscribe(replayID)
+		defer unsubscribe()
+
+		replay := scheduler.NewReplay(replayID, "sample-job", tnnt, replayConfig, scheduler.ReplayStateInProgress, time.Now())
+		for i := 0; i < cap(updates); i++ {
+			broadcaster.Publish(&scheduler.ReplayWithRun{Replay: replay})
+		}
+		assert.Len(t, missed, 0)
+
+		broadcaster.Publish(&scheduler.ReplayWithRun{Replay: replay})
+		broadcaster.Publish(&scheduler.ReplayWithRun{Replay: replay})
+
+		assert.Len(t, updates, cap(updates))
+		assert.Len(t, missed, 1)
+	})
+}
@@ -0,0 +1,32 @@
+package service
+
+import (
+	"github.com/goto/salt/log"
+
+	"github.com/goto/optimus/core/event"
+	"github.com/goto/optimus/core/scheduler"
+)
+
+// ReplayEventPublisher publishes the replay updates made by the replay worker as replay state change events, after
+// handing them over to the next publisher
+type ReplayEventPublisher struct {
+	l log.Logger
+
+	next         ReplayStatusPublisher
+	eventHandler EventHandler
+}
+
+func NewReplayEventPublisher(l log.Logger, next ReplayStatusPublisher, eventHandler EventHandler) *ReplayEventPublisher {
+	return &ReplayEventPublisher{l: l, next: next, eventHandler: eventHandler}
+}
+
+func (p *ReplayEventPublisher) Publish(replay *scheduler.ReplayWithRun) {
+	p.next.Publish(replay)
+
+	replayEvent, err := event.NewReplayStateChangeEvent(replay)
+	if err != nil {
+		p.l.Error("unable to create replay state change event for replay [%s]: %s", replay.Replay.ID().String(), err)
+		return
+	}
+	p.eventHandler.HandleEvent(replayEvent)
+}
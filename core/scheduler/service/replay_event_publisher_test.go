@@ -0,0 +1,65 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/goto/optimus/core/event"
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/scheduler/service"
+	"github.com/goto/optimus/core/tenant"
+	pbInt "github.com/goto/optimus/protos/gotocompany/optimus/integration/v1beta1"
+)
+
+func TestReplayEventPublisher(t *testing.T) {
+	tnnt, _ := tenant.NewTenant("proj", "ns1")
+	startTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	replayConfig := scheduler.NewReplayConfig(startTime, startTime.Add(24*time.Hour), false, nil, "")
+
+	t.Run("publishes replay state change event after passing the update to the next publisher", func(t *testing.T) {
+		eventHandler := newEventHandler(t)
+		broadcaster := service.NewReplayBroadcaster()
+		publisher := service.NewReplayEventPublisher(log.NewNoop(), broadcaster, eventHandler)
+
+		replayID := uuid.New()
+		updates, _, unsubscribe := broadcaster.Subscribe(replayID)
+		defer unsubscribe()
+
+		replay := &scheduler.ReplayWithRun{
+			Replay: scheduler.NewReplay(replayID, "sample-job", tnnt, replayConfig, scheduler.ReplayStatePartialReplayed, time.Now()),
+			Runs: []*scheduler.JobRunStatus{
+				{ScheduledAt: startTime, State: scheduler.StateSuccess},
+				{ScheduledAt: startTime.Add(time.Hour), State: scheduler.StatePending},
+			},
+		}
+
+		var published *event.ReplayStateChange
+		eventHandler.On("HandleEvent", mock.Anything).Run(func(args mock.Arguments) {
+			published = args.Get(0).(*event.ReplayStateChange)
+		}).Return().Once()
+
+		publisher.Publish(replay)
+
+		assert.Equal(t, replay, <-updates)
+		payload, err := published.Bytes()
+		assert.NoError(t, err)
+
+		var changeEvent pbInt.OptimusChangeEvent
+		assert.NoError(t, proto.Unmarshal(payload, &changeEvent))
+		assert.Equal(t, pbInt.OptimusChangeEvent_EVENT_TYPE_REPLAY_STATE_CHANGE, changeEvent.EventType)
+		assert.EqualValues(t, event.SchemaVersion, changeEvent.SchemaVersion)
+		assert.Equal(t, "ns1", changeEvent.NamespaceName)
+
+		replayPayload := changeEvent.GetReplayStateChange()
+		assert.Equal(t, replayID.String(), replayPayload.ReplayId)
+		assert.Equal(t, scheduler.ReplayStatePartialReplayed.String(), replayPayload.State)
+		assert.Len(t, replayPayload.Runs, 2)
+		assert.Equal(t, scheduler.StatePending.String(), replayPayload.Runs[1].State)
+	})
+}
@@ -1,19 +1,25 @@
 package service
 
 import (
+	"fmt"
+	"sort"
+	"sync"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/goto/salt/log"
 	"github.com/robfig/cron/v3"
 	"golang.org/x/net/context"
 
 	"github.com/goto/optimus/config"
 	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/tenant"
 	"github.com/goto/optimus/internal/errors"
 )
 
 const (
-	syncInterval = "@every 1m"
+	defaultReplayLeaseTimeout = 5 * time.Minute
+	leaseRenewalsPerTimeout   = 3
 )
 
 type ReplayManager struct {
@@ -25,15 +31,32 @@ type ReplayManager struct {
 	schedule *cron.Cron
 	Now      func() time.Time
 
+	// workers limits the number of replays processed concurrently
+	workers chan struct{}
+	wg      *sync.WaitGroup
+
+	// drainCtx is done once the manager is closed, the replays being processed stop at their next step
+	drainCtx context.Context
+	drain    context.CancelFunc
+
 	config config.ReplayConfig
 }
 
 func NewReplayManager(l log.Logger, replayRepository ReplayRepository, replayWorker Worker, now func() time.Time, config config.ReplayConfig) *ReplayManager {
+	workerCount := config.WorkerCount
+	if workerCount < 1 {
+		workerCount = 1
+	}
+	drainCtx, drain := context.WithCancel(context.Background())
 	return &ReplayManager{
 		l:                l,
 		replayRepository: replayRepository,
 		replayWorker:     replayWorker,
 		Now:              now,
+		workers:          make(chan struct{}, workerCount),
+		wg:               &sync.WaitGroup{},
+		drainCtx:         drainCtx,
+		drain:            drain,
 		config:           config,
 		schedule: cron.New(cron.WithChain(
 			cron.SkipIfStillRunning(cron.DefaultLogger),
@@ -42,12 +65,24 @@ func NewReplayManager(l log.Logger, replayRepository ReplayRepository, replayWor
 }
 
 type Worker interface {
-	Process(*scheduler.ReplayWithRun)
+	Process(context.Context, *scheduler.ReplayWithRun)
+	Resume(context.Context, *scheduler.ReplayWithRun)
 }
 
 func (m ReplayManager) Initialize() {
+	// replays stranded by servers which are gone are resumed before picking up the new ones
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		m.ResumeStuckReplays()
+	}()
+
 	if m.schedule != nil {
-		_, err := m.schedule.AddFunc(syncInterval, m.StartReplayLoop)
+		syncInterval := time.Minute
+		if m.config.WorkerInterval > 0 {
+			syncInterval = m.config.WorkerInterval
+		}
+		_, err := m.schedule.AddFunc(fmt.Sprintf("@every %s", syncInterval), m.StartReplayLoop)
 		if err != nil {
 			m.l.Error("Failed to add function to cron schedule: %s", err)
 		}
@@ -61,17 +96,226 @@ func (m ReplayManager) StartReplayLoop() {
 	// Cancel timed out replay with status [created, in progress, partial replayed, replayed]
 	m.checkTimedOutReplay(ctx)
 
-	// Fetch created, in progress, and replayed request
-	replayToExecute, err := m.replayRepository.GetReplayToExecute(ctx)
-	if err != nil {
-		if errors.IsErrorType(err, errors.ErrNotFound) {
-			m.l.Debug("no replay request found to execute")
-		} else {
-			m.l.Error("unable to get replay requests to execute: %w", err)
+	// Move waiting replays to created as long as their tenant is below the concurrency limit
+	m.promoteWaitingReplays(ctx)
+
+	// Resume replays in progress whose lease is expired as long as there is an idle worker
+	m.ResumeStuckReplays()
+
+	// Fetch created, in progress, and replayed request as long as there is an idle worker
+	for {
+		select {
+		case m.workers <- struct{}{}:
+		default:
+			m.l.Debug("all replay workers are busy")
+			return
+		}
+
+		replayToExecute, err := m.replayRepository.GetReplayToExecute(ctx)
+		if err != nil {
+			<-m.workers
+			if errors.IsErrorType(err, errors.ErrNotFound) {
+				m.l.Debug("no replay request found to execute")
+			} else {
+				m.l.Error("unable to get replay requests to execute: %s", err)
+			}
+			return
 		}
+
+		m.wg.Add(1)
+		go func() {
+			defer func() {
+				<-m.workers
+				m.wg.Done()
+			}()
+			m.holdLease(replayToExecute.Replay.ID(), func() {
+				m.replayWorker.Process(m.drainCtx, replayToExecute)
+			})
+		}()
+	}
+}
+
+// ResumeStuckReplays hands over replays left in progress, whose lease is expired as the server processing them is
+// gone, back to the workers with the state recorded before they were picked up
+func (m ReplayManager) ResumeStuckReplays() {
+	ctx := context.Background()
+
+	for {
+		select {
+		case m.workers <- struct{}{}:
+		default:
+			m.l.Debug("all replay workers are busy")
+			return
+		}
+
+		stuckReplay, err := m.replayRepository.GetReplayToResume(ctx, m.leaseTimeout())
+		if err != nil {
+			<-m.workers
+			if errors.IsErrorType(err, errors.ErrNotFound) {
+				m.l.Debug("no stuck replay request found to resume")
+			} else {
+				m.l.Error("unable to get stuck replay requests: %s", err)
+			}
+			return
+		}
+
+		replayToResume, lastState, err := m.getReplayToResume(ctx, stuckReplay)
+		if err != nil {
+			<-m.workers
+			m.l.Error("unable to prepare replay [%s] to resume: %s", stuckReplay.ID().String(), err)
+			m.closeStuckReplay(ctx, stuckReplay.ID(), lastState, err)
+			continue
+		}
+
+		m.wg.Add(1)
+		go func() {
+			defer func() {
+				<-m.workers
+				m.wg.Done()
+			}()
+			m.holdLease(replayToResume.Replay.ID(), func() {
+				m.replayWorker.Resume(m.drainCtx, replayToResume)
+			})
+		}()
+	}
+}
+
+// holdLease renews the lease of the replay while it is being processed, so no other server resumes it
+func (m ReplayManager) holdLease(replayID uuid.UUID, process func()) {
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		ticker := time.NewTicker(m.leaseTimeout() / leaseRenewalsPerTimeout)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if err := m.replayRepository.RenewReplayLease(context.Background(), replayID); err != nil {
+					m.l.Error("unable to renew lease of replay [%s]: %s", replayID.String(), err)
+				}
+			}
+		}
+	}()
+
+	process()
+}
+
+func (m ReplayManager) leaseTimeout() time.Duration {
+	if m.config.LeaseTimeout > 0 {
+		return m.config.LeaseTimeout
+	}
+	return defaultReplayLeaseTimeout
+}
+
+// closeStuckReplay closes the claimed replay which can not be resumed, as it is claimed again on every lease timeout
+// otherwise. The replay is left in its latest recorded state when it is terminal, and failed otherwise.
+func (m ReplayManager) closeStuckReplay(ctx context.Context, replayID uuid.UUID, lastState scheduler.ReplayState, cause error) {
+	state, message := lastState, ""
+	if !state.IsTerminal() {
+		state, message = scheduler.ReplayStateFailed, fmt.Sprintf("unable to resume replay: %s", cause)
+	}
+	if err := m.replayRepository.UpdateReplayStatus(ctx, replayID, state, message); err != nil {
+		m.l.Error("unable to close stuck replay [%s] as %s: %s", replayID.String(), state, err)
+	}
+}
+
+// getReplayToResume returns the replay to resume from its latest recorded state, along with that state, which is
+// empty when it is not known
+func (m ReplayManager) getReplayToResume(ctx context.Context, stuckReplay *scheduler.Replay) (*scheduler.ReplayWithRun, scheduler.ReplayState, error) {
+	replayWithRun, err := m.replayRepository.GetReplayByID(ctx, stuckReplay.ID())
+	if err != nil {
+		return nil, "", err
+	}
+
+	transitions, err := m.replayRepository.GetReplayStateTransitions(ctx, stuckReplay.ID())
+	if err != nil {
+		return nil, "", err
+	}
+
+	// being picked up by a worker is not recorded as a transition, hence the latest one is the state to resume from
+	state := scheduler.ReplayStateCreated
+	if len(transitions) > 0 {
+		state = transitions[len(transitions)-1].State
+	}
+	if state.IsTerminal() || state == scheduler.ReplayStateInProgress {
+		return nil, state, errors.InvalidStateTransition(scheduler.EntityReplay, fmt.Sprintf("unable to resume replay from state %s", state))
+	}
+
+	replay := replayWithRun.Replay
+	return &scheduler.ReplayWithRun{
+		Replay: scheduler.NewReplay(replay.ID(), replay.JobName(), replay.Tenant(), replay.Config(), state, replay.CreatedAt()),
+		Runs:   replayWithRun.Runs,
+	}, state, nil
+}
+
+// promoteWaitingReplays moves the waiting replays, oldest first, into created state while their tenant has less active
+// replays than the configured limit
+func (m ReplayManager) promoteWaitingReplays(ctx context.Context) {
+	waitingReplays, err := m.replayRepository.GetReplayRequestsByStatus(ctx, []scheduler.ReplayState{scheduler.ReplayStateWaiting})
+	if err != nil {
+		m.l.Error("unable to get waiting replay requests: %s", err)
+		return
+	}
+	if len(waitingReplays) == 0 {
 		return
 	}
-	go m.replayWorker.Process(replayToExecute)
+
+	activeReplays, err := m.replayRepository.GetReplayRequestsByStatus(ctx, activeReplayStates)
+	if err != nil {
+		m.l.Error("unable to get active replay requests: %s", err)
+		return
+	}
+	activeCount := make(map[tenant.Tenant]int)
+	for _, replay := range activeReplays {
+		activeCount[replay.Tenant()]++
+	}
+
+	sort.SliceStable(waitingReplays, func(i, j int) bool {
+		return waitingReplays[i].CreatedAt().Before(waitingReplays[j].CreatedAt())
+	})
+	for _, replay := range waitingReplays {
+		limit := m.config.TenantConcurrencyLimit
+		if limit > 0 && activeCount[replay.Tenant()] >= limit {
+			continue
+		}
+		if err := m.replayRepository.UpdateReplayStatus(ctx, replay.ID(), scheduler.ReplayStateCreated, ""); err != nil {
+			m.l.Error("unable to move waiting replay [%s] to created: %s", replay.ID().String(), err)
+			continue
+		}
+		activeCount[replay.Tenant()]++
+	}
+}
+
+// Close stops scheduling the replay loop and waits for replays which are being processed
+func (m ReplayManager) Close() {
+	_ = m.Drain(context.Background())
+}
+
+// Drain stops scheduling the replay loop, and lets the replays being processed stop at their next step, checkpointed
+// to be resumed. It returns error when the replays are still being processed once the context is done.
+func (m ReplayManager) Drain(ctx context.Context) error {
+	if m.schedule != nil {
+		<-m.schedule.Stop().Done()
+	}
+	m.drain()
+
+	drained := make(chan struct{})
+	go func() {
+		m.wg.Wait()
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+		m.l.Info("replay manager stopped")
+		return nil
+	case <-ctx.Done():
+		m.l.Warn("replay manager stopped with replays still being processed: %s", ctx.Err())
+		return ctx.Err()
+	}
 }
 
 func (m ReplayManager) checkTimedOutReplay(ctx context.Context) {
@@ -88,9 +332,38 @@ func (m ReplayManager) checkTimedOutReplay(ctx context.Context) {
 		if runningTime < m.config.ReplayTimeout {
 			continue
 		}
+		// time spent waiting for the tenant concurrency limit is not counted
+		promotedAt, err := m.getPromotedTime(ctx, replay)
+		if err != nil {
+			m.l.Error("unable to get state transitions of replay [%s]: %s", replay.ID().String(), err)
+			continue
+		}
+		if !promotedAt.IsZero() && m.Now().Sub(promotedAt) < m.config.ReplayTimeout {
+			continue
+		}
 		message := "replay timed out"
 		if err := m.replayRepository.UpdateReplayStatus(ctx, replay.ID(), scheduler.ReplayStateFailed, message); err != nil {
 			m.l.Error("unable to mark replay [%s] as failed due to time out", replay.ID())
 		}
 	}
 }
+
+// getPromotedTime returns the time the replay left the waiting state, zero if it never waited
+func (m ReplayManager) getPromotedTime(ctx context.Context, replay *scheduler.Replay) (time.Time, error) {
+	transitions, err := m.replayRepository.GetReplayStateTransitions(ctx, replay.ID())
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	waited := false
+	for _, transition := range transitions {
+		if transition.State == scheduler.ReplayStateWaiting {
+			waited = true
+			continue
+		}
+		if waited && transition.State == scheduler.ReplayStateCreated {
+			return transition.CreatedAt, nil
+		}
+	}
+	return time.Time{}, nil
+}
@@ -7,12 +7,15 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"golang.org/x/net/context"
 
 	"github.com/goto/optimus/config"
 	"github.com/goto/optimus/core/scheduler"
 	"github.com/goto/optimus/core/scheduler/service"
 	"github.com/goto/optimus/core/tenant"
+	errs "github.com/goto/optimus/internal/errors"
 )
 
 func TestReplayManager(t *testing.T) {
@@ -24,6 +27,9 @@ func TestReplayManager(t *testing.T) {
 		scheduler.ReplayStateCreated, scheduler.ReplayStateInProgress,
 		scheduler.ReplayStatePartialReplayed, scheduler.ReplayStateReplayed,
 	}
+	waitingStates := []scheduler.ReplayState{scheduler.ReplayStateWaiting}
+	leaseTimeout := 5 * time.Minute
+	errNoStuckReplay := errs.NotFound(scheduler.EntityReplay, "no replay request found to resume")
 	replayID := uuid.New()
 	jobName := scheduler.JobName("sample_select")
 	replayStartTimeStr := "2023-01-03T12:00:00Z"
@@ -42,6 +48,8 @@ func TestReplayManager(t *testing.T) {
 
 			err := errors.New("internal error")
 			replayRepository.On("GetReplayRequestsByStatus", ctx, replaysToCheck).Return(nil, err)
+			replayRepository.On("GetReplayRequestsByStatus", ctx, waitingStates).Return(nil, err)
+			replayRepository.On("GetReplayToResume", ctx, leaseTimeout).Return(nil, errNoStuckReplay)
 			replayRepository.On("GetReplayToExecute", ctx).Return(nil, err)
 
 			replayManager := service.NewReplayManager(logger, replayRepository, nil, currentTime, conf)
@@ -58,13 +66,238 @@ func TestReplayManager(t *testing.T) {
 			replayReq2 := scheduler.NewReplay(uuid.New(), "other_job", tnnt, replayReqConf, scheduler.ReplayStateInProgress, replayCreatedTime2)
 
 			replayRepository.On("GetReplayRequestsByStatus", ctx, replaysToCheck).Return([]*scheduler.Replay{replayReq1, replayReq2}, nil)
+			replayRepository.On("GetReplayStateTransitions", ctx, replayID).Return(nil, nil)
 			replayRepository.On("UpdateReplayStatus", ctx, replayID, scheduler.ReplayStateFailed, "replay timed out").Return(nil).Once()
+			replayRepository.On("GetReplayRequestsByStatus", ctx, waitingStates).Return(nil, nil)
 
 			err := errors.New("internal error")
+			replayRepository.On("GetReplayToResume", ctx, leaseTimeout).Return(nil, errNoStuckReplay)
 			replayRepository.On("GetReplayToExecute", ctx).Return(nil, err)
 
 			replayManager := service.NewReplayManager(logger, replayRepository, nil, currentTime, conf)
 			replayManager.StartReplayLoop()
 		})
+		t.Run("should process replays concurrently up to the configured worker count", func(t *testing.T) {
+			replayRepository := new(ReplayRepository)
+			defer replayRepository.AssertExpectations(t)
+			worker := new(mockReplayWorker)
+			defer worker.AssertExpectations(t)
+
+			replayReq1 := &scheduler.ReplayWithRun{Replay: scheduler.NewReplay(uuid.New(), jobName, tnnt, replayReqConf, scheduler.ReplayStateCreated, time.Now())}
+			replayReq2 := &scheduler.ReplayWithRun{Replay: scheduler.NewReplay(uuid.New(), jobName, tnnt, replayReqConf, scheduler.ReplayStateCreated, time.Now())}
+
+			replayRepository.On("GetReplayRequestsByStatus", ctx, replaysToCheck).Return(nil, nil)
+			replayRepository.On("GetReplayRequestsByStatus", ctx, waitingStates).Return(nil, nil)
+			replayRepository.On("GetReplayToResume", ctx, leaseTimeout).Return(nil, errNoStuckReplay)
+			replayRepository.On("GetReplayToExecute", ctx).Return(replayReq1, nil).Once()
+			replayRepository.On("GetReplayToExecute", ctx).Return(replayReq2, nil).Once()
+			replayRepository.On("GetReplayToExecute", ctx).Return(nil, errors.New("no replay to execute")).Maybe()
+			worker.On("Process", mock.Anything).Return().Twice()
+
+			workerConf := config.ReplayConfig{ReplayTimeout: time.Hour * 3, WorkerCount: 2}
+			replayManager := service.NewReplayManager(logger, replayRepository, worker, currentTime, workerConf)
+			replayManager.StartReplayLoop()
+			replayManager.Close()
+
+			assert.Len(t, worker.Calls, 2)
+		})
+		t.Run("should not mark replay as timed out if it is promoted from waiting within the timeout", func(t *testing.T) {
+			replayRepository := new(ReplayRepository)
+			defer replayRepository.AssertExpectations(t)
+
+			replayReq := scheduler.NewReplay(replayID, jobName, tnnt, replayReqConf, scheduler.ReplayStateInProgress, time.Now().Add(-24*time.Hour))
+			transitions := []*scheduler.ReplayStateTransition{
+				{State: scheduler.ReplayStateWaiting, CreatedAt: time.Now().Add(-24 * time.Hour)},
+				{State: scheduler.ReplayStateCreated, CreatedAt: time.Now().Add(-1 * time.Hour)},
+			}
+
+			replayRepository.On("GetReplayRequestsByStatus", ctx, replaysToCheck).Return([]*scheduler.Replay{replayReq}, nil)
+			replayRepository.On("GetReplayStateTransitions", ctx, replayID).Return(transitions, nil)
+			replayRepository.On("GetReplayRequestsByStatus", ctx, waitingStates).Return(nil, nil)
+			replayRepository.On("GetReplayToResume", ctx, leaseTimeout).Return(nil, errNoStuckReplay)
+			replayRepository.On("GetReplayToExecute", ctx).Return(nil, errors.New("internal error"))
+
+			replayManager := service.NewReplayManager(logger, replayRepository, nil, currentTime, conf)
+			replayManager.StartReplayLoop()
+
+			replayRepository.AssertNotCalled(t, "UpdateReplayStatus", ctx, replayID, scheduler.ReplayStateFailed, "replay timed out")
+		})
+		t.Run("should promote the oldest waiting replays while the tenant is below the concurrency limit", func(t *testing.T) {
+			replayRepository := new(ReplayRepository)
+			defer replayRepository.AssertExpectations(t)
+
+			otherTnnt, _ := tenant.NewTenant(projName.String(), "ns2")
+			activeReplay := scheduler.NewReplay(uuid.New(), "other_job", tnnt, replayReqConf, scheduler.ReplayStateInProgress, time.Now())
+			olderWaitingReplay := scheduler.NewReplay(uuid.New(), jobName, tnnt, replayReqConf, scheduler.ReplayStateWaiting, time.Now().Add(-2*time.Hour))
+			newerWaitingReplay := scheduler.NewReplay(uuid.New(), "another_job", tnnt, replayReqConf, scheduler.ReplayStateWaiting, time.Now().Add(-1*time.Hour))
+			otherTenantWaitingReplay := scheduler.NewReplay(uuid.New(), jobName, otherTnnt, replayReqConf, scheduler.ReplayStateWaiting, time.Now())
+
+			replayRepository.On("GetReplayRequestsByStatus", ctx, replaysToCheck).Return([]*scheduler.Replay{activeReplay}, nil)
+			replayRepository.On("GetReplayRequestsByStatus", ctx, waitingStates).
+				Return([]*scheduler.Replay{newerWaitingReplay, otherTenantWaitingReplay, olderWaitingReplay}, nil)
+			replayRepository.On("UpdateReplayStatus", ctx, olderWaitingReplay.ID(), scheduler.ReplayStateCreated, "").Return(nil).Once()
+			replayRepository.On("UpdateReplayStatus", ctx, otherTenantWaitingReplay.ID(), scheduler.ReplayStateCreated, "").Return(nil).Once()
+			replayRepository.On("GetReplayToResume", ctx, leaseTimeout).Return(nil, errNoStuckReplay)
+			replayRepository.On("GetReplayToExecute", ctx).Return(nil, errors.New("internal error"))
+
+			limitConf := config.ReplayConfig{ReplayTimeout: time.Hour * 3, TenantConcurrencyLimit: 2}
+			replayManager := service.NewReplayManager(logger, replayRepository, nil, currentTime, limitConf)
+			replayManager.StartReplayLoop()
+
+			replayRepository.AssertNotCalled(t, "UpdateReplayStatus", ctx, newerWaitingReplay.ID(), scheduler.ReplayStateCreated, "")
+		})
+	})
+	t.Run("ResumeStuckReplays", func(t *testing.T) {
+		t.Run("should not resume any replay if unable to claim stuck replays", func(t *testing.T) {
+			replayRepository := new(ReplayRepository)
+			defer replayRepository.AssertExpectations(t)
+
+			replayRepository.On("GetReplayToResume", ctx, leaseTimeout).Return(nil, errors.New("internal error")).Once()
+
+			replayManager := service.NewReplayManager(logger, replayRepository, nil, currentTime, conf)
+			replayManager.ResumeStuckReplays()
+		})
+		t.Run("should resume stuck replays whose lease is expired from the latest recorded state", func(t *testing.T) {
+			replayRepository := new(ReplayRepository)
+			defer replayRepository.AssertExpectations(t)
+			worker := new(mockReplayWorker)
+			defer worker.AssertExpectations(t)
+
+			createdAt := time.Now()
+			stuckReplay := scheduler.NewReplay(replayID, jobName, tnnt, replayReqConf, scheduler.ReplayStateInProgress, createdAt)
+			runs := []*scheduler.JobRunStatus{
+				{ScheduledAt: replayStartTime, State: scheduler.StateSuccess},
+				{ScheduledAt: replayEndTime, State: scheduler.StatePending},
+			}
+			transitions := []*scheduler.ReplayStateTransition{
+				{State: scheduler.ReplayStateCreated},
+				{State: scheduler.ReplayStatePartialReplayed},
+			}
+			replayToResume := &scheduler.ReplayWithRun{
+				Replay: scheduler.NewReplay(replayID, jobName, tnnt, replayReqConf, scheduler.ReplayStatePartialReplayed, createdAt),
+				Runs:   runs,
+			}
+
+			replayRepository.On("GetReplayToResume", ctx, leaseTimeout).Return(stuckReplay, nil).Once()
+			replayRepository.On("GetReplayToResume", ctx, leaseTimeout).Return(nil, errNoStuckReplay).Maybe()
+			replayRepository.On("GetReplayByID", ctx, replayID).Return(&scheduler.ReplayWithRun{Replay: stuckReplay, Runs: runs}, nil)
+			replayRepository.On("GetReplayStateTransitions", ctx, replayID).Return(transitions, nil)
+			worker.On("Resume", replayToResume).Return().Once()
+
+			replayManager := service.NewReplayManager(logger, replayRepository, worker, currentTime, conf)
+			replayManager.ResumeStuckReplays()
+			replayManager.Close()
+		})
+		t.Run("should fail the replay which is unable to be prepared and resume the rest", func(t *testing.T) {
+			replayRepository := new(ReplayRepository)
+			defer replayRepository.AssertExpectations(t)
+			worker := new(mockReplayWorker)
+			defer worker.AssertExpectations(t)
+
+			createdAt := time.Now()
+			otherReplayID := uuid.New()
+			stuckReplay1 := scheduler.NewReplay(replayID, jobName, tnnt, replayReqConf, scheduler.ReplayStateInProgress, createdAt)
+			stuckReplay2 := scheduler.NewReplay(otherReplayID, jobName, tnnt, replayReqConf, scheduler.ReplayStateInProgress, createdAt)
+			replayToResume := &scheduler.ReplayWithRun{
+				Replay: scheduler.NewReplay(otherReplayID, jobName, tnnt, replayReqConf, scheduler.ReplayStateCreated, createdAt),
+			}
+
+			replayRepository.On("GetReplayToResume", ctx, leaseTimeout).Return(stuckReplay1, nil).Once()
+			replayRepository.On("GetReplayToResume", ctx, leaseTimeout).Return(stuckReplay2, nil).Once()
+			replayRepository.On("GetReplayToResume", ctx, leaseTimeout).Return(nil, errNoStuckReplay).Maybe()
+			replayRepository.On("GetReplayByID", ctx, replayID).Return(nil, errors.New("internal error"))
+			replayRepository.On("UpdateReplayStatus", ctx, replayID, scheduler.ReplayStateFailed, "unable to resume replay: internal error").Return(nil).Once()
+			replayRepository.On("GetReplayByID", ctx, otherReplayID).Return(&scheduler.ReplayWithRun{Replay: stuckReplay2}, nil)
+			replayRepository.On("GetReplayStateTransitions", ctx, otherReplayID).Return(nil, nil)
+			worker.On("Resume", replayToResume).Return().Once()
+
+			replayManager := service.NewReplayManager(logger, replayRepository, worker, currentTime, conf)
+			replayManager.ResumeStuckReplays()
+			replayManager.Close()
+		})
+		t.Run("should fail the replay whose latest recorded state is in progress instead of resuming it", func(t *testing.T) {
+			replayRepository := new(ReplayRepository)
+			defer replayRepository.AssertExpectations(t)
+			worker := new(mockReplayWorker)
+			defer worker.AssertExpectations(t)
+
+			stuckReplay := scheduler.NewReplay(replayID, jobName, tnnt, replayReqConf, scheduler.ReplayStateInProgress, time.Now())
+			transitions := []*scheduler.ReplayStateTransition{
+				{State: scheduler.ReplayStateCreated},
+				{State: scheduler.ReplayStateInProgress},
+			}
+
+			replayRepository.On("GetReplayToResume", ctx, leaseTimeout).Return(stuckReplay, nil).Once()
+			replayRepository.On("GetReplayToResume", ctx, leaseTimeout).Return(nil, errNoStuckReplay).Maybe()
+			replayRepository.On("GetReplayByID", ctx, replayID).Return(&scheduler.ReplayWithRun{Replay: stuckReplay}, nil)
+			replayRepository.On("GetReplayStateTransitions", ctx, replayID).Return(transitions, nil)
+			replayRepository.On("UpdateReplayStatus", ctx, replayID, scheduler.ReplayStateFailed,
+				"unable to resume replay: invalid state for entity replay: unable to resume replay from state in progress").Return(nil).Once()
+
+			replayManager := service.NewReplayManager(logger, replayRepository, worker, currentTime, conf)
+			replayManager.ResumeStuckReplays()
+			replayManager.Close()
+		})
+		t.Run("should close the replay in its latest recorded state when it is terminal", func(t *testing.T) {
+			replayRepository := new(ReplayRepository)
+			defer replayRepository.AssertExpectations(t)
+			worker := new(mockReplayWorker)
+			defer worker.AssertExpectations(t)
+
+			stuckReplay := scheduler.NewReplay(replayID, jobName, tnnt, replayReqConf, scheduler.ReplayStateInProgress, time.Now())
+			transitions := []*scheduler.ReplayStateTransition{
+				{State: scheduler.ReplayStateCreated},
+				{State: scheduler.ReplayStateSuccess},
+			}
+
+			replayRepository.On("GetReplayToResume", ctx, leaseTimeout).Return(stuckReplay, nil).Once()
+			replayRepository.On("GetReplayToResume", ctx, leaseTimeout).Return(nil, errNoStuckReplay).Maybe()
+			replayRepository.On("GetReplayByID", ctx, replayID).Return(&scheduler.ReplayWithRun{Replay: stuckReplay}, nil)
+			replayRepository.On("GetReplayStateTransitions", ctx, replayID).Return(transitions, nil)
+			replayRepository.On("UpdateReplayStatus", ctx, replayID, scheduler.ReplayStateSuccess, "").Return(nil).Once()
+
+			replayManager := service.NewReplayManager(logger, replayRepository, worker, currentTime, conf)
+			replayManager.ResumeStuckReplays()
+			replayManager.Close()
+		})
+		t.Run("should renew the lease of the replay while it is being resumed", func(t *testing.T) {
+			replayRepository := new(ReplayRepository)
+			defer replayRepository.AssertExpectations(t)
+			worker := new(mockReplayWorker)
+			defer worker.AssertExpectations(t)
+
+			createdAt := time.Now()
+			stuckReplay := scheduler.NewReplay(replayID, jobName, tnnt, replayReqConf, scheduler.ReplayStateInProgress, createdAt)
+			replayToResume := &scheduler.ReplayWithRun{
+				Replay: scheduler.NewReplay(replayID, jobName, tnnt, replayReqConf, scheduler.ReplayStateCreated, createdAt),
+			}
+			shortLeaseTimeout := 30 * time.Millisecond
+
+			replayRepository.On("GetReplayToResume", ctx, shortLeaseTimeout).Return(stuckReplay, nil).Once()
+			replayRepository.On("GetReplayToResume", ctx, shortLeaseTimeout).Return(nil, errNoStuckReplay).Maybe()
+			replayRepository.On("GetReplayByID", ctx, replayID).Return(&scheduler.ReplayWithRun{Replay: stuckReplay}, nil)
+			replayRepository.On("GetReplayStateTransitions", ctx, replayID).Return(nil, nil)
+			replayRepository.On("RenewReplayLease", mock.Anything, replayID).Return(nil)
+			worker.On("Resume", replayToResume).Run(func(mock.Arguments) {
+				time.Sleep(5 * shortLeaseTimeout)
+			}).Return().Once()
+
+			leaseConf := config.ReplayConfig{ReplayTimeout: time.Hour * 3, LeaseTimeout: shortLeaseTimeout}
+			replayManager := service.NewReplayManager(logger, replayRepository, worker, currentTime, leaseConf)
+			replayManager.ResumeStuckReplays()
+			replayManager.Close()
+		})
 	})
 }
+
+type mockReplayWorker struct {
+	mock.Mock
+}
+
+func (m *mockReplayWorker) Process(_ context.Context, replayReq *scheduler.ReplayWithRun) {
+	m.Called(replayReq)
+}
+
+func (m *mockReplayWorker) Resume(_ context.Context, replayReq *scheduler.ReplayWithRun) {
+	m.Called(replayReq)
+}
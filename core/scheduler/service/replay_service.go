@@ -2,24 +2,35 @@ package service
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/goto/salt/log"
 	"golang.org/x/net/context"
 
+	"github.com/goto/optimus/config"
 	"github.com/goto/optimus/core/scheduler"
 	"github.com/goto/optimus/core/tenant"
 	"github.com/goto/optimus/internal/errors"
 	"github.com/goto/optimus/internal/lib/cron"
+	"github.com/goto/optimus/internal/logging"
 	"github.com/goto/optimus/internal/telemetry"
 )
 
 const (
 	getReplaysDayLimit = 30 // TODO: make it configurable via cli
 
-	metricJobReplay = "jobrun_replay_requests_total"
+	defaultReplayStatusPollInterval = 30 * time.Second
+
+	metricJobReplay         = "jobrun_replay_requests_total"
+	metricJobReplayDuration = "jobrun_replay_duration_seconds"
 )
 
+var activeReplayStates = []scheduler.ReplayState{
+	scheduler.ReplayStateCreated, scheduler.ReplayStateInProgress,
+	scheduler.ReplayStatePartialReplayed, scheduler.ReplayStateReplayed,
+}
+
 type SchedulerRunGetter interface {
 	GetJobRuns(ctx context.Context, t tenant.Tenant, criteria *scheduler.JobRunsCriteria, jobCron *cron.ScheduleSpec) ([]*scheduler.JobRunStatus, error)
 }
@@ -30,39 +41,63 @@ type ReplayRepository interface {
 	UpdateReplayStatus(ctx context.Context, replayID uuid.UUID, state scheduler.ReplayState, message string) error
 
 	GetReplayToExecute(context.Context) (*scheduler.ReplayWithRun, error)
+	GetReplayToResume(ctx context.Context, leaseTimeout time.Duration) (*scheduler.Replay, error)
+	RenewReplayLease(ctx context.Context, replayID uuid.UUID) error
+	ReleaseReplayLease(ctx context.Context, replayID uuid.UUID) error
 	GetReplayRequestsByStatus(ctx context.Context, statusList []scheduler.ReplayState) ([]*scheduler.Replay, error)
 	GetReplaysByProject(ctx context.Context, projectName tenant.ProjectName, dayLimits int) ([]*scheduler.Replay, error)
 	GetReplayByID(ctx context.Context, replayID uuid.UUID) (*scheduler.ReplayWithRun, error)
+	GetReplayStateTransitions(ctx context.Context, replayID uuid.UUID) ([]*scheduler.ReplayStateTransition, error)
 }
 
 type ReplayValidator interface {
 	Validate(ctx context.Context, replayRequest *scheduler.Replay, jobCron *cron.ScheduleSpec) error
 }
 
+type ReplayStatusSubscriber interface {
+	Subscribe(replayID uuid.UUID) (updates <-chan *scheduler.ReplayWithRun, missed <-chan struct{}, unsubscribe func())
+}
+
 type ReplayService struct {
 	replayRepo ReplayRepository
 	jobRepo    JobRepository
 	runGetter  SchedulerRunGetter
 
-	validator ReplayValidator
+	validator  ReplayValidator
+	subscriber ReplayStatusSubscriber
 
 	logger log.Logger
+	config config.ReplayConfig
 }
 
 func (r *ReplayService) CreateReplay(ctx context.Context, tenant tenant.Tenant, jobName scheduler.JobName, config *scheduler.ReplayConfig) (replayID uuid.UUID, err error) {
+	l := logging.ForTenant(r.logger, tenant.ProjectName().String(), tenant.NamespaceName().String(), jobName.String())
 	jobCron, err := getJobCron(ctx, r.logger, r.jobRepo, tenant, jobName)
 	if err != nil {
-		r.logger.Error("unable to get cron value for job [%s]: %s", jobName.String(), err.Error())
+		l.Error("unable to get cron value for job [%s]: %s", jobName.String(), err.Error())
+		return uuid.Nil, err
+	}
+
+	expectedRuns := getExpectedRuns(jobCron, config.StartTime, config.EndTime)
+	if err := validateExcludedRuns(config, expectedRuns); err != nil {
+		l.Error("error validating excluded runs of replay request: %s", err)
 		return uuid.Nil, err
 	}
 
 	replayReq := scheduler.NewReplayRequest(jobName, tenant, config, scheduler.ReplayStateCreated)
 	if err := r.validator.Validate(ctx, replayReq, jobCron); err != nil {
-		r.logger.Error("error validating replay request: %s", err)
+		l.Error("error validating replay request: %s", err)
+		return uuid.Nil, err
+	}
+
+	initialState, err := r.getInitialState(ctx, tenant)
+	if err != nil {
+		l.Error("unable to get active replays of tenant: %s", err)
 		return uuid.Nil, err
 	}
+	replayReq = scheduler.NewReplayRequest(jobName, tenant, config, initialState)
 
-	runs := getExpectedRuns(jobCron, config.StartTime, config.EndTime)
+	runs := config.ExcludeRuns(expectedRuns)
 	replayID, err = r.replayRepo.RegisterReplay(ctx, replayReq, runs)
 	if err != nil {
 		return uuid.Nil, err
@@ -77,6 +112,29 @@ func (r *ReplayService) CreateReplay(ctx context.Context, tenant tenant.Tenant,
 	return replayID, nil
 }
 
+// getInitialState queues the replay in waiting state when the tenant already reached its limit of active replays
+func (r *ReplayService) getInitialState(ctx context.Context, tnnt tenant.Tenant) (scheduler.ReplayState, error) {
+	if r.config.TenantConcurrencyLimit <= 0 {
+		return scheduler.ReplayStateCreated, nil
+	}
+
+	activeReplays, err := r.replayRepo.GetReplayRequestsByStatus(ctx, activeReplayStates)
+	if err != nil {
+		return "", err
+	}
+
+	activeCount := 0
+	for _, replay := range activeReplays {
+		if replay.Tenant() == tnnt {
+			activeCount++
+		}
+	}
+	if activeCount >= r.config.TenantConcurrencyLimit {
+		return scheduler.ReplayStateWaiting, nil
+	}
+	return scheduler.ReplayStateCreated, nil
+}
+
 func (r *ReplayService) GetReplayList(ctx context.Context, projectName tenant.ProjectName) (replays []*scheduler.Replay, err error) {
 	return r.replayRepo.GetReplaysByProject(ctx, projectName, getReplaysDayLimit)
 }
@@ -90,17 +148,174 @@ func (r *ReplayService) GetReplayByID(ctx context.Context, replayID uuid.UUID) (
 	return replayWithRun, nil
 }
 
+// GetReplayDetails returns the replay along with who requested it, the reason and every state transition it went through
+func (r *ReplayService) GetReplayDetails(ctx context.Context, replayID uuid.UUID) (*scheduler.ReplayDetails, error) {
+	replayWithRun, err := r.GetReplayByID(ctx, replayID)
+	if err != nil {
+		return nil, err
+	}
+
+	transitions, err := r.replayRepo.GetReplayStateTransitions(ctx, replayID)
+	if err != nil {
+		r.logger.Error("unable to get state transitions of replay [%s]: %s", replayID.String(), err)
+		return nil, err
+	}
+
+	return &scheduler.ReplayDetails{
+		Replay:      replayWithRun.Replay,
+		Runs:        replayWithRun.Runs,
+		Transitions: transitions,
+	}, nil
+}
+
+// SubscribeReplayStatus streams the current status of the replay followed by every update made on it,
+// the stream is closed once the replay reaches a terminal state or the context is done. The replay is re-read
+// when an update is missed and on every poll interval, catching updates made by the workers of other servers
+func (r *ReplayService) SubscribeReplayStatus(ctx context.Context, replayID uuid.UUID) (<-chan *scheduler.ReplayWithRun, error) {
+	updates, missed, unsubscribe := r.subscriber.Subscribe(replayID)
+
+	current, err := r.GetReplayByID(ctx, replayID)
+	if err != nil {
+		unsubscribe()
+		return nil, err
+	}
+
+	stream := make(chan *scheduler.ReplayWithRun, 1)
+	stream <- current
+	if current.Replay.State().IsTerminal() {
+		unsubscribe()
+		close(stream)
+		return stream, nil
+	}
+
+	go func() {
+		defer close(stream)
+		defer unsubscribe()
+
+		ticker := time.NewTicker(r.statusPollInterval())
+		defer ticker.Stop()
+
+		last := current
+		send := func(update *scheduler.ReplayWithRun) bool {
+			select {
+			case stream <- update:
+			case <-ctx.Done():
+				return false
+			}
+			last = update
+			return !update.Replay.State().IsTerminal()
+		}
+		reload := func() bool {
+			latest, err := r.GetReplayByID(ctx, replayID)
+			if err != nil {
+				r.logger.Error("unable to re-read status of replay [%s]: %s", replayID.String(), err)
+				return ctx.Err() == nil
+			}
+			if !isReplayStatusChanged(last, latest) {
+				return true
+			}
+			return send(latest)
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case update, ok := <-updates:
+				if !ok {
+					return
+				}
+				if !send(update) {
+					return
+				}
+			case <-missed:
+				if !reload() {
+					return
+				}
+			case <-ticker.C:
+				if !reload() {
+					return
+				}
+			}
+		}
+	}()
+	return stream, nil
+}
+
+func (r *ReplayService) statusPollInterval() time.Duration {
+	if r.config.StatusPollInterval > 0 {
+		return r.config.StatusPollInterval
+	}
+	return defaultReplayStatusPollInterval
+}
+
+func isReplayStatusChanged(previous, current *scheduler.ReplayWithRun) bool {
+	if previous.Replay.State() != current.Replay.State() || previous.Replay.Message() != current.Replay.Message() {
+		return true
+	}
+	if len(previous.Runs) != len(current.Runs) {
+		return true
+	}
+	for i := range current.Runs {
+		if !previous.Runs[i].ScheduledAt.Equal(current.Runs[i].ScheduledAt) || previous.Runs[i].State != current.Runs[i].State {
+			return true
+		}
+	}
+	return false
+}
+
+// CreateReplayFromFailedRuns creates a replay of only the failed and missing runs of the job within the requested range,
+// the range is narrowed down to the first and last of those runs and every other run in between is excluded
+func (r *ReplayService) CreateReplayFromFailedRuns(ctx context.Context, tenant tenant.Tenant, jobName scheduler.JobName, config *scheduler.ReplayConfig) (uuid.UUID, error) {
+	l := logging.ForTenant(r.logger, tenant.ProjectName().String(), tenant.NamespaceName().String(), jobName.String())
+	jobCron, err := getJobCron(ctx, r.logger, r.jobRepo, tenant, jobName)
+	if err != nil {
+		l.Error("unable to get cron value for job [%s]: %s", jobName.String(), err.Error())
+		return uuid.Nil, err
+	}
+
+	runs, err := r.getRunsStatus(ctx, tenant, jobName, config, jobCron)
+	if err != nil {
+		l.Error("unable to get runs status of job [%s]: %s", jobName.String(), err)
+		return uuid.Nil, err
+	}
+
+	failedRuns := scheduler.JobRunStatusList(runs).GetSortedRunsByStates(scheduler.ReplayFromFailedStates)
+	if len(failedRuns) == 0 {
+		return uuid.Nil, errors.InvalidArgument(scheduler.EntityReplay, "no failed or missing runs found within the replay range")
+	}
+
+	failedRunsConfig := *config
+	failedRunsConfig.StartTime = failedRuns[0].ScheduledAt.UTC()
+	failedRunsConfig.EndTime = failedRuns[len(failedRuns)-1].ScheduledAt.UTC()
+	failedRunsConfig.ExcludedScheduledAt = nil
+
+	failedRunsMap := scheduler.JobRunStatusList(failedRuns).ToRunStatusMap()
+	for _, run := range getExpectedRuns(jobCron, failedRunsConfig.StartTime, failedRunsConfig.EndTime) {
+		if _, ok := failedRunsMap[run.ScheduledAt.UTC()]; !ok {
+			failedRunsConfig.ExcludedScheduledAt = append(failedRunsConfig.ExcludedScheduledAt, run.ScheduledAt.UTC())
+		}
+	}
+
+	return r.CreateReplay(ctx, tenant, jobName, &failedRunsConfig)
+}
+
 func (r *ReplayService) GetRunsStatus(ctx context.Context, tenant tenant.Tenant, jobName scheduler.JobName, config *scheduler.ReplayConfig) ([]*scheduler.JobRunStatus, error) {
+	l := logging.ForTenant(r.logger, tenant.ProjectName().String(), tenant.NamespaceName().String(), jobName.String())
+	jobCron, err := getJobCron(ctx, r.logger, r.jobRepo, tenant, jobName)
+	if err != nil {
+		l.Error("unable to get cron value for job [%s]: %s", jobName.String(), err.Error())
+		return nil, err
+	}
+	return r.getRunsStatus(ctx, tenant, jobName, config, jobCron)
+}
+
+func (r *ReplayService) getRunsStatus(ctx context.Context, tenant tenant.Tenant, jobName scheduler.JobName, config *scheduler.ReplayConfig, jobCron *cron.ScheduleSpec) ([]*scheduler.JobRunStatus, error) {
 	jobRunCriteria := &scheduler.JobRunsCriteria{
 		Name:      jobName.String(),
 		StartDate: config.StartTime,
 		EndDate:   config.EndTime,
 	}
-	jobCron, err := getJobCron(ctx, r.logger, r.jobRepo, tenant, jobName)
-	if err != nil {
-		r.logger.Error("unable to get cron value for job [%s]: %s", jobName.String(), err.Error())
-		return nil, err
-	}
 	existingRuns, err := r.runGetter.GetJobRuns(ctx, tenant, jobRunCriteria, jobCron)
 	if err != nil {
 		return nil, err
@@ -111,11 +326,31 @@ func (r *ReplayService) GetRunsStatus(ctx context.Context, tenant tenant.Tenant,
 	runs := tobeCreatedRuns
 	runs = append(runs, existingRuns...)
 	runs = scheduler.JobRunStatusList(runs).GetSortedRunsByScheduledAt()
-	return runs, nil
+	return config.ExcludeRuns(runs), nil
+}
+
+// validateExcludedRuns makes sure every excluded run is one of the runs of the replay, and not all of them are excluded
+func validateExcludedRuns(config *scheduler.ReplayConfig, expectedRuns []*scheduler.JobRunStatus) error {
+	if len(config.ExcludedScheduledAt) == 0 {
+		return nil
+	}
+
+	expectedRunsMap := scheduler.JobRunStatusList(expectedRuns).ToRunStatusMap()
+	for _, excluded := range config.ExcludedScheduledAt {
+		if _, ok := expectedRunsMap[excluded.UTC()]; !ok {
+			msg := fmt.Sprintf("excluded run %s is not a scheduled run within the replay range", excluded.UTC().Format(time.RFC3339))
+			return errors.InvalidArgument(scheduler.EntityReplay, msg)
+		}
+	}
+
+	if len(config.ExcludeRuns(expectedRuns)) == 0 {
+		return errors.InvalidArgument(scheduler.EntityReplay, "all runs within the replay range are excluded")
+	}
+	return nil
 }
 
-func NewReplayService(replayRepo ReplayRepository, jobRepo JobRepository, validator ReplayValidator, runGetter SchedulerRunGetter, logger log.Logger) *ReplayService {
-	return &ReplayService{replayRepo: replayRepo, jobRepo: jobRepo, validator: validator, runGetter: runGetter, logger: logger}
+func NewReplayService(replayRepo ReplayRepository, jobRepo JobRepository, validator ReplayValidator, runGetter SchedulerRunGetter, subscriber ReplayStatusSubscriber, logger log.Logger, config config.ReplayConfig) *ReplayService {
+	return &ReplayService{replayRepo: replayRepo, jobRepo: jobRepo, validator: validator, runGetter: runGetter, subscriber: subscriber, logger: logger, config: config}
 }
 
 func getJobCron(ctx context.Context, l log.Logger, jobRepo JobRepository, tnnt tenant.Tenant, jobName scheduler.JobName) (*cron.ScheduleSpec, error) {
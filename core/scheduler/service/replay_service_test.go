@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
+	"github.com/goto/optimus/config"
 	"github.com/goto/optimus/core/scheduler"
 	"github.com/goto/optimus/core/scheduler/service"
 	"github.com/goto/optimus/core/tenant"
@@ -75,7 +76,7 @@ func TestReplayService(t *testing.T) {
 			replayValidator.On("Validate", ctx, replayReq, jobCron).Return(nil)
 			replayRepository.On("RegisterReplay", ctx, replayReq, replayRuns).Return(replayID, nil)
 
-			replayService := service.NewReplayService(replayRepository, jobRepository, replayValidator, nil, logger)
+			replayService := service.NewReplayService(replayRepository, jobRepository, replayValidator, nil, nil, logger, config.ReplayConfig{})
 			result, err := replayService.CreateReplay(ctx, tnnt, jobName, replayConfig)
 			assert.NoError(t, err)
 			assert.Equal(t, replayID, result)
@@ -96,7 +97,7 @@ func TestReplayService(t *testing.T) {
 			jobRepository.On("GetJobDetails", ctx, projName, jobName).Return(jobWithDetails, nil)
 			replayValidator.On("Validate", ctx, replayReq, jobCron).Return(errors.New("not passed validation"))
 
-			replayService := service.NewReplayService(replayRepository, jobRepository, replayValidator, nil, logger)
+			replayService := service.NewReplayService(replayRepository, jobRepository, replayValidator, nil, nil, logger, config.ReplayConfig{})
 			result, err := replayService.CreateReplay(ctx, tnnt, jobName, replayConfig)
 			assert.ErrorContains(t, err, "not passed validation")
 			assert.Equal(t, uuid.Nil, result)
@@ -115,7 +116,7 @@ func TestReplayService(t *testing.T) {
 			internalErr := errors.New("internal error")
 			jobRepository.On("GetJobDetails", ctx, projName, jobName).Return(nil, internalErr)
 
-			replayService := service.NewReplayService(replayRepository, jobRepository, replayValidator, nil, logger)
+			replayService := service.NewReplayService(replayRepository, jobRepository, replayValidator, nil, nil, logger, config.ReplayConfig{})
 			result, err := replayService.CreateReplay(ctx, tnnt, jobName, replayConfig)
 			assert.ErrorIs(t, err, internalErr)
 			assert.Equal(t, uuid.Nil, result)
@@ -135,11 +136,148 @@ func TestReplayService(t *testing.T) {
 
 			jobRepository.On("GetJobDetails", ctx, projName, jobName).Return(jobWithDetails, nil)
 
-			replayService := service.NewReplayService(replayRepository, jobRepository, replayValidator, nil, logger)
+			replayService := service.NewReplayService(replayRepository, jobRepository, replayValidator, nil, nil, logger, config.ReplayConfig{})
 			result, err := replayService.CreateReplay(ctx, invalidTenant, jobName, replayConfig)
 			assert.ErrorContains(t, err, "job sample_select does not exist in invalid-namespace namespace")
 			assert.Equal(t, uuid.Nil, result)
 		})
+		t.Run("should register only the runs which are not excluded", func(t *testing.T) {
+			replayRepository := new(ReplayRepository)
+			defer replayRepository.AssertExpectations(t)
+
+			jobRepository := new(JobRepository)
+			defer jobRepository.AssertExpectations(t)
+
+			replayValidator := new(ReplayValidator)
+			defer replayValidator.AssertExpectations(t)
+
+			scheduledTime1, _ := time.Parse(scheduler.ISODateFormat, "2023-01-03T12:00:00Z")
+			scheduledTime2 := scheduledTime1.Add(24 * time.Hour)
+			configWithExclusion := scheduler.NewReplayConfig(startTime, endTime, parallel, replayJobConfig, description)
+			configWithExclusion.ExcludedScheduledAt = []time.Time{scheduledTime1}
+			replayRuns := []*scheduler.JobRunStatus{
+				{ScheduledAt: scheduledTime2, State: scheduler.StatePending},
+			}
+			replayReq := scheduler.NewReplayRequest(jobName, tnnt, configWithExclusion, scheduler.ReplayStateCreated)
+
+			jobRepository.On("GetJobDetails", ctx, projName, jobName).Return(jobWithDetails, nil)
+			replayValidator.On("Validate", ctx, replayReq, jobCron).Return(nil)
+			replayRepository.On("RegisterReplay", ctx, replayReq, replayRuns).Return(replayID, nil)
+
+			replayService := service.NewReplayService(replayRepository, jobRepository, replayValidator, nil, nil, logger, config.ReplayConfig{})
+			result, err := replayService.CreateReplay(ctx, tnnt, jobName, configWithExclusion)
+			assert.NoError(t, err)
+			assert.Equal(t, replayID, result)
+		})
+
+		t.Run("should return error if excluded run is not a scheduled run within the range", func(t *testing.T) {
+			jobRepository := new(JobRepository)
+			defer jobRepository.AssertExpectations(t)
+
+			configWithExclusion := scheduler.NewReplayConfig(startTime, endTime, parallel, replayJobConfig, description)
+			configWithExclusion.ExcludedScheduledAt = []time.Time{startTime}
+
+			jobRepository.On("GetJobDetails", ctx, projName, jobName).Return(jobWithDetails, nil)
+
+			replayService := service.NewReplayService(nil, jobRepository, nil, nil, nil, logger, config.ReplayConfig{})
+			result, err := replayService.CreateReplay(ctx, tnnt, jobName, configWithExclusion)
+			assert.True(t, errs.IsErrorType(err, errs.ErrInvalidArgument))
+			assert.ErrorContains(t, err, "is not a scheduled run within the replay range")
+			assert.Equal(t, uuid.Nil, result)
+		})
+
+		t.Run("should return error if all runs are excluded", func(t *testing.T) {
+			jobRepository := new(JobRepository)
+			defer jobRepository.AssertExpectations(t)
+
+			scheduledTime1, _ := time.Parse(scheduler.ISODateFormat, "2023-01-03T12:00:00Z")
+			configWithExclusion := scheduler.NewReplayConfig(startTime, endTime, parallel, replayJobConfig, description)
+			configWithExclusion.ExcludedScheduledAt = []time.Time{scheduledTime1, scheduledTime1.Add(24 * time.Hour)}
+
+			jobRepository.On("GetJobDetails", ctx, projName, jobName).Return(jobWithDetails, nil)
+
+			replayService := service.NewReplayService(nil, jobRepository, nil, nil, nil, logger, config.ReplayConfig{})
+			result, err := replayService.CreateReplay(ctx, tnnt, jobName, configWithExclusion)
+			assert.ErrorContains(t, err, "all runs within the replay range are excluded")
+			assert.Equal(t, uuid.Nil, result)
+		})
+
+		t.Run("should register replay in waiting state if tenant reached the concurrency limit", func(t *testing.T) {
+			replayRepository := new(ReplayRepository)
+			defer replayRepository.AssertExpectations(t)
+
+			jobRepository := new(JobRepository)
+			defer jobRepository.AssertExpectations(t)
+
+			replayValidator := new(ReplayValidator)
+			defer replayValidator.AssertExpectations(t)
+
+			otherTnnt, _ := tenant.NewTenant(projName.String(), "ns2")
+			activeReplays := []*scheduler.Replay{
+				scheduler.NewReplay(uuid.New(), "other_job", tnnt, replayConfig, scheduler.ReplayStateInProgress, time.Now()),
+				scheduler.NewReplay(uuid.New(), "other_job", otherTnnt, replayConfig, scheduler.ReplayStateInProgress, time.Now()),
+			}
+			replayReq := scheduler.NewReplayRequest(jobName, tnnt, replayConfig, scheduler.ReplayStateCreated)
+			waitingReplayReq := scheduler.NewReplayRequest(jobName, tnnt, replayConfig, scheduler.ReplayStateWaiting)
+
+			jobRepository.On("GetJobDetails", ctx, projName, jobName).Return(jobWithDetails, nil)
+			replayValidator.On("Validate", ctx, replayReq, jobCron).Return(nil)
+			replayRepository.On("GetReplayRequestsByStatus", ctx, mock.Anything).Return(activeReplays, nil)
+			replayRepository.On("RegisterReplay", ctx, waitingReplayReq, mock.Anything).Return(replayID, nil)
+
+			replayService := service.NewReplayService(replayRepository, jobRepository, replayValidator, nil, nil, logger, config.ReplayConfig{TenantConcurrencyLimit: 1})
+			result, err := replayService.CreateReplay(ctx, tnnt, jobName, replayConfig)
+			assert.NoError(t, err)
+			assert.Equal(t, replayID, result)
+		})
+
+		t.Run("should register replay in created state if tenant is below the concurrency limit", func(t *testing.T) {
+			replayRepository := new(ReplayRepository)
+			defer replayRepository.AssertExpectations(t)
+
+			jobRepository := new(JobRepository)
+			defer jobRepository.AssertExpectations(t)
+
+			replayValidator := new(ReplayValidator)
+			defer replayValidator.AssertExpectations(t)
+
+			activeReplays := []*scheduler.Replay{
+				scheduler.NewReplay(uuid.New(), "other_job", tnnt, replayConfig, scheduler.ReplayStateInProgress, time.Now()),
+			}
+			replayReq := scheduler.NewReplayRequest(jobName, tnnt, replayConfig, scheduler.ReplayStateCreated)
+
+			jobRepository.On("GetJobDetails", ctx, projName, jobName).Return(jobWithDetails, nil)
+			replayValidator.On("Validate", ctx, replayReq, jobCron).Return(nil)
+			replayRepository.On("GetReplayRequestsByStatus", ctx, mock.Anything).Return(activeReplays, nil)
+			replayRepository.On("RegisterReplay", ctx, replayReq, mock.Anything).Return(replayID, nil)
+
+			replayService := service.NewReplayService(replayRepository, jobRepository, replayValidator, nil, nil, logger, config.ReplayConfig{TenantConcurrencyLimit: 2})
+			result, err := replayService.CreateReplay(ctx, tnnt, jobName, replayConfig)
+			assert.NoError(t, err)
+			assert.Equal(t, replayID, result)
+		})
+
+		t.Run("should return error if unable to get active replays of the tenant", func(t *testing.T) {
+			replayRepository := new(ReplayRepository)
+			defer replayRepository.AssertExpectations(t)
+
+			jobRepository := new(JobRepository)
+			defer jobRepository.AssertExpectations(t)
+
+			replayValidator := new(ReplayValidator)
+			defer replayValidator.AssertExpectations(t)
+
+			replayReq := scheduler.NewReplayRequest(jobName, tnnt, replayConfig, scheduler.ReplayStateCreated)
+
+			jobRepository.On("GetJobDetails", ctx, projName, jobName).Return(jobWithDetails, nil)
+			replayValidator.On("Validate", ctx, replayReq, jobCron).Return(nil)
+			replayRepository.On("GetReplayRequestsByStatus", ctx, mock.Anything).Return(nil, errors.New("internal error"))
+
+			replayService := service.NewReplayService(replayRepository, jobRepository, replayValidator, nil, nil, logger, config.ReplayConfig{TenantConcurrencyLimit: 1})
+			result, err := replayService.CreateReplay(ctx, tnnt, jobName, replayConfig)
+			assert.ErrorContains(t, err, "internal error")
+			assert.Equal(t, uuid.Nil, result)
+		})
 	})
 	t.Run("GetReplayList", func(t *testing.T) {
 		t.Run("should return replay list with no error", func(t *testing.T) {
@@ -152,7 +290,7 @@ func TestReplayService(t *testing.T) {
 			replayRepository.On("GetReplaysByProject", ctx, mock.Anything, mock.Anything).Return(replays, nil)
 			defer replayRepository.AssertExpectations(t)
 
-			replayService := service.NewReplayService(replayRepository, nil, nil, nil, logger)
+			replayService := service.NewReplayService(replayRepository, nil, nil, nil, nil, logger, config.ReplayConfig{})
 			result, err := replayService.GetReplayList(ctx, tnnt.ProjectName())
 			assert.NoError(t, err)
 			assert.Len(t, result, 3)
@@ -163,7 +301,7 @@ func TestReplayService(t *testing.T) {
 			replayRepository.On("GetReplaysByProject", ctx, mock.Anything, mock.Anything).Return(nil, errors.New("some error"))
 			defer replayRepository.AssertExpectations(t)
 
-			replayService := service.NewReplayService(replayRepository, nil, nil, nil, logger)
+			replayService := service.NewReplayService(replayRepository, nil, nil, nil, nil, logger, config.ReplayConfig{})
 			result, err := replayService.GetReplayList(ctx, tnnt.ProjectName())
 			assert.Error(t, err)
 			assert.Nil(t, result)
@@ -177,7 +315,7 @@ func TestReplayService(t *testing.T) {
 			replayID := uuid.New()
 			replayRepository.On("GetReplayByID", ctx, replayID).Return(nil, errs.NotFound("entity", "not found"))
 
-			replayService := service.NewReplayService(replayRepository, nil, nil, nil, logger)
+			replayService := service.NewReplayService(replayRepository, nil, nil, nil, nil, logger, config.ReplayConfig{})
 			result, err := replayService.GetReplayByID(ctx, replayID)
 			assert.True(t, errs.IsErrorType(err, errs.ErrNotFound))
 			assert.Empty(t, result)
@@ -189,7 +327,7 @@ func TestReplayService(t *testing.T) {
 			replayID := uuid.New()
 			replayRepository.On("GetReplayByID", ctx, replayID).Return(nil, errors.New("internal error"))
 
-			replayService := service.NewReplayService(replayRepository, nil, nil, nil, logger)
+			replayService := service.NewReplayService(replayRepository, nil, nil, nil, nil, logger, config.ReplayConfig{})
 			result, err := replayService.GetReplayByID(ctx, replayID)
 			assert.Error(t, err)
 			assert.Nil(t, result)
@@ -210,7 +348,7 @@ func TestReplayService(t *testing.T) {
 				},
 			}, nil)
 
-			replayService := service.NewReplayService(replayRepository, nil, nil, nil, logger)
+			replayService := service.NewReplayService(replayRepository, nil, nil, nil, nil, logger, config.ReplayConfig{})
 			result, err := replayService.GetReplayByID(ctx, replayID)
 			assert.NoError(t, err)
 			assert.NotNil(t, result)
@@ -218,6 +356,252 @@ func TestReplayService(t *testing.T) {
 		})
 	})
 
+	t.Run("GetReplayDetails", func(t *testing.T) {
+		t.Run("returns err if get state transitions on replay repo is failed", func(t *testing.T) {
+			replayRepository := new(ReplayRepository)
+			defer replayRepository.AssertExpectations(t)
+
+			replayID := uuid.New()
+			replay := scheduler.NewReplay(replayID, jobName, tnnt, replayConfig, scheduler.ReplayStateReplayed, startTime)
+			replayRepository.On("GetReplayByID", ctx, replayID).Return(&scheduler.ReplayWithRun{Replay: replay}, nil)
+			replayRepository.On("GetReplayStateTransitions", ctx, replayID).Return(nil, errors.New("internal error"))
+
+			replayService := service.NewReplayService(replayRepository, nil, nil, nil, nil, logger, config.ReplayConfig{})
+			result, err := replayService.GetReplayDetails(ctx, replayID)
+			assert.Error(t, err)
+			assert.Nil(t, result)
+		})
+		t.Run("returns replay with runs and state transitions", func(t *testing.T) {
+			replayRepository := new(ReplayRepository)
+			defer replayRepository.AssertExpectations(t)
+
+			replayID := uuid.New()
+			auditedConfig := scheduler.NewReplayConfig(startTime, endTime, false, map[string]string{}, "")
+			auditedConfig.RequestedBy = "optimus@example.com"
+			auditedConfig.Reason = "upstream data correction"
+			replay := scheduler.NewReplay(replayID, jobName, tnnt, auditedConfig, scheduler.ReplayStateSuccess, startTime)
+			runs := []*scheduler.JobRunStatus{
+				{
+					ScheduledAt: startTime,
+					State:       scheduler.StateSuccess,
+				},
+			}
+			transitions := []*scheduler.ReplayStateTransition{
+				{State: scheduler.ReplayStateCreated, CreatedAt: startTime},
+				{State: scheduler.ReplayStateReplayed, CreatedAt: startTime.Add(time.Minute)},
+				{State: scheduler.ReplayStateSuccess, CreatedAt: startTime.Add(time.Hour)},
+			}
+			replayRepository.On("GetReplayByID", ctx, replayID).Return(&scheduler.ReplayWithRun{Replay: replay, Runs: runs}, nil)
+			replayRepository.On("GetReplayStateTransitions", ctx, replayID).Return(transitions, nil)
+
+			replayService := service.NewReplayService(replayRepository, nil, nil, nil, nil, logger, config.ReplayConfig{})
+			result, err := replayService.GetReplayDetails(ctx, replayID)
+			assert.NoError(t, err)
+			assert.Equal(t, "optimus@example.com", result.Replay.Config().RequestedBy)
+			assert.Equal(t, "upstream data correction", result.Replay.Config().Reason)
+			assert.Equal(t, runs, result.Runs)
+			assert.Equal(t, transitions, result.Transitions)
+		})
+	})
+
+	t.Run("SubscribeReplayStatus", func(t *testing.T) {
+		t.Run("returns error if replay is not found", func(t *testing.T) {
+			replayRepository := new(ReplayRepository)
+			defer replayRepository.AssertExpectations(t)
+
+			replayID := uuid.New()
+			replayRepository.On("GetReplayByID", ctx, replayID).Return(nil, errs.NotFound("entity", "not found"))
+
+			replayService := service.NewReplayService(replayRepository, nil, nil, nil, service.NewReplayBroadcaster(), logger, config.ReplayConfig{})
+			stream, err := replayService.SubscribeReplayStatus(ctx, replayID)
+			assert.True(t, errs.IsErrorType(err, errs.ErrNotFound))
+			assert.Nil(t, stream)
+		})
+		t.Run("closes the stream after current status if replay is already finished", func(t *testing.T) {
+			replayRepository := new(ReplayRepository)
+			defer replayRepository.AssertExpectations(t)
+
+			replayID := uuid.New()
+			replay := scheduler.NewReplay(replayID, jobName, tnnt, replayConfig, scheduler.ReplayStateSuccess, startTime)
+			replayRepository.On("GetReplayByID", ctx, replayID).Return(&scheduler.ReplayWithRun{Replay: replay}, nil)
+
+			replayService := service.NewReplayService(replayRepository, nil, nil, nil, service.NewReplayBroadcaster(), logger, config.ReplayConfig{})
+			stream, err := replayService.SubscribeReplayStatus(ctx, replayID)
+			assert.NoError(t, err)
+
+			var received []*scheduler.ReplayWithRun
+			for update := range stream {
+				received = append(received, update)
+			}
+			assert.Len(t, received, 1)
+			assert.Equal(t, scheduler.ReplayStateSuccess, received[0].Replay.State())
+		})
+		t.Run("streams published updates until replay reaches terminal state", func(t *testing.T) {
+			replayRepository := new(ReplayRepository)
+			defer replayRepository.AssertExpectations(t)
+
+			replayID := uuid.New()
+			replay := scheduler.NewReplay(replayID, jobName, tnnt, replayConfig, scheduler.ReplayStateCreated, startTime)
+			replayRepository.On("GetReplayByID", ctx, replayID).Return(&scheduler.ReplayWithRun{Replay: replay}, nil)
+
+			broadcaster := service.NewReplayBroadcaster()
+			replayService := service.NewReplayService(replayRepository, nil, nil, nil, broadcaster, logger, config.ReplayConfig{})
+			stream, err := replayService.SubscribeReplayStatus(ctx, replayID)
+			assert.NoError(t, err)
+
+			current := <-stream
+			assert.Equal(t, scheduler.ReplayStateCreated, current.Replay.State())
+
+			broadcaster.Publish(&scheduler.ReplayWithRun{
+				Replay: scheduler.NewReplay(replayID, jobName, tnnt, replayConfig, scheduler.ReplayStateReplayed, startTime),
+			})
+			broadcaster.Publish(&scheduler.ReplayWithRun{
+				Replay: scheduler.NewReplay(replayID, jobName, tnnt, replayConfig, scheduler.ReplayStateSuccess, startTime),
+			})
+
+			var states []scheduler.ReplayState
+			for update := range stream {
+				states = append(states, update.Replay.State())
+			}
+			assert.Equal(t, []scheduler.ReplayState{scheduler.ReplayStateReplayed, scheduler.ReplayStateSuccess}, states)
+		})
+		t.Run("re-reads replay when an update is missed", func(t *testing.T) {
+			replayRepository := new(ReplayRepository)
+			defer replayRepository.AssertExpectations(t)
+
+			replayID := uuid.New()
+			replay := scheduler.NewReplay(replayID, jobName, tnnt, replayConfig, scheduler.ReplayStateInProgress, startTime)
+			finishedReplay := scheduler.NewReplay(replayID, jobName, tnnt, replayConfig, scheduler.ReplayStateSuccess, startTime)
+			replayRepository.On("GetReplayByID", ctx, replayID).Return(&scheduler.ReplayWithRun{Replay: replay}, nil).Once()
+			replayRepository.On("GetReplayByID", ctx, replayID).Return(&scheduler.ReplayWithRun{Replay: finishedReplay}, nil).Once()
+
+			subscriber := &replayStatusSubscriber{
+				updates: make(chan *scheduler.ReplayWithRun),
+				missed:  make(chan struct{}, 1),
+			}
+			replayService := service.NewReplayService(replayRepository, nil, nil, nil, subscriber, logger, config.ReplayConfig{})
+			stream, err := replayService.SubscribeReplayStatus(ctx, replayID)
+			assert.NoError(t, err)
+
+			current := <-stream
+			assert.Equal(t, scheduler.ReplayStateInProgress, current.Replay.State())
+
+			subscriber.missed <- struct{}{}
+
+			var states []scheduler.ReplayState
+			for update := range stream {
+				states = append(states, update.Replay.State())
+			}
+			assert.Equal(t, []scheduler.ReplayState{scheduler.ReplayStateSuccess}, states)
+		})
+		t.Run("polls replay for the updates made by other servers", func(t *testing.T) {
+			replayRepository := new(ReplayRepository)
+			defer replayRepository.AssertExpectations(t)
+
+			replayID := uuid.New()
+			replay := scheduler.NewReplay(replayID, jobName, tnnt, replayConfig, scheduler.ReplayStateInProgress, startTime)
+			failedReplay := scheduler.NewReplay(replayID, jobName, tnnt, replayConfig, scheduler.ReplayStateFailed, startTime)
+			replayRepository.On("GetReplayByID", ctx, replayID).Return(&scheduler.ReplayWithRun{Replay: replay}, nil).Twice()
+			replayRepository.On("GetReplayByID", ctx, replayID).Return(&scheduler.ReplayWithRun{Replay: failedReplay}, nil).Once()
+
+			replayService := service.NewReplayService(replayRepository, nil, nil, nil, service.NewReplayBroadcaster(), logger,
+				config.ReplayConfig{StatusPollInterval: 10 * time.Millisecond})
+			stream, err := replayService.SubscribeReplayStatus(ctx, replayID)
+			assert.NoError(t, err)
+
+			var states []scheduler.ReplayState
+			for update := range stream {
+				states = append(states, update.Replay.State())
+			}
+			assert.Equal(t, []scheduler.ReplayState{scheduler.ReplayStateInProgress, scheduler.ReplayStateFailed}, states)
+		})
+	})
+
+	t.Run("CreateReplayFromFailedRuns", func(t *testing.T) {
+		failedRunsReplayConfig := scheduler.NewReplayConfig(startTime, startTime.Add(96*time.Hour), parallel, replayJobConfig, description)
+		scheduledTime1, _ := time.Parse(scheduler.ISODateFormat, "2023-01-03T12:00:00Z")
+		scheduledTime2 := scheduledTime1.Add(24 * time.Hour)
+		scheduledTime3 := scheduledTime2.Add(24 * time.Hour)
+		scheduledTime4 := scheduledTime3.Add(24 * time.Hour)
+
+		t.Run("should replay only the failed and missing runs within the range", func(t *testing.T) {
+			replayRepository := new(ReplayRepository)
+			defer replayRepository.AssertExpectations(t)
+
+			jobRepository := new(JobRepository)
+			defer jobRepository.AssertExpectations(t)
+
+			replayValidator := new(ReplayValidator)
+			defer replayValidator.AssertExpectations(t)
+
+			schedulerRunGetter := new(mockScheduler)
+			defer schedulerRunGetter.AssertExpectations(t)
+
+			existingRuns := []*scheduler.JobRunStatus{
+				{ScheduledAt: scheduledTime1, State: scheduler.StateSuccess},
+				{ScheduledAt: scheduledTime2, State: scheduler.StateFailed},
+				{ScheduledAt: scheduledTime3, State: scheduler.StateSuccess},
+			}
+			replayRuns := []*scheduler.JobRunStatus{
+				{ScheduledAt: scheduledTime2, State: scheduler.StatePending},
+				{ScheduledAt: scheduledTime4, State: scheduler.StatePending},
+			}
+			isFailedRunsReplay := mock.MatchedBy(func(replay *scheduler.Replay) bool {
+				return replay.Config().StartTime.Equal(scheduledTime2) && replay.Config().EndTime.Equal(scheduledTime4) &&
+					len(replay.Config().ExcludedScheduledAt) == 1 && replay.Config().ExcludedScheduledAt[0].Equal(scheduledTime3)
+			})
+
+			jobRepository.On("GetJobDetails", ctx, projName, jobName).Return(jobWithDetails, nil)
+			schedulerRunGetter.On("GetJobRuns", ctx, tnnt, mock.Anything, jobCron).Return(existingRuns, nil)
+			replayValidator.On("Validate", ctx, isFailedRunsReplay, jobCron).Return(nil)
+			replayRepository.On("RegisterReplay", ctx, isFailedRunsReplay, replayRuns).Return(replayID, nil)
+
+			replayService := service.NewReplayService(replayRepository, jobRepository, replayValidator, schedulerRunGetter, nil, logger, config.ReplayConfig{})
+			result, err := replayService.CreateReplayFromFailedRuns(ctx, tnnt, jobName, failedRunsReplayConfig)
+			assert.NoError(t, err)
+			assert.Equal(t, replayID, result)
+		})
+
+		t.Run("should return error if there is no failed or missing run within the range", func(t *testing.T) {
+			jobRepository := new(JobRepository)
+			defer jobRepository.AssertExpectations(t)
+
+			schedulerRunGetter := new(mockScheduler)
+			defer schedulerRunGetter.AssertExpectations(t)
+
+			existingRuns := []*scheduler.JobRunStatus{
+				{ScheduledAt: scheduledTime1, State: scheduler.StateSuccess},
+				{ScheduledAt: scheduledTime2, State: scheduler.StateSuccess},
+				{ScheduledAt: scheduledTime3, State: scheduler.StateSuccess},
+				{ScheduledAt: scheduledTime4, State: scheduler.StateRunning},
+			}
+
+			jobRepository.On("GetJobDetails", ctx, projName, jobName).Return(jobWithDetails, nil)
+			schedulerRunGetter.On("GetJobRuns", ctx, tnnt, mock.Anything, jobCron).Return(existingRuns, nil)
+
+			replayService := service.NewReplayService(nil, jobRepository, nil, schedulerRunGetter, nil, logger, config.ReplayConfig{})
+			result, err := replayService.CreateReplayFromFailedRuns(ctx, tnnt, jobName, failedRunsReplayConfig)
+			assert.ErrorContains(t, err, "no failed or missing runs found")
+			assert.Equal(t, uuid.Nil, result)
+		})
+
+		t.Run("should return error if unable to get job runs", func(t *testing.T) {
+			jobRepository := new(JobRepository)
+			defer jobRepository.AssertExpectations(t)
+
+			schedulerRunGetter := new(mockScheduler)
+			defer schedulerRunGetter.AssertExpectations(t)
+
+			jobRepository.On("GetJobDetails", ctx, projName, jobName).Return(jobWithDetails, nil)
+			schedulerRunGetter.On("GetJobRuns", ctx, tnnt, mock.Anything, jobCron).Return(nil, errors.New("internal error"))
+
+			replayService := service.NewReplayService(nil, jobRepository, nil, schedulerRunGetter, nil, logger, config.ReplayConfig{})
+			result, err := replayService.CreateReplayFromFailedRuns(ctx, tnnt, jobName, failedRunsReplayConfig)
+			assert.ErrorContains(t, err, "internal error")
+			assert.Equal(t, uuid.Nil, result)
+		})
+	})
+
 	t.Run("GetRunsStatus", func(t *testing.T) {
 		t.Run("returns error when unable to get cron value", func(t *testing.T) {
 			jobRepository := new(JobRepository)
@@ -225,7 +609,7 @@ func TestReplayService(t *testing.T) {
 
 			jobRepository.On("GetJobDetails", mock.Anything, projName, jobName).Return(nil, errors.New("internal error"))
 
-			replayService := service.NewReplayService(nil, jobRepository, nil, nil, logger)
+			replayService := service.NewReplayService(nil, jobRepository, nil, nil, nil, logger, config.ReplayConfig{})
 			result, err := replayService.GetRunsStatus(ctx, tnnt, jobName, replayConfig)
 			assert.Error(t, err)
 			assert.Nil(t, result)
@@ -240,7 +624,7 @@ func TestReplayService(t *testing.T) {
 			jobRepository.On("GetJobDetails", mock.Anything, projName, jobName).Return(jobWithDetails, nil)
 			schedulerRunGetter.On("GetJobRuns", ctx, tnnt, mock.Anything, mock.Anything).Return(nil, errors.New("internal error"))
 
-			replayService := service.NewReplayService(nil, jobRepository, nil, schedulerRunGetter, logger)
+			replayService := service.NewReplayService(nil, jobRepository, nil, schedulerRunGetter, nil, logger, config.ReplayConfig{})
 			result, err := replayService.GetRunsStatus(ctx, tnnt, jobName, replayConfig)
 			assert.Error(t, err)
 			assert.Nil(t, result)
@@ -257,7 +641,7 @@ func TestReplayService(t *testing.T) {
 			jobRepository.On("GetJobDetails", mock.Anything, projName, jobName).Return(jobWithDetails, nil)
 			schedulerRunGetter.On("GetJobRuns", ctx, tnnt, mock.Anything, mock.Anything).Return(runs, nil)
 
-			replayService := service.NewReplayService(nil, jobRepository, nil, schedulerRunGetter, logger)
+			replayService := service.NewReplayService(nil, jobRepository, nil, schedulerRunGetter, nil, logger, config.ReplayConfig{})
 			result, err := replayService.GetRunsStatus(ctx, tnnt, jobName, replayConfig)
 			assert.NoError(t, err)
 			assert.NotNil(t, result)
@@ -282,7 +666,7 @@ func TestReplayService(t *testing.T) {
 			jobRepository.On("GetJobDetails", mock.Anything, projName, jobName).Return(jobWithDetails, nil)
 			schedulerRunGetter.On("GetJobRuns", ctx, tnnt, mock.Anything, mock.Anything).Return(runs, nil)
 
-			replayService := service.NewReplayService(nil, jobRepository, nil, schedulerRunGetter, logger)
+			replayService := service.NewReplayService(nil, jobRepository, nil, schedulerRunGetter, nil, logger, config.ReplayConfig{})
 			result, err := replayService.GetRunsStatus(ctx, tnnt, jobName, replayConfig)
 			assert.NoError(t, err)
 			assert.NotNil(t, result)
@@ -350,6 +734,29 @@ func (_m *ReplayRepository) GetReplayByID(ctx context.Context, replayID uuid.UUI
 	return r0, r1
 }
 
+// GetReplayStateTransitions provides a mock function with given fields: ctx, replayID
+func (_m *ReplayRepository) GetReplayStateTransitions(ctx context.Context, replayID uuid.UUID) ([]*scheduler.ReplayStateTransition, error) {
+	ret := _m.Called(ctx, replayID)
+
+	var r0 []*scheduler.ReplayStateTransition
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*scheduler.ReplayStateTransition); ok {
+		r0 = rf(ctx, replayID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*scheduler.ReplayStateTransition)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, replayID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetReplayRequestsByStatus provides a mock function with given fields: ctx, statusList
 func (_m *ReplayRepository) GetReplayRequestsByStatus(ctx context.Context, statusList []scheduler.ReplayState) ([]*scheduler.Replay, error) {
 	ret := _m.Called(ctx, statusList)
@@ -396,6 +803,57 @@ func (_m *ReplayRepository) GetReplayToExecute(_a0 context.Context) (*scheduler.
 	return r0, r1
 }
 
+// GetReplayToResume provides a mock function with given fields: ctx, leaseTimeout
+func (_m *ReplayRepository) GetReplayToResume(ctx context.Context, leaseTimeout time.Duration) (*scheduler.Replay, error) {
+	ret := _m.Called(ctx, leaseTimeout)
+
+	var r0 *scheduler.Replay
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) *scheduler.Replay); ok {
+		r0 = rf(ctx, leaseTimeout)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*scheduler.Replay)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, time.Duration) error); ok {
+		r1 = rf(ctx, leaseTimeout)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RenewReplayLease provides a mock function with given fields: ctx, replayID
+func (_m *ReplayRepository) RenewReplayLease(ctx context.Context, replayID uuid.UUID) error {
+	ret := _m.Called(ctx, replayID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, replayID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReleaseReplayLease provides a mock function with given fields: ctx, replayID
+func (_m *ReplayRepository) ReleaseReplayLease(ctx context.Context, replayID uuid.UUID) error {
+	ret := _m.Called(ctx, replayID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, replayID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // RegisterReplay provides a mock function with given fields: ctx, replay, runs
 func (_m *ReplayRepository) RegisterReplay(ctx context.Context, replay *scheduler.Replay, runs []*scheduler.JobRunStatus) (uuid.UUID, error) {
 	ret := _m.Called(ctx, replay, runs)
@@ -490,3 +948,12 @@ func (_m *ReplayValidator) Validate(ctx context.Context, replayRequest *schedule
 
 	return r0
 }
+
+type replayStatusSubscriber struct {
+	updates chan *scheduler.ReplayWithRun
+	missed  chan struct{}
+}
+
+func (s *replayStatusSubscriber) Subscribe(uuid.UUID) (<-chan *scheduler.ReplayWithRun, <-chan struct{}, func()) {
+	return s.updates, s.missed, func() {}
+}
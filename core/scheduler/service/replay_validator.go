@@ -12,7 +12,7 @@ import (
 )
 
 var replayStatusToValidate = []scheduler.ReplayState{
-	scheduler.ReplayStateCreated, scheduler.ReplayStateInProgress,
+	scheduler.ReplayStateCreated, scheduler.ReplayStateWaiting, scheduler.ReplayStateInProgress,
 	scheduler.ReplayStatePartialReplayed, scheduler.ReplayStateReplayed,
 }
 
@@ -38,6 +38,29 @@ func (v Validator) Validate(ctx context.Context, replayRequest *scheduler.Replay
 	return v.validateConflictedRun(ctx, replayRequest, jobCron)
 }
 
+// ActiveTenantValidator rejects the replays in archived projects and namespaces, leaving the rest of the validation
+// to the wrapped validator
+type ActiveTenantValidator struct {
+	validator     ReplayValidator
+	tenantService TenantService
+}
+
+func NewActiveTenantValidator(validator ReplayValidator, tenantService TenantService) *ActiveTenantValidator {
+	return &ActiveTenantValidator{validator: validator, tenantService: tenantService}
+}
+
+func (v ActiveTenantValidator) Validate(ctx context.Context, replayRequest *scheduler.Replay, jobCron *cron.ScheduleSpec) error {
+	tenantDetails, err := v.tenantService.GetDetails(ctx, replayRequest.Tenant())
+	if err != nil {
+		return err
+	}
+	if tenantDetails.IsArchived() {
+		return errors.NewError(errors.ErrFailedPrecond, scheduler.EntityReplay,
+			fmt.Sprintf("tenant is archived: project %s, namespace %s", replayRequest.Tenant().ProjectName(), replayRequest.Tenant().NamespaceName()))
+	}
+	return v.validator.Validate(ctx, replayRequest, jobCron)
+}
+
 func (v Validator) validateDateRange(ctx context.Context, replayRequest *scheduler.Replay) error {
 	jobSpec, err := v.jobRepo.GetJobDetails(ctx, replayRequest.Tenant().ProjectName(), replayRequest.JobName())
 	if err != nil {
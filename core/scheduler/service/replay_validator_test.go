@@ -35,7 +35,7 @@ func TestReplayValidator(t *testing.T) {
 	scheduledTimeStr1 := "2023-01-02T12:00:00Z"
 	scheduledTime1, _ := time.Parse(scheduler.ISODateFormat, scheduledTimeStr1)
 	replayStatusToValidate := []scheduler.ReplayState{
-		scheduler.ReplayStateCreated, scheduler.ReplayStateInProgress,
+		scheduler.ReplayStateCreated, scheduler.ReplayStateWaiting, scheduler.ReplayStateInProgress,
 		scheduler.ReplayStatePartialReplayed, scheduler.ReplayStateReplayed,
 	}
 	replayReq := scheduler.NewReplayRequest(jobName, tnnt, replayConfig, scheduler.ReplayStateCreated)
@@ -234,4 +234,43 @@ func TestReplayValidator(t *testing.T) {
 			assert.ErrorIs(t, err, internalErr)
 		})
 	})
+	t.Run("ActiveTenantValidator", func(t *testing.T) {
+		project, _ := tenant.NewProject(tnnt.ProjectName().String(), map[string]string{
+			tenant.ProjectSchedulerHost:  "host",
+			tenant.ProjectStoragePathKey: "gs://location",
+		})
+		namespace, _ := tenant.NewNamespace(tnnt.NamespaceName().String(), project.Name(), map[string]string{})
+
+		t.Run("should return error if the tenant is archived", func(t *testing.T) {
+			archivedProject, _ := tenant.NewProject(project.Name().String(), project.GetConfigs())
+			archivedProject.SetArchived(true)
+			archivedDetails, _ := tenant.NewTenantDetails(archivedProject, namespace, nil)
+
+			tenantService := new(mockTenantService)
+			tenantService.On("GetDetails", ctx, tnnt).Return(archivedDetails, nil)
+			defer tenantService.AssertExpectations(t)
+
+			replayValidator := new(ReplayValidator)
+			defer replayValidator.AssertExpectations(t)
+
+			validator := service.NewActiveTenantValidator(replayValidator, tenantService)
+			err := validator.Validate(ctx, replayReq, jobCron)
+			assert.EqualError(t, err, "failed precondition for entity replay: tenant is archived: project sample-project, namespace sample-namespace")
+		})
+		t.Run("should delegate the validation if the tenant is active", func(t *testing.T) {
+			details, _ := tenant.NewTenantDetails(project, namespace, nil)
+
+			tenantService := new(mockTenantService)
+			tenantService.On("GetDetails", ctx, tnnt).Return(details, nil)
+			defer tenantService.AssertExpectations(t)
+
+			replayValidator := new(ReplayValidator)
+			replayValidator.On("Validate", ctx, replayReq, jobCron).Return(nil)
+			defer replayValidator.AssertExpectations(t)
+
+			validator := service.NewActiveTenantValidator(replayValidator, tenantService)
+			err := validator.Validate(ctx, replayReq, jobCron)
+			assert.NoError(t, err)
+		})
+	})
 }
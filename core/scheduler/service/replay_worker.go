@@ -4,8 +4,11 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/google/uuid"
 	"github.com/goto/salt/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/net/context"
 
 	"github.com/goto/optimus/config"
@@ -28,37 +31,90 @@ type ReplayScheduler interface {
 	GetJobRuns(ctx context.Context, t tenant.Tenant, criteria *scheduler.JobRunsCriteria, jobCron *cron.ScheduleSpec) ([]*scheduler.JobRunStatus, error)
 }
 
+type ReplayStatusPublisher interface {
+	Publish(replay *scheduler.ReplayWithRun)
+}
+
 type ReplayWorker struct {
 	l log.Logger
 
 	replayRepo ReplayRepository
 	scheduler  ReplayScheduler
 
-	jobRepo JobRepository
+	jobRepo   JobRepository
+	publisher ReplayStatusPublisher
 
 	config config.ReplayConfig
 }
 
-func NewReplayWorker(l log.Logger, replayRepo ReplayRepository, scheduler ReplayScheduler, jobRepo JobRepository, config config.ReplayConfig) *ReplayWorker {
-	return &ReplayWorker{l: l, replayRepo: replayRepo, scheduler: scheduler, jobRepo: jobRepo, config: config}
+func NewReplayWorker(l log.Logger, replayRepo ReplayRepository, scheduler ReplayScheduler, jobRepo JobRepository, publisher ReplayStatusPublisher, config config.ReplayConfig) *ReplayWorker {
+	return &ReplayWorker{l: l, replayRepo: replayRepo, scheduler: scheduler, jobRepo: jobRepo, publisher: publisher, config: config}
 }
 
 type JobReplayRunService interface {
 	GetJobRuns(ctx context.Context, projectName tenant.ProjectName, jobName scheduler.JobName, criteria *scheduler.JobRunsCriteria) ([]*scheduler.JobRunStatus, error)
 }
 
-func (w ReplayWorker) Process(replayReq *scheduler.ReplayWithRun) {
-	ctx := context.Background()
+// Process moves the replay to its next state. The replay stops at its next step once the drain context is done,
+// and is checkpointed to be resumed.
+func (w ReplayWorker) Process(drainCtx context.Context, replayReq *scheduler.ReplayWithRun) {
+	ctx, span := startReplaySpan(newReplayContext(drainCtx), "ReplayWorker.Process", replayReq.Replay)
+	defer span.End()
+
+	if isDrained(ctx) {
+		w.checkpoint(ctx, replayReq)
+		return
+	}
 
 	w.l.Debug("processing replay request %s with status %s", replayReq.Replay.ID().String(), replayReq.Replay.State().String())
 	jobCron, err := getJobCron(ctx, w.l, w.jobRepo, replayReq.Replay.Tenant(), replayReq.Replay.JobName())
 	if err != nil {
 		w.l.Error("unable to get cron value for job [%s] replay id [%s]: %s", replayReq.Replay.JobName().String(), replayReq.Replay.ID().String(), err)
-		w.updateReplayAsFailed(ctx, replayReq.Replay.ID(), err.Error())
-		raiseReplayMetric(replayReq.Replay.Tenant(), replayReq.Replay.JobName(), scheduler.ReplayStateFailed)
+		w.updateReplayAsFailed(ctx, replayReq, err.Error())
+		raiseReplayMetric(replayReq.Replay, scheduler.ReplayStateFailed)
+		return
+	}
+
+	w.process(ctx, replayReq, jobCron)
+}
+
+// Resume continues a replay which was stranded in the middle of processing, e.g. due to server restart. Runs which are
+// already active on scheduler are reconciled first, so they are not cleared nor created once more.
+func (w ReplayWorker) Resume(drainCtx context.Context, replayReq *scheduler.ReplayWithRun) {
+	ctx, span := startReplaySpan(newReplayContext(drainCtx), "ReplayWorker.Resume", replayReq.Replay)
+	defer span.End()
+
+	if isDrained(ctx) {
+		w.checkpoint(ctx, replayReq)
+		return
+	}
+
+	w.l.Info("resuming replay request %s with status %s", replayReq.Replay.ID().String(), replayReq.Replay.State().String())
+	jobCron, err := getJobCron(ctx, w.l, w.jobRepo, replayReq.Replay.Tenant(), replayReq.Replay.JobName())
+	if err != nil {
+		w.l.Error("unable to get cron value for job [%s] replay id [%s]: %s", replayReq.Replay.JobName().String(), replayReq.Replay.ID().String(), err)
+		w.updateReplayAsFailed(ctx, replayReq, err.Error())
+		raiseReplayMetric(replayReq.Replay, scheduler.ReplayStateFailed)
+		return
+	}
+
+	reconciledReq, err := w.reconcileRuns(ctx, replayReq, jobCron)
+	if err != nil && isDrained(ctx) {
+		w.checkpoint(ctx, replayReq)
+		return
+	}
+	if err != nil {
+		w.l.Error("unable to reconcile runs of replay [%s]: %s", replayReq.Replay.ID().String(), err)
+		w.updateReplayAsFailed(ctx, replayReq, err.Error())
+		raiseReplayMetric(replayReq.Replay, scheduler.ReplayStateFailed)
 		return
 	}
 
+	w.process(ctx, reconciledReq, jobCron)
+}
+
+func (w ReplayWorker) process(ctx context.Context, replayReq *scheduler.ReplayWithRun, jobCron *cron.ScheduleSpec) {
+	var err error
 	switch replayReq.Replay.State() {
 	case scheduler.ReplayStateCreated:
 		err = w.processNewReplayRequest(ctx, replayReq, jobCron)
@@ -68,13 +124,56 @@ func (w ReplayWorker) Process(replayReq *scheduler.ReplayWithRun) {
 		err = w.processReplayedRequest(ctx, replayReq, jobCron)
 	}
 
+	if err != nil && isDrained(ctx) {
+		w.l.Warn("replay [%s] is drained while processing: %s", replayReq.Replay.ID().String(), err)
+		w.checkpoint(ctx, replayReq)
+		return
+	}
 	if err != nil {
 		w.l.Error("error encountered when processing replay request: %s", err)
-		w.updateReplayAsFailed(ctx, replayReq.Replay.ID(), err.Error())
-		raiseReplayMetric(replayReq.Replay.Tenant(), replayReq.Replay.JobName(), scheduler.ReplayStateFailed)
+		w.updateReplayAsFailed(ctx, replayReq, err.Error())
+		raiseReplayMetric(replayReq.Replay, scheduler.ReplayStateFailed)
+		span := trace.SpanFromContext(ctx)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
 	}
 }
 
+// reconcileRuns marks the pending runs which are already queued or running on scheduler as in progress
+func (w ReplayWorker) reconcileRuns(ctx context.Context, replayReq *scheduler.ReplayWithRun, jobCron *cron.ScheduleSpec) (*scheduler.ReplayWithRun, error) {
+	incomingRuns, err := w.fetchRuns(ctx, replayReq, jobCron)
+	if err != nil {
+		return nil, err
+	}
+
+	incomingRunStatusMap := scheduler.JobRunStatusList(incomingRuns).ToRunStatusMap()
+	updatedReplayMap := make(map[time.Time]scheduler.State)
+	for _, run := range replayReq.Runs {
+		if run.State != scheduler.StatePending {
+			continue
+		}
+		switch incomingRunStatusMap[run.ScheduledAt.UTC()] {
+		case scheduler.StateQueued, scheduler.StateRunning, scheduler.StateInProgress:
+			updatedReplayMap[run.ScheduledAt.UTC()] = scheduler.StateInProgress
+		}
+	}
+	if len(updatedReplayMap) == 0 {
+		return replayReq, nil
+	}
+	w.l.Info("found %d runs of replay [%s] already active on scheduler", len(updatedReplayMap), replayReq.Replay.ID().String())
+
+	// a sequential replay should wait for the active run instead of replaying the next one
+	state := replayReq.Replay.State()
+	if state == scheduler.ReplayStateCreated && !replayReq.Replay.Config().Parallel {
+		state = scheduler.ReplayStatePartialReplayed
+	}
+	replay := replayReq.Replay
+	return &scheduler.ReplayWithRun{
+		Replay: scheduler.NewReplay(replay.ID(), replay.JobName(), replay.Tenant(), replay.Config(), state, replay.CreatedAt()),
+		Runs:   scheduler.JobRunStatusList(replayReq.Runs).MergeWithUpdatedRuns(updatedReplayMap),
+	}, nil
+}
+
 func (w ReplayWorker) createMissingRuns(ctx context.Context, replayReq *scheduler.ReplayWithRun, jobCron *cron.ScheduleSpec) error {
 	// fetch runs within range of replay range
 	existedRuns, err := w.fetchRuns(ctx, replayReq, jobCron)
@@ -88,9 +187,11 @@ func (w ReplayWorker) createMissingRuns(ctx context.Context, replayReq *schedule
 	me := errors.NewMultiError("create runs")
 	for _, run := range runsToBeCreated {
 		// create missing runs
-		if err := w.scheduler.CreateRun(ctx, replayReq.Replay.Tenant(), replayReq.Replay.JobName(), run.GetLogicalTime(jobCron), prefixReplayed); err != nil {
-			me.Append(err)
-		}
+		logicalTime := run.GetLogicalTime(jobCron)
+		err := w.withRetry(ctx, "create run", func() error {
+			return w.scheduler.CreateRun(ctx, replayReq.Replay.Tenant(), replayReq.Replay.JobName(), logicalTime, prefixReplayed)
+		})
+		me.Append(err)
 	}
 
 	return me.ToErr()
@@ -127,16 +228,24 @@ func (w ReplayWorker) processNewReplayRequest(ctx context.Context, replayReq *sc
 		w.l.Error("unable to update replay state for replay_id [%s]: %s", replayReq.Replay.ID().String(), err)
 		return err
 	}
-	raiseReplayMetric(replayReq.Replay.Tenant(), replayReq.Replay.JobName(), state)
+	traceReplayTransition(ctx, state, updatedRuns)
+	w.publishReplayUpdate(replayReq.Replay, state, updatedRuns)
+	raiseReplayMetric(replayReq.Replay, state)
 	return nil
 }
 
 func (w ReplayWorker) processNewReplayRequestParallel(ctx context.Context, replayReq *scheduler.ReplayWithRun, jobCron *cron.ScheduleSpec) ([]*scheduler.JobRunStatus, error) {
-	startLogicalTime := replayReq.GetFirstExecutableRun().GetLogicalTime(jobCron)
-	endLogicalTime := replayReq.GetLastExecutableRun().GetLogicalTime(jobCron)
-	if err := w.scheduler.ClearBatch(ctx, replayReq.Replay.Tenant(), replayReq.Replay.JobName(), startLogicalTime, endLogicalTime); err != nil {
-		w.l.Error("unable to clear job run for replay with replay_id [%s]: %s", replayReq.Replay.ID().String(), err)
-		return nil, err
+	// excluded and already active runs are kept out of the cleared batches
+	for _, batch := range splitPendingRunsIntoBatches(replayReq) {
+		startLogicalTime := batch[0].GetLogicalTime(jobCron)
+		endLogicalTime := batch[len(batch)-1].GetLogicalTime(jobCron)
+		err := w.withRetry(ctx, "clear batch", func() error {
+			return w.scheduler.ClearBatch(ctx, replayReq.Replay.Tenant(), replayReq.Replay.JobName(), startLogicalTime, endLogicalTime)
+		})
+		if err != nil {
+			w.l.Error("unable to clear job run for replay with replay_id [%s]: %s", replayReq.Replay.ID().String(), err)
+			return nil, err
+		}
 	}
 	if err := w.createMissingRuns(ctx, replayReq, jobCron); err != nil {
 		w.l.Error("unable to create missing runs for replay with replay_id [%s]: %s", replayReq.Replay.ID().String(), err)
@@ -152,6 +261,42 @@ func (w ReplayWorker) processNewReplayRequestParallel(ctx context.Context, repla
 	return updatedRuns, nil
 }
 
+// splitPendingRunsIntoBatches groups the pending runs into batches of consecutive runs, not separated by any excluded
+// or non pending run
+func splitPendingRunsIntoBatches(replayReq *scheduler.ReplayWithRun) [][]*scheduler.JobRunStatus {
+	var batches [][]*scheduler.JobRunStatus
+	var batch []*scheduler.JobRunStatus
+	runs := make([]*scheduler.JobRunStatus, len(replayReq.Runs))
+	copy(runs, replayReq.Runs)
+	for _, run := range scheduler.JobRunStatusList(runs).GetSortedRunsByScheduledAt() {
+		if run.State != scheduler.StatePending {
+			if len(batch) > 0 {
+				batches = append(batches, batch)
+				batch = nil
+			}
+			continue
+		}
+		if len(batch) > 0 && hasExclusionBetween(replayReq.Replay.Config().ExcludedScheduledAt, batch[len(batch)-1].ScheduledAt, run.ScheduledAt) {
+			batches = append(batches, batch)
+			batch = nil
+		}
+		batch = append(batch, run)
+	}
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+	return batches
+}
+
+func hasExclusionBetween(excludedScheduledAt []time.Time, start, end time.Time) bool {
+	for _, excluded := range excludedScheduledAt {
+		if excluded.After(start) && excluded.Before(end) {
+			return true
+		}
+	}
+	return false
+}
+
 func (w ReplayWorker) processNewReplayRequestSequential(ctx context.Context, replayReq *scheduler.ReplayWithRun, jobCron *cron.ScheduleSpec) ([]*scheduler.JobRunStatus, error) {
 	runToReplay := replayReq.GetFirstExecutableRun()
 	if runToReplay == nil {
@@ -200,7 +345,9 @@ func (w ReplayWorker) processPartialReplayedRequest(ctx context.Context, replayR
 		w.l.Error("unable to update replay state for replay_id [%s]: %s", replayReq.Replay.ID().String(), err)
 		return err
 	}
-	raiseReplayMetric(replayReq.Replay.Tenant(), replayReq.Replay.JobName(), replayState)
+	traceReplayTransition(ctx, replayState, updatedRuns)
+	w.publishReplayUpdate(replayReq.Replay, replayState, updatedRuns)
+	raiseReplayMetric(replayReq.Replay, replayState)
 	return nil
 }
 
@@ -208,7 +355,10 @@ func (w ReplayWorker) processPartialReplayedRequest(ctx context.Context, replayR
 func (w ReplayWorker) replayRunOnScheduler(ctx context.Context, replayReq *scheduler.ReplayWithRun, jobCron *cron.ScheduleSpec, runToReplay *scheduler.JobRunStatus) error {
 	_, err := w.fetchRun(ctx, replayReq, jobCron, runToReplay.ScheduledAt)
 	if err != nil && errors.IsErrorType(err, errors.ErrNotFound) {
-		if err := w.scheduler.CreateRun(ctx, replayReq.Replay.Tenant(), replayReq.Replay.JobName(), runToReplay.GetLogicalTime(jobCron), prefixReplayed); err != nil {
+		err := w.withRetry(ctx, "create run", func() error {
+			return w.scheduler.CreateRun(ctx, replayReq.Replay.Tenant(), replayReq.Replay.JobName(), runToReplay.GetLogicalTime(jobCron), prefixReplayed)
+		})
+		if err != nil {
 			w.l.Error("unable to create missing runs for replay with replay_id [%s] with logical time %s: %s", replayReq.Replay.ID().String(), runToReplay.GetLogicalTime(jobCron), err)
 			return err
 		}
@@ -216,7 +366,10 @@ func (w ReplayWorker) replayRunOnScheduler(ctx context.Context, replayReq *sched
 	} else if err != nil {
 		return err
 	} else {
-		if err := w.scheduler.Clear(ctx, replayReq.Replay.Tenant(), replayReq.Replay.JobName(), runToReplay.GetLogicalTime(jobCron)); err != nil {
+		err := w.withRetry(ctx, "clear run", func() error {
+			return w.scheduler.Clear(ctx, replayReq.Replay.Tenant(), replayReq.Replay.JobName(), runToReplay.GetLogicalTime(jobCron))
+		})
+		if err != nil {
 			w.l.Error("unable to clear job run for replay with replay_id [%s]: %s", replayReq.Replay.ID().String(), err)
 			return err
 		}
@@ -252,7 +405,9 @@ func (w ReplayWorker) processReplayedRequest(ctx context.Context, replayReq *sch
 		w.l.Error("unable to update replay with replay_id [%s]: %s", replayReq.Replay.ID().String(), err)
 		return err
 	}
-	raiseReplayMetric(replayReq.Replay.Tenant(), replayReq.Replay.JobName(), state)
+	traceReplayTransition(ctx, state, updatedRuns)
+	w.publishReplayUpdate(replayReq.Replay, state, updatedRuns)
+	raiseReplayMetric(replayReq.Replay, state)
 	return nil
 }
 
@@ -277,7 +432,7 @@ func (w ReplayWorker) fetchRun(ctx context.Context, replayReq *scheduler.ReplayW
 		StartDate: scheduledAt,
 		EndDate:   scheduledAt,
 	}
-	runs, err := w.scheduler.GetJobRuns(ctx, replayReq.Replay.Tenant(), jobRunCriteria, jobCron)
+	runs, err := w.getJobRuns(ctx, replayReq.Replay.Tenant(), jobRunCriteria, jobCron)
 	if err != nil {
 		return nil, err
 	}
@@ -293,20 +448,151 @@ func (w ReplayWorker) fetchRuns(ctx context.Context, replayReq *scheduler.Replay
 		StartDate: replayReq.Replay.Config().StartTime,
 		EndDate:   replayReq.Replay.Config().EndTime,
 	}
-	return w.scheduler.GetJobRuns(ctx, replayReq.Replay.Tenant(), jobRunCriteria, jobCron)
+	return w.getJobRuns(ctx, replayReq.Replay.Tenant(), jobRunCriteria, jobCron)
+}
+
+func (w ReplayWorker) getJobRuns(ctx context.Context, tnnt tenant.Tenant, criteria *scheduler.JobRunsCriteria, jobCron *cron.ScheduleSpec) ([]*scheduler.JobRunStatus, error) {
+	var runs []*scheduler.JobRunStatus
+	err := w.withRetry(ctx, "get job runs", func() error {
+		var err error
+		runs, err = w.scheduler.GetJobRuns(ctx, tnnt, criteria, jobCron)
+		return err
+	})
+	return runs, err
+}
+
+// withRetry retries the scheduler operation with exponential backoff as long as it fails with a transient error,
+// giving up once the configured max attempts is reached
+func (w ReplayWorker) withRetry(ctx context.Context, operation string, fn func() error) error {
+	backoff := w.config.RetryBackoff
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if err == nil || attempt >= w.config.RetryMaxAttempts || !isTransientErr(err) {
+			return err
+		}
+
+		w.l.Warn("transient error on %s, attempt %d of %d, retrying in %s: %s", operation, attempt, w.config.RetryMaxAttempts, backoff, err)
+		select {
+		case <-ctx.Done():
+			return err
+		case <-drainOf(ctx):
+			return err
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// isTransientErr reports whether the error is caused by a timeout or a temporary failure on scheduler, such as 5xx responses
+func isTransientErr(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	var temporary interface{ Temporary() bool }
+	if errors.As(err, &temporary) && temporary.Temporary() {
+		return true
+	}
+
+	var timeout interface{ Timeout() bool }
+	return errors.As(err, &timeout) && timeout.Timeout()
+}
+
+func (w ReplayWorker) updateReplayAsFailed(ctx context.Context, replayReq *scheduler.ReplayWithRun, message string) {
+	if err := w.replayRepo.UpdateReplayStatus(ctx, replayReq.Replay.ID(), scheduler.ReplayStateFailed, message); err != nil {
+		w.l.Error("unable to update replay state to failed for replay_id [%s]: %s", replayReq.Replay.ID(), err)
+		return
+	}
+	traceReplayTransition(ctx, scheduler.ReplayStateFailed, replayReq.Runs)
+	w.publishReplayUpdate(replayReq.Replay, scheduler.ReplayStateFailed, replayReq.Runs)
+}
+
+// checkpoint keeps the drained replay in progress and releases its lease, so any server resumes it right away and
+// reconciles the runs the interrupted step has already triggered on scheduler, instead of triggering them once more
+func (w ReplayWorker) checkpoint(ctx context.Context, replayReq *scheduler.ReplayWithRun) {
+	if err := w.replayRepo.ReleaseReplayLease(ctx, replayReq.Replay.ID()); err != nil {
+		w.l.Error("unable to release lease of drained replay [%s]: %s", replayReq.Replay.ID().String(), err)
+		return
+	}
+	w.l.Info("checkpointed drained replay [%s] to be resumed", replayReq.Replay.ID().String())
+}
+
+func (w ReplayWorker) publishReplayUpdate(replay *scheduler.Replay, state scheduler.ReplayState, runs []*scheduler.JobRunStatus) {
+	w.publisher.Publish(&scheduler.ReplayWithRun{
+		Replay: scheduler.NewReplay(replay.ID(), replay.JobName(), replay.Tenant(), replay.Config(), state, replay.CreatedAt()),
+		Runs:   scheduler.JobRunStatusList(runs).GetSortedRunsByScheduledAt(),
+	})
+}
+
+type drainKey struct{}
+
+// replayContext carries the values of the drain context without its cancellation, the step of a replay which is
+// started is completed and recorded even when the replay is being drained. The drain is seen between the steps.
+type replayContext struct {
+	context.Context
+}
+
+func newReplayContext(drainCtx context.Context) context.Context {
+	return context.WithValue(replayContext{Context: drainCtx}, drainKey{}, drainCtx.Done())
 }
 
-func (w ReplayWorker) updateReplayAsFailed(ctx context.Context, replayID uuid.UUID, message string) {
-	if err := w.replayRepo.UpdateReplayStatus(ctx, replayID, scheduler.ReplayStateFailed, message); err != nil {
-		w.l.Error("unable to update replay state to failed for replay_id [%s]: %s", replayID, err)
+func (replayContext) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+func (replayContext) Done() <-chan struct{} {
+	return nil
+}
+
+func (replayContext) Err() error {
+	return nil
+}
+
+// drainOf returns the channel closed once the replay is drained, nil when the replay is never drained
+func drainOf(ctx context.Context) <-chan struct{} {
+	drain, _ := ctx.Value(drainKey{}).(<-chan struct{})
+	return drain
+}
+
+func isDrained(ctx context.Context) bool {
+	select {
+	case <-drainOf(ctx):
+		return true
+	default:
+		return false
 	}
 }
 
-func raiseReplayMetric(t tenant.Tenant, jobName scheduler.JobName, state scheduler.ReplayState) {
-	telemetry.NewCounter(metricJobReplay, map[string]string{
-		"project":   t.ProjectName().String(),
-		"namespace": t.NamespaceName().String(),
-		"job":       jobName.String(),
+func startReplaySpan(ctx context.Context, name string, replay *scheduler.Replay) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(
+		attribute.String("replay_id", replay.ID().String()),
+		attribute.String("project", replay.Tenant().ProjectName().String()),
+		attribute.String("namespace", replay.Tenant().NamespaceName().String()),
+		attribute.String("job", replay.JobName().String()),
+		attribute.String("state", replay.State().String()),
+	))
+}
+
+// traceReplayTransition records the state transitions of the replay as the events of its span
+func traceReplayTransition(ctx context.Context, state scheduler.ReplayState, runs []*scheduler.JobRunStatus) {
+	trace.SpanFromContext(ctx).AddEvent("replay state updated", trace.WithAttributes(
+		attribute.String("state", state.String()),
+		attribute.Int("runs", len(runs)),
+	))
+}
+
+// replayDurationBuckets spans from a minute up to a week, as replays run for the duration of the runs they clear
+var replayDurationBuckets = []float64{60, 300, 900, 1800, 3600, 3 * 3600, 6 * 3600, 12 * 3600, 24 * 3600, 3 * 24 * 3600, 7 * 24 * 3600}
+
+func raiseReplayMetric(replay *scheduler.Replay, state scheduler.ReplayState) {
+	labels := map[string]string{
+		"project":   replay.Tenant().ProjectName().String(),
+		"namespace": replay.Tenant().NamespaceName().String(),
+		"job":       replay.JobName().String(),
 		"status":    state.String(),
-	}).Inc()
+	}
+	telemetry.NewCounter(metricJobReplay, labels).Inc()
+	if state.IsTerminal() && !replay.CreatedAt().IsZero() {
+		telemetry.NewHistogram(metricJobReplayDuration, labels, replayDurationBuckets...).Observe(time.Since(replay.CreatedAt()).Seconds())
+	}
 }
@@ -18,6 +18,7 @@ import (
 )
 
 func TestReplayWorker(t *testing.T) {
+	ctx := context.Background()
 	logger := log.NewNoop()
 	jobAName, _ := scheduler.JobNameFrom("job-a")
 	projName := tenant.ProjectName("proj")
@@ -92,8 +93,8 @@ func TestReplayWorker(t *testing.T) {
 			sch.On("Clear", mock.Anything, tnnt, jobAName, scheduledTime1.Add(-24*time.Hour)).Return(nil)
 			replayRepository.On("UpdateReplay", mock.Anything, replayReq.Replay.ID(), scheduler.ReplayStateReplayed, updatedRuns, "").Return(nil)
 
-			replayWorker := service.NewReplayWorker(logger, replayRepository, sch, jobRepository, replayServerConfig)
-			replayWorker.Process(replayReq)
+			replayWorker := service.NewReplayWorker(logger, replayRepository, sch, jobRepository, service.NewReplayBroadcaster(), replayServerConfig)
+			replayWorker.Process(ctx, replayReq)
 		})
 		t.Run("should able to process new sequential replay request with multiple run", func(t *testing.T) {
 			replayRepository := new(ReplayRepository)
@@ -134,8 +135,8 @@ func TestReplayWorker(t *testing.T) {
 			sch.On("Clear", mock.Anything, tnnt, jobAName, scheduledTime1.Add(-24*time.Hour)).Return(nil)
 			replayRepository.On("UpdateReplay", mock.Anything, replayReq.Replay.ID(), scheduler.ReplayStatePartialReplayed, updatedRuns, "").Return(nil)
 
-			replayWorker := service.NewReplayWorker(logger, replayRepository, sch, jobRepository, replayServerConfig)
-			replayWorker.Process(replayReq)
+			replayWorker := service.NewReplayWorker(logger, replayRepository, sch, jobRepository, service.NewReplayBroadcaster(), replayServerConfig)
+			replayWorker.Process(ctx, replayReq)
 		})
 		t.Run("should able to process new parallel replay request", func(t *testing.T) {
 			replayRepository := new(ReplayRepository)
@@ -166,8 +167,8 @@ func TestReplayWorker(t *testing.T) {
 			sch.On("ClearBatch", mock.Anything, tnnt, jobAName, executionTime1, executionTime2).Return(nil)
 			replayRepository.On("UpdateReplay", mock.Anything, replayReq.Replay.ID(), scheduler.ReplayStateReplayed, mock.Anything, "").Return(nil)
 
-			replayWorker := service.NewReplayWorker(logger, replayRepository, sch, jobRepository, replayServerConfig)
-			replayWorker.Process(replayReq)
+			replayWorker := service.NewReplayWorker(logger, replayRepository, sch, jobRepository, service.NewReplayBroadcaster(), replayServerConfig)
+			replayWorker.Process(ctx, replayReq)
 		})
 		t.Run("should able to process new replay request with creating non existing runs", func(t *testing.T) {
 			replayRepository := new(ReplayRepository)
@@ -208,8 +209,8 @@ func TestReplayWorker(t *testing.T) {
 			sch.On("CreateRun", mock.Anything, tnnt, jobAName, scheduledTime1.Add(-24*time.Hour), "replayed").Return(nil).Once()
 			replayRepository.On("UpdateReplay", mock.Anything, replayReq.Replay.ID(), scheduler.ReplayStatePartialReplayed, updatedRunsAfterRunCreate, "").Return(nil)
 
-			replayWorker := service.NewReplayWorker(logger, replayRepository, sch, jobRepository, replayServerConfig)
-			replayWorker.Process(replayReq)
+			replayWorker := service.NewReplayWorker(logger, replayRepository, sch, jobRepository, service.NewReplayBroadcaster(), replayServerConfig)
+			replayWorker.Process(ctx, replayReq)
 		})
 
 		t.Run("should able to update replay state as failed if unable to get job details", func(t *testing.T) {
@@ -239,8 +240,8 @@ func TestReplayWorker(t *testing.T) {
 			jobRepository.On("GetJobDetails", mock.Anything, projName, jobAName).Return(nil, internalErr)
 			replayRepository.On("UpdateReplayStatus", mock.Anything, replayReq.Replay.ID(), scheduler.ReplayStateFailed, mock.Anything).Return(nil)
 
-			replayWorker := service.NewReplayWorker(logger, replayRepository, sch, jobRepository, replayServerConfig)
-			replayWorker.Process(replayReq)
+			replayWorker := service.NewReplayWorker(logger, replayRepository, sch, jobRepository, service.NewReplayBroadcaster(), replayServerConfig)
+			replayWorker.Process(ctx, replayReq)
 		})
 		t.Run("should able to update replay state as failed if unable to do clear batch of runs", func(t *testing.T) {
 			replayRepository := new(ReplayRepository)
@@ -270,8 +271,8 @@ func TestReplayWorker(t *testing.T) {
 			sch.On("ClearBatch", mock.Anything, tnnt, jobAName, executionTime1, executionTime2).Return(internalErr)
 			replayRepository.On("UpdateReplayStatus", mock.Anything, replayReq.Replay.ID(), scheduler.ReplayStateFailed, mock.Anything).Return(nil)
 
-			replayWorker := service.NewReplayWorker(logger, replayRepository, sch, jobRepository, replayServerConfig)
-			replayWorker.Process(replayReq)
+			replayWorker := service.NewReplayWorker(logger, replayRepository, sch, jobRepository, service.NewReplayBroadcaster(), replayServerConfig)
+			replayWorker.Process(ctx, replayReq)
 		})
 		t.Run("should able to update replay state as failed if unable to do clear run", func(t *testing.T) {
 			replayRepository := new(ReplayRepository)
@@ -298,8 +299,102 @@ func TestReplayWorker(t *testing.T) {
 			sch.On("Clear", mock.Anything, tnnt, jobAName, scheduledTime1.Add(-24*time.Hour)).Return(internalErr)
 			replayRepository.On("UpdateReplayStatus", mock.Anything, replayReq.Replay.ID(), scheduler.ReplayStateFailed, mock.Anything).Return(nil)
 
-			replayWorker := service.NewReplayWorker(logger, replayRepository, sch, jobRepository, replayServerConfig)
-			replayWorker.Process(replayReq)
+			replayWorker := service.NewReplayWorker(logger, replayRepository, sch, jobRepository, service.NewReplayBroadcaster(), replayServerConfig)
+			replayWorker.Process(ctx, replayReq)
+		})
+
+		t.Run("should retry clear run on transient error before processing the replay", func(t *testing.T) {
+			replayRepository := new(ReplayRepository)
+			defer replayRepository.AssertExpectations(t)
+
+			sch := new(mockReplayScheduler)
+			defer sch.AssertExpectations(t)
+
+			jobRepository := new(JobRepository)
+			defer jobRepository.AssertExpectations(t)
+
+			replayReq := &scheduler.ReplayWithRun{
+				Replay: scheduler.NewReplay(uuid.New(), jobAName, tnnt, replayConfig, scheduler.ReplayStateCreated, time.Now()),
+				Runs: []*scheduler.JobRunStatus{
+					{
+						ScheduledAt: scheduledTime1,
+						State:       scheduler.StatePending,
+					},
+				},
+			}
+			updatedRuns := []*scheduler.JobRunStatus{
+				{
+					ScheduledAt: scheduledTime1,
+					State:       scheduler.StateInProgress,
+				},
+			}
+
+			jobRepository.On("GetJobDetails", mock.Anything, projName, jobAName).Return(jobAWithDetails, nil)
+			sch.On("GetJobRuns", mock.Anything, tnnt, mock.Anything, jobCron).Return(replayReq.Runs, nil)
+			sch.On("Clear", mock.Anything, tnnt, jobAName, scheduledTime1.Add(-24*time.Hour)).Return(context.DeadlineExceeded).Once()
+			sch.On("Clear", mock.Anything, tnnt, jobAName, scheduledTime1.Add(-24*time.Hour)).Return(nil).Once()
+			replayRepository.On("UpdateReplay", mock.Anything, replayReq.Replay.ID(), scheduler.ReplayStateReplayed, updatedRuns, "").Return(nil)
+
+			retryConfig := config.ReplayConfig{RetryMaxAttempts: 3, RetryBackoff: time.Millisecond}
+			replayWorker := service.NewReplayWorker(logger, replayRepository, sch, jobRepository, service.NewReplayBroadcaster(), retryConfig)
+			replayWorker.Process(ctx, replayReq)
+		})
+		t.Run("should update replay state as failed once retry attempts on transient error are exhausted", func(t *testing.T) {
+			replayRepository := new(ReplayRepository)
+			defer replayRepository.AssertExpectations(t)
+
+			sch := new(mockReplayScheduler)
+			defer sch.AssertExpectations(t)
+
+			jobRepository := new(JobRepository)
+			defer jobRepository.AssertExpectations(t)
+
+			replayReq := &scheduler.ReplayWithRun{
+				Replay: scheduler.NewReplay(uuid.New(), jobAName, tnnt, replayConfig, scheduler.ReplayStateCreated, time.Now()),
+				Runs: []*scheduler.JobRunStatus{
+					{
+						ScheduledAt: scheduledTime1,
+						State:       scheduler.StatePending,
+					},
+				},
+			}
+
+			jobRepository.On("GetJobDetails", mock.Anything, projName, jobAName).Return(jobAWithDetails, nil)
+			sch.On("GetJobRuns", mock.Anything, tnnt, mock.Anything, jobCron).Return(nil, context.DeadlineExceeded).Times(3)
+			replayRepository.On("UpdateReplayStatus", mock.Anything, replayReq.Replay.ID(), scheduler.ReplayStateFailed, mock.Anything).Return(nil)
+
+			retryConfig := config.ReplayConfig{RetryMaxAttempts: 3, RetryBackoff: time.Millisecond}
+			replayWorker := service.NewReplayWorker(logger, replayRepository, sch, jobRepository, service.NewReplayBroadcaster(), retryConfig)
+			replayWorker.Process(ctx, replayReq)
+		})
+		t.Run("should not retry on non transient error", func(t *testing.T) {
+			replayRepository := new(ReplayRepository)
+			defer replayRepository.AssertExpectations(t)
+
+			sch := new(mockReplayScheduler)
+			defer sch.AssertExpectations(t)
+
+			jobRepository := new(JobRepository)
+			defer jobRepository.AssertExpectations(t)
+
+			replayReq := &scheduler.ReplayWithRun{
+				Replay: scheduler.NewReplay(uuid.New(), jobAName, tnnt, replayConfig, scheduler.ReplayStateCreated, time.Now()),
+				Runs: []*scheduler.JobRunStatus{
+					{
+						ScheduledAt: scheduledTime1,
+						State:       scheduler.StatePending,
+					},
+				},
+			}
+
+			jobRepository.On("GetJobDetails", mock.Anything, projName, jobAName).Return(jobAWithDetails, nil)
+			sch.On("GetJobRuns", mock.Anything, tnnt, mock.Anything, jobCron).Return(replayReq.Runs, nil)
+			sch.On("Clear", mock.Anything, tnnt, jobAName, scheduledTime1.Add(-24*time.Hour)).Return(internalErr).Once()
+			replayRepository.On("UpdateReplayStatus", mock.Anything, replayReq.Replay.ID(), scheduler.ReplayStateFailed, mock.Anything).Return(nil)
+
+			retryConfig := config.ReplayConfig{RetryMaxAttempts: 3, RetryBackoff: time.Millisecond}
+			replayWorker := service.NewReplayWorker(logger, replayRepository, sch, jobRepository, service.NewReplayBroadcaster(), retryConfig)
+			replayWorker.Process(ctx, replayReq)
 		})
 
 		t.Run("should able to process partial replayed request with the recent run status is success", func(t *testing.T) {
@@ -369,8 +464,8 @@ func TestReplayWorker(t *testing.T) {
 			sch.On("Clear", mock.Anything, tnnt, jobAName, scheduledTime2.Add(-24*time.Hour)).Return(nil)
 			replayRepository.On("UpdateReplay", mock.Anything, replayReq.Replay.ID(), scheduler.ReplayStatePartialReplayed, updatedRuns2, "").Return(nil).Once()
 
-			replayWorker := service.NewReplayWorker(logger, replayRepository, sch, jobRepository, replayServerConfig)
-			replayWorker.Process(replayReq)
+			replayWorker := service.NewReplayWorker(logger, replayRepository, sch, jobRepository, service.NewReplayBroadcaster(), replayServerConfig)
+			replayWorker.Process(ctx, replayReq)
 		})
 		t.Run("should able to process partial replayed request with the recent run status is failed", func(t *testing.T) {
 			replayRepository := new(ReplayRepository)
@@ -439,8 +534,8 @@ func TestReplayWorker(t *testing.T) {
 			sch.On("Clear", mock.Anything, tnnt, jobAName, scheduledTime2.Add(-24*time.Hour)).Return(nil)
 			replayRepository.On("UpdateReplay", mock.Anything, replayReq.Replay.ID(), scheduler.ReplayStatePartialReplayed, updatedRuns2, "").Return(nil).Once()
 
-			replayWorker := service.NewReplayWorker(logger, replayRepository, sch, jobRepository, replayServerConfig)
-			replayWorker.Process(replayReq)
+			replayWorker := service.NewReplayWorker(logger, replayRepository, sch, jobRepository, service.NewReplayBroadcaster(), replayServerConfig)
+			replayWorker.Process(ctx, replayReq)
 		})
 		t.Run("should able to update replay state as failed if unable to fetch job runs", func(t *testing.T) {
 			replayRepository := new(ReplayRepository)
@@ -474,8 +569,8 @@ func TestReplayWorker(t *testing.T) {
 			sch.On("GetJobRuns", mock.Anything, tnnt, runsCriteriaJobA, jobCron).Return(nil, internalErr).Once()
 			replayRepository.On("UpdateReplayStatus", mock.Anything, replayReq.Replay.ID(), scheduler.ReplayStateFailed, mock.Anything).Return(nil)
 
-			replayWorker := service.NewReplayWorker(logger, replayRepository, sch, jobRepository, replayServerConfig)
-			replayWorker.Process(replayReq)
+			replayWorker := service.NewReplayWorker(logger, replayRepository, sch, jobRepository, service.NewReplayBroadcaster(), replayServerConfig)
+			replayWorker.Process(ctx, replayReq)
 		})
 		t.Run("should able to update replay state as failed if unable to clear run when processing partial replayed request", func(t *testing.T) {
 			replayRepository := new(ReplayRepository)
@@ -522,8 +617,8 @@ func TestReplayWorker(t *testing.T) {
 			sch.On("Clear", mock.Anything, tnnt, jobAName, scheduledTime2.Add(-24*time.Hour)).Return(internalErr)
 			replayRepository.On("UpdateReplayStatus", mock.Anything, replayReq.Replay.ID(), scheduler.ReplayStateFailed, mock.Anything).Return(nil)
 
-			replayWorker := service.NewReplayWorker(logger, replayRepository, sch, jobRepository, replayServerConfig)
-			replayWorker.Process(replayReq)
+			replayWorker := service.NewReplayWorker(logger, replayRepository, sch, jobRepository, service.NewReplayBroadcaster(), replayServerConfig)
+			replayWorker.Process(ctx, replayReq)
 		})
 
 		t.Run("should able to process replayed request if all state are success", func(t *testing.T) {
@@ -564,8 +659,8 @@ func TestReplayWorker(t *testing.T) {
 			sch.On("GetJobRuns", mock.Anything, tnnt, runsCriteriaJobA, jobCron).Return(updatedRuns, nil)
 			replayRepository.On("UpdateReplay", mock.Anything, replayReq.Replay.ID(), scheduler.ReplayStateSuccess, updatedRuns, "").Return(nil)
 
-			replayWorker := service.NewReplayWorker(logger, replayRepository, sch, jobRepository, replayServerConfig)
-			replayWorker.Process(replayReq)
+			replayWorker := service.NewReplayWorker(logger, replayRepository, sch, jobRepository, service.NewReplayBroadcaster(), replayServerConfig)
+			replayWorker.Process(ctx, replayReq)
 		})
 		t.Run("should able to process replayed request if some of the runs are in failed state", func(t *testing.T) {
 			replayRepository := new(ReplayRepository)
@@ -627,8 +722,8 @@ func TestReplayWorker(t *testing.T) {
 			sch.On("GetJobRuns", mock.Anything, tnnt, runsCriteriaJobA, jobCron).Return(runsFromScheduler, nil)
 			replayRepository.On("UpdateReplay", mock.Anything, replayReq.Replay.ID(), scheduler.ReplayStateReplayed, updatedRuns, "").Return(nil)
 
-			replayWorker := service.NewReplayWorker(logger, replayRepository, sch, jobRepository, replayServerConfig)
-			replayWorker.Process(replayReq)
+			replayWorker := service.NewReplayWorker(logger, replayRepository, sch, jobRepository, service.NewReplayBroadcaster(), replayServerConfig)
+			replayWorker.Process(ctx, replayReq)
 		})
 		t.Run("should able to update replay state as failed if unable to fetch runs when processing replayed request", func(t *testing.T) {
 			replayRepository := new(ReplayRepository)
@@ -658,8 +753,8 @@ func TestReplayWorker(t *testing.T) {
 			sch.On("GetJobRuns", mock.Anything, tnnt, runsCriteriaJobA, jobCron).Return(nil, internalErr)
 			replayRepository.On("UpdateReplayStatus", mock.Anything, replayReq.Replay.ID(), scheduler.ReplayStateFailed, mock.Anything).Return(nil)
 
-			replayWorker := service.NewReplayWorker(logger, replayRepository, sch, jobRepository, replayServerConfig)
-			replayWorker.Process(replayReq)
+			replayWorker := service.NewReplayWorker(logger, replayRepository, sch, jobRepository, service.NewReplayBroadcaster(), replayServerConfig)
+			replayWorker.Process(ctx, replayReq)
 		})
 		t.Run("should able to update replay state as failed if all runs finished and failure found", func(t *testing.T) {
 			replayRepository := new(ReplayRepository)
@@ -699,8 +794,131 @@ func TestReplayWorker(t *testing.T) {
 			sch.On("GetJobRuns", mock.Anything, tnnt, runsCriteriaJobA, jobCron).Return(updatedRuns, nil)
 			replayRepository.On("UpdateReplay", mock.Anything, replayReq.Replay.ID(), scheduler.ReplayStateFailed, updatedRuns, "found 1 failed runs.").Return(nil)
 
-			replayWorker := service.NewReplayWorker(logger, replayRepository, sch, jobRepository, replayServerConfig)
-			replayWorker.Process(replayReq)
+			replayWorker := service.NewReplayWorker(logger, replayRepository, sch, jobRepository, service.NewReplayBroadcaster(), replayServerConfig)
+			replayWorker.Process(ctx, replayReq)
+		})
+		t.Run("should release the lease of replay to be resumed without processing it when drained", func(t *testing.T) {
+			replayRepository := new(ReplayRepository)
+			defer replayRepository.AssertExpectations(t)
+
+			sch := new(mockReplayScheduler)
+			defer sch.AssertExpectations(t)
+
+			jobRepository := new(JobRepository)
+			defer jobRepository.AssertExpectations(t)
+
+			replayReq := &scheduler.ReplayWithRun{
+				Replay: scheduler.NewReplay(uuid.New(), jobAName, tnnt, replayConfig, scheduler.ReplayStateCreated, time.Now()),
+				Runs: []*scheduler.JobRunStatus{
+					{
+						ScheduledAt: scheduledTime1,
+						State:       scheduler.StatePending,
+					},
+				},
+			}
+
+			replayRepository.On("ReleaseReplayLease", mock.Anything, replayReq.Replay.ID()).Return(nil)
+
+			drainCtx, drain := context.WithCancel(ctx)
+			drain()
+
+			replayWorker := service.NewReplayWorker(logger, replayRepository, sch, jobRepository, service.NewReplayBroadcaster(), replayServerConfig)
+			replayWorker.Process(drainCtx, replayReq)
+		})
+	})
+	t.Run("Resume", func(t *testing.T) {
+		t.Run("should wait for the run already active on scheduler when resuming new sequential replay", func(t *testing.T) {
+			replayRepository := new(ReplayRepository)
+			defer replayRepository.AssertExpectations(t)
+
+			sch := new(mockReplayScheduler)
+			defer sch.AssertExpectations(t)
+
+			jobRepository := new(JobRepository)
+			defer jobRepository.AssertExpectations(t)
+
+			replayReq := &scheduler.ReplayWithRun{
+				Replay: scheduler.NewReplay(uuid.New(), jobAName, tnnt, replayConfig, scheduler.ReplayStateCreated, time.Now()),
+				Runs: []*scheduler.JobRunStatus{
+					{ScheduledAt: scheduledTime1, State: scheduler.StatePending},
+					{ScheduledAt: scheduledTime2, State: scheduler.StatePending},
+				},
+			}
+			runsOnScheduler := []*scheduler.JobRunStatus{
+				{ScheduledAt: scheduledTime1, State: scheduler.StateRunning},
+			}
+			updatedRuns := []*scheduler.JobRunStatus{
+				{ScheduledAt: scheduledTime1, State: scheduler.StateInProgress},
+				{ScheduledAt: scheduledTime2, State: scheduler.StatePending},
+			}
+
+			jobRepository.On("GetJobDetails", mock.Anything, projName, jobAName).Return(jobAWithDetails, nil)
+			sch.On("GetJobRuns", mock.Anything, tnnt, runsCriteriaJobA, jobCron).Return(runsOnScheduler, nil)
+			replayRepository.On("UpdateReplay", mock.Anything, replayReq.Replay.ID(), scheduler.ReplayStatePartialReplayed, updatedRuns, "").Return(nil)
+
+			replayWorker := service.NewReplayWorker(logger, replayRepository, sch, jobRepository, service.NewReplayBroadcaster(), replayServerConfig)
+			replayWorker.Resume(ctx, replayReq)
+		})
+		t.Run("should only clear the runs not yet active on scheduler when resuming new parallel replay", func(t *testing.T) {
+			replayRepository := new(ReplayRepository)
+			defer replayRepository.AssertExpectations(t)
+
+			sch := new(mockReplayScheduler)
+			defer sch.AssertExpectations(t)
+
+			jobRepository := new(JobRepository)
+			defer jobRepository.AssertExpectations(t)
+
+			replayReq := &scheduler.ReplayWithRun{
+				Replay: scheduler.NewReplay(uuid.New(), jobAName, tnnt, replayConfigParallel, scheduler.ReplayStateCreated, time.Now()),
+				Runs: []*scheduler.JobRunStatus{
+					{ScheduledAt: scheduledTime1, State: scheduler.StatePending},
+					{ScheduledAt: scheduledTime2, State: scheduler.StatePending},
+					{ScheduledAt: scheduledTime3, State: scheduler.StatePending},
+				},
+			}
+			runsOnScheduler := []*scheduler.JobRunStatus{
+				{ScheduledAt: scheduledTime1, State: scheduler.StateQueued},
+				{ScheduledAt: scheduledTime2, State: scheduler.StateSuccess},
+				{ScheduledAt: scheduledTime3, State: scheduler.StateSuccess},
+			}
+			updatedRuns := []*scheduler.JobRunStatus{
+				{ScheduledAt: scheduledTime1, State: scheduler.StateInProgress},
+				{ScheduledAt: scheduledTime2, State: scheduler.StateInProgress},
+				{ScheduledAt: scheduledTime3, State: scheduler.StateInProgress},
+			}
+
+			jobRepository.On("GetJobDetails", mock.Anything, projName, jobAName).Return(jobAWithDetails, nil)
+			sch.On("GetJobRuns", mock.Anything, tnnt, runsCriteriaJobA, jobCron).Return(runsOnScheduler, nil)
+			sch.On("ClearBatch", mock.Anything, tnnt, jobAName, executionTime2, scheduledTime2).Return(nil).Once()
+			replayRepository.On("UpdateReplay", mock.Anything, replayReq.Replay.ID(), scheduler.ReplayStateReplayed, updatedRuns, "").Return(nil)
+
+			replayWorker := service.NewReplayWorker(logger, replayRepository, sch, jobRepository, service.NewReplayBroadcaster(), replayServerConfig)
+			replayWorker.Resume(ctx, replayReq)
+		})
+		t.Run("should update replay state as failed if unable to fetch runs to reconcile", func(t *testing.T) {
+			replayRepository := new(ReplayRepository)
+			defer replayRepository.AssertExpectations(t)
+
+			sch := new(mockReplayScheduler)
+			defer sch.AssertExpectations(t)
+
+			jobRepository := new(JobRepository)
+			defer jobRepository.AssertExpectations(t)
+
+			replayReq := &scheduler.ReplayWithRun{
+				Replay: scheduler.NewReplay(uuid.New(), jobAName, tnnt, replayConfig, scheduler.ReplayStateCreated, time.Now()),
+				Runs: []*scheduler.JobRunStatus{
+					{ScheduledAt: scheduledTime1, State: scheduler.StatePending},
+				},
+			}
+
+			jobRepository.On("GetJobDetails", mock.Anything, projName, jobAName).Return(jobAWithDetails, nil)
+			sch.On("GetJobRuns", mock.Anything, tnnt, runsCriteriaJobA, jobCron).Return(nil, internalErr)
+			replayRepository.On("UpdateReplayStatus", mock.Anything, replayReq.Replay.ID(), scheduler.ReplayStateFailed, internalErr.Error()).Return(nil)
+
+			replayWorker := service.NewReplayWorker(logger, replayRepository, sch, jobRepository, service.NewReplayBroadcaster(), replayServerConfig)
+			replayWorker.Resume(ctx, replayReq)
 		})
 	})
 }
@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/goto/salt/log"
+	"github.com/gtank/cryptopasta"
+	"github.com/robfig/cron/v3"
+
+	"github.com/goto/optimus/config"
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+)
+
+const (
+	runSnapshotKeyLength = 32
+
+	runSnapshotCleanupInterval = time.Hour
+)
+
+type RunSnapshotRepository interface {
+	Save(ctx context.Context, snapshot *scheduler.RunSnapshot, payload []byte) error
+	Get(ctx context.Context, projectName tenant.ProjectName, id uuid.UUID, at time.Time) (*scheduler.RunSnapshot, []byte, error)
+	GetByJob(ctx context.Context, projectName tenant.ProjectName, jobName scheduler.JobName, at time.Time) ([]*scheduler.RunSnapshot, error)
+	DeleteExpired(ctx context.Context, at time.Time) (int64, error)
+}
+
+// runSnapshotPayload is the part of the snapshot which is encrypted at rest
+type runSnapshotPayload struct {
+	Configs map[string]string `json:"configs"`
+	Files   map[string]string `json:"files"`
+}
+
+// RunSnapshotService keeps the compiled inputs of job runs encrypted with a key of the project, derived from the
+// application key, and deletes them once they expire
+type RunSnapshotService struct {
+	l      log.Logger
+	repo   RunSnapshotRepository
+	appKey *[runSnapshotKeyLength]byte
+	now    func() time.Time
+
+	config   config.RunSnapshotConfig
+	schedule *cron.Cron
+}
+
+func (s *RunSnapshotService) Save(ctx context.Context, projectName tenant.ProjectName, jobName scheduler.JobName, runConfig scheduler.RunConfig, input *scheduler.ExecutorInput) error {
+	payload, err := json.Marshal(runSnapshotPayload{Configs: input.Configs, Files: input.Files})
+	if err != nil {
+		return errors.InternalError(scheduler.EntityRunSnapshot, "unable to marshal run snapshot", err)
+	}
+	encrypted, err := cryptopasta.Encrypt(payload, s.projectKey(projectName))
+	if err != nil {
+		return errors.InternalError(scheduler.EntityRunSnapshot, "unable to encrypt run snapshot", err)
+	}
+
+	snapshot := &scheduler.RunSnapshot{
+		ProjectName: projectName,
+		JobName:     jobName,
+		Executor:    runConfig.Executor,
+		ScheduledAt: runConfig.ScheduledAt,
+		Attempt:     runConfig.Attempt,
+		ExpiresAt:   s.now().Add(s.config.Retention),
+	}
+	return s.repo.Save(ctx, snapshot, encrypted)
+}
+
+// Get returns the snapshot with its decrypted configs and files, as long as it belongs to the project and is not expired
+func (s *RunSnapshotService) Get(ctx context.Context, projectName tenant.ProjectName, id uuid.UUID) (*scheduler.RunSnapshot, error) {
+	snapshot, encrypted, err := s.repo.Get(ctx, projectName, id, s.now())
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := cryptopasta.Decrypt(encrypted, s.projectKey(projectName))
+	if err != nil {
+		s.l.Error("error decrypting run snapshot [%s] of project [%s]: %s", id.String(), projectName, err)
+		return nil, errors.InternalError(scheduler.EntityRunSnapshot, "unable to decrypt run snapshot", err)
+	}
+	var decrypted runSnapshotPayload
+	if err := json.Unmarshal(payload, &decrypted); err != nil {
+		return nil, errors.InternalError(scheduler.EntityRunSnapshot, "unable to unmarshal run snapshot", err)
+	}
+
+	snapshot.Configs = decrypted.Configs
+	snapshot.Files = decrypted.Files
+	return snapshot, nil
+}
+
+// GetByJob lists the snapshots of the job which are not expired, without their configs and files
+func (s *RunSnapshotService) GetByJob(ctx context.Context, projectName tenant.ProjectName, jobName scheduler.JobName) ([]*scheduler.RunSnapshot, error) {
+	return s.repo.GetByJob(ctx, projectName, jobName, s.now())
+}
+
+// projectKey derives the key of the project from the application key, so the snapshots of a project can not be
+// decrypted with the key of another project
+func (s *RunSnapshotService) projectKey(projectName tenant.ProjectName) *[runSnapshotKeyLength]byte {
+	mac := hmac.New(sha256.New, s.appKey[:])
+	mac.Write([]byte(projectName.String()))
+
+	var key [runSnapshotKeyLength]byte
+	copy(key[:], mac.Sum(nil))
+	return &key
+}
+
+func (s *RunSnapshotService) deleteExpired() {
+	deleted, err := s.repo.DeleteExpired(context.Background(), s.now())
+	if err != nil {
+		s.l.Error("error deleting expired run snapshots: %s", err)
+		return
+	}
+	if deleted > 0 {
+		s.l.Info("deleted %d expired run snapshots", deleted)
+	}
+}
+
+func (s *RunSnapshotService) Initialize() {
+	if !s.config.Enabled || s.schedule == nil {
+		return
+	}
+
+	_, err := s.schedule.AddFunc("@every "+runSnapshotCleanupInterval.String(), s.deleteExpired)
+	if err != nil {
+		s.l.Error("Failed to add function to cron schedule: %s", err)
+	}
+	s.schedule.Start()
+}
+
+func (s *RunSnapshotService) Close() {
+	if s.schedule != nil {
+		<-s.schedule.Stop().Done()
+	}
+	s.l.Info("run snapshot cleanup stopped")
+}
+
+func NewRunSnapshotService(l log.Logger, repo RunSnapshotRepository, appKey *[runSnapshotKeyLength]byte, now func() time.Time,
+	config config.RunSnapshotConfig,
+) *RunSnapshotService {
+	return &RunSnapshotService{
+		l:      l,
+		repo:   repo,
+		appKey: appKey,
+		now:    now,
+		config: config,
+		schedule: cron.New(cron.WithChain(
+			cron.SkipIfStillRunning(cron.DefaultLogger),
+		)),
+	}
+}
+
+// AuditedJobRunService keeps a snapshot of every job run input handed over to the executors, a snapshot which can
+// not be kept is only logged so the run is not failed for it
+type AuditedJobRunService struct {
+	*JobRunService
+
+	snapshots *RunSnapshotService
+}
+
+func (s AuditedJobRunService) JobRunInput(ctx context.Context, projectName tenant.ProjectName, jobName scheduler.JobName, config scheduler.RunConfig) (*scheduler.ExecutorInput, error) {
+	input, err := s.JobRunService.JobRunInput(ctx, projectName, jobName, config)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.snapshots.Save(ctx, projectName, jobName, config, input); err != nil {
+		s.l.Warn("error keeping snapshot of run input of job [%s] scheduled at [%s]: %s", jobName, config.ScheduledAt, err)
+	}
+	return input, nil
+}
+
+func NewAuditedJobRunService(jobRunService *JobRunService, snapshots *RunSnapshotService) *AuditedJobRunService {
+	return &AuditedJobRunService{
+		JobRunService: jobRunService,
+		snapshots:     snapshots,
+	}
+}
@@ -0,0 +1,119 @@
+package service_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/goto/optimus/config"
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/scheduler/service"
+	"github.com/goto/optimus/core/tenant"
+)
+
+func TestRunSnapshotService(t *testing.T) {
+	ctx := context.Background()
+	logger := log.NewNoop()
+	now := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+	nowFn := func() time.Time { return now }
+	snapshotConfig := config.RunSnapshotConfig{Enabled: true, Retention: 24 * time.Hour}
+
+	var appKey [32]byte
+	copy(appKey[:], "32charshtesthashtesthashtesthash")
+
+	projectName := tenant.ProjectName("proj")
+	jobName := scheduler.JobName("job-a")
+	runConfig := scheduler.RunConfig{
+		Executor:    scheduler.Executor{Name: "bq2bq", Type: scheduler.ExecutorTask},
+		ScheduledAt: now.Add(-time.Hour),
+		Attempt:     2,
+	}
+	input := &scheduler.ExecutorInput{
+		Configs: map[string]string{"DATASET": "playground"},
+		Secrets: map[string]string{"SECRET": "value"},
+		Files:   map[string]string{"query.sql": "select business_logic from playground.table"},
+	}
+
+	t.Run("Save", func(t *testing.T) {
+		t.Run("stores the snapshot encrypted without the secrets", func(t *testing.T) {
+			repo := new(mockRunSnapshotRepository)
+			defer repo.AssertExpectations(t)
+
+			repo.On("Save", ctx, mock.MatchedBy(func(snapshot *scheduler.RunSnapshot) bool {
+				return snapshot.JobName == jobName && snapshot.Attempt == 2 && snapshot.Configs == nil &&
+					snapshot.ExpiresAt.Equal(now.Add(24*time.Hour))
+			}), mock.MatchedBy(func(payload []byte) bool {
+				return !bytes.Contains(payload, []byte("business_logic")) && !bytes.Contains(payload, []byte("SECRET"))
+			})).Return(nil)
+
+			snapshotService := service.NewRunSnapshotService(logger, repo, &appKey, nowFn, snapshotConfig)
+			err := snapshotService.Save(ctx, projectName, jobName, runConfig, input)
+			assert.NoError(t, err)
+		})
+	})
+	t.Run("Get", func(t *testing.T) {
+		var stored []byte
+		repo := new(mockRunSnapshotRepository)
+		repo.On("Save", ctx, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			stored = args.Get(2).([]byte)
+		}).Return(nil)
+
+		snapshotService := service.NewRunSnapshotService(logger, repo, &appKey, nowFn, snapshotConfig)
+		assert.NoError(t, snapshotService.Save(ctx, projectName, jobName, runConfig, input))
+
+		t.Run("returns the snapshot with decrypted configs and files", func(t *testing.T) {
+			id := uuid.New()
+			repo.On("Get", ctx, projectName, id, now).Return(&scheduler.RunSnapshot{ID: id, ProjectName: projectName, JobName: jobName}, stored, nil).Once()
+
+			snapshot, err := snapshotService.Get(ctx, projectName, id)
+			assert.NoError(t, err)
+			assert.Equal(t, map[string]string(input.Configs), snapshot.Configs)
+			assert.Equal(t, map[string]string(input.Files), snapshot.Files)
+		})
+		t.Run("returns error if snapshot is decrypted with the key of another project", func(t *testing.T) {
+			id := uuid.New()
+			otherProject := tenant.ProjectName("other-proj")
+			repo.On("Get", ctx, otherProject, id, now).Return(&scheduler.RunSnapshot{ID: id, ProjectName: otherProject, JobName: jobName}, stored, nil).Once()
+
+			snapshot, err := snapshotService.Get(ctx, otherProject, id)
+			assert.ErrorContains(t, err, "unable to decrypt run snapshot")
+			assert.Nil(t, snapshot)
+		})
+	})
+}
+
+type mockRunSnapshotRepository struct {
+	mock.Mock
+}
+
+func (m *mockRunSnapshotRepository) Save(ctx context.Context, snapshot *scheduler.RunSnapshot, payload []byte) error {
+	args := m.Called(ctx, snapshot, payload)
+	return args.Error(0)
+}
+
+func (m *mockRunSnapshotRepository) Get(ctx context.Context, projectName tenant.ProjectName, id uuid.UUID, at time.Time) (*scheduler.RunSnapshot, []byte, error) {
+	args := m.Called(ctx, projectName, id, at)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).(*scheduler.RunSnapshot), args.Get(1).([]byte), args.Error(2)
+}
+
+func (m *mockRunSnapshotRepository) GetByJob(ctx context.Context, projectName tenant.ProjectName, jobName scheduler.JobName, at time.Time) ([]*scheduler.RunSnapshot, error) {
+	args := m.Called(ctx, projectName, jobName, at)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*scheduler.RunSnapshot), args.Error(1)
+}
+
+func (m *mockRunSnapshotRepository) DeleteExpired(ctx context.Context, at time.Time) (int64, error) {
+	args := m.Called(ctx, at)
+	return args.Get(0).(int64), args.Error(1)
+}
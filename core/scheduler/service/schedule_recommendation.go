@@ -0,0 +1,179 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+	"github.com/goto/optimus/internal/lib/cron"
+)
+
+const (
+	// historicalRunsForRecommendation is the number of previous schedules inspected to recommend a schedule
+	historicalRunsForRecommendation = 14
+	// minimumSamplesForRecommendation avoids recommending from a history too short to be representative
+	minimumSamplesForRecommendation = 3
+	// scheduleShiftStep rounds the recommended shift, so that small variations in upstream runs do not
+	// produce a recommendation
+	scheduleShiftStep = 5 * time.Minute
+)
+
+// RecommendSchedule inspects when the upstreams of the job completed for its previous schedules, and recommends
+// delaying the schedule when the job usually waits on its sensors, or bringing it forward when the upstreams
+// are usually done well before the job is scheduled
+func (s *JobRunService) RecommendSchedule(ctx context.Context, projectName tenant.ProjectName, jobName scheduler.JobName, referenceTime time.Time) (*scheduler.ScheduleRecommendation, error) {
+	details, err := s.jobRepo.GetJobDetails(ctx, projectName, jobName)
+	if err != nil {
+		s.l.Error("error getting job [%s]: %s", jobName, err)
+		return nil, err
+	}
+	if details.Schedule == nil || details.Schedule.Interval == "" {
+		return nil, errors.InvalidArgument(scheduler.EntityJobRun, "job "+jobName.String()+" has no schedule")
+	}
+	jobCron, err := cron.ParseCronSchedule(details.Schedule.Interval)
+	if err != nil {
+		s.l.Error("error parsing cron interval for job [%s]: %s", jobName, err)
+		return nil, errors.InternalError(scheduler.EntityJobRun, "unable to parse job cron interval", err)
+	}
+
+	recommendation := &scheduler.ScheduleRecommendation{
+		JobName:         jobName,
+		CurrentSchedule: details.Schedule.Interval,
+	}
+
+	scheduleTimes := previousScheduleTimes(jobCron, referenceTime, historicalRunsForRecommendation)
+	readyAfter := map[time.Time]time.Duration{}
+	for _, upstream := range details.Upstreams.UpstreamJobs {
+		if upstream.External || upstream.JobName == "" {
+			continue
+		}
+		if err := s.collectUpstreamReadiness(ctx, upstream, scheduleTimes, readyAfter); err != nil {
+			s.l.Warn("skipping upstream [%s] of job [%s] for schedule recommendation: %s", upstream.JobName, jobName, err)
+		}
+	}
+
+	recommendation.SampleSize = len(readyAfter)
+	if recommendation.SampleSize < minimumSamplesForRecommendation {
+		recommendation.Reason = fmt.Sprintf("not enough completed upstream runs, found %d out of the last %d schedules",
+			recommendation.SampleSize, len(scheduleTimes))
+		return recommendation, nil
+	}
+
+	durations := make([]time.Duration, 0, len(readyAfter))
+	for _, d := range readyAfter {
+		durations = append(durations, d)
+	}
+	recommendation.UpstreamsReadyAfter = percentile(durations, 0.9) //nolint:gomnd
+
+	switch ready := recommendation.UpstreamsReadyAfter; {
+	case ready > scheduleShiftStep:
+		recommendation.Shift = roundUp(ready, scheduleShiftStep)
+		recommendation.Reason = fmt.Sprintf("upstreams are usually done %s after the schedule, the job waits on its sensors meanwhile", ready)
+	case ready < -scheduleShiftStep:
+		recommendation.Shift = ready.Truncate(scheduleShiftStep)
+		recommendation.Reason = fmt.Sprintf("upstreams are usually done %s before the schedule, the job can start earlier", -ready)
+	default:
+		recommendation.Reason = "schedule already matches the completion of upstreams"
+		return recommendation, nil
+	}
+
+	recommendation.RecommendedSchedule, err = shiftCronSchedule(details.Schedule.Interval, recommendation.Shift)
+	if err != nil {
+		recommendation.Reason += fmt.Sprintf(", shift the schedule by %s (%s)", recommendation.Shift, err)
+	}
+	return recommendation, nil
+}
+
+// collectUpstreamReadiness records, for every schedule of the downstream, how long after it the upstream run the
+// downstream depends on was done, keeping the latest one among the upstreams
+func (s *JobRunService) collectUpstreamReadiness(ctx context.Context, upstream *scheduler.JobUpstream, scheduleTimes []time.Time, readyAfter map[time.Time]time.Duration) error {
+	upstreamDetails, err := s.jobRepo.GetJobDetails(ctx, upstream.Tenant.ProjectName(), scheduler.JobName(upstream.JobName))
+	if err != nil {
+		return err
+	}
+	if upstreamDetails.Schedule == nil {
+		return errors.InvalidArgument(scheduler.EntityJobRun, "upstream has no schedule")
+	}
+	upstreamCron, err := cron.ParseCronSchedule(upstreamDetails.Schedule.Interval)
+	if err != nil {
+		return err
+	}
+
+	downstreamTimes := map[time.Time][]time.Time{}
+	var upstreamTimes []time.Time
+	for _, scheduledAt := range scheduleTimes {
+		upstreamScheduledAt := upstreamScheduleTime(upstreamCron, scheduledAt)
+		if _, ok := downstreamTimes[upstreamScheduledAt]; !ok {
+			upstreamTimes = append(upstreamTimes, upstreamScheduledAt)
+		}
+		downstreamTimes[upstreamScheduledAt] = append(downstreamTimes[upstreamScheduledAt], scheduledAt)
+	}
+
+	runs, err := s.repo.GetByScheduledTimes(ctx, upstreamDetails.Job.Tenant, upstreamDetails.Name, upstreamTimes)
+	if err != nil && !errors.IsErrorType(err, errors.ErrNotFound) {
+		return err
+	}
+	for _, run := range runs {
+		if run.EndTime == nil || run.State != scheduler.StateSuccess {
+			continue
+		}
+		for _, scheduledAt := range downstreamTimes[run.ScheduledAt] {
+			after := run.EndTime.Sub(scheduledAt)
+			if current, ok := readyAfter[scheduledAt]; !ok || after > current {
+				readyAfter[scheduledAt] = after
+			}
+		}
+	}
+	return nil
+}
+
+// upstreamScheduleTime returns the schedule time of the upstream run a downstream run scheduled at scheduledAt
+// depends on, which is the upstream run scheduled at the same time, or else the latest one before it
+func upstreamScheduleTime(upstreamCron *cron.ScheduleSpec, scheduledAt time.Time) time.Time {
+	upstreamScheduledAt := upstreamCron.Prev(scheduledAt)
+	if next := upstreamCron.Next(upstreamScheduledAt); next.Equal(scheduledAt) {
+		return next
+	}
+	return upstreamScheduledAt
+}
+
+// shiftCronSchedule shifts a cron running at fixed minute and hour, shifting other crons or across days would
+// change the days the job runs on
+func shiftCronSchedule(interval string, shift time.Duration) (string, error) {
+	fields := strings.Fields(interval)
+	if len(fields) != 5 { //nolint:gomnd
+		return "", fmt.Errorf("only crons with five fields can be shifted")
+	}
+	minute, minuteErr := strconv.Atoi(fields[0])
+	hour, hourErr := strconv.Atoi(fields[1])
+	if minuteErr != nil || hourErr != nil {
+		return "", fmt.Errorf("only crons running at a fixed minute and hour can be shifted")
+	}
+
+	shifted := time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute + shift
+	if shifted < 0 || shifted >= 24*time.Hour {
+		return "", fmt.Errorf("shifted schedule crosses the day")
+	}
+	fields[0] = strconv.Itoa(int(shifted.Minutes()) % 60) //nolint:gomnd
+	fields[1] = strconv.Itoa(int(shifted.Hours()))
+	return strings.Join(fields, " "), nil
+}
+
+func percentile(durations []time.Duration, p float64) time.Duration {
+	sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+	index := int(float64(len(durations)-1) * p)
+	return durations[index]
+}
+
+func roundUp(d, step time.Duration) time.Duration {
+	if d%step == 0 {
+		return d
+	}
+	return d - d%step + step
+}
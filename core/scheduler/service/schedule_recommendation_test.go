@@ -0,0 +1,129 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/scheduler/service"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+)
+
+func TestJobRunServiceRecommendSchedule(t *testing.T) {
+	ctx := context.Background()
+	logger := log.NewNoop()
+	projName := tenant.ProjectName("proj")
+	jobName := scheduler.JobName("downstream_job")
+	upstreamName := scheduler.JobName("upstream_job")
+	tnnt, _ := tenant.NewTenant(projName.String(), "ns1")
+	referenceTime := time.Date(2023, 3, 15, 0, 30, 0, 0, time.UTC)
+
+	downstream := &scheduler.JobWithDetails{
+		Name:     jobName,
+		Job:      &scheduler.Job{Name: jobName, Tenant: tnnt},
+		Schedule: &scheduler.Schedule{Interval: "0 2 * * *"},
+		Upstreams: scheduler.Upstreams{
+			UpstreamJobs: []*scheduler.JobUpstream{
+				{JobName: upstreamName.String(), Tenant: tnnt},
+				{JobName: "external_job", Tenant: tnnt, External: true},
+			},
+		},
+	}
+	upstream := &scheduler.JobWithDetails{
+		Name:     upstreamName,
+		Job:      &scheduler.Job{Name: upstreamName, Tenant: tnnt},
+		Schedule: &scheduler.Schedule{Interval: "0 1 * * *"},
+	}
+	upstreamRuns := func(days int, doneAfterSchedule time.Duration) []*scheduler.JobRun {
+		var runs []*scheduler.JobRun
+		for day := 1; day <= days; day++ {
+			scheduledAt := time.Date(2023, 3, 15-day, 1, 0, 0, 0, time.UTC)
+			endTime := scheduledAt.Add(doneAfterSchedule)
+			runs = append(runs, &scheduler.JobRun{
+				JobName:     upstreamName,
+				Tenant:      tnnt,
+				State:       scheduler.StateSuccess,
+				ScheduledAt: scheduledAt,
+				StartTime:   scheduledAt,
+				EndTime:     &endTime,
+			})
+		}
+		return runs
+	}
+
+	t.Run("returns error when unable to get job details", func(t *testing.T) {
+		jobRepo := new(JobRepository)
+		defer jobRepo.AssertExpectations(t)
+
+		jobRepo.On("GetJobDetails", ctx, projName, jobName).Return(nil, errors.NotFound(scheduler.EntityJobRun, "job not found"))
+
+		runService := service.NewJobRunService(logger, jobRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		recommendation, err := runService.RecommendSchedule(ctx, projName, jobName, referenceTime)
+
+		assert.Nil(t, recommendation)
+		assert.ErrorContains(t, err, "job not found")
+	})
+	t.Run("recommends delaying the schedule when upstreams are done after it", func(t *testing.T) {
+		jobRepo := new(JobRepository)
+		defer jobRepo.AssertExpectations(t)
+		jobRunRepo := new(mockJobRunRepository)
+		defer jobRunRepo.AssertExpectations(t)
+
+		jobRepo.On("GetJobDetails", ctx, projName, jobName).Return(downstream, nil)
+		jobRepo.On("GetJobDetails", ctx, projName, upstreamName).Return(upstream, nil)
+		jobRunRepo.On("GetByScheduledTimes", ctx, tnnt, upstreamName, mock.Anything).Return(upstreamRuns(5, 2*time.Hour+8*time.Minute), nil)
+
+		runService := service.NewJobRunService(logger, jobRepo, jobRunRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		recommendation, err := runService.RecommendSchedule(ctx, projName, jobName, referenceTime)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 5, recommendation.SampleSize)
+		assert.Equal(t, 68*time.Minute, recommendation.UpstreamsReadyAfter)
+		assert.Equal(t, 70*time.Minute, recommendation.Shift)
+		assert.Equal(t, "0 2 * * *", recommendation.CurrentSchedule)
+		assert.Equal(t, "10 3 * * *", recommendation.RecommendedSchedule)
+	})
+	t.Run("recommends bringing the schedule forward when upstreams are done well before it", func(t *testing.T) {
+		jobRepo := new(JobRepository)
+		defer jobRepo.AssertExpectations(t)
+		jobRunRepo := new(mockJobRunRepository)
+		defer jobRunRepo.AssertExpectations(t)
+
+		jobRepo.On("GetJobDetails", ctx, projName, jobName).Return(downstream, nil)
+		jobRepo.On("GetJobDetails", ctx, projName, upstreamName).Return(upstream, nil)
+		jobRunRepo.On("GetByScheduledTimes", ctx, tnnt, upstreamName, mock.Anything).Return(upstreamRuns(5, 18*time.Minute), nil)
+
+		runService := service.NewJobRunService(logger, jobRepo, jobRunRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		recommendation, err := runService.RecommendSchedule(ctx, projName, jobName, referenceTime)
+
+		assert.NoError(t, err)
+		assert.Equal(t, -42*time.Minute, recommendation.UpstreamsReadyAfter)
+		assert.Equal(t, -40*time.Minute, recommendation.Shift)
+		assert.Equal(t, "20 1 * * *", recommendation.RecommendedSchedule)
+	})
+	t.Run("does not recommend without enough completed upstream runs", func(t *testing.T) {
+		jobRepo := new(JobRepository)
+		defer jobRepo.AssertExpectations(t)
+		jobRunRepo := new(mockJobRunRepository)
+		defer jobRunRepo.AssertExpectations(t)
+
+		jobRepo.On("GetJobDetails", ctx, projName, jobName).Return(downstream, nil)
+		jobRepo.On("GetJobDetails", ctx, projName, upstreamName).Return(upstream, nil)
+		jobRunRepo.On("GetByScheduledTimes", ctx, tnnt, upstreamName, mock.Anything).Return(upstreamRuns(2, 3*time.Hour), nil)
+
+		runService := service.NewJobRunService(logger, jobRepo, jobRunRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		recommendation, err := runService.RecommendSchedule(ctx, projName, jobName, referenceTime)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, recommendation.SampleSize)
+		assert.Zero(t, recommendation.Shift)
+		assert.Empty(t, recommendation.RecommendedSchedule)
+		assert.Contains(t, recommendation.Reason, "not enough completed upstream runs")
+	})
+}
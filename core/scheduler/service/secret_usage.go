@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/goto/salt/log"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/tenant"
+)
+
+// secretReferenceRegex matches the secrets referenced in templates, like {{ .secret.NAME }}
+var secretReferenceRegex = regexp.MustCompile(`\.secret\.(\w+)`)
+
+// SecretUsageRepository keeps the versions of the secrets the jobs are compiled against, for auditing the jobs
+// still compiled against the previous version of a rotated secret
+type SecretUsageRepository interface {
+	RecordUsage(ctx context.Context, tnnt tenant.Tenant, jobName string, secretNames []string) error
+}
+
+// SecretUsageRecordingCompiler records the versions of the secrets referenced by the job every time its executor
+// input is compiled, the compiled input is returned even when the usage fails to be recorded
+type SecretUsageRecordingCompiler struct {
+	compiler JobInputCompiler
+	repo     SecretUsageRepository
+
+	logger log.Logger
+}
+
+func (c SecretUsageRecordingCompiler) Compile(ctx context.Context, job *scheduler.JobWithDetails, config scheduler.RunConfig, executedAt time.Time) (*scheduler.ExecutorInput, error) {
+	input, err := c.compiler.Compile(ctx, job, config, executedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if secretNames := referencedSecretNames(job.Job); len(secretNames) > 0 {
+		if err := c.repo.RecordUsage(ctx, job.Job.Tenant, job.Name.String(), secretNames); err != nil {
+			c.logger.Error("error recording secret usage of job [%s]: %s", job.Name, err)
+		}
+	}
+	return input, nil
+}
+
+func (c SecretUsageRecordingCompiler) EnabledHooks(ctx context.Context, job *scheduler.Job) ([]*scheduler.Hook, error) {
+	return c.compiler.EnabledHooks(ctx, job)
+}
+
+// referencedSecretNames returns the names of the secrets referenced in the task config, the hook configs and the assets
+func referencedSecretNames(job *scheduler.Job) []string {
+	templates := []map[string]string{job.Assets}
+	if job.Task != nil {
+		templates = append(templates, job.Task.Config)
+	}
+	for _, hook := range job.Hooks {
+		templates = append(templates, hook.Config)
+	}
+
+	referenced := make(map[string]bool)
+	for _, templateMap := range templates {
+		for _, template := range templateMap {
+			if !strings.Contains(template, SecretsStringToMatch) {
+				continue
+			}
+			for _, match := range secretReferenceRegex.FindAllStringSubmatch(template, -1) {
+				referenced[strings.ToUpper(match[1])] = true
+			}
+		}
+	}
+
+	names := make([]string, 0, len(referenced))
+	for name := range referenced {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func NewSecretUsageRecordingCompiler(compiler JobInputCompiler, repo SecretUsageRepository, logger log.Logger) *SecretUsageRecordingCompiler {
+	return &SecretUsageRecordingCompiler{
+		compiler: compiler,
+		repo:     repo,
+		logger:   logger,
+	}
+}
@@ -0,0 +1,144 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/scheduler/service"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/lib/lru"
+)
+
+func TestSecretUsageRecordingCompiler(t *testing.T) {
+	ctx := context.Background()
+	logger := log.NewNoop()
+	tnnt, _ := tenant.NewTenant("proj", "ns1")
+	executedAt := time.Date(2023, 1, 2, 0, 1, 0, 0, time.UTC)
+	config := scheduler.RunConfig{
+		Executor:    scheduler.Executor{Name: "bq2bq", Type: scheduler.ExecutorTask},
+		ScheduledAt: time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+	input := &scheduler.ExecutorInput{Configs: map[string]string{"EXECUTION_TIME": executedAt.Format(time.RFC3339)}}
+
+	newJob := func(taskConfig, hookConfig, assets map[string]string) *scheduler.JobWithDetails {
+		return &scheduler.JobWithDetails{
+			Name: "job1",
+			Job: &scheduler.Job{
+				Name:   "job1",
+				Tenant: tnnt,
+				Task:   &scheduler.Task{Name: "bq2bq", Config: taskConfig},
+				Hooks:  []*scheduler.Hook{{Name: "predator", Config: hookConfig}},
+				Assets: assets,
+			},
+		}
+	}
+
+	t.Run("Compile", func(t *testing.T) {
+		t.Run("returns error when compilation fails without recording the usage", func(t *testing.T) {
+			job := newJob(map[string]string{"TOKEN": "{{ .secret.API_TOKEN }}"}, nil, nil)
+			compiler := new(mockJobInputCompiler)
+			compiler.On("Compile", ctx, job, config, executedAt).Return(nil, errors.New("unable to compile"))
+			defer compiler.AssertExpectations(t)
+
+			repo := new(mockSecretUsageRepository)
+			defer repo.AssertExpectations(t)
+
+			recordingCompiler := service.NewSecretUsageRecordingCompiler(compiler, repo, logger)
+			_, err := recordingCompiler.Compile(ctx, job, config, executedAt)
+			assert.ErrorContains(t, err, "unable to compile")
+		})
+		t.Run("records the secrets referenced in the task, hooks and assets of the job", func(t *testing.T) {
+			job := newJob(
+				map[string]string{"TOKEN": "{{ .secret.API_TOKEN }}", "PROJECT": "{{ .GLOBAL__PROJECT }}"},
+				map[string]string{"KEY": "{{.secret.storage}}"},
+				map[string]string{"query.sql": "select '{{ .secret.API_TOKEN }}', '{{ .secret.SALT }}'"},
+			)
+			compiler := new(mockJobInputCompiler)
+			compiler.On("Compile", ctx, job, config, executedAt).Return(input, nil)
+			defer compiler.AssertExpectations(t)
+
+			repo := new(mockSecretUsageRepository)
+			repo.On("RecordUsage", ctx, tnnt, "job1", []string{"API_TOKEN", "SALT", "STORAGE"}).Return(nil)
+			defer repo.AssertExpectations(t)
+
+			recordingCompiler := service.NewSecretUsageRecordingCompiler(compiler, repo, logger)
+			result, err := recordingCompiler.Compile(ctx, job, config, executedAt)
+			assert.NoError(t, err)
+			assert.Equal(t, input, result)
+		})
+		t.Run("returns the input when the usage fails to be recorded", func(t *testing.T) {
+			job := newJob(map[string]string{"TOKEN": "{{ .secret.API_TOKEN }}"}, nil, nil)
+			compiler := new(mockJobInputCompiler)
+			compiler.On("Compile", ctx, job, config, executedAt).Return(input, nil)
+			defer compiler.AssertExpectations(t)
+
+			repo := new(mockSecretUsageRepository)
+			repo.On("RecordUsage", ctx, tnnt, "job1", []string{"API_TOKEN"}).Return(errors.New("unable to record"))
+			defer repo.AssertExpectations(t)
+
+			recordingCompiler := service.NewSecretUsageRecordingCompiler(compiler, repo, logger)
+			result, err := recordingCompiler.Compile(ctx, job, config, executedAt)
+			assert.NoError(t, err)
+			assert.Equal(t, input, result)
+		})
+		t.Run("records the usage of the inputs served from the cache", func(t *testing.T) {
+			job := newJob(map[string]string{"TOKEN": "{{ .secret.API_TOKEN }}"}, nil, nil)
+			compiler := new(mockJobInputCompiler)
+			compiler.On("Compile", ctx, job, config, executedAt).Return(input, nil).Once()
+			defer compiler.AssertExpectations(t)
+
+			project, _ := tenant.NewProject("proj", map[string]string{tenant.ProjectSchedulerHost: "host", tenant.ProjectStoragePathKey: "gs://location"})
+			namespace, _ := tenant.NewNamespace("ns1", project.Name(), map[string]string{})
+			details, _ := tenant.NewTenantDetails(project, namespace, nil)
+			tenantService := new(mockTenantService)
+			tenantService.On("GetDetails", ctx, tnnt).Return(details, nil).Twice()
+			defer tenantService.AssertExpectations(t)
+			snippetGetter := new(mockSnippetGetter)
+			snippetGetter.On("GetSnippets", ctx, tnnt.ProjectName()).Return(map[string]string{}, nil).Twice()
+			defer snippetGetter.AssertExpectations(t)
+
+			repo := new(mockSecretUsageRepository)
+			repo.On("RecordUsage", ctx, tnnt, "job1", []string{"API_TOKEN"}).Return(nil).Twice()
+			defer repo.AssertExpectations(t)
+
+			cachedCompiler := service.NewCachedInputCompiler(compiler, lru.New[string, *scheduler.ExecutorInput](10, 0), tenantService, snippetGetter)
+			recordingCompiler := service.NewSecretUsageRecordingCompiler(cachedCompiler, repo, logger)
+
+			for i := 0; i < 2; i++ {
+				result, err := recordingCompiler.Compile(ctx, job, config, executedAt)
+				assert.NoError(t, err)
+				assert.Equal(t, input, result)
+			}
+		})
+		t.Run("does not record anything when the job references no secret", func(t *testing.T) {
+			job := newJob(map[string]string{"PROJECT": "{{ .GLOBAL__PROJECT }}"}, nil, nil)
+			compiler := new(mockJobInputCompiler)
+			compiler.On("Compile", ctx, job, config, executedAt).Return(input, nil)
+			defer compiler.AssertExpectations(t)
+
+			repo := new(mockSecretUsageRepository)
+			defer repo.AssertExpectations(t)
+
+			recordingCompiler := service.NewSecretUsageRecordingCompiler(compiler, repo, logger)
+			result, err := recordingCompiler.Compile(ctx, job, config, executedAt)
+			assert.NoError(t, err)
+			assert.Equal(t, input, result)
+		})
+	})
+}
+
+type mockSecretUsageRepository struct {
+	mock.Mock
+}
+
+func (m *mockSecretUsageRepository) RecordUsage(ctx context.Context, tnnt tenant.Tenant, jobName string, secretNames []string) error {
+	args := m.Called(ctx, tnnt, jobName, secretNames)
+	return args.Error(0)
+}
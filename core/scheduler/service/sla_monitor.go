@@ -0,0 +1,384 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/goto/salt/log"
+	"github.com/robfig/cron/v3"
+
+	"github.com/goto/optimus/config"
+	"github.com/goto/optimus/core/event"
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+	lcron "github.com/goto/optimus/internal/lib/cron"
+	"github.com/goto/optimus/internal/telemetry"
+)
+
+const (
+	defaultSLAMonitorInterval = 5 * time.Minute
+
+	// maxSLABreachLookback bounds the schedule times looked back for the deadlines passed since the last check
+	maxSLABreachLookback = 100
+)
+
+type ProjectsGetter interface {
+	GetAll(ctx context.Context) ([]*tenant.Project, error)
+}
+
+type EventPusher interface {
+	Push(ctx context.Context, event *scheduler.Event) error
+}
+
+// SLAMonitor periodically projects the end time of pending and running job runs from the historical
+// wait and duration of the job, and raises an event when a run is expected to miss its sla deadline.
+// Runs whose deadline has passed since the last check without finishing successfully are reported as breached.
+type SLAMonitor struct {
+	l log.Logger
+
+	projectGetter ProjectsGetter
+	jobRepo       JobRepository
+	runRepo       JobRunRepository
+	notifier      EventPusher
+	eventHandler  EventHandler
+
+	schedule *cron.Cron
+	Now      func() time.Time
+
+	// notified keeps the sla deadline of runs already notified, so that a run is notified only once
+	notified map[string]time.Time
+	// lastChecked is when the deadlines were last checked for breaches
+	lastChecked time.Time
+	mu          *sync.Mutex
+
+	config config.SLAMonitorConfig
+}
+
+func NewSLAMonitor(l log.Logger, projectGetter ProjectsGetter, jobRepo JobRepository, runRepo JobRunRepository, notifier EventPusher,
+	eventHandler EventHandler, now func() time.Time, config config.SLAMonitorConfig,
+) *SLAMonitor {
+	return &SLAMonitor{
+		l:             l,
+		projectGetter: projectGetter,
+		jobRepo:       jobRepo,
+		runRepo:       runRepo,
+		notifier:      notifier,
+		eventHandler:  eventHandler,
+		Now:           now,
+		notified:      map[string]time.Time{},
+		mu:            &sync.Mutex{},
+		config:        config,
+		schedule: cron.New(cron.WithChain(
+			cron.SkipIfStillRunning(cron.DefaultLogger),
+		)),
+	}
+}
+
+func (m *SLAMonitor) Initialize() {
+	if !m.config.Enabled || m.schedule == nil {
+		return
+	}
+
+	_, err := m.schedule.AddFunc(fmt.Sprintf("@every %s", m.interval()), m.StartMonitorLoop)
+	if err != nil {
+		m.l.Error("Failed to add function to cron schedule: %s", err)
+	}
+	m.schedule.Start()
+}
+
+func (m *SLAMonitor) StartMonitorLoop() {
+	ctx := context.Background()
+	now := m.Now()
+	m.pruneNotified(now)
+	since := m.lastCheckedAt(now)
+
+	projects, err := m.projectGetter.GetAll(ctx)
+	if err != nil {
+		m.l.Error("unable to get projects for sla monitoring: %s", err)
+		return
+	}
+
+	for _, project := range projects {
+		jobs, err := m.jobRepo.GetAll(ctx, project.Name())
+		if err != nil {
+			m.l.Error("unable to get jobs of project [%s] for sla monitoring: %s", project.Name(), err)
+			continue
+		}
+		for _, job := range jobs {
+			if err := m.checkJob(ctx, job, since, now); err != nil {
+				m.l.Error("unable to project sla of job [%s]: %s", job.Name, err)
+			}
+		}
+	}
+	m.setLastChecked(now)
+}
+
+func (m *SLAMonitor) checkJob(ctx context.Context, job *scheduler.JobWithDetails, since, now time.Time) error {
+	sla, err := job.SLA()
+	if err != nil || sla.IsEmpty() || job.Schedule == nil {
+		return err
+	}
+	jobCron, err := lcron.ParseCronSchedule(job.Schedule.Interval)
+	if err != nil {
+		return errors.InternalError(scheduler.EntityJobRun, "unable to parse job cron interval", err)
+	}
+
+	if err := m.checkBreaches(ctx, job, jobCron, sla, since, now); err != nil {
+		m.l.Error("unable to check sla breaches of job [%s]: %s", job.Name, err)
+	}
+
+	scheduledAt := jobCron.Prev(now)
+	if scheduledAt.Before(job.Schedule.StartDate) || (job.Schedule.EndDate != nil && scheduledAt.After(*job.Schedule.EndDate)) {
+		return nil
+	}
+
+	key := fmt.Sprintf("%s/%s/%s", job.Job.Tenant.ProjectName(), job.Name, scheduledAt.Format(time.RFC3339))
+	if m.isNotified(key) {
+		return nil
+	}
+
+	historicalTimes := previousScheduleTimes(jobCron, scheduledAt, historicalRunsForEstimate)
+	runs, err := m.runRepo.GetByScheduledTimes(ctx, job.Job.Tenant, job.Name, append(historicalTimes, scheduledAt))
+	if err != nil && !errors.IsErrorType(err, errors.ErrNotFound) {
+		return err
+	}
+
+	var currentRun *scheduler.JobRun
+	pastRuns := make([]*scheduler.JobRun, 0, len(runs))
+	for _, run := range runs {
+		if run.ScheduledAt.Equal(scheduledAt) {
+			currentRun = run
+			continue
+		}
+		pastRuns = append(pastRuns, run)
+	}
+	avgWait, avgDuration := averageWaitAndDuration(pastRuns)
+	if avgDuration == 0 {
+		// without a finished run in history there is nothing to project against
+		return nil
+	}
+
+	deadline := sla.DeadlineFor(scheduledAt)
+	projectedEnd, ok := projectRunEnd(currentRun, scheduledAt, avgWait, avgDuration, now)
+	if !ok || !now.Before(deadline) || !projectedEnd.After(deadline) {
+		return nil
+	}
+
+	event := &scheduler.Event{
+		JobName:        job.Name,
+		Tenant:         job.Job.Tenant,
+		Type:           scheduler.SLAProjectedBreachEvent,
+		EventTime:      now,
+		JobScheduledAt: scheduledAt,
+		Values: map[string]any{
+			"scheduled_at":       scheduledAt.Format(time.RFC3339),
+			"sla_deadline":       deadline.Format(time.RFC3339),
+			"projected_end_time": projectedEnd.Format(time.RFC3339),
+			"message":            projectionMessage(currentRun, avgDuration),
+		},
+	}
+	if err := m.notifier.Push(ctx, event); err != nil {
+		return err
+	}
+	m.markNotified(key, deadline)
+
+	telemetry.NewCounter("jobrun_sla_projected_breach_total", map[string]string{
+		"project":   job.Job.Tenant.ProjectName().String(),
+		"namespace": job.Job.Tenant.NamespaceName().String(),
+	}).Inc()
+	return nil
+}
+
+// checkBreaches reports the runs whose sla deadline passed between since and now, and which had not
+// finished successfully by their deadline
+func (m *SLAMonitor) checkBreaches(ctx context.Context, job *scheduler.JobWithDetails, jobCron *lcron.ScheduleSpec, sla scheduler.SLA, since, now time.Time) error {
+	scheduleTimes := breachCandidates(job.Schedule, jobCron, sla, since, now)
+	if len(scheduleTimes) == 0 {
+		return nil
+	}
+
+	runs, err := m.runRepo.GetByScheduledTimes(ctx, job.Job.Tenant, job.Name, scheduleTimes)
+	if err != nil && !errors.IsErrorType(err, errors.ErrNotFound) {
+		return err
+	}
+	runsByScheduledAt := make(map[string]*scheduler.JobRun, len(runs))
+	for _, run := range runs {
+		runsByScheduledAt[run.ScheduledAt.UTC().String()] = run
+	}
+
+	var breachedTimes []time.Time
+	me := errors.NewMultiError("errors on reporting sla breaches")
+	for _, scheduledAt := range scheduleTimes {
+		run := runsByScheduledAt[scheduledAt.UTC().String()]
+		deadline := sla.DeadlineFor(scheduledAt)
+		if !isSLABreached(run, deadline) {
+			continue
+		}
+		breachedTimes = append(breachedTimes, scheduledAt)
+		me.Append(m.reportBreach(ctx, job, run, scheduledAt, deadline, now))
+	}
+
+	if len(breachedTimes) > 0 {
+		me.Append(m.runRepo.UpdateSLA(ctx, job.Name, job.Job.Tenant.ProjectName(), breachedTimes))
+	}
+	return me.ToErr()
+}
+
+func (m *SLAMonitor) reportBreach(ctx context.Context, job *scheduler.JobWithDetails, run *scheduler.JobRun, scheduledAt, deadline, now time.Time) error {
+	message := "run had not started by the deadline"
+	if run != nil && !run.StartTime.IsZero() {
+		message = fmt.Sprintf("run started at %s, and had not finished successfully by the deadline", run.StartTime.Format(time.RFC3339))
+	}
+	notification := &scheduler.Event{
+		JobName:        job.Name,
+		Tenant:         job.Job.Tenant,
+		Type:           scheduler.SLABreachEvent,
+		EventTime:      now,
+		JobScheduledAt: scheduledAt,
+		Values: map[string]any{
+			"scheduled_at": scheduledAt.Format(time.RFC3339),
+			"sla_deadline": deadline.Format(time.RFC3339),
+			"message":      message,
+			"slas": []any{
+				map[string]any{"scheduled_at": scheduledAt.Format(time.RFC3339)},
+			},
+		},
+	}
+	if err := m.notifier.Push(ctx, notification); err != nil {
+		return err
+	}
+
+	if run == nil {
+		run = &scheduler.JobRun{
+			JobName:     job.Name,
+			Tenant:      job.Job.Tenant,
+			ScheduledAt: scheduledAt,
+		}
+	}
+	breachEvent, err := event.NewJobRunSLABreachEvent(run)
+	if err != nil {
+		m.l.Error("error creating sla breach event for job [%s]: %s", job.Name, err)
+	} else {
+		m.eventHandler.HandleEvent(breachEvent)
+	}
+
+	telemetry.NewCounter("jobrun_sla_breach_total", map[string]string{
+		"project":   job.Job.Tenant.ProjectName().String(),
+		"namespace": job.Job.Tenant.NamespaceName().String(),
+	}).Inc()
+	return nil
+}
+
+// breachCandidates returns the schedule times of the job whose sla deadline falls between since and now
+func breachCandidates(schedule *scheduler.Schedule, jobCron *lcron.ScheduleSpec, sla scheduler.SLA, since, now time.Time) []time.Time {
+	var times []time.Time
+	current := now
+	for i := 0; i < maxSLABreachLookback; i++ {
+		current = jobCron.Prev(current)
+		if current.Before(schedule.StartDate) {
+			break
+		}
+		deadline := sla.DeadlineFor(current)
+		if !deadline.After(since) {
+			break
+		}
+		if deadline.After(now) || (schedule.EndDate != nil && current.After(*schedule.EndDate)) {
+			continue
+		}
+		times = append(times, current)
+	}
+	return times
+}
+
+// isSLABreached tells if the run has not finished successfully by the deadline
+func isSLABreached(run *scheduler.JobRun, deadline time.Time) bool {
+	if run == nil || run.State != scheduler.StateSuccess {
+		return true
+	}
+	return run.EndTime != nil && run.EndTime.After(deadline)
+}
+
+// projectRunEnd estimates the end time of the run at scheduledAt, a run which has not started yet is expected
+// to start after the historical wait, and a started run is expected to take the historical duration
+func projectRunEnd(run *scheduler.JobRun, scheduledAt time.Time, avgWait, avgDuration time.Duration, now time.Time) (time.Time, bool) {
+	if run == nil || run.StartTime.IsZero() {
+		start := scheduledAt.Add(avgWait)
+		if start.Before(now) {
+			start = now
+		}
+		return start.Add(avgDuration), true
+	}
+	if run.EndTime != nil || isRunFinished(run.State) {
+		return time.Time{}, false
+	}
+
+	end := run.StartTime.Add(avgDuration)
+	if end.Before(now) {
+		end = now
+	}
+	return end, true
+}
+
+func projectionMessage(run *scheduler.JobRun, avgDuration time.Duration) string {
+	if run == nil || run.StartTime.IsZero() {
+		return fmt.Sprintf("run has not started yet, and usually takes %s to finish", avgDuration)
+	}
+	return fmt.Sprintf("run started at %s, and usually takes %s to finish", run.StartTime.Format(time.RFC3339), avgDuration)
+}
+
+func (m *SLAMonitor) interval() time.Duration {
+	if m.config.Interval > 0 {
+		return m.config.Interval
+	}
+	return defaultSLAMonitorInterval
+}
+
+// lastCheckedAt returns when the deadlines were last checked, on the first check the deadlines
+// passed within the last interval are considered
+func (m *SLAMonitor) lastCheckedAt(now time.Time) time.Time {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.lastChecked.IsZero() {
+		return now.Add(-m.interval())
+	}
+	return m.lastChecked
+}
+
+func (m *SLAMonitor) setLastChecked(now time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.lastChecked = now
+}
+
+func (m *SLAMonitor) isNotified(key string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, ok := m.notified[key]
+	return ok
+}
+
+func (m *SLAMonitor) markNotified(key string, deadline time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.notified[key] = deadline
+}
+
+func (m *SLAMonitor) pruneNotified(now time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for key, deadline := range m.notified {
+		if deadline.Before(now) {
+			delete(m.notified, key)
+		}
+	}
+}
+
+func (m *SLAMonitor) Close() {
+	if m.schedule != nil {
+		<-m.schedule.Stop().Done()
+	}
+	m.l.Info("sla monitor stopped")
+}
@@ -0,0 +1,289 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/goto/optimus/config"
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/scheduler/service"
+	"github.com/goto/optimus/core/tenant"
+)
+
+func TestSLAMonitor(t *testing.T) {
+	logger := log.NewNoop()
+	project, _ := tenant.NewProject("proj1", map[string]string{
+		"STORAGE_PATH":   "somePath",
+		"SCHEDULER_HOST": "localhost",
+	})
+	tnnt, _ := tenant.NewTenant(project.Name().String(), "ns1")
+	jobName := scheduler.JobName("sample_select")
+
+	now := time.Date(2023, 10, 10, 10, 20, 0, 0, time.UTC)
+	nowFn := func() time.Time { return now }
+	scheduledAt := time.Date(2023, 10, 10, 10, 0, 0, 0, time.UTC)
+	monitorConfig := config.SLAMonitorConfig{Enabled: true}
+
+	jobWithDetails := &scheduler.JobWithDetails{
+		Name: jobName,
+		Job: &scheduler.Job{
+			Name:   jobName,
+			Tenant: tnnt,
+		},
+		Schedule: &scheduler.Schedule{
+			StartDate: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+			Interval:  "0 * * * *",
+		},
+		Alerts: []scheduler.Alert{
+			{
+				On:       scheduler.EventCategorySLAMiss,
+				Channels: []string{"slack://#alerts"},
+				Config:   map[string]string{"duration": "30m"},
+			},
+		},
+	}
+
+	pastEnd := scheduledAt.Add(-time.Hour + time.Minute*35)
+	pastRun := &scheduler.JobRun{
+		ScheduledAt: scheduledAt.Add(-time.Hour),
+		StartTime:   scheduledAt.Add(-time.Hour + time.Minute*10),
+		EndTime:     &pastEnd,
+		State:       scheduler.StateSuccess,
+	}
+
+	t.Run("StartMonitorLoop", func(t *testing.T) {
+		t.Run("does nothing when unable to get projects", func(t *testing.T) {
+			projectGetter := new(mockProjectsGetter)
+			defer projectGetter.AssertExpectations(t)
+
+			projectGetter.On("GetAll", mock.Anything).Return(nil, errors.New("unable to get projects"))
+
+			monitor := service.NewSLAMonitor(logger, projectGetter, nil, nil, nil, nil, nowFn, monitorConfig)
+			monitor.StartMonitorLoop()
+		})
+		t.Run("pushes projected breach event once for a run which has not started in time", func(t *testing.T) {
+			projectGetter := new(mockProjectsGetter)
+			defer projectGetter.AssertExpectations(t)
+			jobRepo := new(JobRepository)
+			defer jobRepo.AssertExpectations(t)
+			jobRunRepo := new(mockJobRunRepository)
+			defer jobRunRepo.AssertExpectations(t)
+			notifier := new(mockEventPusher)
+			defer notifier.AssertExpectations(t)
+
+			projectGetter.On("GetAll", mock.Anything).Return([]*tenant.Project{project}, nil)
+			jobRepo.On("GetAll", mock.Anything, project.Name()).Return([]*scheduler.JobWithDetails{jobWithDetails}, nil)
+			jobRunRepo.On("GetByScheduledTimes", mock.Anything, tnnt, jobName, mock.Anything).Return([]*scheduler.JobRun{pastRun}, nil).Once()
+			notifier.On("Push", mock.Anything, mock.MatchedBy(func(event *scheduler.Event) bool {
+				return event.Type == scheduler.SLAProjectedBreachEvent &&
+					event.JobName == jobName &&
+					event.JobScheduledAt.Equal(scheduledAt) &&
+					event.Values["sla_deadline"] == "2023-10-10T10:30:00Z" &&
+					event.Values["projected_end_time"] == "2023-10-10T10:45:00Z"
+			})).Return(nil).Once()
+
+			monitor := service.NewSLAMonitor(logger, projectGetter, jobRepo, jobRunRepo, notifier, nil, nowFn, monitorConfig)
+			monitor.StartMonitorLoop()
+			monitor.StartMonitorLoop()
+		})
+		t.Run("does not push event when running run is projected to finish within sla", func(t *testing.T) {
+			projectGetter := new(mockProjectsGetter)
+			defer projectGetter.AssertExpectations(t)
+			jobRepo := new(JobRepository)
+			defer jobRepo.AssertExpectations(t)
+			jobRunRepo := new(mockJobRunRepository)
+			defer jobRunRepo.AssertExpectations(t)
+			notifier := new(mockEventPusher)
+			defer notifier.AssertExpectations(t)
+
+			currentRun := &scheduler.JobRun{
+				ScheduledAt: scheduledAt,
+				StartTime:   scheduledAt.Add(time.Minute * 2),
+				State:       scheduler.StateRunning,
+			}
+			projectGetter.On("GetAll", mock.Anything).Return([]*tenant.Project{project}, nil)
+			jobRepo.On("GetAll", mock.Anything, project.Name()).Return([]*scheduler.JobWithDetails{jobWithDetails}, nil)
+			jobRunRepo.On("GetByScheduledTimes", mock.Anything, tnnt, jobName, mock.Anything).Return([]*scheduler.JobRun{pastRun, currentRun}, nil)
+
+			monitor := service.NewSLAMonitor(logger, projectGetter, jobRepo, jobRunRepo, notifier, nil, nowFn, monitorConfig)
+			monitor.StartMonitorLoop()
+		})
+		t.Run("pushes projected breach event when running run started too late to finish within sla", func(t *testing.T) {
+			projectGetter := new(mockProjectsGetter)
+			defer projectGetter.AssertExpectations(t)
+			jobRepo := new(JobRepository)
+			defer jobRepo.AssertExpectations(t)
+			jobRunRepo := new(mockJobRunRepository)
+			defer jobRunRepo.AssertExpectations(t)
+			notifier := new(mockEventPusher)
+			defer notifier.AssertExpectations(t)
+
+			slowJob := *jobWithDetails
+			slowJob.Alerts = []scheduler.Alert{
+				{On: scheduler.EventCategorySLAMiss, Config: map[string]string{"duration": "40m"}},
+			}
+			currentRun := &scheduler.JobRun{
+				ScheduledAt: scheduledAt,
+				StartTime:   scheduledAt.Add(time.Minute * 17),
+				State:       scheduler.StateRunning,
+			}
+			projectGetter.On("GetAll", mock.Anything).Return([]*tenant.Project{project}, nil)
+			jobRepo.On("GetAll", mock.Anything, project.Name()).Return([]*scheduler.JobWithDetails{&slowJob}, nil)
+			jobRunRepo.On("GetByScheduledTimes", mock.Anything, tnnt, jobName, mock.Anything).Return([]*scheduler.JobRun{pastRun, currentRun}, nil)
+			notifier.On("Push", mock.Anything, mock.MatchedBy(func(event *scheduler.Event) bool {
+				return event.Type == scheduler.SLAProjectedBreachEvent &&
+					event.Values["sla_deadline"] == "2023-10-10T10:40:00Z" &&
+					event.Values["projected_end_time"] == "2023-10-10T10:42:00Z"
+			})).Return(nil)
+
+			monitor := service.NewSLAMonitor(logger, projectGetter, jobRepo, jobRunRepo, notifier, nil, nowFn, monitorConfig)
+			monitor.StartMonitorLoop()
+		})
+		t.Run("does not push event when job has no finished run in history", func(t *testing.T) {
+			projectGetter := new(mockProjectsGetter)
+			defer projectGetter.AssertExpectations(t)
+			jobRepo := new(JobRepository)
+			defer jobRepo.AssertExpectations(t)
+			jobRunRepo := new(mockJobRunRepository)
+			defer jobRunRepo.AssertExpectations(t)
+			notifier := new(mockEventPusher)
+			defer notifier.AssertExpectations(t)
+
+			projectGetter.On("GetAll", mock.Anything).Return([]*tenant.Project{project}, nil)
+			jobRepo.On("GetAll", mock.Anything, project.Name()).Return([]*scheduler.JobWithDetails{jobWithDetails}, nil)
+			jobRunRepo.On("GetByScheduledTimes", mock.Anything, tnnt, jobName, mock.Anything).Return([]*scheduler.JobRun{}, nil)
+
+			monitor := service.NewSLAMonitor(logger, projectGetter, jobRepo, jobRunRepo, notifier, nil, nowFn, monitorConfig)
+			monitor.StartMonitorLoop()
+		})
+		t.Run("reports runs which had not finished successfully by the deadline passed since the last check", func(t *testing.T) {
+			projectGetter := new(mockProjectsGetter)
+			defer projectGetter.AssertExpectations(t)
+			jobRepo := new(JobRepository)
+			defer jobRepo.AssertExpectations(t)
+			jobRunRepo := new(mockJobRunRepository)
+			defer jobRunRepo.AssertExpectations(t)
+			notifier := new(mockEventPusher)
+			defer notifier.AssertExpectations(t)
+			eventHandler := newEventHandler(t)
+
+			breachCheckAt := time.Date(2023, 10, 10, 10, 32, 0, 0, time.UTC)
+			currentRun := &scheduler.JobRun{
+				ScheduledAt: scheduledAt,
+				StartTime:   scheduledAt.Add(time.Minute * 2),
+				State:       scheduler.StateRunning,
+			}
+			projectGetter.On("GetAll", mock.Anything).Return([]*tenant.Project{project}, nil)
+			jobRepo.On("GetAll", mock.Anything, project.Name()).Return([]*scheduler.JobWithDetails{jobWithDetails}, nil)
+			jobRunRepo.On("GetByScheduledTimes", mock.Anything, tnnt, jobName, []time.Time{scheduledAt}).Return([]*scheduler.JobRun{currentRun}, nil).Once()
+			jobRunRepo.On("GetByScheduledTimes", mock.Anything, tnnt, jobName, mock.Anything).Return([]*scheduler.JobRun{pastRun, currentRun}, nil)
+			jobRunRepo.On("UpdateSLA", mock.Anything, jobName, project.Name(), []time.Time{scheduledAt}).Return(nil).Once()
+			notifier.On("Push", mock.Anything, mock.MatchedBy(func(event *scheduler.Event) bool {
+				return event.Type == scheduler.SLABreachEvent &&
+					event.JobScheduledAt.Equal(scheduledAt) &&
+					event.Values["sla_deadline"] == "2023-10-10T10:30:00Z"
+			})).Return(nil).Once()
+			eventHandler.On("HandleEvent", mock.Anything).Once()
+
+			monitor := service.NewSLAMonitor(logger, projectGetter, jobRepo, jobRunRepo, notifier, eventHandler, func() time.Time { return breachCheckAt }, monitorConfig)
+			monitor.StartMonitorLoop()
+			monitor.StartMonitorLoop()
+		})
+		t.Run("does not report runs which finished successfully by the deadline", func(t *testing.T) {
+			projectGetter := new(mockProjectsGetter)
+			defer projectGetter.AssertExpectations(t)
+			jobRepo := new(JobRepository)
+			defer jobRepo.AssertExpectations(t)
+			jobRunRepo := new(mockJobRunRepository)
+			defer jobRunRepo.AssertExpectations(t)
+			notifier := new(mockEventPusher)
+			defer notifier.AssertExpectations(t)
+
+			breachCheckAt := time.Date(2023, 10, 10, 10, 32, 0, 0, time.UTC)
+			currentEnd := scheduledAt.Add(time.Minute * 25)
+			currentRun := &scheduler.JobRun{
+				ScheduledAt: scheduledAt,
+				StartTime:   scheduledAt.Add(time.Minute * 2),
+				EndTime:     &currentEnd,
+				State:       scheduler.StateSuccess,
+			}
+			projectGetter.On("GetAll", mock.Anything).Return([]*tenant.Project{project}, nil)
+			jobRepo.On("GetAll", mock.Anything, project.Name()).Return([]*scheduler.JobWithDetails{jobWithDetails}, nil)
+			jobRunRepo.On("GetByScheduledTimes", mock.Anything, tnnt, jobName, mock.Anything).Return([]*scheduler.JobRun{pastRun, currentRun}, nil)
+
+			monitor := service.NewSLAMonitor(logger, projectGetter, jobRepo, jobRunRepo, notifier, nil, func() time.Time { return breachCheckAt }, monitorConfig)
+			monitor.StartMonitorLoop()
+		})
+		t.Run("uses the deadline cron when it is earlier than the duration", func(t *testing.T) {
+			projectGetter := new(mockProjectsGetter)
+			defer projectGetter.AssertExpectations(t)
+			jobRepo := new(JobRepository)
+			defer jobRepo.AssertExpectations(t)
+			jobRunRepo := new(mockJobRunRepository)
+			defer jobRunRepo.AssertExpectations(t)
+			notifier := new(mockEventPusher)
+			defer notifier.AssertExpectations(t)
+			eventHandler := newEventHandler(t)
+
+			deadlineJob := *jobWithDetails
+			deadlineJob.Alerts = []scheduler.Alert{
+				{On: scheduler.EventCategorySLAMiss, Config: map[string]string{"duration": "50m", "deadline": "15 * * * *"}},
+			}
+			projectGetter.On("GetAll", mock.Anything).Return([]*tenant.Project{project}, nil)
+			jobRepo.On("GetAll", mock.Anything, project.Name()).Return([]*scheduler.JobWithDetails{&deadlineJob}, nil)
+			jobRunRepo.On("GetByScheduledTimes", mock.Anything, tnnt, jobName, []time.Time{scheduledAt}).Return([]*scheduler.JobRun{}, nil).Once()
+			jobRunRepo.On("GetByScheduledTimes", mock.Anything, tnnt, jobName, mock.Anything).Return([]*scheduler.JobRun{pastRun}, nil)
+			jobRunRepo.On("UpdateSLA", mock.Anything, jobName, project.Name(), []time.Time{scheduledAt}).Return(nil).Once()
+			notifier.On("Push", mock.Anything, mock.MatchedBy(func(event *scheduler.Event) bool {
+				return event.Type == scheduler.SLABreachEvent &&
+					event.Values["sla_deadline"] == "2023-10-10T10:15:00Z" &&
+					event.Values["message"] == "run had not started by the deadline"
+			})).Return(nil).Once()
+			eventHandler.On("HandleEvent", mock.Anything).Once()
+
+			breachCheckAt := time.Date(2023, 10, 10, 10, 17, 0, 0, time.UTC)
+			monitor := service.NewSLAMonitor(logger, projectGetter, jobRepo, jobRunRepo, notifier, eventHandler, func() time.Time { return breachCheckAt }, monitorConfig)
+			monitor.StartMonitorLoop()
+		})
+		t.Run("skips jobs without sla", func(t *testing.T) {
+			projectGetter := new(mockProjectsGetter)
+			defer projectGetter.AssertExpectations(t)
+			jobRepo := new(JobRepository)
+			defer jobRepo.AssertExpectations(t)
+
+			jobWithoutSLA := *jobWithDetails
+			jobWithoutSLA.Alerts = nil
+			projectGetter.On("GetAll", mock.Anything).Return([]*tenant.Project{project}, nil)
+			jobRepo.On("GetAll", mock.Anything, project.Name()).Return([]*scheduler.JobWithDetails{&jobWithoutSLA}, nil)
+
+			monitor := service.NewSLAMonitor(logger, projectGetter, jobRepo, nil, nil, nil, nowFn, monitorConfig)
+			monitor.StartMonitorLoop()
+		})
+	})
+}
+
+type mockProjectsGetter struct {
+	mock.Mock
+}
+
+func (m *mockProjectsGetter) GetAll(ctx context.Context) ([]*tenant.Project, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*tenant.Project), args.Error(1)
+}
+
+type mockEventPusher struct {
+	mock.Mock
+}
+
+func (m *mockEventPusher) Push(ctx context.Context, event *scheduler.Event) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
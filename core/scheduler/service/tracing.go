@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/internal/lib/window"
+)
+
+const tracerName = "scheduler/service"
+
+// TracedInputCompiler traces the compilations of the executor inputs, the spans of the asset compilation and the
+// plugin calls made by the compiler are nested under the span of the compilation
+type TracedInputCompiler struct {
+	compiler JobInputCompiler
+}
+
+func (c TracedInputCompiler) Compile(ctx context.Context, job *scheduler.JobWithDetails, config scheduler.RunConfig, executedAt time.Time) (*scheduler.ExecutorInput, error) {
+	spanCtx, span := otel.Tracer(tracerName).Start(ctx, "JobInputCompiler.Compile", trace.WithAttributes(
+		attribute.String("project", job.Job.Tenant.ProjectName().String()),
+		attribute.String("namespace", job.Job.Tenant.NamespaceName().String()),
+		attribute.String("job", job.Name.String()),
+		attribute.String("executor.name", config.Executor.Name),
+		attribute.String("executor.type", config.Executor.Type.String()),
+		attribute.String("scheduled_at", config.ScheduledAt.UTC().Format(time.RFC3339)),
+	))
+	defer span.End()
+
+	input, err := c.compiler.Compile(spanCtx, job, config, executedAt)
+	if err != nil {
+		recordSpanError(span, err)
+		return nil, err
+	}
+	span.SetAttributes(attribute.Bool("skipped", input.Skipped), attribute.Int("files", len(input.Files)))
+	return input, nil
+}
+
+func (c TracedInputCompiler) EnabledHooks(ctx context.Context, job *scheduler.Job) ([]*scheduler.Hook, error) {
+	spanCtx, span := otel.Tracer(tracerName).Start(ctx, "JobInputCompiler.EnabledHooks", trace.WithAttributes(
+		attribute.String("project", job.Tenant.ProjectName().String()),
+		attribute.String("job", job.Name.String()),
+	))
+	defer span.End()
+
+	hooks, err := c.compiler.EnabledHooks(spanCtx, job)
+	if err != nil {
+		recordSpanError(span, err)
+	}
+	return hooks, err
+}
+
+func NewTracedInputCompiler(compiler JobInputCompiler) *TracedInputCompiler {
+	return &TracedInputCompiler{compiler: compiler}
+}
+
+// TracedAssetCompiler traces the compilations of the assets of the job runs
+type TracedAssetCompiler struct {
+	compiler AssetCompiler
+}
+
+func (c TracedAssetCompiler) CompileJobRunAssets(ctx context.Context, job *scheduler.Job, systemEnvVars map[string]string, interval window.Interval, contextForTask map[string]interface{}) (map[string]string, error) {
+	spanCtx, span := otel.Tracer(tracerName).Start(ctx, "AssetCompiler.CompileJobRunAssets", trace.WithAttributes(
+		attribute.String("job", job.Name.String()),
+		attribute.String("task", job.Task.Name),
+		attribute.Int("assets", len(job.Assets)),
+	))
+	defer span.End()
+
+	files, err := c.compiler.CompileJobRunAssets(spanCtx, job, systemEnvVars, interval, contextForTask)
+	if err != nil {
+		recordSpanError(span, err)
+	}
+	return files, err
+}
+
+func NewTracedAssetCompiler(compiler AssetCompiler) *TracedAssetCompiler {
+	return &TracedAssetCompiler{compiler: compiler}
+}
+
+func recordSpanError(span trace.Span, err error) {
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
@@ -0,0 +1,86 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/scheduler/service"
+	"github.com/goto/optimus/core/tenant"
+)
+
+func TestTracedInputCompiler(t *testing.T) {
+	ctx := context.Background()
+	tnnt, _ := tenant.NewTenant("proj", "ns1")
+	executedAt := time.Date(2023, 1, 2, 0, 1, 0, 0, time.UTC)
+	config := scheduler.RunConfig{
+		Executor:    scheduler.Executor{Name: "bq2bq", Type: scheduler.ExecutorTask},
+		ScheduledAt: time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+	job := &scheduler.JobWithDetails{
+		Name: "job1",
+		Job: &scheduler.Job{
+			Name:   "job1",
+			Tenant: tnnt,
+			Task:   &scheduler.Task{Name: "bq2bq"},
+		},
+	}
+
+	recordSpans := func(t *testing.T) *tracetest.SpanRecorder {
+		t.Helper()
+		recorder := tracetest.NewSpanRecorder()
+		previous := otel.GetTracerProvider()
+		otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+		t.Cleanup(func() { otel.SetTracerProvider(previous) })
+		return recorder
+	}
+
+	t.Run("Compile", func(t *testing.T) {
+		t.Run("records the compilation of the job in a span", func(t *testing.T) {
+			recorder := recordSpans(t)
+			input := &scheduler.ExecutorInput{Files: map[string]string{"query.sql": "select 1"}}
+
+			compiler := new(mockJobInputCompiler)
+			compiler.On("Compile", mock.Anything, job, config, executedAt).Return(input, nil)
+			defer compiler.AssertExpectations(t)
+
+			tracedCompiler := service.NewTracedInputCompiler(compiler)
+			result, err := tracedCompiler.Compile(ctx, job, config, executedAt)
+			assert.NoError(t, err)
+			assert.Equal(t, input, result)
+
+			spans := recorder.Ended()
+			assert.Len(t, spans, 1)
+			assert.Equal(t, "JobInputCompiler.Compile", spans[0].Name())
+			assert.Contains(t, spans[0].Attributes(), attribute.String("job", "job1"))
+			assert.Contains(t, spans[0].Attributes(), attribute.String("executor.name", "bq2bq"))
+			assert.Contains(t, spans[0].Attributes(), attribute.Int("files", 1))
+		})
+		t.Run("marks the span as failed when compilation fails", func(t *testing.T) {
+			recorder := recordSpans(t)
+
+			compiler := new(mockJobInputCompiler)
+			compiler.On("Compile", mock.Anything, job, config, executedAt).Return(nil, errors.New("unable to compile"))
+			defer compiler.AssertExpectations(t)
+
+			tracedCompiler := service.NewTracedInputCompiler(compiler)
+			_, err := tracedCompiler.Compile(ctx, job, config, executedAt)
+			assert.ErrorContains(t, err, "unable to compile")
+
+			spans := recorder.Ended()
+			assert.Len(t, spans, 1)
+			assert.Equal(t, codes.Error, spans[0].Status().Code)
+			assert.Equal(t, "unable to compile", spans[0].Status().Description)
+		})
+	})
+}
@@ -0,0 +1,84 @@
+package service
+
+import (
+	"sync"
+	"time"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+)
+
+// uploadTracker keeps the progress of the latest upload of each project in memory
+type uploadTracker struct {
+	mu       sync.RWMutex
+	progress map[tenant.ProjectName]*scheduler.UploadProgress
+
+	now func() time.Time
+}
+
+func newUploadTracker() *uploadTracker {
+	return &uploadTracker{
+		progress: make(map[tenant.ProjectName]*scheduler.UploadProgress),
+		now:      time.Now,
+	}
+}
+
+// start registers a new upload of the project, only one upload per project is allowed at a time
+func (u *uploadTracker) start(projectName tenant.ProjectName, totalJobs int) (*scheduler.UploadProgress, error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if existing, ok := u.progress[projectName]; ok && existing.IsInProgress() {
+		return nil, errors.NewError(errors.ErrFailedPrecond, scheduler.EntityUpload, "upload of project "+projectName.String()+" is still in progress")
+	}
+
+	progress := scheduler.NewUploadProgress(projectName, totalJobs, u.now())
+	u.progress[projectName] = progress
+	return progress, nil
+}
+
+func (u *uploadTracker) addUploaded(progress *scheduler.UploadProgress, count int) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	progress.UploadedJobs += count
+}
+
+func (u *uploadTracker) addFailed(progress *scheduler.UploadProgress, count int) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	progress.FailedJobs += count
+}
+
+func (u *uploadTracker) completeNamespace(progress *scheduler.UploadProgress, namespaceName tenant.NamespaceName) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	progress.CompletedNamespaces = append(progress.CompletedNamespaces, namespaceName)
+}
+
+func (u *uploadTracker) finish(progress *scheduler.UploadProgress, err error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	progress.State = scheduler.UploadStateSuccess
+	if err != nil {
+		progress.State = scheduler.UploadStateFailed
+		progress.Message = err.Error()
+	}
+	progress.FinishedAt = u.now()
+}
+
+// get returns a copy of the latest upload progress of the project, as the tracked one is updated concurrently
+func (u *uploadTracker) get(projectName tenant.ProjectName) (*scheduler.UploadProgress, error) {
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+
+	progress, ok := u.progress[projectName]
+	if !ok {
+		return nil, errors.NotFound(scheduler.EntityUpload, "no upload found for project "+projectName.String())
+	}
+
+	progressCopy := *progress
+	progressCopy.CompletedNamespaces = append([]tenant.NamespaceName(nil), progress.CompletedNamespaces...)
+	return &progressCopy, nil
+}
@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/goto/salt/log"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+)
+
+type UpstreamAccessRepository interface {
+	Create(ctx context.Context, request *scheduler.UpstreamAccessRequest) error
+	Get(ctx context.Context, downstreamProject tenant.ProjectName, downstreamJob scheduler.JobName, upstreamProject tenant.ProjectName, upstreamJob scheduler.JobName) (*scheduler.UpstreamAccessRequest, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*scheduler.UpstreamAccessRequest, error)
+	GetByUpstreamProject(ctx context.Context, upstreamProject tenant.ProjectName) ([]*scheduler.UpstreamAccessRequest, error)
+	UpdateDecision(ctx context.Context, id uuid.UUID, state scheduler.UpstreamAccessState, decidedBy, reason string) error
+}
+
+type UpstreamAccessNotifier interface {
+	NotifyUpstreamAccessRequest(ctx context.Context, request *scheduler.UpstreamAccessRequest) error
+}
+
+type UpstreamAccessService struct {
+	repo          UpstreamAccessRepository
+	projectGetter ProjectGetter
+	notifier      UpstreamAccessNotifier
+
+	l log.Logger
+}
+
+// IsAccessAllowed checks whether the downstream job can depend on the upstream job. When the upstream project
+// requires approval and no request is raised yet, a pending request is created and the upstream owner is notified.
+func (s *UpstreamAccessService) IsAccessAllowed(ctx context.Context, downstreamProject tenant.ProjectName, downstreamJob scheduler.JobName,
+	upstreamProject tenant.ProjectName, upstreamJob scheduler.JobName,
+) (bool, error) {
+	if downstreamProject == upstreamProject {
+		return true, nil
+	}
+
+	approvalRequired, err := s.isApprovalRequired(ctx, upstreamProject)
+	if err != nil {
+		return false, err
+	}
+	if !approvalRequired {
+		return true, nil
+	}
+
+	request, err := s.repo.Get(ctx, downstreamProject, downstreamJob, upstreamProject, upstreamJob)
+	if err == nil {
+		return request.IsApproved(), nil
+	}
+	if !errors.IsErrorType(err, errors.ErrNotFound) {
+		return false, err
+	}
+
+	newRequest := scheduler.NewUpstreamAccessRequest(downstreamProject, downstreamJob, upstreamProject, upstreamJob)
+	if err := s.repo.Create(ctx, newRequest); err != nil {
+		s.l.Error("error creating upstream access request of [%s/%s] on [%s/%s]: %s", downstreamProject, downstreamJob, upstreamProject, upstreamJob, err)
+		return false, err
+	}
+
+	// the stored request is fetched to get its id, which is needed by the owner to decide on it
+	request, err = s.repo.Get(ctx, downstreamProject, downstreamJob, upstreamProject, upstreamJob)
+	if err != nil {
+		return false, err
+	}
+	if request.State != scheduler.UpstreamAccessStatePending {
+		return request.IsApproved(), nil
+	}
+	if err := s.notifier.NotifyUpstreamAccessRequest(ctx, request); err != nil {
+		s.l.Warn("unable to notify owner of [%s/%s] on upstream access request: %s", upstreamProject, upstreamJob, err)
+	}
+	return false, nil
+}
+
+func (s *UpstreamAccessService) GetAccessRequests(ctx context.Context, upstreamProject tenant.ProjectName) ([]*scheduler.UpstreamAccessRequest, error) {
+	return s.repo.GetByUpstreamProject(ctx, upstreamProject)
+}
+
+func (s *UpstreamAccessService) Approve(ctx context.Context, upstreamProject tenant.ProjectName, id uuid.UUID, decidedBy, reason string) error {
+	return s.decide(ctx, upstreamProject, id, scheduler.UpstreamAccessStateApproved, decidedBy, reason)
+}
+
+func (s *UpstreamAccessService) Deny(ctx context.Context, upstreamProject tenant.ProjectName, id uuid.UUID, decidedBy, reason string) error {
+	return s.decide(ctx, upstreamProject, id, scheduler.UpstreamAccessStateDenied, decidedBy, reason)
+}
+
+// decide records the decision on the request, which is only made on the project of the upstream
+func (s *UpstreamAccessService) decide(ctx context.Context, upstreamProject tenant.ProjectName, id uuid.UUID, state scheduler.UpstreamAccessState, decidedBy, reason string) error {
+	if decidedBy == "" {
+		return errors.InvalidArgument(scheduler.EntityUpstreamAccess, "decided by is empty")
+	}
+
+	request, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if request.UpstreamProject != upstreamProject {
+		return errors.NotFound(scheduler.EntityUpstreamAccess, "no access request "+id.String()+" on project "+upstreamProject.String())
+	}
+
+	if err := s.repo.UpdateDecision(ctx, request.ID, state, decidedBy, reason); err != nil {
+		s.l.Error("error updating decision of upstream access request [%s]: %s", id.String(), err)
+		return err
+	}
+	s.l.Info("upstream access of [%s/%s] on [%s/%s] is %s by %s", request.DownstreamProject, request.DownstreamJob,
+		request.UpstreamProject, request.UpstreamJob, state, decidedBy)
+	return nil
+}
+
+func (s *UpstreamAccessService) isApprovalRequired(ctx context.Context, projectName tenant.ProjectName) (bool, error) {
+	project, err := s.projectGetter.GetByName(ctx, projectName)
+	if err != nil {
+		s.l.Error("error getting project [%s]: %s", projectName, err)
+		return false, err
+	}
+
+	// approval is not required when the config is not set
+	approvalConfig, _ := project.GetConfig(tenant.ProjectUpstreamAccessApproval)
+	approvalRequired, _ := strconv.ParseBool(approvalConfig)
+	return approvalRequired, nil
+}
+
+func NewUpstreamAccessService(l log.Logger, repo UpstreamAccessRepository, projectGetter ProjectGetter, notifier UpstreamAccessNotifier) *UpstreamAccessService {
+	return &UpstreamAccessService{
+		repo:          repo,
+		projectGetter: projectGetter,
+		notifier:      notifier,
+		l:             l,
+	}
+}
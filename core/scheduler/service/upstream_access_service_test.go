@@ -0,0 +1,237 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/scheduler/service"
+	"github.com/goto/optimus/core/tenant"
+	errs "github.com/goto/optimus/internal/errors"
+)
+
+func TestUpstreamAccessService(t *testing.T) {
+	ctx := context.Background()
+	logger := log.NewNoop()
+
+	downstreamProject := tenant.ProjectName("proj-a")
+	downstreamJob := scheduler.JobName("job-a")
+	upstreamProject := tenant.ProjectName("proj-b")
+	upstreamJob := scheduler.JobName("job-b")
+
+	projectWithApproval, _ := tenant.NewProject(upstreamProject.String(), map[string]string{
+		tenant.ProjectStoragePathKey:         "somePath",
+		tenant.ProjectSchedulerHost:          "localhost",
+		tenant.ProjectUpstreamAccessApproval: "true",
+	})
+	projectWithoutApproval, _ := tenant.NewProject(upstreamProject.String(), map[string]string{
+		tenant.ProjectStoragePathKey: "somePath",
+		tenant.ProjectSchedulerHost:  "localhost",
+	})
+
+	t.Run("IsAccessAllowed", func(t *testing.T) {
+		t.Run("returns true if upstream is in the same project", func(t *testing.T) {
+			upstreamAccessService := service.NewUpstreamAccessService(logger, nil, nil, nil)
+
+			allowed, err := upstreamAccessService.IsAccessAllowed(ctx, upstreamProject, downstreamJob, upstreamProject, upstreamJob)
+			assert.NoError(t, err)
+			assert.True(t, allowed)
+		})
+		t.Run("returns error if unable to get upstream project", func(t *testing.T) {
+			projectGetter := new(mockProjectGetter)
+			defer projectGetter.AssertExpectations(t)
+			projectGetter.On("GetByName", ctx, upstreamProject).Return(nil, errors.New("some error"))
+
+			upstreamAccessService := service.NewUpstreamAccessService(logger, nil, projectGetter, nil)
+
+			allowed, err := upstreamAccessService.IsAccessAllowed(ctx, downstreamProject, downstreamJob, upstreamProject, upstreamJob)
+			assert.ErrorContains(t, err, "some error")
+			assert.False(t, allowed)
+		})
+		t.Run("returns true if upstream project does not require approval", func(t *testing.T) {
+			projectGetter := new(mockProjectGetter)
+			defer projectGetter.AssertExpectations(t)
+			projectGetter.On("GetByName", ctx, upstreamProject).Return(projectWithoutApproval, nil)
+
+			upstreamAccessService := service.NewUpstreamAccessService(logger, nil, projectGetter, nil)
+
+			allowed, err := upstreamAccessService.IsAccessAllowed(ctx, downstreamProject, downstreamJob, upstreamProject, upstreamJob)
+			assert.NoError(t, err)
+			assert.True(t, allowed)
+		})
+		t.Run("returns state of existing request if upstream project requires approval", func(t *testing.T) {
+			projectGetter := new(mockProjectGetter)
+			defer projectGetter.AssertExpectations(t)
+			projectGetter.On("GetByName", ctx, upstreamProject).Return(projectWithApproval, nil)
+
+			request := scheduler.NewUpstreamAccessRequest(downstreamProject, downstreamJob, upstreamProject, upstreamJob)
+			request.State = scheduler.UpstreamAccessStateApproved
+			repo := new(mockUpstreamAccessRepository)
+			defer repo.AssertExpectations(t)
+			repo.On("Get", ctx, downstreamProject, downstreamJob, upstreamProject, upstreamJob).Return(request, nil)
+
+			upstreamAccessService := service.NewUpstreamAccessService(logger, repo, projectGetter, nil)
+
+			allowed, err := upstreamAccessService.IsAccessAllowed(ctx, downstreamProject, downstreamJob, upstreamProject, upstreamJob)
+			assert.NoError(t, err)
+			assert.True(t, allowed)
+		})
+		t.Run("returns error if unable to get existing request", func(t *testing.T) {
+			projectGetter := new(mockProjectGetter)
+			defer projectGetter.AssertExpectations(t)
+			projectGetter.On("GetByName", ctx, upstreamProject).Return(projectWithApproval, nil)
+
+			repo := new(mockUpstreamAccessRepository)
+			defer repo.AssertExpectations(t)
+			repo.On("Get", ctx, downstreamProject, downstreamJob, upstreamProject, upstreamJob).Return(nil, errors.New("some error"))
+
+			upstreamAccessService := service.NewUpstreamAccessService(logger, repo, projectGetter, nil)
+
+			allowed, err := upstreamAccessService.IsAccessAllowed(ctx, downstreamProject, downstreamJob, upstreamProject, upstreamJob)
+			assert.ErrorContains(t, err, "some error")
+			assert.False(t, allowed)
+		})
+		t.Run("creates pending request and notifies upstream owner if no request is found", func(t *testing.T) {
+			projectGetter := new(mockProjectGetter)
+			defer projectGetter.AssertExpectations(t)
+			projectGetter.On("GetByName", ctx, upstreamProject).Return(projectWithApproval, nil)
+
+			newRequest := scheduler.NewUpstreamAccessRequest(downstreamProject, downstreamJob, upstreamProject, upstreamJob)
+			request := scheduler.NewUpstreamAccessRequest(downstreamProject, downstreamJob, upstreamProject, upstreamJob)
+			request.ID = uuid.New()
+			repo := new(mockUpstreamAccessRepository)
+			defer repo.AssertExpectations(t)
+			repo.On("Get", ctx, downstreamProject, downstreamJob, upstreamProject, upstreamJob).
+				Return(nil, errs.NotFound(scheduler.EntityUpstreamAccess, "not found")).Once()
+			repo.On("Create", ctx, newRequest).Return(nil)
+			repo.On("Get", ctx, downstreamProject, downstreamJob, upstreamProject, upstreamJob).Return(request, nil).Once()
+
+			notifier := new(mockUpstreamAccessNotifier)
+			defer notifier.AssertExpectations(t)
+			notifier.On("NotifyUpstreamAccessRequest", ctx, request).Return(errors.New("unable to notify"))
+
+			upstreamAccessService := service.NewUpstreamAccessService(logger, repo, projectGetter, notifier)
+
+			allowed, err := upstreamAccessService.IsAccessAllowed(ctx, downstreamProject, downstreamJob, upstreamProject, upstreamJob)
+			assert.NoError(t, err)
+			assert.False(t, allowed)
+		})
+	})
+	t.Run("Approve", func(t *testing.T) {
+		requestID := uuid.New()
+
+		t.Run("returns error if decided by is empty", func(t *testing.T) {
+			upstreamAccessService := service.NewUpstreamAccessService(logger, nil, nil, nil)
+
+			err := upstreamAccessService.Approve(ctx, upstreamProject, requestID, "", "")
+			assert.ErrorContains(t, err, "decided by is empty")
+		})
+		t.Run("returns error if request is not found", func(t *testing.T) {
+			repo := new(mockUpstreamAccessRepository)
+			defer repo.AssertExpectations(t)
+			repo.On("GetByID", ctx, requestID).Return(nil, errs.NotFound(scheduler.EntityUpstreamAccess, "not found"))
+
+			upstreamAccessService := service.NewUpstreamAccessService(logger, repo, nil, nil)
+
+			err := upstreamAccessService.Approve(ctx, upstreamProject, requestID, "owner@example.com", "")
+			assert.True(t, errs.IsErrorType(err, errs.ErrNotFound))
+		})
+		t.Run("returns error if request is not on the project", func(t *testing.T) {
+			request := scheduler.NewUpstreamAccessRequest(downstreamProject, downstreamJob, upstreamProject, upstreamJob)
+			request.ID = requestID
+			repo := new(mockUpstreamAccessRepository)
+			defer repo.AssertExpectations(t)
+			repo.On("GetByID", ctx, requestID).Return(request, nil)
+
+			upstreamAccessService := service.NewUpstreamAccessService(logger, repo, nil, nil)
+
+			err := upstreamAccessService.Approve(ctx, downstreamProject, requestID, "owner@example.com", "")
+			assert.True(t, errs.IsErrorType(err, errs.ErrNotFound))
+		})
+		t.Run("records the approval", func(t *testing.T) {
+			request := scheduler.NewUpstreamAccessRequest(downstreamProject, downstreamJob, upstreamProject, upstreamJob)
+			request.ID = requestID
+			repo := new(mockUpstreamAccessRepository)
+			defer repo.AssertExpectations(t)
+			repo.On("GetByID", ctx, requestID).Return(request, nil)
+			repo.On("UpdateDecision", ctx, requestID, scheduler.UpstreamAccessStateApproved, "owner@example.com", "shared dataset").Return(nil)
+
+			upstreamAccessService := service.NewUpstreamAccessService(logger, repo, nil, nil)
+
+			err := upstreamAccessService.Approve(ctx, upstreamProject, requestID, "owner@example.com", "shared dataset")
+			assert.NoError(t, err)
+		})
+	})
+	t.Run("Deny", func(t *testing.T) {
+		requestID := uuid.New()
+
+		t.Run("records the denial", func(t *testing.T) {
+			request := scheduler.NewUpstreamAccessRequest(downstreamProject, downstreamJob, upstreamProject, upstreamJob)
+			request.ID = requestID
+			repo := new(mockUpstreamAccessRepository)
+			defer repo.AssertExpectations(t)
+			repo.On("GetByID", ctx, requestID).Return(request, nil)
+			repo.On("UpdateDecision", ctx, requestID, scheduler.UpstreamAccessStateDenied, "owner@example.com", "pii").Return(errors.New("some error"))
+
+			upstreamAccessService := service.NewUpstreamAccessService(logger, repo, nil, nil)
+
+			err := upstreamAccessService.Deny(ctx, upstreamProject, requestID, "owner@example.com", "pii")
+			assert.ErrorContains(t, err, "some error")
+		})
+	})
+}
+
+type mockUpstreamAccessRepository struct {
+	mock.Mock
+}
+
+func (m *mockUpstreamAccessRepository) Create(ctx context.Context, request *scheduler.UpstreamAccessRequest) error {
+	args := m.Called(ctx, request)
+	return args.Error(0)
+}
+
+func (m *mockUpstreamAccessRepository) Get(ctx context.Context, downstreamProject tenant.ProjectName, downstreamJob scheduler.JobName,
+	upstreamProject tenant.ProjectName, upstreamJob scheduler.JobName,
+) (*scheduler.UpstreamAccessRequest, error) {
+	args := m.Called(ctx, downstreamProject, downstreamJob, upstreamProject, upstreamJob)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*scheduler.UpstreamAccessRequest), args.Error(1)
+}
+
+func (m *mockUpstreamAccessRepository) GetByID(ctx context.Context, id uuid.UUID) (*scheduler.UpstreamAccessRequest, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*scheduler.UpstreamAccessRequest), args.Error(1)
+}
+
+func (m *mockUpstreamAccessRepository) GetByUpstreamProject(ctx context.Context, upstreamProject tenant.ProjectName) ([]*scheduler.UpstreamAccessRequest, error) {
+	args := m.Called(ctx, upstreamProject)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*scheduler.UpstreamAccessRequest), args.Error(1)
+}
+
+func (m *mockUpstreamAccessRepository) UpdateDecision(ctx context.Context, id uuid.UUID, state scheduler.UpstreamAccessState, decidedBy, reason string) error {
+	args := m.Called(ctx, id, state, decidedBy, reason)
+	return args.Error(0)
+}
+
+type mockUpstreamAccessNotifier struct {
+	mock.Mock
+}
+
+func (m *mockUpstreamAccessNotifier) NotifyUpstreamAccessRequest(ctx context.Context, request *scheduler.UpstreamAccessRequest) error {
+	args := m.Called(ctx, request)
+	return args.Error(0)
+}
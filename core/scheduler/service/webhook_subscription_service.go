@@ -0,0 +1,262 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/goto/salt/log"
+
+	"github.com/goto/optimus/config"
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/telemetry"
+)
+
+const (
+	webhookEventHeader    = "X-Optimus-Event"
+	webhookDeliveryHeader = "X-Optimus-Delivery"
+
+	defaultWebhookMaxAttempts  = 3
+	defaultWebhookRetryBackoff = 10 * time.Second
+	defaultWebhookTimeout      = 10 * time.Second
+)
+
+type WebhookSubscriptionRepository interface {
+	Create(ctx context.Context, subscription *scheduler.WebhookSubscription) (uuid.UUID, error)
+	Update(ctx context.Context, subscription *scheduler.WebhookSubscription) error
+	GetAll(ctx context.Context, tnnt tenant.Tenant) ([]*scheduler.WebhookSubscription, error)
+	Delete(ctx context.Context, tnnt tenant.Tenant, id uuid.UUID) error
+
+	SaveDelivery(ctx context.Context, delivery *scheduler.WebhookDelivery) error
+	GetDeliveries(ctx context.Context, tnnt tenant.Tenant, subscriptionID uuid.UUID, limit int) ([]*scheduler.WebhookDelivery, error)
+}
+
+type WebhookPoster interface {
+	Post(ctx context.Context, url string, headers map[string]string, body []byte) (int, error)
+}
+
+type webhookPayload struct {
+	Project     string         `json:"project"`
+	Namespace   string         `json:"namespace"`
+	Job         string         `json:"job"`
+	Event       string         `json:"event"`
+	ScheduledAt string         `json:"scheduled_at,omitempty"`
+	EventTime   string         `json:"event_time,omitempty"`
+	Values      map[string]any `json:"values,omitempty"`
+}
+
+// WebhookSubscriptionService manages the webhook subscriptions of the namespaces, and dispatches the run events
+// to the subscriptions in the background, retrying the failed posts with backoff and logging every delivery
+type WebhookSubscriptionService struct {
+	wg sync.WaitGroup
+
+	repo          WebhookSubscriptionRepository
+	tenantService TenantService
+	poster        WebhookPoster
+	config        config.WebhookSubscriptionConfig
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	l log.Logger
+}
+
+func (s *WebhookSubscriptionService) Create(ctx context.Context, subscription *scheduler.WebhookSubscription) (uuid.UUID, error) {
+	id, err := s.repo.Create(ctx, subscription)
+	if err != nil {
+		s.l.Error("error creating webhook subscription in namespace [%s]: %s", subscription.Tenant.NamespaceName(), err)
+		return uuid.Nil, err
+	}
+	return id, nil
+}
+
+func (s *WebhookSubscriptionService) Update(ctx context.Context, subscription *scheduler.WebhookSubscription) error {
+	if err := s.repo.Update(ctx, subscription); err != nil {
+		s.l.Error("error updating webhook subscription [%s]: %s", subscription.ID.String(), err)
+		return err
+	}
+	return nil
+}
+
+func (s *WebhookSubscriptionService) GetAll(ctx context.Context, tnnt tenant.Tenant) ([]*scheduler.WebhookSubscription, error) {
+	return s.repo.GetAll(ctx, tnnt)
+}
+
+func (s *WebhookSubscriptionService) Delete(ctx context.Context, tnnt tenant.Tenant, id uuid.UUID) error {
+	if err := s.repo.Delete(ctx, tnnt, id); err != nil {
+		s.l.Error("error deleting webhook subscription [%s]: %s", id.String(), err)
+		return err
+	}
+	return nil
+}
+
+func (s *WebhookSubscriptionService) GetDeliveries(ctx context.Context, tnnt tenant.Tenant, id uuid.UUID, limit int) ([]*scheduler.WebhookDelivery, error) {
+	return s.repo.GetDeliveries(ctx, tnnt, id, limit)
+}
+
+// Dispatch posts the job success, failure and sla events to the subscriptions of the namespace, without waiting for the deliveries
+func (s *WebhookSubscriptionService) Dispatch(ctx context.Context, event *scheduler.Event) {
+	if event.Type != scheduler.JobSuccessEvent && !event.Type.IsOfType(scheduler.EventCategoryJobFailure) &&
+		!event.Type.IsOfType(scheduler.EventCategorySLAMiss) {
+		return
+	}
+
+	subscriptions, err := s.repo.GetAll(ctx, event.Tenant)
+	if err != nil {
+		s.l.Error("error getting webhook subscriptions of namespace [%s]: %s", event.Tenant.NamespaceName(), err)
+		return
+	}
+	var subscribed []*scheduler.WebhookSubscription
+	for _, subscription := range subscriptions {
+		if subscription.Subscribes(event.Type) {
+			subscribed = append(subscribed, subscription)
+		}
+	}
+	if len(subscribed) == 0 {
+		return
+	}
+
+	plainTextSecrets, err := s.tenantService.GetSecrets(ctx, event.Tenant)
+	if err != nil {
+		s.l.Error("error getting secrets of namespace [%s] for webhook subscriptions: %s", event.Tenant.NamespaceName(), err)
+		return
+	}
+	secrets := tenant.PlainTextSecrets(plainTextSecrets).ToSecretMap()
+
+	body, err := json.Marshal(toWebhookPayload(event))
+	if err != nil {
+		s.l.Error("error marshalling webhook payload of job [%s]: %s", event.JobName, err)
+		return
+	}
+
+	for _, subscription := range subscribed {
+		delivery := &scheduler.WebhookDelivery{
+			ID:             uuid.New(),
+			SubscriptionID: subscription.ID,
+			JobName:        event.JobName,
+			EventType:      event.Type,
+			ScheduledAt:    event.JobScheduledAt,
+		}
+		secret, err := secrets.Get(subscription.SecretName)
+		if err != nil {
+			delivery.Error = err.Error()
+			s.saveDelivery(delivery)
+			continue
+		}
+
+		s.wg.Add(1)
+		go func(subscription *scheduler.WebhookSubscription) {
+			defer s.wg.Done()
+			s.deliver(subscription, delivery, secret, body)
+		}(subscription)
+	}
+}
+
+func (s *WebhookSubscriptionService) deliver(subscription *scheduler.WebhookSubscription, delivery *scheduler.WebhookDelivery, secret string, body []byte) {
+	headers := map[string]string{
+		"Content-Type":                   "application/json",
+		scheduler.WebhookSignatureHeader: scheduler.SignWebhookPayload(secret, body),
+		webhookEventHeader:               delivery.EventType.String(),
+		webhookDeliveryHeader:            delivery.ID.String(),
+	}
+
+	backoff := s.config.RetryBackoff
+	for delivery.Attempts < s.config.MaxAttempts {
+		if delivery.Attempts > 0 {
+			select {
+			case <-time.After(backoff):
+				backoff *= 2
+			case <-s.ctx.Done():
+				s.saveDelivery(delivery)
+				return
+			}
+		}
+
+		delivery.Attempts++
+		ctx, cancel := context.WithTimeout(s.ctx, s.config.Timeout)
+		statusCode, err := s.poster.Post(ctx, subscription.URL, headers, body)
+		cancel()
+
+		delivery.StatusCode = statusCode
+		if err != nil {
+			delivery.Error = err.Error()
+			continue
+		}
+		if statusCode < http.StatusOK || statusCode >= http.StatusMultipleChoices {
+			delivery.Error = fmt.Sprintf("webhook responded with status %d", statusCode)
+			continue
+		}
+		delivery.Delivered = true
+		delivery.Error = ""
+		break
+	}
+	s.saveDelivery(delivery)
+}
+
+func (s *WebhookSubscriptionService) saveDelivery(delivery *scheduler.WebhookDelivery) {
+	if !delivery.Delivered {
+		s.l.Warn("webhook delivery [%s] of job [%s] to subscription [%s] failed after %d attempts: %s", delivery.ID.String(),
+			delivery.JobName, delivery.SubscriptionID.String(), delivery.Attempts, delivery.Error)
+	}
+	telemetry.NewCounter("webhook_deliveries_total", map[string]string{
+		"delivered": fmt.Sprintf("%t", delivery.Delivered),
+	}).Inc()
+
+	if err := s.repo.SaveDelivery(context.Background(), delivery); err != nil {
+		s.l.Error("error saving webhook delivery [%s]: %s", delivery.ID.String(), err)
+	}
+}
+
+// Close stops retrying the deliveries in progress and waits for them to be logged
+func (s *WebhookSubscriptionService) Close() error {
+	s.cancel()
+	s.wg.Wait()
+	return nil
+}
+
+func toWebhookPayload(event *scheduler.Event) webhookPayload {
+	payload := webhookPayload{
+		Project:   event.Tenant.ProjectName().String(),
+		Namespace: event.Tenant.NamespaceName().String(),
+		Job:       event.JobName.String(),
+		Event:     event.Type.String(),
+		Values:    event.Values,
+	}
+	if !event.JobScheduledAt.IsZero() {
+		payload.ScheduledAt = event.JobScheduledAt.Format(time.RFC3339)
+	}
+	if !event.EventTime.IsZero() {
+		payload.EventTime = event.EventTime.Format(time.RFC3339)
+	}
+	return payload
+}
+
+func NewWebhookSubscriptionService(l log.Logger, repo WebhookSubscriptionRepository, tenantService TenantService, poster WebhookPoster,
+	conf config.WebhookSubscriptionConfig,
+) *WebhookSubscriptionService {
+	if conf.MaxAttempts <= 0 {
+		conf.MaxAttempts = defaultWebhookMaxAttempts
+	}
+	if conf.RetryBackoff <= 0 {
+		conf.RetryBackoff = defaultWebhookRetryBackoff
+	}
+	if conf.Timeout <= 0 {
+		conf.Timeout = defaultWebhookTimeout
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &WebhookSubscriptionService{
+		repo:          repo,
+		tenantService: tenantService,
+		poster:        poster,
+		config:        conf,
+		ctx:           ctx,
+		cancel:        cancel,
+		l:             l,
+	}
+}
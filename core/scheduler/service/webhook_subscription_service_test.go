@@ -0,0 +1,165 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/goto/optimus/config"
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/scheduler/service"
+	"github.com/goto/optimus/core/tenant"
+)
+
+func TestWebhookSubscriptionService(t *testing.T) {
+	ctx := context.Background()
+	logger := log.NewNoop()
+	tnnt, _ := tenant.NewTenant("proj", "ns1")
+	conf := config.WebhookSubscriptionConfig{MaxAttempts: 2, RetryBackoff: time.Millisecond, Timeout: time.Second}
+	secret, _ := tenant.NewPlainTextSecret("WEBHOOK_KEY", "signing-key")
+	scheduledAt := time.Date(2023, 10, 10, 10, 0, 0, 0, time.UTC)
+	event := &scheduler.Event{
+		JobName:        "job-a",
+		Tenant:         tnnt,
+		Type:           scheduler.JobFailureEvent,
+		JobScheduledAt: scheduledAt,
+	}
+
+	failureSubscription, _ := scheduler.NewWebhookSubscription(tnnt, "https://example.com/failure", "WEBHOOK_KEY",
+		[]scheduler.JobEventType{scheduler.JobFailureEvent})
+	failureSubscription.ID = uuid.New()
+	successSubscription, _ := scheduler.NewWebhookSubscription(tnnt, "https://example.com/success", "WEBHOOK_KEY",
+		[]scheduler.JobEventType{scheduler.JobSuccessEvent})
+	successSubscription.ID = uuid.New()
+
+	t.Run("Dispatch", func(t *testing.T) {
+		t.Run("does not look up subscriptions for events which cannot be subscribed", func(t *testing.T) {
+			repo := new(mockWebhookSubscriptionRepository)
+			defer repo.AssertExpectations(t)
+
+			webhookService := service.NewWebhookSubscriptionService(logger, repo, nil, nil, conf)
+			webhookService.Dispatch(ctx, &scheduler.Event{JobName: "job-a", Tenant: tnnt, Type: scheduler.TaskStartEvent})
+			assert.NoError(t, webhookService.Close())
+		})
+		t.Run("posts the signed event to the subscribed urls and logs the delivery", func(t *testing.T) {
+			repo := new(mockWebhookSubscriptionRepository)
+			defer repo.AssertExpectations(t)
+			tenantService := new(mockTenantService)
+			defer tenantService.AssertExpectations(t)
+			poster := new(mockWebhookPoster)
+			defer poster.AssertExpectations(t)
+
+			repo.On("GetAll", ctx, tnnt).Return([]*scheduler.WebhookSubscription{failureSubscription, successSubscription}, nil)
+			tenantService.On("GetSecrets", ctx, tnnt).Return([]*tenant.PlainTextSecret{secret}, nil)
+			poster.On("Post", mock.Anything, "https://example.com/failure", mock.MatchedBy(func(headers map[string]string) bool {
+				return headers[scheduler.WebhookSignatureHeader] == scheduler.SignWebhookPayload("signing-key",
+					[]byte(`{"project":"proj","namespace":"ns1","job":"job-a","event":"failure","scheduled_at":"2023-10-10T10:00:00Z"}`))
+			}), mock.Anything).Return(200, nil)
+			repo.On("SaveDelivery", mock.Anything, mock.MatchedBy(func(delivery *scheduler.WebhookDelivery) bool {
+				return delivery.SubscriptionID == failureSubscription.ID && delivery.Delivered && delivery.Attempts == 1 &&
+					delivery.StatusCode == 200 && delivery.ScheduledAt.Equal(scheduledAt)
+			})).Return(nil)
+
+			webhookService := service.NewWebhookSubscriptionService(logger, repo, tenantService, poster, conf)
+			webhookService.Dispatch(ctx, event)
+			assert.NoError(t, webhookService.Close())
+		})
+		t.Run("retries the failed posts and logs the failed delivery", func(t *testing.T) {
+			repo := new(mockWebhookSubscriptionRepository)
+			defer repo.AssertExpectations(t)
+			tenantService := new(mockTenantService)
+			defer tenantService.AssertExpectations(t)
+			poster := new(mockWebhookPoster)
+			defer poster.AssertExpectations(t)
+
+			repo.On("GetAll", ctx, tnnt).Return([]*scheduler.WebhookSubscription{failureSubscription}, nil)
+			tenantService.On("GetSecrets", ctx, tnnt).Return([]*tenant.PlainTextSecret{secret}, nil)
+			poster.On("Post", mock.Anything, "https://example.com/failure", mock.Anything, mock.Anything).
+				Return(0, errors.New("connection refused")).Once()
+			poster.On("Post", mock.Anything, "https://example.com/failure", mock.Anything, mock.Anything).
+				Return(503, nil).Once()
+			logged := make(chan struct{})
+			repo.On("SaveDelivery", mock.Anything, mock.MatchedBy(func(delivery *scheduler.WebhookDelivery) bool {
+				return !delivery.Delivered && delivery.Attempts == 2 && delivery.StatusCode == 503 &&
+					delivery.Error == "webhook responded with status 503"
+			})).Run(func(mock.Arguments) { close(logged) }).Return(nil)
+
+			webhookService := service.NewWebhookSubscriptionService(logger, repo, tenantService, poster, conf)
+			webhookService.Dispatch(ctx, event)
+			// closing stops the retries, so the delivery is awaited first
+			<-logged
+			assert.NoError(t, webhookService.Close())
+		})
+		t.Run("logs the failed delivery when the secret of the subscription is not found", func(t *testing.T) {
+			repo := new(mockWebhookSubscriptionRepository)
+			defer repo.AssertExpectations(t)
+			tenantService := new(mockTenantService)
+			defer tenantService.AssertExpectations(t)
+
+			repo.On("GetAll", ctx, tnnt).Return([]*scheduler.WebhookSubscription{failureSubscription}, nil)
+			tenantService.On("GetSecrets", ctx, tnnt).Return([]*tenant.PlainTextSecret{}, nil)
+			repo.On("SaveDelivery", mock.Anything, mock.MatchedBy(func(delivery *scheduler.WebhookDelivery) bool {
+				return !delivery.Delivered && delivery.Attempts == 0 && delivery.Error != ""
+			})).Return(nil)
+
+			webhookService := service.NewWebhookSubscriptionService(logger, repo, tenantService, nil, conf)
+			webhookService.Dispatch(ctx, event)
+			assert.NoError(t, webhookService.Close())
+		})
+	})
+}
+
+type mockWebhookSubscriptionRepository struct {
+	mock.Mock
+}
+
+func (m *mockWebhookSubscriptionRepository) Create(ctx context.Context, subscription *scheduler.WebhookSubscription) (uuid.UUID, error) {
+	args := m.Called(ctx, subscription)
+	return args.Get(0).(uuid.UUID), args.Error(1)
+}
+
+func (m *mockWebhookSubscriptionRepository) Update(ctx context.Context, subscription *scheduler.WebhookSubscription) error {
+	args := m.Called(ctx, subscription)
+	return args.Error(0)
+}
+
+func (m *mockWebhookSubscriptionRepository) GetAll(ctx context.Context, tnnt tenant.Tenant) ([]*scheduler.WebhookSubscription, error) {
+	args := m.Called(ctx, tnnt)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*scheduler.WebhookSubscription), args.Error(1)
+}
+
+func (m *mockWebhookSubscriptionRepository) Delete(ctx context.Context, tnnt tenant.Tenant, id uuid.UUID) error {
+	args := m.Called(ctx, tnnt, id)
+	return args.Error(0)
+}
+
+func (m *mockWebhookSubscriptionRepository) SaveDelivery(ctx context.Context, delivery *scheduler.WebhookDelivery) error {
+	args := m.Called(ctx, delivery)
+	return args.Error(0)
+}
+
+func (m *mockWebhookSubscriptionRepository) GetDeliveries(ctx context.Context, tnnt tenant.Tenant, subscriptionID uuid.UUID, limit int) ([]*scheduler.WebhookDelivery, error) {
+	args := m.Called(ctx, tnnt, subscriptionID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*scheduler.WebhookDelivery), args.Error(1)
+}
+
+type mockWebhookPoster struct {
+	mock.Mock
+}
+
+func (m *mockWebhookPoster) Post(ctx context.Context, url string, headers map[string]string, body []byte) (int, error) {
+	args := m.Called(ctx, url, headers, body)
+	return args.Int(0), args.Error(1)
+}
@@ -20,6 +20,8 @@ const (
 
 	StateSuccess State = "success"
 	StateFailed  State = "failed"
+	// StateSkipped is the state of the hooks whose when condition does not hold in the run
+	StateSkipped State = "skipped"
 
 	StateWaitUpstream State = "wait_upstream"
 	StateInProgress   State = "in_progress"
@@ -47,6 +49,8 @@ func StateFromString(state string) (State, error) {
 		return StateSuccess, nil
 	case string(StateFailed):
 		return StateFailed, nil
+	case string(StateSkipped):
+		return StateSkipped, nil
 	case string(StateWaitUpstream):
 		return StateWaitUpstream, nil
 	case string(StateInProgress):
@@ -60,6 +64,11 @@ func (j State) String() string {
 	return string(j)
 }
 
+// IsFinished tells if the run has ended, a finished run only goes back to running for events newer than its end
+func (j State) IsFinished() bool {
+	return j == StateSuccess || j == StateFailed || j == StateSkipped
+}
+
 type JobRunStatus struct {
 	ScheduledAt time.Time
 	State       State
@@ -140,6 +149,27 @@ func (j JobRunStatusList) OverrideWithStatus(status State) []*JobRunStatus {
 	return overridedRuns
 }
 
+// EnvironmentHealth represents the health of the scheduler environment serving a tenant
+type EnvironmentHealth struct {
+	Type                     string
+	MetadatabaseHealthy      bool
+	SchedulerHealthy         bool
+	LatestSchedulerHeartbeat time.Time
+}
+
+func (h EnvironmentHealth) IsHealthy() bool {
+	return h.MetadatabaseHealthy && h.SchedulerHealthy
+}
+
+// PoolSlots is the usage of the scheduler pool the tasks of a job run in
+type PoolSlots struct {
+	Name     string
+	Slots    int
+	Occupied int
+	Queued   int
+	Open     int
+}
+
 // JobRunsCriteria represents the filter condition to get run status from scheduler
 type JobRunsCriteria struct {
 	Name        string
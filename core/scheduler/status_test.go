@@ -84,6 +84,14 @@ func TestStatus(t *testing.T) {
 			assert.Equal(t, expectedString, input.String())
 		}
 	})
+	t.Run("IsFinished", func(t *testing.T) {
+		assert.True(t, scheduler.StateSuccess.IsFinished())
+		assert.True(t, scheduler.StateFailed.IsFinished())
+		assert.True(t, scheduler.StateSkipped.IsFinished())
+		assert.False(t, scheduler.StateRetry.IsFinished())
+		assert.False(t, scheduler.StateInProgress.IsFinished())
+		assert.False(t, scheduler.StateWaitUpstream.IsFinished())
+	})
 	t.Run("StateFromString", func(t *testing.T) {
 		expectationsMap := map[string]scheduler.State{
 			"pending":     scheduler.StatePending,
@@ -98,6 +106,8 @@ func TestStatus(t *testing.T) {
 			"SUCCESS":     scheduler.StateSuccess,
 			"failed":      scheduler.StateFailed,
 			"FAILED":      scheduler.StateFailed,
+			"skipped":     scheduler.StateSkipped,
+			"SKIPPED":     scheduler.StateSkipped,
 			"in_progress": scheduler.StateInProgress,
 			"IN_PROGRESS": scheduler.StateInProgress,
 		}
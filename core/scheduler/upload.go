@@ -0,0 +1,51 @@
+package scheduler
+
+import (
+	"time"
+
+	"github.com/goto/optimus/core/tenant"
+)
+
+const (
+	EntityUpload = "upload"
+
+	UploadStateInProgress UploadState = "in progress"
+	UploadStateSuccess    UploadState = "success"
+	UploadStateFailed     UploadState = "failed"
+)
+
+type UploadState string
+
+func (s UploadState) String() string {
+	return string(s)
+}
+
+// UploadProgress tracks the upload of all jobs of a project to the scheduler
+type UploadProgress struct {
+	ProjectName tenant.ProjectName
+	State       UploadState
+
+	TotalJobs    int
+	UploadedJobs int
+	FailedJobs   int
+
+	// Namespaces which are completely uploaded, including the clean up of removed jobs
+	CompletedNamespaces []tenant.NamespaceName
+
+	Message    string
+	StartedAt  time.Time
+	FinishedAt time.Time
+}
+
+func NewUploadProgress(projectName tenant.ProjectName, totalJobs int, startedAt time.Time) *UploadProgress {
+	return &UploadProgress{
+		ProjectName: projectName,
+		State:       UploadStateInProgress,
+		TotalJobs:   totalJobs,
+		StartedAt:   startedAt,
+	}
+}
+
+func (p *UploadProgress) IsInProgress() bool {
+	return p.State == UploadStateInProgress
+}
@@ -0,0 +1,70 @@
+package scheduler
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+)
+
+const (
+	EntityUpstreamAccess = "upstream_access"
+
+	UpstreamAccessStatePending  UpstreamAccessState = "pending approval"
+	UpstreamAccessStateApproved UpstreamAccessState = "approved"
+	UpstreamAccessStateDenied   UpstreamAccessState = "denied"
+)
+
+type UpstreamAccessState string
+
+func UpstreamAccessStateFromString(state string) (UpstreamAccessState, error) {
+	switch strings.ToLower(state) {
+	case string(UpstreamAccessStatePending):
+		return UpstreamAccessStatePending, nil
+	case string(UpstreamAccessStateApproved):
+		return UpstreamAccessStateApproved, nil
+	case string(UpstreamAccessStateDenied):
+		return UpstreamAccessStateDenied, nil
+	default:
+		return "", errors.InvalidArgument(EntityUpstreamAccess, "invalid state for upstream access "+state)
+	}
+}
+
+func (s UpstreamAccessState) String() string {
+	return string(s)
+}
+
+// UpstreamAccessRequest is raised when a job depends on an upstream job owned by another project,
+// the sensor of downstream job is held until the request is approved by the upstream owner
+type UpstreamAccessRequest struct {
+	ID uuid.UUID
+
+	DownstreamProject tenant.ProjectName
+	DownstreamJob     JobName
+	UpstreamProject   tenant.ProjectName
+	UpstreamJob       JobName
+
+	State     UpstreamAccessState
+	DecidedBy string
+	Reason    string
+
+	CreatedAt time.Time
+	DecidedAt time.Time
+}
+
+func NewUpstreamAccessRequest(downstreamProject tenant.ProjectName, downstreamJob JobName, upstreamProject tenant.ProjectName, upstreamJob JobName) *UpstreamAccessRequest {
+	return &UpstreamAccessRequest{
+		DownstreamProject: downstreamProject,
+		DownstreamJob:     downstreamJob,
+		UpstreamProject:   upstreamProject,
+		UpstreamJob:       upstreamJob,
+		State:             UpstreamAccessStatePending,
+	}
+}
+
+func (r *UpstreamAccessRequest) IsApproved() bool {
+	return r.State == UpstreamAccessStateApproved
+}
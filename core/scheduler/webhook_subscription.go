@@ -0,0 +1,95 @@
+package scheduler
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+)
+
+const (
+	EntityWebhookSubscription = "webhook_subscription"
+
+	// WebhookSignatureHeader carries the hex encoded HMAC-SHA256 of the payload, keyed with the secret of the subscription
+	WebhookSignatureHeader = "X-Optimus-Signature"
+)
+
+// WebhookSubscription posts the run events of the jobs in the namespace to the url, signed with the value
+// of the namespace secret, so that the receiver can verify the payload is sent by optimus
+type WebhookSubscription struct {
+	ID     uuid.UUID
+	Tenant tenant.Tenant
+
+	URL        string
+	SecretName string
+	EventTypes []JobEventType
+
+	CreatedAt time.Time
+}
+
+func NewWebhookSubscription(tnnt tenant.Tenant, rawURL, secretName string, eventTypes []JobEventType) (*WebhookSubscription, error) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return nil, errors.InvalidArgument(EntityWebhookSubscription, "url should be an absolute http or https url: "+rawURL)
+	}
+	if secretName == "" {
+		return nil, errors.InvalidArgument(EntityWebhookSubscription, "secret name is empty")
+	}
+	if len(eventTypes) == 0 {
+		return nil, errors.InvalidArgument(EntityWebhookSubscription, "subscription should have at least one event type")
+	}
+	for _, eventType := range eventTypes {
+		switch eventType {
+		case JobSuccessEvent, JobFailureEvent, SLAMissEvent:
+		default:
+			return nil, errors.InvalidArgument(EntityWebhookSubscription, "event type should be one of job_success, failure or sla_miss: "+eventType.String())
+		}
+	}
+
+	return &WebhookSubscription{
+		Tenant:     tnnt,
+		URL:        rawURL,
+		SecretName: secretName,
+		EventTypes: eventTypes,
+	}, nil
+}
+
+// Subscribes returns true when the event is posted to the subscription, sla_miss covers the projected and actual breaches too
+func (s *WebhookSubscription) Subscribes(eventType JobEventType) bool {
+	for _, subscribed := range s.EventTypes {
+		if subscribed == eventType || (subscribed == SLAMissEvent && eventType.IsOfType(EventCategorySLAMiss)) {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookDelivery logs the outcome of posting an event to a subscription, after all the attempts
+type WebhookDelivery struct {
+	ID             uuid.UUID
+	SubscriptionID uuid.UUID
+
+	JobName     JobName
+	EventType   JobEventType
+	ScheduledAt time.Time
+
+	Attempts   int
+	StatusCode int
+	Error      string
+	Delivered  bool
+
+	CreatedAt time.Time
+}
+
+// SignWebhookPayload returns the signature of the payload set in the WebhookSignatureHeader
+func SignWebhookPayload(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,48 @@
+package scheduler_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/tenant"
+)
+
+func TestWebhookSubscription(t *testing.T) {
+	tnnt, _ := tenant.NewTenant("proj", "ns1")
+
+	t.Run("NewWebhookSubscription", func(t *testing.T) {
+		t.Run("should return error if url is not an http url", func(t *testing.T) {
+			subscription, err := scheduler.NewWebhookSubscription(tnnt, "ftp://example.com", "WEBHOOK_KEY",
+				[]scheduler.JobEventType{scheduler.JobFailureEvent})
+			assert.ErrorContains(t, err, "url should be an absolute http or https url")
+			assert.Nil(t, subscription)
+		})
+		t.Run("should return error if secret name is empty", func(t *testing.T) {
+			subscription, err := scheduler.NewWebhookSubscription(tnnt, "https://example.com/hook", "",
+				[]scheduler.JobEventType{scheduler.JobFailureEvent})
+			assert.ErrorContains(t, err, "secret name is empty")
+			assert.Nil(t, subscription)
+		})
+		t.Run("should return error if event type cannot be subscribed", func(t *testing.T) {
+			subscription, err := scheduler.NewWebhookSubscription(tnnt, "https://example.com/hook", "WEBHOOK_KEY",
+				[]scheduler.JobEventType{scheduler.TaskStartEvent})
+			assert.ErrorContains(t, err, "event type should be one of job_success, failure or sla_miss")
+			assert.Nil(t, subscription)
+		})
+	})
+	t.Run("Subscribes", func(t *testing.T) {
+		subscription, err := scheduler.NewWebhookSubscription(tnnt, "https://example.com/hook", "WEBHOOK_KEY",
+			[]scheduler.JobEventType{scheduler.JobSuccessEvent, scheduler.SLAMissEvent})
+		assert.NoError(t, err)
+
+		assert.True(t, subscription.Subscribes(scheduler.JobSuccessEvent))
+		assert.True(t, subscription.Subscribes(scheduler.SLABreachEvent))
+		assert.False(t, subscription.Subscribes(scheduler.JobFailureEvent))
+	})
+	t.Run("SignWebhookPayload", func(t *testing.T) {
+		signature := scheduler.SignWebhookPayload("key", []byte("The quick brown fox jumps over the lazy dog"))
+		assert.Equal(t, "sha256=f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8", signature)
+	})
+}
@@ -0,0 +1,142 @@
+package tenant
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/goto/optimus/internal/errors"
+)
+
+const (
+	EntityAPIToken = "api_token"
+
+	// APITokenPrefix marks the bearer tokens which are api tokens managed by the server,
+	// other bearer tokens are user credentials which are not checked against the api tokens
+	APITokenPrefix = "optimus_"
+)
+
+type Permission string
+
+const (
+	PermissionRead   Permission = "read"
+	PermissionDeploy Permission = "deploy"
+	PermissionReplay Permission = "replay"
+
+	// PermissionAdmin is needed to manage the secrets and the access of a project, it is never granted to api tokens
+	PermissionAdmin Permission = "admin"
+)
+
+// TokenScope is the set of permissions granted to an api token, every scope is able to read the project
+type TokenScope string
+
+const (
+	TokenScopeRead   TokenScope = "read"
+	TokenScopeDeploy TokenScope = "deploy"
+	TokenScopeReplay TokenScope = "replay"
+)
+
+var (
+	scopePermissions = map[TokenScope][]Permission{
+		TokenScopeRead:   {PermissionRead},
+		TokenScopeDeploy: {PermissionRead, PermissionDeploy},
+		TokenScopeReplay: {PermissionRead, PermissionReplay},
+	}
+
+	apiTokenNameRegex = regexp.MustCompile(`^[a-zA-Z0-9_\-.]+$`)
+)
+
+func TokenScopeFrom(scope string) (TokenScope, error) {
+	tokenScope := TokenScope(strings.ToLower(scope))
+	if _, ok := scopePermissions[tokenScope]; !ok {
+		return "", errors.InvalidArgument(EntityAPIToken, "invalid scope "+scope+", use one of read, deploy or replay")
+	}
+	return tokenScope, nil
+}
+
+func (s TokenScope) String() string {
+	return string(s)
+}
+
+func (s TokenScope) Allows(permission Permission) bool {
+	for _, allowed := range scopePermissions[s] {
+		if allowed == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// APIToken lets automation, like CI systems, access a single project with the permissions of its scope.
+// Only the hash of the token is kept, the token itself is shown once when it is created or rotated
+type APIToken struct {
+	projectName ProjectName
+	name        string
+	scope       TokenScope
+	hash        string
+
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+func NewAPIToken(projectName ProjectName, name string, scope TokenScope, hash string, createdAt, updatedAt time.Time) (*APIToken, error) {
+	if name == "" {
+		return nil, errors.InvalidArgument(EntityAPIToken, "api token name is empty")
+	}
+	if !apiTokenNameRegex.MatchString(name) {
+		return nil, errors.InvalidArgument(EntityAPIToken, "api token name "+name+" can only contain letters, numbers, underscore, hyphen and dot")
+	}
+	if _, ok := scopePermissions[scope]; !ok {
+		return nil, errors.InvalidArgument(EntityAPIToken, "invalid scope "+scope.String()+" for api token "+name)
+	}
+	if hash == "" {
+		return nil, errors.InvalidArgument(EntityAPIToken, "hash is empty for api token "+name)
+	}
+
+	return &APIToken{
+		projectName: projectName,
+		name:        name,
+		scope:       scope,
+		hash:        hash,
+		createdAt:   createdAt,
+		updatedAt:   updatedAt,
+	}, nil
+}
+
+func (t *APIToken) ProjectName() ProjectName {
+	return t.projectName
+}
+
+func (t *APIToken) Name() string {
+	return t.name
+}
+
+func (t *APIToken) Scope() TokenScope {
+	return t.scope
+}
+
+func (t *APIToken) Hash() string {
+	return t.hash
+}
+
+func (t *APIToken) CreatedAt() time.Time {
+	return t.createdAt
+}
+
+// UpdatedAt is the time the token was last rotated, or created when it was never rotated
+func (t *APIToken) UpdatedAt() time.Time {
+	return t.updatedAt
+}
+
+// Allows tells whether the token has the permission on the project
+func (t *APIToken) Allows(projectName ProjectName, permission Permission) bool {
+	return t.projectName == projectName && t.scope.Allows(permission)
+}
+
+// HashAPIToken returns the hash of the token to be stored and looked up instead of the token
+func HashAPIToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
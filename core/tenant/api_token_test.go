@@ -0,0 +1,89 @@
+package tenant_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/core/tenant"
+)
+
+func TestEntityAPIToken(t *testing.T) {
+	createdAt := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("TokenScopeFrom", func(t *testing.T) {
+		t.Run("returns error when scope is unknown", func(t *testing.T) {
+			_, err := tenant.TokenScopeFrom("admin")
+			assert.EqualError(t, err, "invalid argument for entity api_token: invalid scope admin, use one of read, deploy or replay")
+		})
+		t.Run("returns the scope regardless of the case", func(t *testing.T) {
+			scope, err := tenant.TokenScopeFrom("Deploy")
+			assert.NoError(t, err)
+			assert.Equal(t, tenant.TokenScopeDeploy, scope)
+		})
+	})
+	t.Run("TokenScope", func(t *testing.T) {
+		t.Run("allows reading for every scope", func(t *testing.T) {
+			for _, scope := range []tenant.TokenScope{tenant.TokenScopeRead, tenant.TokenScopeDeploy, tenant.TokenScopeReplay} {
+				assert.True(t, scope.Allows(tenant.PermissionRead), scope)
+			}
+		})
+		t.Run("allows only the permission of the scope besides reading", func(t *testing.T) {
+			assert.False(t, tenant.TokenScopeRead.Allows(tenant.PermissionDeploy))
+			assert.False(t, tenant.TokenScopeRead.Allows(tenant.PermissionReplay))
+			assert.True(t, tenant.TokenScopeDeploy.Allows(tenant.PermissionDeploy))
+			assert.False(t, tenant.TokenScopeDeploy.Allows(tenant.PermissionReplay))
+			assert.True(t, tenant.TokenScopeReplay.Allows(tenant.PermissionReplay))
+			assert.False(t, tenant.TokenScopeReplay.Allows(tenant.PermissionDeploy))
+		})
+	})
+	t.Run("NewAPIToken", func(t *testing.T) {
+		t.Run("returns error when name is empty", func(t *testing.T) {
+			_, err := tenant.NewAPIToken("proj", "", tenant.TokenScopeRead, "hash", createdAt, createdAt)
+			assert.EqualError(t, err, "invalid argument for entity api_token: api token name is empty")
+		})
+		t.Run("returns error when name has invalid characters", func(t *testing.T) {
+			_, err := tenant.NewAPIToken("proj", "ci token", tenant.TokenScopeRead, "hash", createdAt, createdAt)
+			assert.EqualError(t, err, "invalid argument for entity api_token: api token name ci token can only contain letters, numbers, underscore, hyphen and dot")
+		})
+		t.Run("returns error when scope is unknown", func(t *testing.T) {
+			_, err := tenant.NewAPIToken("proj", "ci", "admin", "hash", createdAt, createdAt)
+			assert.EqualError(t, err, "invalid argument for entity api_token: invalid scope admin for api token ci")
+		})
+		t.Run("returns error when hash is empty", func(t *testing.T) {
+			_, err := tenant.NewAPIToken("proj", "ci", tenant.TokenScopeRead, "", createdAt, createdAt)
+			assert.EqualError(t, err, "invalid argument for entity api_token: hash is empty for api token ci")
+		})
+		t.Run("creates the api token", func(t *testing.T) {
+			token, err := tenant.NewAPIToken("proj", "ci", tenant.TokenScopeDeploy, "hash", createdAt, createdAt)
+			assert.NoError(t, err)
+
+			assert.Equal(t, tenant.ProjectName("proj"), token.ProjectName())
+			assert.Equal(t, "ci", token.Name())
+			assert.Equal(t, tenant.TokenScopeDeploy, token.Scope())
+			assert.Equal(t, "hash", token.Hash())
+			assert.Equal(t, createdAt, token.CreatedAt())
+			assert.Equal(t, createdAt, token.UpdatedAt())
+		})
+	})
+	t.Run("Allows", func(t *testing.T) {
+		token, _ := tenant.NewAPIToken("proj", "ci", tenant.TokenScopeDeploy, "hash", createdAt, createdAt)
+
+		t.Run("returns false for another project", func(t *testing.T) {
+			assert.False(t, token.Allows("other", tenant.PermissionRead))
+		})
+		t.Run("returns the permission of the scope for the project", func(t *testing.T) {
+			assert.True(t, token.Allows("proj", tenant.PermissionDeploy))
+			assert.False(t, token.Allows("proj", tenant.PermissionReplay))
+		})
+	})
+	t.Run("HashAPIToken", func(t *testing.T) {
+		t.Run("returns the same hash for the same token", func(t *testing.T) {
+			hash := tenant.HashAPIToken("optimus_secret")
+			assert.Len(t, hash, 64)
+			assert.Equal(t, hash, tenant.HashAPIToken("optimus_secret"))
+			assert.NotEqual(t, hash, tenant.HashAPIToken("optimus_other"))
+		})
+	})
+}
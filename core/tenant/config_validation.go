@@ -0,0 +1,136 @@
+package tenant
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/goto/optimus/internal/errors"
+)
+
+type configValidator func(value string) error
+
+// wellKnownConfigValidators check the values of the project configs understood by optimus, which can be
+// overridden by the namespaces as well
+var wellKnownConfigValidators = map[string]configValidator{
+	ProjectStoragePathKey:         validateURLConfig,
+	ProjectSchedulerHost:          validateHostConfig,
+	ProjectUpstreamAccessApproval: validateBoolConfig,
+	ProjectJobTimezone:            validateTimezoneConfig,
+	ProjectSecretProvider:         validateSecretProviderConfig,
+	ProjectSecretProviderCacheTTL: validateDurationConfig,
+}
+
+var namespaceConfigValidators = map[string]configValidator{
+	NamespaceProjectSecrets: validateSecretAllowListConfig,
+}
+
+// ValidateProjectConfig checks the mandatory project configs are present and the well known ones have valid values,
+// together with the configs required by the plugins
+func ValidateProjectConfig(config map[string]string, requiredKeys ...string) error {
+	var problems []string
+	for _, key := range append([]string{ProjectStoragePathKey, ProjectSchedulerHost}, requiredKeys...) {
+		if strings.TrimSpace(config[key]) == "" {
+			problems = append(problems, fmt.Sprintf("required config %s is missing", key))
+		}
+	}
+	problems = append(problems, configValueProblems(config, wellKnownConfigValidators)...)
+
+	if len(problems) > 0 {
+		return errors.InvalidArgument(EntityProject, "invalid configuration: "+strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// ValidateNamespaceConfig checks the values of the well known configs of the namespace, including the project
+// configs overridden by the namespace
+func ValidateNamespaceConfig(config map[string]string) error {
+	problems := configValueProblems(config, wellKnownConfigValidators)
+	problems = append(problems, configValueProblems(config, namespaceConfigValidators)...)
+
+	if len(problems) > 0 {
+		return errors.InvalidArgument(EntityNamespace, "invalid configuration: "+strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+func configValueProblems(config map[string]string, validators map[string]configValidator) []string {
+	keys := make([]string, 0, len(config))
+	for key := range config {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var problems []string
+	for _, key := range keys {
+		validate, ok := validators[key]
+		if !ok || config[key] == "" {
+			continue
+		}
+		if err := validate(config[key]); err != nil {
+			problems = append(problems, fmt.Sprintf("config %s: %s", key, err))
+		}
+	}
+	return problems
+}
+
+func validateURLConfig(value string) error {
+	parsed, err := url.Parse(value)
+	if err != nil || parsed.Scheme == "" {
+		return fmt.Errorf("%q is not a url with scheme, like gs://bucket/path", value)
+	}
+	return nil
+}
+
+func validateHostConfig(value string) error {
+	if strings.ContainsAny(value, " \t\n") {
+		return fmt.Errorf("%q is not a valid host", value)
+	}
+	if _, err := url.Parse(value); err != nil {
+		return fmt.Errorf("%q is not a valid host", value)
+	}
+	return nil
+}
+
+func validateBoolConfig(value string) error {
+	if _, err := strconv.ParseBool(value); err != nil {
+		return fmt.Errorf("%q is not a boolean", value)
+	}
+	return nil
+}
+
+func validateTimezoneConfig(value string) error {
+	if _, err := time.LoadLocation(value); err != nil {
+		return fmt.Errorf("%q is not a known timezone", value)
+	}
+	return nil
+}
+
+func validateDurationConfig(value string) error {
+	if duration, err := time.ParseDuration(value); err != nil || duration < 0 {
+		return fmt.Errorf("%q is not a duration, like 5m", value)
+	}
+	return nil
+}
+
+func validateSecretProviderConfig(value string) error {
+	switch value {
+	case SecretProviderVault, SecretProviderGCPSecretManager:
+		return nil
+	default:
+		return fmt.Errorf("%q is not one of %s, %s", value, SecretProviderVault, SecretProviderGCPSecretManager)
+	}
+}
+
+func validateSecretAllowListConfig(value string) error {
+	for _, pattern := range strings.Split(value, ",") {
+		if _, err := path.Match(strings.TrimSpace(pattern), ""); err != nil {
+			return fmt.Errorf("%q is not a valid pattern", strings.TrimSpace(pattern))
+		}
+	}
+	return nil
+}
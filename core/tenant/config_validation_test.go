@@ -0,0 +1,63 @@
+package tenant_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/core/tenant"
+)
+
+func TestConfigValidation(t *testing.T) {
+	t.Run("ValidateProjectConfig", func(t *testing.T) {
+		t.Run("returns error when mandatory or plugin required configs are missing", func(t *testing.T) {
+			err := tenant.ValidateProjectConfig(map[string]string{
+				tenant.ProjectStoragePathKey: "gs://bucket/path",
+			}, "BQ_PROJECT")
+
+			assert.EqualError(t, err, "invalid argument for entity project: invalid configuration: "+
+				"required config SCHEDULER_HOST is missing; required config BQ_PROJECT is missing")
+		})
+		t.Run("returns error for every invalid well known config", func(t *testing.T) {
+			err := tenant.ValidateProjectConfig(map[string]string{
+				tenant.ProjectStoragePathKey:         "gs://bucket/path",
+				tenant.ProjectSchedulerHost:          "http://airflow:8080",
+				tenant.ProjectSecretProvider:         "aws",
+				tenant.ProjectSecretProviderCacheTTL: "-1m",
+			})
+
+			assert.EqualError(t, err, "invalid argument for entity project: invalid configuration: "+
+				"config SECRET_PROVIDER: \"aws\" is not one of vault, gcp_secret_manager; "+
+				"config SECRET_PROVIDER_CACHE_TTL: \"-1m\" is not a duration, like 5m")
+		})
+		t.Run("returns nil when the configs are valid", func(t *testing.T) {
+			err := tenant.ValidateProjectConfig(map[string]string{
+				tenant.ProjectStoragePathKey:         "gs://bucket/path",
+				tenant.ProjectSchedulerHost:          "http://airflow:8080",
+				tenant.ProjectUpstreamAccessApproval: "true",
+				tenant.ProjectJobTimezone:            "Asia/Jakarta",
+				"BQ_PROJECT":                         "bq-project",
+			}, "BQ_PROJECT")
+
+			assert.NoError(t, err)
+		})
+	})
+	t.Run("ValidateNamespaceConfig", func(t *testing.T) {
+		t.Run("returns error when an overridden project config is invalid", func(t *testing.T) {
+			err := tenant.ValidateNamespaceConfig(map[string]string{
+				tenant.ProjectStoragePathKey: "bucket/path",
+			})
+
+			assert.EqualError(t, err, "invalid argument for entity namespace: invalid configuration: "+
+				"config STORAGE_PATH: \"bucket/path\" is not a url with scheme, like gs://bucket/path")
+		})
+		t.Run("returns nil when the configs are valid", func(t *testing.T) {
+			err := tenant.ValidateNamespaceConfig(map[string]string{
+				tenant.NamespaceProjectSecrets: "BQ_*, API_TOKEN",
+				"BUCKET":                       "gs://some_folder",
+			})
+
+			assert.NoError(t, err)
+		})
+	})
+}
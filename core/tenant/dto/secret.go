@@ -12,3 +12,20 @@ type SecretInfo struct {
 
 	UpdatedAt time.Time
 }
+
+// SecretRotation is the result of rotating a secret, the previous version stays readable until PreviousReadableUntil
+type SecretRotation struct {
+	Name string
+
+	Version               int
+	PreviousVersion       int
+	PreviousReadableUntil time.Time
+}
+
+// SecretUsage is the version of a secret a job is compiled against the last time
+type SecretUsage struct {
+	JobName string
+	Version int
+
+	CompiledAt time.Time
+}
@@ -0,0 +1,146 @@
+package v1beta1
+
+import (
+	"context"
+
+	"github.com/goto/salt/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/auth"
+	"github.com/goto/optimus/internal/errors"
+	"github.com/goto/optimus/internal/logging"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+type AccessService interface {
+	Grant(ctx context.Context, projectName tenant.ProjectName, namespaceName tenant.NamespaceName, principal string, role tenant.Role, grantedBy string) (*tenant.RoleBinding, error)
+	Revoke(ctx context.Context, projectName tenant.ProjectName, namespaceName tenant.NamespaceName, principal string) error
+	GetAll(ctx context.Context, projectName tenant.ProjectName) ([]*tenant.RoleBinding, error)
+	AuthorizeAdmin(ctx context.Context, principal string, projectName tenant.ProjectName, namespaceName tenant.NamespaceName) (bool, error)
+}
+
+// AccessHandler manages the role bindings of a project, which is allowed for the admins of the project only
+type AccessHandler struct {
+	l       log.Logger
+	service AccessService
+
+	pb.UnimplementedRoleBindingServiceServer
+}
+
+func (h AccessHandler) GrantRole(ctx context.Context, req *pb.GrantRoleRequest) (*pb.GrantRoleResponse, error) {
+	l := logging.ForTenant(h.l, req.GetProjectName(), req.GetNamespaceName(), "")
+	projectName, err := tenant.ProjectNameFrom(req.GetProjectName())
+	if err != nil {
+		l.Error("error adapting project name [%s]: %s", req.GetProjectName(), err)
+		return nil, errors.GRPCErr(err, "unable to grant role to "+req.GetPrincipal())
+	}
+
+	role, err := tenant.RoleFrom(req.GetRole())
+	if err != nil {
+		l.Error("error adapting role [%s]: %s", req.GetRole(), err)
+		return nil, errors.GRPCErr(err, "unable to grant role to "+req.GetPrincipal())
+	}
+
+	namespaceName := tenant.NamespaceName(req.GetNamespaceName())
+	grantedBy, err := h.authorize(ctx, projectName, namespaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	granted, err := h.service.Grant(ctx, projectName, namespaceName, req.GetPrincipal(), role, grantedBy)
+	if err != nil {
+		l.Error("error granting role [%s] to [%s] on project [%s]: %s", role, req.GetPrincipal(), projectName, err)
+		return nil, errors.GRPCErr(err, "unable to grant role to "+req.GetPrincipal())
+	}
+	return &pb.GrantRoleResponse{RoleBinding: toRoleBinding(granted)}, nil
+}
+
+func (h AccessHandler) ListRoleBindings(ctx context.Context, req *pb.ListRoleBindingsRequest) (*pb.ListRoleBindingsResponse, error) {
+	l := logging.ForTenant(h.l, req.GetProjectName(), "", "")
+	projectName, err := tenant.ProjectNameFrom(req.GetProjectName())
+	if err != nil {
+		l.Error("error adapting project name [%s]: %s", req.GetProjectName(), err)
+		return nil, errors.GRPCErr(err, "unable to list role bindings of "+req.GetProjectName())
+	}
+
+	if _, err := h.authorize(ctx, projectName, ""); err != nil {
+		return nil, err
+	}
+
+	bindings, err := h.service.GetAll(ctx, projectName)
+	if err != nil {
+		l.Error("error getting role bindings of project [%s]: %s", projectName, err)
+		return nil, errors.GRPCErr(err, "unable to list role bindings of "+req.GetProjectName())
+	}
+
+	response := make([]*pb.RoleBinding, len(bindings))
+	for i, binding := range bindings {
+		response[i] = toRoleBinding(binding)
+	}
+	return &pb.ListRoleBindingsResponse{RoleBindings: response}, nil
+}
+
+func (h AccessHandler) RevokeRole(ctx context.Context, req *pb.RevokeRoleRequest) (*pb.RevokeRoleResponse, error) {
+	l := logging.ForTenant(h.l, req.GetProjectName(), req.GetNamespaceName(), "")
+	projectName, err := tenant.ProjectNameFrom(req.GetProjectName())
+	if err != nil {
+		l.Error("error adapting project name [%s]: %s", req.GetProjectName(), err)
+		return nil, errors.GRPCErr(err, "unable to revoke role of "+req.GetPrincipal())
+	}
+	if req.GetPrincipal() == "" {
+		return nil, errors.GRPCErr(errors.InvalidArgument(tenant.EntityRoleBinding, "principal is empty"),
+			"unable to revoke role of "+req.GetPrincipal())
+	}
+
+	namespaceName := tenant.NamespaceName(req.GetNamespaceName())
+	if _, err := h.authorize(ctx, projectName, namespaceName); err != nil {
+		return nil, err
+	}
+
+	if err := h.service.Revoke(ctx, projectName, namespaceName, req.GetPrincipal()); err != nil {
+		l.Error("error revoking role of [%s] on project [%s]: %s", req.GetPrincipal(), projectName, err)
+		return nil, errors.GRPCErr(err, "unable to revoke role of "+req.GetPrincipal())
+	}
+	return &pb.RevokeRoleResponse{}, nil
+}
+
+// authorize checks the caller is an admin of the namespace, it returns the name of the caller when it is allowed.
+// The access is managed by the admins only, whether the role based access is enabled or not.
+func (h AccessHandler) authorize(ctx context.Context, projectName tenant.ProjectName, namespaceName tenant.NamespaceName) (string, error) {
+	principal := auth.PrincipalFrom(ctx)
+	if principal == nil {
+		return "", status.Error(codes.Unauthenticated, "access of project "+projectName.String()+" can only be managed with credentials")
+	}
+
+	caller := principal.String()
+	allowed, err := h.service.AuthorizeAdmin(ctx, caller, projectName, namespaceName)
+	if err != nil {
+		h.l.Error("error authorizing [%s] as admin of project [%s]: %s", caller, projectName, err)
+		return "", errors.GRPCErr(err, "unable to authorize "+caller)
+	}
+	if !allowed {
+		return "", status.Error(codes.PermissionDenied, "only the admins of project "+projectName.String()+" can manage its access")
+	}
+	return caller, nil
+}
+
+func toRoleBinding(binding *tenant.RoleBinding) *pb.RoleBinding {
+	return &pb.RoleBinding{
+		ProjectName:   binding.ProjectName().String(),
+		NamespaceName: binding.NamespaceName().String(),
+		Principal:     binding.Principal(),
+		Role:          binding.Role().String(),
+		GrantedBy:     binding.GrantedBy(),
+		CreatedAt:     timestamppb.New(binding.CreatedAt()),
+	}
+}
+
+func NewAccessHandler(l log.Logger, service AccessService) *AccessHandler {
+	return &AccessHandler{
+		l:       l,
+		service: service,
+	}
+}
@@ -0,0 +1,146 @@
+package v1beta1_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/core/tenant/handler/v1beta1"
+	"github.com/goto/optimus/internal/auth"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+func TestAccessHandler(t *testing.T) {
+	logger := log.NewNoop()
+	projectName := tenant.ProjectName("proj")
+	createdAt := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{Type: auth.PrincipalOIDC, Name: "admin@example.com"})
+	binding, _ := tenant.NewRoleBinding(projectName, "sales", "john@example.com", tenant.RoleEditor, "admin@example.com", createdAt)
+
+	t.Run("GrantRole", func(t *testing.T) {
+		t.Run("returns error when role is invalid", func(t *testing.T) {
+			handler := v1beta1.NewAccessHandler(logger, new(accessService))
+
+			_, err := handler.GrantRole(ctx, &pb.GrantRoleRequest{ProjectName: "proj", Principal: "john@example.com", Role: "owner"})
+			assert.ErrorContains(t, err, "code = InvalidArgument")
+		})
+		t.Run("returns error when the caller is not authenticated", func(t *testing.T) {
+			handler := v1beta1.NewAccessHandler(logger, new(accessService))
+
+			_, err := handler.GrantRole(context.Background(), &pb.GrantRoleRequest{ProjectName: "proj", Principal: "john@example.com", Role: "editor"})
+			assert.ErrorContains(t, err, "code = Unauthenticated")
+		})
+		t.Run("returns error when the caller is not an admin of the namespace", func(t *testing.T) {
+			service := new(accessService)
+			service.On("AuthorizeAdmin", ctx, "admin@example.com", projectName, tenant.NamespaceName("sales")).Return(false, nil)
+			defer service.AssertExpectations(t)
+			handler := v1beta1.NewAccessHandler(logger, service)
+
+			_, err := handler.GrantRole(ctx, &pb.GrantRoleRequest{
+				ProjectName:   "proj",
+				NamespaceName: "sales",
+				Principal:     "john@example.com",
+				Role:          "editor",
+			})
+			assert.EqualError(t, err, "rpc error: code = PermissionDenied desc = only the admins of project proj can manage its access")
+		})
+		t.Run("grants the role recording the caller", func(t *testing.T) {
+			service := new(accessService)
+			service.On("AuthorizeAdmin", ctx, "admin@example.com", projectName, tenant.NamespaceName("sales")).Return(true, nil)
+			service.On("Grant", ctx, projectName, tenant.NamespaceName("sales"), "john@example.com", tenant.RoleEditor, "admin@example.com").
+				Return(binding, nil)
+			defer service.AssertExpectations(t)
+			handler := v1beta1.NewAccessHandler(logger, service)
+
+			resp, err := handler.GrantRole(ctx, &pb.GrantRoleRequest{
+				ProjectName:   "proj",
+				NamespaceName: "sales",
+				Principal:     "john@example.com",
+				Role:          "editor",
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, "editor", resp.GetRoleBinding().GetRole())
+			assert.Equal(t, "admin@example.com", resp.GetRoleBinding().GetGrantedBy())
+			assert.Equal(t, createdAt, resp.GetRoleBinding().GetCreatedAt().AsTime())
+		})
+	})
+	t.Run("ListRoleBindings", func(t *testing.T) {
+		t.Run("returns error when unable to authorize the caller", func(t *testing.T) {
+			service := new(accessService)
+			service.On("AuthorizeAdmin", ctx, "admin@example.com", projectName, tenant.NamespaceName("")).Return(false, errors.New("unknown error"))
+			defer service.AssertExpectations(t)
+			handler := v1beta1.NewAccessHandler(logger, service)
+
+			_, err := handler.ListRoleBindings(ctx, &pb.ListRoleBindingsRequest{ProjectName: "proj"})
+			assert.ErrorContains(t, err, "code = Internal")
+		})
+		t.Run("returns the role bindings of the project", func(t *testing.T) {
+			service := new(accessService)
+			service.On("AuthorizeAdmin", ctx, "admin@example.com", projectName, tenant.NamespaceName("")).Return(true, nil)
+			service.On("GetAll", ctx, projectName).Return([]*tenant.RoleBinding{binding}, nil)
+			defer service.AssertExpectations(t)
+			handler := v1beta1.NewAccessHandler(logger, service)
+
+			resp, err := handler.ListRoleBindings(ctx, &pb.ListRoleBindingsRequest{ProjectName: "proj"})
+			assert.NoError(t, err)
+			assert.Len(t, resp.GetRoleBindings(), 1)
+			assert.Equal(t, "john@example.com", resp.GetRoleBindings()[0].GetPrincipal())
+			assert.Equal(t, "sales", resp.GetRoleBindings()[0].GetNamespaceName())
+		})
+	})
+	t.Run("RevokeRole", func(t *testing.T) {
+		t.Run("returns error when principal is empty", func(t *testing.T) {
+			handler := v1beta1.NewAccessHandler(logger, new(accessService))
+
+			_, err := handler.RevokeRole(ctx, &pb.RevokeRoleRequest{ProjectName: "proj"})
+			assert.ErrorContains(t, err, "code = InvalidArgument")
+		})
+		t.Run("revokes the role on the namespace", func(t *testing.T) {
+			service := new(accessService)
+			service.On("AuthorizeAdmin", ctx, "admin@example.com", projectName, tenant.NamespaceName("sales")).Return(true, nil)
+			service.On("Revoke", ctx, projectName, tenant.NamespaceName("sales"), "john@example.com").Return(nil)
+			defer service.AssertExpectations(t)
+			handler := v1beta1.NewAccessHandler(logger, service)
+
+			_, err := handler.RevokeRole(ctx, &pb.RevokeRoleRequest{ProjectName: "proj", NamespaceName: "sales", Principal: "john@example.com"})
+			assert.NoError(t, err)
+		})
+	})
+}
+
+type accessService struct {
+	mock.Mock
+}
+
+func (a *accessService) Grant(ctx context.Context, projectName tenant.ProjectName, namespaceName tenant.NamespaceName, principal string,
+	role tenant.Role, grantedBy string,
+) (*tenant.RoleBinding, error) {
+	args := a.Called(ctx, projectName, namespaceName, principal, role, grantedBy)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*tenant.RoleBinding), args.Error(1)
+}
+
+func (a *accessService) Revoke(ctx context.Context, projectName tenant.ProjectName, namespaceName tenant.NamespaceName, principal string) error {
+	return a.Called(ctx, projectName, namespaceName, principal).Error(0)
+}
+
+func (a *accessService) GetAll(ctx context.Context, projectName tenant.ProjectName) ([]*tenant.RoleBinding, error) {
+	args := a.Called(ctx, projectName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*tenant.RoleBinding), args.Error(1)
+}
+
+func (a *accessService) AuthorizeAdmin(ctx context.Context, principal string, projectName tenant.ProjectName, namespaceName tenant.NamespaceName) (bool, error) {
+	args := a.Called(ctx, principal, projectName, namespaceName)
+	return args.Bool(0), args.Error(1)
+}
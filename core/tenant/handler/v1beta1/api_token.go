@@ -0,0 +1,123 @@
+package v1beta1
+
+import (
+	"context"
+
+	"github.com/goto/salt/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+	"github.com/goto/optimus/internal/logging"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+type APITokenService interface {
+	Create(ctx context.Context, projectName tenant.ProjectName, name string, scope tenant.TokenScope) (*tenant.APIToken, string, error)
+	Rotate(ctx context.Context, projectName tenant.ProjectName, name string) (string, error)
+	Revoke(ctx context.Context, projectName tenant.ProjectName, name string) error
+	GetAll(ctx context.Context, projectName tenant.ProjectName) ([]*tenant.APIToken, error)
+}
+
+type APITokenHandler struct {
+	l       log.Logger
+	service APITokenService
+
+	pb.UnimplementedAPITokenServiceServer
+}
+
+// CreateAPIToken creates the api token, the token is only returned in the response and is not kept by the server
+func (h *APITokenHandler) CreateAPIToken(ctx context.Context, req *pb.CreateAPITokenRequest) (*pb.CreateAPITokenResponse, error) {
+	l := logging.ForTenant(h.l, req.GetProjectName(), "", "")
+	projectName, err := tenant.ProjectNameFrom(req.GetProjectName())
+	if err != nil {
+		l.Error("error adapting project name [%s]: %s", req.GetProjectName(), err)
+		return nil, errors.GRPCErr(err, "unable to create api token "+req.GetName())
+	}
+
+	scope, err := tenant.TokenScopeFrom(req.GetScope())
+	if err != nil {
+		l.Error("error adapting scope [%s]: %s", req.GetScope(), err)
+		return nil, errors.GRPCErr(err, "unable to create api token "+req.GetName())
+	}
+
+	created, token, err := h.service.Create(ctx, projectName, req.GetName(), scope)
+	if err != nil {
+		l.Error("error creating api token [%s]: %s", req.GetName(), err)
+		return nil, errors.GRPCErr(err, "unable to create api token "+req.GetName())
+	}
+	return &pb.CreateAPITokenResponse{ApiToken: toAPITokenProto(created), Token: token}, nil
+}
+
+func (h *APITokenHandler) ListAPITokens(ctx context.Context, req *pb.ListAPITokensRequest) (*pb.ListAPITokensResponse, error) {
+	l := logging.ForTenant(h.l, req.GetProjectName(), "", "")
+	projectName, err := tenant.ProjectNameFrom(req.GetProjectName())
+	if err != nil {
+		l.Error("error adapting project name [%s]: %s", req.GetProjectName(), err)
+		return nil, errors.GRPCErr(err, "unable to list api tokens")
+	}
+
+	tokens, err := h.service.GetAll(ctx, projectName)
+	if err != nil {
+		l.Error("error getting api tokens of project [%s]: %s", projectName, err)
+		return nil, errors.GRPCErr(err, "unable to list api tokens of "+projectName.String())
+	}
+
+	tokensProto := make([]*pb.APIToken, len(tokens))
+	for i, token := range tokens {
+		tokensProto[i] = toAPITokenProto(token)
+	}
+	return &pb.ListAPITokensResponse{ApiTokens: tokensProto}, nil
+}
+
+// RotateAPIToken replaces the token of the api token, the previous token stops working right away
+func (h *APITokenHandler) RotateAPIToken(ctx context.Context, req *pb.RotateAPITokenRequest) (*pb.RotateAPITokenResponse, error) {
+	l := logging.ForTenant(h.l, req.GetProjectName(), "", "")
+	projectName, err := tenant.ProjectNameFrom(req.GetProjectName())
+	if err != nil {
+		l.Error("error adapting project name [%s]: %s", req.GetProjectName(), err)
+		return nil, errors.GRPCErr(err, "unable to rotate api token "+req.GetName())
+	}
+
+	token, err := h.service.Rotate(ctx, projectName, req.GetName())
+	if err != nil {
+		l.Error("error rotating api token [%s]: %s", req.GetName(), err)
+		return nil, errors.GRPCErr(err, "unable to rotate api token "+req.GetName())
+	}
+	return &pb.RotateAPITokenResponse{Token: token}, nil
+}
+
+func (h *APITokenHandler) RevokeAPIToken(ctx context.Context, req *pb.RevokeAPITokenRequest) (*pb.RevokeAPITokenResponse, error) {
+	l := logging.ForTenant(h.l, req.GetProjectName(), "", "")
+	projectName, err := tenant.ProjectNameFrom(req.GetProjectName())
+	if err != nil {
+		l.Error("error adapting project name [%s]: %s", req.GetProjectName(), err)
+		return nil, errors.GRPCErr(err, "unable to revoke api token "+req.GetName())
+	}
+	if req.GetName() == "" {
+		return nil, errors.GRPCErr(errors.InvalidArgument(tenant.EntityAPIToken, "api token name is empty"),
+			"unable to revoke api token")
+	}
+
+	if err := h.service.Revoke(ctx, projectName, req.GetName()); err != nil {
+		l.Error("error revoking api token [%s]: %s", req.GetName(), err)
+		return nil, errors.GRPCErr(err, "unable to revoke api token "+req.GetName())
+	}
+	return &pb.RevokeAPITokenResponse{}, nil
+}
+
+func toAPITokenProto(token *tenant.APIToken) *pb.APIToken {
+	return &pb.APIToken{
+		Name:      token.Name(),
+		Scope:     token.Scope().String(),
+		CreatedAt: timestamppb.New(token.CreatedAt()),
+		UpdatedAt: timestamppb.New(token.UpdatedAt()),
+	}
+}
+
+func NewAPITokenHandler(l log.Logger, service APITokenService) *APITokenHandler {
+	return &APITokenHandler{
+		l:       l,
+		service: service,
+	}
+}
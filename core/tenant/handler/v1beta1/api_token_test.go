@@ -0,0 +1,127 @@
+package v1beta1_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/core/tenant/handler/v1beta1"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+func TestAPITokenHandler(t *testing.T) {
+	logger := log.NewNoop()
+	ctx := context.Background()
+	projectName := tenant.ProjectName("proj")
+	createdAt := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+	apiToken, _ := tenant.NewAPIToken(projectName, "ci-deploy", tenant.TokenScopeDeploy, "hash", createdAt, createdAt)
+
+	t.Run("CreateAPIToken", func(t *testing.T) {
+		t.Run("returns error when scope is invalid", func(t *testing.T) {
+			handler := v1beta1.NewAPITokenHandler(logger, new(apiTokenService))
+
+			_, err := handler.CreateAPIToken(ctx, &pb.CreateAPITokenRequest{ProjectName: "proj", Name: "ci-deploy", Scope: "admin"})
+			assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid argument for entity "+
+				tenant.EntityAPIToken+": invalid scope admin, use one of read, deploy or replay: unable to create api token ci-deploy")
+		})
+		t.Run("returns the created api token along with its token", func(t *testing.T) {
+			service := new(apiTokenService)
+			service.On("Create", ctx, projectName, "ci-deploy", tenant.TokenScopeDeploy).Return(apiToken, "optimus_secret", nil)
+			defer service.AssertExpectations(t)
+			handler := v1beta1.NewAPITokenHandler(logger, service)
+
+			resp, err := handler.CreateAPIToken(ctx, &pb.CreateAPITokenRequest{ProjectName: "proj", Name: "ci-deploy", Scope: "deploy"})
+			assert.NoError(t, err)
+			assert.Equal(t, "optimus_secret", resp.GetToken())
+			assert.Equal(t, "ci-deploy", resp.GetApiToken().GetName())
+			assert.Equal(t, "deploy", resp.GetApiToken().GetScope())
+		})
+	})
+	t.Run("ListAPITokens", func(t *testing.T) {
+		t.Run("returns error when unable to get the api tokens", func(t *testing.T) {
+			service := new(apiTokenService)
+			service.On("GetAll", ctx, projectName).Return(nil, errors.New("unknown error"))
+			defer service.AssertExpectations(t)
+			handler := v1beta1.NewAPITokenHandler(logger, service)
+
+			_, err := handler.ListAPITokens(ctx, &pb.ListAPITokensRequest{ProjectName: "proj"})
+			assert.EqualError(t, err, "rpc error: code = Internal desc = unknown error: unable to list api tokens of proj")
+		})
+		t.Run("returns the api tokens of the project", func(t *testing.T) {
+			service := new(apiTokenService)
+			service.On("GetAll", ctx, projectName).Return([]*tenant.APIToken{apiToken}, nil)
+			defer service.AssertExpectations(t)
+			handler := v1beta1.NewAPITokenHandler(logger, service)
+
+			resp, err := handler.ListAPITokens(ctx, &pb.ListAPITokensRequest{ProjectName: "proj"})
+			assert.NoError(t, err)
+			assert.Len(t, resp.GetApiTokens(), 1)
+			assert.Equal(t, createdAt, resp.GetApiTokens()[0].GetCreatedAt().AsTime())
+		})
+	})
+	t.Run("RotateAPIToken", func(t *testing.T) {
+		t.Run("returns the new token", func(t *testing.T) {
+			service := new(apiTokenService)
+			service.On("Rotate", ctx, projectName, "ci-deploy").Return("optimus_rotated", nil)
+			defer service.AssertExpectations(t)
+			handler := v1beta1.NewAPITokenHandler(logger, service)
+
+			resp, err := handler.RotateAPIToken(ctx, &pb.RotateAPITokenRequest{ProjectName: "proj", Name: "ci-deploy"})
+			assert.NoError(t, err)
+			assert.Equal(t, "optimus_rotated", resp.GetToken())
+		})
+	})
+	t.Run("RevokeAPIToken", func(t *testing.T) {
+		t.Run("returns error when name is empty", func(t *testing.T) {
+			handler := v1beta1.NewAPITokenHandler(logger, new(apiTokenService))
+
+			_, err := handler.RevokeAPIToken(ctx, &pb.RevokeAPITokenRequest{ProjectName: "proj"})
+			assert.ErrorContains(t, err, "code = InvalidArgument")
+			assert.ErrorContains(t, err, "api token name is empty")
+		})
+		t.Run("revokes the api token", func(t *testing.T) {
+			service := new(apiTokenService)
+			service.On("Revoke", ctx, projectName, "ci-deploy").Return(nil)
+			defer service.AssertExpectations(t)
+			handler := v1beta1.NewAPITokenHandler(logger, service)
+
+			_, err := handler.RevokeAPIToken(ctx, &pb.RevokeAPITokenRequest{ProjectName: "proj", Name: "ci-deploy"})
+			assert.NoError(t, err)
+		})
+	})
+}
+
+type apiTokenService struct {
+	mock.Mock
+}
+
+func (a *apiTokenService) Create(ctx context.Context, projectName tenant.ProjectName, name string, scope tenant.TokenScope) (*tenant.APIToken, string, error) {
+	args := a.Called(ctx, projectName, name, scope)
+	if args.Get(0) == nil {
+		return nil, "", args.Error(2)
+	}
+	return args.Get(0).(*tenant.APIToken), args.String(1), args.Error(2)
+}
+
+func (a *apiTokenService) Rotate(ctx context.Context, projectName tenant.ProjectName, name string) (string, error) {
+	args := a.Called(ctx, projectName, name)
+	return args.String(0), args.Error(1)
+}
+
+func (a *apiTokenService) Revoke(ctx context.Context, projectName tenant.ProjectName, name string) error {
+	return a.Called(ctx, projectName, name).Error(0)
+}
+
+func (a *apiTokenService) GetAll(ctx context.Context, projectName tenant.ProjectName) ([]*tenant.APIToken, error) {
+	args := a.Called(ctx, projectName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*tenant.APIToken), args.Error(1)
+}
@@ -0,0 +1,100 @@
+package v1beta1
+
+import (
+	"context"
+
+	"github.com/goto/salt/log"
+
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+	"github.com/goto/optimus/internal/logging"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+type ArchiveService interface {
+	ArchiveProject(ctx context.Context, projectName tenant.ProjectName) error
+	UnarchiveProject(ctx context.Context, projectName tenant.ProjectName) error
+	ArchiveNamespace(ctx context.Context, projectName tenant.ProjectName, namespaceName tenant.NamespaceName) error
+	UnarchiveNamespace(ctx context.Context, projectName tenant.ProjectName, namespaceName tenant.NamespaceName) error
+}
+
+type ArchiveHandler struct {
+	l       log.Logger
+	service ArchiveService
+
+	pb.UnimplementedArchiveServiceServer
+}
+
+func (h ArchiveHandler) ArchiveProject(ctx context.Context, req *pb.ArchiveProjectRequest) (*pb.ArchiveProjectResponse, error) {
+	l := logging.ForTenant(h.l, req.GetProjectName(), "", "")
+	projectName, err := tenant.ProjectNameFrom(req.GetProjectName())
+	if err != nil {
+		l.Error("error adapting project name [%s]: %s", req.GetProjectName(), err)
+		return nil, errors.GRPCErr(err, "unable to archive project "+req.GetProjectName())
+	}
+
+	if err := h.service.ArchiveProject(ctx, projectName); err != nil {
+		l.Error("error archiving project [%s]: %s", projectName, err)
+		return nil, errors.GRPCErr(err, "unable to archive project "+req.GetProjectName())
+	}
+	return &pb.ArchiveProjectResponse{}, nil
+}
+
+func (h ArchiveHandler) UnarchiveProject(ctx context.Context, req *pb.UnarchiveProjectRequest) (*pb.UnarchiveProjectResponse, error) {
+	l := logging.ForTenant(h.l, req.GetProjectName(), "", "")
+	projectName, err := tenant.ProjectNameFrom(req.GetProjectName())
+	if err != nil {
+		l.Error("error adapting project name [%s]: %s", req.GetProjectName(), err)
+		return nil, errors.GRPCErr(err, "unable to unarchive project "+req.GetProjectName())
+	}
+
+	if err := h.service.UnarchiveProject(ctx, projectName); err != nil {
+		l.Error("error unarchiving project [%s]: %s", projectName, err)
+		return nil, errors.GRPCErr(err, "unable to unarchive project "+req.GetProjectName())
+	}
+	return &pb.UnarchiveProjectResponse{}, nil
+}
+
+func (h ArchiveHandler) ArchiveNamespace(ctx context.Context, req *pb.ArchiveNamespaceRequest) (*pb.ArchiveNamespaceResponse, error) {
+	tnnt, err := tenant.NewTenant(req.GetProjectName(), req.GetNamespaceName())
+	if err != nil {
+		h.l.Error("error adapting tenant [%s/%s]: %s", req.GetProjectName(), req.GetNamespaceName(), err)
+		return nil, errors.GRPCErr(err, "unable to archive namespace "+req.GetNamespaceName())
+	}
+
+	l := h.tenantLogger(tnnt)
+
+	if err := h.service.ArchiveNamespace(ctx, tnnt.ProjectName(), tnnt.NamespaceName()); err != nil {
+		l.Error("error archiving namespace [%s]: %s", tnnt.NamespaceName(), err)
+		return nil, errors.GRPCErr(err, "unable to archive namespace "+req.GetNamespaceName())
+	}
+	return &pb.ArchiveNamespaceResponse{}, nil
+}
+
+func (h ArchiveHandler) UnarchiveNamespace(ctx context.Context, req *pb.UnarchiveNamespaceRequest) (*pb.UnarchiveNamespaceResponse, error) {
+	tnnt, err := tenant.NewTenant(req.GetProjectName(), req.GetNamespaceName())
+	if err != nil {
+		h.l.Error("error adapting tenant [%s/%s]: %s", req.GetProjectName(), req.GetNamespaceName(), err)
+		return nil, errors.GRPCErr(err, "unable to unarchive namespace "+req.GetNamespaceName())
+	}
+
+	l := h.tenantLogger(tnnt)
+
+	if err := h.service.UnarchiveNamespace(ctx, tnnt.ProjectName(), tnnt.NamespaceName()); err != nil {
+		l.Error("error unarchiving namespace [%s]: %s", tnnt.NamespaceName(), err)
+		return nil, errors.GRPCErr(err, "unable to unarchive namespace "+req.GetNamespaceName())
+	}
+	return &pb.UnarchiveNamespaceResponse{}, nil
+}
+
+// tenantLogger attaches the tenant fields to the lines logged for the request
+func (h ArchiveHandler) tenantLogger(tnnt tenant.Tenant) log.Logger {
+	return logging.ForTenant(h.l, tnnt.ProjectName().String(), tnnt.NamespaceName().String(), "")
+}
+
+func NewArchiveHandler(l log.Logger, service ArchiveService) *ArchiveHandler {
+	return &ArchiveHandler{
+		l:       l,
+		service: service,
+	}
+}
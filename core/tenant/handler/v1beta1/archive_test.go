@@ -0,0 +1,129 @@
+package v1beta1_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/core/tenant/handler/v1beta1"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+func TestArchiveHandler(t *testing.T) {
+	logger := log.NewNoop()
+	ctx := context.Background()
+	projectName := tenant.ProjectName("proj")
+	namespaceName := tenant.NamespaceName("sales")
+
+	t.Run("ArchiveProject", func(t *testing.T) {
+		t.Run("returns error when project name is invalid", func(t *testing.T) {
+			handler := v1beta1.NewArchiveHandler(logger, new(archiveService))
+
+			_, err := handler.ArchiveProject(ctx, &pb.ArchiveProjectRequest{})
+			assert.ErrorContains(t, err, "code = InvalidArgument")
+		})
+		t.Run("returns error when unable to archive the project", func(t *testing.T) {
+			service := new(archiveService)
+			service.On("ArchiveProject", ctx, projectName).Return(errors.New("unknown error"))
+			defer service.AssertExpectations(t)
+			handler := v1beta1.NewArchiveHandler(logger, service)
+
+			_, err := handler.ArchiveProject(ctx, &pb.ArchiveProjectRequest{ProjectName: "proj"})
+			assert.EqualError(t, err, "rpc error: code = Internal desc = unknown error: unable to archive project proj")
+		})
+		t.Run("archives the project", func(t *testing.T) {
+			service := new(archiveService)
+			service.On("ArchiveProject", ctx, projectName).Return(nil)
+			defer service.AssertExpectations(t)
+			handler := v1beta1.NewArchiveHandler(logger, service)
+
+			_, err := handler.ArchiveProject(ctx, &pb.ArchiveProjectRequest{ProjectName: "proj"})
+			assert.NoError(t, err)
+		})
+	})
+	t.Run("UnarchiveProject", func(t *testing.T) {
+		t.Run("returns error when project name is invalid", func(t *testing.T) {
+			handler := v1beta1.NewArchiveHandler(logger, new(archiveService))
+
+			_, err := handler.UnarchiveProject(ctx, &pb.UnarchiveProjectRequest{})
+			assert.ErrorContains(t, err, "code = InvalidArgument")
+		})
+		t.Run("unarchives the project", func(t *testing.T) {
+			service := new(archiveService)
+			service.On("UnarchiveProject", ctx, projectName).Return(nil)
+			defer service.AssertExpectations(t)
+			handler := v1beta1.NewArchiveHandler(logger, service)
+
+			_, err := handler.UnarchiveProject(ctx, &pb.UnarchiveProjectRequest{ProjectName: "proj"})
+			assert.NoError(t, err)
+		})
+	})
+	t.Run("ArchiveNamespace", func(t *testing.T) {
+		t.Run("returns error when namespace name is empty", func(t *testing.T) {
+			handler := v1beta1.NewArchiveHandler(logger, new(archiveService))
+
+			_, err := handler.ArchiveNamespace(ctx, &pb.ArchiveNamespaceRequest{ProjectName: "proj"})
+			assert.ErrorContains(t, err, "code = InvalidArgument")
+		})
+		t.Run("returns error when unable to archive the namespace", func(t *testing.T) {
+			service := new(archiveService)
+			service.On("ArchiveNamespace", ctx, projectName, namespaceName).Return(errors.New("unknown error"))
+			defer service.AssertExpectations(t)
+			handler := v1beta1.NewArchiveHandler(logger, service)
+
+			_, err := handler.ArchiveNamespace(ctx, &pb.ArchiveNamespaceRequest{ProjectName: "proj", NamespaceName: "sales"})
+			assert.EqualError(t, err, "rpc error: code = Internal desc = unknown error: unable to archive namespace sales")
+		})
+		t.Run("archives the namespace", func(t *testing.T) {
+			service := new(archiveService)
+			service.On("ArchiveNamespace", ctx, projectName, namespaceName).Return(nil)
+			defer service.AssertExpectations(t)
+			handler := v1beta1.NewArchiveHandler(logger, service)
+
+			_, err := handler.ArchiveNamespace(ctx, &pb.ArchiveNamespaceRequest{ProjectName: "proj", NamespaceName: "sales"})
+			assert.NoError(t, err)
+		})
+	})
+	t.Run("UnarchiveNamespace", func(t *testing.T) {
+		t.Run("returns error when namespace name is empty", func(t *testing.T) {
+			handler := v1beta1.NewArchiveHandler(logger, new(archiveService))
+
+			_, err := handler.UnarchiveNamespace(ctx, &pb.UnarchiveNamespaceRequest{ProjectName: "proj"})
+			assert.ErrorContains(t, err, "code = InvalidArgument")
+		})
+		t.Run("unarchives the namespace", func(t *testing.T) {
+			service := new(archiveService)
+			service.On("UnarchiveNamespace", ctx, projectName, namespaceName).Return(nil)
+			defer service.AssertExpectations(t)
+			handler := v1beta1.NewArchiveHandler(logger, service)
+
+			_, err := handler.UnarchiveNamespace(ctx, &pb.UnarchiveNamespaceRequest{ProjectName: "proj", NamespaceName: "sales"})
+			assert.NoError(t, err)
+		})
+	})
+}
+
+type archiveService struct {
+	mock.Mock
+}
+
+func (a *archiveService) ArchiveProject(ctx context.Context, projectName tenant.ProjectName) error {
+	return a.Called(ctx, projectName).Error(0)
+}
+
+func (a *archiveService) UnarchiveProject(ctx context.Context, projectName tenant.ProjectName) error {
+	return a.Called(ctx, projectName).Error(0)
+}
+
+func (a *archiveService) ArchiveNamespace(ctx context.Context, projectName tenant.ProjectName, namespaceName tenant.NamespaceName) error {
+	return a.Called(ctx, projectName, namespaceName).Error(0)
+}
+
+func (a *archiveService) UnarchiveNamespace(ctx context.Context, projectName tenant.ProjectName, namespaceName tenant.NamespaceName) error {
+	return a.Called(ctx, projectName, namespaceName).Error(0)
+}
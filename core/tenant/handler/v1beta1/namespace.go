@@ -8,6 +8,7 @@ import (
 
 	"github.com/goto/optimus/core/tenant"
 	"github.com/goto/optimus/internal/errors"
+	"github.com/goto/optimus/internal/logging"
 	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
 )
 
@@ -27,21 +28,22 @@ type NamespaceHandler struct {
 func (nh *NamespaceHandler) RegisterProjectNamespace(ctx context.Context, req *pb.RegisterProjectNamespaceRequest) (
 	*pb.RegisterProjectNamespaceResponse, error,
 ) {
+	l := logging.ForTenant(nh.l, req.GetProjectName(), "", "")
 	projName, err := tenant.ProjectNameFrom(req.GetProjectName())
 	if err != nil {
-		nh.l.Error("error adapting project name [%s]: %s", req.GetProjectName(), err)
+		l.Error("error adapting project name [%s]: %s", req.GetProjectName(), err)
 		return nil, errors.GRPCErr(err, "error in register namespace "+req.GetNamespace().Name)
 	}
 
 	namespace, err := fromNamespaceProto(req.GetNamespace(), projName)
 	if err != nil {
-		nh.l.Error("error adapting project [%s]: %s", projName, err)
+		l.Error("error adapting project [%s]: %s", projName, err)
 		return nil, errors.GRPCErr(err, "error in register namespace "+req.GetNamespace().Name)
 	}
 
 	err = nh.nsService.Save(ctx, namespace)
 	if err != nil {
-		nh.l.Error("error saving namespace: %s", err)
+		l.Error("error saving namespace: %s", err)
 		return nil, errors.GRPCErr(err, "error in register namespace "+req.GetNamespace().Name)
 	}
 
@@ -51,15 +53,16 @@ func (nh *NamespaceHandler) RegisterProjectNamespace(ctx context.Context, req *p
 func (nh *NamespaceHandler) ListProjectNamespaces(ctx context.Context, req *pb.ListProjectNamespacesRequest) (
 	*pb.ListProjectNamespacesResponse, error,
 ) {
+	l := logging.ForTenant(nh.l, req.GetProjectName(), "", "")
 	projName, err := tenant.ProjectNameFrom(req.GetProjectName())
 	if err != nil {
-		nh.l.Error("error adapting project name [%s]: %s", req.GetProjectName(), err)
+		l.Error("error adapting project name [%s]: %s", req.GetProjectName(), err)
 		return nil, errors.GRPCErr(err, "error in list namespaces")
 	}
 
 	namespaces, err := nh.nsService.GetAll(ctx, projName)
 	if err != nil {
-		nh.l.Error("error getting all namespaces for project [%s]: %s", projName, err)
+		l.Error("error getting all namespaces for project [%s]: %s", projName, err)
 		return nil, errors.GRPCErr(err, "error in list namespaces")
 	}
 
@@ -76,21 +79,22 @@ func (nh *NamespaceHandler) ListProjectNamespaces(ctx context.Context, req *pb.L
 func (nh *NamespaceHandler) GetNamespace(ctx context.Context, request *pb.GetNamespaceRequest) (
 	*pb.GetNamespaceResponse, error,
 ) {
+	l := logging.ForTenant(nh.l, request.GetProjectName(), request.GetNamespaceName(), "")
 	projName, err := tenant.ProjectNameFrom(request.GetProjectName())
 	if err != nil {
-		nh.l.Error("error adapting project name [%s]: %s", request.GetProjectName(), err)
+		l.Error("error adapting project name [%s]: %s", request.GetProjectName(), err)
 		return nil, errors.GRPCErr(err, "error in get namespace "+request.NamespaceName)
 	}
 
 	namespaceName, err := tenant.NamespaceNameFrom(request.GetNamespaceName())
 	if err != nil {
-		nh.l.Error("error adapting namespace name [%s]: %s", request.GetNamespaceName(), err)
+		l.Error("error adapting namespace name [%s]: %s", request.GetNamespaceName(), err)
 		return nil, errors.GRPCErr(err, "error in get namespace "+request.NamespaceName)
 	}
 
 	namespace, err := nh.nsService.Get(ctx, projName, namespaceName)
 	if err != nil {
-		nh.l.Error("error getting namespace: %s", err)
+		l.Error("error getting namespace: %s", err)
 		return nil, errors.GRPCErr(err, "error in get namespace "+request.NamespaceName)
 	}
 
@@ -9,6 +9,7 @@ import (
 
 	"github.com/goto/optimus/core/tenant"
 	"github.com/goto/optimus/internal/errors"
+	"github.com/goto/optimus/internal/logging"
 	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
 )
 
@@ -23,6 +24,10 @@ type ProjectService interface {
 	Save(context.Context, *tenant.Project) error
 	Get(context.Context, tenant.ProjectName) (*tenant.Project, error)
 	GetAll(context.Context) ([]*tenant.Project, error)
+
+	SavePreset(ctx context.Context, projectName tenant.ProjectName, preset tenant.Preset) (tenant.Preset, error)
+	GetPresets(ctx context.Context, projectName tenant.ProjectName) ([]tenant.Preset, error)
+	DeletePreset(ctx context.Context, projectName tenant.ProjectName, presetName string) error
 }
 
 type TenantService interface {
@@ -63,14 +68,15 @@ func (ph *ProjectHandler) ListProjects(ctx context.Context, _ *pb.ListProjectsRe
 }
 
 func (ph *ProjectHandler) GetProject(ctx context.Context, req *pb.GetProjectRequest) (*pb.GetProjectResponse, error) {
+	l := logging.ForTenant(ph.l, req.GetProjectName(), "", "")
 	projName, err := tenant.ProjectNameFrom(req.GetProjectName())
 	if err != nil {
-		ph.l.Error("error adapting project name [%s]: %s", req.GetProjectName(), err)
+		l.Error("error adapting project name [%s]: %s", req.GetProjectName(), err)
 		return nil, errors.GRPCErr(err, fmt.Sprintf("failed to retrieve project [%s]", req.GetProjectName()))
 	}
 	project, err := ph.projectService.Get(ctx, projName)
 	if err != nil {
-		ph.l.Error("error getting project [%s]: %s", projName, err)
+		l.Error("error getting project [%s]: %s", projName, err)
 		return nil, errors.GRPCErr(err, fmt.Sprintf("failed to retrieve project [%s]", req.GetProjectName()))
 	}
 	return &pb.GetProjectResponse{
@@ -78,6 +84,68 @@ func (ph *ProjectHandler) GetProject(ctx context.Context, req *pb.GetProjectRequ
 	}, nil
 }
 
+func (ph *ProjectHandler) SavePreset(ctx context.Context, req *pb.SavePresetRequest) (*pb.SavePresetResponse, error) {
+	l := logging.ForTenant(ph.l, req.GetProjectName(), "", "")
+	projName, err := tenant.ProjectNameFrom(req.GetProjectName())
+	if err != nil {
+		l.Error("error adapting project name [%s]: %s", req.GetProjectName(), err)
+		return nil, errors.GRPCErr(err, fmt.Sprintf("failed to save preset [%s]", req.GetName()))
+	}
+
+	preset, err := tenant.NewPreset(req.GetName(), req.GetDescription(), req.GetTruncateTo(), req.GetOffset(), req.GetSize())
+	if err != nil {
+		l.Error("error adapting preset [%s]: %s", req.GetName(), err)
+		return nil, errors.GRPCErr(err, fmt.Sprintf("failed to save preset [%s]", req.GetName()))
+	}
+
+	saved, err := ph.projectService.SavePreset(ctx, projName, preset)
+	if err != nil {
+		l.Error("error saving preset [%s]: %s", req.GetName(), err)
+		return nil, errors.GRPCErr(err, fmt.Sprintf("failed to save preset [%s]", req.GetName()))
+	}
+	return &pb.SavePresetResponse{
+		Preset: toWindowPresetProto(saved),
+	}, nil
+}
+
+func (ph *ProjectHandler) ListPresets(ctx context.Context, req *pb.ListPresetsRequest) (*pb.ListPresetsResponse, error) {
+	l := logging.ForTenant(ph.l, req.GetProjectName(), "", "")
+	projName, err := tenant.ProjectNameFrom(req.GetProjectName())
+	if err != nil {
+		l.Error("error adapting project name [%s]: %s", req.GetProjectName(), err)
+		return nil, errors.GRPCErr(err, fmt.Sprintf("failed to retrieve presets of project [%s]", req.GetProjectName()))
+	}
+
+	presets, err := ph.projectService.GetPresets(ctx, projName)
+	if err != nil {
+		l.Error("error getting presets of project [%s]: %s", req.GetProjectName(), err)
+		return nil, errors.GRPCErr(err, fmt.Sprintf("failed to retrieve presets of project [%s]", req.GetProjectName()))
+	}
+
+	presetsProto := make([]*pb.WindowPreset, len(presets))
+	for i, preset := range presets {
+		presetsProto[i] = toWindowPresetProto(preset)
+	}
+	return &pb.ListPresetsResponse{
+		Presets: presetsProto,
+	}, nil
+}
+
+func (ph *ProjectHandler) DeletePreset(ctx context.Context, req *pb.DeletePresetRequest) (*pb.DeletePresetResponse, error) {
+	l := logging.ForTenant(ph.l, req.GetProjectName(), "", "")
+	projName, err := tenant.ProjectNameFrom(req.GetProjectName())
+	if err != nil {
+		l.Error("error adapting project name [%s]: %s", req.GetProjectName(), err)
+		return nil, errors.GRPCErr(err, fmt.Sprintf("failed to delete preset [%s]", req.GetName()))
+	}
+
+	if err := ph.projectService.DeletePreset(ctx, projName, req.GetName()); err != nil {
+		l.Error("error deleting preset [%s]: %s", req.GetName(), err)
+		return nil, errors.GRPCErr(err, fmt.Sprintf("failed to delete preset [%s]", req.GetName()))
+	}
+	return &pb.DeletePresetResponse{}, nil
+}
+
 func NewProjectHandler(l log.Logger, projectService ProjectService) *ProjectHandler {
 	return &ProjectHandler{
 		l:              l,
@@ -131,3 +199,14 @@ func toProjectPresets(presets map[string]tenant.Preset) map[string]*pb.ProjectSp
 	}
 	return presetPb
 }
+
+func toWindowPresetProto(preset tenant.Preset) *pb.WindowPreset {
+	return &pb.WindowPreset{
+		Name:        preset.Name(),
+		Description: preset.Description(),
+		TruncateTo:  preset.Window().GetTruncateTo(),
+		Offset:      preset.Window().GetOffset(),
+		Size:        preset.Window().GetSize(),
+		Version:     int32(preset.Version()),
+	}
+}
@@ -175,6 +175,112 @@ func TestProjectHandler(t *testing.T) {
 			assert.Equal(t, len(savedProj.GetPresets()), len(proj.Project.Presets))
 		})
 	})
+	t.Run("SavePreset", func(t *testing.T) {
+		t.Run("returns error when preset is invalid", func(t *testing.T) {
+			projectService := new(projectService)
+			handler := v1beta1.NewProjectHandler(logger, projectService)
+
+			_, err := handler.SavePreset(ctx, &pb.SavePresetRequest{
+				ProjectName: "savedProj",
+				Name:        "yesterday",
+				TruncateTo:  "d",
+				Size:        "24h",
+			})
+			assert.NotNil(t, err)
+			assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid argument for entity "+
+				"project: description is empty: failed to save preset [yesterday]")
+		})
+		t.Run("returns error when unable to save preset", func(t *testing.T) {
+			projectService := new(projectService)
+			projectService.On("SavePreset", ctx, tenant.ProjectName("savedProj"), mock.Anything).
+				Return(tenant.Preset{}, errors.New("error in saving"))
+			defer projectService.AssertExpectations(t)
+
+			handler := v1beta1.NewProjectHandler(logger, projectService)
+
+			_, err := handler.SavePreset(ctx, &pb.SavePresetRequest{
+				ProjectName: "savedProj",
+				Name:        "yesterday",
+				Description: "preset for yesterday",
+				TruncateTo:  "d",
+				Size:        "24h",
+			})
+			assert.NotNil(t, err)
+			assert.EqualError(t, err, "rpc error: code = Internal desc = error in saving: failed to save preset [yesterday]")
+		})
+		t.Run("returns the saved preset", func(t *testing.T) {
+			preset, err := tenant.NewPreset("yesterday", "preset for yesterday", "d", "", "24h")
+			assert.NoError(t, err)
+
+			projectService := new(projectService)
+			projectService.On("SavePreset", ctx, tenant.ProjectName("savedProj"), preset).Return(preset, nil)
+			defer projectService.AssertExpectations(t)
+
+			handler := v1beta1.NewProjectHandler(logger, projectService)
+
+			resp, err := handler.SavePreset(ctx, &pb.SavePresetRequest{
+				ProjectName: "savedProj",
+				Name:        "yesterday",
+				Description: "preset for yesterday",
+				TruncateTo:  "d",
+				Size:        "24h",
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, "yesterday", resp.GetPreset().GetName())
+			assert.Equal(t, "24h", resp.GetPreset().GetSize())
+		})
+	})
+	t.Run("ListPresets", func(t *testing.T) {
+		t.Run("returns error when project name is empty", func(t *testing.T) {
+			projectService := new(projectService)
+			handler := v1beta1.NewProjectHandler(logger, projectService)
+
+			_, err := handler.ListPresets(ctx, &pb.ListPresetsRequest{})
+			assert.NotNil(t, err)
+			assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid argument for entity "+
+				"project: project name is empty: failed to retrieve presets of project []")
+		})
+		t.Run("returns the presets of the project", func(t *testing.T) {
+			preset, err := tenant.NewPreset("yesterday", "preset for yesterday", "d", "", "24h")
+			assert.NoError(t, err)
+
+			projectService := new(projectService)
+			projectService.On("GetPresets", ctx, tenant.ProjectName("savedProj")).Return([]tenant.Preset{preset}, nil)
+			defer projectService.AssertExpectations(t)
+
+			handler := v1beta1.NewProjectHandler(logger, projectService)
+
+			resp, err := handler.ListPresets(ctx, &pb.ListPresetsRequest{ProjectName: "savedProj"})
+			assert.NoError(t, err)
+			assert.Len(t, resp.GetPresets(), 1)
+			assert.Equal(t, "yesterday", resp.GetPresets()[0].GetName())
+			assert.Equal(t, "preset for yesterday", resp.GetPresets()[0].GetDescription())
+		})
+	})
+	t.Run("DeletePreset", func(t *testing.T) {
+		t.Run("returns error when unable to delete preset", func(t *testing.T) {
+			projectService := new(projectService)
+			projectService.On("DeletePreset", ctx, tenant.ProjectName("savedProj"), "yesterday").
+				Return(errors.New("error in deleting"))
+			defer projectService.AssertExpectations(t)
+
+			handler := v1beta1.NewProjectHandler(logger, projectService)
+
+			_, err := handler.DeletePreset(ctx, &pb.DeletePresetRequest{ProjectName: "savedProj", Name: "yesterday"})
+			assert.NotNil(t, err)
+			assert.EqualError(t, err, "rpc error: code = Internal desc = error in deleting: failed to delete preset [yesterday]")
+		})
+		t.Run("deletes the preset", func(t *testing.T) {
+			projectService := new(projectService)
+			projectService.On("DeletePreset", ctx, tenant.ProjectName("savedProj"), "yesterday").Return(nil)
+			defer projectService.AssertExpectations(t)
+
+			handler := v1beta1.NewProjectHandler(logger, projectService)
+
+			_, err := handler.DeletePreset(ctx, &pb.DeletePresetRequest{ProjectName: "savedProj", Name: "yesterday"})
+			assert.NoError(t, err)
+		})
+	})
 }
 
 type projectService struct {
@@ -203,3 +309,22 @@ func (p *projectService) GetAll(ctx context.Context) ([]*tenant.Project, error)
 	}
 	return prjs, args.Error(1)
 }
+
+func (p *projectService) SavePreset(ctx context.Context, projectName tenant.ProjectName, preset tenant.Preset) (tenant.Preset, error) {
+	args := p.Called(ctx, projectName, preset)
+	return args.Get(0).(tenant.Preset), args.Error(1)
+}
+
+func (p *projectService) GetPresets(ctx context.Context, projectName tenant.ProjectName) ([]tenant.Preset, error) {
+	args := p.Called(ctx, projectName)
+	var presets []tenant.Preset
+	if args.Get(0) != nil {
+		presets = args.Get(0).([]tenant.Preset)
+	}
+	return presets, args.Error(1)
+}
+
+func (p *projectService) DeletePreset(ctx context.Context, projectName tenant.ProjectName, presetName string) error {
+	args := p.Called(ctx, projectName, presetName)
+	return args.Error(0)
+}
@@ -3,6 +3,7 @@ package v1beta1
 import (
 	"context"
 	"encoding/base64"
+	"time"
 
 	"github.com/goto/salt/log"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -19,9 +20,13 @@ const (
 	secretEventsStatusRegistered     = "registered"
 	secretEventsStatusUpdated        = "updated"
 	secretEventsStatusDeleted        = "deleted"
+	secretEventsStatusRotated        = "rotated"
 	secretEventsStatusRegisterFailed = "register_failed"
 	secretEventsStatusUpdateFailed   = "update_failed"
 	secretEventsStatusDeleteFailed   = "delete_failed"
+	secretEventsStatusRotateFailed   = "rotate_failed"
+
+	defaultSecretGracePeriod = 24 * time.Hour
 )
 
 type SecretService interface {
@@ -29,6 +34,8 @@ type SecretService interface {
 	Update(ctx context.Context, projName tenant.ProjectName, nsName string, pts *tenant.PlainTextSecret) error
 	Delete(ctx context.Context, projName tenant.ProjectName, nsName string, secretName tenant.SecretName) error
 	GetSecretsInfo(ctx context.Context, projName tenant.ProjectName) ([]*dto.SecretInfo, error)
+	Rotate(ctx context.Context, projName tenant.ProjectName, nsName string, pts *tenant.PlainTextSecret, gracePeriod time.Duration) (*dto.SecretRotation, error)
+	GetStaleUsages(ctx context.Context, projName tenant.ProjectName, name tenant.SecretName) ([]*dto.SecretUsage, error)
 }
 
 type SecretHandler struct {
@@ -137,6 +144,79 @@ func (sv *SecretHandler) DeleteSecret(ctx context.Context, req *pb.DeleteSecretR
 	return &pb.DeleteSecretResponse{}, nil
 }
 
+// RotateSecret stores a new version of a secret, the previous version stays readable for the grace period of the request
+func (sv *SecretHandler) RotateSecret(ctx context.Context, req *pb.RotateSecretRequest) (*pb.RotateSecretResponse, error) {
+	projName, err := tenant.ProjectNameFrom(req.GetProjectName())
+	if err != nil {
+		return nil, errors.GRPCErr(err, "failed to rotate secret "+req.GetSecretName())
+	}
+
+	gracePeriod := defaultSecretGracePeriod
+	if req.GetGracePeriod() != nil {
+		if err := req.GetGracePeriod().CheckValid(); err != nil {
+			return nil, errors.GRPCErr(errors.InvalidArgument(tenant.EntitySecret, "invalid grace period"), "failed to rotate secret "+req.GetSecretName())
+		}
+		gracePeriod = req.GetGracePeriod().AsDuration()
+	}
+
+	base64Decoded, err := getDecodedSecret(req.GetValue())
+	if err != nil {
+		raiseSecretEventsMetric(projName.String(), req.NamespaceName, secretEventsStatusRotateFailed)
+		return nil, errors.GRPCErr(err, "failed to rotate secret "+req.GetSecretName())
+	}
+
+	secret, err := tenant.NewPlainTextSecret(req.GetSecretName(), base64Decoded)
+	if err != nil {
+		raiseSecretEventsMetric(projName.String(), req.NamespaceName, secretEventsStatusRotateFailed)
+		return nil, errors.GRPCErr(err, "failed to rotate secret "+req.GetSecretName())
+	}
+
+	rotation, err := sv.secretService.Rotate(ctx, projName, req.GetNamespaceName(), secret, gracePeriod)
+	if err != nil {
+		raiseSecretEventsMetric(projName.String(), req.NamespaceName, secretEventsStatusRotateFailed)
+		return nil, errors.GRPCErr(err, "failed to rotate secret "+req.GetSecretName())
+	}
+
+	raiseSecretEventsMetric(projName.String(), req.NamespaceName, secretEventsStatusRotated)
+	return &pb.RotateSecretResponse{
+		SecretName:            rotation.Name,
+		Version:               int32(rotation.Version),
+		PreviousVersion:       int32(rotation.PreviousVersion),
+		PreviousReadableUntil: timestamppb.New(rotation.PreviousReadableUntil),
+	}, nil
+}
+
+// ListStaleSecretUsages lists the jobs still compiled against a previous version of a secret
+func (sv *SecretHandler) ListStaleSecretUsages(ctx context.Context, req *pb.ListStaleSecretUsagesRequest) (*pb.ListStaleSecretUsagesResponse, error) {
+	projName, err := tenant.ProjectNameFrom(req.GetProjectName())
+	if err != nil {
+		return nil, errors.GRPCErr(err, "failed to list stale usages of secret "+req.GetSecretName())
+	}
+
+	secretName, err := tenant.SecretNameFrom(req.GetSecretName())
+	if err != nil {
+		return nil, errors.GRPCErr(err, "failed to list stale usages of secret "+req.GetSecretName())
+	}
+
+	usages, err := sv.secretService.GetStaleUsages(ctx, projName, secretName)
+	if err != nil {
+		return nil, errors.GRPCErr(err, "failed to list stale usages of secret "+secretName.String())
+	}
+
+	staleJobs := make([]*pb.ListStaleSecretUsagesResponse_Usage, len(usages))
+	for i, usage := range usages {
+		staleJobs[i] = &pb.ListStaleSecretUsagesResponse_Usage{
+			JobName:    usage.JobName,
+			Version:    int32(usage.Version),
+			CompiledAt: timestamppb.New(usage.CompiledAt),
+		}
+	}
+	return &pb.ListStaleSecretUsagesResponse{
+		SecretName: secretName.String(),
+		StaleJobs:  staleJobs,
+	}, nil
+}
+
 func getDecodedSecret(encodedString string) (string, error) {
 	if encodedString == "" {
 		return "", errors.InvalidArgument(tenant.EntitySecret, "empty value for secret")
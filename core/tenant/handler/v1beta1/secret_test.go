@@ -10,6 +10,7 @@ import (
 	"github.com/goto/salt/log"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/goto/optimus/core/tenant"
 	"github.com/goto/optimus/core/tenant/dto"
@@ -337,6 +338,90 @@ func TestNewSecretsHandler(t *testing.T) {
 			assert.Nil(t, err)
 		})
 	})
+	t.Run("RotateSecret", func(t *testing.T) {
+		t.Run("returns error when grace period is invalid", func(t *testing.T) {
+			secretService := new(secretService)
+			handler := v1beta1.NewSecretsHandler(logger, secretService)
+
+			_, err := handler.RotateSecret(ctx, &pb.RotateSecretRequest{
+				ProjectName: proj.String(),
+				SecretName:  "name",
+				Value:       base64Val,
+				GracePeriod: &durationpb.Duration{Seconds: 1, Nanos: -1},
+			})
+			assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid argument for entity "+
+				"secret: invalid grace period: failed to rotate secret name")
+		})
+		t.Run("returns error when error is returned from service", func(t *testing.T) {
+			secretService := new(secretService)
+			secretService.On("Rotate", ctx, proj, ns.String(), mock.Anything, 24*time.Hour).
+				Return(nil, errors.New("error in rotate"))
+			defer secretService.AssertExpectations(t)
+			handler := v1beta1.NewSecretsHandler(logger, secretService)
+
+			_, err := handler.RotateSecret(ctx, &pb.RotateSecretRequest{
+				ProjectName:   proj.String(),
+				NamespaceName: ns.String(),
+				SecretName:    "name",
+				Value:         base64Val,
+			})
+			assert.EqualError(t, err, "rpc error: code = Internal desc = error in rotate: failed to rotate secret name")
+		})
+		t.Run("rotates the secret with the grace period", func(t *testing.T) {
+			readableUntil := time.Date(2023, 10, 10, 16, 0, 0, 0, time.UTC)
+
+			secretService := new(secretService)
+			secretService.On("Rotate", ctx, proj, ns.String(), mock.Anything, 6*time.Hour).
+				Return(&dto.SecretRotation{Name: "NAME", Version: 2, PreviousVersion: 1, PreviousReadableUntil: readableUntil}, nil)
+			defer secretService.AssertExpectations(t)
+			handler := v1beta1.NewSecretsHandler(logger, secretService)
+
+			resp, err := handler.RotateSecret(ctx, &pb.RotateSecretRequest{
+				ProjectName:   proj.String(),
+				NamespaceName: ns.String(),
+				SecretName:    "name",
+				Value:         base64Val,
+				GracePeriod:   durationpb.New(6 * time.Hour),
+			})
+			assert.Nil(t, err)
+			assert.Equal(t, "NAME", resp.GetSecretName())
+			assert.EqualValues(t, 2, resp.GetVersion())
+			assert.EqualValues(t, 1, resp.GetPreviousVersion())
+			assert.Equal(t, readableUntil, resp.GetPreviousReadableUntil().AsTime())
+		})
+	})
+	t.Run("ListStaleSecretUsages", func(t *testing.T) {
+		t.Run("returns error when invalid secret name", func(t *testing.T) {
+			secretService := new(secretService)
+			handler := v1beta1.NewSecretsHandler(logger, secretService)
+
+			_, err := handler.ListStaleSecretUsages(ctx, &pb.ListStaleSecretUsagesRequest{ProjectName: proj.String()})
+			assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid argument for entity "+
+				"secret: secret name is empty: failed to list stale usages of secret ")
+		})
+		t.Run("lists the jobs compiled against a previous version", func(t *testing.T) {
+			compiledAt := time.Date(2023, 10, 10, 10, 0, 0, 0, time.UTC)
+			sn, err := tenant.SecretNameFrom("name")
+			assert.Nil(t, err)
+
+			secretService := new(secretService)
+			secretService.On("GetStaleUsages", ctx, proj, sn).
+				Return([]*dto.SecretUsage{{JobName: "job1", Version: 1, CompiledAt: compiledAt}}, nil)
+			defer secretService.AssertExpectations(t)
+			handler := v1beta1.NewSecretsHandler(logger, secretService)
+
+			resp, err := handler.ListStaleSecretUsages(ctx, &pb.ListStaleSecretUsagesRequest{
+				ProjectName: proj.String(),
+				SecretName:  "name",
+			})
+			assert.Nil(t, err)
+			assert.Equal(t, "NAME", resp.GetSecretName())
+			assert.Len(t, resp.GetStaleJobs(), 1)
+			assert.Equal(t, "job1", resp.GetStaleJobs()[0].GetJobName())
+			assert.EqualValues(t, 1, resp.GetStaleJobs()[0].GetVersion())
+			assert.Equal(t, compiledAt, resp.GetStaleJobs()[0].GetCompiledAt().AsTime())
+		})
+	})
 }
 
 type secretService struct {
@@ -366,3 +451,21 @@ func (s *secretService) GetSecretsInfo(ctx context.Context, projName tenant.Proj
 	}
 	return secrets, args.Error(1)
 }
+
+func (s *secretService) Rotate(ctx context.Context, projName tenant.ProjectName, nsName string, pts *tenant.PlainTextSecret, gracePeriod time.Duration) (*dto.SecretRotation, error) {
+	args := s.Called(ctx, projName, nsName, pts, gracePeriod)
+	var rotation *dto.SecretRotation
+	if args.Get(0) != nil {
+		rotation = args.Get(0).(*dto.SecretRotation)
+	}
+	return rotation, args.Error(1)
+}
+
+func (s *secretService) GetStaleUsages(ctx context.Context, projName tenant.ProjectName, name tenant.SecretName) ([]*dto.SecretUsage, error) {
+	args := s.Called(ctx, projName, name)
+	var usages []*dto.SecretUsage
+	if args.Get(0) != nil {
+		usages = args.Get(0).([]*dto.SecretUsage)
+	}
+	return usages, args.Error(1)
+}
@@ -0,0 +1,84 @@
+package v1beta1
+
+import (
+	"context"
+
+	"github.com/goto/salt/log"
+
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+	"github.com/goto/optimus/internal/logging"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+type SnippetService interface {
+	Register(ctx context.Context, projectName tenant.ProjectName, snippet *tenant.Snippet) (*tenant.Snippet, error)
+	GetAll(ctx context.Context, projectName tenant.ProjectName, name string) ([]*tenant.Snippet, error)
+}
+
+type SnippetHandler struct {
+	l       log.Logger
+	service SnippetService
+
+	pb.UnimplementedSnippetServiceServer
+}
+
+// RegisterSnippet registers a new version of the snippet, the first version of a name is 1
+func (h *SnippetHandler) RegisterSnippet(ctx context.Context, req *pb.RegisterSnippetRequest) (*pb.RegisterSnippetResponse, error) {
+	l := logging.ForTenant(h.l, req.GetProjectName(), "", "")
+	projectName, err := tenant.ProjectNameFrom(req.GetProjectName())
+	if err != nil {
+		l.Error("error adapting project name [%s]: %s", req.GetProjectName(), err)
+		return nil, errors.GRPCErr(err, "unable to register snippet "+req.GetName())
+	}
+
+	snippet, err := tenant.NewSnippet(req.GetName(), req.GetContent(), 0)
+	if err != nil {
+		l.Error("error adapting snippet [%s]: %s", req.GetName(), err)
+		return nil, errors.GRPCErr(err, "unable to register snippet "+req.GetName())
+	}
+
+	registered, err := h.service.Register(ctx, projectName, snippet)
+	if err != nil {
+		l.Error("error registering snippet [%s]: %s", req.GetName(), err)
+		return nil, errors.GRPCErr(err, "unable to register snippet "+req.GetName())
+	}
+	return &pb.RegisterSnippetResponse{Snippet: toSnippetProto(registered)}, nil
+}
+
+// ListSnippets lists the versions of the snippets of the project, or of a single snippet when the name is given
+func (h *SnippetHandler) ListSnippets(ctx context.Context, req *pb.ListSnippetsRequest) (*pb.ListSnippetsResponse, error) {
+	l := logging.ForTenant(h.l, req.GetProjectName(), "", "")
+	projectName, err := tenant.ProjectNameFrom(req.GetProjectName())
+	if err != nil {
+		l.Error("error adapting project name [%s]: %s", req.GetProjectName(), err)
+		return nil, errors.GRPCErr(err, "unable to list snippets")
+	}
+
+	snippets, err := h.service.GetAll(ctx, projectName, req.GetName())
+	if err != nil {
+		l.Error("error getting snippets of project [%s]: %s", projectName, err)
+		return nil, errors.GRPCErr(err, "unable to list snippets of "+projectName.String())
+	}
+
+	snippetsProto := make([]*pb.Snippet, len(snippets))
+	for i, snippet := range snippets {
+		snippetsProto[i] = toSnippetProto(snippet)
+	}
+	return &pb.ListSnippetsResponse{Snippets: snippetsProto}, nil
+}
+
+func toSnippetProto(snippet *tenant.Snippet) *pb.Snippet {
+	return &pb.Snippet{
+		Name:    snippet.Name(),
+		Version: int32(snippet.Version()),
+		Content: snippet.Content(),
+	}
+}
+
+func NewSnippetHandler(l log.Logger, service SnippetService) *SnippetHandler {
+	return &SnippetHandler{
+		l:       l,
+		service: service,
+	}
+}
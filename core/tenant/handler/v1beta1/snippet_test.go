@@ -0,0 +1,105 @@
+package v1beta1_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/core/tenant/handler/v1beta1"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+func TestSnippetHandler(t *testing.T) {
+	logger := log.NewNoop()
+	ctx := context.Background()
+	projectName := tenant.ProjectName("proj")
+
+	t.Run("RegisterSnippet", func(t *testing.T) {
+		t.Run("returns error when project name is empty", func(t *testing.T) {
+			handler := v1beta1.NewSnippetHandler(logger, new(snippetService))
+
+			_, err := handler.RegisterSnippet(ctx, &pb.RegisterSnippetRequest{Name: "dedup", Content: "select 1"})
+			assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid argument for entity "+
+				"project: project name is empty: unable to register snippet dedup")
+		})
+		t.Run("returns error when the snippet is invalid", func(t *testing.T) {
+			handler := v1beta1.NewSnippetHandler(logger, new(snippetService))
+
+			_, err := handler.RegisterSnippet(ctx, &pb.RegisterSnippetRequest{ProjectName: "proj", Name: "dedup"})
+			assert.ErrorContains(t, err, "code = InvalidArgument")
+			assert.ErrorContains(t, err, "snippet content is empty for dedup")
+		})
+		t.Run("returns error when unable to register the snippet", func(t *testing.T) {
+			service := new(snippetService)
+			service.On("Register", ctx, projectName, mock.Anything).Return(nil, errors.New("unknown error"))
+			defer service.AssertExpectations(t)
+			handler := v1beta1.NewSnippetHandler(logger, service)
+
+			_, err := handler.RegisterSnippet(ctx, &pb.RegisterSnippetRequest{ProjectName: "proj", Name: "dedup", Content: "select 1"})
+			assert.EqualError(t, err, "rpc error: code = Internal desc = unknown error: unable to register snippet dedup")
+		})
+		t.Run("returns the registered version of the snippet", func(t *testing.T) {
+			registered, _ := tenant.NewSnippet("dedup", "select 1", 2)
+			service := new(snippetService)
+			service.On("Register", ctx, projectName, mock.MatchedBy(func(snippet *tenant.Snippet) bool {
+				return snippet.Name() == "dedup" && snippet.Content() == "select 1"
+			})).Return(registered, nil)
+			defer service.AssertExpectations(t)
+			handler := v1beta1.NewSnippetHandler(logger, service)
+
+			resp, err := handler.RegisterSnippet(ctx, &pb.RegisterSnippetRequest{ProjectName: "proj", Name: "dedup", Content: "select 1"})
+			assert.NoError(t, err)
+			assert.Equal(t, "dedup", resp.GetSnippet().GetName())
+			assert.EqualValues(t, 2, resp.GetSnippet().GetVersion())
+		})
+	})
+	t.Run("ListSnippets", func(t *testing.T) {
+		t.Run("returns error when unable to get the snippets", func(t *testing.T) {
+			service := new(snippetService)
+			service.On("GetAll", ctx, projectName, "").Return(nil, errors.New("unknown error"))
+			defer service.AssertExpectations(t)
+			handler := v1beta1.NewSnippetHandler(logger, service)
+
+			_, err := handler.ListSnippets(ctx, &pb.ListSnippetsRequest{ProjectName: "proj"})
+			assert.EqualError(t, err, "rpc error: code = Internal desc = unknown error: unable to list snippets of proj")
+		})
+		t.Run("returns the versions of the snippet", func(t *testing.T) {
+			first, _ := tenant.NewSnippet("dedup", "select 1", 1)
+			second, _ := tenant.NewSnippet("dedup", "select 2", 2)
+			service := new(snippetService)
+			service.On("GetAll", ctx, projectName, "dedup").Return([]*tenant.Snippet{first, second}, nil)
+			defer service.AssertExpectations(t)
+			handler := v1beta1.NewSnippetHandler(logger, service)
+
+			resp, err := handler.ListSnippets(ctx, &pb.ListSnippetsRequest{ProjectName: "proj", Name: "dedup"})
+			assert.NoError(t, err)
+			assert.Len(t, resp.GetSnippets(), 2)
+			assert.Equal(t, "select 2", resp.GetSnippets()[1].GetContent())
+		})
+	})
+}
+
+type snippetService struct {
+	mock.Mock
+}
+
+func (s *snippetService) Register(ctx context.Context, projectName tenant.ProjectName, snippet *tenant.Snippet) (*tenant.Snippet, error) {
+	args := s.Called(ctx, projectName, snippet)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*tenant.Snippet), args.Error(1)
+}
+
+func (s *snippetService) GetAll(ctx context.Context, projectName tenant.ProjectName, name string) ([]*tenant.Snippet, error) {
+	args := s.Called(ctx, projectName, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*tenant.Snippet), args.Error(1)
+}
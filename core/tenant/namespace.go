@@ -1,10 +1,27 @@
 package tenant
 
 import (
+	"path"
+	"strings"
+
 	"github.com/goto/optimus/internal/errors"
 )
 
-const EntityNamespace = "namespace"
+const (
+	EntityNamespace = "namespace"
+
+	// NamespaceAssetSecretPolicy decides how secret values found in the compiled assets of job runs are handled,
+	// either redact, reject or encrypt, assets are not scanned when it is not set
+	NamespaceAssetSecretPolicy = "ASSET_SECRET_POLICY"
+
+	// NamespaceAlertRoutePrefix prefixes the configs routing the alerts of every job in the namespace,
+	// ALERT_ROUTE__<event category> holds the comma separated channels notified on it, like ALERT_ROUTE__FAILURE
+	NamespaceAlertRoutePrefix = "ALERT_ROUTE__"
+
+	// NamespaceProjectSecrets is the comma separated allow-list of the project secrets visible in the namespace,
+	// like BQ_*,API_TOKEN. Every project secret is visible when it is not set.
+	NamespaceProjectSecrets = "PROJECT_SECRETS"
+)
 
 type NamespaceName string
 
@@ -25,6 +42,9 @@ type Namespace struct {
 
 	projectName ProjectName
 	config      map[string]string
+
+	// archived namespaces keep their specs and history, but their jobs are paused and can not be deployed
+	archived bool
 }
 
 func (n *Namespace) Name() NamespaceName {
@@ -53,6 +73,35 @@ func (n *Namespace) GetConfigs() map[string]string {
 	return confs
 }
 
+func (n *Namespace) IsArchived() bool {
+	return n.archived
+}
+
+func (n *Namespace) SetArchived(archived bool) {
+	n.archived = archived
+}
+
+// AllowsSecret tells whether the secret is visible in the namespace. The secrets of the namespace and the system
+// defined ones are always visible, the rest of the project secrets are visible only when in the allow-list.
+func (n *Namespace) AllowsSecret(secret *PlainTextSecret) bool {
+	if secret.NamespaceName() != "" || secret.Name().IsSystemDefined() {
+		return true
+	}
+
+	allowList, err := n.GetConfig(NamespaceProjectSecrets)
+	if err != nil {
+		return true
+	}
+
+	for _, pattern := range strings.Split(allowList, ",") {
+		pattern = strings.ToUpper(strings.TrimSpace(pattern))
+		if matched, _ := path.Match(pattern, secret.Name().String()); matched {
+			return true
+		}
+	}
+	return false
+}
+
 func NewNamespace(name string, projName ProjectName, config map[string]string) (*Namespace, error) {
 	nsName, err := NamespaceNameFrom(name)
 	if err != nil {
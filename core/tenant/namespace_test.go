@@ -54,5 +54,41 @@ func TestEntityNamespace(t *testing.T) {
 			assert.NotNil(t, err)
 			assert.EqualError(t, err, "not found for entity namespace: namespace config not found non-existent")
 		})
+		t.Run("AllowsSecret", func(t *testing.T) {
+			newSecret := func(name, namespaceName string) *tenant.PlainTextSecret {
+				secret, err := tenant.NewPlainTextSecret(name, "value")
+				assert.Nil(t, err)
+				return secret.WithNamespaceName(namespaceName)
+			}
+
+			t.Run("allows every project secret when allow-list is not set", func(t *testing.T) {
+				ns, err := tenant.NewNamespace("t-namespace", projName, map[string]string{})
+				assert.Nil(t, err)
+
+				assert.True(t, ns.AllowsSecret(newSecret("api_token", "")))
+			})
+			t.Run("allows only the project secrets matching the allow-list", func(t *testing.T) {
+				ns, err := tenant.NewNamespace("t-namespace", projName, map[string]string{
+					tenant.NamespaceProjectSecrets: "bq_*, api_token",
+				})
+				assert.Nil(t, err)
+
+				assert.True(t, ns.AllowsSecret(newSecret("api_token", "")))
+				assert.True(t, ns.AllowsSecret(newSecret("bq_service_account", "")))
+				assert.False(t, ns.AllowsSecret(newSecret("payment_db_password", "")))
+			})
+			t.Run("allows the namespace and system defined secrets regardless of the allow-list", func(t *testing.T) {
+				ns, err := tenant.NewNamespace("t-namespace", projName, map[string]string{
+					tenant.NamespaceProjectSecrets: "",
+				})
+				assert.Nil(t, err)
+
+				assert.True(t, ns.AllowsSecret(newSecret("payment_db_password", "t-namespace")))
+				assert.True(t, ns.AllowsSecret(newSecret(tenant.SecretStorageKey, "")))
+				assert.True(t, ns.AllowsSecret(newSecret(tenant.SecretNotifyWebhookPrefix+"ops", "")))
+				assert.True(t, ns.AllowsSecret(newSecret("DATASTORE_BIGQUERY", "")))
+				assert.False(t, ns.AllowsSecret(newSecret("api_token", "")))
+			})
+		})
 	})
 }
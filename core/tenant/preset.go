@@ -10,6 +10,9 @@ type Preset struct {
 	description string
 
 	window models.Window
+
+	// version is increased every time the preset is updated
+	version int
 }
 
 func (p Preset) Name() string {
@@ -24,6 +27,16 @@ func (p Preset) Window() models.Window {
 	return p.window
 }
 
+func (p Preset) Version() int {
+	return p.version
+}
+
+// WithVersion returns a copy of the preset with the given version
+func (p Preset) WithVersion(version int) Preset {
+	p.version = version
+	return p
+}
+
 func (p Preset) Equal(incoming Preset) bool {
 	if p.name != incoming.name {
 		return false
@@ -54,7 +54,7 @@ func TestPreset(t *testing.T) {
 						offset:      "-1h",
 						size:        "24h",
 					},
-					expectedErrorMessage: "error validating truncate_to: invalid option provided, provide one of: [h d w M]",
+					expectedErrorMessage: "error validating truncate_to: invalid option provided, provide one of: [h d w M], ME or M:<nth><weekday>",
 				},
 			}
 
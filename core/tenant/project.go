@@ -13,6 +13,19 @@ const (
 	ProjectStoragePathKey   = "STORAGE_PATH"
 	ProjectSchedulerHost    = "SCHEDULER_HOST"
 	ProjectSchedulerVersion = "SCHEDULER_VERSION"
+	ProjectSchedulerType    = "SCHEDULER_TYPE"
+
+	// ProjectUpstreamAccessApproval when set to true, jobs of other projects need an approval to depend on the project jobs
+	ProjectUpstreamAccessApproval = "UPSTREAM_ACCESS_APPROVAL"
+
+	// ProjectJobTimezone is the default timezone, e.g. Asia/Jakarta, of the system defined variables given to job runs
+	ProjectJobTimezone = "JOB_TIMEZONE"
+
+	// ProjectResourceValidators lists the comma separated names of the resource spec validators enforced on deploy
+	ProjectResourceValidators = "RESOURCE_VALIDATORS"
+
+	// ProjectMacroPrefix prefixes the configs defining the macros of the project, MACRO__<name> holds the template of the macro
+	ProjectMacroPrefix = "MACRO__"
 )
 
 type ProjectName string
@@ -33,6 +46,9 @@ type Project struct {
 	config map[string]string
 
 	presets map[string]Preset
+
+	// archived projects keep their specs and history, but their jobs are paused and can not be deployed
+	archived bool
 }
 
 func (p *Project) Name() ProjectName {
@@ -57,6 +73,25 @@ func (p *Project) GetConfigs() map[string]string {
 	return confs
 }
 
+// GetMacros returns the templates of the macros defined in project configurations, keyed by the macro name
+func (p *Project) GetMacros() map[string]string {
+	macros := make(map[string]string)
+	for k, v := range p.config {
+		if name := strings.TrimPrefix(k, ProjectMacroPrefix); name != k && name != "" {
+			macros[name] = v
+		}
+	}
+	return macros
+}
+
+func (p *Project) IsArchived() bool {
+	return p.archived
+}
+
+func (p *Project) SetArchived(archived bool) {
+	p.archived = archived
+}
+
 func (p *Project) SetPresets(presets map[string]Preset) {
 	if presets == nil {
 		p.presets = make(map[string]Preset)
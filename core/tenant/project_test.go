@@ -58,5 +58,16 @@ func TestEntityProject(t *testing.T) {
 			assert.Nil(t, err)
 			assert.Equal(t, "d", val2)
 		})
+		t.Run("returns the macros defined in project configs", func(t *testing.T) {
+			project, err := tenant.NewProject("t-optimus", map[string]string{
+				tenant.ProjectSchedulerHost:  "b",
+				tenant.ProjectStoragePathKey: "d",
+				"MACRO__EVENTS_TABLE":        "{{ .proj.BQ_PROJECT }}.events",
+				"MACRO__":                    "unnamed",
+			})
+			assert.Nil(t, err)
+
+			assert.Equal(t, map[string]string{"EVENTS_TABLE": "{{ .proj.BQ_PROJECT }}.events"}, project.GetMacros())
+		})
 	})
 }
@@ -0,0 +1,122 @@
+package tenant
+
+import (
+	"strings"
+	"time"
+
+	"github.com/goto/optimus/internal/errors"
+)
+
+const EntityRoleBinding = "role_binding"
+
+// Role is the set of permissions granted to a principal on a project or on one of its namespaces,
+// every role includes the permissions of the roles below it
+type Role string
+
+const (
+	RoleViewer Role = "viewer"
+	RoleEditor Role = "editor"
+	RoleAdmin  Role = "admin"
+)
+
+var (
+	rolePermissions = map[Role][]Permission{
+		RoleViewer: {PermissionRead},
+		RoleEditor: {PermissionRead, PermissionDeploy, PermissionReplay},
+		RoleAdmin:  {PermissionRead, PermissionDeploy, PermissionReplay, PermissionAdmin},
+	}
+
+	roleRanks = map[Role]int{
+		RoleViewer: 1,
+		RoleEditor: 2,
+		RoleAdmin:  3,
+	}
+)
+
+func RoleFrom(role string) (Role, error) {
+	r := Role(strings.ToLower(role))
+	if _, ok := rolePermissions[r]; !ok {
+		return "", errors.InvalidArgument(EntityRoleBinding, "invalid role "+role+", use one of viewer, editor or admin")
+	}
+	return r, nil
+}
+
+func (r Role) String() string {
+	return string(r)
+}
+
+func (r Role) Allows(permission Permission) bool {
+	for _, allowed := range rolePermissions[r] {
+		if allowed == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// IsHigherThan tells whether the role grants more than the other role, any role is higher than no role
+func (r Role) IsHigherThan(other Role) bool {
+	return roleRanks[r] > roleRanks[other]
+}
+
+// RoleBinding grants the role to the principal on the project, or only on the namespace of the project when it is set
+type RoleBinding struct {
+	projectName   ProjectName
+	namespaceName NamespaceName
+	principal     string
+	role          Role
+
+	grantedBy string
+	createdAt time.Time
+}
+
+func NewRoleBinding(projectName ProjectName, namespaceName NamespaceName, principal string, role Role, grantedBy string, createdAt time.Time) (*RoleBinding, error) {
+	if projectName == "" {
+		return nil, errors.InvalidArgument(EntityRoleBinding, "project name is empty")
+	}
+	if principal == "" {
+		return nil, errors.InvalidArgument(EntityRoleBinding, "principal is empty")
+	}
+	if _, ok := rolePermissions[role]; !ok {
+		return nil, errors.InvalidArgument(EntityRoleBinding, "invalid role "+role.String()+" for principal "+principal)
+	}
+
+	return &RoleBinding{
+		projectName:   projectName,
+		namespaceName: namespaceName,
+		principal:     principal,
+		role:          role,
+		grantedBy:     grantedBy,
+		createdAt:     createdAt,
+	}, nil
+}
+
+func (b *RoleBinding) ProjectName() ProjectName {
+	return b.projectName
+}
+
+// NamespaceName is empty when the role is granted on the whole project
+func (b *RoleBinding) NamespaceName() NamespaceName {
+	return b.namespaceName
+}
+
+func (b *RoleBinding) Principal() string {
+	return b.principal
+}
+
+func (b *RoleBinding) Role() Role {
+	return b.role
+}
+
+func (b *RoleBinding) GrantedBy() string {
+	return b.grantedBy
+}
+
+func (b *RoleBinding) CreatedAt() time.Time {
+	return b.createdAt
+}
+
+// AppliesTo tells whether the binding grants its role on the namespace, the project wide bindings apply to every namespace
+func (b *RoleBinding) AppliesTo(namespaceName NamespaceName) bool {
+	return b.namespaceName == "" || b.namespaceName == namespaceName
+}
@@ -0,0 +1,89 @@
+package tenant_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/core/tenant"
+)
+
+func TestEntityRoleBinding(t *testing.T) {
+	createdAt := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("RoleFrom", func(t *testing.T) {
+		t.Run("returns error when role is unknown", func(t *testing.T) {
+			_, err := tenant.RoleFrom("owner")
+			assert.EqualError(t, err, "invalid argument for entity role_binding: invalid role owner, use one of viewer, editor or admin")
+		})
+		t.Run("returns the role regardless of the case", func(t *testing.T) {
+			role, err := tenant.RoleFrom("Editor")
+			assert.NoError(t, err)
+			assert.Equal(t, tenant.RoleEditor, role)
+		})
+	})
+	t.Run("Role", func(t *testing.T) {
+		t.Run("allows reading for every role", func(t *testing.T) {
+			for _, role := range []tenant.Role{tenant.RoleViewer, tenant.RoleEditor, tenant.RoleAdmin} {
+				assert.True(t, role.Allows(tenant.PermissionRead), role)
+			}
+		})
+		t.Run("allows deploying and replaying from editor", func(t *testing.T) {
+			assert.False(t, tenant.RoleViewer.Allows(tenant.PermissionDeploy))
+			assert.False(t, tenant.RoleViewer.Allows(tenant.PermissionReplay))
+			assert.True(t, tenant.RoleEditor.Allows(tenant.PermissionDeploy))
+			assert.True(t, tenant.RoleEditor.Allows(tenant.PermissionReplay))
+			assert.True(t, tenant.RoleAdmin.Allows(tenant.PermissionDeploy))
+		})
+		t.Run("allows administration only for admin", func(t *testing.T) {
+			assert.False(t, tenant.RoleViewer.Allows(tenant.PermissionAdmin))
+			assert.False(t, tenant.RoleEditor.Allows(tenant.PermissionAdmin))
+			assert.True(t, tenant.RoleAdmin.Allows(tenant.PermissionAdmin))
+		})
+		t.Run("orders the roles", func(t *testing.T) {
+			assert.True(t, tenant.RoleAdmin.IsHigherThan(tenant.RoleEditor))
+			assert.True(t, tenant.RoleEditor.IsHigherThan(tenant.RoleViewer))
+			assert.True(t, tenant.RoleViewer.IsHigherThan(""))
+			assert.False(t, tenant.RoleViewer.IsHigherThan(tenant.RoleEditor))
+			assert.False(t, tenant.RoleEditor.IsHigherThan(tenant.RoleEditor))
+		})
+	})
+	t.Run("NewRoleBinding", func(t *testing.T) {
+		t.Run("returns error when project name is empty", func(t *testing.T) {
+			_, err := tenant.NewRoleBinding("", "", "user@example.com", tenant.RoleViewer, "admin@example.com", createdAt)
+			assert.EqualError(t, err, "invalid argument for entity role_binding: project name is empty")
+		})
+		t.Run("returns error when principal is empty", func(t *testing.T) {
+			_, err := tenant.NewRoleBinding("proj", "", "", tenant.RoleViewer, "admin@example.com", createdAt)
+			assert.EqualError(t, err, "invalid argument for entity role_binding: principal is empty")
+		})
+		t.Run("returns error when role is unknown", func(t *testing.T) {
+			_, err := tenant.NewRoleBinding("proj", "", "user@example.com", "owner", "admin@example.com", createdAt)
+			assert.EqualError(t, err, "invalid argument for entity role_binding: invalid role owner for principal user@example.com")
+		})
+		t.Run("creates the role binding", func(t *testing.T) {
+			binding, err := tenant.NewRoleBinding("proj", "ns", "user@example.com", tenant.RoleEditor, "admin@example.com", createdAt)
+			assert.NoError(t, err)
+			assert.Equal(t, tenant.ProjectName("proj"), binding.ProjectName())
+			assert.Equal(t, tenant.NamespaceName("ns"), binding.NamespaceName())
+			assert.Equal(t, "user@example.com", binding.Principal())
+			assert.Equal(t, tenant.RoleEditor, binding.Role())
+			assert.Equal(t, "admin@example.com", binding.GrantedBy())
+			assert.Equal(t, createdAt, binding.CreatedAt())
+		})
+	})
+	t.Run("AppliesTo", func(t *testing.T) {
+		t.Run("applies project wide binding to every namespace", func(t *testing.T) {
+			binding, _ := tenant.NewRoleBinding("proj", "", "user@example.com", tenant.RoleViewer, "", createdAt)
+			assert.True(t, binding.AppliesTo("ns"))
+			assert.True(t, binding.AppliesTo(""))
+		})
+		t.Run("applies namespace binding only to its namespace", func(t *testing.T) {
+			binding, _ := tenant.NewRoleBinding("proj", "ns", "user@example.com", tenant.RoleViewer, "", createdAt)
+			assert.True(t, binding.AppliesTo("ns"))
+			assert.False(t, binding.AppliesTo("other-ns"))
+			assert.False(t, binding.AppliesTo(""))
+		})
+	})
+}
@@ -12,6 +12,15 @@ const (
 	SecretStorageKey    = "STORAGE"
 	SecretSchedulerAuth = "SCHEDULER_AUTH"
 	SecretNotifySlack   = "NOTIFY_SLACK"
+
+	// SecretNotifyWebhookPrefix prefixes the secrets holding the urls of the webhook channels, webhook://#name
+	// is posted to the url in NOTIFY_WEBHOOK_<name>
+	SecretNotifyWebhookPrefix = "NOTIFY_WEBHOOK_"
+
+	// secretNotifyPrefix prefixes the secrets of every notification channel
+	secretNotifyPrefix = "NOTIFY_"
+	// secretDatastorePrefix prefixes the secrets holding the credentials of the datastores, like DATASTORE_BIGQUERY
+	secretDatastorePrefix = "DATASTORE_"
 )
 
 type SecretName string
@@ -27,9 +36,18 @@ func (sn SecretName) String() string {
 	return string(sn)
 }
 
+// IsSystemDefined is true for the secrets used by optimus itself, like the storage, datastore and notification secrets
+func (sn SecretName) IsSystemDefined() bool {
+	return sn == SecretStorageKey || sn == SecretSchedulerAuth ||
+		strings.HasPrefix(sn.String(), secretNotifyPrefix) || strings.HasPrefix(sn.String(), secretDatastorePrefix)
+}
+
 type PlainTextSecret struct {
 	name  SecretName
 	value string
+
+	// namespaceName is empty for the secrets shared by every namespace of the project
+	namespaceName string
 }
 
 func NewPlainTextSecret(name, value string) (*PlainTextSecret, error) {
@@ -56,6 +74,17 @@ func (p *PlainTextSecret) Name() SecretName {
 	return p.name
 }
 
+func (p *PlainTextSecret) NamespaceName() string {
+	return p.namespaceName
+}
+
+// WithNamespaceName returns a copy of the secret scoped to the given namespace
+func (p *PlainTextSecret) WithNamespaceName(namespaceName string) *PlainTextSecret {
+	secret := *p
+	secret.namespaceName = namespaceName
+	return &secret
+}
+
 type PlainTextSecrets []*PlainTextSecret
 
 type SecretMap map[string]string
@@ -89,6 +118,9 @@ type Secret struct {
 
 	projName      ProjectName
 	namespaceName string
+
+	// version is increased every time the secret is rotated
+	version int
 }
 
 func (s *Secret) Name() SecretName {
@@ -107,6 +139,17 @@ func (s *Secret) NamespaceName() string {
 	return s.namespaceName
 }
 
+func (s *Secret) Version() int {
+	return s.version
+}
+
+// WithVersion returns a copy of the secret with the given version
+func (s *Secret) WithVersion(version int) *Secret {
+	secret := *s
+	secret.version = version
+	return &secret
+}
+
 func NewSecret(name, encodedValue string, projName ProjectName, nsName string) (*Secret, error) {
 	secretName, err := SecretNameFrom(name)
 	if err != nil {
@@ -0,0 +1,83 @@
+package tenant
+
+import (
+	"context"
+	"time"
+
+	"github.com/goto/optimus/internal/errors"
+)
+
+const (
+	// ProjectSecretProvider is the external store the secrets of the project are resolved from, on top of the
+	// secrets registered in optimus. The secrets of the provider take precedence over the registered ones.
+	ProjectSecretProvider = "SECRET_PROVIDER"
+	// ProjectSecretProviderAddress is the address of the vault server, or the id of the gcp project holding the secrets
+	ProjectSecretProviderAddress = "SECRET_PROVIDER_ADDRESS"
+	// ProjectSecretProviderPath is the path of the vault secret holding the secrets as its keys, or the prefix of
+	// the names of the gcp secrets to be resolved
+	ProjectSecretProviderPath = "SECRET_PROVIDER_PATH"
+	// ProjectSecretProviderCacheTTL is how long the resolved secrets are reused, like 10m
+	ProjectSecretProviderCacheTTL = "SECRET_PROVIDER_CACHE_TTL"
+
+	// SecretProviderToken is the registered secret holding the credential of the provider, the vault token
+	// or the gcp service account key. The default gcp credentials of the server are used when it is not registered.
+	SecretProviderToken = "SECRET_PROVIDER_TOKEN"
+
+	SecretProviderVault            = "vault"
+	SecretProviderGCPSecretManager = "gcp_secret_manager"
+
+	DefaultSecretProviderCacheTTL = 5 * time.Minute
+)
+
+// SecretProvider resolves the secrets from a secret store outside of optimus
+type SecretProvider interface {
+	GetSecrets(ctx context.Context, config SecretProviderConfig) (PlainTextSecrets, error)
+}
+
+type SecretProviderConfig struct {
+	Type     string
+	Address  string
+	Path     string
+	CacheTTL time.Duration
+
+	// Token is the credential of the provider, taken from the registered secrets of the project
+	Token string
+}
+
+// SecretProviderConfigFrom returns the secret provider configured for the project, or nil when the project resolves
+// its secrets from the registered secrets only
+func SecretProviderConfigFrom(project *Project) (*SecretProviderConfig, error) {
+	providerType, err := project.GetConfig(ProjectSecretProvider)
+	if err != nil || providerType == "" {
+		return nil, nil //nolint:nilnil
+	}
+
+	if providerType != SecretProviderVault && providerType != SecretProviderGCPSecretManager {
+		return nil, errors.InvalidArgument(EntitySecret, "unknown secret provider "+providerType)
+	}
+
+	address, _ := project.GetConfig(ProjectSecretProviderAddress)
+	if address == "" {
+		return nil, errors.InvalidArgument(EntitySecret, "secret provider address is not configured for project "+project.Name().String())
+	}
+
+	path, _ := project.GetConfig(ProjectSecretProviderPath)
+	if path == "" && providerType == SecretProviderVault {
+		return nil, errors.InvalidArgument(EntitySecret, "vault secret path is not configured for project "+project.Name().String())
+	}
+
+	cacheTTL := DefaultSecretProviderCacheTTL
+	if ttl, _ := project.GetConfig(ProjectSecretProviderCacheTTL); ttl != "" {
+		cacheTTL, err = time.ParseDuration(ttl)
+		if err != nil || cacheTTL < 0 {
+			return nil, errors.InvalidArgument(EntitySecret, "invalid secret provider cache ttl "+ttl)
+		}
+	}
+
+	return &SecretProviderConfig{
+		Type:     providerType,
+		Address:  address,
+		Path:     path,
+		CacheTTL: cacheTTL,
+	}, nil
+}
@@ -0,0 +1,77 @@
+package tenant_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/core/tenant"
+)
+
+func TestSecretProviderConfig(t *testing.T) {
+	newProject := func(conf map[string]string) *tenant.Project {
+		conf[tenant.ProjectStoragePathKey] = "gs://location"
+		conf[tenant.ProjectSchedulerHost] = "host"
+		project, err := tenant.NewProject("test-project", conf)
+		assert.Nil(t, err)
+		return project
+	}
+
+	t.Run("returns nil when secret provider is not configured", func(t *testing.T) {
+		config, err := tenant.SecretProviderConfigFrom(newProject(map[string]string{}))
+		assert.Nil(t, err)
+		assert.Nil(t, config)
+	})
+	t.Run("returns error when secret provider is unknown", func(t *testing.T) {
+		_, err := tenant.SecretProviderConfigFrom(newProject(map[string]string{
+			tenant.ProjectSecretProvider: "aws",
+		}))
+		assert.EqualError(t, err, "invalid argument for entity secret: unknown secret provider aws")
+	})
+	t.Run("returns error when address is not configured", func(t *testing.T) {
+		_, err := tenant.SecretProviderConfigFrom(newProject(map[string]string{
+			tenant.ProjectSecretProvider: tenant.SecretProviderGCPSecretManager,
+		}))
+		assert.EqualError(t, err, "invalid argument for entity secret: secret provider address is not configured for project test-project")
+	})
+	t.Run("returns error when vault path is not configured", func(t *testing.T) {
+		_, err := tenant.SecretProviderConfigFrom(newProject(map[string]string{
+			tenant.ProjectSecretProvider:        tenant.SecretProviderVault,
+			tenant.ProjectSecretProviderAddress: "https://vault.example.com",
+		}))
+		assert.EqualError(t, err, "invalid argument for entity secret: vault secret path is not configured for project test-project")
+	})
+	t.Run("returns error when cache ttl is invalid", func(t *testing.T) {
+		_, err := tenant.SecretProviderConfigFrom(newProject(map[string]string{
+			tenant.ProjectSecretProvider:         tenant.SecretProviderGCPSecretManager,
+			tenant.ProjectSecretProviderAddress:  "gcp-project",
+			tenant.ProjectSecretProviderCacheTTL: "ten minutes",
+		}))
+		assert.EqualError(t, err, "invalid argument for entity secret: invalid secret provider cache ttl ten minutes")
+	})
+	t.Run("returns the config with the default cache ttl", func(t *testing.T) {
+		config, err := tenant.SecretProviderConfigFrom(newProject(map[string]string{
+			tenant.ProjectSecretProvider:        tenant.SecretProviderVault,
+			tenant.ProjectSecretProviderAddress: "https://vault.example.com",
+			tenant.ProjectSecretProviderPath:    "secret/data/optimus",
+		}))
+		assert.Nil(t, err)
+		assert.Equal(t, &tenant.SecretProviderConfig{
+			Type:     tenant.SecretProviderVault,
+			Address:  "https://vault.example.com",
+			Path:     "secret/data/optimus",
+			CacheTTL: tenant.DefaultSecretProviderCacheTTL,
+		}, config)
+	})
+	t.Run("returns the config with the configured cache ttl", func(t *testing.T) {
+		config, err := tenant.SecretProviderConfigFrom(newProject(map[string]string{
+			tenant.ProjectSecretProvider:         tenant.SecretProviderGCPSecretManager,
+			tenant.ProjectSecretProviderAddress:  "gcp-project",
+			tenant.ProjectSecretProviderCacheTTL: "10m",
+		}))
+		assert.Nil(t, err)
+		assert.Equal(t, 10*time.Minute, config.CacheTTL)
+		assert.Equal(t, "", config.Path)
+	})
+}
@@ -56,5 +56,16 @@ func TestEntitySecret(t *testing.T) {
 			assert.Equal(t, projName.String(), s.ProjectName().String())
 			assert.Equal(t, nsName, s.NamespaceName())
 		})
+		t.Run("returns a copy of the secret with the version", func(t *testing.T) {
+			projName, _ := tenant.ProjectNameFrom("test-project")
+
+			s, err := tenant.NewSecret("name", "encoded==", projName, "")
+			assert.Nil(t, err)
+
+			versioned := s.WithVersion(3)
+			assert.Equal(t, 3, versioned.Version())
+			assert.Equal(t, "NAME", versioned.Name().String())
+			assert.Equal(t, 0, s.Version())
+		})
 	})
 }
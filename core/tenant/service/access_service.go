@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/goto/salt/log"
+
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/logging"
+)
+
+type RoleBindingRepository interface {
+	Upsert(ctx context.Context, binding *tenant.RoleBinding) error
+	Delete(ctx context.Context, projectName tenant.ProjectName, namespaceName tenant.NamespaceName, principal string) error
+	GetAll(ctx context.Context, projectName tenant.ProjectName) ([]*tenant.RoleBinding, error)
+	GetByPrincipal(ctx context.Context, projectName tenant.ProjectName, principal string) ([]*tenant.RoleBinding, error)
+}
+
+// AccessService manages the roles bound to the principals, and authorizes the principals with them when
+// the role based access is enabled. The admins are granted the admin role on every project.
+type AccessService struct {
+	repo RoleBindingRepository
+
+	enabled bool
+	admins  map[string]struct{}
+
+	logger log.Logger
+}
+
+// Grant binds the role to the principal, replacing the role it had on the same project or namespace
+func (s AccessService) Grant(ctx context.Context, projectName tenant.ProjectName, namespaceName tenant.NamespaceName, principal string, role tenant.Role, grantedBy string) (*tenant.RoleBinding, error) {
+	l := logging.ForTenant(s.logger, projectName.String(), namespaceName.String(), "")
+	binding, err := tenant.NewRoleBinding(projectName, namespaceName, principal, role, grantedBy, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Upsert(ctx, binding); err != nil {
+		l.Error("error granting role [%s] to [%s] on project [%s] namespace [%s]: %s", role, principal, projectName, namespaceName, err)
+		return nil, err
+	}
+	return binding, nil
+}
+
+func (s AccessService) Revoke(ctx context.Context, projectName tenant.ProjectName, namespaceName tenant.NamespaceName, principal string) error {
+	l := logging.ForTenant(s.logger, projectName.String(), namespaceName.String(), "")
+	if err := s.repo.Delete(ctx, projectName, namespaceName, principal); err != nil {
+		l.Error("error revoking role of [%s] on project [%s] namespace [%s]: %s", principal, projectName, namespaceName, err)
+		return err
+	}
+	return nil
+}
+
+func (s AccessService) GetAll(ctx context.Context, projectName tenant.ProjectName) ([]*tenant.RoleBinding, error) {
+	l := logging.ForTenant(s.logger, projectName.String(), "", "")
+	bindings, err := s.repo.GetAll(ctx, projectName)
+	if err != nil {
+		l.Error("error getting role bindings of project [%s]: %s", projectName, err)
+		return nil, err
+	}
+	return bindings, nil
+}
+
+// RoleOf returns the highest role of the principal on the namespace, counting the roles granted on the whole project.
+// The role is empty when the principal has no role there.
+func (s AccessService) RoleOf(ctx context.Context, principal string, projectName tenant.ProjectName, namespaceName tenant.NamespaceName) (tenant.Role, error) {
+	l := logging.ForTenant(s.logger, projectName.String(), namespaceName.String(), "")
+	if _, ok := s.admins[principal]; ok {
+		return tenant.RoleAdmin, nil
+	}
+
+	bindings, err := s.repo.GetByPrincipal(ctx, projectName, principal)
+	if err != nil {
+		l.Error("error getting role bindings of [%s] on project [%s]: %s", principal, projectName, err)
+		return "", err
+	}
+
+	var role tenant.Role
+	for _, binding := range bindings {
+		if binding.AppliesTo(namespaceName) && binding.Role().IsHigherThan(role) {
+			role = binding.Role()
+		}
+	}
+	return role, nil
+}
+
+// Authorize tells whether the principal has the permission on the namespace of the project, every principal
+// is allowed when the role based access is not enabled
+func (s AccessService) Authorize(ctx context.Context, principal string, projectName tenant.ProjectName, namespaceName tenant.NamespaceName, permission tenant.Permission) (bool, error) {
+	if !s.enabled {
+		return true, nil
+	}
+	return s.authorize(ctx, principal, projectName, namespaceName, permission)
+}
+
+// AuthorizeAdmin tells whether the principal is an admin of the namespace of the project. The roles are checked even
+// when the role based access is not enabled, as the roles granted before it is enabled take effect once it is.
+func (s AccessService) AuthorizeAdmin(ctx context.Context, principal string, projectName tenant.ProjectName, namespaceName tenant.NamespaceName) (bool, error) {
+	return s.authorize(ctx, principal, projectName, namespaceName, tenant.PermissionAdmin)
+}
+
+// AuthorizeServer tells whether the principal can manage the server itself, which is left to the admins once the
+// role based access is enabled
+func (s AccessService) AuthorizeServer(principal string) bool {
+	if !s.enabled {
+		return true
+	}
+	_, ok := s.admins[principal]
+	return ok
+}
+
+func (s AccessService) authorize(ctx context.Context, principal string, projectName tenant.ProjectName, namespaceName tenant.NamespaceName, permission tenant.Permission) (bool, error) {
+	if principal == "" {
+		return false, nil
+	}
+
+	role, err := s.RoleOf(ctx, principal, projectName, namespaceName)
+	if err != nil {
+		return false, err
+	}
+	return role.Allows(permission), nil
+}
+
+func NewAccessService(repo RoleBindingRepository, enabled bool, admins []string, logger log.Logger) *AccessService {
+	adminSet := make(map[string]struct{}, len(admins))
+	for _, admin := range admins {
+		adminSet[admin] = struct{}{}
+	}
+
+	return &AccessService{
+		repo:    repo,
+		enabled: enabled,
+		admins:  adminSet,
+		logger:  logger,
+	}
+}
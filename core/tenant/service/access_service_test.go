@@ -0,0 +1,215 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/core/tenant/service"
+)
+
+func TestAccessService(t *testing.T) {
+	ctx := context.Background()
+	projectName, _ := tenant.ProjectNameFrom("test-project")
+	namespaceName, _ := tenant.NamespaceNameFrom("test-ns")
+	logger := log.NewNoop()
+
+	now := time.Now()
+	projectViewer, _ := tenant.NewRoleBinding(projectName, "", "user@example.com", tenant.RoleViewer, "admin@example.com", now)
+	namespaceEditor, _ := tenant.NewRoleBinding(projectName, namespaceName, "user@example.com", tenant.RoleEditor, "admin@example.com", now)
+
+	t.Run("Grant", func(t *testing.T) {
+		t.Run("returns error when role is invalid", func(t *testing.T) {
+			accessService := service.NewAccessService(new(roleBindingRepo), true, nil, logger)
+
+			_, err := accessService.Grant(ctx, projectName, "", "user@example.com", "owner", "admin@example.com")
+			assert.ErrorContains(t, err, "invalid role owner")
+		})
+		t.Run("returns error when repo returns error", func(t *testing.T) {
+			repo := new(roleBindingRepo)
+			repo.On("Upsert", ctx, mock.Anything).Return(errors.New("error in upsert"))
+			defer repo.AssertExpectations(t)
+
+			accessService := service.NewAccessService(repo, true, nil, logger)
+			_, err := accessService.Grant(ctx, projectName, "", "user@example.com", tenant.RoleViewer, "admin@example.com")
+			assert.EqualError(t, err, "error in upsert")
+		})
+		t.Run("stores the role binding", func(t *testing.T) {
+			repo := new(roleBindingRepo)
+			repo.On("Upsert", ctx, mock.Anything).Return(nil)
+			defer repo.AssertExpectations(t)
+
+			accessService := service.NewAccessService(repo, true, nil, logger)
+			granted, err := accessService.Grant(ctx, projectName, namespaceName, "user@example.com", tenant.RoleEditor, "admin@example.com")
+			assert.NoError(t, err)
+			assert.Equal(t, namespaceName, granted.NamespaceName())
+			assert.Equal(t, tenant.RoleEditor, granted.Role())
+			assert.Equal(t, "admin@example.com", granted.GrantedBy())
+
+			stored := repo.Calls[0].Arguments.Get(1).(*tenant.RoleBinding)
+			assert.Equal(t, granted, stored)
+		})
+	})
+	t.Run("Revoke", func(t *testing.T) {
+		t.Run("returns error when repo returns error", func(t *testing.T) {
+			repo := new(roleBindingRepo)
+			repo.On("Delete", ctx, projectName, namespaceName, "user@example.com").Return(errors.New("error in delete"))
+			defer repo.AssertExpectations(t)
+
+			accessService := service.NewAccessService(repo, true, nil, logger)
+			err := accessService.Revoke(ctx, projectName, namespaceName, "user@example.com")
+			assert.EqualError(t, err, "error in delete")
+		})
+	})
+	t.Run("RoleOf", func(t *testing.T) {
+		t.Run("returns admin for the admins without reading the bindings", func(t *testing.T) {
+			accessService := service.NewAccessService(new(roleBindingRepo), true, []string{"admin@example.com"}, logger)
+
+			role, err := accessService.RoleOf(ctx, "admin@example.com", projectName, namespaceName)
+			assert.NoError(t, err)
+			assert.Equal(t, tenant.RoleAdmin, role)
+		})
+		t.Run("returns error when repo returns error", func(t *testing.T) {
+			repo := new(roleBindingRepo)
+			repo.On("GetByPrincipal", ctx, projectName, "user@example.com").Return(nil, errors.New("error in get"))
+			defer repo.AssertExpectations(t)
+
+			accessService := service.NewAccessService(repo, true, nil, logger)
+			_, err := accessService.RoleOf(ctx, "user@example.com", projectName, namespaceName)
+			assert.EqualError(t, err, "error in get")
+		})
+		t.Run("returns the highest role applying to the namespace", func(t *testing.T) {
+			repo := new(roleBindingRepo)
+			repo.On("GetByPrincipal", ctx, projectName, "user@example.com").Return([]*tenant.RoleBinding{projectViewer, namespaceEditor}, nil)
+			defer repo.AssertExpectations(t)
+
+			accessService := service.NewAccessService(repo, true, nil, logger)
+			role, err := accessService.RoleOf(ctx, "user@example.com", projectName, namespaceName)
+			assert.NoError(t, err)
+			assert.Equal(t, tenant.RoleEditor, role)
+		})
+		t.Run("returns the project role for other namespaces", func(t *testing.T) {
+			repo := new(roleBindingRepo)
+			repo.On("GetByPrincipal", ctx, projectName, "user@example.com").Return([]*tenant.RoleBinding{projectViewer, namespaceEditor}, nil)
+			defer repo.AssertExpectations(t)
+
+			accessService := service.NewAccessService(repo, true, nil, logger)
+			role, err := accessService.RoleOf(ctx, "user@example.com", projectName, "other-ns")
+			assert.NoError(t, err)
+			assert.Equal(t, tenant.RoleViewer, role)
+		})
+		t.Run("returns empty role when principal has no binding", func(t *testing.T) {
+			repo := new(roleBindingRepo)
+			repo.On("GetByPrincipal", ctx, projectName, "user@example.com").Return([]*tenant.RoleBinding{namespaceEditor}, nil)
+			defer repo.AssertExpectations(t)
+
+			accessService := service.NewAccessService(repo, true, nil, logger)
+			role, err := accessService.RoleOf(ctx, "user@example.com", projectName, "")
+			assert.NoError(t, err)
+			assert.Empty(t, role)
+		})
+	})
+	t.Run("Authorize", func(t *testing.T) {
+		t.Run("allows everyone when not enabled", func(t *testing.T) {
+			accessService := service.NewAccessService(new(roleBindingRepo), false, nil, logger)
+
+			allowed, err := accessService.Authorize(ctx, "", projectName, namespaceName, tenant.PermissionAdmin)
+			assert.NoError(t, err)
+			assert.True(t, allowed)
+		})
+		t.Run("denies the requests without principal", func(t *testing.T) {
+			accessService := service.NewAccessService(new(roleBindingRepo), true, nil, logger)
+
+			allowed, err := accessService.Authorize(ctx, "", projectName, namespaceName, tenant.PermissionRead)
+			assert.NoError(t, err)
+			assert.False(t, allowed)
+		})
+		t.Run("checks the permission against the role", func(t *testing.T) {
+			repo := new(roleBindingRepo)
+			repo.On("GetByPrincipal", ctx, projectName, "user@example.com").Return([]*tenant.RoleBinding{namespaceEditor}, nil)
+			defer repo.AssertExpectations(t)
+
+			accessService := service.NewAccessService(repo, true, nil, logger)
+			allowed, err := accessService.Authorize(ctx, "user@example.com", projectName, namespaceName, tenant.PermissionDeploy)
+			assert.NoError(t, err)
+			assert.True(t, allowed)
+
+			allowed, err = accessService.Authorize(ctx, "user@example.com", projectName, namespaceName, tenant.PermissionAdmin)
+			assert.NoError(t, err)
+			assert.False(t, allowed)
+		})
+	})
+	t.Run("AuthorizeServer", func(t *testing.T) {
+		t.Run("allows everyone when not enabled", func(t *testing.T) {
+			accessService := service.NewAccessService(new(roleBindingRepo), false, nil, logger)
+
+			assert.True(t, accessService.AuthorizeServer("user@example.com"))
+		})
+		t.Run("allows only the admins when enabled", func(t *testing.T) {
+			accessService := service.NewAccessService(new(roleBindingRepo), true, []string{"admin@example.com"}, logger)
+
+			assert.True(t, accessService.AuthorizeServer("admin@example.com"))
+			assert.False(t, accessService.AuthorizeServer("user@example.com"))
+		})
+	})
+	t.Run("AuthorizeAdmin", func(t *testing.T) {
+		t.Run("denies the requests without principal even when not enabled", func(t *testing.T) {
+			accessService := service.NewAccessService(new(roleBindingRepo), false, nil, logger)
+
+			allowed, err := accessService.AuthorizeAdmin(ctx, "", projectName, namespaceName)
+			assert.NoError(t, err)
+			assert.False(t, allowed)
+		})
+		t.Run("checks the role even when not enabled", func(t *testing.T) {
+			repo := new(roleBindingRepo)
+			repo.On("GetByPrincipal", ctx, projectName, "user@example.com").Return([]*tenant.RoleBinding{namespaceEditor}, nil)
+			defer repo.AssertExpectations(t)
+
+			accessService := service.NewAccessService(repo, false, nil, logger)
+			allowed, err := accessService.AuthorizeAdmin(ctx, "user@example.com", projectName, namespaceName)
+			assert.NoError(t, err)
+			assert.False(t, allowed)
+		})
+		t.Run("allows the configured admins", func(t *testing.T) {
+			accessService := service.NewAccessService(new(roleBindingRepo), false, []string{"admin@example.com"}, logger)
+
+			allowed, err := accessService.AuthorizeAdmin(ctx, "admin@example.com", projectName, namespaceName)
+			assert.NoError(t, err)
+			assert.True(t, allowed)
+		})
+	})
+}
+
+type roleBindingRepo struct {
+	mock.Mock
+}
+
+func (r *roleBindingRepo) Upsert(ctx context.Context, binding *tenant.RoleBinding) error {
+	return r.Called(ctx, binding).Error(0)
+}
+
+func (r *roleBindingRepo) Delete(ctx context.Context, projectName tenant.ProjectName, namespaceName tenant.NamespaceName, principal string) error {
+	return r.Called(ctx, projectName, namespaceName, principal).Error(0)
+}
+
+func (r *roleBindingRepo) GetAll(ctx context.Context, projectName tenant.ProjectName) ([]*tenant.RoleBinding, error) {
+	args := r.Called(ctx, projectName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*tenant.RoleBinding), args.Error(1)
+}
+
+func (r *roleBindingRepo) GetByPrincipal(ctx context.Context, projectName tenant.ProjectName, principal string) ([]*tenant.RoleBinding, error) {
+	args := r.Called(ctx, projectName, principal)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*tenant.RoleBinding), args.Error(1)
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/goto/salt/log"
+
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+	"github.com/goto/optimus/internal/logging"
+)
+
+const apiTokenBytes = 32
+
+type APITokenRepository interface {
+	Create(ctx context.Context, token *tenant.APIToken) error
+	UpdateHash(ctx context.Context, projectName tenant.ProjectName, name, hash string) error
+	Delete(ctx context.Context, projectName tenant.ProjectName, name string) error
+	GetByHash(ctx context.Context, hash string) (*tenant.APIToken, error)
+	GetAll(ctx context.Context, projectName tenant.ProjectName) ([]*tenant.APIToken, error)
+}
+
+type APITokenService struct {
+	repo APITokenRepository
+
+	logger log.Logger
+}
+
+// Create stores a new api token of the project, the returned token is not stored and can not be read again
+func (s APITokenService) Create(ctx context.Context, projectName tenant.ProjectName, name string, scope tenant.TokenScope) (*tenant.APIToken, string, error) {
+	l := logging.ForTenant(s.logger, projectName.String(), "", "")
+	secret, err := generateAPIToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	now := time.Now().UTC()
+	apiToken, err := tenant.NewAPIToken(projectName, name, scope, tenant.HashAPIToken(secret), now, now)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if err := s.repo.Create(ctx, apiToken); err != nil {
+		l.Error("error creating api token [%s] of project [%s]: %s", name, projectName, err)
+		return nil, "", err
+	}
+	return apiToken, secret, nil
+}
+
+// Rotate replaces the token of the api token, the previous token stops working right away
+func (s APITokenService) Rotate(ctx context.Context, projectName tenant.ProjectName, name string) (string, error) {
+	l := logging.ForTenant(s.logger, projectName.String(), "", "")
+	secret, err := generateAPIToken()
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.repo.UpdateHash(ctx, projectName, name, tenant.HashAPIToken(secret)); err != nil {
+		l.Error("error rotating api token [%s] of project [%s]: %s", name, projectName, err)
+		return "", err
+	}
+	return secret, nil
+}
+
+func (s APITokenService) Revoke(ctx context.Context, projectName tenant.ProjectName, name string) error {
+	l := logging.ForTenant(s.logger, projectName.String(), "", "")
+	if err := s.repo.Delete(ctx, projectName, name); err != nil {
+		l.Error("error revoking api token [%s] of project [%s]: %s", name, projectName, err)
+		return err
+	}
+	return nil
+}
+
+func (s APITokenService) GetAll(ctx context.Context, projectName tenant.ProjectName) ([]*tenant.APIToken, error) {
+	l := logging.ForTenant(s.logger, projectName.String(), "", "")
+	tokens, err := s.repo.GetAll(ctx, projectName)
+	if err != nil {
+		l.Error("error getting api tokens of project [%s]: %s", projectName, err)
+		return nil, err
+	}
+	return tokens, nil
+}
+
+// Authenticate returns the api token of the given token, it returns not found for an unknown, rotated or revoked token
+func (s APITokenService) Authenticate(ctx context.Context, token string) (*tenant.APIToken, error) {
+	if !strings.HasPrefix(token, tenant.APITokenPrefix) {
+		return nil, errors.InvalidArgument(tenant.EntityAPIToken, "token is not an api token")
+	}
+	return s.repo.GetByHash(ctx, tenant.HashAPIToken(token))
+}
+
+func generateAPIToken() (string, error) {
+	raw := make([]byte, apiTokenBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return "", errors.InternalError(tenant.EntityAPIToken, "unable to generate api token", err)
+	}
+	return tenant.APITokenPrefix + hex.EncodeToString(raw), nil
+}
+
+func NewAPITokenService(repo APITokenRepository, logger log.Logger) *APITokenService {
+	return &APITokenService{
+		repo:   repo,
+		logger: logger,
+	}
+}
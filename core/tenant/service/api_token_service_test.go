@@ -0,0 +1,141 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/core/tenant/service"
+)
+
+func TestAPITokenService(t *testing.T) {
+	ctx := context.Background()
+	projectName, _ := tenant.ProjectNameFrom("test-project")
+	logger := log.NewNoop()
+
+	now := time.Now()
+	deployToken, _ := tenant.NewAPIToken(projectName, "ci-deploy", tenant.TokenScopeDeploy, tenant.HashAPIToken("optimus_deploy"), now, now)
+
+	t.Run("Create", func(t *testing.T) {
+		t.Run("returns error when name is invalid", func(t *testing.T) {
+			tokenService := service.NewAPITokenService(new(apiTokenRepo), logger)
+
+			_, _, err := tokenService.Create(ctx, projectName, "ci deploy", tenant.TokenScopeDeploy)
+			assert.ErrorContains(t, err, "api token name ci deploy can only contain")
+		})
+		t.Run("returns error when repo returns error", func(t *testing.T) {
+			repo := new(apiTokenRepo)
+			repo.On("Create", ctx, mock.Anything).Return(errors.New("error in create"))
+			defer repo.AssertExpectations(t)
+
+			tokenService := service.NewAPITokenService(repo, logger)
+			_, _, err := tokenService.Create(ctx, projectName, "ci-deploy", tenant.TokenScopeDeploy)
+			assert.EqualError(t, err, "error in create")
+		})
+		t.Run("stores the hash of the returned token", func(t *testing.T) {
+			repo := new(apiTokenRepo)
+			repo.On("Create", ctx, mock.Anything).Return(nil)
+			defer repo.AssertExpectations(t)
+
+			tokenService := service.NewAPITokenService(repo, logger)
+			created, secret, err := tokenService.Create(ctx, projectName, "ci-deploy", tenant.TokenScopeDeploy)
+			assert.NoError(t, err)
+			assert.True(t, strings.HasPrefix(secret, tenant.APITokenPrefix))
+			assert.Equal(t, tenant.HashAPIToken(secret), created.Hash())
+			assert.Equal(t, tenant.TokenScopeDeploy, created.Scope())
+			assert.Equal(t, projectName, created.ProjectName())
+
+			stored := repo.Calls[0].Arguments.Get(1).(*tenant.APIToken)
+			assert.Equal(t, created, stored)
+		})
+	})
+	t.Run("Rotate", func(t *testing.T) {
+		t.Run("returns error when repo returns error", func(t *testing.T) {
+			repo := new(apiTokenRepo)
+			repo.On("UpdateHash", ctx, projectName, "ci-deploy", mock.Anything).Return(errors.New("error in update"))
+			defer repo.AssertExpectations(t)
+
+			tokenService := service.NewAPITokenService(repo, logger)
+			_, err := tokenService.Rotate(ctx, projectName, "ci-deploy")
+			assert.EqualError(t, err, "error in update")
+		})
+		t.Run("stores the hash of the new token", func(t *testing.T) {
+			repo := new(apiTokenRepo)
+			repo.On("UpdateHash", ctx, projectName, "ci-deploy", mock.Anything).Return(nil)
+			defer repo.AssertExpectations(t)
+
+			tokenService := service.NewAPITokenService(repo, logger)
+			secret, err := tokenService.Rotate(ctx, projectName, "ci-deploy")
+			assert.NoError(t, err)
+			assert.Equal(t, tenant.HashAPIToken(secret), repo.Calls[0].Arguments.String(3))
+		})
+	})
+	t.Run("Revoke", func(t *testing.T) {
+		t.Run("deletes the api token", func(t *testing.T) {
+			repo := new(apiTokenRepo)
+			repo.On("Delete", ctx, projectName, "ci-deploy").Return(nil)
+			defer repo.AssertExpectations(t)
+
+			tokenService := service.NewAPITokenService(repo, logger)
+			err := tokenService.Revoke(ctx, projectName, "ci-deploy")
+			assert.NoError(t, err)
+		})
+	})
+	t.Run("Authenticate", func(t *testing.T) {
+		t.Run("returns error when token is not an api token", func(t *testing.T) {
+			tokenService := service.NewAPITokenService(new(apiTokenRepo), logger)
+
+			_, err := tokenService.Authenticate(ctx, "user-access-token")
+			assert.EqualError(t, err, "invalid argument for entity api_token: token is not an api token")
+		})
+		t.Run("returns the api token of the hash", func(t *testing.T) {
+			repo := new(apiTokenRepo)
+			repo.On("GetByHash", ctx, tenant.HashAPIToken("optimus_deploy")).Return(deployToken, nil)
+			defer repo.AssertExpectations(t)
+
+			tokenService := service.NewAPITokenService(repo, logger)
+			authenticated, err := tokenService.Authenticate(ctx, "optimus_deploy")
+			assert.NoError(t, err)
+			assert.Equal(t, deployToken, authenticated)
+		})
+	})
+}
+
+type apiTokenRepo struct {
+	mock.Mock
+}
+
+func (a *apiTokenRepo) Create(ctx context.Context, token *tenant.APIToken) error {
+	return a.Called(ctx, token).Error(0)
+}
+
+func (a *apiTokenRepo) UpdateHash(ctx context.Context, projectName tenant.ProjectName, name, hash string) error {
+	return a.Called(ctx, projectName, name, hash).Error(0)
+}
+
+func (a *apiTokenRepo) Delete(ctx context.Context, projectName tenant.ProjectName, name string) error {
+	return a.Called(ctx, projectName, name).Error(0)
+}
+
+func (a *apiTokenRepo) GetByHash(ctx context.Context, hash string) (*tenant.APIToken, error) {
+	args := a.Called(ctx, hash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*tenant.APIToken), args.Error(1)
+}
+
+func (a *apiTokenRepo) GetAll(ctx context.Context, projectName tenant.ProjectName) ([]*tenant.APIToken, error) {
+	args := a.Called(ctx, projectName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*tenant.APIToken), args.Error(1)
+}
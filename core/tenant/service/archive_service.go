@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+
+	"github.com/goto/salt/log"
+
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+	"github.com/goto/optimus/internal/logging"
+)
+
+type ProjectArchiveRepository interface {
+	GetByName(context.Context, tenant.ProjectName) (*tenant.Project, error)
+	SetArchived(ctx context.Context, name tenant.ProjectName, archived bool) error
+}
+
+type NamespaceArchiveRepository interface {
+	GetAll(context.Context, tenant.ProjectName) ([]*tenant.Namespace, error)
+	SetArchived(ctx context.Context, projectName tenant.ProjectName, name tenant.NamespaceName, archived bool) error
+}
+
+// TenantJobScheduler pauses and resumes the enabled jobs of a tenant on the scheduler
+type TenantJobScheduler interface {
+	PauseTenantJobs(ctx context.Context, tnnt tenant.Tenant) error
+	ResumeTenantJobs(ctx context.Context, tnnt tenant.Tenant) error
+}
+
+// ArchiveService archives the projects and namespaces being decommissioned. Their specs and history are kept while
+// their jobs are paused, and the deployments and replays on them are rejected until they are unarchived.
+type ArchiveService struct {
+	projectRepo   ProjectArchiveRepository
+	namespaceRepo NamespaceArchiveRepository
+	jobScheduler  TenantJobScheduler
+
+	logger log.Logger
+}
+
+func (s ArchiveService) ArchiveProject(ctx context.Context, projectName tenant.ProjectName) error {
+	l := logging.ForTenant(s.logger, projectName.String(), "", "")
+	namespaces, err := s.namespaceRepo.GetAll(ctx, projectName)
+	if err != nil {
+		l.Error("error getting namespaces of project [%s]: %s", projectName, err)
+		return err
+	}
+
+	if err := s.projectRepo.SetArchived(ctx, projectName, true); err != nil {
+		l.Error("error archiving project [%s]: %s", projectName, err)
+		return err
+	}
+
+	me := errors.NewMultiError("errors on pausing the jobs of project " + projectName.String())
+	for _, namespace := range namespaces {
+		me.Append(s.pauseJobs(ctx, projectName, namespace.Name()))
+	}
+	return me.ToErr()
+}
+
+func (s ArchiveService) UnarchiveProject(ctx context.Context, projectName tenant.ProjectName) error {
+	l := logging.ForTenant(s.logger, projectName.String(), "", "")
+	namespaces, err := s.namespaceRepo.GetAll(ctx, projectName)
+	if err != nil {
+		l.Error("error getting namespaces of project [%s]: %s", projectName, err)
+		return err
+	}
+
+	if err := s.projectRepo.SetArchived(ctx, projectName, false); err != nil {
+		l.Error("error unarchiving project [%s]: %s", projectName, err)
+		return err
+	}
+
+	me := errors.NewMultiError("errors on resuming the jobs of project " + projectName.String())
+	for _, namespace := range namespaces {
+		// the jobs of the namespaces archived on their own stay paused
+		if namespace.IsArchived() {
+			continue
+		}
+		me.Append(s.resumeJobs(ctx, projectName, namespace.Name()))
+	}
+	return me.ToErr()
+}
+
+func (s ArchiveService) ArchiveNamespace(ctx context.Context, projectName tenant.ProjectName, namespaceName tenant.NamespaceName) error {
+	l := logging.ForTenant(s.logger, projectName.String(), namespaceName.String(), "")
+	if err := s.namespaceRepo.SetArchived(ctx, projectName, namespaceName, true); err != nil {
+		l.Error("error archiving namespace [%s]: %s", namespaceName, err)
+		return err
+	}
+	return s.pauseJobs(ctx, projectName, namespaceName)
+}
+
+func (s ArchiveService) UnarchiveNamespace(ctx context.Context, projectName tenant.ProjectName, namespaceName tenant.NamespaceName) error {
+	l := logging.ForTenant(s.logger, projectName.String(), namespaceName.String(), "")
+	project, err := s.projectRepo.GetByName(ctx, projectName)
+	if err != nil {
+		l.Error("error getting project [%s]: %s", projectName, err)
+		return err
+	}
+
+	if err := s.namespaceRepo.SetArchived(ctx, projectName, namespaceName, false); err != nil {
+		l.Error("error unarchiving namespace [%s]: %s", namespaceName, err)
+		return err
+	}
+
+	// the jobs stay paused until the archived project is unarchived as well
+	if project.IsArchived() {
+		return nil
+	}
+	return s.resumeJobs(ctx, projectName, namespaceName)
+}
+
+func (s ArchiveService) pauseJobs(ctx context.Context, projectName tenant.ProjectName, namespaceName tenant.NamespaceName) error {
+	l := logging.ForTenant(s.logger, projectName.String(), namespaceName.String(), "")
+	tnnt, err := tenant.NewTenant(projectName.String(), namespaceName.String())
+	if err != nil {
+		return err
+	}
+
+	if err := s.jobScheduler.PauseTenantJobs(ctx, tnnt); err != nil {
+		l.Error("error pausing jobs of namespace [%s]: %s", namespaceName, err)
+		return err
+	}
+	return nil
+}
+
+func (s ArchiveService) resumeJobs(ctx context.Context, projectName tenant.ProjectName, namespaceName tenant.NamespaceName) error {
+	l := logging.ForTenant(s.logger, projectName.String(), namespaceName.String(), "")
+	tnnt, err := tenant.NewTenant(projectName.String(), namespaceName.String())
+	if err != nil {
+		return err
+	}
+
+	if err := s.jobScheduler.ResumeTenantJobs(ctx, tnnt); err != nil {
+		l.Error("error resuming jobs of namespace [%s]: %s", namespaceName, err)
+		return err
+	}
+	return nil
+}
+
+func NewArchiveService(projectRepo ProjectArchiveRepository, namespaceRepo NamespaceArchiveRepository, jobScheduler TenantJobScheduler, logger log.Logger) *ArchiveService {
+	return &ArchiveService{
+		projectRepo:   projectRepo,
+		namespaceRepo: namespaceRepo,
+		jobScheduler:  jobScheduler,
+		logger:        logger,
+	}
+}
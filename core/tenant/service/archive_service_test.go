@@ -0,0 +1,166 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/core/tenant/service"
+)
+
+func TestArchiveService(t *testing.T) {
+	ctx := context.Background()
+	logger := log.NewNoop()
+	conf := map[string]string{
+		tenant.ProjectSchedulerHost:  "host",
+		tenant.ProjectStoragePathKey: "gs://location",
+	}
+	project, _ := tenant.NewProject("proj", conf)
+	activeNS, _ := tenant.NewNamespace("active-ns", project.Name(), map[string]string{})
+	archivedNS, _ := tenant.NewNamespace("archived-ns", project.Name(), map[string]string{})
+	archivedNS.SetArchived(true)
+
+	activeTenant, _ := tenant.NewTenant(project.Name().String(), activeNS.Name().String())
+	archivedTenant, _ := tenant.NewTenant(project.Name().String(), archivedNS.Name().String())
+
+	t.Run("ArchiveProject", func(t *testing.T) {
+		t.Run("returns error when unable to archive the project", func(t *testing.T) {
+			nsRepo := new(namespaceRepo)
+			nsRepo.On("GetAll", ctx, project.Name()).Return([]*tenant.Namespace{activeNS}, nil)
+			defer nsRepo.AssertExpectations(t)
+
+			prjRepo := new(projectRepo)
+			prjRepo.On("SetArchived", ctx, project.Name(), true).Return(errors.New("unable to archive"))
+			defer prjRepo.AssertExpectations(t)
+
+			jobScheduler := new(tenantJobScheduler)
+			defer jobScheduler.AssertExpectations(t)
+
+			archiveService := service.NewArchiveService(prjRepo, nsRepo, jobScheduler, logger)
+			err := archiveService.ArchiveProject(ctx, project.Name())
+			assert.EqualError(t, err, "unable to archive")
+		})
+		t.Run("archives the project and pauses the jobs of every namespace", func(t *testing.T) {
+			nsRepo := new(namespaceRepo)
+			nsRepo.On("GetAll", ctx, project.Name()).Return([]*tenant.Namespace{activeNS, archivedNS}, nil)
+			defer nsRepo.AssertExpectations(t)
+
+			prjRepo := new(projectRepo)
+			prjRepo.On("SetArchived", ctx, project.Name(), true).Return(nil)
+			defer prjRepo.AssertExpectations(t)
+
+			jobScheduler := new(tenantJobScheduler)
+			jobScheduler.On("PauseTenantJobs", ctx, activeTenant).Return(nil)
+			jobScheduler.On("PauseTenantJobs", ctx, archivedTenant).Return(nil)
+			defer jobScheduler.AssertExpectations(t)
+
+			archiveService := service.NewArchiveService(prjRepo, nsRepo, jobScheduler, logger)
+			err := archiveService.ArchiveProject(ctx, project.Name())
+			assert.NoError(t, err)
+		})
+	})
+	t.Run("UnarchiveProject", func(t *testing.T) {
+		t.Run("resumes the jobs of the namespaces not archived on their own", func(t *testing.T) {
+			nsRepo := new(namespaceRepo)
+			nsRepo.On("GetAll", ctx, project.Name()).Return([]*tenant.Namespace{activeNS, archivedNS}, nil)
+			defer nsRepo.AssertExpectations(t)
+
+			prjRepo := new(projectRepo)
+			prjRepo.On("SetArchived", ctx, project.Name(), false).Return(nil)
+			defer prjRepo.AssertExpectations(t)
+
+			jobScheduler := new(tenantJobScheduler)
+			jobScheduler.On("ResumeTenantJobs", ctx, activeTenant).Return(nil)
+			defer jobScheduler.AssertExpectations(t)
+
+			archiveService := service.NewArchiveService(prjRepo, nsRepo, jobScheduler, logger)
+			err := archiveService.UnarchiveProject(ctx, project.Name())
+			assert.NoError(t, err)
+		})
+	})
+	t.Run("ArchiveNamespace", func(t *testing.T) {
+		t.Run("returns error when unable to pause the jobs", func(t *testing.T) {
+			nsRepo := new(namespaceRepo)
+			nsRepo.On("SetArchived", ctx, project.Name(), activeNS.Name(), true).Return(nil)
+			defer nsRepo.AssertExpectations(t)
+
+			jobScheduler := new(tenantJobScheduler)
+			jobScheduler.On("PauseTenantJobs", ctx, activeTenant).Return(errors.New("scheduler unavailable"))
+			defer jobScheduler.AssertExpectations(t)
+
+			archiveService := service.NewArchiveService(nil, nsRepo, jobScheduler, logger)
+			err := archiveService.ArchiveNamespace(ctx, project.Name(), activeNS.Name())
+			assert.EqualError(t, err, "scheduler unavailable")
+		})
+		t.Run("archives the namespace and pauses its jobs", func(t *testing.T) {
+			nsRepo := new(namespaceRepo)
+			nsRepo.On("SetArchived", ctx, project.Name(), activeNS.Name(), true).Return(nil)
+			defer nsRepo.AssertExpectations(t)
+
+			jobScheduler := new(tenantJobScheduler)
+			jobScheduler.On("PauseTenantJobs", ctx, activeTenant).Return(nil)
+			defer jobScheduler.AssertExpectations(t)
+
+			archiveService := service.NewArchiveService(nil, nsRepo, jobScheduler, logger)
+			err := archiveService.ArchiveNamespace(ctx, project.Name(), activeNS.Name())
+			assert.NoError(t, err)
+		})
+	})
+	t.Run("UnarchiveNamespace", func(t *testing.T) {
+		t.Run("keeps the jobs paused when the project is archived", func(t *testing.T) {
+			archivedProject, _ := tenant.NewProject(project.Name().String(), conf)
+			archivedProject.SetArchived(true)
+
+			prjRepo := new(projectRepo)
+			prjRepo.On("GetByName", ctx, project.Name()).Return(archivedProject, nil)
+			defer prjRepo.AssertExpectations(t)
+
+			nsRepo := new(namespaceRepo)
+			nsRepo.On("SetArchived", ctx, project.Name(), archivedNS.Name(), false).Return(nil)
+			defer nsRepo.AssertExpectations(t)
+
+			jobScheduler := new(tenantJobScheduler)
+			defer jobScheduler.AssertExpectations(t)
+
+			archiveService := service.NewArchiveService(prjRepo, nsRepo, jobScheduler, logger)
+			err := archiveService.UnarchiveNamespace(ctx, project.Name(), archivedNS.Name())
+			assert.NoError(t, err)
+		})
+		t.Run("unarchives the namespace and resumes its jobs", func(t *testing.T) {
+			prjRepo := new(projectRepo)
+			prjRepo.On("GetByName", ctx, project.Name()).Return(project, nil)
+			defer prjRepo.AssertExpectations(t)
+
+			nsRepo := new(namespaceRepo)
+			nsRepo.On("SetArchived", ctx, project.Name(), archivedNS.Name(), false).Return(nil)
+			defer nsRepo.AssertExpectations(t)
+
+			jobScheduler := new(tenantJobScheduler)
+			jobScheduler.On("ResumeTenantJobs", ctx, archivedTenant).Return(nil)
+			defer jobScheduler.AssertExpectations(t)
+
+			archiveService := service.NewArchiveService(prjRepo, nsRepo, jobScheduler, logger)
+			err := archiveService.UnarchiveNamespace(ctx, project.Name(), archivedNS.Name())
+			assert.NoError(t, err)
+		})
+	})
+}
+
+type tenantJobScheduler struct {
+	mock.Mock
+}
+
+func (s *tenantJobScheduler) PauseTenantJobs(ctx context.Context, tnnt tenant.Tenant) error {
+	args := s.Called(ctx, tnnt)
+	return args.Error(0)
+}
+
+func (s *tenantJobScheduler) ResumeTenantJobs(ctx context.Context, tnnt tenant.Tenant) error {
+	args := s.Called(ctx, tnnt)
+	return args.Error(0)
+}
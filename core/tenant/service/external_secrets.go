@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/goto/salt/log"
+
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+	"github.com/goto/optimus/internal/lib/lru"
+	"github.com/goto/optimus/internal/logging"
+)
+
+const externalSecretsCacheSize = 1000
+
+type cachedSecrets struct {
+	secrets   tenant.PlainTextSecrets
+	expiresAt time.Time
+}
+
+// ExternalSecretsGetter resolves the secrets of the projects configured with a secret provider from the provider,
+// on top of the secrets registered in optimus. The resolved secrets are cached per project for the ttl configured
+// in the project, so the provider is not called on every compilation.
+type ExternalSecretsGetter struct {
+	projGetter    ProjectGetter
+	secretsGetter SecretsGetter
+	providers     map[string]tenant.SecretProvider
+
+	cache *lru.Cache[tenant.ProjectName, cachedSecrets]
+	now   func() time.Time
+
+	logger log.Logger
+}
+
+func (e ExternalSecretsGetter) Get(ctx context.Context, projName tenant.ProjectName, namespaceName, name string) (*tenant.PlainTextSecret, error) {
+	l := logging.ForTenant(e.logger, projName.String(), namespaceName, "")
+	secretName, err := tenant.SecretNameFrom(name)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := e.projGetter.Get(ctx, projName)
+	if err != nil {
+		l.Error("error getting project [%s]: %s", projName, err)
+		return nil, err
+	}
+	if config, _ := tenant.SecretProviderConfigFrom(project); config == nil {
+		return e.secretsGetter.Get(ctx, projName, namespaceName, name)
+	}
+
+	secrets, err := e.GetAll(ctx, projName, namespaceName)
+	if err != nil {
+		return nil, err
+	}
+	for _, secret := range secrets {
+		if secret.Name() == secretName {
+			return secret, nil
+		}
+	}
+	return nil, errors.NotFound(tenant.EntitySecret, "no record for "+secretName.String())
+}
+
+func (e ExternalSecretsGetter) GetAll(ctx context.Context, projName tenant.ProjectName, namespaceName string) ([]*tenant.PlainTextSecret, error) {
+	l := logging.ForTenant(e.logger, projName.String(), namespaceName, "")
+	project, err := e.projGetter.Get(ctx, projName)
+	if err != nil {
+		l.Error("error getting project [%s]: %s", projName, err)
+		return nil, err
+	}
+
+	secrets, err := e.secretsGetter.GetAll(ctx, projName, namespaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := tenant.SecretProviderConfigFrom(project)
+	if err != nil {
+		l.Error("error getting secret provider of project [%s]: %s", projName, err)
+		return nil, err
+	}
+	if config == nil {
+		return secrets, nil
+	}
+
+	externalSecrets, err := e.getExternalSecrets(ctx, projName, *config, secrets)
+	if err != nil {
+		return nil, err
+	}
+
+	// the secrets of the provider take precedence over the registered ones with the same name
+	externalSecretMap := externalSecrets.ToSecretMap()
+	merged := make([]*tenant.PlainTextSecret, 0, len(secrets)+len(externalSecrets))
+	for _, secret := range secrets {
+		if _, ok := externalSecretMap[secret.Name().String()]; !ok {
+			merged = append(merged, secret)
+		}
+	}
+	return append(merged, externalSecrets...), nil
+}
+
+func (e ExternalSecretsGetter) getExternalSecrets(ctx context.Context, projName tenant.ProjectName, config tenant.SecretProviderConfig,
+	registeredSecrets []*tenant.PlainTextSecret,
+) (tenant.PlainTextSecrets, error) {
+	l := logging.ForTenant(e.logger, projName.String(), "", "")
+	if cached, ok := e.cache.Get(projName); ok && e.now().Before(cached.expiresAt) {
+		return cached.secrets, nil
+	}
+
+	provider, ok := e.providers[config.Type]
+	if !ok {
+		return nil, errors.InvalidArgument(tenant.EntitySecret, "secret provider "+config.Type+" is not available")
+	}
+
+	for _, secret := range registeredSecrets {
+		if secret.Name() == tenant.SecretProviderToken {
+			config.Token = secret.Value()
+		}
+	}
+
+	secrets, err := provider.GetSecrets(ctx, config)
+	if err != nil {
+		l.Error("error getting secrets of project [%s] from %s: %s", projName, config.Type, err)
+		return nil, err
+	}
+
+	if config.CacheTTL > 0 {
+		e.cache.Add(projName, cachedSecrets{secrets: secrets, expiresAt: e.now().Add(config.CacheTTL)})
+	}
+	return secrets, nil
+}
+
+func NewExternalSecretsGetter(projGetter ProjectGetter, secretsGetter SecretsGetter, providers map[string]tenant.SecretProvider,
+	now func() time.Time, logger log.Logger,
+) *ExternalSecretsGetter {
+	return &ExternalSecretsGetter{
+		projGetter:    projGetter,
+		secretsGetter: secretsGetter,
+		providers:     providers,
+		cache:         lru.New[tenant.ProjectName, cachedSecrets](externalSecretsCacheSize, 0),
+		now:           now,
+		logger:        logger,
+	}
+}
@@ -0,0 +1,171 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/core/tenant/service"
+)
+
+func TestExternalSecretsGetter(t *testing.T) {
+	ctx := context.Background()
+	logger := log.NewNoop()
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	nsName := "test-ns"
+
+	plainProject, _ := tenant.NewProject("test-project", map[string]string{
+		tenant.ProjectSchedulerHost:  "host",
+		tenant.ProjectStoragePathKey: "gs://location",
+	})
+	vaultProject, _ := tenant.NewProject("test-project", map[string]string{
+		tenant.ProjectSchedulerHost:          "host",
+		tenant.ProjectStoragePathKey:         "gs://location",
+		tenant.ProjectSecretProvider:         tenant.SecretProviderVault,
+		tenant.ProjectSecretProviderAddress:  "https://vault.example.com",
+		tenant.ProjectSecretProviderPath:     "secret/data/optimus",
+		tenant.ProjectSecretProviderCacheTTL: "10m",
+	})
+	vaultConfig := tenant.SecretProviderConfig{
+		Type:     tenant.SecretProviderVault,
+		Address:  "https://vault.example.com",
+		Path:     "secret/data/optimus",
+		CacheTTL: 10 * time.Minute,
+		Token:    "vault-token",
+	}
+
+	storageSecret, _ := tenant.NewPlainTextSecret(tenant.SecretStorageKey, "storage")
+	tokenSecret, _ := tenant.NewPlainTextSecret(tenant.SecretProviderToken, "vault-token")
+	registeredSecret, _ := tenant.NewPlainTextSecret("API_KEY", "registered")
+	externalSecret, _ := tenant.NewPlainTextSecret("API_KEY", "external")
+	otherExternalSecret, _ := tenant.NewPlainTextSecret("PASSWORD", "external")
+	registeredSecrets := []*tenant.PlainTextSecret{storageSecret, tokenSecret, registeredSecret}
+	externalSecrets := tenant.PlainTextSecrets{externalSecret, otherExternalSecret}
+
+	t.Run("GetAll", func(t *testing.T) {
+		t.Run("returns the registered secrets when project has no secret provider", func(t *testing.T) {
+			projGetter := new(projectGetter)
+			projGetter.On("Get", ctx, plainProject.Name()).Return(plainProject, nil)
+			defer projGetter.AssertExpectations(t)
+
+			secretsGetter := new(secretGetter)
+			secretsGetter.On("GetAll", ctx, plainProject.Name(), nsName).Return(registeredSecrets, nil)
+			defer secretsGetter.AssertExpectations(t)
+
+			getter := service.NewExternalSecretsGetter(projGetter, secretsGetter, nil, func() time.Time { return now }, logger)
+			secrets, err := getter.GetAll(ctx, plainProject.Name(), nsName)
+			assert.Nil(t, err)
+			assert.Equal(t, registeredSecrets, secrets)
+		})
+		t.Run("returns error when the provider fails", func(t *testing.T) {
+			projGetter := new(projectGetter)
+			projGetter.On("Get", ctx, vaultProject.Name()).Return(vaultProject, nil)
+			defer projGetter.AssertExpectations(t)
+
+			secretsGetter := new(secretGetter)
+			secretsGetter.On("GetAll", ctx, vaultProject.Name(), nsName).Return(registeredSecrets, nil)
+			defer secretsGetter.AssertExpectations(t)
+
+			provider := new(mockSecretProvider)
+			provider.On("GetSecrets", ctx, vaultConfig).Return(nil, errors.New("permission denied"))
+			defer provider.AssertExpectations(t)
+
+			providers := map[string]tenant.SecretProvider{tenant.SecretProviderVault: provider}
+			getter := service.NewExternalSecretsGetter(projGetter, secretsGetter, providers, func() time.Time { return now }, logger)
+			_, err := getter.GetAll(ctx, vaultProject.Name(), nsName)
+			assert.EqualError(t, err, "permission denied")
+		})
+		t.Run("returns the secrets of the provider over the registered ones and caches them for the ttl", func(t *testing.T) {
+			projGetter := new(projectGetter)
+			projGetter.On("Get", ctx, vaultProject.Name()).Return(vaultProject, nil)
+			defer projGetter.AssertExpectations(t)
+
+			secretsGetter := new(secretGetter)
+			secretsGetter.On("GetAll", ctx, vaultProject.Name(), nsName).Return(registeredSecrets, nil)
+			defer secretsGetter.AssertExpectations(t)
+
+			provider := new(mockSecretProvider)
+			provider.On("GetSecrets", ctx, vaultConfig).Return(externalSecrets, nil).Twice()
+			defer provider.AssertExpectations(t)
+
+			currentTime := now
+			providers := map[string]tenant.SecretProvider{tenant.SecretProviderVault: provider}
+			getter := service.NewExternalSecretsGetter(projGetter, secretsGetter, providers, func() time.Time { return currentTime }, logger)
+
+			secrets, err := getter.GetAll(ctx, vaultProject.Name(), nsName)
+			assert.Nil(t, err)
+			assert.Equal(t, tenant.SecretMap{
+				tenant.SecretStorageKey:    "storage",
+				tenant.SecretProviderToken: "vault-token",
+				"API_KEY":                  "external",
+				"PASSWORD":                 "external",
+			}, tenant.PlainTextSecrets(secrets).ToSecretMap())
+
+			currentTime = now.Add(5 * time.Minute)
+			_, err = getter.GetAll(ctx, vaultProject.Name(), nsName)
+			assert.Nil(t, err)
+
+			currentTime = now.Add(11 * time.Minute)
+			_, err = getter.GetAll(ctx, vaultProject.Name(), nsName)
+			assert.Nil(t, err)
+		})
+	})
+	t.Run("Get", func(t *testing.T) {
+		t.Run("returns the registered secret when project has no secret provider", func(t *testing.T) {
+			projGetter := new(projectGetter)
+			projGetter.On("Get", ctx, plainProject.Name()).Return(plainProject, nil)
+			defer projGetter.AssertExpectations(t)
+
+			secretsGetter := new(secretGetter)
+			secretsGetter.On("Get", ctx, plainProject.Name(), nsName, "API_KEY").Return(registeredSecret, nil)
+			defer secretsGetter.AssertExpectations(t)
+
+			getter := service.NewExternalSecretsGetter(projGetter, secretsGetter, nil, func() time.Time { return now }, logger)
+			secret, err := getter.Get(ctx, plainProject.Name(), nsName, "API_KEY")
+			assert.Nil(t, err)
+			assert.Equal(t, "registered", secret.Value())
+		})
+		t.Run("returns the secret of the provider", func(t *testing.T) {
+			projGetter := new(projectGetter)
+			projGetter.On("Get", ctx, vaultProject.Name()).Return(vaultProject, nil)
+			defer projGetter.AssertExpectations(t)
+
+			secretsGetter := new(secretGetter)
+			secretsGetter.On("GetAll", ctx, vaultProject.Name(), nsName).Return(registeredSecrets, nil)
+			defer secretsGetter.AssertExpectations(t)
+
+			provider := new(mockSecretProvider)
+			provider.On("GetSecrets", ctx, vaultConfig).Return(externalSecrets, nil).Once()
+			defer provider.AssertExpectations(t)
+
+			providers := map[string]tenant.SecretProvider{tenant.SecretProviderVault: provider}
+			getter := service.NewExternalSecretsGetter(projGetter, secretsGetter, providers, func() time.Time { return now }, logger)
+
+			secret, err := getter.Get(ctx, vaultProject.Name(), nsName, "api_key")
+			assert.Nil(t, err)
+			assert.Equal(t, "external", secret.Value())
+
+			_, err = getter.Get(ctx, vaultProject.Name(), nsName, "unknown")
+			assert.EqualError(t, err, "not found for entity secret: no record for UNKNOWN")
+		})
+	})
+}
+
+type mockSecretProvider struct {
+	mock.Mock
+}
+
+func (m *mockSecretProvider) GetSecrets(ctx context.Context, config tenant.SecretProviderConfig) (tenant.PlainTextSecrets, error) {
+	args := m.Called(ctx, config)
+	var secrets tenant.PlainTextSecrets
+	if args.Get(0) != nil {
+		secrets = args.Get(0).(tenant.PlainTextSecrets)
+	}
+	return secrets, args.Error(1)
+}
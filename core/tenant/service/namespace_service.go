@@ -17,6 +17,9 @@ type NamespaceService struct {
 }
 
 func (ns NamespaceService) Save(ctx context.Context, namespace *tenant.Namespace) error {
+	if err := tenant.ValidateNamespaceConfig(namespace.GetConfigs()); err != nil {
+		return err
+	}
 	return ns.nsRepo.Save(ctx, namespace)
 }
 
@@ -23,6 +23,20 @@ func TestNamespaceService(t *testing.T) {
 	savedNS, _ := tenant.NewNamespace("savedNS", savedProject.Name(), map[string]string{})
 
 	t.Run("Save", func(t *testing.T) {
+		t.Run("returns error when the well known configs are invalid", func(t *testing.T) {
+			nsRepo := new(namespaceRepo)
+
+			toSaveNS, _ := tenant.NewNamespace("ns", savedProject.Name(), map[string]string{
+				tenant.ProjectJobTimezone:      "Mars/Olympus",
+				tenant.NamespaceProjectSecrets: "BQ_[",
+			})
+
+			namespaceService := service.NewNamespaceService(nsRepo)
+			err := namespaceService.Save(ctx, toSaveNS)
+
+			assert.EqualError(t, err, "invalid argument for entity namespace: invalid configuration: "+
+				"config JOB_TIMEZONE: \"Mars/Olympus\" is not a known timezone; config PROJECT_SECRETS: \"BQ_[\" is not a valid pattern")
+		})
 		t.Run("returns error when fails in service", func(t *testing.T) {
 			nsRepo := new(namespaceRepo)
 			nsRepo.On("Save", ctx, mock.Anything).Return(errors.New("error in saving"))
@@ -128,3 +142,8 @@ func (nr *namespaceRepo) GetAll(ctx context.Context, name tenant.ProjectName) ([
 	}
 	return nss, args.Error(1)
 }
+
+func (nr *namespaceRepo) SetArchived(ctx context.Context, prjName tenant.ProjectName, nsName tenant.NamespaceName, archived bool) error {
+	args := nr.Called(ctx, prjName, nsName, archived)
+	return args.Error(0)
+}
@@ -2,20 +2,30 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"time"
 
 	"github.com/goto/optimus/core/tenant"
 	"github.com/goto/optimus/internal/errors"
+	"github.com/goto/optimus/internal/lib/window"
+	"github.com/goto/optimus/sdk/plugin"
 )
 
+// presetSimulationSchedules are the schedules a new or updated preset is simulated with
+var presetSimulationSchedules = []string{"0 * * * *", "0 0 * * *"}
+
 type ProjectService struct {
 	projectRepo ProjectRepository
 	presetRepo  PresetRepository
+	pluginRepo  PluginRepo
 }
 
-func NewProjectService(projectRepo ProjectRepository, presetRepo PresetRepository) *ProjectService {
+func NewProjectService(projectRepo ProjectRepository, presetRepo PresetRepository, pluginRepo PluginRepo) *ProjectService {
 	return &ProjectService{
 		projectRepo: projectRepo,
 		presetRepo:  presetRepo,
+		pluginRepo:  pluginRepo,
 	}
 }
 
@@ -32,14 +42,46 @@ type PresetRepository interface {
 	Delete(ctx context.Context, projectName tenant.ProjectName, presetName string) error
 }
 
+type PluginRepo interface {
+	GetAll() []*plugin.Plugin
+}
+
 func (s ProjectService) Save(ctx context.Context, project *tenant.Project) error {
+	if err := tenant.ValidateProjectConfig(project.GetConfigs(), s.requiredConfigKeys()...); err != nil {
+		return err
+	}
+
 	if err := s.projectRepo.Save(ctx, project); err != nil {
 		return err
 	}
 
+	// presets managed remotely are kept when the registered project does not carry any
+	if len(project.GetPresets()) == 0 {
+		return nil
+	}
+
 	return s.replacePresets(ctx, project.Name(), project.GetPresets())
 }
 
+// requiredConfigKeys are the project configs the installed plugins can not run without
+func (s ProjectService) requiredConfigKeys() []string {
+	var keys []string
+	seen := make(map[string]bool)
+	for _, p := range s.pluginRepo.GetAll() {
+		info := p.Info()
+		if info == nil {
+			continue
+		}
+		for _, key := range info.RequiredProjectConfigs {
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	return keys
+}
+
 func (s ProjectService) Get(ctx context.Context, name tenant.ProjectName) (*tenant.Project, error) {
 	project, err := s.projectRepo.GetByName(ctx, name)
 	if err != nil {
@@ -75,6 +117,69 @@ func (s ProjectService) GetAll(ctx context.Context) ([]*tenant.Project, error) {
 	return projects, me.ToErr()
 }
 
+// SavePreset creates the preset under the project or updates the existing one having the same name
+func (s ProjectService) SavePreset(ctx context.Context, projectName tenant.ProjectName, preset tenant.Preset) (tenant.Preset, error) {
+	if _, err := s.projectRepo.GetByName(ctx, projectName); err != nil {
+		return tenant.Preset{}, err
+	}
+
+	existings, err := s.getPresets(ctx, projectName)
+	if err != nil {
+		return tenant.Preset{}, err
+	}
+
+	existing, found := existings[preset.Name()]
+	if found && existing.Equal(preset) {
+		return existing, nil
+	}
+
+	if err := simulatePreset(preset); err != nil {
+		return tenant.Preset{}, err
+	}
+
+	if !found {
+		if err := s.presetRepo.Create(ctx, projectName, preset); err != nil {
+			return tenant.Preset{}, err
+		}
+		return preset.WithVersion(1), nil
+	}
+
+	if err := s.presetRepo.Update(ctx, projectName, preset); err != nil {
+		return tenant.Preset{}, err
+	}
+	return preset.WithVersion(existing.Version() + 1), nil
+}
+
+// GetPresets returns the presets of the project sorted by name
+func (s ProjectService) GetPresets(ctx context.Context, projectName tenant.ProjectName) ([]tenant.Preset, error) {
+	presets, err := s.presetRepo.Read(ctx, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(presets, func(i, j int) bool {
+		return presets[i].Name() < presets[j].Name()
+	})
+	return presets, nil
+}
+
+func (s ProjectService) DeletePreset(ctx context.Context, projectName tenant.ProjectName, presetName string) error {
+	if presetName == "" {
+		return errors.InvalidArgument(tenant.EntityProject, "preset name is empty")
+	}
+
+	existings, err := s.getPresets(ctx, projectName)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := existings[presetName]; !ok {
+		return errors.NotFound(tenant.EntityProject, "preset "+presetName+" is not found")
+	}
+
+	return s.presetRepo.Delete(ctx, projectName, presetName)
+}
+
 func (s ProjectService) getPresets(ctx context.Context, projectName tenant.ProjectName) (map[string]tenant.Preset, error) {
 	existings, err := s.presetRepo.Read(ctx, projectName)
 	if err != nil {
@@ -98,6 +203,13 @@ func (s ProjectService) replacePresets(ctx context.Context, projectName tenant.P
 	me := errors.NewMultiError("replace presets within project")
 
 	toCreate, toUpdate, toDelete := s.getPresetsDiff(incomings, existings)
+	for _, preset := range append(toCreate, toUpdate...) {
+		me.Append(simulatePreset(preset))
+	}
+	if len(me.Errors) > 0 {
+		return me.ToErr()
+	}
+
 	for _, preset := range toCreate {
 		me.Append(s.presetRepo.Create(ctx, projectName, preset))
 	}
@@ -137,3 +249,19 @@ func (ProjectService) getPresetsDiff(incomings map[string]tenant.Preset, existin
 
 	return toCreate, toUpdate, toDelete
 }
+
+// simulatePreset checks the window of preset across a year for the schedules commonly used with presets
+func simulatePreset(preset tenant.Preset) error {
+	w := window.FromBaseWindow(preset.Window())
+	start := time.Date(time.Now().Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+	for _, schedule := range presetSimulationSchedules {
+		report, err := window.Simulate(w, schedule, start)
+		if err != nil {
+			return errors.AddErrContext(err, tenant.EntityProject, "unable to simulate preset "+preset.Name())
+		}
+		if err := report.Err(); err != nil {
+			return errors.InvalidArgument(tenant.EntityProject, fmt.Sprintf("invalid preset %s for schedule %s: %s", preset.Name(), schedule, err))
+		}
+	}
+	return nil
+}
@@ -10,6 +10,8 @@ import (
 
 	"github.com/goto/optimus/core/tenant"
 	"github.com/goto/optimus/core/tenant/service"
+	"github.com/goto/optimus/sdk/plugin"
+	mockOpt "github.com/goto/optimus/sdk/plugin/mock"
 )
 
 func TestProjectService(t *testing.T) {
@@ -31,6 +33,43 @@ func TestProjectService(t *testing.T) {
 	savedProject.SetPresets(presetsMap)
 
 	t.Run("Save", func(t *testing.T) {
+		noPlugins := new(pluginRepo)
+		noPlugins.On("GetAll").Return([]*plugin.Plugin{})
+
+		t.Run("returns error when the well known configs are invalid", func(t *testing.T) {
+			projectRepo := new(projectRepo)
+			presetRepo := new(presetRepo)
+
+			toSaveProj, _ := tenant.NewProject("proj", map[string]string{
+				tenant.ProjectSchedulerHost:          "host",
+				tenant.ProjectStoragePathKey:         "location",
+				tenant.ProjectUpstreamAccessApproval: "yes",
+				tenant.ProjectJobTimezone:            "Asia/Jakarta",
+			})
+
+			projService := service.NewProjectService(projectRepo, presetRepo, noPlugins)
+			err := projService.Save(ctx, toSaveProj)
+
+			assert.EqualError(t, err, "invalid argument for entity project: invalid configuration: "+
+				"config STORAGE_PATH: \"location\" is not a url with scheme, like gs://bucket/path; "+
+				"config UPSTREAM_ACCESS_APPROVAL: \"yes\" is not a boolean")
+		})
+		t.Run("returns error when configs required by the plugins are missing", func(t *testing.T) {
+			projectRepo := new(projectRepo)
+			presetRepo := new(presetRepo)
+
+			yamlMod := new(mockOpt.YamlMod)
+			yamlMod.On("PluginInfo").Return(&plugin.Info{Name: "bq2bq", RequiredProjectConfigs: []string{"BQ_PROJECT", "BUCKET"}})
+			plugins := new(pluginRepo)
+			plugins.On("GetAll").Return([]*plugin.Plugin{{YamlMod: yamlMod}})
+
+			toSaveProj, _ := tenant.NewProject("proj", conf)
+
+			projService := service.NewProjectService(projectRepo, presetRepo, plugins)
+			err := projService.Save(ctx, toSaveProj)
+
+			assert.EqualError(t, err, "invalid argument for entity project: invalid configuration: required config BQ_PROJECT is missing")
+		})
 		t.Run("returns error when fails in saving project", func(t *testing.T) {
 			projectRepo := new(projectRepo)
 			projectRepo.On("Save", ctx, mock.Anything).Return(errors.New("error in saving"))
@@ -40,7 +79,7 @@ func TestProjectService(t *testing.T) {
 
 			toSaveProj, _ := tenant.NewProject("proj", conf)
 
-			projService := service.NewProjectService(projectRepo, presetRepo)
+			projService := service.NewProjectService(projectRepo, presetRepo, noPlugins)
 			err := projService.Save(ctx, toSaveProj)
 
 			assert.NotNil(t, err)
@@ -60,12 +99,33 @@ func TestProjectService(t *testing.T) {
 			presetRepo.On("Read", ctx, toSaveProj.Name()).Return([]tenant.Preset{}, nil)
 			presetRepo.On("Create", ctx, toSaveProj.Name(), preset).Return(errors.New("error in creating preset"))
 
-			projService := service.NewProjectService(projectRepo, presetRepo)
+			projService := service.NewProjectService(projectRepo, presetRepo, noPlugins)
 			err = projService.Save(ctx, toSaveProj)
 
 			assert.NotNil(t, err)
 			assert.ErrorContains(t, err, "error in creating preset")
 		})
+		t.Run("returns error when new preset has anomalies on simulation", func(t *testing.T) {
+			projectRepo := new(projectRepo)
+			projectRepo.On("Save", ctx, mock.Anything).Return(nil)
+			defer projectRepo.AssertExpectations(t)
+
+			presetRepo := new(presetRepo)
+			defer presetRepo.AssertExpectations(t)
+
+			zeroSizePreset, err := tenant.NewPreset("today", "preset without size", "d", "0", "")
+			assert.NoError(t, err)
+
+			toSaveProj, _ := tenant.NewProject("proj", conf)
+			toSaveProj.SetPresets(map[string]tenant.Preset{"today": zeroSizePreset})
+
+			presetRepo.On("Read", ctx, toSaveProj.Name()).Return([]tenant.Preset{}, nil)
+
+			projService := service.NewProjectService(projectRepo, presetRepo, noPlugins)
+			err = projService.Save(ctx, toSaveProj)
+
+			assert.ErrorContains(t, err, "invalid preset today")
+		})
 		t.Run("saves the project successfully", func(t *testing.T) {
 			projectRepo := new(projectRepo)
 			projectRepo.On("Save", ctx, mock.Anything).Return(nil)
@@ -80,11 +140,154 @@ func TestProjectService(t *testing.T) {
 			presetRepo.On("Read", ctx, toSaveProj.Name()).Return([]tenant.Preset{}, nil)
 			presetRepo.On("Create", ctx, toSaveProj.Name(), preset).Return(nil)
 
-			projService := service.NewProjectService(projectRepo, presetRepo)
+			projService := service.NewProjectService(projectRepo, presetRepo, noPlugins)
 			err := projService.Save(ctx, toSaveProj)
 
 			assert.Nil(t, err)
 		})
+		t.Run("keeps the existing presets when the project has no presets", func(t *testing.T) {
+			projectRepo := new(projectRepo)
+			projectRepo.On("Save", ctx, mock.Anything).Return(nil)
+			defer projectRepo.AssertExpectations(t)
+
+			presetRepo := new(presetRepo)
+			defer presetRepo.AssertExpectations(t)
+
+			toSaveProj, _ := tenant.NewProject("proj", conf)
+
+			projService := service.NewProjectService(projectRepo, presetRepo, noPlugins)
+			err := projService.Save(ctx, toSaveProj)
+
+			assert.Nil(t, err)
+		})
+	})
+	t.Run("SavePreset", func(t *testing.T) {
+		t.Run("returns error when project is not found", func(t *testing.T) {
+			projectRepo := new(projectRepo)
+			projectRepo.On("GetByName", ctx, savedProject.Name()).Return(nil, errors.New("not found"))
+			defer projectRepo.AssertExpectations(t)
+
+			presetRepo := new(presetRepo)
+			defer presetRepo.AssertExpectations(t)
+
+			projService := service.NewProjectService(projectRepo, presetRepo, nil)
+			_, err := projService.SavePreset(ctx, savedProject.Name(), preset)
+
+			assert.ErrorContains(t, err, "not found")
+		})
+		t.Run("returns error when preset has anomalies on simulation", func(t *testing.T) {
+			projectRepo := new(projectRepo)
+			projectRepo.On("GetByName", ctx, savedProject.Name()).Return(savedProject, nil)
+			defer projectRepo.AssertExpectations(t)
+
+			presetRepo := new(presetRepo)
+			defer presetRepo.AssertExpectations(t)
+
+			zeroSizePreset, err := tenant.NewPreset("today", "preset without size", "d", "0", "")
+			assert.NoError(t, err)
+
+			presetRepo.On("Read", ctx, savedProject.Name()).Return([]tenant.Preset{}, nil)
+
+			projService := service.NewProjectService(projectRepo, presetRepo, nil)
+			_, err = projService.SavePreset(ctx, savedProject.Name(), zeroSizePreset)
+
+			assert.ErrorContains(t, err, "invalid preset today")
+		})
+		t.Run("creates the preset with the first version", func(t *testing.T) {
+			projectRepo := new(projectRepo)
+			projectRepo.On("GetByName", ctx, savedProject.Name()).Return(savedProject, nil)
+			defer projectRepo.AssertExpectations(t)
+
+			presetRepo := new(presetRepo)
+			defer presetRepo.AssertExpectations(t)
+
+			presetRepo.On("Read", ctx, savedProject.Name()).Return([]tenant.Preset{}, nil)
+			presetRepo.On("Create", ctx, savedProject.Name(), preset).Return(nil)
+
+			projService := service.NewProjectService(projectRepo, presetRepo, nil)
+			saved, err := projService.SavePreset(ctx, savedProject.Name(), preset)
+
+			assert.NoError(t, err)
+			assert.Equal(t, 1, saved.Version())
+		})
+		t.Run("updates the changed preset with the next version", func(t *testing.T) {
+			projectRepo := new(projectRepo)
+			projectRepo.On("GetByName", ctx, savedProject.Name()).Return(savedProject, nil)
+			defer projectRepo.AssertExpectations(t)
+
+			presetRepo := new(presetRepo)
+			defer presetRepo.AssertExpectations(t)
+
+			changedPreset, err := tenant.NewPreset("test_preset", "preset for testing", "d", "-1h", "48h")
+			assert.NoError(t, err)
+
+			presetRepo.On("Read", ctx, savedProject.Name()).Return([]tenant.Preset{preset.WithVersion(2)}, nil)
+			presetRepo.On("Update", ctx, savedProject.Name(), changedPreset).Return(nil)
+
+			projService := service.NewProjectService(projectRepo, presetRepo, nil)
+			saved, err := projService.SavePreset(ctx, savedProject.Name(), changedPreset)
+
+			assert.NoError(t, err)
+			assert.Equal(t, 3, saved.Version())
+		})
+		t.Run("does not update the preset when nothing is changed", func(t *testing.T) {
+			projectRepo := new(projectRepo)
+			projectRepo.On("GetByName", ctx, savedProject.Name()).Return(savedProject, nil)
+			defer projectRepo.AssertExpectations(t)
+
+			presetRepo := new(presetRepo)
+			defer presetRepo.AssertExpectations(t)
+
+			presetRepo.On("Read", ctx, savedProject.Name()).Return([]tenant.Preset{preset.WithVersion(2)}, nil)
+
+			projService := service.NewProjectService(projectRepo, presetRepo, nil)
+			saved, err := projService.SavePreset(ctx, savedProject.Name(), preset)
+
+			assert.NoError(t, err)
+			assert.Equal(t, 2, saved.Version())
+		})
+	})
+	t.Run("GetPresets", func(t *testing.T) {
+		t.Run("returns the presets sorted by name", func(t *testing.T) {
+			presetRepo := new(presetRepo)
+			defer presetRepo.AssertExpectations(t)
+
+			otherPreset, err := tenant.NewPreset("another_preset", "another preset for testing", "d", "0", "24h")
+			assert.NoError(t, err)
+
+			presetRepo.On("Read", ctx, savedProject.Name()).Return([]tenant.Preset{preset, otherPreset}, nil)
+
+			projService := service.NewProjectService(new(projectRepo), presetRepo, nil)
+			presets, err := projService.GetPresets(ctx, savedProject.Name())
+
+			assert.NoError(t, err)
+			assert.Equal(t, []tenant.Preset{otherPreset, preset}, presets)
+		})
+	})
+	t.Run("DeletePreset", func(t *testing.T) {
+		t.Run("returns error when preset is not found", func(t *testing.T) {
+			presetRepo := new(presetRepo)
+			defer presetRepo.AssertExpectations(t)
+
+			presetRepo.On("Read", ctx, savedProject.Name()).Return([]tenant.Preset{}, nil)
+
+			projService := service.NewProjectService(new(projectRepo), presetRepo, nil)
+			err := projService.DeletePreset(ctx, savedProject.Name(), preset.Name())
+
+			assert.ErrorContains(t, err, "preset test_preset is not found")
+		})
+		t.Run("deletes the preset", func(t *testing.T) {
+			presetRepo := new(presetRepo)
+			defer presetRepo.AssertExpectations(t)
+
+			presetRepo.On("Read", ctx, savedProject.Name()).Return([]tenant.Preset{preset}, nil)
+			presetRepo.On("Delete", ctx, savedProject.Name(), preset.Name()).Return(nil)
+
+			projService := service.NewProjectService(new(projectRepo), presetRepo, nil)
+			err := projService.DeletePreset(ctx, savedProject.Name(), preset.Name())
+
+			assert.NoError(t, err)
+		})
 	})
 	t.Run("GetAll", func(t *testing.T) {
 		t.Run("returns error when service returns error", func(t *testing.T) {
@@ -96,7 +299,7 @@ func TestProjectService(t *testing.T) {
 			presetRepo := new(presetRepo)
 			defer presetRepo.AssertExpectations(t)
 
-			projService := service.NewProjectService(projectRepo, presetRepo)
+			projService := service.NewProjectService(projectRepo, presetRepo, nil)
 			_, err := projService.GetAll(ctx)
 
 			assert.NotNil(t, err)
@@ -113,7 +316,7 @@ func TestProjectService(t *testing.T) {
 
 			presetRepo.On("Read", ctx, savedProject.Name()).Return(nil, errors.New("error getting presets"))
 
-			projService := service.NewProjectService(projectRepo, presetRepo)
+			projService := service.NewProjectService(projectRepo, presetRepo, nil)
 			_, err := projService.GetAll(ctx)
 
 			assert.NotNil(t, err)
@@ -130,7 +333,7 @@ func TestProjectService(t *testing.T) {
 
 			presetRepo.On("Read", ctx, savedProject.Name()).Return([]tenant.Preset{preset}, nil)
 
-			projService := service.NewProjectService(projectRepo, presetRepo)
+			projService := service.NewProjectService(projectRepo, presetRepo, nil)
 			projs, err := projService.GetAll(ctx)
 
 			assert.Nil(t, err)
@@ -147,7 +350,7 @@ func TestProjectService(t *testing.T) {
 			presetRepo := new(presetRepo)
 			defer presetRepo.AssertExpectations(t)
 
-			projService := service.NewProjectService(projectRepo, presetRepo)
+			projService := service.NewProjectService(projectRepo, presetRepo, nil)
 			_, err := projService.Get(ctx, savedProject.Name())
 
 			assert.NotNil(t, err)
@@ -163,7 +366,7 @@ func TestProjectService(t *testing.T) {
 
 			presetRepo.On("Read", ctx, savedProject.Name()).Return(nil, errors.New("error getting presets"))
 
-			projService := service.NewProjectService(projectRepo, presetRepo)
+			projService := service.NewProjectService(projectRepo, presetRepo, nil)
 			_, err := projService.Get(ctx, savedProject.Name())
 
 			assert.NotNil(t, err)
@@ -179,7 +382,7 @@ func TestProjectService(t *testing.T) {
 
 			presetRepo.On("Read", ctx, savedProject.Name()).Return([]tenant.Preset{preset}, nil)
 
-			projService := service.NewProjectService(projectRepo, presetRepo)
+			projService := service.NewProjectService(projectRepo, presetRepo, nil)
 			proj, err := projService.Get(ctx, savedProject.Name())
 
 			assert.Nil(t, err)
@@ -215,6 +418,11 @@ func (p *projectRepo) GetAll(ctx context.Context) ([]*tenant.Project, error) {
 	return prjs, args.Error(1)
 }
 
+func (p *projectRepo) SetArchived(ctx context.Context, name tenant.ProjectName, archived bool) error {
+	args := p.Called(ctx, name, archived)
+	return args.Error(0)
+}
+
 type presetRepo struct {
 	mock.Mock
 }
@@ -242,3 +450,12 @@ func (p *presetRepo) Delete(ctx context.Context, projectName tenant.ProjectName,
 	args := p.Called(ctx, projectName, presetName)
 	return args.Error(0)
 }
+
+type pluginRepo struct {
+	mock.Mock
+}
+
+func (p *pluginRepo) GetAll() []*plugin.Plugin {
+	args := p.Called()
+	return args.Get(0).([]*plugin.Plugin)
+}
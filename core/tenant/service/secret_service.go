@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/goto/salt/log"
 	"github.com/gtank/cryptopasta"
@@ -9,6 +10,7 @@ import (
 	"github.com/goto/optimus/core/tenant"
 	"github.com/goto/optimus/core/tenant/dto"
 	"github.com/goto/optimus/internal/errors"
+	"github.com/goto/optimus/internal/logging"
 )
 
 const keyLength = 32
@@ -20,6 +22,10 @@ type SecretRepository interface {
 	GetAll(ctx context.Context, projName tenant.ProjectName, nsName string) ([]*tenant.Secret, error)
 	Delete(ctx context.Context, projName tenant.ProjectName, nsName string, name tenant.SecretName) error
 	GetSecretsInfo(ctx context.Context, projName tenant.ProjectName) ([]*dto.SecretInfo, error)
+
+	Rotate(ctx context.Context, secret *tenant.Secret, readableUntil time.Time) (*dto.SecretRotation, error)
+	GetVersion(ctx context.Context, projName tenant.ProjectName, nsName string, name tenant.SecretName, version int) (*tenant.Secret, error)
+	GetStaleUsages(ctx context.Context, projName tenant.ProjectName, name tenant.SecretName) ([]*dto.SecretUsage, error)
 }
 
 type SecretService struct {
@@ -30,20 +36,21 @@ type SecretService struct {
 }
 
 func (s SecretService) Save(ctx context.Context, projName tenant.ProjectName, nsName string, secret *tenant.PlainTextSecret) error {
+	l := logging.ForTenant(s.logger, projName.String(), nsName, "")
 	if secret == nil {
-		s.logger.Error("secret is nil")
+		l.Error("secret is nil")
 		return errors.InvalidArgument(tenant.EntitySecret, "secret is not valid")
 	}
 
 	encoded, err := cryptopasta.Encrypt([]byte(secret.Value()), s.appKey)
 	if err != nil {
-		s.logger.Error("error encrypting secret: %s", err)
+		l.Error("error encrypting secret: %s", err)
 		return errors.InternalError(tenant.EntitySecret, "unable to encrypt the secret", err)
 	}
 
 	item, err := tenant.NewSecret(secret.Name().String(), string(encoded), projName, nsName)
 	if err != nil {
-		s.logger.Error("error encountered when constructing a new secret: %s", err)
+		l.Error("error encountered when constructing a new secret: %s", err)
 		return err
 	}
 
@@ -51,20 +58,21 @@ func (s SecretService) Save(ctx context.Context, projName tenant.ProjectName, ns
 }
 
 func (s SecretService) Update(ctx context.Context, projName tenant.ProjectName, nsName string, secret *tenant.PlainTextSecret) error {
+	l := logging.ForTenant(s.logger, projName.String(), nsName, "")
 	if secret == nil {
-		s.logger.Error("secret is nil")
+		l.Error("secret is nil")
 		return errors.InvalidArgument(tenant.EntitySecret, "secret is not valid")
 	}
 
 	encoded, err := cryptopasta.Encrypt([]byte(secret.Value()), s.appKey)
 	if err != nil {
-		s.logger.Error("error encrypting secret: %s", err)
+		l.Error("error encrypting secret: %s", err)
 		return errors.InternalError(tenant.EntitySecret, "unable to encrypt the secret", err)
 	}
 
 	item, err := tenant.NewSecret(secret.Name().String(), string(encoded), projName, nsName)
 	if err != nil {
-		s.logger.Error("error constructing a new secret: %s", err)
+		l.Error("error constructing a new secret: %s", err)
 		return err
 	}
 
@@ -72,41 +80,47 @@ func (s SecretService) Update(ctx context.Context, projName tenant.ProjectName,
 }
 
 func (s SecretService) Get(ctx context.Context, projName tenant.ProjectName, namespaceName, name string) (*tenant.PlainTextSecret, error) {
+	l := logging.ForTenant(s.logger, projName.String(), namespaceName, "")
 	secretName, err := tenant.SecretNameFrom(name)
 	if err != nil {
-		s.logger.Error("error adapting secret name [%s]: %s", name, err)
+		l.Error("error adapting secret name [%s]: %s", name, err)
 		return nil, errors.InvalidArgument(tenant.EntitySecret, "secret name is not valid")
 	}
 
 	if projName == "" {
-		s.logger.Error("project name for secret [%s] is empty")
+		l.Error("project name for secret [%s] is empty")
 		return nil, errors.InvalidArgument(tenant.EntitySecret, "tenant is not valid")
 	}
 
 	secret, err := s.repo.Get(ctx, projName, namespaceName, secretName)
 	if err != nil {
-		s.logger.Error("error getting stored secret: %s", err)
+		l.Error("error getting stored secret: %s", err)
 		return nil, err
 	}
 
 	cleartext, err := cryptopasta.Decrypt([]byte(secret.EncodedValue()), s.appKey)
 	if err != nil {
-		s.logger.Error("error decrypting secret: %s", err)
+		l.Error("error decrypting secret: %s", err)
 		return nil, err
 	}
 
-	return tenant.NewPlainTextSecret(secretName.String(), string(cleartext))
+	pts, err := tenant.NewPlainTextSecret(secretName.String(), string(cleartext))
+	if err != nil {
+		return nil, err
+	}
+	return pts.WithNamespaceName(secret.NamespaceName()), nil
 }
 
 func (s SecretService) GetAll(ctx context.Context, projName tenant.ProjectName, namespaceName string) ([]*tenant.PlainTextSecret, error) {
+	l := logging.ForTenant(s.logger, projName.String(), namespaceName, "")
 	if projName == "" {
-		s.logger.Error("project name is empty")
+		l.Error("project name is empty")
 		return nil, errors.InvalidArgument(tenant.EntitySecret, "project name is not valid")
 	}
 
 	secrets, err := s.repo.GetAll(ctx, projName, namespaceName)
 	if err != nil {
-		s.logger.Error("error getting all secrets under project [%s] namespace [%s]: %s", projName, namespaceName, err)
+		l.Error("error getting all secrets under project [%s] namespace [%s]: %s", projName, namespaceName, err)
 		return nil, err
 	}
 
@@ -114,24 +128,25 @@ func (s SecretService) GetAll(ctx context.Context, projName tenant.ProjectName,
 	for i, secret := range secrets {
 		cleartext, err := cryptopasta.Decrypt([]byte(secret.EncodedValue()), s.appKey)
 		if err != nil {
-			s.logger.Error("error decrypting secret [%s]: %s", secret.Name().String(), err)
+			l.Error("error decrypting secret [%s]: %s", secret.Name().String(), err)
 			return nil, err
 		}
 
 		pts, err := tenant.NewPlainTextSecret(secret.Name().String(), string(cleartext))
 		if err != nil {
-			s.logger.Error("error constructing plain text secret: %s", err)
+			l.Error("error constructing plain text secret: %s", err)
 			return nil, err
 		}
-		ptsecrets[i] = pts
+		ptsecrets[i] = pts.WithNamespaceName(secret.NamespaceName())
 	}
 
 	return ptsecrets, nil
 }
 
 func (s SecretService) Delete(ctx context.Context, projName tenant.ProjectName, nsName string, name tenant.SecretName) error {
+	l := logging.ForTenant(s.logger, projName.String(), nsName, "")
 	if name == "" {
-		s.logger.Error("secret name is empty")
+		l.Error("secret name is empty")
 		return errors.InvalidArgument(tenant.EntitySecret, "secret name is not valid")
 	}
 
@@ -142,6 +157,88 @@ func (s SecretService) GetSecretsInfo(ctx context.Context, projName tenant.Proje
 	return s.repo.GetSecretsInfo(ctx, projName)
 }
 
+// Rotate stores the secret as a new version, the jobs compiled from now on get the new value while the previous
+// version stays readable for the grace period, giving time to the jobs compiled against it to finish
+func (s SecretService) Rotate(ctx context.Context, projName tenant.ProjectName, nsName string, secret *tenant.PlainTextSecret, gracePeriod time.Duration) (*dto.SecretRotation, error) {
+	l := logging.ForTenant(s.logger, projName.String(), nsName, "")
+	if secret == nil {
+		l.Error("secret is nil")
+		return nil, errors.InvalidArgument(tenant.EntitySecret, "secret is not valid")
+	}
+
+	if gracePeriod < 0 {
+		l.Error("grace period of secret [%s] is negative", secret.Name())
+		return nil, errors.InvalidArgument(tenant.EntitySecret, "grace period should not be negative")
+	}
+
+	encoded, err := cryptopasta.Encrypt([]byte(secret.Value()), s.appKey)
+	if err != nil {
+		l.Error("error encrypting secret: %s", err)
+		return nil, errors.InternalError(tenant.EntitySecret, "unable to encrypt the secret", err)
+	}
+
+	item, err := tenant.NewSecret(secret.Name().String(), string(encoded), projName, nsName)
+	if err != nil {
+		l.Error("error constructing a new secret: %s", err)
+		return nil, err
+	}
+
+	rotation, err := s.repo.Rotate(ctx, item, time.Now().Add(gracePeriod))
+	if err != nil {
+		l.Error("error rotating secret [%s] of project [%s]: %s", secret.Name(), projName, err)
+		return nil, err
+	}
+	return rotation, nil
+}
+
+// GetVersion returns the given version of the secret, either the current one or a previous one within its grace period
+func (s SecretService) GetVersion(ctx context.Context, projName tenant.ProjectName, namespaceName, name string, version int) (*tenant.PlainTextSecret, error) {
+	l := logging.ForTenant(s.logger, projName.String(), namespaceName, "")
+	secretName, err := tenant.SecretNameFrom(name)
+	if err != nil {
+		l.Error("error adapting secret name [%s]: %s", name, err)
+		return nil, errors.InvalidArgument(tenant.EntitySecret, "secret name is not valid")
+	}
+
+	secret, err := s.repo.Get(ctx, projName, namespaceName, secretName)
+	if err != nil {
+		l.Error("error getting stored secret: %s", err)
+		return nil, err
+	}
+
+	if secret.Version() != version {
+		secret, err = s.repo.GetVersion(ctx, projName, namespaceName, secretName, version)
+		if err != nil {
+			l.Error("error getting version [%d] of secret [%s]: %s", version, secretName, err)
+			return nil, err
+		}
+	}
+
+	cleartext, err := cryptopasta.Decrypt([]byte(secret.EncodedValue()), s.appKey)
+	if err != nil {
+		l.Error("error decrypting secret: %s", err)
+		return nil, err
+	}
+
+	return tenant.NewPlainTextSecret(secretName.String(), string(cleartext))
+}
+
+// GetStaleUsages returns the jobs last compiled against a previous version of the secret
+func (s SecretService) GetStaleUsages(ctx context.Context, projName tenant.ProjectName, name tenant.SecretName) ([]*dto.SecretUsage, error) {
+	l := logging.ForTenant(s.logger, projName.String(), "", "")
+	if name == "" {
+		l.Error("secret name is empty")
+		return nil, errors.InvalidArgument(tenant.EntitySecret, "secret name is not valid")
+	}
+
+	usages, err := s.repo.GetStaleUsages(ctx, projName, name)
+	if err != nil {
+		l.Error("error getting stale usages of secret [%s]: %s", name, err)
+		return nil, err
+	}
+	return usages, nil
+}
+
 func NewSecretService(appKey *[32]byte, repo SecretRepository, logger log.Logger) *SecretService {
 	return &SecretService{
 		appKey: appKey,
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/goto/salt/log"
 	"github.com/stretchr/testify/assert"
@@ -263,6 +264,124 @@ func TestSecretService(t *testing.T) {
 			assert.Equal(t, "name", info[0].Name)
 		})
 	})
+	t.Run("Rotate", func(t *testing.T) {
+		t.Run("returns error when secret is not provided", func(t *testing.T) {
+			secretRepo := new(secretRepo)
+
+			secretService := service.NewSecretService(key, secretRepo, logger)
+			_, err := secretService.Rotate(ctx, projectName, nsName, nil, time.Hour)
+			assert.NotNil(t, err)
+			assert.EqualError(t, err, "invalid argument for entity secret: secret is not valid")
+		})
+		t.Run("returns error when grace period is negative", func(t *testing.T) {
+			sec, err := tenant.NewPlainTextSecret("name", "value")
+			assert.Nil(t, err)
+
+			secretRepo := new(secretRepo)
+
+			secretService := service.NewSecretService(key, secretRepo, logger)
+			_, err = secretService.Rotate(ctx, projectName, nsName, sec, -time.Hour)
+			assert.NotNil(t, err)
+			assert.EqualError(t, err, "invalid argument for entity secret: grace period should not be negative")
+		})
+		t.Run("returns error when repo return error", func(t *testing.T) {
+			sec, err := tenant.NewPlainTextSecret("name", "value")
+			assert.Nil(t, err)
+
+			secretRepo := new(secretRepo)
+			secretRepo.On("Rotate", ctx, mock.Anything, mock.Anything).Return(nil, errors.New("error in rotate"))
+			defer secretRepo.AssertExpectations(t)
+
+			secretService := service.NewSecretService(key, secretRepo, logger)
+			_, err = secretService.Rotate(ctx, projectName, nsName, sec, time.Hour)
+			assert.NotNil(t, err)
+			assert.EqualError(t, err, "error in rotate")
+		})
+		t.Run("rotates the secret keeping the previous version readable for the grace period", func(t *testing.T) {
+			sec, err := tenant.NewPlainTextSecret("name", "value")
+			assert.Nil(t, err)
+
+			rotation := &dto.SecretRotation{Name: "NAME", Version: 2, PreviousVersion: 1}
+			secretRepo := new(secretRepo)
+			secretRepo.On("Rotate", ctx, mock.Anything, mock.MatchedBy(func(readableUntil time.Time) bool {
+				return readableUntil.After(time.Now().Add(59*time.Minute)) && !readableUntil.After(time.Now().Add(time.Hour))
+			})).Return(rotation, nil)
+			defer secretRepo.AssertExpectations(t)
+
+			secretService := service.NewSecretService(key, secretRepo, logger)
+			result, err := secretService.Rotate(ctx, projectName, nsName, sec, time.Hour)
+			assert.Nil(t, err)
+			assert.Equal(t, rotation, result)
+		})
+	})
+	t.Run("GetVersion", func(t *testing.T) {
+		sn, err := tenant.SecretNameFrom("name")
+		assert.Nil(t, err)
+		encodedArr := []byte{
+			63, 158, 156, 88, 23, 217, 166, 22, 135, 126, 204, 156, 107, 103, 217, 229, 58, 37,
+			182, 124, 36, 80, 59, 94, 141, 238, 154, 6, 197, 70, 227, 117, 185,
+		}
+		sec, err := tenant.NewSecret("name", string(encodedArr), projectName, nsName)
+		assert.Nil(t, err)
+
+		t.Run("returns the current version of the secret", func(t *testing.T) {
+			secretRepo := new(secretRepo)
+			secretRepo.On("Get", ctx, projectName, nsName, sn).Return(sec.WithVersion(2), nil)
+			defer secretRepo.AssertExpectations(t)
+
+			secretService := service.NewSecretService(key, secretRepo, logger)
+			s, err := secretService.GetVersion(ctx, projectName, nsName, "name", 2)
+			assert.Nil(t, err)
+			assert.Equal(t, "value", s.Value())
+		})
+		t.Run("returns error when the previous version is not readable anymore", func(t *testing.T) {
+			secretRepo := new(secretRepo)
+			secretRepo.On("Get", ctx, projectName, nsName, sn).Return(sec.WithVersion(2), nil)
+			secretRepo.On("GetVersion", ctx, projectName, nsName, sn, 1).Return(nil, errors.New("version 1 of secret NAME is not readable"))
+			defer secretRepo.AssertExpectations(t)
+
+			secretService := service.NewSecretService(key, secretRepo, logger)
+			_, err := secretService.GetVersion(ctx, projectName, nsName, "name", 1)
+			assert.NotNil(t, err)
+			assert.EqualError(t, err, "version 1 of secret NAME is not readable")
+		})
+		t.Run("returns the previous version of the secret within the grace period", func(t *testing.T) {
+			secretRepo := new(secretRepo)
+			secretRepo.On("Get", ctx, projectName, nsName, sn).Return(sec.WithVersion(2), nil)
+			secretRepo.On("GetVersion", ctx, projectName, nsName, sn, 1).Return(sec.WithVersion(1), nil)
+			defer secretRepo.AssertExpectations(t)
+
+			secretService := service.NewSecretService(key, secretRepo, logger)
+			s, err := secretService.GetVersion(ctx, projectName, nsName, "name", 1)
+			assert.Nil(t, err)
+			assert.Equal(t, "NAME", s.Name().String())
+			assert.Equal(t, "value", s.Value())
+		})
+	})
+	t.Run("GetStaleUsages", func(t *testing.T) {
+		t.Run("returns error when secret name is empty", func(t *testing.T) {
+			secretRepo := new(secretRepo)
+
+			secretService := service.NewSecretService(key, secretRepo, logger)
+			_, err := secretService.GetStaleUsages(ctx, projectName, "")
+			assert.NotNil(t, err)
+			assert.EqualError(t, err, "invalid argument for entity secret: secret name is not valid")
+		})
+		t.Run("returns the jobs compiled against a previous version", func(t *testing.T) {
+			sn, err := tenant.SecretNameFrom("name")
+			assert.Nil(t, err)
+
+			usages := []*dto.SecretUsage{{JobName: "job-a", Version: 1}}
+			secretRepo := new(secretRepo)
+			secretRepo.On("GetStaleUsages", ctx, projectName, sn).Return(usages, nil)
+			defer secretRepo.AssertExpectations(t)
+
+			secretService := service.NewSecretService(key, secretRepo, logger)
+			result, err := secretService.GetStaleUsages(ctx, projectName, sn)
+			assert.Nil(t, err)
+			assert.Equal(t, usages, result)
+		})
+	})
 }
 
 type secretRepo struct {
@@ -310,3 +429,30 @@ func (s *secretRepo) GetSecretsInfo(ctx context.Context, projName tenant.Project
 	}
 	return secrets, args.Error(1)
 }
+
+func (s *secretRepo) Rotate(ctx context.Context, secret *tenant.Secret, readableUntil time.Time) (*dto.SecretRotation, error) {
+	args := s.Called(ctx, secret, readableUntil)
+	var rotation *dto.SecretRotation
+	if args.Get(0) != nil {
+		rotation = args.Get(0).(*dto.SecretRotation)
+	}
+	return rotation, args.Error(1)
+}
+
+func (s *secretRepo) GetVersion(ctx context.Context, projName tenant.ProjectName, nsName string, name tenant.SecretName, version int) (*tenant.Secret, error) {
+	args := s.Called(ctx, projName, nsName, name, version)
+	var sec *tenant.Secret
+	if args.Get(0) != nil {
+		sec = args.Get(0).(*tenant.Secret)
+	}
+	return sec, args.Error(1)
+}
+
+func (s *secretRepo) GetStaleUsages(ctx context.Context, projName tenant.ProjectName, name tenant.SecretName) ([]*dto.SecretUsage, error) {
+	args := s.Called(ctx, projName, name)
+	var usages []*dto.SecretUsage
+	if args.Get(0) != nil {
+		usages = args.Get(0).([]*dto.SecretUsage)
+	}
+	return usages, args.Error(1)
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+
+	"github.com/goto/salt/log"
+
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+	"github.com/goto/optimus/internal/logging"
+)
+
+type SnippetRepository interface {
+	Create(ctx context.Context, projectName tenant.ProjectName, snippet *tenant.Snippet) (*tenant.Snippet, error)
+	GetAll(ctx context.Context, projectName tenant.ProjectName) ([]*tenant.Snippet, error)
+}
+
+type SnippetService struct {
+	repo SnippetRepository
+
+	logger log.Logger
+}
+
+// Register stores the snippet as the next version of its name
+func (s SnippetService) Register(ctx context.Context, projectName tenant.ProjectName, snippet *tenant.Snippet) (*tenant.Snippet, error) {
+	l := logging.ForTenant(s.logger, projectName.String(), "", "")
+	if snippet == nil {
+		l.Error("snippet is nil")
+		return nil, errors.InvalidArgument(tenant.EntitySnippet, "snippet is not valid")
+	}
+
+	registered, err := s.repo.Create(ctx, projectName, snippet)
+	if err != nil {
+		l.Error("error registering snippet [%s] of project [%s]: %s", snippet.Name(), projectName, err)
+		return nil, err
+	}
+	return registered, nil
+}
+
+// GetAll returns every version of the snippets of the project, filtered by the name when it is not empty
+func (s SnippetService) GetAll(ctx context.Context, projectName tenant.ProjectName, name string) ([]*tenant.Snippet, error) {
+	l := logging.ForTenant(s.logger, projectName.String(), "", "")
+	snippets, err := s.repo.GetAll(ctx, projectName)
+	if err != nil {
+		l.Error("error getting snippets of project [%s]: %s", projectName, err)
+		return nil, err
+	}
+	if name == "" {
+		return snippets, nil
+	}
+
+	var filtered []*tenant.Snippet
+	for _, snippet := range snippets {
+		if snippet.Name() == name {
+			filtered = append(filtered, snippet)
+		}
+	}
+	return filtered, nil
+}
+
+// GetSnippets returns the contents of the snippets of the project to be included while compiling, keyed by the
+// name for the latest version and by the versioned name, like dedup_by_key@2, for every version
+func (s SnippetService) GetSnippets(ctx context.Context, projectName tenant.ProjectName) (map[string]string, error) {
+	l := logging.ForTenant(s.logger, projectName.String(), "", "")
+	snippets, err := s.repo.GetAll(ctx, projectName)
+	if err != nil {
+		l.Error("error getting snippets of project [%s]: %s", projectName, err)
+		return nil, err
+	}
+
+	contents := make(map[string]string)
+	latestVersions := make(map[string]int)
+	for _, snippet := range snippets {
+		contents[snippet.VersionedName()] = snippet.Content()
+		if snippet.Version() >= latestVersions[snippet.Name()] {
+			latestVersions[snippet.Name()] = snippet.Version()
+			contents[snippet.Name()] = snippet.Content()
+		}
+	}
+	return contents, nil
+}
+
+func NewSnippetService(repo SnippetRepository, logger log.Logger) *SnippetService {
+	return &SnippetService{
+		repo:   repo,
+		logger: logger,
+	}
+}
@@ -0,0 +1,123 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/core/tenant/service"
+)
+
+func TestSnippetService(t *testing.T) {
+	ctx := context.Background()
+	projectName, _ := tenant.ProjectNameFrom("test-project")
+	logger := log.NewNoop()
+
+	dedupV1, _ := tenant.NewSnippet("dedup_by_key", "select 1", 1)
+	dedupV2, _ := tenant.NewSnippet("dedup_by_key", "select 2", 2)
+	latest, _ := tenant.NewSnippet("latest_partition", "select 3", 1)
+
+	t.Run("Register", func(t *testing.T) {
+		t.Run("returns error when snippet is not provided", func(t *testing.T) {
+			snippetService := service.NewSnippetService(new(snippetRepo), logger)
+
+			_, err := snippetService.Register(ctx, projectName, nil)
+			assert.EqualError(t, err, "invalid argument for entity snippet: snippet is not valid")
+		})
+		t.Run("returns error when repo returns error", func(t *testing.T) {
+			snippet, _ := tenant.NewSnippet("dedup_by_key", "select 1", 0)
+			repo := new(snippetRepo)
+			repo.On("Create", ctx, projectName, snippet).Return(nil, errors.New("error in create"))
+			defer repo.AssertExpectations(t)
+
+			snippetService := service.NewSnippetService(repo, logger)
+			_, err := snippetService.Register(ctx, projectName, snippet)
+			assert.EqualError(t, err, "error in create")
+		})
+		t.Run("returns the registered version", func(t *testing.T) {
+			snippet, _ := tenant.NewSnippet("dedup_by_key", "select 2", 0)
+			repo := new(snippetRepo)
+			repo.On("Create", ctx, projectName, snippet).Return(dedupV2, nil)
+			defer repo.AssertExpectations(t)
+
+			snippetService := service.NewSnippetService(repo, logger)
+			registered, err := snippetService.Register(ctx, projectName, snippet)
+			assert.NoError(t, err)
+			assert.Equal(t, 2, registered.Version())
+		})
+	})
+	t.Run("GetAll", func(t *testing.T) {
+		t.Run("returns every version of the snippets", func(t *testing.T) {
+			repo := new(snippetRepo)
+			repo.On("GetAll", ctx, projectName).Return([]*tenant.Snippet{dedupV1, dedupV2, latest}, nil)
+			defer repo.AssertExpectations(t)
+
+			snippetService := service.NewSnippetService(repo, logger)
+			snippets, err := snippetService.GetAll(ctx, projectName, "")
+			assert.NoError(t, err)
+			assert.Equal(t, []*tenant.Snippet{dedupV1, dedupV2, latest}, snippets)
+		})
+		t.Run("returns the versions of the given name", func(t *testing.T) {
+			repo := new(snippetRepo)
+			repo.On("GetAll", ctx, projectName).Return([]*tenant.Snippet{dedupV1, dedupV2, latest}, nil)
+			defer repo.AssertExpectations(t)
+
+			snippetService := service.NewSnippetService(repo, logger)
+			snippets, err := snippetService.GetAll(ctx, projectName, "dedup_by_key")
+			assert.NoError(t, err)
+			assert.Equal(t, []*tenant.Snippet{dedupV1, dedupV2}, snippets)
+		})
+	})
+	t.Run("GetSnippets", func(t *testing.T) {
+		t.Run("returns error when repo returns error", func(t *testing.T) {
+			repo := new(snippetRepo)
+			repo.On("GetAll", ctx, projectName).Return(nil, errors.New("error in get"))
+			defer repo.AssertExpectations(t)
+
+			snippetService := service.NewSnippetService(repo, logger)
+			_, err := snippetService.GetSnippets(ctx, projectName)
+			assert.EqualError(t, err, "error in get")
+		})
+		t.Run("returns contents by name for latest version and by versioned name", func(t *testing.T) {
+			repo := new(snippetRepo)
+			repo.On("GetAll", ctx, projectName).Return([]*tenant.Snippet{dedupV1, dedupV2, latest}, nil)
+			defer repo.AssertExpectations(t)
+
+			snippetService := service.NewSnippetService(repo, logger)
+			contents, err := snippetService.GetSnippets(ctx, projectName)
+			assert.NoError(t, err)
+			assert.Equal(t, map[string]string{
+				"dedup_by_key":       "select 2",
+				"dedup_by_key@1":     "select 1",
+				"dedup_by_key@2":     "select 2",
+				"latest_partition":   "select 3",
+				"latest_partition@1": "select 3",
+			}, contents)
+		})
+	})
+}
+
+type snippetRepo struct {
+	mock.Mock
+}
+
+func (s *snippetRepo) Create(ctx context.Context, projectName tenant.ProjectName, snippet *tenant.Snippet) (*tenant.Snippet, error) {
+	args := s.Called(ctx, projectName, snippet)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*tenant.Snippet), args.Error(1)
+}
+
+func (s *snippetRepo) GetAll(ctx context.Context, projectName tenant.ProjectName) ([]*tenant.Snippet, error) {
+	args := s.Called(ctx, projectName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*tenant.Snippet), args.Error(1)
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/goto/optimus/core/tenant"
 	"github.com/goto/optimus/internal/errors"
+	"github.com/goto/optimus/internal/logging"
 )
 
 type ProjectGetter interface {
@@ -31,54 +32,98 @@ type TenantService struct {
 }
 
 func (t TenantService) GetDetails(ctx context.Context, tnnt tenant.Tenant) (*tenant.WithDetails, error) {
+	l := logging.ForTenant(t.logger, tnnt.ProjectName().String(), tnnt.NamespaceName().String(), "")
 	if tnnt.IsInvalid() {
-		t.logger.Error("tenant information is invalid")
+		l.Error("tenant information is invalid")
 		return nil, errors.InvalidArgument(tenant.EntityTenant, "invalid tenant details provided")
 	}
 
 	proj, err := t.projGetter.Get(ctx, tnnt.ProjectName())
 	if err != nil {
-		t.logger.Error("error getting project [%s]: %s", tnnt.ProjectName().String(), err)
+		l.Error("error getting project [%s]: %s", tnnt.ProjectName().String(), err)
 		return nil, err
 	}
 
 	namespace, err := t.namespaceGetter.Get(ctx, tnnt.ProjectName(), tnnt.NamespaceName())
 	if err != nil {
-		t.logger.Error("error getting namespace [%s]: %s", tnnt.NamespaceName().String(), err)
+		l.Error("error getting namespace [%s]: %s", tnnt.NamespaceName().String(), err)
 		return nil, err
 	}
 
 	secrets, err := t.secretsGetter.GetAll(ctx, tnnt.ProjectName(), tnnt.NamespaceName().String())
 	if err != nil {
-		t.logger.Error("error getting all secrets for project [%s] namespace [%s]: %s", tnnt.ProjectName(), tnnt.NamespaceName(), err)
+		l.Error("error getting all secrets for project [%s] namespace [%s]: %s", tnnt.ProjectName(), tnnt.NamespaceName(), err)
 		return nil, err
 	}
 
-	return tenant.NewTenantDetails(proj, namespace, secrets)
+	return tenant.NewTenantDetails(proj, namespace, visibleSecrets(namespace, secrets))
 }
 
 func (t TenantService) GetProject(ctx context.Context, name tenant.ProjectName) (*tenant.Project, error) {
+	l := logging.ForTenant(t.logger, name.String(), "", "")
 	if name == "" {
-		t.logger.Error("project name is empty")
+		l.Error("project name is empty")
 		return nil, errors.InvalidArgument(tenant.EntityTenant, "invalid project name")
 	}
 	return t.projGetter.Get(ctx, name)
 }
 
 func (t TenantService) GetSecrets(ctx context.Context, tnnt tenant.Tenant) ([]*tenant.PlainTextSecret, error) {
+	l := logging.ForTenant(t.logger, tnnt.ProjectName().String(), tnnt.NamespaceName().String(), "")
 	if tnnt.IsInvalid() {
-		t.logger.Error("tenant information is invalid")
+		l.Error("tenant information is invalid")
 		return nil, errors.InvalidArgument(tenant.EntityTenant, "tenant is invalid")
 	}
-	return t.secretsGetter.GetAll(ctx, tnnt.ProjectName(), tnnt.NamespaceName().String())
+
+	namespace, err := t.namespaceGetter.Get(ctx, tnnt.ProjectName(), tnnt.NamespaceName())
+	if err != nil {
+		l.Error("error getting namespace [%s]: %s", tnnt.NamespaceName().String(), err)
+		return nil, err
+	}
+
+	secrets, err := t.secretsGetter.GetAll(ctx, tnnt.ProjectName(), tnnt.NamespaceName().String())
+	if err != nil {
+		l.Error("error getting all secrets for project [%s] namespace [%s]: %s", tnnt.ProjectName(), tnnt.NamespaceName(), err)
+		return nil, err
+	}
+	return visibleSecrets(namespace, secrets), nil
 }
 
 func (t TenantService) GetSecret(ctx context.Context, tnnt tenant.Tenant, name string) (*tenant.PlainTextSecret, error) {
+	l := logging.ForTenant(t.logger, tnnt.ProjectName().String(), tnnt.NamespaceName().String(), "")
 	if tnnt.IsInvalid() {
-		t.logger.Error("tenant information is invalid")
+		l.Error("tenant information is invalid")
 		return nil, errors.InvalidArgument(tenant.EntityTenant, "tenant is invalid")
 	}
-	return t.secretsGetter.Get(ctx, tnnt.ProjectName(), tnnt.NamespaceName().String(), name)
+
+	namespace, err := t.namespaceGetter.Get(ctx, tnnt.ProjectName(), tnnt.NamespaceName())
+	if err != nil {
+		l.Error("error getting namespace [%s]: %s", tnnt.NamespaceName().String(), err)
+		return nil, err
+	}
+
+	secret, err := t.secretsGetter.Get(ctx, tnnt.ProjectName(), tnnt.NamespaceName().String(), name)
+	if err != nil {
+		l.Error("error getting secret [%s] for project [%s] namespace [%s]: %s", name, tnnt.ProjectName(), tnnt.NamespaceName(), err)
+		return nil, err
+	}
+	// the project secrets not allowed in the namespace are reported as missing, the same as in GetSecrets
+	if !namespace.AllowsSecret(secret) {
+		l.Warn("secret [%s] is not allowed in namespace [%s]", name, tnnt.NamespaceName())
+		return nil, errors.NotFound(tenant.EntitySecret, "no secret "+name+" in namespace "+tnnt.NamespaceName().String())
+	}
+	return secret, nil
+}
+
+// visibleSecrets leaves out the project secrets not allowed in the namespace
+func visibleSecrets(namespace *tenant.Namespace, secrets []*tenant.PlainTextSecret) []*tenant.PlainTextSecret {
+	visible := make([]*tenant.PlainTextSecret, 0, len(secrets))
+	for _, secret := range secrets {
+		if namespace.AllowsSecret(secret) {
+			visible = append(visible, secret)
+		}
+	}
+	return visible
 }
 
 func NewTenantService(projGetter ProjectGetter, nsGetter NamespaceGetter, secretsGetter SecretsGetter, logger log.Logger) *TenantService {
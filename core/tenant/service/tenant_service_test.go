@@ -105,6 +105,33 @@ func TestTenantService(t *testing.T) {
 			assert.Equal(t, 1, len(sec))
 			assert.Equal(t, "value1", sec[pts.Name().String()])
 		})
+		t.Run("returns only the project secrets allowed in the namespace", func(t *testing.T) {
+			restrictedNS, _ := tenant.NewNamespace("testNS", proj.Name(), map[string]string{
+				tenant.NamespaceProjectSecrets: "bq_*",
+			})
+
+			projGetter := new(projectGetter)
+			projGetter.On("Get", ctx, tnnt.ProjectName()).Return(proj, nil)
+			defer projGetter.AssertExpectations(t)
+
+			nsGetter := new(namespaceGetter)
+			nsGetter.On("Get", ctx, tnnt.ProjectName(), tnnt.NamespaceName()).Return(restrictedNS, nil)
+			defer nsGetter.AssertExpectations(t)
+
+			bqSecret, _ := tenant.NewPlainTextSecret("bq_account", "value1")
+			apiSecret, _ := tenant.NewPlainTextSecret("api_token", "value2")
+			nsSecret, _ := tenant.NewPlainTextSecret("db_password", "value3")
+			secGetter := new(secretGetter)
+			secGetter.On("GetAll", ctx, tnnt.ProjectName(), tnnt.NamespaceName().String()).
+				Return([]*tenant.PlainTextSecret{bqSecret, apiSecret, nsSecret.WithNamespaceName("testNS")}, nil)
+			defer secGetter.AssertExpectations(t)
+
+			tenantService := service.NewTenantService(projGetter, nsGetter, secGetter, logger)
+
+			d, err := tenantService.GetDetails(ctx, tnnt)
+			assert.Nil(t, err)
+			assert.Equal(t, map[string]string{"BQ_ACCOUNT": "value1", "DB_PASSWORD": "value3"}, d.SecretsMap())
+		})
 	})
 	t.Run("GetProject", func(t *testing.T) {
 		t.Run("returns error when project name is invalid", func(t *testing.T) {
@@ -139,18 +166,55 @@ func TestTenantService(t *testing.T) {
 			assert.NotNil(t, err)
 			assert.EqualError(t, err, "invalid argument for entity tenant: tenant is invalid")
 		})
+		t.Run("returns error when unable to get namespace", func(t *testing.T) {
+			nsGetter := new(namespaceGetter)
+			nsGetter.On("Get", ctx, tnnt.ProjectName(), tnnt.NamespaceName()).Return(nil, errors.New("unable to get ns"))
+			defer nsGetter.AssertExpectations(t)
+
+			tenantService := service.NewTenantService(nil, nsGetter, nil, logger)
+
+			_, err := tenantService.GetSecrets(ctx, tnnt)
+			assert.NotNil(t, err)
+			assert.EqualError(t, err, "unable to get ns")
+		})
 		t.Run("calls secrets getter to get all the secrets for tenant", func(t *testing.T) {
+			nsGetter := new(namespaceGetter)
+			nsGetter.On("Get", ctx, tnnt.ProjectName(), tnnt.NamespaceName()).Return(ns, nil)
+			defer nsGetter.AssertExpectations(t)
+
 			pts, _ := tenant.NewPlainTextSecret("secret_name", "secret_value")
 			secretsGetter := new(secretGetter)
 			secretsGetter.On("GetAll", ctx, proj.Name(), ns.Name().String()).Return([]*tenant.PlainTextSecret{pts}, nil)
 			defer secretsGetter.AssertExpectations(t)
 
-			tenantService := service.NewTenantService(nil, nil, secretsGetter, logger)
+			tenantService := service.NewTenantService(nil, nsGetter, secretsGetter, logger)
 
 			secrets, err := tenantService.GetSecrets(ctx, tnnt)
 			assert.Nil(t, err)
 			assert.Equal(t, 1, len(secrets))
 		})
+		t.Run("leaves out the project secrets not allowed in the namespace", func(t *testing.T) {
+			restrictedNS, _ := tenant.NewNamespace("testNS", proj.Name(), map[string]string{
+				tenant.NamespaceProjectSecrets: "api_token",
+			})
+			nsGetter := new(namespaceGetter)
+			nsGetter.On("Get", ctx, tnnt.ProjectName(), tnnt.NamespaceName()).Return(restrictedNS, nil)
+			defer nsGetter.AssertExpectations(t)
+
+			apiSecret, _ := tenant.NewPlainTextSecret("api_token", "value1")
+			dbSecret, _ := tenant.NewPlainTextSecret("db_password", "value2")
+			storageSecret, _ := tenant.NewPlainTextSecret(tenant.SecretStorageKey, "value3")
+			secretsGetter := new(secretGetter)
+			secretsGetter.On("GetAll", ctx, proj.Name(), ns.Name().String()).
+				Return([]*tenant.PlainTextSecret{apiSecret, dbSecret, storageSecret}, nil)
+			defer secretsGetter.AssertExpectations(t)
+
+			tenantService := service.NewTenantService(nil, nsGetter, secretsGetter, logger)
+
+			secrets, err := tenantService.GetSecrets(ctx, tnnt)
+			assert.Nil(t, err)
+			assert.Equal(t, tenant.SecretMap{"API_TOKEN": "value1", "STORAGE": "value3"}, tenant.PlainTextSecrets(secrets).ToSecretMap())
+		})
 	})
 	t.Run("GetSecret", func(t *testing.T) {
 		t.Run("return error when project name is invalid", func(t *testing.T) {
@@ -161,17 +225,67 @@ func TestTenantService(t *testing.T) {
 			assert.NotNil(t, err)
 			assert.EqualError(t, err, "invalid argument for entity tenant: tenant is invalid")
 		})
+		t.Run("returns error when unable to get namespace", func(t *testing.T) {
+			nsGetter := new(namespaceGetter)
+			nsGetter.On("Get", ctx, tnnt.ProjectName(), tnnt.NamespaceName()).Return(nil, errors.New("unable to get ns"))
+			defer nsGetter.AssertExpectations(t)
+
+			tenantService := service.NewTenantService(nil, nsGetter, nil, logger)
+
+			_, err := tenantService.GetSecret(ctx, tnnt, "secret_name")
+			assert.EqualError(t, err, "unable to get ns")
+		})
 		t.Run("calls secrets getter to get the secret for tenant", func(t *testing.T) {
+			nsGetter := new(namespaceGetter)
+			nsGetter.On("Get", ctx, tnnt.ProjectName(), tnnt.NamespaceName()).Return(ns, nil)
+			defer nsGetter.AssertExpectations(t)
+
 			pts, _ := tenant.NewPlainTextSecret("secret_name", "secret_value")
 			secretsGetter := new(secretGetter)
 			secretsGetter.On("Get", ctx, proj.Name(), ns.Name().String(), "secret_name").Return(pts, nil)
 			defer secretsGetter.AssertExpectations(t)
-			tenantService := service.NewTenantService(nil, nil, secretsGetter, logger)
+			tenantService := service.NewTenantService(nil, nsGetter, secretsGetter, logger)
 
 			secret, err := tenantService.GetSecret(ctx, tnnt, "secret_name")
 			assert.Nil(t, err)
 			assert.Equal(t, "secret_value", secret.Value())
 		})
+		t.Run("returns not found for the project secret not allowed in the namespace", func(t *testing.T) {
+			restrictedNS, _ := tenant.NewNamespace("testNS", proj.Name(), map[string]string{
+				tenant.NamespaceProjectSecrets: "api_token",
+			})
+			nsGetter := new(namespaceGetter)
+			nsGetter.On("Get", ctx, tnnt.ProjectName(), tnnt.NamespaceName()).Return(restrictedNS, nil)
+			defer nsGetter.AssertExpectations(t)
+
+			dbSecret, _ := tenant.NewPlainTextSecret("db_password", "value")
+			secretsGetter := new(secretGetter)
+			secretsGetter.On("Get", ctx, proj.Name(), ns.Name().String(), "db_password").Return(dbSecret, nil)
+			defer secretsGetter.AssertExpectations(t)
+			tenantService := service.NewTenantService(nil, nsGetter, secretsGetter, logger)
+
+			secret, err := tenantService.GetSecret(ctx, tnnt, "db_password")
+			assert.Nil(t, secret)
+			assert.ErrorContains(t, err, "not found for entity secret")
+		})
+		t.Run("returns the datastore secret regardless of the allow-list of the namespace", func(t *testing.T) {
+			restrictedNS, _ := tenant.NewNamespace("testNS", proj.Name(), map[string]string{
+				tenant.NamespaceProjectSecrets: "api_token",
+			})
+			nsGetter := new(namespaceGetter)
+			nsGetter.On("Get", ctx, tnnt.ProjectName(), tnnt.NamespaceName()).Return(restrictedNS, nil)
+			defer nsGetter.AssertExpectations(t)
+
+			datastoreSecret, _ := tenant.NewPlainTextSecret("DATASTORE_BIGQUERY", "value")
+			secretsGetter := new(secretGetter)
+			secretsGetter.On("Get", ctx, proj.Name(), ns.Name().String(), "DATASTORE_BIGQUERY").Return(datastoreSecret, nil)
+			defer secretsGetter.AssertExpectations(t)
+			tenantService := service.NewTenantService(nil, nsGetter, secretsGetter, logger)
+
+			secret, err := tenantService.GetSecret(ctx, tnnt, "DATASTORE_BIGQUERY")
+			assert.Nil(t, err)
+			assert.Equal(t, "value", secret.Value())
+		})
 	})
 }
 
@@ -0,0 +1,64 @@
+package tenant
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/goto/optimus/internal/errors"
+)
+
+const (
+	EntitySnippet = "snippet"
+
+	// SnippetVersionSeparator separates the name and the version when a snippet is referred at a version, like dedup_by_key@2
+	SnippetVersionSeparator = "@"
+)
+
+var snippetNameRegex = regexp.MustCompile(`^[a-zA-Z0-9_\-.]+$`)
+
+// Snippet is a template registered under a project, which asset templates of the project include by name.
+// Every registration of a name adds a new version, the latest version is used unless a version is referred
+type Snippet struct {
+	name    string
+	version int
+	content string
+}
+
+func NewSnippet(name, content string, version int) (*Snippet, error) {
+	if name == "" {
+		return nil, errors.InvalidArgument(EntitySnippet, "snippet name is empty")
+	}
+	if !snippetNameRegex.MatchString(name) {
+		return nil, errors.InvalidArgument(EntitySnippet, "snippet name "+name+" can only contain letters, numbers, underscore, hyphen and dot")
+	}
+	if content == "" {
+		return nil, errors.InvalidArgument(EntitySnippet, "snippet content is empty for "+name)
+	}
+	if version < 0 {
+		return nil, errors.InvalidArgument(EntitySnippet, "invalid version for snippet "+name)
+	}
+
+	return &Snippet{
+		name:    name,
+		version: version,
+		content: content,
+	}, nil
+}
+
+func (s *Snippet) Name() string {
+	return s.name
+}
+
+// Version is zero for a snippet not registered yet
+func (s *Snippet) Version() int {
+	return s.version
+}
+
+func (s *Snippet) Content() string {
+	return s.content
+}
+
+// VersionedName is the name to include the snippet at its version
+func (s *Snippet) VersionedName() string {
+	return fmt.Sprintf("%s%s%d", s.name, SnippetVersionSeparator, s.version)
+}
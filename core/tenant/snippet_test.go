@@ -0,0 +1,37 @@
+package tenant_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/core/tenant"
+)
+
+func TestEntitySnippet(t *testing.T) {
+	t.Run("returns error when name is empty", func(t *testing.T) {
+		_, err := tenant.NewSnippet("", "select 1", 0)
+		assert.EqualError(t, err, "invalid argument for entity snippet: snippet name is empty")
+	})
+	t.Run("returns error when name has invalid characters", func(t *testing.T) {
+		_, err := tenant.NewSnippet("dedup@2", "select 1", 0)
+		assert.EqualError(t, err, "invalid argument for entity snippet: snippet name dedup@2 can only contain letters, numbers, underscore, hyphen and dot")
+	})
+	t.Run("returns error when content is empty", func(t *testing.T) {
+		_, err := tenant.NewSnippet("dedup_by_key", "", 0)
+		assert.EqualError(t, err, "invalid argument for entity snippet: snippet content is empty for dedup_by_key")
+	})
+	t.Run("returns error when version is negative", func(t *testing.T) {
+		_, err := tenant.NewSnippet("dedup_by_key", "select 1", -1)
+		assert.EqualError(t, err, "invalid argument for entity snippet: invalid version for snippet dedup_by_key")
+	})
+	t.Run("creates the snippet", func(t *testing.T) {
+		snippet, err := tenant.NewSnippet("dedup_by_key", "select 1", 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, "dedup_by_key", snippet.Name())
+		assert.Equal(t, 2, snippet.Version())
+		assert.Equal(t, "select 1", snippet.Content())
+		assert.Equal(t, "dedup_by_key@2", snippet.VersionedName())
+	})
+}
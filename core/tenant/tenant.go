@@ -62,6 +62,11 @@ func NewTenantDetails(proj *Project, namespace *Namespace, secrets PlainTextSecr
 	}, nil
 }
 
+// IsArchived is true when either the project or the namespace of the tenant is archived
+func (w *WithDetails) IsArchived() bool {
+	return w.project.IsArchived() || w.namespace.IsArchived()
+}
+
 func (w *WithDetails) ToTenant() Tenant {
 	return Tenant{
 		projName: w.project.Name(),
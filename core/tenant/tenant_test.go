@@ -117,6 +117,18 @@ func TestAggregateRootTenant(t *testing.T) {
 				assert.Len(t, secMap, 2)
 				assert.Equal(t, "value2", secMap[p2.Name().String()])
 			})
+			t.Run("returns archived when either project or namespace is archived", func(t *testing.T) {
+				details, err := tenant.NewTenantDetails(project, namespace, nil)
+				assert.NoError(t, err)
+				assert.False(t, details.IsArchived())
+
+				archivedNamespace, _ := tenant.NewNamespace("test-ns", project.Name(), map[string]string{})
+				archivedNamespace.SetArchived(true)
+
+				details, err = tenant.NewTenantDetails(project, archivedNamespace, nil)
+				assert.NoError(t, err)
+				assert.True(t, details.IsArchived())
+			})
 		})
 	})
 }
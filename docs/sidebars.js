@@ -69,6 +69,7 @@ module.exports = {
         "client-guide/organizing-specifications",
         "client-guide/backup-bigquery-resource",
         "client-guide/replay-a-job",
+        "client-guide/querying-audit-logs",
         "client-guide/work-with-extension",
         "client-guide/defining-scheduler-version",
       ],
@@ -79,6 +80,7 @@ module.exports = {
       items: [
         "building-plugin/introduction",
         "building-plugin/tutorial",
+        "building-plugin/executor-contract",
       ],
     },
     {
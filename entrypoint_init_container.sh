@@ -15,10 +15,13 @@ echo "INSTANCE_TYPE:$INSTANCE_TYPE"
 echo "INSTANCE_NAME:$INSTANCE_NAME"
 echo "SCHEDULED_AT:$SCHEDULED_AT"
 echo "OPTIMUS_HOST:$OPTIMUS_HOST"
+echo "JOB_RUN_ATTEMPT:$JOB_RUN_ATTEMPT"
+echo "SCHEDULER_DAG_RUN_ID:$SCHEDULER_DAG_RUN_ID"
 echo ""
 
 echo "-- initializing optimus assets"
 optimus job run-input "$JOB_NAME" --project-name \
 	"$PROJECT" --output-dir "$JOB_DIR" \
 	--type "$INSTANCE_TYPE" --name "$INSTANCE_NAME" \
-	--scheduled-at "$SCHEDULED_AT" --host "$OPTIMUS_HOST"
+	--scheduled-at "$SCHEDULED_AT" --host "$OPTIMUS_HOST" \
+	--attempt "$JOB_RUN_ATTEMPT" --scheduler-run-id "$SCHEDULER_DAG_RUN_ID"
@@ -13,5 +13,8 @@ implementations:
     Contains implementation for notification.
   - Scheduler
     Contains implementation for scheduler.
+  - Secret
+    Contains implementation for external secret
+    providers like vault and gcp secret manager.
 */
 package ext
@@ -3,4 +3,5 @@ package notify
 import (
 	_ "github.com/goto/optimus/ext/notify/pagerduty"
 	_ "github.com/goto/optimus/ext/notify/slack"
+	_ "github.com/goto/optimus/ext/notify/webhook"
 )
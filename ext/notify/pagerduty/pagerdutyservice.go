@@ -20,6 +20,8 @@ type customDetails struct {
 	JobURL    string `json:"job_url"`
 	Exception string `json:"exception"`
 	Message   string `json:"message"`
+
+	FailureCategory string `json:"failure_category,omitempty"`
 }
 
 func buildPayloadCustomDetails(evt Event) (string, error) {
@@ -36,6 +38,7 @@ func buildPayloadCustomDetails(evt Event) (string, error) {
 	if message, ok := evt.meta.Values["message"]; ok && message.(string) != "" {
 		details.Message = message.(string)
 	}
+	details.FailureCategory = evt.meta.FailureCategory().String()
 
 	det, err := json.Marshal(&details)
 	if err != nil {
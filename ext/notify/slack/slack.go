@@ -143,7 +143,21 @@ func buildMessageBlocks(events []event, workerErrChan chan error) []api.Block {
 
 		projectName := evt.meta.Tenant.ProjectName().String()
 		namespaceName := evt.meta.Tenant.NamespaceName().String()
-		if evt.meta.Type.IsOfType(scheduler.EventCategorySLAMiss) {
+		if evt.meta.Type == scheduler.SLAProjectedBreachEvent {
+			heading := api.NewTextBlockObject("plain_text",
+				fmt.Sprintf("[Job] SLA Breach Projected | %s/%s", projectName, namespaceName), true, false)
+			blocks = append(blocks, api.NewHeaderBlock(heading))
+
+			if scheduledAt, ok := evt.meta.Values["scheduled_at"]; ok && scheduledAt.(string) != "" {
+				fieldSlice = append(fieldSlice, api.NewTextBlockObject("mrkdwn", fmt.Sprintf("*Scheduled At:*\n%s", scheduledAt.(string)), false, false))
+			}
+			if deadline, ok := evt.meta.Values["sla_deadline"]; ok && deadline.(string) != "" {
+				fieldSlice = append(fieldSlice, api.NewTextBlockObject("mrkdwn", fmt.Sprintf("*SLA Deadline:*\n%s", deadline.(string)), false, false))
+			}
+			if projectedEnd, ok := evt.meta.Values["projected_end_time"]; ok && projectedEnd.(string) != "" {
+				fieldSlice = append(fieldSlice, api.NewTextBlockObject("mrkdwn", fmt.Sprintf("*Projected End:*\n%s", projectedEnd.(string)), false, false))
+			}
+		} else if evt.meta.Type.IsOfType(scheduler.EventCategorySLAMiss) {
 			heading := api.NewTextBlockObject("plain_text",
 				fmt.Sprintf("[Job] SLA Breached | %s/%s", projectName, namespaceName), true, false)
 			blocks = append(blocks, api.NewHeaderBlock(heading))
@@ -185,6 +199,23 @@ func buildMessageBlocks(events []event, workerErrChan chan error) []api.Block {
 			if taskID, ok := evt.meta.Values["task_id"]; ok && taskID.(string) != "" {
 				fieldSlice = append(fieldSlice, api.NewTextBlockObject("mrkdwn", fmt.Sprintf("*Task ID:*\n%s", taskID.(string)), false, false))
 			}
+			if category := evt.meta.FailureCategory(); category != "" {
+				fieldSlice = append(fieldSlice, api.NewTextBlockObject("mrkdwn", fmt.Sprintf("*Failure Category:*\n%s", category), false, false))
+			}
+		} else if evt.meta.Type == scheduler.UpstreamAccessRequestEvent {
+			heading := api.NewTextBlockObject("plain_text",
+				fmt.Sprintf("[Job] Upstream Access Request | %s/%s", projectName, namespaceName), true, false)
+			blocks = append(blocks, api.NewHeaderBlock(heading))
+
+			if downstreamProject, ok := evt.meta.Values["downstream_project"]; ok {
+				fieldSlice = append(fieldSlice, api.NewTextBlockObject("mrkdwn", fmt.Sprintf("*Requested By Project:*\n%s", downstreamProject.(string)), false, false))
+			}
+			if downstreamJob, ok := evt.meta.Values["downstream_job"]; ok {
+				fieldSlice = append(fieldSlice, api.NewTextBlockObject("mrkdwn", fmt.Sprintf("*Requested By Job:*\n%s", downstreamJob.(string)), false, false))
+			}
+			if requestID, ok := evt.meta.Values["request_id"]; ok {
+				fieldSlice = append(fieldSlice, api.NewTextBlockObject("mrkdwn", fmt.Sprintf("*Request ID:*\n%s", requestID.(string)), false, false))
+			}
 		} else {
 			workerErrChan <- fmt.Errorf("worker_buildMessageBlocks: unknown event type: %v", evt.meta.Type)
 			continue
@@ -255,6 +255,60 @@ func TestBuildMessages(t *testing.T) {
             }
         ]
     }
+]`,
+		},
+		{
+			name: "should parse projected sla breach values correctly",
+			args: args{events: []event{
+				{
+					authToken: "xx",
+					owner:     "rr",
+					meta: &scheduler.Event{
+						JobName: jobName,
+						Tenant:  tnnt,
+						Type:    scheduler.SLAProjectedBreachEvent,
+						Values: map[string]any{
+							"scheduled_at":       "2021-07-12T07:40:00Z",
+							"sla_deadline":       "2021-07-12T08:40:00Z",
+							"projected_end_time": "2021-07-12T09:10:00Z",
+						},
+					},
+				},
+			}},
+			want: `[
+    {
+        "type": "header",
+        "text": {
+            "type": "plain_text",
+            "text": "[Job] SLA Breach Projected | foo/test",
+            "emoji": true
+        }
+    },
+    {
+        "type": "section",
+        "fields": [
+            {
+                "type": "mrkdwn",
+                "text": "*Job:*\nfoo-job-spec"
+            },
+            {
+                "type": "mrkdwn",
+                "text": "*Owner:*\nrr"
+            },
+            {
+                "type": "mrkdwn",
+                "text": "*Scheduled At:*\n2021-07-12T07:40:00Z"
+            },
+            {
+                "type": "mrkdwn",
+                "text": "*SLA Deadline:*\n2021-07-12T08:40:00Z"
+            },
+            {
+                "type": "mrkdwn",
+                "text": "*Projected End:*\n2021-07-12T09:10:00Z"
+            }
+        ]
+    }
 ]`,
 		},
 	}
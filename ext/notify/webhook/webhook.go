@@ -0,0 +1,104 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/goto/optimus/core/scheduler"
+)
+
+const (
+	DefaultTimeout = time.Second * 10
+)
+
+// Payload is the json posted to the webhook for every alert
+type Payload struct {
+	Project     string         `json:"project"`
+	Namespace   string         `json:"namespace"`
+	Job         string         `json:"job"`
+	Owner       string         `json:"owner"`
+	Event       string         `json:"event"`
+	EventTime   string         `json:"event_time,omitempty"`
+	ScheduledAt string         `json:"scheduled_at,omitempty"`
+	Values      map[string]any `json:"values,omitempty"`
+}
+
+// Notifier posts the alerts to a generic webhook, the url of the webhook is given as the secret of the channel
+type Notifier struct {
+	client *http.Client
+}
+
+func (n *Notifier) Notify(ctx context.Context, attr scheduler.NotifyAttrs) error {
+	body, err := json.Marshal(toPayload(attr))
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, attr.Secret, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("invalid webhook request for route %s: %w", attr.Route, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post alert to webhook %s: %w", attr.Route, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("webhook %s responded with status %d", attr.Route, resp.StatusCode)
+	}
+	return nil
+}
+
+// Post posts the body to the url of a webhook subscription, returning the status of the response
+func (n *Notifier) Post(ctx context.Context, url string, headers map[string]string, body []byte) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("invalid webhook request: %w", err)
+	}
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to post to webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	return resp.StatusCode, nil
+}
+
+func (*Notifier) Close() error {
+	return nil
+}
+
+func toPayload(attr scheduler.NotifyAttrs) Payload {
+	event := attr.JobEvent
+	payload := Payload{
+		Project:   event.Tenant.ProjectName().String(),
+		Namespace: event.Tenant.NamespaceName().String(),
+		Job:       event.JobName.String(),
+		Owner:     attr.Owner,
+		Event:     event.Type.String(),
+		Values:    event.Values,
+	}
+	if !event.EventTime.IsZero() {
+		payload.EventTime = event.EventTime.Format(time.RFC3339)
+	}
+	if !event.JobScheduledAt.IsZero() {
+		payload.ScheduledAt = event.JobScheduledAt.Format(time.RFC3339)
+	}
+	return payload
+}
+
+func NewNotifier(timeout time.Duration) *Notifier {
+	return &Notifier{
+		client: &http.Client{Timeout: timeout},
+	}
+}
@@ -0,0 +1,81 @@
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/ext/notify/webhook"
+)
+
+func TestWebhook(t *testing.T) {
+	ctx := context.Background()
+	tnnt, _ := tenant.NewTenant("proj1", "ns1")
+	scheduledAt := time.Date(2023, 10, 10, 10, 0, 0, 0, time.UTC)
+	attr := scheduler.NotifyAttrs{
+		Owner: "data-team",
+		JobEvent: &scheduler.Event{
+			JobName:        "job1",
+			Tenant:         tnnt,
+			Type:           scheduler.JobFailureEvent,
+			JobScheduledAt: scheduledAt,
+			Values:         map[string]any{"exception": "table not found"},
+		},
+		Route: "#ops",
+	}
+
+	t.Run("posts the alert to the url of the webhook", func(t *testing.T) {
+		var received webhook.Payload
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		notifyAttr := attr
+		notifyAttr.Secret = server.URL
+		err := webhook.NewNotifier(time.Second).Notify(ctx, notifyAttr)
+		assert.NoError(t, err)
+		assert.Equal(t, webhook.Payload{
+			Project:     "proj1",
+			Namespace:   "ns1",
+			Job:         "job1",
+			Owner:       "data-team",
+			Event:       "failure",
+			ScheduledAt: "2023-10-10T10:00:00Z",
+			Values:      map[string]any{"exception": "table not found"},
+		}, received)
+	})
+	t.Run("returns error when the webhook does not accept the alert", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer server.Close()
+
+		notifyAttr := attr
+		notifyAttr.Secret = server.URL
+		err := webhook.NewNotifier(time.Second).Notify(ctx, notifyAttr)
+		assert.EqualError(t, err, "webhook #ops responded with status 502")
+	})
+	t.Run("posts the body with the headers to the url of the subscription", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "sha256=signature", r.Header.Get(scheduler.WebhookSignatureHeader))
+			w.WriteHeader(http.StatusAccepted)
+		}))
+		defer server.Close()
+
+		statusCode, err := webhook.NewNotifier(time.Second).Post(ctx, server.URL,
+			map[string]string{scheduler.WebhookSignatureHeader: "sha256=signature"}, []byte(`{}`))
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusAccepted, statusCode)
+	})
+}
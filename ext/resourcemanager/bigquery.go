@@ -0,0 +1,245 @@
+package resourcemanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/mitchellh/mapstructure"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+
+	"github.com/goto/optimus/config"
+	"github.com/goto/optimus/core/job"
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/tenant"
+)
+
+const (
+	bigQueryURNPrefix = "bigquery://"
+	bigQueryTaskName  = "bigquery"
+)
+
+var errTableNotFound = errors.New("table is not found")
+
+// TableMetadataReader reads the last modified time and the partitions of a bigquery table
+type TableMetadataReader interface {
+	TableLastModified(ctx context.Context, projectID, datasetID, tableID string) (time.Time, error)
+	TablePartitionExists(ctx context.Context, projectID, datasetID, tableID string, partitionTime time.Time) (bool, error)
+}
+
+// BigQueryResourceManager resolves the inferred upstreams on bigquery tables not managed by any optimus, from the
+// table metadata. The sensors of these upstreams wait on the table being modified instead of on a job run.
+type BigQueryResourceManager struct {
+	name   string
+	config config.ResourceManagerConfigBigQuery
+
+	reader TableMetadataReader
+}
+
+// NewBigQueryResourceManager initializes the resource manager reading the table metadata from bigquery
+func NewBigQueryResourceManager(resourceManagerConfig config.ResourceManager) (*BigQueryResourceManager, error) {
+	var conf config.ResourceManagerConfigBigQuery
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook: mapstructure.StringToTimeDurationHookFunc(),
+		Result:     &conf,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := decoder.Decode(resourceManagerConfig.Config); err != nil {
+		return nil, fmt.Errorf("error decoding resource manger config: %w", err)
+	}
+
+	var opts []option.ClientOption
+	if conf.CredentialsFile != "" {
+		opts = append(opts, option.WithCredentialsFile(conf.CredentialsFile))
+	}
+	// the tables are read with their fully qualified name, the project of the client is only used for billing
+	client, err := bigquery.NewClient(context.Background(), bigquery.DetectProjectID, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing bigquery client of resource manager %s: %w", resourceManagerConfig.Name, err)
+	}
+
+	return NewTestBigQueryResourceManager(resourceManagerConfig.Name, conf, &bigQueryTableMetadataReader{client: client}), nil
+}
+
+func NewTestBigQueryResourceManager(name string, conf config.ResourceManagerConfigBigQuery, reader TableMetadataReader) *BigQueryResourceManager {
+	return &BigQueryResourceManager{
+		name:   name,
+		config: conf,
+		reader: reader,
+	}
+}
+
+// GetOptimusUpstreams returns the table of the upstream when it exists, nothing is returned for the upstreams not
+// on a bigquery table, and for the tables not modified within the max staleness
+func (b *BigQueryResourceManager) GetOptimusUpstreams(ctx context.Context, unresolvedDependency *job.Upstream) ([]*job.Upstream, error) {
+	if ctx == nil {
+		return nil, errors.New("context is nil")
+	}
+
+	resourceURN := unresolvedDependency.Resource()
+	projectID, datasetID, tableID, ok := parseBigQueryTableURN(resourceURN)
+	if !ok || !b.isProjectResolved(projectID) {
+		return nil, nil
+	}
+
+	lastModified, err := b.reader.TableLastModified(ctx, projectID, datasetID, tableID)
+	if err != nil {
+		if errors.Is(err, errTableNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error reading metadata of %s from resource manager %s: %w", resourceURN, b.name, err)
+	}
+	if b.config.MaxStaleness > 0 && time.Since(lastModified) > b.config.MaxStaleness {
+		return nil, nil
+	}
+
+	// the gcp project and dataset of the table stand in for the tenant of the upstream
+	upstreamTenant, err := tenant.NewTenant(projectID, datasetID)
+	if err != nil {
+		return nil, err
+	}
+	upstreamName, err := job.NameFrom(projectID + "." + datasetID + "." + tableID)
+	if err != nil {
+		return nil, err
+	}
+	upstream := job.NewUpstreamResolved(upstreamName, job.UpstreamHostBigQuery, resourceURN, upstreamTenant, unresolvedDependency.Type(), bigQueryTaskName, true)
+	return []*job.Upstream{upstream}, nil
+}
+
+// EvaluateFreshness evaluates the freshness predicate on the table of the resource urn, the partition is checked
+// only once the table is modified within the max age
+func (b *BigQueryResourceManager) EvaluateFreshness(ctx context.Context, resourceURN string, predicate scheduler.FreshnessPredicate) (*scheduler.Freshness, error) {
+	projectID, datasetID, tableID, ok := parseBigQueryTableURN(job.ResourceURN(resourceURN))
+	if !ok {
+		return nil, fmt.Errorf("resource %s is not a bigquery table", resourceURN)
+	}
+
+	lastModified, err := b.reader.TableLastModified(ctx, projectID, datasetID, tableID)
+	if err != nil {
+		if errors.Is(err, errTableNotFound) {
+			return &scheduler.Freshness{Reason: "table is not found"}, nil
+		}
+		return nil, fmt.Errorf("error reading metadata of %s from resource manager %s: %w", resourceURN, b.name, err)
+	}
+
+	freshness := &scheduler.Freshness{LastModified: lastModified}
+	if predicate.MaxAge > 0 && time.Since(lastModified) > predicate.MaxAge {
+		freshness.Reason = fmt.Sprintf("table is not modified within %s", predicate.MaxAge)
+		return freshness, nil
+	}
+
+	if !predicate.PartitionTime.IsZero() {
+		exists, err := b.reader.TablePartitionExists(ctx, projectID, datasetID, tableID, predicate.PartitionTime)
+		if err != nil {
+			return nil, fmt.Errorf("error reading partitions of %s from resource manager %s: %w", resourceURN, b.name, err)
+		}
+		if !exists {
+			freshness.Reason = fmt.Sprintf("partition of %s is not found", predicate.PartitionTime.UTC().Format(time.RFC3339))
+			return freshness, nil
+		}
+	}
+
+	freshness.Fresh = true
+	return freshness, nil
+}
+
+func (b *BigQueryResourceManager) isProjectResolved(projectID string) bool {
+	if len(b.config.Projects) == 0 {
+		return true
+	}
+	for _, project := range b.config.Projects {
+		if project == projectID {
+			return true
+		}
+	}
+	return false
+}
+
+// parseBigQueryTableURN splits urn like bigquery://project:dataset.table, the urn of a dataset is not a table
+func parseBigQueryTableURN(urn job.ResourceURN) (projectID, datasetID, tableID string, ok bool) {
+	name, found := strings.CutPrefix(urn.String(), bigQueryURNPrefix)
+	if !found {
+		return "", "", "", false
+	}
+
+	projectID, datasetTable, found := strings.Cut(name, ":")
+	if !found {
+		return "", "", "", false
+	}
+	datasetID, tableID, found = strings.Cut(datasetTable, ".")
+	if !found || projectID == "" || datasetID == "" || tableID == "" {
+		return "", "", "", false
+	}
+	return projectID, datasetID, tableID, true
+}
+
+type bigQueryTableMetadataReader struct {
+	client *bigquery.Client
+}
+
+func (r *bigQueryTableMetadataReader) TableLastModified(ctx context.Context, projectID, datasetID, tableID string) (time.Time, error) {
+	metadata, err := r.client.DatasetInProject(projectID, datasetID).Table(tableID).Metadata(ctx)
+	if err != nil {
+		var metaErr *googleapi.Error
+		if errors.As(err, &metaErr) && metaErr.Code == http.StatusNotFound {
+			return time.Time{}, errTableNotFound
+		}
+		return time.Time{}, err
+	}
+	return metadata.LastModifiedTime, nil
+}
+
+func (r *bigQueryTableMetadataReader) TablePartitionExists(ctx context.Context, projectID, datasetID, tableID string, partitionTime time.Time) (bool, error) {
+	metadata, err := r.client.DatasetInProject(projectID, datasetID).Table(tableID).Metadata(ctx)
+	if err != nil {
+		var metaErr *googleapi.Error
+		if errors.As(err, &metaErr) && metaErr.Code == http.StatusNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	// the data of a table not partitioned by time is in a single partition
+	if metadata.TimePartitioning == nil {
+		return true, nil
+	}
+
+	query := r.client.Query(fmt.Sprintf("SELECT COUNT(1) FROM `%s.%s.INFORMATION_SCHEMA.PARTITIONS` "+
+		"WHERE table_name = @table_name AND partition_id = @partition_id AND total_rows > 0", projectID, datasetID))
+	query.Parameters = []bigquery.QueryParameter{
+		{Name: "table_name", Value: tableID},
+		{Name: "partition_id", Value: partitionIDFor(metadata.TimePartitioning.Type, partitionTime)},
+	}
+	rows, err := query.Read(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	var row []bigquery.Value
+	if err := rows.Next(&row); err != nil {
+		return false, err
+	}
+	count, ok := row[0].(int64)
+	return ok && count > 0, nil
+}
+
+// partitionIDFor formats the time like the partition ids of the partitioning type, like 20240101 for daily partitions
+func partitionIDFor(partitioningType bigquery.TimePartitioningType, partitionTime time.Time) string {
+	partitionTime = partitionTime.UTC()
+	switch partitioningType {
+	case bigquery.HourPartitioningType:
+		return partitionTime.Format("2006010215")
+	case bigquery.MonthPartitioningType:
+		return partitionTime.Format("200601")
+	case bigquery.YearPartitioningType:
+		return partitionTime.Format("2006")
+	default:
+		return partitionTime.Format("20060102")
+	}
+}
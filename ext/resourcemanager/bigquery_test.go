@@ -0,0 +1,193 @@
+package resourcemanager_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/goto/optimus/config"
+	"github.com/goto/optimus/core/job"
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/ext/resourcemanager"
+)
+
+func TestBigQueryResourceManager(t *testing.T) {
+	ctx := context.Background()
+	resourceURN := job.ResourceURN("bigquery://bq-project:bq_dataset.bq_table")
+	unresolvedUpstream := job.NewUpstreamUnresolvedInferred(resourceURN)
+
+	t.Run("GetOptimusUpstreams", func(t *testing.T) {
+		t.Run("returns nothing for upstream not on a bigquery table", func(t *testing.T) {
+			reader := new(TableMetadataReader)
+			defer reader.AssertExpectations(t)
+
+			manager := resourcemanager.NewTestBigQueryResourceManager("bq", config.ResourceManagerConfigBigQuery{}, reader)
+
+			upstreams, err := manager.GetOptimusUpstreams(ctx, job.NewUpstreamUnresolvedInferred("bigquery://bq-project:bq_dataset"))
+			assert.NoError(t, err)
+			assert.Empty(t, upstreams)
+
+			upstreams, err = manager.GetOptimusUpstreams(ctx, job.NewUpstreamUnresolvedStatic("job", "test-proj"))
+			assert.NoError(t, err)
+			assert.Empty(t, upstreams)
+		})
+		t.Run("returns nothing for table of project not resolved by the manager", func(t *testing.T) {
+			reader := new(TableMetadataReader)
+			defer reader.AssertExpectations(t)
+
+			conf := config.ResourceManagerConfigBigQuery{Projects: []string{"other-project"}}
+			manager := resourcemanager.NewTestBigQueryResourceManager("bq", conf, reader)
+
+			upstreams, err := manager.GetOptimusUpstreams(ctx, unresolvedUpstream)
+			assert.NoError(t, err)
+			assert.Empty(t, upstreams)
+		})
+		t.Run("returns error when unable to read the table metadata", func(t *testing.T) {
+			reader := new(TableMetadataReader)
+			reader.On("TableLastModified", ctx, "bq-project", "bq_dataset", "bq_table").Return(time.Time{}, errors.New("permission denied"))
+			defer reader.AssertExpectations(t)
+
+			manager := resourcemanager.NewTestBigQueryResourceManager("bq", config.ResourceManagerConfigBigQuery{}, reader)
+
+			upstreams, err := manager.GetOptimusUpstreams(ctx, unresolvedUpstream)
+			assert.ErrorContains(t, err, "permission denied")
+			assert.Nil(t, upstreams)
+		})
+		t.Run("returns nothing for table not modified within max staleness", func(t *testing.T) {
+			reader := new(TableMetadataReader)
+			reader.On("TableLastModified", ctx, "bq-project", "bq_dataset", "bq_table").Return(time.Now().Add(-48*time.Hour), nil)
+			defer reader.AssertExpectations(t)
+
+			conf := config.ResourceManagerConfigBigQuery{MaxStaleness: 24 * time.Hour}
+			manager := resourcemanager.NewTestBigQueryResourceManager("bq", conf, reader)
+
+			upstreams, err := manager.GetOptimusUpstreams(ctx, unresolvedUpstream)
+			assert.NoError(t, err)
+			assert.Empty(t, upstreams)
+		})
+		t.Run("returns the table as external upstream", func(t *testing.T) {
+			reader := new(TableMetadataReader)
+			reader.On("TableLastModified", ctx, "bq-project", "bq_dataset", "bq_table").Return(time.Now().Add(-time.Hour), nil)
+			defer reader.AssertExpectations(t)
+
+			conf := config.ResourceManagerConfigBigQuery{Projects: []string{"bq-project"}, MaxStaleness: 24 * time.Hour}
+			manager := resourcemanager.NewTestBigQueryResourceManager("bq", conf, reader)
+
+			upstreamTenant, _ := tenant.NewTenant("bq-project", "bq_dataset")
+			expectedUpstream := job.NewUpstreamResolved("bq-project.bq_dataset.bq_table", job.UpstreamHostBigQuery, resourceURN,
+				upstreamTenant, job.UpstreamTypeInferred, "bigquery", true)
+
+			upstreams, err := manager.GetOptimusUpstreams(ctx, unresolvedUpstream)
+			assert.NoError(t, err)
+			assert.Equal(t, []*job.Upstream{expectedUpstream}, upstreams)
+		})
+	})
+	t.Run("EvaluateFreshness", func(t *testing.T) {
+		partitionTime := time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
+
+		t.Run("returns error for resource not a bigquery table", func(t *testing.T) {
+			reader := new(TableMetadataReader)
+			defer reader.AssertExpectations(t)
+
+			manager := resourcemanager.NewTestBigQueryResourceManager("bq", config.ResourceManagerConfigBigQuery{}, reader)
+
+			freshness, err := manager.EvaluateFreshness(ctx, "bigquery://bq-project:bq_dataset", scheduler.FreshnessPredicate{})
+			assert.ErrorContains(t, err, "is not a bigquery table")
+			assert.Nil(t, freshness)
+		})
+		t.Run("returns not fresh when the table is modified before max age", func(t *testing.T) {
+			lastModified := time.Now().Add(-12 * time.Hour)
+			reader := new(TableMetadataReader)
+			reader.On("TableLastModified", ctx, "bq-project", "bq_dataset", "bq_table").Return(lastModified, nil)
+			defer reader.AssertExpectations(t)
+
+			manager := resourcemanager.NewTestBigQueryResourceManager("bq", config.ResourceManagerConfigBigQuery{}, reader)
+
+			freshness, err := manager.EvaluateFreshness(ctx, resourceURN.String(), scheduler.FreshnessPredicate{MaxAge: 6 * time.Hour})
+			assert.NoError(t, err)
+			assert.False(t, freshness.Fresh)
+			assert.Equal(t, lastModified, freshness.LastModified)
+			assert.Equal(t, "table is not modified within 6h0m0s", freshness.Reason)
+		})
+		t.Run("returns not fresh when the partition does not exist", func(t *testing.T) {
+			reader := new(TableMetadataReader)
+			reader.On("TableLastModified", ctx, "bq-project", "bq_dataset", "bq_table").Return(time.Now(), nil)
+			reader.On("TablePartitionExists", ctx, "bq-project", "bq_dataset", "bq_table", partitionTime).Return(false, nil)
+			defer reader.AssertExpectations(t)
+
+			manager := resourcemanager.NewTestBigQueryResourceManager("bq", config.ResourceManagerConfigBigQuery{}, reader)
+
+			freshness, err := manager.EvaluateFreshness(ctx, resourceURN.String(), scheduler.FreshnessPredicate{PartitionTime: partitionTime})
+			assert.NoError(t, err)
+			assert.False(t, freshness.Fresh)
+			assert.Equal(t, "partition of 2023-10-01T00:00:00Z is not found", freshness.Reason)
+		})
+		t.Run("returns fresh when every predicate is satisfied", func(t *testing.T) {
+			reader := new(TableMetadataReader)
+			reader.On("TableLastModified", ctx, "bq-project", "bq_dataset", "bq_table").Return(time.Now().Add(-time.Hour), nil)
+			reader.On("TablePartitionExists", ctx, "bq-project", "bq_dataset", "bq_table", partitionTime).Return(true, nil)
+			defer reader.AssertExpectations(t)
+
+			manager := resourcemanager.NewTestBigQueryResourceManager("bq", config.ResourceManagerConfigBigQuery{}, reader)
+
+			predicate := scheduler.FreshnessPredicate{PartitionTime: partitionTime, MaxAge: 6 * time.Hour}
+			freshness, err := manager.EvaluateFreshness(ctx, resourceURN.String(), predicate)
+			assert.NoError(t, err)
+			assert.True(t, freshness.Fresh)
+			assert.Empty(t, freshness.Reason)
+		})
+	})
+}
+
+func TestFreshnessEvaluator(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("returns error for resource not a bigquery table", func(t *testing.T) {
+		evaluator := resourcemanager.NewTestFreshnessEvaluator(nil)
+
+		freshness, err := evaluator.EvaluateFreshness(ctx, "maxcompute://project.schema.table", scheduler.FreshnessPredicate{})
+		assert.ErrorContains(t, err, "freshness can only be evaluated for bigquery tables")
+		assert.Nil(t, freshness)
+	})
+	t.Run("returns error when no resource manager resolves the project", func(t *testing.T) {
+		conf := config.ResourceManagerConfigBigQuery{Projects: []string{"other-project"}}
+		manager := resourcemanager.NewTestBigQueryResourceManager("bq", conf, new(TableMetadataReader))
+		evaluator := resourcemanager.NewTestFreshnessEvaluator([]*resourcemanager.BigQueryResourceManager{manager})
+
+		freshness, err := evaluator.EvaluateFreshness(ctx, "bigquery://bq-project:bq_dataset.bq_table", scheduler.FreshnessPredicate{})
+		assert.ErrorContains(t, err, "no resource manager evaluates the freshness")
+		assert.Nil(t, freshness)
+	})
+	t.Run("evaluates with the resource manager resolving the project", func(t *testing.T) {
+		reader := new(TableMetadataReader)
+		reader.On("TableLastModified", ctx, "bq-project", "bq_dataset", "bq_table").Return(time.Now(), nil)
+		defer reader.AssertExpectations(t)
+
+		other := resourcemanager.NewTestBigQueryResourceManager("other", config.ResourceManagerConfigBigQuery{Projects: []string{"other-project"}}, new(TableMetadataReader))
+		manager := resourcemanager.NewTestBigQueryResourceManager("bq", config.ResourceManagerConfigBigQuery{Projects: []string{"bq-project"}}, reader)
+		evaluator := resourcemanager.NewTestFreshnessEvaluator([]*resourcemanager.BigQueryResourceManager{other, manager})
+
+		freshness, err := evaluator.EvaluateFreshness(ctx, "bigquery://bq-project:bq_dataset.bq_table", scheduler.FreshnessPredicate{})
+		assert.NoError(t, err)
+		assert.True(t, freshness.Fresh)
+	})
+}
+
+type TableMetadataReader struct {
+	mock.Mock
+}
+
+func (r *TableMetadataReader) TableLastModified(ctx context.Context, projectID, datasetID, tableID string) (time.Time, error) {
+	args := r.Called(ctx, projectID, datasetID, tableID)
+	return args.Get(0).(time.Time), args.Error(1)
+}
+
+func (r *TableMetadataReader) TablePartitionExists(ctx context.Context, projectID, datasetID, tableID string, partitionTime time.Time) (bool, error) {
+	args := r.Called(ctx, projectID, datasetID, tableID, partitionTime)
+	return args.Bool(0), args.Error(1)
+}
@@ -0,0 +1,51 @@
+package resourcemanager
+
+import (
+	"context"
+
+	"github.com/goto/optimus/config"
+	"github.com/goto/optimus/core/job"
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/internal/errors"
+)
+
+const EntityResourceManager = "resource_manager"
+
+// FreshnessEvaluator evaluates the freshness predicates of the sensors with the resource managers able to read
+// the data of the upstream, which are the bigquery resource managers
+type FreshnessEvaluator struct {
+	bigQueryManagers []*BigQueryResourceManager
+}
+
+func NewFreshnessEvaluator(resourceManagerConfigs []config.ResourceManager) (*FreshnessEvaluator, error) {
+	var bigQueryManagers []*BigQueryResourceManager
+	for _, conf := range resourceManagerConfigs {
+		if conf.Type != "bigquery" {
+			continue
+		}
+		manager, err := NewBigQueryResourceManager(conf)
+		if err != nil {
+			return nil, err
+		}
+		bigQueryManagers = append(bigQueryManagers, manager)
+	}
+	return NewTestFreshnessEvaluator(bigQueryManagers), nil
+}
+
+func NewTestFreshnessEvaluator(bigQueryManagers []*BigQueryResourceManager) *FreshnessEvaluator {
+	return &FreshnessEvaluator{bigQueryManagers: bigQueryManagers}
+}
+
+func (f *FreshnessEvaluator) EvaluateFreshness(ctx context.Context, resourceURN string, predicate scheduler.FreshnessPredicate) (*scheduler.Freshness, error) {
+	projectID, _, _, ok := parseBigQueryTableURN(job.ResourceURN(resourceURN))
+	if !ok {
+		return nil, errors.InvalidArgument(EntityResourceManager, "freshness can only be evaluated for bigquery tables, not for "+resourceURN)
+	}
+
+	for _, manager := range f.bigQueryManagers {
+		if manager.isProjectResolved(projectID) {
+			return manager.EvaluateFreshness(ctx, resourceURN, predicate)
+		}
+	}
+	return nil, errors.NotFound(EntityResourceManager, "no resource manager evaluates the freshness of "+resourceURN)
+}
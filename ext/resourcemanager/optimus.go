@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/mitchellh/mapstructure"
 
@@ -20,26 +22,44 @@ type ResourceManager interface {
 	GetOptimusUpstreams(ctx context.Context, unresolvedDependency *job.Upstream) ([]*job.Upstream, error)
 }
 
+const healthCheckPath = "/ping"
+
 type OptimusResourceManager struct {
 	name   string
 	config config.ResourceManagerConfigOptimus
 
 	httpClient *http.Client
+
+	healthMu        sync.Mutex
+	lastHealthCheck time.Time
+	healthErr       error
 }
 
 // NewOptimusResourceManager initializes job spec repository for Optimus neighbor
 func NewOptimusResourceManager(resourceManagerConfig config.ResourceManager) (*OptimusResourceManager, error) {
 	var conf config.ResourceManagerConfigOptimus
-	if err := mapstructure.Decode(resourceManagerConfig.Config, &conf); err != nil {
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook: mapstructure.StringToTimeDurationHookFunc(),
+		Result:     &conf,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := decoder.Decode(resourceManagerConfig.Config); err != nil {
 		return nil, fmt.Errorf("error decoding resource manger config: %w", err)
 	}
 	if conf.Host == "" {
 		return nil, errors.New("optimus resource manager host is empty")
 	}
+
+	httpClient, err := newHTTPClient(conf)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing client of resource manager %s: %w", resourceManagerConfig.Name, err)
+	}
 	return &OptimusResourceManager{
 		name:       resourceManagerConfig.Name,
 		config:     conf,
-		httpClient: http.DefaultClient,
+		httpClient: httpClient,
 	}, nil
 }
 
@@ -47,6 +67,9 @@ func (o *OptimusResourceManager) GetOptimusUpstreams(ctx context.Context, unreso
 	if ctx == nil {
 		return nil, errors.New("context is nil")
 	}
+	if err := o.checkHealth(ctx); err != nil {
+		return nil, err
+	}
 	request, err := o.constructGetJobSpecificationsRequest(ctx, unresolvedDependency)
 	if err != nil {
 		return nil, fmt.Errorf("error encountered when constructing request: %w", err)
@@ -71,6 +94,46 @@ func (o *OptimusResourceManager) GetOptimusUpstreams(ctx context.Context, unreso
 	return o.toOptimusDependencies(jobSpecResponse.JobSpecificationResponses, unresolvedDependency)
 }
 
+// checkHealth pings the host at most once per health check interval, so the upstreams of every job are not waiting
+// on an unreachable host until they time out
+func (o *OptimusResourceManager) checkHealth(ctx context.Context) error {
+	if o.config.HealthCheckInterval <= 0 {
+		return nil
+	}
+
+	o.healthMu.Lock()
+	defer o.healthMu.Unlock()
+
+	if !o.lastHealthCheck.IsZero() && time.Since(o.lastHealthCheck) < o.config.HealthCheckInterval {
+		return o.healthErr
+	}
+
+	o.healthErr = o.ping(ctx)
+	o.lastHealthCheck = time.Now()
+	return o.healthErr
+}
+
+func (o *OptimusResourceManager) ping(ctx context.Context) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, o.config.Host+healthCheckPath, http.NoBody)
+	if err != nil {
+		return fmt.Errorf("error encountered when constructing health check request: %w", err)
+	}
+	for key, value := range o.config.Headers {
+		request.Header.Set(key, value)
+	}
+
+	response, err := o.httpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("resource manager %s is unreachable: %w", o.name, err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("resource manager %s is unhealthy: %s", o.name, response.Status)
+	}
+	return nil
+}
+
 func (o *OptimusResourceManager) constructGetJobSpecificationsRequest(ctx context.Context, unresolvedDependency *job.Upstream) (*http.Request, error) {
 	var filters []string
 	if unresolvedDependency.Name() != "" {
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
@@ -309,6 +310,89 @@ func (o *OptimusResourceManager) TestGetJobSpecifications() {
 		o.EqualValues(expectedDependencies, actualOptimusDependencies)
 		o.NoError(actualError)
 	})
+
+	o.Run("should authenticate the request with token of client credentials flow if token url is set", func() {
+		router := http.NewServeMux()
+		server := httptest.NewServer(router)
+		defer server.Close()
+
+		conf := config.ResourceManager{
+			Name: "other-optimus",
+			Config: config.ResourceManagerConfigOptimus{
+				Host: server.URL,
+				Auth: config.ResourceManagerAuthConfig{
+					TokenURL:     server.URL + "/token",
+					ClientID:     "client-id",
+					ClientSecret: "client-secret",
+				},
+			},
+		}
+		manager, err := resourcemanager.NewOptimusResourceManager(conf)
+		if err != nil {
+			panic(err)
+		}
+
+		router.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"access_token": "issued-token", "token_type": "Bearer", "expires_in": 3600}`))
+		})
+		router.HandleFunc(apiPath, func(w http.ResponseWriter, r *http.Request) {
+			o.EqualValues("Bearer issued-token", r.Header.Get("Authorization"))
+
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"jobSpecificationResponses": []}`))
+		})
+
+		ctx := context.Background()
+		unresolvedUpstream := job.NewUpstreamUnresolvedStatic("job", "test-proj")
+
+		actualOptimusDependencies, actualError := manager.GetOptimusUpstreams(ctx, unresolvedUpstream)
+
+		o.Empty(actualOptimusDependencies)
+		o.NoError(actualError)
+	})
+
+	o.Run("should return nil and error without requesting the jobs if host is unhealthy", func() {
+		router := http.NewServeMux()
+		server := httptest.NewServer(router)
+		defer server.Close()
+
+		conf := config.ResourceManager{
+			Name: "other-optimus",
+			Config: config.ResourceManagerConfigOptimus{
+				Host:                server.URL,
+				Auth:                config.ResourceManagerAuthConfig{Token: "static-token"},
+				HealthCheckInterval: time.Minute,
+			},
+		}
+		manager, err := resourcemanager.NewOptimusResourceManager(conf)
+		if err != nil {
+			panic(err)
+		}
+
+		pingCount := 0
+		router.HandleFunc("/ping", func(w http.ResponseWriter, r *http.Request) {
+			o.EqualValues("Bearer static-token", r.Header.Get("Authorization"))
+			pingCount++
+			w.WriteHeader(http.StatusServiceUnavailable)
+		})
+		router.HandleFunc(apiPath, func(w http.ResponseWriter, r *http.Request) {
+			o.Fail("jobs should not be requested from an unhealthy host")
+		})
+
+		ctx := context.Background()
+		unresolvedUpstream := job.NewUpstreamUnresolvedStatic("job", "test-proj")
+
+		_, firstError := manager.GetOptimusUpstreams(ctx, unresolvedUpstream)
+		actualOptimusDependencies, actualError := manager.GetOptimusUpstreams(ctx, unresolvedUpstream)
+
+		o.ErrorContains(firstError, "resource manager other-optimus is unhealthy")
+		o.Nil(actualOptimusDependencies)
+		o.ErrorContains(actualError, "resource manager other-optimus is unhealthy")
+		o.Equal(1, pingCount)
+	})
 }
 
 func TestNewOptimusResourceManager(t *testing.T) {
@@ -332,6 +416,53 @@ func TestNewOptimusResourceManager(t *testing.T) {
 		assert.Error(t, actualError)
 	})
 
+	t.Run("should return nil and error if both token and token url are set", func(t *testing.T) {
+		conf := config.ResourceManager{
+			Config: config.ResourceManagerConfigOptimus{
+				Host: "localhost",
+				Auth: config.ResourceManagerAuthConfig{
+					Token:    "token",
+					TokenURL: "http://localhost/token",
+				},
+			},
+		}
+
+		actualResourceManager, actualError := resourcemanager.NewOptimusResourceManager(conf)
+
+		assert.Nil(t, actualResourceManager)
+		assert.Error(t, actualError)
+	})
+
+	t.Run("should return nil and error if ca file cannot be read", func(t *testing.T) {
+		conf := config.ResourceManager{
+			Config: config.ResourceManagerConfigOptimus{
+				Host: "localhost",
+				TLS: config.ResourceManagerTLSConfig{
+					CAFile: "/path/not/exist/ca.pem",
+				},
+			},
+		}
+
+		actualResourceManager, actualError := resourcemanager.NewOptimusResourceManager(conf)
+
+		assert.Nil(t, actualResourceManager)
+		assert.ErrorContains(t, actualError, "error reading ca file")
+	})
+
+	t.Run("should decode health check interval given as string", func(t *testing.T) {
+		conf := config.ResourceManager{
+			Config: map[string]interface{}{
+				"host":                  "localhost",
+				"health_check_interval": "30s",
+			},
+		}
+
+		actualResourceManager, actualError := resourcemanager.NewOptimusResourceManager(conf)
+
+		assert.NotNil(t, actualResourceManager)
+		assert.NoError(t, actualError)
+	})
+
 	t.Run("should return resource manager and nil if no error is encountered", func(t *testing.T) {
 		conf := config.ResourceManager{
 			Config: config.ResourceManagerConfigOptimus{
@@ -0,0 +1,104 @@
+package resourcemanager
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+
+	"github.com/goto/optimus/config"
+)
+
+// newHTTPClient builds the client sending the requests to the host, presenting the client certificate when mTLS
+// is configured and authenticating every request when auth is configured
+func newHTTPClient(conf config.ResourceManagerConfigOptimus) (*http.Client, error) {
+	if conf.TLS == (config.ResourceManagerTLSConfig{}) && isAuthEmpty(conf.Auth) {
+		return http.DefaultClient, nil
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if conf.TLS != (config.ResourceManagerTLSConfig{}) {
+		tlsConfig, err := newTLSConfig(conf.TLS)
+		if err != nil {
+			return nil, err
+		}
+		transport.TLSClientConfig = tlsConfig
+	}
+
+	tokenSource, err := newTokenSource(conf.Auth, &http.Client{Transport: transport})
+	if err != nil {
+		return nil, err
+	}
+	if tokenSource == nil {
+		return &http.Client{Transport: transport}, nil
+	}
+	return &http.Client{
+		Transport: &oauth2.Transport{
+			Source: oauth2.ReuseTokenSource(nil, tokenSource),
+			Base:   transport,
+		},
+	}, nil
+}
+
+func newTLSConfig(conf config.ResourceManagerTLSConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: conf.ServerName,
+	}
+
+	if conf.CertFile != "" || conf.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(conf.CertFile, conf.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("error loading client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	if conf.CAFile != "" {
+		caPEM, err := os.ReadFile(conf.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading ca file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("no certificate is found in ca file %s", conf.CAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	return tlsConfig, nil
+}
+
+// newTokenSource returns nil when no auth is configured, the tokens of the client credentials flow are requested
+// with the given client so the token url is reached through mTLS as well
+func newTokenSource(conf config.ResourceManagerAuthConfig, client *http.Client) (oauth2.TokenSource, error) {
+	switch {
+	case conf.Token != "" && conf.TokenURL != "":
+		return nil, errors.New("either token or token url can be set for the auth of optimus resource manager")
+	case conf.Token != "":
+		return oauth2.StaticTokenSource(&oauth2.Token{AccessToken: conf.Token}), nil
+	case conf.TokenURL != "":
+		if conf.ClientID == "" || conf.ClientSecret == "" {
+			return nil, errors.New("client id and client secret are required with token url")
+		}
+		credentials := clientcredentials.Config{
+			ClientID:     conf.ClientID,
+			ClientSecret: conf.ClientSecret,
+			TokenURL:     conf.TokenURL,
+			Scopes:       conf.Scopes,
+		}
+		ctx := context.WithValue(context.Background(), oauth2.HTTPClient, client)
+		return credentials.TokenSource(ctx), nil
+	default:
+		return nil, nil //nolint:nilnil
+	}
+}
+
+func isAuthEmpty(conf config.ResourceManagerAuthConfig) bool {
+	return conf.Token == "" && conf.TokenURL == ""
+}
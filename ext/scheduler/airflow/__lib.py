@@ -29,6 +29,11 @@ TIMESTAMP_MS_FORMAT = "%Y-%m-%dT%H:%M:%S.%fZ"
 SCHEDULER_ERR_MSG = "scheduler_error"
 STARTUP_TIMEOUT_IN_SECS = int(Variable.get("startup_timeout_in_secs", default_var=2 * 60))
 
+# when brokers are set, job events are published to kafka and consumed by optimus instead of posted to it
+EVENT_KAFKA_BROKERS = Variable.get("optimus_event_kafka_brokers", default_var="")
+EVENT_KAFKA_TOPIC = Variable.get("optimus_event_kafka_topic", default_var="optimus-job-events")
+EVENT_KAFKA_TIMEOUT_IN_SECS = 10
+
 def lookup_non_standard_cron_expression(expr: str) -> str:
     expr_mapping = {
         '@yearly': '0 0 1 1 *',
@@ -138,6 +143,17 @@ class OptimusAPIClient:
         self._raise_error_if_request_failed(response)
         return response.json()
 
+    def get_upstream_freshness(self, resource_urn: str, partition_time: str, max_age: str) -> dict:
+        url = '{optimus_host}/api/v1beta1/upstream_freshness'.format(optimus_host=self.host)
+        params = {'resource_urn': resource_urn}
+        if partition_time:
+            params['partition_time'] = partition_time
+        if max_age:
+            params['max_age'] = max_age
+        response = requests.get(url, params=params, timeout=self.timeout)
+        self._raise_error_if_request_failed(response)
+        return response.json()
+
     def _raise_error_if_request_failed(self, response):
         if response.status_code != 200:
             log.error("Request to optimus returned non-200 status code. Server response:\n")
@@ -172,6 +188,11 @@ class JobSpecTaskWindow:
             self._parse_datetime_utc_str(schedule_time_window_end),
         )
 
+    # the start of the data window of the run, the partition expected on the upstreams
+    def get_window_start(self, scheduled_at: str) -> str:
+        api_response = self._fetch_task_window(scheduled_at)
+        return api_response['start_time']
+
     def _parse_datetime(self, timestamp):
         return datetime.strptime(timestamp, TIMESTAMP_FORMAT)
 
@@ -182,6 +203,33 @@ class JobSpecTaskWindow:
         return self._optimus_client.get_task_window(self.project_name, self.job_name, scheduled_at)
 
 
+class UpstreamFreshness:
+    """
+    Checks the freshness predicates of the data of an upstream with optimus, besides the upstream being done
+
+    :param resource_urn: The urn of the resource of the upstream, like bigquery://project:dataset.table
+    :param partition_exists: Whether the partition of the window start of the run should exist
+    :param max_age_in_secs: The maximum age of the last modification of the data, unchecked when zero
+
+    """
+
+    def __init__(self, optimus_client: OptimusAPIClient, resource_urn: str, partition_exists: bool, max_age_in_secs: int):
+        self._optimus_client = optimus_client
+        self.resource_urn = resource_urn
+        self.partition_exists = partition_exists
+        self.max_age_in_secs = max_age_in_secs
+
+    def is_required(self) -> bool:
+        return bool(self.resource_urn) and (self.partition_exists or self.max_age_in_secs > 0)
+
+    def check(self, window_start: str) -> (bool, str):
+        api_response = self._optimus_client.get_upstream_freshness(
+            self.resource_urn,
+            window_start if self.partition_exists else "",
+            "{}s".format(self.max_age_in_secs) if self.max_age_in_secs > 0 else "")
+        return api_response['fresh'], api_response.get('reason', '')
+
+
 class SuperExternalTaskSensor(BaseSensorOperator):
 
     def __init__(
@@ -195,6 +243,9 @@ class SuperExternalTaskSensor(BaseSensorOperator):
             upstream_optimus_job: str,
             timeout: int,
             *args,
+            upstream_resource_urn: str = "",
+            freshness_partition_exists: bool = False,
+            freshness_max_age_in_secs: int = 0,
             **kwargs) -> None:
         kwargs['mode'] = kwargs.get('mode', 'reschedule')
         super().__init__(**kwargs)
@@ -205,6 +256,8 @@ class SuperExternalTaskSensor(BaseSensorOperator):
         self.upstream_optimus_job = upstream_optimus_job
         self._optimus_client = OptimusAPIClient(optimus_hostname, timeout)
         self._upstream_optimus_client = OptimusAPIClient(upstream_optimus_hostname, timeout)
+        self._freshness = UpstreamFreshness(self._optimus_client, upstream_resource_urn,
+                                            freshness_partition_exists, freshness_max_age_in_secs)
 
     def poke(self, context):
         job_cron_iter = croniter(context.get("dag").schedule_interval, context.get('execution_date'))
@@ -235,6 +288,14 @@ class SuperExternalTaskSensor(BaseSensorOperator):
                              format(self.upstream_optimus_job, self.upstream_optimus_project, schedule_time_window_start,
                                     schedule_time_window_end))
             return False
+
+        if self._freshness.is_required():
+            window_start = task_window.get_window_start(schedule_time.strftime(TIMESTAMP_FORMAT))
+            fresh, reason = self._freshness.check(window_start)
+            if not fresh:
+                self.log.warning("upstream '{}' is not fresh: {}, rescheduling sensor".format(
+                    self._freshness.resource_urn, reason))
+                return False
         return True
 
     def get_last_upstream_times(self, schedule_time_of_current_job, upstream_schedule_interval):
@@ -276,6 +337,44 @@ class SuperExternalTaskSensor(BaseSensorOperator):
             return datetime.strptime(timestamp, TIMESTAMP_MS_FORMAT)
 
 
+class OptimusEventPublisher:
+    _producer = None
+
+    def __init__(self, brokers: str, topic: str):
+        self.brokers = brokers
+        self.topic = topic
+
+    def _get_producer(self):
+        if OptimusEventPublisher._producer is None:
+            from kafka import KafkaProducer
+            OptimusEventPublisher._producer = KafkaProducer(bootstrap_servers=self.brokers.split(","), acks="all")
+        return OptimusEventPublisher._producer
+
+    def publish(self, project, namespace, job, event) -> dict:
+        # same payload as the event endpoint, keyed by job to keep the events of a job in order
+        request_data = {
+            "project_name": project,
+            "namespace_name": namespace,
+            "job_name": job,
+            "event": event,
+        }
+        future = self._get_producer().send(self.topic,
+                                           key="{}/{}".format(project, job).encode("utf-8"),
+                                           value=json.dumps(request_data).encode("utf-8"))
+        metadata = future.get(timeout=EVENT_KAFKA_TIMEOUT_IN_SECS)
+        return {"partition": metadata.partition, "offset": metadata.offset}
+
+
+def notify_event(optimus_client: OptimusAPIClient, params, event) -> dict:
+    if EVENT_KAFKA_BROKERS:
+        try:
+            publisher = OptimusEventPublisher(EVENT_KAFKA_BROKERS, EVENT_KAFKA_TOPIC)
+            return publisher.publish(params["project_name"], params["namespace"], params["job_name"], event)
+        except Exception as e:
+            log.warning(f'failed publishing event to kafka, posting it to optimus: {e}')
+    return optimus_client.notify_event(params["project_name"], params["namespace"], params["job_name"], event)
+
+
 def optimus_notify(context, event_meta):
     params = context.get("params")
     optimus_client = OptimusAPIClient(params["optimus_hostname"])
@@ -287,6 +386,7 @@ def optimus_notify(context, event_meta):
 
     # failure message pushed by failed tasks
     failure_messages = []
+    error_code = ""
 
     def _xcom_value_has_error(_xcom) -> bool:
         return _xcom.key == XCOM_RETURN_KEY and isinstance(_xcom.value, dict) and 'error' in _xcom.value and \
@@ -303,6 +403,7 @@ def optimus_notify(context, event_meta):
             failure_messages.append(xcom.value)
         if _xcom_value_has_error(xcom):
             failure_messages.append(xcom.value['error'])
+            error_code = xcom.value.get('error_code') or error_code
     failure_message = ", ".join(failure_messages)
 
     if SCHEDULER_ERR_MSG in event_meta.keys():
@@ -327,6 +428,7 @@ def optimus_notify(context, event_meta):
         "duration"  : str(task_instance.duration),
         "exception" : str(context.get('exception')) or "",
         "message"   : failure_message,
+        "error_code": str(error_code),
         "scheduled_at"  : current_schedule_date.strftime(TIMESTAMP_FORMAT),
         "event_time"    : datetime.now().timestamp(),
     }
@@ -338,7 +440,7 @@ def optimus_notify(context, event_meta):
     }
     # post event
     log.info(event)
-    resp = optimus_client.notify_event(params["project_name"], params["namespace"], params["job_name"], event)
+    resp = notify_event(optimus_client, params, event)
     log.info(f'posted event {params}, {event}, {resp} ')
     return
 
@@ -356,6 +458,17 @@ def get_run_type(context):
         return task_identifier
 
 
+def add_hook_type(context, meta):
+    # failure hooks are marked with their hook type, to be recorded apart from the other hooks
+    try:
+        hook_type = context.get('params', {}).get('hook_type')
+        if hook_type:
+            meta['hook_type'] = hook_type
+    except Exception as e:
+        print(e)
+    return meta
+
+
 # job level events
 def job_success_event(context):
     try:
@@ -366,6 +479,9 @@ def job_success_event(context):
         result_for_monitoring = get_result_for_monitoring_from_xcom(context)
         if result_for_monitoring is not None:
             meta['monitoring'] = result_for_monitoring
+        artifacts = get_artifacts_from_xcom(context)
+        if artifacts is not None:
+            meta['artifacts'] = artifacts
 
         optimus_notify(context, meta)
     except Exception as e:
@@ -397,6 +513,8 @@ def operator_start_event(context):
             "event_type": "TYPE_{}_START".format(run_type),
             "status": "running"
         }
+        if run_type == "HOOK":
+            add_hook_type(context, meta)
         optimus_notify(context, meta)
     except Exception as e:
         print(e)
@@ -408,6 +526,8 @@ def operator_success_event(context):
             "event_type": "TYPE_{}_SUCCESS".format(run_type),
             "status": "success"
         }
+        if run_type == "HOOK":
+            add_hook_type(context, meta)
         optimus_notify(context, meta)
     except Exception as e:
         print(e)
@@ -420,6 +540,8 @@ def operator_retry_event(context):
             "event_type": "TYPE_{}_RETRY".format(run_type),
             "status": "retried"
         }
+        if run_type == "HOOK":
+            add_hook_type(context, meta)
         optimus_notify(context, meta)
     except Exception as e:
         print(e)
@@ -432,6 +554,8 @@ def operator_failure_event(context):
             "event_type": "TYPE_{}_FAIL".format(run_type),
             "status": "failed"
         }
+        if run_type == "HOOK":
+            add_hook_type(context, meta)
         if SCHEDULER_ERR_MSG in context.keys():
             meta[SCHEDULER_ERR_MSG] = context[SCHEDULER_ERR_MSG]
 
@@ -471,7 +595,7 @@ def optimus_sla_miss_notify(dag, task_list, blocking_task_list, slas, blocking_t
             "value": message,
         }
         # post event
-        resp = optimus_client.notify_event(params["project_name"], params["namespace"], params["job_name"], event)
+        resp = notify_event(optimus_client, params, event)
         log.info(f'posted event {params}, {event}, {resp}')
         return
     except Exception as e:
@@ -500,6 +624,19 @@ def get_result_for_monitoring_from_xcom(ctx):
             return return_value['monitoring']
     return None
 
+def get_artifacts_from_xcom(ctx):
+    return_value = None
+    try:
+        ti = ctx.get('task_instance')
+        return_value = ti.xcom_pull(key='return_value')
+    except Exception as e:
+        log.info(f'error getting artifacts: {e}')
+
+    if type(return_value) is dict:
+        if 'artifacts' in return_value:
+            return return_value['artifacts']
+    return None
+
 # everything below this is here for legacy reasons, should be cleaned up in future
 
 def alert_failed_to_slack(context):
@@ -530,6 +667,7 @@ def alert_failed_to_slack(context):
 
     # failure message pushed by failed tasks
     failure_messages = []
+    error_code = ""
     for xcom in XCom.get_many(
             current_execution_date,
             key=None,
@@ -541,6 +679,7 @@ def alert_failed_to_slack(context):
             failure_messages.append(xcom.value)
         if _xcom_value_has_error(xcom):
             failure_messages.append(xcom.value['error'])
+            error_code = xcom.value.get('error_code') or error_code
     failure_message = ", ".join(failure_messages)
     if failure_message != "":
         log.info(f'failures: {failure_message}')
@@ -611,6 +750,64 @@ def alert_failed_to_slack(context):
     return failed_alert.execute(context=context)
 
 
+class BigQueryTableSensor(BaseSensorOperator):
+    """
+    Waits for a bigquery table not managed by any optimus to be modified at or after the
+    execution date of the dag run
+
+    :param table: The fully qualified name of the table, like project.dataset.table
+    :param optimus_hostname: The optimus checking the freshness predicates of the table, when any is set
+
+    """
+
+    template_fields = ('table',)
+
+    def __init__(
+            self,
+            table: str,
+            *args,
+            optimus_hostname: str = "",
+            project_name: str = "",
+            job_name: str = "",
+            upstream_resource_urn: str = "",
+            freshness_partition_exists: bool = False,
+            freshness_max_age_in_secs: int = 0,
+            **kwargs) -> None:
+        kwargs['mode'] = kwargs.get('mode', 'reschedule')
+        super().__init__(**kwargs)
+        self.table = table
+        self.project_name = project_name
+        self.job_name = job_name
+        self._optimus_client = OptimusAPIClient(optimus_hostname, kwargs.get('timeout'))
+        self._freshness = UpstreamFreshness(self._optimus_client, upstream_resource_urn,
+                                            freshness_partition_exists, freshness_max_age_in_secs)
+
+    def poke(self, context: 'Context') -> bool:
+        from google.api_core.exceptions import NotFound
+        from google.cloud import bigquery
+
+        execution_date = context.get('execution_date')
+        try:
+            table = bigquery.Client().get_table(self.table)
+        except NotFound:
+            self.log.info("table {} is not found, rescheduling sensor".format(self.table))
+            return False
+
+        if table.modified is None or table.modified < execution_date:
+            self.log.info("table {} is last modified at {}, before {}, rescheduling sensor".format(
+                self.table, table.modified, execution_date))
+            return False
+
+        if self._freshness.is_required():
+            schedule_time = croniter(context.get("dag").schedule_interval, execution_date).get_next(datetime)
+            task_window = JobSpecTaskWindow(self._optimus_client, self.project_name, self.job_name)
+            fresh, reason = self._freshness.check(task_window.get_window_start(schedule_time.strftime(TIMESTAMP_FORMAT)))
+            if not fresh:
+                self.log.info("table {} is not fresh: {}, rescheduling sensor".format(self.table, reason))
+                return False
+        return True
+
+
 class ExternalHttpSensor(BaseSensorOperator):
     """
     Executes a HTTP GET statement and returns False on failure caused by
@@ -1,7 +1,9 @@
 package airflow
 
 import (
+	"bytes"
 	"context"
+	"crypto/md5" //nolint:gosec
 	_ "embed"
 	"encoding/json"
 	"fmt"
@@ -37,8 +39,6 @@ const (
 	dagRunCreateURL   = "api/v1/dags/%s/dagRuns"
 	airflowDateFormat = "2006-01-02T15:04:05+00:00"
 
-	schedulerHostKey = "SCHEDULER_HOST"
-
 	baseLibFileName = "__lib.py"
 	jobsDir         = "dags"
 	jobsExtension   = ".py"
@@ -46,14 +46,17 @@ const (
 	concurrentTicketPerSec = 50
 	concurrentLimit        = 100
 
-	metricJobUpload       = "job_upload_total"
-	metricJobRemoval      = "job_removal_total"
-	metricJobStateSuccess = "success"
-	metricJobStateFailed  = "failed"
+	metricJobUpload          = "job_upload_total"
+	metricJobRemoval         = "job_removal_total"
+	metricJobCompileDuration = "job_compile_duration_seconds"
+	metricJobStateSuccess    = "success"
+	metricJobStateFailed     = "failed"
 )
 
 type Bucket interface {
 	WriteAll(ctx context.Context, key string, p []byte, opts *blob.WriterOptions) error
+	ReadAll(ctx context.Context, key string) ([]byte, error)
+	Attributes(ctx context.Context, key string) (*blob.Attributes, error)
 	List(opts *blob.ListOptions) *blob.ListIterator
 	Delete(ctx context.Context, key string) error
 	Close() error
@@ -87,6 +90,10 @@ type Scheduler struct {
 
 	projectGetter ProjectGetter
 	secretGetter  SecretGetter
+
+	composerTokens *composerTokenSources
+
+	canary CanaryConfig
 }
 
 func (s *Scheduler) DeployJobs(ctx context.Context, tenant tenant.Tenant, jobs []*scheduler.JobWithDetails) error {
@@ -111,24 +118,45 @@ func (s *Scheduler) DeployJobs(ctx context.Context, tenant tenant.Tenant, jobs [
 		s.l.Error("failed fetch project details")
 		return errors.AddErrContext(err, EntityAirflow, "error in getting project details")
 	}
+	deployStartedAt := time.Now().UTC()
 	for _, job := range jobs {
 		runner.Add(func(currentJob *scheduler.JobWithDetails) func() (interface{}, error) {
 			return func() (interface{}, error) {
-				return nil, s.compileAndUpload(ctx, project, currentJob, bucket)
+				return s.compileAndUpload(ctx, project, currentJob, bucket)
 			}
 		}(job))
 	}
 
 	countDeploySucceed := 0
 	countDeployFailed := 0
+	countDeploySkipped := 0
+	var uploads []*jobUpload
 	for _, result := range runner.Run() {
 		if result.Err != nil {
 			countDeployFailed++
 			multiError.Append(result.Err)
 			continue
 		}
+		upload, ok := result.Val.(*jobUpload)
+		if ok && upload.skipped {
+			countDeploySkipped++
+		} else if ok {
+			uploads = append(uploads, upload)
+		}
 		countDeploySucceed++
 	}
+	if countDeploySkipped > 0 {
+		s.l.Debug("skipped uploading %d unchanged jobs under namespace [%s]", countDeploySkipped, tenant.NamespaceName().String())
+	}
+
+	if s.canary.Enabled && len(uploads) > 0 {
+		rolledBack, err := s.validateUploads(spanCtx, tenant, bucket, uploads, deployStartedAt)
+		if err != nil {
+			countDeploySucceed -= rolledBack
+			countDeployFailed += rolledBack
+			multiError.Append(err)
+		}
+	}
 	raiseSchedulerMetric(tenant, metricJobUpload, metricJobStateSuccess, countDeploySucceed)
 	raiseSchedulerMetric(tenant, metricJobUpload, metricJobStateFailed, countDeployFailed)
 
@@ -224,20 +252,57 @@ func deleteDirectoryIfEmpty(ctx context.Context, nsDirectoryIdentifier string, b
 	return nil
 }
 
-func (s *Scheduler) compileAndUpload(ctx context.Context, project *tenant.Project, job *scheduler.JobWithDetails, bucket Bucket) error {
+// jobUpload is the compiled job uploaded to the bucket, with the content it replaced for rolling it back
+type jobUpload struct {
+	jobName string
+	blobKey string
+	skipped bool
+
+	// previous is the content of the job before the upload, nil for a new job
+	previous []byte
+}
+
+// compileAndUpload uploads the compiled job unless the stored one has the same content, which makes re-uploading
+// the same jobs after an interrupted upload cheap. Under canary deployment the replaced content is kept.
+func (s *Scheduler) compileAndUpload(ctx context.Context, project *tenant.Project, job *scheduler.JobWithDetails, bucket Bucket) (*jobUpload, error) {
 	namespaceName := job.Job.Tenant.NamespaceName().String()
 	blobKey := pathFromJobName(jobsDir, namespaceName, job.Name.String(), jobsExtension)
+	upload := &jobUpload{jobName: job.Name.String(), blobKey: blobKey}
 
+	compileStartTime := time.Now()
 	compiledJob, err := s.compiler.Compile(project, job)
+	telemetry.NewHistogram(metricJobCompileDuration, map[string]string{
+		"project":   project.Name().String(),
+		"namespace": namespaceName,
+		"job":       job.Name.String(),
+	}).Observe(time.Since(compileStartTime).Seconds())
 	if err != nil {
 		s.l.Error(fmt.Sprintf("failed compilation %s:%s, err:%s", namespaceName, blobKey, err.Error()))
-		return errors.AddErrContext(err, EntityAirflow, "job:"+job.Name.String())
+		return nil, errors.AddErrContext(err, EntityAirflow, "job:"+job.Name.String())
+	}
+
+	// not every bucket provides the checksum, in which case the job is always uploaded
+	if attrs, err := bucket.Attributes(ctx, blobKey); err == nil && len(attrs.MD5) > 0 {
+		checksum := md5.Sum(compiledJob) //nolint:gosec
+		if bytes.Equal(attrs.MD5, checksum[:]) {
+			upload.skipped = true
+			return upload, nil
+		}
 	}
+
+	if s.canary.Enabled {
+		previous, err := bucket.ReadAll(ctx, blobKey)
+		if err != nil && gcerrors.Code(err) != gcerrors.NotFound {
+			return nil, errors.AddErrContext(err, EntityAirflow, "error in reading deployed job: "+job.Name.String())
+		}
+		upload.previous = previous
+	}
+
 	if err := bucket.WriteAll(ctx, blobKey, compiledJob, nil); err != nil {
 		s.l.Error(fmt.Sprintf("failed to upload %s:%s, err:%s", namespaceName, blobKey, err.Error()))
-		return errors.AddErrContext(err, EntityAirflow, "job: "+job.Name.String())
+		return nil, errors.AddErrContext(err, EntityAirflow, "job: "+job.Name.String())
 	}
-	return nil
+	return upload, nil
 }
 
 func pathFromJobName(prefix, namespace, jobName, suffix string) string {
@@ -270,9 +335,10 @@ func (s *Scheduler) GetJobRuns(ctx context.Context, tnnt tenant.Tenant, jobQuery
 	}
 
 	req := airflowRequest{
-		path:   dagStatusBatchURL,
-		method: http.MethodPost,
-		body:   reqBody,
+		endpoint: endpointDagRuns,
+		path:     dagStatusBatchURL,
+		method:   http.MethodPost,
+		body:     reqBody,
 	}
 
 	schdAuth, err := s.getSchedulerAuth(ctx, tnnt)
@@ -314,9 +380,10 @@ func (s *Scheduler) UpdateJobState(ctx context.Context, tnnt tenant.Tenant, jobN
 	for _, jobName := range jobNames {
 		go func(jobName job.Name) {
 			req := airflowRequest{
-				path:   fmt.Sprintf(dagURL, jobName),
-				method: http.MethodPatch,
-				body:   data,
+				endpoint: endpointDag,
+				path:     fmt.Sprintf(dagURL, jobName),
+				method:   http.MethodPatch,
+				body:     data,
 			}
 			_, err := s.client.Invoke(spanCtx, req, schdAuth)
 			ch <- err
@@ -361,7 +428,7 @@ func (s *Scheduler) getSchedulerAuth(ctx context.Context, tnnt tenant.Tenant) (S
 		return SchedulerAuth{}, err
 	}
 
-	host, err := project.GetConfig(schedulerHostKey)
+	host, err := project.GetConfig(tenant.ProjectSchedulerHost)
 	if err != nil {
 		return SchedulerAuth{}, err
 	}
@@ -371,6 +438,11 @@ func (s *Scheduler) getSchedulerAuth(ctx context.Context, tnnt tenant.Tenant) (S
 		return SchedulerAuth{}, err
 	}
 
+	schedulerType, _ := project.GetConfig(tenant.ProjectSchedulerType)
+	if strings.EqualFold(schedulerType, schedulerTypeComposer) {
+		return s.getComposerAuth(tnnt.ProjectName(), host, auth.Value())
+	}
+
 	schdHost := strings.ReplaceAll(host, "http://", "")
 	return SchedulerAuth{
 		host:  schdHost,
@@ -390,9 +462,10 @@ func (s *Scheduler) ClearBatch(ctx context.Context, tnnt tenant.Tenant, jobName
 		startExecutionTime.UTC().Format(airflowDateFormat),
 		endExecutionTime.UTC().Format(airflowDateFormat)))
 	req := airflowRequest{
-		path:   fmt.Sprintf(dagRunClearURL, jobName.String()),
-		method: http.MethodPost,
-		body:   data,
+		endpoint: endpointClear,
+		path:     fmt.Sprintf(dagRunClearURL, jobName.String()),
+		method:   http.MethodPost,
+		body:     data,
 	}
 	schdAuth, err := s.getSchedulerAuth(ctx, tnnt)
 	if err != nil {
@@ -414,9 +487,10 @@ func (s *Scheduler) CreateRun(ctx context.Context, tnnt tenant.Tenant, jobName s
 		executionTime.UTC().Format(airflowDateFormat)),
 	)
 	req := airflowRequest{
-		path:   fmt.Sprintf(dagRunCreateURL, jobName.String()),
-		method: http.MethodPost,
-		body:   data,
+		endpoint: endpointCreateRun,
+		path:     fmt.Sprintf(dagRunCreateURL, jobName.String()),
+		method:   http.MethodPost,
+		body:     data,
 	}
 	schdAuth, err := s.getSchedulerAuth(ctx, tnnt)
 	if err != nil {
@@ -429,14 +503,18 @@ func (s *Scheduler) CreateRun(ctx context.Context, tnnt tenant.Tenant, jobName s
 	return nil
 }
 
-func NewScheduler(l log.Logger, bucketFac BucketFactory, client Client, compiler DagCompiler, projectGetter ProjectGetter, secretGetter SecretGetter) *Scheduler {
+func NewScheduler(l log.Logger, bucketFac BucketFactory, client Client, compiler DagCompiler, projectGetter ProjectGetter,
+	secretGetter SecretGetter, canary CanaryConfig,
+) *Scheduler {
 	return &Scheduler{
-		l:             l,
-		bucketFac:     bucketFac,
-		compiler:      compiler,
-		client:        client,
-		projectGetter: projectGetter,
-		secretGetter:  secretGetter,
+		l:              l,
+		bucketFac:      bucketFac,
+		compiler:       compiler,
+		client:         client,
+		projectGetter:  projectGetter,
+		secretGetter:   secretGetter,
+		composerTokens: newComposerTokenSources(),
+		canary:         canary,
 	}
 }
 
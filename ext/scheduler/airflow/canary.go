@@ -0,0 +1,178 @@
+package airflow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+)
+
+const (
+	importErrorsURL      = "api/v1/importErrors"
+	endpointImportErrors = "import_errors"
+	importErrorsLimit    = 100
+)
+
+// CanaryConfig checks the jobs uploaded for a namespace are imported by airflow, rolling back every job uploaded
+// for the namespace when one of them fails the check
+type CanaryConfig struct {
+	Enabled       bool
+	CheckInterval time.Duration
+	CheckTimeout  time.Duration
+}
+
+type ImportErrorListResponse struct {
+	ImportErrors []ImportError `json:"import_errors"`
+	TotalEntries int           `json:"total_entries"`
+}
+
+type ImportError struct {
+	Filename   string    `json:"filename"`
+	StackTrace string    `json:"stack_trace"`
+	Timestamp  time.Time `json:"timestamp"`
+}
+
+type DagResponse struct {
+	LastParsedTime *time.Time `json:"last_parsed_time"`
+}
+
+// validateUploads waits for airflow to parse the uploaded jobs. A job passes the check once its dag is parsed after
+// the deployment started, and fails on an import error of its file or when it is not parsed before the timeout.
+// It returns the number of jobs rolled back.
+func (s *Scheduler) validateUploads(ctx context.Context, tnnt tenant.Tenant, bucket Bucket, uploads []*jobUpload, since time.Time) (int, error) {
+	spanCtx, span := startChildSpan(ctx, "validateUploads")
+	defer span.End()
+
+	failures := map[string]string{}
+	schdAuth, err := s.getSchedulerAuth(spanCtx, tnnt)
+	if err != nil {
+		for _, upload := range uploads {
+			failures[upload.jobName] = "unable to reach airflow for the import check: " + err.Error()
+		}
+		return s.rollback(spanCtx, tnnt, bucket, uploads, failures)
+	}
+
+	pending := map[string]*jobUpload{}
+	for _, upload := range uploads {
+		pending[upload.jobName] = upload
+	}
+
+	deadline := time.Now().Add(s.canary.CheckTimeout)
+checks:
+	for len(pending) > 0 {
+		importErrors, err := s.getImportErrors(spanCtx, schdAuth)
+		if err != nil {
+			s.l.Warn("error fetching import errors of namespace [%s], retrying: %s", tnnt.NamespaceName().String(), err)
+		}
+		for _, importError := range importErrors {
+			if importError.Timestamp.Before(since) {
+				continue
+			}
+			for jobName, upload := range pending {
+				if strings.HasSuffix(importError.Filename, strings.TrimPrefix(upload.blobKey, jobsDir)) {
+					failures[jobName] = importError.StackTrace
+					delete(pending, jobName)
+				}
+			}
+		}
+
+		for jobName := range pending {
+			parsedAt, err := s.getLastParsedTime(spanCtx, schdAuth, jobName)
+			if err == nil && parsedAt.After(since) {
+				delete(pending, jobName)
+			}
+		}
+
+		if len(pending) == 0 || time.Now().After(deadline) {
+			break
+		}
+		select {
+		case <-spanCtx.Done():
+			break checks
+		case <-time.After(s.canary.CheckInterval):
+		}
+	}
+
+	for jobName := range pending {
+		failures[jobName] = fmt.Sprintf("not parsed by airflow within %s", s.canary.CheckTimeout)
+	}
+	if len(failures) == 0 {
+		return 0, nil
+	}
+	return s.rollback(spanCtx, tnnt, bucket, uploads, failures)
+}
+
+// rollback restores every job uploaded for the namespace to the content it had before the deployment
+func (s *Scheduler) rollback(ctx context.Context, tnnt tenant.Tenant, bucket Bucket, uploads []*jobUpload, failures map[string]string) (int, error) {
+	me := errors.NewMultiError("ErrorsInCanaryDeployment")
+
+	jobNames := make([]string, 0, len(failures))
+	for jobName := range failures {
+		jobNames = append(jobNames, jobName)
+	}
+	sort.Strings(jobNames)
+	for _, jobName := range jobNames {
+		me.Append(errors.InvalidArgument(EntityAirflow, fmt.Sprintf("job %s failed the import check: %s", jobName, failures[jobName])))
+	}
+
+	for _, upload := range uploads {
+		var err error
+		if upload.previous == nil {
+			err = bucket.Delete(ctx, upload.blobKey)
+		} else {
+			err = bucket.WriteAll(ctx, upload.blobKey, upload.previous, nil)
+		}
+		if err != nil {
+			me.Append(errors.AddErrContext(err, EntityAirflow, "error in rolling back job: "+upload.jobName))
+		}
+	}
+	s.l.Warn("rolled back %d jobs under namespace [%s] failing the import check", len(uploads), tnnt.NamespaceName().String())
+	return len(uploads), me.ToErr()
+}
+
+func (s *Scheduler) getImportErrors(ctx context.Context, schdAuth SchedulerAuth) ([]ImportError, error) {
+	req := airflowRequest{
+		endpoint: endpointImportErrors,
+		path:     importErrorsURL,
+		query:    url.Values{"limit": []string{fmt.Sprint(importErrorsLimit)}, "order_by": []string{"-timestamp"}},
+		method:   http.MethodGet,
+	}
+	resp, err := s.client.Invoke(ctx, req, schdAuth)
+	if err != nil {
+		return nil, errors.Wrap(EntityAirflow, "failure while fetching airflow import errors", err)
+	}
+
+	var importErrors ImportErrorListResponse
+	if err := json.Unmarshal(resp, &importErrors); err != nil {
+		return nil, errors.Wrap(EntityAirflow, "json error on parsing airflow import errors: "+string(resp), err)
+	}
+	return importErrors.ImportErrors, nil
+}
+
+func (s *Scheduler) getLastParsedTime(ctx context.Context, schdAuth SchedulerAuth, jobName string) (time.Time, error) {
+	req := airflowRequest{
+		endpoint: endpointDag,
+		path:     fmt.Sprintf(dagURL, jobName),
+		method:   http.MethodGet,
+	}
+	resp, err := s.client.Invoke(ctx, req, schdAuth)
+	if err != nil {
+		return time.Time{}, errors.Wrap(EntityAirflow, "failure while fetching airflow dag", err)
+	}
+
+	var dag DagResponse
+	if err := json.Unmarshal(resp, &dag); err != nil {
+		return time.Time{}, errors.Wrap(EntityAirflow, "json error on parsing airflow dag: "+string(resp), err)
+	}
+	if dag.LastParsedTime == nil {
+		return time.Time{}, nil
+	}
+	return *dag.LastParsedTime, nil
+}
@@ -0,0 +1,109 @@
+package airflow
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+	"gocloud.dev/blob"
+	"gocloud.dev/blob/memblob"
+
+	"github.com/goto/optimus/core/tenant"
+)
+
+func TestCanaryDeployment(t *testing.T) {
+	ctx := context.Background()
+	tnnt, _ := tenant.NewTenant("proj", "ns1")
+	project, _ := tenant.NewProject("proj", map[string]string{
+		tenant.ProjectStoragePathKey: "gs://bucket",
+		tenant.ProjectSchedulerHost:  "airflow:8080",
+	})
+	since := time.Date(2023, 10, 10, 10, 0, 0, 0, time.UTC)
+	canary := CanaryConfig{Enabled: true, CheckInterval: time.Millisecond, CheckTimeout: 50 * time.Millisecond}
+
+	newBucket := func(t *testing.T) *blob.Bucket {
+		t.Helper()
+		bucket := memblob.OpenBucket(nil)
+		assert.NoError(t, bucket.WriteAll(ctx, "dags/ns1/job1.py", []byte("new job1"), nil))
+		assert.NoError(t, bucket.WriteAll(ctx, "dags/ns1/job2.py", []byte("new job2"), nil))
+		return bucket
+	}
+	uploads := []*jobUpload{
+		{jobName: "job1", blobKey: "dags/ns1/job1.py", previous: []byte("old job1")},
+		{jobName: "job2", blobKey: "dags/ns1/job2.py"},
+	}
+
+	t.Run("keeps the jobs parsed by airflow after the deployment", func(t *testing.T) {
+		bucket := newBucket(t)
+		client := clientFunc(func(r airflowRequest) ([]byte, error) {
+			if r.endpoint == endpointImportErrors {
+				return []byte(`{"import_errors": [{"filename": "/opt/airflow/dags/ns1/job1.py", "stack_trace": "old error", "timestamp": "2023-10-10T09:00:00Z"}]}`), nil
+			}
+			return []byte(`{"last_parsed_time": "2023-10-10T10:01:00Z"}`), nil
+		})
+		sch := NewScheduler(log.NewNoop(), nil, client, nil, projectGetter{project}, secretGetter{}, canary)
+
+		rolledBack, err := sch.validateUploads(ctx, tnnt, bucket, uploads, since)
+
+		assert.NoError(t, err)
+		assert.Zero(t, rolledBack)
+		content, _ := bucket.ReadAll(ctx, "dags/ns1/job1.py")
+		assert.Equal(t, "new job1", string(content))
+	})
+	t.Run("rolls back the jobs of the namespace when a job fails to import", func(t *testing.T) {
+		bucket := newBucket(t)
+		client := clientFunc(func(r airflowRequest) ([]byte, error) {
+			if r.endpoint == endpointImportErrors {
+				return []byte(`{"import_errors": [{"filename": "/opt/airflow/dags/ns1/job2.py", "stack_trace": "SyntaxError: invalid syntax", "timestamp": "2023-10-10T10:01:00Z"}]}`), nil
+			}
+			return []byte(`{"last_parsed_time": "2023-10-10T10:01:00Z"}`), nil
+		})
+		sch := NewScheduler(log.NewNoop(), nil, client, nil, projectGetter{project}, secretGetter{}, canary)
+
+		rolledBack, err := sch.validateUploads(ctx, tnnt, bucket, uploads, since)
+
+		assert.ErrorContains(t, err, "job job2 failed the import check: SyntaxError: invalid syntax")
+		assert.Equal(t, 2, rolledBack)
+		content, _ := bucket.ReadAll(ctx, "dags/ns1/job1.py")
+		assert.Equal(t, "old job1", string(content))
+		exists, _ := bucket.Exists(ctx, "dags/ns1/job2.py")
+		assert.False(t, exists)
+	})
+	t.Run("rolls back the jobs not parsed by airflow within the timeout", func(t *testing.T) {
+		bucket := newBucket(t)
+		client := clientFunc(func(r airflowRequest) ([]byte, error) {
+			if r.endpoint == endpointImportErrors {
+				return []byte(`{"import_errors": []}`), nil
+			}
+			return []byte(`{"last_parsed_time": "2023-10-10T09:00:00Z"}`), nil
+		})
+		sch := NewScheduler(log.NewNoop(), nil, client, nil, projectGetter{project}, secretGetter{}, canary)
+
+		rolledBack, err := sch.validateUploads(ctx, tnnt, bucket, uploads, since)
+
+		assert.ErrorContains(t, err, "job job1 failed the import check: not parsed by airflow within 50ms")
+		assert.Equal(t, 2, rolledBack)
+	})
+}
+
+type clientFunc func(r airflowRequest) ([]byte, error)
+
+func (f clientFunc) Invoke(_ context.Context, r airflowRequest, _ SchedulerAuth) ([]byte, error) {
+	return f(r)
+}
+
+type projectGetter struct {
+	project *tenant.Project
+}
+
+func (p projectGetter) Get(context.Context, tenant.ProjectName) (*tenant.Project, error) {
+	return p.project, nil
+}
+
+type secretGetter struct{}
+
+func (secretGetter) Get(_ context.Context, _ tenant.ProjectName, _, name string) (*tenant.PlainTextSecret, error) {
+	return tenant.NewPlainTextSecret(name, "user:password")
+}
@@ -24,9 +24,12 @@ const (
 )
 
 type airflowRequest struct {
-	path   string
-	method string
-	body   []byte
+	// endpoint names the api called, the calls to an endpoint are limited together
+	endpoint string
+	path     string
+	query    url.Values
+	method   string
+	body     []byte
 }
 
 type DagRunListResponse struct {
@@ -52,26 +55,103 @@ type DagRunRequest struct {
 type SchedulerAuth struct {
 	host  string
 	token string
+
+	// bearer marks the token as an OAuth2 access token, used by Cloud Composer
+	bearer bool
+	secure bool
+}
+
+func (a SchedulerAuth) authorizationHeader() string {
+	if a.bearer {
+		return fmt.Sprintf("Bearer %s", a.token)
+	}
+	return fmt.Sprintf("Basic %s", base64.StdEncoding.EncodeToString([]byte(a.token)))
+}
+
+// APIError is returned when airflow responds with a non 200 status code
+type APIError struct {
+	StatusCode int
+	Endpoint   string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("status code received %d on calling %s", e.StatusCode, e.Endpoint)
+}
+
+// Temporary reports whether the request might succeed when retried
+func (e *APIError) Temporary() bool {
+	return e.StatusCode >= http.StatusInternalServerError || e.StatusCode == http.StatusTooManyRequests
+}
+
+// ClientConfig pools the connections to the airflow hosts and limits the calls to every endpoint of a host
+type ClientConfig struct {
+	Timeout             time.Duration
+	MaxIdleConnsPerHost int
+	MaxConnsPerHost     int
+	IdleConnTimeout     time.Duration
+
+	RateLimits     map[string]float64
+	RateLimitBurst int
+
+	FailureThreshold int
+	OpenDuration     time.Duration
 }
 
+// ClientAirflow is shared by every call to airflow, so the calls of the deployments, replays and run queries
+// are limited together
 type ClientAirflow struct {
 	client *http.Client
+	limits *endpointLimits
 }
 
-func NewAirflowClient() *ClientAirflow {
-	return &ClientAirflow{client: &http.Client{}}
+func NewAirflowClient(config ClientConfig) *ClientAirflow {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConnsPerHost = config.MaxIdleConnsPerHost
+	transport.MaxConnsPerHost = config.MaxConnsPerHost
+	transport.IdleConnTimeout = config.IdleConnTimeout
+	return &ClientAirflow{
+		client: &http.Client{Transport: transport, Timeout: config.Timeout},
+		limits: newEndpointLimits(config),
+	}
 }
 
 func (ac ClientAirflow) Invoke(ctx context.Context, r airflowRequest, auth SchedulerAuth) ([]byte, error) {
 	var resp []byte
 
-	endpoint := buildEndPoint(auth.host, r.path)
+	limit := ac.limits.of(auth.host, r.endpoint)
+	if !limit.breaker.allow(time.Now()) {
+		raiseThrottledMetric(r.endpoint, reasonCircuitOpen)
+		return resp, &CircuitOpenError{Endpoint: r.endpoint}
+	}
+	throttled, err := limit.limiter.wait(ctx)
+	if throttled {
+		raiseThrottledMetric(r.endpoint, reasonRateLimited)
+	}
+	if err != nil {
+		limit.breaker.release()
+		return resp, fmt.Errorf("call to %s throttled due to %w", r.endpoint, err)
+	}
+
+	callStartTime := time.Now()
+	resp, err = ac.do(ctx, r, auth)
+	observeCallDuration(r.endpoint, err, time.Since(callStartTime))
+
+	var apiErr *APIError
+	failed := err != nil && (!errors.As(err, &apiErr) || apiErr.Temporary())
+	limit.breaker.record(failed, time.Now())
+	return resp, err
+}
+
+func (ac ClientAirflow) do(ctx context.Context, r airflowRequest, auth SchedulerAuth) ([]byte, error) {
+	var resp []byte
+
+	endpoint := buildEndPoint(auth.host, r.path, r.query, auth.secure)
 	request, err := http.NewRequestWithContext(ctx, r.method, endpoint, bytes.NewBuffer(r.body))
 	if err != nil {
 		return resp, fmt.Errorf("failed to build http request for %s due to %w", endpoint, err)
 	}
 	request.Header.Set("Content-Type", "application/json")
-	request.Header.Set("Authorization", fmt.Sprintf("Basic %s", base64.StdEncoding.EncodeToString([]byte(auth.token))))
+	request.Header.Set("Authorization", auth.authorizationHeader())
 
 	httpResp, respErr := ac.client.Do(request)
 	if respErr != nil {
@@ -79,7 +159,7 @@ func (ac ClientAirflow) Invoke(ctx context.Context, r airflowRequest, auth Sched
 	}
 	if httpResp.StatusCode != http.StatusOK {
 		httpResp.Body.Close()
-		return resp, fmt.Errorf("status code received %d on calling %s", httpResp.StatusCode, endpoint)
+		return resp, &APIError{StatusCode: httpResp.StatusCode, Endpoint: endpoint}
 	}
 	return parseResponse(httpResp)
 }
@@ -94,12 +174,17 @@ func parseResponse(resp *http.Response) ([]byte, error) {
 	return body, nil
 }
 
-func buildEndPoint(host, path string) string {
+func buildEndPoint(host, path string, query url.Values, secure bool) string {
 	host = strings.Trim(host, "/")
+	scheme := "http"
+	if secure {
+		scheme = "https"
+	}
 	u := &url.URL{
-		Scheme: "http",
-		Host:   host,
-		Path:   path,
+		Scheme:   scheme,
+		Host:     host,
+		Path:     path,
+		RawQuery: query.Encode(),
 	}
 	return u.String()
 }
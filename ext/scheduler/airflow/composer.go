@@ -0,0 +1,139 @@
+package airflow
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+)
+
+const (
+	schedulerTypeAirflow  = "airflow"
+	schedulerTypeComposer = "composer"
+
+	composerScope = "https://www.googleapis.com/auth/cloud-platform"
+
+	healthURL     = "api/v1/health"
+	statusHealthy = "healthy"
+)
+
+type HealthResponse struct {
+	Metadatabase struct {
+		Status string `json:"status"`
+	} `json:"metadatabase"`
+	Scheduler struct {
+		Status                   string `json:"status"`
+		LatestSchedulerHeartbeat string `json:"latest_scheduler_heartbeat"`
+	} `json:"scheduler"`
+}
+
+// composerTokenSources caches token sources per project, so access tokens
+// are only refreshed once expired instead of on every airflow api call
+type composerTokenSources struct {
+	mu      sync.Mutex
+	sources map[tenant.ProjectName]composerTokenSource
+}
+
+type composerTokenSource struct {
+	credential string
+	source     oauth2.TokenSource
+}
+
+func newComposerTokenSources() *composerTokenSources {
+	return &composerTokenSources{sources: map[tenant.ProjectName]composerTokenSource{}}
+}
+
+func (c *composerTokenSources) token(projectName tenant.ProjectName, serviceAccount string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cached, ok := c.sources[projectName]
+	if !ok || cached.credential != serviceAccount {
+		// the token source outlives the request it is created in, so it is not bound to the context of the request
+		creds, err := google.CredentialsFromJSON(context.Background(), []byte(serviceAccount), composerScope)
+		if err != nil {
+			return "", errors.InvalidArgument(EntityAirflow, "invalid composer service account: "+err.Error())
+		}
+		cached = composerTokenSource{
+			credential: serviceAccount,
+			source:     oauth2.ReuseTokenSource(nil, creds.TokenSource),
+		}
+		c.sources[projectName] = cached
+	}
+
+	token, err := cached.source.Token()
+	if err != nil {
+		return "", errors.InternalError(EntityAirflow, "unable to get composer access token", err)
+	}
+	return token.AccessToken, nil
+}
+
+func (s *Scheduler) getComposerAuth(projectName tenant.ProjectName, host, serviceAccount string) (SchedulerAuth, error) {
+	token, err := s.composerTokens.token(projectName, serviceAccount)
+	if err != nil {
+		return SchedulerAuth{}, err
+	}
+
+	return SchedulerAuth{
+		host:   composerHost(host),
+		token:  token,
+		bearer: true,
+		secure: true,
+	}, nil
+}
+
+// composerHost returns the host of the composer airflow web server, which is configured as its url
+func composerHost(host string) string {
+	host = strings.TrimPrefix(host, "https://")
+	host = strings.TrimPrefix(host, "http://")
+	return strings.TrimSuffix(host, "/")
+}
+
+// GetEnvironmentHealth returns the health of airflow environment as reported by its health api
+func (s *Scheduler) GetEnvironmentHealth(ctx context.Context, tnnt tenant.Tenant) (*scheduler.EnvironmentHealth, error) {
+	spanCtx, span := startChildSpan(ctx, "GetEnvironmentHealth")
+	defer span.End()
+
+	schdAuth, err := s.getSchedulerAuth(ctx, tnnt)
+	if err != nil {
+		return nil, err
+	}
+
+	req := airflowRequest{
+		endpoint: endpointHealth,
+		path:     healthURL,
+		method:   http.MethodGet,
+	}
+	resp, err := s.client.Invoke(spanCtx, req, schdAuth)
+	if err != nil {
+		return nil, errors.Wrap(EntityAirflow, "failure while fetching airflow health", err)
+	}
+
+	var health HealthResponse
+	if err := json.Unmarshal(resp, &health); err != nil {
+		return nil, errors.Wrap(EntityAirflow, "json error on parsing airflow health: "+string(resp), err)
+	}
+
+	schedulerType := schedulerTypeAirflow
+	if schdAuth.bearer {
+		schedulerType = schedulerTypeComposer
+	}
+	envHealth := &scheduler.EnvironmentHealth{
+		Type:                schedulerType,
+		MetadatabaseHealthy: health.Metadatabase.Status == statusHealthy,
+		SchedulerHealthy:    health.Scheduler.Status == statusHealthy,
+	}
+	if heartbeat, err := time.Parse(time.RFC3339, health.Scheduler.LatestSchedulerHeartbeat); err == nil {
+		envHealth.LatestSchedulerHeartbeat = heartbeat
+	}
+	return envHealth, nil
+}
@@ -0,0 +1,69 @@
+package airflow
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComposerHost(t *testing.T) {
+	t.Run("strips the scheme and the trailing slash of the web server url", func(t *testing.T) {
+		assert.Equal(t, "abc.composer.googleusercontent.com", composerHost("https://abc.composer.googleusercontent.com/"))
+		assert.Equal(t, "abc.composer.googleusercontent.com", composerHost("http://abc.composer.googleusercontent.com"))
+		assert.Equal(t, "abc.composer.googleusercontent.com", composerHost("abc.composer.googleusercontent.com"))
+	})
+}
+
+func TestComposerTokenSources(t *testing.T) {
+	t.Run("reuses the token of the project until it expires", func(t *testing.T) {
+		var tokenRequests int
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			tokenRequests++
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"access_token": "composer-token", "token_type": "Bearer", "expires_in": 3600}`))
+		}))
+		defer server.Close()
+
+		serviceAccount := newTestServiceAccount(t, server.URL)
+		sources := newComposerTokenSources()
+
+		for i := 0; i < 2; i++ {
+			token, err := sources.token("proj", serviceAccount)
+			assert.NoError(t, err)
+			assert.Equal(t, "composer-token", token)
+		}
+		assert.Equal(t, 1, tokenRequests)
+	})
+	t.Run("returns error when the service account is not valid", func(t *testing.T) {
+		sources := newComposerTokenSources()
+
+		_, err := sources.token("proj", "invalid")
+		assert.ErrorContains(t, err, "invalid composer service account")
+	})
+}
+
+func newTestServiceAccount(t *testing.T, tokenURL string) string {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+	serviceAccount, err := json.Marshal(map[string]string{
+		"type":           "service_account",
+		"project_id":     "proj",
+		"private_key_id": "key-id",
+		"private_key":    string(keyPEM),
+		"client_email":   "optimus@proj.iam.gserviceaccount.com",
+		"token_uri":      tokenURL,
+	})
+	assert.NoError(t, err)
+	return string(serviceAccount)
+}
@@ -15,6 +15,7 @@ import (
 
 type PluginRepo interface {
 	GetByName(name string) (*plugin.Plugin, error)
+	GetByNameAndVersion(name, version string) (*plugin.Plugin, error)
 }
 
 type Compiler struct {
@@ -42,7 +43,7 @@ func (c *Compiler) Compile(project *tenant.Project, jobDetails *scheduler.JobWit
 
 	runtimeConfig := SetupRuntimeConfig(jobDetails)
 
-	upstreams := SetupUpstreams(jobDetails.Upstreams, c.hostname)
+	upstreams := SetupUpstreams(jobDetails.Upstreams, jobDetails.RuntimeConfig, c.hostname)
 
 	templateContext := TemplateContext{
 		JobDetails:      jobDetails,
@@ -92,6 +92,33 @@ func TestDagCompiler(t *testing.T) {
 				assert.Equal(t, string(compiledTemplate24), string(compiledDag))
 			})
 		})
+		t.Run("compiles catch up policy of the job", func(t *testing.T) {
+			t.Run("with catchup enabled for full policy", func(t *testing.T) {
+				com, err := dag.NewDagCompiler(nil, "http://optimus.example.com", repo)
+				assert.NoError(t, err)
+
+				job := setupJobDetails(tnnt)
+				job.Schedule.CatchUp = scheduler.CatchUpFull
+				project := setProject(tnnt, "2.4.3")
+				compiledDag, err := com.Compile(project, job)
+				assert.NoError(t, err)
+				assert.Contains(t, string(compiledDag), "catchup=True,")
+				assert.Contains(t, string(compiledDag), `"start_date": datetime.strptime("2022-11-10T05:02:00"`)
+			})
+			t.Run("with start date moved to the first deployment for none policy", func(t *testing.T) {
+				com, err := dag.NewDagCompiler(nil, "http://optimus.example.com", repo)
+				assert.NoError(t, err)
+
+				job := setupJobDetails(tnnt)
+				job.Schedule.CatchUp = scheduler.CatchUpNone
+				job.Job.CreatedAt = time.Date(2023, 3, 1, 10, 30, 0, 0, time.UTC)
+				project := setProject(tnnt, "2.4.3")
+				compiledDag, err := com.Compile(project, job)
+				assert.NoError(t, err)
+				assert.Contains(t, string(compiledDag), "catchup=False,")
+				assert.Contains(t, string(compiledDag), `"start_date": datetime.strptime("2023-03-01T10:30:00"`)
+			})
+		})
 	})
 }
 
@@ -161,10 +188,19 @@ func setupJobDetails(tnnt tenant.Tenant) *scheduler.JobWithDetails {
 				Memory: "2G",
 			},
 		},
+		Sensors: []scheduler.SensorConfig{
+			{Upstream: "project/foo-inter-dep-job", PokeInterval: 5 * time.Minute},
+			{Upstream: "foo-external-optimus-dep-job", Timeout: 2 * time.Hour, Freshness: scheduler.SensorFreshness{
+				PartitionExists: true,
+				MaxAge:          6 * time.Hour,
+			}},
+			{Upstream: "bigquery://bq-project:bq_dataset.bq_table", Freshness: scheduler.SensorFreshness{MaxAge: 12 * time.Hour}},
+		},
 	}
 
 	tnnt1, _ := tenant.NewTenant("project", "namespace")
 	tnnt2, _ := tenant.NewTenant("external-project", "external-namespace")
+	tnnt3, _ := tenant.NewTenant("bq-project", "bq_dataset")
 	upstreams := scheduler.Upstreams{
 		HTTP: nil,
 		UpstreamJobs: []*scheduler.JobUpstream{
@@ -183,12 +219,22 @@ func setupJobDetails(tnnt tenant.Tenant) *scheduler.JobWithDetails {
 				State:    "resolved",
 			},
 			{
-				JobName:  "foo-external-optimus-dep-job",
-				Host:     "http://optimus.external.io",
-				TaskName: "bq-bq",
-				Tenant:   tnnt2,
-				External: true,
-				State:    "resolved",
+				JobName:        "foo-external-optimus-dep-job",
+				Host:           "http://optimus.external.io",
+				TaskName:       "bq-bq",
+				DestinationURN: "bigquery://external-project:external_dataset.daily_report",
+				Tenant:         tnnt2,
+				External:       true,
+				State:          "resolved",
+			},
+			{
+				JobName:        "bq-project.bq_dataset.bq_table",
+				Host:           scheduler.UpstreamHostBigQuery,
+				TaskName:       "bigquery",
+				DestinationURN: "bigquery://bq-project:bq_dataset.bq_table",
+				Tenant:         tnnt3,
+				External:       true,
+				State:          "resolved",
 			},
 		},
 	}
@@ -220,6 +266,15 @@ func (m mockPluginRepo) GetByName(name string) (*plugin.Plugin, error) {
 	return nil, fmt.Errorf("error finding %s", name)
 }
 
+func (m mockPluginRepo) GetByNameAndVersion(name, version string) (*plugin.Plugin, error) {
+	for _, plugin := range m.plugins {
+		if plugin.Info().Name == name && (version == "" || plugin.Info().PluginVersion == version) {
+			return plugin, nil
+		}
+	}
+	return nil, fmt.Errorf("error finding %s@%s", name, version)
+}
+
 func setupPluginRepo() mockPluginRepo {
 	execUnit := new(mock.YamlMod)
 	execUnit.On("PluginInfo").Return(&plugin.Info{
@@ -8,7 +8,7 @@ from __lib import job_success_event, job_failure_event
 # import operator level callbacks
 from __lib import operator_start_event, operator_success_event, operator_retry_event, operator_failure_event
 
-from __lib import optimus_sla_miss_notify, SuperKubernetesPodOperator, SuperExternalTaskSensor
+from __lib import optimus_sla_miss_notify, SuperKubernetesPodOperator, SuperExternalTaskSensor, BigQueryTableSensor
 
 from airflow.configuration import conf
 from airflow.models import DAG, Variable
@@ -82,6 +82,11 @@ def get_entrypoint_cmd(plugin_entrypoint_script):
     entrypoint += "set -o allexport; source {path_secret}; set +o allexport; ".format(path_secret=path_secret)
     return entrypoint + plugin_entrypoint_script
 
+def get_hook_entrypoint_cmd(plugin_entrypoint_script):
+    # hooks whose when condition does not hold in the run exit without running the plugin
+    skip_check = 'if [ "$HOOK_SKIPPED" = "true" ]; then echo "hook is skipped in this run"; exit 0; fi; '
+    return get_entrypoint_cmd(skip_check + plugin_entrypoint_script)
+
 volume = k8s.V1Volume(
     name='asset-volume',
     empty_dir=k8s.V1EmptyDirVolumeSource()
@@ -100,9 +105,11 @@ executor_env_vars = [
 init_env_vars = [
     k8s.V1EnvVar(name="JOB_DIR", value=JOB_DIR),
     k8s.V1EnvVar(name="JOB_NAME", value='infra.billing.weekly-status-reports'),
+    k8s.V1EnvVar(name="JOB_RUN_ATTEMPT", value='{{ task_instance.try_number }}'),
     k8s.V1EnvVar(name="OPTIMUS_HOST", value='http://optimus.example.com'),
     k8s.V1EnvVar(name="PROJECT", value='example-proj'),
     k8s.V1EnvVar(name="SCHEDULED_AT", value='{{ next_execution_date }}'),
+    k8s.V1EnvVar(name="SCHEDULER_DAG_RUN_ID", value='{{ run_id }}'),
 ]
 
 init_container = k8s.V1Container(
@@ -160,7 +167,7 @@ hook_transporter = SuperKubernetesPodOperator(
     namespace=conf.get('kubernetes', 'namespace', fallback="default"),
     image="example.io/namespace/transporter-executor:latest",
     cmds=["/bin/sh", "-c"],
-    arguments=[get_entrypoint_cmd("""java -cp /opt/transporter/transporter.jar:/opt/transporter/jolokia-jvm-agent.jar -javaagent:jolokia-jvm-agent.jar=port=7777,host=0.0.0.0 com.gojek.transporter.Main """)],
+    arguments=[get_hook_entrypoint_cmd("""java -cp /opt/transporter/transporter.jar:/opt/transporter/jolokia-jvm-agent.jar -javaagent:jolokia-jvm-agent.jar=port=7777,host=0.0.0.0 com.gojek.transporter.Main """)],
     name="hook_transporter",
     task_id="hook_transporter",
     get_logs=True,
@@ -195,7 +202,7 @@ hook_predator = SuperKubernetesPodOperator(
     namespace=conf.get('kubernetes', 'namespace', fallback="default"),
     image="example.io/namespace/predator-image:latest",
     cmds=["/bin/sh", "-c"],
-    arguments=[get_entrypoint_cmd("""predator ${SUB_COMMAND} -s ${PREDATOR_URL} -u "${BQ_PROJECT}.${BQ_DATASET}.${BQ_TABLE}" """)],
+    arguments=[get_hook_entrypoint_cmd("""predator ${SUB_COMMAND} -s ${PREDATOR_URL} -u "${BQ_PROJECT}.${BQ_DATASET}.${BQ_TABLE}" """)],
     name="hook_predator",
     task_id="hook_predator",
     get_logs=True,
@@ -230,7 +237,7 @@ hook_failureHook = SuperKubernetesPodOperator(
     namespace=conf.get('kubernetes', 'namespace', fallback="default"),
     image="example.io/namespace/failure-hook-image:latest",
     cmds=["/bin/sh", "-c"],
-    arguments=[get_entrypoint_cmd("""sleep 5 """)],
+    arguments=[get_hook_entrypoint_cmd("""sleep 5 """)],
     name="hook_failureHook",
     task_id="hook_failureHook",
     get_logs=True,
@@ -241,6 +248,7 @@ hook_failureHook = SuperKubernetesPodOperator(
     do_xcom_push=False,
     env_vars=executor_env_vars,
     trigger_rule="one_failed",
+    params={"hook_type": "fail"},
     resources=resources,
     reattach_on_restart=True,
     volume_mounts=asset_volume_mounts,
@@ -276,7 +284,7 @@ wait_foo__dash__inter__dash__dep__dash__job = SuperExternalTaskSensor(
     upstream_optimus_project="project",
     upstream_optimus_namespace="namespace",
     upstream_optimus_job="foo-inter-dep-job",
-    poke_interval=SENSOR_DEFAULT_POKE_INTERVAL_IN_SECS,
+    poke_interval=300,
     timeout=SENSOR_DEFAULT_TIMEOUT_IN_SECS,
     task_id="wait_foo-inter-dep-job-bq-bq",
     depends_on_past=False,
@@ -292,13 +300,31 @@ wait_foo__dash__external__dash__optimus__dash__dep__dash__job = SuperExternalTas
     upstream_optimus_project="external-project",
     upstream_optimus_namespace="external-namespace",
     upstream_optimus_job="foo-external-optimus-dep-job",
+    upstream_resource_urn="bigquery://external-project:external_dataset.daily_report",
+    freshness_partition_exists=True,
+    freshness_max_age_in_secs=21600,
     poke_interval=SENSOR_DEFAULT_POKE_INTERVAL_IN_SECS,
-    timeout=SENSOR_DEFAULT_TIMEOUT_IN_SECS,
+    timeout=7200,
     task_id="wait_foo-external-optimus-dep-job-bq-bq",
     depends_on_past=False,
     dag=dag,
     pool=POOL_SENSOR
 )
+
+wait_bq__dash__project__dot__bq_dataset__dot__bq_table = BigQueryTableSensor(
+    table="bq-project.bq_dataset.bq_table",
+    optimus_hostname="http://optimus.example.com",
+    project_name="example-proj",
+    job_name="infra.billing.weekly-status-reports",
+    upstream_resource_urn="bigquery://bq-project:bq_dataset.bq_table",
+    freshness_max_age_in_secs=43200,
+    poke_interval=SENSOR_DEFAULT_POKE_INTERVAL_IN_SECS,
+    timeout=SENSOR_DEFAULT_TIMEOUT_IN_SECS,
+    task_id="wait_bq-project.bq_dataset.bq_table-bigquery",
+    depends_on_past=False,
+    dag=dag,
+    pool=POOL_SENSOR
+)
 # arrange inter task dependencies
 ####################################
 
@@ -306,6 +332,7 @@ wait_foo__dash__external__dash__optimus__dash__dep__dash__job = SuperExternalTas
 wait_foo__dash__intra__dash__dep__dash__job >> transformation_bq__dash__bq
 wait_foo__dash__inter__dash__dep__dash__job >> transformation_bq__dash__bq
 wait_foo__dash__external__dash__optimus__dash__dep__dash__job >> transformation_bq__dash__bq
+wait_bq__dash__project__dot__bq_dataset__dot__bq_table >> transformation_bq__dash__bq
 
 # setup hooks and dependencies
 # [Dependency/HttpDep/ExternalDep/PreHook] -> Task -> [Post Hook -> Fail Hook]
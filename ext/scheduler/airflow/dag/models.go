@@ -1,6 +1,7 @@
 package dag
 
 import (
+	"sort"
 	"time"
 
 	"github.com/goto/optimus/core/scheduler"
@@ -37,7 +38,7 @@ type Task struct {
 }
 
 func PrepareTask(job *scheduler.Job, pluginRepo PluginRepo) (Task, error) {
-	plugin, err := pluginRepo.GetByName(job.Task.Name)
+	plugin, err := pluginRepo.GetByNameAndVersion(job.Task.Name, job.Task.Version)
 	if err != nil {
 		return Task{}, errors.NotFound(EntitySchedulerAirflow, "plugin not found for "+job.Task.Name)
 	}
@@ -62,7 +63,13 @@ type Hooks struct {
 	Pre          []Hook
 	Post         []Hook
 	Fail         []Hook
-	Dependencies map[string]string
+	Dependencies []HookDependency
+}
+
+// HookDependency makes the After hook run once the Before hook finishes
+type HookDependency struct {
+	Before string
+	After  string
 }
 
 func (h Hooks) List() []Hook { //nolint: gocritic
@@ -72,23 +79,68 @@ func (h Hooks) List() []Hook { //nolint: gocritic
 	return list
 }
 
+// TaskUpstreams returns the pre hooks which no other pre hook depends on, the rest of the pre hooks
+// reach the task through the hooks depending on them
+func (h Hooks) TaskUpstreams() []Hook { //nolint: gocritic
+	return h.withoutDependencyInPhase(h.Pre, func(dep HookDependency) string { return dep.Before })
+}
+
+// TaskDownstreams returns the post hooks which do not depend on another post hook, the rest of the post hooks
+// run after the hooks they depend on
+func (h Hooks) TaskDownstreams() []Hook { //nolint: gocritic
+	return h.withoutDependencyInPhase(h.Post, func(dep HookDependency) string { return dep.After })
+}
+
+func (h Hooks) withoutDependencyInPhase(phase []Hook, side func(HookDependency) string) []Hook { //nolint: gocritic
+	inPhase := map[string]bool{}
+	for _, hook := range phase {
+		inPhase[hook.Name] = true
+	}
+
+	linked := map[string]bool{}
+	for _, dep := range h.Dependencies {
+		if inPhase[dep.Before] && inPhase[dep.After] {
+			linked[side(dep)] = true
+		}
+	}
+
+	var hooks []Hook
+	for _, hook := range phase {
+		if !linked[hook.Name] {
+			hooks = append(hooks, hook)
+		}
+	}
+	return hooks
+}
+
 func PrepareHooksForJob(job *scheduler.Job, pluginRepo PluginRepo) (Hooks, error) {
 	var hooks Hooks
-	hooks.Dependencies = map[string]string{}
+	dependencies := map[HookDependency]bool{}
 
+	infos := make(map[string]*plugin.Info, len(job.Hooks))
+	pluginDependsOn := make(map[string][]string, len(job.Hooks))
 	for _, h := range job.Hooks {
 		hook, err := pluginRepo.GetByName(h.Name)
 		if err != nil {
 			return Hooks{}, errors.NotFound("schedulerAirflow", "hook not found for name "+h.Name)
 		}
+		infos[h.Name] = hook.Info()
+		pluginDependsOn[h.Name] = infos[h.Name].DependsOn
+	}
 
-		info := hook.Info()
+	// hooks are rendered in the order of execution, for the dags to be the same across deployments
+	for _, h := range job.OrderedHooks(pluginDependsOn) {
+		info := infos[h.Name]
 		hk := Hook{
 			Name:       h.Name,
 			Image:      info.Image,
 			Entrypoint: info.Entrypoint,
 		}
-		switch info.HookType {
+		hookType := info.HookType
+		if h.Phase != "" {
+			hookType = plugin.HookType(h.Phase)
+		}
+		switch hookType {
 		case plugin.HookTypePre:
 			hooks.Pre = append(hooks.Pre, hk)
 		case plugin.HookTypePost:
@@ -98,15 +150,28 @@ func PrepareHooksForJob(job *scheduler.Job, pluginRepo PluginRepo) (Hooks, error
 			hooks.Fail = append(hooks.Fail, hk)
 		}
 
-		for _, before := range info.DependsOn {
-			_, err = job.GetHook(before)
-			if err != nil {
+		// dependencies on hooks left out of the job, such as the disabled ones, are skipped
+		dependsOn := make([]string, 0, len(info.DependsOn)+len(h.DependsOn))
+		dependsOn = append(dependsOn, info.DependsOn...)
+		dependsOn = append(dependsOn, h.DependsOn...)
+		for _, before := range dependsOn {
+			if _, err := job.GetHook(before); err != nil {
 				continue
 			}
-			hooks.Dependencies[before] = h.Name
+			dependencies[HookDependency{Before: before, After: h.Name}] = true
 		}
 	}
 
+	for dep := range dependencies {
+		hooks.Dependencies = append(hooks.Dependencies, dep)
+	}
+	sort.Slice(hooks.Dependencies, func(i, j int) bool {
+		if hooks.Dependencies[i].Before != hooks.Dependencies[j].Before {
+			return hooks.Dependencies[i].Before < hooks.Dependencies[j].Before
+		}
+		return hooks.Dependencies[i].After < hooks.Dependencies[j].After
+	})
+
 	return hooks, nil
 }
 
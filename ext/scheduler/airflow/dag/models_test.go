@@ -0,0 +1,73 @@
+package dag_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/ext/scheduler/airflow/dag"
+)
+
+func TestPrepareHooksForJob(t *testing.T) {
+	repo := setupPluginRepo()
+
+	t.Run("uses the hook type of the plugin when phase is not set", func(t *testing.T) {
+		job := &scheduler.Job{Hooks: []*scheduler.Hook{{Name: "transporter"}, {Name: "predator"}, {Name: "failureHook"}}}
+
+		hooks, err := dag.PrepareHooksForJob(job, repo)
+		assert.NoError(t, err)
+
+		assert.Equal(t, []string{"transporter"}, hookNames(hooks.Pre))
+		assert.Equal(t, []string{"predator"}, hookNames(hooks.Post))
+		assert.Equal(t, []string{"failureHook"}, hookNames(hooks.Fail))
+		assert.Equal(t, []dag.HookDependency{{Before: "predator", After: "transporter"}}, hooks.Dependencies)
+	})
+	t.Run("renders the hooks depending on other hooks of the phase as a chain", func(t *testing.T) {
+		job := &scheduler.Job{Hooks: []*scheduler.Hook{
+			{Name: "predator"},
+			{Name: "failureHook", Phase: "post", DependsOn: []string{"predator"}},
+		}}
+
+		hooks, err := dag.PrepareHooksForJob(job, repo)
+		assert.NoError(t, err)
+
+		assert.Equal(t, []string{"predator", "failureHook"}, hookNames(hooks.Post))
+		assert.Empty(t, hooks.Fail)
+		assert.Equal(t, []dag.HookDependency{{Before: "predator", After: "failureHook"}}, hooks.Dependencies)
+		assert.Equal(t, []string{"predator"}, hookNames(hooks.TaskDownstreams()))
+	})
+	t.Run("connects only the last pre hooks of a chain to the task", func(t *testing.T) {
+		job := &scheduler.Job{Hooks: []*scheduler.Hook{
+			{Name: "predator", Phase: "pre"},
+			{Name: "transporter", DependsOn: []string{"predator"}},
+		}}
+
+		hooks, err := dag.PrepareHooksForJob(job, repo)
+		assert.NoError(t, err)
+
+		assert.Equal(t, []string{"predator", "transporter"}, hookNames(hooks.Pre))
+		assert.Equal(t, []dag.HookDependency{{Before: "predator", After: "transporter"}}, hooks.Dependencies)
+		assert.Equal(t, []string{"transporter"}, hookNames(hooks.TaskUpstreams()))
+	})
+	t.Run("orders the hooks of a phase after the hooks they depend on", func(t *testing.T) {
+		job := &scheduler.Job{Hooks: []*scheduler.Hook{
+			{Name: "failureHook", Phase: "post", DependsOn: []string{"predator"}},
+			{Name: "predator"},
+		}}
+
+		hooks, err := dag.PrepareHooksForJob(job, repo)
+		assert.NoError(t, err)
+
+		assert.Equal(t, []string{"predator", "failureHook"}, hookNames(hooks.Post))
+		assert.Equal(t, []dag.HookDependency{{Before: "predator", After: "failureHook"}}, hooks.Dependencies)
+	})
+}
+
+func hookNames(hooks []dag.Hook) []string {
+	var names []string
+	for _, hook := range hooks {
+		names = append(names, hook.Name)
+	}
+	return names
+}
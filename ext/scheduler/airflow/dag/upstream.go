@@ -1,6 +1,8 @@
 package dag
 
 import (
+	"strings"
+
 	"github.com/goto/optimus/core/scheduler"
 	"github.com/goto/optimus/core/tenant"
 )
@@ -22,9 +24,25 @@ type Upstream struct {
 	Tenant   tenant.Tenant
 	Host     string
 	TaskName string
+
+	// BigQueryTable is the project.dataset.table of the upstreams on bigquery tables not managed by any optimus
+	BigQueryTable string
+
+	// PokeIntervalInSecs and TimeoutInSecs override the sensor defaults when greater than zero
+	PokeIntervalInSecs int64
+	TimeoutInSecs      int64
+
+	// DestinationURN is the resource of the upstream, checked for the freshness predicates of the sensor
+	DestinationURN           string
+	FreshnessPartitionExists bool
+	FreshnessMaxAgeInSecs    int64
+}
+
+func (u Upstream) HasFreshness() bool {
+	return u.DestinationURN != "" && (u.FreshnessPartitionExists || u.FreshnessMaxAgeInSecs > 0)
 }
 
-func SetupUpstreams(upstreams scheduler.Upstreams, host string) Upstreams {
+func SetupUpstreams(upstreams scheduler.Upstreams, runtimeConfig scheduler.RuntimeConfig, host string) Upstreams {
 	var ups []Upstream
 	for _, u := range upstreams.UpstreamJobs {
 		var upstreamHost string
@@ -33,11 +51,22 @@ func SetupUpstreams(upstreams scheduler.Upstreams, host string) Upstreams {
 		} else {
 			upstreamHost = u.Host
 		}
+		sensor := runtimeConfig.SensorFor(u)
 		upstream := Upstream{
-			JobName:  u.JobName,
-			Tenant:   u.Tenant,
-			Host:     upstreamHost,
-			TaskName: u.TaskName,
+			JobName:            u.JobName,
+			Tenant:             u.Tenant,
+			Host:               upstreamHost,
+			TaskName:           u.TaskName,
+			PokeIntervalInSecs: int64(sensor.PokeInterval.Seconds()),
+			TimeoutInSecs:      int64(sensor.Timeout.Seconds()),
+		}
+		if u.IsBigQueryTable() {
+			upstream.BigQueryTable = bigQueryTableFrom(u.DestinationURN)
+		}
+		if u.DestinationURN != "" && !sensor.Freshness.IsEmpty() {
+			upstream.DestinationURN = u.DestinationURN
+			upstream.FreshnessPartitionExists = sensor.Freshness.PartitionExists
+			upstream.FreshnessMaxAgeInSecs = int64(sensor.Freshness.MaxAge.Seconds())
 		}
 		ups = append(ups, upstream)
 	}
@@ -46,3 +75,8 @@ func SetupUpstreams(upstreams scheduler.Upstreams, host string) Upstreams {
 		Upstreams: ups,
 	}
 }
+
+// bigQueryTableFrom converts urn like bigquery://project:dataset.table to project.dataset.table
+func bigQueryTableFrom(urn string) string {
+	return strings.Replace(strings.TrimPrefix(urn, "bigquery://"), ":", ".", 1)
+}
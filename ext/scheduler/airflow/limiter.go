@@ -0,0 +1,210 @@
+package airflow
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/goto/optimus/internal/telemetry"
+)
+
+const (
+	endpointDagRuns   = "dag_runs"
+	endpointDag       = "dag"
+	endpointClear     = "clear"
+	endpointCreateRun = "create_run"
+	endpointHealth    = "health"
+
+	metricThrottledCalls = "scheduler_throttled_calls_total"
+	metricCallDuration   = "scheduler_api_call_duration_seconds"
+	metricCallSuccess    = "success"
+	metricCallFailed     = "failed"
+	reasonRateLimited    = "rate_limited"
+	reasonCircuitOpen    = "circuit_open"
+)
+
+// CircuitOpenError is returned for the calls to an endpoint failing fast while its circuit is open
+type CircuitOpenError struct {
+	Endpoint string
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("calls to %s are failing, circuit is open", e.Endpoint)
+}
+
+// Temporary reports the call might succeed once the circuit is closed again
+func (*CircuitOpenError) Temporary() bool {
+	return true
+}
+
+// rateLimiter is a token bucket refilled at the rate, a rate of 0 does not limit the calls
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newRateLimiter(rate float64, burst int) *rateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &rateLimiter{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// wait blocks until the call is allowed by the rate, it reports whether the call had to wait
+func (r *rateLimiter) wait(ctx context.Context) (bool, error) {
+	if r.rate <= 0 {
+		return false, nil
+	}
+
+	r.mu.Lock()
+	now := time.Now()
+	r.tokens = math.Min(r.burst, r.tokens+now.Sub(r.last).Seconds()*r.rate)
+	r.last = now
+	r.tokens--
+	var delay time.Duration
+	if r.tokens < 0 {
+		delay = time.Duration(-r.tokens / r.rate * float64(time.Second))
+	}
+	r.mu.Unlock()
+
+	if delay == 0 {
+		return false, nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		// the token reserved for the call is returned
+		r.mu.Lock()
+		r.tokens++
+		r.mu.Unlock()
+		return true, ctx.Err()
+	case <-timer.C:
+		return true, nil
+	}
+}
+
+// circuitBreaker opens after the consecutive failures reach the threshold, once the open duration passes a
+// single trial call is let through which closes the circuit on success
+type circuitBreaker struct {
+	mu           sync.Mutex
+	threshold    int
+	openDuration time.Duration
+
+	failures int
+	openedAt time.Time
+	trial    bool
+}
+
+func (b *circuitBreaker) allow(now time.Time) bool {
+	if b.threshold <= 0 {
+		return true
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.failures < b.threshold {
+		return true
+	}
+	if b.trial || now.Sub(b.openedAt) < b.openDuration {
+		return false
+	}
+	b.trial = true
+	return true
+}
+
+func (b *circuitBreaker) record(failed bool, now time.Time) {
+	if b.threshold <= 0 {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.trial = false
+	if !failed {
+		b.failures = 0
+		return
+	}
+	b.failures++
+	if b.failures >= b.threshold {
+		b.openedAt = now
+	}
+}
+
+// release gives up the trial call when the call is not made, leaving the circuit as is
+func (b *circuitBreaker) release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.trial = false
+}
+
+type endpointLimit struct {
+	limiter *rateLimiter
+	breaker *circuitBreaker
+}
+
+// endpointLimits keeps the limits of every endpoint of every airflow host called
+type endpointLimits struct {
+	mu     sync.Mutex
+	config ClientConfig
+	limits map[string]*endpointLimit
+}
+
+func newEndpointLimits(config ClientConfig) *endpointLimits {
+	return &endpointLimits{
+		config: config,
+		limits: map[string]*endpointLimit{},
+	}
+}
+
+func (l *endpointLimits) of(host, endpoint string) *endpointLimit {
+	key := host + "/" + endpoint
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if limit, ok := l.limits[key]; ok {
+		return limit
+	}
+	limit := &endpointLimit{
+		limiter: newRateLimiter(l.config.RateLimits[endpoint], l.config.RateLimitBurst),
+		breaker: &circuitBreaker{
+			threshold:    l.config.FailureThreshold,
+			openDuration: l.config.OpenDuration,
+		},
+	}
+	l.limits[key] = limit
+	return limit
+}
+
+func observeCallDuration(endpoint string, err error, duration time.Duration) {
+	status := metricCallSuccess
+	if err != nil {
+		status = metricCallFailed
+	}
+	telemetry.NewHistogram(metricCallDuration, map[string]string{
+		"endpoint": endpoint,
+		"status":   status,
+	}).Observe(duration.Seconds())
+}
+
+func raiseThrottledMetric(endpoint, reason string) {
+	telemetry.NewCounter(metricThrottledCalls, map[string]string{
+		"endpoint": endpoint,
+		"reason":   reason,
+	}).Inc()
+}
@@ -0,0 +1,135 @@
+package airflow
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	now := time.Date(2023, 10, 10, 10, 0, 0, 0, time.UTC)
+
+	t.Run("allows every call when the threshold is not set", func(t *testing.T) {
+		breaker := &circuitBreaker{}
+		breaker.record(true, now)
+		breaker.record(true, now)
+
+		assert.True(t, breaker.allow(now))
+	})
+	t.Run("opens after the consecutive failures reach the threshold", func(t *testing.T) {
+		breaker := &circuitBreaker{threshold: 2, openDuration: time.Minute}
+		breaker.record(true, now)
+		assert.True(t, breaker.allow(now))
+		breaker.record(true, now)
+
+		assert.False(t, breaker.allow(now.Add(time.Second)))
+	})
+	t.Run("lets a single trial call through after the open duration", func(t *testing.T) {
+		breaker := &circuitBreaker{threshold: 1, openDuration: time.Minute}
+		breaker.record(true, now)
+
+		assert.True(t, breaker.allow(now.Add(time.Minute)))
+		assert.False(t, breaker.allow(now.Add(time.Minute)))
+
+		breaker.record(true, now.Add(time.Minute))
+		assert.False(t, breaker.allow(now.Add(time.Minute+time.Second)))
+	})
+	t.Run("closes when the trial call succeeds", func(t *testing.T) {
+		breaker := &circuitBreaker{threshold: 1, openDuration: time.Minute}
+		breaker.record(true, now)
+
+		assert.True(t, breaker.allow(now.Add(time.Minute)))
+		breaker.record(false, now.Add(time.Minute))
+
+		assert.True(t, breaker.allow(now.Add(time.Minute)))
+		assert.True(t, breaker.allow(now.Add(time.Minute)))
+	})
+}
+
+func TestRateLimiter(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("does not throttle when the rate is not set", func(t *testing.T) {
+		limiter := newRateLimiter(0, 1)
+		for i := 0; i < 3; i++ {
+			throttled, err := limiter.wait(ctx)
+			assert.NoError(t, err)
+			assert.False(t, throttled)
+		}
+	})
+	t.Run("throttles the calls above the burst", func(t *testing.T) {
+		limiter := newRateLimiter(50, 2)
+		for i := 0; i < 2; i++ {
+			throttled, err := limiter.wait(ctx)
+			assert.NoError(t, err)
+			assert.False(t, throttled)
+		}
+
+		throttled, err := limiter.wait(ctx)
+		assert.NoError(t, err)
+		assert.True(t, throttled)
+	})
+	t.Run("returns error when the context is done while throttled", func(t *testing.T) {
+		limiter := newRateLimiter(0.01, 1)
+		limiter.wait(ctx)
+
+		cancelledCtx, cancel := context.WithCancel(ctx)
+		cancel()
+		throttled, err := limiter.wait(cancelledCtx)
+
+		assert.True(t, throttled)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestClientAirflowInvoke(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("fails fast after the endpoint keeps failing", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		client := NewAirflowClient(ClientConfig{FailureThreshold: 2, OpenDuration: time.Minute})
+		auth := SchedulerAuth{host: strings.TrimPrefix(server.URL, "http://")}
+		req := airflowRequest{endpoint: endpointDagRuns, path: dagStatusBatchURL, method: http.MethodPost}
+
+		for i := 0; i < 2; i++ {
+			_, err := client.Invoke(ctx, req, auth)
+			var apiErr *APIError
+			assert.ErrorAs(t, err, &apiErr)
+		}
+		_, err := client.Invoke(ctx, req, auth)
+
+		var circuitErr *CircuitOpenError
+		assert.ErrorAs(t, err, &circuitErr)
+		assert.Equal(t, 2, calls)
+	})
+	t.Run("does not count the rejected calls as failures", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+
+		client := NewAirflowClient(ClientConfig{FailureThreshold: 1, OpenDuration: time.Minute})
+		auth := SchedulerAuth{host: strings.TrimPrefix(server.URL, "http://")}
+		req := airflowRequest{endpoint: endpointCreateRun, path: "api/v1/dags/job1/dagRuns", method: http.MethodPost}
+
+		for i := 0; i < 2; i++ {
+			_, err := client.Invoke(ctx, req, auth)
+			var apiErr *APIError
+			assert.ErrorAs(t, err, &apiErr)
+		}
+		assert.Equal(t, 2, calls)
+	})
+}
@@ -0,0 +1,65 @@
+package airflow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+)
+
+const (
+	poolURL       = "api/v1/pools/%s"
+	endpointPools = "pools"
+
+	// defaultPool is the pool airflow runs the tasks in when the job does not name one
+	defaultPool = "default_pool"
+)
+
+type PoolResponse struct {
+	Name          string `json:"name"`
+	Slots         int    `json:"slots"`
+	OccupiedSlots int    `json:"occupied_slots"`
+	QueuedSlots   int    `json:"queued_slots"`
+	OpenSlots     int    `json:"open_slots"`
+}
+
+// GetPoolSlots returns the usage of the airflow pool, the default pool when it is not named
+func (s *Scheduler) GetPoolSlots(ctx context.Context, tnnt tenant.Tenant, pool string) (*scheduler.PoolSlots, error) {
+	spanCtx, span := startChildSpan(ctx, "GetPoolSlots")
+	defer span.End()
+
+	if pool == "" {
+		pool = defaultPool
+	}
+
+	schdAuth, err := s.getSchedulerAuth(ctx, tnnt)
+	if err != nil {
+		return nil, err
+	}
+
+	req := airflowRequest{
+		endpoint: endpointPools,
+		path:     fmt.Sprintf(poolURL, pool),
+		method:   http.MethodGet,
+	}
+	resp, err := s.client.Invoke(spanCtx, req, schdAuth)
+	if err != nil {
+		return nil, errors.Wrap(EntityAirflow, "failure while fetching airflow pool "+pool, err)
+	}
+
+	var poolResp PoolResponse
+	if err := json.Unmarshal(resp, &poolResp); err != nil {
+		return nil, errors.Wrap(EntityAirflow, "json error on parsing airflow pool: "+string(resp), err)
+	}
+	return &scheduler.PoolSlots{
+		Name:     poolResp.Name,
+		Slots:    poolResp.Slots,
+		Occupied: poolResp.OccupiedSlots,
+		Queued:   poolResp.QueuedSlots,
+		Open:     poolResp.OpenSlots,
+	}, nil
+}
@@ -0,0 +1,47 @@
+package airflow
+
+import (
+	"context"
+	"testing"
+
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/tenant"
+)
+
+func TestGetPoolSlots(t *testing.T) {
+	ctx := context.Background()
+	tnnt, _ := tenant.NewTenant("proj", "ns1")
+	project, _ := tenant.NewProject("proj", map[string]string{
+		tenant.ProjectStoragePathKey: "gs://bucket",
+		tenant.ProjectSchedulerHost:  "airflow:8080",
+	})
+
+	t.Run("returns the usage of the default pool when the pool is not named", func(t *testing.T) {
+		var path string
+		client := clientFunc(func(r airflowRequest) ([]byte, error) {
+			path = r.path
+			return []byte(`{"name": "default_pool", "slots": 128, "occupied_slots": 128, "queued_slots": 12, "open_slots": 0}`), nil
+		})
+		sch := NewScheduler(log.NewNoop(), nil, client, nil, projectGetter{project}, secretGetter{}, CanaryConfig{})
+
+		slots, err := sch.GetPoolSlots(ctx, tnnt, "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "api/v1/pools/default_pool", path)
+		assert.Equal(t, &scheduler.PoolSlots{Name: "default_pool", Slots: 128, Occupied: 128, Queued: 12}, slots)
+	})
+	t.Run("returns error when the response is not valid", func(t *testing.T) {
+		client := clientFunc(func(r airflowRequest) ([]byte, error) {
+			return []byte(`not json`), nil
+		})
+		sch := NewScheduler(log.NewNoop(), nil, client, nil, projectGetter{project}, secretGetter{}, CanaryConfig{})
+
+		slots, err := sch.GetPoolSlots(ctx, tnnt, "bq_pool")
+
+		assert.ErrorContains(t, err, "json error on parsing airflow pool")
+		assert.Nil(t, slots)
+	})
+}
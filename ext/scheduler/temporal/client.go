@@ -0,0 +1,286 @@
+package temporal
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/goto/optimus/internal/errors"
+)
+
+const (
+	payloadEncoding = "json/plain"
+
+	workflowStatusRunning        = "WORKFLOW_EXECUTION_STATUS_RUNNING"
+	workflowStatusCompleted      = "WORKFLOW_EXECUTION_STATUS_COMPLETED"
+	workflowStatusContinuedAsNew = "WORKFLOW_EXECUTION_STATUS_CONTINUED_AS_NEW"
+)
+
+type temporalRequest struct {
+	path   string
+	query  url.Values
+	method string
+	body   []byte
+}
+
+type SchedulerAuth struct {
+	host  string
+	token string
+}
+
+// APIError is returned when temporal responds with a non 200 status code
+type APIError struct {
+	StatusCode int
+	Endpoint   string
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("status code received %d on calling %s", e.StatusCode, e.Endpoint)
+	}
+	return fmt.Sprintf("status code received %d on calling %s: %s", e.StatusCode, e.Endpoint, e.Message)
+}
+
+// Temporary reports whether the request might succeed when retried
+func (e *APIError) Temporary() bool {
+	return e.StatusCode >= http.StatusInternalServerError || e.StatusCode == http.StatusTooManyRequests
+}
+
+func isStatus(err error, statusCode int) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == statusCode
+}
+
+// Payload is a value passed to temporal, encoded as json
+type Payload struct {
+	Metadata map[string][]byte `json:"metadata"`
+	Data     []byte            `json:"data"`
+}
+
+func newPayload(value interface{}) (Payload, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return Payload{}, err
+	}
+	return Payload{
+		Metadata: map[string][]byte{"encoding": []byte(payloadEncoding)},
+		Data:     data,
+	}, nil
+}
+
+type Payloads struct {
+	Payloads []Payload `json:"payloads"`
+}
+
+type Memo struct {
+	Fields map[string]Payload `json:"fields"`
+}
+
+func (m *Memo) stringField(name string) string {
+	if m == nil {
+		return ""
+	}
+	payload, ok := m.Fields[name]
+	if !ok {
+		return ""
+	}
+	var value string
+	if err := json.Unmarshal(payload.Data, &value); err != nil {
+		return ""
+	}
+	return value
+}
+
+type WorkflowType struct {
+	Name string `json:"name"`
+}
+
+type TaskQueue struct {
+	Name string `json:"name"`
+}
+
+type RetryPolicy struct {
+	InitialInterval    string  `json:"initialInterval,omitempty"`
+	BackoffCoefficient float64 `json:"backoffCoefficient,omitempty"`
+	MaximumAttempts    int     `json:"maximumAttempts"`
+}
+
+type StartWorkflowRequest struct {
+	WorkflowID            string       `json:"workflowId"`
+	WorkflowType          WorkflowType `json:"workflowType"`
+	TaskQueue             TaskQueue    `json:"taskQueue"`
+	Input                 *Payloads    `json:"input,omitempty"`
+	RetryPolicy           *RetryPolicy `json:"retryPolicy,omitempty"`
+	WorkflowIDReusePolicy string       `json:"workflowIdReusePolicy,omitempty"`
+}
+
+type ScheduleSpec struct {
+	CronString   []string   `json:"cronString"`
+	StartTime    *time.Time `json:"startTime,omitempty"`
+	EndTime      *time.Time `json:"endTime,omitempty"`
+	TimezoneName string     `json:"timezoneName"`
+}
+
+type ScheduleAction struct {
+	StartWorkflow StartWorkflowRequest `json:"startWorkflow"`
+}
+
+type SchedulePolicies struct {
+	OverlapPolicy  string `json:"overlapPolicy"`
+	CatchupWindow  string `json:"catchupWindow,omitempty"`
+	PauseOnFailure bool   `json:"pauseOnFailure"`
+}
+
+type ScheduleState struct {
+	Paused bool   `json:"paused"`
+	Notes  string `json:"notes,omitempty"`
+}
+
+type Schedule struct {
+	Spec     ScheduleSpec     `json:"spec"`
+	Action   ScheduleAction   `json:"action"`
+	Policies SchedulePolicies `json:"policies"`
+	State    *ScheduleState   `json:"state,omitempty"`
+}
+
+type CreateScheduleRequest struct {
+	Schedule Schedule `json:"schedule"`
+	Memo     *Memo    `json:"memo,omitempty"`
+}
+
+type UpdateScheduleRequest struct {
+	Schedule Schedule `json:"schedule"`
+}
+
+type BackfillRequest struct {
+	StartTime     time.Time `json:"startTime"`
+	EndTime       time.Time `json:"endTime"`
+	OverlapPolicy string    `json:"overlapPolicy"`
+}
+
+type SchedulePatch struct {
+	BackfillRequest []BackfillRequest `json:"backfillRequest,omitempty"`
+	Pause           string            `json:"pause,omitempty"`
+	Unpause         string            `json:"unpause,omitempty"`
+}
+
+type PatchScheduleRequest struct {
+	Patch SchedulePatch `json:"patch"`
+}
+
+type ScheduleListEntry struct {
+	ScheduleID string `json:"scheduleId"`
+	Memo       *Memo  `json:"memo"`
+}
+
+type ListSchedulesResponse struct {
+	Schedules     []ScheduleListEntry `json:"schedules"`
+	NextPageToken string              `json:"nextPageToken"`
+}
+
+type WorkflowExecution struct {
+	WorkflowID string `json:"workflowId"`
+	RunID      string `json:"runId"`
+}
+
+type WorkflowExecutionInfo struct {
+	Execution WorkflowExecution `json:"execution"`
+	StartTime time.Time         `json:"startTime"`
+	Status    string            `json:"status"`
+}
+
+type ListWorkflowExecutionsResponse struct {
+	Executions    []WorkflowExecutionInfo `json:"executions"`
+	NextPageToken string                  `json:"nextPageToken"`
+}
+
+type NamespaceInfo struct {
+	Name  string `json:"name"`
+	State string `json:"state"`
+}
+
+type DescribeNamespaceResponse struct {
+	NamespaceInfo NamespaceInfo `json:"namespaceInfo"`
+}
+
+type ClientTemporal struct {
+	client *http.Client
+}
+
+func NewTemporalClient() *ClientTemporal {
+	return &ClientTemporal{client: &http.Client{}}
+}
+
+func (tc ClientTemporal) Invoke(ctx context.Context, r temporalRequest, auth SchedulerAuth) ([]byte, error) {
+	var resp []byte
+
+	endpoint := buildEndPoint(auth.host, r.path, r.query)
+	request, err := http.NewRequestWithContext(ctx, r.method, endpoint, bytes.NewBuffer(r.body))
+	if err != nil {
+		return resp, fmt.Errorf("failed to build http request for %s due to %w", endpoint, err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+	if auth.token != "" {
+		request.Header.Set("Authorization", "Bearer "+auth.token)
+	}
+
+	httpResp, respErr := tc.client.Do(request)
+	if respErr != nil {
+		return resp, fmt.Errorf("failed to call temporal %s due to %w", endpoint, respErr)
+	}
+	body, err := parseResponse(httpResp)
+	if err != nil {
+		return body, err
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		var failure struct {
+			Message string `json:"message"`
+		}
+		_ = json.Unmarshal(body, &failure)
+		return resp, &APIError{StatusCode: httpResp.StatusCode, Endpoint: endpoint, Message: failure.Message}
+	}
+	return body, nil
+}
+
+func parseResponse(resp *http.Response) ([]byte, error) {
+	var body []byte
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return body, errors.Wrap(EntityTemporal, "failed to read temporal response", err)
+	}
+	return body, nil
+}
+
+// buildEndPoint joins the path to the host, which is either a host:port or a url with the scheme
+func buildEndPoint(host, path string, query url.Values) string {
+	host = strings.Trim(host, "/")
+	scheme := "http"
+	if parsed, err := url.Parse(host); err == nil && parsed.Scheme != "" && parsed.Host != "" {
+		scheme = parsed.Scheme
+		host = parsed.Host
+	}
+	u := &url.URL{
+		Scheme:   scheme,
+		Host:     host,
+		Path:     path,
+		RawQuery: query.Encode(),
+	}
+	return u.String()
+}
+
+func startChildSpan(ctx context.Context, name string) (context.Context, trace.Span) {
+	tracer := otel.Tracer("scheduler/temporal")
+
+	return tracer.Start(ctx, name)
+}
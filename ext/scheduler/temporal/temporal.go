@@ -0,0 +1,709 @@
+package temporal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/goto/salt/log"
+	"github.com/kushsharma/parallel"
+
+	"github.com/goto/optimus/core/job"
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+	"github.com/goto/optimus/internal/lib/cron"
+	"github.com/goto/optimus/internal/telemetry"
+)
+
+const (
+	EntityTemporal = "Temporal"
+
+	schedulerTypeTemporal = "temporal"
+
+	namespaceURL        = "api/v1/namespaces/%s"
+	schedulesURL        = "api/v1/namespaces/%s/schedules"
+	scheduleURL         = "api/v1/namespaces/%s/schedules/%s"
+	scheduleUpdateURL   = "api/v1/namespaces/%s/schedules/%s/update"
+	schedulePatchURL    = "api/v1/namespaces/%s/schedules/%s/patch"
+	workflowsURL        = "api/v1/namespaces/%s/workflows"
+	workflowURL         = "api/v1/namespaces/%s/workflows/%s"
+	namespaceRegistered = "NAMESPACE_STATE_REGISTERED"
+
+	overlapPolicyAllowAll   = "SCHEDULE_OVERLAP_POLICY_ALLOW_ALL"
+	reusePolicyDuplicate    = "WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE"
+	reusePolicyTerminate    = "WORKFLOW_ID_REUSE_POLICY_TERMINATE_IF_RUNNING"
+	catchupWindowNone       = "60s"
+	memoNamespace           = "namespace"
+	memoInterval            = "interval"
+	runTypeScheduled        = "scheduled"
+	pageSize                = 1000
+	concurrentTicketPerSec  = 50
+	concurrentLimit         = 100
+	metricJobUpload         = "job_upload_total"
+	metricJobRemoval        = "job_removal_total"
+	metricJobStateSuccess   = "success"
+	metricJobStateFailed    = "failed"
+	retryBackoffCoefficient = 2
+)
+
+type Client interface {
+	Invoke(ctx context.Context, r temporalRequest, auth SchedulerAuth) ([]byte, error)
+}
+
+type SecretGetter interface {
+	Get(ctx context.Context, projName tenant.ProjectName, namespaceName, name string) (*tenant.PlainTextSecret, error)
+}
+
+type ProjectGetter interface {
+	Get(context.Context, tenant.ProjectName) (*tenant.Project, error)
+}
+
+// Config is the workflow every run of a job starts, polled by the temporal workers running the jobs
+type Config struct {
+	TaskQueue    string
+	WorkflowType string
+	// IngressHost is the optimus host the workers fetch the executor inputs of the runs from
+	IngressHost string
+}
+
+// RunInput is the input of the workflow of a run. The runs started by the schedule of the job have no
+// scheduled time in the input, it is the suffix of their workflow id, as it is for every other run.
+type RunInput struct {
+	OptimusHost string `json:"optimus_host"`
+	Project     string `json:"project"`
+	Namespace   string `json:"namespace"`
+	JobName     string `json:"job_name"`
+	ScheduledAt string `json:"scheduled_at,omitempty"`
+	RunType     string `json:"run_type"`
+}
+
+// Scheduler deploys the jobs as temporal schedules, in the temporal namespace named after the project.
+// Every run of a job is a workflow with the id <job name>-<scheduled time in RFC3339>.
+type Scheduler struct {
+	l      log.Logger
+	client Client
+	config Config
+
+	projectGetter ProjectGetter
+	secretGetter  SecretGetter
+}
+
+func (s *Scheduler) DeployJobs(ctx context.Context, tnnt tenant.Tenant, jobs []*scheduler.JobWithDetails) error {
+	spanCtx, span := startChildSpan(ctx, "DeployJobs")
+	defer span.End()
+
+	schdAuth, err := s.getSchedulerAuth(ctx, tnnt)
+	if err != nil {
+		return errors.AddErrContext(err, EntityTemporal, "error in getting scheduler details")
+	}
+
+	multiError := errors.NewMultiError("ErrorsInDeployJobs")
+	runner := parallel.NewRunner(parallel.WithTicket(concurrentTicketPerSec), parallel.WithLimit(concurrentLimit))
+	for _, job := range jobs {
+		runner.Add(func(currentJob *scheduler.JobWithDetails) func() (interface{}, error) {
+			return func() (interface{}, error) {
+				return nil, s.deploySchedule(spanCtx, schdAuth, tnnt, currentJob)
+			}
+		}(job))
+	}
+
+	countDeploySucceed := 0
+	countDeployFailed := 0
+	for _, result := range runner.Run() {
+		if result.Err != nil {
+			countDeployFailed++
+			multiError.Append(result.Err)
+			continue
+		}
+		countDeploySucceed++
+	}
+	raiseSchedulerMetric(tnnt, metricJobUpload, metricJobStateSuccess, countDeploySucceed)
+	raiseSchedulerMetric(tnnt, metricJobUpload, metricJobStateFailed, countDeployFailed)
+
+	return multiError.ToErr()
+}
+
+// deploySchedule creates the schedule of the job, or updates it when the job is already deployed. The runs
+// missed before the deployment are backfilled on creation as per the catch up policy of the job.
+func (s *Scheduler) deploySchedule(ctx context.Context, schdAuth SchedulerAuth, tnnt tenant.Tenant, job *scheduler.JobWithDetails) error {
+	schedule, err := s.toSchedule(tnnt, job)
+	if err != nil {
+		return errors.AddErrContext(err, EntityTemporal, "job: "+job.Name.String())
+	}
+	namespace := tnnt.ProjectName().String()
+
+	memo, err := newMemo(map[string]string{
+		memoNamespace: tnnt.NamespaceName().String(),
+		memoInterval:  job.Schedule.Interval,
+	})
+	if err != nil {
+		return errors.Wrap(EntityTemporal, "unable to marshal memo of job: "+job.Name.String(), err)
+	}
+	body, err := json.Marshal(CreateScheduleRequest{Schedule: schedule, Memo: memo})
+	if err != nil {
+		return errors.Wrap(EntityTemporal, "unable to marshal schedule of job: "+job.Name.String(), err)
+	}
+	_, err = s.client.Invoke(ctx, temporalRequest{
+		path:   fmt.Sprintf(scheduleURL, namespace, url.PathEscape(job.Name.String())),
+		method: http.MethodPost,
+		body:   body,
+	}, schdAuth)
+	if err == nil {
+		return s.backfill(ctx, schdAuth, namespace, job)
+	}
+	if !isStatus(err, http.StatusConflict) {
+		return errors.Wrap(EntityTemporal, "failure while creating schedule of job: "+job.Name.String(), err)
+	}
+
+	// the state of the schedule is left as is, it is changed only on enabling or disabling the job
+	schedule.State = nil
+	body, err = json.Marshal(UpdateScheduleRequest{Schedule: schedule})
+	if err != nil {
+		return errors.Wrap(EntityTemporal, "unable to marshal schedule of job: "+job.Name.String(), err)
+	}
+	_, err = s.client.Invoke(ctx, temporalRequest{
+		path:   fmt.Sprintf(scheduleUpdateURL, namespace, url.PathEscape(job.Name.String())),
+		method: http.MethodPost,
+		body:   body,
+	}, schdAuth)
+	return errors.WrapIfErr(EntityTemporal, "failure while updating schedule of job: "+job.Name.String(), err)
+}
+
+func (s *Scheduler) toSchedule(tnnt tenant.Tenant, job *scheduler.JobWithDetails) (Schedule, error) {
+	if job.Schedule == nil || job.Schedule.Interval == "" {
+		return Schedule{}, errors.InvalidArgument(EntityTemporal, "job has no schedule interval")
+	}
+
+	startTime := job.RunsFrom().UTC()
+	spec := ScheduleSpec{
+		CronString:   []string{job.Schedule.Interval},
+		StartTime:    &startTime,
+		TimezoneName: "UTC",
+	}
+	if job.Schedule.EndDate != nil {
+		endTime := job.Schedule.EndDate.UTC()
+		spec.EndTime = &endTime
+	}
+
+	input, err := newPayload(s.runInput(tnnt, job.Name, time.Time{}, runTypeScheduled))
+	if err != nil {
+		return Schedule{}, err
+	}
+
+	policies := SchedulePolicies{OverlapPolicy: overlapPolicyAllowAll}
+	if job.Schedule.CatchUp == scheduler.CatchUpNone {
+		policies.CatchupWindow = catchupWindowNone
+	}
+
+	return Schedule{
+		Spec: spec,
+		Action: ScheduleAction{StartWorkflow: StartWorkflowRequest{
+			WorkflowID:   job.Name.String(),
+			WorkflowType: WorkflowType{Name: s.config.WorkflowType},
+			TaskQueue:    TaskQueue{Name: s.config.TaskQueue},
+			Input:        &Payloads{Payloads: []Payload{input}},
+			RetryPolicy:  toRetryPolicy(job.Retry),
+		}},
+		Policies: policies,
+		State:    &ScheduleState{Paused: false},
+	}, nil
+}
+
+func toRetryPolicy(retry scheduler.Retry) *RetryPolicy {
+	policy := &RetryPolicy{MaximumAttempts: retry.Count + 1}
+	if retry.Delay > 0 {
+		policy.InitialInterval = fmt.Sprintf("%ds", retry.Delay)
+	}
+	policy.BackoffCoefficient = 1
+	if retry.ExponentialBackoff {
+		policy.BackoffCoefficient = retryBackoffCoefficient
+	}
+	return policy
+}
+
+// backfill starts the runs scheduled between the start of the job and now, all of them when the job
+// catches up fully and the latest one when it catches up the last run only
+func (s *Scheduler) backfill(ctx context.Context, schdAuth SchedulerAuth, namespace string, job *scheduler.JobWithDetails) error {
+	if job.Schedule.CatchUp == scheduler.CatchUpNone {
+		return nil
+	}
+
+	jobCron, err := cron.ParseCronSchedule(job.Schedule.Interval)
+	if err != nil {
+		return errors.Wrap(EntityTemporal, "unable to parse the interval of job: "+job.Name.String(), err)
+	}
+	now := time.Now().UTC()
+	startTime := job.RunsFrom().UTC()
+	if job.Schedule.CatchUp != scheduler.CatchUpFull {
+		// the latest schedule time not after now is the only one backfilled
+		startTime = jobCron.Prev(now.Add(time.Second))
+		if startTime.Before(job.RunsFrom()) {
+			return nil
+		}
+	}
+	if !startTime.Before(now) {
+		return nil
+	}
+
+	body, err := json.Marshal(PatchScheduleRequest{Patch: SchedulePatch{
+		BackfillRequest: []BackfillRequest{{
+			StartTime:     startTime,
+			EndTime:       now,
+			OverlapPolicy: overlapPolicyAllowAll,
+		}},
+	}})
+	if err != nil {
+		return errors.Wrap(EntityTemporal, "unable to marshal backfill of job: "+job.Name.String(), err)
+	}
+	_, err = s.client.Invoke(ctx, temporalRequest{
+		path:   fmt.Sprintf(schedulePatchURL, namespace, url.PathEscape(job.Name.String())),
+		method: http.MethodPost,
+		body:   body,
+	}, schdAuth)
+	return errors.WrapIfErr(EntityTemporal, "failure while backfilling runs of job: "+job.Name.String(), err)
+}
+
+// ListJobs lists the jobs scheduled under the namespace of the tenant
+func (s *Scheduler) ListJobs(ctx context.Context, tnnt tenant.Tenant) ([]string, error) {
+	spanCtx, span := startChildSpan(ctx, "ListJobs")
+	defer span.End()
+
+	schdAuth, err := s.getSchedulerAuth(ctx, tnnt)
+	if err != nil {
+		return nil, err
+	}
+
+	var jobNames []string
+	nextPageToken := ""
+	for {
+		query := url.Values{"maximumPageSize": []string{fmt.Sprint(pageSize)}}
+		if nextPageToken != "" {
+			query.Set("nextPageToken", nextPageToken)
+		}
+		resp, err := s.client.Invoke(spanCtx, temporalRequest{
+			path:   fmt.Sprintf(schedulesURL, tnnt.ProjectName().String()),
+			query:  query,
+			method: http.MethodGet,
+		}, schdAuth)
+		if err != nil {
+			return nil, errors.Wrap(EntityTemporal, "failure while listing temporal schedules", err)
+		}
+
+		var schedules ListSchedulesResponse
+		if err := json.Unmarshal(resp, &schedules); err != nil {
+			return nil, errors.Wrap(EntityTemporal, "json error on parsing temporal schedules: "+string(resp), err)
+		}
+		for _, entry := range schedules.Schedules {
+			if entry.Memo.stringField(memoNamespace) == tnnt.NamespaceName().String() {
+				jobNames = append(jobNames, entry.ScheduleID)
+			}
+		}
+
+		if schedules.NextPageToken == "" {
+			return jobNames, nil
+		}
+		nextPageToken = schedules.NextPageToken
+	}
+}
+
+// DeleteJobs deletes the schedules of the jobs, the past runs of the jobs are kept
+func (s *Scheduler) DeleteJobs(ctx context.Context, tnnt tenant.Tenant, jobNames []string) error {
+	spanCtx, span := startChildSpan(ctx, "DeleteJobs")
+	defer span.End()
+
+	schdAuth, err := s.getSchedulerAuth(ctx, tnnt)
+	if err != nil {
+		return err
+	}
+
+	me := errors.NewMultiError("ErrorsInDeleteJobs")
+	countDeleteJobsSucceed := 0
+	countDeleteJobsFailed := 0
+	for _, jobName := range jobNames {
+		if strings.TrimSpace(jobName) == "" {
+			me.Append(errors.InvalidArgument(EntityTemporal, "job name cannot be an empty string"))
+			continue
+		}
+		_, err := s.client.Invoke(spanCtx, temporalRequest{
+			path:   fmt.Sprintf(scheduleURL, tnnt.ProjectName().String(), url.PathEscape(jobName)),
+			method: http.MethodDelete,
+		}, schdAuth)
+		if err != nil {
+			// ignore missing schedules
+			if !isStatus(err, http.StatusNotFound) {
+				countDeleteJobsFailed++
+				me.Append(errors.Wrap(EntityTemporal, "failure while deleting schedule of job: "+jobName, err))
+			}
+			continue
+		}
+		countDeleteJobsSucceed++
+	}
+	raiseSchedulerMetric(tnnt, metricJobRemoval, metricJobStateSuccess, countDeleteJobsSucceed)
+	raiseSchedulerMetric(tnnt, metricJobRemoval, metricJobStateFailed, countDeleteJobsFailed)
+
+	return me.ToErr()
+}
+
+// UpdateJobState pauses the schedules of the disabled jobs and resumes the enabled ones
+func (s *Scheduler) UpdateJobState(ctx context.Context, tnnt tenant.Tenant, jobNames []job.Name, state string) error {
+	spanCtx, span := startChildSpan(ctx, "UpdateJobState")
+	defer span.End()
+
+	var patch SchedulePatch
+	switch state {
+	case "enabled":
+		patch.Unpause = "enabled from optimus"
+	case "disabled":
+		patch.Pause = "disabled from optimus"
+	default:
+		return errors.InvalidArgument(EntityTemporal, "invalid job state: "+state)
+	}
+	body, err := json.Marshal(PatchScheduleRequest{Patch: patch})
+	if err != nil {
+		return errors.Wrap(EntityTemporal, "unable to marshal schedule patch", err)
+	}
+
+	schdAuth, err := s.getSchedulerAuth(ctx, tnnt)
+	if err != nil {
+		return err
+	}
+	ch := make(chan error, len(jobNames))
+	for _, jobName := range jobNames {
+		go func(jobName job.Name) {
+			_, err := s.client.Invoke(spanCtx, temporalRequest{
+				path:   fmt.Sprintf(schedulePatchURL, tnnt.ProjectName().String(), url.PathEscape(jobName.String())),
+				method: http.MethodPost,
+				body:   body,
+			}, schdAuth)
+			ch <- err
+		}(jobName)
+	}
+	me := errors.NewMultiError("update job state on scheduler")
+	for i := 0; i < len(jobNames); i++ {
+		me.Append(<-ch)
+	}
+
+	if len(me.Errors) > 0 {
+		return errors.Wrap(EntityTemporal, "failure while updating schedule state", me.ToErr())
+	}
+	return nil
+}
+
+// GetJobRuns returns the latest execution of every run of the job scheduled in the criteria
+func (s *Scheduler) GetJobRuns(ctx context.Context, tnnt tenant.Tenant, criteria *scheduler.JobRunsCriteria, jobCron *cron.ScheduleSpec) ([]*scheduler.JobRunStatus, error) {
+	spanCtx, span := startChildSpan(ctx, "GetJobRuns")
+	defer span.End()
+
+	schdAuth, err := s.getSchedulerAuth(ctx, tnnt)
+	if err != nil {
+		return nil, err
+	}
+
+	runs, err := s.listRuns(spanCtx, schdAuth, tnnt.ProjectName().String(), criteria.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	var jobRunList []*scheduler.JobRunStatus
+	if criteria.OnlyLastRun {
+		if len(runs) > 0 {
+			jobRunList = append(jobRunList, runs[len(runs)-1])
+		}
+		return jobRunList, nil
+	}
+
+	// runs are selected by their scheduled time the same as the execution dates of the airflow runs
+	startTime := jobCron.Next(criteria.ExecutionStart(jobCron))
+	endTime := jobCron.Next(criteria.ExecutionEndDate(jobCron))
+	for _, run := range runs {
+		if run.ScheduledAt.Before(startTime) || run.ScheduledAt.After(endTime) {
+			continue
+		}
+		jobRunList = append(jobRunList, run)
+	}
+	return jobRunList, nil
+}
+
+// listRuns lists the latest execution of every run of the job, in the order of scheduled time
+func (s *Scheduler) listRuns(ctx context.Context, schdAuth SchedulerAuth, namespace, jobName string) ([]*scheduler.JobRunStatus, error) {
+	runPrefix := jobName + "-"
+	latest := map[time.Time]WorkflowExecutionInfo{}
+
+	nextPageToken := ""
+	for {
+		query := url.Values{
+			"query":    []string{fmt.Sprintf("WorkflowType = %q AND WorkflowId STARTS_WITH %q", s.config.WorkflowType, runPrefix)},
+			"pageSize": []string{fmt.Sprint(pageSize)},
+		}
+		if nextPageToken != "" {
+			query.Set("nextPageToken", nextPageToken)
+		}
+		resp, err := s.client.Invoke(ctx, temporalRequest{
+			path:   fmt.Sprintf(workflowsURL, namespace),
+			query:  query,
+			method: http.MethodGet,
+		}, schdAuth)
+		if err != nil {
+			return nil, errors.Wrap(EntityTemporal, "failure while fetching temporal workflows", err)
+		}
+
+		var executions ListWorkflowExecutionsResponse
+		if err := json.Unmarshal(resp, &executions); err != nil {
+			return nil, errors.Wrap(EntityTemporal, "json error on parsing temporal workflows: "+string(resp), err)
+		}
+		for _, execution := range executions.Executions {
+			scheduledAt, err := time.Parse(time.RFC3339, strings.TrimPrefix(execution.Execution.WorkflowID, runPrefix))
+			if err != nil {
+				// workflows of the jobs having the name of this job as prefix
+				continue
+			}
+			if existing, ok := latest[scheduledAt]; ok && existing.StartTime.After(execution.StartTime) {
+				continue
+			}
+			latest[scheduledAt] = execution
+		}
+
+		if executions.NextPageToken == "" {
+			break
+		}
+		nextPageToken = executions.NextPageToken
+	}
+
+	runs := make([]*scheduler.JobRunStatus, 0, len(latest))
+	for scheduledAt, execution := range latest {
+		runs = append(runs, &scheduler.JobRunStatus{
+			ScheduledAt: scheduledAt.UTC(),
+			State:       toRunState(execution.Status),
+		})
+	}
+	sort.Slice(runs, func(i, j int) bool {
+		return runs[i].ScheduledAt.Before(runs[j].ScheduledAt)
+	})
+	return runs, nil
+}
+
+func toRunState(status string) scheduler.State {
+	switch status {
+	case workflowStatusRunning, workflowStatusContinuedAsNew:
+		return scheduler.StateRunning
+	case workflowStatusCompleted:
+		return scheduler.StateSuccess
+	default:
+		return scheduler.StateFailed
+	}
+}
+
+func (s *Scheduler) Clear(ctx context.Context, t tenant.Tenant, jobName scheduler.JobName, executionTime time.Time) error {
+	return s.ClearBatch(ctx, t, jobName, executionTime, executionTime)
+}
+
+// ClearBatch reruns the runs of the job between the execution times, terminating the ones still running
+func (s *Scheduler) ClearBatch(ctx context.Context, tnnt tenant.Tenant, jobName scheduler.JobName, startExecutionTime, endExecutionTime time.Time) error {
+	spanCtx, span := startChildSpan(ctx, "Clear")
+	defer span.End()
+
+	schdAuth, err := s.getSchedulerAuth(ctx, tnnt)
+	if err != nil {
+		return err
+	}
+	jobCron, err := s.getJobCron(spanCtx, schdAuth, tnnt.ProjectName().String(), jobName)
+	if err != nil {
+		return err
+	}
+
+	runs, err := s.listRuns(spanCtx, schdAuth, tnnt.ProjectName().String(), jobName.String())
+	if err != nil {
+		return err
+	}
+	startTime := jobCron.Next(startExecutionTime.UTC())
+	endTime := jobCron.Next(endExecutionTime.UTC())
+	me := errors.NewMultiError("ErrorsInClear")
+	for _, run := range runs {
+		if run.ScheduledAt.Before(startTime) || run.ScheduledAt.After(endTime) {
+			continue
+		}
+		me.Append(s.startRun(spanCtx, schdAuth, tnnt, jobName, run.ScheduledAt, runTypeScheduled, reusePolicyTerminate))
+	}
+	return errors.WrapIfErr(EntityTemporal, "failure while clearing temporal workflows", me.ToErr())
+}
+
+// CreateRun starts a run of the job for the schedule time following the execution time, the same as airflow
+func (s *Scheduler) CreateRun(ctx context.Context, tnnt tenant.Tenant, jobName scheduler.JobName, executionTime time.Time, runIDPrefix string) error {
+	spanCtx, span := startChildSpan(ctx, "CreateRun")
+	defer span.End()
+
+	schdAuth, err := s.getSchedulerAuth(ctx, tnnt)
+	if err != nil {
+		return err
+	}
+	jobCron, err := s.getJobCron(spanCtx, schdAuth, tnnt.ProjectName().String(), jobName)
+	if err != nil {
+		return err
+	}
+
+	err = s.startRun(spanCtx, schdAuth, tnnt, jobName, jobCron.Next(executionTime.UTC()), runIDPrefix, reusePolicyDuplicate)
+	return errors.WrapIfErr(EntityTemporal, "failure while creating temporal workflow", err)
+}
+
+func (s *Scheduler) startRun(ctx context.Context, schdAuth SchedulerAuth, tnnt tenant.Tenant, jobName scheduler.JobName,
+	scheduledAt time.Time, runType, reusePolicy string,
+) error {
+	input, err := newPayload(s.runInput(tnnt, jobName, scheduledAt, runType))
+	if err != nil {
+		return err
+	}
+	workflowID := fmt.Sprintf("%s-%s", jobName, scheduledAt.UTC().Format(time.RFC3339))
+	body, err := json.Marshal(StartWorkflowRequest{
+		WorkflowID:            workflowID,
+		WorkflowType:          WorkflowType{Name: s.config.WorkflowType},
+		TaskQueue:             TaskQueue{Name: s.config.TaskQueue},
+		Input:                 &Payloads{Payloads: []Payload{input}},
+		WorkflowIDReusePolicy: reusePolicy,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = s.client.Invoke(ctx, temporalRequest{
+		path:   fmt.Sprintf(workflowURL, tnnt.ProjectName().String(), url.PathEscape(workflowID)),
+		method: http.MethodPost,
+		body:   body,
+	}, schdAuth)
+	return err
+}
+
+func (s *Scheduler) runInput(tnnt tenant.Tenant, jobName scheduler.JobName, scheduledAt time.Time, runType string) RunInput {
+	input := RunInput{
+		OptimusHost: s.config.IngressHost,
+		Project:     tnnt.ProjectName().String(),
+		Namespace:   tnnt.NamespaceName().String(),
+		JobName:     jobName.String(),
+		RunType:     runType,
+	}
+	if !scheduledAt.IsZero() {
+		input.ScheduledAt = scheduledAt.UTC().Format(time.RFC3339)
+	}
+	return input
+}
+
+// getJobCron reads the interval of the job from the memo of its schedule, temporal does not return the cron
+// string the schedule is created with
+func (s *Scheduler) getJobCron(ctx context.Context, schdAuth SchedulerAuth, namespace string, jobName scheduler.JobName) (*cron.ScheduleSpec, error) {
+	resp, err := s.client.Invoke(ctx, temporalRequest{
+		path:   fmt.Sprintf(scheduleURL, namespace, url.PathEscape(jobName.String())),
+		method: http.MethodGet,
+	}, schdAuth)
+	if err != nil {
+		if isStatus(err, http.StatusNotFound) {
+			return nil, errors.NotFound(EntityTemporal, "schedule not found for job: "+jobName.String())
+		}
+		return nil, errors.Wrap(EntityTemporal, "failure while fetching temporal schedule", err)
+	}
+
+	var schedule struct {
+		Memo *Memo `json:"memo"`
+	}
+	if err := json.Unmarshal(resp, &schedule); err != nil {
+		return nil, errors.Wrap(EntityTemporal, "json error on parsing temporal schedule: "+string(resp), err)
+	}
+	jobCron, err := cron.ParseCronSchedule(schedule.Memo.stringField(memoInterval))
+	if err != nil {
+		return nil, errors.Wrap(EntityTemporal, "unable to parse the interval of job: "+jobName.String(), err)
+	}
+	return jobCron, nil
+}
+
+// GetPoolSlots returns error as the workers of temporal pull the tasks from the task queue, there is no pool of slots
+func (*Scheduler) GetPoolSlots(_ context.Context, _ tenant.Tenant, _ string) (*scheduler.PoolSlots, error) {
+	return nil, errors.NotFound(EntityTemporal, "temporal has no pools")
+}
+
+// GetEnvironmentHealth reports temporal as healthy when the namespace of the project is registered
+func (s *Scheduler) GetEnvironmentHealth(ctx context.Context, tnnt tenant.Tenant) (*scheduler.EnvironmentHealth, error) {
+	spanCtx, span := startChildSpan(ctx, "GetEnvironmentHealth")
+	defer span.End()
+
+	schdAuth, err := s.getSchedulerAuth(ctx, tnnt)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Invoke(spanCtx, temporalRequest{
+		path:   fmt.Sprintf(namespaceURL, tnnt.ProjectName().String()),
+		method: http.MethodGet,
+	}, schdAuth)
+	if err != nil {
+		return nil, errors.Wrap(EntityTemporal, "failure while fetching temporal namespace", err)
+	}
+
+	var namespace DescribeNamespaceResponse
+	if err := json.Unmarshal(resp, &namespace); err != nil {
+		return nil, errors.Wrap(EntityTemporal, "json error on parsing temporal namespace: "+string(resp), err)
+	}
+	return &scheduler.EnvironmentHealth{
+		Type:                schedulerTypeTemporal,
+		MetadatabaseHealthy: true,
+		SchedulerHealthy:    namespace.NamespaceInfo.State == namespaceRegistered,
+	}, nil
+}
+
+// getSchedulerAuth reads the temporal host from the scheduler host of the project, the scheduler auth secret
+// is optional and sent as a bearer token when present
+func (s *Scheduler) getSchedulerAuth(ctx context.Context, tnnt tenant.Tenant) (SchedulerAuth, error) {
+	project, err := s.projectGetter.Get(ctx, tnnt.ProjectName())
+	if err != nil {
+		return SchedulerAuth{}, err
+	}
+
+	host, err := project.GetConfig(tenant.ProjectSchedulerHost)
+	if err != nil {
+		return SchedulerAuth{}, err
+	}
+
+	auth, err := s.secretGetter.Get(ctx, tnnt.ProjectName(), tnnt.NamespaceName().String(), tenant.SecretSchedulerAuth)
+	if err != nil {
+		if errors.IsErrorType(err, errors.ErrNotFound) {
+			return SchedulerAuth{host: host}, nil
+		}
+		return SchedulerAuth{}, err
+	}
+	return SchedulerAuth{
+		host:  host,
+		token: auth.Value(),
+	}, nil
+}
+
+func newMemo(fields map[string]string) (*Memo, error) {
+	memo := &Memo{Fields: map[string]Payload{}}
+	for name, value := range fields {
+		payload, err := newPayload(value)
+		if err != nil {
+			return nil, err
+		}
+		memo.Fields[name] = payload
+	}
+	return memo, nil
+}
+
+func NewScheduler(l log.Logger, client Client, config Config, projectGetter ProjectGetter, secretGetter SecretGetter) *Scheduler {
+	return &Scheduler{
+		l:             l,
+		client:        client,
+		config:        config,
+		projectGetter: projectGetter,
+		secretGetter:  secretGetter,
+	}
+}
+
+func raiseSchedulerMetric(jobTenant tenant.Tenant, metricName, status string, metricValue int) {
+	telemetry.NewCounter(metricName, map[string]string{
+		"project":   jobTenant.ProjectName().String(),
+		"namespace": jobTenant.NamespaceName().String(),
+		"status":    status,
+	}).Add(float64(metricValue))
+}
@@ -0,0 +1,150 @@
+package temporal_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/ext/scheduler/temporal"
+	"github.com/goto/optimus/internal/errors"
+	"github.com/goto/optimus/internal/lib/cron"
+)
+
+func TestScheduler(t *testing.T) {
+	ctx := context.Background()
+	tnnt, _ := tenant.NewTenant("proj", "ns1")
+	config := temporal.Config{TaskQueue: "optimus", WorkflowType: "OptimusJob", IngressHost: "optimus:80"}
+
+	newScheduler := func(handler http.HandlerFunc) (*temporal.Scheduler, func()) {
+		server := httptest.NewServer(handler)
+		project, _ := tenant.NewProject("proj", map[string]string{
+			tenant.ProjectStoragePathKey: "gs://bucket",
+			tenant.ProjectSchedulerHost:  server.URL,
+		})
+		sch := temporal.NewScheduler(log.NewNoop(), temporal.NewTemporalClient(), config,
+			projectGetter{project: project}, secretGetter{})
+		return sch, server.Close
+	}
+
+	t.Run("GetJobRuns", func(t *testing.T) {
+		t.Run("returns the latest execution of the runs scheduled in the criteria", func(t *testing.T) {
+			sch, closeServer := newScheduler(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/api/v1/namespaces/proj/workflows", r.URL.Path)
+				assert.Equal(t, `WorkflowType = "OptimusJob" AND WorkflowId STARTS_WITH "job1-"`, r.URL.Query().Get("query"))
+				w.Write([]byte(`{"executions": [
+					{"execution": {"workflowId": "job1-2023-10-09T02:00:00Z"}, "startTime": "2023-10-09T02:00:01Z", "status": "WORKFLOW_EXECUTION_STATUS_COMPLETED"},
+					{"execution": {"workflowId": "job1-2023-10-10T02:00:00Z"}, "startTime": "2023-10-10T05:00:00Z", "status": "WORKFLOW_EXECUTION_STATUS_RUNNING"},
+					{"execution": {"workflowId": "job1-2023-10-10T02:00:00Z"}, "startTime": "2023-10-10T02:00:01Z", "status": "WORKFLOW_EXECUTION_STATUS_FAILED"},
+					{"execution": {"workflowId": "job1-v2-2023-10-10T02:00:00Z"}, "startTime": "2023-10-10T02:00:01Z", "status": "WORKFLOW_EXECUTION_STATUS_FAILED"},
+					{"execution": {"workflowId": "job1-2023-10-12T02:00:00Z"}, "startTime": "2023-10-12T02:00:01Z", "status": "WORKFLOW_EXECUTION_STATUS_COMPLETED"}
+				]}`))
+			})
+			defer closeServer()
+
+			jobCron, _ := cron.ParseCronSchedule("0 2 * * *")
+			criteria := &scheduler.JobRunsCriteria{
+				Name:      "job1",
+				StartDate: time.Date(2023, 10, 9, 2, 0, 0, 0, time.UTC),
+				EndDate:   time.Date(2023, 10, 11, 2, 0, 0, 0, time.UTC),
+			}
+			runs, err := sch.GetJobRuns(ctx, tnnt, criteria, jobCron)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []*scheduler.JobRunStatus{
+				{ScheduledAt: time.Date(2023, 10, 9, 2, 0, 0, 0, time.UTC), State: scheduler.StateSuccess},
+				{ScheduledAt: time.Date(2023, 10, 10, 2, 0, 0, 0, time.UTC), State: scheduler.StateRunning},
+			}, runs)
+		})
+		t.Run("returns error when temporal fails to list the workflows", func(t *testing.T) {
+			sch, closeServer := newScheduler(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			})
+			defer closeServer()
+
+			jobCron, _ := cron.ParseCronSchedule("0 2 * * *")
+			runs, err := sch.GetJobRuns(ctx, tnnt, &scheduler.JobRunsCriteria{Name: "job1", OnlyLastRun: true}, jobCron)
+
+			assert.Nil(t, runs)
+			assert.ErrorContains(t, err, "failure while fetching temporal workflows")
+		})
+	})
+	t.Run("DeployJobs", func(t *testing.T) {
+		t.Run("updates the schedule of an already deployed job", func(t *testing.T) {
+			var updated temporal.UpdateScheduleRequest
+			sch, closeServer := newScheduler(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/api/v1/namespaces/proj/schedules/job1":
+					w.WriteHeader(http.StatusConflict)
+					w.Write([]byte(`{"message": "schedule with this id is already registered"}`))
+				case "/api/v1/namespaces/proj/schedules/job1/update":
+					body, _ := io.ReadAll(r.Body)
+					assert.NoError(t, json.Unmarshal(body, &updated))
+					w.Write([]byte(`{}`))
+				default:
+					t.Errorf("unexpected call to %s", r.URL.Path)
+				}
+			})
+			defer closeServer()
+
+			job := &scheduler.JobWithDetails{
+				Name: "job1",
+				Job:  &scheduler.Job{Name: "job1", Tenant: tnnt},
+				Schedule: &scheduler.Schedule{
+					StartDate: time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC),
+					Interval:  "0 2 * * *",
+					CatchUp:   scheduler.CatchUpLastOnly,
+				},
+				Retry: scheduler.Retry{Count: 2, Delay: 30},
+			}
+			err := sch.DeployJobs(ctx, tnnt, []*scheduler.JobWithDetails{job})
+
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"0 2 * * *"}, updated.Schedule.Spec.CronString)
+			assert.Equal(t, "job1", updated.Schedule.Action.StartWorkflow.WorkflowID)
+			assert.Equal(t, "optimus", updated.Schedule.Action.StartWorkflow.TaskQueue.Name)
+			assert.Equal(t, 3, updated.Schedule.Action.StartWorkflow.RetryPolicy.MaximumAttempts)
+			assert.Nil(t, updated.Schedule.State)
+		})
+	})
+	t.Run("ListJobs", func(t *testing.T) {
+		t.Run("lists the schedules of the namespace", func(t *testing.T) {
+			sch, closeServer := newScheduler(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/api/v1/namespaces/proj/schedules", r.URL.Path)
+				// memo values are base64 encoded json strings, "ns1" and "ns2"
+				w.Write([]byte(`{"schedules": [
+					{"scheduleId": "job1", "memo": {"fields": {"namespace": {"data": "Im5zMSI="}}}},
+					{"scheduleId": "job2", "memo": {"fields": {"namespace": {"data": "Im5zMiI="}}}}
+				]}`))
+			})
+			defer closeServer()
+
+			jobNames, err := sch.ListJobs(ctx, tnnt)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"job1"}, jobNames)
+		})
+	})
+}
+
+type projectGetter struct {
+	project *tenant.Project
+}
+
+func (p projectGetter) Get(context.Context, tenant.ProjectName) (*tenant.Project, error) {
+	return p.project, nil
+}
+
+type secretGetter struct{}
+
+func (secretGetter) Get(context.Context, tenant.ProjectName, string, string) (*tenant.PlainTextSecret, error) {
+	return nil, errors.NotFound(tenant.EntitySecret, "no record for SCHEDULER_AUTH")
+}
@@ -0,0 +1,157 @@
+package gsm
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+
+	"github.com/goto/optimus/core/tenant"
+)
+
+const (
+	DefaultTimeout = time.Second * 10
+
+	endpoint = "https://secretmanager.googleapis.com/v1"
+	scope    = "https://www.googleapis.com/auth/cloud-platform"
+
+	pageSize = 100
+)
+
+type listSecretsResponse struct {
+	Secrets []struct {
+		Name string `json:"name"`
+	} `json:"secrets"`
+	NextPageToken string `json:"nextPageToken"`
+}
+
+type accessSecretVersionResponse struct {
+	Payload struct {
+		Data string `json:"data"`
+	} `json:"payload"`
+}
+
+// Provider reads the latest version of the secrets of a gcp project from Secret Manager, only the secrets having
+// the configured path as the prefix of their name are resolved. The name of the secret is used as is.
+type Provider struct {
+	endpoint    string
+	timeout     time.Duration
+	tokenSource func(ctx context.Context, credential string) (oauth2.TokenSource, error)
+}
+
+func (p *Provider) GetSecrets(ctx context.Context, config tenant.SecretProviderConfig) (tenant.PlainTextSecrets, error) {
+	tokenSource, err := p.tokenSource(ctx, config.Token)
+	if err != nil {
+		return nil, fmt.Errorf("invalid secret manager credential: %w", err)
+	}
+	client := oauth2.NewClient(ctx, tokenSource)
+	client.Timeout = p.timeout
+
+	names, err := p.listSecretNames(ctx, client, config.Address, config.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	secrets := make(tenant.PlainTextSecrets, 0, len(names))
+	for _, name := range names {
+		var version accessSecretVersionResponse
+		versionURL := fmt.Sprintf("%s/projects/%s/secrets/%s/versions/latest:access", p.endpoint, config.Address, name)
+		if err := get(ctx, client, versionURL, &version); err != nil {
+			return nil, fmt.Errorf("failed to access secret %s: %w", name, err)
+		}
+
+		value, err := base64.StdEncoding.DecodeString(version.Payload.Data)
+		if err != nil {
+			return nil, fmt.Errorf("invalid payload of secret %s: %w", name, err)
+		}
+
+		secret, err := tenant.NewPlainTextSecret(name, string(value))
+		if err != nil {
+			return nil, fmt.Errorf("invalid secret %s: %w", name, err)
+		}
+		secrets = append(secrets, secret)
+	}
+	return secrets, nil
+}
+
+func (p *Provider) listSecretNames(ctx context.Context, client *http.Client, gcpProject, prefix string) ([]string, error) {
+	var names []string
+	pageToken := ""
+	for {
+		query := url.Values{}
+		query.Set("pageSize", fmt.Sprint(pageSize))
+		if pageToken != "" {
+			query.Set("pageToken", pageToken)
+		}
+
+		var page listSecretsResponse
+		listURL := fmt.Sprintf("%s/projects/%s/secrets?%s", p.endpoint, gcpProject, query.Encode())
+		if err := get(ctx, client, listURL, &page); err != nil {
+			return nil, fmt.Errorf("failed to list secrets of %s: %w", gcpProject, err)
+		}
+
+		for _, secret := range page.Secrets {
+			// the name is in the form of projects/<project>/secrets/<name>
+			name := path.Base(secret.Name)
+			if strings.HasPrefix(name, prefix) {
+				names = append(names, name)
+			}
+		}
+
+		if page.NextPageToken == "" {
+			return names, nil
+		}
+		pageToken = page.NextPageToken
+	}
+}
+
+func get(ctx context.Context, client *http.Client, resourceURL string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, resourceURL, http.NoBody)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("secret manager responded with status %d", resp.StatusCode)
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// credentialTokenSource uses the service account key when given, the default credentials of the server otherwise
+func credentialTokenSource(ctx context.Context, credential string) (oauth2.TokenSource, error) {
+	if credential == "" {
+		creds, err := google.FindDefaultCredentials(ctx, scope)
+		if err != nil {
+			return nil, err
+		}
+		return creds.TokenSource, nil
+	}
+
+	creds, err := google.CredentialsFromJSON(ctx, []byte(credential), scope)
+	if err != nil {
+		return nil, err
+	}
+	return creds.TokenSource, nil
+}
+
+func NewProvider(timeout time.Duration) *Provider {
+	return &Provider{
+		endpoint:    endpoint,
+		timeout:     timeout,
+		tokenSource: credentialTokenSource,
+	}
+}
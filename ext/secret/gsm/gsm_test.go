@@ -0,0 +1,62 @@
+package gsm
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/oauth2"
+
+	"github.com/goto/optimus/core/tenant"
+)
+
+func TestSecretManager(t *testing.T) {
+	ctx := context.Background()
+	staticToken := func(context.Context, string) (oauth2.TokenSource, error) {
+		return oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "access-token"}), nil
+	}
+	config := tenant.SecretProviderConfig{
+		Type:    tenant.SecretProviderGCPSecretManager,
+		Address: "gcp-project",
+		Path:    "OPTIMUS_",
+	}
+
+	t.Run("reads the latest version of the secrets having the path as prefix", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "Bearer access-token", r.Header.Get("Authorization"))
+			switch r.URL.Path {
+			case "/projects/gcp-project/secrets":
+				if r.URL.Query().Get("pageToken") == "" {
+					w.Write([]byte(`{"secrets": [{"name": "projects/gcp-project/secrets/OPTIMUS_API_KEY"},
+						{"name": "projects/gcp-project/secrets/OTHER_KEY"}], "nextPageToken": "next"}`))
+					return
+				}
+				w.Write([]byte(`{"secrets": [{"name": "projects/gcp-project/secrets/OPTIMUS_PASSWORD"}]}`))
+			case "/projects/gcp-project/secrets/OPTIMUS_API_KEY/versions/latest:access":
+				w.Write([]byte(`{"payload": {"data": "YWJj"}}`))
+			case "/projects/gcp-project/secrets/OPTIMUS_PASSWORD/versions/latest:access":
+				w.Write([]byte(`{"payload": {"data": "ZGVm"}}`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+		defer server.Close()
+
+		provider := &Provider{endpoint: server.URL, timeout: DefaultTimeout, tokenSource: staticToken}
+		secrets, err := provider.GetSecrets(ctx, config)
+		assert.NoError(t, err)
+		assert.Equal(t, tenant.SecretMap{"OPTIMUS_API_KEY": "abc", "OPTIMUS_PASSWORD": "def"}, secrets.ToSecretMap())
+	})
+	t.Run("returns error when secret manager responds with an error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+		}))
+		defer server.Close()
+
+		provider := &Provider{endpoint: server.URL, timeout: DefaultTimeout, tokenSource: staticToken}
+		_, err := provider.GetSecrets(ctx, config)
+		assert.EqualError(t, err, "failed to list secrets of gcp-project: secret manager responded with status 403")
+	})
+}
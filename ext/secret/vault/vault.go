@@ -0,0 +1,84 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/goto/optimus/core/tenant"
+)
+
+const DefaultTimeout = time.Second * 10
+
+type secretResponse struct {
+	Data map[string]any `json:"data"`
+}
+
+// Provider reads the secrets of a project from a HashiCorp Vault kv secret, every key of the secret is resolved
+// as a secret with the same name. Both versions of the kv secrets engine are supported.
+type Provider struct {
+	client *http.Client
+}
+
+func (p *Provider) GetSecrets(ctx context.Context, config tenant.SecretProviderConfig) (tenant.PlainTextSecrets, error) {
+	url := strings.TrimSuffix(config.Address, "/") + "/v1/" + strings.TrimPrefix(config.Path, "/")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("invalid vault request for %s: %w", config.Path, err)
+	}
+	req.Header.Set("X-Vault-Token", config.Token)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read vault secret %s: %w", config.Path, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("vault responded with status %d for secret %s", resp.StatusCode, config.Path)
+	}
+
+	var secret secretResponse
+	if err := json.NewDecoder(resp.Body).Decode(&secret); err != nil {
+		return nil, fmt.Errorf("invalid vault response for secret %s: %w", config.Path, err)
+	}
+
+	return toPlainTextSecrets(secret.Data)
+}
+
+func toPlainTextSecrets(data map[string]any) (tenant.PlainTextSecrets, error) {
+	// kv version 2 nests the values of the secret along with its metadata
+	if values, ok := data["data"].(map[string]any); ok {
+		if _, ok := data["metadata"]; ok {
+			data = values
+		}
+	}
+
+	secrets := make(tenant.PlainTextSecrets, 0, len(data))
+	for key, value := range data {
+		stringValue, ok := value.(string)
+		if !ok {
+			raw, err := json.Marshal(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value of vault key %s: %w", key, err)
+			}
+			stringValue = string(raw)
+		}
+
+		secret, err := tenant.NewPlainTextSecret(key, stringValue)
+		if err != nil {
+			return nil, fmt.Errorf("invalid vault key %s: %w", key, err)
+		}
+		secrets = append(secrets, secret)
+	}
+	return secrets, nil
+}
+
+func NewProvider(timeout time.Duration) *Provider {
+	return &Provider{
+		client: &http.Client{Timeout: timeout},
+	}
+}
@@ -0,0 +1,58 @@
+package vault_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/ext/secret/vault"
+)
+
+func TestVault(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("reads the keys of a kv version 2 secret", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "/v1/secret/data/optimus", r.URL.Path)
+			assert.Equal(t, "vault-token", r.Header.Get("X-Vault-Token"))
+			w.Write([]byte(`{"data": {"data": {"api_key": "abc", "port": 5432}, "metadata": {"version": 3}}}`))
+		}))
+		defer server.Close()
+
+		provider := vault.NewProvider(vault.DefaultTimeout)
+		secrets, err := provider.GetSecrets(ctx, tenant.SecretProviderConfig{
+			Type:    tenant.SecretProviderVault,
+			Address: server.URL + "/",
+			Path:    "/secret/data/optimus",
+			Token:   "vault-token",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, tenant.SecretMap{"API_KEY": "abc", "PORT": "5432"}, secrets.ToSecretMap())
+	})
+	t.Run("reads the keys of a kv version 1 secret", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"data": {"api_key": "abc"}}`))
+		}))
+		defer server.Close()
+
+		provider := vault.NewProvider(vault.DefaultTimeout)
+		secrets, err := provider.GetSecrets(ctx, tenant.SecretProviderConfig{Address: server.URL, Path: "kv/optimus"})
+		assert.NoError(t, err)
+		assert.Equal(t, tenant.SecretMap{"API_KEY": "abc"}, secrets.ToSecretMap())
+	})
+	t.Run("returns error when vault responds with an error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+		}))
+		defer server.Close()
+
+		provider := vault.NewProvider(vault.DefaultTimeout)
+		_, err := provider.GetSecrets(ctx, tenant.SecretProviderConfig{Address: server.URL, Path: "kv/optimus"})
+		assert.EqualError(t, err, "vault responded with status 403 for secret kv/optimus")
+	})
+}
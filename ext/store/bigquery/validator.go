@@ -0,0 +1,63 @@
+package bigquery
+
+import (
+	"regexp"
+
+	"github.com/goto/optimus/core/resource"
+	"github.com/goto/optimus/internal/errors"
+)
+
+const (
+	// NamePatternConfigKey is the tenant config holding the regex the names of datasets, tables, views and
+	// external tables should match
+	NamePatternConfigKey = "BIGQUERY_NAME_PATTERN"
+)
+
+// NamingConventionValidator makes sure the name of the resource, without project and dataset for non dataset
+// resources, matches the pattern configured for the tenant
+type NamingConventionValidator struct{}
+
+func (NamingConventionValidator) Name() string {
+	return "bigquery.naming_convention"
+}
+
+func (NamingConventionValidator) Validate(res *resource.Resource, tenantConfigs map[string]string) error {
+	pattern := tenantConfigs[NamePatternConfigKey]
+	if pattern == "" {
+		return nil
+	}
+
+	nameRegex, err := regexp.Compile(pattern)
+	if err != nil {
+		return errors.InvalidArgument(resource.EntityResource, "invalid "+NamePatternConfigKey+" "+pattern)
+	}
+
+	sections := res.NameSections()
+	name := sections[len(sections)-1]
+	if !nameRegex.MatchString(name) {
+		return errors.InvalidArgument(resource.EntityResource, "name of "+res.FullName()+" does not match the pattern "+pattern)
+	}
+	return nil
+}
+
+// PartitionRequiredValidator makes sure every table is partitioned
+type PartitionRequiredValidator struct{}
+
+func (PartitionRequiredValidator) Name() string {
+	return "bigquery.partition_required"
+}
+
+func (PartitionRequiredValidator) Validate(res *resource.Resource, _ map[string]string) error {
+	if res.Kind() != KindTable {
+		return nil
+	}
+
+	table, err := ConvertSpecTo[Table](res)
+	if err != nil {
+		return err
+	}
+	if table.Partition == nil {
+		return errors.InvalidArgument(resource.EntityResource, "table "+res.FullName()+" is required to be partitioned")
+	}
+	return nil
+}
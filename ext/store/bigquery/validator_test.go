@@ -0,0 +1,83 @@
+package bigquery_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/core/resource"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/ext/store/bigquery"
+)
+
+func TestValidators(t *testing.T) {
+	tnnt, _ := tenant.NewTenant("proj", "ns")
+	metadata := resource.Metadata{Description: "meta"}
+	tableSpec := map[string]any{
+		"description": "resource",
+		"schema":      []map[string]any{{"name": "id", "type": "string"}},
+	}
+
+	t.Run("NamingConventionValidator", func(t *testing.T) {
+		validator := bigquery.NamingConventionValidator{}
+
+		t.Run("returns no error when pattern is not configured", func(t *testing.T) {
+			table, err := resource.NewResource("project.dataset.Table1", bigquery.KindTable, resource.Bigquery, tnnt, &metadata, tableSpec)
+			assert.Nil(t, err)
+
+			err = validator.Validate(table, map[string]string{})
+			assert.Nil(t, err)
+		})
+		t.Run("returns error when pattern is invalid", func(t *testing.T) {
+			table, err := resource.NewResource("project.dataset.table1", bigquery.KindTable, resource.Bigquery, tnnt, &metadata, tableSpec)
+			assert.Nil(t, err)
+
+			err = validator.Validate(table, map[string]string{"BIGQUERY_NAME_PATTERN": "["})
+			assert.ErrorContains(t, err, "invalid BIGQUERY_NAME_PATTERN [")
+		})
+		t.Run("returns error when name of the table does not match the pattern", func(t *testing.T) {
+			table, err := resource.NewResource("project.dataset.Table1", bigquery.KindTable, resource.Bigquery, tnnt, &metadata, tableSpec)
+			assert.Nil(t, err)
+
+			err = validator.Validate(table, map[string]string{"BIGQUERY_NAME_PATTERN": "^[a-z0-9_]+$"})
+			assert.ErrorContains(t, err, "name of project.dataset.Table1 does not match the pattern ^[a-z0-9_]+$")
+		})
+		t.Run("checks the name of the dataset for datasets", func(t *testing.T) {
+			dataset, err := resource.NewResource("project.dataset_1", bigquery.KindDataset, resource.Bigquery, tnnt, &metadata, map[string]any{"description": "dataset"})
+			assert.Nil(t, err)
+
+			err = validator.Validate(dataset, map[string]string{"BIGQUERY_NAME_PATTERN": "^[a-z0-9_]+$"})
+			assert.Nil(t, err)
+		})
+	})
+	t.Run("PartitionRequiredValidator", func(t *testing.T) {
+		validator := bigquery.PartitionRequiredValidator{}
+
+		t.Run("returns error when table is not partitioned", func(t *testing.T) {
+			table, err := resource.NewResource("project.dataset.table1", bigquery.KindTable, resource.Bigquery, tnnt, &metadata, tableSpec)
+			assert.Nil(t, err)
+
+			err = validator.Validate(table, nil)
+			assert.ErrorContains(t, err, "table project.dataset.table1 is required to be partitioned")
+		})
+		t.Run("returns no error when table is partitioned", func(t *testing.T) {
+			spec := map[string]any{
+				"description": "resource",
+				"schema":      []map[string]any{{"name": "event_time", "type": "timestamp"}},
+				"partition":   map[string]any{"field": "event_time", "type": "day"},
+			}
+			table, err := resource.NewResource("project.dataset.table1", bigquery.KindTable, resource.Bigquery, tnnt, &metadata, spec)
+			assert.Nil(t, err)
+
+			err = validator.Validate(table, nil)
+			assert.Nil(t, err)
+		})
+		t.Run("returns no error for other kinds", func(t *testing.T) {
+			view, err := resource.NewResource("project.dataset.view1", bigquery.KindView, resource.Bigquery, tnnt, &metadata, map[string]any{"view_query": "select 1"})
+			assert.Nil(t, err)
+
+			err = validator.Validate(view, nil)
+			assert.Nil(t, err)
+		})
+	})
+}
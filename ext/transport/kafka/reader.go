@@ -0,0 +1,106 @@
+package kafka
+
+import (
+	"context"
+	"time"
+
+	"github.com/goto/salt/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/segmentio/kafka-go"
+)
+
+const (
+	maxHandleAttempts = 5
+	initialBackoff    = time.Second
+)
+
+var kafkaConsumedCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "consumer_kafka_messages_total",
+	Help: "Number of messages consumed from kafka topic, by status",
+}, []string{"status"})
+
+// Reader consumes a topic as part of a consumer group, a message is committed once handled or once
+// the handling keeps failing after retries, hence handlers have to be idempotent
+type Reader struct {
+	logger log.Logger
+
+	kafkaReader *kafka.Reader
+}
+
+func NewReader(kafkaBrokerUrls []string, topic, groupID string, logger log.Logger) *Reader {
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:     kafkaBrokerUrls,
+		Topic:       topic,
+		GroupID:     groupID,
+		Logger:      kafka.LoggerFunc(logger.Debug),
+		ErrorLogger: kafka.LoggerFunc(logger.Error),
+	})
+
+	return &Reader{kafkaReader: reader, logger: logger}
+}
+
+func (r *Reader) Close() error {
+	return r.kafkaReader.Close()
+}
+
+// Read passes the messages to handle till the context is cancelled
+func (r *Reader) Read(ctx context.Context, handle func(context.Context, []byte) error) {
+	for {
+		message, err := r.kafkaReader.FetchMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			r.logger.Error("error fetching message from kafka: %s", err)
+			if !wait(ctx, initialBackoff) {
+				return
+			}
+			continue
+		}
+
+		if !r.handle(ctx, message, handle) {
+			return
+		}
+
+		if err := r.kafkaReader.CommitMessages(ctx, message); err != nil {
+			r.logger.Error("error committing message at partition [%d] offset [%d]: %s", message.Partition, message.Offset, err)
+		}
+	}
+}
+
+// handle retries the message with backoff to survive transient failures, it returns false when the context is cancelled
+func (r *Reader) handle(ctx context.Context, message kafka.Message, handle func(context.Context, []byte) error) bool {
+	backoff := initialBackoff
+	for attempt := 1; ; attempt++ {
+		err := handle(ctx, message.Value)
+		if err == nil {
+			kafkaConsumedCounter.WithLabelValues("success").Inc()
+			return true
+		}
+
+		if attempt >= maxHandleAttempts {
+			r.logger.Error("discarding message at partition [%d] offset [%d] after %d attempts: %s", message.Partition, message.Offset, attempt, err)
+			kafkaConsumedCounter.WithLabelValues("discarded").Inc()
+			return true
+		}
+
+		r.logger.Warn("error handling message at partition [%d] offset [%d], attempt %d: %s", message.Partition, message.Offset, attempt, err)
+		if !wait(ctx, backoff) {
+			return false
+		}
+		backoff *= 2
+	}
+}
+
+func wait(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
@@ -25,10 +25,21 @@ type Writer struct {
 	logger log.Logger
 
 	kafkaWriter *kafka.Writer
+	brokerURLs  []string
+	topic       string
+
+	// routedWriter publishes the events routed by their type, it sets no topic as every message carries its own,
+	// while kafkaWriter keeps publishing the events which cannot be routed to the topic
+	routedWriter *kafka.Writer
+	eventType    func([]byte) (string, error)
 }
 
 func NewWriter(kafkaBrokerUrls []string, topic string, logger log.Logger) *Writer {
-	writer := &kafka.Writer{
+	return &Writer{kafkaWriter: newKafkaWriter(kafkaBrokerUrls, topic, logger), brokerURLs: kafkaBrokerUrls, topic: topic, logger: logger}
+}
+
+func newKafkaWriter(kafkaBrokerUrls []string, topic string, logger log.Logger) *kafka.Writer {
+	return &kafka.Writer{
 		Addr:                   kafka.TCP(kafkaBrokerUrls...),
 		Topic:                  topic,
 		AllowAutoTopicCreation: true,
@@ -39,34 +50,71 @@ func NewWriter(kafkaBrokerUrls []string, topic string, logger log.Logger) *Write
 		Logger:                 kafka.LoggerFunc(logger.Info),
 		ErrorLogger:            kafka.LoggerFunc(logger.Error),
 	}
+}
 
-	return &Writer{kafkaWriter: writer, logger: logger}
+// RouteByEventType publishes every event to its own topic, named after the topic suffixed with the event type, the
+// events whose type cannot be resolved are published to the topic
+func (w *Writer) RouteByEventType(eventType func([]byte) (string, error)) *Writer {
+	w.eventType = eventType
+	w.routedWriter = newKafkaWriter(w.brokerURLs, "", w.logger)
+	return w
 }
 
 func (w *Writer) Close() error {
+	if w.routedWriter != nil {
+		if err := w.routedWriter.Close(); err != nil {
+			return err
+		}
+	}
 	return w.kafkaWriter.Close()
 }
 
 func (w *Writer) Write(messages [][]byte) error {
+	if w.eventType == nil {
+		return w.send(w.kafkaWriter, toKafkaMessages(messages))
+	}
+
+	var routedMessages, unroutedMessages []kafka.Message
+	for _, m := range messages {
+		eventType, err := w.eventType(m)
+		if err != nil {
+			w.logger.Warn("unable to route message by event type, publishing to topic %s: %s", w.topic, err)
+			unroutedMessages = append(unroutedMessages, kafka.Message{Value: m})
+			continue
+		}
+		routedMessages = append(routedMessages, kafka.Message{Topic: w.topic + "." + eventType, Value: m})
+	}
+
+	if len(unroutedMessages) > 0 {
+		if err := w.send(w.kafkaWriter, unroutedMessages); err != nil {
+			return err
+		}
+	}
+	if len(routedMessages) > 0 {
+		return w.send(w.routedWriter, routedMessages)
+	}
+	return nil
+}
+
+func toKafkaMessages(messages [][]byte) []kafka.Message {
 	kafkaMessages := make([]kafka.Message, len(messages))
 	for i, m := range messages {
 		kafkaMessages[i] = kafka.Message{
 			Value: m,
 		}
 	}
-
-	return w.send(kafkaMessages)
+	return kafkaMessages
 }
 
-func (w *Writer) send(messages []kafka.Message) error {
-	err := w.kafkaWriter.WriteMessages(context.Background(), messages...)
+func (w *Writer) send(kafkaWriter *kafka.Writer, messages []kafka.Message) error {
+	err := kafkaWriter.WriteMessages(context.Background(), messages...)
 	if err != nil {
 		var messageSizeError kafka.MessageTooLargeError
 		if errors.As(err, &messageSizeError) {
 			w.logger.Error("Received too large message error for a message, trying remaining")
 			w.logger.Error("Discarded message: %s", string(messageSizeError.Message.Value))
 
-			return w.send(messageSizeError.Remaining)
+			return w.send(kafkaWriter, messageSizeError.Remaining)
 		}
 
 		return err
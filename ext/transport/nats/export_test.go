@@ -0,0 +1,7 @@
+package nats
+
+import "github.com/goto/salt/log"
+
+func NewTestWriter(js jetStream, subject string, logger log.Logger) *Writer {
+	return &Writer{logger: logger, js: js, subject: subject}
+}
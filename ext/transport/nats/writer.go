@@ -0,0 +1,125 @@
+package nats
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/goto/salt/log"
+	"github.com/nats-io/nats.go"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/goto/optimus/core/event/moderator"
+)
+
+const (
+	publishTimeout = time.Second * 5
+)
+
+var errNotAcknowledged = errors.New("message is not acknowledged")
+
+var (
+	natsQueueCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "publisher_nats_events_queued_total",
+		Help: "Number of events published to nats jetstream subject",
+	})
+	natsFailureCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "publisher_nats_delivery_failures_total",
+		Help: "Number of events not acknowledged by nats jetstream",
+	})
+)
+
+// jetStream publishes the messages asynchronously, it is the part of nats.JetStreamContext used by the writer
+type jetStream interface {
+	PublishAsync(subj string, data []byte, opts ...nats.PubOpt) (nats.PubAckFuture, error)
+	PublishAsyncComplete() <-chan struct{}
+}
+
+// Writer publishes the events to a subject of a jetstream stream, the messages of a batch are published
+// asynchronously and the batch is done once they are acknowledged or the publish timeout is reached
+type Writer struct {
+	logger log.Logger
+
+	conn      *nats.Conn
+	js        jetStream
+	subject   string
+	eventType func([]byte) (string, error)
+}
+
+func NewWriter(url, subject string, logger log.Logger) (*Writer, error) {
+	conn, err := nats.Connect(url, nats.Name("optimus-publisher"))
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to nats at %s: %w", url, err)
+	}
+	js, err := conn.JetStream()
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("error getting jetstream context: %w", err)
+	}
+
+	return &Writer{logger: logger, conn: conn, js: js, subject: subject}, nil
+}
+
+// RouteByEventType publishes every event to its own subject, named after the subject suffixed with the event type
+func (w *Writer) RouteByEventType(eventType func([]byte) (string, error)) *Writer {
+	w.eventType = eventType
+	return w
+}
+
+func (w *Writer) Close() error {
+	return w.conn.Drain()
+}
+
+// Write publishes the messages, the messages not acknowledged are returned in a moderator.WriteError so that only
+// they are published again on retry
+func (w *Writer) Write(messages [][]byte) error {
+	futures := make(map[int]nats.PubAckFuture, len(messages))
+	failed := make(map[int]error)
+	for i, m := range messages {
+		subject := w.routeSubject(m)
+		future, err := w.js.PublishAsync(subject, m)
+		if err != nil {
+			w.logger.Error("error publishing message to nats subject %s: %s", subject, err)
+			failed[i] = err
+			continue
+		}
+		futures[i] = future
+	}
+
+	select {
+	case <-w.js.PublishAsyncComplete():
+	case <-time.After(publishTimeout):
+		w.logger.Warn("messages to nats subject %s are not acknowledged within %s", w.subject, publishTimeout)
+	}
+
+	for i, future := range futures {
+		select {
+		case <-future.Ok():
+		case err := <-future.Err():
+			w.logger.Error("message to nats subject %s is not delivered: %s", future.Msg().Subject, err)
+			failed[i] = err
+		default:
+			failed[i] = errNotAcknowledged
+		}
+	}
+
+	natsFailureCounter.Add(float64(len(failed)))
+	natsQueueCounter.Add(float64(len(messages) - len(failed)))
+	if len(failed) > 0 {
+		return &moderator.WriteError{Failed: failed}
+	}
+	return nil
+}
+
+func (w *Writer) routeSubject(message []byte) string {
+	if w.eventType == nil {
+		return w.subject
+	}
+	eventType, err := w.eventType(message)
+	if err != nil {
+		w.logger.Warn("unable to route message by event type, publishing to subject %s: %s", w.subject, err)
+		return w.subject
+	}
+	return w.subject + "." + eventType
+}
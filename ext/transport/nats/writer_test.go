@@ -0,0 +1,96 @@
+package nats_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/goto/salt/log"
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/core/event/moderator"
+	transport "github.com/goto/optimus/ext/transport/nats"
+)
+
+func TestWriter(t *testing.T) {
+	logger := log.NewNoop()
+	byPayload := func(message []byte) (string, error) { return string(message), nil }
+
+	t.Run("Write", func(t *testing.T) {
+		t.Run("returns nil when every message is acknowledged", func(t *testing.T) {
+			js := newJetStream(nil, nil)
+			writer := transport.NewTestWriter(js, "events", logger)
+
+			err := writer.Write([][]byte{[]byte("first"), []byte("second")})
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"events", "events"}, js.subjects)
+		})
+		t.Run("returns write error with only the messages not delivered", func(t *testing.T) {
+			js := newJetStream(map[string]error{"rejected": errors.New("stream is full")},
+				map[string]error{"nacked": errors.New("no responders")})
+			writer := transport.NewTestWriter(js, "events", logger)
+
+			err := writer.Write([][]byte{[]byte("first"), []byte("rejected"), []byte("nacked"), []byte("second")})
+
+			var writeErr *moderator.WriteError
+			assert.ErrorAs(t, err, &writeErr)
+			assert.Len(t, writeErr.Failed, 2)
+			assert.True(t, writeErr.Delivered(0))
+			assert.ErrorContains(t, writeErr.Failed[1], "stream is full")
+			assert.ErrorContains(t, writeErr.Failed[2], "no responders")
+			assert.True(t, writeErr.Delivered(3))
+		})
+		t.Run("publishes to the subject of the event type", func(t *testing.T) {
+			js := newJetStream(nil, nil)
+			writer := transport.NewTestWriter(js, "events", logger).RouteByEventType(byPayload)
+
+			err := writer.Write([][]byte{[]byte("job_success")})
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"events.job_success"}, js.subjects)
+		})
+	})
+}
+
+type jetStream struct {
+	publishErrors map[string]error
+	ackErrors     map[string]error
+
+	subjects []string
+}
+
+func newJetStream(publishErrors, ackErrors map[string]error) *jetStream {
+	return &jetStream{publishErrors: publishErrors, ackErrors: ackErrors}
+}
+
+func (j *jetStream) PublishAsync(subj string, data []byte, _ ...nats.PubOpt) (nats.PubAckFuture, error) {
+	if err, ok := j.publishErrors[string(data)]; ok {
+		return nil, err
+	}
+	j.subjects = append(j.subjects, subj)
+
+	future := &pubAckFuture{msg: &nats.Msg{Subject: subj, Data: data}, ok: make(chan *nats.PubAck, 1), err: make(chan error, 1)}
+	if err, ok := j.ackErrors[string(data)]; ok {
+		future.err <- err
+	} else {
+		future.ok <- &nats.PubAck{Stream: "events"}
+	}
+	return future, nil
+}
+
+func (*jetStream) PublishAsyncComplete() <-chan struct{} {
+	done := make(chan struct{})
+	close(done)
+	return done
+}
+
+type pubAckFuture struct {
+	msg *nats.Msg
+	ok  chan *nats.PubAck
+	err chan error
+}
+
+func (f *pubAckFuture) Ok() <-chan *nats.PubAck { return f.ok }
+
+func (f *pubAckFuture) Err() <-chan error { return f.err }
+
+func (f *pubAckFuture) Msg() *nats.Msg { return f.msg }
@@ -0,0 +1,10 @@
+package pubsub
+
+import (
+	"cloud.google.com/go/pubsub"
+	"github.com/goto/salt/log"
+)
+
+func NewTestWriter(client *pubsub.Client, topicID string, maxBatchSize int, logger log.Logger) *Writer {
+	return newWriter(client, topicID, maxBatchSize, logger)
+}
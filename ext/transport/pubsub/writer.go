@@ -0,0 +1,122 @@
+package pubsub
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"github.com/goto/salt/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/goto/optimus/core/event/moderator"
+)
+
+const (
+	publishTimeout = time.Second * 10
+)
+
+var (
+	pubsubQueueCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "publisher_pubsub_events_queued_total",
+		Help: "Number of events published to google pubsub topic",
+	})
+	pubsubFailureCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "publisher_pubsub_delivery_failures_total",
+		Help: "Number of events not accepted by google pubsub",
+	})
+)
+
+// Writer publishes the events to a google pubsub topic, the client batches the messages of a write
+// up to the max batch size per request
+type Writer struct {
+	logger log.Logger
+
+	client       *pubsub.Client
+	topic        *pubsub.Topic
+	maxBatchSize int
+
+	eventType func([]byte) (string, error)
+	topics    map[string]*pubsub.Topic
+}
+
+func NewWriter(projectID, topicID string, maxBatchSize int, logger log.Logger) (*Writer, error) {
+	client, err := pubsub.NewClient(context.Background(), projectID)
+	if err != nil {
+		return nil, fmt.Errorf("error creating pubsub client for project %s: %w", projectID, err)
+	}
+	return newWriter(client, topicID, maxBatchSize, logger), nil
+}
+
+func newWriter(client *pubsub.Client, topicID string, maxBatchSize int, logger log.Logger) *Writer {
+	w := &Writer{logger: logger, client: client, maxBatchSize: maxBatchSize, topics: make(map[string]*pubsub.Topic)}
+	w.topic = w.getTopic(topicID)
+	return w
+}
+
+// RouteByEventType publishes every event to its own topic, named after the topic suffixed with the event type
+func (w *Writer) RouteByEventType(eventType func([]byte) (string, error)) *Writer {
+	w.eventType = eventType
+	return w
+}
+
+func (w *Writer) Close() error {
+	for _, topic := range w.topics {
+		topic.Stop()
+	}
+	return w.client.Close()
+}
+
+// Write publishes the messages, the messages not delivered are returned in a moderator.WriteError so that only
+// they are published again on retry
+func (w *Writer) Write(messages [][]byte) error {
+	ctx, cancel := context.WithTimeout(context.Background(), publishTimeout)
+	defer cancel()
+
+	results := make([]*pubsub.PublishResult, len(messages))
+	topics := make([]*pubsub.Topic, len(messages))
+	for i, m := range messages {
+		topics[i] = w.routeTopic(m)
+		results[i] = topics[i].Publish(ctx, &pubsub.Message{Data: m})
+	}
+
+	failed := make(map[int]error)
+	for i, result := range results {
+		if _, err := result.Get(ctx); err != nil {
+			w.logger.Error("message to pubsub topic %s is not delivered: %s", topics[i].ID(), err)
+			failed[i] = err
+		}
+	}
+
+	pubsubFailureCounter.Add(float64(len(failed)))
+	pubsubQueueCounter.Add(float64(len(messages) - len(failed)))
+	if len(failed) > 0 {
+		return &moderator.WriteError{Failed: failed}
+	}
+	return nil
+}
+
+func (w *Writer) routeTopic(message []byte) *pubsub.Topic {
+	if w.eventType == nil {
+		return w.topic
+	}
+	eventType, err := w.eventType(message)
+	if err != nil {
+		w.logger.Warn("unable to route message by event type, publishing to topic %s: %s", w.topic.ID(), err)
+		return w.topic
+	}
+	return w.getTopic(w.topic.ID() + "." + eventType)
+}
+
+func (w *Writer) getTopic(topicID string) *pubsub.Topic {
+	if topic, ok := w.topics[topicID]; ok {
+		return topic
+	}
+	topic := w.client.Topic(topicID)
+	if w.maxBatchSize > 0 {
+		topic.PublishSettings.CountThreshold = w.maxBatchSize
+	}
+	w.topics[topicID] = topic
+	return topic
+}
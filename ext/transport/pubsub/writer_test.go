@@ -0,0 +1,80 @@
+package pubsub_test
+
+import (
+	"context"
+	"testing"
+
+	"cloud.google.com/go/pubsub"
+	"cloud.google.com/go/pubsub/pstest"
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/goto/optimus/core/event/moderator"
+	transport "github.com/goto/optimus/ext/transport/pubsub"
+)
+
+func TestWriter(t *testing.T) {
+	ctx := context.Background()
+	logger := log.NewNoop()
+	byPayload := func(message []byte) (string, error) { return string(message), nil }
+
+	setup := func(t *testing.T, topicIDs ...string) (*pstest.Server, *pubsub.Client) {
+		t.Helper()
+
+		srv := pstest.NewServer()
+		t.Cleanup(func() { srv.Close() })
+
+		conn, err := grpc.Dial(srv.Addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		assert.NoError(t, err)
+
+		client, err := pubsub.NewClient(ctx, "test-project", option.WithGRPCConn(conn))
+		assert.NoError(t, err)
+
+		for _, topicID := range topicIDs {
+			_, err := client.CreateTopic(ctx, topicID)
+			assert.NoError(t, err)
+		}
+		return srv, client
+	}
+
+	t.Run("Write", func(t *testing.T) {
+		t.Run("returns nil when every message is delivered", func(t *testing.T) {
+			srv, client := setup(t, "events")
+			writer := transport.NewTestWriter(client, "events", 10, logger)
+			defer writer.Close()
+
+			err := writer.Write([][]byte{[]byte("first"), []byte("second")})
+			assert.NoError(t, err)
+			assert.Len(t, srv.Messages(), 2)
+		})
+		t.Run("returns write error with only the messages not delivered", func(t *testing.T) {
+			srv, client := setup(t, "events", "events.job_success")
+			writer := transport.NewTestWriter(client, "events", 10, logger).RouteByEventType(byPayload)
+			defer writer.Close()
+
+			err := writer.Write([][]byte{[]byte("job_success"), []byte("job_failure"), []byte("job_success")})
+
+			var writeErr *moderator.WriteError
+			assert.ErrorAs(t, err, &writeErr)
+			assert.Len(t, writeErr.Failed, 1)
+			assert.True(t, writeErr.Delivered(0))
+			assert.False(t, writeErr.Delivered(1))
+			assert.True(t, writeErr.Delivered(2))
+			assert.Len(t, srv.Messages(), 2)
+		})
+		t.Run("returns write error with every message when none is delivered", func(t *testing.T) {
+			_, client := setup(t)
+			writer := transport.NewTestWriter(client, "events", 10, logger)
+			defer writer.Close()
+
+			err := writer.Write([][]byte{[]byte("first"), []byte("second")})
+
+			var writeErr *moderator.WriteError
+			assert.ErrorAs(t, err, &writeErr)
+			assert.Len(t, writeErr.Failed, 2)
+		})
+	})
+}
@@ -0,0 +1,118 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+
+	"github.com/goto/optimus/config"
+)
+
+const (
+	defaultUsernameClaim = "email"
+	emailVerifiedClaim   = "email_verified"
+
+	fetchTimeout = 10 * time.Second
+)
+
+// OIDCVerifier verifies the id tokens issued by the OIDC provider for the audience. The provider is discovered on the
+// first verification, and its signing keys are fetched again when a token is signed with a key not known yet, so the
+// keys rotated by the provider are picked up.
+type OIDCVerifier struct {
+	issuer        string
+	audience      string
+	usernameClaim string
+	client        *http.Client
+
+	mu       sync.Mutex
+	verifier *oidc.IDTokenVerifier
+}
+
+// Verify checks the signature, issuer, audience and validity period of the token, and returns the principal named
+// after the username claim of the token. The email is only taken as the name once it is verified by the provider.
+func (v *OIDCVerifier) Verify(ctx context.Context, rawToken string) (*Principal, error) {
+	verifier, err := v.idTokenVerifier(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	idToken, err := verifier.Verify(ctx, rawToken)
+	if err != nil {
+		return nil, err
+	}
+
+	var claims map[string]interface{}
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, fmt.Errorf("invalid token claims: %w", err)
+	}
+	name, _ := claims[v.usernameClaim].(string)
+	if name == "" {
+		return nil, fmt.Errorf("token has no %s claim", v.usernameClaim)
+	}
+	if v.usernameClaim == defaultUsernameClaim {
+		if verified, _ := claims[emailVerifiedClaim].(bool); !verified {
+			return nil, fmt.Errorf("email %s of the token is not verified", name)
+		}
+	}
+	return &Principal{Type: PrincipalOIDC, Name: name}, nil
+}
+
+// idTokenVerifier discovers the provider once, the discovery is tried again on the next verification when it fails.
+// The keys are fetched with a context not bound to a verification, a cancelled verification does not fail the others
+// waiting for the keys.
+func (v *OIDCVerifier) idTokenVerifier(ctx context.Context) (*oidc.IDTokenVerifier, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.verifier != nil {
+		return v.verifier, nil
+	}
+
+	discovered := make(chan struct{})
+	var provider *oidc.Provider
+	var err error
+	go func() {
+		defer close(discovered)
+		provider, err = oidc.NewProvider(oidc.ClientContext(context.Background(), v.client), v.issuer)
+	}()
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-discovered:
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to discover the oidc provider: %w", err)
+	}
+
+	v.verifier = provider.Verifier(&oidc.Config{ClientID: v.audience})
+	return v.verifier, nil
+}
+
+// NewOIDCVerifier returns the verifier of the id tokens of the provider, the provider is discovered on the first
+// verification so the server starts while the provider is not reachable
+func NewOIDCVerifier(conf config.OIDCConfig) (*OIDCVerifier, error) {
+	if parsed, err := url.Parse(conf.IssuerURL); err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		return nil, fmt.Errorf("oidc issuer url %q is not valid", conf.IssuerURL)
+	}
+	if conf.Audience == "" {
+		return nil, errors.New("oidc audience is empty")
+	}
+
+	usernameClaim := conf.UsernameClaim
+	if usernameClaim == "" {
+		usernameClaim = defaultUsernameClaim
+	}
+	return &OIDCVerifier{
+		issuer:        conf.IssuerURL,
+		audience:      conf.Audience,
+		usernameClaim: usernameClaim,
+		client:        &http.Client{Timeout: fetchTimeout},
+	}, nil
+}
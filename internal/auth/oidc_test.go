@@ -0,0 +1,274 @@
+package auth_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/config"
+	"github.com/goto/optimus/internal/auth"
+)
+
+func TestOIDCVerifier(t *testing.T) {
+	ctx := context.Background()
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	provider := newOIDCProvider(t, map[string]interface{}{
+		"keys": []map[string]string{
+			{
+				"kty": "RSA", "kid": "rsa-key", "use": "sig",
+				"n": encodeSegment(rsaKey.N.Bytes()), "e": encodeSegment(big.NewInt(int64(rsaKey.E)).Bytes()),
+			},
+			{
+				"kty": "EC", "kid": "ec-key", "crv": "P-256",
+				"x": encodeSegment(ecKey.X.FillBytes(make([]byte, 32))), "y": encodeSegment(ecKey.Y.FillBytes(make([]byte, 32))),
+			},
+		},
+	})
+	defer provider.Close()
+
+	validClaims := func() map[string]interface{} {
+		return map[string]interface{}{
+			"iss":            provider.URL,
+			"aud":            []string{"optimus", "other"},
+			"email":          "john.doe@example.com",
+			"email_verified": true,
+			"sub":            "1234",
+			"exp":            time.Now().Add(time.Hour).Unix(),
+		}
+	}
+	newVerifier := func(t *testing.T) *auth.OIDCVerifier {
+		t.Helper()
+		verifier, err := auth.NewOIDCVerifier(config.OIDCConfig{IssuerURL: provider.URL, Audience: "optimus"})
+		assert.NoError(t, err)
+		return verifier
+	}
+
+	t.Run("NewOIDCVerifier", func(t *testing.T) {
+		t.Run("returns error when issuer url is not valid", func(t *testing.T) {
+			_, err := auth.NewOIDCVerifier(config.OIDCConfig{IssuerURL: "accounts.example.com", Audience: "optimus"})
+			assert.ErrorContains(t, err, "oidc issuer url \"accounts.example.com\" is not valid")
+		})
+		t.Run("returns error when audience is empty", func(t *testing.T) {
+			_, err := auth.NewOIDCVerifier(config.OIDCConfig{IssuerURL: "https://accounts.example.com"})
+			assert.ErrorContains(t, err, "oidc audience is empty")
+		})
+	})
+	t.Run("Verify", func(t *testing.T) {
+		t.Run("returns the principal of the tokens signed with rsa keys", func(t *testing.T) {
+			token := signRS256(t, rsaKey, "rsa-key", validClaims())
+
+			principal, err := newVerifier(t).Verify(ctx, token)
+			assert.NoError(t, err)
+			assert.Equal(t, auth.PrincipalOIDC, principal.Type)
+			assert.Equal(t, "john.doe@example.com", principal.String())
+			assert.Nil(t, principal.APIToken)
+		})
+		t.Run("returns the principal of the tokens signed with ec keys", func(t *testing.T) {
+			token := signES256(t, ecKey, "ec-key", validClaims())
+
+			principal, err := newVerifier(t).Verify(ctx, token)
+			assert.NoError(t, err)
+			assert.Equal(t, "john.doe@example.com", principal.Name)
+		})
+		t.Run("names the principal after the username claim", func(t *testing.T) {
+			verifier, err := auth.NewOIDCVerifier(config.OIDCConfig{IssuerURL: provider.URL, Audience: "optimus", UsernameClaim: "sub"})
+			assert.NoError(t, err)
+
+			principal, err := verifier.Verify(ctx, signRS256(t, rsaKey, "rsa-key", validClaims()))
+			assert.NoError(t, err)
+			assert.Equal(t, "1234", principal.Name)
+		})
+		t.Run("returns error when the signature does not match", func(t *testing.T) {
+			otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+			assert.NoError(t, err)
+
+			_, err = newVerifier(t).Verify(ctx, signRS256(t, otherKey, "rsa-key", validClaims()))
+			assert.ErrorContains(t, err, "failed to verify signature")
+		})
+		t.Run("returns error when the key is not known", func(t *testing.T) {
+			_, err := newVerifier(t).Verify(ctx, signRS256(t, rsaKey, "rotated-key", validClaims()))
+			assert.ErrorContains(t, err, "failed to verify signature")
+		})
+		t.Run("returns error when the email is not verified", func(t *testing.T) {
+			claims := validClaims()
+			claims["email_verified"] = false
+
+			_, err := newVerifier(t).Verify(ctx, signRS256(t, rsaKey, "rsa-key", claims))
+			assert.EqualError(t, err, "email john.doe@example.com of the token is not verified")
+
+			delete(claims, "email_verified")
+			_, err = newVerifier(t).Verify(ctx, signRS256(t, rsaKey, "rsa-key", claims))
+			assert.EqualError(t, err, "email john.doe@example.com of the token is not verified")
+		})
+		t.Run("returns error when the token is issued by another issuer", func(t *testing.T) {
+			claims := validClaims()
+			claims["iss"] = "https://accounts.example.com"
+
+			_, err := newVerifier(t).Verify(ctx, signRS256(t, rsaKey, "rsa-key", claims))
+			assert.ErrorContains(t, err, "id token issued by a different provider")
+		})
+		t.Run("returns error when the token is issued for another audience", func(t *testing.T) {
+			claims := validClaims()
+			claims["aud"] = "other"
+
+			_, err := newVerifier(t).Verify(ctx, signRS256(t, rsaKey, "rsa-key", claims))
+			assert.ErrorContains(t, err, "expected audience \"optimus\"")
+		})
+		t.Run("returns error when the token is expired", func(t *testing.T) {
+			claims := validClaims()
+			claims["exp"] = time.Now().Add(-time.Hour).Unix()
+
+			_, err := newVerifier(t).Verify(ctx, signRS256(t, rsaKey, "rsa-key", claims))
+			assert.ErrorContains(t, err, "token is expired")
+		})
+		t.Run("returns error when the token is not a jwt", func(t *testing.T) {
+			_, err := newVerifier(t).Verify(ctx, "opaque-access-token")
+			assert.ErrorContains(t, err, "malformed jwt")
+		})
+		t.Run("fetches the keys once for the concurrent verifications", func(t *testing.T) {
+			release := make(chan struct{})
+			var fetches int32
+			slowProvider := newSlowOIDCProvider(t, provider.URL+"/keys", release, &fetches)
+			defer slowProvider.Close()
+
+			verifier, err := auth.NewOIDCVerifier(config.OIDCConfig{IssuerURL: slowProvider.URL, Audience: "optimus"})
+			assert.NoError(t, err)
+			claims := validClaims()
+			claims["iss"] = slowProvider.URL
+			token := signRS256(t, rsaKey, "rsa-key", claims)
+
+			var wg sync.WaitGroup
+			errs := make(chan error, 5)
+			for i := 0; i < 5; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					_, err := verifier.Verify(ctx, token)
+					errs <- err
+				}()
+			}
+			close(release)
+			wg.Wait()
+			close(errs)
+
+			for err := range errs {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, int32(1), atomic.LoadInt32(&fetches))
+		})
+		t.Run("returns error when the context is done while the keys are fetched", func(t *testing.T) {
+			release := make(chan struct{})
+			var fetches int32
+			slowProvider := newSlowOIDCProvider(t, provider.URL+"/keys", release, &fetches)
+			defer slowProvider.Close()
+			defer close(release)
+
+			verifier, err := auth.NewOIDCVerifier(config.OIDCConfig{IssuerURL: slowProvider.URL, Audience: "optimus"})
+			assert.NoError(t, err)
+			claims := validClaims()
+			claims["iss"] = slowProvider.URL
+
+			cancelledCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+			defer cancel()
+			_, err = verifier.Verify(cancelledCtx, signRS256(t, rsaKey, "rsa-key", claims))
+			assert.ErrorContains(t, err, context.DeadlineExceeded.Error())
+		})
+	})
+}
+
+func newOIDCProvider(t *testing.T, jwks map[string]interface{}) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"issuer":                                server.URL,
+			"jwks_uri":                              server.URL + "/keys",
+			"id_token_signing_alg_values_supported": []string{"RS256", "ES256"},
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, _ *http.Request) {
+		json.NewEncoder(w).Encode(jwks)
+	})
+	return server
+}
+
+// newSlowOIDCProvider serves the discovery document and holds the requests of the keys until released, the keys are
+// proxied from the jwks uri
+func newSlowOIDCProvider(t *testing.T, jwksURI string, release <-chan struct{}, fetches *int32) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"issuer":                                server.URL,
+			"jwks_uri":                              server.URL + "/keys",
+			"id_token_signing_alg_values_supported": []string{"RS256", "ES256"},
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(fetches, 1)
+		<-release
+		resp, err := http.Get(jwksURI) //nolint: noctx
+		if err != nil {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		defer resp.Body.Close()
+		io.Copy(w, resp.Body)
+	})
+	return server
+}
+
+func signRS256(t *testing.T, key *rsa.PrivateKey, keyID string, claims map[string]interface{}) string {
+	t.Helper()
+	signed := signedPart(t, "RS256", keyID, claims)
+	digest := sha256.Sum256([]byte(signed))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	assert.NoError(t, err)
+	return signed + "." + encodeSegment(signature)
+}
+
+func signES256(t *testing.T, key *ecdsa.PrivateKey, keyID string, claims map[string]interface{}) string {
+	t.Helper()
+	signed := signedPart(t, "ES256", keyID, claims)
+	digest := sha256.Sum256([]byte(signed))
+	r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+	assert.NoError(t, err)
+	signature := append(r.FillBytes(make([]byte, 32)), s.FillBytes(make([]byte, 32))...)
+	return signed + "." + encodeSegment(signature)
+}
+
+func signedPart(t *testing.T, algorithm, keyID string, claims map[string]interface{}) string {
+	t.Helper()
+	header, err := json.Marshal(map[string]string{"alg": algorithm, "kid": keyID, "typ": "JWT"})
+	assert.NoError(t, err)
+	payload, err := json.Marshal(claims)
+	assert.NoError(t, err)
+	return strings.Join([]string{encodeSegment(header), encodeSegment(payload)}, ".")
+}
+
+func encodeSegment(raw []byte) string {
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
@@ -0,0 +1,49 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/goto/optimus/core/tenant"
+)
+
+type PrincipalType string
+
+const (
+	PrincipalAPIToken PrincipalType = "api_token"
+	PrincipalOIDC     PrincipalType = "oidc"
+)
+
+// Principal is the identity a request is authenticated as
+type Principal struct {
+	Type PrincipalType
+	// Name is the name of the api token, or the username claim of the oidc token
+	Name string
+
+	// APIToken is set for the principals authenticated with an api token, its scope limits the calls allowed
+	APIToken *tenant.APIToken
+}
+
+// String returns the principal as recorded in the audit logs and limited by the rate limits, the api tokens are
+// named as api_token:<project>/<name>, as tokens of the same name can exist in many projects
+func (p *Principal) String() string {
+	if p.Type != PrincipalAPIToken {
+		return p.Name
+	}
+	if p.APIToken == nil {
+		return string(PrincipalAPIToken) + ":" + p.Name
+	}
+	return string(PrincipalAPIToken) + ":" + p.APIToken.ProjectName().String() + "/" + p.Name
+}
+
+type principalContextKey struct{}
+
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalContextKey{}, principal)
+}
+
+// PrincipalFrom returns the principal the request is authenticated as, nil when the request is not authenticated
+// by the server
+func PrincipalFrom(ctx context.Context) *Principal {
+	principal, _ := ctx.Value(principalContextKey{}).(*Principal)
+	return principal
+}
@@ -0,0 +1,28 @@
+package auth_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/auth"
+)
+
+func TestPrincipal(t *testing.T) {
+	t.Run("String", func(t *testing.T) {
+		t.Run("returns the name of the oidc principal", func(t *testing.T) {
+			principal := &auth.Principal{Type: auth.PrincipalOIDC, Name: "john.doe@example.com"}
+
+			assert.Equal(t, "john.doe@example.com", principal.String())
+		})
+		t.Run("returns the project and the name of the api token principal", func(t *testing.T) {
+			apiToken, err := tenant.NewAPIToken("proj", "ci", tenant.TokenScopeDeploy, "hash", time.Time{}, time.Time{})
+			assert.NoError(t, err)
+			principal := &auth.Principal{Type: auth.PrincipalAPIToken, Name: apiToken.Name(), APIToken: apiToken}
+
+			assert.Equal(t, "api_token:proj/ci", principal.String())
+		})
+	})
+}
@@ -3,6 +3,8 @@ package compiler
 import (
 	"bytes"
 	"fmt"
+	"regexp"
+	"sort"
 	"strings"
 	"text/template"
 	"time"
@@ -17,8 +19,16 @@ const (
 	ISODateFormat = "2006-01-02"
 
 	ISOTimeFormat = time.RFC3339
+
+	// maxIncludeDepth bounds the nesting of snippets, to fail on snippets including each other
+	maxIncludeDepth = 10
+
+	// MacroContextName is the name the compiled macros are available with in the template context
+	MacroContextName = "macro"
 )
 
+var macroReferenceRegex = regexp.MustCompile(`\.` + MacroContextName + `\.(\w+)`)
+
 // Engine compiles a set of defined macros using the provided context
 type Engine struct {
 	baseTemplate *template.Template
@@ -55,6 +65,143 @@ func (e *Engine) Compile(templateMap map[string]string, context map[string]any)
 	return rendered, nil
 }
 
+// CompileWithSnippets compiles the templates, which can include a snippet by name with {{ include "name" }}.
+// A snippet is rendered with the context of the template including it, and can include other snippets
+func (e *Engine) CompileWithSnippets(templateMap, snippets map[string]string, context map[string]any) (map[string]string, error) {
+	if len(snippets) == 0 {
+		return e.Compile(templateMap, context)
+	}
+	return compileWithSnippets(templateMap, snippets, context, false)
+}
+
+// Validate renders the templates like CompileWithSnippets, but fails on any reference to a key missing in the
+// context, which otherwise renders as <no value>
+func (e *Engine) Validate(templateMap, snippets map[string]string, context map[string]any) error {
+	_, err := compileWithSnippets(templateMap, snippets, context, true)
+	return err
+}
+
+func compileWithSnippets(templateMap, snippets map[string]string, context map[string]any, strict bool) (map[string]string, error) {
+	rendered := map[string]string{}
+	for name, content := range templateMap {
+		var tmpl *template.Template
+		depth := 0
+		include := func(snippetName string) (string, error) {
+			if tmpl.Lookup(snippetName) == nil {
+				return "", fmt.Errorf("snippet %s is not registered", snippetName)
+			}
+			if depth >= maxIncludeDepth {
+				return "", fmt.Errorf("snippet %s exceeds the include depth of %d", snippetName, maxIncludeDepth)
+			}
+
+			depth++
+			defer func() { depth-- }()
+
+			var buf bytes.Buffer
+			if err := tmpl.ExecuteTemplate(&buf, snippetName, context); err != nil {
+				return "", err
+			}
+			return buf.String(), nil
+		}
+
+		tmpl = template.New(name).Funcs(OptimusFuncMap()).Funcs(template.FuncMap{"include": include})
+		if strict {
+			tmpl = tmpl.Option("missingkey=error")
+		}
+		for snippetName, snippet := range snippets {
+			if _, err := tmpl.New(snippetName).Parse(snippet); err != nil {
+				msg := fmt.Sprintf("unable to parse snippet %s: %s", snippetName, err.Error())
+				return nil, errors.InvalidArgument(EntityCompiler, msg)
+			}
+		}
+
+		if _, err := tmpl.Parse(content); err != nil {
+			msg := fmt.Sprintf("unable to parse content for %s: %s", name, err.Error())
+			return nil, errors.InvalidArgument(EntityCompiler, msg)
+		}
+
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, context); err != nil {
+			msg := fmt.Sprintf("unable to render content for %s: %s", name, err.Error())
+			return nil, errors.InvalidArgument(EntityCompiler, msg)
+		}
+		rendered[name] = strings.TrimSpace(buf.String())
+	}
+	return rendered, nil
+}
+
+// CompileMacros renders the macros with the context, a macro can refer to other macros with {{ .macro.NAME }}.
+// Macros are rendered after the macros they refer to, and macros referring to each other in a cycle are rejected
+func (e *Engine) CompileMacros(macros map[string]string, context map[string]any) (map[string]string, error) {
+	order, err := macroOrder(macros)
+	if err != nil {
+		return nil, err
+	}
+
+	compiled := make(map[string]string, len(macros))
+	macroContext := make(map[string]any, len(context)+1)
+	for k, v := range context {
+		macroContext[k] = v
+	}
+	macroContext[MacroContextName] = compiled
+
+	for _, name := range order {
+		rendered, err := e.Compile(map[string]string{name: macros[name]}, macroContext)
+		if err != nil {
+			return nil, err
+		}
+		compiled[name] = rendered[name]
+	}
+	return compiled, nil
+}
+
+// macroOrder sorts the macros so that every macro comes after the macros it refers to
+func macroOrder(macros map[string]string) ([]string, error) {
+	names := make([]string, 0, len(macros))
+	for name := range macros {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	const (
+		visiting = iota + 1
+		visited
+	)
+	state := make(map[string]int, len(macros))
+	order := make([]string, 0, len(macros))
+
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		switch state[name] {
+		case visited:
+			return nil
+		case visiting:
+			cycle := strings.Join(append(path, name), " -> ")
+			return errors.InvalidArgument(EntityCompiler, "macros refer to each other in a cycle: "+cycle)
+		}
+
+		state[name] = visiting
+		for _, match := range macroReferenceRegex.FindAllStringSubmatch(macros[name], -1) {
+			if _, ok := macros[match[1]]; !ok {
+				continue
+			}
+			if err := visit(match[1], append(path, name)); err != nil {
+				return err
+			}
+		}
+		state[name] = visited
+		order = append(order, name)
+		return nil
+	}
+
+	for _, name := range names {
+		if err := visit(name, nil); err != nil {
+			return nil, err
+		}
+	}
+	return order, nil
+}
+
 func (e *Engine) CompileString(input string, context map[string]any) (string, error) {
 	tmpl, err := e.baseTemplate.New("base").Parse(input)
 	if err != nil {
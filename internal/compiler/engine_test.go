@@ -159,4 +159,111 @@ func TestEngine(t *testing.T) {
 			}
 		})
 	})
+	t.Run("CompileWithSnippets", func(t *testing.T) {
+		context := map[string]interface{}{
+			"DSTART": "2021-02-10T10:00:00+00:00",
+			"DEND":   "2021-02-11T10:00:00+00:00",
+		}
+		snippets := map[string]string{
+			"window_filter":  `event_timestamp > "{{ .DSTART | Date }}" AND event_timestamp <= "{{ .DEND | Date }}"`,
+			"dedup_by_key":   `select * from t where {{ include "window_filter" }}`,
+			"dedup_by_key@1": `select * from t`,
+			"recursive":      `{{ include "recursive" }}`,
+		}
+
+		t.Run("returns compiled content with the included snippets", func(t *testing.T) {
+			comp := compiler.NewEngine()
+			compiled, err := comp.CompileWithSnippets(map[string]string{
+				"query.sql": `{{ include "dedup_by_key" }}`,
+				"old.sql":   `{{ include "dedup_by_key@1" }}`,
+			}, snippets, context)
+			assert.NoError(t, err)
+			assert.Equal(t, map[string]string{
+				"query.sql": `select * from t where event_timestamp > "2021-02-10" AND event_timestamp <= "2021-02-11"`,
+				"old.sql":   `select * from t`,
+			}, compiled)
+		})
+		t.Run("returns error when snippet is not registered", func(t *testing.T) {
+			comp := compiler.NewEngine()
+			_, err := comp.CompileWithSnippets(map[string]string{"query.sql": `{{ include "unknown" }}`}, snippets, context)
+			assert.ErrorContains(t, err, "snippet unknown is not registered")
+		})
+		t.Run("returns error when snippets include each other endlessly", func(t *testing.T) {
+			comp := compiler.NewEngine()
+			_, err := comp.CompileWithSnippets(map[string]string{"query.sql": `{{ include "recursive" }}`}, snippets, context)
+			assert.ErrorContains(t, err, "snippet recursive exceeds the include depth of 10")
+		})
+		t.Run("returns error when snippet cannot be parsed", func(t *testing.T) {
+			comp := compiler.NewEngine()
+			_, err := comp.CompileWithSnippets(map[string]string{"query.sql": `select 1`}, map[string]string{"broken": `{{ .DSTART`}, context)
+			assert.ErrorContains(t, err, "unable to parse snippet broken")
+		})
+		t.Run("compiles without snippets", func(t *testing.T) {
+			comp := compiler.NewEngine()
+			compiled, err := comp.CompileWithSnippets(map[string]string{"query.sql": `{{ .DSTART | Date }}`}, nil, context)
+			assert.NoError(t, err)
+			assert.Equal(t, map[string]string{"query.sql": "2021-02-10"}, compiled)
+		})
+	})
+	t.Run("Validate", func(t *testing.T) {
+		context := map[string]interface{}{
+			"DSTART": "2021-02-10T10:00:00+00:00",
+			"proj":   map[string]string{"BQ_PROJECT": "sample"},
+		}
+
+		t.Run("returns no error when every referenced key is in the context", func(t *testing.T) {
+			comp := compiler.NewEngine()
+			err := comp.Validate(map[string]string{
+				"query.sql": `select * from {{ .proj.BQ_PROJECT }}.t where ts > "{{ .DSTART | Date }}"`,
+			}, nil, context)
+			assert.NoError(t, err)
+		})
+		t.Run("returns error when template references a missing key", func(t *testing.T) {
+			comp := compiler.NewEngine()
+			err := comp.Validate(map[string]string{"query.sql": `select * from t where ts <= "{{ .DEND }}"`}, nil, context)
+			assert.ErrorContains(t, err, "unable to render content for query.sql")
+			assert.ErrorContains(t, err, `map has no entry for key "DEND"`)
+		})
+		t.Run("returns error when included snippet references a missing key", func(t *testing.T) {
+			comp := compiler.NewEngine()
+			err := comp.Validate(map[string]string{"query.sql": `{{ include "filter" }}`},
+				map[string]string{"filter": `{{ .proj.DATASET }}`}, context)
+			assert.ErrorContains(t, err, `map has no entry for key "DATASET"`)
+		})
+	})
+	t.Run("CompileMacros", func(t *testing.T) {
+		context := map[string]interface{}{
+			"DSTART": "2021-02-10T10:00:00+00:00",
+			"proj":   map[string]string{"BQ_PROJECT": "sample"},
+		}
+
+		t.Run("returns macros rendered after the macros they refer to", func(t *testing.T) {
+			comp := compiler.NewEngine()
+			compiled, err := comp.CompileMacros(map[string]string{
+				"TABLE":         `{{ .proj.BQ_PROJECT }}.events`,
+				"WINDOW_FILTER": `ts > "{{ .DSTART | Date }}"`,
+				"EVENTS":        `select * from {{ .macro.TABLE }} where {{ .macro.WINDOW_FILTER }}`,
+			}, context)
+			assert.NoError(t, err)
+			assert.Equal(t, map[string]string{
+				"TABLE":         "sample.events",
+				"WINDOW_FILTER": `ts > "2021-02-10"`,
+				"EVENTS":        `select * from sample.events where ts > "2021-02-10"`,
+			}, compiled)
+		})
+		t.Run("returns error when macros refer to each other in a cycle", func(t *testing.T) {
+			comp := compiler.NewEngine()
+			_, err := comp.CompileMacros(map[string]string{
+				"A": `{{ .macro.B }}`,
+				"B": `{{ .macro.C }}`,
+				"C": `{{ .macro.A }}`,
+			}, context)
+			assert.EqualError(t, err, "invalid argument for entity compiler: macros refer to each other in a cycle: A -> B -> C -> A")
+		})
+		t.Run("returns error when macro cannot be parsed", func(t *testing.T) {
+			comp := compiler.NewEngine()
+			_, err := comp.CompileMacros(map[string]string{"BROKEN": `{{ .DSTART`}, context)
+			assert.ErrorContains(t, err, "unable to parse content for BROKEN")
+		})
+	})
 }
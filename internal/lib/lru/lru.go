@@ -0,0 +1,101 @@
+package lru
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// Cache is a size bounded cache safe for concurrent use, the least recently used entry is
+// evicted when the cache is full. Entries older than the ttl are treated as missing
+type Cache[K comparable, V any] struct {
+	mu sync.Mutex
+
+	size int
+	ttl  time.Duration
+
+	order   *list.List
+	entries map[K]*list.Element
+
+	now func() time.Time
+}
+
+type entry[K comparable, V any] struct {
+	key     K
+	value   V
+	addedAt time.Time
+}
+
+// New returns a cache holding at most size entries, a zero ttl keeps the entries till they are evicted
+func New[K comparable, V any](size int, ttl time.Duration) *Cache[K, V] {
+	return &Cache[K, V]{
+		size:    size,
+		ttl:     ttl,
+		order:   list.New(),
+		entries: make(map[K]*list.Element),
+		now:     time.Now,
+	}
+}
+
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var value V
+	elem, ok := c.entries[key]
+	if !ok {
+		return value, false
+	}
+
+	e := elem.Value.(*entry[K, V])
+	if c.ttl > 0 && c.now().Sub(e.addedAt) > c.ttl {
+		c.removeElement(elem)
+		return value, false
+	}
+
+	c.order.MoveToFront(elem)
+	return e.value, true
+}
+
+func (c *Cache[K, V]) Add(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.size <= 0 {
+		return
+	}
+
+	if elem, ok := c.entries[key]; ok {
+		e := elem.Value.(*entry[K, V])
+		e.value = value
+		e.addedAt = c.now()
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&entry[K, V]{key: key, value: value, addedAt: c.now()})
+	if c.order.Len() > c.size {
+		c.removeElement(c.order.Back())
+	}
+}
+
+func (c *Cache[K, V]) Remove(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		c.removeElement(elem)
+	}
+}
+
+func (c *Cache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.order.Len()
+}
+
+func (c *Cache[K, V]) removeElement(elem *list.Element) {
+	c.order.Remove(elem)
+	delete(c.entries, elem.Value.(*entry[K, V]).key)
+}
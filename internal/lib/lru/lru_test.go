@@ -0,0 +1,80 @@
+package lru_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/internal/lib/lru"
+)
+
+func TestCache(t *testing.T) {
+	t.Run("Get", func(t *testing.T) {
+		t.Run("returns false when key is not present", func(t *testing.T) {
+			cache := lru.New[string, int](2, 0)
+
+			_, ok := cache.Get("a")
+			assert.False(t, ok)
+		})
+		t.Run("returns the added value", func(t *testing.T) {
+			cache := lru.New[string, int](2, 0)
+			cache.Add("a", 1)
+
+			value, ok := cache.Get("a")
+			assert.True(t, ok)
+			assert.Equal(t, 1, value)
+		})
+		t.Run("returns false when entry is older than ttl", func(t *testing.T) {
+			cache := lru.New[string, int](2, time.Millisecond)
+			cache.Add("a", 1)
+			time.Sleep(5 * time.Millisecond)
+
+			_, ok := cache.Get("a")
+			assert.False(t, ok)
+			assert.Equal(t, 0, cache.Len())
+		})
+	})
+	t.Run("Add", func(t *testing.T) {
+		t.Run("evicts the least recently used entry when full", func(t *testing.T) {
+			cache := lru.New[string, int](2, 0)
+			cache.Add("a", 1)
+			cache.Add("b", 2)
+			cache.Get("a")
+			cache.Add("c", 3)
+
+			_, ok := cache.Get("b")
+			assert.False(t, ok)
+			_, ok = cache.Get("a")
+			assert.True(t, ok)
+			_, ok = cache.Get("c")
+			assert.True(t, ok)
+		})
+		t.Run("replaces the value of existing key", func(t *testing.T) {
+			cache := lru.New[string, int](2, 0)
+			cache.Add("a", 1)
+			cache.Add("a", 2)
+
+			value, ok := cache.Get("a")
+			assert.True(t, ok)
+			assert.Equal(t, 2, value)
+			assert.Equal(t, 1, cache.Len())
+		})
+		t.Run("does not keep entries when size is zero", func(t *testing.T) {
+			cache := lru.New[string, int](0, 0)
+			cache.Add("a", 1)
+
+			assert.Equal(t, 0, cache.Len())
+		})
+	})
+	t.Run("Remove", func(t *testing.T) {
+		t.Run("removes the entry", func(t *testing.T) {
+			cache := lru.New[string, int](2, 0)
+			cache.Add("a", 1)
+			cache.Remove("a")
+
+			_, ok := cache.Get("a")
+			assert.False(t, ok)
+		})
+	})
+}
@@ -0,0 +1,98 @@
+package window
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/goto/optimus/internal/errors"
+	"github.com/goto/optimus/internal/lib/cron"
+)
+
+const (
+	AnomalyZeroLength  AnomalyType = "zero_length"
+	AnomalyNegative    AnomalyType = "negative_length"
+	AnomalyBackward    AnomalyType = "backward"
+	AnomalyOverlapping AnomalyType = "overlapping"
+
+	// maxSimulatedRuns caps the simulation for schedules running more often than hourly
+	maxSimulatedRuns = 24 * 366
+)
+
+type AnomalyType string
+
+type Anomaly struct {
+	Type          AnomalyType
+	ScheduledTime time.Time
+	Interval      Interval
+}
+
+func (a Anomaly) String() string {
+	return fmt.Sprintf("%s interval [%s, %s] for schedule time %s", a.Type,
+		a.Interval.Start.Format(time.RFC3339), a.Interval.End.Format(time.RFC3339), a.ScheduledTime.Format(time.RFC3339))
+}
+
+type SimulationReport struct {
+	SimulatedRuns int
+	Anomalies     []Anomaly
+}
+
+// Err returns error when the report has anomalies which make the window unusable,
+// overlapping intervals are expected for windows larger than the schedule interval hence are not considered
+func (r SimulationReport) Err() error {
+	me := errors.NewMultiError("window simulation anomalies")
+	for _, anomaly := range r.Anomalies {
+		if anomaly.Type == AnomalyOverlapping {
+			continue
+		}
+		me.Append(errors.InvalidArgument("Window", anomaly.String()))
+	}
+	return me.ToErr()
+}
+
+// Simulate computes the interval of the window for every schedule time within a year from start, in the
+// location of start, and reports zero length, backward moving, and partially overlapping intervals
+func Simulate(w Window, schedule string, start time.Time) (SimulationReport, error) {
+	jobCron, err := cron.ParseCronSchedule(schedule)
+	if err != nil {
+		return SimulationReport{}, errors.InvalidArgument("Window", "unable to parse schedule "+schedule)
+	}
+
+	report := SimulationReport{}
+	end := start.AddDate(1, 0, 0)
+
+	var previous *Interval
+	for scheduledTime := jobCron.Next(start.Add(-time.Second)); scheduledTime.Before(end); scheduledTime = jobCron.Next(scheduledTime) {
+		if report.SimulatedRuns >= maxSimulatedRuns {
+			break
+		}
+		report.SimulatedRuns++
+
+		interval, err := w.GetInterval(scheduledTime)
+		if err != nil {
+			return SimulationReport{}, err
+		}
+
+		if anomaly, ok := checkInterval(interval, previous); ok {
+			anomaly.ScheduledTime = scheduledTime
+			report.Anomalies = append(report.Anomalies, anomaly)
+		}
+		previous = &interval
+	}
+	return report, nil
+}
+
+func checkInterval(current Interval, previous *Interval) (Anomaly, bool) {
+	switch {
+	case current.End.Equal(current.Start):
+		return Anomaly{Type: AnomalyZeroLength, Interval: current}, true
+	case current.End.Before(current.Start):
+		return Anomaly{Type: AnomalyNegative, Interval: current}, true
+	case previous == nil:
+		return Anomaly{}, false
+	case current.Start.Before(previous.Start) || current.End.Before(previous.End):
+		return Anomaly{Type: AnomalyBackward, Interval: current}, true
+	case current.Start.Before(previous.End) && !current.Start.Equal(previous.Start):
+		return Anomaly{Type: AnomalyOverlapping, Interval: current}, true
+	}
+	return Anomaly{}, false
+}
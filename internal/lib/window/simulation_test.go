@@ -0,0 +1,57 @@
+package window_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/internal/lib/window"
+	"github.com/goto/optimus/internal/models"
+)
+
+func TestSimulate(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("returns error when schedule is not valid", func(t *testing.T) {
+		w1, _ := models.NewWindow(2, "d", "0", "24h")
+
+		_, err := window.Simulate(window.FromBaseWindow(w1), "* * *", start)
+		assert.ErrorContains(t, err, "unable to parse schedule")
+	})
+	t.Run("returns no anomaly for a daily window on daily schedule", func(t *testing.T) {
+		w1, _ := models.NewWindow(2, "d", "0", "24h")
+
+		report, err := window.Simulate(window.FromBaseWindow(w1), "0 0 * * *", start)
+		assert.NoError(t, err)
+		assert.Equal(t, 365, report.SimulatedRuns)
+		assert.Empty(t, report.Anomalies)
+		assert.NoError(t, report.Err())
+	})
+	t.Run("returns no anomaly for a monthly window across month lengths", func(t *testing.T) {
+		w1, _ := models.NewWindow(2, "M", "0", "1M")
+
+		report, err := window.Simulate(window.FromBaseWindow(w1), "0 0 1 * *", start)
+		assert.NoError(t, err)
+		assert.Equal(t, 12, report.SimulatedRuns)
+		assert.NoError(t, report.Err())
+	})
+	t.Run("reports zero length intervals for window without size", func(t *testing.T) {
+		w1, _ := models.NewWindow(2, "d", "0", "")
+
+		report, err := window.Simulate(window.FromBaseWindow(w1), "0 0 * * *", start)
+		assert.NoError(t, err)
+		assert.Len(t, report.Anomalies, 365)
+		assert.Equal(t, window.AnomalyZeroLength, report.Anomalies[0].Type)
+		assert.ErrorContains(t, report.Err(), "zero_length interval")
+	})
+	t.Run("reports overlapping intervals without failing the report", func(t *testing.T) {
+		w1, _ := models.NewWindow(2, "d", "0", "48h")
+
+		report, err := window.Simulate(window.FromBaseWindow(w1), "0 0 * * *", start)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, report.Anomalies)
+		assert.Equal(t, window.AnomalyOverlapping, report.Anomalies[0].Type)
+		assert.NoError(t, report.Err())
+	})
+}
@@ -1,6 +1,7 @@
 package window
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/goto/optimus/internal/errors"
@@ -42,6 +43,60 @@ func (w Window) GetInterval(referenceTime time.Time) (Interval, error) {
 	}, nil
 }
 
+// GetIntervals returns the disjoint intervals of the window ordered from the earliest, a repeated window has an
+// interval for every repetition while other windows have the single interval returned by GetInterval
+func (w Window) GetIntervals(referenceTime time.Time) ([]Interval, error) {
+	repeated, ok := w.window.(models.RepeatedWindow)
+	if w.schedule != nil || !ok {
+		interval, err := w.GetInterval(referenceTime)
+		if err != nil {
+			return nil, err
+		}
+		return []Interval{interval}, nil
+	}
+
+	scheduleTimes, err := repeated.GetScheduleTimes(referenceTime)
+	if err != nil {
+		return nil, err
+	}
+
+	base := FromBaseWindow(repeated.GetBaseWindow())
+	intervals := make([]Interval, len(scheduleTimes))
+	for i, scheduleTime := range scheduleTimes {
+		interval, err := base.GetInterval(scheduleTime)
+		if err != nil {
+			return nil, err
+		}
+		if i > 0 && interval.Start.Before(intervals[i-1].End) {
+			return nil, errors.InvalidArgument("Window", "intervals of the repeated window overlap, every should not be shorter than size")
+		}
+		intervals[i] = interval
+	}
+	return intervals, nil
+}
+
+type formattedInterval struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// FormatIntervals returns the intervals as a JSON list of start and end in RFC3339, in the given location
+func FormatIntervals(intervals []Interval, location *time.Location) (string, error) {
+	formatted := make([]formattedInterval, len(intervals))
+	for i, interval := range intervals {
+		formatted[i] = formattedInterval{
+			Start: interval.Start.In(location).Format(time.RFC3339),
+			End:   interval.End.In(location).Format(time.RFC3339),
+		}
+	}
+
+	raw, err := json.Marshal(formatted)
+	if err != nil {
+		return "", errors.InternalError("Window", "unable to format intervals", err)
+	}
+	return string(raw), nil
+}
+
 func FromSchedule(schedule string) (Window, error) {
 	jobCron, err := cron.ParseCronSchedule(schedule)
 	if err != nil {
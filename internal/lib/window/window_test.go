@@ -59,6 +59,16 @@ func TestWindow(t *testing.T) {
 			assert.Equal(t, "2023-08-31T00:00:00Z", interval.Start.Format(time.RFC3339))
 			assert.Equal(t, "2023-09-01T00:00:00Z", interval.End.Format(time.RFC3339))
 		})
+		t.Run("returns the interval for calendar window", func(t *testing.T) {
+			w1, _ := models.NewWindow(2, "M", "-1bd+1d", "1d")
+			baseWindow := window.FromBaseWindow(w1)
+
+			sept1 := time.Date(2023, 9, 1, 1, 0, 0, 0, time.UTC)
+			interval, err := baseWindow.GetInterval(sept1)
+			assert.NoError(t, err)
+			assert.Equal(t, "2023-08-31T00:00:00Z", interval.Start.Format(time.RFC3339))
+			assert.Equal(t, "2023-09-01T00:00:00Z", interval.End.Format(time.RFC3339))
+		})
 		t.Run("should return zero and error if error parsing schedule", func(t *testing.T) {
 			schedule := "-1 * * * *"
 
@@ -117,4 +127,53 @@ func TestWindow(t *testing.T) {
 			assert.NoError(t, actualError)
 		})
 	})
+
+	t.Run("GetIntervals", func(t *testing.T) {
+		referenceTime := time.Date(2023, 1, 22, 2, 0, 0, 0, time.UTC)
+
+		t.Run("should return single interval for window without repetition", func(t *testing.T) {
+			baseWindow, err := models.NewWindow(2, "d", "0", "24h")
+			assert.NoError(t, err)
+
+			intervals, err := window.FromBaseWindow(baseWindow).GetIntervals(referenceTime)
+			assert.NoError(t, err)
+			assert.Len(t, intervals, 1)
+			assert.Equal(t, "2023-01-21T00:00:00Z", intervals[0].Start.Format(time.RFC3339))
+			assert.Equal(t, "2023-01-22T00:00:00Z", intervals[0].End.Format(time.RFC3339))
+		})
+		t.Run("should return the intervals of repeated window ordered from the earliest", func(t *testing.T) {
+			baseWindow, err := models.NewWindow(2, "d", "0", "24h;repeat=3;every=1w")
+			assert.NoError(t, err)
+
+			intervals, err := window.FromBaseWindow(baseWindow).GetIntervals(referenceTime)
+			assert.NoError(t, err)
+			assert.Len(t, intervals, 3)
+			assert.Equal(t, "2023-01-07T00:00:00Z", intervals[0].Start.Format(time.RFC3339))
+			assert.Equal(t, "2023-01-08T00:00:00Z", intervals[0].End.Format(time.RFC3339))
+			assert.Equal(t, "2023-01-21T00:00:00Z", intervals[2].Start.Format(time.RFC3339))
+			assert.Equal(t, "2023-01-22T00:00:00Z", intervals[2].End.Format(time.RFC3339))
+		})
+		t.Run("should return error when the intervals of repeated window overlap", func(t *testing.T) {
+			baseWindow, err := models.NewWindow(2, "d", "0", "48h;repeat=3;every=1d")
+			assert.NoError(t, err)
+
+			intervals, err := window.FromBaseWindow(baseWindow).GetIntervals(referenceTime)
+			assert.Nil(t, intervals)
+			assert.ErrorContains(t, err, "intervals of the repeated window overlap")
+		})
+	})
+
+	t.Run("FormatIntervals", func(t *testing.T) {
+		t.Run("should format the intervals in the location", func(t *testing.T) {
+			location, err := time.LoadLocation("Asia/Jakarta")
+			assert.NoError(t, err)
+
+			intervals := []window.Interval{
+				{Start: time.Date(2023, 1, 7, 0, 0, 0, 0, time.UTC), End: time.Date(2023, 1, 8, 0, 0, 0, 0, time.UTC)},
+			}
+			formatted, err := window.FormatIntervals(intervals, location)
+			assert.NoError(t, err)
+			assert.JSONEq(t, `[{"start": "2023-01-07T07:00:00+07:00", "end": "2023-01-08T07:00:00+07:00"}]`, formatted)
+		})
+	})
 }
@@ -0,0 +1,88 @@
+package logging
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/goto/optimus/internal/errors"
+)
+
+const EntityLogLevel = "log_level"
+
+type NamespaceLevel struct {
+	ProjectName   string
+	NamespaceName string
+	Level         string
+}
+
+// LevelRegistry keeps the log levels overridden at runtime for namespaces, these are lost on restart
+type LevelRegistry struct {
+	mu     sync.RWMutex
+	levels map[namespaceKey]logrus.Level
+}
+
+type namespaceKey struct {
+	projectName   string
+	namespaceName string
+}
+
+func (r *LevelRegistry) Set(projectName, namespaceName, level string) error {
+	if projectName == "" || namespaceName == "" {
+		return errors.InvalidArgument(EntityLogLevel, "project and namespace name are required")
+	}
+	parsed, err := logrus.ParseLevel(level)
+	if err != nil {
+		return errors.InvalidArgument(EntityLogLevel, "invalid log level "+level)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.levels[namespaceKey{projectName: projectName, namespaceName: namespaceName}] = parsed
+	return nil
+}
+
+func (r *LevelRegistry) Unset(projectName, namespaceName string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.levels, namespaceKey{projectName: projectName, namespaceName: namespaceName})
+}
+
+func (r *LevelRegistry) Get(projectName, namespaceName string) (logrus.Level, bool) {
+	if projectName == "" || namespaceName == "" {
+		return 0, false
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	level, ok := r.levels[namespaceKey{projectName: projectName, namespaceName: namespaceName}]
+	return level, ok
+}
+
+func (r *LevelRegistry) GetAll() []NamespaceLevel {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	levels := make([]NamespaceLevel, 0, len(r.levels))
+	for key, level := range r.levels {
+		levels = append(levels, NamespaceLevel{
+			ProjectName:   key.projectName,
+			NamespaceName: key.namespaceName,
+			Level:         level.String(),
+		})
+	}
+	sort.Slice(levels, func(i, j int) bool {
+		if levels[i].ProjectName != levels[j].ProjectName {
+			return levels[i].ProjectName < levels[j].ProjectName
+		}
+		return levels[i].NamespaceName < levels[j].NamespaceName
+	})
+	return levels
+}
+
+func NewLevelRegistry() *LevelRegistry {
+	return &LevelRegistry{
+		levels: map[namespaceKey]logrus.Level{},
+	}
+}
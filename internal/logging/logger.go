@@ -0,0 +1,133 @@
+package logging
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/goto/salt/log"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	FieldProject   = "project"
+	FieldNamespace = "namespace"
+	FieldJob       = "job"
+)
+
+type Fields map[string]string
+
+// FieldLogger is implemented by the loggers able to attach structured fields to every line they log
+type FieldLogger interface {
+	log.Logger
+	With(fields Fields) log.Logger
+}
+
+// With returns the logger with the given fields attached, loggers not supporting fields are returned as is
+func With(l log.Logger, fields Fields) log.Logger {
+	fl, ok := l.(FieldLogger)
+	if !ok {
+		return l
+	}
+	return fl.With(fields)
+}
+
+// ForTenant attaches the project field, along with the namespace and job when they are not empty
+func ForTenant(l log.Logger, projectName, namespaceName, jobName string) log.Logger {
+	fields := Fields{
+		FieldProject: projectName,
+	}
+	if namespaceName != "" {
+		fields[FieldNamespace] = namespaceName
+	}
+	if jobName != "" {
+		fields[FieldJob] = jobName
+	}
+	return With(l, fields)
+}
+
+// Logger logs through logrus with the fields attached, lines of a namespace are filtered
+// with the level overridden for it in the registry, falling back to the base level
+type Logger struct {
+	entry  *logrus.Entry
+	level  logrus.Level
+	levels *LevelRegistry
+}
+
+func (l *Logger) Debug(msg string, args ...interface{}) {
+	l.log(logrus.DebugLevel, msg, args...)
+}
+
+func (l *Logger) Info(msg string, args ...interface{}) {
+	l.log(logrus.InfoLevel, msg, args...)
+}
+
+func (l *Logger) Warn(msg string, args ...interface{}) {
+	l.log(logrus.WarnLevel, msg, args...)
+}
+
+func (l *Logger) Error(msg string, args ...interface{}) {
+	l.log(logrus.ErrorLevel, msg, args...)
+}
+
+func (l *Logger) Fatal(msg string, args ...interface{}) {
+	l.entry.Fatal(fmt.Sprintf(msg, args...))
+}
+
+func (l *Logger) Level() string {
+	return l.level.String()
+}
+
+func (l *Logger) Writer() io.Writer {
+	return l.entry.Writer()
+}
+
+func (l *Logger) With(fields Fields) log.Logger {
+	logrusFields := logrus.Fields{}
+	for key, value := range fields {
+		logrusFields[key] = value
+	}
+	return &Logger{
+		entry:  l.entry.WithFields(logrusFields),
+		level:  l.level,
+		levels: l.levels,
+	}
+}
+
+func (l *Logger) log(level logrus.Level, msg string, args ...interface{}) {
+	if level > l.effectiveLevel() {
+		return
+	}
+	l.entry.Log(level, fmt.Sprintf(msg, args...))
+}
+
+func (l *Logger) effectiveLevel() logrus.Level {
+	if l.levels == nil {
+		return l.level
+	}
+	projectName, _ := l.entry.Data[FieldProject].(string)
+	namespaceName, _ := l.entry.Data[FieldNamespace].(string)
+	if level, ok := l.levels.Get(projectName, namespaceName); ok {
+		return level
+	}
+	return l.level
+}
+
+// NewLogger creates a logger filtering with the given base level, the levels registry
+// can be nil when levels are not overridden at runtime
+func NewLogger(level string, writer io.Writer, levels *LevelRegistry) (*Logger, error) {
+	baseLevel, err := logrus.ParseLevel(level)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := logrus.New()
+	logger.SetOutput(writer)
+	// filtering is done by the logger, so that namespaces can be more verbose than the base level
+	logger.SetLevel(logrus.DebugLevel)
+
+	return &Logger{
+		entry:  logrus.NewEntry(logger),
+		level:  baseLevel,
+		levels: levels,
+	}, nil
+}
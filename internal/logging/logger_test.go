@@ -0,0 +1,114 @@
+package logging_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/internal/logging"
+)
+
+func TestLogger(t *testing.T) {
+	t.Run("NewLogger", func(t *testing.T) {
+		t.Run("returns error when level is invalid", func(t *testing.T) {
+			logger, err := logging.NewLogger("loud", &bytes.Buffer{}, nil)
+
+			assert.Nil(t, logger)
+			assert.Error(t, err)
+		})
+	})
+	t.Run("With", func(t *testing.T) {
+		t.Run("attaches the fields to every line", func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			logger, err := logging.NewLogger("info", buf, nil)
+			assert.NoError(t, err)
+
+			logging.ForTenant(logger, "proj1", "ns1", "job1").Info("job %s is deployed", "job1")
+
+			assert.Contains(t, buf.String(), "job job1 is deployed")
+			assert.Contains(t, buf.String(), "project=proj1")
+			assert.Contains(t, buf.String(), "namespace=ns1")
+			assert.Contains(t, buf.String(), "job=job1")
+		})
+		t.Run("leaves out the namespace and job when they are empty", func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			logger, err := logging.NewLogger("info", buf, nil)
+			assert.NoError(t, err)
+
+			logging.ForTenant(logger, "proj1", "", "").Info("project secret is updated")
+
+			assert.Contains(t, buf.String(), "project=proj1")
+			assert.NotContains(t, buf.String(), "namespace=")
+			assert.NotContains(t, buf.String(), "job=")
+		})
+		t.Run("returns the logger as is when fields are not supported", func(t *testing.T) {
+			logger := log.NewNoop()
+
+			assert.Equal(t, logger, logging.With(logger, logging.Fields{logging.FieldProject: "proj1"}))
+		})
+	})
+	t.Run("Level", func(t *testing.T) {
+		t.Run("filters lines below the base level", func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			logger, err := logging.NewLogger("info", buf, logging.NewLevelRegistry())
+			assert.NoError(t, err)
+
+			logger.Debug("debug line")
+			logging.ForTenant(logger, "proj1", "ns1", "").Debug("debug line of namespace")
+
+			assert.Empty(t, buf.String())
+			assert.Equal(t, "info", logger.Level())
+		})
+		t.Run("uses the level overridden for the namespace", func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			levels := logging.NewLevelRegistry()
+			assert.NoError(t, levels.Set("proj1", "ns1", "debug"))
+			assert.NoError(t, levels.Set("proj1", "ns2", "error"))
+			logger, err := logging.NewLogger("info", buf, levels)
+			assert.NoError(t, err)
+
+			logging.ForTenant(logger, "proj1", "ns1", "job1").Debug("debug line of ns1")
+			logging.ForTenant(logger, "proj1", "ns2", "job1").Info("info line of ns2")
+			logger.Debug("debug line without namespace")
+
+			assert.Contains(t, buf.String(), "debug line of ns1")
+			assert.NotContains(t, buf.String(), "info line of ns2")
+			assert.NotContains(t, buf.String(), "debug line without namespace")
+		})
+	})
+}
+
+func TestLevelRegistry(t *testing.T) {
+	t.Run("Set", func(t *testing.T) {
+		t.Run("returns error when namespace is empty", func(t *testing.T) {
+			levels := logging.NewLevelRegistry()
+
+			err := levels.Set("proj1", "", "debug")
+
+			assert.ErrorContains(t, err, "project and namespace name are required")
+		})
+		t.Run("returns error when level is invalid", func(t *testing.T) {
+			levels := logging.NewLevelRegistry()
+
+			err := levels.Set("proj1", "ns1", "loud")
+
+			assert.ErrorContains(t, err, "invalid log level loud")
+		})
+	})
+	t.Run("GetAll", func(t *testing.T) {
+		t.Run("returns the levels sorted by project and namespace", func(t *testing.T) {
+			levels := logging.NewLevelRegistry()
+			assert.NoError(t, levels.Set("proj2", "ns1", "debug"))
+			assert.NoError(t, levels.Set("proj1", "ns2", "warn"))
+			assert.NoError(t, levels.Set("proj1", "ns1", "error"))
+			levels.Unset("proj2", "ns1")
+
+			assert.Equal(t, []logging.NamespaceLevel{
+				{ProjectName: "proj1", NamespaceName: "ns1", Level: "error"},
+				{ProjectName: "proj1", NamespaceName: "ns2", Level: "warning"},
+			}, levels.GetAll())
+		})
+	})
+}
@@ -6,13 +6,18 @@ import (
 	"fmt"
 	"sort"
 
+	"github.com/hashicorp/go-version"
+
 	"github.com/goto/optimus/sdk/plugin"
 )
 
 var ErrUnsupportedPlugin = errors.New("unsupported plugin requested, make sure its correctly installed")
 
+// PluginRepository holds every version of the plugins, the oldest version of a plugin is used
+// for the jobs not pinning the version of their task, so a new version can be adopted gradually
 type PluginRepository struct {
 	data       map[string]*plugin.Plugin
+	versions   map[string]map[string]*plugin.Plugin
 	sortedKeys []string
 }
 
@@ -35,15 +40,38 @@ func (s *PluginRepository) GetByName(name string) (*plugin.Plugin, error) {
 	return nil, fmt.Errorf("%s: %w", name, ErrUnsupportedPlugin)
 }
 
+// GetByNameAndVersion returns the version of the plugin, empty version returns the default one
+func (s *PluginRepository) GetByNameAndVersion(name, version string) (*plugin.Plugin, error) {
+	if version == "" {
+		return s.GetByName(name)
+	}
+	if unit, ok := s.versions[name][version]; ok {
+		return unit, nil
+	}
+	return nil, fmt.Errorf("%s@%s: %w", name, version, ErrUnsupportedPlugin)
+}
+
+// GetAll returns every version of the plugins
 func (s *PluginRepository) GetAll() []*plugin.Plugin {
 	var list []*plugin.Plugin
 	s.lazySortPluginKeys() // sorts keys if not sorted
 	for _, pluginName := range s.sortedKeys {
-		list = append(list, s.data[pluginName])
+		list = append(list, s.sortedVersions(pluginName)...)
 	}
 	return list
 }
 
+func (s *PluginRepository) sortedVersions(name string) []*plugin.Plugin {
+	list := make([]*plugin.Plugin, 0, len(s.versions[name]))
+	for _, unit := range s.versions[name] {
+		list = append(list, unit)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return isOlderVersion(list[i].Info().PluginVersion, list[j].Info().PluginVersion)
+	})
+	return list
+}
+
 func (s *PluginRepository) GetTasks() []*plugin.Plugin {
 	var list []*plugin.Plugin
 	s.lazySortPluginKeys() // sorts keys if not sorted
@@ -74,12 +102,19 @@ func (s *PluginRepository) AddYaml(yamlMod plugin.YamlMod) error {
 		return err
 	}
 
-	if _, ok := s.data[info.Name]; ok {
+	if _, ok := s.versions[info.Name][info.PluginVersion]; ok {
 		// duplicated yaml plugin
-		return fmt.Errorf("plugin name already in use %s", info.Name)
+		return fmt.Errorf("plugin name already in use %s@%s", info.Name, info.PluginVersion)
 	}
 
-	s.data[info.Name] = &plugin.Plugin{YamlMod: yamlMod}
+	unit := &plugin.Plugin{YamlMod: yamlMod}
+	if s.versions[info.Name] == nil {
+		s.versions[info.Name] = map[string]*plugin.Plugin{}
+	}
+	s.versions[info.Name][info.PluginVersion] = unit
+	if current, ok := s.data[info.Name]; !ok || isOlderVersion(info.PluginVersion, current.Info().PluginVersion) {
+		s.data[info.Name] = unit
+	}
 	return nil
 }
 
@@ -97,10 +132,26 @@ func (s *PluginRepository) AddBinary(drMod plugin.DependencyResolverMod) error {
 		return fmt.Errorf("plugin name already in use %s", name)
 	}
 
-	s.data[name].DependencyMod = drMod
+	// binary plugins are not versioned, the mod serves every version of the plugin
+	for _, unit := range s.versions[name] {
+		unit.DependencyMod = drMod
+	}
 	return nil
 }
 
+// isOlderVersion compares the versions semantically, falling back to lexical order for non semantic versions
+func isOlderVersion(v1, v2 string) bool {
+	semver1, err1 := version.NewVersion(v1)
+	semver2, err2 := version.NewVersion(v2)
+	if err1 != nil || err2 != nil {
+		return v1 < v2
+	}
+	return semver1.LessThan(semver2)
+}
+
 func NewPluginRepository() *PluginRepository {
-	return &PluginRepository{data: map[string]*plugin.Plugin{}}
+	return &PluginRepository{
+		data:     map[string]*plugin.Plugin{},
+		versions: map[string]map[string]*plugin.Plugin{},
+	}
 }
@@ -1,6 +1,7 @@
 package models_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -44,4 +45,65 @@ func TestPluginModels(t *testing.T) {
 			assert.Equal(t, list[0].Info().Name, "a")
 		})
 	})
+	t.Run("PluginRegistry with versions", func(t *testing.T) {
+		repo := models.NewPluginRepository()
+		for _, pluginVersion := range []string{"1.10.0", "1.2.0", "1.9.1"} {
+			assert.NoError(t, repo.AddYaml(&versionedYamlMod{MockYamlMod: mockPlugin.MockYamlMod{Name: "bq2bq", Type: plugin.TypeTask.String()}, version: pluginVersion}))
+		}
+		dependencyMod := &namedDependencyMod{name: "bq2bq"}
+		assert.NoError(t, repo.AddBinary(dependencyMod))
+
+		t.Run("should not allow the same version of a plugin twice", func(t *testing.T) {
+			err := repo.AddYaml(&versionedYamlMod{MockYamlMod: mockPlugin.MockYamlMod{Name: "bq2bq", Type: plugin.TypeTask.String()}, version: "1.2.0"})
+			assert.ErrorContains(t, err, "plugin name already in use bq2bq@1.2.0")
+		})
+		t.Run("should return the oldest version by default", func(t *testing.T) {
+			defaultPlugin, err := repo.GetByName("bq2bq")
+			assert.NoError(t, err)
+			assert.Equal(t, "1.2.0", defaultPlugin.Info().PluginVersion)
+
+			defaultPlugin, err = repo.GetByNameAndVersion("bq2bq", "")
+			assert.NoError(t, err)
+			assert.Equal(t, "1.2.0", defaultPlugin.Info().PluginVersion)
+		})
+		t.Run("should return the requested version", func(t *testing.T) {
+			pinnedPlugin, err := repo.GetByNameAndVersion("bq2bq", "1.10.0")
+			assert.NoError(t, err)
+			assert.Equal(t, "1.10.0", pinnedPlugin.Info().PluginVersion)
+			assert.Equal(t, dependencyMod, pinnedPlugin.DependencyMod)
+		})
+		t.Run("should return error when the version is not installed", func(t *testing.T) {
+			_, err := repo.GetByNameAndVersion("bq2bq", "2.0.0")
+			assert.ErrorIs(t, err, models.ErrUnsupportedPlugin)
+		})
+		t.Run("should return every version sorted", func(t *testing.T) {
+			list := repo.GetAll()
+			assert.Len(t, list, 3)
+			assert.Equal(t, "1.2.0", list[0].Info().PluginVersion)
+			assert.Equal(t, "1.9.1", list[1].Info().PluginVersion)
+			assert.Equal(t, "1.10.0", list[2].Info().PluginVersion)
+
+			assert.Len(t, repo.GetTasks(), 1)
+		})
+	})
+}
+
+type versionedYamlMod struct {
+	mockPlugin.MockYamlMod
+	version string
+}
+
+func (p *versionedYamlMod) PluginInfo() *plugin.Info {
+	info := p.MockYamlMod.PluginInfo()
+	info.PluginVersion = p.version
+	return info
+}
+
+type namedDependencyMod struct {
+	mockPlugin.MockDependencyMod
+	name string
+}
+
+func (d *namedDependencyMod) GetName(context.Context) (string, error) {
+	return d.name, nil
 }
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -19,10 +20,28 @@ type Window interface {
 }
 
 func NewWindow(version int, truncateTo, offset, size string) (Window, error) {
+	if baseSize, repetition, ok := strings.Cut(size, repeatSeparator); ok {
+		if version != 2 { //nolint:gomnd
+			return nil, errors.New("window with repetition requires version 2")
+		}
+		base, err := NewWindow(version, truncateTo, offset, baseSize)
+		if err != nil {
+			return nil, err
+		}
+		return windowRepeat{Window: base, size: size, repetition: repetition}, nil
+	}
+
+	calendar := isCalendarWindow(truncateTo, offset, size)
 	if version == 1 {
+		if calendar {
+			return nil, errors.New("window with quarters or business days requires version 2")
+		}
 		return windowV1{truncateTo: truncateTo, offset: offset, size: size}, nil
 	}
 	if version == 2 { // nolint:gomnd
+		if calendar {
+			return windowCalendar{truncateTo: truncateTo, offset: offset, size: size}, nil
+		}
 		return windowV2{truncateTo: truncateTo, offset: offset, size: size}, nil
 	}
 	return nil, fmt.Errorf("window version [%d] is not recognized", version)
@@ -0,0 +1,92 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	anchorMonthEnd      = "ME"
+	anchorWeekdayPrefix = "M:"
+
+	daysInWeek        = 7
+	maxWeekdayInMonth = 4
+	weekdayNameLength = 3
+)
+
+var weekdayNames = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// calendarAnchor is a day of the month to truncate the window to, either the last day of the month
+// or the nth weekday of the month, where a negative nth counts from the end of the month
+type calendarAnchor struct {
+	monthEnd bool
+	nth      int
+	weekday  time.Weekday
+}
+
+func isCalendarAnchor(truncateTo string) bool {
+	return truncateTo == anchorMonthEnd || strings.HasPrefix(truncateTo, anchorWeekdayPrefix)
+}
+
+// calendarAnchorFrom parses the anchor from truncate_to, the supported values are ME for the last day of
+// the month and M:<nth><weekday> for the nth weekday of the month, like M:2mon or M:-1fri
+func calendarAnchorFrom(truncateTo string) (calendarAnchor, error) {
+	if truncateTo == anchorMonthEnd {
+		return calendarAnchor{monthEnd: true}, nil
+	}
+
+	expr := strings.TrimPrefix(truncateTo, anchorWeekdayPrefix)
+	if expr == truncateTo || len(expr) <= weekdayNameLength {
+		return calendarAnchor{}, fmt.Errorf("invalid calendar anchor %s", truncateTo)
+	}
+
+	nthExpr, weekdayExpr := expr[:len(expr)-weekdayNameLength], expr[len(expr)-weekdayNameLength:]
+	weekday, ok := weekdayNames[weekdayExpr]
+	if !ok {
+		return calendarAnchor{}, fmt.Errorf("invalid weekday %s in calendar anchor %s", weekdayExpr, truncateTo)
+	}
+	nth, err := strconv.Atoi(nthExpr)
+	if err != nil || nth == 0 || nth > maxWeekdayInMonth || nth < -maxWeekdayInMonth {
+		return calendarAnchor{}, fmt.Errorf("invalid occurrence %s in calendar anchor %s, provide one of 1 to 4 or -1 to -4", nthExpr, truncateTo)
+	}
+	return calendarAnchor{nth: nth, weekday: weekday}, nil
+}
+
+// in returns the anchor of the given month, months out of range are normalized the way time.Date does
+func (a calendarAnchor) in(year int, month time.Month) time.Time {
+	firstDay := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	lastDay := firstDay.AddDate(0, 1, -1)
+	if a.monthEnd {
+		return lastDay
+	}
+	if a.nth > 0 {
+		days := (int(a.weekday) - int(firstDay.Weekday()) + daysInWeek) % daysInWeek
+		return firstDay.AddDate(0, 0, days+daysInWeek*(a.nth-1))
+	}
+	days := (int(lastDay.Weekday()) - int(a.weekday) + daysInWeek) % daysInWeek
+	return lastDay.AddDate(0, 0, -days+daysInWeek*(a.nth+1))
+}
+
+// latest returns the last anchor at or before the given time
+func (a calendarAnchor) latest(t time.Time) time.Time {
+	anchor := a.in(t.Year(), t.Month())
+	if anchor.After(t) {
+		return a.in(t.Year(), t.Month()-1)
+	}
+	return anchor
+}
+
+// shift moves the anchor by the given months, keeping it on the same calendar point of the target month
+func (a calendarAnchor) shift(anchor time.Time, months int) time.Time {
+	return a.in(anchor.Year(), anchor.Month()+time.Month(months))
+}
@@ -0,0 +1,213 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/goto/optimus/internal/utils"
+)
+
+const (
+	truncateBusinessDay = "bd"
+	truncateQuarter     = "Q"
+
+	unitBusinessDay = "bd"
+	unitQuarter     = "Q"
+
+	monthsInQuarter = 3
+)
+
+var (
+	calendarTruncateOptions = []string{"h", "d", "w", "M", truncateQuarter, truncateBusinessDay}
+
+	calendarExpressionPattern = regexp.MustCompile(`^([+-]?\d+(bd|Q|M|w|d|h|m|s))+$`)
+	calendarTermPattern       = regexp.MustCompile(`([+-]?\d+)(bd|Q|M|w|d|h|m|s)`)
+)
+
+// windowCalendar is a version 2 window which understands quarters and business days, it is used when the
+// window truncates to quarter or business day, or its offset or size is expressed in quarters or business days
+type windowCalendar struct {
+	truncateTo string
+	offset     string
+	size       string
+}
+
+// isCalendarWindow tells whether the window needs the calendar aware computation
+func isCalendarWindow(truncateTo, offset, size string) bool {
+	if truncateTo == truncateQuarter || truncateTo == truncateBusinessDay {
+		return true
+	}
+	for _, expr := range []string{offset, size} {
+		if strings.Contains(expr, unitBusinessDay) || strings.Contains(expr, unitQuarter) {
+			return true
+		}
+	}
+	return false
+}
+
+// calendarTerm is a single step of a calendar expression, like -1bd or 2Q
+type calendarTerm struct {
+	value int
+	unit  string
+}
+
+// calendarTermsFrom parses the calendar expression, which is a sequence of terms applied in order, like -1M+2bd.
+// The units are bd for business days, Q for quarters, M for months, w for weeks, d for days, h, m and s
+func calendarTermsFrom(expr string) ([]calendarTerm, error) {
+	if expr == "" || expr == "0" {
+		return nil, nil
+	}
+	if !calendarExpressionPattern.MatchString(expr) {
+		return nil, fmt.Errorf("invalid calendar expression %s, use terms of bd, Q, M, w, d, h, m or s like -1M+2bd", expr)
+	}
+
+	matches := calendarTermPattern.FindAllStringSubmatch(expr, -1)
+	terms := make([]calendarTerm, len(matches))
+	for i, match := range matches {
+		value, err := strconv.Atoi(match[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid value %s in calendar expression %s", match[1], expr)
+		}
+		terms[i] = calendarTerm{value: value, unit: match[2]}
+	}
+	return terms, nil
+}
+
+// apply moves the time by the term, the direction of the term is reversed on negative sign
+func (c calendarTerm) apply(t time.Time, sign int) time.Time {
+	value := c.value * sign
+	switch c.unit {
+	case unitBusinessDay:
+		return addBusinessDays(t, value)
+	case unitQuarter:
+		return t.AddDate(0, value*monthsInQuarter, 0)
+	case "M":
+		return t.AddDate(0, value, 0)
+	case "w":
+		return t.AddDate(0, 0, value*daysInWeek)
+	case "d":
+		return t.AddDate(0, 0, value)
+	case "h":
+		return t.Add(time.Duration(value) * time.Hour)
+	case "m":
+		return t.Add(time.Duration(value) * time.Minute)
+	default:
+		return t.Add(time.Duration(value) * time.Second)
+	}
+}
+
+func isBusinessDay(t time.Time) bool {
+	return t.Weekday() != time.Saturday && t.Weekday() != time.Sunday
+}
+
+// addBusinessDays moves the time by the given business days, skipping saturdays and sundays
+func addBusinessDays(t time.Time, days int) time.Time {
+	step := 1
+	if days < 0 {
+		step, days = -1, -days
+	}
+	for days > 0 {
+		t = t.AddDate(0, 0, step)
+		if isBusinessDay(t) {
+			days--
+		}
+	}
+	return t
+}
+
+func (windowCalendar) GetVersion() int {
+	return 2 //nolint:gomnd
+}
+
+func (w windowCalendar) Validate() error {
+	if w.truncateTo != "" && !utils.ContainsString(calendarTruncateOptions, w.truncateTo) {
+		return fmt.Errorf("error validating truncate_to: invalid option provided, provide one of: %v", calendarTruncateOptions)
+	}
+	if _, err := calendarTermsFrom(w.offset); err != nil {
+		return fmt.Errorf("error validating offset: %w", err)
+	}
+	terms, err := calendarTermsFrom(w.size)
+	if err != nil {
+		return fmt.Errorf("error validating size: %w", err)
+	}
+	for _, term := range terms {
+		if term.value < 0 {
+			return errors.New("error validating size: size cannot be negative")
+		}
+	}
+	return nil
+}
+
+func (w windowCalendar) GetStartTime(scheduleTime time.Time) (time.Time, error) {
+	endTime, err := w.GetEndTime(scheduleTime)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	terms, err := calendarTermsFrom(w.size)
+	if err != nil {
+		return time.Time{}, err
+	}
+	startTime := endTime
+	for _, term := range terms {
+		startTime = term.apply(startTime, -1)
+	}
+	return startTime, nil
+}
+
+func (w windowCalendar) GetEndTime(scheduleTime time.Time) (time.Time, error) {
+	if err := w.Validate(); err != nil {
+		return time.Time{}, err
+	}
+
+	terms, err := calendarTermsFrom(w.offset)
+	if err != nil {
+		return time.Time{}, err
+	}
+	endTime := w.truncateTime(scheduleTime.UTC())
+	for _, term := range terms {
+		endTime = term.apply(endTime, 1)
+	}
+	return endTime, nil
+}
+
+func (w windowCalendar) GetTruncateTo() string {
+	return w.truncateTo
+}
+
+func (w windowCalendar) GetOffset() string {
+	return w.offset
+}
+
+func (w windowCalendar) GetSize() string {
+	return w.size
+}
+
+func (w windowCalendar) truncateTime(scheduleTime time.Time) time.Time {
+	startOfDay := time.Date(scheduleTime.Year(), scheduleTime.Month(), scheduleTime.Day(), 0, 0, 0, 0, time.UTC)
+	switch w.truncateTo {
+	case "h":
+		return scheduleTime.Truncate(time.Hour)
+	case "d":
+		return startOfDay
+	case "w":
+		// weeks start on monday
+		daysSinceMonday := (int(scheduleTime.Weekday()) + daysInWeek - int(time.Monday)) % daysInWeek
+		return startOfDay.AddDate(0, 0, -daysSinceMonday)
+	case "M":
+		return time.Date(scheduleTime.Year(), scheduleTime.Month(), 1, 0, 0, 0, 0, time.UTC)
+	case truncateQuarter:
+		quarterMonth := time.Month((int(scheduleTime.Month())-1)/monthsInQuarter*monthsInQuarter + 1)
+		return time.Date(scheduleTime.Year(), quarterMonth, 1, 0, 0, 0, 0, time.UTC)
+	case truncateBusinessDay:
+		for !isBusinessDay(startOfDay) {
+			startOfDay = startOfDay.AddDate(0, 0, -1)
+		}
+		return startOfDay
+	}
+	return scheduleTime
+}
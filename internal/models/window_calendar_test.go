@@ -0,0 +1,113 @@
+package models_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/internal/models"
+)
+
+func TestWindowCalendar(t *testing.T) {
+	t.Run("Validate", func(t *testing.T) {
+		t.Run("should not throw error for valid calendar windows", func(t *testing.T) {
+			validConfigs := [][3]string{
+				{"Q", "0", "1Q"},
+				{"bd", "", "1d"},
+				{"M", "-1bd+1d", "1d"},
+				{"d", "-1Q", "5bd"},
+				{"", "2bd12h", "1Q1M"},
+			}
+			for _, config := range validConfigs {
+				window, err := models.NewWindow(2, config[0], config[1], config[2])
+				assert.NoError(t, err)
+				assert.NoError(t, window.Validate(), config)
+			}
+		})
+		t.Run("should throw error for invalid calendar windows", func(t *testing.T) {
+			inValidConfigs := [][3]string{
+				{"ME", "0", "1Q"},
+				{"Q", "1x", "1Q"},
+				{"Q", "bd", "1Q"},
+				{"Q", "0", "-1Q"},
+				{"d", "0", "1Q-1bd"},
+			}
+			for _, config := range inValidConfigs {
+				window, err := models.NewWindow(2, config[0], config[1], config[2])
+				assert.NoError(t, err)
+				assert.Error(t, window.Validate(), config)
+			}
+		})
+	})
+	t.Run("GetTimeRange", func(t *testing.T) {
+		cases := []struct {
+			Scenario          string
+			ScheduleTime      time.Time
+			Size              string
+			Offset            string
+			TruncateTo        string
+			ExpectedStartTime time.Time
+			ExpectedEndTime   time.Time
+		}{
+			{
+				Scenario:          "should cover the previous quarter on truncate to quarter",
+				ScheduleTime:      time.Date(2024, 5, 10, 2, 10, 10, 10, time.UTC),
+				Size:              "1Q",
+				Offset:            "0",
+				TruncateTo:        "Q",
+				ExpectedStartTime: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				ExpectedEndTime:   time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+			},
+			{
+				Scenario:          "should shift window by quarters on quarter offset",
+				ScheduleTime:      time.Date(2024, 2, 15, 2, 10, 10, 10, time.UTC),
+				Size:              "1Q",
+				Offset:            "-1Q",
+				TruncateTo:        "Q",
+				ExpectedStartTime: time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC),
+				ExpectedEndTime:   time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC),
+			},
+			{
+				Scenario:          "should cover the last business day of previous month",
+				ScheduleTime:      time.Date(2024, 4, 3, 2, 10, 10, 10, time.UTC),
+				Size:              "1d",
+				Offset:            "-1bd+1d",
+				TruncateTo:        "M",
+				ExpectedStartTime: time.Date(2024, 3, 29, 0, 0, 0, 0, time.UTC),
+				ExpectedEndTime:   time.Date(2024, 3, 30, 0, 0, 0, 0, time.UTC),
+			},
+			{
+				Scenario:          "should skip weekends on business days size",
+				ScheduleTime:      time.Date(2024, 4, 8, 10, 0, 0, 0, time.UTC),
+				Size:              "5bd",
+				Offset:            "",
+				TruncateTo:        "d",
+				ExpectedStartTime: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+				ExpectedEndTime:   time.Date(2024, 4, 8, 0, 0, 0, 0, time.UTC),
+			},
+			{
+				Scenario:          "should truncate to the previous business day on weekend",
+				ScheduleTime:      time.Date(2024, 4, 7, 10, 0, 0, 0, time.UTC),
+				Size:              "1d",
+				Offset:            "",
+				TruncateTo:        "bd",
+				ExpectedStartTime: time.Date(2024, 4, 4, 0, 0, 0, 0, time.UTC),
+				ExpectedEndTime:   time.Date(2024, 4, 5, 0, 0, 0, 0, time.UTC),
+			},
+		}
+		for _, sc := range cases {
+			w, err := models.NewWindow(2, sc.TruncateTo, sc.Offset, sc.Size)
+			assert.NoError(t, err, sc.Scenario)
+			assert.Equal(t, 2, w.GetVersion(), sc.Scenario)
+
+			actualStartTime, actualStartTimeError := w.GetStartTime(sc.ScheduleTime)
+			actualEndTime, actualEndTimeError := w.GetEndTime(sc.ScheduleTime)
+
+			assert.NoError(t, actualStartTimeError, sc.Scenario)
+			assert.Equal(t, sc.ExpectedStartTime.String(), actualStartTime.String(), sc.Scenario)
+			assert.NoError(t, actualEndTimeError, sc.Scenario)
+			assert.Equal(t, sc.ExpectedEndTime.String(), actualEndTime.String(), sc.Scenario)
+		}
+	})
+}
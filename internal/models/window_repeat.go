@@ -0,0 +1,124 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	repeatSeparator = ";"
+	repeatCountKey  = "repeat"
+	repeatEveryKey  = "every"
+
+	maxRepeatCount = 366
+)
+
+// RepeatedWindow is a window made of the intervals of its base window at several schedule times,
+// its start time is the start of the earliest interval and its end time is the end of the latest one
+type RepeatedWindow interface {
+	Window
+
+	GetBaseWindow() Window
+	// GetScheduleTimes returns the schedule times of the base window intervals ordered from the earliest
+	GetScheduleTimes(scheduleTime time.Time) ([]time.Time, error)
+}
+
+// windowRepeat repeats the interval of the base window, moving the schedule time back by every for each repetition.
+// It is expressed by suffixing the size, like 24h;repeat=4;every=1w for the same day over the last 4 weeks
+type windowRepeat struct {
+	Window
+
+	size       string
+	repetition string
+}
+
+func (w windowRepeat) GetSize() string {
+	return w.size
+}
+
+func (w windowRepeat) GetBaseWindow() Window {
+	return w.Window
+}
+
+func (w windowRepeat) Validate() error {
+	if err := w.Window.Validate(); err != nil {
+		return err
+	}
+	if _, _, err := w.parseRepetition(); err != nil {
+		return fmt.Errorf("error validating size: %w", err)
+	}
+	return nil
+}
+
+func (w windowRepeat) GetStartTime(scheduleTime time.Time) (time.Time, error) {
+	scheduleTimes, err := w.GetScheduleTimes(scheduleTime)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return w.Window.GetStartTime(scheduleTimes[0])
+}
+
+func (w windowRepeat) GetEndTime(scheduleTime time.Time) (time.Time, error) {
+	if err := w.Validate(); err != nil {
+		return time.Time{}, err
+	}
+	return w.Window.GetEndTime(scheduleTime)
+}
+
+func (w windowRepeat) GetScheduleTimes(scheduleTime time.Time) ([]time.Time, error) {
+	if err := w.Validate(); err != nil {
+		return nil, err
+	}
+	count, every, err := w.parseRepetition()
+	if err != nil {
+		return nil, err
+	}
+
+	scheduleTimes := make([]time.Time, count)
+	current := scheduleTime
+	for i := count - 1; i >= 0; i-- {
+		scheduleTimes[i] = current
+		for _, term := range every {
+			current = term.apply(current, -1)
+		}
+	}
+	return scheduleTimes, nil
+}
+
+// parseRepetition parses the repetition suffix of size, like repeat=4;every=1w
+func (w windowRepeat) parseRepetition() (int, []calendarTerm, error) {
+	var count int
+	var every []calendarTerm
+	for _, part := range strings.Split(w.repetition, repeatSeparator) {
+		key, value, _ := strings.Cut(part, "=")
+		switch key {
+		case repeatCountKey:
+			parsed, err := strconv.Atoi(value)
+			if err != nil || parsed < 1 || parsed > maxRepeatCount {
+				return 0, nil, fmt.Errorf("invalid repeat %s, provide a number from 1 to %d", value, maxRepeatCount)
+			}
+			count = parsed
+		case repeatEveryKey:
+			terms, err := calendarTermsFrom(value)
+			if err != nil {
+				return 0, nil, fmt.Errorf("invalid every: %w", err)
+			}
+			for _, term := range terms {
+				if term.value <= 0 {
+					return 0, nil, fmt.Errorf("invalid every %s, every should be positive", value)
+				}
+			}
+			every = terms
+		default:
+			return 0, nil, fmt.Errorf("invalid repetition %s, provide %s=<count>;%s=<duration>", part, repeatCountKey, repeatEveryKey)
+		}
+	}
+
+	if count == 0 || len(every) == 0 {
+		return 0, nil, errors.New("repetition requires both repeat and every")
+	}
+	return count, every, nil
+}
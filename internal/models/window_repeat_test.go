@@ -0,0 +1,91 @@
+package models_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/internal/models"
+)
+
+func TestWindowRepeat(t *testing.T) {
+	t.Run("NewWindow", func(t *testing.T) {
+		t.Run("should return error when repetition is used with version 1", func(t *testing.T) {
+			_, err := models.NewWindow(1, "d", "0", "24h;repeat=4;every=1w")
+			assert.ErrorContains(t, err, "window with repetition requires version 2")
+		})
+		t.Run("should return repeated window for version 2", func(t *testing.T) {
+			window, err := models.NewWindow(2, "d", "0", "24h;repeat=4;every=1w")
+			assert.NoError(t, err)
+
+			repeated, ok := window.(models.RepeatedWindow)
+			assert.True(t, ok)
+			assert.Equal(t, "24h;repeat=4;every=1w", repeated.GetSize())
+			assert.Equal(t, "24h", repeated.GetBaseWindow().GetSize())
+			assert.Equal(t, "d", repeated.GetTruncateTo())
+		})
+	})
+	t.Run("Validate", func(t *testing.T) {
+		t.Run("should not throw error for valid repetitions", func(t *testing.T) {
+			validSizes := []string{
+				"24h;repeat=4;every=1w",
+				"24h;every=1M;repeat=12",
+				"1bd;repeat=5;every=1bd",
+			}
+			for _, size := range validSizes {
+				window, err := models.NewWindow(2, "d", "0", size)
+				assert.NoError(t, err)
+				assert.NoError(t, window.Validate(), size)
+			}
+		})
+		t.Run("should throw error for invalid repetitions", func(t *testing.T) {
+			inValidSizes := []string{
+				"24h;repeat=4",
+				"24h;every=1w",
+				"24h;repeat=0;every=1w",
+				"24h;repeat=367;every=1w",
+				"24h;repeat=x;every=1w",
+				"24h;repeat=4;every=-1w",
+				"24h;repeat=4;every=1x",
+				"24h;repeat=4;every=1w;skip=1",
+				"-24h;repeat=4;every=1w",
+			}
+			for _, size := range inValidSizes {
+				window, err := models.NewWindow(2, "d", "0", size)
+				assert.NoError(t, err)
+				assert.Error(t, window.Validate(), size)
+			}
+		})
+	})
+	t.Run("GetScheduleTimes", func(t *testing.T) {
+		t.Run("should return schedule times ordered from the earliest", func(t *testing.T) {
+			window, err := models.NewWindow(2, "d", "0", "24h;repeat=3;every=1M")
+			assert.NoError(t, err)
+
+			scheduleTime := time.Date(2023, 3, 15, 2, 0, 0, 0, time.UTC)
+			scheduleTimes, err := window.(models.RepeatedWindow).GetScheduleTimes(scheduleTime)
+			assert.NoError(t, err)
+			assert.Equal(t, []time.Time{
+				time.Date(2023, 1, 15, 2, 0, 0, 0, time.UTC),
+				time.Date(2023, 2, 15, 2, 0, 0, 0, time.UTC),
+				scheduleTime,
+			}, scheduleTimes)
+		})
+	})
+	t.Run("GetTimeRange", func(t *testing.T) {
+		t.Run("should span from the earliest interval to the latest interval", func(t *testing.T) {
+			window, err := models.NewWindow(2, "d", "0", "24h;repeat=4;every=1w")
+			assert.NoError(t, err)
+
+			scheduleTime := time.Date(2023, 1, 29, 2, 0, 0, 0, time.UTC)
+			startTime, err := window.GetStartTime(scheduleTime)
+			assert.NoError(t, err)
+			endTime, err := window.GetEndTime(scheduleTime)
+			assert.NoError(t, err)
+
+			assert.Equal(t, time.Date(2023, 1, 7, 0, 0, 0, 0, time.UTC), startTime)
+			assert.Equal(t, time.Date(2023, 1, 29, 0, 0, 0, 0, time.UTC), endTime)
+		})
+	})
+}
@@ -41,4 +41,11 @@ func TestNewWindow(t *testing.T) {
 		assert.Nil(t, actualWindow)
 		assert.Error(t, actualError)
 	})
+
+	t.Run("should return nil and error if calendar window is not version 2", func(t *testing.T) {
+		actualWindow, actualError := models.NewWindow(1, "Q", "0", "1Q")
+
+		assert.Nil(t, actualWindow)
+		assert.ErrorContains(t, actualError, "requires version 2")
+	})
 }
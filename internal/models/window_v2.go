@@ -37,6 +37,9 @@ func (w windowV2) GetStartTime(scheduleTime time.Time) (time.Time, error) {
 	if err != nil {
 		return time.Time{}, err
 	}
+	if isCalendarAnchor(w.truncateTo) {
+		return w.getAnchoredTime(scheduleTime, w.size)
+	}
 	return w.getStartTime(endTime)
 }
 
@@ -44,6 +47,9 @@ func (w windowV2) GetEndTime(scheduleTime time.Time) (time.Time, error) {
 	if err := w.Validate(); err != nil {
 		return time.Time{}, err
 	}
+	if isCalendarAnchor(w.truncateTo) {
+		return w.getAnchoredTime(scheduleTime, "")
+	}
 	truncatedTime := w.truncateTime(scheduleTime)
 	return w.adjustOffset(truncatedTime)
 }
@@ -65,10 +71,15 @@ func (w windowV2) validateTruncateTo() error {
 		return nil
 	}
 
+	if isCalendarAnchor(w.truncateTo) {
+		_, err := calendarAnchorFrom(w.truncateTo)
+		return err
+	}
+
 	validTruncateOptions := []string{"h", "d", "w", "M"}
 	// TODO: perhaps we can avoid using util, in hope we can remove this package
 	if !utils.ContainsString(validTruncateOptions, w.truncateTo) {
-		return fmt.Errorf("invalid option provided, provide one of: %v", validTruncateOptions)
+		return fmt.Errorf("invalid option provided, provide one of: %v, %s or %s<nth><weekday>", validTruncateOptions, anchorMonthEnd, anchorWeekdayPrefix)
 	}
 	return nil
 }
@@ -174,3 +185,38 @@ func (w windowV2) getStartTime(endTime time.Time) (time.Time, error) {
 	}
 	return endTime.Add(-nonMonthDuration).AddDate(0, -months, 0), nil
 }
+
+// getAnchoredTime moves the calendar anchor by the months of offset and size, to keep the window on the same
+// calendar point of every month, then applies the rest of offset and size as durations
+func (w windowV2) getAnchoredTime(scheduleTime time.Time, size string) (time.Time, error) {
+	anchor, err := calendarAnchorFrom(w.truncateTo)
+	if err != nil {
+		return time.Time{}, err
+	}
+	offsetMonths, offsetDuration, err := monthsAndDuration(w.offset)
+	if err != nil {
+		return time.Time{}, err
+	}
+	sizeMonths, sizeDuration, err := monthsAndDuration(size)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	anchoredTime := anchor.shift(anchor.latest(scheduleTime), offsetMonths-sizeMonths)
+	return anchoredTime.Add(offsetDuration - sizeDuration), nil
+}
+
+func monthsAndDuration(durationExpression string) (int, time.Duration, error) {
+	if durationExpression == "" {
+		return 0, 0, nil
+	}
+	months, nonMonthDurationString, err := monthsAndNonMonthExpression(durationExpression)
+	if err != nil {
+		return 0, 0, err
+	}
+	nonMonthDuration, err := time.ParseDuration(nonMonthDurationString)
+	if err != nil {
+		return 0, 0, err
+	}
+	return months, nonMonthDuration, nil
+}
@@ -57,7 +57,7 @@ func TestWindowV2(t *testing.T) {
 			}
 		})
 		t.Run("should not throw error for valid window truncate configs", func(t *testing.T) {
-			validTruncateConfigs := []string{"h", "d", "w", "M", ""}
+			validTruncateConfigs := []string{"h", "d", "w", "M", "", "ME", "M:1mon", "M:-1fri", "M:4sun"}
 			for _, config := range validTruncateConfigs {
 				window, err := models.NewWindow(2, config, "", "")
 				if err != nil {
@@ -68,7 +68,7 @@ func TestWindowV2(t *testing.T) {
 			}
 		})
 		t.Run("should throw error for window truncate when it is not a truncate option", func(t *testing.T) {
-			inValidTruncateConfigs := []string{"s", "a", "ms", "m", "H", "D", "W"}
+			inValidTruncateConfigs := []string{"s", "a", "ms", "m", "H", "D", "W", "ME1", "M:", "M:mon", "M:0mon", "M:5mon", "M:-5fri", "M:1xyz"}
 			for _, config := range inValidTruncateConfigs {
 				window, err := models.NewWindow(2, config, "", "")
 				if err != nil {
@@ -270,6 +270,60 @@ func TestWindowV2(t *testing.T) {
 					ExpectedStartTime: time.Date(2022, 0o7, 0o4, 0, 0, 0, 0, time.UTC),
 					ExpectedEndTime:   time.Date(2022, 0o7, 0o5, 0, 0, 0, 0, time.UTC),
 				},
+				{
+					Scenario:          "should truncate to the last month end on truncate to month end",
+					ScheduleTime:      time.Date(2024, 0o3, 0o5, 0o2, 10, 10, 10, time.UTC),
+					Size:              "1M",
+					Offset:            "24h",
+					TruncateTo:        "ME",
+					ExpectedStartTime: time.Date(2024, 0o2, 0o1, 0, 0, 0, 0, time.UTC),
+					ExpectedEndTime:   time.Date(2024, 0o3, 0o1, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					Scenario:          "should not truncate to the previous month end if time is already on month end",
+					ScheduleTime:      time.Date(2024, 0o2, 29, 0, 0, 0, 0, time.UTC),
+					Size:              "24h",
+					Offset:            "",
+					TruncateTo:        "ME",
+					ExpectedStartTime: time.Date(2024, 0o2, 28, 0, 0, 0, 0, time.UTC),
+					ExpectedEndTime:   time.Date(2024, 0o2, 29, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					Scenario:          "should keep the month end on monthly offset on truncate to month end",
+					ScheduleTime:      time.Date(2024, 0o4, 10, 0o2, 10, 10, 10, time.UTC),
+					Size:              "1M",
+					Offset:            "-1M",
+					TruncateTo:        "ME",
+					ExpectedStartTime: time.Date(2024, 0o1, 31, 0, 0, 0, 0, time.UTC),
+					ExpectedEndTime:   time.Date(2024, 0o2, 29, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					Scenario:          "should truncate to the last weekday of month counted from month end",
+					ScheduleTime:      time.Date(2024, 0o3, 0o5, 0o2, 10, 10, 10, time.UTC),
+					Size:              "1M",
+					Offset:            "0",
+					TruncateTo:        "M:-1fri",
+					ExpectedStartTime: time.Date(2024, 0o1, 26, 0, 0, 0, 0, time.UTC),
+					ExpectedEndTime:   time.Date(2024, 0o2, 23, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					Scenario:          "should truncate to the nth weekday of month",
+					ScheduleTime:      time.Date(2024, 0o1, 8, 10, 0, 0, 0, time.UTC),
+					Size:              "24h",
+					Offset:            "0",
+					TruncateTo:        "M:2mon",
+					ExpectedStartTime: time.Date(2024, 0o1, 0o7, 0, 0, 0, 0, time.UTC),
+					ExpectedEndTime:   time.Date(2024, 0o1, 8, 0, 0, 0, 0, time.UTC),
+				},
+				{
+					Scenario:          "should truncate to the nth weekday of previous month if it is not reached yet",
+					ScheduleTime:      time.Date(2024, 0o1, 0o7, 10, 0, 0, 0, time.UTC),
+					Size:              "24h",
+					Offset:            "0",
+					TruncateTo:        "M:2mon",
+					ExpectedStartTime: time.Date(2023, 12, 10, 0, 0, 0, 0, time.UTC),
+					ExpectedEndTime:   time.Date(2023, 12, 11, 0, 0, 0, 0, time.UTC),
+				},
 			}
 			for _, sc := range cases {
 				w, err := models.NewWindow(2, sc.TruncateTo, sc.Offset, sc.Size)
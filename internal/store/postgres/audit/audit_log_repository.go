@@ -0,0 +1,122 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/goto/optimus/core/audit"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+)
+
+const (
+	auditLogColumnsToStore = `actor, project_name, namespace_name, action, method, payload_digest, result, message, created_at`
+	auditLogColumns        = `id, ` + auditLogColumnsToStore
+)
+
+type AuditLogRepository struct {
+	db *pgxpool.Pool
+}
+
+type auditLog struct {
+	ID uuid.UUID
+
+	Actor         string
+	ProjectName   string
+	NamespaceName string
+
+	Action        string
+	Method        string
+	PayloadDigest string
+
+	Result  string
+	Message string
+
+	CreatedAt time.Time
+}
+
+func (l *auditLog) toEntry() *audit.Entry {
+	return &audit.Entry{
+		ID:            l.ID,
+		Actor:         l.Actor,
+		ProjectName:   tenant.ProjectName(l.ProjectName),
+		NamespaceName: tenant.NamespaceName(l.NamespaceName),
+		Action:        audit.Action(l.Action),
+		Method:        l.Method,
+		PayloadDigest: l.PayloadDigest,
+		Result:        audit.Result(l.Result),
+		Message:       l.Message,
+		CreatedAt:     l.CreatedAt,
+	}
+}
+
+func (r AuditLogRepository) Create(ctx context.Context, entry *audit.Entry) error {
+	insertEntry := `INSERT INTO audit_log (` + auditLogColumnsToStore + `) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+	_, err := r.db.Exec(ctx, insertEntry, entry.Actor, entry.ProjectName, entry.NamespaceName, entry.Action, entry.Method,
+		entry.PayloadDigest, entry.Result, entry.Message, entry.CreatedAt)
+	if err != nil {
+		return errors.Wrap(audit.EntityAuditLog, "unable to store audit log", err)
+	}
+	return nil
+}
+
+// Query returns the entries selected by the filter, the latest first
+func (r AuditLogRepository) Query(ctx context.Context, filter audit.Filter) ([]*audit.Entry, error) {
+	conditions := []string{"project_name = $1"}
+	args := []interface{}{filter.ProjectName}
+	addCondition := func(condition string, arg interface{}) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+	if filter.NamespaceName != "" {
+		addCondition("namespace_name = $%d", filter.NamespaceName)
+	}
+	if filter.Actor != "" {
+		addCondition("actor = $%d", filter.Actor)
+	}
+	if filter.Action != "" {
+		addCondition("action = $%d", filter.Action)
+	}
+	if !filter.Since.IsZero() {
+		addCondition("created_at >= $%d", filter.Since)
+	}
+	if !filter.Until.IsZero() {
+		addCondition("created_at < $%d", filter.Until)
+	}
+	args = append(args, filter.Limit)
+
+	getEntries := `SELECT ` + auditLogColumns + ` FROM audit_log WHERE ` + strings.Join(conditions, " AND ") +
+		fmt.Sprintf(` ORDER BY created_at DESC LIMIT $%d`, len(args))
+	rows, err := r.db.Query(ctx, getEntries, args...)
+	if err != nil {
+		return nil, errors.Wrap(audit.EntityAuditLog, "unable to get audit logs", err)
+	}
+	defer rows.Close()
+
+	var entries []*audit.Entry
+	for rows.Next() {
+		stored, err := scanAuditLog(rows)
+		if err != nil {
+			return nil, errors.Wrap(audit.EntityAuditLog, "unable to get the stored audit log", err)
+		}
+		entries = append(entries, stored.toEntry())
+	}
+	return entries, nil
+}
+
+func scanAuditLog(row pgx.Row) (*auditLog, error) {
+	var stored auditLog
+	err := row.Scan(&stored.ID, &stored.Actor, &stored.ProjectName, &stored.NamespaceName, &stored.Action, &stored.Method,
+		&stored.PayloadDigest, &stored.Result, &stored.Message, &stored.CreatedAt)
+	return &stored, err
+}
+
+func NewAuditLogRepository(db *pgxpool.Pool) *AuditLogRepository {
+	return &AuditLogRepository{db: db}
+}
@@ -0,0 +1,78 @@
+//go:build !unit_test
+
+package audit_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/core/audit"
+	postgres "github.com/goto/optimus/internal/store/postgres/audit"
+	"github.com/goto/optimus/tests/setup"
+)
+
+func TestPostgresAuditLogRepository(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+	secretMethod := "/gotocompany.optimus.core.v1beta1.SecretService/RegisterSecret"
+	replayMethod := "/gotocompany.optimus.core.v1beta1.ReplayService/Replay"
+
+	t.Run("Query", func(t *testing.T) {
+		t.Run("returns the entries of the project, the latest first", func(t *testing.T) {
+			db := dbSetup()
+			repo := postgres.NewAuditLogRepository(db)
+
+			first, _ := audit.NewEntry("user-a", "proj", "ns1", audit.ActionSecretSet, secretMethod, "digest-a", nil, now.Add(-time.Hour))
+			second, _ := audit.NewEntry("user-b", "proj", "ns1", audit.ActionReplayCreate, replayMethod, "digest-b", errors.New("invalid"), now)
+			other, _ := audit.NewEntry("user-a", "other-proj", "ns1", audit.ActionSecretSet, secretMethod, "digest-c", nil, now)
+			for _, entry := range []*audit.Entry{first, second, other} {
+				assert.NoError(t, repo.Create(ctx, entry))
+			}
+
+			entries, err := repo.Query(ctx, audit.Filter{ProjectName: "proj", Limit: 10})
+			assert.NoError(t, err)
+			assert.Len(t, entries, 2)
+			assert.Equal(t, "user-b", entries[0].Actor)
+			assert.Equal(t, audit.ResultFailure, entries[0].Result)
+			assert.Equal(t, "invalid", entries[0].Message)
+			assert.Equal(t, first.PayloadDigest, entries[1].PayloadDigest)
+			assert.True(t, entries[1].CreatedAt.Equal(first.CreatedAt))
+		})
+		t.Run("narrows down the entries with the filter", func(t *testing.T) {
+			db := dbSetup()
+			repo := postgres.NewAuditLogRepository(db)
+
+			first, _ := audit.NewEntry("user-a", "proj", "ns1", audit.ActionSecretSet, secretMethod, "digest-a", nil, now.Add(-2*time.Hour))
+			second, _ := audit.NewEntry("user-a", "proj", "ns2", audit.ActionSecretSet, secretMethod, "digest-b", nil, now.Add(-time.Hour))
+			third, _ := audit.NewEntry("user-a", "proj", "ns1", audit.ActionReplayCreate, replayMethod, "digest-c", nil, now)
+			for _, entry := range []*audit.Entry{first, second, third} {
+				assert.NoError(t, repo.Create(ctx, entry))
+			}
+
+			entries, err := repo.Query(ctx, audit.Filter{ProjectName: "proj", Actor: "user-a", Action: audit.ActionSecretSet, Limit: 10})
+			assert.NoError(t, err)
+			assert.Len(t, entries, 2)
+
+			entries, err = repo.Query(ctx, audit.Filter{ProjectName: "proj", NamespaceName: "ns1", Since: now.Add(-3 * time.Hour), Until: now, Limit: 10})
+			assert.NoError(t, err)
+			assert.Len(t, entries, 1)
+			assert.Equal(t, first.PayloadDigest, entries[0].PayloadDigest)
+
+			entries, err = repo.Query(ctx, audit.Filter{ProjectName: "proj", Limit: 1})
+			assert.NoError(t, err)
+			assert.Len(t, entries, 1)
+			assert.Equal(t, audit.ActionReplayCreate, entries[0].Action)
+		})
+	})
+}
+
+func dbSetup() *pgxpool.Pool {
+	pool := setup.TestPool()
+	setup.TruncateTablesWith(pool)
+	return pool
+}
@@ -0,0 +1,97 @@
+package event
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/goto/optimus/core/event/moderator"
+	"github.com/goto/optimus/internal/errors"
+)
+
+const (
+	outboxColumns = `id, payload, status, attempts, COALESCE(last_error, ''), next_attempt_at`
+)
+
+type OutboxRepository struct {
+	db *pgxpool.Pool
+}
+
+func (r OutboxRepository) Save(ctx context.Context, payload []byte) error {
+	insertEvent := `INSERT INTO event_outbox (payload, status, attempts, next_attempt_at, created_at, updated_at)
+		VALUES ($1, $2, 0, NOW(), NOW(), NOW())`
+	if _, err := r.db.Exec(ctx, insertEvent, payload, moderator.OutboxStatusPending); err != nil {
+		return errors.Wrap(moderator.EntityOutbox, "unable to store event in the outbox", err)
+	}
+	return nil
+}
+
+// GetPending returns the pending events due for an attempt at the time, the oldest first
+func (r OutboxRepository) GetPending(ctx context.Context, at time.Time, limit int) ([]*moderator.OutboxEvent, error) {
+	getPending := `SELECT ` + outboxColumns + ` FROM event_outbox
+		WHERE status = $1 AND next_attempt_at <= $2 ORDER BY created_at LIMIT $3`
+	rows, err := r.db.Query(ctx, getPending, moderator.OutboxStatusPending, at, limit)
+	if err != nil {
+		return nil, errors.Wrap(moderator.EntityOutbox, "unable to get pending events of the outbox", err)
+	}
+	defer rows.Close()
+
+	var events []*moderator.OutboxEvent
+	for rows.Next() {
+		var event moderator.OutboxEvent
+		if err := rows.Scan(&event.ID, &event.Payload, &event.Status, &event.Attempts, &event.LastError, &event.NextAttemptAt); err != nil {
+			return nil, errors.Wrap(moderator.EntityOutbox, "unable to get the stored event of the outbox", err)
+		}
+		events = append(events, &event)
+	}
+	return events, nil
+}
+
+func (r OutboxRepository) Delete(ctx context.Context, ids []uuid.UUID) error {
+	if _, err := r.db.Exec(ctx, `DELETE FROM event_outbox WHERE id = ANY($1)`, ids); err != nil {
+		return errors.Wrap(moderator.EntityOutbox, "unable to delete events of the outbox", err)
+	}
+	return nil
+}
+
+func (r OutboxRepository) UpdateAttempts(ctx context.Context, events []*moderator.OutboxEvent) error {
+	batch := pgx.Batch{}
+	for _, event := range events {
+		updateAttempts := `UPDATE event_outbox SET status = $1, attempts = $2, last_error = $3, next_attempt_at = $4, updated_at = NOW()
+			WHERE id = $5`
+		batch.Queue(updateAttempts, event.Status, event.Attempts, event.LastError, event.NextAttemptAt, event.ID)
+	}
+
+	results := r.db.SendBatch(ctx, &batch)
+	defer results.Close()
+
+	multiErr := errors.NewMultiError("error updating attempts of the outbox events")
+	for range events {
+		_, err := results.Exec()
+		multiErr.Append(err)
+	}
+	return multiErr.ToErr()
+}
+
+func (r OutboxRepository) Redrive(ctx context.Context, ids []uuid.UUID, at time.Time) (int64, error) {
+	redrive := `UPDATE event_outbox SET status = $1, attempts = 0, next_attempt_at = $2, updated_at = NOW()
+		WHERE status = $3`
+	args := []any{moderator.OutboxStatusPending, at, moderator.OutboxStatusDeadLetter}
+	if len(ids) > 0 {
+		redrive += ` AND id = ANY($4)`
+		args = append(args, ids)
+	}
+
+	tag, err := r.db.Exec(ctx, redrive, args...)
+	if err != nil {
+		return 0, errors.Wrap(moderator.EntityOutbox, "unable to re-drive dead lettered events of the outbox", err)
+	}
+	return tag.RowsAffected(), nil
+}
+
+func NewOutboxRepository(db *pgxpool.Pool) *OutboxRepository {
+	return &OutboxRepository{db: db}
+}
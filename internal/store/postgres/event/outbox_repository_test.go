@@ -0,0 +1,82 @@
+//go:build !unit_test
+
+package event_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/core/event/moderator"
+	postgres "github.com/goto/optimus/internal/store/postgres/event"
+	"github.com/goto/optimus/tests/setup"
+)
+
+func TestPostgresOutboxRepository(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("returns the pending events due for an attempt and deletes the published ones", func(t *testing.T) {
+		db := dbSetup()
+		repo := postgres.NewOutboxRepository(db)
+
+		assert.NoError(t, repo.Save(ctx, []byte("first")))
+		assert.NoError(t, repo.Save(ctx, []byte("second")))
+
+		events, err := repo.GetPending(ctx, time.Now().Add(time.Second), 10)
+		assert.NoError(t, err)
+		assert.Len(t, events, 2)
+		assert.Equal(t, []byte("first"), events[0].Payload)
+		assert.Equal(t, moderator.OutboxStatusPending, events[0].Status)
+
+		assert.NoError(t, repo.Delete(ctx, []uuid.UUID{events[0].ID}))
+		events, err = repo.GetPending(ctx, time.Now().Add(time.Second), 10)
+		assert.NoError(t, err)
+		assert.Len(t, events, 1)
+		assert.Equal(t, []byte("second"), events[0].Payload)
+	})
+	t.Run("keeps the events out of the pending ones until their next attempt or re-drive", func(t *testing.T) {
+		db := dbSetup()
+		repo := postgres.NewOutboxRepository(db)
+
+		assert.NoError(t, repo.Save(ctx, []byte("retried")))
+		assert.NoError(t, repo.Save(ctx, []byte("dead")))
+		now := time.Now().Add(time.Second)
+		events, err := repo.GetPending(ctx, now, 10)
+		assert.NoError(t, err)
+		assert.Len(t, events, 2)
+
+		events[0].Attempts = 1
+		events[0].LastError = "broker is not available"
+		events[0].NextAttemptAt = now.Add(time.Hour)
+		events[1].Attempts = 3
+		events[1].Status = moderator.OutboxStatusDeadLetter
+		assert.NoError(t, repo.UpdateAttempts(ctx, events))
+
+		pending, err := repo.GetPending(ctx, now, 10)
+		assert.NoError(t, err)
+		assert.Empty(t, pending)
+		pending, err = repo.GetPending(ctx, now.Add(2*time.Hour), 10)
+		assert.NoError(t, err)
+		assert.Len(t, pending, 1)
+		assert.Equal(t, "broker is not available", pending[0].LastError)
+
+		count, err := repo.Redrive(ctx, nil, now)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+		pending, err = repo.GetPending(ctx, now, 10)
+		assert.NoError(t, err)
+		assert.Len(t, pending, 1)
+		assert.Equal(t, []byte("dead"), pending[0].Payload)
+		assert.Equal(t, 0, pending[0].Attempts)
+	})
+}
+
+func dbSetup() *pgxpool.Pool {
+	pool := setup.TestPool()
+	setup.TruncateTablesWith(pool)
+	return pool
+}
@@ -33,8 +33,12 @@ type Spec struct {
 	StaticUpstreams pq.StringArray
 	HTTPUpstreams   json.RawMessage
 
-	TaskName   string
-	TaskConfig map[string]string
+	IgnoredUpstreams pq.StringArray
+	ExtraUpstreams   pq.StringArray
+
+	TaskName    string
+	TaskVersion string
+	TaskConfig  map[string]string
 
 	Hooks json.RawMessage
 
@@ -58,6 +62,7 @@ type Schedule struct {
 	EndDate       *time.Time `json:",omitempty"`
 	Interval      string
 	DependsOnPast bool
+	CatchUp       string `json:",omitempty"`
 	Retry         *Retry
 }
 
@@ -87,13 +92,27 @@ type Asset struct {
 }
 
 type Hook struct {
-	Name   string
-	Config map[string]string
+	Name        string
+	Config      map[string]string
+	EnabledWhen string   `json:",omitempty"`
+	Phase       string   `json:",omitempty"`
+	DependsOn   []string `json:",omitempty"`
+	When        string   `json:",omitempty"`
 }
 
 type Metadata struct {
 	Resource  *MetadataResource
 	Scheduler map[string]string
+	Sensors   []*MetadataSensor `json:",omitempty"`
+}
+
+type MetadataSensor struct {
+	Upstream     string
+	PokeInterval time.Duration
+	Timeout      time.Duration
+
+	FreshnessPartitionExists bool          `json:",omitempty"`
+	FreshnessMaxAge          time.Duration `json:",omitempty"`
 }
 
 type MetadataResource struct {
@@ -130,12 +149,18 @@ func toStorageSpec(jobEntity *job.Job) (*Spec, error) {
 		return nil, err
 	}
 
-	var staticUpstreams []string
+	var staticUpstreams, ignoredUpstreams, extraUpstreams []string
 	var httpUpstreamsInBytes []byte
 	if jobSpec.UpstreamSpec() != nil {
 		for _, name := range jobSpec.UpstreamSpec().UpstreamNames() {
 			staticUpstreams = append(staticUpstreams, name.String())
 		}
+		for _, urn := range jobSpec.UpstreamSpec().IgnoredResources() {
+			ignoredUpstreams = append(ignoredUpstreams, urn.String())
+		}
+		for _, urn := range jobSpec.UpstreamSpec().ExtraResources() {
+			extraUpstreams = append(extraUpstreams, urn.String())
+		}
 		if jobSpec.UpstreamSpec().HTTPUpstreams() != nil {
 			httpUpstreamsInBytes, err = json.Marshal(jobSpec.UpstreamSpec().HTTPUpstreams())
 			if err != nil {
@@ -178,14 +203,18 @@ func toStorageSpec(jobEntity *job.Job) (*Spec, error) {
 
 		Alert: alertsBytes,
 
-		TaskName:   jobSpec.Task().Name().String(),
-		TaskConfig: jobSpec.Task().Config(),
+		TaskName:    jobSpec.Task().Name().String(),
+		TaskVersion: jobSpec.Task().Version(),
+		TaskConfig:  jobSpec.Task().Config(),
 
 		Hooks: hooksBytes,
 
 		StaticUpstreams: staticUpstreams,
 		HTTPUpstreams:   httpUpstreamsInBytes,
 
+		IgnoredUpstreams: ignoredUpstreams,
+		ExtraUpstreams:   extraUpstreams,
+
 		Destination: jobEntity.Destination().String(),
 		Sources:     sources,
 
@@ -234,8 +263,12 @@ func toStorageHooks(hookSpecs []*job.Hook) ([]byte, error) {
 
 func toStorageHook(spec *job.Hook) Hook {
 	return Hook{
-		Name:   spec.Name(),
-		Config: spec.Config(),
+		Name:        spec.Name(),
+		Config:      spec.Config(),
+		EnabledWhen: spec.EnabledWhen(),
+		Phase:       spec.Phase(),
+		DependsOn:   spec.DependsOn(),
+		When:        spec.When(),
 	}
 }
 
@@ -277,6 +310,7 @@ func toStorageSchedule(scheduleSpec *job.Schedule) ([]byte, error) {
 		StartDate:     startDate,
 		Interval:      scheduleSpec.Interval(),
 		DependsOnPast: scheduleSpec.DependsOnPast(),
+		CatchUp:       scheduleSpec.CatchUp().String(),
 		Retry:         retry,
 	}
 	if scheduleSpec.EndDate() != "" {
@@ -316,9 +350,21 @@ func toStorageMetadata(metadataSpec *job.Metadata) ([]byte, error) {
 		}
 	}
 
+	var sensors []*MetadataSensor
+	for _, sensor := range metadataSpec.Sensors() {
+		sensors = append(sensors, &MetadataSensor{
+			Upstream:                 sensor.Upstream(),
+			PokeInterval:             sensor.PokeInterval(),
+			Timeout:                  sensor.Timeout(),
+			FreshnessPartitionExists: sensor.Freshness().PartitionExists,
+			FreshnessMaxAge:          sensor.Freshness().MaxAge,
+		})
+	}
+
 	metadata := Metadata{
 		Resource:  metadataResource,
 		Scheduler: metadataSpec.Scheduler(),
+		Sensors:   sensors,
 	}
 	return json.Marshal(metadata)
 }
@@ -360,7 +406,7 @@ func fromStorageSpec(jobSpec *Spec) (*job.Spec, error) {
 	if err != nil {
 		return nil, err
 	}
-	task := job.NewTask(taskName, taskConfig)
+	task := job.NewTask(taskName, taskConfig).WithVersion(jobSpec.TaskVersion)
 
 	jobSpecBuilder := job.NewSpecBuilder(version, jobName, owner, schedule, w, task).WithDescription(jobSpec.Description)
 
@@ -401,7 +447,16 @@ func fromStorageSpec(jobSpec *Spec) (*job.Spec, error) {
 		upstreamSpecBuilder = upstreamSpecBuilder.WithUpstreamNames(upstreamNames)
 	}
 
-	if httpUpstreams != nil || upstreamNames != nil {
+	var ignoredResources, extraResources []job.ResourceURN
+	for _, urn := range jobSpec.IgnoredUpstreams {
+		ignoredResources = append(ignoredResources, job.ResourceURN(urn))
+	}
+	for _, urn := range jobSpec.ExtraUpstreams {
+		extraResources = append(extraResources, job.ResourceURN(urn))
+	}
+	upstreamSpecBuilder = upstreamSpecBuilder.WithIgnoredResources(ignoredResources).WithExtraResources(extraResources)
+
+	if httpUpstreams != nil || upstreamNames != nil || ignoredResources != nil || extraResources != nil {
 		upstreamSpec, err := upstreamSpecBuilder.Build()
 		if err != nil {
 			return nil, err
@@ -430,6 +485,24 @@ func fromStorageSpec(jobSpec *Spec) (*job.Spec, error) {
 		if storeMetadata.Scheduler != nil {
 			metadataBuilder = metadataBuilder.WithScheduler(storeMetadata.Scheduler)
 		}
+		if storeMetadata.Sensors != nil {
+			sensors := make([]*job.MetadataSensor, len(storeMetadata.Sensors))
+			for i, storeSensor := range storeMetadata.Sensors {
+				sensor, err := job.NewMetadataSensor(storeSensor.Upstream, storeSensor.PokeInterval, storeSensor.Timeout)
+				if err != nil {
+					return nil, err
+				}
+				sensor, err = sensor.WithFreshness(job.SensorFreshness{
+					PartitionExists: storeSensor.FreshnessPartitionExists,
+					MaxAge:          storeSensor.FreshnessMaxAge,
+				})
+				if err != nil {
+					return nil, err
+				}
+				sensors[i] = sensor
+			}
+			metadataBuilder = metadataBuilder.WithSensors(sensors)
+		}
 		metadata, err := metadataBuilder.Build()
 		if err != nil {
 			return nil, err
@@ -484,9 +557,14 @@ func fromStorageSchedule(raw []byte) (*job.Schedule, error) {
 	if err != nil {
 		return nil, err
 	}
+	catchUp, err := job.CatchUpPolicyFrom(storageSchedule.CatchUp)
+	if err != nil {
+		return nil, err
+	}
 	scheduleBuilder := job.NewScheduleBuilder(startDate).
 		WithDependsOnPast(storageSchedule.DependsOnPast).
-		WithInterval(storageSchedule.Interval)
+		WithInterval(storageSchedule.Interval).
+		WithCatchUp(catchUp)
 
 	if storageSchedule.EndDate != nil && !storageSchedule.EndDate.IsZero() {
 		endDate, err := job.ScheduleDateFrom(storageSchedule.EndDate.Format(job.DateLayout))
@@ -531,7 +609,23 @@ func fromStorageHook(hook Hook) (*job.Hook, error) {
 	if err != nil {
 		return nil, err
 	}
-	return job.NewHook(hook.Name, config)
+	jobHook, err := job.NewHook(hook.Name, config)
+	if err != nil {
+		return nil, err
+	}
+	if hook.EnabledWhen != "" {
+		jobHook = jobHook.WithEnabledWhen(hook.EnabledWhen)
+	}
+	if hook.Phase != "" {
+		jobHook = jobHook.WithPhase(hook.Phase)
+	}
+	if len(hook.DependsOn) > 0 {
+		jobHook = jobHook.WithDependsOn(hook.DependsOn)
+	}
+	if hook.When != "" {
+		jobHook = jobHook.WithWhen(hook.When)
+	}
+	return jobHook, nil
 }
 
 func fromStorageAlerts(raw []byte) ([]*job.AlertSpec, error) {
@@ -566,7 +660,7 @@ func FromRow(row pgx.Row) (*Spec, error) {
 	err := row.Scan(&js.ID, &js.Name, &js.Version, &js.Owner, &js.Description,
 		&js.Labels, &js.Schedule, &js.Alert, &js.StaticUpstreams, &js.HTTPUpstreams,
 		&js.TaskName, &js.TaskConfig, &js.WindowSpec, &js.Assets, &js.Hooks, &js.Metadata, &js.Destination, &js.Sources,
-		&js.ProjectName, &js.NamespaceName, &js.CreatedAt, &js.UpdatedAt, &js.DeletedAt)
+		&js.IgnoredUpstreams, &js.ExtraUpstreams, &js.TaskVersion, &js.ProjectName, &js.NamespaceName, &js.CreatedAt, &js.UpdatedAt, &js.DeletedAt)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, errors.NotFound(job.EntityJob, "job not found")
@@ -0,0 +1,123 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/goto/optimus/core/job"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+)
+
+const (
+	deletionConsentColumns = `project_name, job_name, downstream_project_name, downstream_job_name, given_by, reason, created_at`
+	deletionAuditColumns   = `project_name, job_name, requested_by, reason, forced, consented_downstream, overridden_downstream, created_at`
+)
+
+type DeletionRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewDeletionRepository(pool *pgxpool.Pool) *DeletionRepository {
+	return &DeletionRepository{db: pool}
+}
+
+// AddDeletionConsent stores the consent, a consent given again by the same downstream replaces the previous one
+func (r DeletionRepository) AddDeletionConsent(ctx context.Context, consent *job.DeletionConsent) error {
+	insertConsent := `INSERT INTO job_deletion_consent (` + deletionConsentColumns + `) VALUES ($1, $2, $3, $4, $5, $6, NOW())
+		ON CONFLICT (project_name, job_name, downstream_project_name, downstream_job_name)
+		DO UPDATE SET given_by = EXCLUDED.given_by, reason = EXCLUDED.reason, created_at = EXCLUDED.created_at`
+	_, err := r.db.Exec(ctx, insertConsent, consent.ProjectName, consent.JobName, consent.DownstreamProjectName, consent.DownstreamJobName,
+		consent.GivenBy, consent.Reason)
+	if err != nil {
+		return errors.Wrap(job.EntityJobDeletion, "unable to store deletion consent", err)
+	}
+	return nil
+}
+
+func (r DeletionRepository) GetDeletionConsents(ctx context.Context, projectName tenant.ProjectName, jobName job.Name) ([]*job.DeletionConsent, error) {
+	getConsents := `SELECT ` + deletionConsentColumns + ` FROM job_deletion_consent WHERE project_name = $1 AND job_name = $2 ORDER BY created_at ASC`
+	rows, err := r.db.Query(ctx, getConsents, projectName, jobName)
+	if err != nil {
+		return nil, errors.Wrap(job.EntityJobDeletion, "unable to get deletion consents", err)
+	}
+	defer rows.Close()
+
+	var consents []*job.DeletionConsent
+	for rows.Next() {
+		var project, name, downstreamProject, downstreamJob, givenBy, reason string
+		var createdAt time.Time
+		if err := rows.Scan(&project, &name, &downstreamProject, &downstreamJob, &givenBy, &reason, &createdAt); err != nil {
+			return nil, errors.Wrap(job.EntityJobDeletion, "unable to get the stored deletion consent", err)
+		}
+		consents = append(consents, &job.DeletionConsent{
+			ProjectName:           tenant.ProjectName(project),
+			JobName:               job.Name(name),
+			DownstreamProjectName: tenant.ProjectName(downstreamProject),
+			DownstreamJobName:     job.Name(downstreamJob),
+			GivenBy:               givenBy,
+			Reason:                reason,
+			CreatedAt:             createdAt,
+		})
+	}
+	return consents, nil
+}
+
+func (r DeletionRepository) AddDeletionAudit(ctx context.Context, audit *job.DeletionAudit) error {
+	insertAudit := `INSERT INTO job_deletion_audit (` + deletionAuditColumns + `) VALUES ($1, $2, $3, $4, $5, $6, $7, NOW())`
+	_, err := r.db.Exec(ctx, insertAudit, audit.ProjectName, audit.JobName, audit.RequestedBy, audit.Reason, audit.Forced,
+		fullNamesToStrings(audit.ConsentedDownstream), fullNamesToStrings(audit.OverriddenDownstream))
+	if err != nil {
+		return errors.Wrap(job.EntityJobDeletion, "unable to store deletion audit", err)
+	}
+	return nil
+}
+
+func (r DeletionRepository) GetDeletionAudits(ctx context.Context, projectName tenant.ProjectName, jobName job.Name) ([]*job.DeletionAudit, error) {
+	getAudits := `SELECT ` + deletionAuditColumns + ` FROM job_deletion_audit WHERE project_name = $1 AND job_name = $2 ORDER BY created_at DESC`
+	rows, err := r.db.Query(ctx, getAudits, projectName, jobName)
+	if err != nil {
+		return nil, errors.Wrap(job.EntityJobDeletion, "unable to get deletion audits", err)
+	}
+	defer rows.Close()
+
+	var audits []*job.DeletionAudit
+	for rows.Next() {
+		var project, name, requestedBy, reason string
+		var forced bool
+		var consented, overridden []string
+		var createdAt time.Time
+		if err := rows.Scan(&project, &name, &requestedBy, &reason, &forced, &consented, &overridden, &createdAt); err != nil {
+			return nil, errors.Wrap(job.EntityJobDeletion, "unable to get the stored deletion audit", err)
+		}
+		audits = append(audits, &job.DeletionAudit{
+			ProjectName:          tenant.ProjectName(project),
+			JobName:              job.Name(name),
+			RequestedBy:          requestedBy,
+			Reason:               reason,
+			Forced:               forced,
+			ConsentedDownstream:  stringsToFullNames(consented),
+			OverriddenDownstream: stringsToFullNames(overridden),
+			CreatedAt:            createdAt,
+		})
+	}
+	return audits, nil
+}
+
+func fullNamesToStrings(fullNames job.FullNames) []string {
+	names := make([]string, len(fullNames))
+	for i, fullName := range fullNames {
+		names[i] = fullName.String()
+	}
+	return names
+}
+
+func stringsToFullNames(names []string) job.FullNames {
+	var fullNames job.FullNames
+	for _, name := range names {
+		fullNames = append(fullNames, job.FullName(name))
+	}
+	return fullNames
+}
@@ -0,0 +1,69 @@
+//go:build !unit_test
+
+package job_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/core/job"
+	"github.com/goto/optimus/core/tenant"
+	postgres "github.com/goto/optimus/internal/store/postgres/job"
+	"github.com/goto/optimus/tests/setup"
+)
+
+func TestPostgresDeletionRepository(t *testing.T) {
+	ctx := context.Background()
+	projectName := tenant.ProjectName("test-proj")
+	jobName := job.Name("job-A")
+
+	t.Run("DeletionConsent", func(t *testing.T) {
+		t.Run("replaces the consent given again by the same downstream", func(t *testing.T) {
+			pool := setup.TestPool()
+			setup.TruncateTablesWith(pool)
+			repo := postgres.NewDeletionRepository(pool)
+
+			consent, err := job.NewDeletionConsent(projectName, jobName, "test-other-proj", "job-B", "owner@example.com", "")
+			assert.NoError(t, err)
+			assert.NoError(t, repo.AddDeletionConsent(ctx, consent))
+
+			consent.Reason = "moved to job-C"
+			assert.NoError(t, repo.AddDeletionConsent(ctx, consent))
+
+			consents, err := repo.GetDeletionConsents(ctx, projectName, jobName)
+			assert.NoError(t, err)
+			assert.Len(t, consents, 1)
+			assert.Equal(t, job.FullName("test-other-proj/job-B"), consents[0].DownstreamFullName())
+			assert.Equal(t, "moved to job-C", consents[0].Reason)
+			assert.False(t, consents[0].CreatedAt.IsZero())
+		})
+	})
+	t.Run("DeletionAudit", func(t *testing.T) {
+		t.Run("stores and returns the audit of the deletion", func(t *testing.T) {
+			pool := setup.TestPool()
+			setup.TruncateTablesWith(pool)
+			repo := postgres.NewDeletionRepository(pool)
+
+			audit := &job.DeletionAudit{
+				ProjectName:          projectName,
+				JobName:              jobName,
+				RequestedBy:          "user@example.com",
+				Reason:               "table is deprecated",
+				Forced:               true,
+				ConsentedDownstream:  job.FullNames{"test-proj/job-B"},
+				OverriddenDownstream: job.FullNames{"test-proj/job-C"},
+			}
+			assert.NoError(t, repo.AddDeletionAudit(ctx, audit))
+
+			audits, err := repo.GetDeletionAudits(ctx, projectName, jobName)
+			assert.NoError(t, err)
+			assert.Len(t, audits, 1)
+			assert.Equal(t, "user@example.com", audits[0].RequestedBy)
+			assert.True(t, audits[0].Forced)
+			assert.Equal(t, job.FullNames{"test-proj/job-B"}, audits[0].ConsentedDownstream)
+			assert.Equal(t, job.FullNames{"test-proj/job-C"}, audits[0].OverriddenDownstream)
+		})
+	})
+}
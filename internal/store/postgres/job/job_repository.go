@@ -18,7 +18,8 @@ import (
 
 const (
 	jobColumnsToStore = `name, version, owner, description, labels, schedule, alert, static_upstreams, http_upstreams, 
-	task_name, task_config, window_spec, assets, hooks, metadata, destination, sources, project_name, namespace_name, created_at, updated_at`
+	task_name, task_config, window_spec, assets, hooks, metadata, destination, sources, ignored_upstreams, extra_upstreams,
+	task_version, project_name, namespace_name, created_at, updated_at`
 
 	jobColumns = `id, ` + jobColumnsToStore + `, deleted_at`
 )
@@ -70,21 +71,35 @@ func (j JobRepository) triggerInsert(ctx context.Context, jobEntity *job.Job) er
 
 	insertJobQuery := `INSERT INTO job (` + jobColumnsToStore + `)
 	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16,
-	$17, $18, $19, NOW(), NOW());`
+	$17, $18, $19, $20, $21, $22, NOW(), NOW());`
 
-	tag, err := j.db.Exec(ctx, insertJobQuery,
+	tx, err := j.db.Begin(ctx)
+	if err != nil {
+		return errors.InternalError(job.EntityJob, "unable to begin transaction", err)
+	}
+
+	tag, err := tx.Exec(ctx, insertJobQuery,
 		storageJob.Name, storageJob.Version, storageJob.Owner, storageJob.Description, storageJob.Labels,
 		storageJob.Schedule, storageJob.Alert, storageJob.StaticUpstreams, storageJob.HTTPUpstreams,
 		storageJob.TaskName, storageJob.TaskConfig, storageJob.WindowSpec, storageJob.Assets,
 		storageJob.Hooks, storageJob.Metadata, storageJob.Destination, storageJob.Sources,
-		storageJob.ProjectName, storageJob.NamespaceName)
+		storageJob.IgnoredUpstreams, storageJob.ExtraUpstreams,
+		storageJob.TaskVersion, storageJob.ProjectName, storageJob.NamespaceName)
 	if err != nil {
+		tx.Rollback(ctx)
 		return errors.Wrap(job.EntityJob, "unable to save job spec", err)
 	}
 
 	if tag.RowsAffected() == 0 {
+		tx.Rollback(ctx)
 		return errors.InternalError(job.EntityJob, "unable to save job spec, rows affected 0", nil)
 	}
+
+	if err := addSpecVersion(ctx, tx, storageJob); err != nil {
+		tx.Rollback(ctx)
+		return err
+	}
+	tx.Commit(ctx)
 	return nil
 }
 
@@ -120,6 +135,27 @@ WHERE project_name = $4 AND namespace_name = $5 AND name = any ($3);`
 	return nil
 }
 
+func (j JobRepository) GetJobNamesByState(ctx context.Context, jobTenant tenant.Tenant, jobState job.State) ([]job.Name, error) {
+	getJobNamesByStateQuery := `SELECT name FROM job
+WHERE project_name = $1 AND namespace_name = $2 AND state = $3 AND deleted_at IS NULL;`
+
+	rows, err := j.db.Query(ctx, getJobNamesByStateQuery, jobTenant.ProjectName(), jobTenant.NamespaceName(), jobState)
+	if err != nil {
+		return nil, errors.Wrap(job.EntityJob, "error while getting job names by state", err)
+	}
+	defer rows.Close()
+
+	var jobNames []job.Name
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, errors.Wrap(job.EntityJob, "error while getting job names by state", err)
+		}
+		jobNames = append(jobNames, job.Name(name))
+	}
+	return jobNames, nil
+}
+
 func (j JobRepository) SyncState(ctx context.Context, jobTenant tenant.Tenant, disabledJobNames, enabledJobNames []job.Name) error {
 	tx, err := j.db.Begin(ctx)
 	if err != nil {
@@ -163,6 +199,10 @@ func (j JobRepository) ChangeJobNamespace(ctx context.Context, jobName job.Name,
 		tx.Rollback(ctx)
 		return err
 	}
+	if err = changeJobHistoryNamespace(ctx, tx, jobName, tenant, newTenant); err != nil {
+		tx.Rollback(ctx)
+		return err
+	}
 	tx.Commit(ctx)
 	return nil
 }
@@ -223,6 +263,128 @@ WHERE
 	return nil
 }
 
+// changeJobHistoryNamespace moves the replays and the other records of the job owned by its namespace
+func changeJobHistoryNamespace(ctx context.Context, tx pgx.Tx, jobName job.Name, tenant, newTenant tenant.Tenant) error {
+	queries := []string{
+		`UPDATE replay_request SET namespace_name = $1 WHERE job_name = $2 AND project_name = $3 AND namespace_name = $4`,
+		`UPDATE job_run_duration_stats SET namespace_name = $1 WHERE job_name = $2 AND project_name = $3 AND namespace_name = $4`,
+		`UPDATE job_run_trigger SET namespace_name = $1 WHERE job_name = $2 AND project_name = $3 AND namespace_name = $4`,
+		`UPDATE alert_silence SET namespace_name = $1 WHERE job_name = $2 AND project_name = $3 AND namespace_name = $4`,
+	}
+	for _, query := range queries {
+		_, err := tx.Exec(ctx, query, newTenant.NamespaceName(), jobName, tenant.ProjectName(), tenant.NamespaceName())
+		if err != nil {
+			return errors.Wrap(job.EntityJob, "error during change of job history namespace", err)
+		}
+	}
+	return nil
+}
+
+// ChangeJobName renames the job in a single transaction, along with the upstream references of its downstream jobs
+// and the records of its runs and replays
+func (j JobRepository) ChangeJobName(ctx context.Context, jobTenant tenant.Tenant, jobName, newJobName job.Name) error {
+	tx, err := j.db.Begin(ctx)
+	if err != nil {
+		return errors.InternalError(job.EntityJob, "unable to begin transaction", err)
+	}
+
+	if err = changeJobName(ctx, tx, jobTenant, jobName, newJobName); err != nil {
+		tx.Rollback(ctx)
+		return err
+	}
+	if err = changeJobUpstreamName(ctx, tx, jobTenant.ProjectName(), jobName, newJobName); err != nil {
+		tx.Rollback(ctx)
+		return err
+	}
+	if err = changeJobHistoryName(ctx, tx, jobTenant.ProjectName(), jobName, newJobName); err != nil {
+		tx.Rollback(ctx)
+		return err
+	}
+	tx.Commit(ctx)
+	return nil
+}
+
+func changeJobName(ctx context.Context, tx pgx.Tx, jobTenant tenant.Tenant, jobName, newJobName job.Name) error {
+	// soft deleted jobs keep their names, so they are checked as well
+	var exists bool
+	err := tx.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM job WHERE project_name = $1 AND name = $2)`,
+		jobTenant.ProjectName(), newJobName).Scan(&exists)
+	if err != nil {
+		return errors.Wrap(job.EntityJob, "error checking the new job name", err)
+	}
+	if exists {
+		return errors.NewError(errors.ErrAlreadyExists, job.EntityJob, fmt.Sprintf("job %s already exists in project %s", newJobName, jobTenant.ProjectName()))
+	}
+
+	changeJobNameQuery := `
+UPDATE job SET
+	name = $1,
+	updated_at = NOW()
+WHERE
+	name = $2 AND
+	project_name = $3 AND
+	namespace_name = $4 AND
+	deleted_at IS NULL
+;`
+	tag, err := tx.Exec(ctx, changeJobNameQuery, newJobName, jobName, jobTenant.ProjectName(), jobTenant.NamespaceName())
+	if err != nil {
+		return errors.Wrap(job.EntityJob, err.Error(), err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return errors.NotFound(job.EntityJob, fmt.Sprintf("job %s not found in namespace %s", jobName, jobTenant.NamespaceName()))
+	}
+	return nil
+}
+
+func changeJobUpstreamName(ctx context.Context, tx pgx.Tx, projectName tenant.ProjectName, jobName, newJobName job.Name) error {
+	fullName := job.FullNameFrom(projectName, jobName).String()
+	newFullName := job.FullNameFrom(projectName, newJobName).String()
+
+	// static upstreams refer to the jobs of the same project by name, and to the jobs of other projects by full name
+	queries := []struct {
+		query string
+		args  []interface{}
+	}{
+		{`UPDATE job_upstream SET job_name = $1 WHERE project_name = $2 AND job_name = $3`, []interface{}{newJobName, projectName, jobName}},
+		{`UPDATE job_upstream SET upstream_job_name = $1 WHERE upstream_project_name = $2 AND upstream_job_name = $3`, []interface{}{newJobName, projectName, jobName}},
+		{`UPDATE job_column_lineage SET job_name = $1 WHERE project_name = $2 AND job_name = $3`, []interface{}{newJobName, projectName, jobName}},
+		{`UPDATE job_upstream_cache SET job_name = $1 WHERE project_name = $2 AND job_name = $3`, []interface{}{newJobName, projectName, jobName}},
+		{`UPDATE job SET static_upstreams = array_replace(static_upstreams, $1, $2) WHERE project_name = $3 AND $1 = any (static_upstreams)`, []interface{}{jobName.String(), newJobName.String(), projectName}},
+		{`UPDATE job SET static_upstreams = array_replace(static_upstreams, $1, $2) WHERE $1 = any (static_upstreams)`, []interface{}{fullName, newFullName}},
+	}
+	for _, q := range queries {
+		if _, err := tx.Exec(ctx, q.query, q.args...); err != nil {
+			return errors.Wrap(job.EntityJob, "error during change of job upstream name", err)
+		}
+	}
+	return nil
+}
+
+// changeJobHistoryName keeps the runs, replays and the other records of the job linked to it after the rename
+func changeJobHistoryName(ctx context.Context, tx pgx.Tx, projectName tenant.ProjectName, jobName, newJobName job.Name) error {
+	queries := []string{
+		`UPDATE job_run SET job_name = $1 WHERE project_name = $2 AND job_name = $3`,
+		`UPDATE job_spec_version SET job_name = $1 WHERE project_name = $2 AND job_name = $3`,
+		`UPDATE replay_request SET job_name = $1 WHERE project_name = $2 AND job_name = $3`,
+		`UPDATE job_run_duration_stats SET job_name = $1 WHERE project_name = $2 AND job_name = $3`,
+		`UPDATE job_run_trigger SET job_name = $1 WHERE project_name = $2 AND job_name = $3`,
+		`UPDATE run_snapshot SET job_name = $1 WHERE project_name = $2 AND job_name = $3`,
+		`UPDATE alert_silence SET job_name = $1 WHERE project_name = $2 AND job_name = $3`,
+		`UPDATE job_deletion_consent SET job_name = $1 WHERE project_name = $2 AND job_name = $3`,
+		`UPDATE job_deletion_consent SET downstream_job_name = $1 WHERE downstream_project_name = $2 AND downstream_job_name = $3`,
+		`UPDATE job_deletion_audit SET job_name = $1 WHERE project_name = $2 AND job_name = $3`,
+		`UPDATE upstream_access_request SET downstream_job_name = $1 WHERE downstream_project_name = $2 AND downstream_job_name = $3`,
+		`UPDATE upstream_access_request SET upstream_job_name = $1 WHERE upstream_project_name = $2 AND upstream_job_name = $3`,
+	}
+	for _, query := range queries {
+		if _, err := tx.Exec(ctx, query, newJobName, projectName, jobName); err != nil {
+			return errors.Wrap(job.EntityJob, "error during change of job history name", err)
+		}
+	}
+	return nil
+}
+
 func (j JobRepository) preCheckUpdate(ctx context.Context, jobEntity *job.Job) error {
 	existingJob, err := j.get(ctx, jobEntity.ProjectName(), jobEntity.Spec().Name(), false)
 	if err != nil && errors.IsErrorType(err, errors.ErrNotFound) {
@@ -257,25 +419,40 @@ UPDATE job SET
 	version = $1, owner = $2, description = $3, labels = $4, schedule = $5, alert = $6,
 	static_upstreams = $7, http_upstreams = $8, task_name = $9, task_config = $10,
 	window_spec = $11, assets = $12, hooks = $13, metadata = $14, destination = $15, sources = $16,
+	ignored_upstreams = $17, extra_upstreams = $18, task_version = $19,
 	updated_at = NOW(), deleted_at = null
 WHERE
-	name = $17 AND
-	project_name = $18;`
+	name = $20 AND
+	project_name = $21;`
+
+	tx, err := j.db.Begin(ctx)
+	if err != nil {
+		return errors.InternalError(job.EntityJob, "unable to begin transaction", err)
+	}
 
-	tag, err := j.db.Exec(ctx, updateJobQuery,
+	tag, err := tx.Exec(ctx, updateJobQuery,
 		storageJob.Version, storageJob.Owner, storageJob.Description,
 		storageJob.Labels, storageJob.Schedule, storageJob.Alert,
 		storageJob.StaticUpstreams, storageJob.HTTPUpstreams, storageJob.TaskName, storageJob.TaskConfig,
 		storageJob.WindowSpec, storageJob.Assets, storageJob.Hooks, storageJob.Metadata,
 		storageJob.Destination, storageJob.Sources,
+		storageJob.IgnoredUpstreams, storageJob.ExtraUpstreams, storageJob.TaskVersion,
 		storageJob.Name, storageJob.ProjectName)
 	if err != nil {
+		tx.Rollback(ctx)
 		return errors.Wrap(job.EntityJob, "unable to update job spec", err)
 	}
 
 	if tag.RowsAffected() == 0 {
+		tx.Rollback(ctx)
 		return errors.InternalError(job.EntityJob, "unable to update job spec, rows affected 0", nil)
 	}
+
+	if err := addSpecVersion(ctx, tx, storageJob); err != nil {
+		tx.Rollback(ctx)
+		return err
+	}
+	tx.Commit(ctx)
 	return nil
 }
 
@@ -595,6 +772,38 @@ func (j *JobWithUpstream) getJobFullName() string {
 	return j.ProjectName + "/" + j.JobName
 }
 
+// GetAllBySource returns the jobs reading from the resource, as generated by their plugins
+func (j JobRepository) GetAllBySource(ctx context.Context, source job.ResourceURN) ([]*job.Job, error) {
+	me := errors.NewMultiError("get all job specs by source")
+
+	getAllBySource := `SELECT ` + jobColumns + ` FROM job WHERE $1 = any(sources) AND deleted_at IS NULL;`
+
+	rows, err := j.db.Query(ctx, getAllBySource, source)
+	if err != nil {
+		return nil, errors.Wrap(job.EntityJob, "error while jobs for source:  "+source.String(), err)
+	}
+	defer rows.Close()
+
+	var jobs []*job.Job
+	for rows.Next() {
+		spec, err := FromRow(rows)
+		if err != nil {
+			me.Append(err)
+			continue
+		}
+
+		jobSpec, err := specToJob(spec)
+		if err != nil {
+			me.Append(err)
+			continue
+		}
+
+		jobs = append(jobs, jobSpec)
+	}
+
+	return jobs, me.ToErr()
+}
+
 func (j JobRepository) ReplaceUpstreams(ctx context.Context, jobsWithUpstreams []*job.WithUpstream) error {
 	var jobUpstreams []*JobWithUpstream
 	for _, jobWithUpstreams := range jobsWithUpstreams {
@@ -621,10 +830,95 @@ func (j JobRepository) ReplaceUpstreams(ctx context.Context, jobsWithUpstreams [
 		return err
 	}
 
+	if err = j.deleteColumnLineagesByJobNames(ctx, tx, jobFullName); err != nil {
+		tx.Rollback(ctx)
+		return err
+	}
+	if err = j.insertColumnLineages(ctx, tx, jobsWithUpstreams); err != nil {
+		tx.Rollback(ctx)
+		return err
+	}
+
 	tx.Commit(ctx)
 	return nil
 }
 
+func (JobRepository) insertColumnLineages(ctx context.Context, tx pgx.Tx, jobsWithUpstreams []*job.WithUpstream) error {
+	insertColumnLineageQuery := `
+INSERT INTO job_column_lineage (
+	job_id, job_name, project_name,
+	destination_urn, target_column,
+	source_resource_urn, source_column,
+	created_at
+)
+VALUES (
+	(select id FROM job WHERE name = $1 and project_name = $2), $1, $2,
+	$3, $4,
+	$5, $6,
+	NOW()
+);`
+
+	for _, jobWithUpstream := range jobsWithUpstreams {
+		subjectJob := jobWithUpstream.Job()
+		for _, columnLineage := range subjectJob.ColumnLineages() {
+			tag, err := tx.Exec(ctx, insertColumnLineageQuery,
+				subjectJob.Spec().Name(), subjectJob.ProjectName(),
+				subjectJob.Destination(), columnLineage.TargetColumn(),
+				columnLineage.Source(), columnLineage.SourceColumn())
+			if err != nil {
+				return errors.InternalError(job.EntityJob, "unable to save job column lineage", err)
+			}
+
+			if tag.RowsAffected() == 0 {
+				return errors.NewError(errors.ErrInternalError, job.EntityJob, "unable to save job column lineage, rows affected 0")
+			}
+		}
+	}
+	return nil
+}
+
+func (JobRepository) deleteColumnLineagesByJobNames(ctx context.Context, tx pgx.Tx, jobFullNames []string) error {
+	deleteForProjectScope := `DELETE
+FROM job_column_lineage
+WHERE project_name || '/' || job_name = any ($1);`
+
+	_, err := tx.Exec(ctx, deleteForProjectScope, jobFullNames)
+	if err != nil {
+		return errors.Wrap(job.EntityJob, "error during delete of job column lineage", err)
+	}
+
+	return nil
+}
+
+// GetColumnDownstreams returns the active jobs reading the column of the source resource, across projects
+func (j JobRepository) GetColumnDownstreams(ctx context.Context, source job.ResourceURN, column string) ([]*job.ColumnDownstream, error) {
+	query := `
+SELECT
+	l.job_name, l.project_name, l.destination_urn, l.target_column
+FROM job_column_lineage l
+JOIN job j ON l.job_id = j.id
+WHERE l.source_resource_urn = $1 AND l.source_column = $2
+AND j.deleted_at IS NULL
+ORDER BY l.project_name, l.job_name, l.target_column;`
+
+	rows, err := j.db.Query(ctx, query, source, column)
+	if err != nil {
+		return nil, errors.Wrap(job.EntityJob, "error while getting column downstreams", err)
+	}
+	defer rows.Close()
+
+	var columnDownstreams []*job.ColumnDownstream
+	for rows.Next() {
+		var jobName, projectName, destination, targetColumn string
+		if err := rows.Scan(&jobName, &projectName, &destination, &targetColumn); err != nil {
+			return nil, errors.Wrap(job.EntityJob, "error while getting column downstreams", err)
+		}
+		columnDownstreams = append(columnDownstreams, job.NewColumnDownstream(job.Name(jobName), tenant.ProjectName(projectName), job.ResourceURN(destination), targetColumn))
+	}
+
+	return columnDownstreams, nil
+}
+
 func (JobRepository) insertUpstreams(ctx context.Context, tx pgx.Tx, storageJobUpstreams []*JobWithUpstream) error {
 	insertResolvedUpstreamQuery := `
 INSERT INTO job_upstream (
@@ -767,6 +1061,10 @@ func (j JobRepository) hardDelete(ctx context.Context, projectName tenant.Projec
 	if tag.RowsAffected() == 0 {
 		return errors.NewError(errors.ErrInternalError, job.EntityJob, fmt.Sprintf("job %s failed to be deleted", jobName.String()))
 	}
+
+	if _, err := j.db.Exec(ctx, `DELETE FROM job_spec_version WHERE project_name = $1 AND job_name = $2`, projectName, jobName); err != nil {
+		return errors.Wrap(job.EntityJob, "error during job spec versions deletion", err)
+	}
 	return nil
 }
 
@@ -783,6 +1081,51 @@ func (j JobRepository) softDelete(ctx context.Context, projectName tenant.Projec
 	return nil
 }
 
+// BulkDelete deletes the jobs along with their stored upstreams and column lineages in a single transaction,
+// none of the jobs is deleted when one of them fails to be deleted
+func (j JobRepository) BulkDelete(ctx context.Context, projectName tenant.ProjectName, jobNames []job.Name, cleanHistory bool) error {
+	tx, err := j.db.Begin(ctx)
+	if err != nil {
+		return errors.InternalError(job.EntityJob, "unable to begin transaction", err)
+	}
+
+	jobFullNames := make([]string, len(jobNames))
+	for i, jobName := range jobNames {
+		jobFullNames[i] = job.FullNameFrom(projectName, jobName).String()
+	}
+	if err = j.deleteUpstreamsByJobNames(ctx, tx, jobFullNames); err != nil {
+		tx.Rollback(ctx)
+		return err
+	}
+	if err = j.deleteColumnLineagesByJobNames(ctx, tx, jobFullNames); err != nil {
+		tx.Rollback(ctx)
+		return err
+	}
+
+	query := `UPDATE job SET deleted_at = current_timestamp WHERE project_name = $1 AND name = any ($2) AND deleted_at IS NULL`
+	if cleanHistory {
+		query = `DELETE FROM job WHERE project_name = $1 AND name = any ($2)`
+	}
+	tag, err := tx.Exec(ctx, query, projectName, jobNames)
+	if err != nil {
+		tx.Rollback(ctx)
+		return errors.Wrap(job.EntityJob, "error during jobs deletion", err)
+	}
+	if tag.RowsAffected() != int64(len(jobNames)) {
+		tx.Rollback(ctx)
+		return errors.NewError(errors.ErrInternalError, job.EntityJob, fmt.Sprintf("%d of %d jobs failed to be deleted", len(jobNames)-int(tag.RowsAffected()), len(jobNames)))
+	}
+	if cleanHistory {
+		if _, err := tx.Exec(ctx, `DELETE FROM job_spec_version WHERE project_name = $1 AND job_name = any ($2)`, projectName, jobNames); err != nil {
+			tx.Rollback(ctx)
+			return errors.Wrap(job.EntityJob, "error during job spec versions deletion", err)
+		}
+	}
+
+	tx.Commit(ctx)
+	return nil
+}
+
 func (j JobRepository) GetAllByTenant(ctx context.Context, jobTenant tenant.Tenant) ([]*job.Job, error) {
 	me := errors.NewMultiError("get all job specs by project name errors")
 
@@ -709,6 +709,34 @@ func TestPostgresJobRepository(t *testing.T) {
 			assert.NoError(t, err)
 			assert.EqualValues(t, []*job.Upstream{upstreamC}, upstreamsOfJobA)
 		})
+		t.Run("replaces column lineages of the job along with its upstreams", func(t *testing.T) {
+			db := dbSetup()
+
+			jobUpstreamRepo := postgres.NewJobRepository(db)
+			_, err = jobUpstreamRepo.Add(ctx, []*job.Job{jobA, jobB, jobC})
+			assert.NoError(t, err)
+
+			idLineage, err := job.NewColumnLineage("dev.resource.sample_c", "customer_id", "id")
+			assert.NoError(t, err)
+			nameLineage, err := job.NewColumnLineage("dev.resource.sample_c", "customer_name", "name")
+			assert.NoError(t, err)
+
+			jobAWithUpstream := job.NewWithUpstream(jobA.WithColumnLineages([]*job.ColumnLineage{idLineage, nameLineage}), nil)
+			assert.NoError(t, jobUpstreamRepo.ReplaceUpstreams(ctx, []*job.WithUpstream{jobAWithUpstream}))
+
+			columnDownstreams, err := jobUpstreamRepo.GetColumnDownstreams(ctx, "dev.resource.sample_c", "customer_id")
+			assert.NoError(t, err)
+			assert.EqualValues(t, []*job.ColumnDownstream{
+				job.NewColumnDownstream(jobA.Spec().Name(), proj.Name(), jobA.Destination(), "id"),
+			}, columnDownstreams)
+
+			jobAWithUpstream = job.NewWithUpstream(jobA.WithColumnLineages([]*job.ColumnLineage{nameLineage}), nil)
+			assert.NoError(t, jobUpstreamRepo.ReplaceUpstreams(ctx, []*job.WithUpstream{jobAWithUpstream}))
+
+			columnDownstreams, err = jobUpstreamRepo.GetColumnDownstreams(ctx, "dev.resource.sample_c", "customer_id")
+			assert.NoError(t, err)
+			assert.Empty(t, columnDownstreams)
+		})
 		t.Run("deletes existing job upstream without inserts if no longer upstream found", func(t *testing.T) {
 			db := dbSetup()
 
@@ -799,6 +827,99 @@ func TestPostgresJobRepository(t *testing.T) {
 		})
 	})
 
+	t.Run("GetSpecVersions", func(t *testing.T) {
+		t.Run("returns every deployed version of the job spec, the latest first", func(t *testing.T) {
+			db := dbSetup()
+
+			jobSpecA, err := job.NewSpecBuilder(jobVersion, "sample-job-A", jobOwner, jobSchedule, customConfig, jobTask).WithDescription(jobDescription).Build()
+			assert.NoError(t, err)
+			jobA := job.NewJob(sampleTenant, jobSpecA, "dev.resource.sample_a", nil)
+
+			jobRepo := postgres.NewJobRepository(db)
+			_, err = jobRepo.Add(ctx, []*job.Job{jobA})
+			assert.NoError(t, err)
+
+			updatedSpecA, err := job.NewSpecBuilder(jobVersion, "sample-job-A", "new-owner", jobSchedule, customConfig, jobTask).WithDescription(jobDescription).Build()
+			assert.NoError(t, err)
+			_, err = jobRepo.Update(ctx, []*job.Job{job.NewJob(sampleTenant, updatedSpecA, "dev.resource.sample_a", nil)})
+			assert.NoError(t, err)
+
+			versions, err := jobRepo.GetSpecVersions(ctx, proj.Name(), jobSpecA.Name())
+			assert.NoError(t, err)
+			assert.Len(t, versions, 2)
+			assert.Equal(t, 2, versions[0].Version)
+			assert.Equal(t, "new-owner", versions[0].Spec.Owner())
+			assert.Equal(t, 1, versions[1].Version)
+			assert.Equal(t, jobOwner, versions[1].Spec.Owner())
+
+			version, err := jobRepo.GetSpecVersion(ctx, proj.Name(), jobSpecA.Name(), 1)
+			assert.NoError(t, err)
+			assert.Equal(t, jobSpecA.Name(), version.Spec.Name())
+
+			_, err = jobRepo.GetSpecVersion(ctx, proj.Name(), jobSpecA.Name(), 3)
+			assert.ErrorContains(t, err, "version 3 of job sample-job-A is not found")
+		})
+	})
+
+	t.Run("ChangeJobName", func(t *testing.T) {
+		jobSpecA, err := job.NewSpecBuilder(jobVersion, "sample-job-A", jobOwner, jobSchedule, customConfig, jobTask).WithDescription(jobDescription).Build()
+		assert.NoError(t, err)
+		jobA := job.NewJob(sampleTenant, jobSpecA, "dev.resource.sample_a", nil)
+
+		upstreamSpec, err := job.NewSpecUpstreamBuilder().WithUpstreamNames([]job.SpecUpstreamName{"sample-job-A"}).Build()
+		assert.NoError(t, err)
+		jobSpecB, err := job.NewSpecBuilder(jobVersion, "sample-job-B", jobOwner, jobSchedule, customConfig, jobTask).WithSpecUpstream(upstreamSpec).Build()
+		assert.NoError(t, err)
+		jobB := job.NewJob(sampleTenant, jobSpecB, "dev.resource.sample_b", nil)
+
+		t.Run("renames the job along with the upstream references of its downstream", func(t *testing.T) {
+			db := dbSetup()
+
+			jobRepo := postgres.NewJobRepository(db)
+			_, err := jobRepo.Add(ctx, []*job.Job{jobA, jobB})
+			assert.NoError(t, err)
+
+			upstreamAStatic := job.NewUpstreamResolved("sample-job-A", "host-1", "dev.resource.sample_a", sampleTenant, "static", taskName, false)
+			err = jobRepo.ReplaceUpstreams(ctx, []*job.WithUpstream{job.NewWithUpstream(jobB, []*job.Upstream{upstreamAStatic})})
+			assert.NoError(t, err)
+
+			err = jobRepo.ChangeJobName(ctx, sampleTenant, jobSpecA.Name(), "sample-job-A-renamed")
+			assert.NoError(t, err)
+
+			_, err = jobRepo.GetByJobName(ctx, proj.Name(), jobSpecA.Name())
+			assert.Error(t, err)
+			renamedJob, err := jobRepo.GetByJobName(ctx, proj.Name(), "sample-job-A-renamed")
+			assert.NoError(t, err)
+			assert.Equal(t, "sample-job-A-renamed", renamedJob.Spec().Name().String())
+
+			upstreams, err := jobRepo.GetUpstreams(ctx, proj.Name(), jobSpecB.Name())
+			assert.NoError(t, err)
+			assert.Len(t, upstreams, 1)
+			assert.Equal(t, "sample-job-A-renamed", upstreams[0].Name().String())
+
+			storedJobB, err := jobRepo.GetByJobName(ctx, proj.Name(), jobSpecB.Name())
+			assert.NoError(t, err)
+			assert.EqualValues(t, []job.SpecUpstreamName{"sample-job-A-renamed"}, storedJobB.Spec().UpstreamSpec().UpstreamNames())
+		})
+		t.Run("returns error if a job with the new name already exists", func(t *testing.T) {
+			db := dbSetup()
+
+			jobRepo := postgres.NewJobRepository(db)
+			_, err := jobRepo.Add(ctx, []*job.Job{jobA, jobB})
+			assert.NoError(t, err)
+
+			err = jobRepo.ChangeJobName(ctx, sampleTenant, jobSpecA.Name(), jobSpecB.Name())
+			assert.ErrorContains(t, err, "job sample-job-B already exists")
+		})
+		t.Run("returns error if the job is not found in the namespace", func(t *testing.T) {
+			db := dbSetup()
+
+			jobRepo := postgres.NewJobRepository(db)
+			err := jobRepo.ChangeJobName(ctx, sampleTenant, jobSpecA.Name(), "sample-job-A-renamed")
+			assert.ErrorContains(t, err, "job sample-job-A not found")
+		})
+	})
+
 	t.Run("Delete", func(t *testing.T) {
 		t.Run("soft delete a job if not asked to do clean delete", func(t *testing.T) {
 			db := dbSetup()
@@ -906,6 +1027,59 @@ func TestPostgresJobRepository(t *testing.T) {
 		})
 	})
 
+	t.Run("BulkDelete", func(t *testing.T) {
+		t.Run("deletes the jobs along with their upstreams", func(t *testing.T) {
+			db := dbSetup()
+
+			jobSpecA, err := job.NewSpecBuilder(jobVersion, "sample-job-A", jobOwner, jobSchedule, customConfig, jobTask).WithDescription(jobDescription).Build()
+			assert.NoError(t, err)
+			jobA := job.NewJob(sampleTenant, jobSpecA, "dev.resource.sample_a", nil)
+
+			jobSpecX, err := job.NewSpecBuilder(jobVersion, "sample-job-X", jobOwner, jobSchedule, customConfig, jobTask).WithDescription(jobDescription).Build()
+			assert.NoError(t, err)
+			jobX := job.NewJob(sampleTenant, jobSpecX, "dev.resource.sample_x", []job.ResourceURN{"dev.resource.sample_a"})
+
+			jobRepo := postgres.NewJobRepository(db)
+
+			_, err = jobRepo.Add(ctx, []*job.Job{jobA, jobX})
+			assert.NoError(t, err)
+
+			upstreamAInferred := job.NewUpstreamResolved("sample-job-A", "host-1", "dev.resource.sample_a", sampleTenant, "inferred", taskName, false)
+			err = jobRepo.ReplaceUpstreams(ctx, []*job.WithUpstream{job.NewWithUpstream(jobX, []*job.Upstream{upstreamAInferred})})
+			assert.NoError(t, err)
+
+			err = jobRepo.BulkDelete(ctx, proj.Name(), []job.Name{jobSpecA.Name(), jobSpecX.Name()}, false)
+			assert.NoError(t, err)
+
+			jobs, err := jobRepo.GetAllByTenant(ctx, sampleTenant)
+			assert.NoError(t, err)
+			assert.Empty(t, jobs)
+
+			upstreams, err := jobRepo.GetUpstreams(ctx, proj.Name(), jobSpecX.Name())
+			assert.NoError(t, err)
+			assert.Empty(t, upstreams)
+		})
+		t.Run("deletes none of the jobs if one of them fails to be deleted", func(t *testing.T) {
+			db := dbSetup()
+
+			jobSpecA, err := job.NewSpecBuilder(jobVersion, "sample-job-A", jobOwner, jobSchedule, customConfig, jobTask).WithDescription(jobDescription).Build()
+			assert.NoError(t, err)
+			jobA := job.NewJob(sampleTenant, jobSpecA, "dev.resource.sample_a", nil)
+
+			jobRepo := postgres.NewJobRepository(db)
+
+			_, err = jobRepo.Add(ctx, []*job.Job{jobA})
+			assert.NoError(t, err)
+
+			err = jobRepo.BulkDelete(ctx, proj.Name(), []job.Name{jobSpecA.Name(), "sample-job-unknown"}, true)
+			assert.ErrorContains(t, err, "1 of 2 jobs failed to be deleted")
+
+			jobs, err := jobRepo.GetAllByTenant(ctx, sampleTenant)
+			assert.NoError(t, err)
+			assert.Len(t, jobs, 1)
+		})
+	})
+
 	t.Run("GetByJobName", func(t *testing.T) {
 		t.Run("returns job success", func(t *testing.T) {
 			db := dbSetup()
@@ -1036,6 +1210,33 @@ func TestPostgresJobRepository(t *testing.T) {
 		})
 	})
 
+	t.Run("GetAllBySource", func(t *testing.T) {
+		t.Run("returns the active jobs reading from the source", func(t *testing.T) {
+			db := dbSetup()
+
+			jobSpecA, err := job.NewSpecBuilder(jobVersion, "sample-job-A", jobOwner, jobSchedule, customConfig, jobTask).WithDescription(jobDescription).Build()
+			assert.NoError(t, err)
+			jobA := job.NewJob(sampleTenant, jobSpecA, "dev.resource.sample_a", []job.ResourceURN{"dev.resource.sample_b", "dev.resource.sample_c"})
+			jobSpecB, err := job.NewSpecBuilder(jobVersion, "sample-job-B", jobOwner, jobSchedule, customConfig, jobTask).WithDescription(jobDescription).Build()
+			assert.NoError(t, err)
+			jobB := job.NewJob(sampleTenant, jobSpecB, "dev.resource.sample_d", []job.ResourceURN{"dev.resource.sample_c"})
+			jobSpecC, err := job.NewSpecBuilder(jobVersion, "sample-job-C", jobOwner, jobSchedule, customConfig, jobTask).WithDescription(jobDescription).Build()
+			assert.NoError(t, err)
+			jobC := job.NewJob(sampleTenant, jobSpecC, "dev.resource.sample_e", []job.ResourceURN{"dev.resource.sample_c"})
+
+			jobRepo := postgres.NewJobRepository(db)
+			_, err = jobRepo.Add(ctx, []*job.Job{jobA, jobB, jobC})
+			assert.NoError(t, err)
+
+			err = jobRepo.Delete(ctx, sampleTenant.ProjectName(), jobSpecC.Name(), false)
+			assert.NoError(t, err)
+
+			actual, err := jobRepo.GetAllBySource(ctx, "dev.resource.sample_c")
+			assert.NoError(t, err)
+			assert.Equal(t, []*job.Job{jobA, jobB}, actual)
+		})
+	})
+
 	t.Run("GetUpstreams", func(t *testing.T) {
 		t.Run("returns upstream given project and job name", func(t *testing.T) {
 			// TODO: test is failing for nullable fields in upstream
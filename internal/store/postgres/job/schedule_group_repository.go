@@ -0,0 +1,100 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/goto/optimus/core/job"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+)
+
+const scheduleGroupColumns = `project_name, name, kind, job_names, max_offset_minutes, spacing_minutes, created_at, updated_at`
+
+type ScheduleGroupRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewScheduleGroupRepository(pool *pgxpool.Pool) *ScheduleGroupRepository {
+	return &ScheduleGroupRepository{db: pool}
+}
+
+// Upsert stores the schedule group, replacing the one of the same name in the project
+func (r ScheduleGroupRepository) Upsert(ctx context.Context, group *job.ScheduleGroup) error {
+	upsertGroup := `INSERT INTO job_schedule_group (` + scheduleGroupColumns + `) VALUES ($1, $2, $3, $4, $5, $6, NOW(), NOW())
+		ON CONFLICT (project_name, name) DO UPDATE SET kind = EXCLUDED.kind, job_names = EXCLUDED.job_names,
+		max_offset_minutes = EXCLUDED.max_offset_minutes, spacing_minutes = EXCLUDED.spacing_minutes, updated_at = EXCLUDED.updated_at`
+	_, err := r.db.Exec(ctx, upsertGroup, group.ProjectName, group.Name, group.Kind, namesToStrings(group.JobNames),
+		int(group.MaxOffset/time.Minute), int(group.Spacing/time.Minute))
+	if err != nil {
+		return errors.Wrap(job.EntityScheduleGroup, "unable to store schedule group "+group.Name, err)
+	}
+	return nil
+}
+
+func (r ScheduleGroupRepository) Get(ctx context.Context, projectName tenant.ProjectName, name string) (*job.ScheduleGroup, error) {
+	getGroup := `SELECT ` + scheduleGroupColumns + ` FROM job_schedule_group WHERE project_name = $1 AND name = $2`
+	group, err := scanScheduleGroup(r.db.QueryRow(ctx, getGroup, projectName, name))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.NotFound(job.EntityScheduleGroup, "schedule group "+name+" is not found")
+		}
+		return nil, errors.Wrap(job.EntityScheduleGroup, "unable to get schedule group "+name, err)
+	}
+	return group, nil
+}
+
+func (r ScheduleGroupRepository) GetAll(ctx context.Context, projectName tenant.ProjectName) ([]*job.ScheduleGroup, error) {
+	getGroups := `SELECT ` + scheduleGroupColumns + ` FROM job_schedule_group WHERE project_name = $1 ORDER BY name`
+	rows, err := r.db.Query(ctx, getGroups, projectName)
+	if err != nil {
+		return nil, errors.Wrap(job.EntityScheduleGroup, "unable to get schedule groups", err)
+	}
+	defer rows.Close()
+
+	var groups []*job.ScheduleGroup
+	for rows.Next() {
+		group, err := scanScheduleGroup(rows)
+		if err != nil {
+			return nil, errors.Wrap(job.EntityScheduleGroup, "unable to get the stored schedule group", err)
+		}
+		groups = append(groups, group)
+	}
+	return groups, nil
+}
+
+func scanScheduleGroup(row pgx.Row) (*job.ScheduleGroup, error) {
+	var project, name, kind string
+	var jobNames []string
+	var maxOffsetMinutes, spacingMinutes int
+	var createdAt, updatedAt time.Time
+	if err := row.Scan(&project, &name, &kind, &jobNames, &maxOffsetMinutes, &spacingMinutes, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+
+	names := make([]job.Name, len(jobNames))
+	for i, jobName := range jobNames {
+		names[i] = job.Name(jobName)
+	}
+	return &job.ScheduleGroup{
+		ProjectName: tenant.ProjectName(project),
+		Name:        name,
+		Kind:        job.ScheduleGroupKind(kind),
+		JobNames:    names,
+		MaxOffset:   time.Duration(maxOffsetMinutes) * time.Minute,
+		Spacing:     time.Duration(spacingMinutes) * time.Minute,
+		CreatedAt:   createdAt,
+		UpdatedAt:   updatedAt,
+	}, nil
+}
+
+func namesToStrings(names []job.Name) []string {
+	values := make([]string, len(names))
+	for i, name := range names {
+		values[i] = name.String()
+	}
+	return values
+}
@@ -0,0 +1,85 @@
+//go:build !unit_test
+
+package job_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/core/job"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+	postgres "github.com/goto/optimus/internal/store/postgres/job"
+	tenantPostgres "github.com/goto/optimus/internal/store/postgres/tenant"
+	"github.com/goto/optimus/tests/setup"
+)
+
+func TestPostgresScheduleGroupRepository(t *testing.T) {
+	ctx := context.Background()
+
+	proj, err := tenant.NewProject("test-proj",
+		map[string]string{
+			"bucket":                     "gs://some_folder-2",
+			tenant.ProjectSchedulerHost:  "host",
+			tenant.ProjectStoragePathKey: "gs://location",
+		})
+	assert.NoError(t, err)
+
+	dbSetup := func() *pgxpool.Pool {
+		pool := setup.TestPool()
+		setup.TruncateTablesWith(pool)
+
+		projRepo := tenantPostgres.NewProjectRepository(pool)
+		assert.NoError(t, projRepo.Save(ctx, proj))
+		return pool
+	}
+
+	group, err := job.NewScheduleGroup(proj.Name(), "source-limit", job.ScheduleGroupAntiAffinity, []job.Name{"job-A", "job-B"},
+		time.Hour, 15*time.Minute)
+	assert.NoError(t, err)
+
+	t.Run("Upsert", func(t *testing.T) {
+		t.Run("replaces the group of the same name", func(t *testing.T) {
+			pool := dbSetup()
+			repo := postgres.NewScheduleGroupRepository(pool)
+
+			assert.NoError(t, repo.Upsert(ctx, group))
+
+			updated, err := job.NewScheduleGroup(proj.Name(), "source-limit", job.ScheduleGroupAntiAffinity, []job.Name{"job-A", "job-B", "job-C"},
+				30*time.Minute, 10*time.Minute)
+			assert.NoError(t, err)
+			assert.NoError(t, repo.Upsert(ctx, updated))
+
+			groups, err := repo.GetAll(ctx, proj.Name())
+			assert.NoError(t, err)
+			assert.Len(t, groups, 1)
+			assert.Equal(t, []job.Name{"job-A", "job-B", "job-C"}, groups[0].JobNames)
+			assert.Equal(t, 30*time.Minute, groups[0].MaxOffset)
+			assert.Equal(t, 10*time.Minute, groups[0].Spacing)
+		})
+	})
+	t.Run("Get", func(t *testing.T) {
+		t.Run("returns the group", func(t *testing.T) {
+			pool := dbSetup()
+			repo := postgres.NewScheduleGroupRepository(pool)
+			assert.NoError(t, repo.Upsert(ctx, group))
+
+			stored, err := repo.Get(ctx, proj.Name(), "source-limit")
+			assert.NoError(t, err)
+			assert.Equal(t, job.ScheduleGroupAntiAffinity, stored.Kind)
+			assert.Equal(t, group.JobNames, stored.JobNames)
+			assert.False(t, stored.CreatedAt.IsZero())
+		})
+		t.Run("returns not found when group does not exist", func(t *testing.T) {
+			pool := dbSetup()
+			repo := postgres.NewScheduleGroupRepository(pool)
+
+			_, err := repo.Get(ctx, proj.Name(), "unknown")
+			assert.True(t, errors.IsErrorType(err, errors.ErrNotFound))
+		})
+	})
+}
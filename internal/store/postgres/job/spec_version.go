@@ -0,0 +1,89 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/goto/optimus/core/job"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+)
+
+// addSpecVersion stores the deployed spec as the next version of the job
+func addSpecVersion(ctx context.Context, tx pgx.Tx, storageJob *Spec) error {
+	spec, err := json.Marshal(storageJob)
+	if err != nil {
+		return errors.Wrap(job.EntityJobSpecVersion, "unable to marshal job spec version", err)
+	}
+
+	insertVersion := `INSERT INTO job_spec_version (project_name, job_name, version, spec, created_at)
+SELECT $1, $2, COALESCE(MAX(version), 0) + 1, $3, NOW()
+FROM job_spec_version WHERE project_name = $1 AND job_name = $2`
+	if _, err := tx.Exec(ctx, insertVersion, storageJob.ProjectName, storageJob.Name, spec); err != nil {
+		return errors.Wrap(job.EntityJobSpecVersion, "unable to store job spec version", err)
+	}
+	return nil
+}
+
+// GetSpecVersions returns the deployed versions of the job spec, the latest first
+func (j JobRepository) GetSpecVersions(ctx context.Context, projectName tenant.ProjectName, jobName job.Name) ([]*job.SpecVersion, error) {
+	getVersions := `SELECT version, spec, created_at FROM job_spec_version WHERE project_name = $1 AND job_name = $2 ORDER BY version DESC`
+	rows, err := j.db.Query(ctx, getVersions, projectName, jobName)
+	if err != nil {
+		return nil, errors.Wrap(job.EntityJobSpecVersion, "unable to get job spec versions", err)
+	}
+	defer rows.Close()
+
+	var versions []*job.SpecVersion
+	for rows.Next() {
+		version, err := specVersionFromRow(rows, projectName, jobName)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, version)
+	}
+	return versions, nil
+}
+
+func (j JobRepository) GetSpecVersion(ctx context.Context, projectName tenant.ProjectName, jobName job.Name, version int) (*job.SpecVersion, error) {
+	getVersion := `SELECT version, spec, created_at FROM job_spec_version WHERE project_name = $1 AND job_name = $2 AND version = $3`
+	specVersion, err := specVersionFromRow(j.db.QueryRow(ctx, getVersion, projectName, jobName, version), projectName, jobName)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, errors.NotFound(job.EntityJobSpecVersion, fmt.Sprintf("version %d of job %s is not found", version, jobName))
+	}
+	return specVersion, err
+}
+
+func specVersionFromRow(row pgx.Row, projectName tenant.ProjectName, jobName job.Name) (*job.SpecVersion, error) {
+	var version int
+	var rawSpec []byte
+	var createdAt time.Time
+	if err := row.Scan(&version, &rawSpec, &createdAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, err
+		}
+		return nil, errors.Wrap(job.EntityJobSpecVersion, "unable to get the stored job spec version", err)
+	}
+
+	var storageJob Spec
+	if err := json.Unmarshal(rawSpec, &storageJob); err != nil {
+		return nil, errors.Wrap(job.EntityJobSpecVersion, "unable to unmarshal job spec version", err)
+	}
+	// the versions deployed before the job is renamed are kept under the new name
+	storageJob.Name = jobName.String()
+	spec, err := fromStorageSpec(&storageJob)
+	if err != nil {
+		return nil, err
+	}
+	return &job.SpecVersion{
+		ProjectName: projectName,
+		JobName:     jobName,
+		Version:     version,
+		Spec:        spec,
+		CreatedAt:   createdAt,
+	}, nil
+}
@@ -0,0 +1,89 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/goto/optimus/core/job"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+)
+
+type UpstreamCacheRepository struct {
+	db *pgxpool.Pool
+}
+
+type cachedColumnLineage struct {
+	Source       string
+	SourceColumn string
+	TargetColumn string
+}
+
+func NewUpstreamCacheRepository(pool *pgxpool.Pool) *UpstreamCacheRepository {
+	return &UpstreamCacheRepository{db: pool}
+}
+
+func (r UpstreamCacheRepository) GetResolvedUpstreams(ctx context.Context, projectName tenant.ProjectName, jobName job.Name) (*job.ResolvedUpstreams, error) {
+	getCache := `SELECT spec_hash, sources, column_lineages FROM job_upstream_cache WHERE project_name = $1 AND job_name = $2`
+
+	var hash string
+	var sources []string
+	var columnLineages []cachedColumnLineage
+	err := r.db.QueryRow(ctx, getCache, projectName, jobName).Scan(&hash, &sources, &columnLineages)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.NotFound(job.EntityJob, "no cached upstreams found for job "+jobName.String())
+		}
+		return nil, errors.Wrap(job.EntityJob, "unable to get cached upstreams", err)
+	}
+
+	resolved := &job.ResolvedUpstreams{Hash: hash}
+	for _, source := range sources {
+		resolved.Sources = append(resolved.Sources, job.ResourceURN(source))
+	}
+	for _, stored := range columnLineages {
+		columnLineage, err := job.NewColumnLineage(job.ResourceURN(stored.Source), stored.SourceColumn, stored.TargetColumn)
+		if err != nil {
+			return nil, err
+		}
+		resolved.ColumnLineages = append(resolved.ColumnLineages, columnLineage)
+	}
+	return resolved, nil
+}
+
+// SaveResolvedUpstreams replaces the upstreams cached earlier for the job
+func (r UpstreamCacheRepository) SaveResolvedUpstreams(ctx context.Context, projectName tenant.ProjectName, jobName job.Name, resolved *job.ResolvedUpstreams) error {
+	sources := make([]string, len(resolved.Sources))
+	for i, source := range resolved.Sources {
+		sources[i] = source.String()
+	}
+	columnLineages := make([]cachedColumnLineage, len(resolved.ColumnLineages))
+	for i, columnLineage := range resolved.ColumnLineages {
+		columnLineages[i] = cachedColumnLineage{
+			Source:       columnLineage.Source().String(),
+			SourceColumn: columnLineage.SourceColumn(),
+			TargetColumn: columnLineage.TargetColumn(),
+		}
+	}
+
+	storedSources, err := json.Marshal(sources)
+	if err != nil {
+		return errors.Wrap(job.EntityJob, "unable to marshal cached upstreams", err)
+	}
+	storedColumnLineages, err := json.Marshal(columnLineages)
+	if err != nil {
+		return errors.Wrap(job.EntityJob, "unable to marshal cached column lineages", err)
+	}
+
+	upsertCache := `INSERT INTO job_upstream_cache (project_name, job_name, spec_hash, sources, column_lineages, updated_at)
+		VALUES ($1, $2, $3, $4, $5, NOW())
+		ON CONFLICT (project_name, job_name)
+		DO UPDATE SET spec_hash = EXCLUDED.spec_hash, sources = EXCLUDED.sources, column_lineages = EXCLUDED.column_lineages, updated_at = EXCLUDED.updated_at`
+	if _, err := r.db.Exec(ctx, upsertCache, projectName, jobName, resolved.Hash, storedSources, storedColumnLineages); err != nil {
+		return errors.Wrap(job.EntityJob, "unable to store cached upstreams", err)
+	}
+	return nil
+}
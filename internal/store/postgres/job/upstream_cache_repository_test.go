@@ -0,0 +1,56 @@
+//go:build !unit_test
+
+package job_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/core/job"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+	postgres "github.com/goto/optimus/internal/store/postgres/job"
+	"github.com/goto/optimus/tests/setup"
+)
+
+func TestPostgresUpstreamCacheRepository(t *testing.T) {
+	ctx := context.Background()
+	projectName := tenant.ProjectName("test-proj")
+	jobName := job.Name("job-A")
+
+	t.Run("returns not found error if nothing is cached for the job", func(t *testing.T) {
+		pool := setup.TestPool()
+		setup.TruncateTablesWith(pool)
+		repo := postgres.NewUpstreamCacheRepository(pool)
+
+		resolved, err := repo.GetResolvedUpstreams(ctx, projectName, jobName)
+		assert.True(t, errors.IsErrorType(err, errors.ErrNotFound))
+		assert.Nil(t, resolved)
+	})
+	t.Run("replaces the upstreams cached earlier for the job", func(t *testing.T) {
+		pool := setup.TestPool()
+		setup.TruncateTablesWith(pool)
+		repo := postgres.NewUpstreamCacheRepository(pool)
+
+		assert.NoError(t, repo.SaveResolvedUpstreams(ctx, projectName, jobName, &job.ResolvedUpstreams{
+			Hash:    "hash-1",
+			Sources: []job.ResourceURN{"bigquery://proj:dataset.table1"},
+		}))
+
+		columnLineage, err := job.NewColumnLineage("bigquery://proj:dataset.table2", "id", "user_id")
+		assert.NoError(t, err)
+		assert.NoError(t, repo.SaveResolvedUpstreams(ctx, projectName, jobName, &job.ResolvedUpstreams{
+			Hash:           "hash-2",
+			Sources:        []job.ResourceURN{"bigquery://proj:dataset.table2"},
+			ColumnLineages: []*job.ColumnLineage{columnLineage},
+		}))
+
+		resolved, err := repo.GetResolvedUpstreams(ctx, projectName, jobName)
+		assert.NoError(t, err)
+		assert.Equal(t, "hash-2", resolved.Hash)
+		assert.Equal(t, []job.ResourceURN{"bigquery://proj:dataset.table2"}, resolved.Sources)
+		assert.Equal(t, []*job.ColumnLineage{columnLineage}, resolved.ColumnLineages)
+	})
+}
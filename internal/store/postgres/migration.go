@@ -1,13 +1,16 @@
 package postgres
 
 import (
+	"context"
 	"embed"
 	"errors"
 	"fmt"
+	"io/fs"
 
 	"github.com/golang-migrate/migrate/v4"
 	_ "github.com/golang-migrate/migrate/v4/database/postgres" // required for postgres migrate driver
 	"github.com/golang-migrate/migrate/v4/source/iofs"
+	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 //go:embed migrations
@@ -15,6 +18,8 @@ var migrationFs embed.FS
 
 const (
 	resourcePath = "migrations"
+
+	getMigrationVersion = `SELECT version, dirty FROM schema_migrations LIMIT 1`
 )
 
 func NewMigrator(dbConnURL string) (*migrate.Migrate, error) {
@@ -40,6 +45,50 @@ func Migrate(connURL string) error {
 	return nil
 }
 
+// VerifyMigration checks the database is not left dirty and is migrated at least to the latest embedded migration
+func VerifyMigration(ctx context.Context, db *pgxpool.Pool) error {
+	var version int64
+	var dirty bool
+	if err := db.QueryRow(ctx, getMigrationVersion).Scan(&version, &dirty); err != nil {
+		return fmt.Errorf("error reading migration version: %w", err)
+	}
+	if dirty {
+		return fmt.Errorf("database is dirty at migration version %d", version)
+	}
+
+	latest, err := latestMigrationVersion()
+	if err != nil {
+		return err
+	}
+	if uint(version) < latest {
+		return fmt.Errorf("database is at migration version %d, expected %d", version, latest)
+	}
+	return nil
+}
+
+func latestMigrationVersion() (uint, error) {
+	sourceDriver, err := iofs.New(migrationFs, resourcePath)
+	if err != nil {
+		return 0, fmt.Errorf("error initializing source driver: %w", err)
+	}
+	defer sourceDriver.Close()
+
+	version, err := sourceDriver.First()
+	if err != nil {
+		return 0, fmt.Errorf("error reading migrations: %w", err)
+	}
+	for {
+		next, err := sourceDriver.Next(version)
+		if errors.Is(err, fs.ErrNotExist) {
+			return version, nil
+		}
+		if err != nil {
+			return 0, fmt.Errorf("error reading migrations: %w", err)
+		}
+		version = next
+	}
+}
+
 // Rollback to run up migrations
 func Rollback(connURL string, count int) error {
 	m, err := NewMigrator(connURL)
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS replay_state_transition;
+
+ALTER TABLE replay_request DROP COLUMN IF EXISTS requested_by, DROP COLUMN IF EXISTS reason;
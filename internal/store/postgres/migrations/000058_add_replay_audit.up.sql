@@ -0,0 +1,17 @@
+ALTER TABLE replay_request ADD COLUMN IF NOT EXISTS requested_by VARCHAR(100) NOT NULL DEFAULT '', ADD COLUMN IF NOT EXISTS reason TEXT NOT NULL DEFAULT '';
+
+CREATE TABLE IF NOT EXISTS replay_state_transition (
+    replay_id UUID NOT NULL,
+
+    status      VARCHAR(30) NOT NULL,
+    message     TEXT NOT NULL DEFAULT '',
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    CONSTRAINT replay_state_transition_replay_id_fkey
+        FOREIGN KEY(replay_id)
+        REFERENCES replay_request(id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS replay_state_transition_replay_id_idx ON replay_state_transition USING btree (replay_id);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS upstream_access_request;
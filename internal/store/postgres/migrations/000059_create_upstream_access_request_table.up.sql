@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS upstream_access_request (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+
+    downstream_project_name VARCHAR(100) NOT NULL,
+    downstream_job_name     VARCHAR(220) NOT NULL,
+    upstream_project_name   VARCHAR(100) NOT NULL,
+    upstream_job_name       VARCHAR(220) NOT NULL,
+
+    status      VARCHAR(30) NOT NULL,
+    decided_by  VARCHAR(100) NOT NULL DEFAULT '',
+    reason      TEXT NOT NULL DEFAULT '',
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    decided_at TIMESTAMP WITH TIME ZONE,
+
+    UNIQUE (downstream_project_name, downstream_job_name, upstream_project_name, upstream_job_name)
+);
+
+CREATE INDEX IF NOT EXISTS upstream_access_request_upstream_project_name_idx ON upstream_access_request USING btree (upstream_project_name);
@@ -0,0 +1 @@
+ALTER TABLE replay_request DROP COLUMN IF EXISTS excluded_scheduled_at;
@@ -0,0 +1 @@
+ALTER TABLE replay_request ADD COLUMN IF NOT EXISTS excluded_scheduled_at TIMESTAMP WITH TIME ZONE[];
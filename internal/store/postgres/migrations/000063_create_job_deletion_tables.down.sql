@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS job_deletion_audit;
+DROP TABLE IF EXISTS job_deletion_consent;
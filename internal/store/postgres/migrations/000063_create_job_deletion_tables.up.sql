@@ -0,0 +1,31 @@
+CREATE TABLE IF NOT EXISTS job_deletion_consent (
+    project_name            VARCHAR(100) NOT NULL,
+    job_name                VARCHAR(220) NOT NULL,
+    downstream_project_name VARCHAR(100) NOT NULL,
+    downstream_job_name     VARCHAR(220) NOT NULL,
+
+    given_by VARCHAR(100) NOT NULL,
+    reason   TEXT NOT NULL DEFAULT '',
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    PRIMARY KEY (project_name, job_name, downstream_project_name, downstream_job_name)
+);
+
+CREATE TABLE IF NOT EXISTS job_deletion_audit (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+
+    project_name VARCHAR(100) NOT NULL,
+    job_name     VARCHAR(220) NOT NULL,
+
+    requested_by VARCHAR(100) NOT NULL DEFAULT '',
+    reason       TEXT NOT NULL DEFAULT '',
+    forced       BOOLEAN NOT NULL DEFAULT FALSE,
+
+    consented_downstream  TEXT[],
+    overridden_downstream TEXT[],
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS job_deletion_audit_project_name_job_name_idx ON job_deletion_audit USING btree (project_name, job_name);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS job_run_event;
+
+DROP INDEX IF EXISTS job_run_schedule_unique_idx;
@@ -0,0 +1,31 @@
+-- runs registered more than once for a schedule are merged into the latest registered one
+CREATE TEMPORARY TABLE job_run_duplicate AS
+SELECT id, kept_id
+FROM (
+    SELECT id, FIRST_VALUE(id) OVER (PARTITION BY project_name, namespace_name, job_name, scheduled_at ORDER BY created_at DESC) AS kept_id
+    FROM job_run
+) AS job_run_ranked
+WHERE id <> kept_id;
+
+UPDATE task_run   AS r SET job_run_id = d.kept_id FROM job_run_duplicate AS d WHERE r.job_run_id = d.id;
+UPDATE sensor_run AS r SET job_run_id = d.kept_id FROM job_run_duplicate AS d WHERE r.job_run_id = d.id;
+UPDATE hook_run   AS r SET job_run_id = d.kept_id FROM job_run_duplicate AS d WHERE r.job_run_id = d.id;
+
+DELETE FROM job_run AS jr USING job_run_duplicate AS d WHERE jr.id = d.id;
+
+DROP TABLE job_run_duplicate;
+
+CREATE UNIQUE INDEX IF NOT EXISTS job_run_schedule_unique_idx ON job_run (project_name, namespace_name, job_name, scheduled_at);
+
+-- events received from the scheduler, callbacks delivered more than once are recognized by the key
+CREATE TABLE IF NOT EXISTS job_run_event (
+    job_run_id    UUID NOT NULL,
+    operator_name VARCHAR(220) NOT NULL,
+    attempt       INT NOT NULL,
+    event_type    VARCHAR(30) NOT NULL,
+
+    event_time TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    PRIMARY KEY (job_run_id, operator_name, attempt, event_type)
+);
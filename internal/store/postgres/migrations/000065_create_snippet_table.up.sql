@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS snippet (
+    project_name VARCHAR(100) NOT NULL REFERENCES project (name),
+    name         VARCHAR(100) NOT NULL,
+    version      INTEGER NOT NULL,
+    content      TEXT NOT NULL,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    PRIMARY KEY (project_name, name, version)
+);
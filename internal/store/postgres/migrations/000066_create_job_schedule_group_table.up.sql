@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS job_schedule_group (
+    project_name VARCHAR(100) NOT NULL REFERENCES project (name),
+    name         VARCHAR(100) NOT NULL,
+    kind         VARCHAR(30) NOT NULL,
+    job_names    TEXT[] NOT NULL,
+
+    max_offset_minutes INTEGER NOT NULL,
+    spacing_minutes    INTEGER NOT NULL DEFAULT 0,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    PRIMARY KEY (project_name, name)
+);
@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS job_column_lineage (
+    job_id UUID NOT NULL,
+    job_name VARCHAR(220) NOT NULL,
+    project_name VARCHAR(100) NOT NULL,
+
+    destination_urn VARCHAR(300) NOT NULL,
+    target_column VARCHAR(300) NOT NULL,
+    source_resource_urn VARCHAR(300) NOT NULL,
+    source_column VARCHAR(300) NOT NULL,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    CONSTRAINT job_column_lineage_job_id_fkey
+        FOREIGN KEY(job_id)
+        REFERENCES job(id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS job_column_lineage_job_name_idx ON job_column_lineage (project_name, job_name);
+CREATE INDEX IF NOT EXISTS job_column_lineage_source_idx ON job_column_lineage (source_resource_urn, source_column);
@@ -0,0 +1 @@
+ALTER TABLE preset DROP COLUMN IF EXISTS version;
@@ -0,0 +1 @@
+ALTER TABLE preset ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS api_token (
+    project_name VARCHAR(100) NOT NULL REFERENCES project (name),
+    name         VARCHAR(100) NOT NULL,
+    scope        VARCHAR(30) NOT NULL,
+    token_hash   VARCHAR(64) NOT NULL,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    PRIMARY KEY (project_name, name)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS api_token_token_hash_idx ON api_token (token_hash);
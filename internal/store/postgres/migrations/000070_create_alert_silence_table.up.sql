@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS alert_silence (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+
+    project_name   VARCHAR(100) NOT NULL,
+    namespace_name VARCHAR(100) NOT NULL DEFAULT '',
+    job_name       VARCHAR(220) NOT NULL DEFAULT '',
+    labels         JSONB,
+
+    reason     TEXT NOT NULL,
+    created_by VARCHAR(100) NOT NULL DEFAULT '',
+
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS alert_silence_project_name_expires_at_idx ON alert_silence USING btree (project_name, expires_at);
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS job_upstream_cache (
+    project_name VARCHAR(100) NOT NULL,
+    job_name     VARCHAR(220) NOT NULL,
+
+    spec_hash       VARCHAR(64) NOT NULL,
+    sources         JSONB,
+    column_lineages JSONB,
+
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    PRIMARY KEY (project_name, job_name)
+);
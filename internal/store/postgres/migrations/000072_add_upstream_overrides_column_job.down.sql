@@ -0,0 +1,3 @@
+ALTER TABLE job
+    DROP COLUMN IF EXISTS ignored_upstreams,
+    DROP COLUMN IF EXISTS extra_upstreams;
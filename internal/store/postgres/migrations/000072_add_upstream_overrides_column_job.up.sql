@@ -0,0 +1,3 @@
+ALTER TABLE job
+    ADD COLUMN IF NOT EXISTS ignored_upstreams TEXT[],
+    ADD COLUMN IF NOT EXISTS extra_upstreams TEXT[];
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS run_snapshot (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+
+    project_name  VARCHAR(100) NOT NULL,
+    job_name      VARCHAR(220) NOT NULL,
+    executor_name VARCHAR(100) NOT NULL,
+    executor_type VARCHAR(30)  NOT NULL,
+    scheduled_at  TIMESTAMP WITH TIME ZONE NOT NULL,
+    attempt       INTEGER NOT NULL DEFAULT 0,
+
+    payload BYTEA NOT NULL,
+
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS run_snapshot_project_name_job_name_idx ON run_snapshot USING btree (project_name, job_name);
+CREATE INDEX IF NOT EXISTS run_snapshot_expires_at_idx ON run_snapshot USING btree (expires_at);
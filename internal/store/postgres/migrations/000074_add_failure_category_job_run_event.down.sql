@@ -0,0 +1,2 @@
+ALTER TABLE job_run_event
+    DROP COLUMN IF EXISTS failure_category;
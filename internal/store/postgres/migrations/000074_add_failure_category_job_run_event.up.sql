@@ -0,0 +1,2 @@
+ALTER TABLE job_run_event
+    ADD COLUMN IF NOT EXISTS failure_category VARCHAR(15);
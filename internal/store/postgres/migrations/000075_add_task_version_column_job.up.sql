@@ -0,0 +1,2 @@
+ALTER TABLE job
+    ADD COLUMN IF NOT EXISTS task_version VARCHAR(50) NOT NULL DEFAULT '';
@@ -0,0 +1 @@
+CREATE TABLE IF NOT EXISTS failure_hook_run (LIKE hook_run INCLUDING ALL);
@@ -0,0 +1,33 @@
+CREATE TABLE IF NOT EXISTS webhook_subscription (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+
+    project_name   VARCHAR(100) NOT NULL,
+    namespace_name VARCHAR(100) NOT NULL,
+
+    url         TEXT NOT NULL,
+    secret_name VARCHAR(100) NOT NULL,
+    event_types TEXT[] NOT NULL,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS webhook_subscription_project_name_namespace_name_idx ON webhook_subscription USING btree (project_name, namespace_name);
+
+CREATE TABLE IF NOT EXISTS webhook_delivery (
+    id              UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    subscription_id UUID NOT NULL REFERENCES webhook_subscription (id) ON DELETE CASCADE,
+
+    job_name     VARCHAR(220) NOT NULL,
+    event_type   VARCHAR(50) NOT NULL,
+    scheduled_at TIMESTAMP WITH TIME ZONE,
+
+    attempts    INTEGER NOT NULL,
+    status_code INTEGER NOT NULL DEFAULT 0,
+    error       TEXT,
+    delivered   BOOLEAN NOT NULL,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS webhook_delivery_subscription_id_created_at_idx ON webhook_delivery USING btree (subscription_id, created_at);
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS job_run_project_scheduled_at_idx;
@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS job_run_project_scheduled_at_idx ON job_run (project_name, scheduled_at);
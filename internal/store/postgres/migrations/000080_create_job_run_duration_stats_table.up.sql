@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS job_run_duration_stats (
+    project_name   VARCHAR(100) NOT NULL,
+    namespace_name VARCHAR(100) NOT NULL,
+    job_name       VARCHAR(220) NOT NULL,
+
+    -- durations in milliseconds of the latest successful runs, the oldest first
+    durations BIGINT[] NOT NULL,
+
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    PRIMARY KEY (project_name, namespace_name, job_name)
+);
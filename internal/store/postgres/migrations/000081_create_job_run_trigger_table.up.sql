@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS job_run_trigger (
+    project_name   VARCHAR(100) NOT NULL,
+    namespace_name VARCHAR(100) NOT NULL,
+    job_name       VARCHAR(220) NOT NULL,
+    scheduled_at   TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    -- configs set on the compiled task configs of the manual run
+    config_overrides JSONB NOT NULL,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    PRIMARY KEY (project_name, job_name, scheduled_at)
+);
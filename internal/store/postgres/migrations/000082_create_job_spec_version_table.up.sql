@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS job_spec_version (
+    project_name VARCHAR(100) NOT NULL,
+    job_name     VARCHAR(220) NOT NULL,
+    version      INTEGER NOT NULL,
+
+    -- the job spec as stored in the job table when the version is deployed
+    spec JSONB NOT NULL,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    PRIMARY KEY (project_name, job_name, version)
+);
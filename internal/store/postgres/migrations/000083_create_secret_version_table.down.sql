@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS job_secret_version;
+DROP TABLE IF EXISTS secret_version;
+ALTER TABLE secret DROP COLUMN IF EXISTS version;
@@ -0,0 +1,27 @@
+ALTER TABLE secret ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
+
+-- previous values of the rotated secrets, readable until the end of the grace period of the rotation
+CREATE TABLE IF NOT EXISTS secret_version (
+    project_name   VARCHAR(100) NOT NULL,
+    namespace_name VARCHAR(100),
+    name           VARCHAR(100) NOT NULL,
+    version        INTEGER NOT NULL,
+    value          TEXT NOT NULL,
+
+    created_at     TIMESTAMP WITH TIME ZONE NOT NULL,
+    readable_until TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    PRIMARY KEY (project_name, name, version)
+);
+
+-- versions of the secrets referenced by the jobs, as of the last time their executor input is compiled
+CREATE TABLE IF NOT EXISTS job_secret_version (
+    project_name VARCHAR(100) NOT NULL,
+    job_name     VARCHAR(220) NOT NULL,
+    secret_name  VARCHAR(100) NOT NULL,
+    version      INTEGER NOT NULL,
+
+    compiled_at TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    PRIMARY KEY (project_name, job_name, secret_name)
+);
@@ -0,0 +1,3 @@
+ALTER TABLE namespace DROP COLUMN IF EXISTS archived_at;
+
+ALTER TABLE project DROP COLUMN IF EXISTS archived_at;
@@ -0,0 +1,3 @@
+ALTER TABLE project ADD COLUMN IF NOT EXISTS archived_at TIMESTAMP WITH TIME ZONE;
+
+ALTER TABLE namespace ADD COLUMN IF NOT EXISTS archived_at TIMESTAMP WITH TIME ZONE;
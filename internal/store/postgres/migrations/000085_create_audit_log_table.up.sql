@@ -0,0 +1,19 @@
+-- mutating calls made to the server, only the digest of their payloads is kept
+CREATE TABLE IF NOT EXISTS audit_log (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+
+    actor          VARCHAR(100) NOT NULL DEFAULT '',
+    project_name   VARCHAR(100) NOT NULL DEFAULT '',
+    namespace_name VARCHAR(100) NOT NULL DEFAULT '',
+
+    action         VARCHAR(50) NOT NULL,
+    method         VARCHAR(200) NOT NULL,
+    payload_digest VARCHAR(64) NOT NULL,
+
+    result  VARCHAR(20) NOT NULL,
+    message TEXT NOT NULL DEFAULT '',
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS audit_log_project_name_created_at_idx ON audit_log USING btree (project_name, created_at);
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS role_binding (
+    project_name   VARCHAR(100) NOT NULL REFERENCES project (name),
+    namespace_name VARCHAR(100) NOT NULL DEFAULT '',
+    principal      VARCHAR(255) NOT NULL,
+    role           VARCHAR(30) NOT NULL,
+    granted_by     VARCHAR(255) NOT NULL DEFAULT '',
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    PRIMARY KEY (project_name, namespace_name, principal)
+);
+
+CREATE INDEX IF NOT EXISTS role_binding_principal_idx ON role_binding (project_name, principal);
@@ -0,0 +1 @@
+ALTER TABLE replay_request DROP COLUMN IF EXISTS lease_renewed_at;
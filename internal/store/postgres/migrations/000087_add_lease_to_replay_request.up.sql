@@ -0,0 +1 @@
+ALTER TABLE replay_request ADD COLUMN IF NOT EXISTS lease_renewed_at TIMESTAMP WITH TIME ZONE;
@@ -0,0 +1,105 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+)
+
+const (
+	alertSilenceColumnsToStore = `project_name, namespace_name, job_name, labels, reason, created_by, expires_at`
+	alertSilenceColumns        = `id, ` + alertSilenceColumnsToStore + `, created_at`
+)
+
+type AlertSilenceRepository struct {
+	db *pgxpool.Pool
+}
+
+type alertSilence struct {
+	ID uuid.UUID
+
+	ProjectName   string
+	NamespaceName string
+	JobName       string
+	Labels        map[string]string
+
+	Reason    string
+	CreatedBy string
+
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+func (s *alertSilence) toAlertSilence() *scheduler.AlertSilence {
+	return &scheduler.AlertSilence{
+		ID:            s.ID,
+		ProjectName:   tenant.ProjectName(s.ProjectName),
+		NamespaceName: tenant.NamespaceName(s.NamespaceName),
+		JobName:       scheduler.JobName(s.JobName),
+		Labels:        s.Labels,
+		Reason:        s.Reason,
+		CreatedBy:     s.CreatedBy,
+		ExpiresAt:     s.ExpiresAt,
+		CreatedAt:     s.CreatedAt,
+	}
+}
+
+func (r AlertSilenceRepository) Create(ctx context.Context, silence *scheduler.AlertSilence) error {
+	insertSilence := `INSERT INTO alert_silence (` + alertSilenceColumnsToStore + `, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, NOW(), NOW())`
+	_, err := r.db.Exec(ctx, insertSilence, silence.ProjectName, silence.NamespaceName, silence.JobName, silence.Labels,
+		silence.Reason, silence.CreatedBy, silence.ExpiresAt)
+	if err != nil {
+		return errors.Wrap(scheduler.EntityAlertSilence, "unable to store alert silence", err)
+	}
+	return nil
+}
+
+func (r AlertSilenceRepository) GetActive(ctx context.Context, projectName tenant.ProjectName, at time.Time) ([]*scheduler.AlertSilence, error) {
+	getSilences := `SELECT ` + alertSilenceColumns + ` FROM alert_silence WHERE project_name = $1 AND expires_at > $2 ORDER BY expires_at`
+	rows, err := r.db.Query(ctx, getSilences, projectName, at)
+	if err != nil {
+		return nil, errors.Wrap(scheduler.EntityAlertSilence, "unable to get alert silences", err)
+	}
+	defer rows.Close()
+
+	var silences []*scheduler.AlertSilence
+	for rows.Next() {
+		stored, err := scanAlertSilence(rows)
+		if err != nil {
+			return nil, errors.Wrap(scheduler.EntityAlertSilence, "unable to get the stored alert silence", err)
+		}
+		silences = append(silences, stored.toAlertSilence())
+	}
+	return silences, nil
+}
+
+func (r AlertSilenceRepository) Expire(ctx context.Context, projectName tenant.ProjectName, id uuid.UUID, at time.Time) error {
+	expireSilence := `UPDATE alert_silence SET expires_at = $1, updated_at = NOW() WHERE project_name = $2 AND id = $3 AND expires_at > $1`
+	tag, err := r.db.Exec(ctx, expireSilence, at, projectName, id)
+	if err != nil {
+		return errors.Wrap(scheduler.EntityAlertSilence, "unable to expire alert silence", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.NotFound(scheduler.EntityAlertSilence, "no active alert silence found for id "+id.String())
+	}
+	return nil
+}
+
+func scanAlertSilence(row pgx.Row) (*alertSilence, error) {
+	var silence alertSilence
+	err := row.Scan(&silence.ID, &silence.ProjectName, &silence.NamespaceName, &silence.JobName, &silence.Labels,
+		&silence.Reason, &silence.CreatedBy, &silence.ExpiresAt, &silence.CreatedAt)
+	return &silence, err
+}
+
+func NewAlertSilenceRepository(db *pgxpool.Pool) *AlertSilenceRepository {
+	return &AlertSilenceRepository{db: db}
+}
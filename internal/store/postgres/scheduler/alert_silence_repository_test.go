@@ -0,0 +1,71 @@
+//go:build !unit_test
+
+package scheduler_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+	postgres "github.com/goto/optimus/internal/store/postgres/scheduler"
+)
+
+func TestPostgresAlertSilenceRepository(t *testing.T) {
+	ctx := context.Background()
+	projectName := tenant.ProjectName("proj-a")
+	now := time.Now().UTC().Truncate(time.Second)
+
+	t.Run("GetActive", func(t *testing.T) {
+		t.Run("returns only the silences not expired yet", func(t *testing.T) {
+			db := dbSetup()
+			repo := postgres.NewAlertSilenceRepository(db)
+
+			active, err := scheduler.NewAlertSilence(projectName, "ns", "", map[string]string{"team": "data"}, "maintenance", "owner@example.com", now.Add(time.Hour), now)
+			assert.NoError(t, err)
+			expired, err := scheduler.NewAlertSilence(projectName, "ns", "job-a", nil, "maintenance", "owner@example.com", now.Add(time.Minute), now)
+			assert.NoError(t, err)
+			assert.NoError(t, repo.Create(ctx, active))
+			assert.NoError(t, repo.Create(ctx, expired))
+
+			silences, err := repo.GetActive(ctx, projectName, now.Add(10*time.Minute))
+			assert.NoError(t, err)
+			assert.Len(t, silences, 1)
+			assert.Equal(t, tenant.NamespaceName("ns"), silences[0].NamespaceName)
+			assert.Equal(t, map[string]string{"team": "data"}, silences[0].Labels)
+			assert.Equal(t, "maintenance", silences[0].Reason)
+			assert.True(t, silences[0].ExpiresAt.Equal(now.Add(time.Hour)))
+		})
+	})
+	t.Run("Expire", func(t *testing.T) {
+		t.Run("returns not found error if silence does not exist", func(t *testing.T) {
+			db := dbSetup()
+			repo := postgres.NewAlertSilenceRepository(db)
+
+			err := repo.Expire(ctx, projectName, uuid.New(), now)
+			assert.True(t, errors.IsErrorType(err, errors.ErrNotFound))
+		})
+		t.Run("ends the silence", func(t *testing.T) {
+			db := dbSetup()
+			repo := postgres.NewAlertSilenceRepository(db)
+
+			silence, err := scheduler.NewAlertSilence(projectName, "", "job-a", nil, "maintenance", "", now.Add(time.Hour), now)
+			assert.NoError(t, err)
+			assert.NoError(t, repo.Create(ctx, silence))
+			stored, err := repo.GetActive(ctx, projectName, now)
+			assert.NoError(t, err)
+			assert.Len(t, stored, 1)
+
+			assert.NoError(t, repo.Expire(ctx, projectName, stored[0].ID, now))
+
+			silences, err := repo.GetActive(ctx, projectName, now)
+			assert.NoError(t, err)
+			assert.Empty(t, silences)
+		})
+	})
+}
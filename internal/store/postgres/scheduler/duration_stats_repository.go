@@ -0,0 +1,58 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+)
+
+type DurationStatsRepository struct {
+	db *pgxpool.Pool
+}
+
+func (r DurationStatsRepository) Get(ctx context.Context, tnnt tenant.Tenant, jobName scheduler.JobName) (*scheduler.DurationStats, error) {
+	getStats := `SELECT durations FROM job_run_duration_stats WHERE project_name = $1 AND namespace_name = $2 AND job_name = $3`
+	var durationsInMillis []int64
+	err := r.db.QueryRow(ctx, getStats, tnnt.ProjectName(), tnnt.NamespaceName(), jobName).Scan(&durationsInMillis)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.NotFound(scheduler.EntityJobRun, "no duration stats for job "+jobName.String())
+		}
+		return nil, errors.Wrap(scheduler.EntityJobRun, "error while getting duration stats", err)
+	}
+
+	durations := make([]time.Duration, len(durationsInMillis))
+	for i, millis := range durationsInMillis {
+		durations[i] = time.Duration(millis) * time.Millisecond
+	}
+	return &scheduler.DurationStats{
+		Tenant:    tnnt,
+		JobName:   jobName,
+		Durations: durations,
+	}, nil
+}
+
+func (r DurationStatsRepository) Save(ctx context.Context, stats *scheduler.DurationStats) error {
+	durationsInMillis := make([]int64, len(stats.Durations))
+	for i, duration := range stats.Durations {
+		durationsInMillis[i] = duration.Milliseconds()
+	}
+	upsertStats := `INSERT INTO job_run_duration_stats (project_name, namespace_name, job_name, durations, updated_at)
+		VALUES ($1, $2, $3, $4, NOW())
+		ON CONFLICT (project_name, namespace_name, job_name) DO UPDATE SET durations = EXCLUDED.durations, updated_at = NOW()`
+	_, err := r.db.Exec(ctx, upsertStats, stats.Tenant.ProjectName(), stats.Tenant.NamespaceName(), stats.JobName, durationsInMillis)
+	if err != nil {
+		return errors.Wrap(scheduler.EntityJobRun, "unable to save duration stats", err)
+	}
+	return nil
+}
+
+func NewDurationStatsRepository(pool *pgxpool.Pool) *DurationStatsRepository {
+	return &DurationStatsRepository{db: pool}
+}
@@ -0,0 +1,43 @@
+//go:build !unit_test
+
+package scheduler_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+	postgres "github.com/goto/optimus/internal/store/postgres/scheduler"
+)
+
+func TestPostgresDurationStatsRepository(t *testing.T) {
+	ctx := context.Background()
+	tnnt, _ := tenant.NewTenant("test-proj", "test-ns")
+
+	t.Run("returns not found error when no stats are saved for the job", func(t *testing.T) {
+		db := dbSetup()
+		repo := postgres.NewDurationStatsRepository(db)
+
+		stats, err := repo.Get(ctx, tnnt, jobAName)
+		assert.True(t, errors.IsErrorType(err, errors.ErrNotFound))
+		assert.Nil(t, stats)
+	})
+	t.Run("saves and replaces the stats of the job", func(t *testing.T) {
+		db := dbSetup()
+		repo := postgres.NewDurationStatsRepository(db)
+
+		stats := &scheduler.DurationStats{Tenant: tnnt, JobName: jobAName, Durations: []time.Duration{time.Minute}}
+		assert.NoError(t, repo.Save(ctx, stats))
+		stats.Add(90*time.Second, 10)
+		assert.NoError(t, repo.Save(ctx, stats))
+
+		saved, err := repo.Get(ctx, tnnt, jobAName)
+		assert.NoError(t, err)
+		assert.Equal(t, []time.Duration{time.Minute, 90 * time.Second}, saved.Durations)
+	})
+}
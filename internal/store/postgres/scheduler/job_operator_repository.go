@@ -3,6 +3,7 @@ package scheduler
 import (
 	"context"
 	"database/sql"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -17,6 +18,8 @@ const (
 	sensorRunTableName = "sensor_run"
 	taskRunTableName   = "task_run"
 	hookRunTableName   = "hook_run"
+	// failure hooks are recorded apart from the other hooks, as they only run when the task fails
+	failureHookRunTableName = "failure_hook_run"
 
 	jobOperatorColumnsToStore = `name, job_run_id, status, start_time, end_time`
 	jobOperatorColumns        = `id, ` + jobOperatorColumnsToStore
@@ -49,6 +52,8 @@ func operatorTypeToTableName(operatorType scheduler.OperatorType) (string, error
 		return sensorRunTableName, nil
 	case scheduler.OperatorHook:
 		return hookRunTableName, nil
+	case scheduler.OperatorFailureHook:
+		return failureHookRunTableName, nil
 	case scheduler.OperatorTask:
 		return taskRunTableName, nil
 	default:
@@ -91,6 +96,42 @@ func (o *OperatorRunRepository) GetOperatorRun(ctx context.Context, name string,
 	return opRun.toOperatorRun()
 }
 
+// GetOperatorRuns returns the latest run of every sensor, task and hook of the job run, in the order they started
+func (o *OperatorRunRepository) GetOperatorRuns(ctx context.Context, jobRunID uuid.UUID) ([]*scheduler.OperatorRun, error) {
+	operatorTypes := []scheduler.OperatorType{scheduler.OperatorSensor, scheduler.OperatorTask, scheduler.OperatorHook, scheduler.OperatorFailureHook}
+	selects := make([]string, len(operatorTypes))
+	for i, operatorType := range operatorTypes {
+		tableName, err := operatorTypeToTableName(operatorType)
+		if err != nil {
+			return nil, err
+		}
+		// an operator retried within the same job run has a row per attempt, only the latest one is kept
+		selects[i] = "SELECT DISTINCT ON (name) " + jobOperatorColumns + ", '" + operatorType.String() + "' AS operator_type FROM " +
+			tableName + " WHERE job_run_id = $1 ORDER BY name, created_at DESC"
+	}
+	getOperatorRuns := "SELECT * FROM ((" + strings.Join(selects, ") UNION ALL (") + ")) o ORDER BY start_time"
+
+	rows, err := o.db.Query(ctx, getOperatorRuns, jobRunID)
+	if err != nil {
+		return nil, errors.Wrap(scheduler.EntityJobRun, "error while getting operator runs", err)
+	}
+	defer rows.Close()
+
+	var operatorRuns []*scheduler.OperatorRun
+	for rows.Next() {
+		var opRun operatorRun
+		if err := rows.Scan(&opRun.ID, &opRun.Name, &opRun.JobRunID, &opRun.Status, &opRun.StartTime, &opRun.EndTime, &opRun.OperatorType); err != nil {
+			return nil, errors.Wrap(scheduler.EntityJobRun, "error while getting operator run", err)
+		}
+		run, err := opRun.toOperatorRun()
+		if err != nil {
+			return nil, err
+		}
+		operatorRuns = append(operatorRuns, run)
+	}
+	return operatorRuns, nil
+}
+
 func (o *OperatorRunRepository) CreateOperatorRun(ctx context.Context, name string, operatorType scheduler.OperatorType, jobRunID uuid.UUID, startTime time.Time) error {
 	operatorTableName, err := operatorTypeToTableName(operatorType)
 	if err != nil {
@@ -105,4 +105,36 @@ func TestPostgresJobOperatorRepository(t *testing.T) {
 			assert.Equal(t, scheduler.StateFailed, operatorRun.Status)
 		})
 	})
+	t.Run("GetOperatorRuns", func(t *testing.T) {
+		t.Run("returns the latest run of every operator in the order they started", func(t *testing.T) {
+			db := dbSetup()
+			_ = addJobs(ctx, t, db)
+			jobRunRepo := postgres.NewJobRunRepository(db)
+			err := jobRunRepo.Create(ctx, tnnt, jobAName, scheduledAt, slaDefinitionInSec)
+			assert.Nil(t, err)
+
+			jobRun, err := jobRunRepo.GetByScheduledAt(ctx, tnnt, jobAName, scheduledAt)
+			assert.Nil(t, err)
+
+			operatorRunRepo := postgres.NewOperatorRunRepository(db)
+			err = operatorRunRepo.CreateOperatorRun(ctx, "wait-upstream", scheduler.OperatorSensor, jobRun.ID, operatorStartTime)
+			assert.Nil(t, err)
+			err = operatorRunRepo.CreateOperatorRun(ctx, "transform", scheduler.OperatorTask, jobRun.ID, operatorStartTime.Add(time.Minute))
+			assert.Nil(t, err)
+			err = operatorRunRepo.CreateOperatorRun(ctx, "transform", scheduler.OperatorTask, jobRun.ID, operatorStartTime.Add(2*time.Minute))
+			assert.Nil(t, err)
+			err = operatorRunRepo.CreateOperatorRun(ctx, "predator", scheduler.OperatorHook, jobRun.ID, operatorStartTime.Add(3*time.Minute))
+			assert.Nil(t, err)
+
+			operatorRuns, err := operatorRunRepo.GetOperatorRuns(ctx, jobRun.ID)
+			assert.Nil(t, err)
+			assert.Len(t, operatorRuns, 3)
+			assert.Equal(t, scheduler.OperatorSensor, operatorRuns[0].OperatorType)
+			assert.Equal(t, "transform", operatorRuns[1].Name)
+			assert.Equal(t, scheduler.OperatorTask, operatorRuns[1].OperatorType)
+			assert.Equal(t, operatorStartTime.Add(2*time.Minute).UTC().Format(time.RFC1123), operatorRuns[1].StartTime.UTC().Format(time.RFC1123))
+			assert.Equal(t, "predator", operatorRuns[2].Name)
+			assert.Equal(t, scheduler.OperatorHook, operatorRuns[2].OperatorType)
+		})
+	})
 }
@@ -24,7 +24,7 @@ import (
 
 const (
 	jobColumns = `id, name, version, owner, description, labels, schedule, alert, static_upstreams, http_upstreams,
-				  task_name, task_config, window_spec, assets, hooks, metadata, destination, sources, project_name, namespace_name, created_at, updated_at`
+				  task_name, task_version, task_config, window_spec, assets, hooks, metadata, destination, sources, project_name, namespace_name, created_at, updated_at`
 	upstreamColumns = `
     job_name, project_name, upstream_job_name, upstream_project_name, upstream_host,
     upstream_namespace_name, upstream_resource_urn, upstream_task_name, upstream_type, upstream_external, upstream_state`
@@ -39,6 +39,7 @@ type Schedule struct {
 	EndDate       *time.Time
 	Interval      string
 	DependsOnPast bool
+	CatchUp       string
 	Retry         *Retry
 }
 type Retry struct {
@@ -100,8 +101,9 @@ type Job struct {
 	StaticUpstreams pq.StringArray
 	HTTPUpstreams   json.RawMessage
 
-	TaskName   string
-	TaskConfig map[string]string
+	TaskName    string
+	TaskVersion string
+	TaskConfig  map[string]string
 
 	Hooks json.RawMessage
 
@@ -157,6 +159,16 @@ func fromStorageWindow(raw []byte, jobVersion int) (window.Config, error) {
 type Metadata struct {
 	Resource  *MetadataResource
 	Scheduler map[string]string
+	Sensors   []*MetadataSensor
+}
+
+type MetadataSensor struct {
+	Upstream     string
+	PokeInterval time.Duration
+	Timeout      time.Duration
+
+	FreshnessPartitionExists bool
+	FreshnessMaxAge          time.Duration
 }
 
 type MetadataResource struct {
@@ -201,6 +213,17 @@ func fromStorageMetadata(metadata json.RawMessage) (scheduler.RuntimeConfig, err
 	if storeMetadata.Scheduler != nil {
 		runtimeConfig.Scheduler = storeMetadata.Scheduler
 	}
+	for _, sensor := range storeMetadata.Sensors {
+		runtimeConfig.Sensors = append(runtimeConfig.Sensors, scheduler.SensorConfig{
+			Upstream:     sensor.Upstream,
+			PokeInterval: sensor.PokeInterval,
+			Timeout:      sensor.Timeout,
+			Freshness: scheduler.SensorFreshness{
+				PartitionExists: sensor.FreshnessPartitionExists,
+				MaxAge:          sensor.FreshnessMaxAge,
+			},
+		})
+	}
 	return runtimeConfig, nil
 }
 
@@ -224,9 +247,12 @@ func (j *Job) toJob() (*scheduler.Job, error) {
 		WindowConfig: w,
 		Assets:       j.Assets,
 		Task: &scheduler.Task{
-			Name:   j.TaskName,
-			Config: j.TaskConfig,
+			Name:    j.TaskName,
+			Version: j.TaskVersion,
+			Config:  j.TaskConfig,
 		},
+		CreatedAt: j.CreatedAt,
+		UpdatedAt: j.UpdatedAt,
 	}
 
 	if j.Hooks != nil {
@@ -268,6 +294,7 @@ func (j *Job) toJobWithDetails() (*scheduler.JobWithDetails, error) {
 			DependsOnPast: storageSchedule.DependsOnPast,
 			StartDate:     storageSchedule.StartDate,
 			Interval:      storageSchedule.Interval,
+			CatchUp:       scheduler.CatchUpPolicy(storageSchedule.CatchUp),
 		},
 		RuntimeConfig: runtimeConfig,
 	}
@@ -299,7 +326,7 @@ func FromRow(row pgx.Row) (*Job, error) {
 
 	err := row.Scan(&js.ID, &js.Name, &js.Version, &js.Owner, &js.Description,
 		&js.Labels, &js.Schedule, &js.Alert, &js.StaticUpstreams, &js.HTTPUpstreams,
-		&js.TaskName, &js.TaskConfig, &js.WindowSpec, &js.Assets, &js.Hooks, &js.Metadata, &js.Destination, &js.Sources,
+		&js.TaskName, &js.TaskVersion, &js.TaskConfig, &js.WindowSpec, &js.Assets, &js.Hooks, &js.Metadata, &js.Destination, &js.Sources,
 		&js.ProjectName, &js.NamespaceName, &js.CreatedAt, &js.UpdatedAt)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -3,6 +3,7 @@ package scheduler
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"strings"
 	"time"
 
@@ -17,10 +18,17 @@ import (
 
 const (
 	columnsToStore = `job_name, namespace_name, project_name, scheduled_at, start_time, end_time, status, sla_definition, sla_alert`
-	jobRunColumns  = `id, ` + columnsToStore + `, monitoring`
+	jobRunColumns  = `id, ` + columnsToStore + `, monitoring, artifacts, heartbeat_at`
 	dbTimeFormat   = "2006-01-02 15:04:05.000000"
 )
 
+var jobRunSortColumns = map[scheduler.JobRunSortField]string{
+	scheduler.JobRunSortByScheduledAt: "scheduled_at",
+	scheduler.JobRunSortByStartTime:   "start_time",
+	scheduler.JobRunSortByEndTime:     "end_time",
+	scheduler.JobRunSortByJobName:     "job_name",
+}
+
 type JobRunRepository struct {
 	db *pgxpool.Pool
 }
@@ -44,6 +52,9 @@ type jobRun struct {
 	UpdatedAt time.Time
 
 	Monitoring json.RawMessage
+	Artifacts  json.RawMessage
+
+	HeartbeatAt *time.Time
 }
 
 func (j *jobRun) toJobRun() (*scheduler.JobRun, error) {
@@ -61,6 +72,12 @@ func (j *jobRun) toJobRun() (*scheduler.JobRun, error) {
 			return nil, errors.AddErrContext(err, scheduler.EntityJobRun, "invalid monitoring values in database")
 		}
 	}
+	var artifacts map[string]any
+	if j.Artifacts != nil {
+		if err := json.Unmarshal(j.Artifacts, &artifacts); err != nil {
+			return nil, errors.AddErrContext(err, scheduler.EntityJobRun, "invalid artifacts in database")
+		}
+	}
 	return &scheduler.JobRun{
 		ID:            j.ID,
 		JobName:       scheduler.JobName(j.JobName),
@@ -72,6 +89,8 @@ func (j *jobRun) toJobRun() (*scheduler.JobRun, error) {
 		EndTime:       j.EndTime,
 		SLADefinition: j.SLADefinition,
 		Monitoring:    monitoring,
+		Artifacts:     artifacts,
+		HeartbeatAt:   j.HeartbeatAt,
 	}, nil
 }
 
@@ -80,7 +99,7 @@ func (j *JobRunRepository) GetByID(ctx context.Context, id scheduler.JobRunID) (
 	getJobRunByID := `SELECT ` + jobRunColumns + ` FROM job_run where id = $1`
 	err := j.db.QueryRow(ctx, getJobRunByID, id.UUID()).
 		Scan(&jr.ID, &jr.JobName, &jr.NamespaceName, &jr.ProjectName, &jr.ScheduledAt, &jr.StartTime, &jr.EndTime,
-			&jr.Status, &jr.SLADefinition, &jr.SLAAlert, &jr.Monitoring)
+			&jr.Status, &jr.SLADefinition, &jr.SLAAlert, &jr.Monitoring, &jr.Artifacts, &jr.HeartbeatAt)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, errors.NotFound(scheduler.EntityJobRun, "no record for job run id "+id.UUID().String())
@@ -96,7 +115,7 @@ func (j *JobRunRepository) GetByScheduledAt(ctx context.Context, t tenant.Tenant
 	getJobRunByScheduledAt := `SELECT ` + jobRunColumns + `, created_at FROM job_run j where project_name = $1 and namespace_name = $2 and job_name = $3 and scheduled_at = $4 order by created_at desc limit 1`
 	err := j.db.QueryRow(ctx, getJobRunByScheduledAt, t.ProjectName(), t.NamespaceName(), jobName, scheduledAt).
 		Scan(&jr.ID, &jr.JobName, &jr.NamespaceName, &jr.ProjectName, &jr.ScheduledAt, &jr.StartTime, &jr.EndTime,
-			&jr.Status, &jr.SLADefinition, &jr.SLAAlert, &jr.Monitoring, &jr.CreatedAt)
+			&jr.Status, &jr.SLADefinition, &jr.SLAAlert, &jr.Monitoring, &jr.Artifacts, &jr.HeartbeatAt, &jr.CreatedAt)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, errors.NotFound(scheduler.EntityJobRun, "no record for job:"+jobName.String()+" scheduled at: "+scheduledAt.String())
@@ -106,6 +125,22 @@ func (j *JobRunRepository) GetByScheduledAt(ctx context.Context, t tenant.Tenant
 	return jr.toJobRun()
 }
 
+// GetLatestSuccessRun gets the latest successful run of the job scheduled at or before the given time
+func (j *JobRunRepository) GetLatestSuccessRun(ctx context.Context, t tenant.Tenant, jobName scheduler.JobName, scheduledAt time.Time) (*scheduler.JobRun, error) {
+	var jr jobRun
+	getLatestSuccessRun := `SELECT ` + jobRunColumns + ` FROM job_run j where project_name = $1 and namespace_name = $2 and job_name = $3 and scheduled_at <= $4 and status = $5 order by scheduled_at desc limit 1`
+	err := j.db.QueryRow(ctx, getLatestSuccessRun, t.ProjectName(), t.NamespaceName(), jobName, scheduledAt, scheduler.StateSuccess).
+		Scan(&jr.ID, &jr.JobName, &jr.NamespaceName, &jr.ProjectName, &jr.ScheduledAt, &jr.StartTime, &jr.EndTime,
+			&jr.Status, &jr.SLADefinition, &jr.SLAAlert, &jr.Monitoring, &jr.Artifacts, &jr.HeartbeatAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.NotFound(scheduler.EntityJobRun, "no successful run for job:"+jobName.String()+" scheduled before: "+scheduledAt.String())
+		}
+		return nil, errors.Wrap(scheduler.EntityJobRun, "error while getting latest successful run", err)
+	}
+	return jr.toJobRun()
+}
+
 func (j *JobRunRepository) GetByScheduledTimes(ctx context.Context, t tenant.Tenant, jobName scheduler.JobName, scheduleTimes []time.Time) ([]*scheduler.JobRun, error) {
 	var jobRunList []*scheduler.JobRun
 	var scheduledTimesString []string
@@ -121,7 +156,7 @@ func (j *JobRunRepository) GetByScheduledTimes(ctx context.Context, t tenant.Ten
 	for rows.Next() {
 		var jr jobRun
 		err := rows.Scan(&jr.ID, &jr.JobName, &jr.NamespaceName, &jr.ProjectName, &jr.ScheduledAt, &jr.StartTime, &jr.EndTime,
-			&jr.Status, &jr.SLADefinition, &jr.SLAAlert, &jr.Monitoring, &jr.CreatedAt)
+			&jr.Status, &jr.SLADefinition, &jr.SLAAlert, &jr.Monitoring, &jr.Artifacts, &jr.HeartbeatAt, &jr.CreatedAt)
 		if err != nil {
 			if errors.Is(err, pgx.ErrNoRows) {
 				return nil, errors.NotFound(scheduler.EntityJobRun, "no record of job run :"+jobName.String()+" for schedule Times : "+strings.Join(scheduledTimesString, ", "))
@@ -138,6 +173,73 @@ func (j *JobRunRepository) GetByScheduledTimes(ctx context.Context, t tenant.Ten
 	return jobRunList, nil
 }
 
+// Query returns a page of the runs matching the query, job name patterns use * as the wildcard
+func (j *JobRunRepository) Query(ctx context.Context, query *scheduler.JobRunQuery) ([]*scheduler.JobRun, error) {
+	conditions := []string{"project_name = $1"}
+	args := []any{query.ProjectName}
+	addCondition := func(condition string, arg any) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+	if query.NamespaceName != "" {
+		addCondition("namespace_name = $%d", query.NamespaceName)
+	}
+	if query.JobNamePattern != "" {
+		addCondition(`job_name LIKE $%d ESCAPE '\'`, toLikePattern(query.JobNamePattern))
+	}
+	if len(query.States) > 0 {
+		states := make([]string, len(query.States))
+		for i, state := range query.States {
+			states[i] = state.String()
+		}
+		addCondition("status = ANY($%d)", states)
+	}
+	if !query.ScheduledFrom.IsZero() {
+		addCondition("scheduled_at >= $%d", query.ScheduledFrom)
+	}
+	if !query.ScheduledTo.IsZero() {
+		addCondition("scheduled_at <= $%d", query.ScheduledTo)
+	}
+
+	sortColumn, ok := jobRunSortColumns[query.SortBy]
+	if !ok {
+		return nil, errors.InvalidArgument(scheduler.EntityJobRun, "invalid sort field "+string(query.SortBy))
+	}
+	direction := "ASC"
+	if query.Descending {
+		direction = "DESC"
+	}
+	args = append(args, query.PageSize, query.Offset)
+	queryJobRuns := `SELECT ` + jobRunColumns + ` FROM job_run WHERE ` + strings.Join(conditions, " AND ") +
+		fmt.Sprintf(` ORDER BY %s %s NULLS LAST, id LIMIT $%d OFFSET $%d`, sortColumn, direction, len(args)-1, len(args))
+
+	rows, err := j.db.Query(ctx, queryJobRuns, args...)
+	if err != nil {
+		return nil, errors.Wrap(scheduler.EntityJobRun, "error while querying job runs", err)
+	}
+	defer rows.Close()
+
+	var jobRuns []*scheduler.JobRun
+	for rows.Next() {
+		var jr jobRun
+		if err := rows.Scan(&jr.ID, &jr.JobName, &jr.NamespaceName, &jr.ProjectName, &jr.ScheduledAt, &jr.StartTime, &jr.EndTime,
+			&jr.Status, &jr.SLADefinition, &jr.SLAAlert, &jr.Monitoring, &jr.Artifacts, &jr.HeartbeatAt); err != nil {
+			return nil, errors.Wrap(scheduler.EntityJobRun, "error while querying job runs", err)
+		}
+		run, err := jr.toJobRun()
+		if err != nil {
+			return nil, err
+		}
+		jobRuns = append(jobRuns, run)
+	}
+	return jobRuns, nil
+}
+
+func toLikePattern(pattern string) string {
+	escaped := strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(pattern)
+	return strings.ReplaceAll(escaped, "*", "%")
+}
+
 func (j *JobRunRepository) UpdateState(ctx context.Context, jobRunID uuid.UUID, status scheduler.State) error {
 	updateJobRun := "update job_run set status = $1, updated_at = NOW() where id = $2"
 	_, err := j.db.Exec(ctx, updateJobRun, status, jobRunID)
@@ -180,6 +282,22 @@ func (j *JobRunRepository) UpdateMonitoring(ctx context.Context, jobRunID uuid.U
 	return errors.WrapIfErr(scheduler.EntityJobRun, "cannot update monitoring", err)
 }
 
+func (j *JobRunRepository) UpdateArtifacts(ctx context.Context, jobRunID uuid.UUID, artifacts map[string]any) error {
+	artifactsBytes, err := json.Marshal(artifacts)
+	if err != nil {
+		return errors.Wrap(scheduler.EntityJobRun, "error marshalling artifacts", err)
+	}
+	query := `update job_run set artifacts = $1 where id = $2`
+	_, err = j.db.Exec(ctx, query, artifactsBytes, jobRunID)
+	return errors.WrapIfErr(scheduler.EntityJobRun, "cannot update artifacts", err)
+}
+
+func (j *JobRunRepository) UpdateHeartbeat(ctx context.Context, jobRunID uuid.UUID, heartbeatAt time.Time) error {
+	query := `update job_run set heartbeat_at = $1 where id = $2`
+	_, err := j.db.Exec(ctx, query, heartbeatAt, jobRunID)
+	return errors.WrapIfErr(scheduler.EntityJobRun, "cannot update heartbeat", err)
+}
+
 func (j *JobRunRepository) Create(ctx context.Context, t tenant.Tenant, jobName scheduler.JobName, scheduledAt time.Time, slaDefinitionInSec int64) error {
 	// TODO: startTime should be event time
 	insertJobRun := `INSERT INTO job_run (` + columnsToStore + `, created_at, updated_at) values ($1, $2, $3, $4, NOW(), null, $5, $6, FALSE, NOW(), NOW()) ON CONFLICT DO NOTHING`
@@ -187,6 +305,45 @@ func (j *JobRunRepository) Create(ctx context.Context, t tenant.Tenant, jobName
 	return errors.WrapIfErr(scheduler.EntityJobRun, "unable to create job run", err)
 }
 
+// AddEvent records the event received for the job run, it returns false when the event is already recorded
+// SaveTrigger stores the config overrides of the manual run, replacing the ones of an earlier trigger at the same time
+func (j *JobRunRepository) SaveTrigger(ctx context.Context, trigger *scheduler.JobRunTrigger) error {
+	overrides, err := json.Marshal(trigger.ConfigOverrides)
+	if err != nil {
+		return errors.Wrap(scheduler.EntityJobRun, "error marshalling config overrides", err)
+	}
+	query := `INSERT INTO job_run_trigger (project_name, namespace_name, job_name, scheduled_at, config_overrides, created_at)
+VALUES ($1, $2, $3, $4, $5, NOW())
+ON CONFLICT (project_name, job_name, scheduled_at) DO UPDATE SET namespace_name = EXCLUDED.namespace_name,
+config_overrides = EXCLUDED.config_overrides, created_at = EXCLUDED.created_at`
+	_, err = j.db.Exec(ctx, query, trigger.Tenant.ProjectName(), trigger.Tenant.NamespaceName(), trigger.JobName,
+		trigger.ScheduledAt, overrides)
+	return errors.WrapIfErr(scheduler.EntityJobRun, "unable to save job run trigger", err)
+}
+
+// GetConfigOverrides returns the config overrides of the manual run scheduled at the time, empty when it was triggered without any
+func (j *JobRunRepository) GetConfigOverrides(ctx context.Context, t tenant.Tenant, jobName scheduler.JobName, scheduledAt time.Time) (map[string]string, error) {
+	query := `SELECT config_overrides FROM job_run_trigger WHERE project_name = $1 AND job_name = $2 AND scheduled_at = $3`
+	overrides := map[string]string{}
+	err := j.db.QueryRow(ctx, query, t.ProjectName(), jobName, scheduledAt).Scan(&overrides)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return map[string]string{}, nil
+		}
+		return nil, errors.Wrap(scheduler.EntityJobRun, "unable to get config overrides of job run", err)
+	}
+	return overrides, nil
+}
+
+func (j *JobRunRepository) AddEvent(ctx context.Context, jobRunID uuid.UUID, event *scheduler.Event) (bool, error) {
+	addEvent := `INSERT INTO job_run_event (job_run_id, operator_name, attempt, event_type, event_time, failure_category, created_at) values ($1, $2, $3, $4, $5, NULLIF($6, ''), NOW()) ON CONFLICT DO NOTHING`
+	tag, err := j.db.Exec(ctx, addEvent, jobRunID, event.OperatorName, event.Attempt, event.Type, event.EventTime, event.FailureCategory().String())
+	if err != nil {
+		return false, errors.Wrap(scheduler.EntityJobRun, "unable to add job run event", err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
 func NewJobRunRepository(pool *pgxpool.Pool) *JobRunRepository {
 	return &JobRunRepository{
 		db: pool,
@@ -11,6 +11,7 @@ import (
 
 	"github.com/goto/optimus/core/scheduler"
 	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
 	postgres "github.com/goto/optimus/internal/store/postgres/scheduler"
 )
 
@@ -32,6 +33,57 @@ func TestPostgresJobRunRepository(t *testing.T) {
 			assert.Nil(t, err)
 			assert.Equal(t, jobAName, jobRun.JobName.String())
 		})
+		t.Run("does not create the job run again for the same schedule", func(t *testing.T) {
+			db := dbSetup()
+			_ = addJobs(ctx, t, db)
+			jobRunRepo := postgres.NewJobRunRepository(db)
+			err := jobRunRepo.Create(ctx, tnnt, jobAName, scheduledAt, slaDefinitionInSec)
+			assert.Nil(t, err)
+			jobRun, err := jobRunRepo.GetByScheduledAt(ctx, tnnt, jobAName, scheduledAt)
+			assert.Nil(t, err)
+
+			err = jobRunRepo.Create(ctx, tnnt, jobAName, scheduledAt, slaDefinitionInSec)
+			assert.Nil(t, err)
+
+			jobRuns, err := jobRunRepo.GetByScheduledTimes(ctx, tnnt, jobAName, []time.Time{scheduledAt})
+			assert.Nil(t, err)
+			assert.Len(t, jobRuns, 1)
+			assert.Equal(t, jobRun.ID, jobRuns[0].ID)
+		})
+	})
+	t.Run("AddEvent", func(t *testing.T) {
+		t.Run("adds the event only once for the same attempt of operator", func(t *testing.T) {
+			db := dbSetup()
+			_ = addJobs(ctx, t, db)
+			jobRunRepo := postgres.NewJobRunRepository(db)
+			err := jobRunRepo.Create(ctx, tnnt, jobAName, scheduledAt, slaDefinitionInSec)
+			assert.Nil(t, err)
+			jobRun, err := jobRunRepo.GetByScheduledAt(ctx, tnnt, jobAName, scheduledAt)
+			assert.Nil(t, err)
+
+			event := &scheduler.Event{
+				JobName:        jobRun.JobName,
+				Tenant:         tnnt,
+				Type:           scheduler.TaskSuccessEvent,
+				EventTime:      currentTime,
+				OperatorName:   "bq2bq",
+				Attempt:        1,
+				Status:         scheduler.StateSuccess,
+				JobScheduledAt: scheduledAt,
+			}
+			added, err := jobRunRepo.AddEvent(ctx, jobRun.ID, event)
+			assert.Nil(t, err)
+			assert.True(t, added)
+
+			added, err = jobRunRepo.AddEvent(ctx, jobRun.ID, event)
+			assert.Nil(t, err)
+			assert.False(t, added)
+
+			event.Attempt = 2
+			added, err = jobRunRepo.AddEvent(ctx, jobRun.ID, event)
+			assert.Nil(t, err)
+			assert.True(t, added)
+		})
 	})
 	t.Run("GetByID", func(t *testing.T) {
 		t.Run("gets a specific job run by ID", func(t *testing.T) {
@@ -112,4 +164,120 @@ func TestPostgresJobRunRepository(t *testing.T) {
 			assert.EqualValues(t, monitoring, jobRunByID.Monitoring)
 		})
 	})
+	t.Run("UpdateHeartbeat", func(t *testing.T) {
+		t.Run("updates job run heartbeat", func(t *testing.T) {
+			db := dbSetup()
+			_ = addJobs(ctx, t, db)
+			jobRunRepo := postgres.NewJobRunRepository(db)
+			err := jobRunRepo.Create(ctx, tnnt, jobAName, scheduledAt, slaDefinitionInSec)
+			assert.NoError(t, err)
+			jobRun, err := jobRunRepo.GetByScheduledAt(ctx, tnnt, jobAName, scheduledAt)
+			assert.NoError(t, err)
+			assert.Nil(t, jobRun.HeartbeatAt)
+
+			err = jobRunRepo.UpdateHeartbeat(ctx, jobRun.ID, currentTime)
+			assert.NoError(t, err)
+
+			jobRunByID, err := jobRunRepo.GetByID(ctx, scheduler.JobRunID(jobRun.ID))
+			assert.NoError(t, err)
+			assert.Equal(t, currentTime.Format(time.RFC1123), jobRunByID.HeartbeatAt.UTC().Format(time.RFC1123))
+		})
+	})
+	t.Run("GetLatestSuccessRun", func(t *testing.T) {
+		t.Run("gets the latest successful run with its artifacts", func(t *testing.T) {
+			db := dbSetup()
+			_ = addJobs(ctx, t, db)
+			jobRunRepo := postgres.NewJobRunRepository(db)
+
+			previousScheduledAt := scheduledAt.Add(-time.Hour)
+			for _, runScheduledAt := range []time.Time{previousScheduledAt, scheduledAt} {
+				err := jobRunRepo.Create(ctx, tnnt, jobAName, runScheduledAt, slaDefinitionInSec)
+				assert.NoError(t, err)
+			}
+			previousRun, err := jobRunRepo.GetByScheduledAt(ctx, tnnt, jobAName, previousScheduledAt)
+			assert.NoError(t, err)
+			err = jobRunRepo.Update(ctx, previousRun.ID, currentTime, scheduler.StateSuccess)
+			assert.NoError(t, err)
+
+			artifacts := map[string]any{"max_id": "1024"}
+			err = jobRunRepo.UpdateArtifacts(ctx, previousRun.ID, artifacts)
+			assert.NoError(t, err)
+
+			jobRun, err := jobRunRepo.GetLatestSuccessRun(ctx, tnnt, jobAName, scheduledAt)
+			assert.NoError(t, err)
+			assert.Equal(t, previousRun.ID, jobRun.ID)
+			assert.EqualValues(t, artifacts, jobRun.Artifacts)
+		})
+		t.Run("returns not found error if there is no successful run", func(t *testing.T) {
+			db := dbSetup()
+			_ = addJobs(ctx, t, db)
+			jobRunRepo := postgres.NewJobRunRepository(db)
+			err := jobRunRepo.Create(ctx, tnnt, jobAName, scheduledAt, slaDefinitionInSec)
+			assert.NoError(t, err)
+
+			jobRun, err := jobRunRepo.GetLatestSuccessRun(ctx, tnnt, jobAName, scheduledAt)
+			assert.True(t, errors.IsErrorType(err, errors.ErrNotFound))
+			assert.Nil(t, jobRun)
+		})
+	})
+	t.Run("Query", func(t *testing.T) {
+		t.Run("returns the runs matching the filters in the requested order and page", func(t *testing.T) {
+			db := dbSetup()
+			_ = addJobs(ctx, t, db)
+			jobRunRepo := postgres.NewJobRunRepository(db)
+			for i := 0; i < 3; i++ {
+				assert.NoError(t, jobRunRepo.Create(ctx, tnnt, jobAName, scheduledAt.Add(-time.Duration(i)*time.Hour), slaDefinitionInSec))
+			}
+			assert.NoError(t, jobRunRepo.Create(ctx, tnnt, jobBName, scheduledAt, slaDefinitionInSec))
+			jobRun, err := jobRunRepo.GetByScheduledAt(ctx, tnnt, jobAName, scheduledAt)
+			assert.NoError(t, err)
+			assert.NoError(t, jobRunRepo.Update(ctx, jobRun.ID, currentTime, scheduler.StateFailed))
+
+			jobRuns, err := jobRunRepo.Query(ctx, &scheduler.JobRunQuery{
+				ProjectName:    tnnt.ProjectName(),
+				JobNamePattern: "*-A",
+				SortBy:         scheduler.JobRunSortByScheduledAt,
+				Descending:     true,
+				Offset:         1,
+				PageSize:       10,
+			})
+			assert.NoError(t, err)
+			assert.Len(t, jobRuns, 2)
+			assert.Equal(t, jobAName, jobRuns[0].JobName.String())
+			assert.Equal(t, scheduledAt.Add(-time.Hour).UTC().Format(time.RFC1123), jobRuns[0].ScheduledAt.UTC().Format(time.RFC1123))
+
+			jobRuns, err = jobRunRepo.Query(ctx, &scheduler.JobRunQuery{
+				ProjectName:   tnnt.ProjectName(),
+				NamespaceName: tnnt.NamespaceName(),
+				States:        []scheduler.State{scheduler.StateFailed},
+				ScheduledFrom: scheduledAt.Add(-time.Minute),
+				SortBy:        scheduler.JobRunSortByJobName,
+				PageSize:      10,
+			})
+			assert.NoError(t, err)
+			assert.Len(t, jobRuns, 1)
+			assert.Equal(t, jobRun.ID, jobRuns[0].ID)
+		})
+	})
+	t.Run("SaveTrigger", func(t *testing.T) {
+		t.Run("replaces the config overrides of an earlier trigger at the same time", func(t *testing.T) {
+			db := dbSetup()
+			jobRunRepo := postgres.NewJobRunRepository(db)
+			triggeredAt := scheduledAt.Truncate(time.Second)
+
+			trigger, err := scheduler.NewJobRunTrigger(tnnt, jobAName, triggeredAt, map[string]string{"LOAD_METHOD": "APPEND"})
+			assert.NoError(t, err)
+			assert.NoError(t, jobRunRepo.SaveTrigger(ctx, trigger))
+			trigger.ConfigOverrides = map[string]string{"LOAD_METHOD": "REPLACE"}
+			assert.NoError(t, jobRunRepo.SaveTrigger(ctx, trigger))
+
+			overrides, err := jobRunRepo.GetConfigOverrides(ctx, tnnt, jobAName, triggeredAt)
+			assert.NoError(t, err)
+			assert.Equal(t, map[string]string{"LOAD_METHOD": "REPLACE"}, overrides)
+
+			overrides, err = jobRunRepo.GetConfigOverrides(ctx, tnnt, jobBName, triggeredAt)
+			assert.NoError(t, err)
+			assert.Empty(t, overrides)
+		})
+	})
 }
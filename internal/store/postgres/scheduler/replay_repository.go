@@ -16,14 +16,17 @@ import (
 )
 
 const (
-	replayColumnsToStore = `job_name, namespace_name, project_name, start_time, end_time, description, parallel, job_config, status, message`
+	replayColumnsToStore = `job_name, namespace_name, project_name, start_time, end_time, description, parallel, job_config, status, message, requested_by, reason, excluded_scheduled_at`
 	replayColumns        = `id, ` + replayColumnsToStore + `, created_at`
 
 	replayRunColumns       = `replay_id, scheduled_at, status`
 	replayRunDetailColumns = `id as replay_id, job_name, namespace_name, project_name, start_time, end_time, description, 
-parallel, job_config, r.status as replay_status, r.message as replay_message, scheduled_at, run.status as run_status, r.created_at as replay_created_at`
+parallel, job_config, r.status as replay_status, r.message as replay_message, requested_by, reason, excluded_scheduled_at, scheduled_at, run.status as run_status, r.created_at as replay_created_at`
+
+	replayStateTransitionColumns = `status, message, created_at`
 
 	updateReplayRequest = `UPDATE replay_request SET status = $1, message = $2, updated_at = NOW() WHERE id = $3`
+	claimReplayRequest  = `UPDATE replay_request SET status = $1, message = '', lease_renewed_at = NOW(), updated_at = NOW() WHERE id = $2`
 )
 
 type ReplayRepository struct {
@@ -46,6 +49,11 @@ type replayRequest struct {
 	Status  string
 	Message string
 
+	RequestedBy string
+	Reason      string
+
+	ExcludedScheduledAt []time.Time
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
@@ -56,6 +64,9 @@ func (r *replayRequest) toSchedulerReplayRequest() (*scheduler.Replay, error) {
 		return nil, err
 	}
 	conf := scheduler.NewReplayConfig(r.StartTime, r.EndTime, r.Parallel, r.JobConfig, r.Description)
+	conf.RequestedBy = r.RequestedBy
+	conf.Reason = r.Reason
+	conf.ExcludedScheduledAt = r.ExcludedScheduledAt
 	replayStatus, err := scheduler.ReplayStateFromString(r.Status)
 	if err != nil {
 		return nil, err
@@ -83,6 +94,11 @@ type replayRun struct {
 	ReplayStatus string
 	Message      string
 
+	RequestedBy string
+	Reason      string
+
+	ExcludedScheduledAt []time.Time
+
 	ScheduledTime time.Time
 	RunStatus     string
 
@@ -96,6 +112,9 @@ func (r *replayRun) toReplayRequest() (*scheduler.Replay, error) {
 		return nil, err
 	}
 	conf := scheduler.NewReplayConfig(r.StartTime, r.EndTime, r.Parallel, r.JobConfig, r.Description)
+	conf.RequestedBy = r.RequestedBy
+	conf.Reason = r.Reason
+	conf.ExcludedScheduledAt = r.ExcludedScheduledAt
 	replayStatus, err := scheduler.ReplayStateFromString(r.ReplayStatus)
 	if err != nil {
 		return nil, err
@@ -145,6 +164,10 @@ func (r ReplayRepository) RegisterReplay(ctx context.Context, replay *scheduler.
 		return uuid.Nil, err
 	}
 
+	if err := r.insertReplayStateTransition(ctx, tx, storedReplay.ID, replay.State(), replay.Message()); err != nil {
+		return uuid.Nil, err
+	}
+
 	return storedReplay.ID, nil
 }
 
@@ -174,13 +197,52 @@ func (r ReplayRepository) GetReplayToExecute(ctx context.Context) (*scheduler.Re
 		return nil, err
 	}
 
-	// TODO: Avoid having In Progress, but instead use row lock (for update)
-	if _, err := tx.Exec(ctx, updateReplayRequest, scheduler.ReplayStateInProgress, "", storedReplay.Replay.ID()); err != nil {
+	if _, err := tx.Exec(ctx, claimReplayRequest, scheduler.ReplayStateInProgress, storedReplay.Replay.ID()); err != nil {
 		return nil, errors.Wrap(scheduler.EntityJobRun, "unable to update replay", err)
 	}
 	return storedReplay, nil
 }
 
+// GetReplayToResume claims a replay in progress whose lease is not renewed within the lease timeout, as the server
+// processing it is gone. The lease is renewed by the claim, hence the replay is resumed by a single server.
+func (r ReplayRepository) GetReplayToResume(ctx context.Context, leaseTimeout time.Duration) (*scheduler.Replay, error) {
+	claimReplay := `UPDATE replay_request SET lease_renewed_at = NOW() WHERE id = (
+			SELECT id FROM replay_request WHERE status = $1
+			AND (lease_renewed_at IS NULL OR lease_renewed_at < NOW() - make_interval(secs => $2))
+			ORDER BY updated_at LIMIT 1 FOR UPDATE SKIP LOCKED
+		) RETURNING ` + replayColumns
+
+	var rr replayRequest
+	err := r.db.QueryRow(ctx, claimReplay, scheduler.ReplayStateInProgress, leaseTimeout.Seconds()).
+		Scan(&rr.ID, &rr.JobName, &rr.NamespaceName, &rr.ProjectName, &rr.StartTime, &rr.EndTime, &rr.Description, &rr.Parallel, &rr.JobConfig,
+			&rr.Status, &rr.Message, &rr.RequestedBy, &rr.Reason, &rr.ExcludedScheduledAt, &rr.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.NotFound(scheduler.EntityReplay, "no replay request found to resume")
+		}
+		return nil, errors.Wrap(scheduler.EntityReplay, "unable to claim replay to resume", err)
+	}
+	return rr.toSchedulerReplayRequest()
+}
+
+// RenewReplayLease keeps the replay being processed from being resumed by other servers, a released lease is not renewed
+func (r ReplayRepository) RenewReplayLease(ctx context.Context, replayID uuid.UUID) error {
+	renewLease := `UPDATE replay_request SET lease_renewed_at = NOW() WHERE id = $1 AND status = $2 AND lease_renewed_at IS NOT NULL`
+	if _, err := r.db.Exec(ctx, renewLease, replayID, scheduler.ReplayStateInProgress); err != nil {
+		return errors.Wrap(scheduler.EntityReplay, "unable to renew replay lease", err)
+	}
+	return nil
+}
+
+// ReleaseReplayLease lets the replay in progress be resumed by any server without waiting for its lease to expire
+func (r ReplayRepository) ReleaseReplayLease(ctx context.Context, replayID uuid.UUID) error {
+	releaseLease := `UPDATE replay_request SET lease_renewed_at = NULL WHERE id = $1 AND status = $2`
+	if _, err := r.db.Exec(ctx, releaseLease, replayID, scheduler.ReplayStateInProgress); err != nil {
+		return errors.Wrap(scheduler.EntityReplay, "unable to release replay lease", err)
+	}
+	return nil
+}
+
 func (r ReplayRepository) GetReplayRequestsByStatus(ctx context.Context, statusList []scheduler.ReplayState) ([]*scheduler.Replay, error) {
 	getReplayRequest := `SELECT ` + replayColumns + ` FROM replay_request WHERE status = ANY($1)`
 	rows, err := r.db.Query(ctx, getReplayRequest, statusList)
@@ -193,7 +255,7 @@ func (r ReplayRepository) GetReplayRequestsByStatus(ctx context.Context, statusL
 	for rows.Next() {
 		var rr replayRequest
 		if err := rows.Scan(&rr.ID, &rr.JobName, &rr.NamespaceName, &rr.ProjectName, &rr.StartTime, &rr.EndTime, &rr.Description, &rr.Parallel, &rr.JobConfig,
-			&rr.Status, &rr.Message, &rr.CreatedAt); err != nil {
+			&rr.Status, &rr.Message, &rr.RequestedBy, &rr.Reason, &rr.ExcludedScheduledAt, &rr.CreatedAt); err != nil {
 			return nil, errors.Wrap(scheduler.EntityJobRun, "unable to get the stored replay", err)
 		}
 		schedulerReplayReq, err := rr.toSchedulerReplayRequest()
@@ -217,7 +279,7 @@ func (r ReplayRepository) GetReplaysByProject(ctx context.Context, projectName t
 	for rows.Next() {
 		var rr replayRequest
 		if err := rows.Scan(&rr.ID, &rr.JobName, &rr.NamespaceName, &rr.ProjectName, &rr.StartTime, &rr.EndTime, &rr.Description, &rr.Parallel, &rr.JobConfig,
-			&rr.Status, &rr.Message, &rr.CreatedAt); err != nil {
+			&rr.Status, &rr.Message, &rr.RequestedBy, &rr.Reason, &rr.ExcludedScheduledAt, &rr.CreatedAt); err != nil {
 			return nil, errors.Wrap(scheduler.EntityJobRun, "unable to get the stored replay", err)
 		}
 		schedulerReplayReq, err := rr.toSchedulerReplayRequest()
@@ -253,6 +315,8 @@ func (r ReplayRepository) GetReplayByID(ctx context.Context, replayID uuid.UUID)
 		JobConfig:   rr.JobConfig,
 		Parallel:    rr.Parallel,
 		Description: rr.Description,
+		RequestedBy: rr.RequestedBy,
+		Reason:      rr.Reason,
 	}
 	replay := scheduler.NewReplay(rr.ID, scheduler.JobName(rr.JobName), replayTenant, &replayConfig, scheduler.ReplayState(rr.Status), rr.CreatedAt)
 	replayRuns := make([]*scheduler.JobRunStatus, len(runs))
@@ -340,10 +404,60 @@ func (r ReplayRepository) GetReplayJobConfig(ctx context.Context, jobTenant tena
 	return configs, nil
 }
 
+func (r ReplayRepository) GetReplayStateTransitions(ctx context.Context, replayID uuid.UUID) ([]*scheduler.ReplayStateTransition, error) {
+	getTransitions := `SELECT ` + replayStateTransitionColumns + ` FROM replay_state_transition WHERE replay_id=$1 ORDER BY created_at ASC`
+	rows, err := r.db.Query(ctx, getTransitions, replayID)
+	if err != nil {
+		return nil, errors.Wrap(scheduler.EntityReplay, "unable to get replay state transitions", err)
+	}
+	defer rows.Close()
+
+	var transitions []*scheduler.ReplayStateTransition
+	for rows.Next() {
+		var status string
+		transition := &scheduler.ReplayStateTransition{}
+		if err := rows.Scan(&status, &transition.Message, &transition.CreatedAt); err != nil {
+			return nil, errors.Wrap(scheduler.EntityReplay, "unable to get the stored replay state transition", err)
+		}
+		transition.State, err = scheduler.ReplayStateFromString(status)
+		if err != nil {
+			return nil, err
+		}
+		transitions = append(transitions, transition)
+	}
+	return transitions, nil
+}
+
 func (r ReplayRepository) updateReplayRequest(ctx context.Context, id uuid.UUID, replayStatus scheduler.ReplayState, message string) error {
-	if _, err := r.db.Exec(ctx, updateReplayRequest, replayStatus, message, id); err != nil {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, updateReplayRequest, replayStatus, message, id); err != nil {
+		tx.Rollback(ctx)
 		return errors.Wrap(scheduler.EntityJobRun, "unable to update replay", err)
 	}
+	if err := r.insertReplayStateTransition(ctx, tx, id, replayStatus, message); err != nil {
+		tx.Rollback(ctx)
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return errors.Wrap(scheduler.EntityReplay, "unable to update replay", err)
+	}
+	return nil
+}
+
+// insertReplayStateTransition records the state of the replay, unless it is the same as the latest recorded one
+func (ReplayRepository) insertReplayStateTransition(ctx context.Context, tx pgx.Tx, replayID uuid.UUID, replayStatus scheduler.ReplayState, message string) error {
+	insertTransition := `INSERT INTO replay_state_transition (replay_id, ` + replayStateTransitionColumns + `)
+		SELECT $1, $2, $3, NOW() WHERE $2 IS DISTINCT FROM (
+			SELECT status FROM replay_state_transition WHERE replay_id = $1 ORDER BY created_at DESC LIMIT 1
+		)`
+	if _, err := tx.Exec(ctx, insertTransition, replayID, replayStatus, message); err != nil {
+		return errors.Wrap(scheduler.EntityReplay, "unable to store replay state transition", err)
+	}
 	return nil
 }
 
@@ -368,9 +482,10 @@ func (r ReplayRepository) updateReplayRuns(ctx context.Context, id uuid.UUID, ru
 }
 
 func (ReplayRepository) insertReplay(ctx context.Context, tx pgx.Tx, replay *scheduler.Replay) error {
-	insertReplay := `INSERT INTO replay_request (` + replayColumnsToStore + `, created_at, updated_at) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, NOW(), NOW())`
+	insertReplay := `INSERT INTO replay_request (` + replayColumnsToStore + `, created_at, updated_at) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, NOW(), NOW())`
 	_, err := tx.Exec(ctx, insertReplay, replay.JobName().String(), replay.Tenant().NamespaceName(), replay.Tenant().ProjectName(),
-		replay.Config().StartTime, replay.Config().EndTime, replay.Config().Description, replay.Config().Parallel, replay.Config().JobConfig, replay.State(), replay.Message())
+		replay.Config().StartTime, replay.Config().EndTime, replay.Config().Description, replay.Config().Parallel, replay.Config().JobConfig, replay.State(), replay.Message(),
+		replay.Config().RequestedBy, replay.Config().Reason, replay.Config().ExcludedScheduledAt)
 	if err != nil {
 		return errors.Wrap(scheduler.EntityJobRun, "unable to store replay", err)
 	}
@@ -382,7 +497,7 @@ func (ReplayRepository) getReplayRequest(ctx context.Context, tx pgx.Tx, replay
 	getReplayRequest := `SELECT ` + replayColumns + ` FROM replay_request where project_name = $1 and job_name = $2 and start_time = $3 and end_time = $4 order by created_at desc limit 1`
 	if err := tx.QueryRow(ctx, getReplayRequest, replay.Tenant().ProjectName(), replay.JobName().String(), replay.Config().StartTime, replay.Config().EndTime).
 		Scan(&rr.ID, &rr.JobName, &rr.NamespaceName, &rr.ProjectName, &rr.StartTime, &rr.EndTime, &rr.Description, &rr.Parallel, &rr.JobConfig,
-			&rr.Status, &rr.Message, &rr.CreatedAt); err != nil {
+			&rr.Status, &rr.Message, &rr.RequestedBy, &rr.Reason, &rr.ExcludedScheduledAt, &rr.CreatedAt); err != nil {
 		return rr, errors.Wrap(scheduler.EntityJobRun, "unable to get the stored replay", err)
 	}
 	return rr, nil
@@ -392,7 +507,7 @@ func (r ReplayRepository) getReplayRequestByID(ctx context.Context, replayID uui
 	var rr replayRequest
 	getReplayRequest := `SELECT ` + replayColumns + ` FROM replay_request WHERE id=$1`
 	err := r.db.QueryRow(ctx, getReplayRequest, replayID).Scan(&rr.ID, &rr.JobName, &rr.NamespaceName, &rr.ProjectName, &rr.StartTime, &rr.EndTime, &rr.Description, &rr.Parallel, &rr.JobConfig,
-		&rr.Status, &rr.Message, &rr.CreatedAt)
+		&rr.Status, &rr.Message, &rr.RequestedBy, &rr.Reason, &rr.ExcludedScheduledAt, &rr.CreatedAt)
 	if err != nil {
 		return rr, err
 	}
@@ -421,7 +536,7 @@ func (ReplayRepository) getExecutableReplayRuns(ctx context.Context, tx pgx.Tx)
 	getReplayRequest := `
 		WITH request AS (
 			SELECT ` + replayColumns + ` FROM replay_request WHERE status IN ('created', 'partial replayed', 'replayed') 
-			ORDER BY updated_at DESC LIMIT 1
+			ORDER BY updated_at DESC LIMIT 1 FOR UPDATE SKIP LOCKED
 		)
 		SELECT ` + replayRunDetailColumns + ` FROM replay_run AS run
 		JOIN request AS r ON (replay_id = r.id)`
@@ -436,7 +551,7 @@ func (ReplayRepository) getExecutableReplayRuns(ctx context.Context, tx pgx.Tx)
 	for rows.Next() {
 		var run replayRun
 		if err := rows.Scan(&run.ID, &run.JobName, &run.NamespaceName, &run.ProjectName, &run.StartTime, &run.EndTime,
-			&run.Description, &run.Parallel, &run.JobConfig, &run.ReplayStatus, &run.Message, &run.ScheduledTime, &run.RunStatus, &run.CreatedAt); err != nil {
+			&run.Description, &run.Parallel, &run.JobConfig, &run.ReplayStatus, &run.Message, &run.RequestedBy, &run.Reason, &run.ExcludedScheduledAt, &run.ScheduledTime, &run.RunStatus, &run.CreatedAt); err != nil {
 			return runs, errors.Wrap(scheduler.EntityJobRun, "unable to get the stored replay", err)
 		}
 		runs = append(runs, &run)
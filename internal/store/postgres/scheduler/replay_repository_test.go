@@ -76,6 +76,41 @@ func TestPostgresSchedulerRepository(t *testing.T) {
 		})
 	})
 
+	t.Run("GetReplayStateTransitions", func(t *testing.T) {
+		t.Run("return every state change of the replay along with requester", func(t *testing.T) {
+			db := dbSetup()
+			replayRepo := postgres.NewReplayRepository(db)
+
+			replayConfig := scheduler.NewReplayConfig(startTime, endTime, true, replayJobConfig, description)
+			replayConfig.RequestedBy = "optimus-user"
+			replayConfig.Reason = "upstream data correction"
+			replayReq := scheduler.NewReplayRequest(jobAName, tnnt, replayConfig, scheduler.ReplayStateCreated)
+
+			replayID, err := replayRepo.RegisterReplay(ctx, replayReq, jobRunsAllPending)
+			assert.Nil(t, err)
+
+			err = replayRepo.UpdateReplay(ctx, replayID, scheduler.ReplayStateReplayed, jobRunsAllQueued, "")
+			assert.NoError(t, err)
+			err = replayRepo.UpdateReplay(ctx, replayID, scheduler.ReplayStateReplayed, jobRunsAllQueued, "")
+			assert.NoError(t, err)
+			err = replayRepo.UpdateReplayStatus(ctx, replayID, scheduler.ReplayStateFailed, "found 1 failed runs.")
+			assert.NoError(t, err)
+
+			transitions, err := replayRepo.GetReplayStateTransitions(ctx, replayID)
+			assert.NoError(t, err)
+			assert.Len(t, transitions, 3)
+			assert.Equal(t, scheduler.ReplayStateCreated, transitions[0].State)
+			assert.Equal(t, scheduler.ReplayStateReplayed, transitions[1].State)
+			assert.Equal(t, scheduler.ReplayStateFailed, transitions[2].State)
+			assert.Equal(t, "found 1 failed runs.", transitions[2].Message)
+
+			replayWithRuns, err := replayRepo.GetReplayByID(ctx, replayID)
+			assert.NoError(t, err)
+			assert.Equal(t, "optimus-user", replayWithRuns.Replay.Config().RequestedBy)
+			assert.Equal(t, "upstream data correction", replayWithRuns.Replay.Config().Reason)
+		})
+	})
+
 	t.Run("GetReplayToExecute", func(t *testing.T) {
 		t.Run("return executable replay", func(t *testing.T) {
 			db := dbSetup()
@@ -114,6 +149,74 @@ func TestPostgresSchedulerRepository(t *testing.T) {
 		})
 	})
 
+	t.Run("GetReplayToResume", func(t *testing.T) {
+		t.Run("claim replay in progress whose lease is expired only once", func(t *testing.T) {
+			db := dbSetup()
+			replayRepo := postgres.NewReplayRepository(db)
+
+			replayConfig := scheduler.NewReplayConfig(startTime, endTime, true, replayJobConfig, description)
+			replayReq := scheduler.NewReplayRequest(jobAName, tnnt, replayConfig, scheduler.ReplayStateCreated)
+
+			_, err := replayRepo.RegisterReplay(ctx, replayReq, jobRunsAllPending)
+			assert.Nil(t, err)
+			replayToExecute, err := replayRepo.GetReplayToExecute(ctx)
+			assert.Nil(t, err)
+
+			_, err = replayRepo.GetReplayToResume(ctx, time.Hour)
+			assert.ErrorContains(t, err, "no replay request found to resume")
+
+			replayToResume, err := replayRepo.GetReplayToResume(ctx, 0)
+			assert.Nil(t, err)
+			assert.Equal(t, replayToExecute.Replay.ID(), replayToResume.ID())
+
+			_, err = replayRepo.GetReplayToResume(ctx, time.Minute)
+			assert.ErrorContains(t, err, "no replay request found to resume")
+		})
+	})
+	t.Run("RenewReplayLease", func(t *testing.T) {
+		t.Run("keep replay in progress from being claimed to resume", func(t *testing.T) {
+			db := dbSetup()
+			replayRepo := postgres.NewReplayRepository(db)
+
+			replayConfig := scheduler.NewReplayConfig(startTime, endTime, true, replayJobConfig, description)
+			replayReq := scheduler.NewReplayRequest(jobAName, tnnt, replayConfig, scheduler.ReplayStateCreated)
+
+			_, err := replayRepo.RegisterReplay(ctx, replayReq, jobRunsAllPending)
+			assert.Nil(t, err)
+			replayToExecute, err := replayRepo.GetReplayToExecute(ctx)
+			assert.Nil(t, err)
+
+			time.Sleep(time.Second)
+			err = replayRepo.RenewReplayLease(ctx, replayToExecute.Replay.ID())
+			assert.Nil(t, err)
+
+			_, err = replayRepo.GetReplayToResume(ctx, time.Second)
+			assert.ErrorContains(t, err, "no replay request found to resume")
+		})
+	})
+	t.Run("ReleaseReplayLease", func(t *testing.T) {
+		t.Run("let replay in progress be claimed to resume and not renewed anymore", func(t *testing.T) {
+			db := dbSetup()
+			replayRepo := postgres.NewReplayRepository(db)
+
+			replayConfig := scheduler.NewReplayConfig(startTime, endTime, true, replayJobConfig, description)
+			replayReq := scheduler.NewReplayRequest(jobAName, tnnt, replayConfig, scheduler.ReplayStateCreated)
+
+			_, err := replayRepo.RegisterReplay(ctx, replayReq, jobRunsAllPending)
+			assert.Nil(t, err)
+			replayToExecute, err := replayRepo.GetReplayToExecute(ctx)
+			assert.Nil(t, err)
+
+			err = replayRepo.ReleaseReplayLease(ctx, replayToExecute.Replay.ID())
+			assert.Nil(t, err)
+			err = replayRepo.RenewReplayLease(ctx, replayToExecute.Replay.ID())
+			assert.Nil(t, err)
+
+			replayToResume, err := replayRepo.GetReplayToResume(ctx, time.Hour)
+			assert.Nil(t, err)
+			assert.Equal(t, replayToExecute.Replay.ID(), replayToResume.ID())
+		})
+	})
 	t.Run("GetReplayRequestsByStatus", func(t *testing.T) {
 		t.Run("return replay requests given list of status", func(t *testing.T) {
 			db := dbSetup()
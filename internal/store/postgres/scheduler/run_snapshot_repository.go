@@ -0,0 +1,114 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+)
+
+const (
+	runSnapshotColumnsToStore = `project_name, job_name, executor_name, executor_type, scheduled_at, attempt, payload, expires_at`
+	runSnapshotColumns        = `id, project_name, job_name, executor_name, executor_type, scheduled_at, attempt, expires_at, created_at`
+)
+
+type RunSnapshotRepository struct {
+	db *pgxpool.Pool
+}
+
+type runSnapshot struct {
+	ID uuid.UUID
+
+	ProjectName  string
+	JobName      string
+	ExecutorName string
+	ExecutorType string
+	ScheduledAt  time.Time
+	Attempt      int
+
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+func (s *runSnapshot) toRunSnapshot() *scheduler.RunSnapshot {
+	return &scheduler.RunSnapshot{
+		ID:          s.ID,
+		ProjectName: tenant.ProjectName(s.ProjectName),
+		JobName:     scheduler.JobName(s.JobName),
+		Executor: scheduler.Executor{
+			Name: s.ExecutorName,
+			Type: scheduler.ExecutorType(s.ExecutorType),
+		},
+		ScheduledAt: s.ScheduledAt,
+		Attempt:     s.Attempt,
+		ExpiresAt:   s.ExpiresAt,
+		CreatedAt:   s.CreatedAt,
+	}
+}
+
+func (r RunSnapshotRepository) Save(ctx context.Context, snapshot *scheduler.RunSnapshot, payload []byte) error {
+	insertSnapshot := `INSERT INTO run_snapshot (` + runSnapshotColumnsToStore + `, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW())`
+	_, err := r.db.Exec(ctx, insertSnapshot, snapshot.ProjectName, snapshot.JobName, snapshot.Executor.Name,
+		snapshot.Executor.Type.String(), snapshot.ScheduledAt, snapshot.Attempt, payload, snapshot.ExpiresAt)
+	if err != nil {
+		return errors.Wrap(scheduler.EntityRunSnapshot, "unable to store run snapshot", err)
+	}
+	return nil
+}
+
+func (r RunSnapshotRepository) Get(ctx context.Context, projectName tenant.ProjectName, id uuid.UUID, at time.Time) (*scheduler.RunSnapshot, []byte, error) {
+	getSnapshot := `SELECT ` + runSnapshotColumns + `, payload FROM run_snapshot WHERE project_name = $1 AND id = $2 AND expires_at > $3`
+
+	var stored runSnapshot
+	var payload []byte
+	err := r.db.QueryRow(ctx, getSnapshot, projectName, id, at).Scan(&stored.ID, &stored.ProjectName, &stored.JobName,
+		&stored.ExecutorName, &stored.ExecutorType, &stored.ScheduledAt, &stored.Attempt, &stored.ExpiresAt, &stored.CreatedAt,
+		&payload)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil, errors.NotFound(scheduler.EntityRunSnapshot, "no run snapshot found for id "+id.String())
+		}
+		return nil, nil, errors.Wrap(scheduler.EntityRunSnapshot, "unable to get run snapshot", err)
+	}
+	return stored.toRunSnapshot(), payload, nil
+}
+
+func (r RunSnapshotRepository) GetByJob(ctx context.Context, projectName tenant.ProjectName, jobName scheduler.JobName, at time.Time) ([]*scheduler.RunSnapshot, error) {
+	getSnapshots := `SELECT ` + runSnapshotColumns + ` FROM run_snapshot
+		WHERE project_name = $1 AND job_name = $2 AND expires_at > $3 ORDER BY scheduled_at DESC, created_at DESC`
+	rows, err := r.db.Query(ctx, getSnapshots, projectName, jobName, at)
+	if err != nil {
+		return nil, errors.Wrap(scheduler.EntityRunSnapshot, "unable to get run snapshots", err)
+	}
+	defer rows.Close()
+
+	var snapshots []*scheduler.RunSnapshot
+	for rows.Next() {
+		var stored runSnapshot
+		if err := rows.Scan(&stored.ID, &stored.ProjectName, &stored.JobName, &stored.ExecutorName, &stored.ExecutorType,
+			&stored.ScheduledAt, &stored.Attempt, &stored.ExpiresAt, &stored.CreatedAt); err != nil {
+			return nil, errors.Wrap(scheduler.EntityRunSnapshot, "unable to get the stored run snapshot", err)
+		}
+		snapshots = append(snapshots, stored.toRunSnapshot())
+	}
+	return snapshots, nil
+}
+
+func (r RunSnapshotRepository) DeleteExpired(ctx context.Context, at time.Time) (int64, error) {
+	tag, err := r.db.Exec(ctx, `DELETE FROM run_snapshot WHERE expires_at <= $1`, at)
+	if err != nil {
+		return 0, errors.Wrap(scheduler.EntityRunSnapshot, "unable to delete expired run snapshots", err)
+	}
+	return tag.RowsAffected(), nil
+}
+
+func NewRunSnapshotRepository(db *pgxpool.Pool) *RunSnapshotRepository {
+	return &RunSnapshotRepository{db: db}
+}
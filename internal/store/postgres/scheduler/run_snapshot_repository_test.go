@@ -0,0 +1,102 @@
+//go:build !unit_test
+
+package scheduler_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+	postgres "github.com/goto/optimus/internal/store/postgres/scheduler"
+)
+
+func TestPostgresRunSnapshotRepository(t *testing.T) {
+	ctx := context.Background()
+	projectName := tenant.ProjectName("proj-a")
+	jobName := scheduler.JobName("job-a")
+	now := time.Now().UTC().Truncate(time.Second)
+
+	newSnapshot := func(scheduledAt, expiresAt time.Time) *scheduler.RunSnapshot {
+		return &scheduler.RunSnapshot{
+			ProjectName: projectName,
+			JobName:     jobName,
+			Executor:    scheduler.Executor{Name: "bq2bq", Type: scheduler.ExecutorTask},
+			ScheduledAt: scheduledAt,
+			Attempt:     1,
+			ExpiresAt:   expiresAt,
+		}
+	}
+
+	t.Run("GetByJob", func(t *testing.T) {
+		t.Run("returns only the snapshots not expired yet, latest schedule first", func(t *testing.T) {
+			db := dbSetup()
+			repo := postgres.NewRunSnapshotRepository(db)
+
+			assert.NoError(t, repo.Save(ctx, newSnapshot(now.Add(-48*time.Hour), now.Add(time.Hour)), []byte("first")))
+			assert.NoError(t, repo.Save(ctx, newSnapshot(now.Add(-24*time.Hour), now.Add(time.Hour)), []byte("second")))
+			assert.NoError(t, repo.Save(ctx, newSnapshot(now.Add(-72*time.Hour), now.Add(time.Minute)), []byte("expired")))
+
+			snapshots, err := repo.GetByJob(ctx, projectName, jobName, now.Add(10*time.Minute))
+			assert.NoError(t, err)
+			assert.Len(t, snapshots, 2)
+			assert.True(t, snapshots[0].ScheduledAt.Equal(now.Add(-24*time.Hour)))
+			assert.Equal(t, scheduler.Executor{Name: "bq2bq", Type: scheduler.ExecutorTask}, snapshots[0].Executor)
+			assert.Equal(t, 1, snapshots[0].Attempt)
+		})
+	})
+	t.Run("Get", func(t *testing.T) {
+		t.Run("returns the snapshot with its payload", func(t *testing.T) {
+			db := dbSetup()
+			repo := postgres.NewRunSnapshotRepository(db)
+
+			assert.NoError(t, repo.Save(ctx, newSnapshot(now, now.Add(time.Hour)), []byte("encrypted")))
+			stored, err := repo.GetByJob(ctx, projectName, jobName, now)
+			assert.NoError(t, err)
+			assert.Len(t, stored, 1)
+
+			snapshot, payload, err := repo.Get(ctx, projectName, stored[0].ID, now)
+			assert.NoError(t, err)
+			assert.Equal(t, jobName, snapshot.JobName)
+			assert.Equal(t, []byte("encrypted"), payload)
+		})
+		t.Run("returns not found error if snapshot belongs to another project or is expired", func(t *testing.T) {
+			db := dbSetup()
+			repo := postgres.NewRunSnapshotRepository(db)
+
+			assert.NoError(t, repo.Save(ctx, newSnapshot(now, now.Add(time.Hour)), []byte("encrypted")))
+			stored, err := repo.GetByJob(ctx, projectName, jobName, now)
+			assert.NoError(t, err)
+			assert.Len(t, stored, 1)
+
+			_, _, err = repo.Get(ctx, "proj-b", stored[0].ID, now)
+			assert.True(t, errors.IsErrorType(err, errors.ErrNotFound))
+			_, _, err = repo.Get(ctx, projectName, stored[0].ID, now.Add(2*time.Hour))
+			assert.True(t, errors.IsErrorType(err, errors.ErrNotFound))
+			_, _, err = repo.Get(ctx, projectName, uuid.New(), now)
+			assert.True(t, errors.IsErrorType(err, errors.ErrNotFound))
+		})
+	})
+	t.Run("DeleteExpired", func(t *testing.T) {
+		t.Run("deletes the expired snapshots", func(t *testing.T) {
+			db := dbSetup()
+			repo := postgres.NewRunSnapshotRepository(db)
+
+			assert.NoError(t, repo.Save(ctx, newSnapshot(now, now.Add(time.Hour)), []byte("active")))
+			assert.NoError(t, repo.Save(ctx, newSnapshot(now, now.Add(time.Minute)), []byte("expired")))
+
+			deleted, err := repo.DeleteExpired(ctx, now.Add(10*time.Minute))
+			assert.NoError(t, err)
+			assert.EqualValues(t, 1, deleted)
+
+			snapshots, err := repo.GetByJob(ctx, projectName, jobName, now)
+			assert.NoError(t, err)
+			assert.Len(t, snapshots, 1)
+		})
+	})
+}
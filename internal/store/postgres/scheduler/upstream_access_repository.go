@@ -0,0 +1,149 @@
+package scheduler
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"golang.org/x/net/context"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+)
+
+const (
+	upstreamAccessColumnsToStore = `downstream_project_name, downstream_job_name, upstream_project_name, upstream_job_name, status, decided_by, reason`
+	upstreamAccessColumns        = `id, ` + upstreamAccessColumnsToStore + `, created_at, decided_at`
+)
+
+type UpstreamAccessRepository struct {
+	db *pgxpool.Pool
+}
+
+type upstreamAccessRequest struct {
+	ID uuid.UUID
+
+	DownstreamProjectName string
+	DownstreamJobName     string
+	UpstreamProjectName   string
+	UpstreamJobName       string
+
+	Status    string
+	DecidedBy string
+	Reason    string
+
+	CreatedAt time.Time
+	DecidedAt *time.Time
+}
+
+func (u *upstreamAccessRequest) toUpstreamAccessRequest() (*scheduler.UpstreamAccessRequest, error) {
+	state, err := scheduler.UpstreamAccessStateFromString(u.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	request := &scheduler.UpstreamAccessRequest{
+		ID:                u.ID,
+		DownstreamProject: tenant.ProjectName(u.DownstreamProjectName),
+		DownstreamJob:     scheduler.JobName(u.DownstreamJobName),
+		UpstreamProject:   tenant.ProjectName(u.UpstreamProjectName),
+		UpstreamJob:       scheduler.JobName(u.UpstreamJobName),
+		State:             state,
+		DecidedBy:         u.DecidedBy,
+		Reason:            u.Reason,
+		CreatedAt:         u.CreatedAt,
+	}
+	if u.DecidedAt != nil {
+		request.DecidedAt = *u.DecidedAt
+	}
+	return request, nil
+}
+
+func (r UpstreamAccessRepository) Create(ctx context.Context, request *scheduler.UpstreamAccessRequest) error {
+	insertRequest := `INSERT INTO upstream_access_request (` + upstreamAccessColumnsToStore + `, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, NOW(), NOW()) ON CONFLICT DO NOTHING`
+	_, err := r.db.Exec(ctx, insertRequest, request.DownstreamProject, request.DownstreamJob, request.UpstreamProject, request.UpstreamJob,
+		request.State, request.DecidedBy, request.Reason)
+	if err != nil {
+		return errors.Wrap(scheduler.EntityUpstreamAccess, "unable to store upstream access request", err)
+	}
+	return nil
+}
+
+func (r UpstreamAccessRepository) Get(ctx context.Context, downstreamProject tenant.ProjectName, downstreamJob scheduler.JobName,
+	upstreamProject tenant.ProjectName, upstreamJob scheduler.JobName,
+) (*scheduler.UpstreamAccessRequest, error) {
+	getRequest := `SELECT ` + upstreamAccessColumns + ` FROM upstream_access_request
+		WHERE downstream_project_name = $1 AND downstream_job_name = $2 AND upstream_project_name = $3 AND upstream_job_name = $4`
+	row := r.db.QueryRow(ctx, getRequest, downstreamProject, downstreamJob, upstreamProject, upstreamJob)
+	request, err := scanUpstreamAccessRequest(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.NotFound(scheduler.EntityUpstreamAccess, fmt.Sprintf("no upstream access request of %s/%s on %s/%s",
+				downstreamProject, downstreamJob, upstreamProject, upstreamJob))
+		}
+		return nil, errors.Wrap(scheduler.EntityUpstreamAccess, "unable to get upstream access request", err)
+	}
+	return request.toUpstreamAccessRequest()
+}
+
+func (r UpstreamAccessRepository) GetByID(ctx context.Context, id uuid.UUID) (*scheduler.UpstreamAccessRequest, error) {
+	getRequest := `SELECT ` + upstreamAccessColumns + ` FROM upstream_access_request WHERE id = $1`
+	request, err := scanUpstreamAccessRequest(r.db.QueryRow(ctx, getRequest, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.NotFound(scheduler.EntityUpstreamAccess, "no upstream access request found for id "+id.String())
+		}
+		return nil, errors.Wrap(scheduler.EntityUpstreamAccess, "unable to get upstream access request", err)
+	}
+	return request.toUpstreamAccessRequest()
+}
+
+func (r UpstreamAccessRepository) GetByUpstreamProject(ctx context.Context, upstreamProject tenant.ProjectName) ([]*scheduler.UpstreamAccessRequest, error) {
+	getRequests := `SELECT ` + upstreamAccessColumns + ` FROM upstream_access_request WHERE upstream_project_name = $1 ORDER BY created_at DESC`
+	rows, err := r.db.Query(ctx, getRequests, upstreamProject)
+	if err != nil {
+		return nil, errors.Wrap(scheduler.EntityUpstreamAccess, "unable to get upstream access requests", err)
+	}
+	defer rows.Close()
+
+	var requests []*scheduler.UpstreamAccessRequest
+	for rows.Next() {
+		stored, err := scanUpstreamAccessRequest(rows)
+		if err != nil {
+			return nil, errors.Wrap(scheduler.EntityUpstreamAccess, "unable to get the stored upstream access request", err)
+		}
+		request, err := stored.toUpstreamAccessRequest()
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, request)
+	}
+	return requests, nil
+}
+
+func (r UpstreamAccessRepository) UpdateDecision(ctx context.Context, id uuid.UUID, state scheduler.UpstreamAccessState, decidedBy, reason string) error {
+	updateRequest := `UPDATE upstream_access_request SET status = $1, decided_by = $2, reason = $3, decided_at = NOW(), updated_at = NOW() WHERE id = $4`
+	tag, err := r.db.Exec(ctx, updateRequest, state, decidedBy, reason, id)
+	if err != nil {
+		return errors.Wrap(scheduler.EntityUpstreamAccess, "unable to update upstream access request", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.NotFound(scheduler.EntityUpstreamAccess, "no upstream access request found for id "+id.String())
+	}
+	return nil
+}
+
+func scanUpstreamAccessRequest(row pgx.Row) (*upstreamAccessRequest, error) {
+	var request upstreamAccessRequest
+	err := row.Scan(&request.ID, &request.DownstreamProjectName, &request.DownstreamJobName, &request.UpstreamProjectName, &request.UpstreamJobName,
+		&request.Status, &request.DecidedBy, &request.Reason, &request.CreatedAt, &request.DecidedAt)
+	return &request, err
+}
+
+func NewUpstreamAccessRepository(db *pgxpool.Pool) *UpstreamAccessRepository {
+	return &UpstreamAccessRepository{db: db}
+}
@@ -0,0 +1,89 @@
+//go:build !unit_test
+
+package scheduler_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+	postgres "github.com/goto/optimus/internal/store/postgres/scheduler"
+)
+
+func TestPostgresUpstreamAccessRepository(t *testing.T) {
+	ctx := context.Background()
+	downstreamProject := tenant.ProjectName("proj-a")
+	downstreamJob := scheduler.JobName("job-a")
+	upstreamProject := tenant.ProjectName("proj-b")
+	upstreamJob := scheduler.JobName("job-b")
+
+	t.Run("Create", func(t *testing.T) {
+		t.Run("stores request once for the same dependency", func(t *testing.T) {
+			db := dbSetup()
+			repo := postgres.NewUpstreamAccessRepository(db)
+
+			request := scheduler.NewUpstreamAccessRequest(downstreamProject, downstreamJob, upstreamProject, upstreamJob)
+			assert.NoError(t, repo.Create(ctx, request))
+			assert.NoError(t, repo.Create(ctx, request))
+
+			requests, err := repo.GetByUpstreamProject(ctx, upstreamProject)
+			assert.NoError(t, err)
+			assert.Len(t, requests, 1)
+			assert.Equal(t, scheduler.UpstreamAccessStatePending, requests[0].State)
+			assert.True(t, requests[0].DecidedAt.IsZero())
+		})
+	})
+	t.Run("Get", func(t *testing.T) {
+		t.Run("returns not found error if request does not exist", func(t *testing.T) {
+			db := dbSetup()
+			repo := postgres.NewUpstreamAccessRepository(db)
+
+			request, err := repo.Get(ctx, downstreamProject, downstreamJob, upstreamProject, upstreamJob)
+			assert.True(t, errors.IsErrorType(err, errors.ErrNotFound))
+			assert.Nil(t, request)
+		})
+		t.Run("returns stored request", func(t *testing.T) {
+			db := dbSetup()
+			repo := postgres.NewUpstreamAccessRepository(db)
+
+			assert.NoError(t, repo.Create(ctx, scheduler.NewUpstreamAccessRequest(downstreamProject, downstreamJob, upstreamProject, upstreamJob)))
+
+			request, err := repo.Get(ctx, downstreamProject, downstreamJob, upstreamProject, upstreamJob)
+			assert.NoError(t, err)
+			assert.Equal(t, downstreamJob, request.DownstreamJob)
+			assert.Equal(t, upstreamJob, request.UpstreamJob)
+		})
+	})
+	t.Run("UpdateDecision", func(t *testing.T) {
+		t.Run("returns not found error if request does not exist", func(t *testing.T) {
+			db := dbSetup()
+			repo := postgres.NewUpstreamAccessRepository(db)
+
+			err := repo.UpdateDecision(ctx, uuid.New(), scheduler.UpstreamAccessStateApproved, "owner@example.com", "")
+			assert.True(t, errors.IsErrorType(err, errors.ErrNotFound))
+		})
+		t.Run("records the decision", func(t *testing.T) {
+			db := dbSetup()
+			repo := postgres.NewUpstreamAccessRepository(db)
+
+			assert.NoError(t, repo.Create(ctx, scheduler.NewUpstreamAccessRequest(downstreamProject, downstreamJob, upstreamProject, upstreamJob)))
+			stored, err := repo.Get(ctx, downstreamProject, downstreamJob, upstreamProject, upstreamJob)
+			assert.NoError(t, err)
+
+			err = repo.UpdateDecision(ctx, stored.ID, scheduler.UpstreamAccessStateDenied, "owner@example.com", "contains pii")
+			assert.NoError(t, err)
+
+			request, err := repo.GetByID(ctx, stored.ID)
+			assert.NoError(t, err)
+			assert.Equal(t, scheduler.UpstreamAccessStateDenied, request.State)
+			assert.Equal(t, "owner@example.com", request.DecidedBy)
+			assert.Equal(t, "contains pii", request.Reason)
+			assert.False(t, request.DecidedAt.IsZero())
+		})
+	})
+}
@@ -0,0 +1,184 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+)
+
+const (
+	webhookSubscriptionColumnsToStore = `project_name, namespace_name, url, secret_name, event_types`
+	webhookSubscriptionColumns        = `id, ` + webhookSubscriptionColumnsToStore + `, created_at`
+
+	webhookDeliveryColumnsToStore = `subscription_id, job_name, event_type, scheduled_at, attempts, status_code, error, delivered`
+	webhookDeliveryColumns        = `id, subscription_id, job_name, event_type, scheduled_at, attempts, status_code, COALESCE(error, ''), delivered, created_at`
+)
+
+type WebhookSubscriptionRepository struct {
+	db *pgxpool.Pool
+}
+
+type webhookSubscription struct {
+	ID uuid.UUID
+
+	ProjectName   string
+	NamespaceName string
+
+	URL        string
+	SecretName string
+	EventTypes []string
+
+	CreatedAt time.Time
+}
+
+func (s *webhookSubscription) toWebhookSubscription() (*scheduler.WebhookSubscription, error) {
+	tnnt, err := tenant.NewTenant(s.ProjectName, s.NamespaceName)
+	if err != nil {
+		return nil, err
+	}
+	eventTypes := make([]scheduler.JobEventType, len(s.EventTypes))
+	for i, eventType := range s.EventTypes {
+		eventTypes[i] = scheduler.JobEventType(eventType)
+	}
+	return &scheduler.WebhookSubscription{
+		ID:         s.ID,
+		Tenant:     tnnt,
+		URL:        s.URL,
+		SecretName: s.SecretName,
+		EventTypes: eventTypes,
+		CreatedAt:  s.CreatedAt,
+	}, nil
+}
+
+func (r WebhookSubscriptionRepository) Create(ctx context.Context, subscription *scheduler.WebhookSubscription) (uuid.UUID, error) {
+	insertSubscription := `INSERT INTO webhook_subscription (` + webhookSubscriptionColumnsToStore + `, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, NOW(), NOW()) RETURNING id`
+	var id uuid.UUID
+	err := r.db.QueryRow(ctx, insertSubscription, subscription.Tenant.ProjectName(), subscription.Tenant.NamespaceName(),
+		subscription.URL, subscription.SecretName, eventTypesToStore(subscription.EventTypes)).Scan(&id)
+	if err != nil {
+		return uuid.Nil, errors.Wrap(scheduler.EntityWebhookSubscription, "unable to store webhook subscription", err)
+	}
+	return id, nil
+}
+
+func (r WebhookSubscriptionRepository) Update(ctx context.Context, subscription *scheduler.WebhookSubscription) error {
+	updateSubscription := `UPDATE webhook_subscription SET url = $1, secret_name = $2, event_types = $3, updated_at = NOW()
+		WHERE project_name = $4 AND namespace_name = $5 AND id = $6`
+	tag, err := r.db.Exec(ctx, updateSubscription, subscription.URL, subscription.SecretName, eventTypesToStore(subscription.EventTypes),
+		subscription.Tenant.ProjectName(), subscription.Tenant.NamespaceName(), subscription.ID)
+	if err != nil {
+		return errors.Wrap(scheduler.EntityWebhookSubscription, "unable to update webhook subscription", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.NotFound(scheduler.EntityWebhookSubscription, "no webhook subscription found for id "+subscription.ID.String())
+	}
+	return nil
+}
+
+func (r WebhookSubscriptionRepository) GetAll(ctx context.Context, tnnt tenant.Tenant) ([]*scheduler.WebhookSubscription, error) {
+	getSubscriptions := `SELECT ` + webhookSubscriptionColumns + ` FROM webhook_subscription
+		WHERE project_name = $1 AND namespace_name = $2 ORDER BY created_at`
+	rows, err := r.db.Query(ctx, getSubscriptions, tnnt.ProjectName(), tnnt.NamespaceName())
+	if err != nil {
+		return nil, errors.Wrap(scheduler.EntityWebhookSubscription, "unable to get webhook subscriptions", err)
+	}
+	defer rows.Close()
+
+	var subscriptions []*scheduler.WebhookSubscription
+	for rows.Next() {
+		var stored webhookSubscription
+		if err := rows.Scan(&stored.ID, &stored.ProjectName, &stored.NamespaceName, &stored.URL, &stored.SecretName,
+			&stored.EventTypes, &stored.CreatedAt); err != nil {
+			return nil, errors.Wrap(scheduler.EntityWebhookSubscription, "unable to get the stored webhook subscription", err)
+		}
+		subscription, err := stored.toWebhookSubscription()
+		if err != nil {
+			return nil, err
+		}
+		subscriptions = append(subscriptions, subscription)
+	}
+	return subscriptions, nil
+}
+
+func (r WebhookSubscriptionRepository) Delete(ctx context.Context, tnnt tenant.Tenant, id uuid.UUID) error {
+	deleteSubscription := `DELETE FROM webhook_subscription WHERE project_name = $1 AND namespace_name = $2 AND id = $3`
+	tag, err := r.db.Exec(ctx, deleteSubscription, tnnt.ProjectName(), tnnt.NamespaceName(), id)
+	if err != nil {
+		return errors.Wrap(scheduler.EntityWebhookSubscription, "unable to delete webhook subscription", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.NotFound(scheduler.EntityWebhookSubscription, "no webhook subscription found for id "+id.String())
+	}
+	return nil
+}
+
+func (r WebhookSubscriptionRepository) SaveDelivery(ctx context.Context, delivery *scheduler.WebhookDelivery) error {
+	insertDelivery := `INSERT INTO webhook_delivery (` + webhookDeliveryColumnsToStore + `, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW())`
+	var scheduledAt *time.Time
+	if !delivery.ScheduledAt.IsZero() {
+		scheduledAt = &delivery.ScheduledAt
+	}
+	_, err := r.db.Exec(ctx, insertDelivery, delivery.SubscriptionID, delivery.JobName, delivery.EventType, scheduledAt,
+		delivery.Attempts, delivery.StatusCode, delivery.Error, delivery.Delivered)
+	if err != nil {
+		return errors.Wrap(scheduler.EntityWebhookSubscription, "unable to store webhook delivery", err)
+	}
+	return nil
+}
+
+// GetDeliveries returns the latest deliveries to the subscription of the tenant, the newest first
+func (r WebhookSubscriptionRepository) GetDeliveries(ctx context.Context, tnnt tenant.Tenant, subscriptionID uuid.UUID, limit int) ([]*scheduler.WebhookDelivery, error) {
+	getDeliveries := `SELECT ` + webhookDeliveryColumns + ` FROM webhook_delivery
+		WHERE subscription_id = (SELECT id FROM webhook_subscription WHERE project_name = $1 AND namespace_name = $2 AND id = $3)
+		ORDER BY created_at DESC LIMIT $4`
+	rows, err := r.db.Query(ctx, getDeliveries, tnnt.ProjectName(), tnnt.NamespaceName(), subscriptionID, limit)
+	if err != nil {
+		return nil, errors.Wrap(scheduler.EntityWebhookSubscription, "unable to get webhook deliveries", err)
+	}
+	defer rows.Close()
+
+	var deliveries []*scheduler.WebhookDelivery
+	for rows.Next() {
+		delivery, err := scanWebhookDelivery(rows)
+		if err != nil {
+			return nil, errors.Wrap(scheduler.EntityWebhookSubscription, "unable to get the stored webhook delivery", err)
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	return deliveries, nil
+}
+
+func scanWebhookDelivery(row pgx.Row) (*scheduler.WebhookDelivery, error) {
+	var delivery scheduler.WebhookDelivery
+	var jobName, eventType string
+	var scheduledAt *time.Time
+	err := row.Scan(&delivery.ID, &delivery.SubscriptionID, &jobName, &eventType, &scheduledAt, &delivery.Attempts,
+		&delivery.StatusCode, &delivery.Error, &delivery.Delivered, &delivery.CreatedAt)
+	delivery.JobName = scheduler.JobName(jobName)
+	delivery.EventType = scheduler.JobEventType(eventType)
+	if scheduledAt != nil {
+		delivery.ScheduledAt = *scheduledAt
+	}
+	return &delivery, err
+}
+
+func eventTypesToStore(eventTypes []scheduler.JobEventType) []string {
+	stored := make([]string, len(eventTypes))
+	for i, eventType := range eventTypes {
+		stored[i] = eventType.String()
+	}
+	return stored
+}
+
+func NewWebhookSubscriptionRepository(db *pgxpool.Pool) *WebhookSubscriptionRepository {
+	return &WebhookSubscriptionRepository{db: db}
+}
@@ -0,0 +1,98 @@
+//go:build !unit_test
+
+package scheduler_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+	postgres "github.com/goto/optimus/internal/store/postgres/scheduler"
+)
+
+func TestPostgresWebhookSubscriptionRepository(t *testing.T) {
+	ctx := context.Background()
+	tnnt, _ := tenant.NewTenant("test-proj", "test-ns")
+	otherTnnt, _ := tenant.NewTenant("test-proj", "other-ns")
+
+	t.Run("stores, updates and deletes the subscriptions of the namespace", func(t *testing.T) {
+		db := dbSetup()
+		repo := postgres.NewWebhookSubscriptionRepository(db)
+
+		subscription, err := scheduler.NewWebhookSubscription(tnnt, "https://example.com/hook", "WEBHOOK_KEY",
+			[]scheduler.JobEventType{scheduler.JobFailureEvent})
+		assert.NoError(t, err)
+		id, err := repo.Create(ctx, subscription)
+		assert.NoError(t, err)
+
+		subscription.ID = id
+		subscription.EventTypes = []scheduler.JobEventType{scheduler.JobSuccessEvent, scheduler.SLAMissEvent}
+		assert.NoError(t, repo.Update(ctx, subscription))
+
+		subscriptions, err := repo.GetAll(ctx, tnnt)
+		assert.NoError(t, err)
+		assert.Len(t, subscriptions, 1)
+		assert.Equal(t, "https://example.com/hook", subscriptions[0].URL)
+		assert.Equal(t, []scheduler.JobEventType{scheduler.JobSuccessEvent, scheduler.SLAMissEvent}, subscriptions[0].EventTypes)
+
+		others, err := repo.GetAll(ctx, otherTnnt)
+		assert.NoError(t, err)
+		assert.Empty(t, others)
+
+		err = repo.Delete(ctx, otherTnnt, id)
+		assert.True(t, errors.IsErrorType(err, errors.ErrNotFound))
+		assert.NoError(t, repo.Delete(ctx, tnnt, id))
+		subscriptions, err = repo.GetAll(ctx, tnnt)
+		assert.NoError(t, err)
+		assert.Empty(t, subscriptions)
+	})
+	t.Run("returns not found error when updating a subscription which does not exist", func(t *testing.T) {
+		db := dbSetup()
+		repo := postgres.NewWebhookSubscriptionRepository(db)
+
+		subscription, err := scheduler.NewWebhookSubscription(tnnt, "https://example.com/hook", "WEBHOOK_KEY",
+			[]scheduler.JobEventType{scheduler.JobFailureEvent})
+		assert.NoError(t, err)
+		subscription.ID = uuid.New()
+
+		err = repo.Update(ctx, subscription)
+		assert.True(t, errors.IsErrorType(err, errors.ErrNotFound))
+	})
+	t.Run("returns the latest deliveries of the subscription", func(t *testing.T) {
+		db := dbSetup()
+		repo := postgres.NewWebhookSubscriptionRepository(db)
+
+		subscription, err := scheduler.NewWebhookSubscription(tnnt, "https://example.com/hook", "WEBHOOK_KEY",
+			[]scheduler.JobEventType{scheduler.JobFailureEvent})
+		assert.NoError(t, err)
+		id, err := repo.Create(ctx, subscription)
+		assert.NoError(t, err)
+
+		scheduledAt := time.Date(2023, 10, 10, 10, 0, 0, 0, time.UTC)
+		assert.NoError(t, repo.SaveDelivery(ctx, &scheduler.WebhookDelivery{
+			SubscriptionID: id, JobName: "job-a", EventType: scheduler.JobFailureEvent, ScheduledAt: scheduledAt,
+			Attempts: 3, StatusCode: 502, Error: "webhook responded with status 502",
+		}))
+		assert.NoError(t, repo.SaveDelivery(ctx, &scheduler.WebhookDelivery{
+			SubscriptionID: id, JobName: "job-a", EventType: scheduler.JobFailureEvent, ScheduledAt: scheduledAt.Add(time.Hour),
+			Attempts: 1, StatusCode: 200, Delivered: true,
+		}))
+
+		deliveries, err := repo.GetDeliveries(ctx, tnnt, id, 10)
+		assert.NoError(t, err)
+		assert.Len(t, deliveries, 2)
+		assert.True(t, deliveries[0].Delivered)
+		assert.True(t, deliveries[0].ScheduledAt.Equal(scheduledAt.Add(time.Hour)))
+		assert.Equal(t, "webhook responded with status 502", deliveries[1].Error)
+
+		deliveries, err = repo.GetDeliveries(ctx, otherTnnt, id, 10)
+		assert.NoError(t, err)
+		assert.Empty(t, deliveries)
+	})
+}
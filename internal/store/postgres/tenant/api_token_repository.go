@@ -0,0 +1,109 @@
+package tenant
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+)
+
+type APITokenRepository struct {
+	db *pgxpool.Pool
+}
+
+const (
+	apiTokenColumns = `project_name, name, scope, token_hash, created_at, updated_at`
+
+	insertAPIToken = `INSERT INTO api_token (` + apiTokenColumns + `) VALUES ($1, $2, $3, $4, NOW(), NOW())
+ON CONFLICT (project_name, name) DO NOTHING`
+
+	updateAPITokenHash = `UPDATE api_token SET token_hash = $3, updated_at = NOW() WHERE project_name = $1 AND name = $2`
+
+	deleteAPIToken = `DELETE FROM api_token WHERE project_name = $1 AND name = $2`
+
+	getAPITokenByHash = `SELECT ` + apiTokenColumns + ` FROM api_token WHERE token_hash = $1`
+
+	getAPITokensByProjectName = `SELECT ` + apiTokenColumns + ` FROM api_token WHERE project_name = $1 ORDER BY name`
+)
+
+func NewAPITokenRepository(db *pgxpool.Pool) *APITokenRepository {
+	return &APITokenRepository{
+		db: db,
+	}
+}
+
+func (r APITokenRepository) Create(ctx context.Context, token *tenant.APIToken) error {
+	result, err := r.db.Exec(ctx, insertAPIToken, token.ProjectName(), token.Name(), token.Scope(), token.Hash())
+	if err != nil {
+		return errors.Wrap(tenant.EntityAPIToken, "error inserting api token "+token.Name(), err)
+	}
+	if result.RowsAffected() == 0 {
+		return errors.AlreadyExists(tenant.EntityAPIToken, "api token "+token.Name()+" already exists")
+	}
+	return nil
+}
+
+// UpdateHash replaces the hash of the token on rotation
+func (r APITokenRepository) UpdateHash(ctx context.Context, projectName tenant.ProjectName, name, hash string) error {
+	result, err := r.db.Exec(ctx, updateAPITokenHash, projectName, name, hash)
+	if err != nil {
+		return errors.Wrap(tenant.EntityAPIToken, "error updating api token "+name, err)
+	}
+	if result.RowsAffected() == 0 {
+		return errors.NotFound(tenant.EntityAPIToken, "api token "+name+" is not found")
+	}
+	return nil
+}
+
+func (r APITokenRepository) Delete(ctx context.Context, projectName tenant.ProjectName, name string) error {
+	result, err := r.db.Exec(ctx, deleteAPIToken, projectName, name)
+	if err != nil {
+		return errors.Wrap(tenant.EntityAPIToken, "error deleting api token "+name, err)
+	}
+	if result.RowsAffected() == 0 {
+		return errors.NotFound(tenant.EntityAPIToken, "api token "+name+" is not found")
+	}
+	return nil
+}
+
+func (r APITokenRepository) GetByHash(ctx context.Context, hash string) (*tenant.APIToken, error) {
+	token, err := scanAPIToken(r.db.QueryRow(ctx, getAPITokenByHash, hash))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.NotFound(tenant.EntityAPIToken, "api token is not found")
+		}
+		return nil, errors.Wrap(tenant.EntityAPIToken, "error getting api token", err)
+	}
+	return token, nil
+}
+
+func (r APITokenRepository) GetAll(ctx context.Context, projectName tenant.ProjectName) ([]*tenant.APIToken, error) {
+	rows, err := r.db.Query(ctx, getAPITokensByProjectName, projectName)
+	if err != nil {
+		return nil, errors.Wrap(tenant.EntityAPIToken, "error reading api tokens of project "+projectName.String(), err)
+	}
+	defer rows.Close()
+
+	var tokens []*tenant.APIToken
+	for rows.Next() {
+		token, err := scanAPIToken(rows)
+		if err != nil {
+			return nil, errors.Wrap(tenant.EntityAPIToken, "error scanning rows", err)
+		}
+		tokens = append(tokens, token)
+	}
+	return tokens, nil
+}
+
+func scanAPIToken(row pgx.Row) (*tenant.APIToken, error) {
+	var projectName, name, scope, hash string
+	var createdAt, updatedAt time.Time
+	if err := row.Scan(&projectName, &name, &scope, &hash, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+	return tenant.NewAPIToken(tenant.ProjectName(projectName), name, tenant.TokenScope(scope), hash, createdAt, updatedAt)
+}
@@ -0,0 +1,138 @@
+//go:build !unit_test
+
+package tenant_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+	postgres "github.com/goto/optimus/internal/store/postgres/tenant"
+	"github.com/goto/optimus/tests/setup"
+)
+
+func TestPostgresAPITokenRepository(t *testing.T) {
+	ctx := context.Background()
+
+	proj, _ := tenant.NewProject("t-optimus-1",
+		map[string]string{
+			"bucket":                     "gs://some_folder-2",
+			tenant.ProjectSchedulerHost:  "host",
+			tenant.ProjectStoragePathKey: "gs://location",
+		})
+
+	dbSetup := func() *pgxpool.Pool {
+		dbPool := setup.TestPool()
+		setup.TruncateTablesWith(dbPool)
+
+		prjRepo := postgres.NewProjectRepository(dbPool)
+		err := prjRepo.Save(ctx, proj)
+		if err != nil {
+			panic(err)
+		}
+
+		return dbPool
+	}
+
+	now := time.Now()
+	deployToken, _ := tenant.NewAPIToken(proj.Name(), "ci-deploy", tenant.TokenScopeDeploy, tenant.HashAPIToken("optimus_deploy"), now, now)
+	readToken, _ := tenant.NewAPIToken(proj.Name(), "ci-read", tenant.TokenScopeRead, tenant.HashAPIToken("optimus_read"), now, now)
+
+	t.Run("Create", func(t *testing.T) {
+		t.Run("stores the api token", func(t *testing.T) {
+			db := dbSetup()
+			repo := postgres.NewAPITokenRepository(db)
+
+			err := repo.Create(ctx, deployToken)
+			assert.NoError(t, err)
+
+			stored, err := repo.GetByHash(ctx, deployToken.Hash())
+			assert.NoError(t, err)
+			assert.Equal(t, proj.Name(), stored.ProjectName())
+			assert.Equal(t, "ci-deploy", stored.Name())
+			assert.Equal(t, tenant.TokenScopeDeploy, stored.Scope())
+		})
+		t.Run("returns error when api token of the name already exists", func(t *testing.T) {
+			db := dbSetup()
+			repo := postgres.NewAPITokenRepository(db)
+
+			err := repo.Create(ctx, deployToken)
+			assert.NoError(t, err)
+
+			duplicate, _ := tenant.NewAPIToken(proj.Name(), "ci-deploy", tenant.TokenScopeRead, tenant.HashAPIToken("optimus_other"), now, now)
+			err = repo.Create(ctx, duplicate)
+			assert.True(t, errors.IsErrorType(err, errors.ErrAlreadyExists))
+		})
+	})
+	t.Run("UpdateHash", func(t *testing.T) {
+		t.Run("replaces the hash of the api token", func(t *testing.T) {
+			db := dbSetup()
+			repo := postgres.NewAPITokenRepository(db)
+
+			err := repo.Create(ctx, deployToken)
+			assert.NoError(t, err)
+
+			rotatedHash := tenant.HashAPIToken("optimus_rotated")
+			err = repo.UpdateHash(ctx, proj.Name(), "ci-deploy", rotatedHash)
+			assert.NoError(t, err)
+
+			_, err = repo.GetByHash(ctx, deployToken.Hash())
+			assert.True(t, errors.IsErrorType(err, errors.ErrNotFound))
+
+			stored, err := repo.GetByHash(ctx, rotatedHash)
+			assert.NoError(t, err)
+			assert.Equal(t, "ci-deploy", stored.Name())
+		})
+		t.Run("returns not found when api token does not exist", func(t *testing.T) {
+			db := dbSetup()
+			repo := postgres.NewAPITokenRepository(db)
+
+			err := repo.UpdateHash(ctx, proj.Name(), "unknown", "hash")
+			assert.True(t, errors.IsErrorType(err, errors.ErrNotFound))
+		})
+	})
+	t.Run("Delete", func(t *testing.T) {
+		t.Run("deletes the api token", func(t *testing.T) {
+			db := dbSetup()
+			repo := postgres.NewAPITokenRepository(db)
+
+			err := repo.Create(ctx, deployToken)
+			assert.NoError(t, err)
+
+			err = repo.Delete(ctx, proj.Name(), "ci-deploy")
+			assert.NoError(t, err)
+
+			_, err = repo.GetByHash(ctx, deployToken.Hash())
+			assert.True(t, errors.IsErrorType(err, errors.ErrNotFound))
+		})
+		t.Run("returns not found when api token does not exist", func(t *testing.T) {
+			db := dbSetup()
+			repo := postgres.NewAPITokenRepository(db)
+
+			err := repo.Delete(ctx, proj.Name(), "unknown")
+			assert.True(t, errors.IsErrorType(err, errors.ErrNotFound))
+		})
+	})
+	t.Run("GetAll", func(t *testing.T) {
+		t.Run("returns the api tokens of the project ordered by name", func(t *testing.T) {
+			db := dbSetup()
+			repo := postgres.NewAPITokenRepository(db)
+
+			err := repo.Create(ctx, readToken)
+			assert.NoError(t, err)
+			err = repo.Create(ctx, deployToken)
+			assert.NoError(t, err)
+
+			tokens, err := repo.GetAll(ctx, proj.Name())
+			assert.NoError(t, err)
+			assert.Len(t, tokens, 2)
+			assert.Equal(t, "ci-deploy", tokens[0].Name())
+			assert.Equal(t, "ci-read", tokens[1].Name())
+		})
+	})
+}
@@ -17,7 +17,7 @@ type NamespaceRepository struct {
 }
 
 const (
-	namespaceColumns = `id, name, config, project_name, created_at, updated_at`
+	namespaceColumns = `id, name, config, project_name, created_at, updated_at, archived_at`
 )
 
 type Namespace struct {
@@ -27,8 +27,9 @@ type Namespace struct {
 
 	ProjectName string
 
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	ArchivedAt *time.Time
 }
 
 func (n *Namespace) toTenantNamespace() (*tenant.Namespace, error) {
@@ -37,7 +38,12 @@ func (n *Namespace) toTenantNamespace() (*tenant.Namespace, error) {
 		return nil, err
 	}
 
-	return tenant.NewNamespace(n.Name, projName, n.Config)
+	namespace, err := tenant.NewNamespace(n.Name, projName, n.Config)
+	if err != nil {
+		return nil, err
+	}
+	namespace.SetArchived(n.ArchivedAt != nil)
+	return namespace, nil
 }
 
 func (n *NamespaceRepository) Save(ctx context.Context, namespace *tenant.Namespace) error {
@@ -77,7 +83,7 @@ func (n *NamespaceRepository) get(ctx context.Context, projName tenant.ProjectNa
 
 	getNamespaceByNameQuery := `SELECT ` + namespaceColumns + ` FROM namespace WHERE project_name = $1 AND name = $2 AND deleted_at IS NULL`
 	err := n.db.QueryRow(ctx, getNamespaceByNameQuery, projName, name).
-		Scan(&namespace.ID, &namespace.Name, &namespace.Config, &namespace.ProjectName, &namespace.CreatedAt, &namespace.UpdatedAt)
+		Scan(&namespace.ID, &namespace.Name, &namespace.Config, &namespace.ProjectName, &namespace.CreatedAt, &namespace.UpdatedAt, &namespace.ArchivedAt)
 	if err != nil {
 		return Namespace{}, err
 	}
@@ -97,7 +103,7 @@ WHERE project_name = $1 AND deleted_at IS NULL`
 
 	for rows.Next() {
 		var ns Namespace
-		err = rows.Scan(&ns.ID, &ns.Name, &ns.Config, &ns.ProjectName, &ns.CreatedAt, &ns.UpdatedAt)
+		err = rows.Scan(&ns.ID, &ns.Name, &ns.Config, &ns.ProjectName, &ns.CreatedAt, &ns.UpdatedAt, &ns.ArchivedAt)
 		if err != nil {
 			return nil, errors.Wrap(tenant.EntityNamespace, "error in GetAll", err)
 		}
@@ -112,6 +118,20 @@ WHERE project_name = $1 AND deleted_at IS NULL`
 	return namespaces, nil
 }
 
+// SetArchived archives the namespace, or unarchives it when archived is false
+func (n *NamespaceRepository) SetArchived(ctx context.Context, projectName tenant.ProjectName, name tenant.NamespaceName, archived bool) error {
+	archiveNamespaceQuery := `UPDATE namespace SET archived_at = CASE WHEN $1 THEN now() END, updated_at = now()
+WHERE project_name = $2 AND name = $3 AND deleted_at IS NULL`
+	tag, err := n.db.Exec(ctx, archiveNamespaceQuery, archived, projectName, name)
+	if err != nil {
+		return errors.Wrap(tenant.EntityNamespace, "unable to archive namespace", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.NotFound(tenant.EntityNamespace, "no record for "+name.String())
+	}
+	return nil
+}
+
 func NewNamespaceRepository(pool *pgxpool.Pool) *NamespaceRepository {
 	return &NamespaceRepository{
 		db: pool,
@@ -150,4 +150,34 @@ func TestPostgresNamespaceRepository(t *testing.T) {
 			assert.Equal(t, ns.Name(), n.Name())
 		})
 	})
+	t.Run("SetArchived", func(t *testing.T) {
+		t.Run("return error when record is not found", func(t *testing.T) {
+			db := dbSetup()
+			repo := postgres.NewNamespaceRepository(db)
+
+			err := repo.SetArchived(ctx, proj.Name(), ns.Name(), true)
+			assert.ErrorContains(t, err, "no record for n-optimus-1")
+		})
+		t.Run("archives and unarchives the namespace", func(t *testing.T) {
+			db := dbSetup()
+			repo := postgres.NewNamespaceRepository(db)
+
+			err := repo.Save(ctx, ns)
+			assert.Nil(t, err)
+
+			err = repo.SetArchived(ctx, proj.Name(), ns.Name(), true)
+			assert.Nil(t, err)
+
+			n, err := repo.GetByName(ctx, proj.Name(), ns.Name())
+			assert.Nil(t, err)
+			assert.True(t, n.IsArchived())
+
+			err = repo.SetArchived(ctx, proj.Name(), ns.Name(), false)
+			assert.Nil(t, err)
+
+			n, err = repo.GetByName(ctx, proj.Name(), ns.Name())
+			assert.Nil(t, err)
+			assert.False(t, n.IsArchived())
+		})
+	})
 }
@@ -17,7 +17,7 @@ type PresetRepository struct {
 }
 
 const (
-	presetColumns           = `id, project_name, name, description, window_truncate_to, window_offset, window_size, created_at, updated_at`
+	presetColumns           = `id, project_name, name, description, window_truncate_to, window_offset, window_size, version, created_at, updated_at`
 	getPresetsByProjectName = `select ` + presetColumns + ` from preset where project_name = $1`
 )
 
@@ -38,6 +38,8 @@ type Preset struct {
 	Offset     string
 	Size       string
 
+	Version int
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
@@ -76,7 +78,7 @@ func (p PresetRepository) Read(ctx context.Context, projectName tenant.ProjectNa
 			return nil, err
 		}
 
-		output[i] = preset
+		output[i] = preset.WithVersion(existing.Version)
 	}
 
 	return output, nil
@@ -89,6 +91,7 @@ SET
 	window_truncate_to = $2,
 	window_offset = $3,
 	window_size = $4,
+	version = version + 1,
 	updated_at = NOW()
 WHERE
 	project_name = $5
@@ -128,6 +131,7 @@ func (PresetRepository) scanRows(rows pgx.Rows) ([]*Preset, error) {
 			&preset.TruncateTo,
 			&preset.Offset,
 			&preset.Size,
+			&preset.Version,
 			&preset.CreatedAt,
 			&preset.UpdatedAt,
 		)
@@ -17,7 +17,7 @@ type ProjectRepository struct {
 }
 
 const (
-	projectColumns = `id, name, config, created_at, updated_at`
+	projectColumns = `id, name, config, created_at, updated_at, archived_at`
 )
 
 type Project struct {
@@ -25,12 +25,18 @@ type Project struct {
 	Name   string
 	Config map[string]string
 
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	ArchivedAt *time.Time
 }
 
 func (p *Project) toTenantProject() (*tenant.Project, error) {
-	return tenant.NewProject(p.Name, p.Config)
+	project, err := tenant.NewProject(p.Name, p.Config)
+	if err != nil {
+		return nil, err
+	}
+	project.SetArchived(p.ArchivedAt != nil)
+	return project, nil
 }
 
 func (repo ProjectRepository) Save(ctx context.Context, tenantProject *tenant.Project) error {
@@ -65,7 +71,7 @@ func (repo ProjectRepository) get(ctx context.Context, name tenant.ProjectName)
 
 	getProjectByNameQuery := `SELECT ` + projectColumns + ` FROM project WHERE name = $1 AND deleted_at IS NULL`
 	err := repo.db.QueryRow(ctx, getProjectByNameQuery, name).
-		Scan(&project.ID, &project.Name, &project.Config, &project.CreatedAt, &project.UpdatedAt)
+		Scan(&project.ID, &project.Name, &project.Config, &project.CreatedAt, &project.UpdatedAt, &project.ArchivedAt)
 	if err != nil {
 		return Project{}, err
 	}
@@ -84,7 +90,7 @@ func (repo ProjectRepository) GetAll(ctx context.Context) ([]*tenant.Project, er
 
 	for rows.Next() {
 		var prj Project
-		err = rows.Scan(&prj.ID, &prj.Name, &prj.Config, &prj.CreatedAt, &prj.UpdatedAt)
+		err = rows.Scan(&prj.ID, &prj.Name, &prj.Config, &prj.CreatedAt, &prj.UpdatedAt, &prj.ArchivedAt)
 		if err != nil {
 			return nil, errors.Wrap(tenant.EntityProject, "error in GetAll", err)
 		}
@@ -99,6 +105,20 @@ func (repo ProjectRepository) GetAll(ctx context.Context) ([]*tenant.Project, er
 	return projects, nil
 }
 
+// SetArchived archives the project, or unarchives it when archived is false
+func (repo ProjectRepository) SetArchived(ctx context.Context, name tenant.ProjectName, archived bool) error {
+	archiveProjectQuery := `UPDATE project SET archived_at = CASE WHEN $1 THEN now() END, updated_at = now()
+WHERE name = $2 AND deleted_at IS NULL`
+	tag, err := repo.db.Exec(ctx, archiveProjectQuery, archived, name)
+	if err != nil {
+		return errors.Wrap(tenant.EntityProject, "unable to archive project", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.NotFound(tenant.EntityProject, "no project for "+name.String())
+	}
+	return nil
+}
+
 func NewProjectRepository(pool *pgxpool.Pool) *ProjectRepository {
 	return &ProjectRepository{
 		db: pool,
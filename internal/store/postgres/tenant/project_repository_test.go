@@ -123,4 +123,34 @@ func TestPostgresProjectRepository(t *testing.T) {
 			assert.Equal(t, proj.Name(), p.Name())
 		})
 	})
+	t.Run("SetArchived", func(t *testing.T) {
+		t.Run("return error when record is not found", func(t *testing.T) {
+			db := dbSetup()
+			repo := postgres.NewProjectRepository(db)
+
+			err := repo.SetArchived(ctx, proj.Name(), true)
+			assert.EqualError(t, err, "not found for entity project: no project for t-optimus-1")
+		})
+		t.Run("archives and unarchives the project", func(t *testing.T) {
+			db := dbSetup()
+			repo := postgres.NewProjectRepository(db)
+
+			err := repo.Save(ctx, proj)
+			assert.Nil(t, err)
+
+			err = repo.SetArchived(ctx, proj.Name(), true)
+			assert.Nil(t, err)
+
+			p, err := repo.GetByName(ctx, proj.Name())
+			assert.Nil(t, err)
+			assert.True(t, p.IsArchived())
+
+			err = repo.SetArchived(ctx, proj.Name(), false)
+			assert.Nil(t, err)
+
+			p, err = repo.GetByName(ctx, proj.Name())
+			assert.Nil(t, err)
+			assert.False(t, p.IsArchived())
+		})
+	})
 }
@@ -0,0 +1,93 @@
+package tenant
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+)
+
+type RoleBindingRepository struct {
+	db *pgxpool.Pool
+}
+
+const (
+	roleBindingColumns = `project_name, namespace_name, principal, role, granted_by, created_at`
+
+	upsertRoleBinding = `INSERT INTO role_binding (project_name, namespace_name, principal, role, granted_by, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, NOW(), NOW())
+ON CONFLICT (project_name, namespace_name, principal) DO UPDATE SET role = EXCLUDED.role, granted_by = EXCLUDED.granted_by, updated_at = NOW()`
+
+	deleteRoleBinding = `DELETE FROM role_binding WHERE project_name = $1 AND namespace_name = $2 AND principal = $3`
+
+	getRoleBindingsByProjectName = `SELECT ` + roleBindingColumns + ` FROM role_binding WHERE project_name = $1 ORDER BY principal, namespace_name`
+
+	getRoleBindingsByPrincipal = `SELECT ` + roleBindingColumns + ` FROM role_binding WHERE project_name = $1 AND principal = $2`
+)
+
+func NewRoleBindingRepository(db *pgxpool.Pool) *RoleBindingRepository {
+	return &RoleBindingRepository{
+		db: db,
+	}
+}
+
+// Upsert stores the role binding, replacing the role of the principal on the same project or namespace
+func (r RoleBindingRepository) Upsert(ctx context.Context, binding *tenant.RoleBinding) error {
+	_, err := r.db.Exec(ctx, upsertRoleBinding, binding.ProjectName(), binding.NamespaceName(), binding.Principal(),
+		binding.Role(), binding.GrantedBy())
+	if err != nil {
+		return errors.Wrap(tenant.EntityRoleBinding, "error upserting role binding of "+binding.Principal(), err)
+	}
+	return nil
+}
+
+func (r RoleBindingRepository) Delete(ctx context.Context, projectName tenant.ProjectName, namespaceName tenant.NamespaceName, principal string) error {
+	result, err := r.db.Exec(ctx, deleteRoleBinding, projectName, namespaceName, principal)
+	if err != nil {
+		return errors.Wrap(tenant.EntityRoleBinding, "error deleting role binding of "+principal, err)
+	}
+	if result.RowsAffected() == 0 {
+		return errors.NotFound(tenant.EntityRoleBinding, "role binding of "+principal+" is not found")
+	}
+	return nil
+}
+
+func (r RoleBindingRepository) GetAll(ctx context.Context, projectName tenant.ProjectName) ([]*tenant.RoleBinding, error) {
+	return r.query(ctx, "error reading role bindings of project "+projectName.String(), getRoleBindingsByProjectName, projectName)
+}
+
+func (r RoleBindingRepository) GetByPrincipal(ctx context.Context, projectName tenant.ProjectName, principal string) ([]*tenant.RoleBinding, error) {
+	return r.query(ctx, "error reading role bindings of "+principal, getRoleBindingsByPrincipal, projectName, principal)
+}
+
+func (r RoleBindingRepository) query(ctx context.Context, errMsg, query string, args ...interface{}) ([]*tenant.RoleBinding, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrap(tenant.EntityRoleBinding, errMsg, err)
+	}
+	defer rows.Close()
+
+	var bindings []*tenant.RoleBinding
+	for rows.Next() {
+		binding, err := scanRoleBinding(rows)
+		if err != nil {
+			return nil, errors.Wrap(tenant.EntityRoleBinding, "error scanning rows", err)
+		}
+		bindings = append(bindings, binding)
+	}
+	return bindings, nil
+}
+
+func scanRoleBinding(row pgx.Row) (*tenant.RoleBinding, error) {
+	var projectName, namespaceName, principal, role, grantedBy string
+	var createdAt time.Time
+	if err := row.Scan(&projectName, &namespaceName, &principal, &role, &grantedBy, &createdAt); err != nil {
+		return nil, err
+	}
+	return tenant.NewRoleBinding(tenant.ProjectName(projectName), tenant.NamespaceName(namespaceName), principal,
+		tenant.Role(role), grantedBy, createdAt)
+}
@@ -0,0 +1,121 @@
+//go:build !unit_test
+
+package tenant_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+	postgres "github.com/goto/optimus/internal/store/postgres/tenant"
+	"github.com/goto/optimus/tests/setup"
+)
+
+func TestPostgresRoleBindingRepository(t *testing.T) {
+	ctx := context.Background()
+
+	proj, _ := tenant.NewProject("t-optimus-1",
+		map[string]string{
+			"bucket":                     "gs://some_folder-2",
+			tenant.ProjectSchedulerHost:  "host",
+			tenant.ProjectStoragePathKey: "gs://location",
+		})
+
+	dbSetup := func() *pgxpool.Pool {
+		dbPool := setup.TestPool()
+		setup.TruncateTablesWith(dbPool)
+
+		prjRepo := postgres.NewProjectRepository(dbPool)
+		err := prjRepo.Save(ctx, proj)
+		if err != nil {
+			panic(err)
+		}
+
+		return dbPool
+	}
+
+	now := time.Now()
+	projectViewer, _ := tenant.NewRoleBinding(proj.Name(), "", "user@example.com", tenant.RoleViewer, "admin@example.com", now)
+	namespaceEditor, _ := tenant.NewRoleBinding(proj.Name(), "ns", "user@example.com", tenant.RoleEditor, "admin@example.com", now)
+	otherAdmin, _ := tenant.NewRoleBinding(proj.Name(), "", "other@example.com", tenant.RoleAdmin, "admin@example.com", now)
+
+	t.Run("Upsert", func(t *testing.T) {
+		t.Run("stores the role binding", func(t *testing.T) {
+			db := dbSetup()
+			repo := postgres.NewRoleBindingRepository(db)
+
+			err := repo.Upsert(ctx, namespaceEditor)
+			assert.NoError(t, err)
+
+			stored, err := repo.GetByPrincipal(ctx, proj.Name(), "user@example.com")
+			assert.NoError(t, err)
+			assert.Len(t, stored, 1)
+			assert.Equal(t, tenant.NamespaceName("ns"), stored[0].NamespaceName())
+			assert.Equal(t, tenant.RoleEditor, stored[0].Role())
+			assert.Equal(t, "admin@example.com", stored[0].GrantedBy())
+		})
+		t.Run("replaces the role of the principal on the same namespace", func(t *testing.T) {
+			db := dbSetup()
+			repo := postgres.NewRoleBindingRepository(db)
+
+			err := repo.Upsert(ctx, projectViewer)
+			assert.NoError(t, err)
+
+			projectAdmin, _ := tenant.NewRoleBinding(proj.Name(), "", "user@example.com", tenant.RoleAdmin, "root@example.com", now)
+			err = repo.Upsert(ctx, projectAdmin)
+			assert.NoError(t, err)
+
+			stored, err := repo.GetByPrincipal(ctx, proj.Name(), "user@example.com")
+			assert.NoError(t, err)
+			assert.Len(t, stored, 1)
+			assert.Equal(t, tenant.RoleAdmin, stored[0].Role())
+			assert.Equal(t, "root@example.com", stored[0].GrantedBy())
+		})
+	})
+	t.Run("Delete", func(t *testing.T) {
+		t.Run("deletes only the binding of the namespace", func(t *testing.T) {
+			db := dbSetup()
+			repo := postgres.NewRoleBindingRepository(db)
+
+			assert.NoError(t, repo.Upsert(ctx, projectViewer))
+			assert.NoError(t, repo.Upsert(ctx, namespaceEditor))
+
+			err := repo.Delete(ctx, proj.Name(), "ns", "user@example.com")
+			assert.NoError(t, err)
+
+			stored, err := repo.GetByPrincipal(ctx, proj.Name(), "user@example.com")
+			assert.NoError(t, err)
+			assert.Len(t, stored, 1)
+			assert.Equal(t, tenant.RoleViewer, stored[0].Role())
+		})
+		t.Run("returns not found when binding does not exist", func(t *testing.T) {
+			db := dbSetup()
+			repo := postgres.NewRoleBindingRepository(db)
+
+			err := repo.Delete(ctx, proj.Name(), "", "user@example.com")
+			assert.True(t, errors.IsErrorType(err, errors.ErrNotFound))
+		})
+	})
+	t.Run("GetAll", func(t *testing.T) {
+		t.Run("returns the bindings of the project", func(t *testing.T) {
+			db := dbSetup()
+			repo := postgres.NewRoleBindingRepository(db)
+
+			assert.NoError(t, repo.Upsert(ctx, projectViewer))
+			assert.NoError(t, repo.Upsert(ctx, namespaceEditor))
+			assert.NoError(t, repo.Upsert(ctx, otherAdmin))
+
+			bindings, err := repo.GetAll(ctx, proj.Name())
+			assert.NoError(t, err)
+			assert.Len(t, bindings, 3)
+			assert.Equal(t, "other@example.com", bindings[0].Principal())
+			assert.Equal(t, tenant.NamespaceName(""), bindings[1].NamespaceName())
+			assert.Equal(t, tenant.NamespaceName("ns"), bindings[2].NamespaceName())
+		})
+	})
+}
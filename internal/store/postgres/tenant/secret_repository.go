@@ -22,7 +22,7 @@ type SecretRepository struct {
 }
 
 const (
-	secretColumns = `id, name, value, project_name, namespace_name, created_at, updated_at`
+	secretColumns = `id, name, value, project_name, namespace_name, version, created_at, updated_at`
 
 	getAllSecretsInProject = `SELECT ` + secretColumns + `
 FROM secret s WHERE project_name = $1`
@@ -37,6 +37,8 @@ type Secret struct {
 	ProjectName   string
 	NamespaceName sql.NullString
 
+	Version int
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
@@ -74,7 +76,11 @@ func (s *Secret) ToTenantSecret() (*tenant.Secret, error) {
 		nsName = s.NamespaceName.String
 	}
 
-	return tenant.NewSecret(s.Name, string(encrypted), projName, nsName)
+	secret, err := tenant.NewSecret(s.Name, string(encrypted), projName, nsName)
+	if err != nil {
+		return nil, err
+	}
+	return secret.WithVersion(s.Version), nil
 }
 
 func (s *Secret) ToSecretInfo() (*dto.SecretInfo, error) {
@@ -153,8 +159,8 @@ AND project_name = $2
 AND (namespace_name IS NULL OR namespace_name = $3)`
 
 	err := s.db.QueryRow(ctx, getSecretByNameQuery, name, projName, nsName).
-		Scan(&secret.ID, &secret.Name, &secret.Value,
-			&secret.ProjectName, &secret.NamespaceName, &secret.CreatedAt, &secret.UpdatedAt)
+		Scan(&secret.ID, &secret.Name, &secret.Value, &secret.ProjectName, &secret.NamespaceName,
+			&secret.Version, &secret.CreatedAt, &secret.UpdatedAt)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, errors.NotFound(tenant.EntitySecret, "no record for "+name.String())
@@ -193,8 +199,8 @@ WHERE project_name = $1 AND (namespace_name IS NULL or namespace_name = $2)`
 	var tenantSecrets []*tenant.Secret
 	for rows.Next() {
 		var sec Secret
-		err := rows.Scan(&sec.ID, &sec.Name, &sec.Value,
-			&sec.ProjectName, &sec.NamespaceName, &sec.CreatedAt, &sec.UpdatedAt)
+		err := rows.Scan(&sec.ID, &sec.Name, &sec.Value, &sec.ProjectName, &sec.NamespaceName,
+			&sec.Version, &sec.CreatedAt, &sec.UpdatedAt)
 		if err != nil {
 			return nil, errors.Wrap(tenant.EntitySecret, "error in GetAll", err)
 		}
@@ -230,6 +236,11 @@ WHERE project_name = $1 AND name = $2 AND namespace_name IS NULL`
 	if result.RowsAffected() == 0 {
 		return errors.NotFound(tenant.EntitySecret, "secret to delete not found "+name.String())
 	}
+
+	deleteVersions := `DELETE FROM secret_version WHERE project_name = $1 AND name = $2`
+	if _, err := s.db.Exec(ctx, deleteVersions, projName, name); err != nil {
+		return errors.Wrap(tenant.EntitySecret, "error during delete of secret versions", err)
+	}
 	return nil
 }
 
@@ -243,8 +254,8 @@ func (s SecretRepository) GetSecretsInfo(ctx context.Context, projName tenant.Pr
 	var secretInfo []*dto.SecretInfo
 	for rows.Next() {
 		var sec Secret
-		err := rows.Scan(&sec.ID, &sec.Name, &sec.Value,
-			&sec.ProjectName, &sec.NamespaceName, &sec.CreatedAt, &sec.UpdatedAt)
+		err := rows.Scan(&sec.ID, &sec.Name, &sec.Value, &sec.ProjectName, &sec.NamespaceName,
+			&sec.Version, &sec.CreatedAt, &sec.UpdatedAt)
 		if err != nil {
 			return nil, errors.Wrap(tenant.EntitySecret, "error in GetAll", err)
 		}
@@ -5,6 +5,7 @@ package tenant_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/stretchr/testify/assert"
@@ -332,6 +333,66 @@ func TestPostgresSecretRepository(t *testing.T) {
 			assert.EqualError(t, err, "not found for entity secret: secret to delete not found SECRET_NAME")
 		})
 	})
+	t.Run("Rotate", func(t *testing.T) {
+		t.Run("returns error when secret does not exist", func(t *testing.T) {
+			db := dbSetup()
+
+			validSecret, err := tenant.NewSecret("secret_name", "abcd", proj.Name(), "")
+			assert.Nil(t, err)
+
+			repo := postgres.NewSecretRepository(db)
+
+			_, err = repo.Rotate(ctx, validSecret, time.Now().Add(time.Hour))
+			assert.NotNil(t, err)
+			assert.EqualError(t, err, "not found for entity secret: unable to rotate, secret not found for SECRET_NAME")
+		})
+		t.Run("stores a new version keeping the previous one readable until the end of grace period", func(t *testing.T) {
+			db := dbSetup()
+
+			validSecret, err := tenant.NewSecret("secret_name", "abcd", proj.Name(), namespace.Name().String())
+			assert.Nil(t, err)
+
+			repo := postgres.NewSecretRepository(db)
+			assert.Nil(t, repo.Save(ctx, validSecret))
+
+			rotatedSecret, err := tenant.NewSecret("secret_name", "efgh", proj.Name(), namespace.Name().String())
+			assert.Nil(t, err)
+
+			rotation, err := repo.Rotate(ctx, rotatedSecret, time.Now().Add(time.Hour))
+			assert.Nil(t, err)
+			assert.Equal(t, 1, rotation.PreviousVersion)
+			assert.Equal(t, 2, rotation.Version)
+
+			current, err := repo.Get(ctx, proj.Name(), namespace.Name().String(), validSecret.Name())
+			assert.Nil(t, err)
+			assert.Equal(t, "efgh", current.EncodedValue())
+			assert.Equal(t, 2, current.Version())
+
+			previous, err := repo.GetVersion(ctx, proj.Name(), namespace.Name().String(), validSecret.Name(), 1)
+			assert.Nil(t, err)
+			assert.Equal(t, "abcd", previous.EncodedValue())
+			assert.Equal(t, 1, previous.Version())
+		})
+		t.Run("does not return the previous version after the grace period", func(t *testing.T) {
+			db := dbSetup()
+
+			validSecret, err := tenant.NewSecret("secret_name", "abcd", proj.Name(), "")
+			assert.Nil(t, err)
+
+			repo := postgres.NewSecretRepository(db)
+			assert.Nil(t, repo.Save(ctx, validSecret))
+
+			rotatedSecret, err := tenant.NewSecret("secret_name", "efgh", proj.Name(), "")
+			assert.Nil(t, err)
+
+			_, err = repo.Rotate(ctx, rotatedSecret, time.Now().Add(-time.Minute))
+			assert.Nil(t, err)
+
+			_, err = repo.GetVersion(ctx, proj.Name(), "", validSecret.Name(), 1)
+			assert.NotNil(t, err)
+			assert.EqualError(t, err, "not found for entity secret: version 1 of secret SECRET_NAME is not readable")
+		})
+	})
 	t.Run("GetSecretsInfo", func(t *testing.T) {
 		t.Run("should get all the secrets info for a project", func(t *testing.T) {
 			db := dbSetup()
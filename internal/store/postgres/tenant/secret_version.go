@@ -0,0 +1,136 @@
+package tenant
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/core/tenant/dto"
+	"github.com/goto/optimus/internal/errors"
+)
+
+// Rotate replaces the value of the secret with a new version, the previous value is kept in secret_version and
+// stays readable until readableUntil. Previous versions past their grace period are cleaned up on rotation.
+func (s SecretRepository) Rotate(ctx context.Context, tenantSecret *tenant.Secret, readableUntil time.Time) (*dto.SecretRotation, error) {
+	secret := NewSecret(tenantSecret)
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, errors.Wrap(tenant.EntitySecret, "unable to rotate secret", err)
+	}
+
+	var previousVersion int
+	lockSecret := `SELECT version FROM secret WHERE project_name = $1 AND name = $2 FOR UPDATE`
+	if err := tx.QueryRow(ctx, lockSecret, secret.ProjectName, secret.Name).Scan(&previousVersion); err != nil {
+		tx.Rollback(ctx)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.NotFound(tenant.EntitySecret, "unable to rotate, secret not found for "+secret.Name)
+		}
+		return nil, errors.Wrap(tenant.EntitySecret, "unable to rotate secret", err)
+	}
+
+	deleteExpiredVersions := `DELETE FROM secret_version
+WHERE project_name = $1 AND name = $2 AND readable_until < NOW()`
+	if _, err := tx.Exec(ctx, deleteExpiredVersions, secret.ProjectName, secret.Name); err != nil {
+		tx.Rollback(ctx)
+		return nil, errors.Wrap(tenant.EntitySecret, "unable to clean up expired secret versions", err)
+	}
+
+	keepPreviousVersion := `INSERT INTO secret_version (project_name, namespace_name, name, version, value, created_at, readable_until)
+SELECT project_name, namespace_name, name, version, value, NOW(), $3
+FROM secret WHERE project_name = $1 AND name = $2`
+	if _, err := tx.Exec(ctx, keepPreviousVersion, secret.ProjectName, secret.Name, readableUntil); err != nil {
+		tx.Rollback(ctx)
+		return nil, errors.Wrap(tenant.EntitySecret, "unable to keep previous version of secret", err)
+	}
+
+	var version int
+	rotateSecret := `UPDATE secret SET value = $1, version = version + 1, updated_at = NOW()
+WHERE project_name = $2 AND name = $3
+RETURNING version`
+	if err := tx.QueryRow(ctx, rotateSecret, secret.Value, secret.ProjectName, secret.Name).Scan(&version); err != nil {
+		tx.Rollback(ctx)
+		return nil, errors.Wrap(tenant.EntitySecret, "unable to rotate secret", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, errors.Wrap(tenant.EntitySecret, "unable to rotate secret", err)
+	}
+
+	return &dto.SecretRotation{
+		Name:                  secret.Name,
+		Version:               version,
+		PreviousVersion:       previousVersion,
+		PreviousReadableUntil: readableUntil,
+	}, nil
+}
+
+// GetVersion returns a previous version of the secret, as long as its grace period is not over
+func (s SecretRepository) GetVersion(ctx context.Context, projName tenant.ProjectName, nsName string, name tenant.SecretName, version int) (*tenant.Secret, error) {
+	var secret Secret
+
+	getSecretVersion := `SELECT name, value, project_name, namespace_name, version, created_at
+FROM secret_version WHERE name = $1
+AND project_name = $2
+AND (namespace_name IS NULL OR namespace_name = $3)
+AND version = $4
+AND readable_until > NOW()`
+
+	err := s.db.QueryRow(ctx, getSecretVersion, name, projName, nsName, version).
+		Scan(&secret.Name, &secret.Value, &secret.ProjectName, &secret.NamespaceName, &secret.Version, &secret.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.NotFound(tenant.EntitySecret, fmt.Sprintf("version %d of secret %s is not readable", version, name))
+		}
+		return nil, errors.Wrap(tenant.EntitySecret, "error while getting secret version", err)
+	}
+
+	return secret.ToTenantSecret()
+}
+
+// RecordUsage keeps the current versions of the given secrets as the ones the job is compiled against
+func (s SecretRepository) RecordUsage(ctx context.Context, tnnt tenant.Tenant, jobName string, secretNames []string) error {
+	recordUsage := `INSERT INTO job_secret_version (project_name, job_name, secret_name, version, compiled_at)
+SELECT project_name, $2, name, version, NOW()
+FROM secret WHERE project_name = $1
+AND (namespace_name IS NULL OR namespace_name = $3)
+AND name = any ($4)
+ON CONFLICT (project_name, job_name, secret_name) DO UPDATE
+SET version = EXCLUDED.version, compiled_at = EXCLUDED.compiled_at`
+
+	_, err := s.db.Exec(ctx, recordUsage, tnnt.ProjectName(), jobName, tnnt.NamespaceName(), secretNames)
+	if err != nil {
+		return errors.Wrap(tenant.EntitySecret, "unable to record secret usage of job "+jobName, err)
+	}
+	return nil
+}
+
+// GetStaleUsages returns the existing jobs last compiled against a version of the secret older than the current one
+func (s SecretRepository) GetStaleUsages(ctx context.Context, projName tenant.ProjectName, name tenant.SecretName) ([]*dto.SecretUsage, error) {
+	getStaleUsages := `SELECT u.job_name, u.version, u.compiled_at
+FROM job_secret_version u
+JOIN secret s ON s.project_name = u.project_name AND s.name = u.secret_name
+JOIN job j ON j.project_name = u.project_name AND j.name = u.job_name AND j.deleted_at IS NULL
+WHERE u.project_name = $1 AND u.secret_name = $2 AND u.version < s.version
+ORDER BY u.job_name`
+
+	rows, err := s.db.Query(ctx, getStaleUsages, projName, name)
+	if err != nil {
+		return nil, errors.Wrap(tenant.EntitySecret, "unable to get stale usages of secret "+name.String(), err)
+	}
+	defer rows.Close()
+
+	var usages []*dto.SecretUsage
+	for rows.Next() {
+		var usage dto.SecretUsage
+		if err := rows.Scan(&usage.JobName, &usage.Version, &usage.CompiledAt); err != nil {
+			return nil, errors.Wrap(tenant.EntitySecret, "error in GetStaleUsages", err)
+		}
+		usages = append(usages, &usage)
+	}
+
+	return usages, nil
+}
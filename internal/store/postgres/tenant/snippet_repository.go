@@ -0,0 +1,73 @@
+package tenant
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/errors"
+)
+
+type SnippetRepository struct {
+	db *pgxpool.Pool
+}
+
+const (
+	snippetColumns = `name, version, content`
+
+	// the version is incremented within the insert, a concurrent registration of the same name fails on the primary key
+	insertSnippet = `INSERT INTO snippet (project_name, name, version, content, created_at)
+SELECT $1, $2, COALESCE(MAX(version), 0) + 1, $3, NOW() FROM snippet WHERE project_name = $1 AND name = $2
+RETURNING version`
+
+	getSnippetsByProjectName = `SELECT ` + snippetColumns + ` FROM snippet WHERE project_name = $1 ORDER BY name, version`
+)
+
+func NewSnippetRepository(db *pgxpool.Pool) *SnippetRepository {
+	return &SnippetRepository{
+		db: db,
+	}
+}
+
+// Create stores the snippet as the next version of its name, and returns the snippet with the version
+func (r SnippetRepository) Create(ctx context.Context, projectName tenant.ProjectName, snippet *tenant.Snippet) (*tenant.Snippet, error) {
+	var version int
+	if err := r.db.QueryRow(ctx, insertSnippet, projectName, snippet.Name(), snippet.Content()).Scan(&version); err != nil {
+		return nil, errors.Wrap(tenant.EntitySnippet, "error inserting snippet "+snippet.Name(), err)
+	}
+	return tenant.NewSnippet(snippet.Name(), snippet.Content(), version)
+}
+
+// GetAll returns every version of the snippets of the project, ordered by name and version
+func (r SnippetRepository) GetAll(ctx context.Context, projectName tenant.ProjectName) ([]*tenant.Snippet, error) {
+	rows, err := r.db.Query(ctx, getSnippetsByProjectName, projectName)
+	if err != nil {
+		return nil, errors.Wrap(tenant.EntitySnippet, "error reading snippets of project "+projectName.String(), err)
+	}
+	defer rows.Close()
+
+	return r.scanRows(rows)
+}
+
+func (SnippetRepository) scanRows(rows pgx.Rows) ([]*tenant.Snippet, error) {
+	var snippets []*tenant.Snippet
+	for rows.Next() {
+		var (
+			name, content string
+			version       int
+		)
+		if err := rows.Scan(&name, &version, &content); err != nil {
+			return nil, errors.Wrap(tenant.EntitySnippet, "error scanning rows", err)
+		}
+
+		snippet, err := tenant.NewSnippet(name, content, version)
+		if err != nil {
+			return nil, err
+		}
+		snippets = append(snippets, snippet)
+	}
+
+	return snippets, nil
+}
@@ -0,0 +1,99 @@
+//go:build !unit_test
+
+package tenant_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/core/tenant"
+	postgres "github.com/goto/optimus/internal/store/postgres/tenant"
+	"github.com/goto/optimus/tests/setup"
+)
+
+func TestPostgresSnippetRepository(t *testing.T) {
+	ctx := context.Background()
+
+	proj, _ := tenant.NewProject("t-optimus-1",
+		map[string]string{
+			"bucket":                     "gs://some_folder-2",
+			tenant.ProjectSchedulerHost:  "host",
+			tenant.ProjectStoragePathKey: "gs://location",
+		})
+
+	dbSetup := func() *pgxpool.Pool {
+		dbPool := setup.TestPool()
+		setup.TruncateTablesWith(dbPool)
+
+		prjRepo := postgres.NewProjectRepository(dbPool)
+		err := prjRepo.Save(ctx, proj)
+		if err != nil {
+			panic(err)
+		}
+
+		return dbPool
+	}
+
+	dedupV1, _ := tenant.NewSnippet("dedup_by_key", "select 1", 0)
+	dedupV2, _ := tenant.NewSnippet("dedup_by_key", "select 2", 0)
+	latest, _ := tenant.NewSnippet("latest_partition", "select 3", 0)
+
+	t.Run("Create", func(t *testing.T) {
+		t.Run("stores the snippet with the next version of its name", func(t *testing.T) {
+			db := dbSetup()
+			repo := postgres.NewSnippetRepository(db)
+
+			created, err := repo.Create(ctx, proj.Name(), dedupV1)
+			assert.NoError(t, err)
+			assert.Equal(t, 1, created.Version())
+
+			created, err = repo.Create(ctx, proj.Name(), dedupV2)
+			assert.NoError(t, err)
+			assert.Equal(t, 2, created.Version())
+			assert.Equal(t, "select 2", created.Content())
+
+			created, err = repo.Create(ctx, proj.Name(), latest)
+			assert.NoError(t, err)
+			assert.Equal(t, 1, created.Version())
+		})
+		t.Run("returns error when project does not exist", func(t *testing.T) {
+			db := dbSetup()
+			repo := postgres.NewSnippetRepository(db)
+
+			_, err := repo.Create(ctx, "unknown-project", dedupV1)
+			assert.ErrorContains(t, err, "error inserting snippet dedup_by_key")
+		})
+	})
+	t.Run("GetAll", func(t *testing.T) {
+		t.Run("returns every version of the snippets of the project", func(t *testing.T) {
+			db := dbSetup()
+			repo := postgres.NewSnippetRepository(db)
+
+			_, err := repo.Create(ctx, proj.Name(), latest)
+			assert.NoError(t, err)
+			_, err = repo.Create(ctx, proj.Name(), dedupV1)
+			assert.NoError(t, err)
+			_, err = repo.Create(ctx, proj.Name(), dedupV2)
+			assert.NoError(t, err)
+
+			snippets, err := repo.GetAll(ctx, proj.Name())
+			assert.NoError(t, err)
+			assert.Len(t, snippets, 3)
+			assert.Equal(t, "dedup_by_key@1", snippets[0].VersionedName())
+			assert.Equal(t, "select 1", snippets[0].Content())
+			assert.Equal(t, "dedup_by_key@2", snippets[1].VersionedName())
+			assert.Equal(t, "latest_partition@1", snippets[2].VersionedName())
+		})
+		t.Run("returns empty when project has no snippet", func(t *testing.T) {
+			db := dbSetup()
+			repo := postgres.NewSnippetRepository(db)
+
+			snippets, err := repo.GetAll(ctx, proj.Name())
+			assert.NoError(t, err)
+			assert.Empty(t, snippets)
+		})
+	})
+}
@@ -0,0 +1,89 @@
+package telemetry
+
+import (
+	"sync"
+
+	"github.com/goto/optimus/config"
+)
+
+const (
+	// labelProject scopes the values counted for the other labels, so a project with many jobs does not use up
+	// the values allowed for the rest of the projects
+	labelProject = "project"
+
+	// OtherLabelValue replaces the values of a label beyond the values allowed for it
+	OtherLabelValue = "__other__"
+)
+
+var cardinality = &cardinalityLimiter{}
+
+// cardinalityLimiter keeps the number of series of the metrics bounded, by dropping the configured labels and
+// replacing the values of a label beyond the allowed number of values with OtherLabelValue
+type cardinalityLimiter struct {
+	mu sync.Mutex
+
+	dropped             map[string]bool
+	maxValuesPerProject int
+	seen                map[string]map[string]bool
+}
+
+// ConfigureMetricLabels sets the cardinality limits of the labels of the metrics created afterwards
+func ConfigureMetricLabels(conf config.MetricLabelsConfig) {
+	cardinality.mu.Lock()
+	defer cardinality.mu.Unlock()
+
+	cardinality.dropped = make(map[string]bool, len(conf.Drop))
+	for _, label := range conf.Drop {
+		cardinality.dropped[label] = true
+	}
+	cardinality.maxValuesPerProject = conf.MaxValuesPerProject
+	cardinality.seen = map[string]map[string]bool{}
+}
+
+func limitCardinality(labels map[string]string) map[string]string {
+	return cardinality.apply(labels)
+}
+
+func (c *cardinalityLimiter) apply(labels map[string]string) map[string]string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.dropped) == 0 && c.maxValuesPerProject <= 0 {
+		return labels
+	}
+
+	limited := make(map[string]string, len(labels))
+	for label, value := range labels {
+		if c.dropped[label] {
+			continue
+		}
+		limited[label] = c.limitValue(labels[labelProject], label, value)
+	}
+	return limited
+}
+
+// limitValue counts the values of the project label across the projects, and the values of the other labels
+// within the project
+func (c *cardinalityLimiter) limitValue(project, label, value string) string {
+	if c.maxValuesPerProject <= 0 {
+		return value
+	}
+
+	scope := project + "/" + label
+	if label == labelProject {
+		scope = label
+	}
+	values, ok := c.seen[scope]
+	if !ok {
+		values = map[string]bool{}
+		c.seen[scope] = values
+	}
+	if values[value] {
+		return value
+	}
+	if len(values) >= c.maxValuesPerProject {
+		return OtherLabelValue
+	}
+	values[value] = true
+	return value
+}
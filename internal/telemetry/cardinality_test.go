@@ -0,0 +1,44 @@
+package telemetry_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/config"
+	"github.com/goto/optimus/internal/telemetry"
+)
+
+func TestMetricLabelsCardinality(t *testing.T) {
+	defer telemetry.ConfigureMetricLabels(config.MetricLabelsConfig{})
+
+	t.Run("keeps every label when no limit is configured", func(t *testing.T) {
+		telemetry.ConfigureMetricLabels(config.MetricLabelsConfig{})
+
+		first := telemetry.NewCounter("test_unlimited_total", map[string]string{"project": "proj", "job": "job-a"})
+		second := telemetry.NewCounter("test_unlimited_total", map[string]string{"project": "proj", "job": "job-b"})
+		assert.NotSame(t, first, second)
+	})
+	t.Run("drops the configured labels", func(t *testing.T) {
+		telemetry.ConfigureMetricLabels(config.MetricLabelsConfig{Drop: []string{"job"}})
+
+		first := telemetry.NewCounter("test_dropped_total", map[string]string{"project": "proj", "job": "job-a"})
+		second := telemetry.NewCounter("test_dropped_total", map[string]string{"project": "proj", "job": "job-b"})
+		assert.Same(t, first, second)
+		assert.NotContains(t, first.Desc().String(), "job-a")
+	})
+	t.Run("replaces the values beyond the limit of the project", func(t *testing.T) {
+		telemetry.ConfigureMetricLabels(config.MetricLabelsConfig{MaxValuesPerProject: 2})
+
+		first := telemetry.NewHistogram("test_limited_seconds", map[string]string{"project": "proj", "job": "job-a"})
+		second := telemetry.NewHistogram("test_limited_seconds", map[string]string{"project": "proj", "job": "job-b"})
+		third := telemetry.NewHistogram("test_limited_seconds", map[string]string{"project": "proj", "job": "job-c"})
+		assert.NotSame(t, first, second)
+		assert.Contains(t, third.Desc().String(), telemetry.OtherLabelValue)
+		assert.Same(t, third, telemetry.NewHistogram("test_limited_seconds", map[string]string{"project": "proj", "job": "job-d"}))
+
+		// the jobs of a project do not use up the values allowed for the jobs of the other projects
+		otherProject := telemetry.NewHistogram("test_limited_seconds", map[string]string{"project": "other-proj", "job": "job-c"})
+		assert.NotContains(t, otherProject.Desc().String(), telemetry.OtherLabelValue)
+	})
+}
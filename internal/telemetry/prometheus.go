@@ -14,6 +14,9 @@ var (
 
 	gaugeMetricMap   = map[string]prometheus.Gauge{}
 	gaugeMetricMutex = sync.Mutex{}
+
+	histogramMetricMap   = map[string]prometheus.Histogram{}
+	histogramMetricMutex = sync.Mutex{}
 )
 
 func getKey(metric string, labels map[string]string) string {
@@ -30,6 +33,7 @@ func getKey(metric string, labels map[string]string) string {
 }
 
 func NewCounter(metric string, labels map[string]string) prometheus.Counter {
+	labels = limitCardinality(labels)
 	metricKey := getKey(metric, labels)
 
 	counterMetricMutex.Lock()
@@ -44,6 +48,7 @@ func NewCounter(metric string, labels map[string]string) prometheus.Counter {
 }
 
 func NewGauge(metric string, labels map[string]string) prometheus.Gauge {
+	labels = limitCardinality(labels)
 	metricKey := getKey(metric, labels)
 
 	gaugeMetricMutex.Lock()
@@ -56,3 +61,23 @@ func NewGauge(metric string, labels map[string]string) prometheus.Gauge {
 	gaugeMetricMap[metricKey] = newMetric
 	return newMetric
 }
+
+// NewHistogram returns the histogram of the metric with the labels, buckets are only used when the histogram
+// is created, prometheus.DefBuckets is used when none are given
+func NewHistogram(metric string, labels map[string]string, buckets ...float64) prometheus.Histogram {
+	labels = limitCardinality(labels)
+	metricKey := getKey(metric, labels)
+
+	histogramMetricMutex.Lock()
+	defer histogramMetricMutex.Unlock()
+
+	if existingMetric, ok := histogramMetricMap[metricKey]; ok {
+		return existingMetric
+	}
+	if len(buckets) == 0 {
+		buckets = prometheus.DefBuckets
+	}
+	newMetric := promauto.NewHistogram(prometheus.HistogramOpts{Name: metric, ConstLabels: labels, Buckets: buckets})
+	histogramMetricMap[metricKey] = newMetric
+	return newMetric
+}
@@ -12,12 +12,8 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.opentelemetry.io/otel"
-	"go.opentelemetry.io/otel/attribute"
-	"go.opentelemetry.io/otel/exporters/jaeger"
 	"go.opentelemetry.io/otel/propagation"
-	"go.opentelemetry.io/otel/sdk/resource"
 	tracesdk "go.opentelemetry.io/otel/sdk/trace"
-	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
 
 	"github.com/goto/optimus/config"
 )
@@ -25,11 +21,13 @@ import (
 const MetricWaitInterval = time.Second * 2
 
 func Init(l log.Logger, conf config.TelemetryConfig) (func(), error) {
+	ConfigureMetricLabels(conf.MetricLabels)
+
 	var tp *tracesdk.TracerProvider
 	var err error
-	if conf.JaegerAddr != "" {
-		l.Debug("enabling jaeger traces", "addr", conf.JaegerAddr)
-		tp, err = tracerProvider(conf.JaegerAddr)
+	if tracing := TracingConfigOf(conf); tracing.Endpoint != "" {
+		l.Debug("enabling traces", "exporter", tracing.Exporter, "endpoint", tracing.Endpoint)
+		tp, err = tracerProvider(tracing)
 		if err != nil {
 			return nil, err
 		}
@@ -90,33 +88,6 @@ func Init(l log.Logger, conf config.TelemetryConfig) (func(), error) {
 	}, nil
 }
 
-// tracerProvider returns an OpenTelemetry TracerProvider configured to use
-// the Jaeger exporter that will send spans to the provided url. The returned
-// TracerProvider will also use a Resource configured with all the information
-// about the application.
-func tracerProvider(url string) (*tracesdk.TracerProvider, error) {
-	// create the Jaeger exporter
-	jaegerExporter, err := jaeger.New(jaeger.WithCollectorEndpoint(jaeger.WithEndpoint(url)))
-	if err != nil {
-		return nil, err
-	}
-	tp := tracesdk.NewTracerProvider(
-		// Always be sure to batch in production
-		tracesdk.WithBatcher(jaegerExporter),
-
-		// Record information about this application in an Resource
-		tracesdk.WithResource(resource.NewWithAttributes(
-			semconv.SchemaURL,
-			semconv.ServiceNameKey.String(config.AppName()),
-			semconv.ServiceVersionKey.String(config.BuildVersion),
-			attribute.String("build_commit", config.BuildCommit),
-			attribute.String("build_date", config.BuildDate),
-		)),
-	)
-
-	return tp, nil
-}
-
 func MetricsServer(addr string) *http.Server {
 	mux := http.NewServeMux()
 	mux.Handle("/metrics", promhttp.Handler())
@@ -0,0 +1,86 @@
+package telemetry
+
+import (
+	"fmt"
+	"net"
+	"sort"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/jaeger"
+	"go.opentelemetry.io/otel/sdk/resource"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+
+	"github.com/goto/optimus/config"
+)
+
+// TracingConfigOf returns the tracing config of the telemetry, the deprecated jaeger addr is used as the endpoint
+// of the jaeger collector when no endpoint is set
+func TracingConfigOf(conf config.TelemetryConfig) config.TracingConfig {
+	tracing := conf.Tracing
+	if tracing.Endpoint == "" && conf.JaegerAddr != "" {
+		tracing.Endpoint = conf.JaegerAddr
+		tracing.Exporter = config.TracingExporterJaegerCollector
+	}
+	if tracing.Exporter == "" {
+		tracing.Exporter = config.TracingExporterJaegerCollector
+	}
+	return tracing
+}
+
+// tracerProvider returns an OpenTelemetry TracerProvider exporting the spans with the exporter of the config, with a
+// Resource configured with all the information about the application and the attributes of the config
+func tracerProvider(conf config.TracingConfig) (*tracesdk.TracerProvider, error) {
+	if conf.SampleRatio < 0 || conf.SampleRatio > 1 {
+		return nil, fmt.Errorf("tracing sample ratio should be between 0 and 1, got %v", conf.SampleRatio)
+	}
+
+	exporter, err := spanExporter(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := []attribute.KeyValue{
+		semconv.ServiceNameKey.String(config.AppName()),
+		semconv.ServiceVersionKey.String(config.BuildVersion),
+		attribute.String("build_commit", config.BuildCommit),
+		attribute.String("build_date", config.BuildDate),
+	}
+	keys := make([]string, 0, len(conf.Attributes))
+	for key := range conf.Attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		attributes = append(attributes, attribute.String(key, conf.Attributes[key]))
+	}
+
+	sampler := tracesdk.AlwaysSample()
+	if conf.SampleRatio > 0 {
+		sampler = tracesdk.TraceIDRatioBased(conf.SampleRatio)
+	}
+
+	return tracesdk.NewTracerProvider(
+		// Always be sure to batch in production
+		tracesdk.WithBatcher(exporter),
+		// the traces started by the callers, like the executors, keep their sampling decision
+		tracesdk.WithSampler(tracesdk.ParentBased(sampler)),
+		tracesdk.WithResource(resource.NewWithAttributes(semconv.SchemaURL, attributes...)),
+	), nil
+}
+
+func spanExporter(conf config.TracingConfig) (tracesdk.SpanExporter, error) {
+	switch conf.Exporter {
+	case config.TracingExporterJaegerCollector:
+		return jaeger.New(jaeger.WithCollectorEndpoint(jaeger.WithEndpoint(conf.Endpoint)))
+	case config.TracingExporterJaegerAgent:
+		host, port, err := net.SplitHostPort(conf.Endpoint)
+		if err != nil {
+			return nil, fmt.Errorf("jaeger agent endpoint should be host:port, got %s: %w", conf.Endpoint, err)
+		}
+		return jaeger.New(jaeger.WithAgentEndpoint(jaeger.WithAgentHost(host), jaeger.WithAgentPort(port)))
+	default:
+		return nil, fmt.Errorf("unknown tracing exporter %s, should be one of %s, %s", conf.Exporter,
+			config.TracingExporterJaegerCollector, config.TracingExporterJaegerAgent)
+	}
+}
@@ -0,0 +1,206 @@
+package remote
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
+	"github.com/hashicorp/go-hclog"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/goto/optimus/config"
+	"github.com/goto/optimus/internal/models"
+	"github.com/goto/optimus/plugin/v1beta1/dependencyresolver"
+	pbp "github.com/goto/optimus/protos/gotocompany/optimus/plugins/v1beta1"
+	oplugin "github.com/goto/optimus/sdk/plugin"
+)
+
+const (
+	defaultDialTimeout = 10 * time.Second
+	verifyInterval     = 5 * time.Second
+)
+
+// Init connects to the dependency resolver mods served by sidecars over grpc. Sidecars implement the
+// DependencyResolverModService, so they can be written in any language and scaled apart from the server.
+// The connections are made lazily, the server starts without waiting for the sidecars, and the plugin served by
+// every sidecar is verified in the background once it is reachable.
+// NOTE: remote plugins are loaded after yaml and binary plugins, a plugin can only have one of binary or remote mod
+func Init(pluginsRepo *models.PluginRepository, remotes []config.RemotePluginConfig, pluginLogger hclog.Logger) (func(), error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var conns []*grpc.ClientConn
+	cleanup := func() {
+		cancel()
+		for _, conn := range conns {
+			conn.Close()
+		}
+	}
+
+	for _, remote := range remotes {
+		conn, err := dial(remote)
+		if err != nil {
+			return cleanup, fmt.Errorf("dial remote plugin %s at %s: %w", remote.Name, remote.Address, err)
+		}
+		conns = append(conns, conn)
+
+		mod := &remoteMod{
+			DependencyResolverMod: dependencyresolver.NewGRPCClient(conn, pluginLogger),
+			name:                  remote.Name,
+		}
+		if err := pluginsRepo.AddBinary(mod); err != nil {
+			return cleanup, fmt.Errorf("PluginRegistry.Add: %s: %w", remote.Name, err)
+		}
+		go mod.verify(ctx, remote, pluginLogger)
+	}
+
+	return cleanup, nil
+}
+
+func dial(remote config.RemotePluginConfig) (*grpc.ClientConn, error) {
+	transportCredentials := insecure.NewCredentials()
+	if remote.TLS != (config.RemotePluginTLSConfig{}) {
+		tlsConfig, err := newTLSConfig(remote.TLS)
+		if err != nil {
+			return nil, err
+		}
+		transportCredentials = credentials.NewTLS(tlsConfig)
+	}
+
+	return grpc.Dial(remote.Address,
+		grpc.WithTransportCredentials(transportCredentials),
+		grpc.WithChainUnaryInterceptor(
+			otelgrpc.UnaryClientInterceptor(),
+			grpc_retry.UnaryClientInterceptor(),
+		),
+	)
+}
+
+func newTLSConfig(conf config.RemotePluginTLSConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: conf.ServerName,
+	}
+
+	if conf.CertFile != "" || conf.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(conf.CertFile, conf.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("error loading client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	if conf.CAFile != "" {
+		caPEM, err := os.ReadFile(conf.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading ca file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("no certificate is found in ca file %s", conf.CAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	return tlsConfig, nil
+}
+
+// remoteMod serves the mod of the sidecar under the configured name, so it is registered without reaching the
+// sidecar. The calls fail once the sidecar turns out to serve another plugin.
+type remoteMod struct {
+	oplugin.DependencyResolverMod
+
+	name string
+
+	mu  sync.RWMutex
+	err error
+}
+
+func (m *remoteMod) GetName(context.Context) (string, error) {
+	return m.name, nil
+}
+
+func (m *remoteMod) GenerateDestination(ctx context.Context, request oplugin.GenerateDestinationRequest) (*oplugin.GenerateDestinationResponse, error) {
+	if err := m.verifyErr(); err != nil {
+		return nil, err
+	}
+	return m.DependencyResolverMod.GenerateDestination(ctx, request)
+}
+
+func (m *remoteMod) GenerateDependencies(ctx context.Context, request oplugin.GenerateDependenciesRequest) (*oplugin.GenerateDependenciesResponse, error) {
+	if err := m.verifyErr(); err != nil {
+		return nil, err
+	}
+	return m.DependencyResolverMod.GenerateDependencies(ctx, request)
+}
+
+func (m *remoteMod) CompileAssets(ctx context.Context, request oplugin.CompileAssetsRequest) (*oplugin.CompileAssetsResponse, error) { //nolint: gocritic
+	if err := m.verifyErr(); err != nil {
+		return nil, err
+	}
+	return m.DependencyResolverMod.CompileAssets(ctx, request)
+}
+
+func (m *remoteMod) verifyErr() error {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.err
+}
+
+// verify checks the name of the plugin served by the sidecar, retrying until the sidecar is reachable
+func (m *remoteMod) verify(ctx context.Context, remote config.RemotePluginConfig, logger hclog.Logger) {
+	dialTimeout := remote.DialTimeout
+	if dialTimeout == 0 {
+		dialTimeout = defaultDialTimeout
+	}
+
+	for {
+		attemptCtx, cancel := context.WithTimeout(ctx, dialTimeout)
+		pluginName, err := m.DependencyResolverMod.GetName(attemptCtx)
+		cancel()
+
+		if err == nil {
+			if pluginName != m.name {
+				m.mu.Lock()
+				m.err = fmt.Errorf("remote plugin at %s serves %s instead of %s", remote.Address, pluginName, m.name)
+				m.mu.Unlock()
+				logger.Error("remote plugin serves another plugin", "name", m.name, "address", remote.Address, "served", pluginName)
+				return
+			}
+			logger.Debug("remote plugin ready", "name", pluginName)
+			return
+		}
+		logger.Warn("remote plugin is not reachable yet", "name", m.name, "address", remote.Address, "error", err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(verifyInterval):
+		}
+	}
+}
+
+// Serve serves the dependency resolver mod on the address, for the plugins written in go to run as a sidecar
+func Serve(impl oplugin.DependencyResolverMod, address string, logger hclog.Logger) error {
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return err
+	}
+
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(otelgrpc.UnaryServerInterceptor()),
+		grpc.StreamInterceptor(otelgrpc.StreamServerInterceptor()),
+	)
+	pbp.RegisterDependencyResolverModServiceServer(grpcServer, &dependencyresolver.GRPCServer{
+		Impl: impl,
+	})
+
+	logger.Info(fmt.Sprintf("serving dependency resolver mod on %s", address))
+	return grpcServer.Serve(listener)
+}
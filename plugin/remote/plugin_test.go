@@ -0,0 +1,137 @@
+package remote_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+
+	"github.com/goto/optimus/config"
+	"github.com/goto/optimus/internal/models"
+	"github.com/goto/optimus/plugin/remote"
+	"github.com/goto/optimus/plugin/v1beta1/dependencyresolver"
+	pbp "github.com/goto/optimus/protos/gotocompany/optimus/plugins/v1beta1"
+	"github.com/goto/optimus/sdk/plugin"
+	mockPlugin "github.com/goto/optimus/sdk/plugin/mock"
+)
+
+func TestInit(t *testing.T) {
+	ctx := context.Background()
+	logger := hclog.NewNullLogger()
+
+	newRepo := func(t *testing.T) *models.PluginRepository {
+		t.Helper()
+
+		repo := models.NewPluginRepository()
+		assert.NoError(t, repo.AddYaml(&mockPlugin.MockYamlMod{Name: "bq2bq", Type: plugin.TypeTask.String()}))
+		return repo
+	}
+	generateDependencies := func(repo *models.PluginRepository) (*plugin.GenerateDependenciesResponse, error) {
+		p, err := repo.GetByName("bq2bq")
+		if err != nil {
+			return nil, err
+		}
+		return p.DependencyMod.GenerateDependencies(ctx, plugin.GenerateDependenciesRequest{})
+	}
+
+	t.Run("starts without waiting for the sidecar and serves the calls once it is up", func(t *testing.T) {
+		repo := newRepo(t)
+		address := freeAddress(t)
+
+		cleanup, err := remote.Init(repo, []config.RemotePluginConfig{{Name: "bq2bq", Address: address}}, logger)
+		assert.NoError(t, err)
+		defer cleanup()
+
+		p, err := repo.GetByName("bq2bq")
+		assert.NoError(t, err)
+		assert.NotNil(t, p.DependencyMod)
+
+		serveSidecar(t, address, &sidecarMod{name: "bq2bq"})
+
+		assert.Eventually(t, func() bool {
+			resp, err := generateDependencies(repo)
+			return err == nil && assert.ObjectsAreEqual([]string{"bigquery://project:dataset.table"}, resp.Dependencies)
+		}, 10*time.Second, 100*time.Millisecond)
+	})
+	t.Run("fails the calls when the sidecar serves another plugin", func(t *testing.T) {
+		repo := newRepo(t)
+		address := freeAddress(t)
+		serveSidecar(t, address, &sidecarMod{name: "other"})
+
+		cleanup, err := remote.Init(repo, []config.RemotePluginConfig{{Name: "bq2bq", Address: address}}, logger)
+		assert.NoError(t, err)
+		defer cleanup()
+
+		assert.Eventually(t, func() bool {
+			_, err := generateDependencies(repo)
+			return err != nil && assert.ObjectsAreEqual("remote plugin at "+address+" serves other instead of bq2bq", err.Error())
+		}, 10*time.Second, 100*time.Millisecond)
+	})
+	t.Run("returns error when the tls config cannot be loaded", func(t *testing.T) {
+		repo := newRepo(t)
+
+		remotes := []config.RemotePluginConfig{{
+			Name:    "bq2bq",
+			Address: freeAddress(t),
+			TLS:     config.RemotePluginTLSConfig{CAFile: "/non/existing/ca.pem"},
+		}}
+		cleanup, err := remote.Init(repo, remotes, logger)
+		defer cleanup()
+
+		assert.ErrorContains(t, err, "error reading ca file")
+	})
+	t.Run("returns error when the plugin has no yaml version", func(t *testing.T) {
+		repo := models.NewPluginRepository()
+
+		cleanup, err := remote.Init(repo, []config.RemotePluginConfig{{Name: "bq2bq", Address: freeAddress(t)}}, logger)
+		defer cleanup()
+
+		assert.ErrorContains(t, err, "please provide yaml version of the plugin bq2bq")
+	})
+}
+
+func freeAddress(t *testing.T) string {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	address := listener.Addr().String()
+	assert.NoError(t, listener.Close())
+	return address
+}
+
+func serveSidecar(t *testing.T, address string, impl plugin.DependencyResolverMod) {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", address)
+	assert.NoError(t, err)
+
+	grpcServer := grpc.NewServer()
+	pbp.RegisterDependencyResolverModServiceServer(grpcServer, &dependencyresolver.GRPCServer{Impl: impl})
+	go grpcServer.Serve(listener)
+	t.Cleanup(grpcServer.Stop)
+}
+
+type sidecarMod struct {
+	name string
+}
+
+func (m *sidecarMod) GetName(context.Context) (string, error) {
+	return m.name, nil
+}
+
+func (*sidecarMod) GenerateDestination(context.Context, plugin.GenerateDestinationRequest) (*plugin.GenerateDestinationResponse, error) {
+	return &plugin.GenerateDestinationResponse{}, nil
+}
+
+func (*sidecarMod) GenerateDependencies(context.Context, plugin.GenerateDependenciesRequest) (*plugin.GenerateDependenciesResponse, error) {
+	return &plugin.GenerateDependenciesResponse{Dependencies: []string{"bigquery://project:dataset.table"}}, nil
+}
+
+func (*sidecarMod) CompileAssets(context.Context, plugin.CompileAssetsRequest) (*plugin.CompileAssetsResponse, error) { //nolint: gocritic
+	return &plugin.CompileAssetsResponse{}, nil
+}
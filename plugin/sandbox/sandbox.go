@@ -0,0 +1,156 @@
+package sandbox
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/goto/optimus/config"
+	"github.com/goto/optimus/internal/telemetry"
+	"github.com/goto/optimus/sdk/plugin"
+)
+
+const (
+	metricPluginCallFailures = "plugin_sandbox_failures_total"
+
+	reasonTimeout = "timeout"
+	reasonPanic   = "panic"
+)
+
+// DependencyMod runs the calls of a dependency resolver mod with a timeout, a limit on the concurrent calls
+// and recovers from its panics, the calls which are not finished within the timeout are abandoned
+type DependencyMod struct {
+	name    string
+	mod     plugin.DependencyResolverMod
+	timeout time.Duration
+	slots   chan struct{}
+
+	logger hclog.Logger
+}
+
+func (s *DependencyMod) GetName(ctx context.Context) (string, error) {
+	return call(ctx, s, "GetName", s.mod.GetName)
+}
+
+func (s *DependencyMod) GenerateDestination(ctx context.Context, req plugin.GenerateDestinationRequest) (*plugin.GenerateDestinationResponse, error) {
+	return call(ctx, s, "GenerateDestination", func(ctx context.Context) (*plugin.GenerateDestinationResponse, error) {
+		return s.mod.GenerateDestination(ctx, req)
+	})
+}
+
+func (s *DependencyMod) GenerateDependencies(ctx context.Context, req plugin.GenerateDependenciesRequest) (*plugin.GenerateDependenciesResponse, error) {
+	return call(ctx, s, "GenerateDependencies", func(ctx context.Context) (*plugin.GenerateDependenciesResponse, error) {
+		return s.mod.GenerateDependencies(ctx, req)
+	})
+}
+
+func (s *DependencyMod) CompileAssets(ctx context.Context, req plugin.CompileAssetsRequest) (*plugin.CompileAssetsResponse, error) { //nolint: gocritic
+	return call(ctx, s, "CompileAssets", func(ctx context.Context) (*plugin.CompileAssetsResponse, error) {
+		return s.mod.CompileAssets(ctx, req)
+	})
+}
+
+type result[T any] struct {
+	value T
+	err   error
+}
+
+func call[T any](ctx context.Context, s *DependencyMod, method string, fn func(context.Context) (T, error)) (T, error) {
+	spanCtx, span := otel.Tracer("plugin/sandbox").Start(ctx, "plugin."+method, trace.WithAttributes(
+		attribute.String("plugin", s.name),
+	))
+	defer span.End()
+
+	value, err := callWithLimits(spanCtx, s, method, fn)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return value, err
+}
+
+func callWithLimits[T any](ctx context.Context, s *DependencyMod, method string, fn func(context.Context) (T, error)) (T, error) {
+	var empty T
+	if s.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.timeout)
+		defer cancel()
+	}
+
+	if s.slots != nil {
+		select {
+		case s.slots <- struct{}{}:
+		case <-ctx.Done():
+			s.track(method, reasonTimeout)
+			return empty, fmt.Errorf("plugin %s: waiting for a free slot to call %s: %w", s.name, method, ctx.Err())
+		}
+	}
+
+	// buffered, the abandoned calls should not block when they eventually finish
+	done := make(chan result[T], 1)
+	go func() {
+		if s.slots != nil {
+			// the slot is held until the call actually finishes, to bound the calls in flight
+			defer func() { <-s.slots }()
+		}
+		defer func() {
+			if r := recover(); r != nil {
+				s.logger.Error(fmt.Sprintf("plugin %s panicked on %s: %v\n%s", s.name, method, r, debug.Stack()))
+				s.track(method, reasonPanic)
+				done <- result[T]{err: fmt.Errorf("plugin %s: %s panicked: %v", s.name, method, r)}
+			}
+		}()
+
+		value, err := fn(ctx)
+		done <- result[T]{value: value, err: err}
+	}()
+
+	select {
+	case res := <-done:
+		return res.value, res.err
+	case <-ctx.Done():
+		s.track(method, reasonTimeout)
+		return empty, fmt.Errorf("plugin %s: %s did not finish: %w", s.name, method, ctx.Err())
+	}
+}
+
+func (s *DependencyMod) track(method, reason string) {
+	telemetry.NewCounter(metricPluginCallFailures, map[string]string{
+		"plugin": s.name,
+		"method": method,
+		"reason": reason,
+	}).Inc()
+}
+
+// NewDependencyMod sandboxes the mod of the plugin with its limits, the limits of the plugin
+// override the ones for every plugin
+func NewDependencyMod(name string, mod plugin.DependencyResolverMod, conf config.PluginSandboxConfig, logger hclog.Logger) *DependencyMod {
+	timeout, maxConcurrency := conf.Timeout, conf.MaxConcurrency
+	if limits, ok := conf.Plugins[name]; ok {
+		if limits.Timeout > 0 {
+			timeout = limits.Timeout
+		}
+		if limits.MaxConcurrency > 0 {
+			maxConcurrency = limits.MaxConcurrency
+		}
+	}
+
+	var slots chan struct{}
+	if maxConcurrency > 0 {
+		slots = make(chan struct{}, maxConcurrency)
+	}
+	return &DependencyMod{
+		name:    name,
+		mod:     mod,
+		timeout: timeout,
+		slots:   slots,
+		logger:  logger,
+	}
+}
@@ -0,0 +1,130 @@
+package sandbox_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/config"
+	"github.com/goto/optimus/plugin/sandbox"
+	"github.com/goto/optimus/sdk/plugin"
+)
+
+func TestDependencyMod(t *testing.T) {
+	ctx := context.Background()
+	logger := hclog.NewNullLogger()
+	req := plugin.GenerateDependenciesRequest{}
+
+	t.Run("GenerateDependencies", func(t *testing.T) {
+		t.Run("returns the response of the plugin", func(t *testing.T) {
+			mod := &dependencyMod{dependencies: []string{"bigquery://project:dataset.table"}}
+			sandboxed := sandbox.NewDependencyMod("bq2bq", mod, config.PluginSandboxConfig{Timeout: time.Second}, logger)
+
+			resp, err := sandboxed.GenerateDependencies(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"bigquery://project:dataset.table"}, resp.Dependencies)
+		})
+		t.Run("returns error when the plugin does not finish within the timeout", func(t *testing.T) {
+			mod := &dependencyMod{delay: time.Second}
+			sandboxed := sandbox.NewDependencyMod("bq2bq", mod, config.PluginSandboxConfig{Timeout: 10 * time.Millisecond}, logger)
+
+			resp, err := sandboxed.GenerateDependencies(ctx, req)
+			assert.ErrorIs(t, err, context.DeadlineExceeded)
+			assert.ErrorContains(t, err, "plugin bq2bq: GenerateDependencies did not finish")
+			assert.Nil(t, resp)
+		})
+		t.Run("uses the timeout of the plugin over the one for every plugin", func(t *testing.T) {
+			mod := &dependencyMod{delay: 50 * time.Millisecond}
+			conf := config.PluginSandboxConfig{
+				Timeout: 10 * time.Millisecond,
+				Plugins: map[string]config.PluginLimitsConfig{
+					"bq2bq": {Timeout: time.Second},
+				},
+			}
+			sandboxed := sandbox.NewDependencyMod("bq2bq", mod, conf, logger)
+
+			_, err := sandboxed.GenerateDependencies(ctx, req)
+			assert.NoError(t, err)
+		})
+		t.Run("returns error when the plugin panics", func(t *testing.T) {
+			mod := &dependencyMod{panics: true}
+			sandboxed := sandbox.NewDependencyMod("bq2bq", mod, config.PluginSandboxConfig{Timeout: time.Second}, logger)
+
+			resp, err := sandboxed.GenerateDependencies(ctx, req)
+			assert.ErrorContains(t, err, "plugin bq2bq: GenerateDependencies panicked: unable to parse")
+			assert.Nil(t, resp)
+		})
+		t.Run("limits the concurrent calls to the plugin", func(t *testing.T) {
+			mod := &dependencyMod{delay: 20 * time.Millisecond}
+			conf := config.PluginSandboxConfig{Timeout: time.Second, MaxConcurrency: 2}
+			sandboxed := sandbox.NewDependencyMod("bq2bq", mod, conf, logger)
+
+			var wg sync.WaitGroup
+			for i := 0; i < 6; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					_, err := sandboxed.GenerateDependencies(ctx, req)
+					assert.NoError(t, err)
+				}()
+			}
+			wg.Wait()
+
+			assert.Equal(t, int32(2), mod.maxInFlight.Load())
+		})
+		t.Run("returns error when no slot is freed within the timeout", func(t *testing.T) {
+			mod := &dependencyMod{delay: 100 * time.Millisecond}
+			conf := config.PluginSandboxConfig{Timeout: 50 * time.Millisecond, MaxConcurrency: 1}
+			sandboxed := sandbox.NewDependencyMod("bq2bq", mod, conf, logger)
+
+			go sandboxed.GenerateDependencies(ctx, req) //nolint: errcheck
+			time.Sleep(10 * time.Millisecond)
+
+			_, err := sandboxed.GenerateDependencies(ctx, req)
+			assert.ErrorContains(t, err, "waiting for a free slot to call GenerateDependencies")
+		})
+	})
+}
+
+type dependencyMod struct {
+	dependencies []string
+	delay        time.Duration
+	panics       bool
+
+	inFlight    atomic.Int32
+	maxInFlight atomic.Int32
+}
+
+func (*dependencyMod) GetName(context.Context) (string, error) {
+	return "bq2bq", nil
+}
+
+func (*dependencyMod) GenerateDestination(context.Context, plugin.GenerateDestinationRequest) (*plugin.GenerateDestinationResponse, error) {
+	return &plugin.GenerateDestinationResponse{}, nil
+}
+
+func (d *dependencyMod) GenerateDependencies(context.Context, plugin.GenerateDependenciesRequest) (*plugin.GenerateDependenciesResponse, error) {
+	current := d.inFlight.Add(1)
+	defer d.inFlight.Add(-1)
+	for {
+		maxInFlight := d.maxInFlight.Load()
+		if current <= maxInFlight || d.maxInFlight.CompareAndSwap(maxInFlight, current) {
+			break
+		}
+	}
+
+	if d.panics {
+		panic("unable to parse")
+	}
+	time.Sleep(d.delay)
+	return &plugin.GenerateDependenciesResponse{Dependencies: d.dependencies}, nil
+}
+
+func (*dependencyMod) CompileAssets(context.Context, plugin.CompileAssetsRequest) (*plugin.CompileAssetsResponse, error) {
+	return &plugin.CompileAssetsResponse{}, nil
+}
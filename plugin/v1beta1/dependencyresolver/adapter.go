@@ -1,10 +1,19 @@
 package dependencyresolver
 
 import (
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/grpc/metadata"
+
 	pb "github.com/goto/optimus/protos/gotocompany/optimus/plugins/v1beta1"
 	"github.com/goto/optimus/sdk/plugin"
 )
 
+// columnMappingsMetadataKey carries the column mappings of generated dependencies in the response header,
+// as the proto response only has room for the dependencies
+const columnMappingsMetadataKey = "x-column-mappings"
+
 func adaptConfigsToProto(c plugin.Configs) *pb.Configs {
 	tc := &pb.Configs{
 		Configs: []*pb.Configs_Config{},
@@ -52,3 +61,24 @@ func adaptAssetsFromProto(a *pb.Assets) plugin.Assets {
 	}
 	return tc
 }
+
+func adaptColumnMappingsToMetadata(columnMappings []plugin.ColumnMapping) (metadata.MD, error) {
+	encoded, err := json.Marshal(columnMappings)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding column mappings: %w", err)
+	}
+	return metadata.Pairs(columnMappingsMetadataKey, string(encoded)), nil
+}
+
+func adaptColumnMappingsFromMetadata(md metadata.MD) ([]plugin.ColumnMapping, error) {
+	values := md.Get(columnMappingsMetadataKey)
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	var columnMappings []plugin.ColumnMapping
+	if err := json.Unmarshal([]byte(values[0]), &columnMappings); err != nil {
+		return nil, fmt.Errorf("error decoding column mappings: %w", err)
+	}
+	return columnMappings, nil
+}
@@ -9,6 +9,7 @@ import (
 	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
 	"github.com/hashicorp/go-hclog"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -27,6 +28,15 @@ type GRPCClient struct {
 	logger hclog.Logger
 }
 
+// NewGRPCClient creates a client over an established connection, used for the plugins
+// served over the network instead of being launched by core
+func NewGRPCClient(conn *grpc.ClientConn, logger hclog.Logger) *GRPCClient {
+	return &GRPCClient{
+		client: pbp.NewDependencyResolverModServiceClient(conn),
+		logger: logger,
+	}
+}
+
 func (m *GRPCClient) GetName(ctx context.Context) (string, error) {
 	spanCtx, span := tracer.Start(ctx, "GetName")
 	defer span.End()
@@ -68,19 +78,26 @@ func (m *GRPCClient) GenerateDependencies(ctx context.Context, request plugin.Ge
 	spanCtx, span := tracer.Start(ctx, "GenerateDependencies")
 	defer span.End()
 
+	var header metadata.MD
 	outCtx := propagateMetadata(spanCtx)
 	resp, err := m.client.GenerateDependencies(outCtx, &pbp.GenerateDependenciesRequest{
 		Config:  adaptConfigsToProto(request.Config),
 		Assets:  adaptAssetsToProto(request.Assets),
 		Options: &pbp.PluginOptions{DryRun: request.DryRun},
-	}, grpc_retry.WithBackoff(grpc_retry.BackoffExponential(BackoffDuration)),
+	}, grpc.Header(&header), grpc_retry.WithBackoff(grpc_retry.BackoffExponential(BackoffDuration)),
 		grpc_retry.WithMax(PluginGRPCMaxRetry))
 	if err != nil {
 		m.makeFatalOnConnErr(err)
 		return nil, err
 	}
+
+	columnMappings, err := adaptColumnMappingsFromMetadata(header)
+	if err != nil {
+		return nil, err
+	}
 	return &plugin.GenerateDependenciesResponse{
-		Dependencies: resp.Dependencies,
+		Dependencies:   resp.Dependencies,
+		ColumnMappings: columnMappings,
 	}, nil
 }
 
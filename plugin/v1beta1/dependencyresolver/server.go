@@ -3,6 +3,8 @@ package dependencyresolver
 import (
 	"context"
 
+	"google.golang.org/grpc"
+
 	pbp "github.com/goto/optimus/protos/gotocompany/optimus/plugins/v1beta1"
 	"github.com/goto/optimus/sdk/plugin"
 )
@@ -44,6 +46,15 @@ func (s *GRPCServer) GenerateDependencies(ctx context.Context, req *pbp.Generate
 	if err != nil {
 		return nil, err
 	}
+	if len(resp.ColumnMappings) > 0 {
+		header, err := adaptColumnMappingsToMetadata(resp.ColumnMappings)
+		if err != nil {
+			return nil, err
+		}
+		if err := grpc.SetHeader(ctx, header); err != nil {
+			return nil, err
+		}
+	}
 	return &pbp.GenerateDependenciesResponse{Dependencies: resp.Dependencies}, nil
 }
 
@@ -39,16 +39,36 @@ func (p *PluginSpec) PluginInfo() *plugin.Info {
 		HookType:      p.HookType,
 		DependsOn:     p.DependsOn,
 		APIVersion:    p.APIVersion,
+
+		DependencyRules: p.DependencyRules,
+		ConfigSchema:    p.ConfigSchema,
+
+		RequiredProjectConfigs: p.RequiredProjectConfigs,
 	}
 }
 
+// GetQuestions returns the questions of the plugin, followed by the questions of the config schema properties
+// which are neither asked nor given a default config
 func (p *PluginSpec) GetQuestions(context.Context, plugin.GetQuestionsRequest) (*plugin.GetQuestionsResponse, error) {
+	questions := p.Questions
+	if p.ConfigSchema != nil {
+		questions = append(plugin.Questions{}, p.Questions...)
+		for _, schemaQuestion := range p.ConfigSchema.Questions() { //nolint: gocritic
+			if _, asked := p.Questions.Get(schemaQuestion.Name); asked {
+				continue
+			}
+			if _, configured := p.Config.Get(schemaQuestion.Name); configured {
+				continue
+			}
+			questions = append(questions, schemaQuestion)
+		}
+	}
 	return &plugin.GetQuestionsResponse{
-		Questions: p.Questions,
+		Questions: questions,
 	}, nil
 }
 
-func (*PluginSpec) ValidateQuestion(_ context.Context, req plugin.ValidateQuestionRequest) (*plugin.ValidateQuestionResponse, error) { //nolint
+func (p *PluginSpec) ValidateQuestion(_ context.Context, req plugin.ValidateQuestionRequest) (*plugin.ValidateQuestionResponse, error) { //nolint
 	question := req.Answer.Question
 	value := req.Answer.Value
 	if err := question.IsValid(value); err != nil {
@@ -57,6 +77,16 @@ func (*PluginSpec) ValidateQuestion(_ context.Context, req plugin.ValidateQuesti
 			Error:   err.Error(),
 		}, nil
 	}
+	if p.ConfigSchema != nil {
+		if property, ok := p.ConfigSchema.Properties[question.Name]; ok && value != "" {
+			if err := property.ValidateValue(value); err != nil {
+				return &plugin.ValidateQuestionResponse{ //nolint: nilerr
+					Success: false,
+					Error:   err.Error(),
+				}, nil
+			}
+		}
+	}
 	return &plugin.ValidateQuestionResponse{
 		Success: true,
 	}, nil
@@ -177,7 +177,7 @@ func TestYamlPlugin(t *testing.T) {
 			assert.NotEmpty(t, pluginSpec)
 			assert.Equal(t, "/bin/sh", pluginSpec.Entrypoint.Shell)
 		})
-		t.Run("should returns error when load yaml when same name exists", func(t *testing.T) {
+		t.Run("should load yaml as another version when same name exists", func(t *testing.T) {
 			repoWithBinayPlugin := models.NewPluginRepository()
 			err := repoWithBinayPlugin.AddYaml(&mockYamlMod{
 				Name:  testYamlPluginName,
@@ -192,12 +192,18 @@ func TestYamlPlugin(t *testing.T) {
 			assert.Len(t, repoWithBinayPlugin.GetAll(), 1)
 
 			err = yaml.Init(repoWithBinayPlugin, []string{testYamlPluginPath}, pluginLogger)
-			assert.Error(t, err)
+			assert.NoError(t, err)
 			repoPlugins := repoWithBinayPlugin.GetAll()
 
-			assert.Len(t, repoPlugins, 1)
-			assert.Equal(t, repoPlugins[0].Info().Name, testYamlPluginName)
-			assert.NotNil(t, repoPlugins[0].YamlMod)
+			assert.Len(t, repoPlugins, 2)
+			assert.Equal(t, testYamlPluginName, repoPlugins[0].Info().Name)
+			assert.Equal(t, "asdasd", repoPlugins[0].Info().PluginVersion)
+			assert.Equal(t, testYamlPluginName, repoPlugins[1].Info().Name)
+			assert.Equal(t, "latest", repoPlugins[1].Info().PluginVersion)
+
+			defaultPlugin, err := repoWithBinayPlugin.GetByName(testYamlPluginName)
+			assert.NoError(t, err)
+			assert.Equal(t, "asdasd", defaultPlugin.Info().PluginVersion)
 		})
 		t.Run("should not load duplicate yaml", func(t *testing.T) {
 			repoWithBinayPlugin := models.NewPluginRepository()
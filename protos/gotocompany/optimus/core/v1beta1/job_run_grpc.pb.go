@@ -24,6 +24,9 @@ const _ = grpc.SupportPackageIsVersion7
 type JobRunServiceClient interface {
 	// JobRunInput is used to fetch task/hook compiled configuration and assets.
 	JobRunInput(ctx context.Context, in *JobRunInputRequest, opts ...grpc.CallOption) (*JobRunInputResponse, error)
+	// EncryptedJobRunInput returns the job run input with the files having secrets encrypted, only the holder of the
+	// private key of the public key of the request can decrypt them
+	EncryptedJobRunInput(ctx context.Context, in *EncryptedJobRunInputRequest, opts ...grpc.CallOption) (*EncryptedJobRunInputResponse, error)
 	// JobRun returns the current and past run status of jobs on a given range
 	JobRun(ctx context.Context, in *JobRunRequest, opts ...grpc.CallOption) (*JobRunResponse, error)
 	// RegisterJobEvent notifies optimus service about an event related to job
@@ -32,6 +35,30 @@ type JobRunServiceClient interface {
 	UploadToScheduler(ctx context.Context, in *UploadToSchedulerRequest, opts ...grpc.CallOption) (*UploadToSchedulerResponse, error)
 	// GetInterval gets interval on specific job given reference time.
 	GetInterval(ctx context.Context, in *GetIntervalRequest, opts ...grpc.CallOption) (*GetIntervalResponse, error)
+	// CompileExecutorInputAt compiles the executor input of a job run for any scheduled and executed time, to see
+	// the configs, secrets and assets a past run got without running the job again
+	CompileExecutorInputAt(ctx context.Context, in *CompileExecutorInputAtRequest, opts ...grpc.CallOption) (*CompileExecutorInputAtResponse, error)
+	// GetSchedulerHealth returns the health of the scheduler environment serving the namespace
+	GetSchedulerHealth(ctx context.Context, in *GetSchedulerHealthRequest, opts ...grpc.CallOption) (*GetSchedulerHealthResponse, error)
+	// GetJobRunDetail returns the timeline of a job run, with the state and timings of its sensors, task and hooks
+	GetJobRunDetail(ctx context.Context, in *GetJobRunDetailRequest, opts ...grpc.CallOption) (*GetJobRunDetailResponse, error)
+	// QueryJobRuns returns the runs of the jobs of a project, filtered by namespace, job name pattern, state and
+	// scheduled time, a page at a time
+	QueryJobRuns(ctx context.Context, in *QueryJobRunsRequest, opts ...grpc.CallOption) (*QueryJobRunsResponse, error)
+	// TriggerJobRun creates a manual run of a job for a scheduled time, with configs overriding its task configs
+	TriggerJobRun(ctx context.Context, in *TriggerJobRunRequest, opts ...grpc.CallOption) (*TriggerJobRunResponse, error)
+	// EstimateJobRunStart estimates when the run of the job will start, telling why it has not started yet
+	EstimateJobRunStart(ctx context.Context, in *EstimateJobRunStartRequest, opts ...grpc.CallOption) (*EstimateJobRunStartResponse, error)
+	// GetUploadProgress returns the progress of the latest upload of the jobs of a project to the scheduler
+	GetUploadProgress(ctx context.Context, in *GetUploadProgressRequest, opts ...grpc.CallOption) (*GetUploadProgressResponse, error)
+	// JobRunHeartbeat is sent by the executor of a job run to report it is still alive
+	JobRunHeartbeat(ctx context.Context, in *JobRunHeartbeatRequest, opts ...grpc.CallOption) (*JobRunHeartbeatResponse, error)
+	// GetScheduleRecommendation recommends a schedule for the job from the completion times of its upstreams
+	GetScheduleRecommendation(ctx context.Context, in *GetScheduleRecommendationRequest, opts ...grpc.CallOption) (*GetScheduleRecommendationResponse, error)
+	// GetJobRunCriticalPath returns the chain of upstream runs which gated the completion of the job run
+	GetJobRunCriticalPath(ctx context.Context, in *GetJobRunCriticalPathRequest, opts ...grpc.CallOption) (*GetJobRunCriticalPathResponse, error)
+	// GetUpstreamFreshness evaluates the freshness predicates of the data of an upstream for the sensors waiting on it
+	GetUpstreamFreshness(ctx context.Context, in *GetUpstreamFreshnessRequest, opts ...grpc.CallOption) (*GetUpstreamFreshnessResponse, error)
 }
 
 type jobRunServiceClient struct {
@@ -51,6 +78,15 @@ func (c *jobRunServiceClient) JobRunInput(ctx context.Context, in *JobRunInputRe
 	return out, nil
 }
 
+func (c *jobRunServiceClient) EncryptedJobRunInput(ctx context.Context, in *EncryptedJobRunInputRequest, opts ...grpc.CallOption) (*EncryptedJobRunInputResponse, error) {
+	out := new(EncryptedJobRunInputResponse)
+	err := c.cc.Invoke(ctx, "/gotocompany.optimus.core.v1beta1.JobRunService/EncryptedJobRunInput", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *jobRunServiceClient) JobRun(ctx context.Context, in *JobRunRequest, opts ...grpc.CallOption) (*JobRunResponse, error) {
 	out := new(JobRunResponse)
 	err := c.cc.Invoke(ctx, "/gotocompany.optimus.core.v1beta1.JobRunService/JobRun", in, out, opts...)
@@ -87,12 +123,114 @@ func (c *jobRunServiceClient) GetInterval(ctx context.Context, in *GetIntervalRe
 	return out, nil
 }
 
+func (c *jobRunServiceClient) CompileExecutorInputAt(ctx context.Context, in *CompileExecutorInputAtRequest, opts ...grpc.CallOption) (*CompileExecutorInputAtResponse, error) {
+	out := new(CompileExecutorInputAtResponse)
+	err := c.cc.Invoke(ctx, "/gotocompany.optimus.core.v1beta1.JobRunService/CompileExecutorInputAt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobRunServiceClient) GetSchedulerHealth(ctx context.Context, in *GetSchedulerHealthRequest, opts ...grpc.CallOption) (*GetSchedulerHealthResponse, error) {
+	out := new(GetSchedulerHealthResponse)
+	err := c.cc.Invoke(ctx, "/gotocompany.optimus.core.v1beta1.JobRunService/GetSchedulerHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobRunServiceClient) GetJobRunDetail(ctx context.Context, in *GetJobRunDetailRequest, opts ...grpc.CallOption) (*GetJobRunDetailResponse, error) {
+	out := new(GetJobRunDetailResponse)
+	err := c.cc.Invoke(ctx, "/gotocompany.optimus.core.v1beta1.JobRunService/GetJobRunDetail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobRunServiceClient) QueryJobRuns(ctx context.Context, in *QueryJobRunsRequest, opts ...grpc.CallOption) (*QueryJobRunsResponse, error) {
+	out := new(QueryJobRunsResponse)
+	err := c.cc.Invoke(ctx, "/gotocompany.optimus.core.v1beta1.JobRunService/QueryJobRuns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobRunServiceClient) TriggerJobRun(ctx context.Context, in *TriggerJobRunRequest, opts ...grpc.CallOption) (*TriggerJobRunResponse, error) {
+	out := new(TriggerJobRunResponse)
+	err := c.cc.Invoke(ctx, "/gotocompany.optimus.core.v1beta1.JobRunService/TriggerJobRun", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobRunServiceClient) EstimateJobRunStart(ctx context.Context, in *EstimateJobRunStartRequest, opts ...grpc.CallOption) (*EstimateJobRunStartResponse, error) {
+	out := new(EstimateJobRunStartResponse)
+	err := c.cc.Invoke(ctx, "/gotocompany.optimus.core.v1beta1.JobRunService/EstimateJobRunStart", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobRunServiceClient) GetUploadProgress(ctx context.Context, in *GetUploadProgressRequest, opts ...grpc.CallOption) (*GetUploadProgressResponse, error) {
+	out := new(GetUploadProgressResponse)
+	err := c.cc.Invoke(ctx, "/gotocompany.optimus.core.v1beta1.JobRunService/GetUploadProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobRunServiceClient) JobRunHeartbeat(ctx context.Context, in *JobRunHeartbeatRequest, opts ...grpc.CallOption) (*JobRunHeartbeatResponse, error) {
+	out := new(JobRunHeartbeatResponse)
+	err := c.cc.Invoke(ctx, "/gotocompany.optimus.core.v1beta1.JobRunService/JobRunHeartbeat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobRunServiceClient) GetScheduleRecommendation(ctx context.Context, in *GetScheduleRecommendationRequest, opts ...grpc.CallOption) (*GetScheduleRecommendationResponse, error) {
+	out := new(GetScheduleRecommendationResponse)
+	err := c.cc.Invoke(ctx, "/gotocompany.optimus.core.v1beta1.JobRunService/GetScheduleRecommendation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobRunServiceClient) GetJobRunCriticalPath(ctx context.Context, in *GetJobRunCriticalPathRequest, opts ...grpc.CallOption) (*GetJobRunCriticalPathResponse, error) {
+	out := new(GetJobRunCriticalPathResponse)
+	err := c.cc.Invoke(ctx, "/gotocompany.optimus.core.v1beta1.JobRunService/GetJobRunCriticalPath", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobRunServiceClient) GetUpstreamFreshness(ctx context.Context, in *GetUpstreamFreshnessRequest, opts ...grpc.CallOption) (*GetUpstreamFreshnessResponse, error) {
+	out := new(GetUpstreamFreshnessResponse)
+	err := c.cc.Invoke(ctx, "/gotocompany.optimus.core.v1beta1.JobRunService/GetUpstreamFreshness", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // JobRunServiceServer is the server API for JobRunService service.
 // All implementations must embed UnimplementedJobRunServiceServer
 // for forward compatibility
 type JobRunServiceServer interface {
 	// JobRunInput is used to fetch task/hook compiled configuration and assets.
 	JobRunInput(context.Context, *JobRunInputRequest) (*JobRunInputResponse, error)
+	// EncryptedJobRunInput returns the job run input with the files having secrets encrypted, only the holder of the
+	// private key of the public key of the request can decrypt them
+	EncryptedJobRunInput(context.Context, *EncryptedJobRunInputRequest) (*EncryptedJobRunInputResponse, error)
 	// JobRun returns the current and past run status of jobs on a given range
 	JobRun(context.Context, *JobRunRequest) (*JobRunResponse, error)
 	// RegisterJobEvent notifies optimus service about an event related to job
@@ -101,6 +239,30 @@ type JobRunServiceServer interface {
 	UploadToScheduler(context.Context, *UploadToSchedulerRequest) (*UploadToSchedulerResponse, error)
 	// GetInterval gets interval on specific job given reference time.
 	GetInterval(context.Context, *GetIntervalRequest) (*GetIntervalResponse, error)
+	// CompileExecutorInputAt compiles the executor input of a job run for any scheduled and executed time, to see
+	// the configs, secrets and assets a past run got without running the job again
+	CompileExecutorInputAt(context.Context, *CompileExecutorInputAtRequest) (*CompileExecutorInputAtResponse, error)
+	// GetSchedulerHealth returns the health of the scheduler environment serving the namespace
+	GetSchedulerHealth(context.Context, *GetSchedulerHealthRequest) (*GetSchedulerHealthResponse, error)
+	// GetJobRunDetail returns the timeline of a job run, with the state and timings of its sensors, task and hooks
+	GetJobRunDetail(context.Context, *GetJobRunDetailRequest) (*GetJobRunDetailResponse, error)
+	// QueryJobRuns returns the runs of the jobs of a project, filtered by namespace, job name pattern, state and
+	// scheduled time, a page at a time
+	QueryJobRuns(context.Context, *QueryJobRunsRequest) (*QueryJobRunsResponse, error)
+	// TriggerJobRun creates a manual run of a job for a scheduled time, with configs overriding its task configs
+	TriggerJobRun(context.Context, *TriggerJobRunRequest) (*TriggerJobRunResponse, error)
+	// EstimateJobRunStart estimates when the run of the job will start, telling why it has not started yet
+	EstimateJobRunStart(context.Context, *EstimateJobRunStartRequest) (*EstimateJobRunStartResponse, error)
+	// GetUploadProgress returns the progress of the latest upload of the jobs of a project to the scheduler
+	GetUploadProgress(context.Context, *GetUploadProgressRequest) (*GetUploadProgressResponse, error)
+	// JobRunHeartbeat is sent by the executor of a job run to report it is still alive
+	JobRunHeartbeat(context.Context, *JobRunHeartbeatRequest) (*JobRunHeartbeatResponse, error)
+	// GetScheduleRecommendation recommends a schedule for the job from the completion times of its upstreams
+	GetScheduleRecommendation(context.Context, *GetScheduleRecommendationRequest) (*GetScheduleRecommendationResponse, error)
+	// GetJobRunCriticalPath returns the chain of upstream runs which gated the completion of the job run
+	GetJobRunCriticalPath(context.Context, *GetJobRunCriticalPathRequest) (*GetJobRunCriticalPathResponse, error)
+	// GetUpstreamFreshness evaluates the freshness predicates of the data of an upstream for the sensors waiting on it
+	GetUpstreamFreshness(context.Context, *GetUpstreamFreshnessRequest) (*GetUpstreamFreshnessResponse, error)
 	mustEmbedUnimplementedJobRunServiceServer()
 }
 
@@ -111,6 +273,9 @@ type UnimplementedJobRunServiceServer struct {
 func (UnimplementedJobRunServiceServer) JobRunInput(context.Context, *JobRunInputRequest) (*JobRunInputResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method JobRunInput not implemented")
 }
+func (UnimplementedJobRunServiceServer) EncryptedJobRunInput(context.Context, *EncryptedJobRunInputRequest) (*EncryptedJobRunInputResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EncryptedJobRunInput not implemented")
+}
 func (UnimplementedJobRunServiceServer) JobRun(context.Context, *JobRunRequest) (*JobRunResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method JobRun not implemented")
 }
@@ -123,6 +288,39 @@ func (UnimplementedJobRunServiceServer) UploadToScheduler(context.Context, *Uplo
 func (UnimplementedJobRunServiceServer) GetInterval(context.Context, *GetIntervalRequest) (*GetIntervalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInterval not implemented")
 }
+func (UnimplementedJobRunServiceServer) CompileExecutorInputAt(context.Context, *CompileExecutorInputAtRequest) (*CompileExecutorInputAtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompileExecutorInputAt not implemented")
+}
+func (UnimplementedJobRunServiceServer) GetSchedulerHealth(context.Context, *GetSchedulerHealthRequest) (*GetSchedulerHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchedulerHealth not implemented")
+}
+func (UnimplementedJobRunServiceServer) GetJobRunDetail(context.Context, *GetJobRunDetailRequest) (*GetJobRunDetailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobRunDetail not implemented")
+}
+func (UnimplementedJobRunServiceServer) QueryJobRuns(context.Context, *QueryJobRunsRequest) (*QueryJobRunsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryJobRuns not implemented")
+}
+func (UnimplementedJobRunServiceServer) TriggerJobRun(context.Context, *TriggerJobRunRequest) (*TriggerJobRunResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TriggerJobRun not implemented")
+}
+func (UnimplementedJobRunServiceServer) EstimateJobRunStart(context.Context, *EstimateJobRunStartRequest) (*EstimateJobRunStartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateJobRunStart not implemented")
+}
+func (UnimplementedJobRunServiceServer) GetUploadProgress(context.Context, *GetUploadProgressRequest) (*GetUploadProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUploadProgress not implemented")
+}
+func (UnimplementedJobRunServiceServer) JobRunHeartbeat(context.Context, *JobRunHeartbeatRequest) (*JobRunHeartbeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JobRunHeartbeat not implemented")
+}
+func (UnimplementedJobRunServiceServer) GetScheduleRecommendation(context.Context, *GetScheduleRecommendationRequest) (*GetScheduleRecommendationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScheduleRecommendation not implemented")
+}
+func (UnimplementedJobRunServiceServer) GetJobRunCriticalPath(context.Context, *GetJobRunCriticalPathRequest) (*GetJobRunCriticalPathResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobRunCriticalPath not implemented")
+}
+func (UnimplementedJobRunServiceServer) GetUpstreamFreshness(context.Context, *GetUpstreamFreshnessRequest) (*GetUpstreamFreshnessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUpstreamFreshness not implemented")
+}
 func (UnimplementedJobRunServiceServer) mustEmbedUnimplementedJobRunServiceServer() {}
 
 // UnsafeJobRunServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -154,6 +352,24 @@ func _JobRunService_JobRunInput_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JobRunService_EncryptedJobRunInput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EncryptedJobRunInputRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobRunServiceServer).EncryptedJobRunInput(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gotocompany.optimus.core.v1beta1.JobRunService/EncryptedJobRunInput",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobRunServiceServer).EncryptedJobRunInput(ctx, req.(*EncryptedJobRunInputRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _JobRunService_JobRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(JobRunRequest)
 	if err := dec(in); err != nil {
@@ -226,6 +442,204 @@ func _JobRunService_GetInterval_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JobRunService_CompileExecutorInputAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompileExecutorInputAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobRunServiceServer).CompileExecutorInputAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gotocompany.optimus.core.v1beta1.JobRunService/CompileExecutorInputAt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobRunServiceServer).CompileExecutorInputAt(ctx, req.(*CompileExecutorInputAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobRunService_GetSchedulerHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSchedulerHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobRunServiceServer).GetSchedulerHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gotocompany.optimus.core.v1beta1.JobRunService/GetSchedulerHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobRunServiceServer).GetSchedulerHealth(ctx, req.(*GetSchedulerHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobRunService_GetJobRunDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobRunDetailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobRunServiceServer).GetJobRunDetail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gotocompany.optimus.core.v1beta1.JobRunService/GetJobRunDetail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobRunServiceServer).GetJobRunDetail(ctx, req.(*GetJobRunDetailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobRunService_QueryJobRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryJobRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobRunServiceServer).QueryJobRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gotocompany.optimus.core.v1beta1.JobRunService/QueryJobRuns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobRunServiceServer).QueryJobRuns(ctx, req.(*QueryJobRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobRunService_TriggerJobRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerJobRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobRunServiceServer).TriggerJobRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gotocompany.optimus.core.v1beta1.JobRunService/TriggerJobRun",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobRunServiceServer).TriggerJobRun(ctx, req.(*TriggerJobRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobRunService_EstimateJobRunStart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateJobRunStartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobRunServiceServer).EstimateJobRunStart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gotocompany.optimus.core.v1beta1.JobRunService/EstimateJobRunStart",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobRunServiceServer).EstimateJobRunStart(ctx, req.(*EstimateJobRunStartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobRunService_GetUploadProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUploadProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobRunServiceServer).GetUploadProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gotocompany.optimus.core.v1beta1.JobRunService/GetUploadProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobRunServiceServer).GetUploadProgress(ctx, req.(*GetUploadProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobRunService_JobRunHeartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobRunHeartbeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobRunServiceServer).JobRunHeartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gotocompany.optimus.core.v1beta1.JobRunService/JobRunHeartbeat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobRunServiceServer).JobRunHeartbeat(ctx, req.(*JobRunHeartbeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobRunService_GetScheduleRecommendation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScheduleRecommendationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobRunServiceServer).GetScheduleRecommendation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gotocompany.optimus.core.v1beta1.JobRunService/GetScheduleRecommendation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobRunServiceServer).GetScheduleRecommendation(ctx, req.(*GetScheduleRecommendationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobRunService_GetJobRunCriticalPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobRunCriticalPathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobRunServiceServer).GetJobRunCriticalPath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gotocompany.optimus.core.v1beta1.JobRunService/GetJobRunCriticalPath",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobRunServiceServer).GetJobRunCriticalPath(ctx, req.(*GetJobRunCriticalPathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobRunService_GetUpstreamFreshness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUpstreamFreshnessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobRunServiceServer).GetUpstreamFreshness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gotocompany.optimus.core.v1beta1.JobRunService/GetUpstreamFreshness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobRunServiceServer).GetUpstreamFreshness(ctx, req.(*GetUpstreamFreshnessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // JobRunService_ServiceDesc is the grpc.ServiceDesc for JobRunService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -237,6 +651,10 @@ var JobRunService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "JobRunInput",
 			Handler:    _JobRunService_JobRunInput_Handler,
 		},
+		{
+			MethodName: "EncryptedJobRunInput",
+			Handler:    _JobRunService_EncryptedJobRunInput_Handler,
+		},
 		{
 			MethodName: "JobRun",
 			Handler:    _JobRunService_JobRun_Handler,
@@ -253,6 +671,50 @@ var JobRunService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetInterval",
 			Handler:    _JobRunService_GetInterval_Handler,
 		},
+		{
+			MethodName: "CompileExecutorInputAt",
+			Handler:    _JobRunService_CompileExecutorInputAt_Handler,
+		},
+		{
+			MethodName: "GetSchedulerHealth",
+			Handler:    _JobRunService_GetSchedulerHealth_Handler,
+		},
+		{
+			MethodName: "GetJobRunDetail",
+			Handler:    _JobRunService_GetJobRunDetail_Handler,
+		},
+		{
+			MethodName: "QueryJobRuns",
+			Handler:    _JobRunService_QueryJobRuns_Handler,
+		},
+		{
+			MethodName: "TriggerJobRun",
+			Handler:    _JobRunService_TriggerJobRun_Handler,
+		},
+		{
+			MethodName: "EstimateJobRunStart",
+			Handler:    _JobRunService_EstimateJobRunStart_Handler,
+		},
+		{
+			MethodName: "GetUploadProgress",
+			Handler:    _JobRunService_GetUploadProgress_Handler,
+		},
+		{
+			MethodName: "JobRunHeartbeat",
+			Handler:    _JobRunService_JobRunHeartbeat_Handler,
+		},
+		{
+			MethodName: "GetScheduleRecommendation",
+			Handler:    _JobRunService_GetScheduleRecommendation_Handler,
+		},
+		{
+			MethodName: "GetJobRunCriticalPath",
+			Handler:    _JobRunService_GetJobRunCriticalPath_Handler,
+		},
+		{
+			MethodName: "GetUpstreamFreshness",
+			Handler:    _JobRunService_GetUpstreamFreshness_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "gotocompany/optimus/core/v1beta1/job_run.proto",
@@ -159,7 +159,7 @@ func (x JobEvent_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use JobEvent_Type.Descriptor instead.
 func (JobEvent_Type) EnumDescriptor() ([]byte, []int) {
-	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{28, 0}
+	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{45, 0}
 }
 
 type DeployJobSpecificationRequest struct {
@@ -1061,19 +1061,20 @@ func (x *DeleteJobSpecificationResponse) GetMessage() string {
 	return ""
 }
 
-type ChangeJobNamespaceRequest struct {
+type DeleteJobsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProjectName      string `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
-	NamespaceName    string `protobuf:"bytes,2,opt,name=namespace_name,json=namespaceName,proto3" json:"namespace_name,omitempty"`
-	JobName          string `protobuf:"bytes,3,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
-	NewNamespaceName string `protobuf:"bytes,4,opt,name=new_namespace_name,json=newNamespaceName,proto3" json:"new_namespace_name,omitempty"`
+	ProjectName   string   `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	NamespaceName string   `protobuf:"bytes,2,opt,name=namespace_name,json=namespaceName,proto3" json:"namespace_name,omitempty"`
+	JobNames      []string `protobuf:"bytes,3,rep,name=job_names,json=jobNames,proto3" json:"job_names,omitempty"`
+	CleanHistory  bool     `protobuf:"varint,4,opt,name=clean_history,json=cleanHistory,proto3" json:"clean_history,omitempty"`
+	Force         bool     `protobuf:"varint,5,opt,name=force,proto3" json:"force,omitempty"`
 }
 
-func (x *ChangeJobNamespaceRequest) Reset() {
-	*x = ChangeJobNamespaceRequest{}
+func (x *DeleteJobsRequest) Reset() {
+	*x = DeleteJobsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1081,13 +1082,13 @@ func (x *ChangeJobNamespaceRequest) Reset() {
 	}
 }
 
-func (x *ChangeJobNamespaceRequest) String() string {
+func (x *DeleteJobsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeJobNamespaceRequest) ProtoMessage() {}
+func (*DeleteJobsRequest) ProtoMessage() {}
 
-func (x *ChangeJobNamespaceRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteJobsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1099,47 +1100,57 @@ func (x *ChangeJobNamespaceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeJobNamespaceRequest.ProtoReflect.Descriptor instead.
-func (*ChangeJobNamespaceRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteJobsRequest.ProtoReflect.Descriptor instead.
+func (*DeleteJobsRequest) Descriptor() ([]byte, []int) {
 	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ChangeJobNamespaceRequest) GetProjectName() string {
+func (x *DeleteJobsRequest) GetProjectName() string {
 	if x != nil {
 		return x.ProjectName
 	}
 	return ""
 }
 
-func (x *ChangeJobNamespaceRequest) GetNamespaceName() string {
+func (x *DeleteJobsRequest) GetNamespaceName() string {
 	if x != nil {
 		return x.NamespaceName
 	}
 	return ""
 }
 
-func (x *ChangeJobNamespaceRequest) GetJobName() string {
+func (x *DeleteJobsRequest) GetJobNames() []string {
 	if x != nil {
-		return x.JobName
+		return x.JobNames
 	}
-	return ""
+	return nil
 }
 
-func (x *ChangeJobNamespaceRequest) GetNewNamespaceName() string {
+func (x *DeleteJobsRequest) GetCleanHistory() bool {
 	if x != nil {
-		return x.NewNamespaceName
+		return x.CleanHistory
 	}
-	return ""
+	return false
 }
 
-type ChangeJobNamespaceResponse struct {
+func (x *DeleteJobsRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type DeleteJobsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Message            string   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	AffectedDownstream []string `protobuf:"bytes,2,rep,name=affected_downstream,json=affectedDownstream,proto3" json:"affected_downstream,omitempty"`
 }
 
-func (x *ChangeJobNamespaceResponse) Reset() {
-	*x = ChangeJobNamespaceResponse{}
+func (x *DeleteJobsResponse) Reset() {
+	*x = DeleteJobsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1147,13 +1158,13 @@ func (x *ChangeJobNamespaceResponse) Reset() {
 	}
 }
 
-func (x *ChangeJobNamespaceResponse) String() string {
+func (x *DeleteJobsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeJobNamespaceResponse) ProtoMessage() {}
+func (*DeleteJobsResponse) ProtoMessage() {}
 
-func (x *ChangeJobNamespaceResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteJobsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1165,22 +1176,38 @@ func (x *ChangeJobNamespaceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeJobNamespaceResponse.ProtoReflect.Descriptor instead.
-func (*ChangeJobNamespaceResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteJobsResponse.ProtoReflect.Descriptor instead.
+func (*DeleteJobsResponse) Descriptor() ([]byte, []int) {
 	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{16}
 }
 
-type ListJobSpecificationRequest struct {
+func (x *DeleteJobsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DeleteJobsResponse) GetAffectedDownstream() []string {
+	if x != nil {
+		return x.AffectedDownstream
+	}
+	return nil
+}
+
+type ChangeJobNamespaceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProjectName   string `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
-	NamespaceName string `protobuf:"bytes,2,opt,name=namespace_name,json=namespaceName,proto3" json:"namespace_name,omitempty"`
+	ProjectName      string `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	NamespaceName    string `protobuf:"bytes,2,opt,name=namespace_name,json=namespaceName,proto3" json:"namespace_name,omitempty"`
+	JobName          string `protobuf:"bytes,3,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	NewNamespaceName string `protobuf:"bytes,4,opt,name=new_namespace_name,json=newNamespaceName,proto3" json:"new_namespace_name,omitempty"`
 }
 
-func (x *ListJobSpecificationRequest) Reset() {
-	*x = ListJobSpecificationRequest{}
+func (x *ChangeJobNamespaceRequest) Reset() {
+	*x = ChangeJobNamespaceRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1188,13 +1215,13 @@ func (x *ListJobSpecificationRequest) Reset() {
 	}
 }
 
-func (x *ListJobSpecificationRequest) String() string {
+func (x *ChangeJobNamespaceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListJobSpecificationRequest) ProtoMessage() {}
+func (*ChangeJobNamespaceRequest) ProtoMessage() {}
 
-func (x *ListJobSpecificationRequest) ProtoReflect() protoreflect.Message {
+func (x *ChangeJobNamespaceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1206,35 +1233,47 @@ func (x *ListJobSpecificationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListJobSpecificationRequest.ProtoReflect.Descriptor instead.
-func (*ListJobSpecificationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChangeJobNamespaceRequest.ProtoReflect.Descriptor instead.
+func (*ChangeJobNamespaceRequest) Descriptor() ([]byte, []int) {
 	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *ListJobSpecificationRequest) GetProjectName() string {
+func (x *ChangeJobNamespaceRequest) GetProjectName() string {
 	if x != nil {
 		return x.ProjectName
 	}
 	return ""
 }
 
-func (x *ListJobSpecificationRequest) GetNamespaceName() string {
+func (x *ChangeJobNamespaceRequest) GetNamespaceName() string {
 	if x != nil {
 		return x.NamespaceName
 	}
 	return ""
 }
 
-type ListJobSpecificationResponse struct {
+func (x *ChangeJobNamespaceRequest) GetJobName() string {
+	if x != nil {
+		return x.JobName
+	}
+	return ""
+}
+
+func (x *ChangeJobNamespaceRequest) GetNewNamespaceName() string {
+	if x != nil {
+		return x.NewNamespaceName
+	}
+	return ""
+}
+
+type ChangeJobNamespaceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Jobs []*JobSpecification `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
 }
 
-func (x *ListJobSpecificationResponse) Reset() {
-	*x = ListJobSpecificationResponse{}
+func (x *ChangeJobNamespaceResponse) Reset() {
+	*x = ChangeJobNamespaceResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1242,13 +1281,13 @@ func (x *ListJobSpecificationResponse) Reset() {
 	}
 }
 
-func (x *ListJobSpecificationResponse) String() string {
+func (x *ChangeJobNamespaceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListJobSpecificationResponse) ProtoMessage() {}
+func (*ChangeJobNamespaceResponse) ProtoMessage() {}
 
-func (x *ListJobSpecificationResponse) ProtoReflect() protoreflect.Message {
+func (x *ChangeJobNamespaceResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1260,30 +1299,24 @@ func (x *ListJobSpecificationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListJobSpecificationResponse.ProtoReflect.Descriptor instead.
-func (*ListJobSpecificationResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChangeJobNamespaceResponse.ProtoReflect.Descriptor instead.
+func (*ChangeJobNamespaceResponse) Descriptor() ([]byte, []int) {
 	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ListJobSpecificationResponse) GetJobs() []*JobSpecification {
-	if x != nil {
-		return x.Jobs
-	}
-	return nil
-}
-
-type CheckJobSpecificationRequest struct {
+type MoveJobRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProjectName   string            `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
-	Job           *JobSpecification `protobuf:"bytes,2,opt,name=job,proto3" json:"job,omitempty"`
-	NamespaceName string            `protobuf:"bytes,3,opt,name=namespace_name,json=namespaceName,proto3" json:"namespace_name,omitempty"`
+	ProjectName      string `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	NamespaceName    string `protobuf:"bytes,2,opt,name=namespace_name,json=namespaceName,proto3" json:"namespace_name,omitempty"`
+	JobName          string `protobuf:"bytes,3,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	NewNamespaceName string `protobuf:"bytes,4,opt,name=new_namespace_name,json=newNamespaceName,proto3" json:"new_namespace_name,omitempty"`
 }
 
-func (x *CheckJobSpecificationRequest) Reset() {
-	*x = CheckJobSpecificationRequest{}
+func (x *MoveJobRequest) Reset() {
+	*x = MoveJobRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1291,13 +1324,13 @@ func (x *CheckJobSpecificationRequest) Reset() {
 	}
 }
 
-func (x *CheckJobSpecificationRequest) String() string {
+func (x *MoveJobRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckJobSpecificationRequest) ProtoMessage() {}
+func (*MoveJobRequest) ProtoMessage() {}
 
-func (x *CheckJobSpecificationRequest) ProtoReflect() protoreflect.Message {
+func (x *MoveJobRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1309,42 +1342,47 @@ func (x *CheckJobSpecificationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckJobSpecificationRequest.ProtoReflect.Descriptor instead.
-func (*CheckJobSpecificationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MoveJobRequest.ProtoReflect.Descriptor instead.
+func (*MoveJobRequest) Descriptor() ([]byte, []int) {
 	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *CheckJobSpecificationRequest) GetProjectName() string {
+func (x *MoveJobRequest) GetProjectName() string {
 	if x != nil {
 		return x.ProjectName
 	}
 	return ""
 }
 
-func (x *CheckJobSpecificationRequest) GetJob() *JobSpecification {
+func (x *MoveJobRequest) GetNamespaceName() string {
 	if x != nil {
-		return x.Job
+		return x.NamespaceName
 	}
-	return nil
+	return ""
 }
 
-func (x *CheckJobSpecificationRequest) GetNamespaceName() string {
+func (x *MoveJobRequest) GetJobName() string {
 	if x != nil {
-		return x.NamespaceName
+		return x.JobName
 	}
 	return ""
 }
 
-type CheckJobSpecificationResponse struct {
+func (x *MoveJobRequest) GetNewNamespaceName() string {
+	if x != nil {
+		return x.NewNamespaceName
+	}
+	return ""
+}
+
+type MoveJobResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 }
 
-func (x *CheckJobSpecificationResponse) Reset() {
-	*x = CheckJobSpecificationResponse{}
+func (x *MoveJobResponse) Reset() {
+	*x = MoveJobResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1352,13 +1390,13 @@ func (x *CheckJobSpecificationResponse) Reset() {
 	}
 }
 
-func (x *CheckJobSpecificationResponse) String() string {
+func (x *MoveJobResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckJobSpecificationResponse) ProtoMessage() {}
+func (*MoveJobResponse) ProtoMessage() {}
 
-func (x *CheckJobSpecificationResponse) ProtoReflect() protoreflect.Message {
+func (x *MoveJobResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1370,30 +1408,24 @@ func (x *CheckJobSpecificationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckJobSpecificationResponse.ProtoReflect.Descriptor instead.
-func (*CheckJobSpecificationResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use MoveJobResponse.ProtoReflect.Descriptor instead.
+func (*MoveJobResponse) Descriptor() ([]byte, []int) {
 	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *CheckJobSpecificationResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-type CheckJobSpecificationsRequest struct {
+type ChangeJobNameRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProjectName   string              `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
-	Jobs          []*JobSpecification `protobuf:"bytes,2,rep,name=jobs,proto3" json:"jobs,omitempty"`
-	NamespaceName string              `protobuf:"bytes,3,opt,name=namespace_name,json=namespaceName,proto3" json:"namespace_name,omitempty"`
+	ProjectName   string `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	NamespaceName string `protobuf:"bytes,2,opt,name=namespace_name,json=namespaceName,proto3" json:"namespace_name,omitempty"`
+	JobName       string `protobuf:"bytes,3,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	NewJobName    string `protobuf:"bytes,4,opt,name=new_job_name,json=newJobName,proto3" json:"new_job_name,omitempty"`
 }
 
-func (x *CheckJobSpecificationsRequest) Reset() {
-	*x = CheckJobSpecificationsRequest{}
+func (x *ChangeJobNameRequest) Reset() {
+	*x = ChangeJobNameRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1401,13 +1433,13 @@ func (x *CheckJobSpecificationsRequest) Reset() {
 	}
 }
 
-func (x *CheckJobSpecificationsRequest) String() string {
+func (x *ChangeJobNameRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckJobSpecificationsRequest) ProtoMessage() {}
+func (*ChangeJobNameRequest) ProtoMessage() {}
 
-func (x *CheckJobSpecificationsRequest) ProtoReflect() protoreflect.Message {
+func (x *ChangeJobNameRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1419,42 +1451,49 @@ func (x *CheckJobSpecificationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckJobSpecificationsRequest.ProtoReflect.Descriptor instead.
-func (*CheckJobSpecificationsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChangeJobNameRequest.ProtoReflect.Descriptor instead.
+func (*ChangeJobNameRequest) Descriptor() ([]byte, []int) {
 	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *CheckJobSpecificationsRequest) GetProjectName() string {
+func (x *ChangeJobNameRequest) GetProjectName() string {
 	if x != nil {
 		return x.ProjectName
 	}
 	return ""
 }
 
-func (x *CheckJobSpecificationsRequest) GetJobs() []*JobSpecification {
+func (x *ChangeJobNameRequest) GetNamespaceName() string {
 	if x != nil {
-		return x.Jobs
+		return x.NamespaceName
 	}
-	return nil
+	return ""
 }
 
-func (x *CheckJobSpecificationsRequest) GetNamespaceName() string {
+func (x *ChangeJobNameRequest) GetJobName() string {
 	if x != nil {
-		return x.NamespaceName
+		return x.JobName
 	}
 	return ""
 }
 
-type CheckJobSpecificationsResponse struct {
+func (x *ChangeJobNameRequest) GetNewJobName() string {
+	if x != nil {
+		return x.NewJobName
+	}
+	return ""
+}
+
+type ChangeJobNameResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LogStatus *Log `protobuf:"bytes,5,opt,name=log_status,json=logStatus,proto3" json:"log_status,omitempty"`
+	JobName string `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
 }
 
-func (x *CheckJobSpecificationsResponse) Reset() {
-	*x = CheckJobSpecificationsResponse{}
+func (x *ChangeJobNameResponse) Reset() {
+	*x = ChangeJobNameResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1462,13 +1501,13 @@ func (x *CheckJobSpecificationsResponse) Reset() {
 	}
 }
 
-func (x *CheckJobSpecificationsResponse) String() string {
+func (x *ChangeJobNameResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckJobSpecificationsResponse) ProtoMessage() {}
+func (*ChangeJobNameResponse) ProtoMessage() {}
 
-func (x *CheckJobSpecificationsResponse) ProtoReflect() protoreflect.Message {
+func (x *ChangeJobNameResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1480,51 +1519,32 @@ func (x *CheckJobSpecificationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckJobSpecificationsResponse.ProtoReflect.Descriptor instead.
-func (*CheckJobSpecificationsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChangeJobNameResponse.ProtoReflect.Descriptor instead.
+func (*ChangeJobNameResponse) Descriptor() ([]byte, []int) {
 	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *CheckJobSpecificationsResponse) GetLogStatus() *Log {
+func (x *ChangeJobNameResponse) GetJobName() string {
 	if x != nil {
-		return x.LogStatus
+		return x.JobName
 	}
-	return nil
+	return ""
 }
 
-type JobSpecification struct {
+type JobSpecVersion struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Version       int32  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
-	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Owner         string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
-	StartDate     string `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate       string `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"` // optional
-	Interval      string `protobuf:"bytes,6,opt,name=interval,proto3" json:"interval,omitempty"`
-	DependsOnPast bool   `protobuf:"varint,7,opt,name=depends_on_past,json=dependsOnPast,proto3" json:"depends_on_past,omitempty"` // should only execute today if yesterday was completed with success?
-	// Deprecated: Do not use.
-	CatchUp          bool                       `protobuf:"varint,8,opt,name=catch_up,json=catchUp,proto3" json:"catch_up,omitempty"` // should backfill till today?
-	TaskName         string                     `protobuf:"bytes,9,opt,name=task_name,json=taskName,proto3" json:"task_name,omitempty"`
-	Config           []*JobConfigItem           `protobuf:"bytes,10,rep,name=config,proto3" json:"config,omitempty"`
-	WindowSize       string                     `protobuf:"bytes,11,opt,name=window_size,json=windowSize,proto3" json:"window_size,omitempty"`
-	WindowOffset     string                     `protobuf:"bytes,12,opt,name=window_offset,json=windowOffset,proto3" json:"window_offset,omitempty"`
-	WindowTruncateTo string                     `protobuf:"bytes,13,opt,name=window_truncate_to,json=windowTruncateTo,proto3" json:"window_truncate_to,omitempty"`
-	WindowPreset     string                     `protobuf:"bytes,23,opt,name=window_preset,json=windowPreset,proto3" json:"window_preset,omitempty"`
-	Dependencies     []*JobDependency           `protobuf:"bytes,14,rep,name=dependencies,proto3" json:"dependencies,omitempty"` // static dependencies
-	Assets           map[string]string          `protobuf:"bytes,15,rep,name=assets,proto3" json:"assets,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Hooks            []*JobSpecHook             `protobuf:"bytes,16,rep,name=hooks,proto3" json:"hooks,omitempty"`             // optional
-	Description      string                     `protobuf:"bytes,17,opt,name=description,proto3" json:"description,omitempty"` // optional
-	Labels           map[string]string          `protobuf:"bytes,18,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Behavior         *JobSpecification_Behavior `protobuf:"bytes,19,opt,name=behavior,proto3" json:"behavior,omitempty"`
-	Metadata         *JobMetadata               `protobuf:"bytes,20,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Destination      string                     `protobuf:"bytes,21,opt,name=destination,proto3" json:"destination,omitempty"`
-	Sources          []string                   `protobuf:"bytes,22,rep,name=sources,proto3" json:"sources,omitempty"`
+	Version   int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Owner     string                 `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	TaskName  string                 `protobuf:"bytes,3,opt,name=task_name,json=taskName,proto3" json:"task_name,omitempty"`
+	Interval  string                 `protobuf:"bytes,4,opt,name=interval,proto3" json:"interval,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 }
 
-func (x *JobSpecification) Reset() {
-	*x = JobSpecification{}
+func (x *JobSpecVersion) Reset() {
+	*x = JobSpecVersion{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1532,13 +1552,13 @@ func (x *JobSpecification) Reset() {
 	}
 }
 
-func (x *JobSpecification) String() string {
+func (x *JobSpecVersion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JobSpecification) ProtoMessage() {}
+func (*JobSpecVersion) ProtoMessage() {}
 
-func (x *JobSpecification) ProtoReflect() protoreflect.Message {
+func (x *JobSpecVersion) ProtoReflect() protoreflect.Message {
 	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1550,200 +1570,126 @@ func (x *JobSpecification) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JobSpecification.ProtoReflect.Descriptor instead.
-func (*JobSpecification) Descriptor() ([]byte, []int) {
+// Deprecated: Use JobSpecVersion.ProtoReflect.Descriptor instead.
+func (*JobSpecVersion) Descriptor() ([]byte, []int) {
 	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *JobSpecification) GetVersion() int32 {
+func (x *JobSpecVersion) GetVersion() int32 {
 	if x != nil {
 		return x.Version
 	}
 	return 0
 }
 
-func (x *JobSpecification) GetName() string {
+func (x *JobSpecVersion) GetOwner() string {
 	if x != nil {
-		return x.Name
+		return x.Owner
 	}
 	return ""
 }
 
-func (x *JobSpecification) GetOwner() string {
+func (x *JobSpecVersion) GetTaskName() string {
 	if x != nil {
-		return x.Owner
+		return x.TaskName
 	}
 	return ""
 }
 
-func (x *JobSpecification) GetStartDate() string {
+func (x *JobSpecVersion) GetInterval() string {
 	if x != nil {
-		return x.StartDate
+		return x.Interval
 	}
 	return ""
 }
 
-func (x *JobSpecification) GetEndDate() string {
+func (x *JobSpecVersion) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.EndDate
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *JobSpecification) GetInterval() string {
-	if x != nil {
-		return x.Interval
-	}
-	return ""
-}
-
-func (x *JobSpecification) GetDependsOnPast() bool {
-	if x != nil {
-		return x.DependsOnPast
-	}
-	return false
-}
-
-// Deprecated: Do not use.
-func (x *JobSpecification) GetCatchUp() bool {
-	if x != nil {
-		return x.CatchUp
-	}
-	return false
-}
-
-func (x *JobSpecification) GetTaskName() string {
-	if x != nil {
-		return x.TaskName
-	}
-	return ""
-}
-
-func (x *JobSpecification) GetConfig() []*JobConfigItem {
-	if x != nil {
-		return x.Config
-	}
-	return nil
-}
-
-func (x *JobSpecification) GetWindowSize() string {
-	if x != nil {
-		return x.WindowSize
-	}
-	return ""
-}
+type GetJobSpecVersionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *JobSpecification) GetWindowOffset() string {
-	if x != nil {
-		return x.WindowOffset
-	}
-	return ""
+	ProjectName string `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	JobName     string `protobuf:"bytes,2,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
 }
 
-func (x *JobSpecification) GetWindowTruncateTo() string {
-	if x != nil {
-		return x.WindowTruncateTo
+func (x *GetJobSpecVersionsRequest) Reset() {
+	*x = GetJobSpecVersionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *JobSpecification) GetWindowPreset() string {
-	if x != nil {
-		return x.WindowPreset
-	}
-	return ""
+func (x *GetJobSpecVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *JobSpecification) GetDependencies() []*JobDependency {
-	if x != nil {
-		return x.Dependencies
-	}
-	return nil
-}
+func (*GetJobSpecVersionsRequest) ProtoMessage() {}
 
-func (x *JobSpecification) GetAssets() map[string]string {
-	if x != nil {
-		return x.Assets
+func (x *GetJobSpecVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *JobSpecification) GetHooks() []*JobSpecHook {
-	if x != nil {
-		return x.Hooks
-	}
-	return nil
+// Deprecated: Use GetJobSpecVersionsRequest.ProtoReflect.Descriptor instead.
+func (*GetJobSpecVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *JobSpecification) GetDescription() string {
+func (x *GetJobSpecVersionsRequest) GetProjectName() string {
 	if x != nil {
-		return x.Description
+		return x.ProjectName
 	}
 	return ""
 }
 
-func (x *JobSpecification) GetLabels() map[string]string {
-	if x != nil {
-		return x.Labels
-	}
-	return nil
-}
-
-func (x *JobSpecification) GetBehavior() *JobSpecification_Behavior {
-	if x != nil {
-		return x.Behavior
-	}
-	return nil
-}
-
-func (x *JobSpecification) GetMetadata() *JobMetadata {
+func (x *GetJobSpecVersionsRequest) GetJobName() string {
 	if x != nil {
-		return x.Metadata
-	}
-	return nil
-}
-
-func (x *JobSpecification) GetDestination() string {
-	if x != nil {
-		return x.Destination
+		return x.JobName
 	}
 	return ""
 }
 
-func (x *JobSpecification) GetSources() []string {
-	if x != nil {
-		return x.Sources
-	}
-	return nil
-}
-
-type JobDependency struct {
+type GetJobSpecVersionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name           string          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Type           string          `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`                                           // intra/inter/extra
-	HttpDependency *HttpDependency `protobuf:"bytes,3,opt,name=http_dependency,json=httpDependency,proto3" json:"http_dependency,omitempty"` // http sensor dependency
+	Versions []*JobSpecVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
 }
 
-func (x *JobDependency) Reset() {
-	*x = JobDependency{}
+func (x *GetJobSpecVersionsResponse) Reset() {
+	*x = GetJobSpecVersionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[24]
+		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *JobDependency) String() string {
+func (x *GetJobSpecVersionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JobDependency) ProtoMessage() {}
+func (*GetJobSpecVersionsResponse) ProtoMessage() {}
 
-func (x *JobDependency) ProtoReflect() protoreflect.Message {
-	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[24]
+func (x *GetJobSpecVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1754,60 +1700,49 @@ func (x *JobDependency) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JobDependency.ProtoReflect.Descriptor instead.
-func (*JobDependency) Descriptor() ([]byte, []int) {
-	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{24}
-}
-
-func (x *JobDependency) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *JobDependency) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
+// Deprecated: Use GetJobSpecVersionsResponse.ProtoReflect.Descriptor instead.
+func (*GetJobSpecVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *JobDependency) GetHttpDependency() *HttpDependency {
+func (x *GetJobSpecVersionsResponse) GetVersions() []*JobSpecVersion {
 	if x != nil {
-		return x.HttpDependency
+		return x.Versions
 	}
 	return nil
 }
 
-type HttpDependency struct {
+type AddJobDeletionConsentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name    string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Url     string            `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
-	Headers map[string]string `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Params  map[string]string `protobuf:"bytes,4,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// project_name and job_name are the downstream job consenting, the caller is recorded as giving the consent
+	ProjectName string `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	JobName     string `protobuf:"bytes,2,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	// upstream_project_name and upstream_job_name are the job allowed to be deleted
+	UpstreamProjectName string `protobuf:"bytes,3,opt,name=upstream_project_name,json=upstreamProjectName,proto3" json:"upstream_project_name,omitempty"`
+	UpstreamJobName     string `protobuf:"bytes,4,opt,name=upstream_job_name,json=upstreamJobName,proto3" json:"upstream_job_name,omitempty"`
+	Reason              string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *HttpDependency) Reset() {
-	*x = HttpDependency{}
+func (x *AddJobDeletionConsentRequest) Reset() {
+	*x = AddJobDeletionConsentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[25]
+		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HttpDependency) String() string {
+func (x *AddJobDeletionConsentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HttpDependency) ProtoMessage() {}
+func (*AddJobDeletionConsentRequest) ProtoMessage() {}
 
-func (x *HttpDependency) ProtoReflect() protoreflect.Message {
-	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[25]
+func (x *AddJobDeletionConsentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1818,65 +1753,69 @@ func (x *HttpDependency) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HttpDependency.ProtoReflect.Descriptor instead.
-func (*HttpDependency) Descriptor() ([]byte, []int) {
-	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use AddJobDeletionConsentRequest.ProtoReflect.Descriptor instead.
+func (*AddJobDeletionConsentRequest) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *HttpDependency) GetName() string {
+func (x *AddJobDeletionConsentRequest) GetProjectName() string {
 	if x != nil {
-		return x.Name
+		return x.ProjectName
 	}
 	return ""
 }
 
-func (x *HttpDependency) GetUrl() string {
+func (x *AddJobDeletionConsentRequest) GetJobName() string {
 	if x != nil {
-		return x.Url
+		return x.JobName
 	}
 	return ""
 }
 
-func (x *HttpDependency) GetHeaders() map[string]string {
+func (x *AddJobDeletionConsentRequest) GetUpstreamProjectName() string {
 	if x != nil {
-		return x.Headers
+		return x.UpstreamProjectName
 	}
-	return nil
+	return ""
 }
 
-func (x *HttpDependency) GetParams() map[string]string {
+func (x *AddJobDeletionConsentRequest) GetUpstreamJobName() string {
 	if x != nil {
-		return x.Params
+		return x.UpstreamJobName
 	}
-	return nil
+	return ""
 }
 
-type JobSpecHook struct {
+func (x *AddJobDeletionConsentRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AddJobDeletionConsentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Name   string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Config []*JobConfigItem `protobuf:"bytes,2,rep,name=config,proto3" json:"config,omitempty"`
 }
 
-func (x *JobSpecHook) Reset() {
-	*x = JobSpecHook{}
+func (x *AddJobDeletionConsentResponse) Reset() {
+	*x = AddJobDeletionConsentResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[26]
+		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *JobSpecHook) String() string {
+func (x *AddJobDeletionConsentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JobSpecHook) ProtoMessage() {}
+func (*AddJobDeletionConsentResponse) ProtoMessage() {}
 
-func (x *JobSpecHook) ProtoReflect() protoreflect.Message {
-	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[26]
+func (x *AddJobDeletionConsentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1887,51 +1826,37 @@ func (x *JobSpecHook) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JobSpecHook.ProtoReflect.Descriptor instead.
-func (*JobSpecHook) Descriptor() ([]byte, []int) {
-	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{26}
-}
-
-func (x *JobSpecHook) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *JobSpecHook) GetConfig() []*JobConfigItem {
-	if x != nil {
-		return x.Config
-	}
-	return nil
+// Deprecated: Use AddJobDeletionConsentResponse.ProtoReflect.Descriptor instead.
+func (*AddJobDeletionConsentResponse) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{27}
 }
 
-type JobConfigItem struct {
+type GetJobDeletionConsentsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	ProjectName string `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	JobName     string `protobuf:"bytes,2,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
 }
 
-func (x *JobConfigItem) Reset() {
-	*x = JobConfigItem{}
+func (x *GetJobDeletionConsentsRequest) Reset() {
+	*x = GetJobDeletionConsentsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[27]
+		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *JobConfigItem) String() string {
+func (x *GetJobDeletionConsentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JobConfigItem) ProtoMessage() {}
+func (*GetJobDeletionConsentsRequest) ProtoMessage() {}
 
-func (x *JobConfigItem) ProtoReflect() protoreflect.Message {
-	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[27]
+func (x *GetJobDeletionConsentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1942,51 +1867,51 @@ func (x *JobConfigItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JobConfigItem.ProtoReflect.Descriptor instead.
-func (*JobConfigItem) Descriptor() ([]byte, []int) {
-	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use GetJobDeletionConsentsRequest.ProtoReflect.Descriptor instead.
+func (*GetJobDeletionConsentsRequest) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *JobConfigItem) GetName() string {
+func (x *GetJobDeletionConsentsRequest) GetProjectName() string {
 	if x != nil {
-		return x.Name
+		return x.ProjectName
 	}
 	return ""
 }
 
-func (x *JobConfigItem) GetValue() string {
+func (x *GetJobDeletionConsentsRequest) GetJobName() string {
 	if x != nil {
-		return x.Value
+		return x.JobName
 	}
 	return ""
 }
 
-type JobEvent struct {
+type GetJobDeletionConsentsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type  JobEvent_Type    `protobuf:"varint,1,opt,name=type,proto3,enum=gotocompany.optimus.core.v1beta1.JobEvent_Type" json:"type,omitempty"`
-	Value *structpb.Struct `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Consents []*GetJobDeletionConsentsResponse_Consent `protobuf:"bytes,1,rep,name=consents,proto3" json:"consents,omitempty"`
+	Audits   []*GetJobDeletionConsentsResponse_Audit   `protobuf:"bytes,2,rep,name=audits,proto3" json:"audits,omitempty"`
 }
 
-func (x *JobEvent) Reset() {
-	*x = JobEvent{}
+func (x *GetJobDeletionConsentsResponse) Reset() {
+	*x = GetJobDeletionConsentsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[28]
+		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *JobEvent) String() string {
+func (x *GetJobDeletionConsentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JobEvent) ProtoMessage() {}
+func (*GetJobDeletionConsentsResponse) ProtoMessage() {}
 
-func (x *JobEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[28]
+func (x *GetJobDeletionConsentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1997,51 +1922,53 @@ func (x *JobEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JobEvent.ProtoReflect.Descriptor instead.
-func (*JobEvent) Descriptor() ([]byte, []int) {
-	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use GetJobDeletionConsentsResponse.ProtoReflect.Descriptor instead.
+func (*GetJobDeletionConsentsResponse) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *JobEvent) GetType() JobEvent_Type {
+func (x *GetJobDeletionConsentsResponse) GetConsents() []*GetJobDeletionConsentsResponse_Consent {
 	if x != nil {
-		return x.Type
+		return x.Consents
 	}
-	return JobEvent_TYPE_UNSPECIFIED
+	return nil
 }
 
-func (x *JobEvent) GetValue() *structpb.Struct {
+func (x *GetJobDeletionConsentsResponse) GetAudits() []*GetJobDeletionConsentsResponse_Audit {
 	if x != nil {
-		return x.Value
+		return x.Audits
 	}
 	return nil
 }
 
-type JobMetadata struct {
+type RollbackJobRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Resource *JobSpecMetadataResource `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
-	Airflow  *JobSpecMetadataAirflow  `protobuf:"bytes,2,opt,name=airflow,proto3" json:"airflow,omitempty"`
+	ProjectName   string `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	NamespaceName string `protobuf:"bytes,2,opt,name=namespace_name,json=namespaceName,proto3" json:"namespace_name,omitempty"`
+	JobName       string `protobuf:"bytes,3,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	Version       int32  `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (x *JobMetadata) Reset() {
-	*x = JobMetadata{}
+func (x *RollbackJobRequest) Reset() {
+	*x = RollbackJobRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[29]
+		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *JobMetadata) String() string {
+func (x *RollbackJobRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JobMetadata) ProtoMessage() {}
+func (*RollbackJobRequest) ProtoMessage() {}
 
-func (x *JobMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[29]
+func (x *RollbackJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2052,91 +1979,50 @@ func (x *JobMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JobMetadata.ProtoReflect.Descriptor instead.
-func (*JobMetadata) Descriptor() ([]byte, []int) {
-	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use RollbackJobRequest.ProtoReflect.Descriptor instead.
+func (*RollbackJobRequest) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *JobMetadata) GetResource() *JobSpecMetadataResource {
+func (x *RollbackJobRequest) GetProjectName() string {
 	if x != nil {
-		return x.Resource
+		return x.ProjectName
 	}
-	return nil
+	return ""
 }
 
-func (x *JobMetadata) GetAirflow() *JobSpecMetadataAirflow {
+func (x *RollbackJobRequest) GetNamespaceName() string {
 	if x != nil {
-		return x.Airflow
-	}
-	return nil
-}
-
-type JobSpecMetadataResource struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Request *JobSpecMetadataResourceConfig `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
-	Limit   *JobSpecMetadataResourceConfig `protobuf:"bytes,2,opt,name=limit,proto3" json:"limit,omitempty"`
-}
-
-func (x *JobSpecMetadataResource) Reset() {
-	*x = JobSpecMetadataResource{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[30]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *JobSpecMetadataResource) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*JobSpecMetadataResource) ProtoMessage() {}
-
-func (x *JobSpecMetadataResource) ProtoReflect() protoreflect.Message {
-	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[30]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.NamespaceName
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use JobSpecMetadataResource.ProtoReflect.Descriptor instead.
-func (*JobSpecMetadataResource) Descriptor() ([]byte, []int) {
-	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{30}
+	return ""
 }
 
-func (x *JobSpecMetadataResource) GetRequest() *JobSpecMetadataResourceConfig {
+func (x *RollbackJobRequest) GetJobName() string {
 	if x != nil {
-		return x.Request
+		return x.JobName
 	}
-	return nil
+	return ""
 }
 
-func (x *JobSpecMetadataResource) GetLimit() *JobSpecMetadataResourceConfig {
+func (x *RollbackJobRequest) GetVersion() int32 {
 	if x != nil {
-		return x.Limit
+		return x.Version
 	}
-	return nil
+	return 0
 }
 
-type JobSpecMetadataResourceConfig struct {
+type RollbackJobResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Cpu    string `protobuf:"bytes,1,opt,name=cpu,proto3" json:"cpu,omitempty"`
-	Memory string `protobuf:"bytes,2,opt,name=memory,proto3" json:"memory,omitempty"`
+	JobName string `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	Version int32  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (x *JobSpecMetadataResourceConfig) Reset() {
-	*x = JobSpecMetadataResourceConfig{}
+func (x *RollbackJobResponse) Reset() {
+	*x = RollbackJobResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2144,13 +2030,13 @@ func (x *JobSpecMetadataResourceConfig) Reset() {
 	}
 }
 
-func (x *JobSpecMetadataResourceConfig) String() string {
+func (x *RollbackJobResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JobSpecMetadataResourceConfig) ProtoMessage() {}
+func (*RollbackJobResponse) ProtoMessage() {}
 
-func (x *JobSpecMetadataResourceConfig) ProtoReflect() protoreflect.Message {
+func (x *RollbackJobResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2162,36 +2048,37 @@ func (x *JobSpecMetadataResourceConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JobSpecMetadataResourceConfig.ProtoReflect.Descriptor instead.
-func (*JobSpecMetadataResourceConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use RollbackJobResponse.ProtoReflect.Descriptor instead.
+func (*RollbackJobResponse) Descriptor() ([]byte, []int) {
 	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *JobSpecMetadataResourceConfig) GetCpu() string {
+func (x *RollbackJobResponse) GetJobName() string {
 	if x != nil {
-		return x.Cpu
+		return x.JobName
 	}
 	return ""
 }
 
-func (x *JobSpecMetadataResourceConfig) GetMemory() string {
+func (x *RollbackJobResponse) GetVersion() int32 {
 	if x != nil {
-		return x.Memory
+		return x.Version
 	}
-	return ""
+	return 0
 }
 
-type JobSpecMetadataAirflow struct {
+type ValidateJobSpecsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pool  string `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
-	Queue string `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
+	ProjectName   string              `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	NamespaceName string              `protobuf:"bytes,2,opt,name=namespace_name,json=namespaceName,proto3" json:"namespace_name,omitempty"`
+	Jobs          []*JobSpecification `protobuf:"bytes,3,rep,name=jobs,proto3" json:"jobs,omitempty"`
 }
 
-func (x *JobSpecMetadataAirflow) Reset() {
-	*x = JobSpecMetadataAirflow{}
+func (x *ValidateJobSpecsRequest) Reset() {
+	*x = ValidateJobSpecsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2199,13 +2086,13 @@ func (x *JobSpecMetadataAirflow) Reset() {
 	}
 }
 
-func (x *JobSpecMetadataAirflow) String() string {
+func (x *ValidateJobSpecsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JobSpecMetadataAirflow) ProtoMessage() {}
+func (*ValidateJobSpecsRequest) ProtoMessage() {}
 
-func (x *JobSpecMetadataAirflow) ProtoReflect() protoreflect.Message {
+func (x *ValidateJobSpecsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2217,37 +2104,43 @@ func (x *JobSpecMetadataAirflow) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JobSpecMetadataAirflow.ProtoReflect.Descriptor instead.
-func (*JobSpecMetadataAirflow) Descriptor() ([]byte, []int) {
+// Deprecated: Use ValidateJobSpecsRequest.ProtoReflect.Descriptor instead.
+func (*ValidateJobSpecsRequest) Descriptor() ([]byte, []int) {
 	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *JobSpecMetadataAirflow) GetPool() string {
+func (x *ValidateJobSpecsRequest) GetProjectName() string {
 	if x != nil {
-		return x.Pool
+		return x.ProjectName
 	}
 	return ""
 }
 
-func (x *JobSpecMetadataAirflow) GetQueue() string {
+func (x *ValidateJobSpecsRequest) GetNamespaceName() string {
 	if x != nil {
-		return x.Queue
+		return x.NamespaceName
 	}
 	return ""
 }
 
-type RefreshJobsRequest struct {
+func (x *ValidateJobSpecsRequest) GetJobs() []*JobSpecification {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+type ValidateJobSpecsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProjectName    string   `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
-	NamespaceNames []string `protobuf:"bytes,2,rep,name=namespace_names,json=namespaceNames,proto3" json:"namespace_names,omitempty"`
-	JobNames       []string `protobuf:"bytes,3,rep,name=job_names,json=jobNames,proto3" json:"job_names,omitempty"`
+	Valid   bool                               `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Results []*ValidateJobSpecsResponse_Result `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
 }
 
-func (x *RefreshJobsRequest) Reset() {
-	*x = RefreshJobsRequest{}
+func (x *ValidateJobSpecsResponse) Reset() {
+	*x = ValidateJobSpecsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2255,13 +2148,13 @@ func (x *RefreshJobsRequest) Reset() {
 	}
 }
 
-func (x *RefreshJobsRequest) String() string {
+func (x *ValidateJobSpecsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefreshJobsRequest) ProtoMessage() {}
+func (*ValidateJobSpecsResponse) ProtoMessage() {}
 
-func (x *RefreshJobsRequest) ProtoReflect() protoreflect.Message {
+func (x *ValidateJobSpecsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2273,42 +2166,36 @@ func (x *RefreshJobsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefreshJobsRequest.ProtoReflect.Descriptor instead.
-func (*RefreshJobsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ValidateJobSpecsResponse.ProtoReflect.Descriptor instead.
+func (*ValidateJobSpecsResponse) Descriptor() ([]byte, []int) {
 	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *RefreshJobsRequest) GetProjectName() string {
-	if x != nil {
-		return x.ProjectName
-	}
-	return ""
-}
-
-func (x *RefreshJobsRequest) GetNamespaceNames() []string {
+func (x *ValidateJobSpecsResponse) GetValid() bool {
 	if x != nil {
-		return x.NamespaceNames
+		return x.Valid
 	}
-	return nil
+	return false
 }
 
-func (x *RefreshJobsRequest) GetJobNames() []string {
+func (x *ValidateJobSpecsResponse) GetResults() []*ValidateJobSpecsResponse_Result {
 	if x != nil {
-		return x.JobNames
+		return x.Results
 	}
 	return nil
 }
 
-type RefreshJobsResponse struct {
+type ListJobSpecificationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LogStatus *Log `protobuf:"bytes,6,opt,name=log_status,json=logStatus,proto3" json:"log_status,omitempty"`
+	ProjectName   string `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	NamespaceName string `protobuf:"bytes,2,opt,name=namespace_name,json=namespaceName,proto3" json:"namespace_name,omitempty"`
 }
 
-func (x *RefreshJobsResponse) Reset() {
-	*x = RefreshJobsResponse{}
+func (x *ListJobSpecificationRequest) Reset() {
+	*x = ListJobSpecificationRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2316,13 +2203,13 @@ func (x *RefreshJobsResponse) Reset() {
 	}
 }
 
-func (x *RefreshJobsResponse) String() string {
+func (x *ListJobSpecificationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefreshJobsResponse) ProtoMessage() {}
+func (*ListJobSpecificationRequest) ProtoMessage() {}
 
-func (x *RefreshJobsResponse) ProtoReflect() protoreflect.Message {
+func (x *ListJobSpecificationRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2334,28 +2221,35 @@ func (x *RefreshJobsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefreshJobsResponse.ProtoReflect.Descriptor instead.
-func (*RefreshJobsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListJobSpecificationRequest.ProtoReflect.Descriptor instead.
+func (*ListJobSpecificationRequest) Descriptor() ([]byte, []int) {
 	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *RefreshJobsResponse) GetLogStatus() *Log {
+func (x *ListJobSpecificationRequest) GetProjectName() string {
 	if x != nil {
-		return x.LogStatus
+		return x.ProjectName
 	}
-	return nil
+	return ""
 }
 
-type GetDeployJobsStatusRequest struct {
+func (x *ListJobSpecificationRequest) GetNamespaceName() string {
+	if x != nil {
+		return x.NamespaceName
+	}
+	return ""
+}
+
+type ListJobSpecificationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DeployId string `protobuf:"bytes,1,opt,name=deploy_id,json=deployId,proto3" json:"deploy_id,omitempty"`
+	Jobs []*JobSpecification `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
 }
 
-func (x *GetDeployJobsStatusRequest) Reset() {
-	*x = GetDeployJobsStatusRequest{}
+func (x *ListJobSpecificationResponse) Reset() {
+	*x = ListJobSpecificationResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2363,13 +2257,13 @@ func (x *GetDeployJobsStatusRequest) Reset() {
 	}
 }
 
-func (x *GetDeployJobsStatusRequest) String() string {
+func (x *ListJobSpecificationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDeployJobsStatusRequest) ProtoMessage() {}
+func (*ListJobSpecificationResponse) ProtoMessage() {}
 
-func (x *GetDeployJobsStatusRequest) ProtoReflect() protoreflect.Message {
+func (x *ListJobSpecificationResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2381,32 +2275,30 @@ func (x *GetDeployJobsStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDeployJobsStatusRequest.ProtoReflect.Descriptor instead.
-func (*GetDeployJobsStatusRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListJobSpecificationResponse.ProtoReflect.Descriptor instead.
+func (*ListJobSpecificationResponse) Descriptor() ([]byte, []int) {
 	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *GetDeployJobsStatusRequest) GetDeployId() string {
+func (x *ListJobSpecificationResponse) GetJobs() []*JobSpecification {
 	if x != nil {
-		return x.DeployId
+		return x.Jobs
 	}
-	return ""
+	return nil
 }
 
-type GetDeployJobsStatusResponse struct {
+type CheckJobSpecificationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status              string              `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Failures            []*DeployJobFailure `protobuf:"bytes,2,rep,name=failures,proto3" json:"failures,omitempty"`
-	SuccessCount        int32               `protobuf:"varint,3,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
-	FailureCount        int32               `protobuf:"varint,4,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
-	UnknownDependencies map[string]string   `protobuf:"bytes,5,rep,name=unknown_dependencies,json=unknownDependencies,proto3" json:"unknown_dependencies,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ProjectName   string            `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	Job           *JobSpecification `protobuf:"bytes,2,opt,name=job,proto3" json:"job,omitempty"`
+	NamespaceName string            `protobuf:"bytes,3,opt,name=namespace_name,json=namespaceName,proto3" json:"namespace_name,omitempty"`
 }
 
-func (x *GetDeployJobsStatusResponse) Reset() {
-	*x = GetDeployJobsStatusResponse{}
+func (x *CheckJobSpecificationRequest) Reset() {
+	*x = CheckJobSpecificationRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2414,13 +2306,13 @@ func (x *GetDeployJobsStatusResponse) Reset() {
 	}
 }
 
-func (x *GetDeployJobsStatusResponse) String() string {
+func (x *CheckJobSpecificationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDeployJobsStatusResponse) ProtoMessage() {}
+func (*CheckJobSpecificationRequest) ProtoMessage() {}
 
-func (x *GetDeployJobsStatusResponse) ProtoReflect() protoreflect.Message {
+func (x *CheckJobSpecificationRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2432,57 +2324,42 @@ func (x *GetDeployJobsStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDeployJobsStatusResponse.ProtoReflect.Descriptor instead.
-func (*GetDeployJobsStatusResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CheckJobSpecificationRequest.ProtoReflect.Descriptor instead.
+func (*CheckJobSpecificationRequest) Descriptor() ([]byte, []int) {
 	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *GetDeployJobsStatusResponse) GetStatus() string {
+func (x *CheckJobSpecificationRequest) GetProjectName() string {
 	if x != nil {
-		return x.Status
+		return x.ProjectName
 	}
 	return ""
 }
 
-func (x *GetDeployJobsStatusResponse) GetFailures() []*DeployJobFailure {
+func (x *CheckJobSpecificationRequest) GetJob() *JobSpecification {
 	if x != nil {
-		return x.Failures
+		return x.Job
 	}
 	return nil
 }
 
-func (x *GetDeployJobsStatusResponse) GetSuccessCount() int32 {
+func (x *CheckJobSpecificationRequest) GetNamespaceName() string {
 	if x != nil {
-		return x.SuccessCount
+		return x.NamespaceName
 	}
-	return 0
+	return ""
 }
 
-func (x *GetDeployJobsStatusResponse) GetFailureCount() int32 {
-	if x != nil {
-		return x.FailureCount
-	}
-	return 0
-}
-
-func (x *GetDeployJobsStatusResponse) GetUnknownDependencies() map[string]string {
-	if x != nil {
-		return x.UnknownDependencies
-	}
-	return nil
-}
-
-type DeployJobFailure struct {
+type CheckJobSpecificationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	JobName string `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 }
 
-func (x *DeployJobFailure) Reset() {
-	*x = DeployJobFailure{}
+func (x *CheckJobSpecificationResponse) Reset() {
+	*x = CheckJobSpecificationResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2490,13 +2367,13 @@ func (x *DeployJobFailure) Reset() {
 	}
 }
 
-func (x *DeployJobFailure) String() string {
+func (x *CheckJobSpecificationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeployJobFailure) ProtoMessage() {}
+func (*CheckJobSpecificationResponse) ProtoMessage() {}
 
-func (x *DeployJobFailure) ProtoReflect() protoreflect.Message {
+func (x *CheckJobSpecificationResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2508,38 +2385,30 @@ func (x *DeployJobFailure) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeployJobFailure.ProtoReflect.Descriptor instead.
-func (*DeployJobFailure) Descriptor() ([]byte, []int) {
+// Deprecated: Use CheckJobSpecificationResponse.ProtoReflect.Descriptor instead.
+func (*CheckJobSpecificationResponse) Descriptor() ([]byte, []int) {
 	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *DeployJobFailure) GetJobName() string {
-	if x != nil {
-		return x.JobName
-	}
-	return ""
-}
-
-func (x *DeployJobFailure) GetMessage() string {
+func (x *CheckJobSpecificationResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Message
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-type GetJobSpecificationsRequest struct {
+type CheckJobSpecificationsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProjectName         string `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
-	ResourceDestination string `protobuf:"bytes,2,opt,name=resource_destination,json=resourceDestination,proto3" json:"resource_destination,omitempty"`
-	JobName             string `protobuf:"bytes,3,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
-	NamespaceName       string `protobuf:"bytes,4,opt,name=namespace_name,json=namespaceName,proto3" json:"namespace_name,omitempty"`
+	ProjectName   string              `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	Jobs          []*JobSpecification `protobuf:"bytes,2,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	NamespaceName string              `protobuf:"bytes,3,opt,name=namespace_name,json=namespaceName,proto3" json:"namespace_name,omitempty"`
 }
 
-func (x *GetJobSpecificationsRequest) Reset() {
-	*x = GetJobSpecificationsRequest{}
+func (x *CheckJobSpecificationsRequest) Reset() {
+	*x = CheckJobSpecificationsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2547,13 +2416,13 @@ func (x *GetJobSpecificationsRequest) Reset() {
 	}
 }
 
-func (x *GetJobSpecificationsRequest) String() string {
+func (x *CheckJobSpecificationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetJobSpecificationsRequest) ProtoMessage() {}
+func (*CheckJobSpecificationsRequest) ProtoMessage() {}
 
-func (x *GetJobSpecificationsRequest) ProtoReflect() protoreflect.Message {
+func (x *CheckJobSpecificationsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2565,51 +2434,42 @@ func (x *GetJobSpecificationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetJobSpecificationsRequest.ProtoReflect.Descriptor instead.
-func (*GetJobSpecificationsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CheckJobSpecificationsRequest.ProtoReflect.Descriptor instead.
+func (*CheckJobSpecificationsRequest) Descriptor() ([]byte, []int) {
 	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *GetJobSpecificationsRequest) GetProjectName() string {
+func (x *CheckJobSpecificationsRequest) GetProjectName() string {
 	if x != nil {
 		return x.ProjectName
 	}
 	return ""
 }
 
-func (x *GetJobSpecificationsRequest) GetResourceDestination() string {
-	if x != nil {
-		return x.ResourceDestination
-	}
-	return ""
-}
-
-func (x *GetJobSpecificationsRequest) GetJobName() string {
+func (x *CheckJobSpecificationsRequest) GetJobs() []*JobSpecification {
 	if x != nil {
-		return x.JobName
+		return x.Jobs
 	}
-	return ""
+	return nil
 }
 
-func (x *GetJobSpecificationsRequest) GetNamespaceName() string {
+func (x *CheckJobSpecificationsRequest) GetNamespaceName() string {
 	if x != nil {
 		return x.NamespaceName
 	}
 	return ""
 }
 
-type GetJobSpecificationsResponse struct {
+type CheckJobSpecificationsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Deprecated: Do not use.
-	Jobs                      []*JobSpecification         `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
-	JobSpecificationResponses []*JobSpecificationResponse `protobuf:"bytes,2,rep,name=job_specification_responses,json=jobSpecificationResponses,proto3" json:"job_specification_responses,omitempty"`
+	LogStatus *Log `protobuf:"bytes,5,opt,name=log_status,json=logStatus,proto3" json:"log_status,omitempty"`
 }
 
-func (x *GetJobSpecificationsResponse) Reset() {
-	*x = GetJobSpecificationsResponse{}
+func (x *CheckJobSpecificationsResponse) Reset() {
+	*x = CheckJobSpecificationsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2617,13 +2477,13 @@ func (x *GetJobSpecificationsResponse) Reset() {
 	}
 }
 
-func (x *GetJobSpecificationsResponse) String() string {
+func (x *CheckJobSpecificationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetJobSpecificationsResponse) ProtoMessage() {}
+func (*CheckJobSpecificationsResponse) ProtoMessage() {}
 
-func (x *GetJobSpecificationsResponse) ProtoReflect() protoreflect.Message {
+func (x *CheckJobSpecificationsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2635,38 +2495,51 @@ func (x *GetJobSpecificationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetJobSpecificationsResponse.ProtoReflect.Descriptor instead.
-func (*GetJobSpecificationsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CheckJobSpecificationsResponse.ProtoReflect.Descriptor instead.
+func (*CheckJobSpecificationsResponse) Descriptor() ([]byte, []int) {
 	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{39}
 }
 
-// Deprecated: Do not use.
-func (x *GetJobSpecificationsResponse) GetJobs() []*JobSpecification {
-	if x != nil {
-		return x.Jobs
-	}
-	return nil
-}
-
-func (x *GetJobSpecificationsResponse) GetJobSpecificationResponses() []*JobSpecificationResponse {
+func (x *CheckJobSpecificationsResponse) GetLogStatus() *Log {
 	if x != nil {
-		return x.JobSpecificationResponses
+		return x.LogStatus
 	}
 	return nil
 }
 
-type JobSpecificationResponse struct {
+type JobSpecification struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProjectName   string            `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
-	NamespaceName string            `protobuf:"bytes,2,opt,name=namespace_name,json=namespaceName,proto3" json:"namespace_name,omitempty"`
-	Job           *JobSpecification `protobuf:"bytes,3,opt,name=job,proto3" json:"job,omitempty"`
+	Version       int32  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Owner         string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	StartDate     string `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       string `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"` // optional
+	Interval      string `protobuf:"bytes,6,opt,name=interval,proto3" json:"interval,omitempty"`
+	DependsOnPast bool   `protobuf:"varint,7,opt,name=depends_on_past,json=dependsOnPast,proto3" json:"depends_on_past,omitempty"` // should only execute today if yesterday was completed with success?
+	// Deprecated: Do not use.
+	CatchUp          bool                       `protobuf:"varint,8,opt,name=catch_up,json=catchUp,proto3" json:"catch_up,omitempty"` // should backfill till today?
+	TaskName         string                     `protobuf:"bytes,9,opt,name=task_name,json=taskName,proto3" json:"task_name,omitempty"`
+	Config           []*JobConfigItem           `protobuf:"bytes,10,rep,name=config,proto3" json:"config,omitempty"`
+	WindowSize       string                     `protobuf:"bytes,11,opt,name=window_size,json=windowSize,proto3" json:"window_size,omitempty"`
+	WindowOffset     string                     `protobuf:"bytes,12,opt,name=window_offset,json=windowOffset,proto3" json:"window_offset,omitempty"`
+	WindowTruncateTo string                     `protobuf:"bytes,13,opt,name=window_truncate_to,json=windowTruncateTo,proto3" json:"window_truncate_to,omitempty"`
+	WindowPreset     string                     `protobuf:"bytes,23,opt,name=window_preset,json=windowPreset,proto3" json:"window_preset,omitempty"`
+	Dependencies     []*JobDependency           `protobuf:"bytes,14,rep,name=dependencies,proto3" json:"dependencies,omitempty"` // static dependencies
+	Assets           map[string]string          `protobuf:"bytes,15,rep,name=assets,proto3" json:"assets,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Hooks            []*JobSpecHook             `protobuf:"bytes,16,rep,name=hooks,proto3" json:"hooks,omitempty"`             // optional
+	Description      string                     `protobuf:"bytes,17,opt,name=description,proto3" json:"description,omitempty"` // optional
+	Labels           map[string]string          `protobuf:"bytes,18,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Behavior         *JobSpecification_Behavior `protobuf:"bytes,19,opt,name=behavior,proto3" json:"behavior,omitempty"`
+	Metadata         *JobMetadata               `protobuf:"bytes,20,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Destination      string                     `protobuf:"bytes,21,opt,name=destination,proto3" json:"destination,omitempty"`
+	Sources          []string                   `protobuf:"bytes,22,rep,name=sources,proto3" json:"sources,omitempty"`
 }
 
-func (x *JobSpecificationResponse) Reset() {
-	*x = JobSpecificationResponse{}
+func (x *JobSpecification) Reset() {
+	*x = JobSpecification{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2674,13 +2547,13 @@ func (x *JobSpecificationResponse) Reset() {
 	}
 }
 
-func (x *JobSpecificationResponse) String() string {
+func (x *JobSpecification) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JobSpecificationResponse) ProtoMessage() {}
+func (*JobSpecification) ProtoMessage() {}
 
-func (x *JobSpecificationResponse) ProtoReflect() protoreflect.Message {
+func (x *JobSpecification) ProtoReflect() protoreflect.Message {
 	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2692,169 +2565,200 @@ func (x *JobSpecificationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JobSpecificationResponse.ProtoReflect.Descriptor instead.
-func (*JobSpecificationResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use JobSpecification.ProtoReflect.Descriptor instead.
+func (*JobSpecification) Descriptor() ([]byte, []int) {
 	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *JobSpecificationResponse) GetProjectName() string {
+func (x *JobSpecification) GetVersion() int32 {
 	if x != nil {
-		return x.ProjectName
+		return x.Version
+	}
+	return 0
+}
+
+func (x *JobSpecification) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (x *JobSpecificationResponse) GetNamespaceName() string {
+func (x *JobSpecification) GetOwner() string {
 	if x != nil {
-		return x.NamespaceName
+		return x.Owner
 	}
 	return ""
 }
 
-func (x *JobSpecificationResponse) GetJob() *JobSpecification {
+func (x *JobSpecification) GetStartDate() string {
 	if x != nil {
-		return x.Job
+		return x.StartDate
 	}
-	return nil
+	return ""
 }
 
-type ReplaceAllJobSpecificationsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *JobSpecification) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
 
-	ProjectName   string              `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
-	NamespaceName string              `protobuf:"bytes,2,opt,name=namespace_name,json=namespaceName,proto3" json:"namespace_name,omitempty"`
-	Jobs          []*JobSpecification `protobuf:"bytes,3,rep,name=jobs,proto3" json:"jobs,omitempty"`
+func (x *JobSpecification) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
 }
 
-func (x *ReplaceAllJobSpecificationsRequest) Reset() {
-	*x = ReplaceAllJobSpecificationsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[41]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *JobSpecification) GetDependsOnPast() bool {
+	if x != nil {
+		return x.DependsOnPast
 	}
+	return false
 }
 
-func (x *ReplaceAllJobSpecificationsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Do not use.
+func (x *JobSpecification) GetCatchUp() bool {
+	if x != nil {
+		return x.CatchUp
+	}
+	return false
 }
 
-func (*ReplaceAllJobSpecificationsRequest) ProtoMessage() {}
+func (x *JobSpecification) GetTaskName() string {
+	if x != nil {
+		return x.TaskName
+	}
+	return ""
+}
 
-func (x *ReplaceAllJobSpecificationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[41]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *JobSpecification) GetConfig() []*JobConfigItem {
+	if x != nil {
+		return x.Config
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ReplaceAllJobSpecificationsRequest.ProtoReflect.Descriptor instead.
-func (*ReplaceAllJobSpecificationsRequest) Descriptor() ([]byte, []int) {
-	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{41}
+func (x *JobSpecification) GetWindowSize() string {
+	if x != nil {
+		return x.WindowSize
+	}
+	return ""
 }
 
-func (x *ReplaceAllJobSpecificationsRequest) GetProjectName() string {
+func (x *JobSpecification) GetWindowOffset() string {
 	if x != nil {
-		return x.ProjectName
+		return x.WindowOffset
 	}
 	return ""
 }
 
-func (x *ReplaceAllJobSpecificationsRequest) GetNamespaceName() string {
+func (x *JobSpecification) GetWindowTruncateTo() string {
 	if x != nil {
-		return x.NamespaceName
+		return x.WindowTruncateTo
 	}
 	return ""
 }
 
-func (x *ReplaceAllJobSpecificationsRequest) GetJobs() []*JobSpecification {
+func (x *JobSpecification) GetWindowPreset() string {
 	if x != nil {
-		return x.Jobs
+		return x.WindowPreset
 	}
-	return nil
+	return ""
 }
 
-type ReplaceAllJobSpecificationsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *JobSpecification) GetDependencies() []*JobDependency {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
+}
 
-	LogStatus *Log `protobuf:"bytes,1,opt,name=log_status,json=logStatus,proto3" json:"log_status,omitempty"`
+func (x *JobSpecification) GetAssets() map[string]string {
+	if x != nil {
+		return x.Assets
+	}
+	return nil
 }
 
-func (x *ReplaceAllJobSpecificationsResponse) Reset() {
-	*x = ReplaceAllJobSpecificationsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[42]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *JobSpecification) GetHooks() []*JobSpecHook {
+	if x != nil {
+		return x.Hooks
 	}
+	return nil
 }
 
-func (x *ReplaceAllJobSpecificationsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *JobSpecification) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
 }
 
-func (*ReplaceAllJobSpecificationsResponse) ProtoMessage() {}
+func (x *JobSpecification) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
 
-func (x *ReplaceAllJobSpecificationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[42]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *JobSpecification) GetBehavior() *JobSpecification_Behavior {
+	if x != nil {
+		return x.Behavior
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ReplaceAllJobSpecificationsResponse.ProtoReflect.Descriptor instead.
-func (*ReplaceAllJobSpecificationsResponse) Descriptor() ([]byte, []int) {
-	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{42}
+func (x *JobSpecification) GetMetadata() *JobMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
 }
 
-func (x *ReplaceAllJobSpecificationsResponse) GetLogStatus() *Log {
+func (x *JobSpecification) GetDestination() string {
 	if x != nil {
-		return x.LogStatus
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *JobSpecification) GetSources() []string {
+	if x != nil {
+		return x.Sources
 	}
 	return nil
 }
 
-type GetJobTaskRequest struct {
+type JobDependency struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProjectName   string `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
-	NamespaceName string `protobuf:"bytes,2,opt,name=namespace_name,json=namespaceName,proto3" json:"namespace_name,omitempty"`
-	JobName       string `protobuf:"bytes,3,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	Name           string          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type           string          `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`                                           // intra/inter/extra
+	HttpDependency *HttpDependency `protobuf:"bytes,3,opt,name=http_dependency,json=httpDependency,proto3" json:"http_dependency,omitempty"` // http sensor dependency
 }
 
-func (x *GetJobTaskRequest) Reset() {
-	*x = GetJobTaskRequest{}
+func (x *JobDependency) Reset() {
+	*x = JobDependency{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[43]
+		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetJobTaskRequest) String() string {
+func (x *JobDependency) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetJobTaskRequest) ProtoMessage() {}
+func (*JobDependency) ProtoMessage() {}
 
-func (x *GetJobTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[43]
+func (x *JobDependency) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2865,57 +2769,60 @@ func (x *GetJobTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetJobTaskRequest.ProtoReflect.Descriptor instead.
-func (*GetJobTaskRequest) Descriptor() ([]byte, []int) {
-	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use JobDependency.ProtoReflect.Descriptor instead.
+func (*JobDependency) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *GetJobTaskRequest) GetProjectName() string {
+func (x *JobDependency) GetName() string {
 	if x != nil {
-		return x.ProjectName
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GetJobTaskRequest) GetNamespaceName() string {
+func (x *JobDependency) GetType() string {
 	if x != nil {
-		return x.NamespaceName
+		return x.Type
 	}
 	return ""
 }
 
-func (x *GetJobTaskRequest) GetJobName() string {
+func (x *JobDependency) GetHttpDependency() *HttpDependency {
 	if x != nil {
-		return x.JobName
+		return x.HttpDependency
 	}
-	return ""
+	return nil
 }
 
-type GetJobTaskResponse struct {
+type HttpDependency struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Task *JobTask `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Name    string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Url     string            `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Headers map[string]string `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Params  map[string]string `protobuf:"bytes,4,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *GetJobTaskResponse) Reset() {
-	*x = GetJobTaskResponse{}
+func (x *HttpDependency) Reset() {
+	*x = HttpDependency{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[44]
+		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetJobTaskResponse) String() string {
+func (x *HttpDependency) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetJobTaskResponse) ProtoMessage() {}
+func (*HttpDependency) ProtoMessage() {}
 
-func (x *GetJobTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[44]
+func (x *HttpDependency) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2926,49 +2833,65 @@ func (x *GetJobTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetJobTaskResponse.ProtoReflect.Descriptor instead.
-func (*GetJobTaskResponse) Descriptor() ([]byte, []int) {
-	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use HttpDependency.ProtoReflect.Descriptor instead.
+func (*HttpDependency) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *GetJobTaskResponse) GetTask() *JobTask {
+func (x *HttpDependency) GetName() string {
 	if x != nil {
-		return x.Task
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HttpDependency) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *HttpDependency) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
 	}
 	return nil
 }
 
-// JobTask is part of a job that dictates main transformation
-// each job has exactly one task
-type JobTask struct {
+func (x *HttpDependency) GetParams() map[string]string {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+type JobSpecHook struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name         string                `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description  string                `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Image        string                `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
-	Destination  *JobTask_Destination  `protobuf:"bytes,4,opt,name=destination,proto3" json:"destination,omitempty"`
-	Dependencies []*JobTask_Dependency `protobuf:"bytes,5,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	Name   string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Config []*JobConfigItem `protobuf:"bytes,2,rep,name=config,proto3" json:"config,omitempty"`
 }
 
-func (x *JobTask) Reset() {
-	*x = JobTask{}
+func (x *JobSpecHook) Reset() {
+	*x = JobSpecHook{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[45]
+		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *JobTask) String() string {
+func (x *JobSpecHook) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JobTask) ProtoMessage() {}
+func (*JobSpecHook) ProtoMessage() {}
 
-func (x *JobTask) ProtoReflect() protoreflect.Message {
-	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[45]
+func (x *JobSpecHook) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2979,76 +2902,51 @@ func (x *JobTask) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JobTask.ProtoReflect.Descriptor instead.
-func (*JobTask) Descriptor() ([]byte, []int) {
-	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use JobSpecHook.ProtoReflect.Descriptor instead.
+func (*JobSpecHook) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *JobTask) GetName() string {
+func (x *JobSpecHook) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *JobTask) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *JobTask) GetImage() string {
-	if x != nil {
-		return x.Image
-	}
-	return ""
-}
-
-func (x *JobTask) GetDestination() *JobTask_Destination {
-	if x != nil {
-		return x.Destination
-	}
-	return nil
-}
-
-func (x *JobTask) GetDependencies() []*JobTask_Dependency {
+func (x *JobSpecHook) GetConfig() []*JobConfigItem {
 	if x != nil {
-		return x.Dependencies
+		return x.Config
 	}
 	return nil
 }
 
-// Deprecated: Do not use.
-type GetWindowRequest struct {
+type JobConfigItem struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ScheduledAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
-	Size        string                 `protobuf:"bytes,2,opt,name=size,proto3" json:"size,omitempty"`
-	Offset      string                 `protobuf:"bytes,3,opt,name=offset,proto3" json:"offset,omitempty"`
-	TruncateTo  string                 `protobuf:"bytes,4,opt,name=truncate_to,json=truncateTo,proto3" json:"truncate_to,omitempty"`
-	Version     int32                  `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *GetWindowRequest) Reset() {
-	*x = GetWindowRequest{}
+func (x *JobConfigItem) Reset() {
+	*x = JobConfigItem{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[46]
+		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetWindowRequest) String() string {
+func (x *JobConfigItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWindowRequest) ProtoMessage() {}
+func (*JobConfigItem) ProtoMessage() {}
 
-func (x *GetWindowRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[46]
+func (x *JobConfigItem) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3059,73 +2957,51 @@ func (x *GetWindowRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWindowRequest.ProtoReflect.Descriptor instead.
-func (*GetWindowRequest) Descriptor() ([]byte, []int) {
-	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use JobConfigItem.ProtoReflect.Descriptor instead.
+func (*JobConfigItem) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *GetWindowRequest) GetScheduledAt() *timestamppb.Timestamp {
+func (x *JobConfigItem) GetName() string {
 	if x != nil {
-		return x.ScheduledAt
-	}
-	return nil
-}
-
-func (x *GetWindowRequest) GetSize() string {
-	if x != nil {
-		return x.Size
-	}
-	return ""
-}
-
-func (x *GetWindowRequest) GetOffset() string {
-	if x != nil {
-		return x.Offset
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GetWindowRequest) GetTruncateTo() string {
+func (x *JobConfigItem) GetValue() string {
 	if x != nil {
-		return x.TruncateTo
+		return x.Value
 	}
 	return ""
 }
 
-func (x *GetWindowRequest) GetVersion() int32 {
-	if x != nil {
-		return x.Version
-	}
-	return 0
-}
-
-// Deprecated: Do not use.
-type GetWindowResponse struct {
+type JobEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Start *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
-	End   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	Type  JobEvent_Type    `protobuf:"varint,1,opt,name=type,proto3,enum=gotocompany.optimus.core.v1beta1.JobEvent_Type" json:"type,omitempty"`
+	Value *structpb.Struct `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *GetWindowResponse) Reset() {
-	*x = GetWindowResponse{}
+func (x *JobEvent) Reset() {
+	*x = JobEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[47]
+		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetWindowResponse) String() string {
+func (x *JobEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWindowResponse) ProtoMessage() {}
+func (*JobEvent) ProtoMessage() {}
 
-func (x *GetWindowResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[47]
+func (x *JobEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3136,54 +3012,51 @@ func (x *GetWindowResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWindowResponse.ProtoReflect.Descriptor instead.
-func (*GetWindowResponse) Descriptor() ([]byte, []int) {
-	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use JobEvent.ProtoReflect.Descriptor instead.
+func (*JobEvent) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *GetWindowResponse) GetStart() *timestamppb.Timestamp {
+func (x *JobEvent) GetType() JobEvent_Type {
 	if x != nil {
-		return x.Start
+		return x.Type
 	}
-	return nil
+	return JobEvent_TYPE_UNSPECIFIED
 }
 
-func (x *GetWindowResponse) GetEnd() *timestamppb.Timestamp {
+func (x *JobEvent) GetValue() *structpb.Struct {
 	if x != nil {
-		return x.End
+		return x.Value
 	}
 	return nil
 }
 
-type UpdateJobsStateRequest struct {
+type JobMetadata struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProjectName   string   `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
-	NamespaceName string   `protobuf:"bytes,2,opt,name=namespace_name,json=namespaceName,proto3" json:"namespace_name,omitempty"`
-	Remark        string   `protobuf:"bytes,3,opt,name=remark,proto3" json:"remark,omitempty"`
-	State         JobState `protobuf:"varint,4,opt,name=state,proto3,enum=gotocompany.optimus.core.v1beta1.JobState" json:"state,omitempty"`
-	JobNames      []string `protobuf:"bytes,5,rep,name=job_names,json=jobNames,proto3" json:"job_names,omitempty"`
+	Resource *JobSpecMetadataResource `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	Airflow  *JobSpecMetadataAirflow  `protobuf:"bytes,2,opt,name=airflow,proto3" json:"airflow,omitempty"`
 }
 
-func (x *UpdateJobsStateRequest) Reset() {
-	*x = UpdateJobsStateRequest{}
+func (x *JobMetadata) Reset() {
+	*x = JobMetadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[48]
+		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateJobsStateRequest) String() string {
+func (x *JobMetadata) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateJobsStateRequest) ProtoMessage() {}
+func (*JobMetadata) ProtoMessage() {}
 
-func (x *UpdateJobsStateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[48]
+func (x *JobMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3194,69 +3067,51 @@ func (x *UpdateJobsStateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateJobsStateRequest.ProtoReflect.Descriptor instead.
-func (*UpdateJobsStateRequest) Descriptor() ([]byte, []int) {
-	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{48}
-}
-
-func (x *UpdateJobsStateRequest) GetProjectName() string {
-	if x != nil {
-		return x.ProjectName
-	}
-	return ""
-}
-
-func (x *UpdateJobsStateRequest) GetNamespaceName() string {
-	if x != nil {
-		return x.NamespaceName
-	}
-	return ""
-}
-
-func (x *UpdateJobsStateRequest) GetRemark() string {
-	if x != nil {
-		return x.Remark
-	}
-	return ""
+// Deprecated: Use JobMetadata.ProtoReflect.Descriptor instead.
+func (*JobMetadata) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *UpdateJobsStateRequest) GetState() JobState {
+func (x *JobMetadata) GetResource() *JobSpecMetadataResource {
 	if x != nil {
-		return x.State
+		return x.Resource
 	}
-	return JobState_JOB_STATE_UNSPECIFIED
+	return nil
 }
 
-func (x *UpdateJobsStateRequest) GetJobNames() []string {
+func (x *JobMetadata) GetAirflow() *JobSpecMetadataAirflow {
 	if x != nil {
-		return x.JobNames
+		return x.Airflow
 	}
 	return nil
 }
 
-type UpdateJobsStateResponse struct {
+type JobSpecMetadataResource struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Request *JobSpecMetadataResourceConfig `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	Limit   *JobSpecMetadataResourceConfig `protobuf:"bytes,2,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *UpdateJobsStateResponse) Reset() {
-	*x = UpdateJobsStateResponse{}
+func (x *JobSpecMetadataResource) Reset() {
+	*x = JobSpecMetadataResource{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[49]
+		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateJobsStateResponse) String() string {
+func (x *JobSpecMetadataResource) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateJobsStateResponse) ProtoMessage() {}
+func (*JobSpecMetadataResource) ProtoMessage() {}
 
-func (x *UpdateJobsStateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[49]
+func (x *JobSpecMetadataResource) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3267,38 +3122,51 @@ func (x *UpdateJobsStateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateJobsStateResponse.ProtoReflect.Descriptor instead.
-func (*UpdateJobsStateResponse) Descriptor() ([]byte, []int) {
-	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use JobSpecMetadataResource.ProtoReflect.Descriptor instead.
+func (*JobSpecMetadataResource) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{47}
 }
 
-type SyncJobsStateRequest struct {
+func (x *JobSpecMetadataResource) GetRequest() *JobSpecMetadataResourceConfig {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *JobSpecMetadataResource) GetLimit() *JobSpecMetadataResourceConfig {
+	if x != nil {
+		return x.Limit
+	}
+	return nil
+}
+
+type JobSpecMetadataResourceConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProjectName   string                               `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
-	NamespaceName string                               `protobuf:"bytes,2,opt,name=namespace_name,json=namespaceName,proto3" json:"namespace_name,omitempty"`
-	JobStates     []*SyncJobsStateRequest_JobStatePair `protobuf:"bytes,3,rep,name=job_states,json=jobStates,proto3" json:"job_states,omitempty"`
+	Cpu    string `protobuf:"bytes,1,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	Memory string `protobuf:"bytes,2,opt,name=memory,proto3" json:"memory,omitempty"`
 }
 
-func (x *SyncJobsStateRequest) Reset() {
-	*x = SyncJobsStateRequest{}
+func (x *JobSpecMetadataResourceConfig) Reset() {
+	*x = JobSpecMetadataResourceConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[50]
+		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SyncJobsStateRequest) String() string {
+func (x *JobSpecMetadataResourceConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncJobsStateRequest) ProtoMessage() {}
+func (*JobSpecMetadataResourceConfig) ProtoMessage() {}
 
-func (x *SyncJobsStateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[50]
+func (x *JobSpecMetadataResourceConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3309,55 +3177,51 @@ func (x *SyncJobsStateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncJobsStateRequest.ProtoReflect.Descriptor instead.
-func (*SyncJobsStateRequest) Descriptor() ([]byte, []int) {
-	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use JobSpecMetadataResourceConfig.ProtoReflect.Descriptor instead.
+func (*JobSpecMetadataResourceConfig) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *SyncJobsStateRequest) GetProjectName() string {
+func (x *JobSpecMetadataResourceConfig) GetCpu() string {
 	if x != nil {
-		return x.ProjectName
+		return x.Cpu
 	}
 	return ""
 }
 
-func (x *SyncJobsStateRequest) GetNamespaceName() string {
+func (x *JobSpecMetadataResourceConfig) GetMemory() string {
 	if x != nil {
-		return x.NamespaceName
+		return x.Memory
 	}
 	return ""
 }
 
-func (x *SyncJobsStateRequest) GetJobStates() []*SyncJobsStateRequest_JobStatePair {
-	if x != nil {
-		return x.JobStates
-	}
-	return nil
-}
-
-type SyncJobsStateResponse struct {
+type JobSpecMetadataAirflow struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Pool  string `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	Queue string `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
 }
 
-func (x *SyncJobsStateResponse) Reset() {
-	*x = SyncJobsStateResponse{}
+func (x *JobSpecMetadataAirflow) Reset() {
+	*x = JobSpecMetadataAirflow{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[51]
+		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SyncJobsStateResponse) String() string {
+func (x *JobSpecMetadataAirflow) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncJobsStateResponse) ProtoMessage() {}
+func (*JobSpecMetadataAirflow) ProtoMessage() {}
 
-func (x *SyncJobsStateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[51]
+func (x *JobSpecMetadataAirflow) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3368,39 +3232,52 @@ func (x *SyncJobsStateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncJobsStateResponse.ProtoReflect.Descriptor instead.
-func (*SyncJobsStateResponse) Descriptor() ([]byte, []int) {
-	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use JobSpecMetadataAirflow.ProtoReflect.Descriptor instead.
+func (*JobSpecMetadataAirflow) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{49}
 }
 
-type JobInspectResponse_BasicInfoSection struct {
+func (x *JobSpecMetadataAirflow) GetPool() string {
+	if x != nil {
+		return x.Pool
+	}
+	return ""
+}
+
+func (x *JobSpecMetadataAirflow) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+type RefreshJobsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Job         *JobSpecification `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
-	Source      []string          `protobuf:"bytes,2,rep,name=source,proto3" json:"source,omitempty"`
-	Destination string            `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"`
-	Notice      []*Log            `protobuf:"bytes,4,rep,name=notice,proto3" json:"notice,omitempty"`
+	ProjectName    string   `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	NamespaceNames []string `protobuf:"bytes,2,rep,name=namespace_names,json=namespaceNames,proto3" json:"namespace_names,omitempty"`
+	JobNames       []string `protobuf:"bytes,3,rep,name=job_names,json=jobNames,proto3" json:"job_names,omitempty"`
 }
 
-func (x *JobInspectResponse_BasicInfoSection) Reset() {
-	*x = JobInspectResponse_BasicInfoSection{}
+func (x *RefreshJobsRequest) Reset() {
+	*x = RefreshJobsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[52]
+		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *JobInspectResponse_BasicInfoSection) String() string {
+func (x *RefreshJobsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JobInspectResponse_BasicInfoSection) ProtoMessage() {}
+func (*RefreshJobsRequest) ProtoMessage() {}
 
-func (x *JobInspectResponse_BasicInfoSection) ProtoReflect() protoreflect.Message {
-	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[52]
+func (x *RefreshJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3411,69 +3288,57 @@ func (x *JobInspectResponse_BasicInfoSection) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JobInspectResponse_BasicInfoSection.ProtoReflect.Descriptor instead.
-func (*JobInspectResponse_BasicInfoSection) Descriptor() ([]byte, []int) {
-	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{8, 0}
+// Deprecated: Use RefreshJobsRequest.ProtoReflect.Descriptor instead.
+func (*RefreshJobsRequest) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *JobInspectResponse_BasicInfoSection) GetJob() *JobSpecification {
+func (x *RefreshJobsRequest) GetProjectName() string {
 	if x != nil {
-		return x.Job
+		return x.ProjectName
 	}
-	return nil
+	return ""
 }
 
-func (x *JobInspectResponse_BasicInfoSection) GetSource() []string {
+func (x *RefreshJobsRequest) GetNamespaceNames() []string {
 	if x != nil {
-		return x.Source
+		return x.NamespaceNames
 	}
 	return nil
 }
 
-func (x *JobInspectResponse_BasicInfoSection) GetDestination() string {
-	if x != nil {
-		return x.Destination
-	}
-	return ""
-}
-
-func (x *JobInspectResponse_BasicInfoSection) GetNotice() []*Log {
+func (x *RefreshJobsRequest) GetJobNames() []string {
 	if x != nil {
-		return x.Notice
+		return x.JobNames
 	}
 	return nil
 }
 
-type JobInspectResponse_JobDependency struct {
+type RefreshJobsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name          string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Host          string    `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
-	ProjectName   string    `protobuf:"bytes,3,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
-	NamespaceName string    `protobuf:"bytes,4,opt,name=namespace_name,json=namespaceName,proto3" json:"namespace_name,omitempty"`
-	TaskName      string    `protobuf:"bytes,5,opt,name=task_name,json=taskName,proto3" json:"task_name,omitempty"`
-	Runs          []*JobRun `protobuf:"bytes,6,rep,name=runs,proto3" json:"runs,omitempty"`
+	LogStatus *Log `protobuf:"bytes,6,opt,name=log_status,json=logStatus,proto3" json:"log_status,omitempty"`
 }
 
-func (x *JobInspectResponse_JobDependency) Reset() {
-	*x = JobInspectResponse_JobDependency{}
+func (x *RefreshJobsResponse) Reset() {
+	*x = RefreshJobsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[53]
+		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *JobInspectResponse_JobDependency) String() string {
+func (x *RefreshJobsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JobInspectResponse_JobDependency) ProtoMessage() {}
+func (*RefreshJobsResponse) ProtoMessage() {}
 
-func (x *JobInspectResponse_JobDependency) ProtoReflect() protoreflect.Message {
-	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[53]
+func (x *RefreshJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3484,82 +3349,94 @@ func (x *JobInspectResponse_JobDependency) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JobInspectResponse_JobDependency.ProtoReflect.Descriptor instead.
-func (*JobInspectResponse_JobDependency) Descriptor() ([]byte, []int) {
-	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{8, 1}
+// Deprecated: Use RefreshJobsResponse.ProtoReflect.Descriptor instead.
+func (*RefreshJobsResponse) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *JobInspectResponse_JobDependency) GetName() string {
+func (x *RefreshJobsResponse) GetLogStatus() *Log {
 	if x != nil {
-		return x.Name
+		return x.LogStatus
 	}
-	return ""
+	return nil
 }
 
-func (x *JobInspectResponse_JobDependency) GetHost() string {
-	if x != nil {
-		return x.Host
-	}
-	return ""
+type GetDeployJobsStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeployId string `protobuf:"bytes,1,opt,name=deploy_id,json=deployId,proto3" json:"deploy_id,omitempty"`
 }
 
-func (x *JobInspectResponse_JobDependency) GetProjectName() string {
-	if x != nil {
-		return x.ProjectName
+func (x *GetDeployJobsStatusRequest) Reset() {
+	*x = GetDeployJobsStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *JobInspectResponse_JobDependency) GetNamespaceName() string {
-	if x != nil {
-		return x.NamespaceName
-	}
-	return ""
+func (x *GetDeployJobsStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *JobInspectResponse_JobDependency) GetTaskName() string {
-	if x != nil {
-		return x.TaskName
+func (*GetDeployJobsStatusRequest) ProtoMessage() {}
+
+func (x *GetDeployJobsStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *JobInspectResponse_JobDependency) GetRuns() []*JobRun {
+// Deprecated: Use GetDeployJobsStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetDeployJobsStatusRequest) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetDeployJobsStatusRequest) GetDeployId() string {
 	if x != nil {
-		return x.Runs
+		return x.DeployId
 	}
-	return nil
+	return ""
 }
 
-type JobInspectResponse_UpstreamSection struct {
+type GetDeployJobsStatusResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ExternalDependency  []*JobInspectResponse_JobDependency                       `protobuf:"bytes,1,rep,name=external_dependency,json=externalDependency,proto3" json:"external_dependency,omitempty"`
-	InternalDependency  []*JobInspectResponse_JobDependency                       `protobuf:"bytes,2,rep,name=internal_dependency,json=internalDependency,proto3" json:"internal_dependency,omitempty"`
-	HttpDependency      []*HttpDependency                                         `protobuf:"bytes,3,rep,name=http_dependency,json=httpDependency,proto3" json:"http_dependency,omitempty"`
-	UnknownDependencies []*JobInspectResponse_UpstreamSection_UnknownDependencies `protobuf:"bytes,4,rep,name=unknown_dependencies,json=unknownDependencies,proto3" json:"unknown_dependencies,omitempty"`
-	Notice              []*Log                                                    `protobuf:"bytes,5,rep,name=notice,proto3" json:"notice,omitempty"`
+	Status              string              `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Failures            []*DeployJobFailure `protobuf:"bytes,2,rep,name=failures,proto3" json:"failures,omitempty"`
+	SuccessCount        int32               `protobuf:"varint,3,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
+	FailureCount        int32               `protobuf:"varint,4,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
+	UnknownDependencies map[string]string   `protobuf:"bytes,5,rep,name=unknown_dependencies,json=unknownDependencies,proto3" json:"unknown_dependencies,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *JobInspectResponse_UpstreamSection) Reset() {
-	*x = JobInspectResponse_UpstreamSection{}
+func (x *GetDeployJobsStatusResponse) Reset() {
+	*x = GetDeployJobsStatusResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[54]
+		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *JobInspectResponse_UpstreamSection) String() string {
+func (x *GetDeployJobsStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JobInspectResponse_UpstreamSection) ProtoMessage() {}
+func (*GetDeployJobsStatusResponse) ProtoMessage() {}
 
-func (x *JobInspectResponse_UpstreamSection) ProtoReflect() protoreflect.Message {
-	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[54]
+func (x *GetDeployJobsStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3570,72 +3447,72 @@ func (x *JobInspectResponse_UpstreamSection) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JobInspectResponse_UpstreamSection.ProtoReflect.Descriptor instead.
-func (*JobInspectResponse_UpstreamSection) Descriptor() ([]byte, []int) {
-	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{8, 2}
+// Deprecated: Use GetDeployJobsStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetDeployJobsStatusResponse) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *JobInspectResponse_UpstreamSection) GetExternalDependency() []*JobInspectResponse_JobDependency {
+func (x *GetDeployJobsStatusResponse) GetStatus() string {
 	if x != nil {
-		return x.ExternalDependency
+		return x.Status
 	}
-	return nil
+	return ""
 }
 
-func (x *JobInspectResponse_UpstreamSection) GetInternalDependency() []*JobInspectResponse_JobDependency {
+func (x *GetDeployJobsStatusResponse) GetFailures() []*DeployJobFailure {
 	if x != nil {
-		return x.InternalDependency
+		return x.Failures
 	}
 	return nil
 }
 
-func (x *JobInspectResponse_UpstreamSection) GetHttpDependency() []*HttpDependency {
+func (x *GetDeployJobsStatusResponse) GetSuccessCount() int32 {
 	if x != nil {
-		return x.HttpDependency
+		return x.SuccessCount
 	}
-	return nil
+	return 0
 }
 
-func (x *JobInspectResponse_UpstreamSection) GetUnknownDependencies() []*JobInspectResponse_UpstreamSection_UnknownDependencies {
+func (x *GetDeployJobsStatusResponse) GetFailureCount() int32 {
 	if x != nil {
-		return x.UnknownDependencies
+		return x.FailureCount
 	}
-	return nil
+	return 0
 }
 
-func (x *JobInspectResponse_UpstreamSection) GetNotice() []*Log {
+func (x *GetDeployJobsStatusResponse) GetUnknownDependencies() map[string]string {
 	if x != nil {
-		return x.Notice
+		return x.UnknownDependencies
 	}
 	return nil
 }
 
-type JobInspectResponse_DownstreamSection struct {
+type DeployJobFailure struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DownstreamJobs []*JobInspectResponse_JobDependency `protobuf:"bytes,1,rep,name=downstream_jobs,json=downstreamJobs,proto3" json:"downstream_jobs,omitempty"`
-	Notice         []*Log                              `protobuf:"bytes,2,rep,name=notice,proto3" json:"notice,omitempty"`
+	JobName string `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *JobInspectResponse_DownstreamSection) Reset() {
-	*x = JobInspectResponse_DownstreamSection{}
+func (x *DeployJobFailure) Reset() {
+	*x = DeployJobFailure{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[55]
+		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *JobInspectResponse_DownstreamSection) String() string {
+func (x *DeployJobFailure) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JobInspectResponse_DownstreamSection) ProtoMessage() {}
+func (*DeployJobFailure) ProtoMessage() {}
 
-func (x *JobInspectResponse_DownstreamSection) ProtoReflect() protoreflect.Message {
-	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[55]
+func (x *DeployJobFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3646,52 +3523,53 @@ func (x *JobInspectResponse_DownstreamSection) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JobInspectResponse_DownstreamSection.ProtoReflect.Descriptor instead.
-func (*JobInspectResponse_DownstreamSection) Descriptor() ([]byte, []int) {
-	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{8, 3}
+// Deprecated: Use DeployJobFailure.ProtoReflect.Descriptor instead.
+func (*DeployJobFailure) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *JobInspectResponse_DownstreamSection) GetDownstreamJobs() []*JobInspectResponse_JobDependency {
+func (x *DeployJobFailure) GetJobName() string {
 	if x != nil {
-		return x.DownstreamJobs
+		return x.JobName
 	}
-	return nil
+	return ""
 }
 
-func (x *JobInspectResponse_DownstreamSection) GetNotice() []*Log {
+func (x *DeployJobFailure) GetMessage() string {
 	if x != nil {
-		return x.Notice
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-type JobInspectResponse_UpstreamSection_UnknownDependencies struct {
+type GetJobSpecificationsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	JobName             string `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
-	ProjectName         string `protobuf:"bytes,2,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
-	ResourceDestination string `protobuf:"bytes,3,opt,name=resource_destination,json=resourceDestination,proto3" json:"resource_destination,omitempty"`
+	ProjectName         string `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	ResourceDestination string `protobuf:"bytes,2,opt,name=resource_destination,json=resourceDestination,proto3" json:"resource_destination,omitempty"`
+	JobName             string `protobuf:"bytes,3,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	NamespaceName       string `protobuf:"bytes,4,opt,name=namespace_name,json=namespaceName,proto3" json:"namespace_name,omitempty"`
 }
 
-func (x *JobInspectResponse_UpstreamSection_UnknownDependencies) Reset() {
-	*x = JobInspectResponse_UpstreamSection_UnknownDependencies{}
+func (x *GetJobSpecificationsRequest) Reset() {
+	*x = GetJobSpecificationsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[56]
+		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *JobInspectResponse_UpstreamSection_UnknownDependencies) String() string {
+func (x *GetJobSpecificationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JobInspectResponse_UpstreamSection_UnknownDependencies) ProtoMessage() {}
+func (*GetJobSpecificationsRequest) ProtoMessage() {}
 
-func (x *JobInspectResponse_UpstreamSection_UnknownDependencies) ProtoReflect() protoreflect.Message {
-	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[56]
+func (x *GetJobSpecificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3702,58 +3580,66 @@ func (x *JobInspectResponse_UpstreamSection_UnknownDependencies) ProtoReflect()
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JobInspectResponse_UpstreamSection_UnknownDependencies.ProtoReflect.Descriptor instead.
-func (*JobInspectResponse_UpstreamSection_UnknownDependencies) Descriptor() ([]byte, []int) {
-	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{8, 2, 0}
+// Deprecated: Use GetJobSpecificationsRequest.ProtoReflect.Descriptor instead.
+func (*GetJobSpecificationsRequest) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *JobInspectResponse_UpstreamSection_UnknownDependencies) GetJobName() string {
+func (x *GetJobSpecificationsRequest) GetProjectName() string {
 	if x != nil {
-		return x.JobName
+		return x.ProjectName
 	}
 	return ""
 }
 
-func (x *JobInspectResponse_UpstreamSection_UnknownDependencies) GetProjectName() string {
+func (x *GetJobSpecificationsRequest) GetResourceDestination() string {
 	if x != nil {
-		return x.ProjectName
+		return x.ResourceDestination
 	}
 	return ""
 }
 
-func (x *JobInspectResponse_UpstreamSection_UnknownDependencies) GetResourceDestination() string {
+func (x *GetJobSpecificationsRequest) GetJobName() string {
 	if x != nil {
-		return x.ResourceDestination
+		return x.JobName
 	}
 	return ""
 }
 
-type JobSpecification_Behavior struct {
+func (x *GetJobSpecificationsRequest) GetNamespaceName() string {
+	if x != nil {
+		return x.NamespaceName
+	}
+	return ""
+}
+
+type GetJobSpecificationsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Retry  *JobSpecification_Behavior_Retry       `protobuf:"bytes,1,opt,name=retry,proto3" json:"retry,omitempty"`
-	Notify []*JobSpecification_Behavior_Notifiers `protobuf:"bytes,2,rep,name=notify,proto3" json:"notify,omitempty"`
+	// Deprecated: Do not use.
+	Jobs                      []*JobSpecification         `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	JobSpecificationResponses []*JobSpecificationResponse `protobuf:"bytes,2,rep,name=job_specification_responses,json=jobSpecificationResponses,proto3" json:"job_specification_responses,omitempty"`
 }
 
-func (x *JobSpecification_Behavior) Reset() {
-	*x = JobSpecification_Behavior{}
+func (x *GetJobSpecificationsResponse) Reset() {
+	*x = GetJobSpecificationsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[59]
+		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *JobSpecification_Behavior) String() string {
+func (x *GetJobSpecificationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JobSpecification_Behavior) ProtoMessage() {}
+func (*GetJobSpecificationsResponse) ProtoMessage() {}
 
-func (x *JobSpecification_Behavior) ProtoReflect() protoreflect.Message {
-	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[59]
+func (x *GetJobSpecificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3764,53 +3650,53 @@ func (x *JobSpecification_Behavior) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JobSpecification_Behavior.ProtoReflect.Descriptor instead.
-func (*JobSpecification_Behavior) Descriptor() ([]byte, []int) {
-	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{23, 2}
+// Deprecated: Use GetJobSpecificationsResponse.ProtoReflect.Descriptor instead.
+func (*GetJobSpecificationsResponse) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *JobSpecification_Behavior) GetRetry() *JobSpecification_Behavior_Retry {
+// Deprecated: Do not use.
+func (x *GetJobSpecificationsResponse) GetJobs() []*JobSpecification {
 	if x != nil {
-		return x.Retry
+		return x.Jobs
 	}
 	return nil
 }
 
-func (x *JobSpecification_Behavior) GetNotify() []*JobSpecification_Behavior_Notifiers {
+func (x *GetJobSpecificationsResponse) GetJobSpecificationResponses() []*JobSpecificationResponse {
 	if x != nil {
-		return x.Notify
+		return x.JobSpecificationResponses
 	}
 	return nil
 }
 
-// retry behaviour if job failed to execute for the first time
-type JobSpecification_Behavior_Retry struct {
+type JobSpecificationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Count              int32                `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
-	Delay              *durationpb.Duration `protobuf:"bytes,2,opt,name=delay,proto3" json:"delay,omitempty"`
-	ExponentialBackoff bool                 `protobuf:"varint,3,opt,name=exponential_backoff,json=exponentialBackoff,proto3" json:"exponential_backoff,omitempty"`
+	ProjectName   string            `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	NamespaceName string            `protobuf:"bytes,2,opt,name=namespace_name,json=namespaceName,proto3" json:"namespace_name,omitempty"`
+	Job           *JobSpecification `protobuf:"bytes,3,opt,name=job,proto3" json:"job,omitempty"`
 }
 
-func (x *JobSpecification_Behavior_Retry) Reset() {
-	*x = JobSpecification_Behavior_Retry{}
+func (x *JobSpecificationResponse) Reset() {
+	*x = JobSpecificationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[60]
+		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *JobSpecification_Behavior_Retry) String() string {
+func (x *JobSpecificationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JobSpecification_Behavior_Retry) ProtoMessage() {}
+func (*JobSpecificationResponse) ProtoMessage() {}
 
-func (x *JobSpecification_Behavior_Retry) ProtoReflect() protoreflect.Message {
-	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[60]
+func (x *JobSpecificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3821,60 +3707,59 @@ func (x *JobSpecification_Behavior_Retry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JobSpecification_Behavior_Retry.ProtoReflect.Descriptor instead.
-func (*JobSpecification_Behavior_Retry) Descriptor() ([]byte, []int) {
-	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{23, 2, 0}
+// Deprecated: Use JobSpecificationResponse.ProtoReflect.Descriptor instead.
+func (*JobSpecificationResponse) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *JobSpecification_Behavior_Retry) GetCount() int32 {
+func (x *JobSpecificationResponse) GetProjectName() string {
 	if x != nil {
-		return x.Count
+		return x.ProjectName
 	}
-	return 0
+	return ""
 }
 
-func (x *JobSpecification_Behavior_Retry) GetDelay() *durationpb.Duration {
+func (x *JobSpecificationResponse) GetNamespaceName() string {
 	if x != nil {
-		return x.Delay
+		return x.NamespaceName
 	}
-	return nil
+	return ""
 }
 
-func (x *JobSpecification_Behavior_Retry) GetExponentialBackoff() bool {
+func (x *JobSpecificationResponse) GetJob() *JobSpecification {
 	if x != nil {
-		return x.ExponentialBackoff
+		return x.Job
 	}
-	return false
+	return nil
 }
 
-// Notifiers are used to set custom alerting in case of job failure/sla_miss
-type JobSpecification_Behavior_Notifiers struct {
+type ReplaceAllJobSpecificationsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	On       JobEvent_Type     `protobuf:"varint,1,opt,name=on,proto3,enum=gotocompany.optimus.core.v1beta1.JobEvent_Type" json:"on,omitempty"`
-	Channels []string          `protobuf:"bytes,2,rep,name=channels,proto3" json:"channels,omitempty"`
-	Config   map[string]string `protobuf:"bytes,3,rep,name=config,proto3" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ProjectName   string              `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	NamespaceName string              `protobuf:"bytes,2,opt,name=namespace_name,json=namespaceName,proto3" json:"namespace_name,omitempty"`
+	Jobs          []*JobSpecification `protobuf:"bytes,3,rep,name=jobs,proto3" json:"jobs,omitempty"`
 }
 
-func (x *JobSpecification_Behavior_Notifiers) Reset() {
-	*x = JobSpecification_Behavior_Notifiers{}
+func (x *ReplaceAllJobSpecificationsRequest) Reset() {
+	*x = ReplaceAllJobSpecificationsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[61]
+		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *JobSpecification_Behavior_Notifiers) String() string {
+func (x *ReplaceAllJobSpecificationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JobSpecification_Behavior_Notifiers) ProtoMessage() {}
+func (*ReplaceAllJobSpecificationsRequest) ProtoMessage() {}
 
-func (x *JobSpecification_Behavior_Notifiers) ProtoReflect() protoreflect.Message {
-	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[61]
+func (x *ReplaceAllJobSpecificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3885,58 +3770,57 @@ func (x *JobSpecification_Behavior_Notifiers) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JobSpecification_Behavior_Notifiers.ProtoReflect.Descriptor instead.
-func (*JobSpecification_Behavior_Notifiers) Descriptor() ([]byte, []int) {
-	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{23, 2, 1}
+// Deprecated: Use ReplaceAllJobSpecificationsRequest.ProtoReflect.Descriptor instead.
+func (*ReplaceAllJobSpecificationsRequest) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *JobSpecification_Behavior_Notifiers) GetOn() JobEvent_Type {
+func (x *ReplaceAllJobSpecificationsRequest) GetProjectName() string {
 	if x != nil {
-		return x.On
+		return x.ProjectName
 	}
-	return JobEvent_TYPE_UNSPECIFIED
+	return ""
 }
 
-func (x *JobSpecification_Behavior_Notifiers) GetChannels() []string {
+func (x *ReplaceAllJobSpecificationsRequest) GetNamespaceName() string {
 	if x != nil {
-		return x.Channels
+		return x.NamespaceName
 	}
-	return nil
+	return ""
 }
 
-func (x *JobSpecification_Behavior_Notifiers) GetConfig() map[string]string {
+func (x *ReplaceAllJobSpecificationsRequest) GetJobs() []*JobSpecification {
 	if x != nil {
-		return x.Config
+		return x.Jobs
 	}
 	return nil
 }
 
-type JobTask_Destination struct {
+type ReplaceAllJobSpecificationsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Destination string `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
-	Type        string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	LogStatus *Log `protobuf:"bytes,1,opt,name=log_status,json=logStatus,proto3" json:"log_status,omitempty"`
 }
 
-func (x *JobTask_Destination) Reset() {
-	*x = JobTask_Destination{}
+func (x *ReplaceAllJobSpecificationsResponse) Reset() {
+	*x = ReplaceAllJobSpecificationsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[66]
+		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *JobTask_Destination) String() string {
+func (x *ReplaceAllJobSpecificationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JobTask_Destination) ProtoMessage() {}
+func (*ReplaceAllJobSpecificationsResponse) ProtoMessage() {}
 
-func (x *JobTask_Destination) ProtoReflect() protoreflect.Message {
-	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[66]
+func (x *ReplaceAllJobSpecificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3947,50 +3831,45 @@ func (x *JobTask_Destination) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JobTask_Destination.ProtoReflect.Descriptor instead.
-func (*JobTask_Destination) Descriptor() ([]byte, []int) {
-	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{45, 0}
-}
-
-func (x *JobTask_Destination) GetDestination() string {
-	if x != nil {
-		return x.Destination
-	}
-	return ""
+// Deprecated: Use ReplaceAllJobSpecificationsResponse.ProtoReflect.Descriptor instead.
+func (*ReplaceAllJobSpecificationsResponse) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *JobTask_Destination) GetType() string {
+func (x *ReplaceAllJobSpecificationsResponse) GetLogStatus() *Log {
 	if x != nil {
-		return x.Type
+		return x.LogStatus
 	}
-	return ""
+	return nil
 }
 
-type JobTask_Dependency struct {
+type GetJobTaskRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Dependency string `protobuf:"bytes,1,opt,name=dependency,proto3" json:"dependency,omitempty"`
+	ProjectName   string `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	NamespaceName string `protobuf:"bytes,2,opt,name=namespace_name,json=namespaceName,proto3" json:"namespace_name,omitempty"`
+	JobName       string `protobuf:"bytes,3,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
 }
 
-func (x *JobTask_Dependency) Reset() {
-	*x = JobTask_Dependency{}
+func (x *GetJobTaskRequest) Reset() {
+	*x = GetJobTaskRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[67]
+		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *JobTask_Dependency) String() string {
+func (x *GetJobTaskRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JobTask_Dependency) ProtoMessage() {}
+func (*GetJobTaskRequest) ProtoMessage() {}
 
-func (x *JobTask_Dependency) ProtoReflect() protoreflect.Message {
-	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[67]
+func (x *GetJobTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4001,44 +3880,57 @@ func (x *JobTask_Dependency) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JobTask_Dependency.ProtoReflect.Descriptor instead.
-func (*JobTask_Dependency) Descriptor() ([]byte, []int) {
-	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{45, 1}
+// Deprecated: Use GetJobTaskRequest.ProtoReflect.Descriptor instead.
+func (*GetJobTaskRequest) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *JobTask_Dependency) GetDependency() string {
+func (x *GetJobTaskRequest) GetProjectName() string {
 	if x != nil {
-		return x.Dependency
+		return x.ProjectName
 	}
 	return ""
 }
 
-type SyncJobsStateRequest_JobStatePair struct {
+func (x *GetJobTaskRequest) GetNamespaceName() string {
+	if x != nil {
+		return x.NamespaceName
+	}
+	return ""
+}
+
+func (x *GetJobTaskRequest) GetJobName() string {
+	if x != nil {
+		return x.JobName
+	}
+	return ""
+}
+
+type GetJobTaskResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	JobName string   `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
-	State   JobState `protobuf:"varint,2,opt,name=state,proto3,enum=gotocompany.optimus.core.v1beta1.JobState" json:"state,omitempty"`
+	Task *JobTask `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
 }
 
-func (x *SyncJobsStateRequest_JobStatePair) Reset() {
-	*x = SyncJobsStateRequest_JobStatePair{}
+func (x *GetJobTaskResponse) Reset() {
+	*x = GetJobTaskResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[68]
+		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SyncJobsStateRequest_JobStatePair) String() string {
+func (x *GetJobTaskResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncJobsStateRequest_JobStatePair) ProtoMessage() {}
+func (*GetJobTaskResponse) ProtoMessage() {}
 
-func (x *SyncJobsStateRequest_JobStatePair) ProtoReflect() protoreflect.Message {
-	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[68]
+func (x *GetJobTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4049,28 +3941,1491 @@ func (x *SyncJobsStateRequest_JobStatePair) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncJobsStateRequest_JobStatePair.ProtoReflect.Descriptor instead.
-func (*SyncJobsStateRequest_JobStatePair) Descriptor() ([]byte, []int) {
-	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{50, 0}
+// Deprecated: Use GetJobTaskResponse.ProtoReflect.Descriptor instead.
+func (*GetJobTaskResponse) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *SyncJobsStateRequest_JobStatePair) GetJobName() string {
+func (x *GetJobTaskResponse) GetTask() *JobTask {
 	if x != nil {
-		return x.JobName
+		return x.Task
 	}
-	return ""
+	return nil
 }
 
-func (x *SyncJobsStateRequest_JobStatePair) GetState() JobState {
-	if x != nil {
-		return x.State
-	}
-	return JobState_JOB_STATE_UNSPECIFIED
-}
+// JobTask is part of a job that dictates main transformation
+// each job has exactly one task
+type JobTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var File_gotocompany_optimus_core_v1beta1_job_spec_proto protoreflect.FileDescriptor
+	Name         string                `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description  string                `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Image        string                `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	Destination  *JobTask_Destination  `protobuf:"bytes,4,opt,name=destination,proto3" json:"destination,omitempty"`
+	Dependencies []*JobTask_Dependency `protobuf:"bytes,5,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+}
 
-var file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDesc = []byte{
+func (x *JobTask) Reset() {
+	*x = JobTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JobTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobTask) ProtoMessage() {}
+
+func (x *JobTask) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobTask.ProtoReflect.Descriptor instead.
+func (*JobTask) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *JobTask) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *JobTask) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *JobTask) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *JobTask) GetDestination() *JobTask_Destination {
+	if x != nil {
+		return x.Destination
+	}
+	return nil
+}
+
+func (x *JobTask) GetDependencies() []*JobTask_Dependency {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
+}
+
+// Deprecated: Do not use.
+type GetWindowRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScheduledAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
+	Size        string                 `protobuf:"bytes,2,opt,name=size,proto3" json:"size,omitempty"`
+	Offset      string                 `protobuf:"bytes,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	TruncateTo  string                 `protobuf:"bytes,4,opt,name=truncate_to,json=truncateTo,proto3" json:"truncate_to,omitempty"`
+	Version     int32                  `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *GetWindowRequest) Reset() {
+	*x = GetWindowRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWindowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWindowRequest) ProtoMessage() {}
+
+func (x *GetWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWindowRequest.ProtoReflect.Descriptor instead.
+func (*GetWindowRequest) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetWindowRequest) GetScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return nil
+}
+
+func (x *GetWindowRequest) GetSize() string {
+	if x != nil {
+		return x.Size
+	}
+	return ""
+}
+
+func (x *GetWindowRequest) GetOffset() string {
+	if x != nil {
+		return x.Offset
+	}
+	return ""
+}
+
+func (x *GetWindowRequest) GetTruncateTo() string {
+	if x != nil {
+		return x.TruncateTo
+	}
+	return ""
+}
+
+func (x *GetWindowRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// Deprecated: Do not use.
+type GetWindowResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (x *GetWindowResponse) Reset() {
+	*x = GetWindowResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWindowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWindowResponse) ProtoMessage() {}
+
+func (x *GetWindowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWindowResponse.ProtoReflect.Descriptor instead.
+func (*GetWindowResponse) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetWindowResponse) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *GetWindowResponse) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+type UpdateJobsStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectName   string   `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	NamespaceName string   `protobuf:"bytes,2,opt,name=namespace_name,json=namespaceName,proto3" json:"namespace_name,omitempty"`
+	Remark        string   `protobuf:"bytes,3,opt,name=remark,proto3" json:"remark,omitempty"`
+	State         JobState `protobuf:"varint,4,opt,name=state,proto3,enum=gotocompany.optimus.core.v1beta1.JobState" json:"state,omitempty"`
+	JobNames      []string `protobuf:"bytes,5,rep,name=job_names,json=jobNames,proto3" json:"job_names,omitempty"`
+}
+
+func (x *UpdateJobsStateRequest) Reset() {
+	*x = UpdateJobsStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateJobsStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateJobsStateRequest) ProtoMessage() {}
+
+func (x *UpdateJobsStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateJobsStateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateJobsStateRequest) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *UpdateJobsStateRequest) GetProjectName() string {
+	if x != nil {
+		return x.ProjectName
+	}
+	return ""
+}
+
+func (x *UpdateJobsStateRequest) GetNamespaceName() string {
+	if x != nil {
+		return x.NamespaceName
+	}
+	return ""
+}
+
+func (x *UpdateJobsStateRequest) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+func (x *UpdateJobsStateRequest) GetState() JobState {
+	if x != nil {
+		return x.State
+	}
+	return JobState_JOB_STATE_UNSPECIFIED
+}
+
+func (x *UpdateJobsStateRequest) GetJobNames() []string {
+	if x != nil {
+		return x.JobNames
+	}
+	return nil
+}
+
+type UpdateJobsStateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateJobsStateResponse) Reset() {
+	*x = UpdateJobsStateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateJobsStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateJobsStateResponse) ProtoMessage() {}
+
+func (x *UpdateJobsStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateJobsStateResponse.ProtoReflect.Descriptor instead.
+func (*UpdateJobsStateResponse) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{66}
+}
+
+type SyncJobsStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectName   string                               `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	NamespaceName string                               `protobuf:"bytes,2,opt,name=namespace_name,json=namespaceName,proto3" json:"namespace_name,omitempty"`
+	JobStates     []*SyncJobsStateRequest_JobStatePair `protobuf:"bytes,3,rep,name=job_states,json=jobStates,proto3" json:"job_states,omitempty"`
+}
+
+func (x *SyncJobsStateRequest) Reset() {
+	*x = SyncJobsStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncJobsStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncJobsStateRequest) ProtoMessage() {}
+
+func (x *SyncJobsStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncJobsStateRequest.ProtoReflect.Descriptor instead.
+func (*SyncJobsStateRequest) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *SyncJobsStateRequest) GetProjectName() string {
+	if x != nil {
+		return x.ProjectName
+	}
+	return ""
+}
+
+func (x *SyncJobsStateRequest) GetNamespaceName() string {
+	if x != nil {
+		return x.NamespaceName
+	}
+	return ""
+}
+
+func (x *SyncJobsStateRequest) GetJobStates() []*SyncJobsStateRequest_JobStatePair {
+	if x != nil {
+		return x.JobStates
+	}
+	return nil
+}
+
+type SyncJobsStateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SyncJobsStateResponse) Reset() {
+	*x = SyncJobsStateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncJobsStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncJobsStateResponse) ProtoMessage() {}
+
+func (x *SyncJobsStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncJobsStateResponse.ProtoReflect.Descriptor instead.
+func (*SyncJobsStateResponse) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{68}
+}
+
+type FormatJobSpecificationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectName   string              `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	NamespaceName string              `protobuf:"bytes,2,opt,name=namespace_name,json=namespaceName,proto3" json:"namespace_name,omitempty"`
+	Jobs          []*JobSpecification `protobuf:"bytes,3,rep,name=jobs,proto3" json:"jobs,omitempty"`
+}
+
+func (x *FormatJobSpecificationsRequest) Reset() {
+	*x = FormatJobSpecificationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FormatJobSpecificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FormatJobSpecificationsRequest) ProtoMessage() {}
+
+func (x *FormatJobSpecificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FormatJobSpecificationsRequest.ProtoReflect.Descriptor instead.
+func (*FormatJobSpecificationsRequest) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *FormatJobSpecificationsRequest) GetProjectName() string {
+	if x != nil {
+		return x.ProjectName
+	}
+	return ""
+}
+
+func (x *FormatJobSpecificationsRequest) GetNamespaceName() string {
+	if x != nil {
+		return x.NamespaceName
+	}
+	return ""
+}
+
+func (x *FormatJobSpecificationsRequest) GetJobs() []*JobSpecification {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+type FormatJobSpecificationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// jobs are the normalized specifications, in the order of the request
+	Jobs []*JobSpecification `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+}
+
+func (x *FormatJobSpecificationsResponse) Reset() {
+	*x = FormatJobSpecificationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FormatJobSpecificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FormatJobSpecificationsResponse) ProtoMessage() {}
+
+func (x *FormatJobSpecificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FormatJobSpecificationsResponse.ProtoReflect.Descriptor instead.
+func (*FormatJobSpecificationsResponse) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *FormatJobSpecificationsResponse) GetJobs() []*JobSpecification {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+type JobInspectResponse_BasicInfoSection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Job         *JobSpecification `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	Source      []string          `protobuf:"bytes,2,rep,name=source,proto3" json:"source,omitempty"`
+	Destination string            `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"`
+	Notice      []*Log            `protobuf:"bytes,4,rep,name=notice,proto3" json:"notice,omitempty"`
+}
+
+func (x *JobInspectResponse_BasicInfoSection) Reset() {
+	*x = JobInspectResponse_BasicInfoSection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JobInspectResponse_BasicInfoSection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobInspectResponse_BasicInfoSection) ProtoMessage() {}
+
+func (x *JobInspectResponse_BasicInfoSection) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobInspectResponse_BasicInfoSection.ProtoReflect.Descriptor instead.
+func (*JobInspectResponse_BasicInfoSection) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *JobInspectResponse_BasicInfoSection) GetJob() *JobSpecification {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+func (x *JobInspectResponse_BasicInfoSection) GetSource() []string {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *JobInspectResponse_BasicInfoSection) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *JobInspectResponse_BasicInfoSection) GetNotice() []*Log {
+	if x != nil {
+		return x.Notice
+	}
+	return nil
+}
+
+type JobInspectResponse_JobDependency struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name          string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Host          string    `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	ProjectName   string    `protobuf:"bytes,3,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	NamespaceName string    `protobuf:"bytes,4,opt,name=namespace_name,json=namespaceName,proto3" json:"namespace_name,omitempty"`
+	TaskName      string    `protobuf:"bytes,5,opt,name=task_name,json=taskName,proto3" json:"task_name,omitempty"`
+	Runs          []*JobRun `protobuf:"bytes,6,rep,name=runs,proto3" json:"runs,omitempty"`
+}
+
+func (x *JobInspectResponse_JobDependency) Reset() {
+	*x = JobInspectResponse_JobDependency{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JobInspectResponse_JobDependency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobInspectResponse_JobDependency) ProtoMessage() {}
+
+func (x *JobInspectResponse_JobDependency) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobInspectResponse_JobDependency.ProtoReflect.Descriptor instead.
+func (*JobInspectResponse_JobDependency) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{8, 1}
+}
+
+func (x *JobInspectResponse_JobDependency) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *JobInspectResponse_JobDependency) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *JobInspectResponse_JobDependency) GetProjectName() string {
+	if x != nil {
+		return x.ProjectName
+	}
+	return ""
+}
+
+func (x *JobInspectResponse_JobDependency) GetNamespaceName() string {
+	if x != nil {
+		return x.NamespaceName
+	}
+	return ""
+}
+
+func (x *JobInspectResponse_JobDependency) GetTaskName() string {
+	if x != nil {
+		return x.TaskName
+	}
+	return ""
+}
+
+func (x *JobInspectResponse_JobDependency) GetRuns() []*JobRun {
+	if x != nil {
+		return x.Runs
+	}
+	return nil
+}
+
+type JobInspectResponse_UpstreamSection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExternalDependency  []*JobInspectResponse_JobDependency                       `protobuf:"bytes,1,rep,name=external_dependency,json=externalDependency,proto3" json:"external_dependency,omitempty"`
+	InternalDependency  []*JobInspectResponse_JobDependency                       `protobuf:"bytes,2,rep,name=internal_dependency,json=internalDependency,proto3" json:"internal_dependency,omitempty"`
+	HttpDependency      []*HttpDependency                                         `protobuf:"bytes,3,rep,name=http_dependency,json=httpDependency,proto3" json:"http_dependency,omitempty"`
+	UnknownDependencies []*JobInspectResponse_UpstreamSection_UnknownDependencies `protobuf:"bytes,4,rep,name=unknown_dependencies,json=unknownDependencies,proto3" json:"unknown_dependencies,omitempty"`
+	Notice              []*Log                                                    `protobuf:"bytes,5,rep,name=notice,proto3" json:"notice,omitempty"`
+}
+
+func (x *JobInspectResponse_UpstreamSection) Reset() {
+	*x = JobInspectResponse_UpstreamSection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JobInspectResponse_UpstreamSection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobInspectResponse_UpstreamSection) ProtoMessage() {}
+
+func (x *JobInspectResponse_UpstreamSection) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobInspectResponse_UpstreamSection.ProtoReflect.Descriptor instead.
+func (*JobInspectResponse_UpstreamSection) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{8, 2}
+}
+
+func (x *JobInspectResponse_UpstreamSection) GetExternalDependency() []*JobInspectResponse_JobDependency {
+	if x != nil {
+		return x.ExternalDependency
+	}
+	return nil
+}
+
+func (x *JobInspectResponse_UpstreamSection) GetInternalDependency() []*JobInspectResponse_JobDependency {
+	if x != nil {
+		return x.InternalDependency
+	}
+	return nil
+}
+
+func (x *JobInspectResponse_UpstreamSection) GetHttpDependency() []*HttpDependency {
+	if x != nil {
+		return x.HttpDependency
+	}
+	return nil
+}
+
+func (x *JobInspectResponse_UpstreamSection) GetUnknownDependencies() []*JobInspectResponse_UpstreamSection_UnknownDependencies {
+	if x != nil {
+		return x.UnknownDependencies
+	}
+	return nil
+}
+
+func (x *JobInspectResponse_UpstreamSection) GetNotice() []*Log {
+	if x != nil {
+		return x.Notice
+	}
+	return nil
+}
+
+type JobInspectResponse_DownstreamSection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DownstreamJobs []*JobInspectResponse_JobDependency `protobuf:"bytes,1,rep,name=downstream_jobs,json=downstreamJobs,proto3" json:"downstream_jobs,omitempty"`
+	Notice         []*Log                              `protobuf:"bytes,2,rep,name=notice,proto3" json:"notice,omitempty"`
+}
+
+func (x *JobInspectResponse_DownstreamSection) Reset() {
+	*x = JobInspectResponse_DownstreamSection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JobInspectResponse_DownstreamSection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobInspectResponse_DownstreamSection) ProtoMessage() {}
+
+func (x *JobInspectResponse_DownstreamSection) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobInspectResponse_DownstreamSection.ProtoReflect.Descriptor instead.
+func (*JobInspectResponse_DownstreamSection) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{8, 3}
+}
+
+func (x *JobInspectResponse_DownstreamSection) GetDownstreamJobs() []*JobInspectResponse_JobDependency {
+	if x != nil {
+		return x.DownstreamJobs
+	}
+	return nil
+}
+
+func (x *JobInspectResponse_DownstreamSection) GetNotice() []*Log {
+	if x != nil {
+		return x.Notice
+	}
+	return nil
+}
+
+type JobInspectResponse_UpstreamSection_UnknownDependencies struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobName             string `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	ProjectName         string `protobuf:"bytes,2,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	ResourceDestination string `protobuf:"bytes,3,opt,name=resource_destination,json=resourceDestination,proto3" json:"resource_destination,omitempty"`
+}
+
+func (x *JobInspectResponse_UpstreamSection_UnknownDependencies) Reset() {
+	*x = JobInspectResponse_UpstreamSection_UnknownDependencies{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JobInspectResponse_UpstreamSection_UnknownDependencies) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobInspectResponse_UpstreamSection_UnknownDependencies) ProtoMessage() {}
+
+func (x *JobInspectResponse_UpstreamSection_UnknownDependencies) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobInspectResponse_UpstreamSection_UnknownDependencies.ProtoReflect.Descriptor instead.
+func (*JobInspectResponse_UpstreamSection_UnknownDependencies) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{8, 2, 0}
+}
+
+func (x *JobInspectResponse_UpstreamSection_UnknownDependencies) GetJobName() string {
+	if x != nil {
+		return x.JobName
+	}
+	return ""
+}
+
+func (x *JobInspectResponse_UpstreamSection_UnknownDependencies) GetProjectName() string {
+	if x != nil {
+		return x.ProjectName
+	}
+	return ""
+}
+
+func (x *JobInspectResponse_UpstreamSection_UnknownDependencies) GetResourceDestination() string {
+	if x != nil {
+		return x.ResourceDestination
+	}
+	return ""
+}
+
+type GetJobDeletionConsentsResponse_Consent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DownstreamProjectName string                 `protobuf:"bytes,1,opt,name=downstream_project_name,json=downstreamProjectName,proto3" json:"downstream_project_name,omitempty"`
+	DownstreamJobName     string                 `protobuf:"bytes,2,opt,name=downstream_job_name,json=downstreamJobName,proto3" json:"downstream_job_name,omitempty"`
+	GivenBy               string                 `protobuf:"bytes,3,opt,name=given_by,json=givenBy,proto3" json:"given_by,omitempty"`
+	Reason                string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt             *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *GetJobDeletionConsentsResponse_Consent) Reset() {
+	*x = GetJobDeletionConsentsResponse_Consent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetJobDeletionConsentsResponse_Consent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobDeletionConsentsResponse_Consent) ProtoMessage() {}
+
+func (x *GetJobDeletionConsentsResponse_Consent) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobDeletionConsentsResponse_Consent.ProtoReflect.Descriptor instead.
+func (*GetJobDeletionConsentsResponse_Consent) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{29, 0}
+}
+
+func (x *GetJobDeletionConsentsResponse_Consent) GetDownstreamProjectName() string {
+	if x != nil {
+		return x.DownstreamProjectName
+	}
+	return ""
+}
+
+func (x *GetJobDeletionConsentsResponse_Consent) GetDownstreamJobName() string {
+	if x != nil {
+		return x.DownstreamJobName
+	}
+	return ""
+}
+
+func (x *GetJobDeletionConsentsResponse_Consent) GetGivenBy() string {
+	if x != nil {
+		return x.GivenBy
+	}
+	return ""
+}
+
+func (x *GetJobDeletionConsentsResponse_Consent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *GetJobDeletionConsentsResponse_Consent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetJobDeletionConsentsResponse_Audit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestedBy          string                 `protobuf:"bytes,1,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	Reason               string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Forced               bool                   `protobuf:"varint,3,opt,name=forced,proto3" json:"forced,omitempty"`
+	ConsentedDownstream  []string               `protobuf:"bytes,4,rep,name=consented_downstream,json=consentedDownstream,proto3" json:"consented_downstream,omitempty"`
+	OverriddenDownstream []string               `protobuf:"bytes,5,rep,name=overridden_downstream,json=overriddenDownstream,proto3" json:"overridden_downstream,omitempty"`
+	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *GetJobDeletionConsentsResponse_Audit) Reset() {
+	*x = GetJobDeletionConsentsResponse_Audit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetJobDeletionConsentsResponse_Audit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobDeletionConsentsResponse_Audit) ProtoMessage() {}
+
+func (x *GetJobDeletionConsentsResponse_Audit) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobDeletionConsentsResponse_Audit.ProtoReflect.Descriptor instead.
+func (*GetJobDeletionConsentsResponse_Audit) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{29, 1}
+}
+
+func (x *GetJobDeletionConsentsResponse_Audit) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *GetJobDeletionConsentsResponse_Audit) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *GetJobDeletionConsentsResponse_Audit) GetForced() bool {
+	if x != nil {
+		return x.Forced
+	}
+	return false
+}
+
+func (x *GetJobDeletionConsentsResponse_Audit) GetConsentedDownstream() []string {
+	if x != nil {
+		return x.ConsentedDownstream
+	}
+	return nil
+}
+
+func (x *GetJobDeletionConsentsResponse_Audit) GetOverriddenDownstream() []string {
+	if x != nil {
+		return x.OverriddenDownstream
+	}
+	return nil
+}
+
+func (x *GetJobDeletionConsentsResponse_Audit) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ValidateJobSpecsResponse_Result struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobName string   `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	Valid   bool     `protobuf:"varint,2,opt,name=valid,proto3" json:"valid,omitempty"`
+	Errors  []string `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *ValidateJobSpecsResponse_Result) Reset() {
+	*x = ValidateJobSpecsResponse_Result{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateJobSpecsResponse_Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateJobSpecsResponse_Result) ProtoMessage() {}
+
+func (x *ValidateJobSpecsResponse_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateJobSpecsResponse_Result.ProtoReflect.Descriptor instead.
+func (*ValidateJobSpecsResponse_Result) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{33, 0}
+}
+
+func (x *ValidateJobSpecsResponse_Result) GetJobName() string {
+	if x != nil {
+		return x.JobName
+	}
+	return ""
+}
+
+func (x *ValidateJobSpecsResponse_Result) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateJobSpecsResponse_Result) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type JobSpecification_Behavior struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Retry  *JobSpecification_Behavior_Retry       `protobuf:"bytes,1,opt,name=retry,proto3" json:"retry,omitempty"`
+	Notify []*JobSpecification_Behavior_Notifiers `protobuf:"bytes,2,rep,name=notify,proto3" json:"notify,omitempty"`
+}
+
+func (x *JobSpecification_Behavior) Reset() {
+	*x = JobSpecification_Behavior{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JobSpecification_Behavior) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobSpecification_Behavior) ProtoMessage() {}
+
+func (x *JobSpecification_Behavior) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobSpecification_Behavior.ProtoReflect.Descriptor instead.
+func (*JobSpecification_Behavior) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{40, 2}
+}
+
+func (x *JobSpecification_Behavior) GetRetry() *JobSpecification_Behavior_Retry {
+	if x != nil {
+		return x.Retry
+	}
+	return nil
+}
+
+func (x *JobSpecification_Behavior) GetNotify() []*JobSpecification_Behavior_Notifiers {
+	if x != nil {
+		return x.Notify
+	}
+	return nil
+}
+
+// retry behaviour if job failed to execute for the first time
+type JobSpecification_Behavior_Retry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count              int32                `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Delay              *durationpb.Duration `protobuf:"bytes,2,opt,name=delay,proto3" json:"delay,omitempty"`
+	ExponentialBackoff bool                 `protobuf:"varint,3,opt,name=exponential_backoff,json=exponentialBackoff,proto3" json:"exponential_backoff,omitempty"`
+}
+
+func (x *JobSpecification_Behavior_Retry) Reset() {
+	*x = JobSpecification_Behavior_Retry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JobSpecification_Behavior_Retry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobSpecification_Behavior_Retry) ProtoMessage() {}
+
+func (x *JobSpecification_Behavior_Retry) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobSpecification_Behavior_Retry.ProtoReflect.Descriptor instead.
+func (*JobSpecification_Behavior_Retry) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{40, 2, 0}
+}
+
+func (x *JobSpecification_Behavior_Retry) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *JobSpecification_Behavior_Retry) GetDelay() *durationpb.Duration {
+	if x != nil {
+		return x.Delay
+	}
+	return nil
+}
+
+func (x *JobSpecification_Behavior_Retry) GetExponentialBackoff() bool {
+	if x != nil {
+		return x.ExponentialBackoff
+	}
+	return false
+}
+
+// Notifiers are used to set custom alerting in case of job failure/sla_miss
+type JobSpecification_Behavior_Notifiers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	On       JobEvent_Type     `protobuf:"varint,1,opt,name=on,proto3,enum=gotocompany.optimus.core.v1beta1.JobEvent_Type" json:"on,omitempty"`
+	Channels []string          `protobuf:"bytes,2,rep,name=channels,proto3" json:"channels,omitempty"`
+	Config   map[string]string `protobuf:"bytes,3,rep,name=config,proto3" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *JobSpecification_Behavior_Notifiers) Reset() {
+	*x = JobSpecification_Behavior_Notifiers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JobSpecification_Behavior_Notifiers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobSpecification_Behavior_Notifiers) ProtoMessage() {}
+
+func (x *JobSpecification_Behavior_Notifiers) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobSpecification_Behavior_Notifiers.ProtoReflect.Descriptor instead.
+func (*JobSpecification_Behavior_Notifiers) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{40, 2, 1}
+}
+
+func (x *JobSpecification_Behavior_Notifiers) GetOn() JobEvent_Type {
+	if x != nil {
+		return x.On
+	}
+	return JobEvent_TYPE_UNSPECIFIED
+}
+
+func (x *JobSpecification_Behavior_Notifiers) GetChannels() []string {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *JobSpecification_Behavior_Notifiers) GetConfig() map[string]string {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type JobTask_Destination struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Destination string `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	Type        string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (x *JobTask_Destination) Reset() {
+	*x = JobTask_Destination{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JobTask_Destination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobTask_Destination) ProtoMessage() {}
+
+func (x *JobTask_Destination) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobTask_Destination.ProtoReflect.Descriptor instead.
+func (*JobTask_Destination) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{62, 0}
+}
+
+func (x *JobTask_Destination) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *JobTask_Destination) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type JobTask_Dependency struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Dependency string `protobuf:"bytes,1,opt,name=dependency,proto3" json:"dependency,omitempty"`
+}
+
+func (x *JobTask_Dependency) Reset() {
+	*x = JobTask_Dependency{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JobTask_Dependency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobTask_Dependency) ProtoMessage() {}
+
+func (x *JobTask_Dependency) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobTask_Dependency.ProtoReflect.Descriptor instead.
+func (*JobTask_Dependency) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{62, 1}
+}
+
+func (x *JobTask_Dependency) GetDependency() string {
+	if x != nil {
+		return x.Dependency
+	}
+	return ""
+}
+
+type SyncJobsStateRequest_JobStatePair struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobName string   `protobuf:"bytes,1,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	State   JobState `protobuf:"varint,2,opt,name=state,proto3,enum=gotocompany.optimus.core.v1beta1.JobState" json:"state,omitempty"`
+}
+
+func (x *SyncJobsStateRequest_JobStatePair) Reset() {
+	*x = SyncJobsStateRequest_JobStatePair{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncJobsStateRequest_JobStatePair) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncJobsStateRequest_JobStatePair) ProtoMessage() {}
+
+func (x *SyncJobsStateRequest_JobStatePair) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncJobsStateRequest_JobStatePair.ProtoReflect.Descriptor instead.
+func (*SyncJobsStateRequest_JobStatePair) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP(), []int{67, 0}
+}
+
+func (x *SyncJobsStateRequest_JobStatePair) GetJobName() string {
+	if x != nil {
+		return x.JobName
+	}
+	return ""
+}
+
+func (x *SyncJobsStateRequest_JobStatePair) GetState() JobState {
+	if x != nil {
+		return x.State
+	}
+	return JobState_JOB_STATE_UNSPECIFIED
+}
+
+var File_gotocompany_optimus_core_v1beta1_job_spec_proto protoreflect.FileDescriptor
+
+var file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDesc = []byte{
 	0x0a, 0x2f, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2f, 0x6f, 0x70,
 	0x74, 0x69, 0x6d, 0x75, 0x73, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
 	0x61, 0x31, 0x2f, 0x6a, 0x6f, 0x62, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74,
@@ -4313,736 +5668,1051 @@ var file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDesc = []byte{
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
 	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
 	0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xae, 0x01, 0x0a,
-	0x19, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a,
-	0x0e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x2c, 0x0a, 0x12, 0x6e, 0x65, 0x77, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6e, 0x65, 0x77,
-	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x1c, 0x0a,
-	0x1a, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x67, 0x0a, 0x1b, 0x4c,
-	0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a,
-	0x0e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x66, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x53,
-	0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79,
-	0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x22, 0xae, 0x01, 0x0a,
-	0x1c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
-	0x0c, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x44, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e,
-	0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69,
-	0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x39, 0x0a,
-	0x1d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0xb1, 0x01, 0x0a, 0x1d, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x46, 0x0a,
-	0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x6f,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xb5, 0x01, 0x0a,
+	0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x08, 0x6a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6c, 0x65,
+	0x61, 0x6e, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0c, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66,
+	0x6f, 0x72, 0x63, 0x65, 0x22, 0x5f, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f,
+	0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x12, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x44, 0x6f, 0x77, 0x6e, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x22, 0xae, 0x01, 0x0a, 0x19, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x4a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x6e, 0x65, 0x77, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6e, 0x65, 0x77, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x1c, 0x0a, 0x1a, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x4a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa3, 0x01, 0x0a, 0x0e, 0x4d, 0x6f, 0x76, 0x65, 0x4a, 0x6f, 0x62,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x12,
+	0x6e, 0x65, 0x77, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6e, 0x65, 0x77, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x11, 0x0a, 0x0f, 0x4d, 0x6f,
+	0x76, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9d, 0x01,
+	0x0a, 0x14, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x19, 0x0a, 0x08, 0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x6e,
+	0x65, 0x77, 0x5f, 0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x6e, 0x65, 0x77, 0x4a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x32, 0x0a,
+	0x15, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6a, 0x6f, 0x62, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0xb4, 0x01, 0x0a, 0x0e, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x59, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x4a,
+	0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6a, 0x6f, 0x62, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6a, 0x6f, 0x62, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x6a, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65,
+	0x63, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4c, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
+	0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0xd4, 0x01, 0x0a, 0x1c, 0x41, 0x64, 0x64, 0x4a, 0x6f, 0x62, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x32,
+	0x0a, 0x15, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x75,
+	0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x6a,
+	0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x75,
+	0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x1f, 0x0a, 0x1d, 0x41, 0x64, 0x64, 0x4a, 0x6f, 0x62,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5d, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x4a, 0x6f,
+	0x62, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6a,
+	0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6a,
+	0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xc8, 0x05, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x4a, 0x6f,
+	0x62, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x08, 0x63, 0x6f, 0x6e,
+	0x73, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x48, 0x2e, 0x67, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75,
+	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x4a, 0x6f, 0x62, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e,
+	0x73, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6f,
+	0x6e, 0x73, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x5e, 0x0a, 0x06, 0x61, 0x75, 0x64, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x46, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70,
+	0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x06, 0x61, 0x75, 0x64, 0x69, 0x74, 0x73, 0x1a,
+	0xdf, 0x01, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x17, 0x64,
+	0x6f, 0x77, 0x6e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x64, 0x6f,
+	0x77, 0x6e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x64, 0x6f, 0x77, 0x6e, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x5f, 0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x11, 0x64, 0x6f, 0x77, 0x6e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4a, 0x6f, 0x62, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x69, 0x76, 0x65, 0x6e, 0x5f, 0x62, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x69, 0x76, 0x65, 0x6e, 0x42, 0x79, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x1a, 0xfd, 0x01, 0x0a, 0x05, 0x41, 0x75, 0x64, 0x69, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x64, 0x12, 0x31,
+	0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x6f, 0x77, 0x6e,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x63, 0x6f,
+	0x6e, 0x73, 0x65, 0x6e, 0x74, 0x65, 0x64, 0x44, 0x6f, 0x77, 0x6e, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x12, 0x33, 0x0a, 0x15, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x5f,
+	0x64, 0x6f, 0x77, 0x6e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x14, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x44, 0x6f, 0x77, 0x6e,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x93, 0x01, 0x0a, 0x12, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x4a, 0x6f,
+	0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x4a, 0x0a, 0x13, 0x52, 0x6f, 0x6c, 0x6c, 0x62,
+	0x61, 0x63, 0x6b, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x22, 0xab, 0x01, 0x0a, 0x17, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x46, 0x0a, 0x04, 0x6a, 0x6f, 0x62,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x70,
+	0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x6a, 0x6f, 0x62,
+	0x73, 0x22, 0xe0, 0x01, 0x0a, 0x18, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f,
+	0x62, 0x53, 0x70, 0x65, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x12, 0x5b, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70,
+	0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x1a, 0x51, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6a,
+	0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6a,
+	0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x73, 0x22, 0x67, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x53,
+	0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x66, 0x0a,
+	0x1c, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a,
+	0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x6f,
 	0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75,
 	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4a,
 	0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x04, 0x6a, 0x6f, 0x62, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e,
-	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x6c, 0x0a, 0x1e,
-	0x43, 0x68, 0x65, 0x63, 0x6b, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44,
-	0x0a, 0x0a, 0x6c, 0x6f, 0x67, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79,
-	0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x09, 0x6c, 0x6f, 0x67, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x05, 0x22, 0x8b, 0x0e, 0x0a, 0x10, 0x4a,
-	0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77,
-	0x6e, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61,
-	0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a,
-	0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x26, 0x0a, 0x0f, 0x64, 0x65, 0x70,
-	0x65, 0x6e, 0x64, 0x73, 0x5f, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0d, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x73, 0x4f, 0x6e, 0x50, 0x61, 0x73,
-	0x74, 0x12, 0x1d, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x75, 0x70, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x08, 0x42, 0x02, 0x18, 0x01, 0x52, 0x07, 0x63, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70,
-	0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x47, 0x0a,
-	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e,
-	0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69,
-	0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2e, 0x4a, 0x6f, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x06,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
-	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x69, 0x6e,
-	0x64, 0x6f, 0x77, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x69, 0x6e, 0x64, 0x6f,
-	0x77, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x2c, 0x0a, 0x12,
-	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x5f,
-	0x74, 0x6f, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
-	0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x69,
-	0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x12,
-	0x53, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18,
-	0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70,
-	0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65,
-	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x44, 0x65, 0x70, 0x65,
-	0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e,
-	0x63, 0x69, 0x65, 0x73, 0x12, 0x56, 0x0a, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x0f,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x04, 0x6a, 0x6f, 0x62, 0x73, 0x22, 0xae, 0x01, 0x0a, 0x1c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4a,
+	0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x44, 0x0a, 0x03, 0x6a, 0x6f, 0x62,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65,
+	0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x12,
+	0x25, 0x0a, 0x0e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x39, 0x0a, 0x1d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4a,
+	0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x22, 0xb1, 0x01, 0x0a, 0x1d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4a, 0x6f, 0x62, 0x53, 0x70,
+	0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x46, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61,
 	0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
 	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x43, 0x0a, 0x05,
-	0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x67, 0x6f,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x12, 0x25,
+	0x0a, 0x0e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x6c, 0x0a, 0x1e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4a, 0x6f,
+	0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0a, 0x6c, 0x6f, 0x67, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x67, 0x6f,
 	0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75,
-	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4a,
-	0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x48, 0x6f, 0x6f, 0x6b, 0x52, 0x05, 0x68, 0x6f, 0x6f, 0x6b,
-	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x56, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x12, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
-	0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x57, 0x0a, 0x08, 0x62,
-	0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e,
-	0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69,
-	0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x52, 0x08, 0x62, 0x65, 0x68, 0x61,
-	0x76, 0x69, 0x6f, 0x72, 0x12, 0x49, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d,
+	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c,
+	0x6f, 0x67, 0x52, 0x09, 0x6c, 0x6f, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4a, 0x04, 0x08,
+	0x01, 0x10, 0x05, 0x22, 0x8b, 0x0e, 0x0a, 0x10, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65,
+	0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65,
+	0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x12, 0x26, 0x0a, 0x0f, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x73, 0x5f, 0x6f, 0x6e,
+	0x5f, 0x70, 0x61, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x64, 0x65, 0x70,
+	0x65, 0x6e, 0x64, 0x73, 0x4f, 0x6e, 0x50, 0x61, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x08, 0x63, 0x61,
+	0x74, 0x63, 0x68, 0x5f, 0x75, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x42, 0x02, 0x18, 0x01,
+	0x52, 0x07, 0x63, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x73,
+	0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x61,
+	0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x47, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d,
 	0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72,
-	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
-	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x15,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x16, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x41,
-	0x73, 0x73, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x1a, 0xd4, 0x04, 0x0a, 0x08, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x12, 0x57,
-	0x0a, 0x05, 0x72, 0x65, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e,
+	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x1f, 0x0a, 0x0b, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x23, 0x0a, 0x0d, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x4f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f,
+	0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x6f, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x10, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74,
+	0x65, 0x54, 0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x70, 0x72,
+	0x65, 0x73, 0x65, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x12, 0x53, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x65,
+	0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f,
+	0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74,
+	0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x52,
+	0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x56, 0x0a,
+	0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e,
 	0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69,
 	0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
 	0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79,
-	0x52, 0x05, 0x72, 0x65, 0x74, 0x72, 0x79, 0x12, 0x5d, 0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x69, 0x66,
-	0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x45, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f,
-	0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x70,
-	0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x42, 0x65, 0x68, 0x61,
-	0x76, 0x69, 0x6f, 0x72, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x52, 0x06,
-	0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x1a, 0x7f, 0x0a, 0x05, 0x52, 0x65, 0x74, 0x72, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x2f, 0x0a, 0x13, 0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65,
-	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x12, 0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
-	0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x1a, 0x8e, 0x02, 0x0a, 0x09, 0x4e, 0x6f, 0x74, 0x69,
-	0x66, 0x69, 0x65, 0x72, 0x73, 0x12, 0x3f, 0x0a, 0x02, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x2f, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e,
-	0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x02, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x73, 0x12, 0x69, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x51, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79,
-	0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e,
-	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x39, 0x0a,
-	0x0b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x92, 0x01, 0x0a, 0x0d, 0x4a, 0x6f, 0x62,
-	0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x12, 0x59, 0x0a, 0x0f, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x64, 0x65, 0x70, 0x65, 0x6e,
-	0x64, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x67, 0x6f,
-	0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75,
-	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x48,
-	0x74, 0x74, 0x70, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x0e, 0x68,
-	0x74, 0x74, 0x70, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x22, 0xdc, 0x02,
-	0x0a, 0x0e, 0x48, 0x74, 0x74, 0x70, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x57, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f,
-	0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x44,
-	0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12,
-	0x54, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x3c, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70,
+	0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x43, 0x0a, 0x05, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x10,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x48,
+	0x6f, 0x6f, 0x6b, 0x52, 0x05, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x56, 0x0a, 0x06,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x67,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d,
+	0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x12, 0x57, 0x0a, 0x08, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72,
+	0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65,
+	0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x42, 0x65, 0x68, 0x61, 0x76,
+	0x69, 0x6f, 0x72, 0x52, 0x08, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x12, 0x49, 0x0a,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2d, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70,
 	0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63,
-	0x79, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x70,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x61, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x16, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
+	0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xd4, 0x04, 0x0a, 0x08, 0x42,
+	0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x12, 0x57, 0x0a, 0x05, 0x72, 0x65, 0x74, 0x72, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65,
+	0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x42, 0x65, 0x68, 0x61, 0x76,
+	0x69, 0x6f, 0x72, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x52, 0x05, 0x72, 0x65, 0x74, 0x72, 0x79,
+	0x12, 0x5d, 0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x45, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f,
+	0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x52, 0x06, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x1a,
+	0x7f, 0x0a, 0x05, 0x52, 0x65, 0x74, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2f,
+	0x0a, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x12,
+	0x2f, 0x0a, 0x13, 0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x62,
+	0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x65, 0x78,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66,
+	0x1a, 0x8e, 0x02, 0x0a, 0x09, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x12, 0x3f,
+	0x0a, 0x02, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x67, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4a, 0x6f,
+	0x62, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x02, 0x6f, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x69, 0x0a, 0x06, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x51, 0x2e, 0x67, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75,
+	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4a,
+	0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x39, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
 	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x1a, 0x39, 0x0a, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x6a, 0x0a, 0x0b,
-	0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x48, 0x6f, 0x6f, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x47, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x2f, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70,
-	0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x74, 0x65, 0x6d,
-	0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x39, 0x0a, 0x0d, 0x4a, 0x6f, 0x62, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x22, 0xe5, 0x03, 0x0a, 0x08, 0x4a, 0x6f, 0x62, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x12, 0x43, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f,
-	0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74,
-	0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x22, 0xe4, 0x02, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a,
-	0x10, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
-	0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x4c, 0x41, 0x5f,
-	0x4d, 0x49, 0x53, 0x53, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4a,
-	0x4f, 0x42, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x06, 0x12, 0x10, 0x0a, 0x0c,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x07, 0x12, 0x13,
-	0x0a, 0x0f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x52, 0x45, 0x54, 0x52,
-	0x59, 0x10, 0x08, 0x12, 0x15, 0x0a, 0x11, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x41, 0x53, 0x4b,
-	0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x09, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x10, 0x0a, 0x12,
-	0x12, 0x0a, 0x0e, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x46, 0x41, 0x49,
-	0x4c, 0x10, 0x0b, 0x12, 0x15, 0x0a, 0x11, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x45, 0x4e, 0x53,
-	0x4f, 0x52, 0x5f, 0x52, 0x45, 0x54, 0x52, 0x59, 0x10, 0x0c, 0x12, 0x17, 0x0a, 0x13, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x53, 0x45, 0x4e, 0x53, 0x4f, 0x52, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53,
-	0x53, 0x10, 0x0d, 0x12, 0x15, 0x0a, 0x11, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x45, 0x4e, 0x53,
-	0x4f, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x10, 0x0e, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x53, 0x45, 0x4e, 0x53, 0x4f, 0x52, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x10, 0x0f,
-	0x12, 0x13, 0x0a, 0x0f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x53, 0x54,
-	0x41, 0x52, 0x54, 0x10, 0x10, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x48, 0x4f,
-	0x4f, 0x4b, 0x5f, 0x52, 0x45, 0x54, 0x52, 0x59, 0x10, 0x11, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x10, 0x12, 0x12, 0x15,
-	0x0a, 0x11, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x53, 0x55, 0x43, 0x43,
-	0x45, 0x53, 0x53, 0x10, 0x13, 0x22, 0x04, 0x08, 0x02, 0x10, 0x05, 0x22, 0xb8, 0x01, 0x0a, 0x0b,
-	0x4a, 0x6f, 0x62, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x55, 0x0a, 0x08, 0x72,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e,
-	0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69,
-	0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x12, 0x52, 0x0a, 0x07, 0x61, 0x69, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
-	0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x41, 0x69, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x07, 0x61,
-	0x69, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x22, 0xcb, 0x01, 0x0a, 0x17, 0x4a, 0x6f, 0x62, 0x53, 0x70,
-	0x65, 0x63, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x12, 0x59, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
-	0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x55, 0x0a,
-	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x67,
+	0x01, 0x22, 0x92, 0x01, 0x0a, 0x0d, 0x4a, 0x6f, 0x62, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65,
+	0x6e, 0x63, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x59, 0x0a, 0x0f, 0x68,
+	0x74, 0x74, 0x70, 0x5f, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x44, 0x65, 0x70, 0x65,
+	0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x0e, 0x68, 0x74, 0x74, 0x70, 0x44, 0x65, 0x70, 0x65,
+	0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x22, 0xdc, 0x02, 0x0a, 0x0e, 0x48, 0x74, 0x74, 0x70, 0x44,
+	0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12,
+	0x57, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x3d, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f,
+	0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e,
+	0x63, 0x79, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x54, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x48, 0x74, 0x74, 0x70,
+	0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x3a,
+	0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x39, 0x0a, 0x0b, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x6a, 0x0a, 0x0b, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63,
+	0x48, 0x6f, 0x6f, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x47, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x22, 0x39, 0x0a, 0x0d, 0x4a, 0x6f, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x74,
+	0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xe5, 0x03, 0x0a,
+	0x08, 0x4a, 0x6f, 0x62, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x43, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2d,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xe4, 0x02,
+	0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x4c, 0x41, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x10, 0x01, 0x12,
+	0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x55, 0x43, 0x43,
+	0x45, 0x53, 0x53, 0x10, 0x06, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x41,
+	0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x07, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x54, 0x41, 0x53, 0x4b, 0x5f, 0x52, 0x45, 0x54, 0x52, 0x59, 0x10, 0x08, 0x12, 0x15, 0x0a, 0x11,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53,
+	0x53, 0x10, 0x09, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x41, 0x53, 0x4b,
+	0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x10, 0x0a, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x10, 0x0b, 0x12, 0x15, 0x0a, 0x11,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x45, 0x4e, 0x53, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x54, 0x52,
+	0x59, 0x10, 0x0c, 0x12, 0x17, 0x0a, 0x13, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x45, 0x4e, 0x53,
+	0x4f, 0x52, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x0d, 0x12, 0x15, 0x0a, 0x11,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x45, 0x4e, 0x53, 0x4f, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x52,
+	0x54, 0x10, 0x0e, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x45, 0x4e, 0x53,
+	0x4f, 0x52, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x10, 0x0f, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x10, 0x10, 0x12, 0x13,
+	0x0a, 0x0f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x52, 0x45, 0x54, 0x52,
+	0x59, 0x10, 0x11, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x48, 0x4f, 0x4f, 0x4b,
+	0x5f, 0x46, 0x41, 0x49, 0x4c, 0x10, 0x12, 0x12, 0x15, 0x0a, 0x11, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x13, 0x22, 0x04,
+	0x08, 0x02, 0x10, 0x05, 0x22, 0xb8, 0x01, 0x0a, 0x0b, 0x4a, 0x6f, 0x62, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x55, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65,
+	0x63, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x52, 0x0a, 0x07, 0x61,
+	0x69, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x67,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d,
+	0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x41,
+	0x69, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x07, 0x61, 0x69, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x22,
+	0xcb, 0x01, 0x0a, 0x17, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x59, 0x0a, 0x07, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x67,
 	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d,
 	0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
 	0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x05, 0x6c,
-	0x69, 0x6d, 0x69, 0x74, 0x22, 0x49, 0x0a, 0x1d, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x70, 0x75, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x63, 0x70, 0x75, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72,
-	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x22,
-	0x42, 0x0a, 0x16, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x41, 0x69, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x6f,
-	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x14, 0x0a,
-	0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75,
-	0x65, 0x75, 0x65, 0x22, 0x7d, 0x0a, 0x12, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x4a, 0x6f,
-	0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f,
-	0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f,
-	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x6f, 0x62, 0x4e, 0x61, 0x6d,
-	0x65, 0x73, 0x22, 0x61, 0x0a, 0x13, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x4a, 0x6f, 0x62,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0a, 0x6c, 0x6f, 0x67,
-	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x07, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x55, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70,
+	0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x49, 0x0a,
+	0x1d, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x10,
+	0x0a, 0x03, 0x63, 0x70, 0x75, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x70, 0x75,
+	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x22, 0x42, 0x0a, 0x16, 0x4a, 0x6f, 0x62, 0x53,
+	0x70, 0x65, 0x63, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x41, 0x69, 0x72, 0x66, 0x6c,
+	0x6f, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x22, 0x7d, 0x0a, 0x12,
+	0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x1b,
+	0x0a, 0x09, 0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x6a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x61, 0x0a, 0x13, 0x52,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x44, 0x0a, 0x0a, 0x6c, 0x6f, 0x67, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x09, 0x6c,
+	0x6f, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x06, 0x22, 0x39,
+	0x0a, 0x1a, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x4a, 0x6f, 0x62, 0x73, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x49, 0x64, 0x22, 0xa3, 0x03, 0x0a, 0x1b, 0x47, 0x65,
+	0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x4a, 0x6f, 0x62, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x4e, 0x0a, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
+	0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x4a, 0x6f, 0x62,
+	0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x66,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x89, 0x01, 0x0a, 0x14,
+	0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e,
+	0x63, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x56, 0x2e, 0x67, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x4a, 0x6f, 0x62, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77,
+	0x6e, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x13, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x44, 0x65, 0x70, 0x65, 0x6e,
+	0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x1a, 0x46, 0x0a, 0x18, 0x55, 0x6e, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6e, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x47, 0x0a, 0x10, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x4a, 0x6f, 0x62, 0x46, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xb5, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74,
+	0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x14, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19,
+	0x0a, 0x08, 0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0xe6, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4a, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x32, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70,
+	0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x02, 0x18, 0x01, 0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x12, 0x7a, 0x0a,
+	0x1b, 0x6a, 0x6f, 0x62, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79,
+	0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x19,
+	0x6a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x22, 0xaa, 0x01, 0x0a, 0x18, 0x4a, 0x6f,
+	0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x44, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e,
 	0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69,
 	0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x09, 0x6c, 0x6f, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4a,
-	0x04, 0x08, 0x01, 0x10, 0x06, 0x22, 0x39, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6c,
-	0x6f, 0x79, 0x4a, 0x6f, 0x62, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x49, 0x64,
-	0x22, 0xa3, 0x03, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x4a, 0x6f,
-	0x62, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x4e, 0x0a, 0x08, 0x66, 0x61, 0x69, 0x6c,
-	0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x6f, 0x74,
-	0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73,
-	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65,
-	0x70, 0x6c, 0x6f, 0x79, 0x4a, 0x6f, 0x62, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x08,
-	0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0c, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a,
-	0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x89, 0x01, 0x0a, 0x14, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x64,
-	0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x56, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e,
-	0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x4a, 0x6f,
-	0x62, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x2e, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e,
-	0x63, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x13, 0x75, 0x6e, 0x6b, 0x6e, 0x6f,
-	0x77, 0x6e, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x1a, 0x46,
-	0x0a, 0x18, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65,
-	0x6e, 0x63, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x47, 0x0a, 0x10, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
-	0x4a, 0x6f, 0x62, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6a, 0x6f,
-	0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6a, 0x6f,
-	0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
-	0xb5, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x31, 0x0a, 0x14, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x64,
-	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x13, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xe6, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4a,
-	0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d,
-	0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72,
-	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65,
-	0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x02, 0x18, 0x01, 0x52, 0x04,
-	0x6a, 0x6f, 0x62, 0x73, 0x12, 0x7a, 0x0a, 0x1b, 0x6a, 0x6f, 0x62, 0x5f, 0x73, 0x70, 0x65, 0x63,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x67, 0x6f, 0x74, 0x6f,
-	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e,
-	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4a, 0x6f, 0x62,
-	0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x19, 0x6a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73,
-	0x22, 0xaa, 0x01, 0x0a, 0x18, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a,
+	0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x22, 0xb6, 0x01, 0x0a, 0x22, 0x52, 0x65, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x41, 0x6c, 0x6c, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
 	0x0c, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65,
 	0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61,
 	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x44, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61,
-	0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x22, 0xb6, 0x01,
-	0x0a, 0x22, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x41, 0x6c, 0x6c, 0x4a, 0x6f, 0x62, 0x53,
-	0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6a,
-	0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x61, 0x6d, 0x65, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x46,
-	0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d,
-	0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x22, 0x6b, 0x0a, 0x23, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63,
-	0x65, 0x41, 0x6c, 0x6c, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a,
-	0x0a, 0x6c, 0x6f, 0x67, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x25, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e,
-	0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x09, 0x6c, 0x6f, 0x67, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x22, 0x78, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x54, 0x61, 0x73,
-	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6a,
-	0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6e,
-	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x53, 0x0a,
-	0x12, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x29, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e,
-	0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x04, 0x74, 0x61,
-	0x73, 0x6b, 0x22, 0xfb, 0x02, 0x0a, 0x07, 0x4a, 0x6f, 0x62, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x57, 0x0a, 0x0b, 0x64, 0x65,
-	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x35, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70,
+	0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x46, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70,
+	0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x22,
+	0x6b, 0x0a, 0x23, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x41, 0x6c, 0x6c, 0x4a, 0x6f, 0x62,
+	0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0a, 0x6c, 0x6f, 0x67, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x67, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4c, 0x6f,
+	0x67, 0x52, 0x09, 0x6c, 0x6f, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x78, 0x0a, 0x11,
+	0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6a,
+	0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6a,
+	0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x53, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62,
+	0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x04,
+	0x74, 0x61, 0x73, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x67, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4a, 0x6f,
+	0x62, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x22, 0xfb, 0x02, 0x0a, 0x07,
+	0x4a, 0x6f, 0x62, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x12, 0x57, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x54,
+	0x61, 0x73, 0x6b, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x58, 0x0a, 0x0c,
+	0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x34, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79,
+	0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x54, 0x61, 0x73, 0x6b, 0x2e, 0x44, 0x65,
+	0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64,
+	0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x1a, 0x43, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x1a, 0x2c, 0x0a, 0x0a, 0x44,
+	0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70,
+	0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64,
+	0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x22, 0xbc, 0x01, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d,
+	0x0a, 0x0c, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x72, 0x75,
+	0x6e, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x3a, 0x02, 0x18, 0x01, 0x22, 0x77, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12,
+	0x2c, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x3a, 0x02, 0x18,
+	0x01, 0x22, 0xd9, 0x01, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x73,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x25, 0x0a, 0x0e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x40,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e,
+	0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69,
+	0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x19, 0x0a,
+	0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb1, 0x02, 0x0a, 0x14, 0x53, 0x79, 0x6e,
+	0x63, 0x4a, 0x6f, 0x62, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x62, 0x0a, 0x0a, 0x6a,
+	0x6f, 0x62, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x43, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70,
 	0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x54, 0x61, 0x73, 0x6b, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x58, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63,
-	0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x67, 0x6f, 0x74, 0x6f,
+	0x61, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x4a, 0x6f, 0x62, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x50, 0x61, 0x69, 0x72, 0x52, 0x09, 0x6a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x1a,
+	0x6b, 0x0a, 0x0c, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x61, 0x69, 0x72, 0x12,
+	0x19, 0x0a, 0x08, 0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x67, 0x6f, 0x74, 0x6f,
 	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e,
 	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4a, 0x6f, 0x62,
-	0x54, 0x61, 0x73, 0x6b, 0x2e, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x52,
-	0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x1a, 0x43, 0x0a,
-	0x0b, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b,
-	0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12,
-	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x1a, 0x2c, 0x0a, 0x0a, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79,
-	0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79,
-	0x22, 0xbc, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x65, 0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73,
-	0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
-	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x6f, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x54,
-	0x6f, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x3a, 0x02, 0x18, 0x01, 0x22,
-	0x77, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x03, 0x65, 0x6e, 0x64, 0x3a, 0x02, 0x18, 0x01, 0x22, 0xd9, 0x01, 0x0a, 0x16, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
-	0x65, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x40, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61,
-	0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x6f, 0x62, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x6f, 0x62, 0x4e,
-	0x61, 0x6d, 0x65, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f,
-	0x62, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0xb1, 0x02, 0x0a, 0x14, 0x53, 0x79, 0x6e, 0x63, 0x4a, 0x6f, 0x62, 0x73, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6a,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x17, 0x0a, 0x15,
+	0x53, 0x79, 0x6e, 0x63, 0x4a, 0x6f, 0x62, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb2, 0x01, 0x0a, 0x1e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6a,
 	0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
 	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6e,
 	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x62, 0x0a, 0x0a, 0x6a, 0x6f, 0x62, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d,
-	0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72,
-	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x4a, 0x6f,
-	0x62, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4a,
-	0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x61, 0x69, 0x72, 0x52, 0x09, 0x6a, 0x6f, 0x62,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x1a, 0x6b, 0x0a, 0x0c, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x50, 0x61, 0x69, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6a, 0x6f, 0x62, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x40, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x2a, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f,
+	0x6d, 0x65, 0x12, 0x46, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x32, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f,
 	0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x22, 0x17, 0x0a, 0x15, 0x53, 0x79, 0x6e, 0x63, 0x4a, 0x6f, 0x62, 0x73, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x54, 0x0a, 0x08,
-	0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x4a, 0x4f, 0x42, 0x5f,
-	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
-	0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
-	0x5f, 0x45, 0x4e, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x4a, 0x4f,
-	0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44,
-	0x10, 0x02, 0x32, 0xd8, 0x1d, 0x0a, 0x17, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xa1,
-	0x01, 0x0a, 0x16, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x2e, 0x67, 0x6f, 0x74, 0x6f,
-	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e,
-	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x70,
-	0x6c, 0x6f, 0x79, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x40, 0x2e, 0x67, 0x6f, 0x74,
-	0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73,
-	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65,
-	0x70, 0x6c, 0x6f, 0x79, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01,
-	0x30, 0x01, 0x12, 0xca, 0x01, 0x0a, 0x0a, 0x4a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63,
-	0x74, 0x12, 0x33, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e,
-	0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d,
-	0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72,
-	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x49, 0x6e, 0x73,
-	0x70, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x51, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x4b, 0x22, 0x46, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2f,
-	0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d,
-	0x2f, 0x6a, 0x6f, 0x62, 0x2f, 0x69, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x3a, 0x01, 0x2a, 0x12,
-	0xe6, 0x01, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65,
-	0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x2e, 0x67, 0x6f, 0x74,
-	0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73,
-	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x40, 0x2e, 0x67, 0x6f,
+	0x74, 0x61, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x22, 0x69, 0x0a, 0x1f, 0x46, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a,
+	0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x6f,
 	0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75,
-	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x49, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x43, 0x22, 0x3e, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f,
-	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x7d, 0x2f, 0x6a, 0x6f, 0x62, 0x3a, 0x01, 0x2a, 0x12, 0xe1, 0x01, 0x0a, 0x14, 0x41, 0x64, 0x64,
+	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4a,
+	0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x04, 0x6a, 0x6f, 0x62, 0x73, 0x2a, 0x54, 0x0a, 0x08, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x19, 0x0a, 0x15, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11,
+	0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x45, 0x4e, 0x41, 0x42, 0x4c, 0x45,
+	0x44, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x5f, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x32, 0xb4, 0x2d, 0x0a, 0x17,
 	0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x3d, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e,
-	0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x3e, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f,
-	0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x4a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x44, 0x22, 0x3f, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a,
-	0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0xea, 0x01, 0x0a,
-	0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x40, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63,
-	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63,
-	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x41, 0x2e, 0x67, 0x6f, 0x74,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xa1, 0x01, 0x0a, 0x16, 0x44, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x3f, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79,
+	0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x4a, 0x6f, 0x62, 0x53,
+	0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x40, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
+	0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x4a, 0x6f, 0x62,
+	0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0xca, 0x01, 0x0a, 0x0a,
+	0x4a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x12, 0x33, 0x2e, 0x67, 0x6f, 0x74,
 	0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73,
-	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4a, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x44, 0x1a, 0x3f, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f,
-	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x7d, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0xe5, 0x01, 0x0a, 0x13, 0x47, 0x65,
-	0x74, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x3c, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e,
-	0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x3d, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4a, 0x6f,
+	0x62, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x34, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70,
 	0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x51,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4b, 0x12, 0x49, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x7d, 0x2f, 0x6a, 0x6f, 0x62, 0x2f, 0x7b, 0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x7d, 0x12, 0xac, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63,
+	0x61, 0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x51, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4b, 0x22, 0x46, 0x2f,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f,
+	0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6a, 0x6f, 0x62, 0x2f, 0x69, 0x6e,
+	0x73, 0x70, 0x65, 0x63, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0xe6, 0x01, 0x0a, 0x16, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
+	0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62,
+	0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x40, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f,
+	0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x49, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x43, 0x22, 0x3e,
+	0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6a, 0x6f, 0x62, 0x3a, 0x01,
+	0x2a, 0x12, 0xe1, 0x01, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63,
 	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3d, 0x2e, 0x67, 0x6f, 0x74,
 	0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73,
-	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x41, 0x64,
+	0x64, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
 	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3e, 0x2e, 0x67, 0x6f, 0x74, 0x6f,
 	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e,
-	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x41, 0x64, 0x64,
+	0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4a, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x44, 0x22, 0x3f, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x7d, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2f, 0x7b, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6a, 0x6f,
+	0x62, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0xea, 0x01, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
 	0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x0f, 0x12, 0x0d, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x6a, 0x6f, 0x62, 0x73,
-	0x12, 0xee, 0x01, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x53, 0x70,
-	0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x2e, 0x67, 0x6f,
+	0x73, 0x12, 0x40, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e,
+	0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x53, 0x70,
+	0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x41, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
+	0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62,
+	0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x44, 0x1a, 0x3f,
+	0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x3a,
+	0x01, 0x2a, 0x12, 0xe5, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65,
+	0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x2e, 0x67, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3d, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4a,
+	0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x51, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4b, 0x12,
+	0x49, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d,
+	0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6a, 0x6f, 0x62, 0x2f,
+	0x7b, 0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0xac, 0x01, 0x0a, 0x14, 0x47,
+	0x65, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x3d, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
+	0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65,
+	0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x3e, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79,
+	0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d, 0x2f, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x12, 0xee, 0x01, 0x0a, 0x16, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f,
+	0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x40, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70,
+	0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a,
+	0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x51, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4b, 0x2a,
+	0x49, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d,
+	0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6a, 0x6f, 0x62, 0x2f,
+	0x7b, 0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0xca, 0x01, 0x0a, 0x0a, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x33, 0x2e, 0x67, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34,
+	0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74,
+	0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x51, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4b, 0x22, 0x46, 0x2f, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x7b,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x2f, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0xd0, 0x01, 0x0a, 0x12, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x4a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x3b,
+	0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74,
+	0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3c, 0x2e, 0x67, 0x6f,
 	0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75,
-	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x40, 0x2e, 0x67,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d,
-	0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x51,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4b, 0x2a, 0x49, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x7d, 0x2f, 0x6a, 0x6f, 0x62, 0x2f, 0x7b, 0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x7d, 0x12, 0xd0, 0x01, 0x0a, 0x12, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x4e,
-	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x3b, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63,
+	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x39, 0x22, 0x34, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x7d, 0x2f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2d, 0x6a, 0x6f, 0x62, 0x2d, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0xc9, 0x01, 0x0a, 0x07, 0x4d,
+	0x6f, 0x76, 0x65, 0x4a, 0x6f, 0x62, 0x12, 0x30, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x4a, 0x6f,
+	0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x6f, 0x76, 0x65,
+	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x59, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x53, 0x22, 0x4e, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2f, 0x7b,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f,
+	0x6a, 0x6f, 0x62, 0x2f, 0x7b, 0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6d,
+	0x6f, 0x76, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0xe6, 0x01, 0x0a, 0x0d, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x4a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63,
 	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63,
 	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x4a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3c, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70,
-	0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65,
-	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4a,
-	0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x3f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x39, 0x22, 0x34, 0x2f, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x7b, 0x70,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x63, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x2d, 0x6a, 0x6f, 0x62, 0x2d, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x3a, 0x01, 0x2a, 0x12, 0xdd, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62,
-	0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x2e,
-	0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69,
-	0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3e, 0x2e, 0x67,
+	0x67, 0x65, 0x4a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x37, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f,
+	0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x4e, 0x61, 0x6d,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x64, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x5e, 0x22, 0x59, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x7d, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2f, 0x7b, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6a, 0x6f,
+	0x62, 0x2f, 0x7b, 0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x2d, 0x6a, 0x6f, 0x62, 0x2d, 0x6e, 0x61, 0x6d, 0x65, 0x3a, 0x01, 0x2a, 0x12,
+	0xcf, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3b, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62,
+	0x53, 0x70, 0x65, 0x63, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x3c, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
+	0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65,
+	0x63, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x3e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x38, 0x12, 0x36, 0x2f, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x7b, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6a, 0x6f, 0x62, 0x2f, 0x7b,
+	0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0xed, 0x01, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x4a, 0x6f, 0x62, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x3e, 0x2e, 0x67, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75,
+	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x41,
+	0x64, 0x64, 0x4a, 0x6f, 0x62, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e,
+	0x73, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3f, 0x2e, 0x67, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75,
+	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x41,
+	0x64, 0x64, 0x4a, 0x6f, 0x62, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e,
+	0x73, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x53, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x4d, 0x22, 0x48, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6a, 0x6f, 0x62, 0x2f, 0x7b, 0x6a, 0x6f, 0x62, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x3a, 0x01,
+	0x2a, 0x12, 0xe4, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x3f, 0x2e, 0x67,
 	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d,
 	0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x40, 0x12, 0x3e, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2f,
-	0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d,
-	0x2f, 0x6a, 0x6f, 0x62, 0x12, 0xcb, 0x01, 0x0a, 0x15, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4a, 0x6f,
-	0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3e,
+	0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x6e, 0x73, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x40, 0x2e,
+	0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69,
+	0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x47, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x41, 0x12, 0x3f, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6a, 0x6f, 0x62, 0x2f, 0x7b, 0x6a, 0x6f,
+	0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x12, 0xd9, 0x01, 0x0a, 0x0b, 0x52, 0x6f, 0x6c,
+	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x4a, 0x6f, 0x62, 0x12, 0x34, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35,
 	0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74,
 	0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3f,
+	0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x57, 0x22, 0x52, 0x2f,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f,
+	0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6a, 0x6f, 0x62, 0x2f, 0x7b, 0x6a,
+	0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63,
+	0x6b, 0x3a, 0x01, 0x2a, 0x12, 0xde, 0x01, 0x0a, 0x10, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x73, 0x12, 0x39, 0x2e, 0x67, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x53, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4d, 0x22, 0x48, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0xdd, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f,
+	0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d,
 	0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74,
 	0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x22, 0x29, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6a, 0x6f, 0x62, 0x2f, 0x63, 0x68, 0x65,
-	0x63, 0x6b, 0x12, 0x9f, 0x01, 0x0a, 0x16, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4a, 0x6f, 0x62, 0x53,
-	0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3f, 0x2e,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3e, 0x2e,
 	0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69,
 	0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x40,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x40, 0x12, 0x3e, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x7d, 0x2f, 0x6a, 0x6f, 0x62, 0x12, 0xcb, 0x01, 0x0a, 0x15, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4a,
+	0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x3e, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70,
+	0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x3f, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70,
+	0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x22, 0x29, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6a, 0x6f, 0x62, 0x2f, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x12, 0x9f, 0x01, 0x0a, 0x16, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4a, 0x6f, 0x62,
+	0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3f,
 	0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74,
 	0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
 	0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x30, 0x01, 0x12, 0x7e, 0x0a, 0x0b, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x4a,
-	0x6f, 0x62, 0x73, 0x12, 0x34, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
-	0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x4a, 0x6f,
-	0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x67, 0x6f, 0x74, 0x6f,
-	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e,
-	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x66,
-	0x72, 0x65, 0x73, 0x68, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x30, 0x01, 0x12, 0x94, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6c,
-	0x6f, 0x79, 0x4a, 0x6f, 0x62, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3c, 0x2e, 0x67,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d,
-	0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x4a, 0x6f, 0x62, 0x73, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3d, 0x2e, 0x67, 0x6f, 0x74,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x40, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70,
+	0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x7e, 0x0a, 0x0b, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x4a, 0x6f, 0x62, 0x73, 0x12, 0x34, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x4a,
+	0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x67, 0x6f, 0x74,
 	0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73,
-	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x4a, 0x6f, 0x62, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0xb0, 0x01, 0x0a, 0x1b,
-	0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x41, 0x6c, 0x6c, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65,
-	0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x44, 0x2e, 0x67, 0x6f,
-	0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75,
-	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52,
-	0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x41, 0x6c, 0x6c, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x45, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e,
-	0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x41, 0x6c, 0x6c, 0x4a,
-	0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0xcf,
-	0x01, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x33, 0x2e,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x65,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x94, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70,
+	0x6c, 0x6f, 0x79, 0x4a, 0x6f, 0x62, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3c, 0x2e,
 	0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69,
 	0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x34, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79,
+	0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x4a, 0x6f, 0x62, 0x73, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3d, 0x2e, 0x67, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75,
+	0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x4a, 0x6f, 0x62, 0x73, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0xb0, 0x01, 0x0a,
+	0x1b, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x41, 0x6c, 0x6c, 0x4a, 0x6f, 0x62, 0x53, 0x70,
+	0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x44, 0x2e, 0x67,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d,
+	0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x41, 0x6c, 0x6c, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65,
+	0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x45, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79,
 	0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x54, 0x61, 0x73, 0x6b,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x56, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x50,
-	0x12, 0x4e, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x7d, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d,
-	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6a, 0x6f, 0x62,
-	0x2f, 0x7b, 0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x74, 0x61, 0x73, 0x6b,
-	0x12, 0x90, 0x01, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x32,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x41, 0x6c, 0x6c,
+	0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12,
+	0xcf, 0x01, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x33,
 	0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74,
 	0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x33, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
+	0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x54, 0x61, 0x73,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x56, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x50, 0x12, 0x4e, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x7d, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2f, 0x7b, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6a, 0x6f,
+	0x62, 0x2f, 0x7b, 0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x74, 0x61, 0x73,
+	0x6b, 0x12, 0x90, 0x01, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12,
+	0x32, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70,
+	0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
+	0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x88, 0x02, 0x01, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x12, 0xde, 0x01, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a,
+	0x6f, 0x62, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x38, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x39, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79,
 	0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x88, 0x02, 0x01, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x11, 0x12, 0x0f, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x77, 0x69, 0x6e,
-	0x64, 0x6f, 0x77, 0x12, 0xde, 0x01, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f,
-	0x62, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x38, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x73,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x56, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x50, 0x32, 0x4b, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x7d, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2d, 0x6a, 0x6f, 0x62, 0x2d, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0xd6, 0x01, 0x0a, 0x0d, 0x53, 0x79, 0x6e, 0x63, 0x4a, 0x6f,
+	0x62, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x36, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
 	0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f,
-	0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x4a, 0x6f, 0x62, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x39, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e,
-	0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x56, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x50, 0x32, 0x4b, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2f,
-	0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d,
-	0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2d, 0x6a, 0x6f, 0x62, 0x2d, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x3a, 0x01, 0x2a, 0x12, 0xd6, 0x01, 0x0a, 0x0d, 0x53, 0x79, 0x6e, 0x63, 0x4a, 0x6f, 0x62,
-	0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x36, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d,
-	0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72,
-	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x4a, 0x6f,
-	0x62, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37,
-	0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74,
-	0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x4a, 0x6f, 0x62, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x54, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4e, 0x32,
-	0x49, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d,
-	0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x79, 0x6e, 0x63,
-	0x2d, 0x6a, 0x6f, 0x62, 0x2d, 0x73, 0x74, 0x61, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x42, 0xaa, 0x01,
-	0x0a, 0x1e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
-	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x6e, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73,
-	0x42, 0x1e, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
-	0x50, 0x01, 0x5a, 0x1e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67,
-	0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x6e, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6d,
-	0x75, 0x73, 0x92, 0x41, 0x45, 0x12, 0x05, 0x32, 0x03, 0x30, 0x2e, 0x31, 0x1a, 0x0e, 0x31, 0x32,
-	0x37, 0x2e, 0x30, 0x2e, 0x30, 0x2e, 0x31, 0x3a, 0x39, 0x31, 0x30, 0x30, 0x22, 0x04, 0x2f, 0x61,
-	0x70, 0x69, 0x2a, 0x01, 0x01, 0x72, 0x23, 0x0a, 0x21, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73,
-	0x20, 0x4a, 0x6f, 0x62, 0x20, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x20, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x4a,
+	0x6f, 0x62, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x37, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70,
+	0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x4a, 0x6f, 0x62, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x54, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4e,
+	0x32, 0x49, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x7d, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x79, 0x6e,
+	0x63, 0x2d, 0x6a, 0x6f, 0x62, 0x2d, 0x73, 0x74, 0x61, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0xf1,
+	0x01, 0x0a, 0x17, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x40, 0x2e, 0x67, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x46, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x41, 0x2e, 0x67,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d,
+	0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x51, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4b, 0x22, 0x46, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x7d, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x2f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x3a,
+	0x01, 0x2a, 0x42, 0xaa, 0x01, 0x0a, 0x1e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x6e, 0x2e, 0x6f, 0x70,
+	0x74, 0x69, 0x6d, 0x75, 0x73, 0x42, 0x1e, 0x4a, 0x6f, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x72, 0x50, 0x01, 0x5a, 0x1e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x6e, 0x2f,
+	0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x92, 0x41, 0x45, 0x12, 0x05, 0x32, 0x03, 0x30, 0x2e,
+	0x31, 0x1a, 0x0e, 0x31, 0x32, 0x37, 0x2e, 0x30, 0x2e, 0x30, 0x2e, 0x31, 0x3a, 0x39, 0x31, 0x30,
+	0x30, 0x22, 0x04, 0x2f, 0x61, 0x70, 0x69, 0x2a, 0x01, 0x01, 0x72, 0x23, 0x0a, 0x21, 0x4f, 0x70,
+	0x74, 0x69, 0x6d, 0x75, 0x73, 0x20, 0x4a, 0x6f, 0x62, 0x20, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -5058,7 +6728,7 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDescGZIP() []byte {
 }
 
 var file_gotocompany_optimus_core_v1beta1_job_spec_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes = make([]protoimpl.MessageInfo, 69)
+var file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes = make([]protoimpl.MessageInfo, 91)
 var file_gotocompany_optimus_core_v1beta1_job_spec_proto_goTypes = []interface{}{
 	(JobState)(0),                                                  // 0: gotocompany.optimus.core.v1beta1.JobState
 	(JobEvent_Type)(0),                                             // 1: gotocompany.optimus.core.v1beta1.JobEvent.Type
@@ -5077,174 +6747,224 @@ var file_gotocompany_optimus_core_v1beta1_job_spec_proto_goTypes = []interface{}
 	(*GetJobSpecificationResponse)(nil),                            // 14: gotocompany.optimus.core.v1beta1.GetJobSpecificationResponse
 	(*DeleteJobSpecificationRequest)(nil),                          // 15: gotocompany.optimus.core.v1beta1.DeleteJobSpecificationRequest
 	(*DeleteJobSpecificationResponse)(nil),                         // 16: gotocompany.optimus.core.v1beta1.DeleteJobSpecificationResponse
-	(*ChangeJobNamespaceRequest)(nil),                              // 17: gotocompany.optimus.core.v1beta1.ChangeJobNamespaceRequest
-	(*ChangeJobNamespaceResponse)(nil),                             // 18: gotocompany.optimus.core.v1beta1.ChangeJobNamespaceResponse
-	(*ListJobSpecificationRequest)(nil),                            // 19: gotocompany.optimus.core.v1beta1.ListJobSpecificationRequest
-	(*ListJobSpecificationResponse)(nil),                           // 20: gotocompany.optimus.core.v1beta1.ListJobSpecificationResponse
-	(*CheckJobSpecificationRequest)(nil),                           // 21: gotocompany.optimus.core.v1beta1.CheckJobSpecificationRequest
-	(*CheckJobSpecificationResponse)(nil),                          // 22: gotocompany.optimus.core.v1beta1.CheckJobSpecificationResponse
-	(*CheckJobSpecificationsRequest)(nil),                          // 23: gotocompany.optimus.core.v1beta1.CheckJobSpecificationsRequest
-	(*CheckJobSpecificationsResponse)(nil),                         // 24: gotocompany.optimus.core.v1beta1.CheckJobSpecificationsResponse
-	(*JobSpecification)(nil),                                       // 25: gotocompany.optimus.core.v1beta1.JobSpecification
-	(*JobDependency)(nil),                                          // 26: gotocompany.optimus.core.v1beta1.JobDependency
-	(*HttpDependency)(nil),                                         // 27: gotocompany.optimus.core.v1beta1.HttpDependency
-	(*JobSpecHook)(nil),                                            // 28: gotocompany.optimus.core.v1beta1.JobSpecHook
-	(*JobConfigItem)(nil),                                          // 29: gotocompany.optimus.core.v1beta1.JobConfigItem
-	(*JobEvent)(nil),                                               // 30: gotocompany.optimus.core.v1beta1.JobEvent
-	(*JobMetadata)(nil),                                            // 31: gotocompany.optimus.core.v1beta1.JobMetadata
-	(*JobSpecMetadataResource)(nil),                                // 32: gotocompany.optimus.core.v1beta1.JobSpecMetadataResource
-	(*JobSpecMetadataResourceConfig)(nil),                          // 33: gotocompany.optimus.core.v1beta1.JobSpecMetadataResourceConfig
-	(*JobSpecMetadataAirflow)(nil),                                 // 34: gotocompany.optimus.core.v1beta1.JobSpecMetadataAirflow
-	(*RefreshJobsRequest)(nil),                                     // 35: gotocompany.optimus.core.v1beta1.RefreshJobsRequest
-	(*RefreshJobsResponse)(nil),                                    // 36: gotocompany.optimus.core.v1beta1.RefreshJobsResponse
-	(*GetDeployJobsStatusRequest)(nil),                             // 37: gotocompany.optimus.core.v1beta1.GetDeployJobsStatusRequest
-	(*GetDeployJobsStatusResponse)(nil),                            // 38: gotocompany.optimus.core.v1beta1.GetDeployJobsStatusResponse
-	(*DeployJobFailure)(nil),                                       // 39: gotocompany.optimus.core.v1beta1.DeployJobFailure
-	(*GetJobSpecificationsRequest)(nil),                            // 40: gotocompany.optimus.core.v1beta1.GetJobSpecificationsRequest
-	(*GetJobSpecificationsResponse)(nil),                           // 41: gotocompany.optimus.core.v1beta1.GetJobSpecificationsResponse
-	(*JobSpecificationResponse)(nil),                               // 42: gotocompany.optimus.core.v1beta1.JobSpecificationResponse
-	(*ReplaceAllJobSpecificationsRequest)(nil),                     // 43: gotocompany.optimus.core.v1beta1.ReplaceAllJobSpecificationsRequest
-	(*ReplaceAllJobSpecificationsResponse)(nil),                    // 44: gotocompany.optimus.core.v1beta1.ReplaceAllJobSpecificationsResponse
-	(*GetJobTaskRequest)(nil),                                      // 45: gotocompany.optimus.core.v1beta1.GetJobTaskRequest
-	(*GetJobTaskResponse)(nil),                                     // 46: gotocompany.optimus.core.v1beta1.GetJobTaskResponse
-	(*JobTask)(nil),                                                // 47: gotocompany.optimus.core.v1beta1.JobTask
-	(*GetWindowRequest)(nil),                                       // 48: gotocompany.optimus.core.v1beta1.GetWindowRequest
-	(*GetWindowResponse)(nil),                                      // 49: gotocompany.optimus.core.v1beta1.GetWindowResponse
-	(*UpdateJobsStateRequest)(nil),                                 // 50: gotocompany.optimus.core.v1beta1.UpdateJobsStateRequest
-	(*UpdateJobsStateResponse)(nil),                                // 51: gotocompany.optimus.core.v1beta1.UpdateJobsStateResponse
-	(*SyncJobsStateRequest)(nil),                                   // 52: gotocompany.optimus.core.v1beta1.SyncJobsStateRequest
-	(*SyncJobsStateResponse)(nil),                                  // 53: gotocompany.optimus.core.v1beta1.SyncJobsStateResponse
-	(*JobInspectResponse_BasicInfoSection)(nil),                    // 54: gotocompany.optimus.core.v1beta1.JobInspectResponse.BasicInfoSection
-	(*JobInspectResponse_JobDependency)(nil),                       // 55: gotocompany.optimus.core.v1beta1.JobInspectResponse.JobDependency
-	(*JobInspectResponse_UpstreamSection)(nil),                     // 56: gotocompany.optimus.core.v1beta1.JobInspectResponse.UpstreamSection
-	(*JobInspectResponse_DownstreamSection)(nil),                   // 57: gotocompany.optimus.core.v1beta1.JobInspectResponse.DownstreamSection
-	(*JobInspectResponse_UpstreamSection_UnknownDependencies)(nil), // 58: gotocompany.optimus.core.v1beta1.JobInspectResponse.UpstreamSection.UnknownDependencies
-	nil,                                     // 59: gotocompany.optimus.core.v1beta1.JobSpecification.AssetsEntry
-	nil,                                     // 60: gotocompany.optimus.core.v1beta1.JobSpecification.LabelsEntry
-	(*JobSpecification_Behavior)(nil),       // 61: gotocompany.optimus.core.v1beta1.JobSpecification.Behavior
-	(*JobSpecification_Behavior_Retry)(nil), // 62: gotocompany.optimus.core.v1beta1.JobSpecification.Behavior.Retry
-	(*JobSpecification_Behavior_Notifiers)(nil), // 63: gotocompany.optimus.core.v1beta1.JobSpecification.Behavior.Notifiers
-	nil,                         // 64: gotocompany.optimus.core.v1beta1.JobSpecification.Behavior.Notifiers.ConfigEntry
-	nil,                         // 65: gotocompany.optimus.core.v1beta1.HttpDependency.HeadersEntry
-	nil,                         // 66: gotocompany.optimus.core.v1beta1.HttpDependency.ParamsEntry
-	nil,                         // 67: gotocompany.optimus.core.v1beta1.GetDeployJobsStatusResponse.UnknownDependenciesEntry
-	(*JobTask_Destination)(nil), // 68: gotocompany.optimus.core.v1beta1.JobTask.Destination
-	(*JobTask_Dependency)(nil),  // 69: gotocompany.optimus.core.v1beta1.JobTask.Dependency
-	(*SyncJobsStateRequest_JobStatePair)(nil), // 70: gotocompany.optimus.core.v1beta1.SyncJobsStateRequest.JobStatePair
-	(*Log)(nil),                   // 71: gotocompany.optimus.core.v1beta1.Log
-	(*timestamppb.Timestamp)(nil), // 72: google.protobuf.Timestamp
-	(*structpb.Struct)(nil),       // 73: google.protobuf.Struct
-	(*durationpb.Duration)(nil),   // 74: google.protobuf.Duration
+	(*DeleteJobsRequest)(nil),                                      // 17: gotocompany.optimus.core.v1beta1.DeleteJobsRequest
+	(*DeleteJobsResponse)(nil),                                     // 18: gotocompany.optimus.core.v1beta1.DeleteJobsResponse
+	(*ChangeJobNamespaceRequest)(nil),                              // 19: gotocompany.optimus.core.v1beta1.ChangeJobNamespaceRequest
+	(*ChangeJobNamespaceResponse)(nil),                             // 20: gotocompany.optimus.core.v1beta1.ChangeJobNamespaceResponse
+	(*MoveJobRequest)(nil),                                         // 21: gotocompany.optimus.core.v1beta1.MoveJobRequest
+	(*MoveJobResponse)(nil),                                        // 22: gotocompany.optimus.core.v1beta1.MoveJobResponse
+	(*ChangeJobNameRequest)(nil),                                   // 23: gotocompany.optimus.core.v1beta1.ChangeJobNameRequest
+	(*ChangeJobNameResponse)(nil),                                  // 24: gotocompany.optimus.core.v1beta1.ChangeJobNameResponse
+	(*JobSpecVersion)(nil),                                         // 25: gotocompany.optimus.core.v1beta1.JobSpecVersion
+	(*GetJobSpecVersionsRequest)(nil),                              // 26: gotocompany.optimus.core.v1beta1.GetJobSpecVersionsRequest
+	(*GetJobSpecVersionsResponse)(nil),                             // 27: gotocompany.optimus.core.v1beta1.GetJobSpecVersionsResponse
+	(*AddJobDeletionConsentRequest)(nil),                           // 28: gotocompany.optimus.core.v1beta1.AddJobDeletionConsentRequest
+	(*AddJobDeletionConsentResponse)(nil),                          // 29: gotocompany.optimus.core.v1beta1.AddJobDeletionConsentResponse
+	(*GetJobDeletionConsentsRequest)(nil),                          // 30: gotocompany.optimus.core.v1beta1.GetJobDeletionConsentsRequest
+	(*GetJobDeletionConsentsResponse)(nil),                         // 31: gotocompany.optimus.core.v1beta1.GetJobDeletionConsentsResponse
+	(*RollbackJobRequest)(nil),                                     // 32: gotocompany.optimus.core.v1beta1.RollbackJobRequest
+	(*RollbackJobResponse)(nil),                                    // 33: gotocompany.optimus.core.v1beta1.RollbackJobResponse
+	(*ValidateJobSpecsRequest)(nil),                                // 34: gotocompany.optimus.core.v1beta1.ValidateJobSpecsRequest
+	(*ValidateJobSpecsResponse)(nil),                               // 35: gotocompany.optimus.core.v1beta1.ValidateJobSpecsResponse
+	(*ListJobSpecificationRequest)(nil),                            // 36: gotocompany.optimus.core.v1beta1.ListJobSpecificationRequest
+	(*ListJobSpecificationResponse)(nil),                           // 37: gotocompany.optimus.core.v1beta1.ListJobSpecificationResponse
+	(*CheckJobSpecificationRequest)(nil),                           // 38: gotocompany.optimus.core.v1beta1.CheckJobSpecificationRequest
+	(*CheckJobSpecificationResponse)(nil),                          // 39: gotocompany.optimus.core.v1beta1.CheckJobSpecificationResponse
+	(*CheckJobSpecificationsRequest)(nil),                          // 40: gotocompany.optimus.core.v1beta1.CheckJobSpecificationsRequest
+	(*CheckJobSpecificationsResponse)(nil),                         // 41: gotocompany.optimus.core.v1beta1.CheckJobSpecificationsResponse
+	(*JobSpecification)(nil),                                       // 42: gotocompany.optimus.core.v1beta1.JobSpecification
+	(*JobDependency)(nil),                                          // 43: gotocompany.optimus.core.v1beta1.JobDependency
+	(*HttpDependency)(nil),                                         // 44: gotocompany.optimus.core.v1beta1.HttpDependency
+	(*JobSpecHook)(nil),                                            // 45: gotocompany.optimus.core.v1beta1.JobSpecHook
+	(*JobConfigItem)(nil),                                          // 46: gotocompany.optimus.core.v1beta1.JobConfigItem
+	(*JobEvent)(nil),                                               // 47: gotocompany.optimus.core.v1beta1.JobEvent
+	(*JobMetadata)(nil),                                            // 48: gotocompany.optimus.core.v1beta1.JobMetadata
+	(*JobSpecMetadataResource)(nil),                                // 49: gotocompany.optimus.core.v1beta1.JobSpecMetadataResource
+	(*JobSpecMetadataResourceConfig)(nil),                          // 50: gotocompany.optimus.core.v1beta1.JobSpecMetadataResourceConfig
+	(*JobSpecMetadataAirflow)(nil),                                 // 51: gotocompany.optimus.core.v1beta1.JobSpecMetadataAirflow
+	(*RefreshJobsRequest)(nil),                                     // 52: gotocompany.optimus.core.v1beta1.RefreshJobsRequest
+	(*RefreshJobsResponse)(nil),                                    // 53: gotocompany.optimus.core.v1beta1.RefreshJobsResponse
+	(*GetDeployJobsStatusRequest)(nil),                             // 54: gotocompany.optimus.core.v1beta1.GetDeployJobsStatusRequest
+	(*GetDeployJobsStatusResponse)(nil),                            // 55: gotocompany.optimus.core.v1beta1.GetDeployJobsStatusResponse
+	(*DeployJobFailure)(nil),                                       // 56: gotocompany.optimus.core.v1beta1.DeployJobFailure
+	(*GetJobSpecificationsRequest)(nil),                            // 57: gotocompany.optimus.core.v1beta1.GetJobSpecificationsRequest
+	(*GetJobSpecificationsResponse)(nil),                           // 58: gotocompany.optimus.core.v1beta1.GetJobSpecificationsResponse
+	(*JobSpecificationResponse)(nil),                               // 59: gotocompany.optimus.core.v1beta1.JobSpecificationResponse
+	(*ReplaceAllJobSpecificationsRequest)(nil),                     // 60: gotocompany.optimus.core.v1beta1.ReplaceAllJobSpecificationsRequest
+	(*ReplaceAllJobSpecificationsResponse)(nil),                    // 61: gotocompany.optimus.core.v1beta1.ReplaceAllJobSpecificationsResponse
+	(*GetJobTaskRequest)(nil),                                      // 62: gotocompany.optimus.core.v1beta1.GetJobTaskRequest
+	(*GetJobTaskResponse)(nil),                                     // 63: gotocompany.optimus.core.v1beta1.GetJobTaskResponse
+	(*JobTask)(nil),                                                // 64: gotocompany.optimus.core.v1beta1.JobTask
+	(*GetWindowRequest)(nil),                                       // 65: gotocompany.optimus.core.v1beta1.GetWindowRequest
+	(*GetWindowResponse)(nil),                                      // 66: gotocompany.optimus.core.v1beta1.GetWindowResponse
+	(*UpdateJobsStateRequest)(nil),                                 // 67: gotocompany.optimus.core.v1beta1.UpdateJobsStateRequest
+	(*UpdateJobsStateResponse)(nil),                                // 68: gotocompany.optimus.core.v1beta1.UpdateJobsStateResponse
+	(*SyncJobsStateRequest)(nil),                                   // 69: gotocompany.optimus.core.v1beta1.SyncJobsStateRequest
+	(*SyncJobsStateResponse)(nil),                                  // 70: gotocompany.optimus.core.v1beta1.SyncJobsStateResponse
+	(*FormatJobSpecificationsRequest)(nil),                         // 71: gotocompany.optimus.core.v1beta1.FormatJobSpecificationsRequest
+	(*FormatJobSpecificationsResponse)(nil),                        // 72: gotocompany.optimus.core.v1beta1.FormatJobSpecificationsResponse
+	(*JobInspectResponse_BasicInfoSection)(nil),                    // 73: gotocompany.optimus.core.v1beta1.JobInspectResponse.BasicInfoSection
+	(*JobInspectResponse_JobDependency)(nil),                       // 74: gotocompany.optimus.core.v1beta1.JobInspectResponse.JobDependency
+	(*JobInspectResponse_UpstreamSection)(nil),                     // 75: gotocompany.optimus.core.v1beta1.JobInspectResponse.UpstreamSection
+	(*JobInspectResponse_DownstreamSection)(nil),                   // 76: gotocompany.optimus.core.v1beta1.JobInspectResponse.DownstreamSection
+	(*JobInspectResponse_UpstreamSection_UnknownDependencies)(nil), // 77: gotocompany.optimus.core.v1beta1.JobInspectResponse.UpstreamSection.UnknownDependencies
+	(*GetJobDeletionConsentsResponse_Consent)(nil),                 // 78: gotocompany.optimus.core.v1beta1.GetJobDeletionConsentsResponse.Consent
+	(*GetJobDeletionConsentsResponse_Audit)(nil),                   // 79: gotocompany.optimus.core.v1beta1.GetJobDeletionConsentsResponse.Audit
+	(*ValidateJobSpecsResponse_Result)(nil),                        // 80: gotocompany.optimus.core.v1beta1.ValidateJobSpecsResponse.Result
+	nil,                                                            // 81: gotocompany.optimus.core.v1beta1.JobSpecification.AssetsEntry
+	nil,                                                            // 82: gotocompany.optimus.core.v1beta1.JobSpecification.LabelsEntry
+	(*JobSpecification_Behavior)(nil),                              // 83: gotocompany.optimus.core.v1beta1.JobSpecification.Behavior
+	(*JobSpecification_Behavior_Retry)(nil),                        // 84: gotocompany.optimus.core.v1beta1.JobSpecification.Behavior.Retry
+	(*JobSpecification_Behavior_Notifiers)(nil),                    // 85: gotocompany.optimus.core.v1beta1.JobSpecification.Behavior.Notifiers
+	nil,                         // 86: gotocompany.optimus.core.v1beta1.JobSpecification.Behavior.Notifiers.ConfigEntry
+	nil,                         // 87: gotocompany.optimus.core.v1beta1.HttpDependency.HeadersEntry
+	nil,                         // 88: gotocompany.optimus.core.v1beta1.HttpDependency.ParamsEntry
+	nil,                         // 89: gotocompany.optimus.core.v1beta1.GetDeployJobsStatusResponse.UnknownDependenciesEntry
+	(*JobTask_Destination)(nil), // 90: gotocompany.optimus.core.v1beta1.JobTask.Destination
+	(*JobTask_Dependency)(nil),  // 91: gotocompany.optimus.core.v1beta1.JobTask.Dependency
+	(*SyncJobsStateRequest_JobStatePair)(nil), // 92: gotocompany.optimus.core.v1beta1.SyncJobsStateRequest.JobStatePair
+	(*Log)(nil),                   // 93: gotocompany.optimus.core.v1beta1.Log
+	(*timestamppb.Timestamp)(nil), // 94: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),       // 95: google.protobuf.Struct
+	(*durationpb.Duration)(nil),   // 96: google.protobuf.Duration
 }
 var file_gotocompany_optimus_core_v1beta1_job_spec_proto_depIdxs = []int32{
-	25, // 0: gotocompany.optimus.core.v1beta1.DeployJobSpecificationRequest.jobs:type_name -> gotocompany.optimus.core.v1beta1.JobSpecification
-	71, // 1: gotocompany.optimus.core.v1beta1.DeployJobSpecificationResponse.log_status:type_name -> gotocompany.optimus.core.v1beta1.Log
-	25, // 2: gotocompany.optimus.core.v1beta1.AddJobSpecificationsRequest.specs:type_name -> gotocompany.optimus.core.v1beta1.JobSpecification
-	25, // 3: gotocompany.optimus.core.v1beta1.UpdateJobSpecificationsRequest.specs:type_name -> gotocompany.optimus.core.v1beta1.JobSpecification
-	25, // 4: gotocompany.optimus.core.v1beta1.JobInspectRequest.spec:type_name -> gotocompany.optimus.core.v1beta1.JobSpecification
-	72, // 5: gotocompany.optimus.core.v1beta1.JobInspectRequest.scheduled_at:type_name -> google.protobuf.Timestamp
-	72, // 6: gotocompany.optimus.core.v1beta1.JobRun.scheduled_at:type_name -> google.protobuf.Timestamp
-	54, // 7: gotocompany.optimus.core.v1beta1.JobInspectResponse.basic_info:type_name -> gotocompany.optimus.core.v1beta1.JobInspectResponse.BasicInfoSection
-	56, // 8: gotocompany.optimus.core.v1beta1.JobInspectResponse.upstreams:type_name -> gotocompany.optimus.core.v1beta1.JobInspectResponse.UpstreamSection
-	57, // 9: gotocompany.optimus.core.v1beta1.JobInspectResponse.downstreams:type_name -> gotocompany.optimus.core.v1beta1.JobInspectResponse.DownstreamSection
-	25, // 10: gotocompany.optimus.core.v1beta1.CreateJobSpecificationRequest.spec:type_name -> gotocompany.optimus.core.v1beta1.JobSpecification
-	25, // 11: gotocompany.optimus.core.v1beta1.GetJobSpecificationResponse.spec:type_name -> gotocompany.optimus.core.v1beta1.JobSpecification
-	25, // 12: gotocompany.optimus.core.v1beta1.ListJobSpecificationResponse.jobs:type_name -> gotocompany.optimus.core.v1beta1.JobSpecification
-	25, // 13: gotocompany.optimus.core.v1beta1.CheckJobSpecificationRequest.job:type_name -> gotocompany.optimus.core.v1beta1.JobSpecification
-	25, // 14: gotocompany.optimus.core.v1beta1.CheckJobSpecificationsRequest.jobs:type_name -> gotocompany.optimus.core.v1beta1.JobSpecification
-	71, // 15: gotocompany.optimus.core.v1beta1.CheckJobSpecificationsResponse.log_status:type_name -> gotocompany.optimus.core.v1beta1.Log
-	29, // 16: gotocompany.optimus.core.v1beta1.JobSpecification.config:type_name -> gotocompany.optimus.core.v1beta1.JobConfigItem
-	26, // 17: gotocompany.optimus.core.v1beta1.JobSpecification.dependencies:type_name -> gotocompany.optimus.core.v1beta1.JobDependency
-	59, // 18: gotocompany.optimus.core.v1beta1.JobSpecification.assets:type_name -> gotocompany.optimus.core.v1beta1.JobSpecification.AssetsEntry
-	28, // 19: gotocompany.optimus.core.v1beta1.JobSpecification.hooks:type_name -> gotocompany.optimus.core.v1beta1.JobSpecHook
-	60, // 20: gotocompany.optimus.core.v1beta1.JobSpecification.labels:type_name -> gotocompany.optimus.core.v1beta1.JobSpecification.LabelsEntry
-	61, // 21: gotocompany.optimus.core.v1beta1.JobSpecification.behavior:type_name -> gotocompany.optimus.core.v1beta1.JobSpecification.Behavior
-	31, // 22: gotocompany.optimus.core.v1beta1.JobSpecification.metadata:type_name -> gotocompany.optimus.core.v1beta1.JobMetadata
-	27, // 23: gotocompany.optimus.core.v1beta1.JobDependency.http_dependency:type_name -> gotocompany.optimus.core.v1beta1.HttpDependency
-	65, // 24: gotocompany.optimus.core.v1beta1.HttpDependency.headers:type_name -> gotocompany.optimus.core.v1beta1.HttpDependency.HeadersEntry
-	66, // 25: gotocompany.optimus.core.v1beta1.HttpDependency.params:type_name -> gotocompany.optimus.core.v1beta1.HttpDependency.ParamsEntry
-	29, // 26: gotocompany.optimus.core.v1beta1.JobSpecHook.config:type_name -> gotocompany.optimus.core.v1beta1.JobConfigItem
-	1,  // 27: gotocompany.optimus.core.v1beta1.JobEvent.type:type_name -> gotocompany.optimus.core.v1beta1.JobEvent.Type
-	73, // 28: gotocompany.optimus.core.v1beta1.JobEvent.value:type_name -> google.protobuf.Struct
-	32, // 29: gotocompany.optimus.core.v1beta1.JobMetadata.resource:type_name -> gotocompany.optimus.core.v1beta1.JobSpecMetadataResource
-	34, // 30: gotocompany.optimus.core.v1beta1.JobMetadata.airflow:type_name -> gotocompany.optimus.core.v1beta1.JobSpecMetadataAirflow
-	33, // 31: gotocompany.optimus.core.v1beta1.JobSpecMetadataResource.request:type_name -> gotocompany.optimus.core.v1beta1.JobSpecMetadataResourceConfig
-	33, // 32: gotocompany.optimus.core.v1beta1.JobSpecMetadataResource.limit:type_name -> gotocompany.optimus.core.v1beta1.JobSpecMetadataResourceConfig
-	71, // 33: gotocompany.optimus.core.v1beta1.RefreshJobsResponse.log_status:type_name -> gotocompany.optimus.core.v1beta1.Log
-	39, // 34: gotocompany.optimus.core.v1beta1.GetDeployJobsStatusResponse.failures:type_name -> gotocompany.optimus.core.v1beta1.DeployJobFailure
-	67, // 35: gotocompany.optimus.core.v1beta1.GetDeployJobsStatusResponse.unknown_dependencies:type_name -> gotocompany.optimus.core.v1beta1.GetDeployJobsStatusResponse.UnknownDependenciesEntry
-	25, // 36: gotocompany.optimus.core.v1beta1.GetJobSpecificationsResponse.jobs:type_name -> gotocompany.optimus.core.v1beta1.JobSpecification
-	42, // 37: gotocompany.optimus.core.v1beta1.GetJobSpecificationsResponse.job_specification_responses:type_name -> gotocompany.optimus.core.v1beta1.JobSpecificationResponse
-	25, // 38: gotocompany.optimus.core.v1beta1.JobSpecificationResponse.job:type_name -> gotocompany.optimus.core.v1beta1.JobSpecification
-	25, // 39: gotocompany.optimus.core.v1beta1.ReplaceAllJobSpecificationsRequest.jobs:type_name -> gotocompany.optimus.core.v1beta1.JobSpecification
-	71, // 40: gotocompany.optimus.core.v1beta1.ReplaceAllJobSpecificationsResponse.log_status:type_name -> gotocompany.optimus.core.v1beta1.Log
-	47, // 41: gotocompany.optimus.core.v1beta1.GetJobTaskResponse.task:type_name -> gotocompany.optimus.core.v1beta1.JobTask
-	68, // 42: gotocompany.optimus.core.v1beta1.JobTask.destination:type_name -> gotocompany.optimus.core.v1beta1.JobTask.Destination
-	69, // 43: gotocompany.optimus.core.v1beta1.JobTask.dependencies:type_name -> gotocompany.optimus.core.v1beta1.JobTask.Dependency
-	72, // 44: gotocompany.optimus.core.v1beta1.GetWindowRequest.scheduled_at:type_name -> google.protobuf.Timestamp
-	72, // 45: gotocompany.optimus.core.v1beta1.GetWindowResponse.start:type_name -> google.protobuf.Timestamp
-	72, // 46: gotocompany.optimus.core.v1beta1.GetWindowResponse.end:type_name -> google.protobuf.Timestamp
-	0,  // 47: gotocompany.optimus.core.v1beta1.UpdateJobsStateRequest.state:type_name -> gotocompany.optimus.core.v1beta1.JobState
-	70, // 48: gotocompany.optimus.core.v1beta1.SyncJobsStateRequest.job_states:type_name -> gotocompany.optimus.core.v1beta1.SyncJobsStateRequest.JobStatePair
-	25, // 49: gotocompany.optimus.core.v1beta1.JobInspectResponse.BasicInfoSection.job:type_name -> gotocompany.optimus.core.v1beta1.JobSpecification
-	71, // 50: gotocompany.optimus.core.v1beta1.JobInspectResponse.BasicInfoSection.notice:type_name -> gotocompany.optimus.core.v1beta1.Log
-	9,  // 51: gotocompany.optimus.core.v1beta1.JobInspectResponse.JobDependency.runs:type_name -> gotocompany.optimus.core.v1beta1.JobRun
-	55, // 52: gotocompany.optimus.core.v1beta1.JobInspectResponse.UpstreamSection.external_dependency:type_name -> gotocompany.optimus.core.v1beta1.JobInspectResponse.JobDependency
-	55, // 53: gotocompany.optimus.core.v1beta1.JobInspectResponse.UpstreamSection.internal_dependency:type_name -> gotocompany.optimus.core.v1beta1.JobInspectResponse.JobDependency
-	27, // 54: gotocompany.optimus.core.v1beta1.JobInspectResponse.UpstreamSection.http_dependency:type_name -> gotocompany.optimus.core.v1beta1.HttpDependency
-	58, // 55: gotocompany.optimus.core.v1beta1.JobInspectResponse.UpstreamSection.unknown_dependencies:type_name -> gotocompany.optimus.core.v1beta1.JobInspectResponse.UpstreamSection.UnknownDependencies
-	71, // 56: gotocompany.optimus.core.v1beta1.JobInspectResponse.UpstreamSection.notice:type_name -> gotocompany.optimus.core.v1beta1.Log
-	55, // 57: gotocompany.optimus.core.v1beta1.JobInspectResponse.DownstreamSection.downstream_jobs:type_name -> gotocompany.optimus.core.v1beta1.JobInspectResponse.JobDependency
-	71, // 58: gotocompany.optimus.core.v1beta1.JobInspectResponse.DownstreamSection.notice:type_name -> gotocompany.optimus.core.v1beta1.Log
-	62, // 59: gotocompany.optimus.core.v1beta1.JobSpecification.Behavior.retry:type_name -> gotocompany.optimus.core.v1beta1.JobSpecification.Behavior.Retry
-	63, // 60: gotocompany.optimus.core.v1beta1.JobSpecification.Behavior.notify:type_name -> gotocompany.optimus.core.v1beta1.JobSpecification.Behavior.Notifiers
-	74, // 61: gotocompany.optimus.core.v1beta1.JobSpecification.Behavior.Retry.delay:type_name -> google.protobuf.Duration
-	1,  // 62: gotocompany.optimus.core.v1beta1.JobSpecification.Behavior.Notifiers.on:type_name -> gotocompany.optimus.core.v1beta1.JobEvent.Type
-	64, // 63: gotocompany.optimus.core.v1beta1.JobSpecification.Behavior.Notifiers.config:type_name -> gotocompany.optimus.core.v1beta1.JobSpecification.Behavior.Notifiers.ConfigEntry
-	0,  // 64: gotocompany.optimus.core.v1beta1.SyncJobsStateRequest.JobStatePair.state:type_name -> gotocompany.optimus.core.v1beta1.JobState
-	2,  // 65: gotocompany.optimus.core.v1beta1.JobSpecificationService.DeployJobSpecification:input_type -> gotocompany.optimus.core.v1beta1.DeployJobSpecificationRequest
-	8,  // 66: gotocompany.optimus.core.v1beta1.JobSpecificationService.JobInspect:input_type -> gotocompany.optimus.core.v1beta1.JobInspectRequest
-	11, // 67: gotocompany.optimus.core.v1beta1.JobSpecificationService.CreateJobSpecification:input_type -> gotocompany.optimus.core.v1beta1.CreateJobSpecificationRequest
-	4,  // 68: gotocompany.optimus.core.v1beta1.JobSpecificationService.AddJobSpecifications:input_type -> gotocompany.optimus.core.v1beta1.AddJobSpecificationsRequest
-	6,  // 69: gotocompany.optimus.core.v1beta1.JobSpecificationService.UpdateJobSpecifications:input_type -> gotocompany.optimus.core.v1beta1.UpdateJobSpecificationsRequest
-	13, // 70: gotocompany.optimus.core.v1beta1.JobSpecificationService.GetJobSpecification:input_type -> gotocompany.optimus.core.v1beta1.GetJobSpecificationRequest
-	40, // 71: gotocompany.optimus.core.v1beta1.JobSpecificationService.GetJobSpecifications:input_type -> gotocompany.optimus.core.v1beta1.GetJobSpecificationsRequest
-	15, // 72: gotocompany.optimus.core.v1beta1.JobSpecificationService.DeleteJobSpecification:input_type -> gotocompany.optimus.core.v1beta1.DeleteJobSpecificationRequest
-	17, // 73: gotocompany.optimus.core.v1beta1.JobSpecificationService.ChangeJobNamespace:input_type -> gotocompany.optimus.core.v1beta1.ChangeJobNamespaceRequest
-	19, // 74: gotocompany.optimus.core.v1beta1.JobSpecificationService.ListJobSpecification:input_type -> gotocompany.optimus.core.v1beta1.ListJobSpecificationRequest
-	21, // 75: gotocompany.optimus.core.v1beta1.JobSpecificationService.CheckJobSpecification:input_type -> gotocompany.optimus.core.v1beta1.CheckJobSpecificationRequest
-	23, // 76: gotocompany.optimus.core.v1beta1.JobSpecificationService.CheckJobSpecifications:input_type -> gotocompany.optimus.core.v1beta1.CheckJobSpecificationsRequest
-	35, // 77: gotocompany.optimus.core.v1beta1.JobSpecificationService.RefreshJobs:input_type -> gotocompany.optimus.core.v1beta1.RefreshJobsRequest
-	37, // 78: gotocompany.optimus.core.v1beta1.JobSpecificationService.GetDeployJobsStatus:input_type -> gotocompany.optimus.core.v1beta1.GetDeployJobsStatusRequest
-	43, // 79: gotocompany.optimus.core.v1beta1.JobSpecificationService.ReplaceAllJobSpecifications:input_type -> gotocompany.optimus.core.v1beta1.ReplaceAllJobSpecificationsRequest
-	45, // 80: gotocompany.optimus.core.v1beta1.JobSpecificationService.GetJobTask:input_type -> gotocompany.optimus.core.v1beta1.GetJobTaskRequest
-	48, // 81: gotocompany.optimus.core.v1beta1.JobSpecificationService.GetWindow:input_type -> gotocompany.optimus.core.v1beta1.GetWindowRequest
-	50, // 82: gotocompany.optimus.core.v1beta1.JobSpecificationService.UpdateJobsState:input_type -> gotocompany.optimus.core.v1beta1.UpdateJobsStateRequest
-	52, // 83: gotocompany.optimus.core.v1beta1.JobSpecificationService.SyncJobsState:input_type -> gotocompany.optimus.core.v1beta1.SyncJobsStateRequest
-	3,  // 84: gotocompany.optimus.core.v1beta1.JobSpecificationService.DeployJobSpecification:output_type -> gotocompany.optimus.core.v1beta1.DeployJobSpecificationResponse
-	10, // 85: gotocompany.optimus.core.v1beta1.JobSpecificationService.JobInspect:output_type -> gotocompany.optimus.core.v1beta1.JobInspectResponse
-	12, // 86: gotocompany.optimus.core.v1beta1.JobSpecificationService.CreateJobSpecification:output_type -> gotocompany.optimus.core.v1beta1.CreateJobSpecificationResponse
-	5,  // 87: gotocompany.optimus.core.v1beta1.JobSpecificationService.AddJobSpecifications:output_type -> gotocompany.optimus.core.v1beta1.AddJobSpecificationsResponse
-	7,  // 88: gotocompany.optimus.core.v1beta1.JobSpecificationService.UpdateJobSpecifications:output_type -> gotocompany.optimus.core.v1beta1.UpdateJobSpecificationsResponse
-	14, // 89: gotocompany.optimus.core.v1beta1.JobSpecificationService.GetJobSpecification:output_type -> gotocompany.optimus.core.v1beta1.GetJobSpecificationResponse
-	41, // 90: gotocompany.optimus.core.v1beta1.JobSpecificationService.GetJobSpecifications:output_type -> gotocompany.optimus.core.v1beta1.GetJobSpecificationsResponse
-	16, // 91: gotocompany.optimus.core.v1beta1.JobSpecificationService.DeleteJobSpecification:output_type -> gotocompany.optimus.core.v1beta1.DeleteJobSpecificationResponse
-	18, // 92: gotocompany.optimus.core.v1beta1.JobSpecificationService.ChangeJobNamespace:output_type -> gotocompany.optimus.core.v1beta1.ChangeJobNamespaceResponse
-	20, // 93: gotocompany.optimus.core.v1beta1.JobSpecificationService.ListJobSpecification:output_type -> gotocompany.optimus.core.v1beta1.ListJobSpecificationResponse
-	22, // 94: gotocompany.optimus.core.v1beta1.JobSpecificationService.CheckJobSpecification:output_type -> gotocompany.optimus.core.v1beta1.CheckJobSpecificationResponse
-	24, // 95: gotocompany.optimus.core.v1beta1.JobSpecificationService.CheckJobSpecifications:output_type -> gotocompany.optimus.core.v1beta1.CheckJobSpecificationsResponse
-	36, // 96: gotocompany.optimus.core.v1beta1.JobSpecificationService.RefreshJobs:output_type -> gotocompany.optimus.core.v1beta1.RefreshJobsResponse
-	38, // 97: gotocompany.optimus.core.v1beta1.JobSpecificationService.GetDeployJobsStatus:output_type -> gotocompany.optimus.core.v1beta1.GetDeployJobsStatusResponse
-	44, // 98: gotocompany.optimus.core.v1beta1.JobSpecificationService.ReplaceAllJobSpecifications:output_type -> gotocompany.optimus.core.v1beta1.ReplaceAllJobSpecificationsResponse
-	46, // 99: gotocompany.optimus.core.v1beta1.JobSpecificationService.GetJobTask:output_type -> gotocompany.optimus.core.v1beta1.GetJobTaskResponse
-	49, // 100: gotocompany.optimus.core.v1beta1.JobSpecificationService.GetWindow:output_type -> gotocompany.optimus.core.v1beta1.GetWindowResponse
-	51, // 101: gotocompany.optimus.core.v1beta1.JobSpecificationService.UpdateJobsState:output_type -> gotocompany.optimus.core.v1beta1.UpdateJobsStateResponse
-	53, // 102: gotocompany.optimus.core.v1beta1.JobSpecificationService.SyncJobsState:output_type -> gotocompany.optimus.core.v1beta1.SyncJobsStateResponse
-	84, // [84:103] is the sub-list for method output_type
-	65, // [65:84] is the sub-list for method input_type
-	65, // [65:65] is the sub-list for extension type_name
-	65, // [65:65] is the sub-list for extension extendee
-	0,  // [0:65] is the sub-list for field type_name
+	42,  // 0: gotocompany.optimus.core.v1beta1.DeployJobSpecificationRequest.jobs:type_name -> gotocompany.optimus.core.v1beta1.JobSpecification
+	93,  // 1: gotocompany.optimus.core.v1beta1.DeployJobSpecificationResponse.log_status:type_name -> gotocompany.optimus.core.v1beta1.Log
+	42,  // 2: gotocompany.optimus.core.v1beta1.AddJobSpecificationsRequest.specs:type_name -> gotocompany.optimus.core.v1beta1.JobSpecification
+	42,  // 3: gotocompany.optimus.core.v1beta1.UpdateJobSpecificationsRequest.specs:type_name -> gotocompany.optimus.core.v1beta1.JobSpecification
+	42,  // 4: gotocompany.optimus.core.v1beta1.JobInspectRequest.spec:type_name -> gotocompany.optimus.core.v1beta1.JobSpecification
+	94,  // 5: gotocompany.optimus.core.v1beta1.JobInspectRequest.scheduled_at:type_name -> google.protobuf.Timestamp
+	94,  // 6: gotocompany.optimus.core.v1beta1.JobRun.scheduled_at:type_name -> google.protobuf.Timestamp
+	73,  // 7: gotocompany.optimus.core.v1beta1.JobInspectResponse.basic_info:type_name -> gotocompany.optimus.core.v1beta1.JobInspectResponse.BasicInfoSection
+	75,  // 8: gotocompany.optimus.core.v1beta1.JobInspectResponse.upstreams:type_name -> gotocompany.optimus.core.v1beta1.JobInspectResponse.UpstreamSection
+	76,  // 9: gotocompany.optimus.core.v1beta1.JobInspectResponse.downstreams:type_name -> gotocompany.optimus.core.v1beta1.JobInspectResponse.DownstreamSection
+	42,  // 10: gotocompany.optimus.core.v1beta1.CreateJobSpecificationRequest.spec:type_name -> gotocompany.optimus.core.v1beta1.JobSpecification
+	42,  // 11: gotocompany.optimus.core.v1beta1.GetJobSpecificationResponse.spec:type_name -> gotocompany.optimus.core.v1beta1.JobSpecification
+	94,  // 12: gotocompany.optimus.core.v1beta1.JobSpecVersion.created_at:type_name -> google.protobuf.Timestamp
+	25,  // 13: gotocompany.optimus.core.v1beta1.GetJobSpecVersionsResponse.versions:type_name -> gotocompany.optimus.core.v1beta1.JobSpecVersion
+	78,  // 14: gotocompany.optimus.core.v1beta1.GetJobDeletionConsentsResponse.consents:type_name -> gotocompany.optimus.core.v1beta1.GetJobDeletionConsentsResponse.Consent
+	79,  // 15: gotocompany.optimus.core.v1beta1.GetJobDeletionConsentsResponse.audits:type_name -> gotocompany.optimus.core.v1beta1.GetJobDeletionConsentsResponse.Audit
+	42,  // 16: gotocompany.optimus.core.v1beta1.ValidateJobSpecsRequest.jobs:type_name -> gotocompany.optimus.core.v1beta1.JobSpecification
+	80,  // 17: gotocompany.optimus.core.v1beta1.ValidateJobSpecsResponse.results:type_name -> gotocompany.optimus.core.v1beta1.ValidateJobSpecsResponse.Result
+	42,  // 18: gotocompany.optimus.core.v1beta1.ListJobSpecificationResponse.jobs:type_name -> gotocompany.optimus.core.v1beta1.JobSpecification
+	42,  // 19: gotocompany.optimus.core.v1beta1.CheckJobSpecificationRequest.job:type_name -> gotocompany.optimus.core.v1beta1.JobSpecification
+	42,  // 20: gotocompany.optimus.core.v1beta1.CheckJobSpecificationsRequest.jobs:type_name -> gotocompany.optimus.core.v1beta1.JobSpecification
+	93,  // 21: gotocompany.optimus.core.v1beta1.CheckJobSpecificationsResponse.log_status:type_name -> gotocompany.optimus.core.v1beta1.Log
+	46,  // 22: gotocompany.optimus.core.v1beta1.JobSpecification.config:type_name -> gotocompany.optimus.core.v1beta1.JobConfigItem
+	43,  // 23: gotocompany.optimus.core.v1beta1.JobSpecification.dependencies:type_name -> gotocompany.optimus.core.v1beta1.JobDependency
+	81,  // 24: gotocompany.optimus.core.v1beta1.JobSpecification.assets:type_name -> gotocompany.optimus.core.v1beta1.JobSpecification.AssetsEntry
+	45,  // 25: gotocompany.optimus.core.v1beta1.JobSpecification.hooks:type_name -> gotocompany.optimus.core.v1beta1.JobSpecHook
+	82,  // 26: gotocompany.optimus.core.v1beta1.JobSpecification.labels:type_name -> gotocompany.optimus.core.v1beta1.JobSpecification.LabelsEntry
+	83,  // 27: gotocompany.optimus.core.v1beta1.JobSpecification.behavior:type_name -> gotocompany.optimus.core.v1beta1.JobSpecification.Behavior
+	48,  // 28: gotocompany.optimus.core.v1beta1.JobSpecification.metadata:type_name -> gotocompany.optimus.core.v1beta1.JobMetadata
+	44,  // 29: gotocompany.optimus.core.v1beta1.JobDependency.http_dependency:type_name -> gotocompany.optimus.core.v1beta1.HttpDependency
+	87,  // 30: gotocompany.optimus.core.v1beta1.HttpDependency.headers:type_name -> gotocompany.optimus.core.v1beta1.HttpDependency.HeadersEntry
+	88,  // 31: gotocompany.optimus.core.v1beta1.HttpDependency.params:type_name -> gotocompany.optimus.core.v1beta1.HttpDependency.ParamsEntry
+	46,  // 32: gotocompany.optimus.core.v1beta1.JobSpecHook.config:type_name -> gotocompany.optimus.core.v1beta1.JobConfigItem
+	1,   // 33: gotocompany.optimus.core.v1beta1.JobEvent.type:type_name -> gotocompany.optimus.core.v1beta1.JobEvent.Type
+	95,  // 34: gotocompany.optimus.core.v1beta1.JobEvent.value:type_name -> google.protobuf.Struct
+	49,  // 35: gotocompany.optimus.core.v1beta1.JobMetadata.resource:type_name -> gotocompany.optimus.core.v1beta1.JobSpecMetadataResource
+	51,  // 36: gotocompany.optimus.core.v1beta1.JobMetadata.airflow:type_name -> gotocompany.optimus.core.v1beta1.JobSpecMetadataAirflow
+	50,  // 37: gotocompany.optimus.core.v1beta1.JobSpecMetadataResource.request:type_name -> gotocompany.optimus.core.v1beta1.JobSpecMetadataResourceConfig
+	50,  // 38: gotocompany.optimus.core.v1beta1.JobSpecMetadataResource.limit:type_name -> gotocompany.optimus.core.v1beta1.JobSpecMetadataResourceConfig
+	93,  // 39: gotocompany.optimus.core.v1beta1.RefreshJobsResponse.log_status:type_name -> gotocompany.optimus.core.v1beta1.Log
+	56,  // 40: gotocompany.optimus.core.v1beta1.GetDeployJobsStatusResponse.failures:type_name -> gotocompany.optimus.core.v1beta1.DeployJobFailure
+	89,  // 41: gotocompany.optimus.core.v1beta1.GetDeployJobsStatusResponse.unknown_dependencies:type_name -> gotocompany.optimus.core.v1beta1.GetDeployJobsStatusResponse.UnknownDependenciesEntry
+	42,  // 42: gotocompany.optimus.core.v1beta1.GetJobSpecificationsResponse.jobs:type_name -> gotocompany.optimus.core.v1beta1.JobSpecification
+	59,  // 43: gotocompany.optimus.core.v1beta1.GetJobSpecificationsResponse.job_specification_responses:type_name -> gotocompany.optimus.core.v1beta1.JobSpecificationResponse
+	42,  // 44: gotocompany.optimus.core.v1beta1.JobSpecificationResponse.job:type_name -> gotocompany.optimus.core.v1beta1.JobSpecification
+	42,  // 45: gotocompany.optimus.core.v1beta1.ReplaceAllJobSpecificationsRequest.jobs:type_name -> gotocompany.optimus.core.v1beta1.JobSpecification
+	93,  // 46: gotocompany.optimus.core.v1beta1.ReplaceAllJobSpecificationsResponse.log_status:type_name -> gotocompany.optimus.core.v1beta1.Log
+	64,  // 47: gotocompany.optimus.core.v1beta1.GetJobTaskResponse.task:type_name -> gotocompany.optimus.core.v1beta1.JobTask
+	90,  // 48: gotocompany.optimus.core.v1beta1.JobTask.destination:type_name -> gotocompany.optimus.core.v1beta1.JobTask.Destination
+	91,  // 49: gotocompany.optimus.core.v1beta1.JobTask.dependencies:type_name -> gotocompany.optimus.core.v1beta1.JobTask.Dependency
+	94,  // 50: gotocompany.optimus.core.v1beta1.GetWindowRequest.scheduled_at:type_name -> google.protobuf.Timestamp
+	94,  // 51: gotocompany.optimus.core.v1beta1.GetWindowResponse.start:type_name -> google.protobuf.Timestamp
+	94,  // 52: gotocompany.optimus.core.v1beta1.GetWindowResponse.end:type_name -> google.protobuf.Timestamp
+	0,   // 53: gotocompany.optimus.core.v1beta1.UpdateJobsStateRequest.state:type_name -> gotocompany.optimus.core.v1beta1.JobState
+	92,  // 54: gotocompany.optimus.core.v1beta1.SyncJobsStateRequest.job_states:type_name -> gotocompany.optimus.core.v1beta1.SyncJobsStateRequest.JobStatePair
+	42,  // 55: gotocompany.optimus.core.v1beta1.FormatJobSpecificationsRequest.jobs:type_name -> gotocompany.optimus.core.v1beta1.JobSpecification
+	42,  // 56: gotocompany.optimus.core.v1beta1.FormatJobSpecificationsResponse.jobs:type_name -> gotocompany.optimus.core.v1beta1.JobSpecification
+	42,  // 57: gotocompany.optimus.core.v1beta1.JobInspectResponse.BasicInfoSection.job:type_name -> gotocompany.optimus.core.v1beta1.JobSpecification
+	93,  // 58: gotocompany.optimus.core.v1beta1.JobInspectResponse.BasicInfoSection.notice:type_name -> gotocompany.optimus.core.v1beta1.Log
+	9,   // 59: gotocompany.optimus.core.v1beta1.JobInspectResponse.JobDependency.runs:type_name -> gotocompany.optimus.core.v1beta1.JobRun
+	74,  // 60: gotocompany.optimus.core.v1beta1.JobInspectResponse.UpstreamSection.external_dependency:type_name -> gotocompany.optimus.core.v1beta1.JobInspectResponse.JobDependency
+	74,  // 61: gotocompany.optimus.core.v1beta1.JobInspectResponse.UpstreamSection.internal_dependency:type_name -> gotocompany.optimus.core.v1beta1.JobInspectResponse.JobDependency
+	44,  // 62: gotocompany.optimus.core.v1beta1.JobInspectResponse.UpstreamSection.http_dependency:type_name -> gotocompany.optimus.core.v1beta1.HttpDependency
+	77,  // 63: gotocompany.optimus.core.v1beta1.JobInspectResponse.UpstreamSection.unknown_dependencies:type_name -> gotocompany.optimus.core.v1beta1.JobInspectResponse.UpstreamSection.UnknownDependencies
+	93,  // 64: gotocompany.optimus.core.v1beta1.JobInspectResponse.UpstreamSection.notice:type_name -> gotocompany.optimus.core.v1beta1.Log
+	74,  // 65: gotocompany.optimus.core.v1beta1.JobInspectResponse.DownstreamSection.downstream_jobs:type_name -> gotocompany.optimus.core.v1beta1.JobInspectResponse.JobDependency
+	93,  // 66: gotocompany.optimus.core.v1beta1.JobInspectResponse.DownstreamSection.notice:type_name -> gotocompany.optimus.core.v1beta1.Log
+	94,  // 67: gotocompany.optimus.core.v1beta1.GetJobDeletionConsentsResponse.Consent.created_at:type_name -> google.protobuf.Timestamp
+	94,  // 68: gotocompany.optimus.core.v1beta1.GetJobDeletionConsentsResponse.Audit.created_at:type_name -> google.protobuf.Timestamp
+	84,  // 69: gotocompany.optimus.core.v1beta1.JobSpecification.Behavior.retry:type_name -> gotocompany.optimus.core.v1beta1.JobSpecification.Behavior.Retry
+	85,  // 70: gotocompany.optimus.core.v1beta1.JobSpecification.Behavior.notify:type_name -> gotocompany.optimus.core.v1beta1.JobSpecification.Behavior.Notifiers
+	96,  // 71: gotocompany.optimus.core.v1beta1.JobSpecification.Behavior.Retry.delay:type_name -> google.protobuf.Duration
+	1,   // 72: gotocompany.optimus.core.v1beta1.JobSpecification.Behavior.Notifiers.on:type_name -> gotocompany.optimus.core.v1beta1.JobEvent.Type
+	86,  // 73: gotocompany.optimus.core.v1beta1.JobSpecification.Behavior.Notifiers.config:type_name -> gotocompany.optimus.core.v1beta1.JobSpecification.Behavior.Notifiers.ConfigEntry
+	0,   // 74: gotocompany.optimus.core.v1beta1.SyncJobsStateRequest.JobStatePair.state:type_name -> gotocompany.optimus.core.v1beta1.JobState
+	2,   // 75: gotocompany.optimus.core.v1beta1.JobSpecificationService.DeployJobSpecification:input_type -> gotocompany.optimus.core.v1beta1.DeployJobSpecificationRequest
+	8,   // 76: gotocompany.optimus.core.v1beta1.JobSpecificationService.JobInspect:input_type -> gotocompany.optimus.core.v1beta1.JobInspectRequest
+	11,  // 77: gotocompany.optimus.core.v1beta1.JobSpecificationService.CreateJobSpecification:input_type -> gotocompany.optimus.core.v1beta1.CreateJobSpecificationRequest
+	4,   // 78: gotocompany.optimus.core.v1beta1.JobSpecificationService.AddJobSpecifications:input_type -> gotocompany.optimus.core.v1beta1.AddJobSpecificationsRequest
+	6,   // 79: gotocompany.optimus.core.v1beta1.JobSpecificationService.UpdateJobSpecifications:input_type -> gotocompany.optimus.core.v1beta1.UpdateJobSpecificationsRequest
+	13,  // 80: gotocompany.optimus.core.v1beta1.JobSpecificationService.GetJobSpecification:input_type -> gotocompany.optimus.core.v1beta1.GetJobSpecificationRequest
+	57,  // 81: gotocompany.optimus.core.v1beta1.JobSpecificationService.GetJobSpecifications:input_type -> gotocompany.optimus.core.v1beta1.GetJobSpecificationsRequest
+	15,  // 82: gotocompany.optimus.core.v1beta1.JobSpecificationService.DeleteJobSpecification:input_type -> gotocompany.optimus.core.v1beta1.DeleteJobSpecificationRequest
+	17,  // 83: gotocompany.optimus.core.v1beta1.JobSpecificationService.DeleteJobs:input_type -> gotocompany.optimus.core.v1beta1.DeleteJobsRequest
+	19,  // 84: gotocompany.optimus.core.v1beta1.JobSpecificationService.ChangeJobNamespace:input_type -> gotocompany.optimus.core.v1beta1.ChangeJobNamespaceRequest
+	21,  // 85: gotocompany.optimus.core.v1beta1.JobSpecificationService.MoveJob:input_type -> gotocompany.optimus.core.v1beta1.MoveJobRequest
+	23,  // 86: gotocompany.optimus.core.v1beta1.JobSpecificationService.ChangeJobName:input_type -> gotocompany.optimus.core.v1beta1.ChangeJobNameRequest
+	26,  // 87: gotocompany.optimus.core.v1beta1.JobSpecificationService.GetJobSpecVersions:input_type -> gotocompany.optimus.core.v1beta1.GetJobSpecVersionsRequest
+	28,  // 88: gotocompany.optimus.core.v1beta1.JobSpecificationService.AddJobDeletionConsent:input_type -> gotocompany.optimus.core.v1beta1.AddJobDeletionConsentRequest
+	30,  // 89: gotocompany.optimus.core.v1beta1.JobSpecificationService.GetJobDeletionConsents:input_type -> gotocompany.optimus.core.v1beta1.GetJobDeletionConsentsRequest
+	32,  // 90: gotocompany.optimus.core.v1beta1.JobSpecificationService.RollbackJob:input_type -> gotocompany.optimus.core.v1beta1.RollbackJobRequest
+	34,  // 91: gotocompany.optimus.core.v1beta1.JobSpecificationService.ValidateJobSpecs:input_type -> gotocompany.optimus.core.v1beta1.ValidateJobSpecsRequest
+	36,  // 92: gotocompany.optimus.core.v1beta1.JobSpecificationService.ListJobSpecification:input_type -> gotocompany.optimus.core.v1beta1.ListJobSpecificationRequest
+	38,  // 93: gotocompany.optimus.core.v1beta1.JobSpecificationService.CheckJobSpecification:input_type -> gotocompany.optimus.core.v1beta1.CheckJobSpecificationRequest
+	40,  // 94: gotocompany.optimus.core.v1beta1.JobSpecificationService.CheckJobSpecifications:input_type -> gotocompany.optimus.core.v1beta1.CheckJobSpecificationsRequest
+	52,  // 95: gotocompany.optimus.core.v1beta1.JobSpecificationService.RefreshJobs:input_type -> gotocompany.optimus.core.v1beta1.RefreshJobsRequest
+	54,  // 96: gotocompany.optimus.core.v1beta1.JobSpecificationService.GetDeployJobsStatus:input_type -> gotocompany.optimus.core.v1beta1.GetDeployJobsStatusRequest
+	60,  // 97: gotocompany.optimus.core.v1beta1.JobSpecificationService.ReplaceAllJobSpecifications:input_type -> gotocompany.optimus.core.v1beta1.ReplaceAllJobSpecificationsRequest
+	62,  // 98: gotocompany.optimus.core.v1beta1.JobSpecificationService.GetJobTask:input_type -> gotocompany.optimus.core.v1beta1.GetJobTaskRequest
+	65,  // 99: gotocompany.optimus.core.v1beta1.JobSpecificationService.GetWindow:input_type -> gotocompany.optimus.core.v1beta1.GetWindowRequest
+	67,  // 100: gotocompany.optimus.core.v1beta1.JobSpecificationService.UpdateJobsState:input_type -> gotocompany.optimus.core.v1beta1.UpdateJobsStateRequest
+	69,  // 101: gotocompany.optimus.core.v1beta1.JobSpecificationService.SyncJobsState:input_type -> gotocompany.optimus.core.v1beta1.SyncJobsStateRequest
+	71,  // 102: gotocompany.optimus.core.v1beta1.JobSpecificationService.FormatJobSpecifications:input_type -> gotocompany.optimus.core.v1beta1.FormatJobSpecificationsRequest
+	3,   // 103: gotocompany.optimus.core.v1beta1.JobSpecificationService.DeployJobSpecification:output_type -> gotocompany.optimus.core.v1beta1.DeployJobSpecificationResponse
+	10,  // 104: gotocompany.optimus.core.v1beta1.JobSpecificationService.JobInspect:output_type -> gotocompany.optimus.core.v1beta1.JobInspectResponse
+	12,  // 105: gotocompany.optimus.core.v1beta1.JobSpecificationService.CreateJobSpecification:output_type -> gotocompany.optimus.core.v1beta1.CreateJobSpecificationResponse
+	5,   // 106: gotocompany.optimus.core.v1beta1.JobSpecificationService.AddJobSpecifications:output_type -> gotocompany.optimus.core.v1beta1.AddJobSpecificationsResponse
+	7,   // 107: gotocompany.optimus.core.v1beta1.JobSpecificationService.UpdateJobSpecifications:output_type -> gotocompany.optimus.core.v1beta1.UpdateJobSpecificationsResponse
+	14,  // 108: gotocompany.optimus.core.v1beta1.JobSpecificationService.GetJobSpecification:output_type -> gotocompany.optimus.core.v1beta1.GetJobSpecificationResponse
+	58,  // 109: gotocompany.optimus.core.v1beta1.JobSpecificationService.GetJobSpecifications:output_type -> gotocompany.optimus.core.v1beta1.GetJobSpecificationsResponse
+	16,  // 110: gotocompany.optimus.core.v1beta1.JobSpecificationService.DeleteJobSpecification:output_type -> gotocompany.optimus.core.v1beta1.DeleteJobSpecificationResponse
+	18,  // 111: gotocompany.optimus.core.v1beta1.JobSpecificationService.DeleteJobs:output_type -> gotocompany.optimus.core.v1beta1.DeleteJobsResponse
+	20,  // 112: gotocompany.optimus.core.v1beta1.JobSpecificationService.ChangeJobNamespace:output_type -> gotocompany.optimus.core.v1beta1.ChangeJobNamespaceResponse
+	22,  // 113: gotocompany.optimus.core.v1beta1.JobSpecificationService.MoveJob:output_type -> gotocompany.optimus.core.v1beta1.MoveJobResponse
+	24,  // 114: gotocompany.optimus.core.v1beta1.JobSpecificationService.ChangeJobName:output_type -> gotocompany.optimus.core.v1beta1.ChangeJobNameResponse
+	27,  // 115: gotocompany.optimus.core.v1beta1.JobSpecificationService.GetJobSpecVersions:output_type -> gotocompany.optimus.core.v1beta1.GetJobSpecVersionsResponse
+	29,  // 116: gotocompany.optimus.core.v1beta1.JobSpecificationService.AddJobDeletionConsent:output_type -> gotocompany.optimus.core.v1beta1.AddJobDeletionConsentResponse
+	31,  // 117: gotocompany.optimus.core.v1beta1.JobSpecificationService.GetJobDeletionConsents:output_type -> gotocompany.optimus.core.v1beta1.GetJobDeletionConsentsResponse
+	33,  // 118: gotocompany.optimus.core.v1beta1.JobSpecificationService.RollbackJob:output_type -> gotocompany.optimus.core.v1beta1.RollbackJobResponse
+	35,  // 119: gotocompany.optimus.core.v1beta1.JobSpecificationService.ValidateJobSpecs:output_type -> gotocompany.optimus.core.v1beta1.ValidateJobSpecsResponse
+	37,  // 120: gotocompany.optimus.core.v1beta1.JobSpecificationService.ListJobSpecification:output_type -> gotocompany.optimus.core.v1beta1.ListJobSpecificationResponse
+	39,  // 121: gotocompany.optimus.core.v1beta1.JobSpecificationService.CheckJobSpecification:output_type -> gotocompany.optimus.core.v1beta1.CheckJobSpecificationResponse
+	41,  // 122: gotocompany.optimus.core.v1beta1.JobSpecificationService.CheckJobSpecifications:output_type -> gotocompany.optimus.core.v1beta1.CheckJobSpecificationsResponse
+	53,  // 123: gotocompany.optimus.core.v1beta1.JobSpecificationService.RefreshJobs:output_type -> gotocompany.optimus.core.v1beta1.RefreshJobsResponse
+	55,  // 124: gotocompany.optimus.core.v1beta1.JobSpecificationService.GetDeployJobsStatus:output_type -> gotocompany.optimus.core.v1beta1.GetDeployJobsStatusResponse
+	61,  // 125: gotocompany.optimus.core.v1beta1.JobSpecificationService.ReplaceAllJobSpecifications:output_type -> gotocompany.optimus.core.v1beta1.ReplaceAllJobSpecificationsResponse
+	63,  // 126: gotocompany.optimus.core.v1beta1.JobSpecificationService.GetJobTask:output_type -> gotocompany.optimus.core.v1beta1.GetJobTaskResponse
+	66,  // 127: gotocompany.optimus.core.v1beta1.JobSpecificationService.GetWindow:output_type -> gotocompany.optimus.core.v1beta1.GetWindowResponse
+	68,  // 128: gotocompany.optimus.core.v1beta1.JobSpecificationService.UpdateJobsState:output_type -> gotocompany.optimus.core.v1beta1.UpdateJobsStateResponse
+	70,  // 129: gotocompany.optimus.core.v1beta1.JobSpecificationService.SyncJobsState:output_type -> gotocompany.optimus.core.v1beta1.SyncJobsStateResponse
+	72,  // 130: gotocompany.optimus.core.v1beta1.JobSpecificationService.FormatJobSpecifications:output_type -> gotocompany.optimus.core.v1beta1.FormatJobSpecificationsResponse
+	103, // [103:131] is the sub-list for method output_type
+	75,  // [75:103] is the sub-list for method input_type
+	75,  // [75:75] is the sub-list for extension type_name
+	75,  // [75:75] is the sub-list for extension extendee
+	0,   // [0:75] is the sub-list for field type_name
 }
 
 func init() { file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() }
@@ -5266,8 +6986,128 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeployJobSpecificationResponse); i {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeployJobSpecificationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddJobSpecificationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddJobSpecificationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateJobSpecificationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateJobSpecificationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JobInspectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JobRun); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JobInspectResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateJobSpecificationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateJobSpecificationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetJobSpecificationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5278,8 +7118,8 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddJobSpecificationsRequest); i {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetJobSpecificationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5290,8 +7130,8 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddJobSpecificationsResponse); i {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteJobSpecificationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5302,8 +7142,8 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateJobSpecificationsRequest); i {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteJobSpecificationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5314,8 +7154,8 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateJobSpecificationsResponse); i {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteJobsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5326,8 +7166,8 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JobInspectRequest); i {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteJobsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5338,8 +7178,8 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JobRun); i {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeJobNamespaceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5350,8 +7190,8 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JobInspectResponse); i {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeJobNamespaceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5362,8 +7202,8 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateJobSpecificationRequest); i {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveJobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5374,8 +7214,8 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateJobSpecificationResponse); i {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveJobResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5386,8 +7226,8 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetJobSpecificationRequest); i {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeJobNameRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5398,8 +7238,8 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetJobSpecificationResponse); i {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeJobNameResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5410,8 +7250,8 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteJobSpecificationRequest); i {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JobSpecVersion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5422,8 +7262,8 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteJobSpecificationResponse); i {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetJobSpecVersionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5434,8 +7274,8 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChangeJobNamespaceRequest); i {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetJobSpecVersionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5446,8 +7286,8 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChangeJobNamespaceResponse); i {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddJobDeletionConsentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5458,7 +7298,91 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddJobDeletionConsentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetJobDeletionConsentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetJobDeletionConsentsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RollbackJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RollbackJobResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateJobSpecsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateJobSpecsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListJobSpecificationRequest); i {
 			case 0:
 				return &v.state
@@ -5470,7 +7394,7 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListJobSpecificationResponse); i {
 			case 0:
 				return &v.state
@@ -5482,7 +7406,7 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CheckJobSpecificationRequest); i {
 			case 0:
 				return &v.state
@@ -5494,7 +7418,7 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CheckJobSpecificationResponse); i {
 			case 0:
 				return &v.state
@@ -5506,7 +7430,7 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CheckJobSpecificationsRequest); i {
 			case 0:
 				return &v.state
@@ -5518,7 +7442,7 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CheckJobSpecificationsResponse); i {
 			case 0:
 				return &v.state
@@ -5530,7 +7454,7 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*JobSpecification); i {
 			case 0:
 				return &v.state
@@ -5542,7 +7466,7 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*JobDependency); i {
 			case 0:
 				return &v.state
@@ -5554,7 +7478,7 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*HttpDependency); i {
 			case 0:
 				return &v.state
@@ -5566,7 +7490,7 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*JobSpecHook); i {
 			case 0:
 				return &v.state
@@ -5578,7 +7502,7 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*JobConfigItem); i {
 			case 0:
 				return &v.state
@@ -5590,7 +7514,7 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*JobEvent); i {
 			case 0:
 				return &v.state
@@ -5602,7 +7526,7 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*JobMetadata); i {
 			case 0:
 				return &v.state
@@ -5614,7 +7538,7 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*JobSpecMetadataResource); i {
 			case 0:
 				return &v.state
@@ -5626,7 +7550,7 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*JobSpecMetadataResourceConfig); i {
 			case 0:
 				return &v.state
@@ -5638,7 +7562,7 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*JobSpecMetadataAirflow); i {
 			case 0:
 				return &v.state
@@ -5650,7 +7574,7 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RefreshJobsRequest); i {
 			case 0:
 				return &v.state
@@ -5662,7 +7586,7 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RefreshJobsResponse); i {
 			case 0:
 				return &v.state
@@ -5674,7 +7598,7 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetDeployJobsStatusRequest); i {
 			case 0:
 				return &v.state
@@ -5686,7 +7610,7 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetDeployJobsStatusResponse); i {
 			case 0:
 				return &v.state
@@ -5698,7 +7622,7 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeployJobFailure); i {
 			case 0:
 				return &v.state
@@ -5710,7 +7634,7 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetJobSpecificationsRequest); i {
 			case 0:
 				return &v.state
@@ -5722,7 +7646,7 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetJobSpecificationsResponse); i {
 			case 0:
 				return &v.state
@@ -5734,7 +7658,7 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*JobSpecificationResponse); i {
 			case 0:
 				return &v.state
@@ -5746,7 +7670,7 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReplaceAllJobSpecificationsRequest); i {
 			case 0:
 				return &v.state
@@ -5758,7 +7682,7 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReplaceAllJobSpecificationsResponse); i {
 			case 0:
 				return &v.state
@@ -5770,7 +7694,7 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetJobTaskRequest); i {
 			case 0:
 				return &v.state
@@ -5782,7 +7706,7 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetJobTaskResponse); i {
 			case 0:
 				return &v.state
@@ -5794,7 +7718,7 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*JobTask); i {
 			case 0:
 				return &v.state
@@ -5806,7 +7730,7 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetWindowRequest); i {
 			case 0:
 				return &v.state
@@ -5818,7 +7742,7 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetWindowResponse); i {
 			case 0:
 				return &v.state
@@ -5830,7 +7754,7 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateJobsStateRequest); i {
 			case 0:
 				return &v.state
@@ -5842,7 +7766,7 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateJobsStateResponse); i {
 			case 0:
 				return &v.state
@@ -5854,7 +7778,7 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SyncJobsStateRequest); i {
 			case 0:
 				return &v.state
@@ -5866,7 +7790,7 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SyncJobsStateResponse); i {
 			case 0:
 				return &v.state
@@ -5878,7 +7802,31 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FormatJobSpecificationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FormatJobSpecificationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*JobInspectResponse_BasicInfoSection); i {
 			case 0:
 				return &v.state
@@ -5890,7 +7838,7 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*JobInspectResponse_JobDependency); i {
 			case 0:
 				return &v.state
@@ -5902,7 +7850,7 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*JobInspectResponse_UpstreamSection); i {
 			case 0:
 				return &v.state
@@ -5914,7 +7862,7 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*JobInspectResponse_DownstreamSection); i {
 			case 0:
 				return &v.state
@@ -5926,7 +7874,7 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*JobInspectResponse_UpstreamSection_UnknownDependencies); i {
 			case 0:
 				return &v.state
@@ -5938,7 +7886,43 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetJobDeletionConsentsResponse_Consent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetJobDeletionConsentsResponse_Audit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateJobSpecsResponse_Result); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*JobSpecification_Behavior); i {
 			case 0:
 				return &v.state
@@ -5950,7 +7934,7 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*JobSpecification_Behavior_Retry); i {
 			case 0:
 				return &v.state
@@ -5962,7 +7946,7 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*JobSpecification_Behavior_Notifiers); i {
 			case 0:
 				return &v.state
@@ -5974,7 +7958,7 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*JobTask_Destination); i {
 			case 0:
 				return &v.state
@@ -5986,7 +7970,7 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*JobTask_Dependency); i {
 			case 0:
 				return &v.state
@@ -5998,7 +7982,7 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 				return nil
 			}
 		}
-		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+		file_gotocompany_optimus_core_v1beta1_job_spec_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SyncJobsStateRequest_JobStatePair); i {
 			case 0:
 				return &v.state
@@ -6017,7 +8001,7 @@ func file_gotocompany_optimus_core_v1beta1_job_spec_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_gotocompany_optimus_core_v1beta1_job_spec_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   69,
+			NumMessages:   91,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -42,8 +42,25 @@ type JobSpecificationServiceClient interface {
 	GetJobSpecifications(ctx context.Context, in *GetJobSpecificationsRequest, opts ...grpc.CallOption) (*GetJobSpecificationsResponse, error)
 	// DeleteJobSpecification deletes a job spec of a namespace
 	DeleteJobSpecification(ctx context.Context, in *DeleteJobSpecificationRequest, opts ...grpc.CallOption) (*DeleteJobSpecificationResponse, error)
+	// DeleteJobs deletes several job specs of a namespace at once, refusing when jobs outside of the request depend on them unless forced
+	DeleteJobs(ctx context.Context, in *DeleteJobsRequest, opts ...grpc.CallOption) (*DeleteJobsResponse, error)
 	// ChangeJobNamespace move a job spec from one namespace to another
 	ChangeJobNamespace(ctx context.Context, in *ChangeJobNamespaceRequest, opts ...grpc.CallOption) (*ChangeJobNamespaceResponse, error)
+	// MoveJob changes the namespace of a job after checking the secrets and configs it refers to are available in the new namespace
+	MoveJob(ctx context.Context, in *MoveJobRequest, opts ...grpc.CallOption) (*MoveJobResponse, error)
+	// ChangeJobName renames a job spec, keeping its run and replay history linked to the new name
+	ChangeJobName(ctx context.Context, in *ChangeJobNameRequest, opts ...grpc.CallOption) (*ChangeJobNameResponse, error)
+	// GetJobSpecVersions lists the deployed versions of a job spec, the latest first
+	GetJobSpecVersions(ctx context.Context, in *GetJobSpecVersionsRequest, opts ...grpc.CallOption) (*GetJobSpecVersionsResponse, error)
+	// AddJobDeletionConsent records the consent of a downstream job to delete the upstream job it depends on
+	AddJobDeletionConsent(ctx context.Context, in *AddJobDeletionConsentRequest, opts ...grpc.CallOption) (*AddJobDeletionConsentResponse, error)
+	// GetJobDeletionConsents lists the consents of the downstream jobs to delete a job, and the audit trail of its
+	// deletions
+	GetJobDeletionConsents(ctx context.Context, in *GetJobDeletionConsentsRequest, opts ...grpc.CallOption) (*GetJobDeletionConsentsResponse, error)
+	// RollbackJob redeploys a previously deployed version of a job spec
+	RollbackJob(ctx context.Context, in *RollbackJobRequest, opts ...grpc.CallOption) (*RollbackJobResponse, error)
+	// ValidateJobSpecs runs the checks done on deployment against the job specs of the request, without persisting them
+	ValidateJobSpecs(ctx context.Context, in *ValidateJobSpecsRequest, opts ...grpc.CallOption) (*ValidateJobSpecsResponse, error)
 	// ListJobSpecification returns list of jobs created in a project
 	ListJobSpecification(ctx context.Context, in *ListJobSpecificationRequest, opts ...grpc.CallOption) (*ListJobSpecificationResponse, error)
 	// CheckJobSpecification checks if a job specification is valid
@@ -68,6 +85,8 @@ type JobSpecificationServiceClient interface {
 	UpdateJobsState(ctx context.Context, in *UpdateJobsStateRequest, opts ...grpc.CallOption) (*UpdateJobsStateResponse, error)
 	// SyncJobsState enable / disable job on scheuler
 	SyncJobsState(ctx context.Context, in *SyncJobsStateRequest, opts ...grpc.CallOption) (*SyncJobsStateResponse, error)
+	// FormatJobSpecifications returns the job specifications normalized into the canonical form of the server
+	FormatJobSpecifications(ctx context.Context, in *FormatJobSpecificationsRequest, opts ...grpc.CallOption) (*FormatJobSpecificationsResponse, error)
 }
 
 type jobSpecificationServiceClient struct {
@@ -172,6 +191,15 @@ func (c *jobSpecificationServiceClient) DeleteJobSpecification(ctx context.Conte
 	return out, nil
 }
 
+func (c *jobSpecificationServiceClient) DeleteJobs(ctx context.Context, in *DeleteJobsRequest, opts ...grpc.CallOption) (*DeleteJobsResponse, error) {
+	out := new(DeleteJobsResponse)
+	err := c.cc.Invoke(ctx, "/gotocompany.optimus.core.v1beta1.JobSpecificationService/DeleteJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *jobSpecificationServiceClient) ChangeJobNamespace(ctx context.Context, in *ChangeJobNamespaceRequest, opts ...grpc.CallOption) (*ChangeJobNamespaceResponse, error) {
 	out := new(ChangeJobNamespaceResponse)
 	err := c.cc.Invoke(ctx, "/gotocompany.optimus.core.v1beta1.JobSpecificationService/ChangeJobNamespace", in, out, opts...)
@@ -181,6 +209,69 @@ func (c *jobSpecificationServiceClient) ChangeJobNamespace(ctx context.Context,
 	return out, nil
 }
 
+func (c *jobSpecificationServiceClient) MoveJob(ctx context.Context, in *MoveJobRequest, opts ...grpc.CallOption) (*MoveJobResponse, error) {
+	out := new(MoveJobResponse)
+	err := c.cc.Invoke(ctx, "/gotocompany.optimus.core.v1beta1.JobSpecificationService/MoveJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobSpecificationServiceClient) ChangeJobName(ctx context.Context, in *ChangeJobNameRequest, opts ...grpc.CallOption) (*ChangeJobNameResponse, error) {
+	out := new(ChangeJobNameResponse)
+	err := c.cc.Invoke(ctx, "/gotocompany.optimus.core.v1beta1.JobSpecificationService/ChangeJobName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobSpecificationServiceClient) GetJobSpecVersions(ctx context.Context, in *GetJobSpecVersionsRequest, opts ...grpc.CallOption) (*GetJobSpecVersionsResponse, error) {
+	out := new(GetJobSpecVersionsResponse)
+	err := c.cc.Invoke(ctx, "/gotocompany.optimus.core.v1beta1.JobSpecificationService/GetJobSpecVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobSpecificationServiceClient) AddJobDeletionConsent(ctx context.Context, in *AddJobDeletionConsentRequest, opts ...grpc.CallOption) (*AddJobDeletionConsentResponse, error) {
+	out := new(AddJobDeletionConsentResponse)
+	err := c.cc.Invoke(ctx, "/gotocompany.optimus.core.v1beta1.JobSpecificationService/AddJobDeletionConsent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobSpecificationServiceClient) GetJobDeletionConsents(ctx context.Context, in *GetJobDeletionConsentsRequest, opts ...grpc.CallOption) (*GetJobDeletionConsentsResponse, error) {
+	out := new(GetJobDeletionConsentsResponse)
+	err := c.cc.Invoke(ctx, "/gotocompany.optimus.core.v1beta1.JobSpecificationService/GetJobDeletionConsents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobSpecificationServiceClient) RollbackJob(ctx context.Context, in *RollbackJobRequest, opts ...grpc.CallOption) (*RollbackJobResponse, error) {
+	out := new(RollbackJobResponse)
+	err := c.cc.Invoke(ctx, "/gotocompany.optimus.core.v1beta1.JobSpecificationService/RollbackJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobSpecificationServiceClient) ValidateJobSpecs(ctx context.Context, in *ValidateJobSpecsRequest, opts ...grpc.CallOption) (*ValidateJobSpecsResponse, error) {
+	out := new(ValidateJobSpecsResponse)
+	err := c.cc.Invoke(ctx, "/gotocompany.optimus.core.v1beta1.JobSpecificationService/ValidateJobSpecs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *jobSpecificationServiceClient) ListJobSpecification(ctx context.Context, in *ListJobSpecificationRequest, opts ...grpc.CallOption) (*ListJobSpecificationResponse, error) {
 	out := new(ListJobSpecificationResponse)
 	err := c.cc.Invoke(ctx, "/gotocompany.optimus.core.v1beta1.JobSpecificationService/ListJobSpecification", in, out, opts...)
@@ -340,6 +431,15 @@ func (c *jobSpecificationServiceClient) SyncJobsState(ctx context.Context, in *S
 	return out, nil
 }
 
+func (c *jobSpecificationServiceClient) FormatJobSpecifications(ctx context.Context, in *FormatJobSpecificationsRequest, opts ...grpc.CallOption) (*FormatJobSpecificationsResponse, error) {
+	out := new(FormatJobSpecificationsResponse)
+	err := c.cc.Invoke(ctx, "/gotocompany.optimus.core.v1beta1.JobSpecificationService/FormatJobSpecifications", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // JobSpecificationServiceServer is the server API for JobSpecificationService service.
 // All implementations must embed UnimplementedJobSpecificationServiceServer
 // for forward compatibility
@@ -364,8 +464,25 @@ type JobSpecificationServiceServer interface {
 	GetJobSpecifications(context.Context, *GetJobSpecificationsRequest) (*GetJobSpecificationsResponse, error)
 	// DeleteJobSpecification deletes a job spec of a namespace
 	DeleteJobSpecification(context.Context, *DeleteJobSpecificationRequest) (*DeleteJobSpecificationResponse, error)
+	// DeleteJobs deletes several job specs of a namespace at once, refusing when jobs outside of the request depend on them unless forced
+	DeleteJobs(context.Context, *DeleteJobsRequest) (*DeleteJobsResponse, error)
 	// ChangeJobNamespace move a job spec from one namespace to another
 	ChangeJobNamespace(context.Context, *ChangeJobNamespaceRequest) (*ChangeJobNamespaceResponse, error)
+	// MoveJob changes the namespace of a job after checking the secrets and configs it refers to are available in the new namespace
+	MoveJob(context.Context, *MoveJobRequest) (*MoveJobResponse, error)
+	// ChangeJobName renames a job spec, keeping its run and replay history linked to the new name
+	ChangeJobName(context.Context, *ChangeJobNameRequest) (*ChangeJobNameResponse, error)
+	// GetJobSpecVersions lists the deployed versions of a job spec, the latest first
+	GetJobSpecVersions(context.Context, *GetJobSpecVersionsRequest) (*GetJobSpecVersionsResponse, error)
+	// AddJobDeletionConsent records the consent of a downstream job to delete the upstream job it depends on
+	AddJobDeletionConsent(context.Context, *AddJobDeletionConsentRequest) (*AddJobDeletionConsentResponse, error)
+	// GetJobDeletionConsents lists the consents of the downstream jobs to delete a job, and the audit trail of its
+	// deletions
+	GetJobDeletionConsents(context.Context, *GetJobDeletionConsentsRequest) (*GetJobDeletionConsentsResponse, error)
+	// RollbackJob redeploys a previously deployed version of a job spec
+	RollbackJob(context.Context, *RollbackJobRequest) (*RollbackJobResponse, error)
+	// ValidateJobSpecs runs the checks done on deployment against the job specs of the request, without persisting them
+	ValidateJobSpecs(context.Context, *ValidateJobSpecsRequest) (*ValidateJobSpecsResponse, error)
 	// ListJobSpecification returns list of jobs created in a project
 	ListJobSpecification(context.Context, *ListJobSpecificationRequest) (*ListJobSpecificationResponse, error)
 	// CheckJobSpecification checks if a job specification is valid
@@ -390,6 +507,8 @@ type JobSpecificationServiceServer interface {
 	UpdateJobsState(context.Context, *UpdateJobsStateRequest) (*UpdateJobsStateResponse, error)
 	// SyncJobsState enable / disable job on scheuler
 	SyncJobsState(context.Context, *SyncJobsStateRequest) (*SyncJobsStateResponse, error)
+	// FormatJobSpecifications returns the job specifications normalized into the canonical form of the server
+	FormatJobSpecifications(context.Context, *FormatJobSpecificationsRequest) (*FormatJobSpecificationsResponse, error)
 	mustEmbedUnimplementedJobSpecificationServiceServer()
 }
 
@@ -421,9 +540,33 @@ func (UnimplementedJobSpecificationServiceServer) GetJobSpecifications(context.C
 func (UnimplementedJobSpecificationServiceServer) DeleteJobSpecification(context.Context, *DeleteJobSpecificationRequest) (*DeleteJobSpecificationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteJobSpecification not implemented")
 }
+func (UnimplementedJobSpecificationServiceServer) DeleteJobs(context.Context, *DeleteJobsRequest) (*DeleteJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteJobs not implemented")
+}
 func (UnimplementedJobSpecificationServiceServer) ChangeJobNamespace(context.Context, *ChangeJobNamespaceRequest) (*ChangeJobNamespaceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChangeJobNamespace not implemented")
 }
+func (UnimplementedJobSpecificationServiceServer) MoveJob(context.Context, *MoveJobRequest) (*MoveJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveJob not implemented")
+}
+func (UnimplementedJobSpecificationServiceServer) ChangeJobName(context.Context, *ChangeJobNameRequest) (*ChangeJobNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeJobName not implemented")
+}
+func (UnimplementedJobSpecificationServiceServer) GetJobSpecVersions(context.Context, *GetJobSpecVersionsRequest) (*GetJobSpecVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobSpecVersions not implemented")
+}
+func (UnimplementedJobSpecificationServiceServer) AddJobDeletionConsent(context.Context, *AddJobDeletionConsentRequest) (*AddJobDeletionConsentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddJobDeletionConsent not implemented")
+}
+func (UnimplementedJobSpecificationServiceServer) GetJobDeletionConsents(context.Context, *GetJobDeletionConsentsRequest) (*GetJobDeletionConsentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobDeletionConsents not implemented")
+}
+func (UnimplementedJobSpecificationServiceServer) RollbackJob(context.Context, *RollbackJobRequest) (*RollbackJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackJob not implemented")
+}
+func (UnimplementedJobSpecificationServiceServer) ValidateJobSpecs(context.Context, *ValidateJobSpecsRequest) (*ValidateJobSpecsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateJobSpecs not implemented")
+}
 func (UnimplementedJobSpecificationServiceServer) ListJobSpecification(context.Context, *ListJobSpecificationRequest) (*ListJobSpecificationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListJobSpecification not implemented")
 }
@@ -454,6 +597,9 @@ func (UnimplementedJobSpecificationServiceServer) UpdateJobsState(context.Contex
 func (UnimplementedJobSpecificationServiceServer) SyncJobsState(context.Context, *SyncJobsStateRequest) (*SyncJobsStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SyncJobsState not implemented")
 }
+func (UnimplementedJobSpecificationServiceServer) FormatJobSpecifications(context.Context, *FormatJobSpecificationsRequest) (*FormatJobSpecificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FormatJobSpecifications not implemented")
+}
 func (UnimplementedJobSpecificationServiceServer) mustEmbedUnimplementedJobSpecificationServiceServer() {
 }
 
@@ -620,6 +766,24 @@ func _JobSpecificationService_DeleteJobSpecification_Handler(srv interface{}, ct
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JobSpecificationService_DeleteJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobSpecificationServiceServer).DeleteJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gotocompany.optimus.core.v1beta1.JobSpecificationService/DeleteJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobSpecificationServiceServer).DeleteJobs(ctx, req.(*DeleteJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _JobSpecificationService_ChangeJobNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ChangeJobNamespaceRequest)
 	if err := dec(in); err != nil {
@@ -638,6 +802,132 @@ func _JobSpecificationService_ChangeJobNamespace_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JobSpecificationService_MoveJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobSpecificationServiceServer).MoveJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gotocompany.optimus.core.v1beta1.JobSpecificationService/MoveJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobSpecificationServiceServer).MoveJob(ctx, req.(*MoveJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobSpecificationService_ChangeJobName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeJobNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobSpecificationServiceServer).ChangeJobName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gotocompany.optimus.core.v1beta1.JobSpecificationService/ChangeJobName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobSpecificationServiceServer).ChangeJobName(ctx, req.(*ChangeJobNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobSpecificationService_GetJobSpecVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobSpecVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobSpecificationServiceServer).GetJobSpecVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gotocompany.optimus.core.v1beta1.JobSpecificationService/GetJobSpecVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobSpecificationServiceServer).GetJobSpecVersions(ctx, req.(*GetJobSpecVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobSpecificationService_AddJobDeletionConsent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddJobDeletionConsentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobSpecificationServiceServer).AddJobDeletionConsent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gotocompany.optimus.core.v1beta1.JobSpecificationService/AddJobDeletionConsent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobSpecificationServiceServer).AddJobDeletionConsent(ctx, req.(*AddJobDeletionConsentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobSpecificationService_GetJobDeletionConsents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobDeletionConsentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobSpecificationServiceServer).GetJobDeletionConsents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gotocompany.optimus.core.v1beta1.JobSpecificationService/GetJobDeletionConsents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobSpecificationServiceServer).GetJobDeletionConsents(ctx, req.(*GetJobDeletionConsentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobSpecificationService_RollbackJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobSpecificationServiceServer).RollbackJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gotocompany.optimus.core.v1beta1.JobSpecificationService/RollbackJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobSpecificationServiceServer).RollbackJob(ctx, req.(*RollbackJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobSpecificationService_ValidateJobSpecs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateJobSpecsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobSpecificationServiceServer).ValidateJobSpecs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gotocompany.optimus.core.v1beta1.JobSpecificationService/ValidateJobSpecs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobSpecificationServiceServer).ValidateJobSpecs(ctx, req.(*ValidateJobSpecsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _JobSpecificationService_ListJobSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListJobSpecificationRequest)
 	if err := dec(in); err != nil {
@@ -832,6 +1122,24 @@ func _JobSpecificationService_SyncJobsState_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JobSpecificationService_FormatJobSpecifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FormatJobSpecificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobSpecificationServiceServer).FormatJobSpecifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gotocompany.optimus.core.v1beta1.JobSpecificationService/FormatJobSpecifications",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobSpecificationServiceServer).FormatJobSpecifications(ctx, req.(*FormatJobSpecificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // JobSpecificationService_ServiceDesc is the grpc.ServiceDesc for JobSpecificationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -867,10 +1175,42 @@ var JobSpecificationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteJobSpecification",
 			Handler:    _JobSpecificationService_DeleteJobSpecification_Handler,
 		},
+		{
+			MethodName: "DeleteJobs",
+			Handler:    _JobSpecificationService_DeleteJobs_Handler,
+		},
 		{
 			MethodName: "ChangeJobNamespace",
 			Handler:    _JobSpecificationService_ChangeJobNamespace_Handler,
 		},
+		{
+			MethodName: "MoveJob",
+			Handler:    _JobSpecificationService_MoveJob_Handler,
+		},
+		{
+			MethodName: "ChangeJobName",
+			Handler:    _JobSpecificationService_ChangeJobName_Handler,
+		},
+		{
+			MethodName: "GetJobSpecVersions",
+			Handler:    _JobSpecificationService_GetJobSpecVersions_Handler,
+		},
+		{
+			MethodName: "AddJobDeletionConsent",
+			Handler:    _JobSpecificationService_AddJobDeletionConsent_Handler,
+		},
+		{
+			MethodName: "GetJobDeletionConsents",
+			Handler:    _JobSpecificationService_GetJobDeletionConsents_Handler,
+		},
+		{
+			MethodName: "RollbackJob",
+			Handler:    _JobSpecificationService_RollbackJob_Handler,
+		},
+		{
+			MethodName: "ValidateJobSpecs",
+			Handler:    _JobSpecificationService_ValidateJobSpecs_Handler,
+		},
 		{
 			MethodName: "ListJobSpecification",
 			Handler:    _JobSpecificationService_ListJobSpecification_Handler,
@@ -899,6 +1239,10 @@ var JobSpecificationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SyncJobsState",
 			Handler:    _JobSpecificationService_SyncJobsState_Handler,
 		},
+		{
+			MethodName: "FormatJobSpecifications",
+			Handler:    _JobSpecificationService_FormatJobSpecifications_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
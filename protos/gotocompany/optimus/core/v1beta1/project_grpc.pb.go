@@ -28,6 +28,12 @@ type ProjectServiceClient interface {
 	ListProjects(ctx context.Context, in *ListProjectsRequest, opts ...grpc.CallOption) (*ListProjectsResponse, error)
 	// GetProject returns project details based on project_name
 	GetProject(ctx context.Context, in *GetProjectRequest, opts ...grpc.CallOption) (*GetProjectResponse, error)
+	// SavePreset creates a window preset of the project, or updates it with a new version
+	SavePreset(ctx context.Context, in *SavePresetRequest, opts ...grpc.CallOption) (*SavePresetResponse, error)
+	// ListPresets returns the window presets of the project
+	ListPresets(ctx context.Context, in *ListPresetsRequest, opts ...grpc.CallOption) (*ListPresetsResponse, error)
+	// DeletePreset deletes a window preset of the project
+	DeletePreset(ctx context.Context, in *DeletePresetRequest, opts ...grpc.CallOption) (*DeletePresetResponse, error)
 }
 
 type projectServiceClient struct {
@@ -65,6 +71,33 @@ func (c *projectServiceClient) GetProject(ctx context.Context, in *GetProjectReq
 	return out, nil
 }
 
+func (c *projectServiceClient) SavePreset(ctx context.Context, in *SavePresetRequest, opts ...grpc.CallOption) (*SavePresetResponse, error) {
+	out := new(SavePresetResponse)
+	err := c.cc.Invoke(ctx, "/gotocompany.optimus.core.v1beta1.ProjectService/SavePreset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) ListPresets(ctx context.Context, in *ListPresetsRequest, opts ...grpc.CallOption) (*ListPresetsResponse, error) {
+	out := new(ListPresetsResponse)
+	err := c.cc.Invoke(ctx, "/gotocompany.optimus.core.v1beta1.ProjectService/ListPresets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) DeletePreset(ctx context.Context, in *DeletePresetRequest, opts ...grpc.CallOption) (*DeletePresetResponse, error) {
+	out := new(DeletePresetResponse)
+	err := c.cc.Invoke(ctx, "/gotocompany.optimus.core.v1beta1.ProjectService/DeletePreset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProjectServiceServer is the server API for ProjectService service.
 // All implementations must embed UnimplementedProjectServiceServer
 // for forward compatibility
@@ -75,6 +108,12 @@ type ProjectServiceServer interface {
 	ListProjects(context.Context, *ListProjectsRequest) (*ListProjectsResponse, error)
 	// GetProject returns project details based on project_name
 	GetProject(context.Context, *GetProjectRequest) (*GetProjectResponse, error)
+	// SavePreset creates a window preset of the project, or updates it with a new version
+	SavePreset(context.Context, *SavePresetRequest) (*SavePresetResponse, error)
+	// ListPresets returns the window presets of the project
+	ListPresets(context.Context, *ListPresetsRequest) (*ListPresetsResponse, error)
+	// DeletePreset deletes a window preset of the project
+	DeletePreset(context.Context, *DeletePresetRequest) (*DeletePresetResponse, error)
 	mustEmbedUnimplementedProjectServiceServer()
 }
 
@@ -91,6 +130,15 @@ func (UnimplementedProjectServiceServer) ListProjects(context.Context, *ListProj
 func (UnimplementedProjectServiceServer) GetProject(context.Context, *GetProjectRequest) (*GetProjectResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProject not implemented")
 }
+func (UnimplementedProjectServiceServer) SavePreset(context.Context, *SavePresetRequest) (*SavePresetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SavePreset not implemented")
+}
+func (UnimplementedProjectServiceServer) ListPresets(context.Context, *ListPresetsRequest) (*ListPresetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPresets not implemented")
+}
+func (UnimplementedProjectServiceServer) DeletePreset(context.Context, *DeletePresetRequest) (*DeletePresetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePreset not implemented")
+}
 func (UnimplementedProjectServiceServer) mustEmbedUnimplementedProjectServiceServer() {}
 
 // UnsafeProjectServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -158,6 +206,60 @@ func _ProjectService_GetProject_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProjectService_SavePreset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SavePresetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).SavePreset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gotocompany.optimus.core.v1beta1.ProjectService/SavePreset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).SavePreset(ctx, req.(*SavePresetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_ListPresets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPresetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).ListPresets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gotocompany.optimus.core.v1beta1.ProjectService/ListPresets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).ListPresets(ctx, req.(*ListPresetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_DeletePreset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePresetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).DeletePreset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gotocompany.optimus.core.v1beta1.ProjectService/DeletePreset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).DeletePreset(ctx, req.(*DeletePresetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProjectService_ServiceDesc is the grpc.ServiceDesc for ProjectService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -177,6 +279,18 @@ var ProjectService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetProject",
 			Handler:    _ProjectService_GetProject_Handler,
 		},
+		{
+			MethodName: "SavePreset",
+			Handler:    _ProjectService_SavePreset_Handler,
+		},
+		{
+			MethodName: "ListPresets",
+			Handler:    _ProjectService_ListPresets_Handler,
+		},
+		{
+			MethodName: "DeletePreset",
+			Handler:    _ProjectService_DeletePreset_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "gotocompany/optimus/core/v1beta1/project.proto",
@@ -26,6 +26,10 @@ type ReplayServiceClient interface {
 	ReplayDryRun(ctx context.Context, in *ReplayDryRunRequest, opts ...grpc.CallOption) (*ReplayDryRunResponse, error)
 	ListReplay(ctx context.Context, in *ListReplayRequest, opts ...grpc.CallOption) (*ListReplayResponse, error)
 	GetReplay(ctx context.Context, in *GetReplayRequest, opts ...grpc.CallOption) (*GetReplayResponse, error)
+	// StreamReplayStatus sends the status of the replay, followed by every update on it until the replay is done
+	StreamReplayStatus(ctx context.Context, in *StreamReplayStatusRequest, opts ...grpc.CallOption) (ReplayService_StreamReplayStatusClient, error)
+	// GetReplayDetails returns the replay with its requester, reason and every state it went through
+	GetReplayDetails(ctx context.Context, in *GetReplayDetailsRequest, opts ...grpc.CallOption) (*GetReplayDetailsResponse, error)
 }
 
 type replayServiceClient struct {
@@ -72,6 +76,47 @@ func (c *replayServiceClient) GetReplay(ctx context.Context, in *GetReplayReques
 	return out, nil
 }
 
+func (c *replayServiceClient) StreamReplayStatus(ctx context.Context, in *StreamReplayStatusRequest, opts ...grpc.CallOption) (ReplayService_StreamReplayStatusClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ReplayService_ServiceDesc.Streams[0], "/gotocompany.optimus.core.v1beta1.ReplayService/StreamReplayStatus", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &replayServiceStreamReplayStatusClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ReplayService_StreamReplayStatusClient interface {
+	Recv() (*GetReplayResponse, error)
+	grpc.ClientStream
+}
+
+type replayServiceStreamReplayStatusClient struct {
+	grpc.ClientStream
+}
+
+func (x *replayServiceStreamReplayStatusClient) Recv() (*GetReplayResponse, error) {
+	m := new(GetReplayResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *replayServiceClient) GetReplayDetails(ctx context.Context, in *GetReplayDetailsRequest, opts ...grpc.CallOption) (*GetReplayDetailsResponse, error) {
+	out := new(GetReplayDetailsResponse)
+	err := c.cc.Invoke(ctx, "/gotocompany.optimus.core.v1beta1.ReplayService/GetReplayDetails", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ReplayServiceServer is the server API for ReplayService service.
 // All implementations must embed UnimplementedReplayServiceServer
 // for forward compatibility
@@ -80,6 +125,10 @@ type ReplayServiceServer interface {
 	ReplayDryRun(context.Context, *ReplayDryRunRequest) (*ReplayDryRunResponse, error)
 	ListReplay(context.Context, *ListReplayRequest) (*ListReplayResponse, error)
 	GetReplay(context.Context, *GetReplayRequest) (*GetReplayResponse, error)
+	// StreamReplayStatus sends the status of the replay, followed by every update on it until the replay is done
+	StreamReplayStatus(*StreamReplayStatusRequest, ReplayService_StreamReplayStatusServer) error
+	// GetReplayDetails returns the replay with its requester, reason and every state it went through
+	GetReplayDetails(context.Context, *GetReplayDetailsRequest) (*GetReplayDetailsResponse, error)
 	mustEmbedUnimplementedReplayServiceServer()
 }
 
@@ -99,6 +148,12 @@ func (UnimplementedReplayServiceServer) ListReplay(context.Context, *ListReplayR
 func (UnimplementedReplayServiceServer) GetReplay(context.Context, *GetReplayRequest) (*GetReplayResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetReplay not implemented")
 }
+func (UnimplementedReplayServiceServer) StreamReplayStatus(*StreamReplayStatusRequest, ReplayService_StreamReplayStatusServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamReplayStatus not implemented")
+}
+func (UnimplementedReplayServiceServer) GetReplayDetails(context.Context, *GetReplayDetailsRequest) (*GetReplayDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReplayDetails not implemented")
+}
 func (UnimplementedReplayServiceServer) mustEmbedUnimplementedReplayServiceServer() {}
 
 // UnsafeReplayServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -184,6 +239,45 @@ func _ReplayService_GetReplay_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ReplayService_StreamReplayStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamReplayStatusRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ReplayServiceServer).StreamReplayStatus(m, &replayServiceStreamReplayStatusServer{stream})
+}
+
+type ReplayService_StreamReplayStatusServer interface {
+	Send(*GetReplayResponse) error
+	grpc.ServerStream
+}
+
+type replayServiceStreamReplayStatusServer struct {
+	grpc.ServerStream
+}
+
+func (x *replayServiceStreamReplayStatusServer) Send(m *GetReplayResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ReplayService_GetReplayDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReplayDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReplayServiceServer).GetReplayDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gotocompany.optimus.core.v1beta1.ReplayService/GetReplayDetails",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReplayServiceServer).GetReplayDetails(ctx, req.(*GetReplayDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ReplayService_ServiceDesc is the grpc.ServiceDesc for ReplayService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -207,7 +301,17 @@ var ReplayService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetReplay",
 			Handler:    _ReplayService_GetReplay_Handler,
 		},
+		{
+			MethodName: "GetReplayDetails",
+			Handler:    _ReplayService_GetReplayDetails_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamReplayStatus",
+			Handler:       _ReplayService_StreamReplayStatus_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "gotocompany/optimus/core/v1beta1/replay.proto",
 }
@@ -24,6 +24,16 @@ const _ = grpc.SupportPackageIsVersion7
 type RuntimeServiceClient interface {
 	// server ping with version
 	Version(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error)
+	// ListPlugins returns the plugins registered in the server with their capabilities and the result of a health probe
+	ListPlugins(ctx context.Context, in *ListPluginsRequest, opts ...grpc.CallOption) (*ListPluginsResponse, error)
+	// RedriveEvents moves the dead lettered events of the event outbox back to pending to be published again
+	RedriveEvents(ctx context.Context, in *RedriveEventsRequest, opts ...grpc.CallOption) (*RedriveEventsResponse, error)
+	// ListLogLevels returns the log levels overridden at runtime for namespaces along with the level of the server
+	ListLogLevels(ctx context.Context, in *ListLogLevelsRequest, opts ...grpc.CallOption) (*ListLogLevelsResponse, error)
+	// SetLogLevel overrides the log level of a namespace until the server restarts
+	SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*SetLogLevelResponse, error)
+	// UnsetLogLevel removes the log level of a namespace, falling back to the level of the server
+	UnsetLogLevel(ctx context.Context, in *UnsetLogLevelRequest, opts ...grpc.CallOption) (*UnsetLogLevelResponse, error)
 }
 
 type runtimeServiceClient struct {
@@ -43,12 +53,67 @@ func (c *runtimeServiceClient) Version(ctx context.Context, in *VersionRequest,
 	return out, nil
 }
 
+func (c *runtimeServiceClient) ListPlugins(ctx context.Context, in *ListPluginsRequest, opts ...grpc.CallOption) (*ListPluginsResponse, error) {
+	out := new(ListPluginsResponse)
+	err := c.cc.Invoke(ctx, "/gotocompany.optimus.core.v1beta1.RuntimeService/ListPlugins", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) RedriveEvents(ctx context.Context, in *RedriveEventsRequest, opts ...grpc.CallOption) (*RedriveEventsResponse, error) {
+	out := new(RedriveEventsResponse)
+	err := c.cc.Invoke(ctx, "/gotocompany.optimus.core.v1beta1.RuntimeService/RedriveEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) ListLogLevels(ctx context.Context, in *ListLogLevelsRequest, opts ...grpc.CallOption) (*ListLogLevelsResponse, error) {
+	out := new(ListLogLevelsResponse)
+	err := c.cc.Invoke(ctx, "/gotocompany.optimus.core.v1beta1.RuntimeService/ListLogLevels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*SetLogLevelResponse, error) {
+	out := new(SetLogLevelResponse)
+	err := c.cc.Invoke(ctx, "/gotocompany.optimus.core.v1beta1.RuntimeService/SetLogLevel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) UnsetLogLevel(ctx context.Context, in *UnsetLogLevelRequest, opts ...grpc.CallOption) (*UnsetLogLevelResponse, error) {
+	out := new(UnsetLogLevelResponse)
+	err := c.cc.Invoke(ctx, "/gotocompany.optimus.core.v1beta1.RuntimeService/UnsetLogLevel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RuntimeServiceServer is the server API for RuntimeService service.
 // All implementations must embed UnimplementedRuntimeServiceServer
 // for forward compatibility
 type RuntimeServiceServer interface {
 	// server ping with version
 	Version(context.Context, *VersionRequest) (*VersionResponse, error)
+	// ListPlugins returns the plugins registered in the server with their capabilities and the result of a health probe
+	ListPlugins(context.Context, *ListPluginsRequest) (*ListPluginsResponse, error)
+	// RedriveEvents moves the dead lettered events of the event outbox back to pending to be published again
+	RedriveEvents(context.Context, *RedriveEventsRequest) (*RedriveEventsResponse, error)
+	// ListLogLevels returns the log levels overridden at runtime for namespaces along with the level of the server
+	ListLogLevels(context.Context, *ListLogLevelsRequest) (*ListLogLevelsResponse, error)
+	// SetLogLevel overrides the log level of a namespace until the server restarts
+	SetLogLevel(context.Context, *SetLogLevelRequest) (*SetLogLevelResponse, error)
+	// UnsetLogLevel removes the log level of a namespace, falling back to the level of the server
+	UnsetLogLevel(context.Context, *UnsetLogLevelRequest) (*UnsetLogLevelResponse, error)
 	mustEmbedUnimplementedRuntimeServiceServer()
 }
 
@@ -59,6 +124,21 @@ type UnimplementedRuntimeServiceServer struct {
 func (UnimplementedRuntimeServiceServer) Version(context.Context, *VersionRequest) (*VersionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Version not implemented")
 }
+func (UnimplementedRuntimeServiceServer) ListPlugins(context.Context, *ListPluginsRequest) (*ListPluginsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPlugins not implemented")
+}
+func (UnimplementedRuntimeServiceServer) RedriveEvents(context.Context, *RedriveEventsRequest) (*RedriveEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedriveEvents not implemented")
+}
+func (UnimplementedRuntimeServiceServer) ListLogLevels(context.Context, *ListLogLevelsRequest) (*ListLogLevelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLogLevels not implemented")
+}
+func (UnimplementedRuntimeServiceServer) SetLogLevel(context.Context, *SetLogLevelRequest) (*SetLogLevelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLogLevel not implemented")
+}
+func (UnimplementedRuntimeServiceServer) UnsetLogLevel(context.Context, *UnsetLogLevelRequest) (*UnsetLogLevelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsetLogLevel not implemented")
+}
 func (UnimplementedRuntimeServiceServer) mustEmbedUnimplementedRuntimeServiceServer() {}
 
 // UnsafeRuntimeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -90,6 +170,96 @@ func _RuntimeService_Version_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RuntimeService_ListPlugins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPluginsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).ListPlugins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gotocompany.optimus.core.v1beta1.RuntimeService/ListPlugins",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).ListPlugins(ctx, req.(*ListPluginsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeService_RedriveEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedriveEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).RedriveEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gotocompany.optimus.core.v1beta1.RuntimeService/RedriveEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).RedriveEvents(ctx, req.(*RedriveEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeService_ListLogLevels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLogLevelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).ListLogLevels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gotocompany.optimus.core.v1beta1.RuntimeService/ListLogLevels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).ListLogLevels(ctx, req.(*ListLogLevelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeService_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLogLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).SetLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gotocompany.optimus.core.v1beta1.RuntimeService/SetLogLevel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).SetLogLevel(ctx, req.(*SetLogLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeService_UnsetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsetLogLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).UnsetLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gotocompany.optimus.core.v1beta1.RuntimeService/UnsetLogLevel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).UnsetLogLevel(ctx, req.(*UnsetLogLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RuntimeService_ServiceDesc is the grpc.ServiceDesc for RuntimeService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -101,6 +271,26 @@ var RuntimeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Version",
 			Handler:    _RuntimeService_Version_Handler,
 		},
+		{
+			MethodName: "ListPlugins",
+			Handler:    _RuntimeService_ListPlugins_Handler,
+		},
+		{
+			MethodName: "RedriveEvents",
+			Handler:    _RuntimeService_RedriveEvents_Handler,
+		},
+		{
+			MethodName: "ListLogLevels",
+			Handler:    _RuntimeService_ListLogLevels_Handler,
+		},
+		{
+			MethodName: "SetLogLevel",
+			Handler:    _RuntimeService_SetLogLevel_Handler,
+		},
+		{
+			MethodName: "UnsetLogLevel",
+			Handler:    _RuntimeService_UnsetLogLevel_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "gotocompany/optimus/core/v1beta1/runtime.proto",
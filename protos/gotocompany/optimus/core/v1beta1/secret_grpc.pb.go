@@ -30,6 +30,10 @@ type SecretServiceClient interface {
 	ListSecrets(ctx context.Context, in *ListSecretsRequest, opts ...grpc.CallOption) (*ListSecretsResponse, error)
 	// DeleteSecret deletes a secret for a project
 	DeleteSecret(ctx context.Context, in *DeleteSecretRequest, opts ...grpc.CallOption) (*DeleteSecretResponse, error)
+	// RotateSecret stores a new version of a secret, keeping the previous version readable for the grace period
+	RotateSecret(ctx context.Context, in *RotateSecretRequest, opts ...grpc.CallOption) (*RotateSecretResponse, error)
+	// ListStaleSecretUsages lists the jobs still compiled against a previous version of a secret
+	ListStaleSecretUsages(ctx context.Context, in *ListStaleSecretUsagesRequest, opts ...grpc.CallOption) (*ListStaleSecretUsagesResponse, error)
 }
 
 type secretServiceClient struct {
@@ -76,6 +80,24 @@ func (c *secretServiceClient) DeleteSecret(ctx context.Context, in *DeleteSecret
 	return out, nil
 }
 
+func (c *secretServiceClient) RotateSecret(ctx context.Context, in *RotateSecretRequest, opts ...grpc.CallOption) (*RotateSecretResponse, error) {
+	out := new(RotateSecretResponse)
+	err := c.cc.Invoke(ctx, "/gotocompany.optimus.core.v1beta1.SecretService/RotateSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretServiceClient) ListStaleSecretUsages(ctx context.Context, in *ListStaleSecretUsagesRequest, opts ...grpc.CallOption) (*ListStaleSecretUsagesResponse, error) {
+	out := new(ListStaleSecretUsagesResponse)
+	err := c.cc.Invoke(ctx, "/gotocompany.optimus.core.v1beta1.SecretService/ListStaleSecretUsages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SecretServiceServer is the server API for SecretService service.
 // All implementations must embed UnimplementedSecretServiceServer
 // for forward compatibility
@@ -88,6 +110,10 @@ type SecretServiceServer interface {
 	ListSecrets(context.Context, *ListSecretsRequest) (*ListSecretsResponse, error)
 	// DeleteSecret deletes a secret for a project
 	DeleteSecret(context.Context, *DeleteSecretRequest) (*DeleteSecretResponse, error)
+	// RotateSecret stores a new version of a secret, keeping the previous version readable for the grace period
+	RotateSecret(context.Context, *RotateSecretRequest) (*RotateSecretResponse, error)
+	// ListStaleSecretUsages lists the jobs still compiled against a previous version of a secret
+	ListStaleSecretUsages(context.Context, *ListStaleSecretUsagesRequest) (*ListStaleSecretUsagesResponse, error)
 	mustEmbedUnimplementedSecretServiceServer()
 }
 
@@ -107,6 +133,12 @@ func (UnimplementedSecretServiceServer) ListSecrets(context.Context, *ListSecret
 func (UnimplementedSecretServiceServer) DeleteSecret(context.Context, *DeleteSecretRequest) (*DeleteSecretResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteSecret not implemented")
 }
+func (UnimplementedSecretServiceServer) RotateSecret(context.Context, *RotateSecretRequest) (*RotateSecretResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateSecret not implemented")
+}
+func (UnimplementedSecretServiceServer) ListStaleSecretUsages(context.Context, *ListStaleSecretUsagesRequest) (*ListStaleSecretUsagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStaleSecretUsages not implemented")
+}
 func (UnimplementedSecretServiceServer) mustEmbedUnimplementedSecretServiceServer() {}
 
 // UnsafeSecretServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -192,6 +224,42 @@ func _SecretService_DeleteSecret_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SecretService_RotateSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretServiceServer).RotateSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gotocompany.optimus.core.v1beta1.SecretService/RotateSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretServiceServer).RotateSecret(ctx, req.(*RotateSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SecretService_ListStaleSecretUsages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStaleSecretUsagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretServiceServer).ListStaleSecretUsages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gotocompany.optimus.core.v1beta1.SecretService/ListStaleSecretUsages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretServiceServer).ListStaleSecretUsages(ctx, req.(*ListStaleSecretUsagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SecretService_ServiceDesc is the grpc.ServiceDesc for SecretService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -215,6 +283,14 @@ var SecretService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteSecret",
 			Handler:    _SecretService_DeleteSecret_Handler,
 		},
+		{
+			MethodName: "RotateSecret",
+			Handler:    _SecretService_RotateSecret_Handler,
+		},
+		{
+			MethodName: "ListStaleSecretUsages",
+			Handler:    _SecretService_ListStaleSecretUsages_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "gotocompany/optimus/core/v1beta1/secret.proto",
@@ -25,17 +25,20 @@ const (
 type OptimusChangeEvent_EventType int32
 
 const (
-	OptimusChangeEvent_EVENT_TYPE_TYPE_UNSPECIFIED  OptimusChangeEvent_EventType = 0
-	OptimusChangeEvent_EVENT_TYPE_RESOURCE_CREATE   OptimusChangeEvent_EventType = 1
-	OptimusChangeEvent_EVENT_TYPE_RESOURCE_UPDATE   OptimusChangeEvent_EventType = 2
-	OptimusChangeEvent_EVENT_TYPE_JOB_CREATE        OptimusChangeEvent_EventType = 3
-	OptimusChangeEvent_EVENT_TYPE_JOB_UPDATE        OptimusChangeEvent_EventType = 4
-	OptimusChangeEvent_EVENT_TYPE_JOB_DELETE        OptimusChangeEvent_EventType = 5
-	OptimusChangeEvent_EVENT_TYPE_JOB_WAIT_UPSTREAM OptimusChangeEvent_EventType = 6
-	OptimusChangeEvent_EVENT_TYPE_JOB_IN_PROGRESS   OptimusChangeEvent_EventType = 7
-	OptimusChangeEvent_EVENT_TYPE_JOB_SUCCESS       OptimusChangeEvent_EventType = 8
-	OptimusChangeEvent_EVENT_TYPE_JOB_FAILURE       OptimusChangeEvent_EventType = 9
-	OptimusChangeEvent_EVENT_TYPE_JOB_STATE_CHANGE  OptimusChangeEvent_EventType = 10
+	OptimusChangeEvent_EVENT_TYPE_TYPE_UNSPECIFIED     OptimusChangeEvent_EventType = 0
+	OptimusChangeEvent_EVENT_TYPE_RESOURCE_CREATE      OptimusChangeEvent_EventType = 1
+	OptimusChangeEvent_EVENT_TYPE_RESOURCE_UPDATE      OptimusChangeEvent_EventType = 2
+	OptimusChangeEvent_EVENT_TYPE_JOB_CREATE           OptimusChangeEvent_EventType = 3
+	OptimusChangeEvent_EVENT_TYPE_JOB_UPDATE           OptimusChangeEvent_EventType = 4
+	OptimusChangeEvent_EVENT_TYPE_JOB_DELETE           OptimusChangeEvent_EventType = 5
+	OptimusChangeEvent_EVENT_TYPE_JOB_WAIT_UPSTREAM    OptimusChangeEvent_EventType = 6
+	OptimusChangeEvent_EVENT_TYPE_JOB_IN_PROGRESS      OptimusChangeEvent_EventType = 7
+	OptimusChangeEvent_EVENT_TYPE_JOB_SUCCESS          OptimusChangeEvent_EventType = 8
+	OptimusChangeEvent_EVENT_TYPE_JOB_FAILURE          OptimusChangeEvent_EventType = 9
+	OptimusChangeEvent_EVENT_TYPE_JOB_STATE_CHANGE     OptimusChangeEvent_EventType = 10
+	OptimusChangeEvent_EVENT_TYPE_JOB_SLA_BREACH       OptimusChangeEvent_EventType = 11
+	OptimusChangeEvent_EVENT_TYPE_JOB_DURATION_ANOMALY OptimusChangeEvent_EventType = 12
+	OptimusChangeEvent_EVENT_TYPE_REPLAY_STATE_CHANGE  OptimusChangeEvent_EventType = 13
 )
 
 // Enum value maps for OptimusChangeEvent_EventType.
@@ -52,19 +55,25 @@ var (
 		8:  "EVENT_TYPE_JOB_SUCCESS",
 		9:  "EVENT_TYPE_JOB_FAILURE",
 		10: "EVENT_TYPE_JOB_STATE_CHANGE",
+		11: "EVENT_TYPE_JOB_SLA_BREACH",
+		12: "EVENT_TYPE_JOB_DURATION_ANOMALY",
+		13: "EVENT_TYPE_REPLAY_STATE_CHANGE",
 	}
 	OptimusChangeEvent_EventType_value = map[string]int32{
-		"EVENT_TYPE_TYPE_UNSPECIFIED":  0,
-		"EVENT_TYPE_RESOURCE_CREATE":   1,
-		"EVENT_TYPE_RESOURCE_UPDATE":   2,
-		"EVENT_TYPE_JOB_CREATE":        3,
-		"EVENT_TYPE_JOB_UPDATE":        4,
-		"EVENT_TYPE_JOB_DELETE":        5,
-		"EVENT_TYPE_JOB_WAIT_UPSTREAM": 6,
-		"EVENT_TYPE_JOB_IN_PROGRESS":   7,
-		"EVENT_TYPE_JOB_SUCCESS":       8,
-		"EVENT_TYPE_JOB_FAILURE":       9,
-		"EVENT_TYPE_JOB_STATE_CHANGE":  10,
+		"EVENT_TYPE_TYPE_UNSPECIFIED":     0,
+		"EVENT_TYPE_RESOURCE_CREATE":      1,
+		"EVENT_TYPE_RESOURCE_UPDATE":      2,
+		"EVENT_TYPE_JOB_CREATE":           3,
+		"EVENT_TYPE_JOB_UPDATE":           4,
+		"EVENT_TYPE_JOB_DELETE":           5,
+		"EVENT_TYPE_JOB_WAIT_UPSTREAM":    6,
+		"EVENT_TYPE_JOB_IN_PROGRESS":      7,
+		"EVENT_TYPE_JOB_SUCCESS":          8,
+		"EVENT_TYPE_JOB_FAILURE":          9,
+		"EVENT_TYPE_JOB_STATE_CHANGE":     10,
+		"EVENT_TYPE_JOB_SLA_BREACH":       11,
+		"EVENT_TYPE_JOB_DURATION_ANOMALY": 12,
+		"EVENT_TYPE_REPLAY_STATE_CHANGE":  13,
 	}
 )
 
@@ -92,7 +101,7 @@ func (x OptimusChangeEvent_EventType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use OptimusChangeEvent_EventType.Descriptor instead.
 func (OptimusChangeEvent_EventType) EnumDescriptor() ([]byte, []int) {
-	return file_gotocompany_optimus_integration_v1beta1_event_proto_rawDescGZIP(), []int{4, 0}
+	return file_gotocompany_optimus_integration_v1beta1_event_proto_rawDescGZIP(), []int{6, 0}
 }
 
 type ResourceChangePayload struct {
@@ -331,6 +340,156 @@ func (x *JobStateChangePayload) GetState() v1beta1.JobState {
 	return v1beta1.JobState(0)
 }
 
+type ReplayRunPayload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScheduledAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
+	State       string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+}
+
+func (x *ReplayRunPayload) Reset() {
+	*x = ReplayRunPayload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gotocompany_optimus_integration_v1beta1_event_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayRunPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayRunPayload) ProtoMessage() {}
+
+func (x *ReplayRunPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_integration_v1beta1_event_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayRunPayload.ProtoReflect.Descriptor instead.
+func (*ReplayRunPayload) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_integration_v1beta1_event_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ReplayRunPayload) GetScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return nil
+}
+
+func (x *ReplayRunPayload) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+type ReplayStateChangePayload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReplayId  string                 `protobuf:"bytes,1,opt,name=replay_id,json=replayId,proto3" json:"replay_id,omitempty"`
+	JobName   string                 `protobuf:"bytes,2,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	State     string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Runs      []*ReplayRunPayload    `protobuf:"bytes,6,rep,name=runs,proto3" json:"runs,omitempty"`
+	Message   string                 `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ReplayStateChangePayload) Reset() {
+	*x = ReplayStateChangePayload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gotocompany_optimus_integration_v1beta1_event_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayStateChangePayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayStateChangePayload) ProtoMessage() {}
+
+func (x *ReplayStateChangePayload) ProtoReflect() protoreflect.Message {
+	mi := &file_gotocompany_optimus_integration_v1beta1_event_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayStateChangePayload.ProtoReflect.Descriptor instead.
+func (*ReplayStateChangePayload) Descriptor() ([]byte, []int) {
+	return file_gotocompany_optimus_integration_v1beta1_event_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ReplayStateChangePayload) GetReplayId() string {
+	if x != nil {
+		return x.ReplayId
+	}
+	return ""
+}
+
+func (x *ReplayStateChangePayload) GetJobName() string {
+	if x != nil {
+		return x.JobName
+	}
+	return ""
+}
+
+func (x *ReplayStateChangePayload) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ReplayStateChangePayload) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ReplayStateChangePayload) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *ReplayStateChangePayload) GetRuns() []*ReplayRunPayload {
+	if x != nil {
+		return x.Runs
+	}
+	return nil
+}
+
+func (x *ReplayStateChangePayload) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 type OptimusChangeEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -347,13 +506,15 @@ type OptimusChangeEvent struct {
 	//	*OptimusChangeEvent_ResourceChange
 	//	*OptimusChangeEvent_JobRun
 	//	*OptimusChangeEvent_JobStateChange
-	Payload isOptimusChangeEvent_Payload `protobuf_oneof:"payload"`
+	//	*OptimusChangeEvent_ReplayStateChange
+	Payload       isOptimusChangeEvent_Payload `protobuf_oneof:"payload"`
+	SchemaVersion uint32                       `protobuf:"varint,11,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
 }
 
 func (x *OptimusChangeEvent) Reset() {
 	*x = OptimusChangeEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gotocompany_optimus_integration_v1beta1_event_proto_msgTypes[4]
+		mi := &file_gotocompany_optimus_integration_v1beta1_event_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -366,7 +527,7 @@ func (x *OptimusChangeEvent) String() string {
 func (*OptimusChangeEvent) ProtoMessage() {}
 
 func (x *OptimusChangeEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_gotocompany_optimus_integration_v1beta1_event_proto_msgTypes[4]
+	mi := &file_gotocompany_optimus_integration_v1beta1_event_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -379,7 +540,7 @@ func (x *OptimusChangeEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OptimusChangeEvent.ProtoReflect.Descriptor instead.
 func (*OptimusChangeEvent) Descriptor() ([]byte, []int) {
-	return file_gotocompany_optimus_integration_v1beta1_event_proto_rawDescGZIP(), []int{4}
+	return file_gotocompany_optimus_integration_v1beta1_event_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *OptimusChangeEvent) GetEventId() string {
@@ -452,6 +613,20 @@ func (x *OptimusChangeEvent) GetJobStateChange() *JobStateChangePayload {
 	return nil
 }
 
+func (x *OptimusChangeEvent) GetReplayStateChange() *ReplayStateChangePayload {
+	if x, ok := x.GetPayload().(*OptimusChangeEvent_ReplayStateChange); ok {
+		return x.ReplayStateChange
+	}
+	return nil
+}
+
+func (x *OptimusChangeEvent) GetSchemaVersion() uint32 {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return 0
+}
+
 type isOptimusChangeEvent_Payload interface {
 	isOptimusChangeEvent_Payload()
 }
@@ -472,6 +647,10 @@ type OptimusChangeEvent_JobStateChange struct {
 	JobStateChange *JobStateChangePayload `protobuf:"bytes,9,opt,name=job_state_change,json=jobStateChange,proto3,oneof"`
 }
 
+type OptimusChangeEvent_ReplayStateChange struct {
+	ReplayStateChange *ReplayStateChangePayload `protobuf:"bytes,10,opt,name=replay_state_change,json=replayStateChange,proto3,oneof"`
+}
+
 func (*OptimusChangeEvent_JobChange) isOptimusChangeEvent_Payload() {}
 
 func (*OptimusChangeEvent_ResourceChange) isOptimusChangeEvent_Payload() {}
@@ -480,6 +659,8 @@ func (*OptimusChangeEvent_JobRun) isOptimusChangeEvent_Payload() {}
 
 func (*OptimusChangeEvent_JobStateChange) isOptimusChangeEvent_Payload() {}
 
+func (*OptimusChangeEvent_ReplayStateChange) isOptimusChangeEvent_Payload() {}
+
 var File_gotocompany_optimus_integration_v1beta1_event_proto protoreflect.FileDescriptor
 
 var file_gotocompany_optimus_integration_v1beta1_event_proto_rawDesc = []byte{
@@ -534,76 +715,120 @@ var file_gotocompany_optimus_integration_v1beta1_event_proto_rawDesc = []byte{
 	0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69,
 	0x6d, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
 	0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x22, 0x88, 0x08, 0x0a, 0x12, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x43, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x49, 0x64, 0x12, 0x3b, 0x0a, 0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x41, 0x74, 0x12,
-	0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x61, 0x6d, 0x65,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x64, 0x0a, 0x0a, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x45, 0x2e,
-	0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69,
-	0x6d, 0x75, 0x73, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x43,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x5a, 0x0a, 0x0a, 0x6a, 0x6f, 0x62, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
-	0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4a, 0x6f,
-	0x62, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x48, 0x00,
-	0x52, 0x09, 0x6a, 0x6f, 0x62, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x69, 0x0a, 0x0f, 0x72,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x22, 0x67, 0x0a, 0x10, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x75, 0x6e, 0x50, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x3d, 0x0a, 0x0c, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0xc3, 0x02, 0x0a, 0x18, 0x52, 0x65,
+	0x70, 0x6c, 0x61, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x61,
+	0x79, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6a, 0x6f, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6a, 0x6f, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65,
+	0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x4d, 0x0a, 0x04, 0x72, 0x75, 0x6e, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61,
 	0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x67,
 	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x79,
-	0x6c, 0x6f, 0x61, 0x64, 0x48, 0x00, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x51, 0x0a, 0x07, 0x6a, 0x6f, 0x62, 0x5f, 0x72, 0x75,
-	0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x69, 0x6e,
-	0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x75, 0x6e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x48,
-	0x00, 0x52, 0x06, 0x6a, 0x6f, 0x62, 0x52, 0x75, 0x6e, 0x12, 0x6a, 0x0a, 0x10, 0x6a, 0x6f, 0x62,
-	0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x09, 0x20,
+	0x65, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x75, 0x6e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52,
+	0x04, 0x72, 0x75, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0x8c, 0x0a, 0x0a, 0x12, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x3b, 0x0a, 0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0a, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x41, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x64, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x45, 0x2e, 0x67,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d,
+	0x75, 0x73, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x5a,
+	0x0a, 0x0a, 0x6a, 0x6f, 0x62, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x39, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79,
+	0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4a, 0x6f, 0x62,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x48, 0x00, 0x52,
+	0x09, 0x6a, 0x6f, 0x62, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x69, 0x0a, 0x0f, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x07, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
 	0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4a, 0x6f,
-	0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x79, 0x6c,
-	0x6f, 0x61, 0x64, 0x48, 0x00, 0x52, 0x0e, 0x6a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0xd8, 0x02, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41,
-	0x54, 0x45, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x55, 0x50, 0x44, 0x41,
-	0x54, 0x45, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x4a, 0x4f, 0x42, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x10, 0x03, 0x12,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x48, 0x00, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x51, 0x0a, 0x07, 0x6a, 0x6f, 0x62, 0x5f, 0x72, 0x75, 0x6e,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x6e, 0x79, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x69, 0x6e, 0x74,
+	0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x75, 0x6e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x48, 0x00,
+	0x52, 0x06, 0x6a, 0x6f, 0x62, 0x52, 0x75, 0x6e, 0x12, 0x6a, 0x0a, 0x10, 0x6a, 0x6f, 0x62, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79,
+	0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4a, 0x6f, 0x62,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x48, 0x00, 0x52, 0x0e, 0x6a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x12, 0x73, 0x0a, 0x13, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x41, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e,
+	0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c,
+	0x61, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x48, 0x00, 0x52, 0x11, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0xc0, 0x03, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f,
+	0x0a, 0x1b, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x1e, 0x0a, 0x1a, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45,
+	0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12,
+	0x1e, 0x0a, 0x1a, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45,
+	0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x02, 0x12,
 	0x19, 0x0a, 0x15, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4a, 0x4f,
-	0x42, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4a, 0x4f, 0x42, 0x5f, 0x44, 0x45, 0x4c,
-	0x45, 0x54, 0x45, 0x10, 0x05, 0x12, 0x20, 0x0a, 0x1c, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x4a, 0x4f, 0x42, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x55, 0x50, 0x53,
-	0x54, 0x52, 0x45, 0x41, 0x4d, 0x10, 0x06, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4a, 0x4f, 0x42, 0x5f, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x4f,
-	0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x07, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53,
-	0x53, 0x10, 0x08, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x4a, 0x4f, 0x42, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x09, 0x12,
-	0x1f, 0x0a, 0x1b, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4a, 0x4f,
-	0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x0a,
-	0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x49, 0x0a, 0x1e, 0x63,
-	0x6f, 0x6d, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x6e, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x42, 0x05, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x50, 0x01, 0x5a, 0x1e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x6e, 0x2f, 0x6f,
-	0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x42, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4a, 0x4f, 0x42, 0x5f, 0x55, 0x50, 0x44,
+	0x41, 0x54, 0x45, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x4a, 0x4f, 0x42, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x05,
+	0x12, 0x20, 0x0a, 0x1c, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4a,
+	0x4f, 0x42, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x55, 0x50, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d,
+	0x10, 0x06, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x4a, 0x4f, 0x42, 0x5f, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53,
+	0x10, 0x07, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x08, 0x12, 0x1a,
+	0x0a, 0x16, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4a, 0x4f, 0x42,
+	0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x09, 0x12, 0x1f, 0x0a, 0x1b, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x0a, 0x12, 0x1d, 0x0a, 0x19, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x4c,
+	0x41, 0x5f, 0x42, 0x52, 0x45, 0x41, 0x43, 0x48, 0x10, 0x0b, 0x12, 0x23, 0x0a, 0x1f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4a, 0x4f, 0x42, 0x5f, 0x44, 0x55, 0x52,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x4e, 0x4f, 0x4d, 0x41, 0x4c, 0x59, 0x10, 0x0c, 0x12,
+	0x22, 0x0a, 0x1e, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45,
+	0x50, 0x4c, 0x41, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47,
+	0x45, 0x10, 0x0d, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x49,
+	0x0a, 0x1e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
+	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x6e, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73,
+	0x42, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x01, 0x5a, 0x1e, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x6e, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -619,36 +844,43 @@ func file_gotocompany_optimus_integration_v1beta1_event_proto_rawDescGZIP() []by
 }
 
 var file_gotocompany_optimus_integration_v1beta1_event_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_gotocompany_optimus_integration_v1beta1_event_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_gotocompany_optimus_integration_v1beta1_event_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_gotocompany_optimus_integration_v1beta1_event_proto_goTypes = []interface{}{
 	(OptimusChangeEvent_EventType)(0),     // 0: gotocompany.optimus.integration.v1beta1.OptimusChangeEvent.EventType
 	(*ResourceChangePayload)(nil),         // 1: gotocompany.optimus.integration.v1beta1.ResourceChangePayload
 	(*JobChangePayload)(nil),              // 2: gotocompany.optimus.integration.v1beta1.JobChangePayload
 	(*JobRunPayload)(nil),                 // 3: gotocompany.optimus.integration.v1beta1.JobRunPayload
 	(*JobStateChangePayload)(nil),         // 4: gotocompany.optimus.integration.v1beta1.JobStateChangePayload
-	(*OptimusChangeEvent)(nil),            // 5: gotocompany.optimus.integration.v1beta1.OptimusChangeEvent
-	(*v1beta1.ResourceSpecification)(nil), // 6: gotocompany.optimus.core.v1beta1.ResourceSpecification
-	(*v1beta1.JobSpecification)(nil),      // 7: gotocompany.optimus.core.v1beta1.JobSpecification
-	(*timestamppb.Timestamp)(nil),         // 8: google.protobuf.Timestamp
-	(v1beta1.JobState)(0),                 // 9: gotocompany.optimus.core.v1beta1.JobState
+	(*ReplayRunPayload)(nil),              // 5: gotocompany.optimus.integration.v1beta1.ReplayRunPayload
+	(*ReplayStateChangePayload)(nil),      // 6: gotocompany.optimus.integration.v1beta1.ReplayStateChangePayload
+	(*OptimusChangeEvent)(nil),            // 7: gotocompany.optimus.integration.v1beta1.OptimusChangeEvent
+	(*v1beta1.ResourceSpecification)(nil), // 8: gotocompany.optimus.core.v1beta1.ResourceSpecification
+	(*v1beta1.JobSpecification)(nil),      // 9: gotocompany.optimus.core.v1beta1.JobSpecification
+	(*timestamppb.Timestamp)(nil),         // 10: google.protobuf.Timestamp
+	(v1beta1.JobState)(0),                 // 11: gotocompany.optimus.core.v1beta1.JobState
 }
 var file_gotocompany_optimus_integration_v1beta1_event_proto_depIdxs = []int32{
-	6,  // 0: gotocompany.optimus.integration.v1beta1.ResourceChangePayload.resource:type_name -> gotocompany.optimus.core.v1beta1.ResourceSpecification
-	7,  // 1: gotocompany.optimus.integration.v1beta1.JobChangePayload.job_spec:type_name -> gotocompany.optimus.core.v1beta1.JobSpecification
-	8,  // 2: gotocompany.optimus.integration.v1beta1.JobRunPayload.scheduled_at:type_name -> google.protobuf.Timestamp
-	8,  // 3: gotocompany.optimus.integration.v1beta1.JobRunPayload.start_time:type_name -> google.protobuf.Timestamp
-	9,  // 4: gotocompany.optimus.integration.v1beta1.JobStateChangePayload.state:type_name -> gotocompany.optimus.core.v1beta1.JobState
-	8,  // 5: gotocompany.optimus.integration.v1beta1.OptimusChangeEvent.occurred_at:type_name -> google.protobuf.Timestamp
-	0,  // 6: gotocompany.optimus.integration.v1beta1.OptimusChangeEvent.event_type:type_name -> gotocompany.optimus.integration.v1beta1.OptimusChangeEvent.EventType
-	2,  // 7: gotocompany.optimus.integration.v1beta1.OptimusChangeEvent.job_change:type_name -> gotocompany.optimus.integration.v1beta1.JobChangePayload
-	1,  // 8: gotocompany.optimus.integration.v1beta1.OptimusChangeEvent.resource_change:type_name -> gotocompany.optimus.integration.v1beta1.ResourceChangePayload
-	3,  // 9: gotocompany.optimus.integration.v1beta1.OptimusChangeEvent.job_run:type_name -> gotocompany.optimus.integration.v1beta1.JobRunPayload
-	4,  // 10: gotocompany.optimus.integration.v1beta1.OptimusChangeEvent.job_state_change:type_name -> gotocompany.optimus.integration.v1beta1.JobStateChangePayload
-	11, // [11:11] is the sub-list for method output_type
-	11, // [11:11] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	8,  // 0: gotocompany.optimus.integration.v1beta1.ResourceChangePayload.resource:type_name -> gotocompany.optimus.core.v1beta1.ResourceSpecification
+	9,  // 1: gotocompany.optimus.integration.v1beta1.JobChangePayload.job_spec:type_name -> gotocompany.optimus.core.v1beta1.JobSpecification
+	10, // 2: gotocompany.optimus.integration.v1beta1.JobRunPayload.scheduled_at:type_name -> google.protobuf.Timestamp
+	10, // 3: gotocompany.optimus.integration.v1beta1.JobRunPayload.start_time:type_name -> google.protobuf.Timestamp
+	11, // 4: gotocompany.optimus.integration.v1beta1.JobStateChangePayload.state:type_name -> gotocompany.optimus.core.v1beta1.JobState
+	10, // 5: gotocompany.optimus.integration.v1beta1.ReplayRunPayload.scheduled_at:type_name -> google.protobuf.Timestamp
+	10, // 6: gotocompany.optimus.integration.v1beta1.ReplayStateChangePayload.start_time:type_name -> google.protobuf.Timestamp
+	10, // 7: gotocompany.optimus.integration.v1beta1.ReplayStateChangePayload.end_time:type_name -> google.protobuf.Timestamp
+	5,  // 8: gotocompany.optimus.integration.v1beta1.ReplayStateChangePayload.runs:type_name -> gotocompany.optimus.integration.v1beta1.ReplayRunPayload
+	10, // 9: gotocompany.optimus.integration.v1beta1.OptimusChangeEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	0,  // 10: gotocompany.optimus.integration.v1beta1.OptimusChangeEvent.event_type:type_name -> gotocompany.optimus.integration.v1beta1.OptimusChangeEvent.EventType
+	2,  // 11: gotocompany.optimus.integration.v1beta1.OptimusChangeEvent.job_change:type_name -> gotocompany.optimus.integration.v1beta1.JobChangePayload
+	1,  // 12: gotocompany.optimus.integration.v1beta1.OptimusChangeEvent.resource_change:type_name -> gotocompany.optimus.integration.v1beta1.ResourceChangePayload
+	3,  // 13: gotocompany.optimus.integration.v1beta1.OptimusChangeEvent.job_run:type_name -> gotocompany.optimus.integration.v1beta1.JobRunPayload
+	4,  // 14: gotocompany.optimus.integration.v1beta1.OptimusChangeEvent.job_state_change:type_name -> gotocompany.optimus.integration.v1beta1.JobStateChangePayload
+	6,  // 15: gotocompany.optimus.integration.v1beta1.OptimusChangeEvent.replay_state_change:type_name -> gotocompany.optimus.integration.v1beta1.ReplayStateChangePayload
+	16, // [16:16] is the sub-list for method output_type
+	16, // [16:16] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_gotocompany_optimus_integration_v1beta1_event_proto_init() }
@@ -706,6 +938,30 @@ func file_gotocompany_optimus_integration_v1beta1_event_proto_init() {
 			}
 		}
 		file_gotocompany_optimus_integration_v1beta1_event_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayRunPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gotocompany_optimus_integration_v1beta1_event_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayStateChangePayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gotocompany_optimus_integration_v1beta1_event_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OptimusChangeEvent); i {
 			case 0:
 				return &v.state
@@ -718,11 +974,12 @@ func file_gotocompany_optimus_integration_v1beta1_event_proto_init() {
 			}
 		}
 	}
-	file_gotocompany_optimus_integration_v1beta1_event_proto_msgTypes[4].OneofWrappers = []interface{}{
+	file_gotocompany_optimus_integration_v1beta1_event_proto_msgTypes[6].OneofWrappers = []interface{}{
 		(*OptimusChangeEvent_JobChange)(nil),
 		(*OptimusChangeEvent_ResourceChange)(nil),
 		(*OptimusChangeEvent_JobRun)(nil),
 		(*OptimusChangeEvent_JobStateChange)(nil),
+		(*OptimusChangeEvent_ReplayStateChange)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -730,7 +987,7 @@ func file_gotocompany_optimus_integration_v1beta1_event_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_gotocompany_optimus_integration_v1beta1_event_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   5,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,145 @@
+package executor
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	encryptedRunInputPath = "/api/v1beta1/project/%s/job/%s/encrypted_run_input"
+
+	// fileKeyLabel has to be the same label the server encrypts the file key with
+	fileKeyLabel = "optimus-executor-input"
+	// secretDataPrefix has to be the same prefix the server seals the secret names with
+	secretDataPrefix = "secret:"
+	keySize          = 2048
+)
+
+// encryptedInput is decoded from the response of the http gateway, which names the fields in lower camel case
+type encryptedInput struct {
+	Input
+	EncryptedKey     string            `json:"encryptedKey"`
+	EncryptedFiles   map[string]string `json:"encryptedFiles"`
+	EncryptedSecrets map[string]string `json:"encryptedSecrets"`
+}
+
+// GenerateKey creates the key used to fetch the encrypted input of a run, a new key
+// is expected to be generated for every run and not to be kept after it
+func GenerateKey() (*rsa.PrivateKey, error) {
+	return rsa.GenerateKey(rand.Reader, keySize)
+}
+
+// FetchEncryptedInput gets the input of the executor for the job run when some of the
+// files have secrets in them. The secrets and those files are encrypted by the server
+// with the public part of the key, and are decrypted into the secrets and files of the input.
+func (c *Client) FetchEncryptedInput(ctx context.Context, req RunRequest, key *rsa.PrivateKey) (*Input, error) {
+	if req.ProjectName == "" || req.JobName == "" {
+		return nil, errors.New("project name and job name are required")
+	}
+	if req.InstanceName == "" || req.InstanceType == "" {
+		return nil, errors.New("instance name and instance type are required")
+	}
+	if key == nil {
+		return nil, errors.New("key is required")
+	}
+
+	publicKey, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding public key: %w", err)
+	}
+
+	body := map[string]string{
+		"scheduled_at":  req.ScheduledAt.Format(time.RFC3339),
+		"instance_name": req.InstanceName,
+		"instance_type": string(req.InstanceType),
+		"public_key":    base64.StdEncoding.EncodeToString(publicKey),
+	}
+	if req.JobRunID != "" {
+		body["jobrun_id"] = req.JobRunID
+	}
+
+	respBody, err := c.post(ctx, fmt.Sprintf(encryptedRunInputPath, req.ProjectName, req.JobName), body)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching encrypted run input: %w", err)
+	}
+
+	var input encryptedInput
+	if err := json.Unmarshal(respBody, &input); err != nil {
+		return nil, fmt.Errorf("error decoding encrypted run input: %w", err)
+	}
+	if len(input.EncryptedFiles) == 0 && len(input.EncryptedSecrets) == 0 {
+		return &input.Input, nil
+	}
+
+	gcm, err := fileCipher(key, input.EncryptedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Files == nil {
+		input.Files = map[string]string{}
+	}
+	for name, content := range input.EncryptedFiles {
+		plain, err := open(gcm, content, name)
+		if err != nil {
+			return nil, fmt.Errorf("error decrypting file %s: %w", name, err)
+		}
+		input.Files[name] = plain
+	}
+
+	if input.Secrets == nil {
+		input.Secrets = map[string]string{}
+	}
+	for name, value := range input.EncryptedSecrets {
+		plain, err := open(gcm, value, secretDataPrefix+name)
+		if err != nil {
+			return nil, fmt.Errorf("error decrypting secret %s: %w", name, err)
+		}
+		input.Secrets[name] = plain
+	}
+	return &input.Input, nil
+}
+
+func fileCipher(key *rsa.PrivateKey, encryptedKey string) (cipher.AEAD, error) {
+	wrappedKey, err := base64.StdEncoding.DecodeString(encryptedKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid encrypted key: %w", err)
+	}
+	fileKey, err := rsa.DecryptOAEP(sha256.New(), rand.Reader, key, wrappedKey, []byte(fileKeyLabel))
+	if err != nil {
+		return nil, fmt.Errorf("error decrypting file key: %w", err)
+	}
+
+	block, err := aes.NewCipher(fileKey)
+	if err != nil {
+		return nil, fmt.Errorf("error creating cipher: %w", err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("error creating cipher: %w", err)
+	}
+	return gcm, nil
+}
+
+func open(gcm cipher.AEAD, content, additionalData string) (string, error) {
+	sealed, err := base64.StdEncoding.DecodeString(content)
+	if err != nil || len(sealed) < gcm.NonceSize() {
+		return "", errors.New("invalid encrypted content")
+	}
+	nonce, ciphertext := sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():]
+	plain, err := gcm.Open(nil, nonce, ciphertext, []byte(additionalData))
+	if err != nil {
+		return "", err
+	}
+	return string(plain), nil
+}
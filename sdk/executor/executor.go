@@ -0,0 +1,250 @@
+// Package executor contains the client used inside task and hook images to talk to
+// the Optimus server during a job run: fetching the run input, writing it to the
+// filesystem, sending heartbeats and reporting the artifacts produced by the run.
+package executor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	InstanceTypeTask InstanceType = "TYPE_TASK"
+	InstanceTypeHook InstanceType = "TYPE_HOOK"
+	// InstanceTypeHookFailure is for the hooks of type fail, only accepted along with the encrypted run input
+	InstanceTypeHookFailure InstanceType = "TYPE_HOOK_FAILURE"
+
+	// InputDirectory is the directory, relative to the asset directory, where the run input is written
+	InputDirectory = "in"
+	EnvFileName    = ".env"
+	SecretFileName = ".secret"
+
+	// DefaultXComPath is the file read by the scheduler once the executor container finishes
+	DefaultXComPath = "/airflow/xcom/return.json"
+	artifactsKey    = "artifacts"
+
+	runInputPath  = "/api/v1beta1/project/%s/job/%s/run_input"
+	heartbeatPath = "/api/v1beta1/project/%s/namespace/%s/job/%s/heartbeat"
+
+	defaultTimeout = time.Minute
+)
+
+type InstanceType string
+
+// RunRequest identifies the job run the executor is part of
+type RunRequest struct {
+	ProjectName   string
+	NamespaceName string
+	JobName       string
+	ScheduledAt   time.Time
+
+	InstanceName string
+	InstanceType InstanceType
+	JobRunID     string
+}
+
+// Input is the compiled input of an executor for a job run
+type Input struct {
+	Envs    map[string]string `json:"envs"`
+	Files   map[string]string `json:"files"`
+	Secrets map[string]string `json:"secrets"`
+}
+
+type Client struct {
+	host       string
+	httpClient *http.Client
+}
+
+func NewClient(host string) *Client {
+	return &Client{
+		host:       strings.TrimSuffix(host, "/"),
+		httpClient: &http.Client{Timeout: defaultTimeout},
+	}
+}
+
+// FetchInput gets the compiled envs, files and secrets of the executor for the job run
+func (c *Client) FetchInput(ctx context.Context, req RunRequest) (*Input, error) {
+	if req.ProjectName == "" || req.JobName == "" {
+		return nil, errors.New("project name and job name are required")
+	}
+	if req.InstanceName == "" || req.InstanceType == "" {
+		return nil, errors.New("instance name and instance type are required")
+	}
+
+	body := map[string]string{
+		"scheduled_at":  req.ScheduledAt.Format(time.RFC3339),
+		"instance_name": req.InstanceName,
+		"instance_type": string(req.InstanceType),
+	}
+	if req.JobRunID != "" {
+		body["jobrun_id"] = req.JobRunID
+	}
+
+	path := fmt.Sprintf(runInputPath, url.PathEscape(req.ProjectName), url.PathEscape(req.JobName))
+	respBody, err := c.post(ctx, path, body)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching run input: %w", err)
+	}
+
+	var input Input
+	if err := json.Unmarshal(respBody, &input); err != nil {
+		return nil, fmt.Errorf("error decoding run input: %w", err)
+	}
+	return &input, nil
+}
+
+// Heartbeat reports to the server that the executor of the job run is still alive
+func (c *Client) Heartbeat(ctx context.Context, req RunRequest) error {
+	if req.ProjectName == "" || req.NamespaceName == "" || req.JobName == "" {
+		return errors.New("project name, namespace name and job name are required")
+	}
+
+	body := map[string]string{
+		"scheduled_at": req.ScheduledAt.Format(time.RFC3339),
+	}
+	path := fmt.Sprintf(heartbeatPath, url.PathEscape(req.ProjectName), url.PathEscape(req.NamespaceName), url.PathEscape(req.JobName))
+	if _, err := c.post(ctx, path, body); err != nil {
+		return fmt.Errorf("error sending heartbeat: %w", err)
+	}
+	return nil
+}
+
+// KeepAlive sends a heartbeat every interval until the context is done,
+// failures are passed to onError and do not stop the heartbeats
+func (c *Client) KeepAlive(ctx context.Context, req RunRequest, interval time.Duration, onError func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := c.Heartbeat(ctx, req); err != nil && onError != nil && ctx.Err() == nil {
+			onError(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *Client) post(ctx context.Context, path string, body interface{}) ([]byte, error) {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, c.host+path, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	respBody, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d: %s", response.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+	return respBody, nil
+}
+
+// WriteInput writes the files of the input into <assetDir>/in, along with the
+// envs and secrets as KEY='value' lines in .env and .secret files
+func WriteInput(assetDir string, input *Input) error {
+	dirPath := filepath.Join(assetDir, InputDirectory)
+	if err := os.MkdirAll(dirPath, 0o700); err != nil {
+		return fmt.Errorf("failed to create directory at %s: %w", dirPath, err)
+	}
+
+	for fileName, content := range input.Files {
+		filePath := filepath.Join(dirPath, fileName)
+		if !strings.HasPrefix(filePath, dirPath+string(filepath.Separator)) {
+			return fmt.Errorf("invalid file name %s", fileName)
+		}
+		if err := os.MkdirAll(filepath.Dir(filePath), 0o700); err != nil {
+			return fmt.Errorf("failed to create directory at %s: %w", filepath.Dir(filePath), err)
+		}
+		if err := os.WriteFile(filePath, []byte(content), 0o600); err != nil {
+			return fmt.Errorf("failed to write file at %s: %w", filePath, err)
+		}
+	}
+
+	if err := writeKeyValues(filepath.Join(dirPath, EnvFileName), input.Envs); err != nil {
+		return err
+	}
+	return writeKeyValues(filepath.Join(dirPath, SecretFileName), input.Secrets)
+}
+
+func writeKeyValues(filePath string, values map[string]string) error {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var content strings.Builder
+	for _, key := range keys {
+		content.WriteString(fmt.Sprintf("%s='%s'\n", key, values[key]))
+	}
+
+	if err := os.WriteFile(filePath, []byte(content.String()), 0o600); err != nil {
+		return fmt.Errorf("failed to write file at %s: %w", filePath, err)
+	}
+	return nil
+}
+
+// ReportArtifacts adds the artifacts to the xcom file read by the scheduler, keeping
+// any other value already written in it. The scheduler sends the artifacts to Optimus
+// with the success event of the run, making them available to downstream job runs.
+func ReportArtifacts(xcomPath string, artifacts map[string]string) error {
+	values := map[string]interface{}{}
+	content, err := os.ReadFile(xcomPath)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to read file at %s: %w", xcomPath, err)
+	}
+	if len(bytes.TrimSpace(content)) > 0 {
+		if err := json.Unmarshal(content, &values); err != nil {
+			return fmt.Errorf("invalid content in %s: %w", xcomPath, err)
+		}
+	}
+
+	merged := map[string]interface{}{}
+	if existing, ok := values[artifactsKey].(map[string]interface{}); ok {
+		merged = existing
+	}
+	for key, value := range artifacts {
+		merged[key] = value
+	}
+	values[artifactsKey] = merged
+
+	content, err = json.Marshal(values)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(xcomPath), 0o755); err != nil {
+		return fmt.Errorf("failed to create directory at %s: %w", filepath.Dir(xcomPath), err)
+	}
+	if err := os.WriteFile(xcomPath, content, 0o644); err != nil { //nolint:gosec
+		return fmt.Errorf("failed to write file at %s: %w", xcomPath, err)
+	}
+	return nil
+}
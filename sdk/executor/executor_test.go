@@ -0,0 +1,257 @@
+package executor_test
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/sdk/executor"
+)
+
+func TestExecutor(t *testing.T) {
+	ctx := context.Background()
+	scheduledAt := time.Date(2023, 1, 2, 3, 0, 0, 0, time.UTC)
+	runRequest := executor.RunRequest{
+		ProjectName:   "proj",
+		NamespaceName: "ns",
+		JobName:       "job1",
+		ScheduledAt:   scheduledAt,
+		InstanceName:  "bq2bq",
+		InstanceType:  executor.InstanceTypeTask,
+	}
+
+	t.Run("FetchInput", func(t *testing.T) {
+		t.Run("returns error when instance is not provided", func(t *testing.T) {
+			client := executor.NewClient("http://localhost")
+
+			input, err := client.FetchInput(ctx, executor.RunRequest{ProjectName: "proj", JobName: "job1"})
+			assert.Nil(t, input)
+			assert.ErrorContains(t, err, "instance name and instance type are required")
+		})
+		t.Run("returns error when server responds with failure", func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`{"message":"job not found"}`))
+			}))
+			defer server.Close()
+
+			input, err := executor.NewClient(server.URL).FetchInput(ctx, runRequest)
+			assert.Nil(t, input)
+			assert.ErrorContains(t, err, "unexpected status code 404")
+		})
+		t.Run("returns the run input of the instance", func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "/api/v1beta1/project/proj/job/job1/run_input", r.URL.Path)
+
+				var body map[string]string
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, "2023-01-02T03:00:00Z", body["scheduled_at"])
+				assert.Equal(t, "bq2bq", body["instance_name"])
+				assert.Equal(t, "TYPE_TASK", body["instance_type"])
+
+				w.Write([]byte(`{"envs":{"EXECUTION_TIME":"2023-01-02"},"files":{"query.sql":"select 1"},"secrets":{"TOKEN":"secret"}}`))
+			}))
+			defer server.Close()
+
+			input, err := executor.NewClient(server.URL).FetchInput(ctx, runRequest)
+			assert.NoError(t, err)
+			assert.Equal(t, map[string]string{"EXECUTION_TIME": "2023-01-02"}, input.Envs)
+			assert.Equal(t, map[string]string{"query.sql": "select 1"}, input.Files)
+			assert.Equal(t, map[string]string{"TOKEN": "secret"}, input.Secrets)
+		})
+	})
+
+	t.Run("FetchEncryptedInput", func(t *testing.T) {
+		t.Run("returns error when key is not provided", func(t *testing.T) {
+			input, err := executor.NewClient("http://localhost").FetchEncryptedInput(ctx, runRequest, nil)
+			assert.Nil(t, input)
+			assert.ErrorContains(t, err, "key is required")
+		})
+		t.Run("returns the run input with the encrypted files and secrets decrypted", func(t *testing.T) {
+			key, err := executor.GenerateKey()
+			assert.NoError(t, err)
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/api/v1beta1/project/proj/job/job1/encrypted_run_input", r.URL.Path)
+
+				var body map[string]string
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, "TYPE_TASK", body["instance_type"])
+
+				der, err := base64.StdEncoding.DecodeString(body["public_key"])
+				assert.NoError(t, err)
+				parsed, err := x509.ParsePKIXPublicKey(der)
+				assert.NoError(t, err)
+
+				fileKey := make([]byte, 32)
+				_, err = rand.Read(fileKey)
+				assert.NoError(t, err)
+				encryptedKey, err := rsa.EncryptOAEP(sha256.New(), rand.Reader, parsed.(*rsa.PublicKey), fileKey, []byte("optimus-executor-input"))
+				assert.NoError(t, err)
+
+				block, err := aes.NewCipher(fileKey)
+				assert.NoError(t, err)
+				gcm, err := cipher.NewGCM(block)
+				assert.NoError(t, err)
+				nonce := make([]byte, gcm.NonceSize())
+				sealed := gcm.Seal(nonce, nonce, []byte("select 'secret'"), []byte("secret.sql"))
+				sealedSecret := gcm.Seal(nonce, nonce, []byte("secret"), []byte("secret:TOKEN"))
+
+				json.NewEncoder(w).Encode(map[string]interface{}{
+					"envs":             map[string]string{"EXECUTION_TIME": "2023-01-02"},
+					"files":            map[string]string{"query.sql": "select 1"},
+					"encryptedKey":     base64.StdEncoding.EncodeToString(encryptedKey),
+					"encryptedFiles":   map[string]string{"secret.sql": base64.StdEncoding.EncodeToString(sealed)},
+					"encryptedSecrets": map[string]string{"TOKEN": base64.StdEncoding.EncodeToString(sealedSecret)},
+				})
+			}))
+			defer server.Close()
+
+			input, err := executor.NewClient(server.URL).FetchEncryptedInput(ctx, runRequest, key)
+			assert.NoError(t, err)
+			assert.Equal(t, map[string]string{"EXECUTION_TIME": "2023-01-02"}, input.Envs)
+			assert.Equal(t, map[string]string{"query.sql": "select 1", "secret.sql": "select 'secret'"}, input.Files)
+			assert.Equal(t, map[string]string{"TOKEN": "secret"}, input.Secrets)
+		})
+		t.Run("returns error when encrypted file is not sealed with the file key", func(t *testing.T) {
+			key, err := executor.GenerateKey()
+			assert.NoError(t, err)
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				encryptedKey, err := rsa.EncryptOAEP(sha256.New(), rand.Reader, &key.PublicKey, make([]byte, 32), []byte("optimus-executor-input"))
+				assert.NoError(t, err)
+
+				json.NewEncoder(w).Encode(map[string]interface{}{
+					"encryptedKey":   base64.StdEncoding.EncodeToString(encryptedKey),
+					"encryptedFiles": map[string]string{"secret.sql": base64.StdEncoding.EncodeToString(make([]byte, 40))},
+				})
+			}))
+			defer server.Close()
+
+			input, err := executor.NewClient(server.URL).FetchEncryptedInput(ctx, runRequest, key)
+			assert.Nil(t, input)
+			assert.ErrorContains(t, err, "error decrypting file secret.sql")
+		})
+		t.Run("returns error when encrypted secret is not sealed with its secret name", func(t *testing.T) {
+			key, err := executor.GenerateKey()
+			assert.NoError(t, err)
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				fileKey := make([]byte, 32)
+				encryptedKey, err := rsa.EncryptOAEP(sha256.New(), rand.Reader, &key.PublicKey, fileKey, []byte("optimus-executor-input"))
+				assert.NoError(t, err)
+
+				block, err := aes.NewCipher(fileKey)
+				assert.NoError(t, err)
+				gcm, err := cipher.NewGCM(block)
+				assert.NoError(t, err)
+				nonce := make([]byte, gcm.NonceSize())
+				sealed := gcm.Seal(nonce, nonce, []byte("secret"), []byte("TOKEN"))
+
+				json.NewEncoder(w).Encode(map[string]interface{}{
+					"encryptedKey":     base64.StdEncoding.EncodeToString(encryptedKey),
+					"encryptedSecrets": map[string]string{"TOKEN": base64.StdEncoding.EncodeToString(sealed)},
+				})
+			}))
+			defer server.Close()
+
+			input, err := executor.NewClient(server.URL).FetchEncryptedInput(ctx, runRequest, key)
+			assert.Nil(t, input)
+			assert.ErrorContains(t, err, "error decrypting secret TOKEN")
+		})
+	})
+
+	t.Run("Heartbeat", func(t *testing.T) {
+		t.Run("returns error when namespace is not provided", func(t *testing.T) {
+			err := executor.NewClient("http://localhost").Heartbeat(ctx, executor.RunRequest{ProjectName: "proj", JobName: "job1"})
+			assert.ErrorContains(t, err, "project name, namespace name and job name are required")
+		})
+		t.Run("sends the heartbeat of the job run", func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/api/v1beta1/project/proj/namespace/ns/job/job1/heartbeat", r.URL.Path)
+
+				var body map[string]string
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, map[string]string{"scheduled_at": "2023-01-02T03:00:00Z"}, body)
+			}))
+			defer server.Close()
+
+			err := executor.NewClient(server.URL).Heartbeat(ctx, runRequest)
+			assert.NoError(t, err)
+		})
+	})
+
+	t.Run("WriteInput", func(t *testing.T) {
+		t.Run("writes files, envs and secrets into the input directory", func(t *testing.T) {
+			assetDir := t.TempDir()
+			input := &executor.Input{
+				Envs:    map[string]string{"B": "2", "A": "1"},
+				Files:   map[string]string{"query.sql": "select 1", "nested/config.yaml": "a: b"},
+				Secrets: map[string]string{"TOKEN": "secret"},
+			}
+
+			err := executor.WriteInput(assetDir, input)
+			assert.NoError(t, err)
+
+			assertFileContent(t, filepath.Join(assetDir, "in", "query.sql"), "select 1")
+			assertFileContent(t, filepath.Join(assetDir, "in", "nested", "config.yaml"), "a: b")
+			assertFileContent(t, filepath.Join(assetDir, "in", ".env"), "A='1'\nB='2'\n")
+			assertFileContent(t, filepath.Join(assetDir, "in", ".secret"), "TOKEN='secret'\n")
+		})
+		t.Run("returns error when file is outside the input directory", func(t *testing.T) {
+			input := &executor.Input{Files: map[string]string{"../outside": "content"}}
+
+			err := executor.WriteInput(t.TempDir(), input)
+			assert.ErrorContains(t, err, "invalid file name ../outside")
+		})
+	})
+
+	t.Run("ReportArtifacts", func(t *testing.T) {
+		t.Run("writes the artifacts when xcom file does not exist", func(t *testing.T) {
+			xcomPath := filepath.Join(t.TempDir(), "xcom", "return.json")
+
+			err := executor.ReportArtifacts(xcomPath, map[string]string{"table": "proj.dataset.table"})
+			assert.NoError(t, err)
+			assertFileContent(t, xcomPath, `{"artifacts":{"table":"proj.dataset.table"}}`)
+		})
+		t.Run("merges the artifacts with existing values", func(t *testing.T) {
+			xcomPath := filepath.Join(t.TempDir(), "return.json")
+			existing := `{"monitoring":{"slot_millis":10},"artifacts":{"rows":"5"}}`
+			assert.NoError(t, os.WriteFile(xcomPath, []byte(existing), 0o600))
+
+			err := executor.ReportArtifacts(xcomPath, map[string]string{"table": "proj.dataset.table"})
+			assert.NoError(t, err)
+			assertFileContent(t, xcomPath, `{"artifacts":{"rows":"5","table":"proj.dataset.table"},"monitoring":{"slot_millis":10}}`)
+		})
+		t.Run("returns error when xcom file is not valid json", func(t *testing.T) {
+			xcomPath := filepath.Join(t.TempDir(), "return.json")
+			assert.NoError(t, os.WriteFile(xcomPath, []byte("invalid"), 0o600))
+
+			err := executor.ReportArtifacts(xcomPath, map[string]string{"table": "proj.dataset.table"})
+			assert.ErrorContains(t, err, "invalid content")
+		})
+	})
+}
+
+func assertFileContent(t *testing.T, filePath, expected string) {
+	t.Helper()
+
+	content, err := os.ReadFile(filePath)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, string(content))
+}
@@ -0,0 +1,237 @@
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	SchemaTypeObject  = "object"
+	SchemaTypeString  = "string"
+	SchemaTypeInteger = "integer"
+	SchemaTypeNumber  = "number"
+	SchemaTypeBoolean = "boolean"
+
+	// templateMarker marks config values compiled at run time, which are only checked for being present
+	templateMarker = "{{"
+
+	maxSuggestionDistance = 2
+)
+
+// ConfigSchema describes the configs accepted by the plugin, following a subset of JSON schema. As the configs
+// are strings, the values are checked against the type, enum, pattern and length keywords of their property.
+type ConfigSchema struct {
+	Type       string                     `yaml:"type,omitempty"`
+	Properties map[string]*PropertySchema `yaml:"properties,omitempty"`
+	Required   []string                   `yaml:"required,omitempty"`
+	// AdditionalProperties rejects the configs not listed in properties when set to false, catching typos in the names
+	AdditionalProperties *bool `yaml:"additionalProperties,omitempty"`
+}
+
+type PropertySchema struct {
+	Type        string   `yaml:"type,omitempty"`
+	Description string   `yaml:"description,omitempty"`
+	Default     string   `yaml:"default,omitempty"`
+	Enum        []string `yaml:"enum,omitempty"`
+	Pattern     string   `yaml:"pattern,omitempty"`
+	MinLength   int      `yaml:"minLength,omitempty"`
+	MaxLength   int      `yaml:"maxLength,omitempty"`
+}
+
+func (s *ConfigSchema) Validate() error {
+	if s.Type != "" && s.Type != SchemaTypeObject {
+		return fmt.Errorf("config schema type %s is not supported, only %s is", s.Type, SchemaTypeObject)
+	}
+	for name, property := range s.Properties {
+		if err := property.validate(); err != nil {
+			return fmt.Errorf("config schema property %s: %w", name, err)
+		}
+	}
+	for _, name := range s.Required {
+		if _, ok := s.Properties[name]; !ok {
+			return fmt.Errorf("required config %s is not defined in config schema properties", name)
+		}
+	}
+	return nil
+}
+
+func (p *PropertySchema) validate() error {
+	switch p.Type {
+	case "", SchemaTypeString, SchemaTypeInteger, SchemaTypeNumber, SchemaTypeBoolean:
+	default:
+		return fmt.Errorf("type %s is not supported", p.Type)
+	}
+	if _, err := regexp.Compile(p.Pattern); err != nil {
+		return fmt.Errorf("invalid pattern %q", p.Pattern)
+	}
+	if p.MaxLength != 0 && p.MinLength > p.MaxLength {
+		return errors.New("minLength is greater than maxLength")
+	}
+	return nil
+}
+
+// ValidateConfig checks the configs against the schema, reporting every problem found
+func (s *ConfigSchema) ValidateConfig(config map[string]string) error {
+	var problems []string
+	for _, name := range s.Required {
+		if _, ok := config[name]; !ok {
+			problems = append(problems, fmt.Sprintf("required config %s is missing", name))
+		}
+	}
+
+	names := make([]string, 0, len(config))
+	for name := range config {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		property, ok := s.Properties[name]
+		if !ok {
+			if s.AdditionalProperties != nil && !*s.AdditionalProperties {
+				problems = append(problems, s.unknownConfigProblem(name))
+			}
+			continue
+		}
+		if err := property.ValidateValue(config[name]); err != nil {
+			problems = append(problems, fmt.Sprintf("config %s: %s", name, err))
+		}
+	}
+
+	if len(problems) > 0 {
+		return errors.New(strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+func (s *ConfigSchema) unknownConfigProblem(name string) string {
+	suggestion, closest := "", maxSuggestionDistance+1
+	for property := range s.Properties {
+		if distance := editDistance(strings.ToUpper(name), strings.ToUpper(property)); distance < closest ||
+			(distance == closest && property < suggestion) {
+			suggestion, closest = property, distance
+		}
+	}
+	if suggestion == "" {
+		return fmt.Sprintf("unknown config %s", name)
+	}
+	return fmt.Sprintf("unknown config %s, did you mean %s?", name, suggestion)
+}
+
+// ValidateValue checks the value of the config against the property, values compiled at run time are not checked
+func (p *PropertySchema) ValidateValue(value string) error {
+	if strings.Contains(value, templateMarker) {
+		return nil
+	}
+
+	var err error
+	switch p.Type {
+	case SchemaTypeInteger:
+		_, err = strconv.ParseInt(value, 10, 64)
+	case SchemaTypeNumber:
+		_, err = strconv.ParseFloat(value, 64)
+	case SchemaTypeBoolean:
+		_, err = strconv.ParseBool(value)
+	}
+	if err != nil {
+		return fmt.Errorf("value %q is not a valid %s", value, p.Type)
+	}
+
+	if len(p.Enum) > 0 && !contains(p.Enum, value) {
+		return fmt.Errorf("value %q is not one of %s", value, strings.Join(p.Enum, ", "))
+	}
+	if p.Pattern != "" {
+		if matched, err := regexp.MatchString(p.Pattern, value); err != nil || !matched {
+			return fmt.Errorf("value %q does not match pattern %s", value, p.Pattern)
+		}
+	}
+	if p.MinLength != 0 && len(value) < p.MinLength {
+		return fmt.Errorf("value %q is shorter than %d", value, p.MinLength)
+	}
+	if p.MaxLength != 0 && len(value) > p.MaxLength {
+		return fmt.Errorf("value %q is longer than %d", value, p.MaxLength)
+	}
+	return nil
+}
+
+// Questions returns the survey questions of the properties, in the order of their names. The required ones and the
+// ones having an enum or a pattern are validated the same way as the configs are on deployment.
+func (s *ConfigSchema) Questions() Questions {
+	names := make([]string, 0, len(s.Properties))
+	for name := range s.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	questions := make(Questions, 0, len(names))
+	for _, name := range names {
+		questions = append(questions, s.Properties[name].question(name, contains(s.Required, name)))
+	}
+	return questions
+}
+
+func (p *PropertySchema) question(name string, required bool) Question {
+	prompt := p.Description
+	if prompt == "" {
+		prompt = name
+	}
+	question := Question{
+		Name:      name,
+		Prompt:    prompt,
+		Default:   p.Default,
+		Required:  required,
+		Regexp:    p.Pattern,
+		MinLength: p.MinLength,
+		MaxLength: p.MaxLength,
+	}
+	if len(p.Enum) > 0 {
+		question.Multiselect = p.Enum
+	}
+	if p.Pattern != "" {
+		question.ValidationError = fmt.Sprintf("%s should match %s", name, p.Pattern)
+	}
+	return question
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// editDistance is the number of single character edits turning one name into the other
+func editDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current := make([]int, len(b)+1)
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = minOf(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous = current
+	}
+	return previous[len(b)]
+}
+
+func minOf(values ...int) int {
+	smallest := values[0]
+	for _, v := range values[1:] {
+		if v < smallest {
+			smallest = v
+		}
+	}
+	return smallest
+}
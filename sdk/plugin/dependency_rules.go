@@ -0,0 +1,71 @@
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"regexp"
+)
+
+const (
+	ParserRegex = "regex"
+)
+
+// DependencyRules resolve the destination and the upstreams of the jobs declaratively, letting yaml plugins
+// without a dependency resolver mod take part in the dependency resolution
+type DependencyRules struct {
+	Destination DestinationRule `yaml:"destination"`
+	Upstreams   []UpstreamRule  `yaml:"upstreams,omitempty"`
+}
+
+type DestinationRule struct {
+	// Type of the destination resource, like bigquery
+	Type string `yaml:"type"`
+	// Name of the destination templated with the task configs, like {{.PROJECT}}:{{.DATASET}}.{{.TABLE}}
+	Name string `yaml:"name"`
+}
+
+type UpstreamRule struct {
+	// Asset is the pattern of the names of the assets the rule is applied on, like *.sql
+	Asset string `yaml:"asset"`
+	// Parser extracting the upstreams from the asset, defaults to regex
+	Parser string `yaml:"parser,omitempty"`
+	// Pattern matching the upstreams in the asset
+	Pattern string `yaml:"pattern"`
+	// URN of every match, expanded with the submatches of the pattern, like bigquery://$1:$2.$3
+	URN string `yaml:"urn"`
+}
+
+func (r *DependencyRules) Validate() error {
+	if r.Destination.Type == "" || r.Destination.Name == "" {
+		return errors.New("dependency rules destination type and name cannot be empty")
+	}
+	for _, rule := range r.Upstreams {
+		if err := rule.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r UpstreamRule) Validate() error {
+	if _, err := filepath.Match(r.Asset, ""); err != nil || r.Asset == "" {
+		return fmt.Errorf("invalid asset pattern %q in upstream rule", r.Asset)
+	}
+	if r.Parser != "" && r.Parser != ParserRegex {
+		return fmt.Errorf("parser %s is not supported in upstream rule", r.Parser)
+	}
+	if _, err := regexp.Compile(r.Pattern); err != nil || r.Pattern == "" {
+		return fmt.Errorf("invalid pattern %q in upstream rule", r.Pattern)
+	}
+	if r.URN == "" {
+		return errors.New("urn cannot be empty in upstream rule")
+	}
+	return nil
+}
+
+// AppliesTo tells if the rule extracts the upstreams of the asset
+func (r UpstreamRule) AppliesTo(assetName string) bool {
+	matched, err := filepath.Match(r.Asset, assetName)
+	return err == nil && matched
+}
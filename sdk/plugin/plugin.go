@@ -65,6 +65,15 @@ type Info struct {
 	// PluginType provides the place of execution, could be before the transformation
 	// after the transformation, etc
 	HookType HookType `yaml:",omitempty"`
+
+	// DependencyRules resolve the dependencies of the jobs for plugins without dependency resolver mod
+	DependencyRules *DependencyRules `yaml:"dependency_rules,omitempty"`
+
+	// ConfigSchema validates the configs of the jobs using the plugin on deployment, and drives the survey questions
+	ConfigSchema *ConfigSchema `yaml:"config_schema,omitempty"`
+
+	// RequiredProjectConfigs are the project configs the plugin needs, projects missing any of them are rejected on registration
+	RequiredProjectConfigs []string `yaml:"required_project_configs,omitempty"`
 }
 
 func (info *Info) Validate() error {
@@ -94,6 +103,14 @@ func (info *Info) Validate() error {
 		return errors.New("plugin type is not supported")
 	}
 
+	if info.DependencyRules != nil {
+		if err := info.DependencyRules.Validate(); err != nil {
+			return err
+		}
+	}
+	if info.ConfigSchema != nil {
+		return info.ConfigSchema.Validate()
+	}
 	return nil
 }
 
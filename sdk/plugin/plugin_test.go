@@ -105,6 +105,53 @@ func TestPlugins(t *testing.T) {
 						PluginType: "",
 					},
 				},
+				{
+					name: "when dependency rules destination is empty",
+					err:  errors.New("dependency rules destination type and name cannot be empty"),
+					info: plugin.Info{
+						Name:          "example",
+						Image:         "goto.io/example",
+						PluginVersion: "0.2",
+						Entrypoint: plugin.Entrypoint{
+							Script: "sleep 10",
+						},
+						PluginType:      plugin.TypeTask,
+						DependencyRules: &plugin.DependencyRules{},
+					},
+				},
+				{
+					name: "when dependency rules upstream pattern is invalid",
+					err:  errors.New(`invalid pattern "(" in upstream rule`),
+					info: plugin.Info{
+						Name:          "example",
+						Image:         "goto.io/example",
+						PluginVersion: "0.2",
+						Entrypoint: plugin.Entrypoint{
+							Script: "sleep 10",
+						},
+						PluginType: plugin.TypeTask,
+						DependencyRules: &plugin.DependencyRules{
+							Destination: plugin.DestinationRule{Type: "bigquery", Name: "{{.TABLE}}"},
+							Upstreams:   []plugin.UpstreamRule{{Asset: "*.sql", Pattern: "(", URN: "bigquery://$1"}},
+						},
+					},
+				},
+				{
+					name: "when config schema pattern is invalid",
+					err:  errors.New(`config schema property LOAD_METHOD: invalid pattern "("`),
+					info: plugin.Info{
+						Name:          "example",
+						Image:         "goto.io/example",
+						PluginVersion: "0.2",
+						Entrypoint: plugin.Entrypoint{
+							Script: "sleep 10",
+						},
+						PluginType: plugin.TypeTask,
+						ConfigSchema: &plugin.ConfigSchema{
+							Properties: map[string]*plugin.PropertySchema{"LOAD_METHOD": {Pattern: "("}},
+						},
+					},
+				},
 				{
 					name: "when valid",
 					err:  nil,
@@ -131,6 +178,54 @@ func TestPlugins(t *testing.T) {
 		})
 	})
 
+	t.Run("ConfigSchema", func(t *testing.T) {
+		additionalProperties := false
+		schema := &plugin.ConfigSchema{
+			Type: plugin.SchemaTypeObject,
+			Properties: map[string]*plugin.PropertySchema{
+				"LOAD_METHOD": {Description: "Load method", Enum: []string{"APPEND", "MERGE", "REPLACE"}, Default: "APPEND"},
+				"PARTITIONS":  {Type: plugin.SchemaTypeInteger},
+				"TABLE":       {Pattern: `^[a-z0-9_]+$`},
+			},
+			Required:             []string{"TABLE"},
+			AdditionalProperties: &additionalProperties,
+		}
+
+		t.Run("ValidateConfig", func(t *testing.T) {
+			t.Run("returns no error for configs following the schema", func(t *testing.T) {
+				err := schema.ValidateConfig(map[string]string{
+					"LOAD_METHOD": "MERGE",
+					"PARTITIONS":  "{{ .GLOBAL__PARTITIONS }}",
+					"TABLE":       "sample_table",
+				})
+				assert.NoError(t, err)
+			})
+			t.Run("returns every problem found, suggesting the property of a mistyped config", func(t *testing.T) {
+				err := schema.ValidateConfig(map[string]string{
+					"LOAD_METHD": "APPEND",
+					"PARTITIONS": "ten",
+				})
+				assert.EqualError(t, err, "required config TABLE is missing; unknown config LOAD_METHD, did you mean LOAD_METHOD?; "+
+					`config PARTITIONS: value "ten" is not a valid integer`)
+			})
+			t.Run("accepts unknown configs when additional properties are not rejected", func(t *testing.T) {
+				lenientSchema := &plugin.ConfigSchema{Properties: schema.Properties}
+				assert.NoError(t, lenientSchema.ValidateConfig(map[string]string{"LOAD_METHD": "APPEND"}))
+			})
+		})
+		t.Run("Questions", func(t *testing.T) {
+			questions := schema.Questions()
+			assert.Len(t, questions, 3)
+			assert.Equal(t, plugin.Question{
+				Name:        "LOAD_METHOD",
+				Prompt:      "Load method",
+				Default:     "APPEND",
+				Multiselect: []string{"APPEND", "MERGE", "REPLACE"},
+			}, questions[0])
+			assert.True(t, questions[2].Required)
+			assert.Error(t, questions[2].IsValid(""))
+		})
+	})
 	t.Run("ValidatorFactory", func(t *testing.T) {
 		validator := plugin.ValidatorFactory.NewFromRegex(`^[a-z0-9_\-]+$`, "invalid string format")
 		assert.Error(t, validator(23)) // input should be only string
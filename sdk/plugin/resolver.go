@@ -77,4 +77,15 @@ type GenerateDependenciesRequest struct {
 
 type GenerateDependenciesResponse struct {
 	Dependencies []string
+
+	// ColumnMappings is optional, plugins capable of parsing the assets can tell
+	// which columns of the dependencies each column of the destination is derived from
+	ColumnMappings []ColumnMapping
+}
+
+// ColumnMapping maps a column of the destination to a column of one of the dependencies
+type ColumnMapping struct {
+	Dependency       string `json:"dependency"`
+	DependencyColumn string `json:"dependency_column"`
+	Column           string `json:"column"`
 }
@@ -0,0 +1,313 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/goto/salt/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/auth"
+	"github.com/goto/optimus/internal/errors"
+	oHandler "github.com/goto/optimus/server/handler/v1beta1"
+)
+
+// roleServices are the grpc services whose methods are authorized with the roles of the principals, the permission
+// required by their methods is the one required from the api tokens
+var roleServices = []string{
+	"JobSpecificationService/",
+	"JobRunService/",
+	"ResourceService/",
+	"ReplayService/",
+	"LineageService/",
+	"AuditLogService/",
+	"UpstreamAccessService/",
+	"SnippetService/",
+	"ScheduleGroupService/",
+	"AlertSilenceService/",
+	"RunSnapshotService/",
+	"WebhookSubscriptionService/",
+	"ArchiveService/",
+	"BackupService/",
+	"NamespaceService/",
+}
+
+// roleMethods are the methods of the other services which are authorized with the roles like the methods of the
+// role services
+var roleMethods = map[string]struct{}{
+	"ProjectService/GetProject":   {},
+	"ProjectService/ListPresets":  {},
+	"ProjectService/SavePreset":   {},
+	"ProjectService/DeletePreset": {},
+}
+
+// userMethodPermissions is the permission required from the roles by the methods on the project, the secrets and
+// the api tokens, which are not allowed for api tokens
+var userMethodPermissions = map[string]tenant.Permission{
+	registerProjectMethod: tenant.PermissionAdmin,
+
+	"SecretService/ListSecrets":           tenant.PermissionRead,
+	"SecretService/ListStaleSecretUsages": tenant.PermissionAdmin,
+	"SecretService/RegisterSecret":        tenant.PermissionAdmin,
+	"SecretService/UpdateSecret":          tenant.PermissionAdmin,
+	"SecretService/DeleteSecret":          tenant.PermissionAdmin,
+	"SecretService/RotateSecret":          tenant.PermissionAdmin,
+
+	"APITokenService/CreateAPIToken": tenant.PermissionAdmin,
+	"APITokenService/ListAPITokens":  tenant.PermissionAdmin,
+	"APITokenService/RotateAPIToken": tenant.PermissionAdmin,
+	"APITokenService/RevokeAPIToken": tenant.PermissionAdmin,
+}
+
+// authenticatedMethods are the grpc methods not about a tenant, they are allowed for every principal. The status of
+// a deployment is looked up by its id and the window is computed from the request alone, so neither has a project to
+// be authorized on. The role bindings are authorized by their handler, as they are managed by the admins even before
+// the role based access is enabled.
+var authenticatedMethods = map[string]struct{}{
+	"RuntimeService/Version":                      {},
+	"RuntimeService/ListPlugins":                  {},
+	"ProjectService/ListProjects":                 {},
+	"JobRunService/GetUpstreamFreshness":          {},
+	"JobSpecificationService/GetDeployJobsStatus": {},
+	"JobSpecificationService/GetWindow":           {},
+	"RoleBindingService/GrantRole":                {},
+	"RoleBindingService/ListRoleBindings":         {},
+	"RoleBindingService/RevokeRole":               {},
+}
+
+// registerProjectMethod registers a new project or updates the config of an existing one, only the admins of the
+// server can register a new project
+const registerProjectMethod = "ProjectService/RegisterProject"
+
+// unscopedPaths are the http paths not about a project, they are not authorized with the roles. The readiness is
+// public, and everything about a project is served through the grpc services, so every http path is one of these.
+var unscopedPaths = map[string]struct{}{
+	oHandler.ReadinessPath: {},
+}
+
+// serverAdminMethods are the grpc methods managing the server itself, they are only allowed for the admins of the
+// server and not for api tokens
+var serverAdminMethods = map[string]struct{}{
+	"RuntimeService/RedriveEvents": {},
+	"RuntimeService/ListLogLevels": {},
+	"RuntimeService/SetLogLevel":   {},
+	"RuntimeService/UnsetLogLevel": {},
+}
+
+// checkUnscopedPaths returns error when any of the http paths is not an unscoped path, as only the grpc calls are
+// authorized with the roles
+func checkUnscopedPaths(patterns []string) error {
+	var unknown []string
+	for _, pattern := range patterns {
+		if _, ok := unscopedPaths[pattern]; !ok {
+			unknown = append(unknown, pattern)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return fmt.Errorf("http paths can not be authorized with the roles: %s", strings.Join(unknown, ", "))
+	}
+	return nil
+}
+
+// movingRequest is a request moving the job or the resource to another namespace, which is authorized on both
+type movingRequest interface {
+	GetNewNamespaceName() string
+}
+
+type roleAuthorizer interface {
+	Authorize(ctx context.Context, principal string, projectName tenant.ProjectName, namespaceName tenant.NamespaceName, permission tenant.Permission) (bool, error)
+	AuthorizeServer(principal string) bool
+}
+
+type projectGetter interface {
+	GetByName(ctx context.Context, name tenant.ProjectName) (*tenant.Project, error)
+}
+
+// roleAccess authorizes the calls of the OIDC principals on the projects, namespaces, jobs, secrets, presets,
+// snippets, schedule groups, replays, resources, backups, audit logs and upstream access with the roles bound to them
+// on the project or the namespace of the call. The api tokens are limited by their scope instead. The calls without
+// principal, and the calls of the methods not known here, are rejected once the role based access is enabled,
+// otherwise they are left to be authorized in front of the server.
+type roleAccess struct {
+	logger     log.Logger
+	authorizer roleAuthorizer
+	projects   projectGetter
+	enabled    bool
+}
+
+// checkAuthenticated returns error for the calls without principal when the role based access is enabled, as they
+// can not be authorized with the roles
+func (a roleAccess) checkAuthenticated(target string) error {
+	if a.enabled {
+		return status.Errorf(codes.Unauthenticated, "%s needs authentication as the role based access is enabled", target)
+	}
+	return nil
+}
+
+// checkKnown returns error for the calls of the methods which are not authorized with the roles when the role based
+// access is enabled, so a method added without its permission is not left open
+func (a roleAccess) checkKnown(ctx context.Context, target string) error {
+	if !a.enabled {
+		return nil
+	}
+	if auth.PrincipalFrom(ctx) == nil {
+		return a.checkAuthenticated(target)
+	}
+	return status.Errorf(codes.PermissionDenied, "%s is not authorized with the roles", target)
+}
+
+func rolePermission(method string) (tenant.Permission, bool) {
+	if permission, ok := userMethodPermissions[method]; ok {
+		return permission, true
+	}
+	if _, ok := roleMethods[method]; ok {
+		permission, ok := methodPermissions[method]
+		return permission, ok
+	}
+	for _, service := range roleServices {
+		if strings.HasPrefix(method, service) {
+			permission, ok := methodPermissions[method]
+			return permission, ok
+		}
+	}
+	return "", false
+}
+
+func (a roleAccess) authorize(ctx context.Context, projectName tenant.ProjectName, namespaceName tenant.NamespaceName,
+	permission tenant.Permission, target string,
+) error {
+	principal := auth.PrincipalFrom(ctx)
+	if principal == nil {
+		return a.checkAuthenticated(target)
+	}
+	if principal.Type != auth.PrincipalOIDC {
+		return nil
+	}
+	if projectName == "" {
+		return status.Errorf(codes.PermissionDenied, "%s can not be authorized to call %s without project", principal, target)
+	}
+
+	allowed, err := a.authorizer.Authorize(ctx, principal.String(), projectName, namespaceName, permission)
+	if err != nil {
+		a.logger.Error("error authorizing [%s] to call [%s]: %s", principal, target, err)
+		return status.Error(codes.Internal, "unable to authorize the request")
+	}
+	if !allowed {
+		return status.Errorf(codes.PermissionDenied, "%s does not have %s permission on project %s to call %s",
+			principal, permission, projectName, target)
+	}
+	return nil
+}
+
+func (a roleAccess) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	method := strings.TrimPrefix(info.FullMethod, grpcServicePrefix)
+	if _, ok := serverAdminMethods[method]; ok {
+		if err := a.authorizeServer(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+	if _, ok := authenticatedMethods[method]; ok {
+		if auth.PrincipalFrom(ctx) == nil {
+			if err := a.checkAuthenticated(info.FullMethod); err != nil {
+				return nil, err
+			}
+		}
+		return handler(ctx, req)
+	}
+	if method == registerProjectMethod {
+		if err := a.authorizeProjectRegistration(ctx, req, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+
+	permission, ok := rolePermission(method)
+	if !ok {
+		if err := a.checkKnown(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+
+	if err := a.authorizeRequest(ctx, req, permission, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// authorizeProjectRegistration authorizes the update of an existing project for its admins, and the registration
+// of a new project for the admins of the server
+func (a roleAccess) authorizeProjectRegistration(ctx context.Context, req interface{}, target string) error {
+	projectName, _ := tenantOfRequest(req)
+	if projectName == "" {
+		return a.authorizeServer(ctx, target)
+	}
+
+	_, err := a.projects.GetByName(ctx, projectName)
+	if err == nil {
+		return a.authorize(ctx, projectName, "", tenant.PermissionAdmin, target)
+	}
+	if !errors.IsErrorType(err, errors.ErrNotFound) {
+		a.logger.Error("error getting project [%s] to authorize [%s]: %s", projectName, target, err)
+		return status.Error(codes.Internal, "unable to authorize the request")
+	}
+	return a.authorizeServer(ctx, target)
+}
+
+// authorizeRequest authorizes the call on the tenant of the request, and on the namespace it moves to when it moves
+func (a roleAccess) authorizeRequest(ctx context.Context, req interface{}, permission tenant.Permission, target string) error {
+	projectName, namespaceName := tenantOfRequest(req)
+	if err := a.authorize(ctx, projectName, namespaceName, permission, target); err != nil {
+		return err
+	}
+	if request, ok := req.(movingRequest); ok {
+		return a.authorize(ctx, projectName, tenant.NamespaceName(request.GetNewNamespaceName()), permission, target)
+	}
+	return nil
+}
+
+func (a roleAccess) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	permission, ok := rolePermission(strings.TrimPrefix(info.FullMethod, grpcServicePrefix))
+	if !ok {
+		if err := a.checkKnown(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+	return handler(srv, &authorizedStream{ServerStream: ss, access: a, permission: permission, method: info.FullMethod})
+}
+
+// authorizedStream authorizes every message received through the stream, as each of them can be on another namespace
+type authorizedStream struct {
+	grpc.ServerStream
+
+	access     roleAccess
+	permission tenant.Permission
+	method     string
+}
+
+func (s *authorizedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return s.access.authorizeRequest(s.Context(), m, s.permission, s.method)
+}
+
+// authorizeServer authorizes the call of the OIDC principal on the server itself
+func (a roleAccess) authorizeServer(ctx context.Context, target string) error {
+	principal := auth.PrincipalFrom(ctx)
+	if principal == nil {
+		return a.checkAuthenticated(target)
+	}
+	if principal.Type != auth.PrincipalOIDC || a.authorizer.AuthorizeServer(principal.String()) {
+		return nil
+	}
+	return status.Errorf(codes.PermissionDenied, "%s is not an admin of the server to call %s", principal, target)
+}
@@ -0,0 +1,140 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/auth"
+	"github.com/goto/optimus/internal/errors"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+	"github.com/goto/optimus/server"
+)
+
+func TestRoleAccess(t *testing.T) {
+	principal := &auth.Principal{Type: auth.PrincipalOIDC, Name: "john@example.com"}
+
+	t.Run("unaryInterceptor", func(t *testing.T) {
+		info := &grpc.UnaryServerInfo{FullMethod: "/gotocompany.optimus.core.v1beta1.JobSpecificationService/DeleteJobs"}
+		req := &pb.DeleteJobsRequest{ProjectName: "mine", NamespaceName: "sales"}
+		handler := func(context.Context, interface{}) (interface{}, error) { return "ok", nil }
+
+		t.Run("rejects the calls without principal when the role based access is enabled", func(t *testing.T) {
+			interceptor := server.NewTestRoleAccessInterceptor(&roleAuthorizer{}, true)
+
+			_, err := interceptor(context.Background(), req, info, handler)
+			assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		})
+		t.Run("leaves the calls without principal when the role based access is not enabled", func(t *testing.T) {
+			interceptor := server.NewTestRoleAccessInterceptor(&roleAuthorizer{}, false)
+
+			resp, err := interceptor(context.Background(), req, info, handler)
+			assert.NoError(t, err)
+			assert.Equal(t, "ok", resp)
+		})
+		t.Run("authorizes the calls of the principal on the tenant of the request", func(t *testing.T) {
+			interceptor := server.NewTestRoleAccessInterceptor(&roleAuthorizer{allowed: map[tenant.ProjectName]bool{"mine": true}}, true)
+			ctx := auth.WithPrincipal(context.Background(), principal)
+
+			_, err := interceptor(ctx, req, info, handler)
+			assert.NoError(t, err)
+
+			_, err = interceptor(ctx, &pb.DeleteJobsRequest{ProjectName: "victim"}, info, handler)
+			assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		})
+		t.Run("authorizes the namespace registration for the admins of the project", func(t *testing.T) {
+			interceptor := server.NewTestRoleAccessInterceptor(&roleAuthorizer{allowed: map[tenant.ProjectName]bool{"mine": true}}, true)
+			ctx := auth.WithPrincipal(context.Background(), principal)
+			registerInfo := &grpc.UnaryServerInfo{FullMethod: "/gotocompany.optimus.core.v1beta1.NamespaceService/RegisterProjectNamespace"}
+
+			_, err := interceptor(ctx, &pb.RegisterProjectNamespaceRequest{ProjectName: "victim", Namespace: &pb.NamespaceSpecification{Name: "sales"}}, registerInfo, handler)
+			assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		})
+		t.Run("allows the calls of the principal without project on the methods not about a tenant", func(t *testing.T) {
+			interceptor := server.NewTestRoleAccessInterceptor(&roleAuthorizer{}, true)
+			ctx := auth.WithPrincipal(context.Background(), principal)
+			deployInfo := &grpc.UnaryServerInfo{FullMethod: "/gotocompany.optimus.core.v1beta1.JobSpecificationService/GetDeployJobsStatus"}
+			windowInfo := &grpc.UnaryServerInfo{FullMethod: "/gotocompany.optimus.core.v1beta1.JobSpecificationService/GetWindow"}
+
+			resp, err := interceptor(ctx, &pb.GetDeployJobsStatusRequest{DeployId: "deploy-id"}, deployInfo, handler)
+			assert.NoError(t, err)
+			assert.Equal(t, "ok", resp)
+
+			resp, err = interceptor(ctx, &pb.GetWindowRequest{Size: "1d"}, windowInfo, handler)
+			assert.NoError(t, err)
+			assert.Equal(t, "ok", resp)
+
+			_, err = interceptor(context.Background(), &pb.GetWindowRequest{Size: "1d"}, windowInfo, handler)
+			assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		})
+		t.Run("rejects the methods not authorized with the roles when the role based access is enabled", func(t *testing.T) {
+			unknownInfo := &grpc.UnaryServerInfo{FullMethod: "/gotocompany.optimus.core.v1beta1.UnknownService/Call"}
+			ctx := auth.WithPrincipal(context.Background(), principal)
+
+			interceptor := server.NewTestRoleAccessInterceptor(&roleAuthorizer{}, true)
+			_, err := interceptor(ctx, req, unknownInfo, handler)
+			assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+			interceptor = server.NewTestRoleAccessInterceptor(&roleAuthorizer{}, false)
+			resp, err := interceptor(ctx, req, unknownInfo, handler)
+			assert.NoError(t, err)
+			assert.Equal(t, "ok", resp)
+		})
+	})
+	t.Run("project registration", func(t *testing.T) {
+		info := &grpc.UnaryServerInfo{FullMethod: "/gotocompany.optimus.core.v1beta1.ProjectService/RegisterProject"}
+		handler := func(context.Context, interface{}) (interface{}, error) { return "ok", nil }
+		projects := &projectGetter{existing: map[tenant.ProjectName]bool{"mine": true, "victim": true}}
+		ctx := auth.WithPrincipal(context.Background(), principal)
+
+		t.Run("authorizes the update of an existing project for its admins", func(t *testing.T) {
+			interceptor := server.NewTestRoleAccessInterceptorWithProjects(&roleAuthorizer{allowed: map[tenant.ProjectName]bool{"mine": true}}, projects, true)
+
+			_, err := interceptor(ctx, &pb.RegisterProjectRequest{Project: &pb.ProjectSpecification{Name: "mine"}}, info, handler)
+			assert.NoError(t, err)
+
+			_, err = interceptor(ctx, &pb.RegisterProjectRequest{Project: &pb.ProjectSpecification{Name: "victim"}}, info, handler)
+			assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		})
+		t.Run("authorizes the registration of a new project for the admins of the server", func(t *testing.T) {
+			req := &pb.RegisterProjectRequest{Project: &pb.ProjectSpecification{Name: "new"}}
+
+			interceptor := server.NewTestRoleAccessInterceptorWithProjects(&roleAuthorizer{allowed: map[tenant.ProjectName]bool{"new": true}}, projects, true)
+			_, err := interceptor(ctx, req, info, handler)
+			assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+			interceptor = server.NewTestRoleAccessInterceptorWithProjects(&roleAuthorizer{admins: map[string]bool{principal.String(): true}}, projects, true)
+			_, err = interceptor(ctx, req, info, handler)
+			assert.NoError(t, err)
+		})
+	})
+}
+
+type roleAuthorizer struct {
+	allowed map[tenant.ProjectName]bool
+	admins  map[string]bool
+}
+
+func (r *roleAuthorizer) Authorize(_ context.Context, _ string, projectName tenant.ProjectName, _ tenant.NamespaceName, _ tenant.Permission) (bool, error) {
+	return r.allowed[projectName], nil
+}
+
+func (r *roleAuthorizer) AuthorizeServer(principal string) bool {
+	return r.admins[principal]
+}
+
+type projectGetter struct {
+	existing map[tenant.ProjectName]bool
+}
+
+func (p *projectGetter) GetByName(_ context.Context, name tenant.ProjectName) (*tenant.Project, error) {
+	if !p.existing[name] {
+		return nil, errors.NotFound(tenant.EntityProject, "no project for "+name.String())
+	}
+	return tenant.NewProject(name.String(), map[string]string{tenant.ProjectSchedulerHost: "host", tenant.ProjectStoragePathKey: "gs://location"})
+}
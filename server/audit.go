@@ -0,0 +1,184 @@
+package server
+
+import (
+	"context"
+	"hash"
+	"strings"
+	"time"
+
+	"github.com/goto/salt/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/goto/optimus/core/audit"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/auth"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+const auditRecordTimeout = 5 * time.Second
+
+// auditedMethods are the mutating grpc methods recorded in the audit log, with the action they are recorded as
+var auditedMethods = map[string]audit.Action{
+	"ProjectService/RegisterProject":            audit.ActionProjectUpdate,
+	"ProjectService/SavePreset":                 audit.ActionProjectUpdate,
+	"ProjectService/DeletePreset":               audit.ActionProjectUpdate,
+	"NamespaceService/RegisterProjectNamespace": audit.ActionNamespaceUpdate,
+
+	"SecretService/RegisterSecret": audit.ActionSecretSet,
+	"SecretService/UpdateSecret":   audit.ActionSecretSet,
+	"SecretService/DeleteSecret":   audit.ActionSecretDelete,
+	"SecretService/RotateSecret":   audit.ActionSecretSet,
+
+	"JobSpecificationService/DeployJobSpecification":      audit.ActionJobDeploy,
+	"JobSpecificationService/ReplaceAllJobSpecifications": audit.ActionJobDeploy,
+	"JobSpecificationService/AddJobSpecifications":        audit.ActionJobDeploy,
+	"JobSpecificationService/UpdateJobSpecifications":     audit.ActionJobDeploy,
+	"JobSpecificationService/CreateJobSpecification":      audit.ActionJobDeploy,
+	"JobSpecificationService/ChangeJobNamespace":          audit.ActionJobDeploy,
+	"JobSpecificationService/ChangeJobName":               audit.ActionJobDeploy,
+	"JobSpecificationService/RollbackJob":                 audit.ActionJobDeploy,
+	"JobSpecificationService/DeleteJobSpecification":      audit.ActionJobDelete,
+	"JobSpecificationService/DeleteJobs":                  audit.ActionJobDelete,
+	"JobSpecificationService/UpdateJobsState":             audit.ActionJobUpdateState,
+	"JobSpecificationService/SyncJobsState":               audit.ActionJobUpdateState,
+	"JobRunService/UploadToScheduler":                     audit.ActionJobUpload,
+
+	"ResourceService/DeployResourceSpecification": audit.ActionResourceDeploy,
+	"ResourceService/ApplyResources":              audit.ActionResourceDeploy,
+	"ResourceService/CreateResource":              audit.ActionResourceDeploy,
+	"ResourceService/UpdateResource":              audit.ActionResourceDeploy,
+	"ResourceService/ChangeResourceNamespace":     audit.ActionResourceDeploy,
+
+	"BackupService/CreateBackup": audit.ActionBackupCreate,
+	"ReplayService/Replay":       audit.ActionReplayCreate,
+}
+
+type auditRecorder interface {
+	Record(ctx context.Context, entry *audit.Entry) error
+}
+
+type namespaceRequest interface {
+	GetNamespaceName() string
+}
+
+// auditLog records the calls of the audited methods once they are handled, failing to record them does not fail
+// the calls. It runs after the authentication, so the principals of the calls are known as the actors.
+type auditLog struct {
+	logger   log.Logger
+	recorder auditRecorder
+}
+
+func (a auditLog) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	action, ok := auditedMethods[strings.TrimPrefix(info.FullMethod, grpcServicePrefix)]
+	if !ok {
+		return handler(ctx, req)
+	}
+
+	resp, err := handler(ctx, req)
+
+	payload := audit.NewPayloadHash()
+	writePayload(payload, req)
+	projectName, namespaceName := tenantOfRequest(req)
+	a.record(ctx, action, info.FullMethod, projectName, namespaceName, audit.PayloadDigest(payload), err)
+	return resp, err
+}
+
+func (a auditLog) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	action, ok := auditedMethods[strings.TrimPrefix(info.FullMethod, grpcServicePrefix)]
+	if !ok {
+		return handler(srv, ss)
+	}
+
+	stream := &auditedStream{ServerStream: ss, payload: audit.NewPayloadHash()}
+	err := handler(srv, stream)
+
+	a.record(ss.Context(), action, info.FullMethod, stream.projectName, stream.namespaceName, audit.PayloadDigest(stream.payload), err)
+	return err
+}
+
+func (a auditLog) record(ctx context.Context, action audit.Action, method string, projectName tenant.ProjectName,
+	namespaceName tenant.NamespaceName, payloadDigest string, callErr error,
+) {
+	entry, err := audit.NewEntry(actorOf(ctx), projectName, namespaceName, action, method, payloadDigest, callErr, time.Now().UTC())
+	if err != nil {
+		a.logger.Error("error adapting audit log of [%s]: %s", method, err)
+		return
+	}
+
+	// the call may be cancelled by the client by now, the entry is recorded regardless
+	recordCtx, cancel := context.WithTimeout(context.Background(), auditRecordTimeout)
+	defer cancel()
+	if err := a.recorder.Record(recordCtx, entry); err != nil {
+		a.logger.Error("error recording audit log of [%s]: %s", method, err)
+	}
+}
+
+// auditedStream writes every message received through the stream to the payload hash, the tenant is taken from
+// the first message carrying one
+type auditedStream struct {
+	grpc.ServerStream
+
+	payload       hash.Hash
+	projectName   tenant.ProjectName
+	namespaceName tenant.NamespaceName
+}
+
+func (s *auditedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	writePayload(s.payload, m)
+	if s.projectName == "" {
+		s.projectName, s.namespaceName = tenantOfRequest(m)
+	}
+	return nil
+}
+
+func writePayload(payload hash.Hash, req interface{}) {
+	message, ok := req.(proto.Message)
+	if !ok {
+		return
+	}
+	raw, err := proto.MarshalOptions{Deterministic: true}.Marshal(message)
+	if err != nil {
+		return
+	}
+	payload.Write(raw)
+}
+
+func tenantOfRequest(req interface{}) (tenant.ProjectName, tenant.NamespaceName) {
+	switch request := req.(type) {
+	case *pb.RegisterProjectRequest:
+		return tenant.ProjectName(request.GetProject().GetName()), ""
+	case *pb.RegisterProjectNamespaceRequest:
+		return tenant.ProjectName(request.GetProjectName()), tenant.NamespaceName(request.GetNamespace().GetName())
+	}
+
+	var projectName tenant.ProjectName
+	var namespaceName tenant.NamespaceName
+	if request, ok := req.(projectRequest); ok {
+		projectName = tenant.ProjectName(request.GetProjectName())
+	}
+	if request, ok := req.(namespaceRequest); ok {
+		namespaceName = tenant.NamespaceName(request.GetNamespaceName())
+	}
+	return projectName, namespaceName
+}
+
+// actorOf returns the principal the call is authenticated as, otherwise the actor sent by the client
+func actorOf(ctx context.Context) string {
+	if principal := auth.PrincipalFrom(ctx); principal != nil {
+		return principal.String()
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if values := md.Get(audit.ActorMetadataKey); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
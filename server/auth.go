@@ -0,0 +1,304 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/goto/salt/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/auth"
+	oHandler "github.com/goto/optimus/server/handler/v1beta1"
+)
+
+const (
+	authorizationKey = "authorization"
+	bearerPrefix     = "Bearer "
+
+	grpcServicePrefix = "/gotocompany.optimus.core.v1beta1."
+)
+
+// methodPermissions is the permission required from api tokens by the grpc methods,
+// the methods not listed here are not allowed for api tokens
+var methodPermissions = map[string]tenant.Permission{
+	"RuntimeService/Version":     tenant.PermissionRead,
+	"RuntimeService/ListPlugins": tenant.PermissionRead,
+
+	"ProjectService/GetProject":                 tenant.PermissionRead,
+	"ProjectService/ListPresets":                tenant.PermissionRead,
+	"ProjectService/SavePreset":                 tenant.PermissionDeploy,
+	"ProjectService/DeletePreset":               tenant.PermissionDeploy,
+	"NamespaceService/GetNamespace":             tenant.PermissionRead,
+	"NamespaceService/ListProjectNamespaces":    tenant.PermissionRead,
+	"NamespaceService/RegisterProjectNamespace": tenant.PermissionAdmin,
+
+	"JobSpecificationService/GetJobSpecification":         tenant.PermissionRead,
+	"JobSpecificationService/GetJobSpecifications":        tenant.PermissionRead,
+	"JobSpecificationService/ListJobSpecification":        tenant.PermissionRead,
+	"JobSpecificationService/CheckJobSpecification":       tenant.PermissionRead,
+	"JobSpecificationService/CheckJobSpecifications":      tenant.PermissionRead,
+	"JobSpecificationService/ValidateJobSpecs":            tenant.PermissionRead,
+	"JobSpecificationService/FormatJobSpecifications":     tenant.PermissionRead,
+	"JobSpecificationService/GetJobTask":                  tenant.PermissionRead,
+	"JobSpecificationService/GetWindow":                   tenant.PermissionRead,
+	"JobSpecificationService/JobInspect":                  tenant.PermissionRead,
+	"JobSpecificationService/GetDeployJobsStatus":         tenant.PermissionRead,
+	"JobSpecificationService/GetJobSpecVersions":          tenant.PermissionRead,
+	"JobSpecificationService/GetJobDeletionConsents":      tenant.PermissionRead,
+	"JobSpecificationService/DeployJobSpecification":      tenant.PermissionDeploy,
+	"JobSpecificationService/ReplaceAllJobSpecifications": tenant.PermissionDeploy,
+	"JobSpecificationService/AddJobSpecifications":        tenant.PermissionDeploy,
+	"JobSpecificationService/UpdateJobSpecifications":     tenant.PermissionDeploy,
+	"JobSpecificationService/CreateJobSpecification":      tenant.PermissionDeploy,
+	"JobSpecificationService/DeleteJobSpecification":      tenant.PermissionDeploy,
+	"JobSpecificationService/DeleteJobs":                  tenant.PermissionDeploy,
+	"JobSpecificationService/ChangeJobNamespace":          tenant.PermissionDeploy,
+	"JobSpecificationService/MoveJob":                     tenant.PermissionDeploy,
+	"JobSpecificationService/ChangeJobName":               tenant.PermissionDeploy,
+	"JobSpecificationService/AddJobDeletionConsent":       tenant.PermissionDeploy,
+	"JobSpecificationService/RollbackJob":                 tenant.PermissionDeploy,
+	"JobSpecificationService/RefreshJobs":                 tenant.PermissionDeploy,
+	"JobSpecificationService/UpdateJobsState":             tenant.PermissionDeploy,
+	"JobSpecificationService/SyncJobsState":               tenant.PermissionDeploy,
+
+	"LineageService/GetLineage":       tenant.PermissionRead,
+	"LineageService/GetColumnLineage": tenant.PermissionRead,
+
+	"ResourceService/ListResourceSpecification":   tenant.PermissionRead,
+	"ResourceService/ReadResource":                tenant.PermissionRead,
+	"ResourceService/DeployResourceSpecification": tenant.PermissionDeploy,
+	"ResourceService/ApplyResources":              tenant.PermissionDeploy,
+	"ResourceService/CreateResource":              tenant.PermissionDeploy,
+	"ResourceService/UpdateResource":              tenant.PermissionDeploy,
+	"ResourceService/ChangeResourceNamespace":     tenant.PermissionDeploy,
+
+	"JobRunService/JobRun":                    tenant.PermissionRead,
+	"JobRunService/GetInterval":               tenant.PermissionRead,
+	"JobRunService/GetSchedulerHealth":        tenant.PermissionRead,
+	"JobRunService/GetJobRunDetail":           tenant.PermissionRead,
+	"JobRunService/GetScheduleRecommendation": tenant.PermissionRead,
+	"JobRunService/GetJobRunCriticalPath":     tenant.PermissionRead,
+	"JobRunService/EstimateJobRunStart":       tenant.PermissionRead,
+	"JobRunService/QueryJobRuns":              tenant.PermissionRead,
+	"JobRunService/GetUploadProgress":         tenant.PermissionRead,
+	"JobRunService/UploadToScheduler":         tenant.PermissionDeploy,
+	"JobRunService/JobRunHeartbeat":           tenant.PermissionDeploy,
+	"JobRunService/JobRunInput":               tenant.PermissionDeploy,
+	"JobRunService/RegisterJobEvent":          tenant.PermissionDeploy,
+	"JobRunService/EncryptedJobRunInput":      tenant.PermissionDeploy,
+	"JobRunService/TriggerJobRun":             tenant.PermissionReplay,
+	"JobRunService/CompileExecutorInputAt":    tenant.PermissionAdmin,
+
+	"BackupService/GetBackup":    tenant.PermissionRead,
+	"BackupService/ListBackups":  tenant.PermissionRead,
+	"BackupService/CreateBackup": tenant.PermissionDeploy,
+
+	"ReplayService/GetReplay":          tenant.PermissionRead,
+	"ReplayService/GetReplayDetails":   tenant.PermissionRead,
+	"ReplayService/ListReplay":         tenant.PermissionRead,
+	"ReplayService/StreamReplayStatus": tenant.PermissionRead,
+	"ReplayService/Replay":             tenant.PermissionReplay,
+	"ReplayService/ReplayDryRun":       tenant.PermissionReplay,
+
+	"AuditLogService/Query": tenant.PermissionAdmin,
+
+	"UpstreamAccessService/ListUpstreamAccessRequests": tenant.PermissionAdmin,
+	"UpstreamAccessService/ApproveUpstreamAccess":      tenant.PermissionAdmin,
+	"UpstreamAccessService/DenyUpstreamAccess":         tenant.PermissionAdmin,
+
+	"SnippetService/RegisterSnippet": tenant.PermissionDeploy,
+	"SnippetService/ListSnippets":    tenant.PermissionRead,
+
+	"ScheduleGroupService/RegisterScheduleGroup": tenant.PermissionDeploy,
+	"ScheduleGroupService/ListScheduleGroups":    tenant.PermissionRead,
+	"ScheduleGroupService/PlanScheduleGroup":     tenant.PermissionRead,
+	"ScheduleGroupService/ApplyScheduleGroup":    tenant.PermissionDeploy,
+
+	"AlertSilenceService/CreateAlertSilence": tenant.PermissionDeploy,
+	"AlertSilenceService/ListAlertSilences":  tenant.PermissionRead,
+	"AlertSilenceService/ExpireAlertSilence": tenant.PermissionDeploy,
+	"AlertSilenceService/GetHealthSummary":   tenant.PermissionRead,
+
+	"RunSnapshotService/ListRunSnapshots": tenant.PermissionAdmin,
+	"RunSnapshotService/GetRunSnapshot":   tenant.PermissionAdmin,
+
+	"WebhookSubscriptionService/CreateWebhookSubscription": tenant.PermissionDeploy,
+	"WebhookSubscriptionService/UpdateWebhookSubscription": tenant.PermissionDeploy,
+	"WebhookSubscriptionService/ListWebhookSubscriptions":  tenant.PermissionRead,
+	"WebhookSubscriptionService/DeleteWebhookSubscription": tenant.PermissionDeploy,
+	"WebhookSubscriptionService/ListWebhookDeliveries":     tenant.PermissionRead,
+
+	"ArchiveService/ArchiveProject":     tenant.PermissionAdmin,
+	"ArchiveService/UnarchiveProject":   tenant.PermissionAdmin,
+	"ArchiveService/ArchiveNamespace":   tenant.PermissionAdmin,
+	"ArchiveService/UnarchiveNamespace": tenant.PermissionAdmin,
+}
+
+type apiTokenAuthenticator interface {
+	Authenticate(ctx context.Context, token string) (*tenant.APIToken, error)
+}
+
+type oidcVerifier interface {
+	Verify(ctx context.Context, rawToken string) (*auth.Principal, error)
+}
+
+type projectRequest interface {
+	GetProjectName() string
+}
+
+// tokenAuth authenticates the requests with api tokens, and with the id tokens of the OIDC provider when it is set,
+// putting the principal of the request into its context. The scope of the api tokens is enforced on the requests
+// authenticated with them. The requests without credentials are left to be authenticated in front of the server,
+// unless the authentication is required.
+type tokenAuth struct {
+	logger        log.Logger
+	authenticator apiTokenAuthenticator
+	oidc          oidcVerifier
+	required      bool
+}
+
+// authenticate returns the principal of the request, nil when the request has no credentials known to the server
+func (a tokenAuth) authenticate(ctx context.Context, authorization string) (*auth.Principal, error) {
+	token := strings.TrimPrefix(authorization, bearerPrefix)
+	switch {
+	case strings.HasPrefix(token, tenant.APITokenPrefix):
+		apiToken, err := a.authenticator.Authenticate(ctx, token)
+		if err != nil {
+			a.logger.Warn("unable to authenticate api token: %s", err)
+			return nil, status.Error(codes.Unauthenticated, "invalid api token")
+		}
+		return &auth.Principal{Type: auth.PrincipalAPIToken, Name: apiToken.Name(), APIToken: apiToken}, nil
+	case a.oidc != nil && strings.HasPrefix(authorization, bearerPrefix):
+		principal, err := a.oidc.Verify(ctx, token)
+		if err != nil {
+			a.logger.Warn("unable to authenticate oidc token: %s", err)
+			return nil, status.Error(codes.Unauthenticated, "invalid oidc token")
+		}
+		return principal, nil
+	}
+
+	if a.required {
+		return nil, status.Error(codes.Unauthenticated, "authentication is required")
+	}
+	return nil, nil //nolint:nilnil
+}
+
+func (a tokenAuth) authenticateGRPC(ctx context.Context, fullMethod string) (*auth.Principal, error) {
+	// the health checks and the reflection are served without authentication
+	if !strings.HasPrefix(fullMethod, grpcServicePrefix) {
+		return nil, nil //nolint:nilnil
+	}
+
+	var authorization string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(authorizationKey); len(values) > 0 {
+			authorization = values[0]
+		}
+	}
+
+	principal, err := a.authenticate(ctx, authorization)
+	if err != nil || principal == nil || principal.APIToken == nil {
+		return principal, err
+	}
+
+	apiToken := principal.APIToken
+	permission, ok := methodPermissions[strings.TrimPrefix(fullMethod, grpcServicePrefix)]
+	if !ok || !apiToken.Scope().Allows(permission) {
+		return nil, status.Errorf(codes.PermissionDenied, "api token %s with scope %s is not allowed to call %s",
+			apiToken.Name(), apiToken.Scope(), fullMethod)
+	}
+	return principal, nil
+}
+
+func checkProject(principal *auth.Principal, req interface{}) error {
+	if principal == nil || principal.APIToken == nil {
+		return nil
+	}
+	request, ok := req.(projectRequest)
+	if !ok || request.GetProjectName() == principal.APIToken.ProjectName().String() {
+		return nil
+	}
+	return status.Errorf(codes.PermissionDenied, "api token %s is not allowed to access project %s",
+		principal.Name, request.GetProjectName())
+}
+
+func (a tokenAuth) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	principal, err := a.authenticateGRPC(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	if principal != nil {
+		if err := checkProject(principal, req); err != nil {
+			return nil, err
+		}
+		ctx = auth.WithPrincipal(ctx, principal)
+	}
+	return handler(ctx, req)
+}
+
+func (a tokenAuth) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	principal, err := a.authenticateGRPC(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	if principal != nil {
+		ss = &authenticatedStream{ServerStream: ss, ctx: auth.WithPrincipal(ss.Context(), principal), principal: principal}
+	}
+	return handler(srv, ss)
+}
+
+// authenticatedStream carries the principal in its context, and checks the project of every message received
+// through the stream
+type authenticatedStream struct {
+	grpc.ServerStream
+
+	ctx       context.Context
+	principal *auth.Principal
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *authenticatedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return checkProject(s.principal, m)
+}
+
+// httpMiddleware puts the principal into the context of the requests. The requests with api tokens are limited to
+// reading the project of the token, the admin endpoints are never allowed for api tokens
+func (a tokenAuth) httpMiddleware(pattern string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal, err := a.authenticate(r.Context(), r.Header.Get(authorizationKey))
+		if err != nil {
+			// the readiness is probed without credentials
+			if pattern == oHandler.ReadinessPath {
+				next.ServeHTTP(w, r)
+				return
+			}
+			http.Error(w, status.Convert(err).Message(), http.StatusUnauthorized)
+			return
+		}
+		if principal == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if apiToken := principal.APIToken; apiToken != nil {
+			allowed := r.Method == http.MethodGet && !strings.Contains(pattern, "/admin/") &&
+				apiToken.Allows(tenant.ProjectName(r.URL.Query().Get("project_name")), tenant.PermissionRead)
+			if !allowed {
+				http.Error(w, "api token "+apiToken.Name()+" is not allowed to access "+r.URL.Path, http.StatusForbidden)
+				return
+			}
+		}
+		next.ServeHTTP(w, r.WithContext(auth.WithPrincipal(r.Context(), principal)))
+	})
+}
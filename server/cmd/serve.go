@@ -50,7 +50,9 @@ func (s *serveCommand) RunE(_ *cobra.Command, _ []string) error {
 	}
 
 	optimusServer, err := server.New(conf)
-	defer optimusServer.Shutdown()
+	if optimusServer != nil {
+		defer optimusServer.Shutdown()
+	}
 	if err != nil {
 		return fmt.Errorf("unable to create server: %w", err)
 	}
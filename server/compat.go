@@ -0,0 +1,76 @@
+package server
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/goto/optimus/config"
+	"github.com/goto/optimus/internal/telemetry"
+)
+
+const (
+	legacyGRPCServicePrefix = "/odpf.optimus.core.v1beta1."
+	legacyHTTPPathPrefix    = "/api/v1/"
+	httpPathPrefix          = "/api/v1beta1/"
+
+	legacyClientWarning = `299 - "legacy optimus client protocol is deprecated, upgrade the client"`
+
+	metricLegacyClientRequests = "server_legacy_client_requests_total"
+)
+
+// legacyCompat translates the requests of the clients still speaking the legacy protocol to the current one,
+// so the server can be upgraded ahead of the clients. The services and messages are unchanged between the
+// protocols, only the grpc package and the http path prefix are renamed.
+type legacyCompat struct {
+	sunset string
+}
+
+func newLegacyCompat(conf config.LegacyClientConfig) *legacyCompat {
+	if !conf.Enabled {
+		return nil
+	}
+	return &legacyCompat{sunset: conf.Sunset}
+}
+
+// wrap serves the legacy requests through next as current ones, the responses are marked deprecated
+func (c *legacyCompat) wrap(next http.Handler) http.Handler {
+	if c == nil {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case isGRPCRequest(r) && strings.HasPrefix(r.URL.Path, legacyGRPCServicePrefix):
+			method := strings.TrimPrefix(r.URL.Path, legacyGRPCServicePrefix)
+			c.translate(w, r, legacyGRPCServicePrefix, grpcServicePrefix)
+			c.track("grpc", method)
+		case strings.HasPrefix(r.URL.Path, legacyHTTPPathPrefix):
+			c.translate(w, r, legacyHTTPPathPrefix, httpPathPrefix)
+			c.track("http", r.Method)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (c *legacyCompat) translate(w http.ResponseWriter, r *http.Request, legacyPrefix, prefix string) {
+	r.URL.Path = prefix + strings.TrimPrefix(r.URL.Path, legacyPrefix)
+	if r.URL.RawPath != "" {
+		r.URL.RawPath = prefix + strings.TrimPrefix(r.URL.RawPath, legacyPrefix)
+	}
+
+	w.Header().Set("Deprecation", "true")
+	w.Header().Set("Warning", legacyClientWarning)
+	if c.sunset != "" {
+		w.Header().Set("Sunset", c.sunset)
+	}
+}
+
+func (*legacyCompat) track(protocol, method string) {
+	telemetry.NewCounter(metricLegacyClientRequests, map[string]string{
+		"protocol": protocol,
+		"method":   method,
+	}).Inc()
+}
+
+func isGRPCRequest(r *http.Request) bool {
+	return r.ProtoMajor == 2 && strings.Contains(r.Header.Get("Content-Type"), "application/grpc")
+}
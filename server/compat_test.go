@@ -0,0 +1,126 @@
+package server_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/goto/optimus/config"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+	"github.com/goto/optimus/server"
+)
+
+func TestLegacyCompat(t *testing.T) {
+	ctx := context.Background()
+	legacyGetProject := "/odpf.optimus.core.v1beta1.ProjectService/GetProject"
+
+	serve := func(t *testing.T, conf config.LegacyClientConfig) *httptest.Server {
+		t.Helper()
+
+		grpcServer := grpc.NewServer()
+		pb.RegisterProjectServiceServer(grpcServer, &projectServer{})
+
+		gwmux := runtime.NewServeMux()
+		assert.NoError(t, pb.RegisterProjectServiceHandlerServer(ctx, gwmux, &projectServer{}))
+		baseMux := http.NewServeMux()
+		baseMux.Handle("/api/", http.StripPrefix("/api", gwmux))
+
+		srv := httptest.NewServer(server.NewTestGRPCHandler(grpcServer, baseMux, conf))
+		t.Cleanup(srv.Close)
+		return srv
+	}
+	dial := func(t *testing.T, srv *httptest.Server) *grpc.ClientConn {
+		t.Helper()
+
+		conn, err := grpc.DialContext(ctx, srv.Listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+		assert.NoError(t, err)
+		t.Cleanup(func() { conn.Close() })
+		return conn
+	}
+
+	t.Run("serves legacy grpc calls with the current messages and marks them deprecated", func(t *testing.T) {
+		srv := serve(t, config.LegacyClientConfig{Enabled: true, Sunset: "Sat, 01 Jun 2024 00:00:00 GMT"})
+		conn := dial(t, srv)
+
+		var header metadata.MD
+		resp := &pb.GetProjectResponse{}
+		err := conn.Invoke(ctx, legacyGetProject, &pb.GetProjectRequest{ProjectName: "sample-project"}, resp, grpc.Header(&header))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "sample-project", resp.GetProject().GetName())
+		assert.Equal(t, "bar", resp.GetProject().GetConfig()["foo"])
+		assert.Equal(t, []string{"true"}, header.Get("deprecation"))
+		assert.Equal(t, []string{"Sat, 01 Jun 2024 00:00:00 GMT"}, header.Get("sunset"))
+	})
+	t.Run("serves current grpc calls without marking them deprecated", func(t *testing.T) {
+		srv := serve(t, config.LegacyClientConfig{Enabled: true})
+		conn := dial(t, srv)
+
+		var header metadata.MD
+		resp, err := pb.NewProjectServiceClient(conn).GetProject(ctx, &pb.GetProjectRequest{ProjectName: "sample-project"}, grpc.Header(&header))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "sample-project", resp.GetProject().GetName())
+		assert.Empty(t, header.Get("deprecation"))
+	})
+	t.Run("rejects legacy grpc calls when compatibility is disabled", func(t *testing.T) {
+		srv := serve(t, config.LegacyClientConfig{})
+		conn := dial(t, srv)
+
+		err := conn.Invoke(ctx, legacyGetProject, &pb.GetProjectRequest{ProjectName: "sample-project"}, &pb.GetProjectResponse{})
+
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+	t.Run("serves legacy http calls with the current messages and marks them deprecated", func(t *testing.T) {
+		srv := serve(t, config.LegacyClientConfig{Enabled: true})
+
+		resp, err := http.Get(srv.URL + "/api/v1/project/sample-project")
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		var body struct {
+			Project struct {
+				Name   string            `json:"name"`
+				Config map[string]string `json:"config"`
+			} `json:"project"`
+		}
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+		assert.Equal(t, "sample-project", body.Project.Name)
+		assert.Equal(t, "bar", body.Project.Config["foo"])
+		assert.Equal(t, "true", resp.Header.Get("Deprecation"))
+		assert.Empty(t, resp.Header.Get("Sunset"))
+	})
+	t.Run("does not serve legacy http calls when compatibility is disabled", func(t *testing.T) {
+		srv := serve(t, config.LegacyClientConfig{})
+
+		resp, err := http.Get(srv.URL + "/api/v1/project/sample-project")
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
+
+type projectServer struct {
+	pb.UnimplementedProjectServiceServer
+}
+
+func (*projectServer) GetProject(_ context.Context, req *pb.GetProjectRequest) (*pb.GetProjectResponse, error) {
+	return &pb.GetProjectResponse{
+		Project: &pb.ProjectSpecification{
+			Name:   req.GetProjectName(),
+			Config: map[string]string{"foo": "bar"},
+		},
+	}, nil
+}
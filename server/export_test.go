@@ -0,0 +1,53 @@
+package server
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/goto/salt/log"
+	"google.golang.org/grpc"
+
+	"github.com/goto/optimus/config"
+)
+
+func NewTestRateLimitInterceptor(conf config.RateLimitConfig) (grpc.UnaryServerInterceptor, error) {
+	limit, err := newRateLimit(conf)
+	if err != nil {
+		return nil, err
+	}
+	return limit.unaryInterceptor, nil
+}
+
+// NewTestGatewayRateLimit returns the rate limit interceptor, with the dialer the http gateway connects through
+func NewTestGatewayRateLimit(conf config.RateLimitConfig) (grpc.UnaryServerInterceptor, func(context.Context, string) (net.Conn, error), error) {
+	limit, err := newRateLimit(conf)
+	if err != nil {
+		return nil, nil, err
+	}
+	return limit.unaryInterceptor, limit.gateway.dial, nil
+}
+
+// NewTestClientQuota returns the check and the count of the calls of a client within the quota of a group
+func NewTestClientQuota(quota int, window time.Duration) (func(string, time.Time) (bool, time.Duration), func(string, time.Time)) {
+	q := newClientQuota(quota, window)
+	return q.available, q.take
+}
+
+func NewTestGRPCHandler(grpcServer *grpc.Server, otherHandler http.Handler, conf config.LegacyClientConfig) http.Handler {
+	return grpcHandlerFunc(grpcServer, otherHandler, newLegacyCompat(conf))
+}
+
+type (
+	RoleAuthorizer = roleAuthorizer
+	ProjectGetter  = projectGetter
+)
+
+func NewTestRoleAccessInterceptor(authorizer RoleAuthorizer, enabled bool) grpc.UnaryServerInterceptor {
+	return roleAccess{logger: log.NewNoop(), authorizer: authorizer, enabled: enabled}.unaryInterceptor
+}
+
+func NewTestRoleAccessInterceptorWithProjects(authorizer RoleAuthorizer, projects ProjectGetter, enabled bool) grpc.UnaryServerInterceptor {
+	return roleAccess{logger: log.NewNoop(), authorizer: authorizer, projects: projects, enabled: enabled}.unaryInterceptor
+}
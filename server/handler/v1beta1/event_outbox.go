@@ -0,0 +1,54 @@
+package v1beta1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/goto/salt/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+type OutboxRedriver interface {
+	Redrive(ctx context.Context, ids []uuid.UUID) (int64, error)
+}
+
+type EventOutboxHandler struct {
+	l        log.Logger
+	redriver OutboxRedriver
+}
+
+// RedriveEvents moves the dead lettered events of the outbox back to pending, the events with the given ids or all
+// of them when no id is given, to be published again
+func (h EventOutboxHandler) RedriveEvents(ctx context.Context, req *pb.RedriveEventsRequest) (*pb.RedriveEventsResponse, error) {
+	if h.redriver == nil {
+		return nil, status.Error(codes.FailedPrecondition, "event outbox is not enabled")
+	}
+
+	ids := make([]uuid.UUID, len(req.GetIds()))
+	for i, rawID := range req.GetIds() {
+		id, err := uuid.Parse(rawID)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid event id "+rawID)
+		}
+		ids[i] = id
+	}
+
+	count, err := h.redriver.Redrive(ctx, ids)
+	if err != nil {
+		h.l.Error("error re-driving dead lettered events: %s", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	h.l.Info("%d dead lettered events are re-driven", count)
+	return &pb.RedriveEventsResponse{Redriven: count}, nil
+}
+
+// NewEventOutboxHandler creates the handler of the event outbox, the redriver is nil when the outbox is not enabled
+func NewEventOutboxHandler(l log.Logger, redriver OutboxRedriver) *EventOutboxHandler {
+	return &EventOutboxHandler{
+		l:        l,
+		redriver: redriver,
+	}
+}
@@ -0,0 +1,64 @@
+package v1beta1_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+	v1 "github.com/goto/optimus/server/handler/v1beta1"
+)
+
+func TestEventOutboxHandler(t *testing.T) {
+	ctx := context.Background()
+	logger := log.NewNoop()
+
+	t.Run("re-drives the dead lettered events with the ids", func(t *testing.T) {
+		id := uuid.New()
+		redriver := &outboxRedriver{count: 1}
+		handler := v1.NewEventOutboxHandler(logger, redriver)
+
+		resp, err := handler.RedriveEvents(ctx, &pb.RedriveEventsRequest{Ids: []string{id.String()}})
+
+		assert.NoError(t, err)
+		assert.EqualValues(t, 1, resp.GetRedriven())
+		assert.Equal(t, []uuid.UUID{id}, redriver.ids)
+	})
+	t.Run("re-drives all dead lettered events when no id is given", func(t *testing.T) {
+		redriver := &outboxRedriver{count: 3}
+		handler := v1.NewEventOutboxHandler(logger, redriver)
+
+		resp, err := handler.RedriveEvents(ctx, &pb.RedriveEventsRequest{})
+
+		assert.NoError(t, err)
+		assert.EqualValues(t, 3, resp.GetRedriven())
+		assert.Empty(t, redriver.ids)
+	})
+	t.Run("returns error when an id is invalid", func(t *testing.T) {
+		handler := v1.NewEventOutboxHandler(logger, &outboxRedriver{})
+
+		_, err := handler.RedriveEvents(ctx, &pb.RedriveEventsRequest{Ids: []string{"invalid"}})
+
+		assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid event id invalid")
+	})
+	t.Run("returns error when the event outbox is not enabled", func(t *testing.T) {
+		handler := v1.NewEventOutboxHandler(logger, nil)
+
+		_, err := handler.RedriveEvents(ctx, &pb.RedriveEventsRequest{})
+
+		assert.EqualError(t, err, "rpc error: code = FailedPrecondition desc = event outbox is not enabled")
+	})
+}
+
+type outboxRedriver struct {
+	ids   []uuid.UUID
+	count int64
+}
+
+func (o *outboxRedriver) Redrive(_ context.Context, ids []uuid.UUID) (int64, error) {
+	o.ids = ids
+	return o.count, nil
+}
@@ -0,0 +1,64 @@
+package v1beta1
+
+import (
+	"context"
+
+	"github.com/goto/salt/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/goto/optimus/internal/errors"
+	"github.com/goto/optimus/internal/logging"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+)
+
+type LogLevelRegistry interface {
+	Set(projectName, namespaceName, level string) error
+	Unset(projectName, namespaceName string)
+	GetAll() []logging.NamespaceLevel
+}
+
+// LogLevelHandler serves the log levels overridden at runtime for namespaces, falling back to the level of the server
+type LogLevelHandler struct {
+	l        log.Logger
+	registry LogLevelRegistry
+}
+
+func (h LogLevelHandler) ListLogLevels(_ context.Context, _ *pb.ListLogLevelsRequest) (*pb.ListLogLevelsResponse, error) {
+	levels := h.registry.GetAll()
+	namespaces := make([]*pb.NamespaceLogLevel, len(levels))
+	for i, level := range levels {
+		namespaces[i] = &pb.NamespaceLogLevel{
+			ProjectName:   level.ProjectName,
+			NamespaceName: level.NamespaceName,
+			Level:         level.Level,
+		}
+	}
+	return &pb.ListLogLevelsResponse{Default: h.l.Level(), Namespaces: namespaces}, nil
+}
+
+func (h LogLevelHandler) SetLogLevel(_ context.Context, req *pb.SetLogLevelRequest) (*pb.SetLogLevelResponse, error) {
+	if err := h.registry.Set(req.GetProjectName(), req.GetNamespaceName(), req.GetLevel()); err != nil {
+		h.l.Error("error setting log level of namespace [%s]: %s", req.GetNamespaceName(), err)
+		return nil, errors.GRPCErr(err, "unable to set log level of namespace "+req.GetNamespaceName())
+	}
+	h.l.Info("log level of namespace [%s/%s] is set to %s", req.GetProjectName(), req.GetNamespaceName(), req.GetLevel())
+	return &pb.SetLogLevelResponse{}, nil
+}
+
+func (h LogLevelHandler) UnsetLogLevel(_ context.Context, req *pb.UnsetLogLevelRequest) (*pb.UnsetLogLevelResponse, error) {
+	if req.GetProjectName() == "" || req.GetNamespaceName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "project_name and namespace_name are required")
+	}
+
+	h.registry.Unset(req.GetProjectName(), req.GetNamespaceName())
+	h.l.Info("log level of namespace [%s/%s] is reset", req.GetProjectName(), req.GetNamespaceName())
+	return &pb.UnsetLogLevelResponse{}, nil
+}
+
+func NewLogLevelHandler(l log.Logger, registry LogLevelRegistry) *LogLevelHandler {
+	return &LogLevelHandler{
+		l:        l,
+		registry: registry,
+	}
+}
@@ -0,0 +1,68 @@
+package v1beta1_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/internal/logging"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+	v1 "github.com/goto/optimus/server/handler/v1beta1"
+)
+
+func TestLogLevelHandler(t *testing.T) {
+	ctx := context.Background()
+	logger := log.NewNoop()
+
+	t.Run("sets the level of namespace", func(t *testing.T) {
+		levels := logging.NewLevelRegistry()
+		handler := v1.NewLogLevelHandler(logger, levels)
+
+		_, err := handler.SetLogLevel(ctx, &pb.SetLogLevelRequest{ProjectName: "proj1", NamespaceName: "ns1", Level: "debug"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []logging.NamespaceLevel{{ProjectName: "proj1", NamespaceName: "ns1", Level: "debug"}}, levels.GetAll())
+	})
+	t.Run("returns invalid argument when level is invalid", func(t *testing.T) {
+		levels := logging.NewLevelRegistry()
+		handler := v1.NewLogLevelHandler(logger, levels)
+
+		_, err := handler.SetLogLevel(ctx, &pb.SetLogLevelRequest{ProjectName: "proj1", NamespaceName: "ns1", Level: "loud"})
+
+		assert.ErrorContains(t, err, "code = InvalidArgument")
+		assert.ErrorContains(t, err, "invalid log level loud")
+		assert.Empty(t, levels.GetAll())
+	})
+	t.Run("lists the levels of namespaces", func(t *testing.T) {
+		levels := logging.NewLevelRegistry()
+		assert.NoError(t, levels.Set("proj1", "ns1", "debug"))
+		handler := v1.NewLogLevelHandler(logger, levels)
+
+		resp, err := handler.ListLogLevels(ctx, &pb.ListLogLevelsRequest{})
+
+		assert.NoError(t, err)
+		assert.Len(t, resp.GetNamespaces(), 1)
+		assert.Equal(t, "proj1", resp.GetNamespaces()[0].GetProjectName())
+		assert.Equal(t, "ns1", resp.GetNamespaces()[0].GetNamespaceName())
+		assert.Equal(t, "debug", resp.GetNamespaces()[0].GetLevel())
+	})
+	t.Run("resets the level of namespace", func(t *testing.T) {
+		levels := logging.NewLevelRegistry()
+		assert.NoError(t, levels.Set("proj1", "ns1", "debug"))
+		handler := v1.NewLogLevelHandler(logger, levels)
+
+		_, err := handler.UnsetLogLevel(ctx, &pb.UnsetLogLevelRequest{ProjectName: "proj1", NamespaceName: "ns1"})
+
+		assert.NoError(t, err)
+		assert.Empty(t, levels.GetAll())
+	})
+	t.Run("returns invalid argument when resetting without the namespace", func(t *testing.T) {
+		handler := v1.NewLogLevelHandler(logger, logging.NewLevelRegistry())
+
+		_, err := handler.UnsetLogLevel(ctx, &pb.UnsetLogLevelRequest{ProjectName: "proj1"})
+
+		assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = project_name and namespace_name are required")
+	})
+}
@@ -0,0 +1,113 @@
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/goto/salt/log"
+
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+	"github.com/goto/optimus/sdk/plugin"
+)
+
+const (
+	pluginProbeTimeout = 5 * time.Second
+
+	pluginHealthy = "ok"
+)
+
+type PluginRegistry interface {
+	GetAll() []*plugin.Plugin
+}
+
+type PluginHandler struct {
+	l        log.Logger
+	registry PluginRegistry
+}
+
+// ListPlugins lists the plugins registered in the server with their capabilities and the result of a health probe,
+// to verify a plugin rollout across servers
+func (h PluginHandler) ListPlugins(ctx context.Context, _ *pb.ListPluginsRequest) (*pb.ListPluginsResponse, error) {
+	plugins := h.registry.GetAll()
+	response := make([]*pb.ListPluginsResponse_Plugin, 0, len(plugins))
+	for _, p := range plugins {
+		info := p.Info()
+		if info == nil {
+			continue
+		}
+		status := &pb.ListPluginsResponse_Plugin{
+			Name:             info.Name,
+			Type:             info.PluginType.String(),
+			Version:          info.PluginVersion,
+			HasYamlMod:       p.YamlMod != nil,
+			HasDependencyMod: p.DependencyMod != nil,
+			AssetTypes:       assetTypes(ctx, p),
+			Health:           pluginHealthy,
+		}
+		if err := probe(ctx, p); err != nil {
+			h.l.Warn("plugin [%s] is unhealthy: %s", info.Name, err)
+			status.Health = err.Error()
+		}
+		response = append(response, status)
+	}
+	return &pb.ListPluginsResponse{Plugins: response}, nil
+}
+
+// assetTypes are the file extensions of the default assets of the plugin
+func assetTypes(ctx context.Context, p *plugin.Plugin) []string {
+	if p.YamlMod == nil {
+		return []string{}
+	}
+	assets, err := p.YamlMod.DefaultAssets(ctx, plugin.DefaultAssetsRequest{Options: plugin.Options{DryRun: true}})
+	if err != nil || assets == nil {
+		return []string{}
+	}
+
+	seen := map[string]bool{}
+	types := []string{}
+	for _, asset := range assets.Assets {
+		assetType := filepath.Ext(asset.Name)
+		if assetType == "" {
+			assetType = asset.Name
+		}
+		if !seen[assetType] {
+			seen[assetType] = true
+			types = append(types, assetType)
+		}
+	}
+	sort.Strings(types)
+	return types
+}
+
+// probe checks the plugin spec, and that the dependency mod process is responding as the plugin
+func probe(ctx context.Context, p *plugin.Plugin) error {
+	info := p.Info()
+	if err := info.Validate(); err != nil {
+		return err
+	}
+	if p.DependencyMod == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, pluginProbeTimeout)
+	defer cancel()
+
+	name, err := p.DependencyMod.GetName(ctx)
+	if err != nil {
+		return fmt.Errorf("dependency mod is not responding: %w", err)
+	}
+	if name != info.Name {
+		return fmt.Errorf("dependency mod responds as plugin %s", name)
+	}
+	return nil
+}
+
+func NewPluginHandler(l log.Logger, registry PluginRegistry) *PluginHandler {
+	return &PluginHandler{
+		l:        l,
+		registry: registry,
+	}
+}
@@ -0,0 +1,76 @@
+package v1beta1_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+	"github.com/goto/optimus/sdk/plugin"
+	mockPlugin "github.com/goto/optimus/sdk/plugin/mock"
+	v1 "github.com/goto/optimus/server/handler/v1beta1"
+)
+
+func TestPluginHandler(t *testing.T) {
+	logger := log.NewNoop()
+	ctx := context.Background()
+	bq2bqInfo := &plugin.Info{
+		Name:          "bq2bq",
+		PluginType:    plugin.TypeTask,
+		PluginVersion: "0.3.2",
+		Image:         "goto/bq2bq:0.3.2",
+		Entrypoint:    plugin.Entrypoint{Script: "python3 /opt/bumblebee/main.py"},
+	}
+
+	t.Run("lists the plugins with their capabilities and health", func(t *testing.T) {
+		yamlMod := new(mockPlugin.YamlMod)
+		yamlMod.On("PluginInfo").Return(bq2bqInfo)
+		yamlMod.On("DefaultAssets", mock.Anything, mock.Anything).Return(&plugin.DefaultAssetsResponse{
+			Assets: plugin.Assets{{Name: "query.sql"}, {Name: "pre.sql"}, {Name: "schema.json"}},
+		}, nil)
+		dependencyMod := new(mockPlugin.DependencyResolverMod)
+		dependencyMod.On("GetName", mock.Anything).Return("bq2bq", nil)
+
+		handler := v1.NewPluginHandler(logger, &pluginRegistry{plugins: []*plugin.Plugin{
+			{YamlMod: yamlMod, DependencyMod: dependencyMod},
+		}})
+
+		resp, err := handler.ListPlugins(ctx, &pb.ListPluginsRequest{})
+		assert.NoError(t, err)
+		assert.Len(t, resp.GetPlugins(), 1)
+		assert.Equal(t, "bq2bq", resp.GetPlugins()[0].GetName())
+		assert.Equal(t, "task", resp.GetPlugins()[0].GetType())
+		assert.Equal(t, "0.3.2", resp.GetPlugins()[0].GetVersion())
+		assert.True(t, resp.GetPlugins()[0].GetHasYamlMod())
+		assert.True(t, resp.GetPlugins()[0].GetHasDependencyMod())
+		assert.Equal(t, []string{".json", ".sql"}, resp.GetPlugins()[0].GetAssetTypes())
+		assert.Equal(t, "ok", resp.GetPlugins()[0].GetHealth())
+	})
+	t.Run("reports the plugin unhealthy when its dependency mod is not responding", func(t *testing.T) {
+		yamlMod := new(mockPlugin.YamlMod)
+		yamlMod.On("PluginInfo").Return(bq2bqInfo)
+		yamlMod.On("DefaultAssets", mock.Anything, mock.Anything).Return(&plugin.DefaultAssetsResponse{}, nil)
+		dependencyMod := new(mockPlugin.DependencyResolverMod)
+		dependencyMod.On("GetName", mock.Anything).Return("", errors.New("connection is shut down"))
+
+		handler := v1.NewPluginHandler(logger, &pluginRegistry{plugins: []*plugin.Plugin{
+			{YamlMod: yamlMod, DependencyMod: dependencyMod},
+		}})
+
+		resp, err := handler.ListPlugins(ctx, &pb.ListPluginsRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, "dependency mod is not responding: connection is shut down", resp.GetPlugins()[0].GetHealth())
+	})
+}
+
+type pluginRegistry struct {
+	plugins []*plugin.Plugin
+}
+
+func (r *pluginRegistry) GetAll() []*plugin.Plugin {
+	return r.plugins
+}
@@ -0,0 +1,120 @@
+package v1beta1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/goto/salt/log"
+)
+
+// ReadinessPath reports whether the server is ready to serve requests, it responds with 503 until the warm up
+// at startup is finished, when any of the readiness checks fails or once the server is shutting down
+const ReadinessPath = "/ready"
+
+const readinessCheckTimeout = 5 * time.Second
+
+// ReadinessCheck is run on every readiness probe, the server is not ready when the check returns error
+type ReadinessCheck struct {
+	Name  string
+	Check func(ctx context.Context) error
+}
+
+type ReadinessHandler struct {
+	l      log.Logger
+	checks []ReadinessCheck
+
+	mu        sync.RWMutex
+	warmedUp  bool
+	warmUpErr error
+	draining  bool
+}
+
+type readinessResponse struct {
+	Ready  bool              `json:"ready"`
+	Checks map[string]string `json:"checks"`
+}
+
+// SetWarmUpResult records the result of the latest warm up attempt, the server is warmed up once it succeeds
+func (h *ReadinessHandler) SetWarmUpResult(err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.warmUpErr = err
+	if err == nil {
+		h.warmedUp = true
+	}
+}
+
+// SetDraining reports the server not ready from now on, as it is shutting down
+func (h *ReadinessHandler) SetDraining() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.draining = true
+}
+
+func (h *ReadinessHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	response := readinessResponse{
+		Ready:  true,
+		Checks: map[string]string{"warm_up": "ok"},
+	}
+
+	h.mu.RLock()
+	warmedUp, warmUpErr, draining := h.warmedUp, h.warmUpErr, h.draining
+	h.mu.RUnlock()
+	if draining {
+		response.Ready = false
+		response.Checks["shutdown"] = "draining"
+	}
+	if !warmedUp {
+		response.Ready = false
+		response.Checks["warm_up"] = "in progress"
+		if warmUpErr != nil {
+			response.Checks["warm_up"] = warmUpErr.Error()
+		}
+	}
+
+	for _, check := range h.checks {
+		if err := runReadinessCheck(r.Context(), check); err != nil {
+			h.l.Warn("readiness check [%s] fails: %s", check.Name, err)
+			response.Ready = false
+			response.Checks[check.Name] = err.Error()
+			continue
+		}
+		response.Checks[check.Name] = "ok"
+	}
+
+	status := http.StatusOK
+	if !response.Ready {
+		status = http.StatusServiceUnavailable
+	}
+	writeJSON(w, status, response)
+}
+
+func runReadinessCheck(ctx context.Context, check ReadinessCheck) error {
+	ctx, cancel := context.WithTimeout(ctx, readinessCheckTimeout)
+	defer cancel()
+
+	return check.Check(ctx)
+}
+
+func NewReadinessHandler(l log.Logger, checks ...ReadinessCheck) *ReadinessHandler {
+	return &ReadinessHandler{
+		l:      l,
+		checks: checks,
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
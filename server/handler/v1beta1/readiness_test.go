@@ -0,0 +1,77 @@
+package v1beta1_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/goto/salt/log"
+	"github.com/stretchr/testify/assert"
+
+	v1 "github.com/goto/optimus/server/handler/v1beta1"
+)
+
+func TestReadinessHandler(t *testing.T) {
+	logger := log.NewNoop()
+	passing := v1.ReadinessCheck{Name: "plugins", Check: func(context.Context) error { return nil }}
+	failing := v1.ReadinessCheck{Name: "migrations", Check: func(context.Context) error { return errors.New("database is dirty") }}
+
+	t.Run("returns unavailable until warmed up", func(t *testing.T) {
+		handler := v1.NewReadinessHandler(logger, passing)
+
+		req := httptest.NewRequest(http.MethodGet, v1.ReadinessPath, nil)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+		assert.JSONEq(t, `{"ready":false,"checks":{"warm_up":"in progress","plugins":"ok"}}`, rec.Body.String())
+	})
+	t.Run("returns unavailable with the error of the failed warm up", func(t *testing.T) {
+		handler := v1.NewReadinessHandler(logger, passing)
+		handler.SetWarmUpResult(errors.New("scheduler is unhealthy"))
+
+		req := httptest.NewRequest(http.MethodGet, v1.ReadinessPath, nil)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+		assert.JSONEq(t, `{"ready":false,"checks":{"warm_up":"scheduler is unhealthy","plugins":"ok"}}`, rec.Body.String())
+	})
+	t.Run("returns unavailable when a check fails", func(t *testing.T) {
+		handler := v1.NewReadinessHandler(logger, passing, failing)
+		handler.SetWarmUpResult(nil)
+
+		req := httptest.NewRequest(http.MethodGet, v1.ReadinessPath, nil)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+		assert.JSONEq(t, `{"ready":false,"checks":{"warm_up":"ok","plugins":"ok","migrations":"database is dirty"}}`, rec.Body.String())
+	})
+	t.Run("returns unavailable once draining", func(t *testing.T) {
+		handler := v1.NewReadinessHandler(logger, passing)
+		handler.SetWarmUpResult(nil)
+		handler.SetDraining()
+
+		req := httptest.NewRequest(http.MethodGet, v1.ReadinessPath, nil)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+		assert.JSONEq(t, `{"ready":false,"checks":{"warm_up":"ok","shutdown":"draining","plugins":"ok"}}`, rec.Body.String())
+	})
+	t.Run("returns ok when warmed up and every check passes", func(t *testing.T) {
+		handler := v1.NewReadinessHandler(logger, passing)
+		handler.SetWarmUpResult(errors.New("scheduler is unhealthy"))
+		handler.SetWarmUpResult(nil)
+
+		req := httptest.NewRequest(http.MethodGet, v1.ReadinessPath, nil)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"ready":true,"checks":{"warm_up":"ok","plugins":"ok"}}`, rec.Body.String())
+	})
+}
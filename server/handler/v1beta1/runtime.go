@@ -0,0 +1,21 @@
+package v1beta1
+
+// RuntimeHandler serves the runtime service, with the version of the server, the plugins registered in it, the
+// re-drive of its event outbox and the log levels of the namespaces
+type RuntimeHandler struct {
+	*VersionHandler
+	*PluginHandler
+	*EventOutboxHandler
+	*LogLevelHandler
+}
+
+func NewRuntimeHandler(versionHandler *VersionHandler, pluginHandler *PluginHandler, eventOutboxHandler *EventOutboxHandler,
+	logLevelHandler *LogLevelHandler,
+) *RuntimeHandler {
+	return &RuntimeHandler{
+		VersionHandler:     versionHandler,
+		PluginHandler:      pluginHandler,
+		EventOutboxHandler: eventOutboxHandler,
+		LogLevelHandler:    logLevelHandler,
+	}
+}
@@ -2,49 +2,17 @@ package server
 
 import (
 	"fmt"
-	"io"
 	"os"
 
 	"github.com/goto/salt/log"
-)
-
-type defaultLogger struct {
-	logger *log.Logrus
-}
-
-func (d defaultLogger) Debug(msg string, args ...interface{}) {
-	d.logger.Debug(fmt.Sprintf(msg, args...))
-}
-
-func (d defaultLogger) Info(msg string, args ...interface{}) {
-	d.logger.Info(fmt.Sprintf(msg, args...))
-}
-
-func (d defaultLogger) Warn(msg string, args ...interface{}) {
-	d.logger.Warn(fmt.Sprintf(msg, args...))
-}
 
-func (d defaultLogger) Error(msg string, args ...interface{}) {
-	d.logger.Error(fmt.Sprintf(msg, args...))
-}
-
-func (d defaultLogger) Fatal(msg string, args ...interface{}) {
-	d.logger.Fatal(fmt.Sprintf(msg, args...))
-}
-
-func (d defaultLogger) Level() string {
-	return d.logger.Level()
-}
-
-func (d defaultLogger) Writer() io.Writer {
-	return d.logger.Writer()
-}
+	"github.com/goto/optimus/internal/logging"
+)
 
-func NewLogger(level string) log.Logger {
-	return &defaultLogger{
-		logger: log.NewLogrus(
-			log.LogrusWithLevel(level),
-			log.LogrusWithWriter(os.Stderr),
-		),
+func NewLogger(level string, levels *logging.LevelRegistry) (log.Logger, error) {
+	logger, err := logging.NewLogger(level, os.Stderr, levels)
+	if err != nil {
+		return nil, fmt.Errorf("invalid log level [%s]: %w", level, err)
 	}
+	return logger, nil
 }
@@ -0,0 +1,25 @@
+package server_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/optimus/internal/logging"
+	"github.com/goto/optimus/server"
+)
+
+func TestNewLogger(t *testing.T) {
+	t.Run("returns error on invalid log level", func(t *testing.T) {
+		logger, err := server.NewLogger("loud", logging.NewLevelRegistry())
+
+		assert.ErrorContains(t, err, "invalid log level [loud]")
+		assert.Nil(t, logger)
+	})
+	t.Run("returns logger with the given level", func(t *testing.T) {
+		logger, err := server.NewLogger("warn", logging.NewLevelRegistry())
+
+		assert.NoError(t, err)
+		assert.Equal(t, "warning", logger.Level())
+	})
+}
@@ -20,6 +20,9 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/goto/optimus/config"
+	aHandler "github.com/goto/optimus/core/audit/handler/v1beta1"
+	aService "github.com/goto/optimus/core/audit/service"
+	"github.com/goto/optimus/core/event"
 	"github.com/goto/optimus/core/event/moderator"
 	jHandler "github.com/goto/optimus/core/job/handler/v1beta1"
 	jResolver "github.com/goto/optimus/core/job/resolver"
@@ -27,26 +30,42 @@ import (
 	rModel "github.com/goto/optimus/core/resource"
 	rHandler "github.com/goto/optimus/core/resource/handler/v1beta1"
 	rService "github.com/goto/optimus/core/resource/service"
+	"github.com/goto/optimus/core/scheduler"
 	schedulerHandler "github.com/goto/optimus/core/scheduler/handler/v1beta1"
 	schedulerResolver "github.com/goto/optimus/core/scheduler/resolver"
 	schedulerService "github.com/goto/optimus/core/scheduler/service"
+	tModel "github.com/goto/optimus/core/tenant"
 	tHandler "github.com/goto/optimus/core/tenant/handler/v1beta1"
 	tService "github.com/goto/optimus/core/tenant/service"
 	"github.com/goto/optimus/ext/notify/pagerduty"
 	"github.com/goto/optimus/ext/notify/slack"
+	"github.com/goto/optimus/ext/notify/webhook"
+	"github.com/goto/optimus/ext/resourcemanager"
+	"github.com/goto/optimus/ext/secret/gsm"
+	"github.com/goto/optimus/ext/secret/vault"
 	bqStore "github.com/goto/optimus/ext/store/bigquery"
 	"github.com/goto/optimus/ext/transport/kafka"
+	"github.com/goto/optimus/ext/transport/nats"
+	"github.com/goto/optimus/ext/transport/pubsub"
+	"github.com/goto/optimus/internal/auth"
 	"github.com/goto/optimus/internal/compiler"
 	"github.com/goto/optimus/internal/errors"
+	"github.com/goto/optimus/internal/lib/lru"
+	"github.com/goto/optimus/internal/logging"
 	"github.com/goto/optimus/internal/models"
 	"github.com/goto/optimus/internal/store/postgres"
+	auditRepo "github.com/goto/optimus/internal/store/postgres/audit"
+	eventRepo "github.com/goto/optimus/internal/store/postgres/event"
 	jRepo "github.com/goto/optimus/internal/store/postgres/job"
 	"github.com/goto/optimus/internal/store/postgres/resource"
 	schedulerRepo "github.com/goto/optimus/internal/store/postgres/scheduler"
 	"github.com/goto/optimus/internal/store/postgres/tenant"
 	"github.com/goto/optimus/internal/telemetry"
 	"github.com/goto/optimus/plugin"
+	"github.com/goto/optimus/plugin/remote"
+	"github.com/goto/optimus/plugin/sandbox"
 	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+	oPlugin "github.com/goto/optimus/sdk/plugin"
 	oHandler "github.com/goto/optimus/server/handler/v1beta1"
 )
 
@@ -58,25 +77,47 @@ type OptimusServer struct {
 	conf   *config.ServerConfig
 	logger log.Logger
 
+	// logLevels keeps the log levels of namespaces overridden at runtime
+	logLevels *logging.LevelRegistry
+
 	dbPool *pgxpool.Pool
 	key    *[keyLength]byte
 
-	serverAddr string
-	grpcServer *grpc.Server
-	httpServer *http.Server
+	serverAddr   string
+	grpcServer   *grpc.Server
+	httpServer   *http.Server
+	httpHandlers map[string]http.Handler
+
+	apiTokenService *tService.APITokenService
+	tokenAuth       tokenAuth
+	accessService   *tService.AccessService
+	roleAccess      roleAccess
+	rateLimit       rateLimit
+	auditLogService *aService.AuditLogService
+
+	readiness *oHandler.ReadinessHandler
+	warmer    warmer
 
 	pluginRepo *models.PluginRepository
+	drainFns   []func(ctx context.Context) error
 	cleanupFn  []func()
 
 	eventHandler moderator.Handler
+	outboxRelay  *moderator.OutboxRelay
 }
 
 func New(conf *config.ServerConfig) (*OptimusServer, error) {
 	addr := fmt.Sprintf(":%d", conf.Serve.Port)
+	logLevels := logging.NewLevelRegistry()
+	logger, err := NewLogger(conf.Log.Level.String(), logLevels)
+	if err != nil {
+		return nil, err
+	}
 	server := &OptimusServer{
 		conf:       conf,
 		serverAddr: addr,
-		logger:     NewLogger(conf.Log.Level.String()),
+		logger:     logger,
+		logLevels:  logLevels,
 	}
 
 	if err := checkRequiredConfigs(conf.Serve); err != nil {
@@ -84,11 +125,11 @@ func New(conf *config.ServerConfig) (*OptimusServer, error) {
 	}
 
 	setupFns := []setupFn{
-		server.setupPublisher,
 		server.setupPlugins,
 		server.setupTelemetry,
 		server.setupAppKey,
 		server.setupDB,
+		server.setupPublisher,
 		server.setupGRPCServer,
 		server.setupHandlers,
 		server.setupMonitoring,
@@ -102,6 +143,7 @@ func New(conf *config.ServerConfig) (*OptimusServer, error) {
 	}
 
 	server.logger.Info("Starting Optimus", "version", config.BuildVersion)
+	server.startWarmUp()
 	server.startListening()
 
 	return server, nil
@@ -113,9 +155,17 @@ func (s *OptimusServer) setupPublisher() error {
 		return nil
 	}
 
-	ch := make(chan []byte, s.conf.Publisher.Buffer)
+	encode, err := event.Encoder(s.conf.Publisher.Encoding)
+	if err != nil {
+		return err
+	}
+	var eventType func([]byte) (string, error)
+	if s.conf.Publisher.TopicPerEventType {
+		eventType = event.TypeResolver(s.conf.Publisher.Encoding)
+	}
 
-	var worker *moderator.Worker
+	var writer moderator.Writer
+	var interval time.Duration
 
 	switch s.conf.Publisher.Type {
 	case "kafka":
@@ -124,14 +174,74 @@ func (s *OptimusServer) setupPublisher() error {
 			return err
 		}
 
-		writer := kafka.NewWriter(kafkaConfig.BrokerURLs, kafkaConfig.Topic, s.logger)
-		interval := time.Second * time.Duration(kafkaConfig.BatchIntervalSecond)
-		worker = moderator.NewWorker(ch, writer, interval, s.logger)
+		kafkaWriter := kafka.NewWriter(kafkaConfig.BrokerURLs, kafkaConfig.Topic, s.logger)
+		if eventType != nil {
+			kafkaWriter.RouteByEventType(eventType)
+		}
+		writer = kafkaWriter
+		interval = time.Second * time.Duration(kafkaConfig.BatchIntervalSecond)
+	case "nats":
+		var natsConfig config.PublisherNATSConfig
+		if err := mapstructure.Decode(s.conf.Publisher.Config, &natsConfig); err != nil {
+			return err
+		}
+
+		natsWriter, err := nats.NewWriter(natsConfig.URL, natsConfig.Subject, s.logger)
+		if err != nil {
+			return err
+		}
+		if eventType != nil {
+			natsWriter.RouteByEventType(eventType)
+		}
+		writer = natsWriter
+		interval = time.Second * time.Duration(natsConfig.BatchIntervalSecond)
+	case "pubsub":
+		var pubsubConfig config.PublisherPubSubConfig
+		if err := mapstructure.Decode(s.conf.Publisher.Config, &pubsubConfig); err != nil {
+			return err
+		}
+
+		pubsubWriter, err := pubsub.NewWriter(pubsubConfig.ProjectID, pubsubConfig.Topic, pubsubConfig.MaxBatchSize, s.logger)
+		if err != nil {
+			return err
+		}
+		if eventType != nil {
+			pubsubWriter.RouteByEventType(eventType)
+		}
+		writer = pubsubWriter
+		interval = time.Second * time.Duration(pubsubConfig.BatchIntervalSecond)
 	default:
 		return fmt.Errorf("publisher with type [%s] is not recognized", s.conf.Publisher.Type)
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
+
+	if s.conf.Publisher.Outbox.Enabled {
+		outboxRepo := eventRepo.NewOutboxRepository(s.dbPool)
+		outboxConfig := moderator.OutboxConfig{
+			BatchSize:    s.conf.Publisher.Outbox.BatchSize,
+			MaxAttempts:  s.conf.Publisher.Outbox.MaxAttempts,
+			RetryBackoff: s.conf.Publisher.Outbox.RetryBackoff,
+		}
+		s.outboxRelay = moderator.NewOutboxRelay(outboxRepo, writer, interval, outboxConfig, func() time.Time {
+			return time.Now().UTC()
+		}, s.logger)
+		go s.outboxRelay.Run(ctx)
+
+		s.cleanupFn = append(s.cleanupFn, func() {
+			cancel()
+
+			if err := s.outboxRelay.Close(); err != nil {
+				s.logger.Error("error closing outbox relay: %v", err)
+			}
+		})
+
+		s.eventHandler = moderator.NewEncodedHandler(moderator.NewOutboxHandler(outboxRepo, s.logger), encode)
+		return nil
+	}
+
+	ch := make(chan []byte, s.conf.Publisher.Buffer)
+	worker := moderator.NewWorker(ch, writer, interval, s.logger)
 	go worker.Run(ctx)
 
 	s.cleanupFn = append(s.cleanupFn, func() {
@@ -142,7 +252,36 @@ func (s *OptimusServer) setupPublisher() error {
 		}
 	})
 
-	s.eventHandler = moderator.NewEventHandler(ch, s.logger)
+	s.eventHandler = moderator.NewEncodedHandler(moderator.NewEventHandler(ch, s.logger), encode)
+	return nil
+}
+
+func (s *OptimusServer) setupEventConsumer(consumer *schedulerHandler.JobEventConsumer) error {
+	if s.conf.EventConsumer == nil {
+		return nil
+	}
+
+	switch s.conf.EventConsumer.Type {
+	case "kafka":
+		var kafkaConfig config.EventConsumerKafkaConfig
+		if err := mapstructure.Decode(s.conf.EventConsumer.Config, &kafkaConfig); err != nil {
+			return err
+		}
+
+		reader := kafka.NewReader(kafkaConfig.BrokerURLs, kafkaConfig.Topic, kafkaConfig.GroupID, s.logger)
+		ctx, cancel := context.WithCancel(context.Background())
+		go reader.Read(ctx, consumer.Consume)
+
+		s.cleanupFn = append(s.cleanupFn, func() {
+			cancel()
+
+			if err := reader.Close(); err != nil {
+				s.logger.Error("error closing event consumer: %v", err)
+			}
+		})
+	default:
+		return fmt.Errorf("event consumer with type [%s] is not recognized", s.conf.EventConsumer.Type)
+	}
 	return nil
 }
 
@@ -161,13 +300,35 @@ func (s *OptimusServer) setupPlugins() error {
 	s.cleanupFn = append(s.cleanupFn, hPlugin.CleanupClients)
 
 	var pluginArgs []string
-	if s.conf.Telemetry.JaegerAddr != "" {
-		pluginArgs = append(pluginArgs, "-t", s.conf.Telemetry.JaegerAddr)
+	// binary plugins export their traces to the jaeger collector only
+	if tracing := telemetry.TracingConfigOf(s.conf.Telemetry); tracing.Endpoint != "" && tracing.Exporter == config.TracingExporterJaegerCollector {
+		pluginArgs = append(pluginArgs, "-t", tracing.Endpoint)
 	}
 	// discover and load plugins.
 	var err error
 	s.pluginRepo, err = plugin.Initialize(pluginLogger, pluginArgs...)
-	return err
+	if err != nil {
+		return err
+	}
+
+	remoteCleanup, err := remote.Init(s.pluginRepo, s.conf.Plugin.Remotes, pluginLogger)
+	s.cleanupFn = append(s.cleanupFn, remoteCleanup)
+	if err != nil {
+		return err
+	}
+
+	// the versions of a plugin share its mod, and so the limits of the mod
+	sandboxed := map[oPlugin.DependencyResolverMod]oPlugin.DependencyResolverMod{}
+	for _, p := range s.pluginRepo.GetAll() {
+		if p.DependencyMod == nil {
+			continue
+		}
+		if _, ok := sandboxed[p.DependencyMod]; !ok {
+			sandboxed[p.DependencyMod] = sandbox.NewDependencyMod(p.Info().Name, p.DependencyMod, s.conf.Plugin.Sandbox, pluginLogger)
+		}
+		p.DependencyMod = sandboxed[p.DependencyMod]
+	}
+	return nil
 }
 
 func (s *OptimusServer) setupTelemetry() error {
@@ -215,8 +376,36 @@ func (s *OptimusServer) setupDB() error {
 }
 
 func (s *OptimusServer) setupGRPCServer() error {
+	s.apiTokenService = tService.NewAPITokenService(tenant.NewAPITokenRepository(s.dbPool), s.logger)
+	s.tokenAuth = tokenAuth{
+		logger:        s.logger,
+		authenticator: s.apiTokenService,
+		required:      s.conf.Serve.Auth.Required,
+	}
+	if s.conf.Serve.Auth.OIDC.IssuerURL != "" {
+		verifier, err := auth.NewOIDCVerifier(s.conf.Serve.Auth.OIDC)
+		if err != nil {
+			return err
+		}
+		s.tokenAuth.oidc = verifier
+	}
+	s.auditLogService = aService.NewAuditLogService(auditRepo.NewAuditLogRepository(s.dbPool), s.logger)
+
+	rbacConf := s.conf.Serve.Auth.RBAC
+	s.accessService = tService.NewAccessService(tenant.NewRoleBindingRepository(s.dbPool), rbacConf.Enabled, rbacConf.Admins, s.logger)
+	s.roleAccess = roleAccess{
+		logger:     s.logger,
+		authorizer: s.accessService,
+		projects:   tenant.NewProjectRepository(s.dbPool),
+		enabled:    rbacConf.Enabled,
+	}
 	var err error
-	s.grpcServer, err = setupGRPCServer(s.logger)
+	s.rateLimit, err = newRateLimit(s.conf.Serve.RateLimit)
+	if err != nil {
+		return err
+	}
+
+	s.grpcServer, err = setupGRPCServer(s.logger, s.tokenAuth, s.rateLimit, auditLog{logger: s.logger, recorder: s.auditLogService}, s.roleAccess)
 	return err
 }
 
@@ -227,7 +416,20 @@ func (s *OptimusServer) setupMonitoring() error {
 }
 
 func (s *OptimusServer) setupHTTPProxy() error {
-	srv, cleanup, err := prepareHTTPProxy(s.serverAddr, s.grpcServer)
+	patterns := make([]string, 0, len(s.httpHandlers))
+	for pattern := range s.httpHandlers {
+		patterns = append(patterns, pattern)
+	}
+	if err := checkUnscopedPaths(patterns); err != nil {
+		return err
+	}
+
+	handlers := make(map[string]http.Handler, len(s.httpHandlers))
+	for pattern, handler := range s.httpHandlers {
+		handlers[pattern] = s.tokenAuth.httpMiddleware(pattern, handler)
+	}
+
+	srv, cleanup, err := prepareHTTPProxy(s.serverAddr, s.grpcServer, handlers, newLegacyCompat(s.conf.LegacyClient), s.rateLimit.gateway)
 	s.httpServer = srv
 	s.cleanupFn = append(s.cleanupFn, cleanup)
 	return err
@@ -245,44 +447,24 @@ func (s *OptimusServer) startListening() {
 	}()
 }
 
-func (s *OptimusServer) Shutdown() {
-	s.logger.Warn("Shutting down server")
-	if s.httpServer != nil {
-		// Create a deadline to wait for server
-		ctxProxy, cancelProxy := context.WithTimeout(context.Background(), shutdownWait)
-		defer cancelProxy()
-
-		if err := s.httpServer.Shutdown(ctxProxy); err != nil {
-			s.logger.Error("Error in proxy shutdown", err)
-		}
-	}
-
-	if s.grpcServer != nil {
-		s.grpcServer.GracefulStop()
-	}
-
-	for _, fn := range s.cleanupFn {
-		fn() // Todo: log all the errors from cleanup before exit
-	}
-
-	if s.dbPool != nil {
-		s.dbPool.Close()
-	}
-
-	s.logger.Info("Server shutdown complete")
-}
-
 func (s *OptimusServer) setupHandlers() error {
 	// Tenant Bounded Context Setup
 	tProjectRepo := tenant.NewProjectRepository(s.dbPool)
 	tNamespaceRepo := tenant.NewNamespaceRepository(s.dbPool)
 	tSecretRepo := tenant.NewSecretRepository(s.dbPool)
 	presetRepo := tenant.NewPresetRepository(s.dbPool)
+	tSnippetRepo := tenant.NewSnippetRepository(s.dbPool)
 
-	tProjectService := tService.NewProjectService(tProjectRepo, presetRepo)
+	tProjectService := tService.NewProjectService(tProjectRepo, presetRepo, s.pluginRepo)
 	tNamespaceService := tService.NewNamespaceService(tNamespaceRepo)
 	tSecretService := tService.NewSecretService(s.key, tSecretRepo, s.logger)
-	tenantService := tService.NewTenantService(tProjectService, tNamespaceService, tSecretService, s.logger)
+	secretProviders := map[string]tModel.SecretProvider{
+		tModel.SecretProviderVault:            vault.NewProvider(vault.DefaultTimeout),
+		tModel.SecretProviderGCPSecretManager: gsm.NewProvider(gsm.DefaultTimeout),
+	}
+	tExternalSecretsGetter := tService.NewExternalSecretsGetter(tProjectService, tSecretService, secretProviders, time.Now, s.logger)
+	tenantService := tService.NewTenantService(tProjectService, tNamespaceService, tExternalSecretsGetter, s.logger)
+	tSnippetService := tService.NewSnippetService(tSnippetRepo, s.logger)
 
 	// Scheduler bounded context
 	jobRunRepo := schedulerRepo.NewJobRunRepository(s.dbPool)
@@ -307,45 +489,96 @@ func (s *OptimusServer) setupHandlers() error {
 			},
 			new(pagerduty.PagerDutyServiceImpl),
 		),
+		"webhook": webhook.NewNotifier(webhook.DefaultTimeout),
 	}
+	webhookSubscriptionService := schedulerService.NewWebhookSubscriptionService(s.logger, schedulerRepo.NewWebhookSubscriptionRepository(s.dbPool),
+		tenantService, webhook.NewNotifier(s.conf.WebhookSubscription.Timeout), s.conf.WebhookSubscription)
 
 	newEngine := compiler.NewEngine()
 
 	newPriorityResolver := schedulerResolver.NewSimpleResolver()
-	assetCompiler := schedulerService.NewJobAssetsCompiler(newEngine, s.pluginRepo, s.logger)
-	jobInputCompiler := schedulerService.NewJobInputCompiler(tenantService, newEngine, assetCompiler, s.logger)
-	notificationService := schedulerService.NewNotifyService(s.logger, jobProviderRepo, tenantService, notifierChanels)
+	assetCompiler := schedulerService.NewTracedAssetCompiler(schedulerService.NewJobAssetsCompiler(newEngine, s.pluginRepo, tSnippetService, s.logger))
+	var jobInputCompiler schedulerService.JobInputCompiler = schedulerService.NewJobInputCompiler(tenantService, newEngine, assetCompiler, jobRunRepo, s.pluginRepo, s.conf.Serve.IngressHost, s.logger)
+	jobInputCompiler = schedulerService.NewProvenanceInputCompiler(jobInputCompiler, s.pluginRepo, s.logger)
+	if s.conf.ExecutorInput.CacheSize > 0 {
+		inputCache := lru.New[string, *scheduler.ExecutorInput](s.conf.ExecutorInput.CacheSize, s.conf.ExecutorInput.CacheTTL)
+		jobInputCompiler = schedulerService.NewCachedInputCompiler(jobInputCompiler, inputCache, tenantService, tSnippetService)
+	}
+	// the usage is recorded around the cache, so that the inputs served from the cache are recorded as well
+	jobInputCompiler = schedulerService.NewSecretUsageRecordingCompiler(jobInputCompiler, tSecretRepo, s.logger)
+	jobInputCompiler = schedulerService.NewTracedInputCompiler(jobInputCompiler)
+	alertSilenceService := schedulerService.NewAlertSilenceService(s.logger, schedulerRepo.NewAlertSilenceRepository(s.dbPool), func() time.Time {
+		return time.Now().UTC()
+	})
+	notificationService := schedulerService.NewNotifyService(s.logger, jobProviderRepo, tenantService, alertSilenceService,
+		webhookSubscriptionService, notifierChanels)
 	newScheduler, err := NewScheduler(s.logger, s.conf, s.pluginRepo, tProjectService, tSecretService)
 	if err != nil {
 		return err
 	}
 
 	replayRepository := schedulerRepo.NewReplayRepository(s.dbPool)
-	replayWorker := schedulerService.NewReplayWorker(s.logger, replayRepository, newScheduler, jobProviderRepo, s.conf.Replay)
+	replayBroadcaster := schedulerService.NewReplayBroadcaster()
+	replayEventPublisher := schedulerService.NewReplayEventPublisher(s.logger, replayBroadcaster, s.eventHandler)
+	replayWorker := schedulerService.NewReplayWorker(s.logger, replayRepository, newScheduler, jobProviderRepo, replayEventPublisher, s.conf.Replay)
 	replayManager := schedulerService.NewReplayManager(s.logger, replayRepository, replayWorker, func() time.Time {
 		return time.Now().UTC()
 	}, s.conf.Replay)
 
-	replayValidator := schedulerService.NewValidator(replayRepository, newScheduler, jobProviderRepo)
-	replayService := schedulerService.NewReplayService(replayRepository, jobProviderRepo, replayValidator, newScheduler, s.logger)
+	replayValidator := schedulerService.NewActiveTenantValidator(schedulerService.NewValidator(replayRepository, newScheduler, jobProviderRepo), tenantService)
+	replayService := schedulerService.NewReplayService(replayRepository, jobProviderRepo, replayValidator, newScheduler, replayBroadcaster, s.logger, s.conf.Replay)
+
+	slaMonitor := schedulerService.NewSLAMonitor(s.logger, tProjectService, jobProviderRepo, jobRunRepo, notificationService, s.eventHandler, func() time.Time {
+		return time.Now().UTC()
+	}, s.conf.SLAMonitor)
 
+	upstreamAccessRepository := schedulerRepo.NewUpstreamAccessRepository(s.dbPool)
+	upstreamAccessService := schedulerService.NewUpstreamAccessService(s.logger, upstreamAccessRepository, tProjectRepo, notificationService)
+	upstreamFreshnessEvaluator, err := resourcemanager.NewFreshnessEvaluator(s.conf.ResourceManagers)
+	if err != nil {
+		return err
+	}
+
+	failureClassifier, err := schedulerService.NewFailureRuleClassifier(s.conf.FailureRules)
+	if err != nil {
+		return err
+	}
+	var durationObserver schedulerService.DurationObserver
+	if s.conf.DurationAnomaly.Enabled {
+		durationObserver = schedulerService.NewDurationAnomalyDetector(s.logger, schedulerRepo.NewDurationStatsRepository(s.dbPool),
+			s.eventHandler, s.conf.DurationAnomaly)
+	}
 	newJobRunService := schedulerService.NewJobRunService(
 		s.logger, jobProviderRepo, jobRunRepo, replayRepository, operatorRunRepository,
-		newScheduler, newPriorityResolver, jobInputCompiler, s.eventHandler, tProjectRepo,
+		newScheduler, newPriorityResolver, jobInputCompiler, s.eventHandler, tProjectRepo, failureClassifier, durationObserver,
 	)
 
+	runSnapshotService := schedulerService.NewRunSnapshotService(s.logger, schedulerRepo.NewRunSnapshotRepository(s.dbPool), s.key, func() time.Time {
+		return time.Now().UTC()
+	}, s.conf.RunSnapshot)
+	var jobRunInputService schedulerHandler.JobRunService = newJobRunService
+	if s.conf.RunSnapshot.Enabled {
+		jobRunInputService = schedulerService.NewAuditedJobRunService(newJobRunService, runSnapshotService)
+	}
+
 	// Job Bounded Context Setup
 	jJobRepo := jRepo.NewJobRepository(s.dbPool)
-	jPluginService := jService.NewJobPluginService(s.pluginRepo, newEngine, s.logger)
+	jDeletionRepo := jRepo.NewDeletionRepository(s.dbPool)
+	jPluginService := jService.NewJobPluginService(s.pluginRepo, newEngine, tSnippetService, s.logger)
+	jCachedPluginService := jService.NewCachedPluginService(jPluginService, jRepo.NewUpstreamCacheRepository(s.dbPool), s.logger)
 	jExternalUpstreamResolver, _ := jResolver.NewExternalUpstreamResolver(s.conf.ResourceManagers)
 	jInternalUpstreamResolver := jResolver.NewInternalUpstreamResolver(jJobRepo)
 	jUpstreamResolver := jResolver.NewUpstreamResolver(jJobRepo, jExternalUpstreamResolver, jInternalUpstreamResolver)
-	jJobService := jService.NewJobService(jJobRepo, jJobRepo, jJobRepo, jPluginService, jUpstreamResolver, tenantService, s.eventHandler, s.logger, newJobRunService)
+	jJobService := jService.NewJobService(jJobRepo, jJobRepo, jJobRepo, jCachedPluginService, jUpstreamResolver, tenantService, s.eventHandler, s.logger, newJobRunService, jDeletionRepo)
+	jScheduleGroupService := jService.NewScheduleGroupService(jRepo.NewScheduleGroupRepository(s.dbPool), jJobRepo, newJobRunService, s.logger)
+	jLineageService := jService.NewLineageService(jJobRepo, s.logger)
+
+	tArchiveService := tService.NewArchiveService(tProjectRepo, tNamespaceRepo, jJobService, s.logger)
 
 	// Resource Bounded Context
 	resourceRepository := resource.NewRepository(s.dbPool)
 	backupRepository := resource.NewBackupRepository(s.dbPool)
-	resourceManager := rService.NewResourceManager(resourceRepository, s.logger)
+	resourceManager := rService.NewResourceManager(resourceRepository, tenantService, s.logger)
 	resourceService := rService.NewResourceService(s.logger, resourceRepository, jJobService, resourceManager, s.eventHandler)
 	backupService := rService.NewBackupService(backupRepository, resourceRepository, resourceManager, s.logger)
 
@@ -353,6 +586,8 @@ func (s *OptimusServer) setupHandlers() error {
 	bqClientProvider := bqStore.NewClientProvider()
 	bigqueryStore := bqStore.NewBigqueryDataStore(tenantService, bqClientProvider)
 	resourceManager.RegisterDatastore(rModel.Bigquery, bigqueryStore)
+	resourceManager.RegisterValidator(rModel.Bigquery, bqStore.NamingConventionValidator{})
+	resourceManager.RegisterValidator(rModel.Bigquery, bqStore.PartitionRequiredValidator{})
 
 	// Tenant Handlers
 	pb.RegisterSecretServiceServer(s.grpcServer, tHandler.NewSecretsHandler(s.logger, tSecretService))
@@ -362,19 +597,73 @@ func (s *OptimusServer) setupHandlers() error {
 	// Resource Handler
 	pb.RegisterResourceServiceServer(s.grpcServer, rHandler.NewResourceHandler(s.logger, resourceService))
 
-	pb.RegisterJobRunServiceServer(s.grpcServer, schedulerHandler.NewJobRunHandler(s.logger, newJobRunService, notificationService))
+	jobRunHandler := schedulerHandler.NewJobRunHandler(s.logger, jobRunInputService, notificationService, upstreamAccessService, upstreamFreshnessEvaluator)
+	pb.RegisterJobRunServiceServer(s.grpcServer, jobRunHandler)
+	if err := s.setupEventConsumer(schedulerHandler.NewJobEventConsumer(s.logger, jobRunHandler)); err != nil {
+		return err
+	}
+
+	s.warmer = warmer{
+		logger:          s.logger,
+		pluginRepo:      s.pluginRepo,
+		projectGetter:   tProjectService,
+		namespaceGetter: tNamespaceService,
+		scheduler:       newScheduler,
+	}
+	s.readiness = oHandler.NewReadinessHandler(s.logger,
+		oHandler.ReadinessCheck{Name: "plugins", Check: s.warmer.checkPlugins},
+		oHandler.ReadinessCheck{Name: "migrations", Check: func(ctx context.Context) error {
+			return postgres.VerifyMigration(ctx, s.dbPool)
+		}},
+	)
+
+	s.httpHandlers = map[string]http.Handler{
+		oHandler.ReadinessPath: s.readiness,
+	}
 
 	// backup service
 	pb.RegisterBackupServiceServer(s.grpcServer, rHandler.NewBackupHandler(s.logger, backupService))
 
-	// version service
-	pb.RegisterRuntimeServiceServer(s.grpcServer, oHandler.NewVersionHandler(s.logger, config.BuildVersion))
+	// runtime service
+	var outboxRedriver oHandler.OutboxRedriver
+	if s.outboxRelay != nil {
+		outboxRedriver = s.outboxRelay
+	}
+	pb.RegisterRuntimeServiceServer(s.grpcServer, oHandler.NewRuntimeHandler(
+		oHandler.NewVersionHandler(s.logger, config.BuildVersion),
+		oHandler.NewPluginHandler(s.logger, s.pluginRepo),
+		oHandler.NewEventOutboxHandler(s.logger, outboxRedriver),
+		oHandler.NewLogLevelHandler(s.logger, s.logLevels),
+	))
 
 	// Core Job Handler
 	pb.RegisterJobSpecificationServiceServer(s.grpcServer, jHandler.NewJobHandler(jJobService, s.logger))
+	pb.RegisterLineageServiceServer(s.grpcServer, jHandler.NewLineageHandler(s.logger, jLineageService, jJobService))
 
 	pb.RegisterReplayServiceServer(s.grpcServer, schedulerHandler.NewReplayHandler(s.logger, replayService))
+	pb.RegisterUpstreamAccessServiceServer(s.grpcServer, schedulerHandler.NewUpstreamAccessHandler(s.logger, upstreamAccessService))
+	pb.RegisterSnippetServiceServer(s.grpcServer, tHandler.NewSnippetHandler(s.logger, tSnippetService))
+	pb.RegisterScheduleGroupServiceServer(s.grpcServer, jHandler.NewScheduleGroupHandler(s.logger, jScheduleGroupService))
+	pb.RegisterAPITokenServiceServer(s.grpcServer, tHandler.NewAPITokenHandler(s.logger, s.apiTokenService))
+	pb.RegisterAlertSilenceServiceServer(s.grpcServer, schedulerHandler.NewAlertSilenceHandler(s.logger, alertSilenceService, newJobRunService))
+	pb.RegisterRunSnapshotServiceServer(s.grpcServer, schedulerHandler.NewRunSnapshotHandler(s.logger, runSnapshotService))
+	pb.RegisterWebhookSubscriptionServiceServer(s.grpcServer, schedulerHandler.NewWebhookSubscriptionHandler(s.logger, webhookSubscriptionService))
+	pb.RegisterArchiveServiceServer(s.grpcServer, tHandler.NewArchiveHandler(s.logger, tArchiveService))
+	pb.RegisterRoleBindingServiceServer(s.grpcServer, tHandler.NewAccessHandler(s.logger, s.accessService))
+
+	// audit log service
+	pb.RegisterAuditLogServiceServer(s.grpcServer, aHandler.NewAuditLogHandler(s.logger, s.auditLogService))
 	replayManager.Initialize()
+	s.drainFns = append(s.drainFns, replayManager.Drain)
+	slaMonitor.Initialize()
+	s.cleanupFn = append(s.cleanupFn, slaMonitor.Close)
+	runSnapshotService.Initialize()
+	s.cleanupFn = append(s.cleanupFn, runSnapshotService.Close)
+	s.cleanupFn = append(s.cleanupFn, func() {
+		if err := webhookSubscriptionService.Close(); err != nil {
+			s.logger.Error("error closing webhook subscription service: %s", err)
+		}
+	})
 
 	s.cleanupFn = append(s.cleanupFn, func() {
 		err = notificationService.Close()
@@ -0,0 +1,429 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/goto/optimus/config"
+	"github.com/goto/optimus/internal/auth"
+	"github.com/goto/optimus/internal/telemetry"
+)
+
+const (
+	callGroupDeploy  = "deploy"
+	callGroupReplay  = "replay"
+	callGroupCompile = "compile"
+
+	retryAfterKey     = "retry-after"
+	forwardedForKey   = "x-forwarded-for"
+	rateLimitSweepGap = time.Minute
+
+	metricRateLimitedCalls = "server_rate_limited_calls_total"
+)
+
+// rateLimitedMethods are the expensive grpc methods, with the group of calls they are limited in
+var rateLimitedMethods = map[string]string{
+	"JobSpecificationService/DeployJobSpecification":      callGroupDeploy,
+	"JobSpecificationService/ReplaceAllJobSpecifications": callGroupDeploy,
+	"JobSpecificationService/AddJobSpecifications":        callGroupDeploy,
+	"JobSpecificationService/UpdateJobSpecifications":     callGroupDeploy,
+	"JobSpecificationService/CreateJobSpecification":      callGroupDeploy,
+	"JobSpecificationService/RefreshJobs":                 callGroupDeploy,
+	"ResourceService/DeployResourceSpecification":         callGroupDeploy,
+	"ResourceService/ApplyResources":                      callGroupDeploy,
+	"JobRunService/UploadToScheduler":                     callGroupDeploy,
+
+	"ReplayService/Replay":        callGroupReplay,
+	"ReplayService/ReplayDryRun":  callGroupReplay,
+	"JobRunService/TriggerJobRun": callGroupReplay,
+
+	"JobRunService/JobRunInput":                      callGroupCompile,
+	"JobRunService/EncryptedJobRunInput":             callGroupCompile,
+	"JobRunService/CompileExecutorInputAt":           callGroupCompile,
+	"JobSpecificationService/CheckJobSpecification":  callGroupCompile,
+	"JobSpecificationService/CheckJobSpecifications": callGroupCompile,
+	"JobSpecificationService/JobInspect":             callGroupCompile,
+	"JobSpecificationService/ValidateJobSpecs":       callGroupCompile,
+}
+
+// clientLimit limits the calls of every client to a group
+type clientLimit interface {
+	// available tells whether the client can call the group, it returns how long the client has to wait otherwise.
+	// The call is only counted with take.
+	available(client string, now time.Time) (bool, time.Duration)
+	take(client string, now time.Time)
+}
+
+// clientLimiter is a token bucket of every client calling a group, refilled at the rate. The buckets refilled
+// completely are dropped, as they are the same as new ones.
+type clientLimiter struct {
+	mu      sync.Mutex
+	rate    float64
+	burst   float64
+	buckets map[string]*tokenBucket
+
+	lastSweep time.Time
+}
+
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+func newClientLimiter(rate float64, burst int) *clientLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &clientLimiter{
+		rate:    rate,
+		burst:   float64(burst),
+		buckets: map[string]*tokenBucket{},
+	}
+}
+
+// available tells whether the client has a token left, it returns how long the client has to wait for the next
+// token when none is left. The token is only taken with take.
+func (l *clientLimiter) available(client string, now time.Time) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	bucket := l.refill(client, now)
+	if bucket.tokens < 1 {
+		return false, time.Duration((1 - bucket.tokens) / l.rate * float64(time.Second))
+	}
+	return true, 0
+}
+
+// take takes a token of the client, the client may go below zero tokens when it has taken the last one since
+// it is checked, then it waits longer for the next token
+func (l *clientLimiter) take(client string, now time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.refill(client, now).tokens--
+}
+
+func (l *clientLimiter) refill(client string, now time.Time) *tokenBucket {
+	if now.Sub(l.lastSweep) > rateLimitSweepGap {
+		l.sweep(now)
+	}
+
+	bucket, ok := l.buckets[client]
+	if !ok {
+		bucket = &tokenBucket{tokens: l.burst, last: now}
+		l.buckets[client] = bucket
+	}
+	bucket.tokens = math.Min(l.burst, bucket.tokens+now.Sub(bucket.last).Seconds()*l.rate)
+	bucket.last = now
+	return bucket
+}
+
+func (l *clientLimiter) sweep(now time.Time) {
+	refill := time.Duration(l.burst / l.rate * float64(time.Second))
+	for client, bucket := range l.buckets {
+		if now.Sub(bucket.last) >= refill {
+			delete(l.buckets, client)
+		}
+	}
+	l.lastSweep = now
+}
+
+// clientQuota counts the calls of every client to a group within a window, the clients over the quota wait for the
+// next window. The counts of a window are dropped when the next one starts.
+type clientQuota struct {
+	mu     sync.Mutex
+	quota  int
+	window time.Duration
+	counts map[string]int
+
+	windowStart time.Time
+}
+
+func newClientQuota(quota int, window time.Duration) *clientQuota {
+	return &clientQuota{
+		quota:  quota,
+		window: window,
+		counts: map[string]int{},
+	}
+}
+
+func (q *clientQuota) available(client string, now time.Time) (bool, time.Duration) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.startWindow(now)
+	if q.counts[client] >= q.quota {
+		return false, q.windowStart.Add(q.window).Sub(now)
+	}
+	return true, 0
+}
+
+func (q *clientQuota) take(client string, now time.Time) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.startWindow(now)
+	q.counts[client]++
+}
+
+func (q *clientQuota) startWindow(now time.Time) {
+	if now.Before(q.windowStart.Add(q.window)) {
+		return
+	}
+	q.windowStart = now.Truncate(q.window)
+	q.counts = map[string]int{}
+}
+
+// rateLimit rejects the expensive calls of the clients going over the rate or the quota of the group of the call,
+// the clients being the principals of the calls and the ip addresses they are made from. The rejected calls are told
+// when to retry with the retry-after metadata, in seconds.
+type rateLimit struct {
+	principalLimits map[string][]clientLimit
+	ipLimits        map[string][]clientLimit
+
+	// trustedProxies are the proxies in front of the server, only their x-forwarded-for header is honoured
+	trustedProxies []*net.IPNet
+	// gateway is the http gateway proxying to the grpc server, which forwards the address of the http clients
+	gateway *gatewayPeers
+}
+
+// gatewayPeers are the local addresses of the connections of the http gateway to the grpc server, the gateway is
+// trusted as a proxy for the calls made through them, as it appends the address of the http client to the
+// x-forwarded-for of the call
+type gatewayPeers struct {
+	addresses sync.Map
+}
+
+// dial connects the gateway to the grpc server, keeping the address the connection is made from until it is closed
+func (g *gatewayPeers) dial(ctx context.Context, addr string) (net.Conn, error) {
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	g.addresses.Store(conn.LocalAddr().String(), struct{}{})
+	return &gatewayConn{Conn: conn, peers: g}, nil
+}
+
+func (g *gatewayPeers) contains(addr string) bool {
+	_, ok := g.addresses.Load(addr)
+	return ok
+}
+
+type gatewayConn struct {
+	net.Conn
+
+	peers *gatewayPeers
+}
+
+func (c *gatewayConn) Close() error {
+	c.peers.addresses.Delete(c.LocalAddr().String())
+	return c.Conn.Close()
+}
+
+func newRateLimit(conf config.RateLimitConfig) (rateLimit, error) {
+	if (len(conf.PrincipalQuotas) > 0 || len(conf.IPQuotas) > 0) && conf.QuotaWindow <= 0 {
+		return rateLimit{}, fmt.Errorf("invalid serve.rate_limit.quota_window %s, it has to be positive for the quotas", conf.QuotaWindow)
+	}
+	limits := func(rates map[string]float64, quotas map[string]int) map[string][]clientLimit {
+		limits := map[string][]clientLimit{}
+		for group, rate := range rates {
+			if rate > 0 {
+				limits[group] = append(limits[group], newClientLimiter(rate, conf.Burst))
+			}
+		}
+		for group, quota := range quotas {
+			if quota > 0 {
+				limits[group] = append(limits[group], newClientQuota(quota, conf.QuotaWindow))
+			}
+		}
+		return limits
+	}
+
+	trustedProxies, err := parseTrustedProxies(conf.TrustedProxies)
+	if err != nil {
+		return rateLimit{}, err
+	}
+
+	return rateLimit{
+		principalLimits: limits(conf.PrincipalRates, conf.PrincipalQuotas),
+		ipLimits:        limits(conf.IPRates, conf.IPQuotas),
+		trustedProxies:  trustedProxies,
+		gateway:         &gatewayPeers{},
+	}, nil
+}
+
+// limitedClient is a limit of the group applying to the client of a call, kind being principal or ip
+type limitedClient struct {
+	limit  clientLimit
+	client string
+	kind   string
+}
+
+// check returns how long the client has to wait before calling the group again, 0 when the call is allowed.
+// The call is counted in the limits of the principal and of the ip address only when it is within all of them.
+func (r rateLimit) check(group string, principal *auth.Principal, ip string) time.Duration {
+	now := time.Now()
+
+	var limited []limitedClient
+	if principal != nil {
+		for _, limit := range r.principalLimits[group] {
+			limited = append(limited, limitedClient{limit: limit, client: principal.String(), kind: "principal"})
+		}
+	}
+	if ip != "" {
+		for _, limit := range r.ipLimits[group] {
+			limited = append(limited, limitedClient{limit: limit, client: ip, kind: "ip"})
+		}
+	}
+
+	for _, c := range limited {
+		if ok, retryAfter := c.limit.available(c.client, now); !ok {
+			raiseRateLimitedMetric(group, c.kind)
+			return retryAfter
+		}
+	}
+	for _, c := range limited {
+		c.limit.take(c.client, now)
+	}
+	return 0
+}
+
+func (r rateLimit) checkGRPC(ctx context.Context, fullMethod string) (time.Duration, string) {
+	group, ok := rateLimitedMethods[strings.TrimPrefix(fullMethod, grpcServicePrefix)]
+	if !ok {
+		return 0, ""
+	}
+	return r.check(group, auth.PrincipalFrom(ctx), r.grpcClientIP(ctx)), group
+}
+
+func (r rateLimit) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	retryAfter, group := r.checkGRPC(ctx, info.FullMethod)
+	if retryAfter > 0 {
+		_ = grpc.SetHeader(ctx, metadata.Pairs(retryAfterKey, retryAfterSeconds(retryAfter)))
+		return nil, rateLimitedError(group, retryAfter)
+	}
+	return handler(ctx, req)
+}
+
+func (r rateLimit) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	retryAfter, group := r.checkGRPC(ss.Context(), info.FullMethod)
+	if retryAfter > 0 {
+		_ = ss.SetHeader(metadata.Pairs(retryAfterKey, retryAfterSeconds(retryAfter)))
+		return rateLimitedError(group, retryAfter)
+	}
+	return handler(srv, ss)
+}
+
+func rateLimitedError(group string, retryAfter time.Duration) error {
+	return status.Errorf(codes.ResourceExhausted, "too many %s calls, retry after %s seconds", group, retryAfterSeconds(retryAfter))
+}
+
+func retryAfterSeconds(retryAfter time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(retryAfter.Seconds())))
+}
+
+// grpcClientIP returns the address of the peer, or the address it forwards the call for when the peer is the http
+// gateway or a trusted proxy. The forwarded addresses are not taken from anyone else, as the clients can send any
+// of them.
+func (r rateLimit) grpcClientIP(ctx context.Context) string {
+	var peerIP string
+	var fromGateway bool
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		peerIP = hostOf(p.Addr.String())
+		fromGateway = r.gateway != nil && r.gateway.contains(p.Addr.String())
+	}
+
+	var forwarded string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		forwarded = strings.Join(md.Get(forwardedForKey), ",")
+	}
+	return r.clientIP(peerIP, forwarded, fromGateway)
+}
+
+// clientIP walks the forwarded addresses from the nearest one, as every proxy appends the address it is called from,
+// and returns the first address which is not a trusted proxy
+func (r rateLimit) clientIP(peerIP, forwarded string, trustedPeer bool) string {
+	if forwarded == "" || !(trustedPeer || r.isTrustedProxy(peerIP)) {
+		return peerIP
+	}
+
+	addresses := strings.Split(forwarded, ",")
+	client := peerIP
+	for i := len(addresses) - 1; i >= 0; i-- {
+		address := strings.TrimSpace(addresses[i])
+		if address == "" {
+			continue
+		}
+		client = address
+		if !r.isTrustedProxy(address) {
+			break
+		}
+	}
+	return client
+}
+
+func (r rateLimit) isTrustedProxy(address string) bool {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return false
+	}
+	for _, proxy := range r.trustedProxies {
+		if proxy.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseTrustedProxies parses the proxies given as ip addresses or CIDR ranges
+func parseTrustedProxies(proxies []string) ([]*net.IPNet, error) {
+	trusted := make([]*net.IPNet, 0, len(proxies))
+	for _, proxy := range proxies {
+		if !strings.Contains(proxy, "/") {
+			ip := net.ParseIP(proxy)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q in serve.rate_limit.trusted_proxies", proxy)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			trusted = append(trusted, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+
+		_, network, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q in serve.rate_limit.trusted_proxies: %w", proxy, err)
+		}
+		trusted = append(trusted, network)
+	}
+	return trusted, nil
+}
+
+func hostOf(addr string) string {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+	return host
+}
+
+func raiseRateLimitedMetric(group, client string) {
+	telemetry.NewCounter(metricRateLimitedCalls, map[string]string{
+		"group":  group,
+		"client": client,
+	}).Inc()
+}
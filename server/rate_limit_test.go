@@ -0,0 +1,243 @@
+package server_test
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/goto/optimus/config"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/auth"
+	pb "github.com/goto/optimus/protos/gotocompany/optimus/core/v1beta1"
+	"github.com/goto/optimus/server"
+)
+
+func TestRateLimit(t *testing.T) {
+	// a call every 1000 seconds, so the tokens are not refilled within the test
+	slowRate := map[string]float64{"compile": 0.001}
+	info := &grpc.UnaryServerInfo{FullMethod: "/gotocompany.optimus.core.v1beta1.JobRunService/EncryptedJobRunInput"}
+	handler := func(context.Context, interface{}) (interface{}, error) { return "done", nil }
+	callContext := func(principal, address, forwardedFor string) context.Context {
+		addr, _ := net.ResolveTCPAddr("tcp", address)
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: addr})
+		if forwardedFor != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("x-forwarded-for", forwardedFor))
+		}
+		if principal != "" {
+			ctx = auth.WithPrincipal(ctx, &auth.Principal{Type: auth.PrincipalOIDC, Name: principal})
+		}
+		return ctx
+	}
+
+	t.Run("unaryInterceptor", func(t *testing.T) {
+		t.Run("rejects the calls of a principal over its burst as resource exhausted", func(t *testing.T) {
+			interceptor, err := server.NewTestRateLimitInterceptor(config.RateLimitConfig{PrincipalRates: slowRate, Burst: 2})
+			assert.NoError(t, err)
+
+			resp, err := interceptor(callContext("john", "1.1.1.1:1234", ""), nil, info, handler)
+			assert.NoError(t, err)
+			assert.Equal(t, "done", resp)
+			_, err = interceptor(callContext("john", "2.2.2.2:1234", ""), nil, info, handler)
+			assert.NoError(t, err)
+
+			_, err = interceptor(callContext("john", "3.3.3.3:1234", ""), nil, info, handler)
+			assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+			assert.ErrorContains(t, err, "too many compile calls, retry after 1000 seconds")
+
+			_, err = interceptor(callContext("jane", "3.3.3.3:1234", ""), nil, info, handler)
+			assert.NoError(t, err)
+		})
+		t.Run("limits the api tokens of the same name in different projects separately", func(t *testing.T) {
+			interceptor, err := server.NewTestRateLimitInterceptor(config.RateLimitConfig{PrincipalRates: slowRate, Burst: 1})
+			assert.NoError(t, err)
+			tokenContext := func(projectName string) context.Context {
+				apiToken, err := tenant.NewAPIToken(tenant.ProjectName(projectName), "ci", tenant.TokenScopeDeploy, "hash", time.Time{}, time.Time{})
+				assert.NoError(t, err)
+				principal := &auth.Principal{Type: auth.PrincipalAPIToken, Name: apiToken.Name(), APIToken: apiToken}
+				return auth.WithPrincipal(callContext("", "1.1.1.1:1234", ""), principal)
+			}
+
+			_, err = interceptor(tokenContext("proj-a"), nil, info, handler)
+			assert.NoError(t, err)
+			_, err = interceptor(tokenContext("proj-a"), nil, info, handler)
+			assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+			_, err = interceptor(tokenContext("proj-b"), nil, info, handler)
+			assert.NoError(t, err)
+		})
+		t.Run("rejects the calls of an ip address over its burst as resource exhausted", func(t *testing.T) {
+			interceptor, err := server.NewTestRateLimitInterceptor(config.RateLimitConfig{IPRates: slowRate, Burst: 1})
+			assert.NoError(t, err)
+
+			_, err = interceptor(callContext("", "1.1.1.1:1234", ""), nil, info, handler)
+			assert.NoError(t, err)
+
+			_, err = interceptor(callContext("", "1.1.1.1:1234", ""), nil, info, handler)
+			assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+			_, err = interceptor(callContext("", "2.2.2.2:1234", ""), nil, info, handler)
+			assert.NoError(t, err)
+		})
+		t.Run("does not limit the methods out of the groups", func(t *testing.T) {
+			interceptor, err := server.NewTestRateLimitInterceptor(config.RateLimitConfig{PrincipalRates: slowRate, Burst: 1})
+			assert.NoError(t, err)
+
+			unknown := &grpc.UnaryServerInfo{FullMethod: "/gotocompany.optimus.core.v1beta1.JobRunService/JobRun"}
+			_, err = interceptor(callContext("john", "1.1.1.1:1234", ""), nil, unknown, handler)
+			assert.NoError(t, err)
+			_, err = interceptor(callContext("john", "1.1.1.1:1234", ""), nil, unknown, handler)
+			assert.NoError(t, err)
+		})
+		t.Run("ignores the forwarded metadata sent by the clients which are not trusted proxies", func(t *testing.T) {
+			interceptor, err := server.NewTestRateLimitInterceptor(config.RateLimitConfig{IPRates: slowRate, Burst: 1})
+			assert.NoError(t, err)
+
+			_, err = interceptor(callContext("", "1.1.1.1:1234", "5.5.5.5"), nil, info, handler)
+			assert.NoError(t, err)
+
+			_, err = interceptor(callContext("", "1.1.1.1:1234", "6.6.6.6"), nil, info, handler)
+			assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		})
+		t.Run("limits the forwarded address sent by the trusted proxies", func(t *testing.T) {
+			interceptor, err := server.NewTestRateLimitInterceptor(config.RateLimitConfig{IPRates: slowRate, Burst: 1, TrustedProxies: []string{"10.0.0.0/8"}})
+			assert.NoError(t, err)
+
+			_, err = interceptor(callContext("", "10.0.0.1:1234", "5.5.5.5"), nil, info, handler)
+			assert.NoError(t, err)
+			_, err = interceptor(callContext("", "10.0.0.1:1234", "6.6.6.6"), nil, info, handler)
+			assert.NoError(t, err)
+			// the address prepended by the client is not taken, only the one appended by the proxy
+			_, err = interceptor(callContext("", "10.0.0.1:1234", "7.7.7.7, 5.5.5.5"), nil, info, handler)
+			assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		})
+		t.Run("does not take the token of the principal when the ip address is limited", func(t *testing.T) {
+			interceptor, err := server.NewTestRateLimitInterceptor(config.RateLimitConfig{PrincipalRates: slowRate, IPRates: slowRate, Burst: 1})
+			assert.NoError(t, err)
+
+			_, err = interceptor(callContext("john", "1.1.1.1:1234", ""), nil, info, handler)
+			assert.NoError(t, err)
+			_, err = interceptor(callContext("jane", "1.1.1.1:1234", ""), nil, info, handler)
+			assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+			_, err = interceptor(callContext("jane", "2.2.2.2:1234", ""), nil, info, handler)
+			assert.NoError(t, err)
+		})
+		t.Run("rejects the calls of a principal over its quota until the window is over", func(t *testing.T) {
+			interceptor, err := server.NewTestRateLimitInterceptor(config.RateLimitConfig{
+				PrincipalQuotas: map[string]int{"compile": 2},
+				QuotaWindow:     time.Hour,
+			})
+			assert.NoError(t, err)
+
+			_, err = interceptor(callContext("john", "1.1.1.1:1234", ""), nil, info, handler)
+			assert.NoError(t, err)
+			_, err = interceptor(callContext("john", "2.2.2.2:1234", ""), nil, info, handler)
+			assert.NoError(t, err)
+
+			_, err = interceptor(callContext("john", "3.3.3.3:1234", ""), nil, info, handler)
+			assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+			assert.ErrorContains(t, err, "too many compile calls")
+
+			_, err = interceptor(callContext("jane", "3.3.3.3:1234", ""), nil, info, handler)
+			assert.NoError(t, err)
+		})
+		t.Run("does not count the call in the quota when the rate is exceeded", func(t *testing.T) {
+			interceptor, err := server.NewTestRateLimitInterceptor(config.RateLimitConfig{
+				PrincipalRates: slowRate,
+				Burst:          1,
+				IPQuotas:       map[string]int{"compile": 1},
+				QuotaWindow:    time.Hour,
+			})
+			assert.NoError(t, err)
+
+			_, err = interceptor(callContext("john", "1.1.1.1:1234", ""), nil, info, handler)
+			assert.NoError(t, err)
+			_, err = interceptor(callContext("john", "2.2.2.2:1234", ""), nil, info, handler)
+			assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+			_, err = interceptor(callContext("jane", "2.2.2.2:1234", ""), nil, info, handler)
+			assert.NoError(t, err)
+		})
+		t.Run("returns error when the quota window is not positive", func(t *testing.T) {
+			_, err := server.NewTestRateLimitInterceptor(config.RateLimitConfig{PrincipalQuotas: map[string]int{"deploy": 10}})
+			assert.ErrorContains(t, err, "invalid serve.rate_limit.quota_window")
+		})
+		t.Run("returns error when a trusted proxy is invalid", func(t *testing.T) {
+			_, err := server.NewTestRateLimitInterceptor(config.RateLimitConfig{TrustedProxies: []string{"proxy"}})
+			assert.ErrorContains(t, err, "invalid trusted proxy")
+		})
+	})
+	t.Run("clientQuota", func(t *testing.T) {
+		windowStart := time.Date(2023, 10, 10, 10, 0, 0, 0, time.UTC)
+
+		t.Run("tells the client over the quota to wait until the next window", func(t *testing.T) {
+			available, take := server.NewTestClientQuota(2, time.Hour)
+
+			take("john", windowStart.Add(time.Minute))
+			take("john", windowStart.Add(time.Minute*2))
+
+			ok, retryAfter := available("john", windowStart.Add(time.Minute*20))
+			assert.False(t, ok)
+			assert.Equal(t, time.Minute*40, retryAfter)
+
+			ok, _ = available("jane", windowStart.Add(time.Minute*20))
+			assert.True(t, ok)
+		})
+		t.Run("starts the counts over with the next window", func(t *testing.T) {
+			available, take := server.NewTestClientQuota(1, time.Hour)
+
+			take("john", windowStart.Add(time.Minute*50))
+			ok, _ := available("john", windowStart.Add(time.Minute*59))
+			assert.False(t, ok)
+
+			ok, _ = available("john", windowStart.Add(time.Hour))
+			assert.True(t, ok)
+		})
+	})
+	t.Run("gateway", func(t *testing.T) {
+		t.Run("limits the http clients of the gateway by their own address", func(t *testing.T) {
+			interceptor, dialer, err := server.NewTestGatewayRateLimit(config.RateLimitConfig{IPRates: slowRate, Burst: 1})
+			assert.NoError(t, err)
+
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			assert.NoError(t, err)
+			grpcServer := grpc.NewServer(grpc.UnaryInterceptor(interceptor))
+			pb.RegisterJobRunServiceServer(grpcServer, pb.UnimplementedJobRunServiceServer{})
+			go func() { _ = grpcServer.Serve(listener) }()
+			defer grpcServer.Stop()
+
+			conn, err := grpc.Dial(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()),
+				grpc.WithContextDialer(dialer))
+			assert.NoError(t, err)
+			defer conn.Close()
+
+			gwmux := runtime.NewServeMux()
+			assert.NoError(t, pb.RegisterJobRunServiceHandler(context.Background(), gwmux, conn))
+
+			callGateway := func(remoteAddr string) int {
+				req := httptest.NewRequest(http.MethodPost, "/v1beta1/project/sample/job/sample-job/run_input", strings.NewReader("{}"))
+				req.RemoteAddr = remoteAddr
+				rec := httptest.NewRecorder()
+				gwmux.ServeHTTP(rec, req)
+				return rec.Code
+			}
+
+			// the calls which are not limited reach the unimplemented service
+			assert.Equal(t, http.StatusNotImplemented, callGateway("1.1.1.1:1234"))
+			assert.Equal(t, http.StatusNotImplemented, callGateway("2.2.2.2:1234"))
+			assert.Equal(t, http.StatusTooManyRequests, callGateway("1.1.1.1:4321"))
+			assert.Equal(t, http.StatusTooManyRequests, callGateway("2.2.2.2:4321"))
+		})
+	})
+}
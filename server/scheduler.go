@@ -1,25 +1,62 @@
 package server
 
 import (
+	"fmt"
+
 	"github.com/goto/salt/log"
 
 	"github.com/goto/optimus/config"
+	schedulerService "github.com/goto/optimus/core/scheduler/service"
 	"github.com/goto/optimus/ext/scheduler/airflow"
 	"github.com/goto/optimus/ext/scheduler/airflow/bucket"
 	"github.com/goto/optimus/ext/scheduler/airflow/dag"
+	"github.com/goto/optimus/ext/scheduler/temporal"
 )
 
+// Scheduler is the scheduler the jobs are deployed to and run on
+type Scheduler interface {
+	schedulerService.Scheduler
+	schedulerService.ReplayScheduler
+}
+
 func NewScheduler(l log.Logger, conf *config.ServerConfig, pluginRepo dag.PluginRepo, projecGetter airflow.ProjectGetter,
 	secretGetter airflow.SecretGetter,
-) (*airflow.Scheduler, error) {
-	bucketFactory := bucket.NewFactory(projecGetter, secretGetter)
+) (Scheduler, error) {
+	switch conf.Scheduler.Type {
+	case "", "airflow":
+		bucketFactory := bucket.NewFactory(projecGetter, secretGetter)
 
-	dagCompiler, err := dag.NewDagCompiler(l, conf.Serve.IngressHost, pluginRepo)
-	if err != nil {
-		return nil, err
-	}
+		dagCompiler, err := dag.NewDagCompiler(l, conf.Serve.IngressHost, pluginRepo)
+		if err != nil {
+			return nil, err
+		}
 
-	client := airflow.NewAirflowClient()
-	scheduler := airflow.NewScheduler(l, bucketFactory, client, dagCompiler, projecGetter, secretGetter)
-	return scheduler, nil
+		airflowConfig := conf.Scheduler.Airflow
+		client := airflow.NewAirflowClient(airflow.ClientConfig{
+			Timeout:             airflowConfig.Timeout,
+			MaxIdleConnsPerHost: airflowConfig.MaxIdleConnsPerHost,
+			MaxConnsPerHost:     airflowConfig.MaxConnsPerHost,
+			IdleConnTimeout:     airflowConfig.IdleConnTimeout,
+			RateLimits:          airflowConfig.RateLimits,
+			RateLimitBurst:      airflowConfig.RateLimitBurst,
+			FailureThreshold:    airflowConfig.CircuitBreaker.FailureThreshold,
+			OpenDuration:        airflowConfig.CircuitBreaker.OpenDuration,
+		})
+		canary := airflow.CanaryConfig{
+			Enabled:       airflowConfig.Canary.Enabled,
+			CheckInterval: airflowConfig.Canary.CheckInterval,
+			CheckTimeout:  airflowConfig.Canary.CheckTimeout,
+		}
+		return airflow.NewScheduler(l, bucketFactory, client, dagCompiler, projecGetter, secretGetter, canary), nil
+	case "temporal":
+		temporalConfig := temporal.Config{
+			TaskQueue:    conf.Scheduler.Temporal.TaskQueue,
+			WorkflowType: conf.Scheduler.Temporal.WorkflowType,
+			IngressHost:  conf.Serve.IngressHost,
+		}
+		client := temporal.NewTemporalClient()
+		return temporal.NewScheduler(l, client, temporalConfig, projecGetter, secretGetter), nil
+	default:
+		return nil, fmt.Errorf("scheduler with type [%s] is not recognized", conf.Scheduler.Type)
+	}
 }
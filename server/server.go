@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
-	"strings"
 	"time"
 
 	"github.com/goto/salt/log"
@@ -16,6 +15,7 @@ import (
 	grpctags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/sirupsen/logrus"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
@@ -56,10 +56,13 @@ func checkRequiredConfigs(conf config.Serve) error {
 	} else if parsed.Scheme != "postgres" {
 		return errors.New("unsupported database scheme, use 'postgres'")
 	}
+	if conf.Auth.RBAC.Enabled && !conf.Auth.Required {
+		return errors.New("serve.auth.rbac.enabled needs serve.auth.required, the requests without credentials are not checked by the roles")
+	}
 	return nil
 }
 
-func setupGRPCServer(l log.Logger) (*grpc.Server, error) {
+func setupGRPCServer(l log.Logger, auth tokenAuth, limited rateLimit, audited auditLog, access roleAccess) (*grpc.Server, error) {
 	// Logrus entry is used, allowing pre-definition of certain fields by the user.
 	grpcLogLevel, err := logrus.ParseLevel(l.Level())
 	if err != nil {
@@ -85,11 +88,19 @@ func setupGRPCServer(l log.Logger) (*grpc.Server, error) {
 			otelgrpc.UnaryServerInterceptor(),
 			grpc_prometheus.UnaryServerInterceptor,
 			grpc_recovery.UnaryServerInterceptor(grpc_recovery.WithRecoveryHandler(recoverPanic)),
+			auth.unaryInterceptor,
+			limited.unaryInterceptor,
+			audited.unaryInterceptor,
+			access.unaryInterceptor,
 		),
 		grpc_middleware.WithStreamServerChain(
 			otelgrpc.StreamServerInterceptor(),
 			grpc_prometheus.StreamServerInterceptor,
 			grpc_recovery.StreamServerInterceptor(grpc_recovery.WithRecoveryHandler(recoverPanic)),
+			auth.streamInterceptor,
+			limited.streamInterceptor,
+			audited.streamInterceptor,
+			access.streamInterceptor,
 		),
 		grpc.MaxRecvMsgSize(GRPCMaxRecvMsgSize),
 		grpc.MaxSendMsgSize(GRPCMaxSendMsgSize),
@@ -108,7 +119,7 @@ func setupGRPCServer(l log.Logger) (*grpc.Server, error) {
 	return grpcServer, nil
 }
 
-func prepareHTTPProxy(grpcAddr string, grpcServer *grpc.Server) (*http.Server, func(), error) {
+func prepareHTTPProxy(grpcAddr string, grpcServer *grpc.Server, handlers map[string]http.Handler, legacy *legacyCompat, gateway *gatewayPeers) (*http.Server, func(), error) {
 	timeoutGrpcDialCtx, grpcDialCancel := context.WithTimeout(context.Background(), DialTimeout)
 	defer grpcDialCancel()
 
@@ -119,6 +130,7 @@ func prepareHTTPProxy(grpcAddr string, grpcServer *grpc.Server) (*http.Server, f
 	// gRPC dialup options to proxy http connections
 	grpcConn, err := grpc.DialContext(timeoutGrpcDialCtx, grpcAddr, []grpc.DialOption{
 		grpc.WithInsecure(),
+		grpc.WithContextDialer(gateway.dial),
 		grpc.WithDefaultCallOptions(
 			grpc.MaxCallRecvMsgSize(GRPCMaxRecvMsgSize),
 			grpc.MaxCallSendMsgSize(GRPCMaxSendMsgSize),
@@ -159,22 +171,60 @@ func prepareHTTPProxy(grpcAddr string, grpcServer *grpc.Server) (*http.Server, f
 	if err := pb.RegisterSecretServiceHandler(runtimeCtx, gwmux, grpcConn); err != nil {
 		return nil, cleanup, fmt.Errorf("RegisterSecretServiceHandler: %w", err)
 	}
+	if err := pb.RegisterLineageServiceHandler(runtimeCtx, gwmux, grpcConn); err != nil {
+		return nil, cleanup, fmt.Errorf("RegisterLineageServiceHandler: %w", err)
+	}
+	if err := pb.RegisterAuditLogServiceHandler(runtimeCtx, gwmux, grpcConn); err != nil {
+		return nil, cleanup, fmt.Errorf("RegisterAuditLogServiceHandler: %w", err)
+	}
+	if err := pb.RegisterUpstreamAccessServiceHandler(runtimeCtx, gwmux, grpcConn); err != nil {
+		return nil, cleanup, fmt.Errorf("RegisterUpstreamAccessServiceHandler: %w", err)
+	}
+	if err := pb.RegisterSnippetServiceHandler(runtimeCtx, gwmux, grpcConn); err != nil {
+		return nil, cleanup, fmt.Errorf("RegisterSnippetServiceHandler: %w", err)
+	}
+	if err := pb.RegisterScheduleGroupServiceHandler(runtimeCtx, gwmux, grpcConn); err != nil {
+		return nil, cleanup, fmt.Errorf("RegisterScheduleGroupServiceHandler: %w", err)
+	}
+	if err := pb.RegisterAPITokenServiceHandler(runtimeCtx, gwmux, grpcConn); err != nil {
+		return nil, cleanup, fmt.Errorf("RegisterAPITokenServiceHandler: %w", err)
+	}
+	if err := pb.RegisterAlertSilenceServiceHandler(runtimeCtx, gwmux, grpcConn); err != nil {
+		return nil, cleanup, fmt.Errorf("RegisterAlertSilenceServiceHandler: %w", err)
+	}
+	if err := pb.RegisterRunSnapshotServiceHandler(runtimeCtx, gwmux, grpcConn); err != nil {
+		return nil, cleanup, fmt.Errorf("RegisterRunSnapshotServiceHandler: %w", err)
+	}
+	if err := pb.RegisterWebhookSubscriptionServiceHandler(runtimeCtx, gwmux, grpcConn); err != nil {
+		return nil, cleanup, fmt.Errorf("RegisterWebhookSubscriptionServiceHandler: %w", err)
+	}
+	if err := pb.RegisterArchiveServiceHandler(runtimeCtx, gwmux, grpcConn); err != nil {
+		return nil, cleanup, fmt.Errorf("RegisterArchiveServiceHandler: %w", err)
+	}
+	if err := pb.RegisterRoleBindingServiceHandler(runtimeCtx, gwmux, grpcConn); err != nil {
+		return nil, cleanup, fmt.Errorf("RegisterRoleBindingServiceHandler: %w", err)
+	}
 
 	// base router
 	baseMux := http.NewServeMux()
 	baseMux.HandleFunc("/ping", func(w http.ResponseWriter, r *http.Request) {
 		fmt.Fprintf(w, "pong")
 	})
+	baseMux.Handle("/metrics", promhttp.Handler())
 	baseMux.HandleFunc("/plugins", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
 		w.Header().Set("Content-Type", "application/zip")
 		http.ServeFile(w, r, plugin.PluginsArchiveName)
 	})
 	baseMux.Handle("/api/", otelhttp.NewHandler(http.StripPrefix("/api", gwmux), "api"))
+	// handlers which are not served through grpc gateway
+	for pattern, handler := range handlers {
+		baseMux.Handle(pattern, otelhttp.NewHandler(handler, pattern))
+	}
 
 	//nolint: gomnd
 	srv := &http.Server{
-		Handler:      grpcHandlerFunc(grpcServer, baseMux),
+		Handler:      grpcHandlerFunc(grpcServer, baseMux, legacy),
 		Addr:         grpcAddr,
 		ReadTimeout:  5 * time.Second,
 		WriteTimeout: 30 * time.Minute, // FIXME: Creating issues for grpc connection
@@ -190,12 +240,13 @@ func prepareHTTPProxy(grpcAddr string, grpcServer *grpc.Server) (*http.Server, f
 // into two ports, default port for grpc and default+1 for grpc-gateway proxy.
 // We can also use something like a connection multiplexer
 // https://github.com/soheilhy/cmux to achieve the same.
-func grpcHandlerFunc(grpcServer *grpc.Server, otherHandler http.Handler) http.Handler {
-	return h2c.NewHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.ProtoMajor == 2 && strings.Contains(r.Header.Get("Content-Type"), "application/grpc") {
+// The requests of legacy clients are translated before being routed, when the compatibility is enabled.
+func grpcHandlerFunc(grpcServer *grpc.Server, otherHandler http.Handler, legacy *legacyCompat) http.Handler {
+	return h2c.NewHandler(legacy.wrap(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if isGRPCRequest(r) {
 			grpcServer.ServeHTTP(w, r)
 		} else {
 			otherHandler.ServeHTTP(w, r)
 		}
-	}), &http2.Server{})
+	})), &http2.Server{})
 }
@@ -0,0 +1,74 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// Shutdown stops the server in phases. It reports not ready first, then it stops taking new calls and waits for
+// the calls being served, the executor input compilations among them, along with the replays being processed to be
+// checkpointed, all within the drain timeout. The connections to the database and the publisher are closed last.
+func (s *OptimusServer) Shutdown() {
+	s.logger.Warn("Shutting down server")
+	conf := s.conf.Serve.Shutdown
+
+	if s.readiness != nil {
+		s.readiness.SetDraining()
+		if conf.ReadinessDelay > 0 {
+			s.logger.Info("waiting %s for the load balancers to stop sending calls", conf.ReadinessDelay)
+			time.Sleep(conf.ReadinessDelay)
+		}
+	}
+
+	drainTimeout := conf.DrainTimeout
+	if drainTimeout <= 0 {
+		drainTimeout = shutdownWait
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), drainTimeout)
+	defer cancel()
+
+	if s.httpServer != nil {
+		if err := s.httpServer.Shutdown(ctx); err != nil {
+			s.logger.Error("Error in proxy shutdown", err)
+		}
+	}
+
+	if s.grpcServer != nil {
+		gracefulStopGRPC(ctx, s.grpcServer)
+	}
+
+	for _, fn := range s.drainFns {
+		if err := fn(ctx); err != nil {
+			s.logger.Error("error draining in-flight work: %s", err)
+		}
+	}
+
+	// the cleanups are run in reverse, what is set up later may depend on what is set up before it
+	for i := len(s.cleanupFn) - 1; i >= 0; i-- {
+		s.cleanupFn[i]() // Todo: log all the errors from cleanup before exit
+	}
+
+	if s.dbPool != nil {
+		s.dbPool.Close()
+	}
+
+	s.logger.Info("Server shutdown complete")
+}
+
+// gracefulStopGRPC waits for the calls being served to finish, the ones left once the context is done are cancelled
+func gracefulStopGRPC(ctx context.Context, srv *grpc.Server) {
+	stopped := make(chan struct{})
+	go func() {
+		srv.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		srv.Stop()
+		<-stopped
+	}
+}
@@ -0,0 +1,130 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/goto/salt/log"
+
+	"github.com/goto/optimus/core/scheduler"
+	"github.com/goto/optimus/core/tenant"
+	"github.com/goto/optimus/internal/models"
+)
+
+const (
+	warmUpTimeout       = 2 * time.Minute
+	warmUpRetryInterval = 30 * time.Second
+)
+
+type warmUpProjectGetter interface {
+	GetAll(ctx context.Context) ([]*tenant.Project, error)
+}
+
+type warmUpNamespaceGetter interface {
+	GetAll(ctx context.Context, projectName tenant.ProjectName) ([]*tenant.Namespace, error)
+}
+
+type warmUpScheduler interface {
+	GetEnvironmentHealth(ctx context.Context, tnnt tenant.Tenant) (*scheduler.EnvironmentHealth, error)
+}
+
+// warmer prepares the server after startup, before it is reported as ready to serve requests
+type warmer struct {
+	logger log.Logger
+
+	pluginRepo      *models.PluginRepository
+	projectGetter   warmUpProjectGetter
+	namespaceGetter warmUpNamespaceGetter
+	scheduler       warmUpScheduler
+}
+
+func (w warmer) warmUp(ctx context.Context) error {
+	if err := w.checkPlugins(ctx); err != nil {
+		return err
+	}
+
+	projects, err := w.projectGetter.GetAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	return w.checkSchedulers(ctx, projects)
+}
+
+func (w warmer) checkPlugins(context.Context) error {
+	if len(w.pluginRepo.GetAll()) == 0 {
+		return errors.New("no plugins are loaded")
+	}
+	return nil
+}
+
+// checkSchedulers checks the scheduler environment of the projects, which also caches their scheduler credentials.
+// It only fails when none of the environments is healthy, so a single unhealthy project does not keep the server out.
+func (w warmer) checkSchedulers(ctx context.Context, projects []*tenant.Project) error {
+	var checked, healthy int
+	for _, project := range projects {
+		namespaces, err := w.namespaceGetter.GetAll(ctx, project.Name())
+		if err != nil {
+			return err
+		}
+		if len(namespaces) == 0 {
+			continue
+		}
+
+		tnnt, err := tenant.NewTenant(project.Name().String(), namespaces[0].Name().String())
+		if err != nil {
+			return err
+		}
+
+		checked++
+		health, err := w.scheduler.GetEnvironmentHealth(ctx, tnnt)
+		if err != nil {
+			w.logger.Warn("unable to check scheduler of project [%s]: %s", project.Name(), err)
+			continue
+		}
+		if !health.IsHealthy() {
+			w.logger.Warn("scheduler of project [%s] is unhealthy", project.Name())
+			continue
+		}
+		healthy++
+	}
+
+	if checked > 0 && healthy == 0 {
+		return fmt.Errorf("none of the schedulers of %d projects is healthy", checked)
+	}
+	return nil
+}
+
+// startWarmUp keeps warming up the server in background until it succeeds, the readiness probe reports
+// the server as not ready until then
+func (s *OptimusServer) startWarmUp() {
+	// plugins are sorted lazily when listed, listing them before the server starts listening
+	// avoids sorting them concurrently on the first requests
+	s.pluginRepo.GetAll()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cleanupFn = append(s.cleanupFn, cancel)
+
+	go func() {
+		for {
+			warmUpCtx, cancelWarmUp := context.WithTimeout(ctx, warmUpTimeout)
+			err := s.warmer.warmUp(warmUpCtx)
+			cancelWarmUp()
+
+			s.readiness.SetWarmUpResult(err)
+			if err == nil {
+				s.logger.Info("Warm up is finished")
+				return
+			}
+			s.logger.Warn("warm up fails, retrying in %s: %s", warmUpRetryInterval, err)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(warmUpRetryInterval):
+			}
+		}
+	}()
+}
@@ -90,6 +90,7 @@ func dropTables(db *pgxpool.Pool) error {
 	tablesToDelete := []string{
 		"instance",
 		"hook_run",
+		"failure_hook_run",
 		"sensor_run",
 		"task_run",
 		"job_run_old",
@@ -135,12 +136,19 @@ func TruncateTablesWith(pool *pgxpool.Pool) {
 	pool.Exec(ctx, "TRUNCATE TABLE backup CASCADE")
 	pool.Exec(ctx, "TRUNCATE TABLE replay_request CASCADE")
 	pool.Exec(ctx, "TRUNCATE TABLE replay_run CASCADE")
+	pool.Exec(ctx, "TRUNCATE TABLE upstream_access_request CASCADE")
+	pool.Exec(ctx, "TRUNCATE TABLE alert_silence CASCADE")
+	pool.Exec(ctx, "TRUNCATE TABLE run_snapshot CASCADE")
+	pool.Exec(ctx, "TRUNCATE TABLE job_deletion_consent, job_deletion_audit CASCADE")
+	pool.Exec(ctx, "TRUNCATE TABLE job_schedule_group CASCADE")
 	pool.Exec(ctx, "TRUNCATE TABLE resource CASCADE")
 
 	pool.Exec(ctx, "TRUNCATE TABLE job_run CASCADE")
+	pool.Exec(ctx, "TRUNCATE TABLE job_run_event CASCADE")
 	pool.Exec(ctx, "TRUNCATE TABLE sensor_run CASCADE")
 	pool.Exec(ctx, "TRUNCATE TABLE task_run CASCADE")
 	pool.Exec(ctx, "TRUNCATE TABLE hook_run CASCADE")
+	pool.Exec(ctx, "TRUNCATE TABLE failure_hook_run CASCADE")
 
 	pool.Exec(ctx, "TRUNCATE TABLE job CASCADE")
 
@@ -149,8 +157,20 @@ func TruncateTablesWith(pool *pgxpool.Pool) {
 	pool.Exec(ctx, "TRUNCATE TABLE project CASCADE")
 	pool.Exec(ctx, "TRUNCATE TABLE project_old, namespace_old, secret_old CASCADE")
 	pool.Exec(ctx, "TRUNCATE TABLE preset CASCADE")
+	pool.Exec(ctx, "TRUNCATE TABLE snippet CASCADE")
+	pool.Exec(ctx, "TRUNCATE TABLE api_token CASCADE")
+	pool.Exec(ctx, "TRUNCATE TABLE role_binding CASCADE")
 
 	pool.Exec(ctx, "TRUNCATE TABLE job_deployment CASCADE")
 
 	pool.Exec(ctx, "TRUNCATE TABLE job_upstream CASCADE")
+	pool.Exec(ctx, "TRUNCATE TABLE job_column_lineage CASCADE")
+	pool.Exec(ctx, "TRUNCATE TABLE job_upstream_cache CASCADE")
+	pool.Exec(ctx, "TRUNCATE TABLE event_outbox CASCADE")
+	pool.Exec(ctx, "TRUNCATE TABLE webhook_subscription, webhook_delivery CASCADE")
+	pool.Exec(ctx, "TRUNCATE TABLE job_run_duration_stats CASCADE")
+	pool.Exec(ctx, "TRUNCATE TABLE job_run_trigger CASCADE")
+	pool.Exec(ctx, "TRUNCATE TABLE job_spec_version CASCADE")
+	pool.Exec(ctx, "TRUNCATE TABLE secret_version, job_secret_version CASCADE")
+	pool.Exec(ctx, "TRUNCATE TABLE audit_log CASCADE")
 }